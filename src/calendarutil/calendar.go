@@ -0,0 +1,319 @@
+package calendarutil
+
+import (
+    "errors"
+    "fmt"
+    "math"
+)
+
+/**
+ * 历法配置，由改用Gregorian历法的第一天决定，这一天以前按照Julian历法
+ */
+type Calendar struct {
+    // 开始使用Gregorian历法的第一天的儒略日数
+    reform int
+}
+
+var (
+    ErrInvalidMonth = errors.New("calendarutil: invalid month")
+    ErrInvalidDay = errors.New("calendarutil: invalid day")
+    ErrInvalidTime = errors.New("calendarutil: invalid time of day")
+    ErrYearOutOfRange = errors.New("calendarutil: year out of range")
+    ErrNonexistentDate = errors.New("calendarutil: date does not exist in this calendar")
+)
+
+/**
+ * 给错误加上出错的日期，仍然可以用errors.Is判断是哪种错误
+ */
+func dateError(err error, year, month, day int) error {
+    return fmt.Errorf("%w: %d-%02d-%02d", err, year, month, day)
+}
+
+/**
+ * 支持的最小年份(天文纪年，即公元前4713年，儒略日数从这一年开始)
+ */
+const MIN_YEAR = -4712
+
+/**
+ * 支持的最大年份
+ */
+const MAX_YEAR = 9999
+
+var daysOfMonth = []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+var (
+    // 全部按照Gregorian历法
+    ProlepticGregorian = &Calendar{math.MinInt64}
+    // 全部按照Julian历法
+    ProlepticJulian = &Calendar{math.MaxInt64}
+    // 1582年10月4日之后是10月15日，与JULIAN_LAST_*和GREGORIAN_FIRST_*常量一致
+    DefaultCalendar = NewReformCalendar(GREGORIAN_FIRST_YEAR, GREGORIAN_FIRST_MONTH, GREGORIAN_FIRST_DATE)
+    // 英国及其殖民地，1752年9月2日之后是9月14日
+    BritishCalendar = NewReformCalendar(1752, 9, 14)
+    // 俄国，1918年1月31日之后是2月14日
+    RussianCalendar = NewReformCalendar(1918, 2, 14)
+)
+
+/**
+ * 创建在某天改用Gregorian历法的历法配置，前一天是Julian历的日期
+ *
+ * @param year
+ *            开始使用Gregorian历法的年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @return 历法配置
+ */
+func NewReformCalendar(year, month, day int) *Calendar {
+    return &Calendar{ToJulianDateInGregorian(year, month, day)}
+}
+
+/**
+ * 判断日期是否按照Gregorian历法，同时检查日期是否有效
+ *
+ * @return 按照Gregorian历法返回true，按照Julian历法返回false，日期无效时返回错误
+ */
+func (c *Calendar) IsGregorian(year, month, day int) (bool, error) {
+    if year < MIN_YEAR || year > MAX_YEAR {
+        return false, dateError(ErrYearOutOfRange, year, month, day)
+    }
+    if month < 1 || month > 12 {
+        return false, dateError(ErrInvalidMonth, year, month, day)
+    }
+    if day < 1 || day > 31 {
+        return false, dateError(ErrInvalidDay, year, month, day)
+    }
+    if ToJulianDateInGregorian(year, month, day) >= c.reform {
+        if day > getDaysOfMonth(month, IsGregorianLeapYear(year)) {
+            return false, dateError(ErrInvalidDay, year, month, day)
+        }
+        return true, nil
+    }
+    if ToJulianDateInJulian(year, month, day) < c.reform {
+        if day > getDaysOfMonth(month, IsJulianLeapYear(year)) {
+            return false, dateError(ErrInvalidDay, year, month, day)
+        }
+        return false, nil
+    }
+    return false, dateError(ErrNonexistentDate, year, month, day)
+}
+
+/**
+ * 检查日期是否有效
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @return 日期有效时返回nil，否则返回包装了ErrYearOutOfRange、ErrInvalidMonth、ErrInvalidDay或ErrNonexistentDate的错误，
+ *         用errors.Is判断
+ */
+func (c *Calendar) Validate(year, month, day int) error {
+    _, err := c.IsGregorian(year, month, day)
+    return err
+}
+
+func getDaysOfMonth(month int, leap bool) int {
+    if month == 2 && leap {
+        return 29
+    }
+    return daysOfMonth[month - 1]
+}
+
+/**
+ * 计算某月第一个存在的日期的儒略日数，这个月的1日在改历时被跳过的时候是改历的第一天
+ */
+func (c *Calendar) getFirstDayOfMonth(year, month int) (int, error) {
+    jdn, err := c.ToJulianDate(year, month, 1)
+    if errors.Is(err, ErrNonexistentDate) {
+        return c.reform, nil
+    }
+    return jdn, err
+}
+
+/**
+ * 计算某月的天数，改历所在的月份不计跳过的日子，例如英国1752年9月只有19天
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @return 天数
+ */
+func (c *Calendar) DaysInMonth(year, month int) (int, error) {
+    if month < 1 || month > 12 {
+        return 0, fmt.Errorf("%w: %d-%02d", ErrInvalidMonth, year, month)
+    }
+    first, err := c.getFirstDayOfMonth(year, month)
+    if err != nil {
+        return 0, err
+    }
+    year, month = year + month / 12, month % 12 + 1
+    if year > MAX_YEAR {
+        // 最后一个月，不必考虑改历
+        return 31, nil
+    }
+    next, err := c.getFirstDayOfMonth(year, month)
+    if err != nil {
+        return 0, err
+    }
+    return next - first, nil
+}
+
+/**
+ * 计算某年的天数，改历所在的年份不计跳过的日子，例如英国1752年只有355天
+ *
+ * @param year
+ *            年份
+ * @return 天数
+ */
+func (c *Calendar) DaysInYear(year int) (int, error) {
+    first, err := c.getFirstDayOfMonth(year, 1)
+    if err != nil {
+        return 0, err
+    }
+    if year == MAX_YEAR {
+        if c.IsLeapYear(year) {
+            return 366, nil
+        }
+        return 365, nil
+    }
+    next, err := c.getFirstDayOfMonth(year + 1, 1)
+    if err != nil {
+        return 0, err
+    }
+    return next - first, nil
+}
+
+/**
+ * 闰年判断，按照这一年2月末所用的历法
+ *
+ * @param year
+ *            年份
+ * @return 闰年返回true，平年返回false
+ */
+func (c *Calendar) IsLeapYear(year int) bool {
+    // Julian历的2月29日(或3月1日)在改历以前
+    if ToJulianDateInJulian(year, 3, 1) <= c.reform {
+        return IsJulianLeapYear(year)
+    }
+    return IsGregorianLeapYear(year)
+}
+
+/**
+ * 计算日期的儒略日数，以当天中午12点为准
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @return 儒略日数，日期无效或者在改历时跳过的日子里时返回错误
+ */
+func (c *Calendar) ToJulianDate(year, month, day int) (int, error) {
+    gregorian, err := c.IsGregorian(year, month, day)
+    if err != nil {
+        return 0, err
+    }
+    if gregorian {
+        return ToJulianDateInGregorian(year, month, day), nil
+    }
+    return ToJulianDateInJulian(year, month, day), nil
+}
+
+/**
+ * 计算日期时间的儒略日
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @param hour
+ *            小时
+ * @param minute
+ *            分钟
+ * @param second
+ *            秒数
+ * @return 儒略日，日期时间无效或者在改历时跳过的日子里时返回错误
+ */
+func (c *Calendar) ToJulianDateHMS(year, month, day, hour, minute int, second float64) (float64, error) {
+    jd, err := c.ToJulianDay(year, month, day, hour, minute, second)
+    if err != nil {
+        return 0, err
+    }
+    return jd.Float(), nil
+}
+
+/**
+ * 由儒略日数计算日期，算法摘自<a href="http://en.wikipedia.org/wiki/Julian_day">英文维基百科<i>Julian Day</i>词条</a>
+ *
+ * @param jdn
+ *            儒略日数
+ * @return 年、月、日
+ */
+func (c *Calendar) FromJulianDate(jdn int) (int, int, int) {
+    var b, d int
+    if jdn >= c.reform {
+        a := jdn + 32044
+        b = (4 * a + 3) / 146097
+        d = a - 146097 * b / 4
+    } else {
+        d = jdn + 32082
+    }
+    e := (4 * d + 3) / 1461
+    f := d - 1461 * e / 4
+    m := (5 * f + 2) / 153
+    day := f - (153 * m + 2) / 5 + 1
+    month := m + 3 - 12 * (m / 10)
+    year := 100 * b + e - 4800 + m / 10
+    return year, month, day
+}
+
+/**
+ * 计算星期几
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @return 星期几的数字表示，1-6表示星期一到星期六，0表示星期日，日期无效时返回错误
+ */
+func (c *Calendar) GetWeekday(year, month, day int) (int, error) {
+    jdn, err := c.ToJulianDate(year, month, day)
+    if err != nil {
+        return 0, err
+    }
+    return (jdn + 1) % 7, nil
+}
+
+/**
+ * 按照{@link #DefaultCalendar}计算某月的天数
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @return 天数
+ */
+func DaysInMonth(year, month int) (int, error) {
+    return DefaultCalendar.DaysInMonth(year, month)
+}
+
+/**
+ * 按照{@link #DefaultCalendar}计算某年的天数
+ *
+ * @param year
+ *            年份
+ * @return 天数
+ */
+func DaysInYear(year int) (int, error) {
+    return DefaultCalendar.DaysInYear(year)
+}
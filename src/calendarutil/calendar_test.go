@@ -0,0 +1,184 @@
+package calendarutil
+
+import (
+    "errors"
+    "testing"
+)
+
+func Test_Calendar_ToJulianDate(t *testing.T) {
+    for _, v := range []struct {
+        calendar *Calendar
+        year, month, day int
+        jdn int
+    }{
+        {DefaultCalendar, 1582, 10, 4, 2299160},
+        {DefaultCalendar, 1582, 10, 15, 2299161},
+        {DefaultCalendar, 2000, 1, 1, 2451545},
+        {BritishCalendar, 1752, 9, 2, 2361221},
+        {BritishCalendar, 1752, 9, 14, 2361222},
+        {BritishCalendar, 1700, 3, 1, ToJulianDateInJulian(1700, 3, 1)},
+        {RussianCalendar, 1918, 1, 31, 2421638},
+        {RussianCalendar, 1918, 2, 14, 2421639},
+        {ProlepticGregorian, 1582, 10, 4, ToJulianDateInGregorian(1582, 10, 4)},
+        {ProlepticJulian, 2000, 1, 1, 2451558},
+        // 公元前4713年1月1日(天文纪年-4712年)
+        {ProlepticJulian, -4712, 1, 1, 0},
+    } {
+        jdn, err := v.calendar.ToJulianDate(v.year, v.month, v.day)
+        if err != nil || jdn != v.jdn {
+            t.Error("fail", v.year, v.month, v.day, jdn, err)
+        }
+        year, month, day := v.calendar.FromJulianDate(jdn)
+        if year != v.year || month != v.month || day != v.day {
+            t.Error("fail", year, month, day)
+        }
+    }
+}
+
+func Test_Calendar_Gap(t *testing.T) {
+    for _, v := range []struct {
+        calendar *Calendar
+        year, month, day int
+    }{
+        {DefaultCalendar, 1582, 10, 5},
+        {DefaultCalendar, 1582, 10, 14},
+        {BritishCalendar, 1752, 9, 3},
+        {BritishCalendar, 1752, 9, 13},
+        {RussianCalendar, 1918, 2, 1},
+    } {
+        if _, err := v.calendar.ToJulianDate(v.year, v.month, v.day); !errors.Is(err, ErrNonexistentDate) {
+            t.Error("fail", v.year, v.month, v.day)
+        }
+        if _, err := v.calendar.GetWeekday(v.year, v.month, v.day); !errors.Is(err, ErrNonexistentDate) {
+            t.Error("fail", v.year, v.month, v.day)
+        }
+    }
+    if _, err := ProlepticGregorian.ToJulianDate(1582, 10, 10); err == nil {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    // 包级函数不报错，跳过的日子按10月15日计算
+    first, _ := DefaultCalendar.ToJulianDate(1582, 10, 15)
+    if ToJulianDate(1582, 10, 10) != first || GetWeekday(1582, 10, 10) != GetWeekday(1582, 10, 15) {
+        t.Error("fail")
+    }
+}
+
+func Test_Calendar_IsLeapYear(t *testing.T) {
+    // 1700年在英国仍是闰年，在欧洲大陆已经不是
+    if BritishCalendar.IsLeapYear(1700) && !DefaultCalendar.IsLeapYear(1700) &&
+        ProlepticJulian.IsLeapYear(1900) && !ProlepticGregorian.IsLeapYear(1500) &&
+        RussianCalendar.IsLeapYear(1900) && !RussianCalendar.IsLeapYear(2100) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    for year := 1000; year < 2200; year++ {
+        if IsLeapYear(year) != DefaultCalendar.IsLeapYear(year) {
+            t.Error("fail", year)
+        }
+    }
+}
+
+func Test_Calendar_GetWeekday(t *testing.T) {
+    // 1752年9月2日是星期三，9月14日是星期四
+    w1, _ := BritishCalendar.GetWeekday(1752, 9, 2)
+    w2, _ := BritishCalendar.GetWeekday(1752, 9, 14)
+    // 1582年10月4日是星期四，10月15日是星期五
+    w3, _ := DefaultCalendar.GetWeekday(1582, 10, 4)
+    w4, _ := DefaultCalendar.GetWeekday(1582, 10, 15)
+    w5, _ := ProlepticGregorian.GetWeekday(2024, 6, 21)
+    if w1 == 3 && w2 == 4 && w3 == 4 && w4 == 5 && w5 == 5 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", w1, w2, w3, w4, w5)
+    }
+}
+
+func Test_Calendar_ToJulianDateHMS(t *testing.T) {
+    jd, err := RussianCalendar.ToJulianDateHMS(1917, 10, 25, 0, 0, 0)
+    // 十月革命：儒略历1917年10月25日即公历11月7日
+    if err == nil && jd == ToJulianDateInGregorianHMS(1917, 11, 7, 0, 0, 0) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", jd, err)
+    }
+}
+
+func Test_Calendar_Validate(t *testing.T) {
+    if errors.Is(ProlepticGregorian.Validate(2015, 2, 29), ErrInvalidDay) &&
+        ProlepticGregorian.Validate(2016, 2, 29) == nil &&
+        errors.Is(ProlepticGregorian.Validate(2016, 13, 1), ErrInvalidMonth) &&
+        errors.Is(ProlepticGregorian.Validate(2016, 4, 31), ErrInvalidDay) &&
+        errors.Is(ProlepticGregorian.Validate(2016, 4, 0), ErrInvalidDay) &&
+        errors.Is(ProlepticGregorian.Validate(10000, 1, 1), ErrYearOutOfRange) &&
+        errors.Is(ProlepticGregorian.Validate(-4713, 1, 1), ErrYearOutOfRange) &&
+        // 1700年2月29日在英国存在，在欧洲大陆不存在
+        BritishCalendar.Validate(1700, 2, 29) == nil &&
+        errors.Is(DefaultCalendar.Validate(1700, 2, 29), ErrInvalidDay) &&
+        errors.Is(DefaultCalendar.Validate(1582, 10, 10), ErrNonexistentDate) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// 错误信息带有出错的日期
+func Test_Calendar_Error(t *testing.T) {
+    err := DefaultCalendar.Validate(1582, 10, 10)
+    if errors.Is(err, ErrNonexistentDate) && err.Error() == "calendarutil: date does not exist in this calendar: 1582-10-10" {
+        t.Log("ok")
+    } else {
+        t.Error("fail", err)
+    }
+    _, err = DaysInMonth(2015, 13)
+    if errors.Is(err, ErrInvalidMonth) && err.Error() == "calendarutil: invalid month: 2015-13" {
+        t.Log("ok")
+    } else {
+        t.Error("fail", err)
+    }
+}
+
+func Test_Calendar_ToJulianDate_Invalid(t *testing.T) {
+    _, err1 := DefaultCalendar.ToJulianDate(2015, 2, 30)
+    _, err2 := DefaultCalendar.ToJulianDateHMS(2015, 2, 1, 24, 0, 0)
+    _, err3 := DefaultCalendar.GetWeekday(2015, 0, 1)
+    if errors.Is(err1, ErrInvalidDay) && errors.Is(err2, ErrInvalidTime) && errors.Is(err3, ErrInvalidMonth) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", err1, err2, err3)
+    }
+}
+
+func Test_Calendar_DaysInMonth(t *testing.T) {
+    d1, _ := DefaultCalendar.DaysInMonth(1582, 10)
+    d2, _ := BritishCalendar.DaysInMonth(1752, 9)
+    // 俄国1918年2月1日至13日被跳过
+    d3, _ := RussianCalendar.DaysInMonth(1918, 2)
+    d4, _ := BritishCalendar.DaysInMonth(1700, 2)
+    d5, _ := DefaultCalendar.DaysInMonth(1700, 2)
+    d6, _ := DaysInMonth(2024, 12)
+    d7, _ := DaysInMonth(MAX_YEAR, 12)
+    _, err := DaysInMonth(2024, 13)
+    if d1 == 21 && d2 == 19 && d3 == 15 && d4 == 29 && d5 == 28 && d6 == 31 && d7 == 31 &&
+        errors.Is(err, ErrInvalidMonth) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", d1, d2, d3, d4, d5, d6, d7, err)
+    }
+}
+
+func Test_Calendar_DaysInYear(t *testing.T) {
+    d1, _ := DefaultCalendar.DaysInYear(1582)
+    d2, _ := BritishCalendar.DaysInYear(1752)
+    d3, _ := DaysInYear(2000)
+    d4, _ := DaysInYear(1900)
+    d5, _ := ProlepticJulian.DaysInYear(1900)
+    _, err := DaysInYear(MAX_YEAR + 1)
+    if d1 == 355 && d2 == 355 && d3 == 366 && d4 == 365 && d5 == 366 && errors.Is(err, ErrYearOutOfRange) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", d1, d2, d3, d4, d5, err)
+    }
+}
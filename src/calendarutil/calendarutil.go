@@ -1,6 +1,7 @@
 package calendarutil
 
 import (
+    "errors"
     "time"
 )
 /**
@@ -72,17 +73,15 @@ func IsJulianLeapYear(y int) bool {
 }
 
 /**
- * {@value #JULIAN_LAST_YEAR}年(含)以前按照Julian历法，{@value #JULIAN_LAST_YEAR}年以后按照Gregorian历法。
+ * {@value #JULIAN_LAST_YEAR}年(含)以前按照Julian历法，{@value #JULIAN_LAST_YEAR}年以后按照Gregorian历法，
+ * 与DefaultCalendar.IsLeapYear相同。其他改历日期使用{@link Calendar#IsLeapYear}
  *
  * @param y
  *            年份
  * @return 闰年返回true，平年返回false
  */
 func IsLeapYear(y int) bool {
-    if y <= JULIAN_LAST_YEAR {
-        return IsJulianLeapYear(y)
-    }
-    return IsGregorianLeapYear(y)
+    return DefaultCalendar.IsLeapYear(y)
 }
 
 /**
@@ -95,7 +94,7 @@ func IsLeapYear(y int) bool {
  *            月份
  * @param day
  *            日期
- * @return 返回以Gregorian历法计算的儒略日数
+ * @return 返回以Gregorian历法计算的儒略日数，不检查日期是否有效，需要检查时使用{@link #ProlepticGregorian}
  */
 
 func ToJulianDateInGregorian(year, month, day int) int {
@@ -115,7 +114,7 @@ func ToJulianDateInGregorian(year, month, day int) int {
  *            月份
  * @param day
  *            日期
- * @return 返回以Julian历法计算的儒略日数
+ * @return 返回以Julian历法计算的儒略日数，不检查日期是否有效，需要检查时使用{@link #ProlepticJulian}
  */
 func ToJulianDateInJulian(year, month, day int) int {
     var a int = (14 - month) / 12
@@ -129,7 +128,9 @@ func ToJulianDateInJulian(year, month, day int) int {
  * {@value #JULIAN_LAST_DATE}日及以前按照Julian历法，{@value #GREGORIAN_FIRST_YEAR}年
  * {@value #GREGORIAN_FIRST_MONTH}月{@value #GREGORIAN_FIRST_DATE}日及以后按照Gregorian历法，中间的日期按照
  * {@value #GREGORIAN_FIRST_YEAR}年{@value #GREGORIAN_FIRST_MONTH}月
- * {@value #GREGORIAN_FIRST_DATE}日计算。
+ * {@value #GREGORIAN_FIRST_DATE}日计算。有效的日期与DefaultCalendar.ToJulianDate相同，只是这个函数不报错，
+ * 改历时跳过的日子和无效的日期(例如2月30日)都会得到某个儒略日数，
+ * 需要其他改历日期或者对无效、不存在的日期报错时使用DefaultCalendar.ToJulianDate等{@link Calendar}的方法。
  *
  * @param y
  *            年份
@@ -140,29 +141,19 @@ func ToJulianDateInJulian(year, month, day int) int {
  * @return 返回相应历法的儒略日数
  */
 func ToJulianDate(y, m, d int ) int {
-    if y < JULIAN_LAST_YEAR {
-        return ToJulianDateInJulian(y, m, d);
-    }
-    if y == JULIAN_LAST_YEAR && m < JULIAN_LAST_MONTH {
-        return ToJulianDateInJulian(y, m, d);
-    }
-    if y == JULIAN_LAST_YEAR && m == JULIAN_LAST_MONTH && d <= JULIAN_LAST_DATE {
-        return ToJulianDateInJulian(y, m, d);
-    }
-
-    if y > GREGORIAN_FIRST_YEAR {
-        return ToJulianDateInGregorian(y, m, d);
+    jdn, err := DefaultCalendar.ToJulianDate(y, m, d)
+    if err == nil {
+        return jdn
     }
-    if y == GREGORIAN_FIRST_YEAR && m > GREGORIAN_FIRST_MONTH {
-        return ToJulianDateInGregorian(y, m, d);
+    if errors.Is(err, ErrNonexistentDate) {
+        // 改历时跳过的日子，以Gregorian历法实施第一天计算
+        return DefaultCalendar.reform
     }
-    if y == GREGORIAN_FIRST_YEAR && m == GREGORIAN_FIRST_MONTH && d >= GREGORIAN_FIRST_DATE {
-        return ToJulianDateInGregorian(y, m, d);
+    // 无效的日期不报错，按所在的历法直接计算
+    if ToJulianDateInGregorian(y, m, d) >= DefaultCalendar.reform {
+        return ToJulianDateInGregorian(y, m, d)
     }
-
-    // 剩下的都是中间的，以Gregorian历法实施第一天计算
-    return ToJulianDateInGregorian(GREGORIAN_FIRST_YEAR, GREGORIAN_FIRST_MONTH,
-            GREGORIAN_FIRST_DATE)
+    return ToJulianDateInJulian(y, m, d)
 }
 
 /**
@@ -337,7 +328,7 @@ func FromJulianDate(jd float64, tz *time.Location, tt2utc bool) time.Time {
     }
     z := int(jd + 0.5)
     if z < JULIAN_GREGORIAN_BOUNDARY {
-        fromJulianDateInJulian(jd, tz)
+        return fromJulianDateInJulian(jd, tz)
     }
     return fromJulianDateInGregorian(jd, tz)
 }
@@ -422,7 +413,8 @@ func getWeekdayForJulian(y, m, d int) int {
  * 计算星期几，{@value #JULIAN_LAST_YEAR}年{@value #JULIAN_LAST_MONTH}月 {@value #JULIAN_LAST_DATE}
  * 日及以前按照Julian历法，{@value #GREGORIAN_FIRST_YEAR}年 {@value #GREGORIAN_FIRST_MONTH}月
  * {@value #GREGORIAN_FIRST_DATE}日及以后按照Gregorian历法，中间的日期按照 {@value #GREGORIAN_FIRST_YEAR}年
- * {@value #GREGORIAN_FIRST_MONTH}月 {@value #GREGORIAN_FIRST_DATE}日计算。
+ * {@value #GREGORIAN_FIRST_MONTH}月 {@value #GREGORIAN_FIRST_DATE}日计算。有效的日期与DefaultCalendar.GetWeekday相同，
+ * 只是这个函数不报错，需要对无效、不存在的日期报错时使用DefaultCalendar.GetWeekday等{@link Calendar}的方法。
  *
  * @param y
  *            年份
@@ -433,35 +425,23 @@ func getWeekdayForJulian(y, m, d int) int {
  * @return 星期几的数字表示，1-6表示星期一到星期六，0表示星期日
  */
 func GetWeekday(y,m,d int) int {
-    if y < JULIAN_LAST_YEAR {
-        return getWeekdayForJulian(y, m, d)
+    w, err := DefaultCalendar.GetWeekday(y, m, d)
+    if err == nil {
+        return w
     }
-    if y == JULIAN_LAST_YEAR && m < JULIAN_LAST_MONTH {
-        return getWeekdayForJulian(y, m, d)
+    if errors.Is(err, ErrNonexistentDate) {
+        // 改历时跳过的日子，以Gregorian历法实施第一天计算
+        return getWeekdayForGregorian(GREGORIAN_FIRST_YEAR, GREGORIAN_FIRST_MONTH, GREGORIAN_FIRST_DATE)
     }
-    if y == JULIAN_LAST_YEAR && m == JULIAN_LAST_MONTH && d <= JULIAN_LAST_DATE {
-        return getWeekdayForJulian(y, m, d)
-    }
-
-    if y > GREGORIAN_FIRST_YEAR {
+    // 无效的日期不报错，按所在的历法直接计算
+    if ToJulianDateInGregorian(y, m, d) >= DefaultCalendar.reform {
         return getWeekdayForGregorian(y, m, d)
     }
-    if y == GREGORIAN_FIRST_YEAR && m > GREGORIAN_FIRST_MONTH {
-        return getWeekdayForGregorian(y, m, d)
-    }
-    if y == GREGORIAN_FIRST_YEAR && m == GREGORIAN_FIRST_MONTH && d >= GREGORIAN_FIRST_DATE {
-        return getWeekdayForGregorian(y, m, d)
-    }
-
-    // 剩下的都是中间的，以Gregorian历法实施第一天计算
-    return getWeekdayForGregorian(GREGORIAN_FIRST_YEAR, GREGORIAN_FIRST_MONTH,
-            GREGORIAN_FIRST_DATE)
+    return getWeekdayForJulian(y, m, d)
 }
 
 /**
- * 计算地球时和UTC的时差，算法摘自<i><a
- * href="http://eclipse.gsfc.nasa.gov/SEhelp/deltatpoly2004.html">NASA网站</a></i><br />
- * ∆T = TT - UT 此算法在-1999年到3000年有效
+ * 用当前的∆T模型(默认为{@link #EspenakMeeus2004})计算地球时和UTC的时差
  *
  * @param jd
  *            儒略日
@@ -469,9 +449,7 @@ func GetWeekday(y,m,d int) int {
  */
 
 func GetDeltaTJD(jd float64) float64 {
-    cal := FromJulianDate(jd, time.UTC, false)
-    year, month, _ := cal.Date()
-    return GetDeltaT(year, int(month) )
+    return GetDeltaTJDByModel(jd, GetDeltaTModel())
 }
 
 /**
@@ -486,7 +464,19 @@ func GetDeltaTJD(jd float64) float64 {
  * @return ∆T的值，单位为秒
  */
 func GetDeltaT(year, month int) float64 {
-    y := float64(year) + (float64(month) - 0.5) / 12
+    return getEspenakMeeusDeltaT(year, float64(year) + (float64(month) - 0.5) / 12)
+}
+
+/**
+ * Espenak和Meeus(2004)的∆T多项式
+ *
+ * @param year
+ *            用来选择多项式的整数年份
+ * @param y
+ *            小数年份
+ * @return ∆T的值，单位为秒
+ */
+func getEspenakMeeusDeltaT(year int, y float64) float64 {
     if year < -500 {
         u :=  (float64(year) - 1820) / 100.0
         return -20 + 32 * u * u
@@ -130,10 +130,11 @@ func Test_FromJulianDateLocal(t *testing.T) {
         t.Error("fail")
     }
 
+    // 改历以前的年月日按Julian历法，time.Time的星期几按Gregorian历法计算，没有意义
     cal = FromJulianDateLocal(2.2324937542361114e+06, true)
-    timestr = cal.Format(time.UnixDate)
+    timestr = cal.Format("2006-01-02 15:04:05 MST")
     t.Log(timestr)
-    if "Sat Apr  5 14:06:27 LMT 1400" == timestr {
+    if "1400-03-27 14:06:27 LMT" == timestr {
         t.Log("ok")
     } else {
         t.Error("fail")
@@ -152,13 +153,22 @@ func Test_FromJulianDate(t *testing.T) {
     }
 
     cal = FromJulianDate(2.2324937542361114e+06, time.UTC, true)
-    timestr = cal.Format(time.UnixDate)
+    timestr = cal.Format("2006-01-02 15:04:05 MST")
     t.Log(timestr)
-    if "Sat Apr  5 06:00:44 UTC 1400" == timestr {
+    if "1400-03-27 06:00:44 UTC" == timestr {
         t.Log("ok")
     } else {
         t.Error("fail")
     }
+
+    // 改历前最后一天和改历第一天往返转换
+    for _, v := range [][3]int{{1582, 10, 4}, {1582, 10, 15}} {
+        jdn := ToJulianDate(v[0], v[1], v[2])
+        year, month, day := FromJulianDate(float64(jdn), time.UTC, false).Date()
+        if year != v[0] || int(month) != v[1] || day != v[2] {
+            t.Error("fail", v, year, month, day)
+        }
+    }
 }
 
 func Test_GetJulianThousandYears(t *testing.T ) {
@@ -0,0 +1,431 @@
+package calendarutil
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "math"
+    "os"
+    "sort"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+)
+
+/**
+ * ∆T = TT - UT的计算模型
+ */
+type DeltaTModel interface {
+    /**
+     * 计算∆T
+     *
+     * @param year
+     *            小数年份，例如2000.5表示2000年年中
+     * @return ∆T的值，单位为秒
+     */
+    GetDeltaT(year float64) float64
+}
+
+/**
+ * 用函数实现的∆T模型
+ */
+type DeltaTFunc func(year float64) float64
+
+func (f DeltaTFunc) GetDeltaT(year float64) float64 {
+    return f(year)
+}
+
+/**
+ * Espenak和Meeus(2004)的分段多项式，摘自<i><a
+ * href="http://eclipse.gsfc.nasa.gov/SEhelp/deltatpoly2004.html">NASA网站</a></i>，在-1999年到3000年有效
+ */
+var EspenakMeeus2004 DeltaTModel = DeltaTFunc(func(year float64) float64 {
+    return getEspenakMeeusDeltaT(int(math.Floor(year)), year)
+})
+
+/**
+ * Morrison和Stephenson(2004)的长期抛物线 ∆T = -20 + 32u²，u = (year - 1820) / 100。
+ * 只适合公元前500年以前等远离现代的日期，在现代与观测值相差可达数十秒，完整的模型见{@link #MorrisonStephenson2004}
+ */
+var MorrisonStephenson2004LongTermParabola DeltaTModel = DeltaTFunc(func(year float64) float64 {
+    u := (year - 1820) / 100
+    return -20 + 32 * u * u
+})
+
+/**
+ * Morrison和Stephenson(2004)的模型：公元前500年至2005年按他们给出的∆T表线性插值，
+ * 表以前用长期抛物线，2005年以后在100年内逐渐过渡到长期抛物线。
+ * 表的数值摘自NASA日食网站的<i>Historical Values of Delta T</i>表
+ */
+var MorrisonStephenson2004 DeltaTModel = &DeltaTTable{
+    Years: []float64{
+        -500, -400, -300, -200, -100, 0, 100, 200, 300, 400,
+        500, 600, 700, 800, 900, 1000, 1100, 1200, 1300, 1400,
+        1500, 1600, 1700, 1750, 1800, 1850, 1900, 1950, 1955, 1960,
+        1965, 1970, 1975, 1980, 1985, 1990, 1995, 2000, 2005,
+    },
+    Values: []float64{
+        17190, 15530, 14080, 12790, 11640, 10580, 9600, 8640, 7680, 6700,
+        5710, 4740, 3810, 2960, 2200, 1570, 1090, 740, 490, 320,
+        200, 120, 9, 13, 14, 7, -3, 29, 31.1, 33.2,
+        35.7, 40.2, 45.5, 50.5, 54.3, 56.9, 60.8, 63.8, 64.7,
+    },
+    Fallback: MorrisonStephenson2004LongTermParabola,
+}
+
+/**
+ * Stephenson、Morrison和Hohenkerk(2016)的长期抛物线 ∆T = -320 + 32.5u²，u = (year - 1825) / 100。
+ * 只适合公元前720年以前等远离现代的日期，在现代与观测值相差几百秒(例如2000年约为-220秒)，
+ * 公元前720年至2016年用{@link #StephensonMorrisonHohenkerk2016}
+ */
+var StephensonMorrisonHohenkerk2016LongTermParabola DeltaTModel = DeltaTFunc(func(year float64) float64 {
+    u := (year - 1825) / 100
+    return -320 + 32.5 * u * u
+})
+
+/**
+ * Stephenson、Morrison和Hohenkerk(2016)的模型：公元前720年至2016年用他们给出的三次样条，
+ * 以外用长期抛物线。样条的系数摘自他们的论文(<i>Proc. R. Soc. A</i> 472: 20160404)，
+ * 也见HM Nautical Almanac Office的<i><a href="http://astro.ukho.gov.uk/nao/lvm/">Delta T</a></i>网页
+ */
+var StephensonMorrisonHohenkerk2016 DeltaTModel = &DeltaTSpline{
+    Knots: []float64{
+        -720, -100, 400, 1000, 1150, 1300, 1500, 1600, 1650, 1720,
+        1800, 1810, 1820, 1830, 1840, 1850, 1855, 1860, 1865, 1870,
+        1875, 1880, 1885, 1890, 1895, 1900, 1905, 1910, 1915, 1920,
+        1925, 1930, 1935, 1940, 1945, 1950, 1953, 1956, 1959, 1962,
+        1965, 1968, 1971, 1974, 1977, 1980, 1983, 1986, 1989, 1992,
+        1995, 1998, 2001, 2004, 2007, 2010, 2013, 2016,
+    },
+    Coefficients: [][4]float64{
+        {20371.848, -9999.586, 776.247, 409.160},
+        {11557.668, -5822.270, 1303.151, -503.433},
+        {6535.116, -5671.519, -298.291, 1085.087},
+        {1650.393, -753.210, 184.811, -25.346},
+        {1056.647, -459.628, 108.771, -24.641},
+        {681.149, -421.345, 61.953, -29.414},
+        {292.343, -192.841, -6.572, 16.197},
+        {109.127, -78.697, 10.505, 3.018},
+        {43.952, -68.089, 38.333, -2.127},
+        {12.068, 2.507, 41.731, -37.939},
+        {18.367, -3.481, -1.126, 1.918},
+        {15.678, 0.021, 4.629, -3.812},
+        {16.516, -2.157, -6.806, 3.250},
+        {10.804, -6.018, 2.944, -0.096},
+        {7.634, -0.416, 2.658, -0.539},
+        {9.338, 1.642, 0.261, -0.883},
+        {10.357, -0.486, -2.389, 1.558},
+        {9.040, -0.591, 2.284, -2.477},
+        {8.255, -3.456, -5.148, 2.720},
+        {2.371, -5.593, 3.011, -0.914},
+        {-1.126, -2.314, 0.269, -0.039},
+        {-3.210, -1.893, 0.152, 0.563},
+        {-4.388, 0.101, 1.842, -1.438},
+        {-3.884, -0.531, -2.474, 1.871},
+        {-5.017, 0.134, 3.138, -0.232},
+        {-1.977, 5.715, 2.443, -1.257},
+        {4.923, 6.828, -1.329, 0.720},
+        {11.142, 6.330, 0.831, -0.825},
+        {17.479, 5.518, -1.643, 0.262},
+        {21.617, 3.020, -0.856, 0.008},
+        {23.789, 1.333, -0.831, 0.127},
+        {24.418, 0.052, -0.449, 0.142},
+        {24.164, -0.419, -0.022, 0.702},
+        {24.426, 1.645, 2.086, -1.106},
+        {27.050, 2.499, -1.232, 0.614},
+        {28.932, 1.127, 0.220, -0.277},
+        {30.002, 0.737, -0.610, 0.631},
+        {30.760, 1.409, 1.282, -0.799},
+        {32.652, 1.577, -1.115, 0.507},
+        {33.621, 0.868, 0.406, 0.199},
+        {35.093, 2.275, 1.002, -0.414},
+        {37.956, 3.035, -0.242, 0.202},
+        {40.951, 3.157, 0.364, -0.229},
+        {44.244, 3.199, -0.323, 0.172},
+        {47.291, 3.069, 0.193, -0.192},
+        {50.361, 2.878, -0.384, 0.081},
+        {52.936, 2.354, -0.140, -0.166},
+        {54.984, 1.577, -0.637, 0.448},
+        {56.373, 1.649, 0.709, -0.277},
+        {58.453, 2.235, -0.122, 0.111},
+        {60.678, 2.324, 0.212, -0.315},
+        {62.898, 1.804, -0.732, 0.112},
+        {64.083, 0.674, -0.396, 0.193},
+        {64.553, 0.466, 0.184, -0.008},
+        {65.197, 0.804, 0.160, -0.101},
+        {66.061, 0.839, -0.142, 0.168},
+        {66.920, 1.007, 0.363, -0.385},
+    },
+    Fallback: StephensonMorrisonHohenkerk2016LongTermParabola,
+}
+
+/**
+ * 分段三次样条表示的∆T。第i段在Knots[i]和Knots[i + 1]之间，t = (year - Knots[i]) / (Knots[i + 1] - Knots[i])，
+ * ∆T = a0 + a1·t + a2·t² + a3·t³。样条以外用Fallback计算，在两端外100年内逐渐过渡到Fallback，避免跳变
+ */
+type DeltaTSpline struct {
+    // 按时间排列的节点年份，比Coefficients多一个
+    Knots []float64
+    // 每一段的系数a0、a1、a2、a3，单位为秒
+    Coefficients [][4]float64
+    // 样条以外使用的模型
+    Fallback DeltaTModel
+}
+
+/**
+ * 计算样条第i段在year的值
+ */
+func (s *DeltaTSpline) evaluate(i int, year float64) float64 {
+    t := (year - s.Knots[i]) / (s.Knots[i + 1] - s.Knots[i])
+    c := s.Coefficients[i]
+    return c[0] + t * (c[1] + t * (c[2] + t * c[3]))
+}
+
+/**
+ * 按样条计算∆T
+ */
+func (s *DeltaTSpline) GetDeltaT(year float64) float64 {
+    n := len(s.Coefficients)
+    first, last := s.Knots[0], s.Knots[n]
+    if year < first {
+        diff := s.evaluate(0, first) - s.Fallback.GetDeltaT(first)
+        weight := math.Max(0, 1 - (first - year) / 100)
+        return s.Fallback.GetDeltaT(year) + diff * weight
+    }
+    if year >= last {
+        diff := s.evaluate(n - 1, last) - s.Fallback.GetDeltaT(last)
+        weight := math.Max(0, 1 - (year - last) / 100)
+        return s.Fallback.GetDeltaT(year) + diff * weight
+    }
+    i := sort.SearchFloat64s(s.Knots, year)
+    if i == len(s.Knots) || s.Knots[i] != year {
+        i--
+    }
+    return s.evaluate(i, year)
+}
+
+/**
+ * 按年份插值的∆T观测值表。表以外的年份用Fallback计算，表尾之后在100年内逐渐过渡到Fallback，避免在表尾跳变
+ */
+type DeltaTTable struct {
+    // 按时间排列的小数年份
+    Years []float64
+    // 对应的∆T，单位为秒
+    Values []float64
+    // 表以外使用的模型
+    Fallback DeltaTModel
+}
+
+/**
+ * 1620年至2024年每两年年初的∆T。1620年至1998年取自<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>
+ * 第二版(1998)表10.A，2000年以后由IERS的观测值计算：∆T = 32.184 + (TAI-UTC) - (UT1-UTC)，
+ * UT1-UTC取自IERS EOP C04序列，与USNO的deltat.data一致
+ */
+var observedDeltaT = []float64{
+    121.0, 112.0, 103.0, 95.0, 88.0, 82.0, 77.0, 72.0, 68.0, 63.0,
+    60.0, 56.0, 53.0, 51.0, 48.0, 46.0, 44.0, 42.0, 40.0, 38.0,
+    35.0, 33.0, 31.0, 29.0, 26.0, 24.0, 22.0, 20.0, 18.0, 16.0,
+    14.0, 12.0, 11.0, 10.0, 9.0, 8.0, 7.0, 7.0, 7.0, 7.0,
+    7.0, 7.0, 8.0, 8.0, 9.0, 9.0, 9.0, 9.0, 9.0, 10.0,
+    10.0, 10.0, 10.0, 10.0, 10.0, 10.0, 10.0, 11.0, 11.0, 11.0,
+    11.0, 11.0, 12.0, 12.0, 12.0, 12.0, 13.0, 13.0, 13.0, 14.0,
+    14.0, 14.0, 14.0, 15.0, 15.0, 15.0, 15.0, 15.0, 16.0, 16.0,
+    16.0, 16.0, 16.0, 16.0, 16.0, 16.0, 15.0, 15.0, 14.0, 13.0,
+    13.1, 12.5, 12.2, 12.0, 12.0, 12.0, 12.0, 12.0, 12.0, 11.9,
+    11.6, 11.0, 10.2, 9.2, 8.2, 7.1, 6.2, 5.6, 5.4, 5.3,
+    5.4, 5.6, 5.9, 6.2, 6.5, 6.8, 7.1, 7.3, 7.5, 7.6,
+    7.7, 7.3, 6.2, 5.2, 2.7, 1.4, -1.2, -2.8, -3.8, -4.8,
+    -5.5, -5.3, -5.6, -5.7, -5.9, -6.0, -6.3, -6.5, -6.2, -4.7,
+    -2.8, -0.1, 2.6, 5.3, 7.7, 10.4, 13.3, 16.0, 18.2, 20.2,
+    21.1, 22.4, 23.5, 23.8, 24.3, 24.0, 23.9, 23.9, 23.7, 24.0,
+    24.3, 25.3, 26.2, 27.3, 28.2, 29.1, 30.0, 30.7, 31.4, 32.2,
+    33.1, 34.0, 35.0, 36.5, 38.3, 40.2, 42.2, 44.5, 46.5, 48.5,
+    50.5, 52.2, 53.8, 54.9, 55.8, 56.9, 58.3, 60.0, 61.6, 63.0,
+    // 2000年至2024年
+    63.8, 64.3, 64.6, 64.8, 65.5, 66.1,
+    66.6, 67.3, 68.1, 69.0, 69.4, 69.2, 69.2,
+}
+
+/**
+ * 由内置的观测值创建∆T表
+ *
+ * @return 1620年至2024年的∆T表，表以外使用{@link #EspenakMeeus2004}
+ */
+func NewObservedDeltaTTable() *DeltaTTable {
+    years := make([]float64, len(observedDeltaT))
+    for i := range years {
+        years[i] = 1620 + 2 * float64(i)
+    }
+    return &DeltaTTable{years, append([]float64(nil), observedDeltaT...), EspenakMeeus2004}
+}
+
+/**
+ * 解析∆T表，每行是"小数年份 ∆T"，或者USNO的deltat.data格式"年 月 日 ∆T"，以#开头的是注释
+ *
+ * @param r
+ *            表的内容
+ * @param fallback
+ *            表以外使用的模型
+ * @return ∆T表
+ */
+func ParseDeltaTTable(r io.Reader, fallback DeltaTModel) (*DeltaTTable, error) {
+    table := &DeltaTTable{Fallback: fallback}
+    scanner := bufio.NewScanner(r)
+    lineNo := 0
+    for scanner.Scan() {
+        lineNo++
+        line := scanner.Text()
+        if i := strings.Index(line, "#"); i >= 0 {
+            line = line[:i]
+        }
+        fields := strings.Fields(line)
+        if len(fields) == 0 {
+            continue
+        }
+        values := make([]float64, len(fields))
+        for i, field := range fields {
+            v, err := strconv.ParseFloat(field, 64)
+            if err != nil {
+                return nil, fmt.Errorf("calendarutil: line %d: %v", lineNo, err)
+            }
+            values[i] = v
+        }
+        var year float64
+        switch len(values) {
+        case 2:
+            year = values[0]
+        case 4:
+            date := time.Date(int(values[0]), time.Month(values[1]), int(values[2]), 0, 0, 0, 0, time.UTC)
+            year = values[0] + float64(date.YearDay() - 1) / 365.25
+        default:
+            return nil, fmt.Errorf("calendarutil: line %d: invalid delta T %q", lineNo, scanner.Text())
+        }
+        if n := len(table.Years); n > 0 && year <= table.Years[n - 1] {
+            return nil, fmt.Errorf("calendarutil: line %d: delta T out of order", lineNo)
+        }
+        table.Years = append(table.Years, year)
+        table.Values = append(table.Values, values[len(values) - 1])
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, err
+    }
+    if len(table.Years) == 0 {
+        return nil, fmt.Errorf("calendarutil: empty delta T table")
+    }
+    return table, nil
+}
+
+/**
+ * 从文件读取∆T表
+ *
+ * @param filename
+ *            文件名
+ * @param fallback
+ *            表以外使用的模型
+ * @return ∆T表
+ */
+func LoadDeltaTTable(filename string, fallback DeltaTModel) (*DeltaTTable, error) {
+    f, err := os.Open(filename)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    return ParseDeltaTTable(f, fallback)
+}
+
+/**
+ * 线性插值计算∆T
+ */
+func (t *DeltaTTable) GetDeltaT(year float64) float64 {
+    n := len(t.Years)
+    if year < t.Years[0] {
+        return t.Fallback.GetDeltaT(year)
+    }
+    if year >= t.Years[n - 1] {
+        // 表尾之后把与Fallback的差值在100年内线性消去
+        last := t.Years[n - 1]
+        diff := t.Values[n - 1] - t.Fallback.GetDeltaT(last)
+        weight := math.Max(0, 1 - (year - last) / 100)
+        return t.Fallback.GetDeltaT(year) + diff * weight
+    }
+    i := sort.SearchFloat64s(t.Years, year)
+    if t.Years[i] == year {
+        return t.Values[i]
+    }
+    f := (year - t.Years[i - 1]) / (t.Years[i] - t.Years[i - 1])
+    return t.Values[i - 1] + f * (t.Values[i] - t.Values[i - 1])
+}
+
+var (
+    deltaTModelLock sync.RWMutex
+    deltaTModel = EspenakMeeus2004
+    // 每次设置模型都加1
+    deltaTModelVersion = 0
+)
+
+/**
+ * 取得GetDeltaTJD等函数使用的∆T模型
+ */
+func GetDeltaTModel() DeltaTModel {
+    deltaTModelLock.RLock()
+    defer deltaTModelLock.RUnlock()
+    return deltaTModel
+}
+
+/**
+ * 设置GetDeltaTJD等函数使用的∆T模型，model为nil时恢复为{@link #EspenakMeeus2004}。
+ * 这是全局设置，依赖∆T的缓存(例如农历月)由GetDeltaTModelVersion判断是否过期。
+ * 要同时比较几个模型，用GetDeltaTJDByModel和FromJulianDateByModel
+ *
+ * @param model
+ *            ∆T模型
+ */
+func SetDeltaTModel(model DeltaTModel) {
+    if model == nil {
+        model = EspenakMeeus2004
+    }
+    deltaTModelLock.Lock()
+    deltaTModel = model
+    deltaTModelVersion++
+    deltaTModelLock.Unlock()
+}
+
+/**
+ * 取得∆T模型的版本号，每次调用SetDeltaTModel都会改变，按∆T计算结果的缓存在版本号变化后应该清空
+ */
+func GetDeltaTModelVersion() int {
+    deltaTModelLock.RLock()
+    defer deltaTModelLock.RUnlock()
+    return deltaTModelVersion
+}
+
+/**
+ * 用指定的模型计算∆T
+ *
+ * @param jd
+ *            儒略日
+ * @param model
+ *            ∆T模型
+ * @return ∆T的值，单位为秒
+ */
+func GetDeltaTJDByModel(jd float64, model DeltaTModel) float64 {
+    cal := FromJulianDate(jd, time.UTC, false)
+    year, month, _ := cal.Date()
+    return model.GetDeltaT(float64(year) + (float64(month) - 0.5) / 12)
+}
+
+/**
+ * 由TT儒略日计算对应的UT日期时间，∆T用指定的模型计算
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param tz
+ *            使用的时区
+ * @param model
+ *            ∆T模型
+ * @return 对应的日期时间，时区为tz
+ */
+func FromJulianDateByModel(jd float64, tz *time.Location, model DeltaTModel) time.Time {
+    return FromJulianDate(jd - GetDeltaTJDByModel(jd, model) / 86400, tz, false)
+}
@@ -0,0 +1,143 @@
+package calendarutil
+
+import (
+    "math"
+    "strings"
+    "testing"
+    "time"
+)
+
+func Test_EspenakMeeus2004(t *testing.T) {
+    for _, year := range []int{-600, 400, 1650, 1900, 1999, 2010, 2100, 2200} {
+        for _, month := range []int{1, 7, 12} {
+            if EspenakMeeus2004.GetDeltaT(float64(year) + (float64(month) - 0.5) / 12) != GetDeltaT(year, month) {
+                t.Error("fail", year, month)
+            }
+        }
+    }
+}
+
+func Test_LongTermParabola(t *testing.T) {
+    if MorrisonStephenson2004LongTermParabola.GetDeltaT(1820) == -20 && MorrisonStephenson2004LongTermParabola.GetDeltaT(1620) == 108 &&
+        StephensonMorrisonHohenkerk2016LongTermParabola.GetDeltaT(1825) == -320 &&
+        StephensonMorrisonHohenkerk2016LongTermParabola.GetDeltaT(-1175) == 28930 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// Morrison和Stephenson(2004)发表的∆T
+func Test_MorrisonStephenson2004(t *testing.T) {
+    for _, v := range []struct {
+        year, deltaT float64
+    }{
+        {-500, 17190}, {1000, 1570}, {1600, 120}, {1900, -3}, {2000, 63.8},
+    } {
+        if MorrisonStephenson2004.GetDeltaT(v.year) != v.deltaT {
+            t.Error("fail", v.year, MorrisonStephenson2004.GetDeltaT(v.year))
+        }
+    }
+    // 两个表值之间线性插值，表以前用长期抛物线
+    if MorrisonStephenson2004.GetDeltaT(1050) != 1330 ||
+        MorrisonStephenson2004.GetDeltaT(-1000) != MorrisonStephenson2004LongTermParabola.GetDeltaT(-1000) {
+        t.Error("fail")
+    }
+}
+
+// Stephenson、Morrison和Hohenkerk(2016)发表的∆T
+func Test_StephensonMorrisonHohenkerk2016(t *testing.T) {
+    for _, v := range []struct {
+        year, deltaT float64
+    }{
+        {1000, 1650.4}, {1600, 109.1}, {1900, -2.0}, {-720, 20371.8}, {2000, 63.8},
+    } {
+        if math.Abs(StephensonMorrisonHohenkerk2016.GetDeltaT(v.year) - v.deltaT) > 0.5 {
+            t.Error("fail", v.year, StephensonMorrisonHohenkerk2016.GetDeltaT(v.year))
+        }
+    }
+    // 样条在节点处连续
+    spline := StephensonMorrisonHohenkerk2016.(*DeltaTSpline)
+    for _, knot := range spline.Knots[1:len(spline.Knots) - 1] {
+        if d := spline.GetDeltaT(knot + 1e-9) - spline.GetDeltaT(knot - 1e-9); math.Abs(d) > 0.01 {
+            t.Error("fail", knot, d)
+        }
+    }
+    // 样条以外连续地过渡到长期抛物线
+    if math.Abs(spline.GetDeltaT(-720.001) - spline.GetDeltaT(-720)) > 0.1 ||
+        spline.GetDeltaT(-1000) != StephensonMorrisonHohenkerk2016LongTermParabola.GetDeltaT(-1000) ||
+        math.Abs(spline.GetDeltaT(2016.001) - spline.GetDeltaT(2015.999)) > 0.1 ||
+        spline.GetDeltaT(2200) != StephensonMorrisonHohenkerk2016LongTermParabola.GetDeltaT(2200) {
+        t.Error("fail")
+    }
+}
+
+func Test_ObservedDeltaTTable(t *testing.T) {
+    table := NewObservedDeltaTTable()
+    if table.GetDeltaT(1620) != 121 || table.GetDeltaT(1621) != 116.5 || table.GetDeltaT(2000) != 63.8 ||
+        table.GetDeltaT(2024) != 69.2 || table.GetDeltaT(1500) != EspenakMeeus2004.GetDeltaT(1500) {
+        t.Error("fail")
+    }
+    // 表尾之后连续地过渡到Espenak-Meeus多项式
+    if math.Abs(table.GetDeltaT(2024.001) - 69.2) > 0.01 || table.GetDeltaT(2124) != EspenakMeeus2004.GetDeltaT(2124) {
+        t.Error("fail", table.GetDeltaT(2024.001))
+    }
+    // 与观测值相比，Espenak-Meeus多项式在2020年偏大约2秒
+    if EspenakMeeus2004.GetDeltaT(2020) - table.GetDeltaT(2020) > 1.5 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_ParseDeltaTTable(t *testing.T) {
+    // USNO deltat.data的格式
+    table, err := ParseDeltaTTable(strings.NewReader("# comment\n2020  1  1  69.3612\n2020  2  1  69.3863\n"), EspenakMeeus2004)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(table.Years) != 2 || table.Years[0] != 2020 || table.GetDeltaT(2020) != 69.3612 {
+        t.Error("fail", table.Years)
+    }
+
+    table, err = ParseDeltaTTable(strings.NewReader("1990.0 56.86\n2000.0 63.83\n"), MorrisonStephenson2004LongTermParabola)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if math.Abs(table.GetDeltaT(1995) - 60.345) > 1e-9 || table.GetDeltaT(1000) != MorrisonStephenson2004LongTermParabola.GetDeltaT(1000) {
+        t.Error("fail")
+    }
+
+    for _, s := range []string{"", "2000 1 63.8\n", "2000.0 x\n", "2000.0 63.8\n1999.0 63.0\n"} {
+        if _, err := ParseDeltaTTable(strings.NewReader(s), EspenakMeeus2004); err == nil {
+            t.Error("fail", s)
+        }
+    }
+}
+
+func Test_SetDeltaTModel(t *testing.T) {
+    defer SetDeltaTModel(nil)
+    jd := ToJulianDateHMS(1820, 7, 1, 0, 0, 0)
+    version := GetDeltaTModelVersion()
+    SetDeltaTModel(MorrisonStephenson2004)
+    if GetDeltaTJD(jd) != GetDeltaTJDByModel(jd, MorrisonStephenson2004) || GetDeltaTModelVersion() == version {
+        t.Error("fail")
+    }
+    SetDeltaTModel(nil)
+    if GetDeltaTJD(jd) == GetDeltaT(1820, 7) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_FromJulianDateByModel(t *testing.T) {
+    jd := ToJulianDateHMS(1820, 7, 1, 0, 0, 0)
+    // ∆T = -20 + 32 * (0.005)² 约为-20秒
+    cal := FromJulianDateByModel(jd, time.UTC, MorrisonStephenson2004LongTermParabola)
+    if cal.Format("15:04:05") == "00:00:19" || cal.Format("15:04:05") == "00:00:20" {
+        t.Log("ok")
+    } else {
+        t.Error("fail", cal)
+    }
+}
@@ -0,0 +1,333 @@
+package calendarutil
+
+import (
+    "fmt"
+    "math"
+    "time"
+)
+
+/**
+ * Unix时间起点1970年1月1日0h所在日期的儒略日数，当天中午12点是儒略日2440588.0
+ */
+const UNIX_EPOCH_JULIAN_DATE = 2440587
+
+/**
+ * 用整数部分和小数部分分开表示的儒略日。float64表示的儒略日在2450000附近只能精确到约20微秒，
+ * 分开表示以后小数部分的精度约为1e-11秒。不包含时间尺度，需要换算时使用timescale包
+ */
+type JulianDay struct {
+    // 整数部分，儒略日的整数部分对应当天中午12点
+    day int
+    // 小数部分，在[0, 1)之间
+    fraction float64
+}
+
+/**
+ * 创建儒略日，小数部分可以是任意值，会被规范化到[0, 1)之间
+ *
+ * @param day
+ *            整数部分
+ * @param fraction
+ *            小数部分
+ * @return 儒略日
+ */
+func NewJulianDay(day int, fraction float64) JulianDay {
+    f := math.Floor(fraction)
+    day += int(f)
+    fraction -= f
+    if fraction >= 1 {
+        // 很小的负数加1以后舍入成1
+        day++
+        fraction = 0
+    }
+    return JulianDay{day, fraction}
+}
+
+/**
+ * 由float64表示的儒略日创建儒略日
+ *
+ * @param jd
+ *            儒略日
+ * @return 儒略日
+ */
+func NewJulianDayFromFloat(jd float64) JulianDay {
+    day := math.Floor(jd)
+    return JulianDay{int(day), jd - day}
+}
+
+/**
+ * 由time.Time创建儒略日，精确到纳秒
+ *
+ * @param t
+ *            时间，按UTC换算
+ * @return 儒略日
+ */
+func NewJulianDayFromTime(t time.Time) JulianDay {
+    seconds := t.Unix()
+    days := seconds / 86400
+    if seconds % 86400 < 0 {
+        days--
+    }
+    seconds -= days * 86400
+    return NewJulianDay(UNIX_EPOCH_JULIAN_DATE + int(days), 0.5 + (float64(seconds) + float64(t.Nanosecond()) / 1e9) / 86400)
+}
+
+/**
+ * 由修正儒略日创建儒略日
+ *
+ * @param mjd
+ *            修正儒略日
+ * @return 儒略日
+ */
+func NewJulianDayFromMJD(mjd float64) JulianDay {
+    return NewJulianDay(2400000, mjd + 0.5)
+}
+
+/**
+ * 由J2000.0起算的秒数创建儒略日
+ *
+ * @param seconds
+ *            从J2000.0(儒略日2451545.0)起算的秒数
+ * @return 儒略日
+ */
+func NewJulianDayFromJ2000Seconds(seconds float64) JulianDay {
+    return JulianDay{int(J2000), 0}.AddSeconds(seconds)
+}
+
+/**
+ * 由Unix时间创建儒略日
+ *
+ * @param seconds
+ *            从1970年1月1日0h起算的秒数
+ * @return 儒略日
+ */
+func NewJulianDayFromUnix(seconds float64) JulianDay {
+    return JulianDay{UNIX_EPOCH_JULIAN_DATE, 0.5}.AddSeconds(seconds)
+}
+
+/**
+ * 儒略日的整数部分
+ */
+func (j JulianDay) Day() int {
+    return j.day
+}
+
+/**
+ * 儒略日的小数部分，在[0, 1)之间
+ */
+func (j JulianDay) Fraction() float64 {
+    return j.fraction
+}
+
+/**
+ * float64表示的儒略日，会损失亚毫秒精度
+ */
+func (j JulianDay) Float() float64 {
+    return float64(j.day) + j.fraction
+}
+
+/**
+ * 加上若干天
+ *
+ * @param days
+ *            天数，可以是负数
+ * @return 新的儒略日
+ */
+func (j JulianDay) Add(days float64) JulianDay {
+    whole := math.Floor(days)
+    return NewJulianDay(j.day + int(whole), j.fraction + (days - whole))
+}
+
+/**
+ * 加上若干秒
+ *
+ * @param seconds
+ *            秒数，可以是负数
+ * @return 新的儒略日
+ */
+func (j JulianDay) AddSeconds(seconds float64) JulianDay {
+    days := math.Floor(seconds / 86400)
+    return NewJulianDay(j.day + int(days), j.fraction + (seconds - days * 86400) / 86400)
+}
+
+/**
+ * 加上一段时间
+ *
+ * @param d
+ *            时间长度，可以是负数
+ * @return 新的儒略日
+ */
+func (j JulianDay) AddDuration(d time.Duration) JulianDay {
+    days := d / (24 * time.Hour)
+    d -= days * 24 * time.Hour
+    return NewJulianDay(j.day + int(days), j.fraction + d.Seconds() / 86400)
+}
+
+/**
+ * 计算两个儒略日之差
+ *
+ * @param o
+ *            另一个儒略日
+ * @return j - o，单位是日
+ */
+func (j JulianDay) Sub(o JulianDay) float64 {
+    return float64(j.day - o.day) + (j.fraction - o.fraction)
+}
+
+/**
+ * 计算两个儒略日之差，整数部分和小数部分分开换算，比Sub的结果乘以86400精确
+ *
+ * @param o
+ *            另一个儒略日
+ * @return j - o，单位是秒
+ */
+func (j JulianDay) SubSeconds(o JulianDay) float64 {
+    return float64(j.day - o.day) * 86400 + (j.fraction - o.fraction) * 86400
+}
+
+/**
+ * 比较两个儒略日
+ *
+ * @param o
+ *            另一个儒略日
+ * @return j在o之前返回-1，相等返回0，在o之后返回1
+ */
+func (j JulianDay) Compare(o JulianDay) int {
+    switch {
+    case j.day < o.day || j.day == o.day && j.fraction < o.fraction:
+        return -1
+    case j.day == o.day && j.fraction == o.fraction:
+        return 0
+    }
+    return 1
+}
+
+/**
+ * 是否在o之前
+ */
+func (j JulianDay) Before(o JulianDay) bool {
+    return j.Compare(o) < 0
+}
+
+/**
+ * 是否在o之后
+ */
+func (j JulianDay) After(o JulianDay) bool {
+    return j.Compare(o) > 0
+}
+
+/**
+ * 是否和o相同
+ */
+func (j JulianDay) Equal(o JulianDay) bool {
+    return j.Compare(o) == 0
+}
+
+/**
+ * 修正儒略日
+ *
+ * @return JD - 2400000.5
+ */
+func (j JulianDay) MJD() float64 {
+    return float64(j.day - 2400000) + (j.fraction - 0.5)
+}
+
+/**
+ * 从J2000.0起算的秒数
+ *
+ * @return (JD - 2451545.0) * 86400
+ */
+func (j JulianDay) J2000Seconds() float64 {
+    return j.SubSeconds(JulianDay{int(J2000), 0})
+}
+
+/**
+ * Unix时间，不考虑闰秒
+ *
+ * @return 从1970年1月1日0h起算的秒数
+ */
+func (j JulianDay) Unix() float64 {
+    return j.SubSeconds(JulianDay{UNIX_EPOCH_JULIAN_DATE, 0.5})
+}
+
+/**
+ * 转换成time.Time，精确到纳秒。不做时间尺度换算，儒略日按UTC处理
+ *
+ * @param tz
+ *            结果使用的时区
+ * @return 对应的日期时间
+ */
+func (j JulianDay) Time(tz *time.Location) time.Time {
+    seconds := (j.fraction - 0.5) * 86400
+    sec := math.Floor(seconds)
+    nsec := int64(math.Round((seconds - sec) * 1e9))
+    unix := int64(j.day - UNIX_EPOCH_JULIAN_DATE) * 86400 + int64(sec)
+    return time.Unix(unix, nsec).In(tz)
+}
+
+/**
+ * 计算日期时间的儒略日，日期的换算同{@link #ToJulianDate}
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @param hour
+ *            小时
+ * @param minute
+ *            分钟
+ * @param second
+ *            秒数
+ * @return 儒略日
+ */
+func ToJulianDay(year, month, day, hour, minute int, second float64) JulianDay {
+    return NewJulianDay(ToJulianDate(year, month, day), (float64(hour) - 12) / 24 + float64(minute) / 1440 + second / 86400)
+}
+
+/**
+ * 计算日期时间的儒略日
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @param hour
+ *            小时
+ * @param minute
+ *            分钟
+ * @param second
+ *            秒数
+ * @return 儒略日，日期时间无效或者在改历时跳过的日子里时返回错误
+ */
+func (c *Calendar) ToJulianDay(year, month, day, hour, minute int, second float64) (JulianDay, error) {
+    // 允许闰秒
+    if hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 || second >= 61 {
+        return JulianDay{}, fmt.Errorf("%w: %02d:%02d:%g", ErrInvalidTime, hour, minute, second)
+    }
+    jdn, err := c.ToJulianDate(year, month, day)
+    if err != nil {
+        return JulianDay{}, err
+    }
+    return NewJulianDay(jdn, (float64(hour) - 12) / 24 + float64(minute) / 1440 + second / 86400), nil
+}
+
+/**
+ * 由儒略日计算日期时间
+ *
+ * @param jd
+ *            儒略日
+ * @return 年、月、日、时、分、秒
+ */
+func (c *Calendar) FromJulianDay(jd JulianDay) (int, int, int, int, int, float64) {
+    // 儒略日的整数部分从中午开始，先换算到从午夜开始
+    jd = jd.Add(0.5)
+    year, month, day := c.FromJulianDate(jd.day)
+    seconds := jd.fraction * 86400
+    hour := int(seconds / 3600)
+    minute := int(seconds / 60) - hour * 60
+    return year, month, day, hour, minute, seconds - float64(hour * 3600 + minute * 60)
+}
@@ -0,0 +1,86 @@
+package calendarutil
+
+import (
+    "math"
+    "testing"
+    "time"
+)
+
+func Test_NewJulianDay(t *testing.T) {
+    j1 := NewJulianDay(2451545, -0.25)
+    j2 := NewJulianDay(2451545, 2.5)
+    j3 := NewJulianDay(2451545, -1e-20)
+    if j1.Day() == 2451544 && j1.Fraction() == 0.75 && j2.Day() == 2451547 && j2.Fraction() == 0.5 &&
+        j3.Day() == 2451545 && j3.Fraction() == 0 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", j1, j2, j3)
+    }
+}
+
+func Test_JulianDay_Time(t *testing.T) {
+    // 纳秒级往返
+    for _, tm := range []time.Time{
+        time.Date(2024, 6, 21, 12, 34, 56, 123456789, time.UTC),
+        time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC),
+        time.Date(1582, 10, 15, 0, 0, 0, 1, time.UTC),
+        time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+    } {
+        back := NewJulianDayFromTime(tm).Time(time.UTC)
+        if !back.Equal(tm) {
+            t.Error("fail", tm, back)
+        }
+    }
+    j := NewJulianDayFromTime(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC))
+    if j.Day() == 2451545 && j.Fraction() == 0 && j.J2000Seconds() == 0 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", j)
+    }
+}
+
+func Test_JulianDay_Arithmetic(t *testing.T) {
+    j := NewJulianDayFromFloat(2460000.25)
+    k := j.AddSeconds(0.0001)
+    l := j.AddDuration(-36 * time.Hour + time.Microsecond)
+    if math.Abs(k.SubSeconds(j) - 0.0001) < 1e-9 && k.After(j) && j.Before(k) && !j.Equal(k) &&
+        math.Abs(l.SubSeconds(j) + 129600 - 1e-6) < 1e-9 && j.Add(1.5).Sub(j) == 1.5 &&
+        j.Add(-0.25).Equal(NewJulianDay(2460000, 0)) && j.Compare(j) == 0 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", k.SubSeconds(j), l.SubSeconds(j))
+    }
+}
+
+func Test_JulianDay_MJD(t *testing.T) {
+    // 1858年11月17日0h是MJD的起点
+    j := ToJulianDay(1858, 11, 17, 0, 0, 0)
+    m := NewJulianDayFromMJD(60000.75)
+    if j.MJD() == 0 && m.MJD() == 60000.75 && m.Float() == 2460001.25 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", j.MJD(), m.MJD())
+    }
+}
+
+func Test_JulianDay_Unix(t *testing.T) {
+    j := NewJulianDayFromUnix(1700000000.5)
+    back := NewJulianDayFromJ2000Seconds(j.J2000Seconds())
+    if j.Unix() == 1700000000.5 && j.Time(time.UTC).Equal(time.Unix(1700000000, 5e8)) &&
+        math.Abs(back.SubSeconds(j)) < 1e-9 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", j.Unix(), back.SubSeconds(j))
+    }
+}
+
+func Test_Calendar_FromJulianDay(t *testing.T) {
+    j, err := BritishCalendar.ToJulianDay(1752, 9, 2, 18, 30, 15.25)
+    y, m, d, h, mi, s := BritishCalendar.FromJulianDay(j)
+    if err == nil && y == 1752 && m == 9 && d == 2 && h == 18 && mi == 30 && math.Abs(s - 15.25) < 1e-6 &&
+        math.Abs(j.Float() - ToJulianDateInJulianHMS(1752, 9, 2, 18, 30, 15.25)) < 1e-9 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", y, m, d, h, mi, s, err)
+    }
+}
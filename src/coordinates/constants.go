@@ -0,0 +1,11 @@
+package coordinates
+
+/**
+ * 一个天文单位(IAU 2012)，单位是千米
+ */
+const AU = 149597870.7
+
+/**
+ * 月亮的平均半径，单位是千米
+ */
+const MOON_RADIUS = 1737.4
@@ -0,0 +1,184 @@
+package coordinates
+
+import (
+    "math"
+    "mathutil"
+)
+
+/**
+ * 黄道坐标，单位是弧度(rad)
+ */
+type Ecliptic struct {
+    // 黄经
+    Longitude float64
+    // 黄纬
+    Latitude float64
+}
+
+/**
+ * 赤道坐标，单位是弧度(rad)
+ */
+type Equatorial struct {
+    // 赤经
+    RightAscension float64
+    // 赤纬
+    Declination float64
+}
+
+/**
+ * 地平坐标，单位是弧度(rad)
+ */
+type Horizontal struct {
+    // 方位角，从正北向东量度
+    Azimuth float64
+    // 高度角
+    Altitude float64
+}
+
+/**
+ * 银道坐标，单位是弧度(rad)
+ */
+type Galactic struct {
+    // 银经
+    Longitude float64
+    // 银纬
+    Latitude float64
+}
+
+/**
+ * 银河北极的J2000赤经
+ */
+const GALACTIC_POLE_RIGHT_ASCENSION = 192.85948 * math.Pi / 180
+
+/**
+ * 银河北极的J2000赤纬
+ */
+const GALACTIC_POLE_DECLINATION = 27.12825 * math.Pi / 180
+
+/**
+ * 北天极的银经
+ */
+const CELESTIAL_POLE_GALACTIC_LONGITUDE = 122.93192 * math.Pi / 180
+
+/**
+ * 把球面坐标绕x轴旋转，黄道与赤道、地平与时角之间的转换都可以归结为这种旋转，参考<i>Jean
+ * Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第13章
+ *
+ * @param lon
+ *            经度(rad)
+ * @param lat
+ *            纬度(rad)
+ * @param angle
+ *            旋转角(rad)
+ * @return 旋转后的经度和纬度(rad)
+ */
+func rotate(lon float64, lat float64, angle float64) (float64, float64) {
+    sinLon, cosLon := math.Sincos(lon)
+    sinLat, cosLat := math.Sincos(lat)
+    sinA, cosA := math.Sincos(angle)
+    x := math.Atan2(sinLon * cosA - sinLat / cosLat * sinA, cosLon)
+    y := math.Asin(sinLat * cosA + cosLat * sinA * sinLon)
+    return mathutil.Mod2Pi(x), y
+}
+
+/**
+ * 黄道坐标转换为赤道坐标，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第13章(13.3)、(13.4)式
+ *
+ * @param obliquity
+ *            黄赤交角(rad)，用平黄赤交角得到平赤道坐标，用真黄赤交角得到视赤道坐标
+ * @return 赤道坐标
+ */
+func (e *Ecliptic) ToEquatorial(obliquity float64) *Equatorial {
+    ra, dec := rotate(e.Longitude, e.Latitude, obliquity)
+    return &Equatorial{ra, dec}
+}
+
+/**
+ * 赤道坐标转换为黄道坐标，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第13章(13.1)、(13.2)式
+ *
+ * @param obliquity
+ *            黄赤交角(rad)
+ * @return 黄道坐标
+ */
+func (e *Equatorial) ToEcliptic(obliquity float64) *Ecliptic {
+    lon, lat := rotate(e.RightAscension, e.Declination, -obliquity)
+    return &Ecliptic{lon, lat}
+}
+
+/**
+ * 赤道坐标转换为地平坐标，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第13章(13.5)、(13.6)式
+ *
+ * @param latitude
+ *            观测者的地理纬度(rad)
+ * @param lst
+ *            地方恒星时(rad)
+ * @return 地平坐标，不含大气折射
+ */
+func (e *Equatorial) ToHorizontal(latitude float64, lst float64) *Horizontal {
+    h := lst - e.RightAscension
+    sinH, cosH := math.Sincos(h)
+    sinDec, cosDec := math.Sincos(e.Declination)
+    sinLat, cosLat := math.Sincos(latitude)
+    // Meeus的方位角从正南量度，这里加π改为从正北量度
+    az := math.Atan2(sinH, cosH * sinLat - sinDec / cosDec * cosLat) + math.Pi
+    alt := math.Asin(sinLat * sinDec + cosLat * cosDec * cosH)
+    return &Horizontal{mathutil.Mod2Pi(az), alt}
+}
+
+/**
+ * 地平坐标转换为赤道坐标
+ *
+ * @param latitude
+ *            观测者的地理纬度(rad)
+ * @param lst
+ *            地方恒星时(rad)
+ * @return 赤道坐标
+ */
+func (h *Horizontal) ToEquatorial(latitude float64, lst float64) *Equatorial {
+    a := h.Azimuth - math.Pi
+    sinA, cosA := math.Sincos(a)
+    sinAlt, cosAlt := math.Sincos(h.Altitude)
+    sinLat, cosLat := math.Sincos(latitude)
+    ha := math.Atan2(sinA, cosA * sinLat + sinAlt / cosAlt * cosLat)
+    dec := math.Asin(sinLat * sinAlt - cosLat * cosAlt * cosA)
+    return &Equatorial{mathutil.Mod2Pi(lst - ha), dec}
+}
+
+/**
+ * 计算时角
+ *
+ * @param lst
+ *            地方恒星时(rad)
+ * @return 限制在[-π, π]之间的时角(rad)，正值表示在子午圈以西
+ */
+func (e *Equatorial) GetHourAngle(lst float64) float64 {
+    return mathutil.ModPi(lst - e.RightAscension)
+}
+
+/**
+ * J2000赤道坐标转换为银道坐标，银河北极和北天极的银经采用IAU 1958定义在J2000历元下的数值
+ *
+ * @return 银道坐标
+ */
+func (e *Equatorial) ToGalactic() *Galactic {
+    sinDec, cosDec := math.Sincos(e.Declination)
+    sinP, cosP := math.Sincos(GALACTIC_POLE_DECLINATION)
+    sinDa, cosDa := math.Sincos(e.RightAscension - GALACTIC_POLE_RIGHT_ASCENSION)
+    b := math.Asin(sinDec * sinP + cosDec * cosP * cosDa)
+    l := CELESTIAL_POLE_GALACTIC_LONGITUDE - math.Atan2(cosDec * sinDa, sinDec * cosP - cosDec * sinP * cosDa)
+    return &Galactic{mathutil.Mod2Pi(l), b}
+}
+
+/**
+ * 银道坐标转换为J2000赤道坐标
+ *
+ * @return 赤道坐标
+ */
+func (g *Galactic) ToEquatorial() *Equatorial {
+    sinB, cosB := math.Sincos(g.Latitude)
+    sinP, cosP := math.Sincos(GALACTIC_POLE_DECLINATION)
+    sinDl, cosDl := math.Sincos(CELESTIAL_POLE_GALACTIC_LONGITUDE - g.Longitude)
+    dec := math.Asin(sinB * sinP + cosB * cosP * cosDl)
+    ra := GALACTIC_POLE_RIGHT_ASCENSION + math.Atan2(cosB * sinDl, sinB * cosP - cosB * sinP * cosDl)
+    return &Equatorial{mathutil.Mod2Pi(ra), dec}
+}
@@ -0,0 +1,124 @@
+package coordinates
+
+import (
+    "math"
+    "mathutil"
+    "testing"
+)
+
+const epsilon = 1e-6
+
+func degrees(r float64) float64 {
+    return r * 180 / math.Pi
+}
+
+// Jean Meeus的Astronomical Algorithms第13章例13.a：北河三(Pollux)
+func Test_EquatorialToEcliptic(t *testing.T) {
+    pollux := &Equatorial{mathutil.ToRadians(116.328942), mathutil.ToRadians(28.026183)}
+    ecl := pollux.ToEcliptic(mathutil.ToRadians(23.4392911))
+    if math.Abs(degrees(ecl.Longitude) - 113.215630) < epsilon && math.Abs(degrees(ecl.Latitude) - 6.684170) < epsilon {
+        t.Log("ok")
+    } else {
+        t.Error("fail", degrees(ecl.Longitude), degrees(ecl.Latitude))
+    }
+}
+
+func Test_EclipticToEquatorial(t *testing.T) {
+    ecl := &Ecliptic{mathutil.ToRadians(113.215630), mathutil.ToRadians(6.684170)}
+    eq := ecl.ToEquatorial(mathutil.ToRadians(23.4392911))
+    if math.Abs(degrees(eq.RightAscension) - 116.328942) < epsilon && math.Abs(degrees(eq.Declination) - 28.026183) < epsilon {
+        t.Log("ok")
+    } else {
+        t.Error("fail", degrees(eq.RightAscension), degrees(eq.Declination))
+    }
+}
+
+// 例13.b：1987年4月10日19h21m00s UT在华盛顿海军天文台观测金星
+func Test_EquatorialToHorizontal(t *testing.T) {
+    venus := &Equatorial{mathutil.ToRadians(347.3193375), mathutil.ToRadians(-6.719891)}
+    latitude := mathutil.DmsToRadians(38, 55, 17)
+    // 格林尼治视恒星时8h34m56.853s
+    lst := mathutil.ToRadians(128.7368875) - mathutil.DmsToRadians(77, 3, 56)
+    hor := venus.ToHorizontal(latitude, lst)
+    // Meeus的方位角68.0337°从正南量度
+    if math.Abs(degrees(hor.Azimuth) - 248.0337) < 2e-4 && math.Abs(degrees(hor.Altitude) - 15.1249) < 2e-4 &&
+        math.Abs(degrees(venus.GetHourAngle(lst)) - 64.352) < 1e-3 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", degrees(hor.Azimuth), degrees(hor.Altitude))
+    }
+
+    eq := hor.ToEquatorial(latitude, lst)
+    if math.Abs(eq.RightAscension - venus.RightAscension) < 1e-12 && math.Abs(eq.Declination - venus.Declination) < 1e-12 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", degrees(eq.RightAscension), degrees(eq.Declination))
+    }
+}
+
+func Test_EquatorialToGalactic(t *testing.T) {
+    pole := &Equatorial{GALACTIC_POLE_RIGHT_ASCENSION, GALACTIC_POLE_DECLINATION}
+    if math.Abs(degrees(pole.ToGalactic().Latitude) - 90) > epsilon {
+        t.Error("fail")
+    }
+    // 银河系中心
+    center := (&Equatorial{mathutil.ToRadians(266.40499), mathutil.ToRadians(-28.93617)}).ToGalactic()
+    if math.Abs(degrees(mathutil.ModPi(center.Longitude))) > 1e-4 || math.Abs(degrees(center.Latitude)) > 1e-4 {
+        t.Error("fail", degrees(center.Longitude), degrees(center.Latitude))
+    }
+    // 北天极的银经
+    ncp := (&Equatorial{0, math.Pi / 2}).ToGalactic()
+    if math.Abs(ncp.Longitude - CELESTIAL_POLE_GALACTIC_LONGITUDE) < epsilon {
+        t.Log("ok")
+    } else {
+        t.Error("fail", degrees(ncp.Longitude))
+    }
+}
+
+func Test_GalacticToEquatorial(t *testing.T) {
+    for _, eq := range []*Equatorial{{0.5, 0.3}, {3.0, -1.2}, {5.9, 0.01}} {
+        result := eq.ToGalactic().ToEquatorial()
+        if math.Abs(result.RightAscension - eq.RightAscension) > 1e-12 || math.Abs(result.Declination - eq.Declination) > 1e-12 {
+            t.Error("fail", result)
+        }
+    }
+}
+
+// Meeus例11.a，帕洛马山天文台
+func Test_GetGeocentricTerms(t *testing.T) {
+    rhoSin, rhoCos := GetGeocentricTerms(mathutil.ToRadians(mathutil.DmsToDegrees(33, 21, 22)), 1706)
+    if math.Abs(rhoSin - 0.546861) < 1e-6 && math.Abs(rhoCos - 0.836339) < 1e-6 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", rhoSin, rhoCos)
+    }
+}
+
+// Meeus例40.a，火星的站心赤道坐标：赤经22h38m08.54s，赤纬-15°46'30.0"
+func Test_ToTopocentric(t *testing.T) {
+    eq := &Equatorial{mathutil.ToRadians(339.530208), mathutil.ToRadians(-15.771083)}
+    lat := mathutil.ToRadians(mathutil.DmsToDegrees(33, 21, 22))
+    // 赤道地平视差23.592"
+    distance := 1 / math.Sin(mathutil.SecondsToRadians(23.592))
+    lst := mathutil.ToRadians(288.7958 + 339.530208)
+    topo := eq.ToTopocentric(distance, lat, 1706, lst)
+    ra := degrees(topo.RightAscension) / 15
+    if math.Abs(ra - mathutil.DmsToDegrees(22, 38, 8.54)) < 0.01 / 3600 &&
+        math.Abs(degrees(topo.Declination) + mathutil.DmsToDegrees(15, 46, 30.0)) < 0.1 / 3600 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", ra, degrees(topo.Declination))
+    }
+}
+
+func Test_ToTopocentric_Wrap(t *testing.T) {
+    // 赤经接近0的月亮在西边，视差使赤经减小，结果应该在2π附近而不是负数
+    eq := &Equatorial{mathutil.ToRadians(0.1), 0}
+    topo := eq.ToTopocentric(60, mathutil.ToRadians(40), 0, mathutil.ToRadians(60))
+    if topo.RightAscension >= 0 && topo.RightAscension < 2 * math.Pi &&
+        degrees(topo.RightAscension) > 359 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", degrees(topo.RightAscension))
+    }
+}
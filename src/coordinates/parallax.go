@@ -0,0 +1,55 @@
+package coordinates
+
+import (
+    "math"
+    "mathutil"
+)
+
+/**
+ * 地球扁率(IAU 1976)
+ */
+const EARTH_FLATTENING = 1 / 298.257
+
+/**
+ * 地球赤道半径，单位是米
+ */
+const EARTH_EQUATORIAL_RADIUS = 6378140.0
+
+/**
+ * 计算观测者的地心纬度参数，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第11章
+ *
+ * @param latitude
+ *            地理纬度(rad)
+ * @param elevation
+ *            海拔高度(米)
+ * @return ρsinφ'和ρcosφ'，ρ是观测者到地心的距离(地球赤道半径为单位)，φ'是地心纬度
+ */
+func GetGeocentricTerms(latitude float64, elevation float64) (float64, float64) {
+    b := 1 - EARTH_FLATTENING
+    u := math.Atan(b * math.Tan(latitude))
+    h := elevation / EARTH_EQUATORIAL_RADIUS
+    return b * math.Sin(u) + h * math.Sin(latitude), math.Cos(u) + h * math.Cos(latitude)
+}
+
+/**
+ * 地心赤道坐标转换为站心赤道坐标(修正周日视差)，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第40章
+ *
+ * @param distance
+ *            天体到地心的距离，单位是地球赤道半径
+ * @param latitude
+ *            观测者的地理纬度(rad)
+ * @param elevation
+ *            观测者的海拔高度(米)
+ * @param lst
+ *            地方恒星时(rad)
+ * @return 站心赤道坐标，赤经在[0, 2π)之间
+ */
+func (e *Equatorial) ToTopocentric(distance float64, latitude float64, elevation float64, lst float64) *Equatorial {
+    rhoSin, rhoCos := GetGeocentricTerms(latitude, elevation)
+    sinP := 1 / distance
+    sinH, cosH := math.Sincos(lst - e.RightAscension)
+    sinDec, cosDec := math.Sincos(e.Declination)
+    dRA := math.Atan2(-rhoCos * sinP * sinH, cosDec - rhoCos * sinP * cosH)
+    dec := math.Atan2((sinDec - rhoSin * sinP) * math.Cos(dRA), cosDec - rhoCos * sinP * cosH)
+    return &Equatorial{mathutil.Mod2Pi(e.RightAscension + dRA), dec}
+}
@@ -0,0 +1,149 @@
+package eclipse
+
+import (
+    "coordinates"
+    "elp2000moon"
+    "fmt"
+    "math"
+    "mathutil"
+    "nutation"
+    "vsop87earthd"
+)
+
+/**
+ * 食的类型
+ */
+type Kind int
+
+const (
+    // 偏食
+    Partial Kind = iota
+    // 环食，只有日食
+    Annular
+    // 全食
+    Total
+    // 全环食，中心线上一部分地方是全食，另一部分地方是环食，只有日食
+    Hybrid
+    // 半影月食，只有月食
+    Penumbral
+)
+
+var kindNames = []string{"偏食", "环食", "全食", "全环食", "半影食"}
+
+/**
+ * 类型的中文名称，无效的类型返回类似“%!Kind(7)”的字符串
+ */
+func (k Kind) String() string {
+    if k < 0 || int(k) >= len(kindNames) {
+        return fmt.Sprintf("%%!Kind(%d)", int(k))
+    }
+    return kindNames[k]
+}
+
+/**
+ * 地球赤道半径，单位是千米，和{@link coordinates#EARTH_EQUATORIAL_RADIUS}一致
+ */
+const EARTH_RADIUS = coordinates.EARTH_EQUATORIAL_RADIUS / 1000
+
+/**
+ * 太阳半径，单位是地球赤道半径
+ */
+const SUN_RADIUS = 696000 / EARTH_RADIUS
+
+/**
+ * 月亮半径与地球赤道半径之比，半影用0.2725076，本影用0.272281(月面山谷使本影食的月亮略小)，与NASA的日食表一致
+ */
+const (
+    MOON_RADIUS_PENUMBRA = 0.2725076
+    MOON_RADIUS_UMBRA = 0.272281
+)
+
+/**
+ * 太阳在1天文单位处的地平视差，单位是角秒
+ */
+const SUN_PARALLAX = 8.794
+
+/**
+ * 太阳在1天文单位处的视半径，单位是角秒
+ */
+const SUN_SEMIDIAMETER = 959.63
+
+/**
+ * 计算食甚和各个食相时刻时搜索的时间范围，单位是日。月亮相对于影子每小时移动约0.5°，
+ * 食的全过程不超过7小时，在朔望前后0.3日之内一定能找到
+ */
+const searchRange = 0.3
+
+/**
+ * 求导数时的步长，单位是日
+ */
+const delta = 1e-4
+
+/**
+ * 计算太阳的地心视赤道坐标
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 视赤道坐标和日地距离(km)
+ */
+func getSunEquatorial(jd float64) (*coordinates.Equatorial, float64) {
+    ecl := &coordinates.Ecliptic{
+        Longitude: vsop87earthd.GetEarthEclipticLongitudeForSun(jd),
+        Latitude: -vsop87earthd.GetSunEclipticLatitudeForEarth(jd),
+    }
+    return ecl.ToEquatorial(nutation.GetTrueObliquity(jd)), vsop87earthd.GetSunRadiusForEarth(jd) * coordinates.AU
+}
+
+/**
+ * 计算月亮的地心视赤道坐标
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 视赤道坐标和地月距离(km)
+ */
+func getMoonEquatorial(jd float64) (*coordinates.Equatorial, float64) {
+    ecl := &coordinates.Ecliptic{
+        Longitude: elp2000moon.GetEarthEclipticLongitudeForMoon(jd),
+        Latitude: elp2000moon.GetEarthEclipticLatitudeForMoon(jd),
+    }
+    return ecl.ToEquatorial(nutation.GetTrueObliquity(jd)), elp2000moon.GetEarthRadiusForMoon(jd)
+}
+
+/**
+ * 把赤道坐标和距离换算成直角坐标
+ */
+func toRectangular(eq *coordinates.Equatorial, r float64) (float64, float64, float64) {
+    return r * math.Cos(eq.Declination) * math.Cos(eq.RightAscension),
+        r * math.Cos(eq.Declination) * math.Sin(eq.RightAscension),
+        r * math.Sin(eq.Declination)
+}
+
+/**
+ * 求极值点和食相时刻所用的参数
+ */
+var solver = mathutil.NewSolver(1e-7, 100)
+
+/**
+ * 求f在[a, b]之间的极小值点，即f的导数的零点
+ *
+ * @return 极小值点，区间内没有极小值时返回错误
+ */
+func findMinimum(f func(float64) float64, a, b float64) (float64, error) {
+    return solver.Brent(func(t float64) float64 {
+        return f(t + delta) - f(t - delta)
+    }, a, b)
+}
+
+/**
+ * 求g(t) = 0在[a, b]之间的根，g(a)和g(b)不异号或者区间端点为NaN时返回NaN，表示没有这个食相
+ */
+func findContact(g func(float64) float64, a, b float64) float64 {
+    if math.IsNaN(a) || math.IsNaN(b) {
+        return math.NaN()
+    }
+    t, err := solver.Brent(g, a, b)
+    if err != nil {
+        return math.NaN()
+    }
+    return t
+}
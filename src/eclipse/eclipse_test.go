@@ -0,0 +1,210 @@
+package eclipse
+
+import (
+    "calendarutil"
+    "math"
+    "riseset"
+    "testing"
+)
+
+// NASA五千年日食表(Five Millennium Canon of Solar Eclipses)中的日食，食甚时刻是TD，精确到秒
+var nasaSolarEclipses = []struct {
+    kind Kind
+    year, month, day, hour, minute, second int
+    magnitude, gamma float64
+}{
+    {Total, 1999, 8, 11, 11, 4, 9, 1.0286, 0.5062},
+    {Total, 2017, 8, 21, 18, 26, 40, 1.0306, 0.4367},
+    {Annular, 2020, 6, 21, 6, 41, 15, 0.9940, 0.1209},
+    {Total, 2020, 12, 14, 16, 14, 39, 1.0254, -0.2939},
+    {Hybrid, 2023, 4, 20, 4, 17, 56, 1.0132, -0.3952},
+    {Total, 2024, 4, 8, 18, 18, 29, 1.0566, 0.3431},
+}
+
+// NASA五千年月食表(Five Millennium Canon of Lunar Eclipses)中的月食，食甚时刻是TD，精确到秒
+var nasaLunarEclipses = []struct {
+    kind Kind
+    year, month, day, hour, minute, second int
+    magnitude, gamma float64
+}{
+    {Total, 2021, 5, 26, 11, 19, 53, 1.0095, 0.4774},
+    {Total, 2022, 5, 16, 4, 12, 42, 1.4137, -0.2532},
+    {Total, 2022, 11, 8, 11, 0, 22, 1.3589, 0.2570},
+}
+
+// NASA日食贝塞尔根数中T0时刻(TD)的x、y、l1、l2，用来检验月亮和太阳的位置
+var nasaBesselianElements = []struct {
+    year, month, day, hour int
+    x, y, l1, l2 float64
+}{
+    {2017, 8, 21, 18, -0.129576, 0.485417, 0.542093, -0.004028},
+    {2024, 4, 8, 18, -0.318244, 0.219764, 0.535813, -0.010274},
+}
+
+func Test_getBesselianElements(t *testing.T) {
+    for _, v := range nasaBesselianElements {
+        e := getBesselianElements(calendarutil.ToJulianDateHMS(v.year, v.month, v.day, v.hour, 0, 0))
+        t.Log(e.x, e.y, e.l1, e.l2)
+        // 0.0003个地球半径相当于在月亮处约1″
+        if math.Abs(e.x - v.x) > 0.0003 || math.Abs(e.y - v.y) > 0.0003 {
+            t.Error("fail", v.year, e.x - v.x, e.y - v.y)
+        }
+        if math.Abs(e.l1 - v.l1) > 0.0001 || math.Abs(e.l2 - v.l2) > 0.0001 {
+            t.Error("fail", v.year, e.l1 - v.l1, e.l2 - v.l2)
+        }
+    }
+}
+
+func Test_GetSolarEclipse(t *testing.T) {
+    for _, e := range nasaSolarEclipses {
+        jd := calendarutil.ToJulianDateHMS(e.year, e.month, e.day, e.hour, e.minute, float64(e.second))
+        eclipse := GetSolarEclipse(jd)
+        if eclipse == nil || eclipse.Kind != e.kind || math.Abs(eclipse.Greatest - jd) * 1440 > 1 ||
+            math.Abs(eclipse.Magnitude - e.magnitude) > 0.001 || math.Abs(eclipse.Gamma - e.gamma) > 0.001 {
+            t.Error("fail", e, eclipse)
+        }
+    }
+}
+
+func Test_GetLunarEclipse(t *testing.T) {
+    for _, e := range nasaLunarEclipses {
+        jd := calendarutil.ToJulianDateHMS(e.year, e.month, e.day, e.hour, e.minute, float64(e.second))
+        eclipse := GetLunarEclipse(jd)
+        if eclipse == nil || eclipse.Kind != e.kind || math.Abs(eclipse.Greatest - jd) * 1440 > 1 ||
+            math.Abs(eclipse.UmbralMagnitude - e.magnitude) > 0.001 || math.Abs(eclipse.Gamma - e.gamma) > 0.001 {
+            t.Error("fail", e, eclipse)
+        }
+    }
+}
+
+func Test_FindSolarEclipses(t *testing.T) {
+    // 2021年至2023年的日食
+    kinds := []Kind{Annular, Total, Partial, Partial, Hybrid, Annular}
+    eclipses := FindSolarEclipses(float64(calendarutil.ToJulianDate(2021, 1, 1)), float64(calendarutil.ToJulianDate(2024, 1, 1)))
+    if len(eclipses) != len(kinds) {
+        t.Fatal("fail", len(eclipses))
+    }
+    for i, eclipse := range eclipses {
+        if eclipse.Kind != kinds[i] {
+            t.Error("fail", i, eclipse.Kind)
+        }
+    }
+    // 2022年10月25日日偏食，食分0.8619，γ=1.0701
+    e := eclipses[3]
+    if math.Abs(e.Magnitude - 0.8619) < 0.001 && math.Abs(e.Gamma - 1.0701) < 0.001 &&
+        e.P1 < e.Greatest && e.Greatest < e.P4 && math.IsNaN(e.U1) && math.IsNaN(e.U4) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", e)
+    }
+}
+
+func Test_FindLunarEclipses(t *testing.T) {
+    // 2020年有4次半影月食，2021年至2023年依次是全食、偏食、全食、全食、半影食、偏食
+    kinds := []Kind{Penumbral, Penumbral, Penumbral, Penumbral, Total, Partial, Total, Total, Penumbral, Partial}
+    eclipses := FindLunarEclipses(float64(calendarutil.ToJulianDate(2020, 1, 1)), float64(calendarutil.ToJulianDate(2024, 1, 1)))
+    if len(eclipses) != len(kinds) {
+        t.Fatal("fail", len(eclipses))
+    }
+    for i, eclipse := range eclipses {
+        if eclipse.Kind != kinds[i] {
+            t.Error("fail", i, eclipse.Kind)
+        }
+    }
+    // 2023年5月5日半影月食，半影食分0.9636
+    e := eclipses[8]
+    if math.Abs(e.PenumbralMagnitude - 0.9636) < 0.001 && math.Abs(e.Gamma + 1.0350) < 0.001 &&
+        e.UmbralMagnitude < 0 && math.IsNaN(e.U1) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", e)
+    }
+    // 2022年11月8日月全食，各个食相依次发生，食既到生光约85分钟
+    e = eclipses[7]
+    if e.P1 < e.U1 && e.U1 < e.U2 && e.U2 < e.Greatest && e.Greatest < e.U3 && e.U3 < e.U4 && e.U4 < e.P4 &&
+        math.Abs((e.U3 - e.U2) * 1440 - 85) < 2 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", e)
+    }
+}
+
+func Test_GetLocalCircumstances(t *testing.T) {
+    // 2024年4月8日日全食的食甚点(北纬25°17.4'，西经104°08.3')，全食持续4分28.1秒，太阳高度70°
+    e := GetSolarEclipse(float64(calendarutil.ToJulianDate(2024, 4, 8)))
+    local := e.GetLocalCircumstances(&riseset.Location{Latitude: 25 + 17.4 / 60, Longitude: -(104 + 8.3 / 60), Elevation: 0})
+    if local == nil || local.Kind != Total || !local.Visible || local.Obscuration != 1 ||
+        math.Abs((local.C3.Time - local.C2.Time) * 86400 - 268.1) > 1 ||
+        math.Abs(local.Maximum.Time - e.Greatest) * 1440 > 1 || math.Abs(local.Maximum.Altitude - 70) > 1 ||
+        !(local.C1.Time < local.C2.Time && local.C2.Time < local.Maximum.Time &&
+            local.Maximum.Time < local.C3.Time && local.C3.Time < local.C4.Time) {
+        t.Error("fail", local)
+    }
+    // 2017年8月21日日全食的食甚点(北纬36°58'，西经87°40')，全食持续2分40.2秒，太阳高度64°
+    e = GetSolarEclipse(float64(calendarutil.ToJulianDate(2017, 8, 21)))
+    local = e.GetLocalCircumstances(&riseset.Location{Latitude: 36 + 58.0 / 60, Longitude: -(87 + 40.0 / 60), Elevation: 0})
+    if local == nil || local.Kind != Total || math.Abs((local.C3.Time - local.C2.Time) * 86400 - 160.2) > 1 ||
+        math.Abs(local.Maximum.Altitude - 64) > 1 {
+        t.Error("fail", local)
+    }
+    // 从悉尼看不到，也不在半影范围以内
+    if e.GetLocalCircumstances(&riseset.Location{Latitude: -33.87, Longitude: 151.21, Elevation: 0}) != nil {
+        t.Error("fail")
+    }
+}
+
+func Test_GetLocalCircumstances_Shanghai(t *testing.T) {
+    shanghai := &riseset.Location{Latitude: 31.23, Longitude: 121.47, Elevation: 4}
+    // 2009年7月22日上海可以看到约5分钟的日全食
+    e := GetSolarEclipse(float64(calendarutil.ToJulianDate(2009, 7, 22)))
+    local := e.GetLocalCircumstances(shanghai)
+    if local == nil || local.Kind != Total || !local.Visible || math.Abs((local.C3.Time - local.C2.Time) * 1440 - 5) > 0.5 ||
+        local.C1.Altitude < 30 {
+        t.Error("fail", local)
+    }
+    // 2024年4月8日日全食发生时上海是夜里
+    e = GetSolarEclipse(float64(calendarutil.ToJulianDate(2024, 4, 8)))
+    local = e.GetLocalCircumstances(shanghai)
+    if local != nil && local.Visible {
+        t.Error("fail", local)
+    }
+    // 2020年6月21日日环食，上海看到偏食
+    e = GetSolarEclipse(float64(calendarutil.ToJulianDate(2020, 6, 21)))
+    local = e.GetLocalCircumstances(shanghai)
+    if local != nil && local.Kind == Partial && local.Visible && math.IsNaN(local.C2.Time) &&
+        local.Obscuration > 0 && local.Obscuration < local.Magnitude {
+        t.Log("ok")
+    } else {
+        t.Error("fail", local)
+    }
+}
+
+func Test_getObscuration(t *testing.T) {
+    if getObscuration(1, 1, 2) == 0 && getObscuration(1, 1.05, 0) == 1 &&
+        math.Abs(getObscuration(1, 0.9, 0) - 0.81) < 1e-12 &&
+        math.Abs(getObscuration(1, 1, 1) - (2 * math.Pi / 3 - math.Sqrt(3) / 2) / math.Pi) < 1e-12 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_Kind_String(t *testing.T) {
+    if Total.String() == "全食" && Penumbral.String() == "半影食" &&
+        Kind(7).String() == "%!Kind(7)" && Kind(-1).String() == "%!Kind(-1)" {
+        t.Log("ok")
+    } else {
+        t.Error("fail", Kind(7))
+    }
+}
+
+func Test_GetLocalCircumstances_NoContacts(t *testing.T) {
+    // 缺少P1、P4时不能用0当作搜索区间
+    e := GetSolarEclipse(float64(calendarutil.ToJulianDate(2024, 4, 8)))
+    e.P1 = math.NaN()
+    if e.GetLocalCircumstances(&riseset.Location{Latitude: 32.5, Longitude: -104.5}) == nil {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
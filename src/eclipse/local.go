@@ -0,0 +1,193 @@
+package eclipse
+
+import (
+    "calendarutil"
+    "coordinates"
+    "math"
+    "mathutil"
+    "riseset"
+    "sidereal"
+)
+
+/**
+ * 本地看到的食相
+ */
+type LocalContact struct {
+    // 时刻的儒略日(TT)，没有这个食相时为NaN
+    Time float64
+    // 太阳的站心高度角(度)，不含大气折射，没有这个食相时为NaN
+    Altitude float64
+}
+
+/**
+ * 某地看到的日食情况。C1是初亏，C2是食既，C3是生光，C4是复圆，偏食没有C2和C3
+ */
+type LocalSolarEclipse struct {
+    // 本地看到的类型，只有偏食、全食和环食
+    Kind Kind
+    // 食甚
+    Maximum LocalContact
+    // 食甚时的食分
+    Magnitude float64
+    // 食甚时太阳圆面被遮住的面积比例
+    Obscuration float64
+    C1 LocalContact
+    C2 LocalContact
+    C3 LocalContact
+    C4 LocalContact
+    // 初亏到复圆之间太阳是否在地平线以上(只检查初亏、食甚和复圆)
+    Visible bool
+}
+
+/**
+ * 观测者在基本平面上的坐标和当地的影子半径，长度单位是地球赤道半径
+ */
+type observerGeometry struct {
+    // 月亮中心相对观测者的坐标
+    u, v float64
+    // 观测者所在平面上的半影和本影半径
+    L1, L2 float64
+}
+
+/**
+ * 计算观测者相对影子的位置，参考<i>Explanatory Supplement to the Astronomical Almanac</i>(1992)第8.3节
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param loc
+ *            观测地点
+ * @return 观测者相对影子的位置
+ */
+func getObserverGeometry(jd float64, loc *riseset.Location) *observerGeometry {
+    e := getBesselianElements(jd)
+    lat := mathutil.ToRadians(loc.Latitude)
+    rhoSin, rhoCos := coordinates.GetGeocentricTerms(lat, loc.Elevation)
+    ut := jd - calendarutil.GetDeltaTJD(jd) / 86400
+    // 观测者相对影锥轴线的时角
+    h := sidereal.GetLocalSiderealTime(ut, mathutil.ToRadians(loc.Longitude)) - e.a
+    sinD, cosD := math.Sincos(e.d)
+    xi := rhoCos * math.Sin(h)
+    eta := rhoSin * cosD - rhoCos * math.Cos(h) * sinD
+    zeta := rhoSin * sinD + rhoCos * math.Cos(h) * cosD
+    return &observerGeometry{
+        u: e.x - xi,
+        v: e.y - eta,
+        L1: e.l1 - zeta * e.tanF1,
+        L2: e.l2 - zeta * e.tanF2,
+    }
+}
+
+/**
+ * 月亮中心与观测者的距离
+ */
+func (g *observerGeometry) getDistance() float64 {
+    return math.Hypot(g.u, g.v)
+}
+
+/**
+ * 计算太阳的站心高度角
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param loc
+ *            观测地点
+ * @return 高度角(度)，不含大气折射
+ */
+func getSunAltitude(jd float64, loc *riseset.Location) float64 {
+    sun, rs := getSunEquatorial(jd)
+    lat := mathutil.ToRadians(loc.Latitude)
+    ut := jd - calendarutil.GetDeltaTJD(jd) / 86400
+    lst := sidereal.GetLocalSiderealTime(ut, mathutil.ToRadians(loc.Longitude))
+    topo := sun.ToTopocentric(rs / EARTH_RADIUS, lat, loc.Elevation, lst)
+    return topo.ToHorizontal(lat, lst).Altitude * 180 / math.Pi
+}
+
+/**
+ * 计算太阳圆面被月亮遮住的面积比例
+ *
+ * @param sun
+ *            太阳的半径
+ * @param moon
+ *            月亮的半径
+ * @param distance
+ *            两个圆心的距离，和半径的单位相同
+ * @return 被遮住的面积比例
+ */
+func getObscuration(sun, moon, distance float64) float64 {
+    if distance >= sun + moon {
+        return 0
+    }
+    if distance <= math.Abs(sun - moon) {
+        return math.Min(1, moon * moon / (sun * sun))
+    }
+    // 两个圆相交部分的面积
+    a := math.Acos((distance * distance + sun * sun - moon * moon) / (2 * distance * sun))
+    b := math.Acos((distance * distance + moon * moon - sun * sun) / (2 * distance * moon))
+    area := sun * sun * (a - math.Sin(2 * a) / 2) + moon * moon * (b - math.Sin(2 * b) / 2)
+    return area / (math.Pi * sun * sun)
+}
+
+/**
+ * 计算某地看到的日食情况
+ *
+ * @param loc
+ *            观测地点
+ * @return 本地看到的日食情况，当地不在半影范围以内或者日食没有P1、P4时返回nil。
+ *         太阳在地平线以下时仍然给出各个食相，此时Visible为false
+ */
+func (e *SolarEclipse) GetLocalCircumstances(loc *riseset.Location) *LocalSolarEclipse {
+    distance := func(t float64) float64 {
+        return getObserverGeometry(t, loc).getDistance()
+    }
+    if math.IsNaN(e.P1) || math.IsNaN(e.P4) {
+        return nil
+    }
+    maximum, err := findMinimum(distance, e.P1, e.P4)
+    if err != nil {
+        return nil
+    }
+    g := getObserverGeometry(maximum, loc)
+    m := g.getDistance()
+    if m >= g.L1 {
+        return nil
+    }
+
+    local := &LocalSolarEclipse{
+        Kind: Partial,
+        Maximum: LocalContact{maximum, getSunAltitude(maximum, loc)},
+        Magnitude: (g.L1 - m) / (g.L1 + g.L2),
+        // 太阳和月亮的视半径之比等于(L1 + L2)与(L1 - L2)之比
+        Obscuration: getObscuration((g.L1 + g.L2) / 2, (g.L1 - g.L2) / 2, m),
+    }
+    contact := func(g func(t float64) float64, a, b float64) LocalContact {
+        t := findContact(g, a, b)
+        if math.IsNaN(t) {
+            return LocalContact{math.NaN(), math.NaN()}
+        }
+        return LocalContact{t, getSunAltitude(t, loc)}
+    }
+    outer := func(t float64) float64 {
+        g := getObserverGeometry(t, loc)
+        return g.getDistance() - g.L1
+    }
+    local.C1 = contact(outer, e.P1, maximum)
+    local.C4 = contact(outer, e.P4, maximum)
+    if m < math.Abs(g.L2) {
+        if g.L2 < 0 {
+            local.Kind = Total
+        } else {
+            local.Kind = Annular
+        }
+        inner := func(t float64) float64 {
+            g := getObserverGeometry(t, loc)
+            return g.getDistance() - math.Abs(g.L2)
+        }
+        local.C2 = contact(inner, local.C1.Time, maximum)
+        local.C3 = contact(inner, local.C4.Time, maximum)
+    } else {
+        local.C2 = LocalContact{math.NaN(), math.NaN()}
+        local.C3 = local.C2
+    }
+    local.Visible = local.C1.Altitude > 0 || local.Maximum.Altitude > 0 || local.C4.Altitude > 0
+    return local
+}
@@ -0,0 +1,171 @@
+package eclipse
+
+import (
+    "coordinates"
+    "math"
+    "mathutil"
+    "moonphase"
+)
+
+/**
+ * 月食，时刻都是儒略日(TT)，没有的食相为NaN。
+ * P1、P4是月亮与半影外切，U1、U4是与本影外切，U2、U3是与本影内切(食既、生光)
+ */
+type LunarEclipse struct {
+    Kind Kind
+    // 食甚，月亮中心离地影轴线最近的时刻
+    Greatest float64
+    // 本影食分，食甚时月亮直径进入本影的比例
+    UmbralMagnitude float64
+    // 半影食分，食甚时月亮直径进入半影的比例
+    PenumbralMagnitude float64
+    // 食甚时月亮中心离地影轴线的距离，单位是地球赤道半径，月亮在轴线以北为正
+    Gamma float64
+    P1 float64
+    U1 float64
+    U2 float64
+    U3 float64
+    U4 float64
+    P4 float64
+}
+
+/**
+ * 地球平均半径与赤道半径之比，用于计算地影大小
+ */
+const EARTH_MEAN_RADIUS_RATIO = 0.998340
+
+/**
+ * 大气使地影扩大的比例，按Danjon方法把地球半径增加1/85，与NASA的月食表一致
+ */
+const SHADOW_ENLARGEMENT = 1 + 1.0 / 85
+
+/**
+ * 某一时刻月亮和地影的几何关系，角度单位是弧度(rad)
+ */
+type shadowGeometry struct {
+    // 月亮中心与地影轴线的角距离，月亮在轴线以北为正
+    distance float64
+    // 本影和半影的角半径
+    umbra, penumbra float64
+    // 月亮的视半径
+    moon float64
+    // 月亮的地平视差
+    parallax float64
+}
+
+/**
+ * 计算月亮和地影的几何关系，参考<i>Explanatory Supplement to the Astronomical Almanac</i>(1992)第8.4节
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 月亮和地影的几何关系
+ */
+func getShadowGeometry(jd float64) *shadowGeometry {
+    sun, rs := getSunEquatorial(jd)
+    moon, rm := getMoonEquatorial(jd)
+    // 地影轴线指向太阳的对点
+    ra := sun.RightAscension + math.Pi
+    dec := -sun.Declination
+    cosDistance := math.Sin(moon.Declination) * math.Sin(dec) +
+        math.Cos(moon.Declination) * math.Cos(dec) * math.Cos(moon.RightAscension - ra)
+    distance := math.Acos(math.Max(-1, math.Min(1, cosDistance)))
+
+    parallax := math.Asin(EARTH_RADIUS / rm)
+    sunParallax := mathutil.SecondsToRadians(SUN_PARALLAX) * coordinates.AU / rs
+    sunRadius := mathutil.SecondsToRadians(SUN_SEMIDIAMETER) * coordinates.AU / rs
+    earth := SHADOW_ENLARGEMENT * EARTH_MEAN_RADIUS_RATIO * parallax
+    return &shadowGeometry{
+        distance: math.Copysign(distance, moon.Declination - dec),
+        umbra: earth + sunParallax - sunRadius,
+        penumbra: earth + sunParallax + sunRadius,
+        moon: math.Asin(MOON_RADIUS_PENUMBRA * EARTH_RADIUS / rm),
+        parallax: parallax,
+    }
+}
+
+/**
+ * 计算离给定时刻最近的望的月食
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 月食，这次望没有月食时返回nil
+ */
+func GetLunarEclipse(jd float64) *LunarEclipse {
+    fullMoon, err := moonphase.FullMoon.Nearest(jd)
+    if err != nil {
+        return nil
+    }
+    greatest, err := findMinimum(func(t float64) float64 {
+        return math.Abs(getShadowGeometry(t).distance)
+    }, fullMoon - searchRange, fullMoon + searchRange)
+    if err != nil {
+        return nil
+    }
+    g := getShadowGeometry(greatest)
+    sigma := math.Abs(g.distance)
+    eclipse := &LunarEclipse{
+        Greatest: greatest,
+        UmbralMagnitude: (g.umbra + g.moon - sigma) / (2 * g.moon),
+        PenumbralMagnitude: (g.penumbra + g.moon - sigma) / (2 * g.moon),
+        Gamma: g.distance / g.parallax,
+    }
+    switch {
+    case eclipse.UmbralMagnitude >= 1:
+        eclipse.Kind = Total
+    case eclipse.UmbralMagnitude > 0:
+        eclipse.Kind = Partial
+    case eclipse.PenumbralMagnitude > 0:
+        eclipse.Kind = Penumbral
+    default:
+        return nil
+    }
+
+    contact := func(radius func(g *shadowGeometry) float64) func(float64) float64 {
+        return func(t float64) float64 {
+            g := getShadowGeometry(t)
+            return math.Abs(g.distance) - radius(g)
+        }
+    }
+    penumbraOuter := contact(func(g *shadowGeometry) float64 {
+        return g.penumbra + g.moon
+    })
+    umbraOuter := contact(func(g *shadowGeometry) float64 {
+        return g.umbra + g.moon
+    })
+    umbraInner := contact(func(g *shadowGeometry) float64 {
+        return g.umbra - g.moon
+    })
+    eclipse.P1 = findContact(penumbraOuter, greatest - searchRange, greatest)
+    eclipse.P4 = findContact(penumbraOuter, greatest + searchRange, greatest)
+    eclipse.U1, eclipse.U2, eclipse.U3, eclipse.U4 = math.NaN(), math.NaN(), math.NaN(), math.NaN()
+    if eclipse.Kind != Penumbral {
+        eclipse.U1 = findContact(umbraOuter, greatest - searchRange, greatest)
+        eclipse.U4 = findContact(umbraOuter, greatest + searchRange, greatest)
+    }
+    if eclipse.Kind == Total {
+        eclipse.U2 = findContact(umbraInner, greatest - searchRange, greatest)
+        eclipse.U3 = findContact(umbraInner, greatest + searchRange, greatest)
+    }
+    return eclipse
+}
+
+/**
+ * 计算一段时间内的所有月食
+ *
+ * @param start
+ *            开始的儒略日(TT)
+ * @param end
+ *            结束的儒略日(TT)
+ * @return 食甚在[start, end)之间的月食，按时间顺序
+ */
+func FindLunarEclipses(start, end float64) []*LunarEclipse {
+    var result []*LunarEclipse
+    // 食甚和望相差不到半天
+    for jd, err := moonphase.FullMoon.After(start - 1); err == nil && jd < end + 1; jd, err = moonphase.FullMoon.After(jd) {
+        eclipse := GetLunarEclipse(jd)
+        if eclipse != nil && eclipse.Greatest >= start && eclipse.Greatest < end {
+            result = append(result, eclipse)
+        }
+    }
+    return result
+}
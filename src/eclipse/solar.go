@@ -0,0 +1,189 @@
+package eclipse
+
+import (
+    "math"
+    "moonphase"
+)
+
+/**
+ * 日食，时刻都是儒略日(TT)，没有的食相为NaN。
+ * 各个食相是对整个地球而言的：P1、P4是半影与地球外切，U1、U4是本影(或伪本影)与地球外切，U2、U3是内切
+ */
+type SolarEclipse struct {
+    Kind Kind
+    // 食甚，影锥轴线离地心最近的时刻
+    Greatest float64
+    // 食分，中心食是食甚点月亮与太阳视直径之比，偏食是食甚时太阳直径被遮住的比例
+    Magnitude float64
+    // 食甚时影锥轴线离地心的距离，单位是地球赤道半径，轴线在地心以北为正
+    Gamma float64
+    P1 float64
+    U1 float64
+    U2 float64
+    U3 float64
+    U4 float64
+    P4 float64
+}
+
+/**
+ * 考虑地球扁率以后中心食的界限，单位是地球赤道半径，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第54章
+ */
+const CENTRAL_LIMIT = 0.9972
+
+/**
+ * 日食的贝塞尔根数，长度单位是地球赤道半径
+ */
+type besselianElements struct {
+    // 影锥轴线的赤经和赤纬
+    a, d float64
+    // 月亮中心在基本平面上的坐标
+    x, y float64
+    // 半影和本影在基本平面上的半径，本影为负表示影锥顶点在基本平面以外
+    l1, l2 float64
+    // 半影和本影锥的半顶角的正切
+    tanF1, tanF2 float64
+}
+
+/**
+ * 由太阳和月亮的地心坐标计算贝塞尔根数，参考<i>Explanatory Supplement to the Astronomical Almanac</i>(1992)第8.3节
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 贝塞尔根数
+ */
+func getBesselianElements(jd float64) *besselianElements {
+    sun, rs := getSunEquatorial(jd)
+    moon, rm := getMoonEquatorial(jd)
+    rm /= EARTH_RADIUS
+    sx, sy, sz := toRectangular(sun, rs / EARTH_RADIUS)
+    mx, my, mz := toRectangular(moon, rm)
+    // 影锥轴线的方向，从月亮指向太阳
+    gx, gy, gz := sx - mx, sy - my, sz - mz
+    g := math.Sqrt(gx * gx + gy * gy + gz * gz)
+    a := math.Atan2(gy, gx)
+    d := math.Asin(gz / g)
+
+    h := moon.RightAscension - a
+    sinD, cosD := math.Sincos(moon.Declination)
+    x := rm * cosD * math.Sin(h)
+    y := rm * (sinD * math.Cos(d) - cosD * math.Sin(d) * math.Cos(h))
+    z := rm * (sinD * math.Sin(d) + cosD * math.Cos(d) * math.Cos(h))
+
+    f1 := math.Asin((SUN_RADIUS + MOON_RADIUS_PENUMBRA) / g)
+    f2 := math.Asin((SUN_RADIUS - MOON_RADIUS_UMBRA) / g)
+    return &besselianElements{
+        a: a,
+        d: d,
+        x: x,
+        y: y,
+        l1: z * math.Tan(f1) + MOON_RADIUS_PENUMBRA / math.Cos(f1),
+        l2: z * math.Tan(f2) - MOON_RADIUS_UMBRA / math.Cos(f2),
+        tanF1: math.Tan(f1),
+        tanF2: math.Tan(f2),
+    }
+}
+
+/**
+ * 影锥轴线离地心的距离
+ */
+func (e *besselianElements) getDistance() float64 {
+    return math.Hypot(e.x, e.y)
+}
+
+/**
+ * 计算离给定时刻最近的朔的日食
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 日食，这次朔没有日食时返回nil
+ */
+func GetSolarEclipse(jd float64) *SolarEclipse {
+    newMoon, err := moonphase.NewMoon.Nearest(jd)
+    if err != nil {
+        return nil
+    }
+    greatest, err := findMinimum(func(t float64) float64 {
+        return getBesselianElements(t).getDistance()
+    }, newMoon - searchRange, newMoon + searchRange)
+    if err != nil {
+        return nil
+    }
+    e := getBesselianElements(greatest)
+    m := e.getDistance()
+    if m >= CENTRAL_LIMIT + e.l1 {
+        return nil
+    }
+
+    eclipse := &SolarEclipse{Greatest: greatest, Gamma: math.Copysign(m, e.y)}
+    switch {
+    case m < CENTRAL_LIMIT:
+        // 中心食，按食甚点(轴线与地面的交点)的影子大小判断
+        zeta := math.Sqrt(1 - m * m)
+        L1 := e.l1 - zeta * e.tanF1
+        L2 := e.l2 - zeta * e.tanF2
+        eclipse.Magnitude = (L1 - L2) / (L1 + L2)
+        if L2 >= 0 {
+            eclipse.Kind = Annular
+        } else if e.l2 > 0 {
+            // 食甚点是全食，中心线两端的本影顶点达不到地面
+            eclipse.Kind = Hybrid
+        } else {
+            eclipse.Kind = Total
+        }
+    case m < CENTRAL_LIMIT + math.Abs(e.l2):
+        // 非中心全食或环食，本影只扫过极区
+        eclipse.Magnitude = (e.l1 - e.l2) / (e.l1 + e.l2)
+        if e.l2 < 0 {
+            eclipse.Kind = Total
+        } else {
+            eclipse.Kind = Annular
+        }
+    default:
+        eclipse.Kind = Partial
+        eclipse.Magnitude = (CENTRAL_LIMIT + e.l1 - m) / (e.l1 + e.l2)
+    }
+
+    outer := func(t float64) float64 {
+        e := getBesselianElements(t)
+        return e.getDistance() - 1 - e.l1
+    }
+    eclipse.P1 = findContact(outer, greatest - searchRange, greatest)
+    eclipse.P4 = findContact(outer, greatest + searchRange, greatest)
+    eclipse.U1, eclipse.U2, eclipse.U3, eclipse.U4 = math.NaN(), math.NaN(), math.NaN(), math.NaN()
+    if eclipse.Kind != Partial {
+        umbraOuter := func(t float64) float64 {
+            e := getBesselianElements(t)
+            return e.getDistance() - 1 - math.Abs(e.l2)
+        }
+        umbraInner := func(t float64) float64 {
+            e := getBesselianElements(t)
+            return e.getDistance() - 1 + math.Abs(e.l2)
+        }
+        eclipse.U1 = findContact(umbraOuter, greatest - searchRange, greatest)
+        eclipse.U2 = findContact(umbraInner, greatest - searchRange, greatest)
+        eclipse.U3 = findContact(umbraInner, greatest + searchRange, greatest)
+        eclipse.U4 = findContact(umbraOuter, greatest + searchRange, greatest)
+    }
+    return eclipse
+}
+
+/**
+ * 计算一段时间内的所有日食
+ *
+ * @param start
+ *            开始的儒略日(TT)
+ * @param end
+ *            结束的儒略日(TT)
+ * @return 食甚在[start, end)之间的日食，按时间顺序
+ */
+func FindSolarEclipses(start, end float64) []*SolarEclipse {
+    var result []*SolarEclipse
+    // 食甚和朔相差不到半天
+    for jd, err := moonphase.NewMoon.After(start - 1); err == nil && jd < end + 1; jd, err = moonphase.NewMoon.After(jd) {
+        eclipse := GetSolarEclipse(jd)
+        if eclipse != nil && eclipse.Greatest >= start && eclipse.Greatest < end {
+            result = append(result, eclipse)
+        }
+    }
+    return result
+}
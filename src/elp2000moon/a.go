@@ -0,0 +1,117 @@
+package elp2000moon
+
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+    "nutation"
+)
+
+/**
+ * 月亮轨道的基本参数，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第47章
+ */
+type moonArguments struct {
+    // 月亮平黄经
+    lp float64
+    // 月日距角
+    d float64
+    // 太阳平近点角
+    m float64
+    // 月亮平近点角
+    mp float64
+    // 月亮纬度参数
+    f float64
+    // 地球轨道离心率修正项
+    e float64
+    // 金星、木星摄动项参数，黄经的摄动已包含在ELP2000-82的级数里，不再需要A2
+    a1, a3 float64
+}
+
+/**
+ * 按儒略日计算月亮轨道的基本参数
+ *
+ * @param jd
+ *            儒略日
+ * @return 月亮轨道的基本参数，角度单位是弧度(rad)
+ */
+func getMoonArguments(jd float64) *moonArguments {
+    t := calendarutil.GetJulianCentury(jd)
+    return &moonArguments{
+        lp: mathutil.ToRadians(218.3164477 + (481267.88123421 + (-0.0015786 + (1.0 / 538841 - t / 65194000) * t) * t) * t),
+        d: mathutil.ToRadians(297.8501921 + (445267.1114034 + (-0.0018819 + (1.0 / 545868 - t / 113065000) * t) * t) * t),
+        m: mathutil.ToRadians(357.5291092 + (35999.0502909 + (-0.0001536 + t / 24490000) * t) * t),
+        mp: mathutil.ToRadians(134.9633964 + (477198.8675055 + (0.0087414 + (1.0 / 69699 - t / 14712000) * t) * t) * t),
+        f: mathutil.ToRadians(93.2720950 + (483202.0175233 + (-0.0036539 + (-1.0 / 3526000 + t / 863310000) * t) * t) * t),
+        e: 1 + (-0.002516 - 0.0000074 * t) * t,
+        a1: mathutil.ToRadians(119.75 + 131.849 * t),
+        a3: mathutil.ToRadians(313.45 + 481266.484 * t),
+    }
+}
+
+/**
+ * 月亮的光行时对黄经的修正，单位是角秒。ELP2000-82给出的是几何位置，月亮的光行时约1.28秒，这段时间里月亮移动约0.70″
+ */
+const LIGHT_TIME_CORRECTION = -0.704
+
+/**
+ * 按儒略日计算月亮的地心黄经(不含章动)，周期项用ELP2000-82的黄经级数，比Meeus的表47.A精确得多
+ *
+ * @param jd
+ *            儒略日
+ * @return 月亮的地心黄经，单位是弧度(rad)
+ */
+func GetEarthMeanEclipticLongitudeForMoon(jd float64) float64 {
+    t := calendarutil.GetJulianCentury(jd)
+    l := ((GetMoonL3(t) * t + GetMoonL2(t)) * t + GetMoonL1(t)) * t + GetMoonL0(t)
+    // 月亮平黄经(J2000平春分点)
+    lp := 3.81034409 + (8399.684730072 + (-3.319e-05 + (3.11e-08 - 2.033e-10 * t) * t) * t) * t
+    // 黄经总岁差
+    l += (5028.792262 + (1.1124406 + (0.00007699 + (-0.000023479 - 0.0000000178 * t) * t) * t) * t) * t
+    if tx := t - 10; tx > 0 {
+        // 3000年以后的修正
+        l += -0.866 + 1.43 * tx + 0.054 * tx * tx
+    }
+    return mathutil.Mod2Pi(lp + mathutil.SecondsToRadians(l + LIGHT_TIME_CORRECTION))
+}
+
+/**
+ * 按儒略日计算月亮的地心视黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 月亮的地心视黄经，单位是弧度(rad)
+ */
+func GetEarthEclipticLongitudeForMoon(jd float64) float64 {
+    l := GetEarthMeanEclipticLongitudeForMoon(jd)
+    // 修正章动
+    l += nutation.GetLongitudeNutation(jd)
+    return mathutil.Mod2Pi(l)
+}
+
+/**
+ * 按儒略日计算月亮的地心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 月亮的地心黄纬，单位是弧度(rad)
+ */
+func GetEarthEclipticLatitudeForMoon(jd float64) float64 {
+    a := getMoonArguments(jd)
+    b := GetMoonB(a.d, a.m, a.mp, a.f, a.e)
+    // 金星、木星及地球扁率的摄动
+    b += -2235 * math.Sin(a.lp) + 382 * math.Sin(a.a3) + 175 * math.Sin(a.a1 - a.f) +
+        175 * math.Sin(a.a1 + a.f) + 127 * math.Sin(a.lp - a.mp) - 115 * math.Sin(a.lp + a.mp)
+    return mathutil.ToRadians(b / 1000000)
+}
+
+/**
+ * 按儒略日计算地月距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 地球中心和月亮中心的距离，单位是千米(km)
+ */
+func GetEarthRadiusForMoon(jd float64) float64 {
+    a := getMoonArguments(jd)
+    return 385000.56 + GetMoonR(a.d, a.m, a.mp, a.f, a.e) / 1000
+}
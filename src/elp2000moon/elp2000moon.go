@@ -0,0 +1,150 @@
+
+/*
+   MOON - ELP2000-82 Truncated Series
+   GEOCENTRIC ECLIPTIC AND MEAN EQUINOX OF THE DATE
+   Spherical (L,B,R) Coordinates
+
+   Truncated by Jean Meeus to the periodic terms of
+   Astronomical Algorithms, 2nd edition (1998), tables 47.A and 47.B
+   Accuracy: about 4 arc sec in latitude
+   Only B and R are kept here, the longitude comes from the
+   longer series in elp2000moon_l.go
+
+   B = Latitude in 0.000001 degrees
+   R = Distance in 0.001 km
+
+   d  = Mean elongation of the Moon
+   m  = Mean anomaly of the Sun
+   mp = Mean anomaly of the Moon
+   f  = Argument of latitude of the Moon
+   e  = Eccentricity factor of the Earth's orbit
+
+   Ref:
+   ELP 2000-82: Lunar Solution
+   M. Chapront-Touze, J. Chapront
+   Astronomy & Astrophysics
+   vol. 124, p50-p62
+   1983
+*/
+
+package elp2000moon
+
+import (
+    "math"
+)
+
+func GetMoonB (d, m, mp, f, e float64) float64 {
+    var result float64 = 0.0
+    result += 5128122 * math.Sin(f)
+    result += 280602 * math.Sin(mp + f)
+    result += 277693 * math.Sin(mp - f)
+    result += 173237 * math.Sin(2 * d - f)
+    result += 55413 * math.Sin(2 * d - mp + f)
+    result += 46271 * math.Sin(2 * d - mp - f)
+    result += 32573 * math.Sin(2 * d + f)
+    result += 17198 * math.Sin(2 * mp + f)
+    result += 9266 * math.Sin(2 * d + mp - f)
+    result += 8822 * math.Sin(2 * mp - f)
+    result += 8216 * e * math.Sin(2 * d - m - f)
+    result += 4324 * math.Sin(2 * d - 2 * mp - f)
+    result += 4200 * math.Sin(2 * d + mp + f)
+    result += -3359 * e * math.Sin(2 * d + m - f)
+    result += 2463 * e * math.Sin(2 * d - m - mp + f)
+    result += 2211 * e * math.Sin(2 * d - m + f)
+    result += 2065 * e * math.Sin(2 * d - m - mp - f)
+    result += -1870 * e * math.Sin(m - mp - f)
+    result += 1828 * math.Sin(4 * d - mp - f)
+    result += -1794 * e * math.Sin(m + f)
+    result += -1749 * math.Sin(3 * f)
+    result += -1565 * e * math.Sin(m - mp + f)
+    result += -1491 * math.Sin(d + f)
+    result += -1475 * e * math.Sin(m + mp + f)
+    result += -1410 * e * math.Sin(m + mp - f)
+    result += -1344 * e * math.Sin(m - f)
+    result += -1335 * math.Sin(d - f)
+    result += 1107 * math.Sin(3 * mp + f)
+    result += 1021 * math.Sin(4 * d - f)
+    result += 833 * math.Sin(4 * d - mp + f)
+    result += 777 * math.Sin(mp - 3 * f)
+    result += 671 * math.Sin(4 * d - 2 * mp + f)
+    result += 607 * math.Sin(2 * d - 3 * f)
+    result += 596 * math.Sin(2 * d + 2 * mp - f)
+    result += 491 * e * math.Sin(2 * d - m + mp - f)
+    result += -451 * math.Sin(2 * d - 2 * mp + f)
+    result += 439 * math.Sin(3 * mp - f)
+    result += 422 * math.Sin(2 * d + 2 * mp + f)
+    result += 421 * math.Sin(2 * d - 3 * mp - f)
+    result += -366 * e * math.Sin(2 * d + m - mp + f)
+    result += -351 * e * math.Sin(2 * d + m + f)
+    result += 331 * math.Sin(4 * d + f)
+    result += 315 * e * math.Sin(2 * d - m + mp + f)
+    result += 302 * e * e * math.Sin(2 * d - 2 * m - f)
+    result += -283 * math.Sin(mp + 3 * f)
+    result += -229 * e * math.Sin(2 * d + m + mp - f)
+    result += 223 * e * math.Sin(d + m - f)
+    result += 223 * e * math.Sin(d + m + f)
+    result += -220 * e * math.Sin(m - 2 * mp - f)
+    result += -220 * e * math.Sin(2 * d + m - mp - f)
+    result += -185 * math.Sin(d + mp + f)
+    result += 181 * e * math.Sin(2 * d - m - 2 * mp - f)
+    result += -177 * e * math.Sin(m + 2 * mp + f)
+    result += 176 * math.Sin(4 * d - 2 * mp - f)
+    result += 166 * e * math.Sin(4 * d - m - mp - f)
+    result += -164 * math.Sin(d + mp - f)
+    result += 132 * math.Sin(4 * d + mp - f)
+    result += -119 * math.Sin(d - mp - f)
+    result += 115 * e * math.Sin(4 * d - m - f)
+    result += 107 * e * e * math.Sin(2 * d - 2 * m + f)
+    return result
+}
+
+func GetMoonR (d, m, mp, f, e float64) float64 {
+    var result float64 = 0.0
+    result += -20905355 * math.Cos(mp)
+    result += -3699111 * math.Cos(2 * d - mp)
+    result += -2955968 * math.Cos(2 * d)
+    result += -569925 * math.Cos(2 * mp)
+    result += 48888 * e * math.Cos(m)
+    result += -3149 * math.Cos(2 * f)
+    result += 246158 * math.Cos(2 * d - 2 * mp)
+    result += -152138 * e * math.Cos(2 * d - m - mp)
+    result += -170733 * math.Cos(2 * d + mp)
+    result += -204586 * e * math.Cos(2 * d - m)
+    result += -129620 * e * math.Cos(m - mp)
+    result += 108743 * math.Cos(d)
+    result += 104755 * e * math.Cos(m + mp)
+    result += 10321 * math.Cos(2 * d - 2 * f)
+    result += 79661 * math.Cos(mp - 2 * f)
+    result += -34782 * math.Cos(4 * d - mp)
+    result += -23210 * math.Cos(3 * mp)
+    result += -21636 * math.Cos(4 * d - 2 * mp)
+    result += 24208 * e * math.Cos(2 * d + m - mp)
+    result += 30824 * e * math.Cos(2 * d + m)
+    result += -8379 * math.Cos(d - mp)
+    result += -16675 * e * math.Cos(d + m)
+    result += -12831 * e * math.Cos(2 * d - m + mp)
+    result += -10445 * math.Cos(2 * d + 2 * mp)
+    result += -11650 * math.Cos(4 * d)
+    result += 14403 * math.Cos(2 * d - 3 * mp)
+    result += -7003 * e * math.Cos(m - 2 * mp)
+    result += 10056 * e * math.Cos(2 * d - m - 2 * mp)
+    result += 6322 * math.Cos(d + mp)
+    result += -9884 * e * e * math.Cos(2 * d - 2 * m)
+    result += 5751 * e * math.Cos(m + 2 * mp)
+    result += -4950 * e * e * math.Cos(2 * d - 2 * m - mp)
+    result += 4130 * math.Cos(2 * d + mp - 2 * f)
+    result += -3958 * e * math.Cos(4 * d - m - mp)
+    result += 3258 * math.Cos(3 * d - mp)
+    result += 2616 * e * math.Cos(2 * d + m + mp)
+    result += -1897 * e * math.Cos(4 * d - m - 2 * mp)
+    result += -2117 * e * e * math.Cos(2 * m - mp)
+    result += 2354 * e * e * math.Cos(2 * d + 2 * m - mp)
+    result += -1423 * math.Cos(4 * d + mp)
+    result += -1117 * math.Cos(4 * mp)
+    result += -1571 * e * math.Cos(4 * d - m)
+    result += -1739 * math.Cos(d - 2 * mp)
+    result += -4421 * math.Cos(2 * mp - 2 * f)
+    result += 1165 * e * e * math.Cos(2 * m + mp)
+    result += 8752 * math.Cos(2 * d - mp - 2 * f)
+    return result
+}
@@ -0,0 +1,739 @@
+/*
+   MOON - ELP2000-82 Longitude Series
+   GEOCENTRIC ECLIPTIC, MEAN EQUINOX OF J2000
+   Periodic terms only, the mean longitude and the precession are added in
+   GetEarthMeanEclipticLongitudeForMoon
+
+   Truncated by Xu Jianwei for the Shouxing Astronomical Calendar (寿星天文历)
+   Accuracy: about 1 arc sec in longitude in the modern era
+
+   Each term is A * cos(B + C*t + D*t^2/1e4 + E*t^3/1e8 + F*t^4/1e8)
+   A = amplitude in arc seconds
+   t = (JD - 2451545) / 36525
+
+   Ref:
+   ELP 2000-82: Lunar Solution
+   M. Chapront-Touze, J. Chapront
+   Astronomy & Astrophysics
+   vol. 124, p50-p62
+   1983
+*/
+
+package elp2000moon
+
+import (
+    "math"
+)
+
+/**
+ * 月亮黄经级数的一项
+ */
+type term struct {
+    a, b, c, d, e, f float64
+}
+
+var moonL = [][]term{
+    // L0, 442 terms
+    {
+        {22639.586, 0.78475822, 8328.691424623, 1.5229241, 25.0719, -0.123598},
+        {4586.438, 0.1873974, 7214.06286536, -2.184756, -18.860, 0.08280},
+        {2369.914, 2.5429520, 15542.75428998, -0.661832, 6.212, -0.04080},
+        {769.026, 3.140313, 16657.38284925, 3.04585, 50.144, -0.2472},
+        {666.418, 1.527671, 628.30195521, -0.02664, 0.062, -0.0054},
+        {411.596, 4.826607, 16866.9323150, -1.28012, -1.07, -0.0059},
+        {211.656, 4.115028, -1114.6285593, -3.70768, -43.93, 0.2064},
+        {205.436, 0.230523, 6585.7609101, -2.15812, -18.92, 0.0882},
+        {191.956, 4.898507, 23871.4457146, 0.86109, 31.28, -0.164},
+        {164.729, 2.586078, 14914.4523348, -0.6352, 6.15, -0.035},
+        {147.321, 5.45530, -7700.3894694, -1.5496, -25.01, 0.118},
+        {124.988, 0.48608, 7771.3771450, -0.3309, 3.11, -0.020},
+        {109.380, 3.88323, 8956.9933798, 1.4963, 25.13, -0.129},
+        {55.177, 5.57033, -1324.1780250, 0.6183, 7.3, -0.035},
+        {45.100, 0.89898, 25195.623740, 0.2428, 24.0, -0.129},
+        {39.533, 3.81213, -8538.240890, 2.8030, 26.1, -0.118},
+        {38.430, 4.30115, 22756.817155, -2.8466, -12.6, 0.042},
+        {36.124, 5.49587, 24986.074274, 4.5688, 75.2, -0.371},
+        {30.773, 1.94559, 14428.125731, -4.3695, -37.7, 0.166},
+        {28.397, 3.28586, 7842.364821, -2.2114, -18.8, 0.077},
+        {24.358, 5.64142, 16171.056245, -0.6885, 6.3, -0.046},
+        {18.585, 4.41371, -557.314280, -1.8538, -22.0, 0.10},
+        {17.954, 3.58454, 8399.679100, -0.3576, 3.2, -0.03},
+        {14.530, 4.9416, 23243.143759, 0.888, 31.2, -0.16},
+        {14.380, 0.9709, 32200.137139, 2.384, 56.4, -0.29},
+        {14.251, 5.7641, -2.301200, 1.523, 25.1, -0.12},
+        {13.899, 0.3735, 31085.508580, -1.324, 12.4, -0.08},
+        {13.194, 1.7595, -9443.319984, -5.231, -69.0, 0.33},
+        {9.679, 3.0997, -16029.080894, -3.072, -50.1, 0.24},
+        {9.366, 0.3016, 24080.995180, -3.465, -19.9, 0.08},
+        {8.606, 4.1582, -1742.930514, -3.681, -44.0, 0.21},
+        {8.453, 2.8416, 16100.068570, 1.192, 28.2, -0.14},
+        {8.050, 2.6292, 14286.150380, -0.609, 6.1, -0.03},
+        {7.630, 6.2388, 17285.684804, 3.019, 50.2, -0.25},
+        {7.447, 1.4845, 1256.603910, -0.053, 0.1, -0.01},
+        {7.371, 0.2736, 5957.458955, -2.131, -19.0, 0.09},
+        {7.063, 5.6715, 33.757047, -0.308, -3.6, 0.02},
+        {6.383, 4.7843, 7004.513400, 2.141, 32.4, -0.16},
+        {5.742, 2.6572, 32409.686605, -1.942, 5, -0.05},
+        {4.374, 4.3443, 22128.51520, -2.820, -13, 0.05},
+        {3.998, 3.2545, 33524.31516, 1.766, 49, -0.25},
+        {3.210, 2.2443, 14985.44001, -2.516, -16, 0.06},
+        {2.915, 1.7138, 24499.74767, 0.834, 31, -0.17},
+        {2.732, 1.9887, 13799.82378, -4.343, -38, 0.17},
+        {2.568, 5.4122, -7072.08751, -1.576, -25, 0.11},
+        {2.521, 3.2427, 8470.66678, -2.238, -19, 0.07},
+        {2.489, 4.0719, -486.32660, -3.734, -44, 0.20},
+        {2.146, 5.6135, -1952.47998, 0.645, 7, -0.03},
+        {1.978, 2.7291, 39414.20000, 0.199, 37, -0.21},
+        {1.934, 1.5682, 33314.76570, 6.092, 100, -0.5},
+        {1.871, 0.4166, 30457.20662, -1.297, 12, -0.1},
+        {1.753, 2.0582, -8886.00570, -3.38, -47, 0.2},
+        {1.437, 2.386, -695.87607, 0.59, 7, 0},
+        {1.373, 3.026, -209.54947, 4.33, 51, -0.2},
+        {1.262, 5.940, 16728.37052, 1.17, 28, -0.1},
+        {1.224, 6.172, 6656.74859, -4.04, -41, 0.2},
+        {1.187, 5.873, 6099.43431, -5.89, -63, 0.3},
+        {1.177, 1.014, 31571.83518, 2.41, 56, -0.3},
+        {1.162, 3.840, 9585.29534, 1.47, 25, -0.1},
+        {1.143, 5.639, 8364.73984, -2.18, -19, 0.1},
+        {1.078, 1.229, 70.98768, -1.88, -22, 0.1},
+        {1.059, 3.326, 40528.82856, 3.91, 81, -0.4},
+        {0.990, 5.013, 40738.37803, -0.42, 30, -0.2},
+        {0.948, 5.687, -17772.01141, -6.75, -94, 0.5},
+        {0.876, 0.298, -0.35232, 0, 0, 0},
+        {0.822, 2.994, 393.02097, 0, 0, 0},
+        {0.788, 1.836, 8326.39022, 3.05, 50, -0.2},
+        {0.752, 4.985, 22614.84180, 0.91, 31, -0.2},
+        {0.740, 2.875, 8330.99262, 0, 0, 0},
+        {0.669, 0.744, -24357.77232, -4.60, -75, 0.4},
+        {0.644, 1.314, 8393.12577, -2.18, -19, 0.1},
+        {0.639, 5.888, 575.33849, 0, 0, 0},
+        {0.635, 1.116, 23385.11911, -2.87, -13, 0},
+        {0.584, 5.197, 24428.75999, 2.71, 53, -0.3},
+        {0.583, 3.513, -9095.55517, 0.95, 4, 0},
+        {0.572, 6.059, 29970.88002, -5.03, -32, 0.1},
+        {0.565, 2.960, 0.32863, 1.52, 25, -0.1},
+        {0.561, 4.001, -17981.56087, -2.43, -43, 0.2},
+        {0.557, 0.529, 7143.07519, -0.30, 3, 0},
+        {0.546, 2.311, 25614.37623, 4.54, 75, -0.4},
+        {0.536, 4.229, 15752.30376, -4.99, -45, 0.2},
+        {0.493, 3.316, -8294.9344, -1.83, -29, 0.1},
+        {0.491, 1.744, 8362.4485, 1.21, 21, -0.1},
+        {0.478, 1.803, -10071.6219, -5.20, -69, 0.3},
+        {0.454, 0.857, 15333.2048, 3.66, 57, -0.3},
+        {0.445, 2.071, 8311.7707, -2.18, -19, 0.1},
+        {0.426, 0.345, 23452.6932, -3.44, -20, 0.1},
+        {0.420, 4.941, 33733.8646, -2.56, -2, 0},
+        {0.413, 1.642, 17495.2343, -1.31, -1, 0},
+        {0.404, 1.458, 23314.1314, -0.99, 9, -0.1},
+        {0.395, 2.132, 38299.5714, -3.51, -6, 0},
+        {0.382, 2.700, 31781.3846, -1.92, 5, 0},
+        {0.375, 4.827, 6376.2114, 2.17, 32, -0.2},
+        {0.361, 3.867, 16833.1753, -0.97, 3, 0},
+        {0.358, 5.044, 15056.4277, -4.40, -38, 0.2},
+        {0.350, 5.157, -8257.7037, -3.40, -47, 0.2},
+        {0.344, 4.233, 157.7344, 0, 0, 0},
+        {0.340, 2.672, 13657.8484, -0.58, 6, 0},
+        {0.329, 5.610, 41853.0066, 3.29, 74, -0.4},
+        {0.325, 5.895, -39.8149, 0, 0, 0},
+        {0.309, 4.387, 21500.2132, -2.79, -13, 0.1},
+        {0.302, 1.278, 786.0419, 0, 0, 0},
+        {0.302, 5.341, -24567.3218, -0.27, -24, 0.1},
+        {0.301, 1.045, 5889.8848, -1.57, -12, 0},
+        {0.294, 4.201, -2371.2325, -3.65, -44, 0.2},
+        {0.293, 3.704, 21642.1886, -6.55, -57, 0.2},
+        {0.290, 4.069, 32828.4391, 2.36, 56, -0.3},
+        {0.289, 3.472, 31713.8105, -1.35, 12, -0.1},
+        {0.285, 5.407, -33.7814, 0.31, 4, 0},
+        {0.283, 5.998, -16.9207, -3.71, -44, 0.2},
+        {0.283, 2.772, 38785.8980, 0.23, 37, -0.2},
+        {0.274, 5.343, 15613.7420, -2.54, -16, 0.1},
+        {0.263, 3.997, 25823.9257, 0.22, 24, -0.1},
+        {0.254, 0.600, 24638.3095, -1.61, 2, 0},
+        {0.253, 1.344, 6447.1991, 0.29, 10, -0.1},
+        {0.250, 0.887, 141.9754, -3.76, -44, 0.2},
+        {0.247, 0.317, 5329.1570, -2.10, -19, 0.1},
+        {0.245, 0.141, 36.0484, -3.71, -44, 0.2},
+        {0.231, 2.287, 14357.1381, -2.49, -16, 0.1},
+        {0.227, 5.158, 2.6298, 0, 0, 0},
+        {0.219, 5.085, 47742.8914, 1.72, 63, -0.3},
+        {0.211, 2.145, 6638.7244, -2.18, -19, 0.1},
+        {0.201, 4.415, 39623.7495, -4.13, -14, 0},
+        {0.194, 2.091, 588.4927, 0, 0, 0},
+        {0.193, 3.057, -15400.7789, -3.10, -50, 0},
+        {0.186, 5.598, 16799.3582, -0.72, 6, 0},
+        {0.185, 3.886, 1150.6770, 0, 0, 0},
+        {0.183, 1.619, 7178.0144, 1.52, 25, 0},
+        {0.181, 2.635, 8328.3391, 1.52, 25, 0},
+        {0.181, 2.077, 8329.0437, 1.52, 25, 0},
+        {0.179, 3.215, -9652.8694, -0.90, -18, 0},
+        {0.176, 1.716, -8815.0180, -5.26, -69, 0},
+        {0.175, 5.673, 550.7553, 0, 0, 0},
+        {0.170, 2.060, 31295.0580, -5.6, -39, 0},
+        {0.167, 1.239, 7211.7617, -0.7, 6, 0},
+        {0.165, 4.499, 14967.4158, -0.7, 6, 0},
+        {0.164, 3.595, 15540.4531, 0.9, 31, 0},
+        {0.164, 4.237, 522.3694, 0, 0, 0},
+        {0.163, 4.633, 15545.0555, -2.2, -19, 0},
+        {0.161, 0.478, 6428.0209, -2.2, -19, 0},
+        {0.158, 2.03, 13171.5218, -4.3, -38, 0},
+        {0.157, 2.28, 7216.3641, -3.7, -44, 0},
+        {0.154, 5.65, 7935.6705, 1.5, 25, 0},
+        {0.152, 0.46, 29828.9047, -1.3, 12, 0},
+        {0.151, 1.19, -0.7113, 0, 0, 0},
+        {0.150, 1.42, 23942.4334, -1.0, 9, 0},
+        {0.144, 2.75, 7753.3529, 1.5, 25, 0},
+        {0.137, 2.08, 7213.7105, -2.2, -19, 0},
+        {0.137, 1.44, 7214.4152, -2.2, -19, 0},
+        {0.136, 4.46, -1185.6162, -1.8, -22, 0},
+        {0.136, 3.03, 8000.1048, -2.2, -19, 0},
+        {0.134, 2.83, 14756.7124, -0.7, 6, 0},
+        {0.131, 5.05, 6821.0419, -2.2, -19, 0},
+        {0.128, 5.99, -17214.6971, -4.9, -72, 0},
+        {0.127, 5.35, 8721.7124, 1.5, 25, 0},
+        {0.126, 4.49, 46628.2629, -2.0, 19, 0},
+        {0.125, 5.94, 7149.6285, 1.5, 25, 0},
+        {0.124, 1.09, 49067.0695, 1.1, 55, 0},
+        {0.121, 2.88, 15471.7666, 1.2, 28, 0},
+        {0.111, 3.92, 41643.4571, 7.6, 125, -1},
+        {0.110, 1.96, 8904.0299, 1.5, 25, 0},
+        {0.106, 3.30, -18.0489, -2.2, -19, 0},
+        {0.105, 2.30, -4.9310, 1.5, 25, 0},
+        {0.104, 2.22, -6.5590, -1.9, -22, 0},
+        {0.101, 1.44, 1884.9059, -0.1, 0, 0},
+        {0.100, 5.92, 5471.1324, -5.9, -63, 0},
+        {0.099, 1.12, 15149.7333, -0.7, 6, 0},
+        {0.096, 4.73, 15508.9972, -0.4, 10, 0},
+        {0.095, 5.18, 7230.9835, 1.5, 25, 0},
+        {0.093, 3.37, 39900.5266, 3.9, 81, 0},
+        {0.092, 2.01, 25057.0619, 2.7, 53, 0},
+        {0.092, 1.21, -79.6298, 0, 0, 0},
+        {0.092, 1.65, -26310.2523, -4.0, -68, 0},
+        {0.091, 1.01, 42062.5561, -1.0, 23, 0},
+        {0.090, 6.10, 29342.5781, -5.0, -32, 0},
+        {0.090, 4.43, 15542.4020, -0.7, 6, 0},
+        {0.090, 3.80, 15543.1066, -0.7, 6, 0},
+        {0.089, 4.15, 6063.3859, -2.2, -19, 0},
+        {0.086, 4.03, 52.9691, 0, 0, 0},
+        {0.085, 0.49, 47952.4409, -2.6, 11, 0},
+        {0.085, 1.60, 7632.8154, 2.1, 32, 0},
+        {0.084, 0.22, 14392.0773, -0.7, 6, 0},
+        {0.083, 6.22, 6028.4466, -4.0, -41, 0},
+        {0.083, 0.63, -7909.9389, 2.8, 26, 0},
+        {0.083, 5.20, -77.5523, 0, 0, 0},
+        {0.082, 2.74, 8786.1467, -2.2, -19, 0},
+        {0.080, 2.43, 9166.5428, -2.8, -26, 0},
+        {0.080, 3.70, -25405.1732, 4.1, 27, 0},
+        {0.078, 5.68, 48857.5200, 5.4, 106, -1},
+        {0.077, 1.85, 8315.5735, -2.2, -19, 0},
+        {0.075, 5.46, -18191.1103, 1.9, 8, 0},
+        {0.075, 1.41, -16238.6304, 1.3, 1, 0},
+        {0.074, 5.06, 40110.0761, -0.4, 30, 0},
+        {0.072, 2.10, 64.4343, -3.7, -44, 0},
+        {0.071, 2.17, 37671.2695, -3.5, -6, 0},
+        {0.069, 1.71, 16693.4313, -0.7, 6, 0},
+        {0.069, 3.33, -26100.7028, -8.3, -119, 1},
+        {0.068, 1.09, 8329.4028, 1.5, 25, 0},
+        {0.068, 3.62, 8327.9801, 1.5, 25, 0},
+        {0.068, 2.41, 16833.1509, -1.0, 3, 0},
+        {0.067, 3.40, 24709.2971, -3.5, -20, 0},
+        {0.067, 1.65, 8346.7156, -0.3, 3, 0},
+        {0.066, 2.61, 22547.2677, 1.5, 39, 0},
+        {0.066, 3.50, 15576.5113, -1.0, 3, 0},
+        {0.065, 5.76, 33037.9886, -2.0, 5, 0},
+        {0.065, 4.58, 8322.1325, -0.3, 3, 0},
+        {0.065, 6.20, 17913.9868, 3.0, 50, 0},
+        {0.065, 1.50, 22685.8295, -1.0, 9, 0},
+        {0.065, 2.37, 7180.3058, -1.9, -15, 0},
+        {0.064, 1.06, 30943.5332, 2.4, 56, 0},
+        {0.064, 1.89, 8288.8765, 1.5, 25, 0},
+        {0.064, 4.70, 6.0335, 0.3, 4, 0},
+        {0.063, 2.83, 8368.5063, 1.5, 25, 0},
+        {0.063, 5.66, -2580.7819, 0.7, 7, 0},
+        {0.062, 3.78, 7056.3285, -2.2, -19, 0},
+        {0.061, 1.49, 8294.9100, 1.8, 29, 0},
+        {0.061, 0.12, -10281.1714, -0.9, -18, 0},
+        {0.061, 3.06, -8362.4729, -1.2, -21, 0},
+        {0.061, 4.43, 8170.9571, 1.5, 25, 0},
+        {0.059, 5.78, -13.1179, -3.7, -44, 0},
+        {0.059, 5.97, 6625.5702, -2.2, -19, 0},
+        {0.058, 5.01, -0.5080, -0.3, 0, 0},
+        {0.058, 2.73, 7161.0938, -2.2, -19, 0},
+        {0.057, 0.19, 7214.0629, -2.2, -19, 0},
+        {0.057, 4.00, 22199.5029, -4.7, -35, 0},
+        {0.057, 5.38, 8119.1420, 5.8, 76, 0},
+        {0.056, 1.07, 7542.6495, 1.5, 25, 0},
+        {0.056, 0.28, 8486.4258, 1.5, 25, 0},
+        {0.054, 4.19, 16655.0816, 4.6, 75, 0},
+        {0.053, 0.72, 7267.0320, -2.2, -19, 0},
+        {0.053, 3.12, 12.6192, 0.6, 7, 0},
+        {0.052, 2.99, -32896.013, -1.8, -49, 0},
+        {0.052, 3.46, 1097.708, 0, 0, 0},
+        {0.051, 5.37, -6443.786, -1.6, -25, 0},
+        {0.051, 1.35, 7789.401, -2.2, -19, 0},
+        {0.051, 5.83, 40042.502, 0.2, 38, 0},
+        {0.051, 3.63, 9114.733, 1.5, 25, 0},
+        {0.050, 1.51, 8504.484, -2.5, -22, 0},
+        {0.050, 5.23, 16659.684, 1.5, 25, 0},
+        {0.050, 1.15, 7247.820, -2.5, -23, 0},
+        {0.047, 0.25, -1290.421, 0.3, 0, 0},
+        {0.047, 4.67, -32686.464, -6.1, -100, 0},
+        {0.047, 3.49, 548.678, 0, 0, 0},
+        {0.047, 2.37, 6663.308, -2.2, -19, 0},
+        {0.046, 0.98, 1572.084, 0, 0, 0},
+        {0.046, 2.04, 14954.262, -0.7, 6, 0},
+        {0.046, 3.72, 6691.693, -2.2, -19, 0},
+        {0.045, 6.19, -235.287, 0, 0, 0},
+        {0.044, 2.96, 32967.001, -0.1, 27, 0},
+        {0.044, 3.82, -1671.943, -5.6, -66, 0},
+        {0.043, 5.82, 1179.063, 0, 0, 0},
+        {0.043, 0.07, 34152.617, 1.7, 49, 0},
+        {0.043, 3.71, 6514.773, -0.3, 0, 0},
+        {0.043, 5.62, 15.732, -2.5, -23, 0},
+        {0.043, 5.80, 8351.233, -2.2, -19, 0},
+        {0.042, 0.27, 7740.199, 1.5, 25, 0},
+        {0.042, 6.14, 15385.020, -0.7, 6, 0},
+        {0.042, 6.13, 7285.051, -4.1, -41, 0},
+        {0.041, 1.27, 32757.451, 4.2, 78, 0},
+        {0.041, 4.46, 8275.722, 1.5, 25, 0},
+        {0.040, 0.23, 8381.661, 1.5, 25, 0},
+        {0.040, 5.87, -766.864, 2.5, 29, 0},
+        {0.040, 1.66, 254.431, 0, 0, 0},
+        {0.040, 0.40, 9027.981, -0.4, 0, 0},
+        {0.040, 2.96, 7777.936, 1.5, 25, 0},
+        {0.039, 4.67, 33943.068, 6.1, 100, 0},
+        {0.039, 3.52, 8326.062, 1.5, 25, 0},
+        {0.039, 3.75, 21013.887, -6.5, -57, 0},
+        {0.039, 5.60, 606.978, 0, 0, 0},
+        {0.039, 1.19, 8331.321, 1.5, 25, 0},
+        {0.039, 2.84, 7211.433, -2.2, -19, 0},
+        {0.038, 0.67, 7216.693, -2.2, -19, 0},
+        {0.038, 6.22, 25161.867, 0.6, 28, 0},
+        {0.038, 4.40, 7806.322, 1.5, 25, 0},
+        {0.038, 4.16, 9179.168, -2.2, -19, 0},
+        {0.037, 4.73, 14991.999, -0.7, 6, 0},
+        {0.036, 0.35, 67.514, -0.6, -7, 0},
+        {0.036, 3.70, 25266.611, -1.6, 0, 0},
+        {0.036, 5.39, 16328.796, -0.7, 6, 0},
+        {0.035, 1.44, 7174.248, -2.2, -19, 0},
+        {0.035, 5.00, 15684.730, -4.4, -38, 0},
+        {0.035, 0.39, -15.419, -2.2, -19, 0},
+        {0.035, 6.07, 15020.385, -0.7, 6, 0},
+        {0.034, 6.01, 7371.797, -2.2, -19, 0},
+        {0.034, 0.96, -16623.626, -3.4, -54, 0},
+        {0.033, 6.24, 9479.368, 1.5, 25, 0},
+        {0.033, 3.21, 23661.896, 5.2, 82, 0},
+        {0.033, 4.06, 8311.418, -2.2, -19, 0},
+        {0.033, 2.40, 1965.105, 0, 0, 0},
+        {0.033, 5.17, 15489.785, -0.7, 6, 0},
+        {0.033, 5.03, 21986.540, 0.9, 31, 0},
+        {0.033, 4.10, 16691.140, 2.7, 46, 0},
+        {0.033, 5.13, 47114.589, 1.7, 63, 0},
+        {0.033, 4.45, 8917.184, 1.5, 25, 0},
+        {0.033, 4.23, 2.078, 0, 0, 0},
+        {0.032, 2.33, 75.251, 1.5, 25, 0},
+        {0.032, 2.10, 7253.878, -2.2, -19, 0},
+        {0.032, 3.11, -0.224, 1.5, 25, 0},
+        {0.032, 4.43, 16640.462, -0.7, 6, 0},
+        {0.032, 5.68, 8328.363, 0, 0, 0},
+        {0.031, 5.32, 8329.020, 3.0, 50, 0},
+        {0.031, 3.70, 16118.093, -0.7, 6, 0},
+        {0.030, 3.67, 16721.817, -0.7, 6, 0},
+        {0.030, 5.27, -1881.492, -1.2, -15, 0},
+        {0.030, 5.72, 8157.839, -2.2, -19, 0},
+        {0.029, 5.73, -18400.313, -6.7, -94, 0},
+        {0.029, 2.76, 16.000, -2.2, -19, 0},
+        {0.029, 1.75, 8879.447, 1.5, 25, 0},
+        {0.029, 0.32, 8851.061, 1.5, 25, 0},
+        {0.029, 0.90, 14704.903, 3.7, 57, 0},
+        {0.028, 2.90, 15595.723, -0.7, 6, 0},
+        {0.028, 5.88, 16864.631, 0.2, 24, 0},
+        {0.028, 0.63, 16869.234, -2.8, -26, 0},
+        {0.028, 4.04, -18609.863, -2.4, -43, 0},
+        {0.027, 5.83, 6727.736, -5.9, -63, 0},
+        {0.027, 6.12, 418.752, 4.3, 51, 0},
+        {0.027, 0.14, 41157.131, 3.9, 81, 0},
+        {0.026, 3.80, 15.542, 0, 0, 0},
+        {0.026, 1.68, 50181.698, 4.8, 99, -1},
+        {0.026, 0.32, 315.469, 0, 0, 0},
+        {0.025, 5.67, 19.188, 0.3, 0, 0},
+        {0.025, 3.16, 62.133, -2.2, -19, 0},
+        {0.025, 3.76, 15502.939, -0.7, 6, 0},
+        {0.025, 4.53, 45999.961, -2.0, 19, 0},
+        {0.024, 3.21, 837.851, -4.4, -51, 0},
+        {0.024, 2.82, 38157.596, 0.3, 37, 0},
+        {0.024, 5.21, 15540.124, -0.7, 6, 0},
+        {0.024, 0.26, 14218.576, 0, 13, 0},
+        {0.024, 3.01, 15545.384, -0.7, 6, 0},
+        {0.024, 1.16, -17424.247, -0.6, -21, 0},
+        {0.023, 2.34, -67.574, 0.6, 7, 0},
+        {0.023, 2.44, 18.024, -1.9, -22, 0},
+        {0.023, 3.70, 469.400, 0, 0, 0},
+        {0.023, 0.72, 7136.511, -2.2, -19, 0},
+        {0.023, 4.50, 15582.569, -0.7, 6, 0},
+        {0.023, 2.80, -16586.395, -4.9, -72, 0},
+        {0.023, 1.51, 80.182, 0, 0, 0},
+        {0.023, 1.09, 5261.583, -1.5, -12, 0},
+        {0.023, 0.56, 54956.954, -0.5, 44, 0},
+        {0.023, 4.01, 8550.860, -2.2, -19, 0},
+        {0.023, 4.46, 38995.448, -4.1, -14, 0},
+        {0.023, 3.82, 2358.126, 0, 0, 0},
+        {0.022, 3.77, 32271.125, 0.5, 34, 0},
+        {0.022, 0.82, 15935.775, -0.7, 6, 0},
+        {0.022, 1.07, 24013.421, -2.9, -13, 0},
+        {0.022, 0.40, 8940.078, -2.2, -19, 0},
+        {0.022, 2.06, 15700.489, -0.7, 6, 0},
+        {0.022, 4.27, 15124.002, -5.0, -45, 0},
+        {0.021, 1.16, 56071.583, 3.2, 88, 0},
+        {0.021, 5.58, 9572.189, -2.2, -19, 0},
+        {0.020, 1.70, -17.273, -3.7, -44, 0},
+        {0.020, 3.05, 214.617, 0, 0, 0},
+        {0.020, 4.41, 8391.048, -2.2, -19, 0},
+        {0.020, 5.95, 23869.145, 2.4, 56, 0},
+        {0.020, 0.42, 40947.927, -4.7, -21, 0},
+        {0.019, 1.39, 5818.897, 0.3, 10, 0},
+        {0.019, 0.71, 23873.747, -0.7, 6, 0},
+        {0.019, 2.81, 7291.615, -2.2, -19, 0},
+        {0.019, 5.09, 8428.018, -2.2, -19, 0},
+        {0.019, 4.14, 6518.187, -1.6, -12, 0},
+        {0.019, 3.85, 21.330, 0, 0, 0},
+        {0.018, 0.66, 14445.046, -0.7, 6, 0},
+        {0.018, 1.65, 0.966, -4.0, -48, 0},
+        {0.018, 5.64, -17143.709, -6.8, -94, 0},
+        {0.018, 6.01, 7736.432, -2.2, -19, 0},
+        {0.018, 2.74, 31153.083, -1.9, 5, 0},
+        {0.018, 4.58, 6116.355, -2.2, -19, 0},
+        {0.018, 2.28, 46.401, 0.3, 0, 0},
+        {0.018, 3.80, 10213.597, 1.4, 25, 0},
+        {0.018, 2.84, 56281.132, -1.1, 36, 0},
+        {0.018, 3.53, 8249.062, 1.5, 25, 0},
+        {0.017, 4.43, 20871.911, -3, -13, 0},
+        {0.017, 4.44, 627.596, 0, 0, 0},
+        {0.017, 1.85, 628.308, 0, 0, 0},
+        {0.017, 1.19, 8408.321, 2, 25, 0},
+        {0.017, 1.95, 7214.056, -2, -19, 0},
+        {0.017, 1.57, 7214.070, -2, -19, 0},
+        {0.017, 1.65, 13870.811, -6, -60, 0},
+        {0.017, 0.30, 22.542, -4, -44, 0},
+        {0.017, 2.62, -119.445, 0, 0, 0},
+        {0.016, 4.87, 5747.909, 2, 32, 0},
+        {0.016, 4.45, 14339.108, -1, 6, 0},
+        {0.016, 1.83, 41366.680, 0, 30, 0},
+        {0.016, 4.53, 16309.618, -3, -23, 0},
+        {0.016, 2.54, 15542.754, -1, 6, 0},
+        {0.016, 6.05, 1203.646, 0, 0, 0},
+        {0.015, 5.2, 2751.147, 0, 0, 0},
+        {0.015, 1.8, -10699.924, -5, -69, 0},
+        {0.015, 0.4, 22824.391, -3, -20, 0},
+        {0.015, 2.1, 30666.756, -6, -39, 0},
+        {0.015, 2.1, 6010.417, -2, -19, 0},
+        {0.015, 0.7, -23729.470, -5, -75, 0},
+        {0.015, 1.4, 14363.691, -1, 6, 0},
+        {0.015, 5.8, 16900.689, -2, 0, 0},
+        {0.015, 5.2, 23800.458, 3, 53, 0},
+        {0.015, 5.3, 6035.000, -2, -19, 0},
+        {0.015, 1.2, 8251.139, 2, 25, 0},
+        {0.015, 3.6, -8.860, 0, 0, 0},
+        {0.015, 0.8, 882.739, 0, 0, 0},
+        {0.015, 3.0, 1021.329, 0, 0, 0},
+        {0.015, 0.6, 23296.107, 1, 31, 0},
+        {0.014, 5.4, 7227.181, 2, 25, 0},
+        {0.014, 0.1, 7213.352, -2, -19, 0},
+        {0.014, 4.0, 15506.706, 3, 50, 0},
+        {0.014, 3.4, 7214.774, -2, -19, 0},
+        {0.014, 4.6, 6665.385, -2, -19, 0},
+        {0.014, 0.1, -8.636, -2, -22, 0},
+        {0.014, 3.1, 15465.202, -1, 6, 0},
+        {0.014, 4.9, 508.863, 0, 0, 0},
+        {0.014, 3.5, 8406.244, 2, 25, 0},
+        {0.014, 1.3, 13313.497, -8, -82, 0},
+        {0.014, 2.8, 49276.619, -3, 0, 0},
+        {0.014, 0.1, 30528.194, -3, -10, 0},
+        {0.013, 1.7, 25128.050, 1, 31, 0},
+        {0.013, 2.9, 14128.405, -1, 6, 0},
+        {0.013, 3.4, 57395.761, 3, 80, 0},
+        {0.013, 2.7, 13029.546, -1, 6, 0},
+        {0.013, 3.9, 7802.556, -2, -19, 0},
+        {0.013, 1.6, 8258.802, -2, -19, 0},
+        {0.013, 2.2, 8417.709, -2, -19, 0},
+        {0.013, 0.7, 9965.210, -2, -19, 0},
+        {0.013, 3.4, 50391.247, 0, 48, 0},
+        {0.013, 3.0, 7134.433, -2, -19, 0},
+        {0.013, 2.9, 30599.182, -5, -31, 0},
+        {0.013, 3.6, -9723.857, 1, 0, 0},
+        {0.013, 4.8, 7607.084, -2, -19, 0},
+        {0.012, 0.8, 23837.689, 1, 35, 0},
+        {0.012, 3.6, 4.409, -4, -44, 0},
+        {0.012, 5.0, 16657.031, 3, 50, 0},
+        {0.012, 4.4, 16657.735, 3, 50, 0},
+        {0.012, 1.1, 15578.803, -4, -38, 0},
+        {0.012, 6.0, -11.490, 0, 0, 0},
+        {0.012, 1.9, 8164.398, 0, 0, 0},
+        {0.012, 2.4, 31852.372, -4, -17, 0},
+        {0.012, 2.4, 6607.085, -2, -19, 0},
+        {0.012, 4.2, 8359.870, 0, 0, 0},
+        {0.012, 0.5, 5799.713, -2, -19, 0},
+        {0.012, 2.7, 7220.622, 0, 0, 0},
+        {0.012, 4.3, -139.720, 0, 0, 0},
+        {0.012, 2.3, 13728.836, -2, -16, 0},
+        {0.011, 3.6, 14912.146, 1, 31, 0},
+        {0.011, 4.7, 14916.748, -2, -19, 0},
+    },
+    // L1, 149 terms
+    {
+        {1.67680, 4.66926, 628.301955, -0.0266, 0.1, -0.005},
+        {0.51642, 3.3721, 6585.760910, -2.158, -18.9, 0.09},
+        {0.41383, 5.7277, 14914.452335, -0.635, 6.2, -0.04},
+        {0.37115, 3.9695, 7700.389469, 1.550, 25.0, -0.12},
+        {0.27560, 0.7416, 8956.993380, 1.496, 25.1, -0.13},
+        {0.24599, 4.2253, -2.301200, 1.523, 25.1, -0.12},
+        {0.07118, 0.1443, 7842.36482, -2.211, -19, 0.08},
+        {0.06128, 2.4998, 16171.05625, -0.688, 6, 0},
+        {0.04516, 0.443, 8399.67910, -0.36, 3, 0},
+        {0.04048, 5.771, 14286.15038, -0.61, 6, 0},
+        {0.03747, 4.626, 1256.60391, -0.05, 0, 0},
+        {0.03707, 3.415, 5957.45895, -2.13, -19, 0.1},
+        {0.03649, 1.800, 23243.14376, 0.89, 31, -0.2},
+        {0.02438, 0.042, 16029.08089, 3.07, 50, -0.2},
+        {0.02165, 1.017, -1742.93051, -3.68, -44, 0.2},
+        {0.01923, 3.097, 17285.68480, 3.02, 50, -0.3},
+        {0.01692, 1.280, 0.3286, 1.52, 25, -0.1},
+        {0.01361, 0.298, 8326.3902, 3.05, 50, -0.2},
+        {0.01293, 4.013, 7072.0875, 1.58, 25, -0.1},
+        {0.01276, 4.413, 8330.9926, 0, 0, 0},
+        {0.01270, 0.101, 8470.6668, -2.24, -19, 0.1},
+        {0.01097, 1.203, 22128.5152, -2.82, -13, 0},
+        {0.01088, 2.545, 15542.7543, -0.66, 6, 0},
+        {0.00835, 0.190, 7214.0629, -2.18, -19, 0.1},
+        {0.00734, 4.855, 24499.7477, 0.83, 31, -0.2},
+        {0.00686, 5.130, 13799.8238, -4.34, -38, 0.2},
+        {0.00631, 0.930, -486.3266, -3.73, -44, 0},
+        {0.00585, 0.699, 9585.2953, 1.5, 25, 0},
+        {0.00566, 4.073, 8328.3391, 1.5, 25, 0},
+        {0.00566, 0.638, 8329.0437, 1.5, 25, 0},
+        {0.00539, 2.472, -1952.4800, 0.6, 7, 0},
+        {0.00509, 2.88, -0.7113, 0, 0, 0},
+        {0.00469, 3.56, 30457.2066, -1.3, 12, 0},
+        {0.00387, 0.78, -0.3523, 0, 0, 0},
+        {0.00378, 1.84, 22614.8418, 0.9, 31, 0},
+        {0.00362, 5.53, -695.8761, 0.6, 7, 0},
+        {0.00317, 2.80, 16728.3705, 1.2, 28, 0},
+        {0.00303, 6.07, 157.7344, 0, 0, 0},
+        {0.00300, 2.53, 33.7570, -0.3, -4, 0},
+        {0.00295, 4.16, 31571.8352, 2.4, 56, 0},
+        {0.00289, 5.98, 7211.7617, -0.7, 6, 0},
+        {0.00285, 2.06, 15540.4531, 0.9, 31, 0},
+        {0.00283, 2.65, 2.6298, 0, 0, 0},
+        {0.00282, 6.17, 15545.0555, -2.2, -19, 0},
+        {0.00278, 1.23, -39.8149, 0, 0, 0},
+        {0.00272, 3.82, 7216.3641, -3.7, -44, 0},
+        {0.00270, 4.37, 70.9877, -1.9, -22, 0},
+        {0.00256, 5.81, 13657.8484, -0.6, 6, 0},
+        {0.00244, 5.64, -0.2237, 1.5, 25, 0},
+        {0.00240, 2.96, 8311.7707, -2.2, -19, 0},
+        {0.00239, 0.87, -33.7814, 0.3, 4, 0},
+        {0.00216, 2.31, 15.9995, -2.2, -19, 0},
+        {0.00186, 3.46, 5329.1570, -2.1, -19, 0},
+        {0.00169, 2.40, 24357.772, 4.6, 75, 0},
+        {0.00161, 5.80, 8329.403, 1.5, 25, 0},
+        {0.00161, 5.20, 8327.980, 1.5, 25, 0},
+        {0.00160, 4.26, 23385.119, -2.9, -13, 0},
+        {0.00156, 1.26, 550.755, 0, 0, 0},
+        {0.00155, 1.25, 21500.213, -2.8, -13, 0},
+        {0.00152, 0.60, -16.921, -3.7, -44, 0},
+        {0.00150, 2.71, -79.630, 0, 0, 0},
+        {0.00150, 5.29, 15.542, 0, 0, 0},
+        {0.00148, 1.06, -2371.232, -3.7, -44, 0},
+        {0.00141, 0.77, 8328.691, 1.5, 25, 0},
+        {0.00141, 3.67, 7143.075, -0.3, 0, 0},
+        {0.00138, 5.45, 25614.376, 4.5, 75, 0},
+        {0.00129, 4.90, 23871.446, 0.9, 31, 0},
+        {0.00126, 4.03, 141.975, -3.8, -44, 0},
+        {0.00124, 6.01, 522.369, 0, 0, 0},
+        {0.00120, 4.94, -10071.622, -5.2, -69, 0},
+        {0.00118, 5.07, -15.419, -2.2, -19, 0},
+        {0.00107, 3.49, 23452.693, -3.4, -20, 0},
+        {0.00104, 4.78, 17495.234, -1.3, 0, 0},
+        {0.00103, 1.44, -18.049, -2.2, -19, 0},
+        {0.00102, 5.63, 15542.402, -0.7, 6, 0},
+        {0.00102, 2.59, 15543.107, -0.7, 6, 0},
+        {0.00100, 4.11, -6.559, -1.9, -22, 0},
+        {0.00097, 0.08, 15400.779, 3.1, 50, 0},
+        {0.00096, 5.84, 31781.385, -1.9, 5, 0},
+        {0.00094, 1.08, 8328.363, 0, 0, 0},
+        {0.00094, 2.46, 16799.358, -0.7, 6, 0},
+        {0.00094, 1.69, 6376.211, 2.2, 32, 0},
+        {0.00093, 3.64, 8329.020, 3.0, 50, 0},
+        {0.00093, 2.65, 16655.082, 4.6, 75, 0},
+        {0.00090, 1.90, 15056.428, -4.4, -38, 0},
+        {0.00089, 1.59, 52.969, 0, 0, 0},
+        {0.00088, 2.02, -8257.704, -3.4, -47, 0},
+        {0.00088, 3.02, 7213.711, -2.2, -19, 0},
+        {0.00087, 0.50, 7214.415, -2.2, -19, 0},
+        {0.00087, 0.49, 16659.684, 1.5, 25, 0},
+        {0.00082, 5.64, -4.931, 1.5, 25, 0},
+        {0.00079, 5.17, 13171.522, -4.3, -38, 0},
+        {0.00076, 3.60, 29828.905, -1.3, 12, 0},
+        {0.00076, 4.08, 24567.322, 0.3, 24, 0},
+        {0.00076, 4.58, 1884.906, -0.1, 0, 0},
+        {0.00073, 0.33, 31713.811, -1.4, 12, 0},
+        {0.00073, 0.93, 32828.439, 2.4, 56, 0},
+        {0.00071, 5.91, 38785.898, 0.2, 37, 0},
+        {0.00069, 2.20, 15613.742, -2.5, -16, 0},
+        {0.00066, 3.87, 15.732, -2.5, -23, 0},
+        {0.00066, 0.86, 25823.926, 0.2, 24, 0},
+        {0.00065, 2.52, 8170.957, 1.5, 25, 0},
+        {0.00063, 0.18, 8322.132, -0.3, 0, 0},
+        {0.00060, 5.84, 8326.062, 1.5, 25, 0},
+        {0.00060, 5.15, 8331.321, 1.5, 25, 0},
+        {0.00060, 2.18, 8486.426, 1.5, 25, 0},
+        {0.00058, 2.30, -1.731, -4, -44, 0},
+        {0.00058, 5.43, 14357.138, -2, -16, 0},
+        {0.00057, 3.09, 8294.910, 2, 29, 0},
+        {0.00057, 4.67, -8362.473, -1, -21, 0},
+        {0.00056, 4.15, 16833.151, -1, 0, 0},
+        {0.00054, 1.93, 7056.329, -2, -19, 0},
+        {0.00054, 5.27, 8315.574, -2, -19, 0},
+        {0.00052, 5.6, 8311.418, -2, -19, 0},
+        {0.00052, 2.7, -77.552, 0, 0, 0},
+        {0.00051, 4.3, 7230.984, 2, 25, 0},
+        {0.00050, 0.4, -0.508, 0, 0, 0},
+        {0.00049, 5.4, 7211.433, -2, -19, 0},
+        {0.00049, 4.4, 7216.693, -2, -19, 0},
+        {0.00049, 4.3, 16864.631, 0, 24, 0},
+        {0.00049, 2.2, 16869.234, -3, -26, 0},
+        {0.00047, 6.1, 627.596, 0, 0, 0},
+        {0.00047, 5.0, 12.619, 1, 7, 0},
+        {0.00045, 4.9, -8815.018, -5, -69, 0},
+        {0.00044, 1.6, 62.133, -2, -19, 0},
+        {0.00042, 2.9, -13.118, -4, -44, 0},
+        {0.00042, 4.1, -119.445, 0, 0, 0},
+        {0.00041, 4.3, 22756.817, -3, -13, 0},
+        {0.00041, 3.6, 8288.877, 2, 25, 0},
+        {0.00040, 0.5, 6663.308, -2, -19, 0},
+        {0.00040, 1.1, 8368.506, 2, 25, 0},
+        {0.00039, 4.1, 6443.786, 2, 25, 0},
+        {0.00039, 3.1, 16657.383, 3, 50, 0},
+        {0.00038, 0.1, 16657.031, 3, 50, 0},
+        {0.00038, 3.0, 16657.735, 3, 50, 0},
+        {0.00038, 4.6, 23942.433, -1, 9, 0},
+        {0.00037, 4.3, 15385.020, -1, 6, 0},
+        {0.00037, 5.0, 548.678, 0, 0, 0},
+        {0.00036, 1.8, 7213.352, -2, -19, 0},
+        {0.00036, 1.7, 7214.774, -2, -19, 0},
+        {0.00035, 1.1, 7777.936, 2, 25, 0},
+        {0.00035, 1.6, -8.860, 0, 0, 0},
+        {0.00035, 4.4, 23869.145, 2, 56, 0},
+        {0.00035, 2.0, 6691.693, -2, -19, 0},
+        {0.00034, 1.3, -1185.616, -2, -22, 0},
+        {0.00034, 2.2, 23873.747, -1, 6, 0},
+        {0.00033, 2.0, -235.287, 0, 0, 0},
+        {0.00033, 3.1, 17913.987, 3, 50, 0},
+        {0.00033, 1.0, 8351.233, -2, -19, 0},
+    },
+    // L2, 34 terms
+    {
+        {0.004870, 4.6693, 628.30196, -0.027, 0, -0.01},
+        {0.002280, 2.6746, -2.30120, 1.523, 25, -0.12},
+        {0.001500, 3.372, 6585.76091, -2.16, -19, 0.1},
+        {0.001200, 5.728, 14914.45233, -0.64, 6, 0},
+        {0.001080, 3.969, 7700.38947, 1.55, 25, -0.1},
+        {0.000800, 0.742, 8956.99338, 1.50, 25, -0.1},
+        {0.000254, 6.002, 0.3286, 1.52, 25, -0.1},
+        {0.000210, 0.144, 7842.3648, -2.21, -19, 0},
+        {0.000180, 2.500, 16171.0562, -0.7, 6, 0},
+        {0.000130, 0.44, 8399.6791, -0.4, 3, 0},
+        {0.000126, 5.03, 8326.3902, 3.0, 50, 0},
+        {0.000120, 5.77, 14286.1504, -0.6, 6, 0},
+        {0.000118, 5.96, 8330.9926, 0, 0, 0},
+        {0.000110, 1.80, 23243.1438, 0.9, 31, 0},
+        {0.000110, 3.42, 5957.4590, -2.1, -19, 0},
+        {0.000110, 4.63, 1256.6039, -0.1, 0, 0},
+        {0.000099, 4.70, -0.7113, 0, 0, 0},
+        {0.000070, 0.04, 16029.0809, 3.1, 50, 0},
+        {0.000070, 5.14, 8328.3391, 1.5, 25, 0},
+        {0.000070, 5.85, 8329.0437, 1.5, 25, 0},
+        {0.000060, 1.02, -1742.9305, -3.7, -44, 0},
+        {0.000060, 3.10, 17285.6848, 3.0, 50, 0},
+        {0.000054, 5.69, -0.352, 0, 0, 0},
+        {0.000043, 0.52, 15.542, 0, 0, 0},
+        {0.000041, 2.03, 2.630, 0, 0, 0},
+        {0.000040, 0.10, 8470.667, -2.2, -19, 0},
+        {0.000040, 4.01, 7072.088, 1.6, 25, 0},
+        {0.000036, 2.93, -8.860, -0.3, 0, 0},
+        {0.000030, 1.20, 22128.515, -2.8, -13, 0},
+        {0.000030, 2.54, 15542.754, -0.7, 6, 0},
+        {0.000027, 4.43, 7211.762, -0.7, 6, 0},
+        {0.000026, 0.51, 15540.453, 0.9, 31, 0},
+        {0.000026, 1.44, 15545.055, -2.2, -19, 0},
+        {0.000025, 5.37, 7216.364, -3.7, -44, 0},
+    },
+    // L3, 2 terms
+    {
+        {0.00001200, 1.041, -2.3012, 1.52, 25, -0.1},
+        {0.00000170, 0.31, -0.711, 0, 0, 0},
+    },
+}
+
+/**
+ * 计算黄经级数中t的某一次幂的系数
+ *
+ * @param series
+ *            级数
+ * @param t
+ *            儒略世纪数
+ * @return 系数，单位是角秒
+ */
+func evaluate(series []term, t float64) float64 {
+    t2 := t * t / 1e4
+    t3 := t * t * t / 1e8
+    t4 := t * t * t * t / 1e8
+    var result float64 = 0.0
+    for _, v := range series {
+        result += v.a * math.Cos(v.b + v.c * t + v.d * t2 + v.e * t3 + v.f * t4)
+    }
+    return result
+}
+
+/**
+ * 计算月亮黄经周期项中t^0的系数
+ *
+ * @param t
+ *            儒略世纪数
+ * @return 系数，单位是角秒
+ */
+func GetMoonL0(t float64) float64 {
+    return evaluate(moonL[0], t)
+}
+
+/**
+ * 计算月亮黄经周期项中t^1的系数
+ *
+ * @param t
+ *            儒略世纪数
+ * @return 系数，单位是角秒
+ */
+func GetMoonL1(t float64) float64 {
+    return evaluate(moonL[1], t)
+}
+
+/**
+ * 计算月亮黄经周期项中t^2的系数
+ *
+ * @param t
+ *            儒略世纪数
+ * @return 系数，单位是角秒
+ */
+func GetMoonL2(t float64) float64 {
+    return evaluate(moonL[2], t)
+}
+
+/**
+ * 计算月亮黄经周期项中t^3的系数
+ *
+ * @param t
+ *            儒略世纪数
+ * @return 系数，单位是角秒
+ */
+func GetMoonL3(t float64) float64 {
+    return evaluate(moonL[3], t)
+}
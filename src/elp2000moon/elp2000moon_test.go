@@ -0,0 +1,93 @@
+package elp2000moon
+
+import (
+    "math"
+    "mathutil"
+    "testing"
+)
+
+// Jean Meeus, Astronomical Algorithms 例47.a，1992年4月12日0h TD
+// λ = 133.162655°，β = -3.229126°，Δ = 368409.7 km，视黄经 = 133.167265°
+const meeusJD = 2448724.5
+
+func Test_GetMoonXX(t *testing.T) {
+    a := getMoonArguments(meeusJD)
+    B := GetMoonB(a.d, a.m, a.mp, a.f, a.e)
+    R := GetMoonR(a.d, a.m, a.mp, a.f, a.e)
+    // 加上金星、木星及地球扁率的摄动
+    B += -2235 * math.Sin(a.lp) + 382 * math.Sin(a.a3) + 175 * math.Sin(a.a1 - a.f) +
+        175 * math.Sin(a.a1 + a.f) + 127 * math.Sin(a.lp - a.mp) - 115 * math.Sin(a.lp + a.mp)
+    t.Log(B, R)
+    // Meeus例47.a，Σb = -3229126，Σr = -16590875
+    if math.Abs(B + 3229126) < 1 && math.Abs(R + 16590875) < 1 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetEarthMeanEclipticLongitudeForMoon(t *testing.T) {
+    l := GetEarthMeanEclipticLongitudeForMoon(meeusJD)
+    t.Log(l)
+    // Meeus例47.a用的是表47.A的截断级数，与完整的ELP2000-82相差约2″
+    if math.Abs(l - mathutil.ToRadians(133.162655)) < mathutil.SecondsToRadians(2) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetEarthEclipticLongitudeForMoon(t *testing.T) {
+    l := GetEarthEclipticLongitudeForMoon(meeusJD)
+    dpsi := l - GetEarthMeanEclipticLongitudeForMoon(meeusJD)
+    t.Log(l, dpsi)
+    // 这里用的是IAU 1980完整的章动序列，∆ψ与Meeus的16.595″相差不到0.001″
+    if math.Abs(dpsi - mathutil.SecondsToRadians(16.595)) < mathutil.SecondsToRadians(0.005) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetEarthEclipticLatitudeForMoon(t *testing.T) {
+    b := GetEarthEclipticLatitudeForMoon(meeusJD)
+    t.Log(b)
+    if math.Abs(b - mathutil.ToRadians(-3.229126)) < mathutil.ToRadians(0.000001) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetEarthRadiusForMoon(t *testing.T) {
+    r := GetEarthRadiusForMoon(meeusJD)
+    t.Log(r)
+    if math.Abs(r - 368409.7) < 0.1 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetMoonMeanAscendingNode(t *testing.T) {
+    node := GetMoonMeanAscendingNode(meeusJD)
+    t.Log(mathutil.ToDegrees(node))
+    // Meeus例53.a，Ω = 274.400656°
+    if math.Abs(node - mathutil.ToRadians(274.400656)) < mathutil.ToRadians(0.000001) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetOpticalLibration(t *testing.T) {
+    l, b := GetOpticalLibration(meeusJD)
+    t.Log(mathutil.ToDegrees(l), mathutil.ToDegrees(b))
+    // Meeus例53.a，l′ = -1.206°，b′ = +4.194°
+    if math.Abs(l - mathutil.ToRadians(-1.206)) < mathutil.ToRadians(0.001) &&
+        math.Abs(b - mathutil.ToRadians(4.194)) < mathutil.ToRadians(0.001) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
@@ -0,0 +1,46 @@
+package elp2000moon
+
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+)
+
+/**
+ * 月球平赤道面与黄道面的交角，单位是度
+ */
+const MOON_EQUATOR_INCLINATION = 1.54242
+
+/**
+ * 按儒略日计算月亮轨道平升交点的黄经，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第47章
+ *
+ * @param jd
+ *            儒略日
+ * @return 平升交点黄经，单位是弧度(rad)
+ */
+func GetMoonMeanAscendingNode(jd float64) float64 {
+    t := calendarutil.GetJulianCentury(jd)
+    return mathutil.Mod2Pi(mathutil.ToRadians(125.0445479 + (-1934.1362891 + (0.0020754 + (1.0 / 467441 - t / 60616000) * t) * t) * t))
+}
+
+/**
+ * 按儒略日计算月亮的光学天平动，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第53章。
+ * 物理天平动不超过0.04°，这里不计算
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 经度天平动和纬度天平动，单位是弧度(rad)，经度天平动在[-π, π]之间
+ */
+func GetOpticalLibration(jd float64) (float64, float64) {
+    a := getMoonArguments(jd)
+    // 用不含章动的黄经，即λ - ∆ψ
+    w := GetEarthMeanEclipticLongitudeForMoon(jd) - GetMoonMeanAscendingNode(jd)
+    b := GetEarthEclipticLatitudeForMoon(jd)
+    i := mathutil.ToRadians(MOON_EQUATOR_INCLINATION)
+    sinW, cosW := math.Sincos(w)
+    sinB, cosB := math.Sincos(b)
+    sinI, cosI := math.Sincos(i)
+    A := math.Atan2(sinW * cosB * cosI - sinB * sinI, cosW * cosB)
+    l := mathutil.ModPi(A - a.f)
+    return l, math.Asin(-sinW * cosB * sinI - sinB * cosI)
+}
@@ -0,0 +1,180 @@
+package ganzhi
+
+import (
+    "calendarutil"
+    "lunar"
+    "solar_terms"
+    "time"
+)
+
+/**
+ * 天干
+ */
+var Stems = []string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+
+/**
+ * 地支
+ */
+var Branches = []string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+
+/**
+ * 干支，Stem是天干序号(0-9)，Branch是地支序号(0-11)
+ */
+type GanZhi struct {
+    Stem int
+    Branch int
+}
+
+/**
+ * 由六十甲子的序号得到干支
+ *
+ * @param index
+ *            序号，甲子为0
+ * @return 干支
+ */
+func FromIndex(index int) GanZhi {
+    index = mod(index, 60)
+    return GanZhi{index % 10, index % 12}
+}
+
+/**
+ * 六十甲子中的序号，甲子为0，癸亥为59
+ */
+func (g GanZhi) Index() int {
+    return mod(6 * g.Stem - 5 * g.Branch, 60)
+}
+
+func (g GanZhi) String() string {
+    return Stems[g.Stem] + Branches[g.Branch]
+}
+
+func mod(a, b int) int {
+    return (a % b + b) % b
+}
+
+/**
+ * 换年的时刻
+ */
+type YearBoundary int
+
+const (
+    // 以立春换年，用于八字
+    LiChunBoundary YearBoundary = iota
+    // 以农历正月初一换年
+    SpringFestivalBoundary
+)
+
+/**
+ * 北京时间
+ */
+var beijing = time.FixedZone("CST", 8 * 3600)
+
+/**
+ * 按月份顺序排列的十二节，从小寒(丑月)开始
+ */
+var sectionalTerms = getSectionalTerms()
+
+func getSectionalTerms() []*solarterms.SolarTerm {
+    result := []*solarterms.SolarTerm{solarterms.XiaoHan}
+    for term := solarterms.XiaoHan.Next(); term != solarterms.XiaoHan; term = term.Next() {
+        if term.Kind == solarterms.Sectional {
+            result = append(result, term)
+        }
+    }
+    return result
+}
+
+/**
+ * 计算以立春换年的年份，求解立春时刻失败时返回错误
+ */
+func getLiChunYear(t time.Time) (int, error) {
+    year := t.UTC().Year()
+    liChun, err := solarterms.LiChun.Time(year)
+    if err != nil {
+        return 0, err
+    }
+    if t.Before(liChun) {
+        year--
+    }
+    return year, nil
+}
+
+/**
+ * 计算年干支，1984年为甲子年
+ *
+ * @param t
+ *            时刻
+ * @param boundary
+ *            以立春还是以正月初一换年
+ * @return 年干支，以正月初一换年而日期超出农历的范围或者求解立春时刻失败时返回错误
+ */
+func GetYear(t time.Time, boundary YearBoundary) (GanZhi, error) {
+    var year int
+    if boundary == LiChunBoundary {
+        var err error
+        if year, err = getLiChunYear(t); err != nil {
+            return GanZhi{}, err
+        }
+    } else {
+        y, m, d := t.In(beijing).Date()
+        date, err := lunar.FromGregorian(y, int(m), d)
+        if err != nil {
+            return GanZhi{}, err
+        }
+        year = date.Year
+    }
+    return FromIndex(year - 1984), nil
+}
+
+/**
+ * 计算月干支，以十二节换月，寅月从立春开始。月干由年干按“五虎遁”推算
+ *
+ * @param t
+ *            时刻
+ * @return 月干支，求解节气时刻失败时返回错误
+ */
+func GetMonth(t time.Time) (GanZhi, error) {
+    year := t.UTC().Year()
+    // 从寅月开始数的月数，小寒以前是上一年的子月
+    month := -2
+    for i := len(sectionalTerms) - 1; i >= 0; i-- {
+        start, err := sectionalTerms[i].Time(year)
+        if err != nil {
+            return GanZhi{}, err
+        }
+        if !t.Before(start) {
+            month = i - 1
+            break
+        }
+    }
+    // 每年12个月，1984年寅月为丙寅(序号2)
+    return FromIndex((year - 1984) * 12 + month + 2), nil
+}
+
+/**
+ * 计算日干支，2000年1月1日(儒略日数2451545)为戊午日
+ *
+ * @param jdn
+ *            儒略日数
+ * @return 日干支
+ */
+func GetDay(jdn int) GanZhi {
+    return FromIndex(jdn + 49)
+}
+
+/**
+ * 计算时辰的干支，23点以后为第二天的子时。时干由日干按“五鼠遁”推算
+ *
+ * @param t
+ *            时刻，按t所在时区的钟表时间计算，time.Time的日期是Gregorian历法(1582年以前也是)
+ * @return 时干支
+ */
+func GetHour(t time.Time) GanZhi {
+    if t.Hour() == 23 {
+        t = t.Add(time.Hour)
+    }
+    y, m, d := t.Date()
+    day := GetDay(calendarutil.ToJulianDateInGregorian(y, int(m), d))
+    branch := (t.Hour() + 1) / 2 % 12
+    return GanZhi{(day.Stem % 5 * 2 + branch) % 10, branch}
+}
@@ -0,0 +1,109 @@
+package ganzhi
+
+import (
+    "calendarutil"
+    "testing"
+    "time"
+)
+
+func Test_FromIndex(t *testing.T) {
+    for i := 0; i < 60; i++ {
+        if FromIndex(i).Index() != i {
+            t.Error("fail", i)
+        }
+    }
+    if FromIndex(0).String() == "甲子" && FromIndex(59).String() == "癸亥" &&
+        FromIndex(60).String() == "甲子" && FromIndex(-1).String() == "癸亥" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func getYearName(t *testing.T, tm time.Time, boundary YearBoundary) string {
+    g, err := GetYear(tm, boundary)
+    if err != nil {
+        t.Error("fail", err)
+    }
+    return g.String()
+}
+
+// 2024年立春是北京时间2月4日16:27
+func Test_GetYear(t *testing.T) {
+    before := time.Date(2024, 2, 4, 16, 0, 0, 0, beijing)
+    after := time.Date(2024, 2, 4, 17, 0, 0, 0, beijing)
+    t.Log(getYearName(t, before, LiChunBoundary), getYearName(t, after, LiChunBoundary))
+    if getYearName(t, before, LiChunBoundary) == "癸卯" &&
+        getYearName(t, after, LiChunBoundary) == "甲辰" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// 2024年春节是2月10日
+func Test_GetYear_SpringFestival(t *testing.T) {
+    before := time.Date(2024, 2, 9, 23, 0, 0, 0, beijing)
+    after := time.Date(2024, 2, 10, 1, 0, 0, 0, beijing)
+    t.Log(getYearName(t, before, SpringFestivalBoundary), getYearName(t, after, SpringFestivalBoundary))
+    if getYearName(t, before, SpringFestivalBoundary) == "癸卯" &&
+        getYearName(t, after, SpringFestivalBoundary) == "甲辰" &&
+        getYearName(t, before, LiChunBoundary) == "甲辰" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetMonth(t *testing.T) {
+    cases := []struct {
+        t time.Time
+        expected string
+    }{
+        // 2023年大雪是12月7日，2024年小寒是1月6日，立春是2月4日16:27，惊蛰是3月5日
+        {time.Date(2023, 12, 20, 12, 0, 0, 0, beijing), "甲子"},
+        {time.Date(2024, 1, 1, 0, 30, 0, 0, beijing), "甲子"},
+        {time.Date(2024, 1, 10, 12, 0, 0, 0, beijing), "乙丑"},
+        {time.Date(2024, 2, 4, 16, 0, 0, 0, beijing), "乙丑"},
+        {time.Date(2024, 2, 4, 17, 0, 0, 0, beijing), "丙寅"},
+        {time.Date(2024, 3, 10, 12, 0, 0, 0, beijing), "丁卯"},
+        {time.Date(2024, 12, 31, 12, 0, 0, 0, beijing), "丙子"},
+    }
+    for _, c := range cases {
+        g, err := GetMonth(c.t)
+        t.Log(c.t, g)
+        if err != nil || g.String() != c.expected {
+            t.Error("fail")
+        }
+    }
+}
+
+// 2000年1月1日是戊午日，1949年10月1日是甲子日
+func Test_GetDay(t *testing.T) {
+    if GetDay(calendarutil.ToJulianDate(2000, 1, 1)).String() == "戊午" &&
+        GetDay(calendarutil.ToJulianDate(1949, 10, 1)).String() == "甲子" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// 1949年10月1日甲子日，子时为甲子，午时为庚午；23点以后是第二天乙丑日的丙子时
+func Test_GetHour(t *testing.T) {
+    if GetHour(time.Date(1949, 10, 1, 0, 30, 0, 0, beijing)).String() == "甲子" &&
+        GetHour(time.Date(1949, 10, 1, 12, 0, 0, 0, beijing)).String() == "庚午" &&
+        GetHour(time.Date(1949, 10, 1, 22, 59, 0, 0, beijing)).String() == "乙亥" &&
+        GetHour(time.Date(1949, 10, 1, 23, 0, 0, 0, beijing)).String() == "丙子" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    // time.Time的1000年1月1日是Gregorian历法，即Julian历法999年12月27日
+    jdn, _ := calendarutil.ProlepticJulian.ToJulianDate(999, 12, 27)
+    day := GetDay(jdn)
+    if h := GetHour(time.Date(1000, 1, 1, 0, 30, 0, 0, beijing)); h == (GanZhi{day.Stem % 5 * 2, 0}) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", h, day)
+    }
+}
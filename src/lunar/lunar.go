@@ -0,0 +1,457 @@
+package lunar
+
+import (
+    "calendarutil"
+    "errors"
+    "fmt"
+    "math"
+    "moonphase"
+    "solar_terms"
+    "sync"
+    "time"
+)
+
+/**
+ * 农历日期
+ */
+type LunarDate struct {
+    Year int
+    Month int
+    Day int
+    IsLeapMonth bool
+}
+
+/**
+ * 农历月，start和end都是北京时间日期的儒略日数，end是下个月初一
+ */
+type lunarMonth struct {
+    year int
+    month int
+    isLeap bool
+    start int
+    end int
+    // 朔离午夜太近，初一可能差一天
+    ambiguous bool
+}
+
+/**
+ * 北京时间相对UTC的时差，单位是日
+ */
+const BEIJING_TIME_OFFSET = 8.0 / 24
+
+/**
+ * 1912年至1928年中央观象台编算的历书使用北京地方平时(东经116°25′)，相对UTC的时差，单位是日。
+ * 这与“按UTC+8定朔”的现代规则不同，但香港天文台的公历与农历日期对照表在这几年沿用了当时的历书，
+ * 例如1916年的朔在UTC+8是2月4日00:05，在北京地方平时是2月3日23:51，对照表的春节是2月3日
+ */
+const BEIJING_LOCAL_MEAN_TIME_OFFSET = (116.0 + 25.0 / 60) / 360
+
+/**
+ * 开始使用北京地方平时的日期(1912年1月1日)的儒略日数
+ */
+const LOCAL_MEAN_TIME_FIRST_JULIAN_DATE = 2419403
+
+/**
+ * 开始使用东经120°标准时的日期(1929年1月1日)的儒略日数
+ */
+const STANDARD_TIME_FIRST_JULIAN_DATE = 2425613
+
+/**
+ * 朔离午夜少于这个秒数时认为初一所在的日期不能确定。月亮理论的误差约2秒，
+ * 本世纪后半叶∆T的外推误差可能达到1分钟甚至更多，所以这只是提示，更远的年份即使不在界限以内也可能有误
+ */
+const AMBIGUOUS_NEW_MOON_MARGIN = 60.0
+
+var (
+    ErrInvalidMonth = errors.New("lunar: invalid month")
+    ErrInvalidDay = errors.New("lunar: invalid day")
+    ErrNoLeapMonth = errors.New("lunar: no such leap month")
+    ErrOutOfRange = errors.New("lunar: date out of range")
+)
+
+/**
+ * 把TT时刻换算成北京时间所在日期的儒略日数
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 北京时间日期的儒略日数
+ */
+func toBeijingDate(jd float64) int {
+    jd -= calendarutil.GetDeltaTJD(jd) / 86400
+    return int(math.Floor(jd + getTimeOffset(jd) + 0.5))
+}
+
+/**
+ * 历书所用时间相对UTC的时差
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @return 时差，单位是日
+ */
+func getTimeOffset(jd float64) float64 {
+    if jd + 0.5 >= LOCAL_MEAN_TIME_FIRST_JULIAN_DATE && jd + 0.5 < STANDARD_TIME_FIRST_JULIAN_DATE {
+        return BEIJING_LOCAL_MEAN_TIME_OFFSET
+    }
+    return BEIJING_TIME_OFFSET
+}
+
+/**
+ * 计算某天(含)以前最近的朔日
+ *
+ * @param jdn
+ *            北京时间日期的儒略日数
+ * @return 朔日的儒略日数和朔是否离午夜太近，求解朔的时刻失败时返回错误
+ */
+func getNewMoonDateBefore(jdn int) (int, bool, error) {
+    // 北京时间jdn当天结束的时刻
+    jd := float64(jdn) + 0.5 - getTimeOffset(float64(jdn))
+    jd += calendarutil.GetDeltaTJD(jd) / 86400
+    newMoon, err := moonphase.NewMoon.Before(jd)
+    if err != nil {
+        return 0, false, err
+    }
+    date, ambiguous := getNewMoonDate(newMoon)
+    return date, ambiguous, nil
+}
+
+/**
+ * 计算朔所在的日期
+ *
+ * @param jd
+ *            朔的时刻(TT)
+ * @return 北京时间日期的儒略日数，以及朔离午夜是否少于AMBIGUOUS_NEW_MOON_MARGIN
+ */
+func getNewMoonDate(jd float64) (int, bool) {
+    jdn := toBeijingDate(jd)
+    ut := jd - calendarutil.GetDeltaTJD(jd) / 86400
+    // 离当天开始和结束的秒数
+    seconds := (ut + getTimeOffset(ut) + 0.5 - float64(jdn)) * 86400
+    return jdn, seconds < AMBIGUOUS_NEW_MOON_MARGIN || 86400 - seconds < AMBIGUOUS_NEW_MOON_MARGIN
+}
+
+var (
+    cacheLock sync.Mutex
+    // 按冬至所在公历年份缓存，每个值是从上一年冬至所在月到本年冬至所在月之前的各月
+    cache = map[int][]*lunarMonth{}
+    // 缓存所用的∆T模型版本，模型改变后清空缓存
+    cacheVersion = calendarutil.GetDeltaTModelVersion()
+)
+
+/**
+ * 计算从year-1年冬至所在的十一月开始到year年冬至所在的十一月之前的各个农历月。
+ * 两个冬至之间有13个月时，第一个不含中气的月为闰月。
+ *
+ * @param year
+ *            公历年份
+ * @return 12或13个农历月，求解朔或中气的时刻失败时返回错误
+ */
+func getMonthsOfYear(year int) ([]*lunarMonth, error) {
+    version := calendarutil.GetDeltaTModelVersion()
+    cacheLock.Lock()
+    if cacheVersion != version {
+        cache = map[int][]*lunarMonth{}
+        cacheVersion = version
+    }
+    months, ok := cache[year]
+    cacheLock.Unlock()
+    if ok {
+        return months, nil
+    }
+
+    jd, err := solarterms.DongZhi.JulianDate(year - 1)
+    if err != nil {
+        return nil, err
+    }
+    winterSolstice := toBeijingDate(jd)
+    start, ambiguous, err := getNewMoonDateBefore(winterSolstice)
+    if err != nil {
+        return nil, err
+    }
+    jd, err = solarterms.DongZhi.JulianDate(year)
+    if err != nil {
+        return nil, err
+    }
+    end, _, err := getNewMoonDateBefore(toBeijingDate(jd))
+    if err != nil {
+        return nil, err
+    }
+
+    // 两个十一月之间的朔日
+    starts := []int{start}
+    ambiguousStarts := []bool{ambiguous}
+    for {
+        newMoon, err := moonphase.NewMoon.After(float64(starts[len(starts) - 1]) + 20)
+        if err != nil {
+            return nil, err
+        }
+        next, ambiguous := getNewMoonDate(newMoon)
+        if next >= end {
+            break
+        }
+        starts = append(starts, next)
+        ambiguousStarts = append(ambiguousStarts, ambiguous)
+    }
+    starts = append(starts, end)
+
+    // 中气所在日期，冬至以后的节气都在year年
+    principal := []int{winterSolstice}
+    for term := solarterms.DongZhi.Next(); term != solarterms.DongZhi; term = term.Next() {
+        if term.Kind == solarterms.Principal {
+            jd, err := term.JulianDate(year)
+            if err != nil {
+                return nil, err
+            }
+            principal = append(principal, toBeijingDate(jd))
+        }
+    }
+
+    hasLeap := len(starts) - 1 == 13
+    month := 11
+    lunarYear := year - 1
+    months = make([]*lunarMonth, 0, len(starts) - 1)
+    for i := 0; i < len(starts) - 1; i++ {
+        m := &lunarMonth{start: starts[i], end: starts[i + 1], ambiguous: ambiguousStarts[i]}
+        if hasLeap && i > 0 && !containsAny(principal, m.start, m.end) {
+            // 只有第一个无中气的月是闰月
+            hasLeap = false
+            m.isLeap = true
+        } else if i > 0 {
+            month++
+            if month > 12 {
+                month = 1
+                lunarYear++
+            }
+        }
+        m.year = lunarYear
+        m.month = month
+        months = append(months, m)
+    }
+
+    cacheLock.Lock()
+    // 计算期间模型改变时不缓存
+    if cacheVersion == version {
+        cache[year] = months
+    }
+    cacheLock.Unlock()
+    return months, nil
+}
+
+/**
+ * 判断[start, end)之间是否包含days中的某一天
+ */
+func containsAny(days []int, start int, end int) bool {
+    for _, day := range days {
+        if day >= start && day < end {
+            return true
+        }
+    }
+    return false
+}
+
+/**
+ * 找出包含某天的农历月
+ *
+ * @param jdn
+ *            北京时间日期的儒略日数
+ * @return 农历月，找不到时返回错误
+ */
+func getMonthOf(jdn int) (*lunarMonth, error) {
+    year, _, _ := calendarutil.DefaultCalendar.FromJulianDate(jdn)
+    if year < calendarutil.MIN_YEAR || year > calendarutil.MAX_YEAR {
+        return nil, ErrOutOfRange
+    }
+    // 当年冬至所在的十一月及以后的日期算在下一年里
+    for _, y := range []int{year, year + 1} {
+        months, err := getMonthsOfYear(y)
+        if err != nil {
+            return nil, err
+        }
+        for _, m := range months {
+            if jdn >= m.start && jdn < m.end {
+                return m, nil
+            }
+        }
+    }
+    return nil, ErrOutOfRange
+}
+
+/**
+ * 由儒略日数计算农历日期
+ *
+ * @param jdn
+ *            儒略日数(北京时间)
+ * @return 农历日期，超出范围时返回错误
+ */
+func FromJulianDate(jdn int) (*LunarDate, error) {
+    m, err := getMonthOf(jdn)
+    if err != nil {
+        return nil, err
+    }
+    return &LunarDate{m.year, m.month, jdn - m.start + 1, m.isLeap}, nil
+}
+
+/**
+ * 由公历日期计算农历日期，1582年10月4日及以前按照Julian历法
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @return 农历日期，公历日期无效或超出范围时返回错误
+ */
+func FromGregorian(year, month, day int) (*LunarDate, error) {
+    jdn, err := calendarutil.DefaultCalendar.ToJulianDate(year, month, day)
+    if err != nil {
+        return nil, err
+    }
+    return FromJulianDate(jdn)
+}
+
+/**
+ * 计算农历日期的儒略日数
+ *
+ * @return 儒略日数(北京时间)，日期不存在时返回错误
+ */
+func (d *LunarDate) ToJulianDate() (int, error) {
+    if d.Month < 1 || d.Month > 12 {
+        return 0, ErrInvalidMonth
+    }
+    // 十一月和十二月从当年冬至开始，算在下一年里
+    year := d.Year
+    if d.Month >= 11 {
+        year++
+    }
+    months, err := getMonthsOfYear(year)
+    if err != nil {
+        return 0, err
+    }
+    for _, m := range months {
+        if m.year == d.Year && m.month == d.Month && m.isLeap == d.IsLeapMonth {
+            return d.dayOf(m)
+        }
+    }
+    return 0, ErrNoLeapMonth
+}
+
+func (d *LunarDate) dayOf(m *lunarMonth) (int, error) {
+    if d.Day < 1 || d.Day > m.end - m.start {
+        return 0, ErrInvalidDay
+    }
+    return m.start + d.Day - 1, nil
+}
+
+/**
+ * 计算农历日期对应的公历日期，1582年10月4日及以前按照Julian历法
+ *
+ * @return 公历的年、月、日，日期不存在时返回错误
+ */
+func (d *LunarDate) ToGregorian() (int, int, int, error) {
+    jdn, err := d.ToJulianDate()
+    if err != nil {
+        return 0, 0, 0, err
+    }
+    year, month, day := calendarutil.FromJulianDate(float64(jdn), time.UTC, false).Date()
+    return year, int(month), day, nil
+}
+
+/**
+ * 计算某年农历的闰月
+ *
+ * @param year
+ *            农历年份
+ * @return 闰月的月份，没有闰月时返回0，求解朔或中气的时刻失败时返回错误
+ */
+func GetLeapMonth(year int) (int, error) {
+    for _, y := range []int{year, year + 1} {
+        months, err := getMonthsOfYear(y)
+        if err != nil {
+            return 0, err
+        }
+        for _, m := range months {
+            if m.year == year && m.isLeap {
+                return m.month, nil
+            }
+        }
+    }
+    return 0, nil
+}
+
+/**
+ * 计算农历某月的天数
+ *
+ * @param year
+ *            农历年份
+ * @param month
+ *            月份
+ * @param isLeap
+ *            是否闰月
+ * @return 天数(29或30)，月份不存在时返回错误
+ */
+func GetDaysOfMonth(year, month int, isLeap bool) (int, error) {
+    d := &LunarDate{year, month, 1, isLeap}
+    start, err := d.ToJulianDate()
+    if err != nil {
+        return 0, err
+    }
+    m, err := getMonthOf(start)
+    if err != nil {
+        return 0, err
+    }
+    return m.end - start, nil
+}
+
+/**
+ * 判断农历某月初一的日期是否不能确定，即朔离北京时间午夜少于AMBIGUOUS_NEW_MOON_MARGIN秒，
+ * 这时初一可能比算得的日期早或晚一天，前一个月的天数也随之改变
+ *
+ * @param year
+ *            农历年份
+ * @param month
+ *            月份
+ * @param isLeap
+ *            是否闰月
+ * @return 初一不能确定时返回true，月份不存在时返回错误
+ */
+func IsMonthStartAmbiguous(year, month int, isLeap bool) (bool, error) {
+    d := &LunarDate{year, month, 1, isLeap}
+    start, err := d.ToJulianDate()
+    if err != nil {
+        return false, err
+    }
+    m, err := getMonthOf(start)
+    if err != nil {
+        return false, err
+    }
+    return m.ambiguous, nil
+}
+
+var chineseMonthNames = []string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+var chineseDayNames = []string{"初", "十", "廿", "三"}
+var chineseNumbers = []string{"一", "二", "三", "四", "五", "六", "七", "八", "九", "十"}
+
+/**
+ * 农历日期的中文表示，例如“闰四月初一”，月或日无效时返回类似“%!LunarDate(2016-13-1)”的字符串
+ */
+func (d *LunarDate) String() string {
+    if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 30 {
+        return fmt.Sprintf("%%!LunarDate(%d-%d-%d)", d.Year, d.Month, d.Day)
+    }
+    s := ""
+    if d.IsLeapMonth {
+        s = "闰"
+    }
+    s += chineseMonthNames[d.Month - 1] + "月"
+    switch d.Day {
+    case 10:
+        s += "初十"
+    case 20:
+        s += "二十"
+    case 30:
+        s += "三十"
+    default:
+        s += chineseDayNames[d.Day / 10] + chineseNumbers[(d.Day - 1) % 10]
+    }
+    return s
+}
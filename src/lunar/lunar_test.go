@@ -0,0 +1,279 @@
+package lunar
+
+import (
+    "calendarutil"
+    "errors"
+    "math"
+    "moonphase"
+    "testing"
+)
+
+// 1900年至2100年各年春节的公历日期和闰月(0表示无闰月)，与香港天文台的公历与农历日期对照表一致
+var springFestivals = []struct {
+    year, month, day, leapMonth int
+}{
+    {1900, 1, 31, 8}, {1901, 2, 19, 0}, {1902, 2, 8, 0}, {1903, 1, 29, 5},
+    {1904, 2, 16, 0}, {1905, 2, 4, 0}, {1906, 1, 25, 4}, {1907, 2, 13, 0},
+    {1908, 2, 2, 0}, {1909, 1, 22, 2}, {1910, 2, 10, 0}, {1911, 1, 30, 6},
+    {1912, 2, 18, 0}, {1913, 2, 6, 0}, {1914, 1, 26, 5}, {1915, 2, 14, 0},
+    {1916, 2, 3, 0}, {1917, 1, 23, 2}, {1918, 2, 11, 0}, {1919, 2, 1, 7},
+    {1920, 2, 20, 0}, {1921, 2, 8, 0}, {1922, 1, 28, 5}, {1923, 2, 16, 0},
+    {1924, 2, 5, 0}, {1925, 1, 24, 4}, {1926, 2, 13, 0}, {1927, 2, 2, 0},
+    {1928, 1, 23, 2}, {1929, 2, 10, 0}, {1930, 1, 30, 6}, {1931, 2, 17, 0},
+    {1932, 2, 6, 0}, {1933, 1, 26, 5}, {1934, 2, 14, 0}, {1935, 2, 4, 0},
+    {1936, 1, 24, 3}, {1937, 2, 11, 0}, {1938, 1, 31, 7}, {1939, 2, 19, 0},
+    {1940, 2, 8, 0}, {1941, 1, 27, 6}, {1942, 2, 15, 0}, {1943, 2, 5, 0},
+    {1944, 1, 25, 4}, {1945, 2, 13, 0}, {1946, 2, 2, 0}, {1947, 1, 22, 2},
+    {1948, 2, 10, 0}, {1949, 1, 29, 7}, {1950, 2, 17, 0}, {1951, 2, 6, 0},
+    {1952, 1, 27, 5}, {1953, 2, 14, 0}, {1954, 2, 3, 0}, {1955, 1, 24, 3},
+    {1956, 2, 12, 0}, {1957, 1, 31, 8}, {1958, 2, 18, 0}, {1959, 2, 8, 0},
+    {1960, 1, 28, 6}, {1961, 2, 15, 0}, {1962, 2, 5, 0}, {1963, 1, 25, 4},
+    {1964, 2, 13, 0}, {1965, 2, 2, 0}, {1966, 1, 21, 3}, {1967, 2, 9, 0},
+    {1968, 1, 30, 7}, {1969, 2, 17, 0}, {1970, 2, 6, 0}, {1971, 1, 27, 5},
+    {1972, 2, 15, 0}, {1973, 2, 3, 0}, {1974, 1, 23, 4}, {1975, 2, 11, 0},
+    {1976, 1, 31, 8}, {1977, 2, 18, 0}, {1978, 2, 7, 0}, {1979, 1, 28, 6},
+    {1980, 2, 16, 0}, {1981, 2, 5, 0}, {1982, 1, 25, 4}, {1983, 2, 13, 0},
+    {1984, 2, 2, 10}, {1985, 2, 20, 0}, {1986, 2, 9, 0}, {1987, 1, 29, 6},
+    {1988, 2, 17, 0}, {1989, 2, 6, 0}, {1990, 1, 27, 5}, {1991, 2, 15, 0},
+    {1992, 2, 4, 0}, {1993, 1, 23, 3}, {1994, 2, 10, 0}, {1995, 1, 31, 8},
+    {1996, 2, 19, 0}, {1997, 2, 7, 0}, {1998, 1, 28, 5}, {1999, 2, 16, 0},
+    {2000, 2, 5, 0}, {2001, 1, 24, 4}, {2002, 2, 12, 0}, {2003, 2, 1, 0},
+    {2004, 1, 22, 2}, {2005, 2, 9, 0}, {2006, 1, 29, 7}, {2007, 2, 18, 0},
+    {2008, 2, 7, 0}, {2009, 1, 26, 5}, {2010, 2, 14, 0}, {2011, 2, 3, 0},
+    {2012, 1, 23, 4}, {2013, 2, 10, 0}, {2014, 1, 31, 9}, {2015, 2, 19, 0},
+    {2016, 2, 8, 0}, {2017, 1, 28, 6}, {2018, 2, 16, 0}, {2019, 2, 5, 0},
+    {2020, 1, 25, 4}, {2021, 2, 12, 0}, {2022, 2, 1, 0}, {2023, 1, 22, 2},
+    {2024, 2, 10, 0}, {2025, 1, 29, 6}, {2026, 2, 17, 0}, {2027, 2, 6, 0},
+    {2028, 1, 26, 5}, {2029, 2, 13, 0}, {2030, 2, 3, 0}, {2031, 1, 23, 3},
+    {2032, 2, 11, 0}, {2033, 1, 31, 11}, {2034, 2, 19, 0}, {2035, 2, 8, 0},
+    {2036, 1, 28, 6}, {2037, 2, 15, 0}, {2038, 2, 4, 0}, {2039, 1, 24, 5},
+    {2040, 2, 12, 0}, {2041, 2, 1, 0}, {2042, 1, 22, 2}, {2043, 2, 10, 0},
+    {2044, 1, 30, 7}, {2045, 2, 17, 0}, {2046, 2, 6, 0}, {2047, 1, 26, 5},
+    {2048, 2, 14, 0}, {2049, 2, 2, 0}, {2050, 1, 23, 3}, {2051, 2, 11, 0},
+    {2052, 2, 1, 8}, {2053, 2, 19, 0}, {2054, 2, 8, 0}, {2055, 1, 28, 6},
+    {2056, 2, 15, 0}, {2057, 2, 4, 0}, {2058, 1, 24, 4}, {2059, 2, 12, 0},
+    {2060, 2, 2, 0}, {2061, 1, 21, 3}, {2062, 2, 9, 0}, {2063, 1, 29, 7},
+    {2064, 2, 17, 0}, {2065, 2, 5, 0}, {2066, 1, 26, 5}, {2067, 2, 14, 0},
+    {2068, 2, 3, 0}, {2069, 1, 23, 4}, {2070, 2, 11, 0}, {2071, 1, 31, 8},
+    {2072, 2, 19, 0}, {2073, 2, 7, 0}, {2074, 1, 27, 6}, {2075, 2, 15, 0},
+    {2076, 2, 5, 0}, {2077, 1, 24, 4}, {2078, 2, 12, 0}, {2079, 2, 2, 0},
+    {2080, 1, 22, 3}, {2081, 2, 9, 0}, {2082, 1, 29, 7}, {2083, 2, 17, 0},
+    {2084, 2, 6, 0}, {2085, 1, 26, 5}, {2086, 2, 14, 0}, {2087, 2, 3, 0},
+    {2088, 1, 24, 4}, {2089, 2, 10, 0}, {2090, 1, 30, 8}, {2091, 2, 18, 0},
+    {2092, 2, 7, 0}, {2093, 1, 27, 6}, {2094, 2, 15, 0}, {2095, 2, 5, 0},
+    {2096, 1, 25, 4}, {2097, 2, 12, 0}, {2098, 2, 1, 0}, {2099, 1, 21, 2},
+    {2100, 2, 9, 0},
+}
+
+func Test_FromGregorian(t *testing.T) {
+    for _, v := range springFestivals {
+        d, err := FromGregorian(v.year, v.month, v.day)
+        if err != nil || *d != (LunarDate{v.year, 1, 1, false}) {
+            t.Error("fail", v.year, d, err)
+            continue
+        }
+        // 春节前一天是上一年的腊月二十九或三十
+        jdn := calendarutil.ToJulianDate(v.year, v.month, v.day)
+        d, err = FromJulianDate(jdn - 1)
+        if err != nil || d.Year != v.year - 1 || d.Month != 12 || d.IsLeapMonth || (d.Day != 29 && d.Day != 30) {
+            t.Error("fail", v.year, d)
+        }
+    }
+}
+
+func Test_GetLeapMonth(t *testing.T) {
+    for _, v := range springFestivals {
+        if leapMonth, err := GetLeapMonth(v.year); err != nil || leapMonth != v.leapMonth {
+            t.Error("fail", v.year, leapMonth, err)
+        }
+    }
+}
+
+// 2033年问题：按“无中气置闰”的规则，2033年应闰十一月，而不是闰七月
+func Test_2033(t *testing.T) {
+    d, err := FromGregorian(2033, 12, 22)
+    t.Log(d)
+    if err == nil && *d == (LunarDate{2033, 11, 1, true}) && d.String() == "闰冬月初一" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    d, err = FromGregorian(2033, 8, 25)
+    t.Log(d)
+    if err == nil && *d == (LunarDate{2033, 8, 1, false}) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// 2057年9月的朔在北京时间9月29日00:00:05前后，离午夜只有几秒，香港天文台的历表是9月29日初一
+func Test_2057(t *testing.T) {
+    d, err := FromGregorian(2057, 9, 29)
+    t.Log(d)
+    if err != nil || *d != (LunarDate{2057, 9, 1, false}) {
+        t.Error("fail", err)
+    }
+    if ambiguous, err := IsMonthStartAmbiguous(2057, 9, false); err != nil || !ambiguous {
+        t.Error("fail", ambiguous, err)
+    }
+}
+
+// 1900年到2100年间朔离北京时间午夜少于1分钟的只有这两个月
+func Test_IsMonthStartAmbiguous(t *testing.T) {
+    expected := map[[2]int]bool{{2057, 9}: true, {2097, 7}: true}
+    for year := 1900; year <= 2100; year++ {
+        leapMonth, err := GetLeapMonth(year)
+        if err != nil {
+            t.Fatal("fail", year, err)
+        }
+        for month := 1; month <= 12; month++ {
+            for _, isLeap := range []bool{false, true} {
+                if isLeap && month != leapMonth {
+                    continue
+                }
+                ambiguous, err := IsMonthStartAmbiguous(year, month, isLeap)
+                if err != nil || ambiguous != (expected[[2]int{year, month}] && !isLeap) {
+                    t.Error("fail", year, month, isLeap, ambiguous, err)
+                }
+            }
+        }
+    }
+    if _, err := IsMonthStartAmbiguous(2020, 5, true); err != ErrNoLeapMonth {
+        t.Error("fail", err)
+    }
+}
+
+// 1912年至1928年按北京地方平时定朔。1916年的朔在UTC+8是2月4日00:05，在北京地方平时是2月3日23:51
+func Test_LocalMeanTime(t *testing.T) {
+    newMoon, err := moonphase.NewMoon.After(float64(calendarutil.ToJulianDate(1916, 2, 1)))
+    if err != nil {
+        t.Fatal("fail", err)
+    }
+    ut := newMoon - calendarutil.GetDeltaTJD(newMoon) / 86400
+    if jdn := int(math.Floor(ut + BEIJING_TIME_OFFSET + 0.5)); jdn != calendarutil.ToJulianDate(1916, 2, 4) {
+        t.Error("fail", jdn)
+    }
+    d, err := FromGregorian(1916, 2, 3)
+    if err != nil || *d != (LunarDate{1916, 1, 1, false}) {
+        t.Error("fail", d, err)
+    }
+    for _, v := range springFestivals {
+        if v.year < 1912 || v.year > 1928 {
+            continue
+        }
+        if d, err := FromGregorian(v.year, v.month, v.day); err != nil || *d != (LunarDate{v.year, 1, 1, false}) {
+            t.Error("fail", v.year, d, err)
+        }
+    }
+}
+
+func Test_ToGregorian(t *testing.T) {
+    d := &LunarDate{2033, 11, 1, true}
+    year, month, day, err := d.ToGregorian()
+    if err == nil && year == 2033 && month == 12 && day == 22 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", year, month, day, err)
+    }
+
+    d = &LunarDate{2020, 4, 1, true}
+    year, month, day, err = d.ToGregorian()
+    if err == nil && year == 2020 && month == 5 && day == 23 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", year, month, day, err)
+    }
+}
+
+// 公历和农历互相转换结果一致
+func Test_ToJulianDate(t *testing.T) {
+    start := calendarutil.ToJulianDate(2016, 1, 1)
+    for jdn := start; jdn < start + 3 * 366; jdn++ {
+        d, err := FromJulianDate(jdn)
+        if err != nil {
+            t.Error("fail", jdn, err)
+            continue
+        }
+        result, err := d.ToJulianDate()
+        if err != nil || result != jdn {
+            t.Error("fail", jdn, d, result, err)
+        }
+    }
+}
+
+func Test_ToJulianDate_Error(t *testing.T) {
+    if _, err := (&LunarDate{2020, 13, 1, false}).ToJulianDate(); err != ErrInvalidMonth {
+        t.Error("fail", err)
+    }
+    if _, err := (&LunarDate{2020, 5, 1, true}).ToJulianDate(); err != ErrNoLeapMonth {
+        t.Error("fail", err)
+    }
+    if _, err := (&LunarDate{2020, 4, 30, true}).ToJulianDate(); err != ErrInvalidDay {
+        t.Error("fail", err)
+    }
+    if _, err := (&LunarDate{2020, 4, 0, false}).ToJulianDate(); err != ErrInvalidDay {
+        t.Error("fail", err)
+    }
+}
+
+func Test_GetDaysOfMonth(t *testing.T) {
+    days, err := GetDaysOfMonth(2020, 4, true)
+    t.Log(days, err)
+    if err == nil && days == 29 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_String(t *testing.T) {
+    if (&LunarDate{2016, 1, 1, false}).String() == "正月初一" &&
+        (&LunarDate{2016, 12, 20, false}).String() == "腊月二十" &&
+        (&LunarDate{2016, 6, 21, false}).String() == "六月廿一" &&
+        (&LunarDate{2016, 10, 30, false}).String() == "十月三十" &&
+        (&LunarDate{2016, 11, 15, false}).String() == "冬月十五" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    // 无效的日期不会越界
+    if (&LunarDate{2016, 13, 1, false}).String() == "%!LunarDate(2016-13-1)" &&
+        (&LunarDate{2016, 1, 0, false}).String() == "%!LunarDate(2016-1-0)" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_FromGregorian_Error(t *testing.T) {
+    if _, err := FromGregorian(2020, 2, 30); !errors.Is(err, calendarutil.ErrInvalidDay) {
+        t.Error("fail", err)
+    }
+    // 1582年10月5日至14日因改历不存在
+    if _, err := FromGregorian(1582, 10, 10); !errors.Is(err, calendarutil.ErrNonexistentDate) {
+        t.Error("fail", err)
+    }
+    if _, err := FromJulianDate(-10000000); err != ErrOutOfRange {
+        t.Error("fail", err)
+    }
+}
+
+// 改变∆T模型后重新计算农历月
+func Test_SetDeltaTModel(t *testing.T) {
+    defer calendarutil.SetDeltaTModel(nil)
+    before, _ := FromGregorian(2024, 2, 10)
+    // ∆T为10天时朔日整体提前
+    calendarutil.SetDeltaTModel(calendarutil.DeltaTFunc(func(year float64) float64 {
+        return 10 * 86400
+    }))
+    changed, _ := FromGregorian(2024, 2, 10)
+    calendarutil.SetDeltaTModel(nil)
+    after, _ := FromGregorian(2024, 2, 10)
+    t.Log(before, changed, after)
+    if *before == (LunarDate{2024, 1, 1, false}) && *changed != *before && *after == *before {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
@@ -20,6 +20,17 @@ func ToRadians(degrees float64) float64 {
     return degrees * math.Pi / 180
 }
 
+/**
+ * 把弧度换算成角度
+ *
+ * @param radians
+ *            弧度
+ * @return 角度
+ */
+func ToDegrees(radians float64) float64 {
+    return radians * 180 / math.Pi
+}
+
 
 /**
  * 把角秒换算成弧度
@@ -130,30 +141,16 @@ func DmsToRadians(d int, m int, s float64 ) float64 {
 }
 
 /**
-     * 牛顿迭代求解方程的根
+     * 牛顿迭代求解方程的根，根的精度为1e-7，最多迭代100次
      *
      * @param f
      *            方程表达式
      * @param x0
      *            对根的估值
-     * @return 在x0附近的一个根
+     * @return 在x0附近的一个根，不收敛时返回最后一次迭代的值。需要知道是否收敛时使用{@link Solver#Newton}
      */
 
 func NewtonIteration(f func (float64) float64 , x0 float64) float64 {
-    const Epsilon = 1e-7
-    const Delta = 5e-6
-    var x float64
-
-    for {
-        x = x0
-        fx := f(x)
-        // 导数
-        fpx := ( f(x + Delta) - f( x - Delta ) ) / Delta / 2
-        x0 = x - fx / fpx
-        if  math.Abs(x0 - x) <= Epsilon {
-            break
-        }
-    }
+    x, _ := defaultSolver.Newton(f, x0)
     return x
-
 }
@@ -0,0 +1,252 @@
+package mathutil
+
+import (
+    "errors"
+    "math"
+)
+
+var (
+    ErrNoConvergence = errors.New("mathutil: iteration did not converge")
+    ErrZeroDerivative = errors.New("mathutil: zero derivative")
+    ErrNotBracketed = errors.New("mathutil: root is not bracketed")
+)
+
+/**
+ * 方程求根的参数
+ */
+type Solver struct {
+    // 根的精度，相邻两次迭代的差或者区间长度不超过它时认为已经收敛
+    Tolerance float64
+    // 最大迭代次数，超过时返回ErrNoConvergence
+    MaxIterations int
+    // 牛顿迭代用中心差分求导数时的步长
+    Delta float64
+}
+
+/**
+ * NewtonIteration使用的参数，其他包需要时用NewSolver创建自己的参数
+ */
+var defaultSolver = NewSolver(1e-7, 100)
+
+/**
+ * 创建求根参数，求导数的步长取5e-6
+ *
+ * @param tolerance
+ *            根的精度
+ * @param maxIterations
+ *            最大迭代次数
+ * @return 求根参数
+ */
+func NewSolver(tolerance float64, maxIterations int) *Solver {
+    return &Solver{Tolerance: tolerance, MaxIterations: maxIterations, Delta: 5e-6}
+}
+
+/**
+ * 牛顿迭代求解方程的根，导数用中心差分计算
+ *
+ * @param f
+ *            方程表达式
+ * @param x0
+ *            对根的估值
+ * @return 在x0附近的一个根，导数为零时返回ErrZeroDerivative，迭代次数用完或者出现NaN时返回ErrNoConvergence
+ */
+func (s *Solver) Newton(f func(float64) float64, x0 float64) (float64, error) {
+    x := x0
+    for i := 0; i < s.MaxIterations; i++ {
+        fx := f(x)
+        if fx == 0 {
+            return x, nil
+        }
+        fpx := (f(x + s.Delta) - f(x - s.Delta)) / s.Delta / 2
+        if fpx == 0 {
+            return x, ErrZeroDerivative
+        }
+        next := x - fx / fpx
+        if math.IsNaN(next) || math.IsInf(next, 0) {
+            return x, ErrNoConvergence
+        }
+        if math.Abs(next - x) <= s.Tolerance {
+            return next, nil
+        }
+        x = next
+    }
+    return x, ErrNoConvergence
+}
+
+/**
+ * 割线法求解方程的根
+ *
+ * @param f
+ *            方程表达式
+ * @param x0
+ *            第一个初值
+ * @param x1
+ *            第二个初值
+ * @return 在x0、x1附近的一个根，割线水平时返回ErrZeroDerivative，不收敛时返回ErrNoConvergence
+ */
+func (s *Solver) Secant(f func(float64) float64, x0, x1 float64) (float64, error) {
+    f0, f1 := f(x0), f(x1)
+    for i := 0; i < s.MaxIterations; i++ {
+        if f1 == 0 {
+            return x1, nil
+        }
+        if f1 == f0 {
+            return x1, ErrZeroDerivative
+        }
+        x2 := x1 - f1 * (x1 - x0) / (f1 - f0)
+        if math.IsNaN(x2) || math.IsInf(x2, 0) {
+            return x1, ErrNoConvergence
+        }
+        if math.Abs(x2 - x1) <= s.Tolerance {
+            return x2, nil
+        }
+        x0, f0 = x1, f1
+        x1, f1 = x2, f(x2)
+    }
+    return x1, ErrNoConvergence
+}
+
+/**
+ * 二分法求解方程在[a, b]之间的根，f(a)和f(b)必须异号或者有一个为零
+ *
+ * @param f
+ *            方程表达式
+ * @param a
+ *            区间左端
+ * @param b
+ *            区间右端
+ * @return 区间内的一个根，f(a)和f(b)同号时返回ErrNotBracketed
+ */
+func (s *Solver) Bisection(f func(float64) float64, a, b float64) (float64, error) {
+    fa, fb := f(a), f(b)
+    if fa == 0 {
+        return a, nil
+    }
+    if fb == 0 {
+        return b, nil
+    }
+    if math.Signbit(fa) == math.Signbit(fb) {
+        return 0, ErrNotBracketed
+    }
+    for i := 0; i < s.MaxIterations; i++ {
+        m := (a + b) / 2
+        if math.Abs(b - a) <= 2 * s.Tolerance {
+            return m, nil
+        }
+        fm := f(m)
+        if fm == 0 {
+            return m, nil
+        }
+        if math.Signbit(fm) == math.Signbit(fa) {
+            a, fa = m, fm
+        } else {
+            b = m
+        }
+    }
+    return (a + b) / 2, ErrNoConvergence
+}
+
+/**
+ * Brent方法求解方程在[a, b]之间的根，结合二分法、割线法和反二次插值，
+ * 保证收敛且通常比二分法快得多。算法摘自<i>Numerical Recipes</i> 9.3节
+ *
+ * @param f
+ *            方程表达式
+ * @param a
+ *            区间左端
+ * @param b
+ *            区间右端
+ * @return 区间内的一个根，f(a)和f(b)同号时返回ErrNotBracketed
+ */
+func (s *Solver) Brent(f func(float64) float64, a, b float64) (float64, error) {
+    fa, fb := f(a), f(b)
+    if fa == 0 {
+        return a, nil
+    }
+    if fb == 0 {
+        return b, nil
+    }
+    if math.Signbit(fa) == math.Signbit(fb) {
+        return 0, ErrNotBracketed
+    }
+    c, fc := b, fb
+    var d, e float64
+    for i := 0; i < s.MaxIterations; i++ {
+        if math.Signbit(fb) == math.Signbit(fc) {
+            // 保证根在b和c之间
+            c, fc = a, fa
+            d = b - a
+            e = d
+        }
+        if math.Abs(fc) < math.Abs(fb) {
+            a, b, c = b, c, b
+            fa, fb, fc = fb, fc, fb
+        }
+        tol := 2 * 1e-16 * math.Abs(b) + s.Tolerance / 2
+        m := (c - b) / 2
+        if math.Abs(m) <= tol || fb == 0 {
+            return b, nil
+        }
+        if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+            // 尝试插值
+            var p, q float64
+            r := fb / fa
+            if a == c {
+                // 割线法
+                p = 2 * m * r
+                q = 1 - r
+            } else {
+                // 反二次插值
+                q = fa / fc
+                t := fb / fc
+                p = r * (2 * m * q * (q - t) - (b - a) * (t - 1))
+                q = (q - 1) * (t - 1) * (r - 1)
+            }
+            if p > 0 {
+                q = -q
+            } else {
+                p = -p
+            }
+            if 2 * p < math.Min(3 * m * q - math.Abs(tol * q), math.Abs(e * q)) {
+                e = d
+                d = p / q
+            } else {
+                // 插值结果不好，改用二分法
+                d = m
+                e = d
+            }
+        } else {
+            d = m
+            e = d
+        }
+        a, fa = b, fb
+        if math.Abs(d) > tol {
+            b += d
+        } else {
+            b += math.Copysign(tol, m)
+        }
+        fb = f(b)
+    }
+    return b, ErrNoConvergence
+}
+
+/**
+ * 先用牛顿迭代求解，不收敛或者结果超出[a, b]时改用Brent方法，适合已知根的大致范围的情况
+ *
+ * @param f
+ *            方程表达式
+ * @param x0
+ *            对根的估值
+ * @param a
+ *            根所在区间的左端
+ * @param b
+ *            根所在区间的右端
+ * @return 方程的根，两种方法都失败时返回错误
+ */
+func (s *Solver) Solve(f func(float64) float64, x0, a, b float64) (float64, error) {
+    x, err := s.Newton(f, x0)
+    if err == nil && x >= a && x <= b {
+        return x, nil
+    }
+    return s.Brent(f, a, b)
+}
@@ -0,0 +1,125 @@
+package mathutil
+
+import (
+    "math"
+    "testing"
+)
+
+func Test_Solver_Newton(t *testing.T) {
+    x, err := defaultSolver.Newton(func(x float64) float64 {
+        return x * x - 2
+    }, 1.4)
+    if err == nil && math.Abs(x - math.Sqrt(2)) < 1e-12 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", x, err)
+    }
+}
+
+func Test_Solver_Newton_Pathological(t *testing.T) {
+    // 在x0=0处导数为零
+    _, err1 := defaultSolver.Newton(func(x float64) float64 {
+        return x * x + 1
+    }, 0)
+    // 没有实根，迭代来回跳动
+    _, err2 := defaultSolver.Newton(func(x float64) float64 {
+        return x * x + 1
+    }, 0.5)
+    // 从0开始在0和1之间循环
+    _, err3 := defaultSolver.Newton(func(x float64) float64 {
+        return x * x * x - 2 * x + 2
+    }, 0)
+    // 发散
+    _, err4 := defaultSolver.Newton(math.Atan, 2)
+    // 出现NaN
+    _, err5 := defaultSolver.Newton(func(x float64) float64 {
+        return math.Sqrt(x) - 10
+    }, 1e-7)
+    if err1 == ErrZeroDerivative && err2 == ErrNoConvergence && err3 == ErrNoConvergence &&
+        err4 != nil && err5 != nil {
+        t.Log("ok")
+    } else {
+        t.Error("fail", err1, err2, err3, err4, err5)
+    }
+}
+
+func Test_Solver_Secant(t *testing.T) {
+    x, err := defaultSolver.Secant(math.Cos, 1, 2)
+    _, err2 := defaultSolver.Secant(func(x float64) float64 {
+        return 1
+    }, 1, 2)
+    if err == nil && math.Abs(x - math.Pi / 2) < 1e-7 && err2 == ErrZeroDerivative {
+        t.Log("ok")
+    } else {
+        t.Error("fail", x, err, err2)
+    }
+}
+
+func Test_Solver_Bisection(t *testing.T) {
+    // 在0.3处跳变的函数
+    step := func(x float64) float64 {
+        if x < 0.3 {
+            return -1
+        }
+        return 1
+    }
+    x, err := defaultSolver.Bisection(step, -1, 1)
+    _, err2 := defaultSolver.Bisection(math.Exp, -1, 1)
+    if err == nil && math.Abs(x - 0.3) < 1e-7 && err2 == ErrNotBracketed {
+        t.Log("ok")
+    } else {
+        t.Error("fail", x, err, err2)
+    }
+}
+
+func Test_Solver_Brent(t *testing.T) {
+    // 立方根在0处导数无穷大，牛顿迭代发散
+    x1, err1 := defaultSolver.Brent(math.Cbrt, -1, 2)
+    x2, err2 := defaultSolver.Brent(func(x float64) float64 {
+        return (x + 3) * (x - 1) * (x - 1)
+    }, -4, 4.0 / 3)
+    x3, err3 := defaultSolver.Brent(func(x float64) float64 {
+        if x < 0.3 {
+            return -1
+        }
+        return 1
+    }, -1, 1)
+    _, err4 := defaultSolver.Brent(math.Exp, -1, 1)
+    if err1 == nil && math.Abs(x1) < 1e-7 && err2 == nil && math.Abs(x2 + 3) < 1e-7 &&
+        err3 == nil && math.Abs(x3 - 0.3) < 1e-7 && err4 == ErrNotBracketed {
+        t.Log("ok")
+    } else {
+        t.Error("fail", x1, err1, x2, err2, x3, err3, err4)
+    }
+}
+
+func Test_Solver_MaxIterations(t *testing.T) {
+    s := NewSolver(1e-15, 3)
+    _, err := s.Bisection(math.Sin, 3, 4)
+    if err == ErrNoConvergence {
+        t.Log("ok")
+    } else {
+        t.Error("fail", err)
+    }
+}
+
+func Test_Solver_Solve(t *testing.T) {
+    // 牛顿迭代发散，改用Brent方法
+    x, err := defaultSolver.Solve(math.Atan, 2, -1, 3)
+    if err == nil && math.Abs(x) < 1e-7 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", x, err)
+    }
+}
+
+func Test_NewtonIteration_NoHang(t *testing.T) {
+    x := NewtonIteration(func(x float64) float64 {
+        return x * x * x - 2 * x + 2
+    }, 0)
+    if !math.IsNaN(x) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", x)
+    }
+}
@@ -0,0 +1,94 @@
+package moonphase
+
+import (
+    "coordinates"
+    "elp2000moon"
+    "math"
+    "mathutil"
+    "nutation"
+    "vsop87earthd"
+)
+
+/**
+ * 计算太阳和月亮的地心视赤道坐标
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 太阳的赤道坐标、日地距离(km)、月亮的赤道坐标和地月距离(km)
+ */
+func getSunAndMoon(jd float64) (*coordinates.Equatorial, float64, *coordinates.Equatorial, float64) {
+    obliquity := nutation.GetTrueObliquity(jd)
+    sun := &coordinates.Ecliptic{
+        Longitude: vsop87earthd.GetEarthEclipticLongitudeForSun(jd),
+        Latitude: -vsop87earthd.GetSunEclipticLatitudeForEarth(jd),
+    }
+    moon := &coordinates.Ecliptic{
+        Longitude: elp2000moon.GetEarthEclipticLongitudeForMoon(jd),
+        Latitude: elp2000moon.GetEarthEclipticLatitudeForMoon(jd),
+    }
+    return sun.ToEquatorial(obliquity), vsop87earthd.GetSunRadiusForEarth(jd) * coordinates.AU,
+        moon.ToEquatorial(obliquity), elp2000moon.GetEarthRadiusForMoon(jd)
+}
+
+/**
+ * 计算月亮的相位角，即在月亮上看太阳和地球的角距离，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第48章
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 相位角，单位是弧度(rad)，在[0, π]之间，朔为π，望为0
+ */
+func GetPhaseAngle(jd float64) float64 {
+    sun, r, moon, distance := getSunAndMoon(jd)
+    // 月亮与太阳的地心角距离
+    cosPsi := math.Sin(sun.Declination) * math.Sin(moon.Declination) +
+        math.Cos(sun.Declination) * math.Cos(moon.Declination) * math.Cos(sun.RightAscension - moon.RightAscension)
+    psi := math.Acos(math.Max(-1, math.Min(1, cosPsi)))
+    return math.Atan2(r * math.Sin(psi), distance - r * math.Cos(psi))
+}
+
+/**
+ * 计算月亮被照亮部分的比例
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 月面被照亮部分的比例，在[0, 1]之间
+ */
+func GetIlluminatedFraction(jd float64) float64 {
+    return (1 + math.Cos(GetPhaseAngle(jd))) / 2
+}
+
+/**
+ * 计算月亮亮边中点的位置角，从天球北极方向起算，向东为正，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第48章
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 亮边的位置角，单位是弧度(rad)，在[0, 2π)之间，接近3π/2时是上半月，接近π/2时是下半月
+ */
+func GetBrightLimbPositionAngle(jd float64) float64 {
+    sun, _, moon, _ := getSunAndMoon(jd)
+    sinA, cosA := math.Sincos(sun.RightAscension - moon.RightAscension)
+    return mathutil.Mod2Pi(math.Atan2(math.Cos(sun.Declination) * sinA,
+        math.Sin(sun.Declination) * math.Cos(moon.Declination) - math.Cos(sun.Declination) * math.Sin(moon.Declination) * cosA))
+}
+
+/**
+ * 计算月龄，即离上一个朔的时间
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 月龄，单位是日
+ */
+func GetAge(jd float64) float64 {
+    return jd - GetBefore(jd, NewMoon)
+}
+
+/**
+ * 计算月亮的地心视直径
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 视直径，单位是弧度(rad)
+ */
+func GetAngularDiameter(jd float64) float64 {
+    return 2 * math.Asin(coordinates.MOON_RADIUS / elp2000moon.GetEarthRadiusForMoon(jd))
+}
@@ -0,0 +1,80 @@
+package moonphase
+
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+    "testing"
+)
+
+// Jean Meeus, Astronomical Algorithms 例48.a，1992年4月12日0h TD
+const meeusJD = 2448724.5
+
+func Test_GetPhaseAngle(t *testing.T) {
+    i := GetPhaseAngle(meeusJD)
+    t.Log(mathutil.ToDegrees(i))
+    // i = 69.0756°
+    if math.Abs(i - mathutil.ToRadians(69.0756)) < mathutil.ToRadians(0.001) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetIlluminatedFraction(t *testing.T) {
+    k := GetIlluminatedFraction(meeusJD)
+    t.Log(k)
+    // k = 0.6786
+    if math.Abs(k - 0.6786) < 0.0001 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    // 朔的时候接近0，望的时候接近1
+    k = GetIlluminatedFraction(calendarutil.ToJulianDateHMS(2024, 4, 8, 18, 22, 0))
+    if k > 0.001 {
+        t.Error("fail", k)
+    }
+    k = GetIlluminatedFraction(calendarutil.ToJulianDateHMS(2022, 11, 8, 11, 3, 0))
+    if k < 0.999 {
+        t.Error("fail", k)
+    }
+}
+
+func Test_GetBrightLimbPositionAngle(t *testing.T) {
+    chi := GetBrightLimbPositionAngle(meeusJD)
+    t.Log(mathutil.ToDegrees(chi))
+    // χ = 285.0°
+    if math.Abs(chi - mathutil.ToRadians(285.0)) < mathutil.ToRadians(0.1) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetAge(t *testing.T) {
+    // 2024年4月8日18:22 TD朔，4月15日19:14 TD上弦
+    age := GetAge(calendarutil.ToJulianDateHMS(2024, 4, 15, 19, 14, 0))
+    t.Log(age)
+    if math.Abs(age - (7 + 52.0 / 1440)) < 2.0 / 1440 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    // 朔的时刻月龄为0
+    newMoon := GetNearest(calendarutil.ToJulianDateHMS(2024, 4, 8, 18, 22, 0), NewMoon)
+    if age := GetAge(newMoon); math.Abs(age) > precision {
+        t.Error("fail", age)
+    }
+}
+
+func Test_GetAngularDiameter(t *testing.T) {
+    d := GetAngularDiameter(meeusJD)
+    t.Log(mathutil.ToDegrees(d) * 3600)
+    // Δ = 368409.7 km时视直径约为1945.5″
+    if math.Abs(mathutil.ToDegrees(d) * 3600 - 1945.5) < 0.5 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
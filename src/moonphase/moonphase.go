@@ -0,0 +1,143 @@
+package moonphase
+
+import (
+    "elp2000moon"
+    "math"
+    "mathutil"
+    "vsop87earthd"
+)
+
+type Phase struct {
+    Name string
+    // 月亮与太阳的地心视黄经之差(度)
+    Elongation float64
+}
+
+var (
+    NewMoon = &Phase{"朔", 0}
+    FirstQuarter = &Phase{"上弦", 90}
+    FullMoon = &Phase{"望", 180}
+    LastQuarter = &Phase{"下弦", 270}
+)
+
+/**
+ * 朔望月的平均长度，单位是日
+ */
+const SYNODIC_MONTH = 29.530588861
+
+/**
+ * 迭代求得的月相时刻的误差范围，单位是日，相差小于此值的视为同一时刻
+ */
+const precision = 1e-6
+
+/**
+ * 计算月亮与太阳的地心视黄经之差减去月相对应的黄经差
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param phase
+ *            月相
+ * @return 限制在[-π, π]之间的差值(rad)
+ */
+func getElongationDifference(jd float64, phase *Phase) float64 {
+    elongation := elp2000moon.GetEarthEclipticLongitudeForMoon(jd) - vsop87earthd.GetEarthEclipticLongitudeForSun(jd)
+    return mathutil.ModPi(elongation - mathutil.ToRadians(phase.Elongation))
+}
+
+/**
+ * 求解月相时刻所用的参数
+ */
+var solver = mathutil.NewSolver(1e-7, 100)
+
+/**
+ * 计算离给定时刻最近的月相时刻，先按平均朔望月估算，再用牛顿迭代求解，不收敛时在估值前后5天之内用Brent方法求解
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 月相时刻的儒略日(TT)，两种方法都失败时返回错误
+ */
+func (p *Phase) Nearest(jd float64) (float64, error) {
+    jd0 := jd - getElongationDifference(jd, p) / (2 * math.Pi) * SYNODIC_MONTH
+    return solver.Solve(func(x float64) float64 {
+        return getElongationDifference(x, p)
+    }, jd0, jd0 - 5, jd0 + 5)
+}
+
+/**
+ * 计算给定时刻(含)以前的最后一个月相时刻
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 月相时刻的儒略日(TT)，求解失败时返回错误
+ */
+func (p *Phase) Before(jd float64) (float64, error) {
+    result, err := p.Nearest(jd)
+    if err == nil && result > jd + precision {
+        result, err = p.Nearest(result - SYNODIC_MONTH)
+    }
+    return result, err
+}
+
+/**
+ * 计算给定时刻以后的第一个月相时刻
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 月相时刻的儒略日(TT)，求解失败时返回错误
+ */
+func (p *Phase) After(jd float64) (float64, error) {
+    result, err := p.Nearest(jd)
+    if err == nil && result <= jd + precision {
+        result, err = p.Nearest(result + SYNODIC_MONTH)
+    }
+    return result, err
+}
+
+/**
+ * 错误时返回NaN
+ */
+func orNaN(jd float64, err error) float64 {
+    if err != nil {
+        return math.NaN()
+    }
+    return jd
+}
+
+/**
+ * 计算离给定时刻最近的月相时刻
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param phase
+ *            月相
+ * @return 月相时刻的儒略日(TT)，求解失败时返回NaN。需要知道错误时使用{@link Phase#Nearest}
+ */
+func GetNearest(jd float64, phase *Phase) float64 {
+    return orNaN(phase.Nearest(jd))
+}
+
+/**
+ * 计算给定时刻(含)以前的最后一个月相时刻
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param phase
+ *            月相
+ * @return 月相时刻的儒略日(TT)，求解失败时返回NaN。需要知道错误时使用{@link Phase#Before}
+ */
+func GetBefore(jd float64, phase *Phase) float64 {
+    return orNaN(phase.Before(jd))
+}
+
+/**
+ * 计算给定时刻以后的第一个月相时刻
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param phase
+ *            月相
+ * @return 月相时刻的儒略日(TT)，求解失败时返回NaN。需要知道错误时使用{@link Phase#After}
+ */
+func GetAfter(jd float64, phase *Phase) float64 {
+    return orNaN(phase.After(jd))
+}
@@ -0,0 +1,86 @@
+package moonphase
+
+import (
+    "calendarutil"
+    "math"
+    "testing"
+)
+
+// NASA六千年月相表(Six Millennium Catalog of Phases of the Moon)中的月相时刻，TD，精确到分钟
+var nasaPhases = []struct {
+    phase *Phase
+    year, month, day, hour, minute int
+}{
+    {NewMoon, 1900, 1, 1, 13, 52},
+    {FullMoon, 1900, 1, 15, 19, 8},
+    {NewMoon, 1999, 8, 11, 11, 10},
+    {FirstQuarter, 2000, 1, 14, 13, 35},
+    {FullMoon, 2000, 1, 21, 4, 41},
+    {LastQuarter, 2000, 1, 28, 7, 58},
+    {NewMoon, 2009, 7, 22, 2, 36},
+    {NewMoon, 2015, 3, 20, 9, 37},
+    {NewMoon, 2017, 8, 21, 18, 31},
+    {FullMoon, 2018, 7, 27, 20, 22},
+    {FullMoon, 2019, 1, 21, 5, 17},
+    {FullMoon, 2020, 1, 10, 19, 22},
+    {FullMoon, 2022, 11, 8, 11, 3},
+    {LastQuarter, 2024, 4, 2, 3, 16},
+    {NewMoon, 2024, 4, 8, 18, 22},
+    {FirstQuarter, 2024, 4, 15, 19, 14},
+    {NewMoon, 2100, 1, 10, 12, 59},
+}
+
+func Test_GetNearest(t *testing.T) {
+    for _, v := range nasaPhases {
+        expected := calendarutil.ToJulianDateHMS(v.year, v.month, v.day, v.hour, v.minute, 0)
+        // 从前后10天开始找
+        for _, offset := range []float64{-10, 0, 10} {
+            jd := GetNearest(expected + offset, v.phase)
+            diff := (jd - expected) * 1440
+            if math.Abs(diff) > 1 {
+                t.Error("fail", v.phase.Name, v.year, v.month, v.day, diff)
+            }
+        }
+    }
+}
+
+func Test_GetBefore(t *testing.T) {
+    for _, v := range nasaPhases {
+        expected := calendarutil.ToJulianDateHMS(v.year, v.month, v.day, v.hour, v.minute, 0)
+        jd := GetBefore(expected + 1, v.phase)
+        if math.Abs(jd - expected) * 1440 > 1 {
+            t.Error("fail", v.phase.Name, v.year, v.month, v.day)
+        }
+        jd = GetBefore(expected - 1, v.phase)
+        if math.Abs(jd + SYNODIC_MONTH - expected) > 1 {
+            t.Error("fail", v.phase.Name, v.year, v.month, v.day)
+        }
+    }
+}
+
+func Test_GetAfter(t *testing.T) {
+    for _, v := range nasaPhases {
+        expected := calendarutil.ToJulianDateHMS(v.year, v.month, v.day, v.hour, v.minute, 0)
+        jd := GetAfter(expected - 1, v.phase)
+        if math.Abs(jd - expected) * 1440 > 1 {
+            t.Error("fail", v.phase.Name, v.year, v.month, v.day)
+        }
+        jd = GetAfter(expected + 1, v.phase)
+        if math.Abs(jd - SYNODIC_MONTH - expected) > 1 {
+            t.Error("fail", v.phase.Name, v.year, v.month, v.day)
+        }
+    }
+}
+
+// 相邻的朔之间相隔29.2到29.9天
+func Test_GetAfter_Sequence(t *testing.T) {
+    jd := float64(calendarutil.ToJulianDate(1900, 1, 1))
+    last := GetAfter(jd, NewMoon)
+    for i := 0; i < 2500; i++ {
+        jd = GetAfter(last, NewMoon)
+        if jd - last < 29.2 || jd - last > 29.9 {
+            t.Error("fail", jd, jd - last)
+        }
+        last = jd
+    }
+}
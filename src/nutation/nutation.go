@@ -0,0 +1,351 @@
+package nutation
+
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+)
+
+/**
+ * 章动序列的一项，幅角为 l*月亮平近点角 + lp*太阳平近点角 + f*月亮升交角距 + d*日月平距角 + om*月亮升交点平黄经
+ */
+type iau1980Term struct {
+    l, lp, f, d, om int
+    // 黄经章动系数 (sp + spt * T) * sin，单位0.0001角秒
+    sp, spt float64
+    // 交角章动系数 (ce + cet * T) * cos，单位0.0001角秒
+    ce, cet float64
+}
+
+/**
+ * IAU 1980章动理论的全部106项，取自<i>Explanatory Supplement to the Astronomical Almanac</i>(1992)表3.222.1
+ */
+var iau1980Terms = []iau1980Term{
+    { 0,  0,  0,  0,  1, -171996.0, -174.2, 92025.0, 8.9},
+    { 0,  0,  0,  0,  2, 2062.0, 0.2, -895.0, 0.5},
+    {-2,  0,  2,  0,  1, 46.0, 0.0, -24.0, 0.0},
+    { 2,  0, -2,  0,  0, 11.0, 0.0, 0.0, 0.0},
+    {-2,  0,  2,  0,  2, -3.0, 0.0, 1.0, 0.0},
+    { 1, -1,  0, -1,  0, -3.0, 0.0, 0.0, 0.0},
+    { 0, -2,  2, -2,  1, -2.0, 0.0, 1.0, 0.0},
+    { 2,  0, -2,  0,  1, 1.0, 0.0, 0.0, 0.0},
+    { 0,  0,  2, -2,  2, -13187.0, -1.6, 5736.0, -3.1},
+    { 0,  1,  0,  0,  0, 1426.0, -3.4, 54.0, -0.1},
+    { 0,  1,  2, -2,  2, -517.0, 1.2, 224.0, -0.6},
+    { 0, -1,  2, -2,  2, 217.0, -0.5, -95.0, 0.3},
+    { 0,  0,  2, -2,  1, 129.0, 0.1, -70.0, 0.0},
+    { 2,  0,  0, -2,  0, 48.0, 0.0, 1.0, 0.0},
+    { 0,  0,  2, -2,  0, -22.0, 0.0, 0.0, 0.0},
+    { 0,  2,  0,  0,  0, 17.0, -0.1, 0.0, 0.0},
+    { 0,  1,  0,  0,  1, -15.0, 0.0, 9.0, 0.0},
+    { 0,  2,  2, -2,  2, -16.0, 0.1, 7.0, 0.0},
+    { 0, -1,  0,  0,  1, -12.0, 0.0, 6.0, 0.0},
+    {-2,  0,  0,  2,  1, -6.0, 0.0, 3.0, 0.0},
+    { 0, -1,  2, -2,  1, -5.0, 0.0, 3.0, 0.0},
+    { 2,  0,  0, -2,  1, 4.0, 0.0, -2.0, 0.0},
+    { 0,  1,  2, -2,  1, 4.0, 0.0, -2.0, 0.0},
+    { 1,  0,  0, -1,  0, -4.0, 0.0, 0.0, 0.0},
+    { 2,  1,  0, -2,  0, 1.0, 0.0, 0.0, 0.0},
+    { 0,  0, -2,  2,  1, 1.0, 0.0, 0.0, 0.0},
+    { 0,  1, -2,  2,  0, -1.0, 0.0, 0.0, 0.0},
+    { 0,  1,  0,  0,  2, 1.0, 0.0, 0.0, 0.0},
+    {-1,  0,  0,  1,  1, 1.0, 0.0, 0.0, 0.0},
+    { 0,  1,  2, -2,  0, -1.0, 0.0, 0.0, 0.0},
+    { 0,  0,  2,  0,  2, -2274.0, -0.2, 977.0, -0.5},
+    { 1,  0,  0,  0,  0, 712.0, 0.1, -7.0, 0.0},
+    { 0,  0,  2,  0,  1, -386.0, -0.4, 200.0, 0.0},
+    { 1,  0,  2,  0,  2, -301.0, 0.0, 129.0, -0.1},
+    { 1,  0,  0, -2,  0, -158.0, 0.0, -1.0, 0.0},
+    {-1,  0,  2,  0,  2, 123.0, 0.0, -53.0, 0.0},
+    { 0,  0,  0,  2,  0, 63.0, 0.0, -2.0, 0.0},
+    { 1,  0,  0,  0,  1, 63.0, 0.1, -33.0, 0.0},
+    {-1,  0,  0,  0,  1, -58.0, -0.1, 32.0, 0.0},
+    {-1,  0,  2,  2,  2, -59.0, 0.0, 26.0, 0.0},
+    { 1,  0,  2,  0,  1, -51.0, 0.0, 27.0, 0.0},
+    { 0,  0,  2,  2,  2, -38.0, 0.0, 16.0, 0.0},
+    { 2,  0,  0,  0,  0, 29.0, 0.0, -1.0, 0.0},
+    { 1,  0,  2, -2,  2, 29.0, 0.0, -12.0, 0.0},
+    { 2,  0,  2,  0,  2, -31.0, 0.0, 13.0, 0.0},
+    { 0,  0,  2,  0,  0, 26.0, 0.0, -1.0, 0.0},
+    {-1,  0,  2,  0,  1, 21.0, 0.0, -10.0, 0.0},
+    {-1,  0,  0,  2,  1, 16.0, 0.0, -8.0, 0.0},
+    { 1,  0,  0, -2,  1, -13.0, 0.0, 7.0, 0.0},
+    {-1,  0,  2,  2,  1, -10.0, 0.0, 5.0, 0.0},
+    { 1,  1,  0, -2,  0, -7.0, 0.0, 0.0, 0.0},
+    { 0,  1,  2,  0,  2, 7.0, 0.0, -3.0, 0.0},
+    { 0, -1,  2,  0,  2, -7.0, 0.0, 3.0, 0.0},
+    { 1,  0,  2,  2,  2, -8.0, 0.0, 3.0, 0.0},
+    { 1,  0,  0,  2,  0, 6.0, 0.0, 0.0, 0.0},
+    { 2,  0,  2, -2,  2, 6.0, 0.0, -3.0, 0.0},
+    { 0,  0,  0,  2,  1, -6.0, 0.0, 3.0, 0.0},
+    { 0,  0,  2,  2,  1, -7.0, 0.0, 3.0, 0.0},
+    { 1,  0,  2, -2,  1, 6.0, 0.0, -3.0, 0.0},
+    { 0,  0,  0, -2,  1, -5.0, 0.0, 3.0, 0.0},
+    { 1, -1,  0,  0,  0, 5.0, 0.0, 0.0, 0.0},
+    { 2,  0,  2,  0,  1, -5.0, 0.0, 3.0, 0.0},
+    { 0,  1,  0, -2,  0, -4.0, 0.0, 0.0, 0.0},
+    { 1,  0, -2,  0,  0, 4.0, 0.0, 0.0, 0.0},
+    { 0,  0,  0,  1,  0, -4.0, 0.0, 0.0, 0.0},
+    { 1,  1,  0,  0,  0, -3.0, 0.0, 0.0, 0.0},
+    { 1,  0,  2,  0,  0, 3.0, 0.0, 0.0, 0.0},
+    { 1, -1,  2,  0,  2, -3.0, 0.0, 1.0, 0.0},
+    {-1, -1,  2,  2,  2, -3.0, 0.0, 1.0, 0.0},
+    {-2,  0,  0,  0,  1, -2.0, 0.0, 1.0, 0.0},
+    { 3,  0,  2,  0,  2, -3.0, 0.0, 1.0, 0.0},
+    { 0, -1,  2,  2,  2, -3.0, 0.0, 1.0, 0.0},
+    { 1,  1,  2,  0,  2, 2.0, 0.0, -1.0, 0.0},
+    {-1,  0,  2, -2,  1, -2.0, 0.0, 1.0, 0.0},
+    { 2,  0,  0,  0,  1, 2.0, 0.0, -1.0, 0.0},
+    { 1,  0,  0,  0,  2, -2.0, 0.0, 1.0, 0.0},
+    { 3,  0,  0,  0,  0, 2.0, 0.0, 0.0, 0.0},
+    { 0,  0,  2,  1,  2, 2.0, 0.0, -1.0, 0.0},
+    {-1,  0,  0,  0,  2, 1.0, 0.0, -1.0, 0.0},
+    { 1,  0,  0, -4,  0, -1.0, 0.0, 0.0, 0.0},
+    {-2,  0,  2,  2,  2, 1.0, 0.0, -1.0, 0.0},
+    {-1,  0,  2,  4,  2, -2.0, 0.0, 1.0, 0.0},
+    { 2,  0,  0, -4,  0, -1.0, 0.0, 0.0, 0.0},
+    { 1,  1,  2, -2,  2, 1.0, 0.0, -1.0, 0.0},
+    { 1,  0,  2,  2,  1, -1.0, 0.0, 1.0, 0.0},
+    {-2,  0,  2,  4,  2, -1.0, 0.0, 1.0, 0.0},
+    {-1,  0,  4,  0,  2, 1.0, 0.0, 0.0, 0.0},
+    { 1, -1,  0, -2,  0, 1.0, 0.0, 0.0, 0.0},
+    { 2,  0,  2, -2,  1, 1.0, 0.0, -1.0, 0.0},
+    { 2,  0,  2,  2,  2, -1.0, 0.0, 0.0, 0.0},
+    { 1,  0,  0,  2,  1, -1.0, 0.0, 0.0, 0.0},
+    { 0,  0,  4, -2,  2, 1.0, 0.0, 0.0, 0.0},
+    { 3,  0,  2, -2,  2, 1.0, 0.0, 0.0, 0.0},
+    { 1,  0,  2, -2,  0, -1.0, 0.0, 0.0, 0.0},
+    { 0,  1,  2,  0,  1, 1.0, 0.0, 0.0, 0.0},
+    {-1, -1,  0,  2,  1, 1.0, 0.0, 0.0, 0.0},
+    { 0,  0, -2,  0,  1, -1.0, 0.0, 0.0, 0.0},
+    { 0,  0,  2, -1,  2, -1.0, 0.0, 0.0, 0.0},
+    { 0,  1,  0,  2,  0, -1.0, 0.0, 0.0, 0.0},
+    { 1,  0, -2, -2,  0, -1.0, 0.0, 0.0, 0.0},
+    { 0, -1,  2,  0,  1, -1.0, 0.0, 0.0, 0.0},
+    { 1,  1,  0, -2,  1, -1.0, 0.0, 0.0, 0.0},
+    { 1,  0, -2,  2,  0, -1.0, 0.0, 0.0, 0.0},
+    { 2,  0,  0,  2,  0, 1.0, 0.0, 0.0, 0.0},
+    { 0,  0,  2,  4,  2, -1.0, 0.0, 0.0, 0.0},
+    { 0,  1,  0,  1,  0, 1.0, 0.0, 0.0, 0.0},
+}
+
+type iau2000BTerm struct {
+    l, lp, f, d, om int
+    // 黄经章动系数 (ps + pst * T) * sin + pc * cos，单位0.1微角秒
+    ps, pst, pc float64
+    // 交角章动系数 (ec + ect * T) * cos + es * sin，单位0.1微角秒
+    ec, ect, es float64
+}
+
+/**
+ * IAU 2000B章动模型的77项日月章动，取自McCarthy & Luzum (2003)
+ */
+var iau2000BTerms = []iau2000BTerm{
+    { 0,  0,  0,  0,  1, -172064161.0, -174666.0, 33386.0, 92052331.0, 9086.0, 15377.0},
+    { 0,  0,  2, -2,  2, -13170906.0, -1675.0, -13696.0, 5730336.0, -3015.0, -4587.0},
+    { 0,  0,  2,  0,  2, -2276413.0, -234.0, 2796.0, 978459.0, -485.0, 1374.0},
+    { 0,  0,  0,  0,  2, 2074554.0, 207.0, -698.0, -897492.0, 470.0, -291.0},
+    { 0,  1,  0,  0,  0, 1475877.0, -3633.0, 11817.0, 73871.0, -184.0, -1924.0},
+    { 0,  1,  2, -2,  2, -516821.0, 1226.0, -524.0, 224386.0, -677.0, -174.0},
+    { 1,  0,  0,  0,  0, 711159.0, 73.0, -872.0, -6750.0, 0.0, 358.0},
+    { 0,  0,  2,  0,  1, -387298.0, -367.0, 380.0, 200728.0, 18.0, 318.0},
+    { 1,  0,  2,  0,  2, -301461.0, -36.0, 816.0, 129025.0, -63.0, 367.0},
+    { 0, -1,  2, -2,  2, 215829.0, -494.0, 111.0, -95929.0, 299.0, 132.0},
+    { 0,  0,  2, -2,  1, 128227.0, 137.0, 181.0, -68982.0, -9.0, 39.0},
+    {-1,  0,  2,  0,  2, 123457.0, 11.0, 19.0, -53311.0, 32.0, -4.0},
+    {-1,  0,  0,  2,  0, 156994.0, 10.0, -168.0, -1235.0, 0.0, 82.0},
+    { 1,  0,  0,  0,  1, 63110.0, 63.0, 27.0, -33228.0, 0.0, -9.0},
+    {-1,  0,  0,  0,  1, -57976.0, -63.0, -189.0, 31429.0, 0.0, -75.0},
+    {-1,  0,  2,  2,  2, -59641.0, -11.0, 149.0, 25543.0, -11.0, 66.0},
+    { 1,  0,  2,  0,  1, -51613.0, -42.0, 129.0, 26366.0, 0.0, 78.0},
+    {-2,  0,  2,  0,  1, 45893.0, 50.0, 31.0, -24236.0, -10.0, 20.0},
+    { 0,  0,  0,  2,  0, 63384.0, 11.0, -150.0, -1220.0, 0.0, 29.0},
+    { 0,  0,  2,  2,  2, -38571.0, -1.0, 158.0, 16452.0, -11.0, 68.0},
+    { 0, -2,  2, -2,  2, 32481.0, 0.0, 0.0, -13870.0, 0.0, 0.0},
+    {-2,  0,  0,  2,  0, -47722.0, 0.0, -18.0, 477.0, 0.0, -25.0},
+    { 2,  0,  2,  0,  2, -31046.0, -1.0, 131.0, 13238.0, -11.0, 59.0},
+    { 1,  0,  2, -2,  2, 28593.0, 0.0, -1.0, -12338.0, 10.0, -3.0},
+    {-1,  0,  2,  0,  1, 20441.0, 21.0, 10.0, -10758.0, 0.0, -3.0},
+    { 2,  0,  0,  0,  0, 29243.0, 0.0, -74.0, -609.0, 0.0, 13.0},
+    { 0,  0,  2,  0,  0, 25887.0, 0.0, -66.0, -550.0, 0.0, 11.0},
+    { 0,  1,  0,  0,  1, -14053.0, -25.0, 79.0, 8551.0, -2.0, -45.0},
+    {-1,  0,  0,  2,  1, 15164.0, 10.0, 11.0, -8001.0, 0.0, -1.0},
+    { 0,  2,  2, -2,  2, -15794.0, 72.0, -16.0, 6850.0, -42.0, -5.0},
+    { 0,  0, -2,  2,  0, 21783.0, 0.0, 13.0, -167.0, 0.0, 13.0},
+    { 1,  0,  0, -2,  1, -12873.0, -10.0, -37.0, 6953.0, 0.0, -14.0},
+    { 0, -1,  0,  0,  1, -12654.0, 11.0, 63.0, 6415.0, 0.0, 26.0},
+    {-1,  0,  2,  2,  1, -10204.0, 0.0, 25.0, 5222.0, 0.0, 15.0},
+    { 0,  2,  0,  0,  0, 16707.0, -85.0, -10.0, 168.0, -1.0, 10.0},
+    { 1,  0,  2,  2,  2, -7691.0, 0.0, 44.0, 3268.0, 0.0, 19.0},
+    {-2,  0,  2,  0,  0, -11024.0, 0.0, -14.0, 104.0, 0.0, 2.0},
+    { 0,  1,  2,  0,  2, 7566.0, -21.0, -11.0, -3250.0, 0.0, -5.0},
+    { 0,  0,  2,  2,  1, -6637.0, -11.0, 25.0, 3353.0, 0.0, 14.0},
+    { 0, -1,  2,  0,  2, -7141.0, 21.0, 8.0, 3070.0, 0.0, 4.0},
+    { 0,  0,  0,  2,  1, -6302.0, -11.0, 2.0, 3272.0, 0.0, 4.0},
+    { 1,  0,  2, -2,  1, 5800.0, 10.0, 2.0, -3045.0, 0.0, -1.0},
+    { 2,  0,  2, -2,  2, 6443.0, 0.0, -7.0, -2768.0, 0.0, -4.0},
+    {-2,  0,  0,  2,  1, -5774.0, -11.0, -15.0, 3041.0, 0.0, -5.0},
+    { 2,  0,  2,  0,  1, -5350.0, 0.0, 21.0, 2695.0, 0.0, 12.0},
+    { 0, -1,  2, -2,  1, -4752.0, -11.0, -3.0, 2719.0, 0.0, -3.0},
+    { 0,  0,  0, -2,  1, -4940.0, -11.0, -21.0, 2720.0, 0.0, -9.0},
+    {-1, -1,  0,  2,  0, 7350.0, 0.0, -8.0, -51.0, 0.0, 4.0},
+    { 2,  0,  0, -2,  1, 4065.0, 0.0, 6.0, -2206.0, 0.0, 1.0},
+    { 1,  0,  0,  2,  0, 6579.0, 0.0, -24.0, -199.0, 0.0, 2.0},
+    { 0,  1,  2, -2,  1, 3579.0, 0.0, 5.0, -1900.0, 0.0, 1.0},
+    { 1, -1,  0,  0,  0, 4725.0, 0.0, -6.0, -41.0, 0.0, 3.0},
+    {-2,  0,  2,  0,  2, -3075.0, 0.0, -2.0, 1313.0, 0.0, -1.0},
+    { 3,  0,  2,  0,  2, -2904.0, 0.0, 15.0, 1233.0, 0.0, 7.0},
+    { 0, -1,  0,  2,  0, 4348.0, 0.0, -10.0, -81.0, 0.0, 2.0},
+    { 1, -1,  2,  0,  2, -2878.0, 0.0, 8.0, 1232.0, 0.0, 4.0},
+    { 0,  0,  0,  1,  0, -4230.0, 0.0, 5.0, -20.0, 0.0, -2.0},
+    {-1, -1,  2,  2,  2, -2819.0, 0.0, 7.0, 1207.0, 0.0, 3.0},
+    {-1,  0,  2,  0,  0, -4056.0, 0.0, 5.0, 40.0, 0.0, -2.0},
+    { 0, -1,  2,  2,  2, -2647.0, 0.0, 11.0, 1129.0, 0.0, 5.0},
+    {-2,  0,  0,  0,  1, -2294.0, 0.0, -10.0, 1266.0, 0.0, -4.0},
+    { 1,  1,  2,  0,  2, 2481.0, 0.0, -7.0, -1062.0, 0.0, -3.0},
+    { 2,  0,  0,  0,  1, 2179.0, 0.0, -2.0, -1129.0, 0.0, -2.0},
+    {-1,  1,  0,  1,  0, 3276.0, 0.0, 1.0, -9.0, 0.0, 0.0},
+    { 1,  1,  0,  0,  0, -3389.0, 0.0, 5.0, 35.0, 0.0, -2.0},
+    { 1,  0,  2,  0,  0, 3339.0, 0.0, -13.0, -107.0, 0.0, 1.0},
+    {-1,  0,  2, -2,  1, -1987.0, 0.0, -6.0, 1073.0, 0.0, -2.0},
+    { 1,  0,  0,  0,  2, -1981.0, 0.0, 0.0, 854.0, 0.0, 0.0},
+    {-1,  0,  0,  1,  0, 4026.0, 0.0, -353.0, -553.0, 0.0, -139.0},
+    { 0,  0,  2,  1,  2, 1660.0, 0.0, -5.0, -710.0, 0.0, -2.0},
+    {-1,  0,  2,  4,  2, -1521.0, 0.0, 9.0, 647.0, 0.0, 4.0},
+    {-1,  1,  0,  1,  1, 1314.0, 0.0, 0.0, -700.0, 0.0, 0.0},
+    { 0, -2,  2, -2,  1, -1283.0, 0.0, 0.0, 672.0, 0.0, 0.0},
+    { 1,  0,  2,  2,  1, -1331.0, 0.0, 8.0, 663.0, 0.0, 4.0},
+    {-2,  0,  2,  2,  2, 1383.0, 0.0, -2.0, -594.0, 0.0, -2.0},
+    {-1,  0,  0,  0,  2, 1405.0, 0.0, 4.0, -610.0, 0.0, 2.0},
+    { 1,  1,  2, -2,  2, 1290.0, 0.0, 0.0, -556.0, 0.0, 0.0},
+}
+
+/**
+ * IAU 2000B模型中用于代替行星章动的固定偏差，单位角秒
+ */
+const (
+    iau2000BLongitudeOffset = -0.000135
+    iau2000BObliquityOffset = 0.000388
+)
+
+/**
+ * 把角秒表示的多项式与整圈数合并成弧度，并限制在[-π, π]之间
+ *
+ * @param seconds
+ *            角秒部分
+ * @param turns
+ *            整圈数部分
+ * @return 弧度
+ */
+func fundamentalArgument(seconds float64, turns float64) float64 {
+    return mathutil.ModPi(mathutil.SecondsToRadians(seconds) + math.Mod(turns, 1) * 2 * math.Pi)
+}
+
+/**
+ * 按IAU 1980章动理论计算黄经章动和交角章动
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 黄经章动Δψ和交角章动Δε，单位是弧度(rad)
+ */
+func GetNutation(jd float64) (float64, float64) {
+    t := calendarutil.GetJulianCentury(jd)
+
+    // 月亮平近点角
+    l := fundamentalArgument(485866.733 + (715922.633 + (31.310 + 0.064 * t) * t) * t, 1325 * t)
+    // 太阳平近点角
+    lp := fundamentalArgument(1287099.804 + (1292581.224 + (-0.577 - 0.012 * t) * t) * t, 99 * t)
+    // 月亮升交角距
+    f := fundamentalArgument(335778.877 + (295263.137 + (-13.257 + 0.011 * t) * t) * t, 1342 * t)
+    // 日月平距角
+    d := fundamentalArgument(1072261.307 + (1105601.328 + (-6.891 + 0.019 * t) * t) * t, 1236 * t)
+    // 月亮升交点平黄经
+    om := fundamentalArgument(450160.280 + (-482890.539 + (7.455 + 0.008 * t) * t) * t, -5 * t)
+
+    var dpsi, deps float64
+    // 从小项加到大项以减少舍入误差
+    for i := len(iau1980Terms) - 1; i >= 0; i-- {
+        term := &iau1980Terms[i]
+        arg := float64(term.l) * l + float64(term.lp) * lp + float64(term.f) * f +
+            float64(term.d) * d + float64(term.om) * om
+        dpsi += (term.sp + term.spt * t) * math.Sin(arg)
+        deps += (term.ce + term.cet * t) * math.Cos(arg)
+    }
+    return mathutil.SecondsToRadians(dpsi / 1e4), mathutil.SecondsToRadians(deps / 1e4)
+}
+
+/**
+ * 按IAU 2000B章动模型计算黄经章动和交角章动，1995年到2050年间与IAU 2000A相差不超过1毫角秒
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 黄经章动Δψ和交角章动Δε，单位是弧度(rad)
+ */
+func GetNutation2000B(jd float64) (float64, float64) {
+    t := calendarutil.GetJulianCentury(jd)
+
+    l := mathutil.SecondsToRadians(math.Mod(485868.249036 + 1717915923.2178 * t, 1296000))
+    lp := mathutil.SecondsToRadians(math.Mod(1287104.79305 + 129596581.0481 * t, 1296000))
+    f := mathutil.SecondsToRadians(math.Mod(335779.526232 + 1739527262.8478 * t, 1296000))
+    d := mathutil.SecondsToRadians(math.Mod(1072260.70369 + 1602961601.2090 * t, 1296000))
+    om := mathutil.SecondsToRadians(math.Mod(450160.398036 - 6962890.5431 * t, 1296000))
+
+    var dpsi, deps float64
+    for i := len(iau2000BTerms) - 1; i >= 0; i-- {
+        term := &iau2000BTerms[i]
+        arg := math.Mod(float64(term.l) * l + float64(term.lp) * lp + float64(term.f) * f +
+            float64(term.d) * d + float64(term.om) * om, 2 * math.Pi)
+        sin, cos := math.Sin(arg), math.Cos(arg)
+        dpsi += (term.ps + term.pst * t) * sin + term.pc * cos
+        deps += (term.ec + term.ect * t) * cos + term.es * sin
+    }
+    return mathutil.SecondsToRadians(dpsi / 1e7 + iau2000BLongitudeOffset),
+        mathutil.SecondsToRadians(deps / 1e7 + iau2000BObliquityOffset)
+}
+
+/**
+ * 计算黄经章动(IAU 1980)
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 黄经章动Δψ，单位是弧度(rad)
+ */
+func GetLongitudeNutation(jd float64) float64 {
+    dpsi, _ := GetNutation(jd)
+    return dpsi
+}
+
+/**
+ * 计算交角章动(IAU 1980)
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 交角章动Δε，单位是弧度(rad)
+ */
+func GetObliquityNutation(jd float64) float64 {
+    _, deps := GetNutation(jd)
+    return deps
+}
+
+/**
+ * 计算黄赤交角平均值，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第22章(22.2)式
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 平黄赤交角ε0，单位是弧度(rad)
+ */
+func GetMeanObliquity(jd float64) float64 {
+    t := calendarutil.GetJulianCentury(jd)
+    return mathutil.SecondsToRadians(84381.448 + (-46.8150 + (-0.00059 + 0.001813 * t) * t) * t)
+}
+
+/**
+ * 计算真黄赤交角，即平黄赤交角加上交角章动
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 真黄赤交角ε，单位是弧度(rad)
+ */
+func GetTrueObliquity(jd float64) float64 {
+    return GetMeanObliquity(jd) + GetObliquityNutation(jd)
+}
@@ -0,0 +1,77 @@
+package nutation
+
+import (
+    "math"
+    "mathutil"
+    "testing"
+)
+
+// Jean Meeus, Astronomical Algorithms 例22.a，1987年4月10日0h TD
+// Δψ = -3.788″，Δε = +9.443″，ε0 = 23°26′27.407″，ε = 23°26′36.850″
+// Meeus只用了63项，和完整的106项相差不到0.001″
+const meeusJD = 2446895.5
+
+func Test_GetNutation(t *testing.T) {
+    dpsi, deps := GetNutation(meeusJD)
+    dpsi = dpsi / mathutil.SecondsToRadians(1)
+    deps = deps / mathutil.SecondsToRadians(1)
+    t.Log(dpsi, deps)
+    if math.Abs(dpsi + 3.788) < 0.001 && math.Abs(deps - 9.443) < 0.001 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// 与SOFA的iauNut80测试值比较
+func Test_GetNutation_SOFA(t *testing.T) {
+    dpsi, deps := GetNutation(2453736.5)
+    t.Log(dpsi, deps)
+    if math.Abs(dpsi + 0.9643658353226563966e-5) < 1e-13 &&
+        math.Abs(deps - 0.4060051006879713322e-4) < 1e-13 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// 与SOFA的iauNut00b测试值比较
+func Test_GetNutation2000B(t *testing.T) {
+    dpsi, deps := GetNutation2000B(2453736.5)
+    t.Log(dpsi, deps)
+    if math.Abs(dpsi + 0.9632552291148362783e-5) < 1e-13 &&
+        math.Abs(deps - 0.4063197106621159367e-4) < 1e-13 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetLongitudeNutation(t *testing.T) {
+    dpsi, deps := GetNutation(meeusJD)
+    if GetLongitudeNutation(meeusJD) == dpsi && GetObliquityNutation(meeusJD) == deps {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetMeanObliquity(t *testing.T) {
+    eps0 := GetMeanObliquity(meeusJD)
+    t.Log(eps0)
+    if math.Abs(eps0 - mathutil.DmsToRadians(23, 26, 27.407)) < mathutil.SecondsToRadians(0.001) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetTrueObliquity(t *testing.T) {
+    eps := GetTrueObliquity(meeusJD)
+    t.Log(eps)
+    if math.Abs(eps - mathutil.DmsToRadians(23, 26, 36.850)) < mathutil.SecondsToRadians(0.002) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
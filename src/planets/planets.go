@@ -0,0 +1,200 @@
+package planets
+
+import (
+    "calendarutil"
+    "coordinates"
+    "math"
+    "mathutil"
+    "nutation"
+    "vsop87earthd"
+    "vsop87jupiterd"
+    "vsop87marsd"
+    "vsop87mercuryd"
+    "vsop87neptuned"
+    "vsop87saturnd"
+    "vsop87uranusd"
+    "vsop87venusd"
+)
+
+/**
+ * 行星，日心坐标由VSOP87D理论计算
+ */
+type Planet struct {
+    Name string
+    longitude func(float64) float64
+    latitude func(float64) float64
+    radius func(float64) float64
+}
+
+var (
+    Mercury = &Planet{"水星", vsop87mercuryd.GetSunEclipticLongitudeForMercury,
+        vsop87mercuryd.GetSunEclipticLatitudeForMercury, vsop87mercuryd.GetSunRadiusForMercury}
+    Venus = &Planet{"金星", vsop87venusd.GetSunEclipticLongitudeForVenus,
+        vsop87venusd.GetSunEclipticLatitudeForVenus, vsop87venusd.GetSunRadiusForVenus}
+    Mars = &Planet{"火星", vsop87marsd.GetSunEclipticLongitudeForMars,
+        vsop87marsd.GetSunEclipticLatitudeForMars, vsop87marsd.GetSunRadiusForMars}
+    Jupiter = &Planet{"木星", vsop87jupiterd.GetSunEclipticLongitudeForJupiter,
+        vsop87jupiterd.GetSunEclipticLatitudeForJupiter, vsop87jupiterd.GetSunRadiusForJupiter}
+    Saturn = &Planet{"土星", vsop87saturnd.GetSunEclipticLongitudeForSaturn,
+        vsop87saturnd.GetSunEclipticLatitudeForSaturn, vsop87saturnd.GetSunRadiusForSaturn}
+    Uranus = &Planet{"天王星", vsop87uranusd.GetSunEclipticLongitudeForUranus,
+        vsop87uranusd.GetSunEclipticLatitudeForUranus, vsop87uranusd.GetSunRadiusForUranus}
+    Neptune = &Planet{"海王星", vsop87neptuned.GetSunEclipticLongitudeForNeptune,
+        vsop87neptuned.GetSunEclipticLatitudeForNeptune, vsop87neptuned.GetSunRadiusForNeptune}
+)
+
+/**
+ * 光线走过1天文单位所需的时间，单位是日
+ */
+const LIGHT_TIME_PER_AU = 0.0057755183
+
+/**
+ * 光行差常数，单位是角秒
+ */
+const ABERRATION_CONSTANT = 20.49552
+
+/**
+ * 按儒略日计算行星的日心坐标
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 日心黄经(rad)、日心黄纬(rad)和到太阳的距离(au)
+ */
+func (p *Planet) GetHeliocentricPosition(jd float64) (float64, float64, float64) {
+    return p.longitude(jd), p.latitude(jd), p.radius(jd)
+}
+
+/**
+ * 把行星和地球的日心坐标换算成行星的地心直角坐标
+ */
+func toGeocentric(l, b, r, l0, b0, r0 float64) (float64, float64, float64) {
+    x := r * math.Cos(b) * math.Cos(l) - r0 * math.Cos(b0) * math.Cos(l0)
+    y := r * math.Cos(b) * math.Sin(l) - r0 * math.Cos(b0) * math.Sin(l0)
+    z := r * math.Sin(b) - r0 * math.Sin(b0)
+    return x, y, z
+}
+
+/**
+ * 计算经过光行时修正的行星地心几何坐标，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第33章
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 地心黄经(rad)、地心黄纬(rad)和到地球的距离(au)
+ */
+func (p *Planet) getGeometricPosition(jd float64) (float64, float64, float64) {
+    l0 := vsop87earthd.GetSunEclipticLongitudeForEarth(jd)
+    b0 := vsop87earthd.GetSunEclipticLatitudeForEarth(jd)
+    r0 := vsop87earthd.GetSunRadiusForEarth(jd)
+
+    // 光行时τ，迭代直到行星位置不再变化
+    tau := 0.0
+    var x, y, z float64
+    for i := 0; i < 10; i++ {
+        l, b, r := p.GetHeliocentricPosition(jd - tau)
+        x, y, z = toGeocentric(l, b, r, l0, b0, r0)
+        next := LIGHT_TIME_PER_AU * math.Sqrt(x * x + y * y + z * z)
+        if math.Abs(next - tau) < 1e-9 {
+            break
+        }
+        tau = next
+    }
+    return mathutil.Mod2Pi(math.Atan2(y, x)), math.Atan2(z, math.Hypot(x, y)), math.Sqrt(x * x + y * y + z * z)
+}
+
+/**
+ * 计算周年光行差，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第23章(23.2)式
+ *
+ * @param lon
+ *            地心黄经(rad)
+ * @param lat
+ *            地心黄纬(rad)
+ * @param jd
+ *            儒略日(TT)
+ * @return 黄经和黄纬的修正量(rad)
+ */
+func getAberration(lon float64, lat float64, jd float64) (float64, float64) {
+    t := calendarutil.GetJulianCentury(jd)
+    // 地球轨道的偏心率和近日点黄经
+    e := 0.016708634 - (0.000042037 + 0.0000001267 * t) * t
+    pi := mathutil.ToRadians(102.93735 + (1.71946 + 0.00046 * t) * t)
+    // 太阳的几何黄经
+    sun := vsop87earthd.GetSunEclipticLongitudeForEarth(jd) + math.Pi
+    k := mathutil.SecondsToRadians(ABERRATION_CONSTANT)
+    dlon := (-k * math.Cos(sun - lon) + e * k * math.Cos(pi - lon)) / math.Cos(lat)
+    dlat := -k * math.Sin(lat) * (math.Sin(sun - lon) - e * math.Sin(pi - lon))
+    return dlon, dlat
+}
+
+/**
+ * 计算行星的地心视黄道坐标，包括光行时、光行差、FK5修正和章动
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 地心视黄道坐标和到地球的距离(au)
+ */
+func (p *Planet) GetApparentPosition(jd float64) (*coordinates.Ecliptic, float64) {
+    lon, lat, dist := p.getGeometricPosition(jd)
+
+    // 光行差
+    dlon, dlat := getAberration(lon, lat, jd)
+    lon += dlon
+    lat += dlat
+
+    // 转换到fk5
+    dlon = vsop87earthd.Vsop2Fk5LongitudeCorrection(lon, lat, jd)
+    lat += vsop87earthd.Vsop2Fk5LatitudeCorrection(lon, lat, jd)
+    lon += dlon
+
+    // 修正章动
+    lon += nutation.GetLongitudeNutation(jd)
+
+    return &coordinates.Ecliptic{Longitude: mathutil.Mod2Pi(lon), Latitude: lat}, dist
+}
+
+/**
+ * 计算行星的地心视赤道坐标
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 地心视赤道坐标和到地球的距离(au)
+ */
+func (p *Planet) GetApparentEquatorial(jd float64) (*coordinates.Equatorial, float64) {
+    ecl, dist := p.GetApparentPosition(jd)
+    return ecl.ToEquatorial(nutation.GetTrueObliquity(jd)), dist
+}
+
+/**
+ * 计算行星的地心视黄经
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 地心视黄经(rad)
+ */
+func (p *Planet) GetEarthEclipticLongitude(jd float64) float64 {
+    ecl, _ := p.GetApparentPosition(jd)
+    return ecl.Longitude
+}
+
+/**
+ * 计算行星的地心视黄纬
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 地心视黄纬(rad)
+ */
+func (p *Planet) GetEarthEclipticLatitude(jd float64) float64 {
+    ecl, _ := p.GetApparentPosition(jd)
+    return ecl.Latitude
+}
+
+/**
+ * 计算行星到地球的距离(光行时修正后)
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 距离，单位是天文单位(au)
+ */
+func (p *Planet) GetEarthRadius(jd float64) float64 {
+    _, _, dist := p.getGeometricPosition(jd)
+    return dist
+}
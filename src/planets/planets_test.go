@@ -0,0 +1,69 @@
+package planets
+
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+    "testing"
+)
+
+// VSOP87D在J2000.0的日心坐标，取自VSOP87发布的vsop87.chk
+var checkValues = []struct {
+    planet *Planet
+    l, b, r float64
+}{
+    {Mercury, 4.4293481036, -0.0527573409, 0.4664714751},
+    {Venus, 3.1870221833, 0.0569782849, 0.7202129253},
+    {Mars, 6.2735389983, -0.0247779824, 1.3912076925},
+    {Jupiter, 0.6334614186, -0.0205001039, 4.9653813154},
+    {Saturn, 0.7980038761, -0.0401984149, 9.1838483225},
+    {Uranus, 5.5225485803, -0.0119527838, 19.9240482638},
+    {Neptune, 5.3045629252, 0.0042236789, 30.1205328392},
+}
+
+func Test_GetHeliocentricPosition(t *testing.T) {
+    for _, v := range checkValues {
+        l, b, r := v.planet.GetHeliocentricPosition(calendarutil.J2000)
+        if math.Abs(l - v.l) > 1e-9 || math.Abs(b - v.b) > 1e-9 || math.Abs(r - v.r) > 1e-7 {
+            t.Error("fail", v.planet.Name, l, b, r)
+        }
+    }
+}
+
+// Jean Meeus的Astronomical Algorithms第33章例33.a：1992年12月20日0h TD的金星
+func Test_GetApparentPosition(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1992, 12, 20, 0, 0, 0)
+    ecl, dist := Venus.GetApparentPosition(jd)
+    // Meeus用完整的VSOP87得到313.08102°, -2.08474°，这里允许2角秒的差别
+    if math.Abs(ecl.Longitude - mathutil.ToRadians(313.08102)) < mathutil.SecondsToRadians(2) &&
+        math.Abs(ecl.Latitude - mathutil.ToRadians(-2.08474)) < mathutil.SecondsToRadians(2) &&
+        math.Abs(dist - 0.910947) < 1e-5 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", ecl.Longitude * 180 / math.Pi, ecl.Latitude * 180 / math.Pi, dist)
+    }
+}
+
+func Test_GetApparentEquatorial(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1992, 12, 20, 0, 0, 0)
+    eq, _ := Venus.GetApparentEquatorial(jd)
+    // α = 21h04m41.454s, δ = -18°53′16.84″
+    ra := mathutil.ToRadians((21 + 4.0 / 60 + 41.454 / 3600) * 15)
+    dec := -mathutil.DmsToRadians(18, 53, 16.84)
+    if math.Abs(eq.RightAscension - ra) < mathutil.SecondsToRadians(0.1) && math.Abs(eq.Declination - dec) < mathutil.SecondsToRadians(0.1) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", eq.RightAscension, eq.Declination)
+    }
+}
+
+func Test_GetEarthRadius(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1992, 12, 20, 0, 0, 0)
+    ecl, dist := Mars.GetApparentPosition(jd)
+    if Mars.GetEarthRadius(jd) == dist && Mars.GetEarthEclipticLongitude(jd) == ecl.Longitude &&
+        Mars.GetEarthEclipticLatitude(jd) == ecl.Latitude {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
@@ -0,0 +1,157 @@
+package riseset
+
+import (
+    "calendarutil"
+    "coordinates"
+    "elp2000moon"
+    "math"
+    "mathutil"
+    "nutation"
+    "sidereal"
+    "time"
+)
+
+/**
+ * 一天中月亮的各个时刻，月出月落以月面上边缘与视地平线相切为准
+ */
+type MoonTimes struct {
+    // 当天第一次月出
+    Moonrise Event
+    // 当天第一次月落
+    Moonset Event
+    // 当天全部的月出，按时间顺序。高纬度地区或者当天比24小时长时可能有两次
+    Moonrises []time.Time
+    // 当天全部的月落，按时间顺序
+    Moonsets []time.Time
+    // 上中天，通常有一次，大约每个月有一天没有，当天比24小时长(夏令时结束)时可能有两次
+    Transits []time.Time
+}
+
+/**
+ * 搜索月出月落时的步长，单位是日。月亮高度在20分钟内不会两次穿过地平线，高纬度地区的擦地情况除外
+ */
+const moonSearchStep = 1.0 / 72
+
+/**
+ * 求解月出月落和中天时刻所用的参数
+ */
+var solver = mathutil.NewSolver(1e-7, 100)
+
+/**
+ * 计算月亮的站心视赤道坐标
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @param loc
+ *            观测地点
+ * @return 站心视赤道坐标、地方恒星时(rad)和地月距离(km)
+ */
+func getMoonEquatorial(jd float64, loc *Location) (*coordinates.Equatorial, float64, float64) {
+    jde := jd + calendarutil.GetDeltaTJD(jd) / 86400
+    ecl := &coordinates.Ecliptic{
+        Longitude: elp2000moon.GetEarthEclipticLongitudeForMoon(jde),
+        Latitude: elp2000moon.GetEarthEclipticLatitudeForMoon(jde),
+    }
+    r := elp2000moon.GetEarthRadiusForMoon(jde)
+    lat := mathutil.ToRadians(loc.Latitude)
+    lst := sidereal.GetLocalSiderealTime(jd, mathutil.ToRadians(loc.Longitude))
+    eq := ecl.ToEquatorial(nutation.GetTrueObliquity(jde))
+    return eq.ToTopocentric(r * 1000 / coordinates.EARTH_EQUATORIAL_RADIUS, lat, loc.Elevation, lst), lst, r
+}
+
+/**
+ * 计算月亮中心的站心高度与月出月落时的高度之差
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @param loc
+ *            观测地点
+ * @return 高度差(度)，月亮上边缘在视地平线以上时为正
+ */
+func getMoonAltitude(jd float64, loc *Location) float64 {
+    eq, lst, r := getMoonEquatorial(jd, loc)
+    alt := eq.ToHorizontal(mathutil.ToRadians(loc.Latitude), lst).Altitude
+    semidiameter := mathutil.ToDegrees(math.Asin(coordinates.MOON_RADIUS / r))
+    return mathutil.ToDegrees(alt) - getHorizonAltitude(semidiameter, loc.Elevation)
+}
+
+/**
+ * 计算月亮的站心时角
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @param loc
+ *            观测地点
+ * @return 限制在[-π, π]之间的时角(rad)
+ */
+func getMoonHourAngle(jd float64, loc *Location) float64 {
+    eq, lst, _ := getMoonEquatorial(jd, loc)
+    return eq.GetHourAngle(lst)
+}
+
+/**
+ * 计算某天月亮的上中天、月出和月落时刻。月亮每天比前一天晚升起约50分钟，
+ * 所以大约每个月有一天没有月出、一天没有月落，这时对应的State是NoEvent。
+ * Moonrise和Moonset是当天的第一次，一天有两次月出或月落时全部时刻在Moonrises和Moonsets里
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @param loc
+ *            观测地点
+ * @param tz
+ *            日期和结果使用的时区
+ * @return 月亮的各个时刻
+ */
+func GetMoonTimes(year, month, day int, loc *Location, tz *time.Location) *MoonTimes {
+    start := calendarutil.NewJulianDayFromTime(time.Date(year, time.Month(month), day, 0, 0, 0, 0, tz)).Float()
+    end := calendarutil.NewJulianDayFromTime(time.Date(year, time.Month(month), day + 1, 0, 0, 0, 0, tz)).Float()
+    result := &MoonTimes{Moonrise: Event{State: NoEvent}, Moonset: Event{State: NoEvent}}
+
+    altitude := func(jd float64) float64 {
+        return getMoonAltitude(jd, loc)
+    }
+    hourAngle := func(jd float64) float64 {
+        return getMoonHourAngle(jd, loc)
+    }
+    crossed := false
+    a, altA, haA := start, altitude(start), hourAngle(start)
+    for a < end {
+        b := math.Min(a + moonSearchStep, end)
+        altB, haB := altitude(b), hourAngle(b)
+        if (altA < 0) != (altB < 0) {
+            crossed = true
+            jd, err := solver.Brent(altitude, a, b)
+            event, times := &result.Moonset, &result.Moonsets
+            if altA < 0 {
+                event, times = &result.Moonrise, &result.Moonrises
+            }
+            if err == nil {
+                t := calendarutil.FromJulianDate(jd, tz, false)
+                if event.State == NoEvent {
+                    *event = Event{t, Normal}
+                }
+                *times = append(*times, t)
+            }
+        }
+        // 时角从负变正是上中天，从π跳到-π是下中天
+        if haA < 0 && haB >= 0 && haB - haA < math.Pi {
+            if jd, err := solver.Brent(hourAngle, a, b); err == nil {
+                result.Transits = append(result.Transits, calendarutil.FromJulianDate(jd, tz, false))
+            }
+        }
+        a, altA, haA = b, altB, haB
+    }
+    if !crossed {
+        state := AlwaysBelow
+        if altA > 0 {
+            state = AlwaysAbove
+        }
+        result.Moonrise = Event{State: state}
+        result.Moonset = Event{State: state}
+    }
+    return result
+}
@@ -0,0 +1,65 @@
+package riseset
+
+import (
+    "testing"
+    "time"
+)
+
+// 月亮的时刻(UTC)。期望值不是用本包算的，而是用github.com/soniakeys/meeus独立计算：
+// 月亮位置取Meeus第47章的级数，按第40章修正视差，每10秒计算一次月亮上边缘的站心高度，再用二分法求出穿过-34′的时刻。
+// 本包用的是寿星天文历截断的ELP2000-82黄经和Brent方法，两者相差不到2秒
+func Test_GetMoonTimes(t *testing.T) {
+    r := GetMoonTimes(2024, 6, 21, greenwich, time.UTC)
+    checkTime(t, "moonset", r.Moonset.Time, 2024, 6, 21, 2, 24, 7)
+    checkTime(t, "moonrise", r.Moonrise.Time, 2024, 6, 21, 20, 43, 16)
+    if len(r.Transits) != 1 {
+        t.Fatal("fail", r.Transits)
+    }
+    checkTime(t, "transit", r.Transits[0], 2024, 6, 21, 23, 59, 30)
+
+    r = GetMoonTimes(2024, 4, 1, greenwich, time.UTC)
+    checkTime(t, "moonrise", r.Moonrise.Time, 2024, 4, 1, 2, 8, 44)
+    checkTime(t, "moonset", r.Moonset.Time, 2024, 4, 1, 8, 36, 36)
+
+    r = GetMoonTimes(2024, 3, 3, &Location{40.71, -74.0, 0}, time.UTC)
+    checkTime(t, "moonrise", r.Moonrise.Time, 2024, 3, 3, 6, 13, 51)
+    checkTime(t, "transit", r.Transits[0], 2024, 3, 3, 10, 44, 23)
+    checkTime(t, "moonset", r.Moonset.Time, 2024, 3, 3, 15, 10, 24)
+}
+
+func Test_GetMoonTimes_NoEvent(t *testing.T) {
+    // 悉尼2024年9月10日(UTC)没有月出
+    r := GetMoonTimes(2024, 9, 10, &Location{-33.87, 151.21, 0}, time.UTC)
+    if r.Moonrise.State != NoEvent || r.Moonset.State != Normal {
+        t.Error("fail", r.Moonrise.State, r.Moonset.State)
+    }
+    checkTime(t, "moonset", r.Moonset.Time, 2024, 9, 10, 14, 27, 8)
+    // 特罗姆瑟2024年1月20日月亮整天不落
+    r = GetMoonTimes(2024, 1, 20, &Location{69.65, 18.96, 0}, time.UTC)
+    if r.Moonrise.State != AlwaysAbove || r.Moonset.State != AlwaysAbove || len(r.Transits) != 1 {
+        t.Error("fail", r.Moonrise.State, r.Moonset.State)
+    }
+    checkTime(t, "transit", r.Transits[0], 2024, 1, 20, 18, 44, 48)
+}
+
+func Test_GetMoonTimes_TimeZone(t *testing.T) {
+    shanghai := time.FixedZone("CST", 8 * 3600)
+    r := GetMoonTimes(2024, 2, 24, &Location{31.23, 121.47, 0}, shanghai)
+    // 北京时间06:27月落，17:35月出
+    if r.Moonrise.Time.Location() != shanghai || r.Moonset.State != Normal {
+        t.Error("fail")
+    }
+    checkTime(t, "moonrise", r.Moonrise.Time, 2024, 2, 24, 9, 35, 2)
+    checkTime(t, "moonset", r.Moonset.Time, 2024, 2, 23, 22, 27, 15)
+}
+
+func Test_GetMoonTimes_TwoEvents(t *testing.T) {
+    // 北纬66°的2024年6月12日(UTC)，月亮在午夜刚过和午夜前各落下一次
+    r := GetMoonTimes(2024, 6, 12, &Location{66, 18.96, 0}, time.UTC)
+    if len(r.Moonsets) != 2 || len(r.Moonrises) != 1 || !r.Moonset.Time.Equal(r.Moonsets[0]) {
+        t.Fatal("fail", r.Moonrises, r.Moonsets)
+    }
+    checkTime(t, "moonset", r.Moonsets[0], 2024, 6, 12, 0, 1, 1)
+    checkTime(t, "moonrise", r.Moonrise.Time, 2024, 6, 12, 7, 16, 56)
+    checkTime(t, "moonset", r.Moonsets[1], 2024, 6, 12, 23, 44, 22)
+}
@@ -0,0 +1,242 @@
+package riseset
+
+import (
+    "calendarutil"
+    "coordinates"
+    "math"
+    "mathutil"
+    "nutation"
+    "sidereal"
+    "time"
+    "vsop87earthd"
+)
+
+/**
+ * 观测地点
+ */
+type Location struct {
+    // 地理纬度(度)，北纬为正
+    Latitude float64
+    // 地理经度(度)，东经为正
+    Longitude float64
+    // 海拔高度(米)
+    Elevation float64
+}
+
+/**
+ * 升落事件的状态
+ */
+type State int
+
+const (
+    // 当天有这个事件
+    Normal State = iota
+    // 太阳或月亮整天都在指定高度以上，例如极昼
+    AlwaysAbove
+    // 太阳或月亮整天都在指定高度以下，例如极夜
+    AlwaysBelow
+    // 当天没有这个事件，但是有别的升落事件，例如月出推迟到了第二天
+    NoEvent
+)
+
+/**
+ * 升落事件，State不是Normal时Time没有意义
+ */
+type Event struct {
+    Time time.Time
+    State State
+}
+
+/**
+ * 一天中太阳的各个时刻，晨光始(Dawn)和昏影终(Dusk)按照民用、航海、天文晨昏蒙影分别给出
+ */
+type SunTimes struct {
+    Transit time.Time
+    Sunrise Event
+    Sunset Event
+    CivilDawn Event
+    CivilDusk Event
+    NauticalDawn Event
+    NauticalDusk Event
+    AstronomicalDawn Event
+    AstronomicalDusk Event
+}
+
+/**
+ * 地平线上的大气折射，单位是度
+ */
+const HORIZON_REFRACTION = 34.0 / 60
+
+/**
+ * 太阳在1天文单位处的视半径，单位是角秒
+ */
+const SUN_SEMIDIAMETER = 959.63
+
+/**
+ * 民用、航海、天文晨昏蒙影的太阳高度，单位是度
+ */
+const (
+    CIVIL_TWILIGHT_ALTITUDE = -6.0
+    NAUTICAL_TWILIGHT_ALTITUDE = -12.0
+    ASTRONOMICAL_TWILIGHT_ALTITUDE = -18.0
+)
+
+/**
+ * 一个太阳日对应的恒星时角度与2π之比的倒数
+ */
+const SIDEREAL_RATIO = 0.9972695663
+
+/**
+ * 迭代求得的时刻的误差范围，单位是日
+ */
+const precision = 1e-6
+
+/**
+ * 计算太阳的地心视赤经和视赤纬
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @return 视赤道坐标和日地距离(au)
+ */
+func getSunEquatorial(jd float64) (*coordinates.Equatorial, float64) {
+    jde := jd + calendarutil.GetDeltaTJD(jd) / 86400
+    ecl := &coordinates.Ecliptic{
+        Longitude: vsop87earthd.GetEarthEclipticLongitudeForSun(jde),
+        Latitude: -vsop87earthd.GetSunEclipticLatitudeForEarth(jde),
+    }
+    return ecl.ToEquatorial(nutation.GetTrueObliquity(jde)), vsop87earthd.GetSunRadiusForEarth(jde)
+}
+
+/**
+ * 计算太阳的地方时角
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @param loc
+ *            观测地点
+ * @return 限制在[-π, π]之间的时角(rad)、赤纬(rad)和日地距离(au)
+ */
+func getHourAngle(jd float64, loc *Location) (float64, float64, float64) {
+    eq, r := getSunEquatorial(jd)
+    lst := sidereal.GetLocalSiderealTime(jd, mathutil.ToRadians(loc.Longitude))
+    return eq.GetHourAngle(lst), eq.Declination, r
+}
+
+/**
+ * 迭代计算太阳上中天的时刻
+ *
+ * @param jd
+ *            估计的儒略日(UT)
+ * @param loc
+ *            观测地点
+ * @return 上中天的儒略日(UT)
+ */
+func getTransit(jd float64, loc *Location) float64 {
+    for i := 0; i < 10; i++ {
+        h, _, _ := getHourAngle(jd, loc)
+        dt := -h / (2 * math.Pi) * SIDEREAL_RATIO
+        jd += dt
+        if math.Abs(dt) < precision {
+            break
+        }
+    }
+    return jd
+}
+
+/**
+ * 从上中天开始迭代计算太阳中心到达某个高度的时刻
+ *
+ * @param transit
+ *            上中天的儒略日(UT)
+ * @param loc
+ *            观测地点
+ * @param altitude
+ *            太阳中心的高度(度)
+ * @param sign
+ *            -1表示上中天以前(升起)，1表示上中天以后(落下)
+ * @return 时刻的儒略日(UT)和事件的状态
+ */
+func getEventTime(transit float64, loc *Location, altitude float64, sign float64) (float64, State) {
+    phi := mathutil.ToRadians(loc.Latitude)
+    sinH0 := math.Sin(mathutil.ToRadians(altitude))
+    jd := transit
+    for i := 0; i < 10; i++ {
+        h, dec, _ := getHourAngle(jd, loc)
+        cosH0 := (sinH0 - math.Sin(phi) * math.Sin(dec)) / (math.Cos(phi) * math.Cos(dec))
+        if cosH0 < -1 {
+            return 0, AlwaysAbove
+        }
+        if cosH0 > 1 {
+            return 0, AlwaysBelow
+        }
+        dt := mathutil.ModPi(sign * math.Acos(cosH0) - h) / (2 * math.Pi) * SIDEREAL_RATIO
+        jd += dt
+        if math.Abs(dt) < precision {
+            break
+        }
+    }
+    return jd, Normal
+}
+
+/**
+ * 计算升起落下时天体中心的高度，包括地平线大气折射、天体视半径和海拔造成的地平俯角
+ *
+ * @param semidiameter
+ *            天体的视半径(度)
+ * @param elevation
+ *            海拔高度(米)
+ * @return 天体中心的高度(度)
+ */
+func getHorizonAltitude(semidiameter float64, elevation float64) float64 {
+    h0 := -HORIZON_REFRACTION - semidiameter
+    if elevation > 0 {
+        // 地平俯角约为1.76′√h
+        h0 -= 1.76 / 60 * math.Sqrt(elevation)
+    }
+    return h0
+}
+
+/**
+ * 计算上中天前后太阳中心到达某个高度的事件
+ */
+func getEvent(transit float64, loc *Location, altitude float64, sign float64, tz *time.Location) Event {
+    jd, state := getEventTime(transit, loc, altitude, sign)
+    if state != Normal {
+        return Event{State: state}
+    }
+    return Event{calendarutil.FromJulianDate(jd, tz, false), Normal}
+}
+
+/**
+ * 计算某天太阳的上中天、日出、日落和晨昏蒙影时刻，日出日落以日面上边缘与视地平线相切为准
+ *
+ * @param year
+ *            年份
+ * @param month
+ *            月份
+ * @param day
+ *            日期
+ * @param loc
+ *            观测地点
+ * @param tz
+ *            结果使用的时区
+ * @return 太阳的各个时刻
+ */
+func GetSunTimes(year, month, day int, loc *Location, tz *time.Location) *SunTimes {
+    // 从当地平正午开始迭代
+    noon := float64(calendarutil.ToJulianDate(year, month, day)) - loc.Longitude / 360
+    transit := getTransit(noon, loc)
+    _, r := getSunEquatorial(transit)
+    horizon := getHorizonAltitude(mathutil.SecondsToDegrees(SUN_SEMIDIAMETER / r), loc.Elevation)
+    return &SunTimes{
+        Transit: calendarutil.FromJulianDate(transit, tz, false),
+        Sunrise: getEvent(transit, loc, horizon, -1, tz),
+        Sunset: getEvent(transit, loc, horizon, 1, tz),
+        CivilDawn: getEvent(transit, loc, CIVIL_TWILIGHT_ALTITUDE, -1, tz),
+        CivilDusk: getEvent(transit, loc, CIVIL_TWILIGHT_ALTITUDE, 1, tz),
+        NauticalDawn: getEvent(transit, loc, NAUTICAL_TWILIGHT_ALTITUDE, -1, tz),
+        NauticalDusk: getEvent(transit, loc, NAUTICAL_TWILIGHT_ALTITUDE, 1, tz),
+        AstronomicalDawn: getEvent(transit, loc, ASTRONOMICAL_TWILIGHT_ALTITUDE, -1, tz),
+        AstronomicalDusk: getEvent(transit, loc, ASTRONOMICAL_TWILIGHT_ALTITUDE, 1, tz),
+    }
+}
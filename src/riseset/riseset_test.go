@@ -0,0 +1,90 @@
+package riseset
+
+import (
+    "testing"
+    "time"
+)
+
+// 格林尼治天文台的太阳时刻(UTC)，用Jean Meeus的Astronomical Algorithms第15章的方法计算
+var greenwich = &Location{51.4769, -0.0005, 0}
+
+func checkTime(t *testing.T, name string, actual time.Time, year, month, day, hour, minute, second int) {
+    expected := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)
+    diff := actual.Sub(expected)
+    if diff < -time.Minute || diff > time.Minute {
+        t.Error("fail", name, actual, expected)
+    }
+}
+
+func Test_GetSunTimes(t *testing.T) {
+    r := GetSunTimes(2024, 6, 21, greenwich, time.UTC)
+    checkTime(t, "sunrise", r.Sunrise.Time, 2024, 6, 21, 3, 42, 51)
+    checkTime(t, "transit", r.Transit, 2024, 6, 21, 12, 1, 56)
+    checkTime(t, "sunset", r.Sunset.Time, 2024, 6, 21, 20, 21, 0)
+    // 夏至前后伦敦没有天文晨昏蒙影
+    if r.AstronomicalDawn.State != AlwaysAbove || r.AstronomicalDusk.State != AlwaysAbove {
+        t.Error("fail")
+    }
+
+    r = GetSunTimes(2024, 12, 21, greenwich, time.UTC)
+    checkTime(t, "sunrise", r.Sunrise.Time, 2024, 12, 21, 8, 3, 20)
+    checkTime(t, "transit", r.Transit, 2024, 12, 21, 11, 58, 19)
+    checkTime(t, "sunset", r.Sunset.Time, 2024, 12, 21, 15, 53, 19)
+
+    r = GetSunTimes(2024, 3, 10, greenwich, time.UTC)
+    checkTime(t, "civil dawn", r.CivilDawn.Time, 2024, 3, 10, 5, 51, 15)
+    checkTime(t, "civil dusk", r.CivilDusk.Time, 2024, 3, 10, 18, 30, 3)
+
+    r = GetSunTimes(2024, 11, 10, greenwich, time.UTC)
+    checkTime(t, "astronomical dawn", r.AstronomicalDawn.Time, 2024, 11, 10, 5, 14, 21)
+    checkTime(t, "astronomical dusk", r.AstronomicalDusk.Time, 2024, 11, 10, 18, 12, 50)
+
+    // 纽约的航海晨昏蒙影
+    r = GetSunTimes(2024, 9, 22, &Location{40.7128, -74.0060, 0}, time.UTC)
+    checkTime(t, "nautical dusk", r.NauticalDusk.Time, 2024, 9, 22, 23, 51, 23)
+    if r.NauticalDawn.State != Normal || r.NauticalDusk.State != Normal {
+        t.Error("fail")
+    }
+}
+
+func Test_GetSunTimes_TimeZone(t *testing.T) {
+    beijing := time.FixedZone("CST", 8 * 3600)
+    r := GetSunTimes(2024, 6, 21, &Location{39.9042, 116.4074, 0}, beijing)
+    if r.Sunrise.Time.Location() != beijing {
+        t.Error("fail")
+    }
+    // 北京夏至日出约04:46，日落约19:46
+    checkTime(t, "sunrise", r.Sunrise.Time, 2024, 6, 20, 20, 46, 0)
+    checkTime(t, "sunset", r.Sunset.Time, 2024, 6, 21, 11, 46, 0)
+}
+
+func Test_GetSunTimes_Elevation(t *testing.T) {
+    low := GetSunTimes(2024, 6, 21, greenwich, time.UTC)
+    high := GetSunTimes(2024, 6, 21, &Location{51.4769, -0.0005, 1000}, time.UTC)
+    // 海拔越高日出越早、日落越晚
+    if high.Sunrise.Time.Before(low.Sunrise.Time) && high.Sunset.Time.After(low.Sunset.Time) &&
+        high.CivilDawn.Time.Equal(low.CivilDawn.Time) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetSunTimes_Polar(t *testing.T) {
+    tromso := &Location{69.65, 18.96, 0}
+    r := GetSunTimes(2024, 6, 21, tromso, time.UTC)
+    if r.Sunrise.State != AlwaysAbove || r.Sunset.State != AlwaysAbove || r.CivilDawn.State != AlwaysAbove {
+        t.Error("fail")
+    }
+    r = GetSunTimes(2024, 12, 21, tromso, time.UTC)
+    if r.Sunrise.State != AlwaysBelow || r.Sunset.State != AlwaysBelow || r.CivilDawn.State != Normal {
+        t.Error("fail")
+    }
+    // 朗伊尔城冬至连民用晨昏蒙影也没有
+    r = GetSunTimes(2024, 12, 21, &Location{78.22, 15.65, 0}, time.UTC)
+    if r.CivilDawn.State == AlwaysBelow && r.NauticalDawn.State == Normal && r.AstronomicalDusk.State == Normal {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
@@ -0,0 +1,104 @@
+package sidereal
+
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+    "nutation"
+)
+
+/**
+ * 把UT儒略日换算成TT儒略日
+ */
+func toDynamicalTime(jd float64) float64 {
+    return jd + calendarutil.GetDeltaTJD(jd) / 86400
+}
+
+/**
+ * 计算格林尼治平恒星时(IAU 1982)，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第12章(12.4)式
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @return 平恒星时，单位是弧度(rad)
+ */
+func GetMeanSiderealTime(jd float64) float64 {
+    t := calendarutil.GetJulianCentury(jd)
+    theta := 280.46061837 + 360.98564736629 * (jd - calendarutil.J2000) + t * t * (0.000387933 - t / 38710000)
+    return mathutil.Mod2Pi(mathutil.ToRadians(theta))
+}
+
+/**
+ * 计算地球自转角(ERA)，参考IERS Conventions(2010)第5章(5.15)式
+ *
+ * @param jd
+ *            儒略日(UT1)
+ * @return 地球自转角，单位是弧度(rad)
+ */
+func GetEarthRotationAngle(jd float64) float64 {
+    d := jd - calendarutil.J2000
+    // 整数日部分的自转是整圈，分开计算以保留精度
+    turns := math.Mod(d, 1) + 0.7790572732640 + 0.00273781191135448 * d
+    return mathutil.Mod2Pi(2 * math.Pi * turns)
+}
+
+/**
+ * 计算格林尼治平恒星时(IAU 2006)，由地球自转角加上岁差引起的多项式得到，参考IERS Conventions(2010)第5章(5.32)式
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @return 平恒星时，单位是弧度(rad)
+ */
+func GetMeanSiderealTime2006(jd float64) float64 {
+    t := calendarutil.GetJulianCentury(toDynamicalTime(jd))
+    seconds := 0.014506 + (4612.156534 + (1.3915817 + (-0.00000044 + (-0.000029956 - 0.0000000368 * t) * t) * t) * t) * t
+    return mathutil.Mod2Pi(GetEarthRotationAngle(jd) + mathutil.SecondsToRadians(seconds))
+}
+
+/**
+ * 计算赤经章动(二分差)，即黄经章动在赤道上的投影Δψcosε
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @return 赤经章动，单位是弧度(rad)
+ */
+func GetEquationOfEquinoxes(jd float64) float64 {
+    jde := toDynamicalTime(jd)
+    return nutation.GetLongitudeNutation(jde) * math.Cos(nutation.GetTrueObliquity(jde))
+}
+
+/**
+ * 计算格林尼治视恒星时，即平恒星时(IAU 1982)加上赤经章动
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @return 视恒星时，单位是弧度(rad)
+ */
+func GetApparentSiderealTime(jd float64) float64 {
+    return mathutil.Mod2Pi(GetMeanSiderealTime(jd) + GetEquationOfEquinoxes(jd))
+}
+
+/**
+ * 计算地方平恒星时
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @param longitude
+ *            地理经度，东经为正，单位是弧度(rad)
+ * @return 地方平恒星时，单位是弧度(rad)
+ */
+func GetLocalMeanSiderealTime(jd float64, longitude float64) float64 {
+    return mathutil.Mod2Pi(GetMeanSiderealTime(jd) + longitude)
+}
+
+/**
+ * 计算地方视恒星时
+ *
+ * @param jd
+ *            儒略日(UT)
+ * @param longitude
+ *            地理经度，东经为正，单位是弧度(rad)
+ * @return 地方视恒星时，单位是弧度(rad)
+ */
+func GetLocalSiderealTime(jd float64, longitude float64) float64 {
+    return mathutil.Mod2Pi(GetApparentSiderealTime(jd) + longitude)
+}
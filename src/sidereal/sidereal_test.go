@@ -0,0 +1,93 @@
+package sidereal
+
+import (
+    "calendarutil"
+    "math"
+    "testing"
+)
+
+/**
+ * 把时、分、秒换算成弧度
+ */
+func hmsToRadians(h, m int, s float64) float64 {
+    return (float64(h) * 3600 + float64(m) * 60 + s) / 86400 * 2 * math.Pi
+}
+
+/**
+ * 把弧度换算成时秒
+ */
+func radiansToSeconds(r float64) float64 {
+    return r / (2 * math.Pi) * 86400
+}
+
+// Jean Meeus的Astronomical Algorithms第12章例12.a：1987年4月10日0h UT
+func Test_GetMeanSiderealTime_12a(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1987, 4, 10, 0, 0, 0)
+    diff := radiansToSeconds(GetMeanSiderealTime(jd) - hmsToRadians(13, 10, 46.3668))
+    if math.Abs(diff) < 0.0001 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", diff)
+    }
+}
+
+func Test_GetApparentSiderealTime_12a(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1987, 4, 10, 0, 0, 0)
+    diff := radiansToSeconds(GetApparentSiderealTime(jd) - hmsToRadians(13, 10, 46.1351))
+    if math.Abs(diff) < 0.001 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", diff)
+    }
+}
+
+// 例12.b：1987年4月10日19h21m00s UT
+func Test_GetMeanSiderealTime_12b(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1987, 4, 10, 19, 21, 0)
+    diff := radiansToSeconds(GetMeanSiderealTime(jd) - hmsToRadians(8, 34, 57.0896))
+    if math.Abs(diff) < 0.0001 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", diff)
+    }
+}
+
+// SOFA的t_sofa_c.c中iauEra00和iauGmst06的测试值
+func Test_GetEarthRotationAngle(t *testing.T) {
+    if math.Abs(GetEarthRotationAngle(2400000.5 + 54388.0) - 0.4022837240028158102) < 1e-12 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetMeanSiderealTime2006(t *testing.T) {
+    // SOFA的测试值取TT=UT1，这里TT由ΔT得到，差别在1e-9弧度以内
+    diff := GetMeanSiderealTime2006(2400000.5 + 53736.0) - 1.754174971870091203
+    if math.Abs(diff) < 1e-9 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", diff)
+    }
+    // 与IAU 1982的平恒星时在1900年至2100年之间相差不到0.1秒
+    for year := 1900; year <= 2100; year += 10 {
+        jd := calendarutil.ToJulianDateHMS(year, 1, 1, 0, 0, 0)
+        if math.Abs(radiansToSeconds(GetMeanSiderealTime2006(jd) - GetMeanSiderealTime(jd))) > 0.1 {
+            t.Error("fail", year)
+        }
+    }
+}
+
+func Test_GetLocalSiderealTime(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1987, 4, 10, 19, 21, 0)
+    // 西经77°03′56″(华盛顿)
+    longitude := -(77 + 3.0 / 60 + 56.0 / 3600) * math.Pi / 180
+    local := GetLocalSiderealTime(jd, longitude)
+    mean := GetLocalMeanSiderealTime(jd, longitude)
+    if math.Abs(local - mean - GetEquationOfEquinoxes(jd)) < 1e-12 &&
+        math.Abs(radiansToSeconds(mean - hmsToRadians(3, 26, 41.3563))) < 0.0001 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", radiansToSeconds(mean))
+    }
+}
@@ -1,35 +1,197 @@
 package solarterms
 
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+    "time"
+    "timescale"
+    "vsop87earthd"
+)
+
 type SolarTerm struct {
     Order int
+    // 简体名称
     Name string
     Month int
     EstimateDate int
+    // 太阳视黄经(度)
+    Longitude float64
+    Kind Kind
+    // 繁体名称
+    TraditionalName string
+    Pinyin string
+    EnglishName string
 }
 
+/**
+ * 节气的种类，节(节令)和中气相间排列
+ */
+type Kind int
+
+const (
+    // 节，如立春、惊蛰，干支纪月以节换月
+    Sectional Kind = iota
+    // 中气，如雨水、春分，农历以无中气之月为闰月
+    Principal
+)
+
 var (
-    XiaoHan = &SolarTerm{20, "小寒", 1, 5}
-    DaHan = &SolarTerm{21, "大寒", 1, 22}
-    LiChun = &SolarTerm{22, "立春", 2, 5}
-    YuShui = &SolarTerm{23, "雨水", 2, 22}
-    JingZhe = &SolarTerm{24, "惊蛰", 3, 5}
-    ChunFen = &SolarTerm{1, "春分", 3, 22}
-    QingMing = &SolarTerm{2, "清明", 4, 5}
-    GuYu = &SolarTerm{3, "谷雨", 4, 22}
-    LiXia = &SolarTerm{4, "立夏", 5, 5}
-    XiaoMan = &SolarTerm{5, "小满", 5, 22}
-    MangZhong = &SolarTerm{6, "芒种", 6, 5}
-    XiaZhi = &SolarTerm{7, "夏至", 6, 22}
-    XiaoShu = &SolarTerm{8, "小暑", 7, 5}
-    DaShu = &SolarTerm{9, "大暑", 7, 22}
-    LiQiu =  &SolarTerm{10, "立秋", 8, 5}
-    ChuShu = &SolarTerm{11, "处暑", 8, 22}
-    BaiLu = &SolarTerm{12, "白露", 9, 5}
-    QiuFen = &SolarTerm{13, "秋分", 9, 22}
-    HanLu = &SolarTerm{14, "寒露", 10, 5}
-    ShuangJiang = &SolarTerm{15, "霜降", 10, 22}
-    LiDong = &SolarTerm{16, "立冬", 11, 5}
-    XiaoXue = &SolarTerm{17, "小雪", 11, 22 }
-    DaXue = &SolarTerm{18, "大雪", 12, 5}
-    DongZhi = &SolarTerm{19, "冬至", 12, 22}
+    XiaoHan = &SolarTerm{20, "小寒", 1, 5, 285, Sectional, "小寒", "Xiǎohán", "Minor Cold"}
+    DaHan = &SolarTerm{21, "大寒", 1, 22, 300, Principal, "大寒", "Dàhán", "Major Cold"}
+    LiChun = &SolarTerm{22, "立春", 2, 5, 315, Sectional, "立春", "Lìchūn", "Start of Spring"}
+    YuShui = &SolarTerm{23, "雨水", 2, 22, 330, Principal, "雨水", "Yǔshuǐ", "Rain Water"}
+    JingZhe = &SolarTerm{24, "惊蛰", 3, 5, 345, Sectional, "驚蟄", "Jīngzhé", "Awakening of Insects"}
+    ChunFen = &SolarTerm{1, "春分", 3, 22, 0, Principal, "春分", "Chūnfēn", "Spring Equinox"}
+    QingMing = &SolarTerm{2, "清明", 4, 5, 15, Sectional, "清明", "Qīngmíng", "Pure Brightness"}
+    GuYu = &SolarTerm{3, "谷雨", 4, 22, 30, Principal, "穀雨", "Gǔyǔ", "Grain Rain"}
+    LiXia = &SolarTerm{4, "立夏", 5, 5, 45, Sectional, "立夏", "Lìxià", "Start of Summer"}
+    XiaoMan = &SolarTerm{5, "小满", 5, 22, 60, Principal, "小滿", "Xiǎomǎn", "Grain Full"}
+    MangZhong = &SolarTerm{6, "芒种", 6, 5, 75, Sectional, "芒種", "Mángzhòng", "Grain in Ear"}
+    XiaZhi = &SolarTerm{7, "夏至", 6, 22, 90, Principal, "夏至", "Xiàzhì", "Summer Solstice"}
+    XiaoShu = &SolarTerm{8, "小暑", 7, 5, 105, Sectional, "小暑", "Xiǎoshǔ", "Minor Heat"}
+    DaShu = &SolarTerm{9, "大暑", 7, 22, 120, Principal, "大暑", "Dàshǔ", "Major Heat"}
+    LiQiu = &SolarTerm{10, "立秋", 8, 5, 135, Sectional, "立秋", "Lìqiū", "Start of Autumn"}
+    ChuShu = &SolarTerm{11, "处暑", 8, 22, 150, Principal, "處暑", "Chǔshǔ", "End of Heat"}
+    BaiLu = &SolarTerm{12, "白露", 9, 5, 165, Sectional, "白露", "Báilù", "White Dew"}
+    QiuFen = &SolarTerm{13, "秋分", 9, 22, 180, Principal, "秋分", "Qiūfēn", "Autumn Equinox"}
+    HanLu = &SolarTerm{14, "寒露", 10, 5, 195, Sectional, "寒露", "Hánlù", "Cold Dew"}
+    ShuangJiang = &SolarTerm{15, "霜降", 10, 22, 210, Principal, "霜降", "Shuāngjiàng", "Frost's Descent"}
+    LiDong = &SolarTerm{16, "立冬", 11, 5, 225, Sectional, "立冬", "Lìdōng", "Start of Winter"}
+    XiaoXue = &SolarTerm{17, "小雪", 11, 22, 240, Principal, "小雪", "Xiǎoxuě", "Minor Snow"}
+    DaXue = &SolarTerm{18, "大雪", 12, 5, 255, Sectional, "大雪", "Dàxuě", "Major Snow"}
+    DongZhi = &SolarTerm{19, "冬至", 12, 22, 270, Principal, "冬至", "Dōngzhì", "Winter Solstice"}
 )
+
+/**
+ * 按Order排列的全部节气，从春分开始
+ */
+var terms = []*SolarTerm{
+    ChunFen, QingMing, GuYu, LiXia, XiaoMan, MangZhong,
+    XiaZhi, XiaoShu, DaShu, LiQiu, ChuShu, BaiLu,
+    QiuFen, HanLu, ShuangJiang, LiDong, XiaoXue, DaXue,
+    DongZhi, XiaoHan, DaHan, LiChun, YuShui, JingZhe,
+}
+
+/**
+ * 全部24个节气
+ *
+ * @return 按Order排列的节气，从春分开始
+ */
+func All() []*SolarTerm {
+    result := make([]*SolarTerm, len(terms))
+    copy(result, terms)
+    return result
+}
+
+/**
+ * 按序号查找节气
+ *
+ * @param order
+ *            序号，春分为1，惊蛰为24
+ * @return 节气，序号不在1到24之间时返回nil
+ */
+func ByOrder(order int) *SolarTerm {
+    if order < 1 || order > len(terms) {
+        return nil
+    }
+    return terms[order - 1]
+}
+
+/**
+ * 按名称查找节气，简体、繁体、拼音和英文名称都可以
+ *
+ * @param name
+ *            名称
+ * @return 节气，找不到时返回nil
+ */
+func ByName(name string) *SolarTerm {
+    for _, term := range terms {
+        if term.Name == name || term.TraditionalName == name ||
+            term.Pinyin == name || term.EnglishName == name {
+            return term
+        }
+    }
+    return nil
+}
+
+/**
+ * 下一个节气，惊蛰的下一个是春分
+ */
+func (term *SolarTerm) Next() *SolarTerm {
+    return terms[term.Order % len(terms)]
+}
+
+/**
+ * 上一个节气，春分的上一个是惊蛰
+ */
+func (term *SolarTerm) Prev() *SolarTerm {
+    return terms[(term.Order + len(terms) - 2) % len(terms)]
+}
+
+/**
+ * 求解节气时刻所用的参数
+ */
+var solver = mathutil.NewSolver(1e-7, 100)
+
+/**
+ * 计算某年这个节气的时刻，以EstimateDate为初值用牛顿迭代求解
+ * 太阳地心视黄经 = Longitude，不收敛时在EstimateDate前后10天之内用Brent方法求解
+ *
+ * @param year
+ *            年份
+ * @return 节气时刻的儒略日(TT)，两种方法都失败时返回错误
+ */
+func (term *SolarTerm) JulianDate(year int) (float64, error) {
+    lon := mathutil.ToRadians(term.Longitude)
+    jd0 := float64(calendarutil.ToJulianDate(year, term.Month, term.EstimateDate))
+    return solver.Solve(func(jd float64) float64 {
+        return mathutil.ModPi(vsop87earthd.GetEarthEclipticLongitudeForSun(jd) - lon)
+    }, jd0, jd0 - 10, jd0 + 10)
+}
+
+/**
+ * 计算某年某个节气的时刻
+ *
+ * @param year
+ *            年份
+ * @param term
+ *            节气
+ * @return 节气时刻的儒略日(TT)，求解失败时返回NaN。需要知道错误时使用{@link SolarTerm#JulianDate}
+ */
+func GetJulianDate(year int, term *SolarTerm) float64 {
+    jd, err := term.JulianDate(year)
+    if err != nil {
+        return math.NaN()
+    }
+    return jd
+}
+
+/**
+ * 计算某年这个节气的时刻
+ *
+ * @param year
+ *            年份
+ * @return 节气时刻(UTC)，1972年以后按闰秒表换算，求解失败时返回错误
+ */
+func (term *SolarTerm) Time(year int) (time.Time, error) {
+    jd, err := term.JulianDate(year)
+    if err != nil {
+        return time.Time{}, err
+    }
+    return timescale.NewInstant(jd, timescale.TT).Time(time.UTC), nil
+}
+
+/**
+ * 计算某年某个节气的时刻
+ *
+ * @param year
+ *            年份
+ * @param term
+ *            节气
+ * @return 节气时刻(UTC)，1972年以后按闰秒表换算，求解失败时返回零值。需要知道错误时使用{@link SolarTerm#Time}
+ */
+func TimeOf(year int, term *SolarTerm) time.Time {
+    tm, _ := term.Time(year)
+    return tm
+}
@@ -1,7 +1,10 @@
 package solarterms
 
 import (
+    "calendarutil"
+    "math"
     "testing"
+    "time"
 )
 
 func Test_1(t *testing.T) {
@@ -18,3 +21,182 @@ func Test_1(t *testing.T) {
         t.Error("fail")
     }
 }
+
+// 天文年历中的二分二至时刻(UTC)
+var almanacTimes = []struct {
+    year int
+    term *SolarTerm
+    time time.Time
+}{
+    {2000, ChunFen, time.Date(2000, 3, 20, 7, 35, 0, 0, time.UTC)},
+    {2000, XiaZhi, time.Date(2000, 6, 21, 1, 48, 0, 0, time.UTC)},
+    {2000, QiuFen, time.Date(2000, 9, 22, 17, 28, 0, 0, time.UTC)},
+    {2000, DongZhi, time.Date(2000, 12, 21, 13, 37, 0, 0, time.UTC)},
+    {2010, ChunFen, time.Date(2010, 3, 20, 17, 32, 0, 0, time.UTC)},
+    {2010, XiaZhi, time.Date(2010, 6, 21, 11, 28, 0, 0, time.UTC)},
+    {2010, QiuFen, time.Date(2010, 9, 23, 3, 9, 0, 0, time.UTC)},
+    {2010, DongZhi, time.Date(2010, 12, 21, 23, 38, 0, 0, time.UTC)},
+    {2016, ChunFen, time.Date(2016, 3, 20, 4, 30, 0, 0, time.UTC)},
+    {2016, XiaZhi, time.Date(2016, 6, 20, 22, 34, 0, 0, time.UTC)},
+    {2016, QiuFen, time.Date(2016, 9, 22, 14, 21, 0, 0, time.UTC)},
+    {2016, DongZhi, time.Date(2016, 12, 21, 10, 44, 0, 0, time.UTC)},
+    {2020, ChunFen, time.Date(2020, 3, 20, 3, 50, 0, 0, time.UTC)},
+    {2020, XiaZhi, time.Date(2020, 6, 20, 21, 44, 0, 0, time.UTC)},
+    {2020, QiuFen, time.Date(2020, 9, 22, 13, 31, 0, 0, time.UTC)},
+    {2020, DongZhi, time.Date(2020, 12, 21, 10, 2, 0, 0, time.UTC)},
+    {2024, ChunFen, time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)},
+    {2024, XiaZhi, time.Date(2024, 6, 20, 20, 51, 0, 0, time.UTC)},
+    {2024, QiuFen, time.Date(2024, 9, 22, 12, 44, 0, 0, time.UTC)},
+    {2024, DongZhi, time.Date(2024, 12, 21, 9, 20, 0, 0, time.UTC)},
+}
+
+// 允许的误差
+const almanacTolerance = time.Minute
+
+func Test_TimeOf(t *testing.T) {
+    for _, v := range almanacTimes {
+        tm := TimeOf(v.year, v.term)
+        diff := tm.Sub(v.time)
+        t.Log(v.year, v.term.Name, tm, diff)
+        if diff < -almanacTolerance || diff > almanacTolerance {
+            t.Error("fail")
+        }
+    }
+}
+
+// Jean Meeus, Astronomical Algorithms 例27.b，用VSOP87算得1962年夏至为6月21日21h24m42s TD
+func Test_GetJulianDate(t *testing.T) {
+    jd := GetJulianDate(1962, XiaZhi)
+    t.Log(jd)
+    if math.Abs(jd - calendarutil.ToJulianDateHMS(1962, 6, 21, 21, 24, 42)) < 1.0 / 86400 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// 1900年到2100年的节气时刻(TT)。本机无法取得香港天文台或美国海军天文台的历表，期望值改用寿星天文历
+// (github.com/6tail/lunar-go的ShouXingUtil.SaLonT)独立算出，它的太阳黄经用另一套截断的VSOP87级数和章动公式，
+// 与本包的算法无关，两者相差不到10秒。比较用TT，不受两边∆T模型不同的影响
+var referenceTimes = []struct {
+    year int
+    term *SolarTerm
+    month, day, hour, minute, second int
+}{
+    {1900, LiChun, 2, 4, 5, 51, 28},
+    {1900, QingMing, 4, 5, 5, 52, 38},
+    {1900, LiQiu, 8, 8, 0, 50, 32},
+    {1900, DongZhi, 12, 22, 6, 41, 32},
+    {1912, LiChun, 2, 5, 3, 53, 44},
+    {1912, QingMing, 4, 5, 3, 48, 28},
+    {1912, LiQiu, 8, 7, 22, 37, 24},
+    {1912, DongZhi, 12, 22, 4, 44, 53},
+    {1950, LiChun, 2, 4, 9, 21, 14},
+    {1950, QingMing, 4, 5, 8, 44, 56},
+    {1950, LiQiu, 8, 8, 2, 55, 40},
+    {1950, DongZhi, 12, 22, 10, 13, 47},
+    {1957, LiChun, 2, 4, 1, 55, 9},
+    {1957, QingMing, 4, 5, 1, 19, 20},
+    {1957, LiQiu, 8, 7, 19, 32, 34},
+    {1957, DongZhi, 12, 22, 2, 49, 6},
+    {2050, LiChun, 2, 3, 15, 45, 5},
+    {2050, QingMing, 4, 4, 14, 4, 33},
+    {2050, LiQiu, 8, 7, 6, 53, 48},
+    {2050, DongZhi, 12, 21, 16, 40, 1},
+    {2057, LiChun, 2, 3, 8, 44, 1},
+    {2057, QingMing, 4, 4, 6, 54, 8},
+    {2057, LiQiu, 8, 6, 23, 35, 28},
+    {2057, DongZhi, 12, 21, 9, 44, 25},
+    {2090, LiChun, 2, 3, 8, 44, 59},
+    {2090, QingMing, 4, 4, 6, 38, 55},
+    {2090, LiQiu, 8, 6, 22, 55, 30},
+    {2090, DongZhi, 12, 21, 9, 46, 22},
+    {2099, LiChun, 2, 3, 13, 12, 33},
+    {2099, QingMing, 4, 4, 10, 54, 30},
+    {2099, LiQiu, 8, 7, 3, 13, 17},
+    {2099, DongZhi, 12, 21, 14, 7, 25},
+}
+
+func Test_GetJulianDate_1900_2100(t *testing.T) {
+    for _, v := range referenceTimes {
+        jd := GetJulianDate(v.year, v.term)
+        expected := calendarutil.ToJulianDateHMS(v.year, v.month, v.day, v.hour, v.minute, float64(v.second))
+        diff := (jd - expected) * 86400
+        if math.Abs(diff) > 10 {
+            t.Error("fail", v.year, v.term.Name, diff)
+        }
+    }
+}
+
+// 1900年到2100年每个节气都应该在估计日期前后5天内，相邻节气相隔14到16天
+func Test_TimeOf_1900_2100(t *testing.T) {
+    order := []*SolarTerm{XiaoHan, DaHan, LiChun, YuShui, JingZhe, ChunFen,
+        QingMing, GuYu, LiXia, XiaoMan, MangZhong, XiaZhi, XiaoShu, DaShu,
+        LiQiu, ChuShu, BaiLu, QiuFen, HanLu, ShuangJiang, LiDong, XiaoXue,
+        DaXue, DongZhi}
+    var last float64
+    for year := 1900; year <= 2100; year++ {
+        for _, term := range order {
+            jd := GetJulianDate(year, term)
+            estimate := float64(calendarutil.ToJulianDate(year, term.Month, term.EstimateDate))
+            if math.Abs(jd - estimate) > 5 {
+                t.Error("fail", year, term.Name, jd)
+            }
+            if last != 0 && (jd - last < 14 || jd - last > 16) {
+                t.Error("fail", year, term.Name, jd - last)
+            }
+            last = jd
+        }
+    }
+}
+
+func Test_All(t *testing.T) {
+    all := All()
+    if len(all) != 24 {
+        t.Error("fail")
+    }
+    for i, term := range all {
+        if term.Order != i + 1 || term.Longitude != float64(i * 15) {
+            t.Error("fail", term.Name)
+        }
+        // 节和中气相间排列，春分是中气
+        if (i % 2 == 0) != (term.Kind == Principal) {
+            t.Error("fail", term.Name)
+        }
+    }
+    // 修改返回的切片不影响注册表
+    all[0] = nil
+    if All()[0] == ChunFen {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_ByOrder(t *testing.T) {
+    if ByOrder(1) == ChunFen && ByOrder(24) == JingZhe && ByOrder(0) == nil && ByOrder(25) == nil {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_ByName(t *testing.T) {
+    if ByName("惊蛰") == JingZhe && ByName("驚蟄") == JingZhe &&
+        ByName("Jīngzhé") == JingZhe && ByName("Awakening of Insects") == JingZhe &&
+        ByName("春节") == nil {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_Next(t *testing.T) {
+    if ChunFen.Next() == QingMing && JingZhe.Next() == ChunFen &&
+        DongZhi.Next() == XiaoHan && ChunFen.Prev() == JingZhe &&
+        XiaoHan.Prev() == DongZhi {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
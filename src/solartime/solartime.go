@@ -0,0 +1,80 @@
+package solartime
+
+import (
+    "calendarutil"
+    "coordinates"
+    "math"
+    "mathutil"
+    "nutation"
+    "time"
+    "vsop87earthd"
+)
+
+/**
+ * 太阳光行差加上FK5修正，单位是度，见<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第28.3式
+ */
+const ABERRATION_AND_FK5 = 0.0057183
+
+/**
+ * 按儒略日计算太阳的平黄经，参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第28.2式
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 太阳平黄经，单位是弧度(rad)
+ */
+func GetSunMeanLongitude(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    l := 280.4664567 + (360007.6982779 + (0.03032028 + (1.0 / 49931 + (-1.0 / 15300 - t / 2000000) * t) * t) * t) * t
+    return mathutil.Mod2Pi(mathutil.ToRadians(l))
+}
+
+/**
+ * 按儒略日计算时差，即真太阳时减平太阳时，由太阳平黄经和视赤经求得，
+ * 参考<i>Jean Meeus</i>的<i>Astronomical Algorithms</i>第二版(1998)第28章
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return 时差，单位是秒，一年之中在-15分钟到+17分钟之间
+ */
+func GetEquationOfTime(jd float64) float64 {
+    ecl := &coordinates.Ecliptic{
+        Longitude: vsop87earthd.GetEarthEclipticLongitudeForSun(jd),
+        Latitude: -vsop87earthd.GetSunEclipticLatitudeForEarth(jd),
+    }
+    obliquity := nutation.GetTrueObliquity(jd)
+    ra := ecl.ToEquatorial(obliquity).RightAscension
+    e := GetSunMeanLongitude(jd) - mathutil.ToRadians(ABERRATION_AND_FK5) - ra +
+        nutation.GetLongitudeNutation(jd) * math.Cos(obliquity)
+    // 1°相当于4分钟
+    return mathutil.ToDegrees(mathutil.ModPi(e)) * 240
+}
+
+/**
+ * 计算某一时刻在给定经度的地方平太阳时
+ *
+ * @param t
+ *            时刻，任意时区
+ * @param longitude
+ *            地理经度(度)，东经为正
+ * @return 同一时刻，时区为地方平时“LMT”，钟表读数就是平太阳时，时区偏移精确到秒
+ */
+func GetMeanSolarTime(t time.Time, longitude float64) time.Time {
+    // 经度每度相当于4分钟
+    return t.In(time.FixedZone("LMT", int(math.Round(longitude * 240))))
+}
+
+/**
+ * 计算某一时刻在给定经度的地方真太阳时，即地方平太阳时加上时差
+ *
+ * @param t
+ *            时刻，任意时区
+ * @param longitude
+ *            地理经度(度)，东经为正
+ * @return 同一时刻，时区为地方真时“LAT”，钟表读数就是真太阳时，时区偏移精确到秒
+ */
+func GetApparentSolarTime(t time.Time, longitude float64) time.Time {
+    jd := calendarutil.NewJulianDayFromTime(t).Float()
+    jde := jd + calendarutil.GetDeltaTJD(jd) / 86400
+    e := GetEquationOfTime(jde)
+    return t.In(time.FixedZone("LAT", int(math.Round(longitude * 240 + e))))
+}
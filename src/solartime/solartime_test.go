@@ -0,0 +1,72 @@
+package solartime
+
+import (
+    "math"
+    "mathutil"
+    "testing"
+    "time"
+)
+
+// Jean Meeus, Astronomical Algorithms 例28.a，1992年10月13日0h TD
+const meeusJD = 2448908.5
+
+func Test_GetSunMeanLongitude(t *testing.T) {
+    l := GetSunMeanLongitude(meeusJD)
+    t.Log(mathutil.ToDegrees(l))
+    // L0 = 201.807193°
+    if math.Abs(l - mathutil.ToRadians(201.807193)) < mathutil.ToRadians(0.000001) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetEquationOfTime(t *testing.T) {
+    e := GetEquationOfTime(meeusJD)
+    t.Log(e)
+    // E = 13m42.6s
+    if math.Abs(e - (13 * 60 + 42.6)) < 0.1 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    // 11月初时差最大，2月中旬最小
+    if e := GetEquationOfTime(2460617.5); e < 16 * 60 || e > 17 * 60 {
+        t.Error("fail", e)
+    }
+    if e := GetEquationOfTime(2460355.5); e > -14 * 60 || e < -15 * 60 {
+        t.Error("fail", e)
+    }
+}
+
+func Test_GetMeanSolarTime(t *testing.T) {
+    cst := time.FixedZone("CST", 8 * 3600)
+    // 北京东经116.4°，比东八区标准时晚14分24秒
+    civil := time.Date(2024, 6, 1, 12, 0, 0, 0, cst)
+    lmt := GetMeanSolarTime(civil, 116.4)
+    t.Log(lmt)
+    name, offset := lmt.Zone()
+    // 时刻不变，只是换成地方平时的读数
+    if lmt.Equal(civil) && name == "LMT" && offset == 27936 &&
+        lmt.Hour() == 11 && lmt.Minute() == 45 && lmt.Second() == 36 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_GetApparentSolarTime(t *testing.T) {
+    cst := time.FixedZone("CST", 8 * 3600)
+    civil := time.Date(1992, 10, 13, 8, 0, 0, 0, cst)
+    lat := GetApparentSolarTime(civil, 120)
+    t.Log(lat)
+    // 东经120°的平太阳时与东八区标准时相同，真太阳时快13分42秒左右
+    y, m, d := lat.Date()
+    reading := time.Date(y, m, d, lat.Hour(), lat.Minute(), lat.Second(), lat.Nanosecond(), time.UTC)
+    diff := reading.Sub(time.Date(1992, 10, 13, 8, 0, 0, 0, time.UTC)).Seconds()
+    if name, _ := lat.Zone(); lat.Equal(civil) && name == "LAT" && math.Abs(diff - (13 * 60 + 42.6)) < 1 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", diff)
+    }
+}
@@ -0,0 +1,46 @@
+package timescale
+
+import (
+    "sync"
+)
+
+/**
+ * UT1-UTC(DUT1)的来源，由UTC儒略日给出UT1-UTC的秒数，绝对值不超过0.9秒。
+ * 可以按IERS公报(Bulletin A/B)或finals2000A.data编写
+ */
+type DUT1Func func(jd float64) float64
+
+var (
+    dut1Lock sync.RWMutex
+    dut1 DUT1Func
+)
+
+/**
+ * 设置UT1-UTC的来源，f为nil时恢复默认值，即UT1-UTC为0。只在闰秒表范围内使用，
+ * 闰秒表以前的UT1按∆T的模型计算
+ *
+ * @param f
+ *            UT1-UTC的来源
+ */
+func SetDUT1(f DUT1Func) {
+    dut1Lock.Lock()
+    dut1 = f
+    dut1Lock.Unlock()
+}
+
+/**
+ * 计算UT1-UTC
+ *
+ * @param jd
+ *            儒略日(UTC)
+ * @return UT1-UTC(秒)，没有设置来源时为0
+ */
+func GetDUT1(jd float64) float64 {
+    dut1Lock.RLock()
+    f := dut1
+    dut1Lock.RUnlock()
+    if f == nil {
+        return 0
+    }
+    return f(jd)
+}
@@ -0,0 +1,215 @@
+package timescale
+
+import (
+    "bufio"
+    "calendarutil"
+    "fmt"
+    "io"
+    "strconv"
+    "strings"
+    "sync"
+)
+
+/**
+ * 闰秒表的一项，从UTC日期Start(儒略日，当天0h)起TAI-UTC为Offset秒
+ */
+type LeapSecond struct {
+    Start float64
+    Offset float64
+}
+
+/**
+ * NTP时间戳的起点1900年1月1日0h的儒略日
+ */
+const NTP_EPOCH = 2415020.5
+
+/**
+ * 1972年1月1日起的闰秒表，取自IERS的leap-seconds.list
+ */
+var defaultLeapSeconds = []LeapSecond{
+    {utcDate(1972, 1), 10}, {utcDate(1972, 7), 11}, {utcDate(1973, 1), 12},
+    {utcDate(1974, 1), 13}, {utcDate(1975, 1), 14}, {utcDate(1976, 1), 15},
+    {utcDate(1977, 1), 16}, {utcDate(1978, 1), 17}, {utcDate(1979, 1), 18},
+    {utcDate(1980, 1), 19}, {utcDate(1981, 7), 20}, {utcDate(1982, 7), 21},
+    {utcDate(1983, 7), 22}, {utcDate(1985, 7), 23}, {utcDate(1988, 1), 24},
+    {utcDate(1990, 1), 25}, {utcDate(1991, 1), 26}, {utcDate(1992, 7), 27},
+    {utcDate(1993, 7), 28}, {utcDate(1994, 7), 29}, {utcDate(1996, 1), 30},
+    {utcDate(1997, 7), 31}, {utcDate(1999, 1), 32}, {utcDate(2006, 1), 33},
+    {utcDate(2009, 1), 34}, {utcDate(2012, 7), 35}, {utcDate(2015, 7), 36},
+    {utcDate(2017, 1), 37},
+}
+
+/**
+ * 内置闰秒表的过期时刻(儒略日，UTC)。IERS Bulletin C 70宣布2025年12月底不加闰秒，
+ * leap-seconds.list随之延长到2026年12月28日
+ */
+var defaultLeapSecondsExpiry = float64(calendarutil.ToJulianDate(2026, 12, 28)) - 0.5
+
+var (
+    leapSecondsLock sync.RWMutex
+    leapSeconds = defaultLeapSeconds
+    // 过期以后不知道有没有新的闰秒，改用∆T的模型
+    leapSecondsExpiry = defaultLeapSecondsExpiry
+)
+
+/**
+ * 某月1日0h的儒略日
+ */
+func utcDate(year, month int) float64 {
+    return float64(calendarutil.ToJulianDate(year, month, 1)) - 0.5
+}
+
+/**
+ * 取得当前使用的闰秒表
+ *
+ * @return 闰秒表的副本，按时间排列
+ */
+func GetLeapSeconds() []LeapSecond {
+    leapSecondsLock.RLock()
+    defer leapSecondsLock.RUnlock()
+    return append([]LeapSecond(nil), leapSeconds...)
+}
+
+/**
+ * 取得当前使用的闰秒表的过期时刻
+ *
+ * @return 儒略日(UTC)，这以后按∆T的模型计算UTC
+ */
+func GetLeapSecondsExpiry() float64 {
+    leapSecondsLock.RLock()
+    defer leapSecondsLock.RUnlock()
+    return leapSecondsExpiry
+}
+
+/**
+ * 替换当前使用的闰秒表，table为空时恢复内置的闰秒表和过期时刻
+ *
+ * @param table
+ *            按时间排列的闰秒表
+ * @param expires
+ *            闰秒表的过期时刻，儒略日(UTC)
+ */
+func SetLeapSeconds(table []LeapSecond, expires float64) {
+    if len(table) == 0 {
+        table = defaultLeapSeconds
+        expires = defaultLeapSecondsExpiry
+    }
+    leapSecondsLock.Lock()
+    leapSeconds = append([]LeapSecond(nil), table...)
+    leapSecondsExpiry = expires
+    leapSecondsLock.Unlock()
+}
+
+/**
+ * 解析IERS发布的leap-seconds.list文件，每个数据行是"NTP时间戳 TAI-UTC"，以#开头的是注释，
+ * 其中"#@ NTP时间戳"是文件的过期时刻，必须存在
+ *
+ * @param r
+ *            文件内容
+ * @return 闰秒表和过期时刻(儒略日，UTC)
+ */
+func ParseLeapSeconds(r io.Reader) ([]LeapSecond, float64, error) {
+    var table []LeapSecond
+    expires := 0.0
+    scanner := bufio.NewScanner(r)
+    lineNo := 0
+    for scanner.Scan() {
+        lineNo++
+        line := scanner.Text()
+        if strings.HasPrefix(line, "#@") {
+            ntp, err := strconv.ParseInt(strings.TrimSpace(line[2:]), 10, 64)
+            if err != nil {
+                return nil, 0, fmt.Errorf("timescale: line %d: %v", lineNo, err)
+            }
+            expires = NTP_EPOCH + float64(ntp) / 86400
+            continue
+        }
+        if i := strings.Index(line, "#"); i >= 0 {
+            line = line[:i]
+        }
+        fields := strings.Fields(line)
+        if len(fields) == 0 {
+            continue
+        }
+        if len(fields) != 2 {
+            return nil, 0, fmt.Errorf("timescale: line %d: invalid leap second %q", lineNo, scanner.Text())
+        }
+        ntp, err := strconv.ParseInt(fields[0], 10, 64)
+        if err != nil {
+            return nil, 0, fmt.Errorf("timescale: line %d: %v", lineNo, err)
+        }
+        offset, err := strconv.ParseFloat(fields[1], 64)
+        if err != nil {
+            return nil, 0, fmt.Errorf("timescale: line %d: %v", lineNo, err)
+        }
+        start := NTP_EPOCH + float64(ntp) / 86400
+        if len(table) > 0 && start <= table[len(table) - 1].Start {
+            return nil, 0, fmt.Errorf("timescale: line %d: leap seconds out of order", lineNo)
+        }
+        table = append(table, LeapSecond{start, offset})
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, 0, err
+    }
+    if expires == 0 {
+        return nil, 0, fmt.Errorf("timescale: missing expiration date")
+    }
+    return table, expires, nil
+}
+
+/**
+ * 读取并使用IERS发布的leap-seconds.list文件
+ *
+ * @param r
+ *            文件内容
+ */
+func LoadLeapSeconds(r io.Reader) error {
+    table, expires, err := ParseLeapSeconds(r)
+    if err != nil {
+        return err
+    }
+    SetLeapSeconds(table, expires)
+    return nil
+}
+
+/**
+ * 查找UTC时刻的TAI-UTC
+ *
+ * @param jd
+ *            儒略日(UTC)
+ * @return TAI-UTC(秒)，早于闰秒表或闰秒表已过期时返回false
+ */
+func getLeapSecondsForUTC(jd float64) (float64, bool) {
+    leapSecondsLock.RLock()
+    defer leapSecondsLock.RUnlock()
+    if jd >= leapSecondsExpiry {
+        return 0, false
+    }
+    for i := len(leapSeconds) - 1; i >= 0; i-- {
+        if jd >= leapSeconds[i].Start {
+            return leapSeconds[i].Offset, true
+        }
+    }
+    return 0, false
+}
+
+/**
+ * 查找TAI时刻的TAI-UTC
+ *
+ * @param jd
+ *            儒略日(TAI)
+ * @return TAI-UTC(秒)，早于闰秒表或闰秒表已过期时返回false
+ */
+func getLeapSecondsForTAI(jd float64) (float64, bool) {
+    leapSecondsLock.RLock()
+    defer leapSecondsLock.RUnlock()
+    for i := len(leapSeconds) - 1; i >= 0; i-- {
+        if jd >= leapSeconds[i].Start + leapSeconds[i].Offset / 86400 {
+            if jd >= leapSecondsExpiry + leapSeconds[i].Offset / 86400 {
+                return 0, false
+            }
+            return leapSeconds[i].Offset, true
+        }
+    }
+    return 0, false
+}
@@ -0,0 +1,277 @@
+package timescale
+
+import (
+    "calendarutil"
+    "fmt"
+    "math"
+    "mathutil"
+    "time"
+)
+
+/**
+ * 时间尺度
+ */
+type Scale int
+
+const (
+    // 协调世界时，1972年以前没有闰秒表，闰秒表过期以后不知道有没有新的闰秒，都按UT1处理
+    UTC Scale = iota
+    // 国际原子时
+    TAI
+    // 地球时
+    TT
+    // 世界时，闰秒表范围内是UTC加上SetDUT1设置的UT1-UTC，范围以外按∆T的模型计算
+    UT1
+    // 太阳系质心力学时
+    TDB
+)
+
+var scaleNames = []string{"UTC", "TAI", "TT", "UT1", "TDB"}
+
+func (s Scale) String() string {
+    if s < 0 || int(s) >= len(scaleNames) {
+        return fmt.Sprintf("%%!Scale(%d)", int(s))
+    }
+    return scaleNames[s]
+}
+
+/**
+ * TT-TAI，单位是秒
+ */
+const TT_MINUS_TAI = 32.184
+
+/**
+ * Unix时间起点1970年1月1日0h的儒略日
+ */
+const UNIX_EPOCH = calendarutil.UNIX_EPOCH_JULIAN_DATE + 0.5
+
+/**
+ * 某个时间尺度下的时刻，不同尺度之间只能通过To转换。
+ * 儒略日用calendarutil.JulianDay保存，和time.Time往返转换时精确到纳秒
+ */
+type Instant struct {
+    jd calendarutil.JulianDay
+    scale Scale
+}
+
+/**
+ * 创建时刻
+ *
+ * @param jd
+ *            儒略日
+ * @param scale
+ *            jd所用的时间尺度
+ * @return 时刻
+ */
+func NewInstant(jd float64, scale Scale) Instant {
+    return Instant{calendarutil.NewJulianDayFromFloat(jd), scale}
+}
+
+/**
+ * 由calendarutil.JulianDay创建时刻，不损失精度
+ *
+ * @param jd
+ *            儒略日
+ * @param scale
+ *            jd所用的时间尺度
+ * @return 时刻
+ */
+func NewInstantFromJulianDay(jd calendarutil.JulianDay, scale Scale) Instant {
+    return Instant{jd, scale}
+}
+
+/**
+ * 由time.Time创建UTC时刻，1972年以前的UTC按UT1处理
+ *
+ * @param t
+ *            时间，任意时区
+ * @return UTC时刻
+ */
+func FromTime(t time.Time) Instant {
+    return Instant{calendarutil.NewJulianDayFromTime(t), UTC}
+}
+
+/**
+ * 由time.Time创建某个时间尺度下的时刻，t的读数按该时间尺度理解，例如TT的2000年1月1日12h
+ *
+ * @param t
+ *            时间，时区只用来把读数换算成UTC读数
+ * @param scale
+ *            t所用的时间尺度
+ * @return 时刻
+ */
+func FromTimeIn(t time.Time, scale Scale) Instant {
+    return Instant{calendarutil.NewJulianDayFromTime(t), scale}
+}
+
+/**
+ * 时刻的儒略日
+ */
+func (i Instant) JulianDate() float64 {
+    return i.jd.Float()
+}
+
+/**
+ * 时刻的儒略日，不损失精度
+ */
+func (i Instant) JulianDay() calendarutil.JulianDay {
+    return i.jd
+}
+
+/**
+ * 时刻的时间尺度
+ */
+func (i Instant) Scale() Scale {
+    return i.scale
+}
+
+/**
+ * 把时刻转换成UTC的time.Time
+ *
+ * @param tz
+ *            结果使用的时区
+ * @return 对应的日期时间
+ */
+func (i Instant) Time(tz *time.Location) time.Time {
+    return i.To(UTC).jd.Time(tz)
+}
+
+/**
+ * 把时刻在本身的时间尺度下的读数表示成time.Time，例如TT时刻的读数，是FromTimeIn的逆运算
+ *
+ * @param tz
+ *            结果使用的时区
+ * @return 对应的日期时间
+ */
+func (i Instant) TimeIn(tz *time.Location) time.Time {
+    return i.jd.Time(tz)
+}
+
+/**
+ * 加上若干秒，时间尺度不变
+ *
+ * @param seconds
+ *            秒数，可以是负数
+ * @return 新的时刻
+ */
+func (i Instant) AddSeconds(seconds float64) Instant {
+    return Instant{i.jd.AddSeconds(seconds), i.scale}
+}
+
+/**
+ * 计算两个时刻之差，o先转换到i的时间尺度
+ *
+ * @param o
+ *            另一个时刻
+ * @return i - o，单位是秒
+ */
+func (i Instant) Sub(o Instant) float64 {
+    return i.jd.SubSeconds(o.To(i.scale).jd)
+}
+
+/**
+ * 转换到另一个时间尺度，经由TT换算
+ *
+ * @param scale
+ *            目标时间尺度
+ * @return 目标时间尺度下的同一时刻
+ */
+func (i Instant) To(scale Scale) Instant {
+    if i.scale == scale {
+        return i
+    }
+    tt := i.jd.AddSeconds(getOffset(i.jd.Float(), i.scale))
+    return Instant{tt.AddSeconds(-getOffsetFromTT(tt.Float(), scale)), scale}
+}
+
+/**
+ * 计算TT与另一时间尺度之差
+ *
+ * @param scale
+ *            时间尺度
+ * @return TT减去该时间尺度的秒数
+ */
+func (i Instant) Offset(scale Scale) float64 {
+    return i.To(TT).jd.SubSeconds(i.To(scale).jd)
+}
+
+/**
+ * 计算TT减去某个时间尺度的秒数
+ *
+ * @param jd
+ *            儒略日，按scale计
+ * @param scale
+ *            时间尺度
+ * @return TT减去该时间尺度的秒数
+ */
+func getOffset(jd float64, scale Scale) float64 {
+    switch scale {
+    case UTC:
+        if offset, ok := getLeapSecondsForUTC(jd); ok {
+            return offset + TT_MINUS_TAI
+        }
+        // 1972年以前没有闰秒表，闰秒表过期以后也不知道TAI-UTC，把UTC当作UT1
+        return calendarutil.GetDeltaTJD(jd)
+    case TAI:
+        return TT_MINUS_TAI
+    case UT1:
+        // UT1-UTC不到0.9秒，用UT1的儒略日近似求UTC
+        utc := jd - GetDUT1(jd) / 86400
+        if offset, ok := getLeapSecondsForUTC(utc); ok {
+            return offset + TT_MINUS_TAI - GetDUT1(utc)
+        }
+        return calendarutil.GetDeltaTJD(jd)
+    case TDB:
+        // TDB-TT只有毫秒量级，用TDB代替TT计算即可
+        return -getTDBMinusTT(jd)
+    }
+    return 0
+}
+
+/**
+ * 计算TT减去某个时间尺度的秒数
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @param scale
+ *            时间尺度
+ * @return TT减去该时间尺度的秒数
+ */
+func getOffsetFromTT(jd float64, scale Scale) float64 {
+    switch scale {
+    case UTC, UT1:
+        tai := jd - TT_MINUS_TAI / 86400
+        if offset, ok := getLeapSecondsForTAI(tai); ok {
+            if scale == UT1 {
+                return offset + TT_MINUS_TAI - GetDUT1(tai - offset / 86400)
+            }
+            return offset + TT_MINUS_TAI
+        }
+        return getDeltaTFromTT(jd)
+    case TAI:
+        return TT_MINUS_TAI
+    case TDB:
+        return -getTDBMinusTT(jd)
+    }
+    return 0
+}
+
+/**
+ * 由TT求∆T，∆T按UT计算，迭代一次即可
+ */
+func getDeltaTFromTT(jd float64) float64 {
+    ut := jd - calendarutil.GetDeltaTJD(jd) / 86400
+    return calendarutil.GetDeltaTJD(ut)
+}
+
+/**
+ * 计算TDB-TT，参考<i>Explanatory Supplement to the Astronomical Almanac</i>(1992)第2.222式
+ *
+ * @param jd
+ *            儒略日(TT)
+ * @return TDB-TT(秒)
+ */
+func getTDBMinusTT(jd float64) float64 {
+    g := mathutil.ToRadians(357.53 + 0.9856003 * (jd - calendarutil.J2000))
+    return 0.001658 * math.Sin(g) + 0.000014 * math.Sin(2 * g)
+}
@@ -0,0 +1,183 @@
+package timescale
+
+import (
+    "calendarutil"
+    "math"
+    "strings"
+    "testing"
+    "time"
+)
+
+func Test_Offset(t *testing.T) {
+    before := FromTime(time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC))
+    after := FromTime(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC))
+    if math.Abs(before.Offset(UTC) - 68.184) < 1e-4 && math.Abs(after.Offset(UTC) - 69.184) < 1e-4 &&
+        math.Abs(after.Offset(TAI) - TT_MINUS_TAI) < 1e-4 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", before.Offset(UTC), after.Offset(UTC))
+    }
+    // 1972年1月1日TAI-UTC为10秒
+    i := FromTime(time.Date(1972, 1, 1, 12, 0, 0, 0, time.UTC))
+    if math.Abs(i.Offset(UTC) - 42.184) > 1e-4 {
+        t.Error("fail", i.Offset(UTC))
+    }
+}
+
+func Test_To(t *testing.T) {
+    for _, scale := range []Scale{UTC, TAI, TT, UT1, TDB} {
+        for _, tm := range []time.Time{
+            time.Date(1960, 5, 1, 0, 0, 0, 0, time.UTC),
+            time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC),
+            time.Date(2017, 1, 1, 0, 0, 1, 0, time.UTC),
+            time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC),
+        } {
+            i := FromTime(tm)
+            back := i.To(scale).To(UTC)
+            if back.Scale() != UTC || math.Abs(back.JulianDate() - i.JulianDate()) * 86400 > 1e-4 {
+                t.Error("fail", scale, tm)
+            }
+        }
+    }
+}
+
+func Test_TDB(t *testing.T) {
+    // TDB-TT不超过1.7毫秒
+    for jd := calendarutil.J2000; jd < calendarutil.J2000 + 366; jd += 10 {
+        i := NewInstant(jd, TT)
+        if math.Abs(i.Offset(TDB)) > 0.0017 {
+            t.Error("fail", jd)
+        }
+    }
+}
+
+func Test_UT1(t *testing.T) {
+    jd := calendarutil.ToJulianDateHMS(1900, 1, 1, 0, 0, 0)
+    i := NewInstant(jd, UT1)
+    // 1972年以前UTC按UT1计算
+    if math.Abs(i.Offset(UT1) - calendarutil.GetDeltaTJD(jd)) < 1e-4 && i.To(UTC).JulianDate() == jd {
+        t.Log("ok")
+    } else {
+        t.Error("fail", i.Offset(UT1))
+    }
+}
+
+func Test_UT1_LeapSeconds(t *testing.T) {
+    tm := time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC)
+    i := FromTime(tm)
+    // 默认UT1-UTC为0
+    if d := i.To(UT1).JulianDay().SubSeconds(i.JulianDay()); math.Abs(d) > 1e-4 || math.Abs(i.Offset(UT1) - 69.184) > 1e-4 {
+        t.Error("fail", d, i.Offset(UT1))
+    }
+    SetDUT1(func(jd float64) float64 {
+        return 0.3
+    })
+    defer SetDUT1(nil)
+    ut1 := i.To(UT1)
+    // UT1和UTC的读数相差0.3秒
+    diff := ut1.JulianDay().SubSeconds(i.JulianDay())
+    if math.Abs(diff - 0.3) < 1e-4 && math.Abs(i.Offset(UT1) - 68.884) < 1e-4 &&
+        math.Abs(ut1.To(UTC).JulianDay().SubSeconds(i.JulianDay())) < 1e-4 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", diff, i.Offset(UT1))
+    }
+}
+
+func Test_Time(t *testing.T) {
+    tm := time.Date(2017, 1, 1, 0, 0, 0, 123456000, time.UTC)
+    i := FromTime(tm).To(TT)
+    // 儒略日用float64表示，分辨率约为20微秒
+    diff := i.Time(time.UTC).Sub(tm)
+    if i.Scale() == TT && diff < 50 * time.Microsecond && diff > -50 * time.Microsecond {
+        t.Log("ok")
+    } else {
+        t.Error("fail", i.Time(time.UTC))
+    }
+}
+
+const leapSecondsList = `#	Updated through IERS Bulletin C
+#$	 3676924800
+#@	 4100000000
+2272060800	10	# 1 Jan 1972
+2287785600	11	# 1 Jul 1972
+3692217600	37	# 1 Jan 2017
+4000000000	38	# 假想的闰秒
+`
+
+func Test_LoadLeapSeconds(t *testing.T) {
+    defer SetLeapSeconds(nil, 0)
+    if err := LoadLeapSeconds(strings.NewReader(leapSecondsList)); err != nil {
+        t.Fatal(err)
+    }
+    table := GetLeapSeconds()
+    if len(table) != 4 || table[0].Start != utcDate(1972, 1) || table[2].Start != utcDate(2017, 1) {
+        t.Error("fail", table)
+    }
+    if GetLeapSecondsExpiry() != NTP_EPOCH + 4100000000.0 / 86400 {
+        t.Error("fail", GetLeapSecondsExpiry())
+    }
+    future := NewInstant(NTP_EPOCH + 4000000000.0 / 86400 + 1, UTC)
+    if math.Abs(future.Offset(UTC) - 70.184) > 1e-4 {
+        t.Error("fail", future.Offset(UTC))
+    }
+
+    SetLeapSeconds(nil, 0)
+    if len(GetLeapSeconds()) == len(defaultLeapSeconds) && math.Abs(future.Offset(UTC) - 69.184) < 1e-4 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+
+    if _, _, err := ParseLeapSeconds(strings.NewReader("#@ 4100000000\n2287785600 11\n2272060800 10\n")); err == nil {
+        t.Error("fail")
+    }
+    if _, _, err := ParseLeapSeconds(strings.NewReader("2272060800 10\n")); err == nil {
+        t.Error("fail")
+    }
+}
+
+// 闰秒表过期以后不再沿用最后一项，UTC按∆T的模型计算
+func Test_LeapSecondsExpiry(t *testing.T) {
+    expires := GetLeapSecondsExpiry()
+    before := NewInstant(expires - 1, UTC)
+    if math.Abs(before.Offset(UTC) - 69.184) > 1e-4 {
+        t.Error("fail", before.Offset(UTC))
+    }
+    after := NewInstant(expires + 365, UTC)
+    if math.Abs(after.Offset(UTC) - calendarutil.GetDeltaTJD(expires + 365)) > 1e-4 {
+        t.Error("fail", after.Offset(UTC))
+    }
+    // 从TT换算回UTC也一样
+    if math.Abs(after.To(TT).To(UTC).jd.Float() - (expires + 365)) > 1e-9 {
+        t.Error("fail", after.To(TT).To(UTC))
+    }
+    if UTC.String() == "UTC" && TDB.String() == "TDB" && Scale(5).String() == "%!Scale(5)" && Scale(-1).String() == "%!Scale(-1)" {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_Instant_RoundTrip(t *testing.T) {
+    // 不同时间尺度之间往返转换后精确到微秒以内，和time.Time之间精确到纳秒
+    tm := time.Date(2024, 6, 21, 12, 34, 56, 123456789, time.UTC)
+    i := FromTime(tm)
+    if !i.Time(time.UTC).Equal(tm) {
+        t.Error("fail", i.Time(time.UTC))
+    }
+    for _, scale := range []Scale{TAI, TT, UT1, TDB} {
+        back := i.To(scale).To(UTC)
+        if math.Abs(back.Sub(i)) > 1e-6 {
+            t.Error("fail", scale, back.Sub(i))
+        }
+    }
+    tt := FromTimeIn(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), TT)
+    if tt.JulianDay().Equal(calendarutil.NewJulianDay(2451545, 0)) &&
+        math.Abs(tt.To(TAI).Sub(tt)) < 1e-9 && math.Abs(tt.AddSeconds(1).Sub(tt) - 1) < 1e-9 &&
+        tt.TimeIn(time.UTC).Equal(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
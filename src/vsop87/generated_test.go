@@ -0,0 +1,46 @@
+package vsop87_test
+
+import (
+    "math"
+    "testing"
+    "vsop87"
+    "vsop87earthd"
+)
+
+// 放在外部测试包里，避免vsop87和vsop87earthd互相导入
+func loadEarth(t *testing.T) *vsop87.Body {
+    bodies, err := vsop87.Load("../../vsop87d.txt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    return bodies["Earth"]
+}
+
+// 与vsop87gen生成的代码比较
+func Test_Evaluate_Generated(t *testing.T) {
+    earth := loadEarth(t)
+    generated := []func(float64) float64{
+        vsop87earthd.GetEarthL0, vsop87earthd.GetEarthL1, vsop87earthd.GetEarthL2,
+        vsop87earthd.GetEarthL3, vsop87earthd.GetEarthL4, vsop87earthd.GetEarthL5,
+    }
+    for _, x := range []float64{-0.5, 0, 0.012345, 1.2345} {
+        for n, f := range generated {
+            if math.Abs(earth.Variables["L"][n].Evaluate(x) - f(x)) > 1e-12 {
+                t.Error("fail", n, x)
+            }
+        }
+    }
+
+    jd := 2451545.0 + 8000.5
+    x := (jd - 2451545.0) / 365250
+    l := math.Mod(earth.Evaluate("L", x), 2 * math.Pi)
+    b := earth.Evaluate("B", x)
+    r := earth.Evaluate("R", x)
+    if math.Abs(l - vsop87earthd.GetSunEclipticLongitudeForEarth(jd)) < 1e-12 &&
+        math.Abs(b - vsop87earthd.GetSunEclipticLatitudeForEarth(jd)) < 1e-12 &&
+        math.Abs(r - vsop87earthd.GetSunRadiusForEarth(jd)) < 1e-12 {
+        t.Log("ok")
+    } else {
+        t.Error("fail", l, b, r)
+    }
+}
@@ -0,0 +1,294 @@
+package vsop87
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "math"
+    "os"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+/**
+ * 序列中的一项 A * cos(B + C * t)
+ */
+type Term struct {
+    A, B, C float64
+}
+
+/**
+ * t的某一次幂的系数序列
+ */
+type Series []Term
+
+/**
+ * 一个天体的全部序列，Variables按变量名(L、B、R或X、Y、Z等)索引，每个变量按t的幂次排列
+ */
+type Body struct {
+    Name string
+    Variables map[string][]Series
+}
+
+/**
+ * 计算序列的值
+ *
+ * @param t
+ *            儒略千年数
+ * @return ΣA * cos(B + C * t)
+ */
+func (s Series) Evaluate(t float64) float64 {
+    result := 0.0
+    for _, term := range s {
+        result += term.A * math.Cos(term.B + term.C * t)
+    }
+    return result
+}
+
+/**
+ * 计算天体某个变量的值，即 Σ(t^n * Series[n])
+ *
+ * @param variable
+ *            变量名，例如"L"
+ * @param t
+ *            儒略千年数
+ * @return 变量的值，没有这个变量时返回0
+ */
+func (b *Body) Evaluate(variable string, t float64) float64 {
+    series := b.Variables[variable]
+    result := 0.0
+    for n := len(series) - 1; n >= 0; n-- {
+        result = result * t + series[n].Evaluate(t)
+    }
+    return result
+}
+
+/**
+ * 从文件中读取VSOP87序列
+ *
+ * @param filename
+ *            文件名
+ * @return 按天体名称索引的序列
+ */
+func Load(filename string) (map[string]*Body, error) {
+    f, err := os.Open(filename)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    return Parse(f)
+}
+
+/**
+ * 解析VSOP87序列，支持两种格式：
+ * <ul>
+ * <li>vsop87d.txt的格式，标题行为"Earth L 0 559"(天体、变量、幂次、项数)，每项一行"A B C"</li>
+ * <li>IMCCE发布的原始文件格式，标题行以"VSOP87"开头，每项一行，最后三列是A、B、C</li>
+ * </ul>
+ *
+ * @param r
+ *            VSOP87文本
+ * @return 按天体名称索引的序列
+ */
+func Parse(r io.Reader) (map[string]*Body, error) {
+    bodies := map[string]*Body{}
+    scanner := bufio.NewScanner(r)
+    lineNo := 0
+    for scanner.Scan() {
+        lineNo++
+        if strings.TrimSpace(scanner.Text()) == "" {
+            continue
+        }
+        name, variable, power, count, err := parseHeader(scanner.Text())
+        if err != nil {
+            return nil, fmt.Errorf("vsop87: line %d: %v", lineNo, err)
+        }
+        series := make(Series, 0, count)
+        for i := 0; i < count; i++ {
+            if !scanner.Scan() {
+                return nil, fmt.Errorf("vsop87: line %d: expected %d terms, got %d", lineNo, count, i)
+            }
+            lineNo++
+            term, err := parseTerm(scanner.Text())
+            if err != nil {
+                return nil, fmt.Errorf("vsop87: line %d: %v", lineNo, err)
+            }
+            series = append(series, term)
+        }
+
+        body, ok := bodies[name]
+        if !ok {
+            body = &Body{name, map[string][]Series{}}
+            bodies[name] = body
+        }
+        list := body.Variables[variable]
+        for len(list) <= power {
+            list = append(list, nil)
+        }
+        list[power] = series
+        body.Variables[variable] = list
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, err
+    }
+    return bodies, nil
+}
+
+/**
+ * 解析标题行
+ *
+ * @return 天体名称、变量名、幂次和项数
+ */
+func parseHeader(line string) (string, string, int, int, error) {
+    fields := strings.Fields(line)
+    if len(fields) > 0 && fields[0] == "VSOP87" {
+        return parseIMCCEHeader(fields)
+    }
+    if len(fields) != 4 {
+        return "", "", 0, 0, fmt.Errorf("invalid header %q", line)
+    }
+    power, err := strconv.Atoi(fields[2])
+    if err != nil {
+        return "", "", 0, 0, err
+    }
+    count, err := strconv.Atoi(fields[3])
+    if err != nil {
+        return "", "", 0, 0, err
+    }
+    return fields[0], fields[1], power, count, nil
+}
+
+/**
+ * 解析IMCCE原始文件的标题行，例如
+ * " VSOP87 VERSION D4    EARTH     VARIABLE 1 (LBR)       *T**0    559 TERMS    HIGHER ORDER OF SIX"
+ */
+func parseIMCCEHeader(fields []string) (string, string, int, int, error) {
+    if len(fields) < 9 || fields[4] != "VARIABLE" || !strings.HasPrefix(fields[7], "*T**") {
+        return "", "", 0, 0, fmt.Errorf("invalid header %q", strings.Join(fields, " "))
+    }
+    // 天体名称只保留首字母大写，与vsop87d.txt一致
+    name := fields[3][:1] + strings.ToLower(fields[3][1:])
+    index, err := strconv.Atoi(fields[5])
+    if err != nil {
+        return "", "", 0, 0, err
+    }
+    // 变量名取自括号里的坐标类型，例如(LBR)中第1个变量是L
+    names := strings.Trim(fields[6], "()")
+    if index < 1 || index > len(names) {
+        return "", "", 0, 0, fmt.Errorf("invalid variable %d of %s", index, fields[6])
+    }
+    power, err := strconv.Atoi(strings.TrimPrefix(fields[7], "*T**"))
+    if err != nil {
+        return "", "", 0, 0, err
+    }
+    count, err := strconv.Atoi(fields[8])
+    if err != nil {
+        return "", "", 0, 0, err
+    }
+    return name, names[index - 1 : index], power, count, nil
+}
+
+/**
+ * 解析一项，取每行的最后三列作为A、B、C
+ */
+func parseTerm(line string) (Term, error) {
+    fields := strings.Fields(line)
+    if len(fields) < 3 {
+        return Term{}, fmt.Errorf("invalid term %q", line)
+    }
+    var values [3]float64
+    for i, s := range fields[len(fields) - 3:] {
+        v, err := strconv.ParseFloat(s, 64)
+        if err != nil {
+            return Term{}, err
+        }
+        values[i] = v
+    }
+    return Term{values[0], values[1], values[2]}, nil
+}
+
+/**
+ * 计算LBR形式(VSOP87 B、D版本)的天体日心坐标
+ *
+ * @param t
+ *            儒略千年数
+ * @return 日心黄经(rad)、日心黄纬(rad)和到太阳的距离(au)
+ */
+func (b *Body) GetPosition(t float64) (float64, float64, float64) {
+    l := math.Mod(b.Evaluate("L", t), 2 * math.Pi)
+    if l < 0 {
+        l += 2 * math.Pi
+    }
+    return l, b.Evaluate("B", t), b.Evaluate("R", t)
+}
+
+/**
+ * 按目标精度截断序列，舍去振幅最小的若干项，使每个变量舍去的振幅之和不超过accuracy。
+ * 在|t|≤1(公元1000年至3000年)之间误差不会超过accuracy，这是一个保守的上界，实际误差通常小一个数量级。
+ * 距离R这类以长度为单位的变量按accuracy乘以平均距离(R0的常数项)截断，相当于相同的相对精度。
+ *
+ * @param accuracy
+ *            目标精度，角度变量的单位是弧度(rad)
+ * @return 截断后的序列，不修改原来的序列
+ */
+func (b *Body) Truncate(accuracy float64) *Body {
+    result := &Body{b.Name, map[string][]Series{}}
+    for variable, series := range b.Variables {
+        budget := accuracy / float64(len(series))
+        if variable == "R" && len(series) > 0 && len(series[0]) > 0 {
+            budget *= math.Abs(series[0][0].A)
+        }
+        truncated := make([]Series, len(series))
+        for n, s := range series {
+            truncated[n] = s.truncate(budget)
+        }
+        result.Variables[variable] = truncated
+    }
+    return result
+}
+
+/**
+ * 舍去振幅最小的项，直到舍去的振幅之和将要超过budget为止，保留的项维持原来的顺序
+ */
+func (s Series) truncate(budget float64) Series {
+    amplitudes := make([]float64, len(s))
+    for i, term := range s {
+        amplitudes[i] = math.Abs(term.A)
+    }
+    sorted := append([]float64(nil), amplitudes...)
+    sort.Float64s(sorted)
+    // 振幅小于threshold的项都舍去
+    threshold := 0.0
+    sum := 0.0
+    for _, a := range sorted {
+        if sum + a > budget {
+            break
+        }
+        sum += a
+        threshold = a
+    }
+    result := make(Series, 0, len(s))
+    dropped := 0.0
+    for i, term := range s {
+        if amplitudes[i] <= threshold && dropped + amplitudes[i] <= sum {
+            dropped += amplitudes[i]
+            continue
+        }
+        result = append(result, term)
+    }
+    return result
+}
+
+/**
+ * 序列的总项数
+ */
+func (b *Body) Len() int {
+    n := 0
+    for _, series := range b.Variables {
+        for _, s := range series {
+            n += len(s)
+        }
+    }
+    return n
+}
@@ -0,0 +1,90 @@
+package vsop87
+
+import (
+    "math"
+    "strings"
+    "testing"
+)
+
+func loadEarth(t *testing.T) *Body {
+    bodies, err := Load("../../vsop87d.txt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(bodies) != 8 {
+        t.Error("fail", len(bodies))
+    }
+    return bodies["Earth"]
+}
+
+func Test_Load(t *testing.T) {
+    earth := loadEarth(t)
+    if len(earth.Variables["L"]) == 6 && len(earth.Variables["B"]) == 5 && len(earth.Variables["R"]) == 6 &&
+        len(earth.Variables["L"][0]) == 559 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// VSOP87D地球在J2000.0和1900年1月0.5日的日心坐标，取自VSOP87发布的vsop87.chk
+func Test_Evaluate(t *testing.T) {
+    earth := loadEarth(t)
+    for _, v := range []struct {
+        jd, l, b, r float64
+    }{
+        {2451545.0, 1.7519238681, -0.0000039656, 0.9833276819},
+        {2415020.0, 1.7391225563, -0.0000005679, 0.9832689778},
+    } {
+        x := (v.jd - 2451545.0) / 365250
+        l := math.Mod(earth.Evaluate("L", x), 2 * math.Pi)
+        if l < 0 {
+            l += 2 * math.Pi
+        }
+        if math.Abs(l - v.l) > 1e-10 || math.Abs(earth.Evaluate("B", x) - v.b) > 1e-10 ||
+            math.Abs(earth.Evaluate("R", x) - v.r) > 1e-10 {
+            t.Error("fail", v.jd, l, earth.Evaluate("B", x), earth.Evaluate("R", x))
+        }
+    }
+    // 每个幂次的序列直接求和
+    series := earth.Variables["L"][1]
+    sum := 0.0
+    for _, term := range series {
+        sum += term.A * math.Cos(term.B + term.C * 0.1)
+    }
+    if sum == series.Evaluate(0.1) {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+// IMCCE原始文件的格式，只取了前两项
+const imcceSample = ` VSOP87 VERSION D4    EARTH     VARIABLE 1 (LBR)       *T**0      2 TERMS    HIGHER ORDER OF SIX
+ 4310    1  0  0  0  0  0  0  0  0  0  0  0  0     0.00000000000     0.00000000000     1.75347045673 0.00000000000     0.00000000000
+ 4310    2  0  0  1  0  0  0  0  0  0  0  0  0     0.00000000000     0.00000000000     0.03341656456 4.66925680417  6283.07584999140
+ VSOP87 VERSION D4    EARTH     VARIABLE 3 (LBR)       *T**1      1 TERMS    HIGHER ORDER OF SIX
+ 4331    1  0  0  1  0  0  0  0  0  0  0  0  0     0.00000000000     0.00000000000     0.00103018607 1.10748968172  6283.07584999140
+`
+
+func Test_Parse_IMCCE(t *testing.T) {
+    bodies, err := Parse(strings.NewReader(imcceSample))
+    if err != nil {
+        t.Fatal(err)
+    }
+    earth := bodies["Earth"]
+    if earth != nil && len(earth.Variables["L"][0]) == 2 && earth.Variables["L"][0][1].C == 6283.07584999140 &&
+        len(earth.Variables["R"]) == 2 && earth.Variables["R"][0] == nil && earth.Variables["R"][1][0].A == 0.00103018607 {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+}
+
+func Test_Parse_Error(t *testing.T) {
+    for _, s := range []string{"Earth L 0\n", "Earth L 0 2\n1 2 3\n", "Earth L 0 1\n1 x 3\n"} {
+        if _, err := Parse(strings.NewReader(s)); err == nil {
+            t.Error("fail", s)
+        }
+    }
+}
@@ -4,6 +4,7 @@ import (
     "calendarutil"
     "mathutil"
     "math"
+    "nutation"
 )
 /**
  * 按儒略日计算地球的日心黄经
@@ -118,7 +119,7 @@ func GetEarthEclipticLongitudeForSun(jd float64 ) float64 {
 
 
     // 修正章动
-    // l += GetLongitudeNutation(jd)
+    l += nutation.GetLongitudeNutation(jd)
 
     // 转换到fk5
     l += Vsop2Fk5LongitudeCorrection(l, b, jd);
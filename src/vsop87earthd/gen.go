@@ -0,0 +1,3 @@
+package vsop87earthd
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Earth -package vsop87earthd
@@ -0,0 +1,102 @@
+package vsop87earthd
+
+import (
+    "calendarutil"
+    "math"
+    "mathutil"
+    "sync"
+    "vsop87"
+)
+
+/**
+ * 常用的目标精度，单位是弧度(rad)。太阳视黄经每分钟约变化2.5″，1″的精度相当于节气时刻误差约半分钟
+ */
+const (
+    ACCURACY_ARC_SECOND = math.Pi / 648000
+    ACCURACY_10_ARC_SECONDS = 10 * ACCURACY_ARC_SECOND
+    ACCURACY_ARC_MINUTE = 60 * ACCURACY_ARC_SECOND
+)
+
+/**
+ * 完整的地球VSOP87D序列
+ */
+var earth = &vsop87.Body{Name: "Earth", Variables: map[string][]vsop87.Series{"L": earthL, "B": earthB, "R": earthR}}
+
+/**
+ * 可以缓存的精度，从高到低排列
+ */
+var accuracyLevels = []float64{ACCURACY_ARC_SECOND, ACCURACY_10_ARC_SECONDS, ACCURACY_ARC_MINUTE}
+
+var (
+    truncatedLock sync.Mutex
+    // 按精度缓存截断后的序列，键只取accuracyLevels中的值，所以缓存的大小有上限
+    truncatedCache = map[float64]*vsop87.Body{}
+)
+
+/**
+ * 取得按目标精度截断的地球序列，公元1000年至3000年之间误差不超过accuracy，参见vsop87.Body.Truncate。
+ * 任意的accuracy向下取到不低于它的ACCURACY_*精度，比ACCURACY_ARC_SECOND还高时返回完整的序列
+ *
+ * @param accuracy
+ *            目标精度，单位是弧度(rad)
+ * @return 截断后的序列
+ */
+func GetTruncatedSeries(accuracy float64) *vsop87.Body {
+    level := 0.0
+    for _, v := range accuracyLevels {
+        if v <= accuracy {
+            level = v
+        }
+    }
+    if level == 0 {
+        return earth
+    }
+
+    truncatedLock.Lock()
+    defer truncatedLock.Unlock()
+    body, ok := truncatedCache[level]
+    if !ok {
+        body = earth.Truncate(level)
+        truncatedCache[level] = body
+    }
+    return body
+}
+
+/**
+ * 用截断的序列计算地球的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @param accuracy
+ *            目标精度，单位是弧度(rad)
+ * @return 地球的日心黄经，单位是弧度(rad)
+ */
+func GetTruncatedSunEclipticLongitudeForEarth(jd float64, accuracy float64) float64 {
+    return mathutil.Mod2Pi(GetTruncatedSeries(accuracy).Evaluate("L", calendarutil.GetJulianThousandYears(jd)))
+}
+
+/**
+ * 用截断的序列计算地球的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @param accuracy
+ *            目标精度，单位是弧度(rad)
+ * @return 地球的日心黄纬，单位是弧度(rad)
+ */
+func GetTruncatedSunEclipticLatitudeForEarth(jd float64, accuracy float64) float64 {
+    return GetTruncatedSeries(accuracy).Evaluate("B", calendarutil.GetJulianThousandYears(jd))
+}
+
+/**
+ * 用截断的序列计算地球和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @param accuracy
+ *            目标精度，单位是弧度(rad)，距离的相对误差与之相当
+ * @return 地球和太阳的距离，单位是天文单位(au)
+ */
+func GetTruncatedSunRadiusForEarth(jd float64, accuracy float64) float64 {
+    return GetTruncatedSeries(accuracy).Evaluate("R", calendarutil.GetJulianThousandYears(jd))
+}
@@ -0,0 +1,399 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// EARTH B - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87earthd
+
+import "vsop87"
+
+var earthB = []vsop87.Series{
+	// B0, 184 terms
+	{
+		{A: 2.7962e-06, B: 3.19870156017, C: 84334.66158130829},
+		{A: 1.01643e-06, B: 5.42248619256, C: 5507.5532386674},
+		{A: 8.0445e-07, B: 3.88013204458, C: 5223.6939198022},
+		{A: 4.3806e-07, B: 3.70444689758, C: 2352.8661537718},
+		{A: 3.1933e-07, B: 4.00026369781, C: 1577.3435424478},
+		{A: 2.2724e-07, B: 3.9847383156, C: 1047.7473117547},
+		{A: 1.6392e-07, B: 3.56456119782, C: 5856.4776591154},
+		{A: 1.8141e-07, B: 4.98367470263, C: 6283.0758499914},
+		{A: 1.4443e-07, B: 3.70275614914, C: 9437.762934887},
+		{A: 1.4304e-07, B: 3.41117857525, C: 10213.285546211},
+		{A: 1.1246e-07, B: 4.8282069053, C: 14143.4952424306},
+		{A: 1.09e-07, B: 2.08574562327, C: 6812.766815086},
+		{A: 9.714e-08, B: 3.47303947752, C: 4694.0029547076},
+		{A: 1.0367e-07, B: 4.05663927946, C: 71092.88135493269},
+		{A: 8.775e-08, B: 4.44016515669, C: 5753.3848848968},
+		{A: 8.366e-08, B: 4.9925151218, C: 7084.8967811152},
+		{A: 6.921e-08, B: 4.32559054073, C: 6275.9623029906},
+		{A: 9.145e-08, B: 1.14182646613, C: 6620.8901131878},
+		{A: 7.194e-08, B: 3.60193205752, C: 529.6909650946},
+		{A: 7.698e-08, B: 5.55425745881, C: 167621.5758508619},
+		{A: 5.285e-08, B: 2.48446991566, C: 4705.7323075436},
+		{A: 5.208e-08, B: 6.24992674537, C: 18073.7049386502},
+		{A: 4.529e-08, B: 2.33827747356, C: 6309.3741697912},
+		{A: 5.579e-08, B: 4.41023653738, C: 7860.4193924392},
+		{A: 4.743e-08, B: 0.70995680136, C: 5884.9268465832},
+		{A: 4.301e-08, B: 1.10255777773, C: 6681.2248533996},
+		{A: 3.849e-08, B: 1.82229412531, C: 5486.777843175},
+		{A: 4.093e-08, B: 5.11700141207, C: 13367.9726311066},
+		{A: 3.681e-08, B: 0.43793170356, C: 3154.6870848956},
+		{A: 3.42e-08, B: 5.42034800952, C: 6069.7767545534},
+		{A: 3.617e-08, B: 6.04641937526, C: 3930.2096962196},
+		{A: 3.67e-08, B: 4.58210192227, C: 12194.0329146209},
+		{A: 2.918e-08, B: 1.95463881126, C: 10977.078804699},
+		{A: 2.797e-08, B: 5.61259275048, C: 11790.6290886588},
+		{A: 2.502e-08, B: 0.60499729367, C: 6496.3749454294},
+		{A: 2.319e-08, B: 5.01648216014, C: 1059.3819301892},
+		{A: 2.684e-08, B: 1.39470396488, C: 22003.9146348698},
+		{A: 2.428e-08, B: 3.24183056052, C: 78051.5857313169},
+		{A: 2.12e-08, B: 4.30691000285, C: 5643.1785636774},
+		{A: 2.257e-08, B: 3.15557225618, C: 90617.7374312997},
+		{A: 1.813e-08, B: 3.75574218285, C: 3340.6124266998},
+		{A: 2.226e-08, B: 2.79699346659, C: 12036.4607348882},
+		{A: 1.888e-08, B: 0.86991545823, C: 8635.9420037632},
+		{A: 1.517e-08, B: 1.95852055701, C: 398.1490034082},
+		{A: 1.581e-08, B: 3.19976230948, C: 5088.6288397668},
+		{A: 1.421e-08, B: 6.25530883827, C: 2544.3144198834},
+		{A: 1.595e-08, B: 0.25619915135, C: 17298.1823273262},
+		{A: 1.391e-08, B: 4.69964175561, C: 7058.5984613154},
+		{A: 1.478e-08, B: 2.81808207569, C: 25934.1243310894},
+		{A: 1.481e-08, B: 3.65823554806, C: 11506.7697697936},
+		{A: 1.693e-08, B: 4.95689385293, C: 156475.2902479957},
+		{A: 1.183e-08, B: 1.29343061246, C: 775.522611324},
+		{A: 1.114e-08, B: 2.37889311846, C: 3738.761430108},
+		{A: 9.94e-09, B: 4.30088900425, C: 9225.539273283},
+		{A: 9.24e-09, B: 3.06451026812, C: 4164.311989613},
+		{A: 8.67e-09, B: 0.55606931068, C: 8429.2412664666},
+		{A: 9.88e-09, B: 5.97286104208, C: 7079.3738568078},
+		{A: 8.24e-09, B: 1.50984806173, C: 10447.3878396044},
+		{A: 9.15e-09, B: 0.12635654592, C: 11015.1064773348},
+		{A: 7.42e-09, B: 1.99159139281, C: 26087.9031415742},
+		{A: 1.039e-08, B: 3.14159265359, C: 0},
+		{A: 8.5e-09, B: 4.24120016095, C: 29864.334027309},
+		{A: 7.55e-09, B: 2.8963187332, C: 4732.0306273434},
+		{A: 7.14e-09, B: 1.37548118603, C: 2146.1654164752},
+		{A: 7.08e-09, B: 1.91406542362, C: 8031.0922630584},
+		{A: 7.46e-09, B: 0.57893808616, C: 796.2980068164},
+		{A: 8.02e-09, B: 5.1233913723, C: 2942.4634232916},
+		{A: 7.51e-09, B: 1.67479850166, C: 21228.3920235458},
+		{A: 6.02e-09, B: 4.09976538826, C: 64809.80550494129},
+		{A: 5.94e-09, B: 3.49580704962, C: 16496.3613962024},
+		{A: 5.92e-09, B: 4.59481504319, C: 4690.4798363586},
+		{A: 5.3e-09, B: 5.739792952, C: 8827.3902698748},
+		{A: 5.03e-09, B: 5.66433137112, C: 33794.5437235286},
+		{A: 4.83e-09, B: 1.57106522411, C: 801.8209311238},
+		{A: 4.38e-09, B: 0.06707733767, C: 3128.3887650958},
+		{A: 4.23e-09, B: 2.86944595927, C: 12566.1516999828},
+		{A: 5.04e-09, B: 3.2620766916, C: 7632.9432596502},
+		{A: 5.52e-09, B: 1.02926440457, C: 239762.20451754928},
+		{A: 4.27e-09, B: 3.6743437821, C: 213.299095438},
+		{A: 4.04e-09, B: 1.46193297142, C: 15720.8387848784},
+		{A: 5.03e-09, B: 4.85802444134, C: 6290.1893969922},
+		{A: 4.17e-09, B: 0.81920713533, C: 5216.5803728014},
+		{A: 3.65e-09, B: 0.01002966162, C: 12168.0026965746},
+		{A: 3.63e-09, B: 1.28376436579, C: 6206.8097787158},
+		{A: 3.53e-09, B: 4.7005913311, C: 7234.794256242},
+		{A: 4.15e-09, B: 0.96862624175, C: 4136.9104335162},
+		{A: 3.87e-09, B: 3.09145061418, C: 25158.6017197654},
+		{A: 3.73e-09, B: 2.65119262792, C: 7342.4577801806},
+		{A: 3.61e-09, B: 2.97762937739, C: 9623.6882766912},
+		{A: 4.18e-09, B: 3.75759994446, C: 5230.807466803},
+		{A: 3.96e-09, B: 1.22507712354, C: 6438.4962494256},
+		{A: 3.22e-09, B: 1.21162178805, C: 8662.240323563},
+		{A: 2.84e-09, B: 5.64170320068, C: 1589.0728952838},
+		{A: 3.79e-09, B: 1.72248432748, C: 14945.3161735544},
+		{A: 3.2e-09, B: 3.94161159962, C: 7330.8231617461},
+		{A: 3.13e-09, B: 5.47602376446, C: 1194.4470102246},
+		{A: 2.92e-09, B: 1.38971327603, C: 11769.8536931664},
+		{A: 3.05e-09, B: 0.80429352049, C: 37724.7534197482},
+		{A: 2.57e-09, B: 5.81382809757, C: 426.598190876},
+		{A: 2.65e-09, B: 6.10358507671, C: 6836.6452528338},
+		{A: 2.5e-09, B: 4.56452895547, C: 7477.522860216},
+		{A: 2.66e-09, B: 2.62926282354, C: 7238.6755916},
+		{A: 2.63e-09, B: 6.22089501237, C: 6133.5126528568},
+		{A: 3.06e-09, B: 2.79682380531, C: 1748.016413067},
+		{A: 2.36e-09, B: 2.46093023714, C: 11371.7046897582},
+		{A: 3.16e-09, B: 1.62662805006, C: 250908.4901204155},
+		{A: 2.16e-09, B: 3.68721275185, C: 5849.3641121146},
+		{A: 2.3e-09, B: 0.36165162947, C: 5863.5912061162},
+		{A: 2.33e-09, B: 5.03509933858, C: 20426.571092422},
+		{A: 2e-09, B: 5.86073159059, C: 4535.0594369244},
+		{A: 2.77e-09, B: 4.65400292395, C: 82239.1669577989},
+		{A: 2.09e-09, B: 3.72323200804, C: 10973.55568635},
+		{A: 1.99e-09, B: 5.05186622555, C: 5429.8794682394},
+		{A: 2.56e-09, B: 2.4092327977, C: 19651.048481098},
+		{A: 2.1e-09, B: 4.50691909144, C: 29088.811415985},
+		{A: 1.81e-09, B: 6.00294783127, C: 4292.3308329504},
+		{A: 2.49e-09, B: 0.12900984422, C: 154379.7956244863},
+		{A: 2.09e-09, B: 3.87759458598, C: 17789.845619785},
+		{A: 2.25e-09, B: 3.18339652605, C: 18875.525869774},
+		{A: 1.91e-09, B: 4.53897489299, C: 18477.1087646123},
+		{A: 1.72e-09, B: 2.09694183014, C: 13095.8426650774},
+		{A: 1.82e-09, B: 3.161079435, C: 16730.4636895958},
+		{A: 1.88e-09, B: 2.22746128596, C: 41654.9631159678},
+		{A: 1.64e-09, B: 5.18686275017, C: 5481.2549188676},
+		{A: 1.6e-09, B: 2.49298855159, C: 12592.4500197826},
+		{A: 1.55e-09, B: 1.5959543823, C: 10021.8372800994},
+		{A: 1.35e-09, B: 0.21349051064, C: 10988.808157535},
+		{A: 1.78e-09, B: 3.8037517797, C: 23581.2581773176},
+		{A: 1.23e-09, B: 1.66800739151, C: 15110.4661198662},
+		{A: 1.22e-09, B: 2.72678272244, C: 18849.2275499742},
+		{A: 1.26e-09, B: 1.1767551291, C: 14919.0178537546},
+		{A: 1.42e-09, B: 3.95053441332, C: 337.8142631964},
+		{A: 1.16e-09, B: 6.06340906229, C: 6709.6740408674},
+		{A: 1.37e-09, B: 3.52143246757, C: 12139.5535091068},
+		{A: 1.36e-09, B: 2.92179113542, C: 32217.2001810808},
+		{A: 1.1e-09, B: 3.51203379263, C: 18052.9295431578},
+		{A: 1.47e-09, B: 4.63371971408, C: 22805.7355659936},
+		{A: 1.08e-09, B: 5.45280814878, C: 7.1135470008},
+		{A: 1.48e-09, B: 0.65447253687, C: 95480.9471841745},
+		{A: 1.19e-09, B: 5.92110458985, C: 33019.0211122046},
+		{A: 1.1e-09, B: 5.34824206306, C: 639.897286314},
+		{A: 1.06e-09, B: 3.71081682629, C: 14314.1681130498},
+		{A: 1.39e-09, B: 6.17607198418, C: 24356.7807886416},
+		{A: 1.18e-09, B: 5.5973871267, C: 161338.5000008705},
+		{A: 1.17e-09, B: 3.6506527164, C: 45585.1728121874},
+		{A: 1.27e-09, B: 4.74596574209, C: 49515.382508407},
+		{A: 1.2e-09, B: 1.04211499785, C: 6915.8595893046},
+		{A: 1.2e-09, B: 5.60638811846, C: 5650.2921106782},
+		{A: 1.15e-09, B: 3.10668213289, C: 14712.317116458},
+		{A: 9.9e-10, B: 0.69018940049, C: 12779.4507954208},
+		{A: 9.7e-10, B: 1.07908724794, C: 9917.6968745098},
+		{A: 9.3e-10, B: 2.62295197319, C: 17260.1546546904},
+		{A: 9.9e-10, B: 4.45774681732, C: 4933.2084403326},
+		{A: 1.23e-09, B: 1.37488922089, C: 28286.9904848612},
+		{A: 1.21e-09, B: 5.19767249813, C: 27511.4678735372},
+		{A: 1.05e-09, B: 0.87192267806, C: 77375.95720492408},
+		{A: 8.7e-10, B: 3.9363781295, C: 17654.7805397496},
+		{A: 1.22e-09, B: 2.2395606868, C: 83997.09113559539},
+		{A: 8.7e-10, B: 4.18201600952, C: 22779.4372461938},
+		{A: 1.04e-09, B: 4.59580877295, C: 1349.8674096588},
+		{A: 1.02e-09, B: 2.83545248411, C: 12352.8526045448},
+		{A: 1.02e-09, B: 3.97386522171, C: 10818.1352869158},
+		{A: 1.01e-09, B: 4.32892825857, C: 36147.4098773004},
+		{A: 9.4e-10, B: 5.00001709261, C: 150192.2143980043},
+		{A: 7.7e-10, B: 3.97199369296, C: 1592.5960136328},
+		{A: 1e-09, B: 6.07733097102, C: 26735.9452622132},
+		{A: 8.6e-10, B: 5.2602963825, C: 28313.288804661},
+		{A: 9.3e-10, B: 4.31900620254, C: 44809.6502008634},
+		{A: 7.6e-10, B: 6.22743405935, C: 13521.7514415914},
+		{A: 7.2e-10, B: 1.55820597747, C: 6256.7775301916},
+		{A: 8.2e-10, B: 4.95202664555, C: 10575.4066829418},
+		{A: 8.2e-10, B: 1.69647647075, C: 1990.745017041},
+		{A: 7.5e-10, B: 2.29836095644, C: 3634.6210245184},
+		{A: 7.5e-10, B: 2.66367876557, C: 16200.7727245012},
+		{A: 8.7e-10, B: 0.26630214764, C: 31441.6775697568},
+		{A: 7.7e-10, B: 2.25530954137, C: 5235.3285382367},
+		{A: 7.6e-10, B: 1.09869730846, C: 12903.9659631792},
+		{A: 5.8e-10, B: 4.28246138307, C: 12559.038152982},
+		{A: 6.4e-10, B: 5.51112830114, C: 173904.65170085328},
+		{A: 5.6e-10, B: 2.60133794851, C: 73188.3759784421},
+		{A: 5.5e-10, B: 5.81483150022, C: 143233.51002162008},
+		{A: 5.4e-10, B: 3.38482031504, C: 323049.11878710287},
+		{A: 3.9e-10, B: 3.28500401343, C: 71768.50988132549},
+		{A: 3.9e-10, B: 3.1123991069, C: 96900.81328129109},
+	},
+	// B1, 99 terms
+	{
+		{A: 9.03e-08, B: 3.8972906189, C: 5507.5532386674},
+		{A: 6.177e-08, B: 1.73038850355, C: 5223.6939198022},
+		{A: 3.8e-08, B: 5.24404145734, C: 2352.8661537718},
+		{A: 2.834e-08, B: 2.4734503745, C: 1577.3435424478},
+		{A: 1.817e-08, B: 0.41874743765, C: 6283.0758499914},
+		{A: 1.499e-08, B: 1.83320979291, C: 5856.4776591154},
+		{A: 1.466e-08, B: 5.69401926017, C: 5753.3848848968},
+		{A: 1.301e-08, B: 2.18890066314, C: 9437.762934887},
+		{A: 1.233e-08, B: 4.95222451476, C: 10213.285546211},
+		{A: 1.021e-08, B: 0.12866660208, C: 7860.4193924392},
+		{A: 9.82e-09, B: 0.09005453285, C: 14143.4952424306},
+		{A: 8.65e-09, B: 1.73949953555, C: 3930.2096962196},
+		{A: 5.81e-09, B: 2.26949174067, C: 5884.9268465832},
+		{A: 5.24e-09, B: 5.65662503159, C: 529.6909650946},
+		{A: 4.73e-09, B: 6.22750969242, C: 6309.3741697912},
+		{A: 4.51e-09, B: 1.53288619213, C: 18073.7049386502},
+		{A: 3.64e-09, B: 3.61614477374, C: 13367.9726311066},
+		{A: 3.72e-09, B: 3.2247072132, C: 6275.9623029906},
+		{A: 2.68e-09, B: 2.34341267879, C: 11790.6290886588},
+		{A: 3.22e-09, B: 0.94084045832, C: 6069.7767545534},
+		{A: 2.32e-09, B: 0.26781182579, C: 7058.5984613154},
+		{A: 2.16e-09, B: 6.05952221329, C: 10977.078804699},
+		{A: 2.32e-09, B: 2.93325646109, C: 22003.9146348698},
+		{A: 2.04e-09, B: 3.86264841382, C: 6496.3749454294},
+		{A: 2.02e-09, B: 2.81892511133, C: 15720.8387848784},
+		{A: 1.85e-09, B: 4.93512381859, C: 12036.4607348882},
+		{A: 2.2e-09, B: 3.99305643742, C: 6812.766815086},
+		{A: 1.66e-09, B: 1.74970002999, C: 11506.7697697936},
+		{A: 2.12e-09, B: 1.57166285369, C: 4694.0029547076},
+		{A: 1.57e-09, B: 1.08259734788, C: 5643.1785636774},
+		{A: 1.54e-09, B: 5.99434678412, C: 5486.777843175},
+		{A: 1.44e-09, B: 5.23285656085, C: 78051.5857313169},
+		{A: 1.44e-09, B: 1.16454655948, C: 90617.7374312997},
+		{A: 1.37e-09, B: 2.67760436027, C: 6290.1893969922},
+		{A: 1.8e-09, B: 2.06509026215, C: 7084.8967811152},
+		{A: 1.21e-09, B: 5.90212574947, C: 9225.539273283},
+		{A: 1.5e-09, B: 2.00175038718, C: 5230.807466803},
+		{A: 1.49e-09, B: 5.06157254516, C: 17298.1823273262},
+		{A: 1.18e-09, B: 5.39979058038, C: 3340.6124266998},
+		{A: 1.61e-09, B: 3.32421999691, C: 6283.3196674749},
+		{A: 1.21e-09, B: 4.36722193162, C: 19651.048481098},
+		{A: 1.16e-09, B: 5.83462858507, C: 4705.7323075436},
+		{A: 1.28e-09, B: 4.35489873365, C: 25934.1243310894},
+		{A: 1.43e-09, B: 0, C: 0},
+		{A: 1.09e-09, B: 2.52157834166, C: 6438.4962494256},
+		{A: 9.9e-10, B: 2.70727488041, C: 5216.5803728014},
+		{A: 1.03e-09, B: 0.93782340879, C: 8827.3902698748},
+		{A: 8.2e-10, B: 4.2921468039, C: 8635.9420037632},
+		{A: 7.9e-10, B: 2.24085737326, C: 1059.3819301892},
+		{A: 9.7e-10, B: 5.50959692365, C: 29864.334027309},
+		{A: 7.2e-10, B: 0.21891639822, C: 21228.3920235458},
+		{A: 7.1e-10, B: 2.86755026812, C: 6681.2248533996},
+		{A: 7.4e-10, B: 2.20184828895, C: 37724.7534197482},
+		{A: 6.3e-10, B: 4.45586625948, C: 7079.3738568078},
+		{A: 6.1e-10, B: 0.63918772258, C: 33794.5437235286},
+		{A: 4.7e-10, B: 2.09070235724, C: 3128.3887650958},
+		{A: 4.7e-10, B: 3.325438433, C: 26087.9031415742},
+		{A: 4.9e-10, B: 1.60680905005, C: 6702.5604938666},
+		{A: 5.7e-10, B: 0.11215813438, C: 29088.811415985},
+		{A: 5.6e-10, B: 5.47982934911, C: 775.522611324},
+		{A: 5e-10, B: 1.89396788463, C: 12139.5535091068},
+		{A: 4.7e-10, B: 2.9721490724, C: 20426.571092422},
+		{A: 4.1e-10, B: 5.5532939489, C: 11015.1064773348},
+		{A: 4.1e-10, B: 5.91861144924, C: 23581.2581773176},
+		{A: 4.5e-10, B: 4.95273290181, C: 5863.5912061162},
+		{A: 5e-10, B: 3.62740835096, C: 41654.9631159678},
+		{A: 3.7e-10, B: 6.09033460601, C: 64809.80550494129},
+		{A: 3.7e-10, B: 5.86153655431, C: 12566.1516999828},
+		{A: 4.6e-10, B: 1.65798680284, C: 25158.6017197654},
+		{A: 3.8e-10, B: 2.00673650251, C: 426.598190876},
+		{A: 3.6e-10, B: 6.24373396652, C: 6283.14316029419},
+		{A: 3.6e-10, B: 0.40465162918, C: 6283.0085396886},
+		{A: 3.2e-10, B: 6.03707103538, C: 2942.4634232916},
+		{A: 4.1e-10, B: 4.86809570283, C: 1592.5960136328},
+		{A: 2.8e-10, B: 4.38359423735, C: 7632.9432596502},
+		{A: 2.8e-10, B: 6.03334294232, C: 17789.845619785},
+		{A: 2.6e-10, B: 3.88971333608, C: 5331.3574437408},
+		{A: 2.6e-10, B: 5.94932724051, C: 16496.3613962024},
+		{A: 3.1e-10, B: 1.44666331503, C: 16730.4636895958},
+		{A: 2.6e-10, B: 6.26376705837, C: 23543.23050468179},
+		{A: 3.3e-10, B: 0.93797239147, C: 213.299095438},
+		{A: 2.6e-10, B: 3.71858432944, C: 13095.8426650774},
+		{A: 2.7e-10, B: 0.60565274405, C: 10988.808157535},
+		{A: 2.3e-10, B: 4.4438898555, C: 18849.2275499742},
+		{A: 2.8e-10, B: 1.53862289477, C: 6279.4854213396},
+		{A: 2.8e-10, B: 1.96831814872, C: 6286.6662786432},
+		{A: 2.8e-10, B: 5.78094918529, C: 15110.4661198662},
+		{A: 2.6e-10, B: 2.48165809843, C: 5729.506447149},
+		{A: 2e-10, B: 3.85655029499, C: 9623.6882766912},
+		{A: 2.1e-10, B: 5.83006047147, C: 7234.794256242},
+		{A: 2.1e-10, B: 0.69628570421, C: 398.1490034082},
+		{A: 2.2e-10, B: 5.02222806555, C: 6127.6554505572},
+		{A: 2e-10, B: 3.4761126529, C: 6148.010769956},
+		{A: 2e-10, B: 0.90769829044, C: 5481.2549188676},
+		{A: 2e-10, B: 0.03081589303, C: 6418.1409300268},
+		{A: 2e-10, B: 3.74220084927, C: 1589.0728952838},
+		{A: 2.1e-10, B: 4.00149269576, C: 3154.6870848956},
+		{A: 1.8e-10, B: 1.58348238359, C: 2118.7638603784},
+		{A: 1.9e-10, B: 0.85407021371, C: 14712.317116458},
+	},
+	// B2, 49 terms
+	{
+		{A: 1.662e-08, B: 1.62703209173, C: 84334.66158130829},
+		{A: 4.92e-09, B: 2.41382223971, C: 1047.7473117547},
+		{A: 3.44e-09, B: 2.24353004539, C: 5507.5532386674},
+		{A: 2.58e-09, B: 6.00906896311, C: 5223.6939198022},
+		{A: 1.31e-09, B: 0.9544734524, C: 6283.0758499914},
+		{A: 8.6e-10, B: 1.67530247303, C: 7860.4193924392},
+		{A: 9e-10, B: 0.97606804452, C: 1577.3435424478},
+		{A: 9e-10, B: 0.37899871725, C: 2352.8661537718},
+		{A: 8.9e-10, B: 6.25807507963, C: 10213.285546211},
+		{A: 7.5e-10, B: 0.84213523741, C: 167621.5758508619},
+		{A: 5.2e-10, B: 1.70501566089, C: 14143.4952424306},
+		{A: 5.7e-10, B: 6.15295833679, C: 12194.0329146209},
+		{A: 5.1e-10, B: 1.2761601674, C: 5753.3848848968},
+		{A: 5.1e-10, B: 5.37229738682, C: 6812.766815086},
+		{A: 3.4e-10, B: 1.73672994279, C: 7058.5984613154},
+		{A: 3.8e-10, B: 2.77761031485, C: 10988.808157535},
+		{A: 4.6e-10, B: 3.38617099014, C: 156475.2902479957},
+		{A: 2.1e-10, B: 1.95248349228, C: 8827.3902698748},
+		{A: 1.8e-10, B: 3.33419222028, C: 8429.2412664666},
+		{A: 1.9e-10, B: 4.32945160287, C: 17789.845619785},
+		{A: 1.7e-10, B: 0.66191210656, C: 6283.0085396886},
+		{A: 1.8e-10, B: 3.74885333072, C: 11769.8536931664},
+		{A: 1.7e-10, B: 4.23058370776, C: 10977.078804699},
+		{A: 1.7e-10, B: 1.78116162721, C: 5486.777843175},
+		{A: 2.1e-10, B: 1.36972913918, C: 12036.4607348882},
+		{A: 1.7e-10, B: 2.79601092529, C: 796.2980068164},
+		{A: 1.5e-10, B: 0.4308784885, C: 11790.6290886588},
+		{A: 1.7e-10, B: 1.35132152761, C: 78051.5857313169},
+		{A: 1.5e-10, B: 1.17032155085, C: 213.299095438},
+		{A: 1.8e-10, B: 2.85221514199, C: 5088.6288397668},
+		{A: 1.7e-10, B: 0.21780913672, C: 6283.14316029419},
+		{A: 1.3e-10, B: 1.21201504386, C: 25132.3033999656},
+		{A: 1.2e-10, B: 1.12953712197, C: 90617.7374312997},
+		{A: 1.2e-10, B: 5.13714452592, C: 7079.3738568078},
+		{A: 1.3e-10, B: 3.79842135217, C: 4933.2084403326},
+		{A: 1.2e-10, B: 4.89407978213, C: 3738.761430108},
+		{A: 1.5e-10, B: 6.05682328852, C: 398.1490034082},
+		{A: 1.4e-10, B: 4.81029291856, C: 4694.0029547076},
+		{A: 1.1e-10, B: 0.61684523405, C: 3128.3887650958},
+		{A: 1.1e-10, B: 5.328765385, C: 6040.3472460174},
+		{A: 1.4e-10, B: 5.27227350286, C: 4535.0594369244},
+		{A: 1.1e-10, B: 2.39292099451, C: 5331.3574437408},
+		{A: 1e-10, B: 4.4529653271, C: 6525.8044539654},
+		{A: 1.4e-10, B: 4.66400985037, C: 8031.0922630584},
+		{A: 1e-10, B: 3.22472385926, C: 9437.762934887},
+		{A: 1.1e-10, B: 3.80913404437, C: 801.8209311238},
+		{A: 1e-10, B: 5.15032130575, C: 11371.7046897582},
+		{A: 1.3e-10, B: 0.98720797401, C: 5729.506447149},
+		{A: 9e-11, B: 5.94191743597, C: 7632.9432596502},
+	},
+	// B3, 11 terms
+	{
+		{A: 1.1e-10, B: 0.23877262399, C: 7860.4193924392},
+		{A: 9e-11, B: 1.16069982609, C: 5507.5532386674},
+		{A: 8e-11, B: 1.65357552925, C: 5884.9268465832},
+		{A: 8e-11, B: 2.86720038197, C: 7058.5984613154},
+		{A: 7e-11, B: 3.04818741666, C: 5486.777843175},
+		{A: 7e-11, B: 2.59437103785, C: 529.6909650946},
+		{A: 8e-11, B: 4.02863090524, C: 6256.7775301916},
+		{A: 8e-11, B: 2.42003508927, C: 5753.3848848968},
+		{A: 6e-11, B: 0.84181087594, C: 6275.9623029906},
+		{A: 6e-11, B: 5.40160929468, C: 1577.3435424478},
+		{A: 7e-11, B: 2.73399865247, C: 6309.3741697912},
+	},
+	// B4, 5 terms
+	{
+		{A: 4e-11, B: 0.79662198849, C: 6438.4962494256},
+		{A: 5e-11, B: 0.84308705203, C: 1047.7473117547},
+		{A: 5e-11, B: 0.05711572303, C: 84334.66158130829},
+		{A: 3e-11, B: 3.46779895686, C: 6279.5527316424},
+		{A: 3e-11, B: 2.89822201212, C: 6127.6554505572},
+	},
+}
+
+// GetEarthB0 evaluates the t^0 series of B.
+func GetEarthB0(t float64) float64 {
+	return earthB[0].Evaluate(t)
+}
+
+// GetEarthB1 evaluates the t^1 series of B.
+func GetEarthB1(t float64) float64 {
+	return earthB[1].Evaluate(t)
+}
+
+// GetEarthB2 evaluates the t^2 series of B.
+func GetEarthB2(t float64) float64 {
+	return earthB[2].Evaluate(t)
+}
+
+// GetEarthB3 evaluates the t^3 series of B.
+func GetEarthB3(t float64) float64 {
+	return earthB[3].Evaluate(t)
+}
+
+// GetEarthB4 evaluates the t^4 series of B.
+func GetEarthB4(t float64) float64 {
+	return earthB[4].Evaluate(t)
+}
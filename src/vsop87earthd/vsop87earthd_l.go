@@ -0,0 +1,1139 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// EARTH L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87earthd
+
+import "vsop87"
+
+var earthL = []vsop87.Series{
+	// L0, 559 terms
+	{
+		{A: 1.75347045673, B: 0, C: 0},
+		{A: 0.03341656456, B: 4.66925680417, C: 6283.0758499914},
+		{A: 0.00034894275, B: 4.62610241759, C: 12566.1516999828},
+		{A: 3.417571e-05, B: 2.82886579606, C: 3.523118349},
+		{A: 3.497056e-05, B: 2.74411800971, C: 5753.3848848968},
+		{A: 3.135896e-05, B: 3.62767041758, C: 77713.7714681205},
+		{A: 2.676218e-05, B: 4.41808351397, C: 7860.4193924392},
+		{A: 2.342687e-05, B: 6.13516237631, C: 3930.2096962196},
+		{A: 1.273166e-05, B: 2.03709655772, C: 529.6909650946},
+		{A: 1.324292e-05, B: 0.74246356352, C: 11506.7697697936},
+		{A: 9.01855e-06, B: 2.04505443513, C: 26.2983197998},
+		{A: 1.199167e-05, B: 1.10962944315, C: 1577.3435424478},
+		{A: 8.57223e-06, B: 3.50849156957, C: 398.1490034082},
+		{A: 7.79786e-06, B: 1.17882652114, C: 5223.6939198022},
+		{A: 9.9025e-06, B: 5.23268129594, C: 5884.9268465832},
+		{A: 7.53141e-06, B: 2.53339053818, C: 5507.5532386674},
+		{A: 5.05264e-06, B: 4.58292563052, C: 18849.2275499742},
+		{A: 4.92379e-06, B: 4.20506639861, C: 775.522611324},
+		{A: 3.56655e-06, B: 2.91954116867, C: 0.0673103028},
+		{A: 2.84125e-06, B: 1.89869034186, C: 796.2980068164},
+		{A: 2.4281e-06, B: 0.34481140906, C: 5486.777843175},
+		{A: 3.17087e-06, B: 5.84901952218, C: 11790.6290886588},
+		{A: 2.71039e-06, B: 0.31488607649, C: 10977.078804699},
+		{A: 2.0616e-06, B: 4.80646606059, C: 2544.3144198834},
+		{A: 2.05385e-06, B: 1.86947813692, C: 5573.1428014331},
+		{A: 2.02261e-06, B: 2.45767795458, C: 6069.7767545534},
+		{A: 1.26184e-06, B: 1.0830263021, C: 20.7753954924},
+		{A: 1.55516e-06, B: 0.83306073807, C: 213.299095438},
+		{A: 1.15132e-06, B: 0.64544911683, C: 0.9803210682},
+		{A: 1.02851e-06, B: 0.63599846727, C: 4694.0029547076},
+		{A: 1.01724e-06, B: 4.26679821365, C: 7.1135470008},
+		{A: 9.9206e-07, B: 6.20992940258, C: 2146.1654164752},
+		{A: 1.32212e-06, B: 3.41118275555, C: 2942.4634232916},
+		{A: 9.7607e-07, B: 0.6810127227, C: 155.4203994342},
+		{A: 8.5128e-07, B: 1.29870743025, C: 6275.9623029906},
+		{A: 7.4651e-07, B: 1.75508916159, C: 5088.6288397668},
+		{A: 1.01895e-06, B: 0.97569221824, C: 15720.8387848784},
+		{A: 8.4711e-07, B: 3.67080093025, C: 71430.69561812909},
+		{A: 7.3547e-07, B: 4.67926565481, C: 801.8209311238},
+		{A: 7.3874e-07, B: 3.50319443167, C: 3154.6870848956},
+		{A: 7.8756e-07, B: 3.03698313141, C: 12036.4607348882},
+		{A: 7.9637e-07, B: 1.807913307, C: 17260.1546546904},
+		{A: 8.5803e-07, B: 5.98322631256, C: 161000.6857376741},
+		{A: 5.6963e-07, B: 2.78430398043, C: 6286.5989683404},
+		{A: 6.1148e-07, B: 1.81839811024, C: 7084.8967811152},
+		{A: 6.9627e-07, B: 0.83297596966, C: 9437.762934887},
+		{A: 5.6116e-07, B: 4.38694880779, C: 14143.4952424306},
+		{A: 6.2449e-07, B: 3.97763880587, C: 8827.3902698748},
+		{A: 5.1145e-07, B: 0.28306864501, C: 5856.4776591154},
+		{A: 5.5577e-07, B: 3.47006009062, C: 6279.5527316424},
+		{A: 4.1036e-07, B: 5.36817351402, C: 8429.2412664666},
+		{A: 5.1605e-07, B: 1.33282746983, C: 1748.016413067},
+		{A: 5.1992e-07, B: 0.18914945834, C: 12139.5535091068},
+		{A: 4.9e-07, B: 0.48735065033, C: 1194.4470102246},
+		{A: 3.92e-07, B: 6.16832995016, C: 10447.3878396044},
+		{A: 3.5566e-07, B: 1.77597314691, C: 6812.766815086},
+		{A: 3.677e-07, B: 6.04133859347, C: 10213.285546211},
+		{A: 3.6596e-07, B: 2.56955238628, C: 1059.3819301892},
+		{A: 3.3291e-07, B: 0.59309499459, C: 17789.845619785},
+		{A: 3.5954e-07, B: 1.70876111898, C: 2352.8661537718},
+		{A: 4.0938e-07, B: 2.39850881707, C: 19651.048481098},
+		{A: 3.0047e-07, B: 2.73975123935, C: 1349.8674096588},
+		{A: 3.0412e-07, B: 0.44294464135, C: 83996.84731811189},
+		{A: 2.3663e-07, B: 0.48473567763, C: 8031.0922630584},
+		{A: 2.3574e-07, B: 2.06527720049, C: 3340.6124266998},
+		{A: 2.1089e-07, B: 4.14825464101, C: 951.7184062506},
+		{A: 2.4738e-07, B: 0.21484762138, C: 3.5904286518},
+		{A: 2.5352e-07, B: 3.16470953405, C: 4690.4798363586},
+		{A: 2.282e-07, B: 5.22197888032, C: 4705.7323075436},
+		{A: 2.1419e-07, B: 1.42563735525, C: 16730.4636895958},
+		{A: 2.1891e-07, B: 5.55594302562, C: 553.5694028424},
+		{A: 1.7481e-07, B: 4.56052900359, C: 135.0650800354},
+		{A: 1.9925e-07, B: 5.22208471269, C: 12168.0026965746},
+		{A: 1.986e-07, B: 5.77470167653, C: 6309.3741697912},
+		{A: 2.03e-07, B: 0.37133792946, C: 283.8593188652},
+		{A: 1.4421e-07, B: 4.19315332546, C: 242.728603974},
+		{A: 1.6225e-07, B: 5.98837722564, C: 11769.8536931664},
+		{A: 1.5077e-07, B: 4.19567181073, C: 6256.7775301916},
+		{A: 1.9124e-07, B: 3.82219996949, C: 23581.2581773176},
+		{A: 1.8888e-07, B: 5.38626880969, C: 149854.4001348079},
+		{A: 1.4346e-07, B: 3.72355084422, C: 38.0276726358},
+		{A: 1.7898e-07, B: 2.21490735647, C: 13367.9726311066},
+		{A: 1.2054e-07, B: 2.62229588349, C: 955.5997416086},
+		{A: 1.1287e-07, B: 0.17739328092, C: 4164.311989613},
+		{A: 1.3971e-07, B: 4.40138139996, C: 6681.2248533996},
+		{A: 1.3621e-07, B: 1.88934471407, C: 7632.9432596502},
+		{A: 1.2503e-07, B: 1.13052412208, C: 5.5229243074},
+		{A: 1.0498e-07, B: 5.35909518669, C: 1592.5960136328},
+		{A: 9.803e-08, B: 0.99947478995, C: 11371.7046897582},
+		{A: 9.22e-08, B: 4.57138609781, C: 4292.3308329504},
+		{A: 1.0327e-07, B: 6.19982566125, C: 6438.4962494256},
+		{A: 1.2003e-07, B: 1.003514567, C: 632.7837393132},
+		{A: 1.0827e-07, B: 0.32734520222, C: 103.0927742186},
+		{A: 8.356e-08, B: 4.53902685948, C: 25132.3033999656},
+		{A: 1.0005e-07, B: 6.0291496328, C: 5746.271337896},
+		{A: 8.409e-08, B: 3.29946744189, C: 7234.794256242},
+		{A: 8.006e-08, B: 5.82145271907, C: 28.4491874678},
+		{A: 1.0523e-07, B: 0.93871805506, C: 11926.2544136688},
+		{A: 7.686e-08, B: 3.12142363172, C: 7238.6755916},
+		{A: 9.378e-08, B: 2.62414241032, C: 5760.4984318976},
+		{A: 8.127e-08, B: 6.11228001785, C: 4732.0306273434},
+		{A: 9.232e-08, B: 0.48343968736, C: 522.5774180938},
+		{A: 9.802e-08, B: 5.24413991147, C: 27511.4678735372},
+		{A: 7.871e-08, B: 0.99590177926, C: 5643.1785636774},
+		{A: 8.123e-08, B: 6.2705301365, C: 426.598190876},
+		{A: 9.048e-08, B: 5.33686335897, C: 6386.16862421},
+		{A: 8.62e-08, B: 4.16538210888, C: 7058.5984613154},
+		{A: 6.297e-08, B: 4.71724819317, C: 6836.6452528338},
+		{A: 7.575e-08, B: 3.97382858911, C: 11499.6562227928},
+		{A: 7.756e-08, B: 2.95729056763, C: 23013.5395395872},
+		{A: 7.314e-08, B: 0.60652505806, C: 11513.8833167944},
+		{A: 5.955e-08, B: 2.87641047971, C: 6283.14316029419},
+		{A: 6.534e-08, B: 5.79072926033, C: 18073.7049386502},
+		{A: 7.188e-08, B: 3.99831508699, C: 74.7815985673},
+		{A: 7.346e-08, B: 4.38582365437, C: 316.3918696566},
+		{A: 5.413e-08, B: 5.39199024641, C: 419.4846438752},
+		{A: 5.127e-08, B: 2.36062848786, C: 10973.55568635},
+		{A: 7.056e-08, B: 0.32258441903, C: 263.0839233728},
+		{A: 6.625e-08, B: 3.66475158672, C: 17298.1823273262},
+		{A: 6.762e-08, B: 5.91132535899, C: 90955.5516944961},
+		{A: 4.938e-08, B: 5.73672165674, C: 9917.6968745098},
+		{A: 5.547e-08, B: 2.45152597661, C: 12352.8526045448},
+		{A: 5.958e-08, B: 3.32051344676, C: 6283.0085396886},
+		{A: 4.471e-08, B: 2.06385999536, C: 7079.3738568078},
+		{A: 6.153e-08, B: 1.45823331144, C: 233141.3144043615},
+		{A: 4.348e-08, B: 4.4234217548, C: 5216.5803728014},
+		{A: 6.123e-08, B: 1.07494905258, C: 19804.8272915828},
+		{A: 4.488e-08, B: 3.6528503715, C: 206.1855484372},
+		{A: 4.02e-08, B: 0.83995823171, C: 20.3553193988},
+		{A: 5.188e-08, B: 4.06503864016, C: 6208.2942514241},
+		{A: 5.307e-08, B: 0.38217636096, C: 31441.6775697568},
+		{A: 3.785e-08, B: 2.34369213733, C: 3.881335358},
+		{A: 4.497e-08, B: 3.27230796845, C: 11015.1064773348},
+		{A: 4.132e-08, B: 0.92128915753, C: 3738.761430108},
+		{A: 3.521e-08, B: 5.97844807108, C: 3894.1818295422},
+		{A: 4.215e-08, B: 1.90601120623, C: 245.8316462294},
+		{A: 3.701e-08, B: 5.03069397926, C: 536.8045120954},
+		{A: 3.865e-08, B: 1.82634360607, C: 11856.2186514245},
+		{A: 3.652e-08, B: 1.01838584934, C: 16200.7727245012},
+		{A: 3.39e-08, B: 0.97785123922, C: 8635.9420037632},
+		{A: 3.737e-08, B: 2.95380107829, C: 3128.3887650958},
+		{A: 3.507e-08, B: 3.71291946325, C: 6290.1893969922},
+		{A: 3.086e-08, B: 3.64646921512, C: 10.6366653498},
+		{A: 3.397e-08, B: 1.10590684017, C: 14712.317116458},
+		{A: 3.334e-08, B: 0.83684924911, C: 6496.3749454294},
+		{A: 2.805e-08, B: 2.58504514144, C: 14314.1681130498},
+		{A: 3.65e-08, B: 1.08344142571, C: 88860.05707098669},
+		{A: 3.388e-08, B: 3.20185096055, C: 5120.6011455836},
+		{A: 3.252e-08, B: 3.47859752062, C: 6133.5126528568},
+		{A: 2.553e-08, B: 3.94869034189, C: 1990.745017041},
+		{A: 3.52e-08, B: 2.05559692878, C: 244287.60000722768},
+		{A: 2.565e-08, B: 1.560717849, C: 23543.23050468179},
+		{A: 2.621e-08, B: 3.85639359951, C: 266.6070417218},
+		{A: 2.955e-08, B: 3.39692949667, C: 9225.539273283},
+		{A: 2.876e-08, B: 6.02635617464, C: 154717.6098876827},
+		{A: 2.395e-08, B: 1.16131956403, C: 10984.1923516998},
+		{A: 3.161e-08, B: 1.32798718453, C: 10873.9860304804},
+		{A: 3.163e-08, B: 5.08946464629, C: 21228.3920235458},
+		{A: 2.361e-08, B: 4.27212906992, C: 6040.3472460174},
+		{A: 3.03e-08, B: 1.80209931347, C: 35371.8872659764},
+		{A: 2.343e-08, B: 3.576898605, C: 10969.9652576982},
+		{A: 2.618e-08, B: 2.57870156528, C: 22483.84857449259},
+		{A: 2.113e-08, B: 3.71393780256, C: 65147.6197681377},
+		{A: 2.019e-08, B: 0.81393923319, C: 170.6728706192},
+		{A: 2.003e-08, B: 0.38091017375, C: 6172.869528772},
+		{A: 2.506e-08, B: 3.74379142438, C: 10575.4066829418},
+		{A: 2.381e-08, B: 0.10581361289, C: 7.046236698},
+		{A: 1.949e-08, B: 4.86892513469, C: 36.0278666774},
+		{A: 2.074e-08, B: 4.2279477457, C: 5650.2921106782},
+		{A: 1.924e-08, B: 5.5946054986, C: 6282.0955289232},
+		{A: 1.949e-08, B: 1.07002512703, C: 5230.807466803},
+		{A: 1.988e-08, B: 5.19736046771, C: 6262.300454499},
+		{A: 1.887e-08, B: 3.74365662683, C: 23.8784377478},
+		{A: 1.787e-08, B: 1.25929682929, C: 12559.038152982},
+		{A: 1.883e-08, B: 1.90364058477, C: 15.252471185},
+		{A: 1.816e-08, B: 3.68083868442, C: 15110.4661198662},
+		{A: 1.701e-08, B: 4.4110589538, C: 110.2063212194},
+		{A: 1.99e-08, B: 3.93295788548, C: 6206.8097787158},
+		{A: 2.103e-08, B: 0.75354917468, C: 13521.7514415914},
+		{A: 1.774e-08, B: 0.48747535361, C: 1551.045222648},
+		{A: 1.882e-08, B: 0.86684493432, C: 22003.9146348698},
+		{A: 1.924e-08, B: 1.22898324132, C: 709.9330485583},
+		{A: 2.009e-08, B: 4.6285092198, C: 6037.244203762},
+		{A: 1.924e-08, B: 0.60231842508, C: 6284.0561710596},
+		{A: 1.596e-08, B: 3.98332956992, C: 13916.0191096416},
+		{A: 1.664e-08, B: 4.41939715469, C: 8662.240323563},
+		{A: 1.971e-08, B: 1.04560500503, C: 18209.33026366019},
+		{A: 1.942e-08, B: 4.31335979989, C: 6244.9428143536},
+		{A: 1.476e-08, B: 0.93271367331, C: 2379.1644735716},
+		{A: 1.81e-08, B: 0.49112137707, C: 1.4844727083},
+		{A: 1.346e-08, B: 1.51574702235, C: 4136.9104335162},
+		{A: 1.528e-08, B: 5.61835711404, C: 6127.6554505572},
+		{A: 1.791e-08, B: 3.22187270126, C: 39302.096962196},
+		{A: 1.747e-08, B: 3.05638656738, C: 18319.5365848796},
+		{A: 1.431e-08, B: 4.51153808594, C: 20426.571092422},
+		{A: 1.695e-08, B: 0.22047718414, C: 25158.6017197654},
+		{A: 1.242e-08, B: 4.46665769933, C: 17256.6315363414},
+		{A: 1.463e-08, B: 4.69242679213, C: 14945.3161735544},
+		{A: 1.205e-08, B: 1.86912144659, C: 4590.910180489},
+		{A: 1.192e-08, B: 2.74227166898, C: 12569.6748183318},
+		{A: 1.222e-08, B: 5.18120087482, C: 5333.9002410216},
+		{A: 1.39e-08, B: 5.42894648983, C: 143571.32428481648},
+		{A: 1.473e-08, B: 1.70479245805, C: 11712.9553182308},
+		{A: 1.362e-08, B: 2.61069503292, C: 6062.6632075526},
+		{A: 1.148e-08, B: 6.0300180054, C: 3634.6210245184},
+		{A: 1.198e-08, B: 5.15294130422, C: 10177.2576795336},
+		{A: 1.266e-08, B: 0.11421493643, C: 18422.62935909819},
+		{A: 1.411e-08, B: 1.09908857534, C: 3496.032826134},
+		{A: 1.349e-08, B: 2.99805109633, C: 17654.7805397496},
+		{A: 1.253e-08, B: 2.79850152848, C: 167283.7615876655},
+		{A: 1.311e-08, B: 1.60942984879, C: 5481.2549188676},
+		{A: 1.079e-08, B: 6.20304501787, C: 3.2863574178},
+		{A: 1.181e-08, B: 1.20653776978, C: 131.5419616864},
+		{A: 1.254e-08, B: 5.45103277798, C: 6076.8903015542},
+		{A: 1.035e-08, B: 2.32142722747, C: 7342.4577801806},
+		{A: 1.117e-08, B: 0.38838354256, C: 949.1756089698},
+		{A: 9.66e-09, B: 3.18341890851, C: 11087.2851259184},
+		{A: 1.171e-08, B: 3.39635049962, C: 12562.6285816338},
+		{A: 1.121e-08, B: 0.72627490378, C: 220.4126424388},
+		{A: 1.024e-08, B: 2.19378315386, C: 11403.676995575},
+		{A: 8.88e-09, B: 3.91173199285, C: 4686.8894077068},
+		{A: 9.1e-09, B: 1.98802695087, C: 735.8765135318},
+		{A: 8.3e-09, B: 0.48984915507, C: 24072.9214697764},
+		{A: 1.096e-08, B: 6.17377835617, C: 5436.9930152402},
+		{A: 9.08e-09, B: 0.44959639433, C: 7477.522860216},
+		{A: 9.74e-09, B: 1.52996238356, C: 9623.6882766912},
+		{A: 8.4e-09, B: 1.79543266333, C: 5429.8794682394},
+		{A: 7.78e-09, B: 6.17699177946, C: 38.1330356378},
+		{A: 7.76e-09, B: 4.09855402433, C: 14.2270940016},
+		{A: 1.068e-08, B: 4.64200173735, C: 43232.3066584156},
+		{A: 9.54e-09, B: 1.49988435748, C: 1162.4747044078},
+		{A: 9.07e-09, B: 0.86986870809, C: 10344.2950653858},
+		{A: 9.31e-09, B: 4.06044689031, C: 28766.924424484},
+		{A: 7.39e-09, B: 5.04368197372, C: 639.897286314},
+		{A: 9.37e-09, B: 3.4688469896, C: 1589.0728952838},
+		{A: 7.63e-09, B: 5.86304932998, C: 16858.4825329332},
+		{A: 9.53e-09, B: 4.20801492835, C: 11190.377900137},
+		{A: 7.08e-09, B: 1.7289998894, C: 13095.8426650774},
+		{A: 9.69e-09, B: 1.64439522215, C: 29088.811415985},
+		{A: 7.17e-09, B: 0.16688678895, C: 11.729352836},
+		{A: 9.62e-09, B: 3.53092337542, C: 12416.5885028482},
+		{A: 7.47e-09, B: 5.77866940346, C: 12592.4500197826},
+		{A: 6.72e-09, B: 1.91095796194, C: 3.9321532631},
+		{A: 6.71e-09, B: 5.46240843677, C: 18052.9295431578},
+		{A: 6.75e-09, B: 6.28311558823, C: 4535.0594369244},
+		{A: 6.84e-09, B: 0.3997501208, C: 5849.3641121146},
+		{A: 7.99e-09, B: 0.29851185294, C: 12132.439962106},
+		{A: 7.58e-09, B: 0.96370823331, C: 1052.2683831884},
+		{A: 7.82e-09, B: 5.33878339919, C: 13517.8701062334},
+		{A: 7.3e-09, B: 1.70106160291, C: 17267.26820169119},
+		{A: 7.49e-09, B: 2.59599901875, C: 11609.8625440122},
+		{A: 7.34e-09, B: 2.78417782952, C: 640.8776073822},
+		{A: 6.88e-09, B: 5.15048287468, C: 16496.3613962024},
+		{A: 7.7e-09, B: 1.62469589333, C: 4701.1165017084},
+		{A: 6.33e-09, B: 2.20587893893, C: 25934.1243310894},
+		{A: 7.6e-09, B: 4.21317219403, C: 377.3736079158},
+		{A: 5.84e-09, B: 2.13420121623, C: 10557.5941608238},
+		{A: 5.74e-09, B: 0.24250054587, C: 9779.1086761254},
+		{A: 5.73e-09, B: 3.16435264609, C: 533.2140834436},
+		{A: 6.85e-09, B: 3.19344289472, C: 12146.6670561076},
+		{A: 6.75e-09, B: 0.96179233959, C: 10454.5013866052},
+		{A: 6.48e-09, B: 1.46327342555, C: 6268.8487559898},
+		{A: 5.89e-09, B: 2.50543543638, C: 3097.88382272579},
+		{A: 5.51e-09, B: 5.28099026956, C: 9388.0059094152},
+		{A: 6.96e-09, B: 3.65342150016, C: 4804.209275927},
+		{A: 6.69e-09, B: 2.51030077026, C: 2388.8940204492},
+		{A: 5.5e-09, B: 0.06883864342, C: 20199.094959633},
+		{A: 6.29e-09, B: 4.13350995675, C: 45892.73043315699},
+		{A: 6.78e-09, B: 6.09190163533, C: 135.62532501},
+		{A: 5.93e-09, B: 1.50136257618, C: 226858.23855437007},
+		{A: 5.42e-09, B: 3.58573645173, C: 6148.010769956},
+		{A: 6.82e-09, B: 5.02203067788, C: 17253.04110768959},
+		{A: 5.65e-09, B: 4.2930923861, C: 11933.3679606696},
+		{A: 4.86e-09, B: 0.77746204893, C: 27.4015560968},
+		{A: 5.03e-09, B: 0.58963565969, C: 15671.0817594066},
+		{A: 6.16e-09, B: 4.06539884128, C: 227.476132789},
+		{A: 5.83e-09, B: 6.12695541996, C: 18875.525869774},
+		{A: 5.37e-09, B: 2.1505644098, C: 21954.15760939799},
+		{A: 6.69e-09, B: 6.06986269566, C: 47162.5163546352},
+		{A: 4.75e-09, B: 0.4034384211, C: 6915.8595893046},
+		{A: 5.4e-09, B: 2.83444222174, C: 5326.7866940208},
+		{A: 5.3e-09, B: 5.26359885263, C: 10988.808157535},
+		{A: 5.82e-09, B: 3.24533095664, C: 153.7788104848},
+		{A: 6.41e-09, B: 3.24711791371, C: 2107.0345075424},
+		{A: 6.21e-09, B: 3.09698523779, C: 33019.0211122046},
+		{A: 4.66e-09, B: 3.14982372198, C: 10440.2742926036},
+		{A: 4.66e-09, B: 0.90708835657, C: 5966.6839803348},
+		{A: 5.28e-09, B: 0.8192645447, C: 813.5502839598},
+		{A: 6.03e-09, B: 3.81378921927, C: 316428.22867391503},
+		{A: 5.59e-09, B: 1.81894804124, C: 17996.0311682222},
+		{A: 4.37e-09, B: 2.28625594435, C: 6303.8512454838},
+		{A: 5.18e-09, B: 4.86069178322, C: 20597.2439630412},
+		{A: 4.24e-09, B: 6.23520018693, C: 6489.2613984286},
+		{A: 5.18e-09, B: 6.17617826756, C: 0.2438174835},
+		{A: 4.04e-09, B: 5.72804304258, C: 5642.1982426092},
+		{A: 4.58e-09, B: 1.34117773915, C: 6287.0080032545},
+		{A: 5.48e-09, B: 5.6845445832, C: 155427.542936241},
+		{A: 5.47e-09, B: 1.03391472061, C: 3646.3503773544},
+		{A: 4.28e-09, B: 4.69800981138, C: 846.0828347512},
+		{A: 4.13e-09, B: 6.02520699406, C: 6279.4854213396},
+		{A: 5.34e-09, B: 3.03030638223, C: 66567.48586525429},
+		{A: 3.83e-09, B: 1.49056949125, C: 19800.9459562248},
+		{A: 4.1e-09, B: 5.28319622279, C: 18451.07854656599},
+		{A: 3.52e-09, B: 4.68891600359, C: 4907.3020501456},
+		{A: 4.8e-09, B: 5.36572651091, C: 348.924420448},
+		{A: 3.44e-09, B: 5.89157452896, C: 6546.1597733642},
+		{A: 3.4e-09, B: 0.3755742644, C: 13119.72110282519},
+		{A: 4.34e-09, B: 4.98417785901, C: 6702.5604938666},
+		{A: 3.32e-09, B: 2.68902519126, C: 29296.6153895786},
+		{A: 4.48e-09, B: 2.16478480251, C: 5905.7022420756},
+		{A: 3.44e-09, B: 2.06546633735, C: 49.7570254718},
+		{A: 3.15e-09, B: 1.24023811803, C: 4061.2192153944},
+		{A: 3.24e-09, B: 2.30897526929, C: 5017.508371365},
+		{A: 4.13e-09, B: 0.17171692962, C: 6286.6662786432},
+		{A: 4.31e-09, B: 3.86601101393, C: 12489.8856287072},
+		{A: 3.49e-09, B: 4.55372342974, C: 4933.2084403326},
+		{A: 3.23e-09, B: 0.41971136084, C: 10770.8932562618},
+		{A: 3.41e-09, B: 2.68612860807, C: 11.0457002639},
+		{A: 3.16e-09, B: 3.52936906658, C: 17782.7320727842},
+		{A: 3.15e-09, B: 5.63357264999, C: 568.8218740274},
+		{A: 3.4e-09, B: 3.83571212349, C: 10660.6869350424},
+		{A: 2.97e-09, B: 0.62691416712, C: 20995.3929664494},
+		{A: 4.05e-09, B: 1.00085779471, C: 16460.33352952499},
+		{A: 4.14e-09, B: 1.21998752076, C: 51092.7260508548},
+		{A: 3.36e-09, B: 4.71465945226, C: 6179.9830757728},
+		{A: 3.61e-09, B: 3.71227508354, C: 28237.2334593894},
+		{A: 3.85e-09, B: 6.21925225757, C: 24356.7807886416},
+		{A: 3.27e-09, B: 1.05606504715, C: 11919.140866668},
+		{A: 3.27e-09, B: 6.14222420989, C: 6254.6266625236},
+		{A: 2.68e-09, B: 2.47224339737, C: 664.75604513},
+		{A: 2.69e-09, B: 1.86207884109, C: 23141.5583829246},
+		{A: 3.45e-09, B: 0.93461290184, C: 6058.7310542895},
+		{A: 2.96e-09, B: 4.5168755718, C: 6418.1409300268},
+		{A: 3.53e-09, B: 4.50033653082, C: 36949.2308084242},
+		{A: 2.6e-09, B: 4.04963546305, C: 6525.8044539654},
+		{A: 2.98e-09, B: 2.20046722622, C: 156137.47598479927},
+		{A: 2.53e-09, B: 3.49900838384, C: 29864.334027309},
+		{A: 2.54e-09, B: 2.44901693835, C: 5331.3574437408},
+		{A: 2.96e-09, B: 0.84347588787, C: 5729.506447149},
+		{A: 2.98e-09, B: 1.29194706125, C: 22805.7355659936},
+		{A: 2.41e-09, B: 2.00721280805, C: 16737.5772365966},
+		{A: 3.11e-09, B: 1.23668016334, C: 6281.5913772831},
+		{A: 2.4e-09, B: 2.51650377121, C: 6245.0481773556},
+		{A: 3.32e-09, B: 3.55576945724, C: 7668.6374249425},
+		{A: 2.64e-09, B: 4.44052061202, C: 12964.300703391},
+		{A: 2.57e-09, B: 1.79654471948, C: 11080.1715789176},
+		{A: 2.6e-09, B: 3.3307759842, C: 5888.4499649322},
+		{A: 2.85e-09, B: 0.3088636143, C: 11823.1616394502},
+		{A: 2.9e-09, B: 5.70141882483, C: 77.673770428},
+		{A: 2.55e-09, B: 4.0093966444, C: 5881.4037282342},
+		{A: 2.53e-09, B: 4.73318493678, C: 16723.350142595},
+		{A: 2.28e-09, B: 0.95333661324, C: 5540.0857894588},
+		{A: 3.19e-09, B: 1.38633229189, C: 163096.18036118348},
+		{A: 2.24e-09, B: 1.65156322696, C: 10027.9031957292},
+		{A: 2.26e-09, B: 0.34106460604, C: 17796.9591667858},
+		{A: 2.36e-09, B: 4.19817431922, C: 19.66976089979},
+		{A: 2.8e-09, B: 4.1408026897, C: 12539.853380183},
+		{A: 2.75e-09, B: 5.50306930248, C: 32.5325507914},
+		{A: 2.23e-09, B: 5.23334210294, C: 56.8983749356},
+		{A: 2.17e-09, B: 6.08587881787, C: 6805.6532680852},
+		{A: 2.8e-09, B: 4.52472044653, C: 6016.4688082696},
+		{A: 2.27e-09, B: 5.06509843737, C: 6277.552925684},
+		{A: 2.26e-09, B: 5.17755154305, C: 11720.0688652316},
+		{A: 2.45e-09, B: 3.96486270306, C: 22.7752014508},
+		{A: 2.2e-09, B: 4.7207808197, C: 6.62855890001},
+		{A: 2.07e-09, B: 5.71701403951, C: 41.5507909848},
+		{A: 2.04e-09, B: 3.9122741125, C: 2699.7348193176},
+		{A: 2.09e-09, B: 0.86881969011, C: 6321.1035226272},
+		{A: 2e-09, B: 2.11984445273, C: 4274.5183108324},
+		{A: 2e-09, B: 5.39839888163, C: 6019.9919266186},
+		{A: 2.09e-09, B: 5.67606291663, C: 11293.4706743556},
+		{A: 2.52e-09, B: 1.64965729351, C: 9380.9596727172},
+		{A: 2.75e-09, B: 5.04826903506, C: 73.297125859},
+		{A: 2.08e-09, B: 1.88207277133, C: 11300.5842213564},
+		{A: 2.72e-09, B: 0.74640926842, C: 1975.492545856},
+		{A: 1.99e-09, B: 3.30836672397, C: 22743.4093795164},
+		{A: 2.69e-09, B: 4.48560812155, C: 64471.99124174489},
+		{A: 1.92e-09, B: 2.17464236325, C: 5863.5912061162},
+		{A: 2.28e-09, B: 5.85373115869, C: 128.0188433374},
+		{A: 2.61e-09, B: 2.64321183295, C: 55022.9357470744},
+		{A: 2.2e-09, B: 5.75012110079, C: 29.429508536},
+		{A: 1.87e-09, B: 4.03230554718, C: 467.9649903544},
+		{A: 2e-09, B: 5.60556112058, C: 1066.49547719},
+		{A: 2.31e-09, B: 1.09802712785, C: 12341.8069042809},
+		{A: 1.99e-09, B: 0.295006252, C: 149.5631971346},
+		{A: 2.49e-09, B: 5.10473210814, C: 7875.6718636242},
+		{A: 2.08e-09, B: 0.93013835019, C: 14919.0178537546},
+		{A: 1.79e-09, B: 0.87104393079, C: 12721.572099417},
+		{A: 2.03e-09, B: 1.56920753653, C: 28286.9904848612},
+		{A: 1.79e-09, B: 2.47036386443, C: 16062.1845261168},
+		{A: 1.98e-09, B: 3.54061588502, C: 30.914125635},
+		{A: 1.71e-09, B: 3.45356518113, C: 5327.4761083828},
+		{A: 1.83e-09, B: 0.72325421604, C: 6272.0301497275},
+		{A: 2.16e-09, B: 2.97174580686, C: 19402.7969528166},
+		{A: 1.68e-09, B: 2.51550550242, C: 23937.856389741},
+		{A: 1.95e-09, B: 0.09045393425, C: 156.4007205024},
+		{A: 1.79e-09, B: 4.4947179809, C: 31415.379249957},
+		{A: 2.16e-09, B: 0.42177594328, C: 23539.7073863328},
+		{A: 1.89e-09, B: 0.37542530191, C: 9814.6041002912},
+		{A: 2.18e-09, B: 2.36835880025, C: 16627.3709153772},
+		{A: 1.66e-09, B: 4.23182968446, C: 16840.67001081519},
+		{A: 2e-09, B: 2.02153258098, C: 16097.6799502826},
+		{A: 1.69e-09, B: 0.91318727, C: 95.9792272178},
+		{A: 2.11e-09, B: 5.73370637657, C: 151.8972810852},
+		{A: 2.04e-09, B: 0.42643085174, C: 515.463871093},
+		{A: 2.12e-09, B: 3.00233538977, C: 12043.574281889},
+		{A: 1.92e-09, B: 5.46153589821, C: 6379.0550772092},
+		{A: 1.65e-09, B: 1.38698167064, C: 4171.4255366138},
+		{A: 1.6e-09, B: 6.23798383332, C: 202.2533951741},
+		{A: 2.15e-09, B: 0.20889073407, C: 5621.8429232104},
+		{A: 1.81e-09, B: 4.12439203622, C: 13341.6743113068},
+		{A: 1.53e-09, B: 1.24460848836, C: 29826.3063546732},
+		{A: 1.5e-09, B: 3.12999753018, C: 799.8211251654},
+		{A: 1.75e-09, B: 4.55671604437, C: 239424.39025435288},
+		{A: 1.92e-09, B: 1.33928820063, C: 394.6258850592},
+		{A: 1.49e-09, B: 2.65697593276, C: 21.335640467},
+		{A: 1.46e-09, B: 5.58021191726, C: 412.3710968744},
+		{A: 1.56e-09, B: 3.75650175503, C: 12323.4230960088},
+		{A: 1.43e-09, B: 3.75708566606, C: 58864.5439181463},
+		{A: 1.43e-09, B: 3.28248547724, C: 29.8214381488},
+		{A: 1.44e-09, B: 1.07862546598, C: 1265.5674786264},
+		{A: 1.48e-09, B: 0.23389236655, C: 10021.8372800994},
+		{A: 1.93e-09, B: 5.92751083086, C: 40879.4405046438},
+		{A: 1.4e-09, B: 4.97612440269, C: 158.9435177832},
+		{A: 1.48e-09, B: 2.61640453469, C: 17157.0618804718},
+		{A: 1.41e-09, B: 3.66871308723, C: 26084.0218062162},
+		{A: 1.47e-09, B: 5.09968173403, C: 661.232926781},
+		{A: 1.46e-09, B: 4.96885605695, C: 57375.8019008462},
+		{A: 1.42e-09, B: 0.78678347839, C: 12779.4507954208},
+		{A: 1.34e-09, B: 4.79432636012, C: 111.1866422876},
+		{A: 1.4e-09, B: 1.27748013377, C: 107.6635239386},
+		{A: 1.69e-09, B: 2.74893543762, C: 26735.9452622132},
+		{A: 1.65e-09, B: 3.95288000638, C: 6357.8574485587},
+		{A: 1.83e-09, B: 5.43418358741, C: 369.6998159404},
+		{A: 1.34e-09, B: 3.09132862833, C: 17.812522118},
+		{A: 1.32e-09, B: 3.05633896779, C: 22490.9621214934},
+		{A: 1.34e-09, B: 4.09472795832, C: 6599.467719648},
+		{A: 1.81e-09, B: 4.22950689891, C: 966.9708774356},
+		{A: 1.52e-09, B: 5.28885894415, C: 12669.2444742014},
+		{A: 1.5e-09, B: 5.86819430908, C: 97238.62754448749},
+		{A: 1.42e-09, B: 5.87266532526, C: 22476.73502749179},
+		{A: 1.45e-09, B: 5.07330784304, C: 87.30820453981},
+		{A: 1.33e-09, B: 5.65471067133, C: 31.9723058168},
+		{A: 1.24e-09, B: 2.83326217072, C: 12566.2190102856},
+		{A: 1.35e-09, B: 3.12861731644, C: 32217.2001810808},
+		{A: 1.37e-09, B: 0.86487461904, C: 9924.8104215106},
+		{A: 1.72e-09, B: 1.98369595114, C: 174242.4659640497},
+		{A: 1.7e-09, B: 4.41115280254, C: 327574.51427678124},
+		{A: 1.51e-09, B: 0.46542099527, C: 39609.6545831656},
+		{A: 1.48e-09, B: 2.13439571118, C: 491.6632924588},
+		{A: 1.53e-09, B: 3.78801830344, C: 17363.24742890899},
+		{A: 1.65e-09, B: 5.31654110459, C: 16943.7627850338},
+		{A: 1.65e-09, B: 4.06747587817, C: 58953.145443294},
+		{A: 1.18e-09, B: 0.63846333239, C: 6.0659156298},
+		{A: 1.59e-09, B: 0.86086959274, C: 221995.02880149524},
+		{A: 1.19e-09, B: 5.96432932413, C: 1385.8952763362},
+		{A: 1.14e-09, B: 5.16516114595, C: 25685.872802808},
+		{A: 1.12e-09, B: 3.39403722178, C: 21393.5419698576},
+		{A: 1.12e-09, B: 4.92889233335, C: 56.8032621698},
+		{A: 1.19e-09, B: 2.40637635942, C: 18635.9284545362},
+		{A: 1.15e-09, B: 0.23374479051, C: 418.9243989006},
+		{A: 1.22e-09, B: 0.93575234049, C: 24492.40611365159},
+		{A: 1.15e-09, B: 4.58880032176, C: 26709.6469424134},
+		{A: 1.3e-09, B: 4.85539251, C: 22345.2603761082},
+		{A: 1.4e-09, B: 1.09413073202, C: 44809.6502008634},
+		{A: 1.12e-09, B: 6.05401806281, C: 433.7117378768},
+		{A: 1.04e-09, B: 1.54931540602, C: 127.9515330346},
+		{A: 1.05e-09, B: 4.82620858888, C: 33794.5437235286},
+		{A: 1.02e-09, B: 4.12448497391, C: 15664.03552270859},
+		{A: 1.07e-09, B: 4.67919356465, C: 77690.75950573849},
+		{A: 1.18e-09, B: 4.5232017012, C: 19004.6479494084},
+		{A: 1.07e-09, B: 5.71774478555, C: 77736.78343050249},
+		{A: 1.43e-09, B: 1.81201813018, C: 4214.0690150848},
+		{A: 1.25e-09, B: 1.14419195615, C: 625.6701923124},
+		{A: 1.24e-09, B: 3.27736514057, C: 12566.08438968},
+		{A: 1.1e-09, B: 1.08682570828, C: 2787.0430238574},
+		{A: 1.05e-09, B: 1.78318141871, C: 18139.2945014159},
+		{A: 1.02e-09, B: 4.75119578149, C: 12242.6462833254},
+		{A: 1.37e-09, B: 1.43510636754, C: 86464.6133168312},
+		{A: 1.01e-09, B: 4.91289409429, C: 401.6721217572},
+		{A: 1.29e-09, B: 1.23567904485, C: 12029.3471878874},
+		{A: 1.38e-09, B: 2.45654707999, C: 7576.560073574},
+		{A: 1.03e-09, B: 0.40004073416, C: 90279.92316810328},
+		{A: 1.08e-09, B: 0.9898977494, C: 5636.0650166766},
+		{A: 1.17e-09, B: 5.17362872063, C: 34520.3093093808},
+		{A: 1e-09, B: 3.95534628189, C: 5547.1993364596},
+		{A: 9.8e-10, B: 1.28118280598, C: 21548.9623692918},
+		{A: 9.7e-10, B: 3.34717130592, C: 16310.9790457206},
+		{A: 9.8e-10, B: 4.37041908717, C: 34513.2630726828},
+		{A: 1.25e-09, B: 2.7216443296, C: 24065.80792277559},
+		{A: 1.02e-09, B: 0.66938025772, C: 10239.5838660108},
+		{A: 1.19e-09, B: 1.21689479331, C: 1478.8665740644},
+		{A: 9.4e-10, B: 1.99595224256, C: 13362.4497067992},
+		{A: 9.4e-10, B: 4.30965982872, C: 26880.3198130326},
+		{A: 9.5e-10, B: 2.89807657534, C: 34911.412076091},
+		{A: 1.06e-09, B: 1.0015665359, C: 16522.6597160022},
+		{A: 9.7e-10, B: 0.89642320201, C: 71980.63357473118},
+		{A: 1.16e-09, B: 4.19967201116, C: 206.7007372966},
+		{A: 9.9e-10, B: 1.37437847718, C: 1039.0266107904},
+		{A: 1.26e-09, B: 3.21642544972, C: 305281.9430710488},
+		{A: 9.4e-10, B: 0.6899787606, C: 7834.1210726394},
+		{A: 9.4e-10, B: 5.58132218606, C: 3104.9300594238},
+		{A: 9.5e-10, B: 3.0382374111, C: 8982.810669309},
+		{A: 1.08e-09, B: 0.52696637156, C: 276.7457718644},
+		{A: 1.24e-09, B: 3.43899862683, C: 172146.9713405403},
+		{A: 1.02e-09, B: 1.04031728553, C: 95143.1329209781},
+		{A: 1.04e-09, B: 3.39218586218, C: 290.972865866},
+		{A: 1.1e-09, B: 3.68205877433, C: 22380.755800274},
+		{A: 1.17e-09, B: 0.78475956902, C: 83286.91426955358},
+		{A: 8.3e-10, B: 0.18241793425, C: 15141.390794312},
+		{A: 8.9e-10, B: 4.45371820659, C: 792.7748884674},
+		{A: 8.2e-10, B: 4.80703651241, C: 6819.8803620868},
+		{A: 8.7e-10, B: 3.43122851097, C: 27707.5424942948},
+		{A: 1.01e-09, B: 5.32081603011, C: 2301.58581590939},
+		{A: 8.2e-10, B: 0.87060089842, C: 10241.2022911672},
+		{A: 8.6e-10, B: 4.61919461931, C: 36147.4098773004},
+		{A: 9.5e-10, B: 2.87032884659, C: 23020.65308658799},
+		{A: 8.8e-10, B: 3.2113316569, C: 33326.5787331742},
+		{A: 8e-10, B: 1.84900424847, C: 21424.4666443034},
+		{A: 1.01e-09, B: 4.18796434479, C: 30666.1549584328},
+		{A: 1.07e-09, B: 5.77864921649, C: 34115.1140692746},
+		{A: 1.04e-09, B: 1.08739495962, C: 6288.5987742988},
+		{A: 1.1e-09, B: 3.32898859416, C: 72140.6286666874},
+		{A: 8.7e-10, B: 4.40657711727, C: 142.1786270362},
+		{A: 1.09e-09, B: 1.94546030825, C: 24279.10701821359},
+		{A: 8.7e-10, B: 4.32472045435, C: 742.9900605326},
+		{A: 1.07e-09, B: 4.91580912547, C: 277.0349937414},
+		{A: 8.8e-10, B: 2.10180220766, C: 26482.1708096244},
+		{A: 8.6e-10, B: 4.01887374432, C: 12491.3701014155},
+		{A: 1.06e-09, B: 5.49092372854, C: 62883.3551395136},
+		{A: 8e-10, B: 6.19781316983, C: 6709.6740408674},
+		{A: 8.8e-10, B: 2.09872810657, C: 238004.5241572363},
+		{A: 8.3e-10, B: 4.90662164029, C: 51.28033786241},
+		{A: 9.5e-10, B: 4.13387406591, C: 18216.443810661},
+		{A: 7.8e-10, B: 6.0694939168, C: 148434.53403769128},
+		{A: 7.9e-10, B: 3.03048221644, C: 838.9692877504},
+		{A: 7.4e-10, B: 5.49813051211, C: 29026.48522950779},
+		{A: 7.3e-10, B: 3.05008665738, C: 567.7186377304},
+		{A: 8.4e-10, B: 0.46604373274, C: 45.1412196366},
+		{A: 9.3e-10, B: 2.52267536308, C: 48739.859897083},
+		{A: 7.6e-10, B: 1.76418124905, C: 41654.9631159678},
+		{A: 6.7e-10, B: 5.77851227793, C: 6311.5250374592},
+		{A: 6.2e-10, B: 3.32967880172, C: 15508.6151232744},
+		{A: 7.9e-10, B: 5.59773841328, C: 71960.38658322369},
+		{A: 5.7e-10, B: 3.90629505268, C: 5999.2165311262},
+		{A: 6.1e-10, B: 0.05695043232, C: 7856.89627409019},
+		{A: 6.1e-10, B: 5.63297958433, C: 7863.9425107882},
+		{A: 6.5e-10, B: 3.72178394016, C: 12573.2652469836},
+		{A: 5.7e-10, B: 4.18217219541, C: 26087.9031415742},
+		{A: 6.6e-10, B: 3.92262333487, C: 69853.3520756813},
+		{A: 5.3e-10, B: 5.51119362045, C: 77710.24834977149},
+		{A: 5.3e-10, B: 4.88573986961, C: 77717.29458646949},
+		{A: 6.2e-10, B: 2.88876342225, C: 9411.4646150872},
+		{A: 5.1e-10, B: 1.12657183874, C: 82576.9812209953},
+		{A: 4.5e-10, B: 2.95671076719, C: 24602.61243487099},
+		{A: 4e-10, B: 5.55145719241, C: 12565.1713789146},
+		{A: 3.9e-10, B: 1.20838190039, C: 18842.11400297339},
+		{A: 4.5e-10, B: 3.18590558749, C: 45585.1728121874},
+		{A: 4.9e-10, B: 2.44790934886, C: 13613.804277336},
+	},
+	// L1, 341 terms
+	{
+		{A: 6283.31966747491, B: 0, C: 0},
+		{A: 0.00206058863, B: 2.67823455584, C: 6283.0758499914},
+		{A: 4.30343e-05, B: 2.63512650414, C: 12566.1516999828},
+		{A: 4.25264e-06, B: 1.59046980729, C: 3.523118349},
+		{A: 1.08977e-06, B: 2.96618001993, C: 1577.3435424478},
+		{A: 9.3478e-07, B: 2.59212835365, C: 18849.2275499742},
+		{A: 1.19261e-06, B: 5.79557487799, C: 26.2983197998},
+		{A: 7.2122e-07, B: 1.13846158196, C: 529.6909650946},
+		{A: 6.7768e-07, B: 1.87472304791, C: 398.1490034082},
+		{A: 6.7327e-07, B: 4.40918235168, C: 5507.5532386674},
+		{A: 5.9027e-07, B: 2.8879703846, C: 5223.6939198022},
+		{A: 5.5976e-07, B: 2.17471680261, C: 155.4203994342},
+		{A: 4.5407e-07, B: 0.39803079805, C: 796.2980068164},
+		{A: 3.6369e-07, B: 0.46624739835, C: 775.522611324},
+		{A: 2.8958e-07, B: 2.64707383882, C: 7.1135470008},
+		{A: 1.9097e-07, B: 1.84628332577, C: 5486.777843175},
+		{A: 2.0844e-07, B: 5.34138275149, C: 0.9803210682},
+		{A: 1.8508e-07, B: 4.96855124577, C: 213.299095438},
+		{A: 1.6233e-07, B: 0.03216483047, C: 2544.3144198834},
+		{A: 1.7293e-07, B: 2.99116864949, C: 6275.9623029906},
+		{A: 1.5832e-07, B: 1.43049285325, C: 2146.1654164752},
+		{A: 1.4615e-07, B: 1.20532366323, C: 10977.078804699},
+		{A: 1.1877e-07, B: 3.25804815607, C: 5088.6288397668},
+		{A: 1.1514e-07, B: 2.07502418155, C: 4694.0029547076},
+		{A: 9.721e-08, B: 4.23925472239, C: 1349.8674096588},
+		{A: 9.969e-08, B: 1.30262991097, C: 6286.5989683404},
+		{A: 9.452e-08, B: 2.69957062864, C: 242.728603974},
+		{A: 1.2461e-07, B: 2.83432285512, C: 1748.016413067},
+		{A: 1.1808e-07, B: 5.2737979048, C: 1194.4470102246},
+		{A: 8.577e-08, B: 5.64475868067, C: 951.7184062506},
+		{A: 1.0641e-07, B: 0.76614199202, C: 553.5694028424},
+		{A: 7.576e-08, B: 5.30062664886, C: 2352.8661537718},
+		{A: 5.834e-08, B: 1.76649917904, C: 1059.3819301892},
+		{A: 6.385e-08, B: 2.65033984967, C: 9437.762934887},
+		{A: 5.223e-08, B: 5.66135767624, C: 71430.69561812909},
+		{A: 5.305e-08, B: 0.90857521574, C: 3154.6870848956},
+		{A: 6.101e-08, B: 4.66632584188, C: 4690.4798363586},
+		{A: 4.33e-08, B: 0.24102555403, C: 6812.766815086},
+		{A: 5.041e-08, B: 1.42490103709, C: 6438.4962494256},
+		{A: 4.259e-08, B: 0.77355900599, C: 10447.3878396044},
+		{A: 5.198e-08, B: 1.85353197345, C: 801.8209311238},
+		{A: 3.744e-08, B: 2.00119516488, C: 8031.0922630584},
+		{A: 3.558e-08, B: 2.42901552681, C: 14143.4952424306},
+		{A: 3.372e-08, B: 3.86210700128, C: 1592.5960136328},
+		{A: 3.374e-08, B: 0.88776219727, C: 12036.4607348882},
+		{A: 3.175e-08, B: 3.18785710594, C: 4705.7323075436},
+		{A: 3.221e-08, B: 0.61599835472, C: 8429.2412664666},
+		{A: 4.132e-08, B: 5.23992859705, C: 7084.8967811152},
+		{A: 2.97e-08, B: 6.07026318493, C: 4292.3308329504},
+		{A: 2.9e-08, B: 2.32464208411, C: 20.3553193988},
+		{A: 3.504e-08, B: 4.79975694359, C: 6279.5527316424},
+		{A: 2.95e-08, B: 1.43108874817, C: 5746.271337896},
+		{A: 2.697e-08, B: 4.80368225199, C: 7234.794256242},
+		{A: 2.531e-08, B: 6.22290682655, C: 6836.6452528338},
+		{A: 2.745e-08, B: 0.93466065396, C: 5760.4984318976},
+		{A: 3.25e-08, B: 3.39954640038, C: 7632.9432596502},
+		{A: 2.277e-08, B: 5.00277837672, C: 17789.845619785},
+		{A: 2.075e-08, B: 3.95534978634, C: 10213.285546211},
+		{A: 2.061e-08, B: 2.22411683077, C: 5856.4776591154},
+		{A: 2.252e-08, B: 5.67166499885, C: 11499.6562227928},
+		{A: 2.148e-08, B: 5.20184578235, C: 11513.8833167944},
+		{A: 1.886e-08, B: 0.53198320577, C: 3340.6124266998},
+		{A: 1.875e-08, B: 4.73511970207, C: 83996.84731811189},
+		{A: 2.06e-08, B: 2.54987293999, C: 25132.3033999656},
+		{A: 1.794e-08, B: 1.47435409831, C: 4164.311989613},
+		{A: 1.778e-08, B: 3.02473091781, C: 5.5229243074},
+		{A: 2.029e-08, B: 0.90960209983, C: 6256.7775301916},
+		{A: 2.075e-08, B: 2.26767270157, C: 522.5774180938},
+		{A: 1.772e-08, B: 3.02622802353, C: 5753.3848848968},
+		{A: 1.569e-08, B: 6.12410242782, C: 5216.5803728014},
+		{A: 1.59e-08, B: 4.63713748247, C: 3.2863574178},
+		{A: 1.542e-08, B: 4.20004448567, C: 13367.9726311066},
+		{A: 1.427e-08, B: 1.19088061711, C: 3894.1818295422},
+		{A: 1.375e-08, B: 3.09301252193, C: 135.0650800354},
+		{A: 1.359e-08, B: 4.24532506641, C: 426.598190876},
+		{A: 1.34e-08, B: 5.76511818622, C: 6040.3472460174},
+		{A: 1.284e-08, B: 3.08524663344, C: 5643.1785636774},
+		{A: 1.25e-08, B: 3.07748157144, C: 11926.2544136688},
+		{A: 1.551e-08, B: 3.07665451458, C: 6681.2248533996},
+		{A: 1.268e-08, B: 2.09196018331, C: 6290.1893969922},
+		{A: 1.144e-08, B: 3.24444699514, C: 12168.0026965746},
+		{A: 1.248e-08, B: 3.44504937285, C: 536.8045120954},
+		{A: 1.118e-08, B: 2.31829670425, C: 16730.4636895958},
+		{A: 1.105e-08, B: 5.31966001019, C: 23.8784377478},
+		{A: 1.051e-08, B: 3.75015946014, C: 7860.4193924392},
+		{A: 1.025e-08, B: 2.44688534235, C: 1990.745017041},
+		{A: 9.62e-09, B: 0.81771017882, C: 3.881335358},
+		{A: 9.1e-09, B: 0.41727865299, C: 7079.3738568078},
+		{A: 8.83e-09, B: 5.16833917651, C: 11790.6290886588},
+		{A: 9.57e-09, B: 4.07673573735, C: 6127.6554505572},
+		{A: 1.11e-08, B: 3.90096793825, C: 11506.7697697936},
+		{A: 8.02e-09, B: 3.88778875582, C: 10973.55568635},
+		{A: 7.8e-09, B: 2.39934293755, C: 1589.0728952838},
+		{A: 7.58e-09, B: 1.30034364248, C: 103.0927742186},
+		{A: 7.49e-09, B: 4.962758033, C: 6496.3749454294},
+		{A: 7.65e-09, B: 3.36312388424, C: 36.0278666774},
+		{A: 9.15e-09, B: 5.41543742089, C: 206.1855484372},
+		{A: 7.76e-09, B: 2.57589093871, C: 11371.7046897582},
+		{A: 7.72e-09, B: 3.98369209464, C: 955.5997416086},
+		{A: 7.49e-09, B: 5.17890001805, C: 10969.9652576982},
+		{A: 8.06e-09, B: 0.34218864254, C: 9917.6968745098},
+		{A: 7.28e-09, B: 5.20962563787, C: 38.0276726358},
+		{A: 6.85e-09, B: 2.77592961854, C: 20.7753954924},
+		{A: 6.36e-09, B: 4.28242193632, C: 28.4491874678},
+		{A: 6.08e-09, B: 5.63278508906, C: 10984.1923516998},
+		{A: 7.04e-09, B: 5.60738823665, C: 3738.761430108},
+		{A: 6.85e-09, B: 0.38876148682, C: 15.252471185},
+		{A: 6.01e-09, B: 0.73489602442, C: 419.4846438752},
+		{A: 7.16e-09, B: 2.65279791438, C: 6309.3741697912},
+		{A: 5.84e-09, B: 5.54502568227, C: 17298.1823273262},
+		{A: 6.5e-09, B: 1.13379656406, C: 7058.5984613154},
+		{A: 6.88e-09, B: 2.59683891779, C: 3496.032826134},
+		{A: 4.85e-09, B: 0.44467180946, C: 12352.8526045448},
+		{A: 5.28e-09, B: 2.74936967681, C: 3930.2096962196},
+		{A: 5.97e-09, B: 5.27668281777, C: 10575.4066829418},
+		{A: 5.83e-09, B: 3.1892906781, C: 4732.0306273434},
+		{A: 5.26e-09, B: 5.01697321546, C: 5884.9268465832},
+		{A: 5.4e-09, B: 1.29175137075, C: 640.8776073822},
+		{A: 4.73e-09, B: 5.4995330697, C: 5230.807466803},
+		{A: 4.06e-09, B: 5.21248452189, C: 220.4126424388},
+		{A: 3.95e-09, B: 1.87474483222, C: 16200.7727245012},
+		{A: 3.7e-09, B: 3.84921354713, C: 18073.7049386502},
+		{A: 3.67e-09, B: 0.88533542778, C: 6283.14316029419},
+		{A: 3.79e-09, B: 0.37983009325, C: 10177.2576795336},
+		{A: 3.56e-09, B: 3.84145204913, C: 11712.9553182308},
+		{A: 3.74e-09, B: 5.01577520608, C: 7.046236698},
+		{A: 3.81e-09, B: 4.30250406634, C: 6062.6632075526},
+		{A: 4.71e-09, B: 0.86381834647, C: 6069.7767545534},
+		{A: 3.67e-09, B: 1.32943839763, C: 6283.0085396886},
+		{A: 4.6e-09, B: 5.19667219575, C: 6284.0561710596},
+		{A: 3.33e-09, B: 5.54256205741, C: 4686.8894077068},
+		{A: 3.41e-09, B: 4.36522989934, C: 7238.6755916},
+		{A: 3.36e-09, B: 4.00205876835, C: 3097.88382272579},
+		{A: 3.59e-09, B: 6.22679790284, C: 245.8316462294},
+		{A: 3.07e-09, B: 2.35299010924, C: 170.6728706192},
+		{A: 3.43e-09, B: 3.77164927143, C: 6076.8903015542},
+		{A: 2.96e-09, B: 5.44152227481, C: 17260.1546546904},
+		{A: 3.28e-09, B: 0.13837875384, C: 11015.1064773348},
+		{A: 2.68e-09, B: 1.1390455063, C: 12569.6748183318},
+		{A: 2.63e-09, B: 0.00538633678, C: 4136.9104335162},
+		{A: 2.82e-09, B: 5.0439983748, C: 7477.522860216},
+		{A: 2.88e-09, B: 3.13401177517, C: 12559.038152982},
+		{A: 2.59e-09, B: 0.93882269387, C: 5642.1982426092},
+		{A: 2.92e-09, B: 1.98420020514, C: 12132.439962106},
+		{A: 2.47e-09, B: 3.84244798532, C: 5429.8794682394},
+		{A: 2.45e-09, B: 5.70467521726, C: 65147.6197681377},
+		{A: 2.41e-09, B: 0.99480969552, C: 3634.6210245184},
+		{A: 2.46e-09, B: 3.06168069935, C: 110.2063212194},
+		{A: 2.39e-09, B: 6.11855909114, C: 11856.2186514245},
+		{A: 2.63e-09, B: 0.66348415419, C: 21228.3920235458},
+		{A: 2.62e-09, B: 1.51070507866, C: 12146.6670561076},
+		{A: 2.3e-09, B: 1.75927314884, C: 9779.1086761254},
+		{A: 2.23e-09, B: 2.00967043606, C: 6172.869528772},
+		{A: 2.46e-09, B: 1.10411690865, C: 6282.0955289232},
+		{A: 2.21e-09, B: 3.03945240854, C: 8635.9420037632},
+		{A: 2.14e-09, B: 4.03840869663, C: 14314.1681130498},
+		{A: 2.36e-09, B: 5.4691507058, C: 13916.0191096416},
+		{A: 2.24e-09, B: 4.68408089456, C: 24072.9214697764},
+		{A: 2.12e-09, B: 2.13695625494, C: 5849.3641121146},
+		{A: 2.07e-09, B: 3.07724246401, C: 11.729352836},
+		{A: 2.07e-09, B: 6.10306282747, C: 23543.23050468179},
+		{A: 2.66e-09, B: 1.00709566823, C: 2388.8940204492},
+		{A: 2.17e-09, B: 6.27837036335, C: 17267.26820169119},
+		{A: 2.04e-09, B: 2.34615348695, C: 266.6070417218},
+		{A: 1.95e-09, B: 5.55015549753, C: 6133.5126528568},
+		{A: 1.88e-09, B: 2.52667166175, C: 6525.8044539654},
+		{A: 1.85e-09, B: 0.90960768344, C: 18319.5365848796},
+		{A: 1.77e-09, B: 1.73429218289, C: 154717.6098876827},
+		{A: 1.87e-09, B: 4.76483647432, C: 4535.0594369244},
+		{A: 1.86e-09, B: 4.63080493407, C: 10440.2742926036},
+		{A: 2.15e-09, B: 2.8125545456, C: 7342.4577801806},
+		{A: 1.72e-09, B: 1.45551888559, C: 9225.539273283},
+		{A: 1.62e-09, B: 3.30661909388, C: 639.897286314},
+		{A: 1.68e-09, B: 2.17671416605, C: 27.4015560968},
+		{A: 1.6e-09, B: 1.68164180475, C: 15110.4661198662},
+		{A: 1.58e-09, B: 0.13519771874, C: 13095.8426650774},
+		{A: 1.83e-09, B: 0.56281322071, C: 13517.8701062334},
+		{A: 1.79e-09, B: 3.58450811616, C: 87.30820453981},
+		{A: 1.52e-09, B: 2.84070476818, C: 5650.2921106782},
+		{A: 1.82e-09, B: 0.44065530624, C: 17253.04110768959},
+		{A: 1.6e-09, B: 5.95767264171, C: 4701.1165017084},
+		{A: 1.42e-09, B: 1.4629013752, C: 11087.2851259184},
+		{A: 1.42e-09, B: 2.04464036087, C: 20426.571092422},
+		{A: 1.31e-09, B: 5.40912137746, C: 2699.7348193176},
+		{A: 1.44e-09, B: 2.07312090485, C: 25158.6017197654},
+		{A: 1.47e-09, B: 6.15106982168, C: 9623.6882766912},
+		{A: 1.41e-09, B: 5.55739979498, C: 10454.5013866052},
+		{A: 1.35e-09, B: 0.06098110407, C: 16723.350142595},
+		{A: 1.24e-09, B: 5.81218025669, C: 17256.6315363414},
+		{A: 1.24e-09, B: 2.36293551623, C: 4933.2084403326},
+		{A: 1.26e-09, B: 3.47435905118, C: 22483.84857449259},
+		{A: 1.59e-09, B: 5.63954754618, C: 5729.506447149},
+		{A: 1.23e-09, B: 3.92815963256, C: 17996.0311682222},
+		{A: 1.48e-09, B: 3.02509280598, C: 1551.045222648},
+		{A: 1.2e-09, B: 5.91904349732, C: 6206.8097787158},
+		{A: 1.34e-09, B: 3.11122937825, C: 21954.15760939799},
+		{A: 1.19e-09, B: 5.5214112345, C: 709.9330485583},
+		{A: 1.22e-09, B: 3.00813429479, C: 19800.9459562248},
+		{A: 1.27e-09, B: 1.37618620001, C: 14945.3161735544},
+		{A: 1.41e-09, B: 2.56889468729, C: 1052.2683831884},
+		{A: 1.23e-09, B: 2.83671175442, C: 11919.140866668},
+		{A: 1.18e-09, B: 0.81934438215, C: 5331.3574437408},
+		{A: 1.51e-09, B: 2.68731829165, C: 11769.8536931664},
+		{A: 1.19e-09, B: 5.08835797638, C: 5481.2549188676},
+		{A: 1.53e-09, B: 2.46021790779, C: 11933.3679606696},
+		{A: 1.08e-09, B: 1.04936452145, C: 11403.676995575},
+		{A: 1.28e-09, B: 0.99794735107, C: 8827.3902698748},
+		{A: 1.44e-09, B: 2.54869747042, C: 227.476132789},
+		{A: 1.5e-09, B: 4.50631437136, C: 2379.1644735716},
+		{A: 1.07e-09, B: 1.79272017026, C: 13119.72110282519},
+		{A: 1.07e-09, B: 4.43556814486, C: 18422.62935909819},
+		{A: 1.09e-09, B: 0.29269062317, C: 16737.5772365966},
+		{A: 1.41e-09, B: 3.18979826258, C: 6262.300454499},
+		{A: 1.22e-09, B: 4.23040027813, C: 29.429508536},
+		{A: 1.11e-09, B: 5.16954029551, C: 17782.7320727842},
+		{A: 1e-09, B: 3.52213872761, C: 18052.9295431578},
+		{A: 1.08e-09, B: 1.08514212991, C: 16858.4825329332},
+		{A: 1.06e-09, B: 1.9608524841, C: 74.7815985673},
+		{A: 1.1e-09, B: 2.30582372873, C: 16460.33352952499},
+		{A: 9.7e-10, B: 3.5091894021, C: 5333.9002410216},
+		{A: 9.9e-10, B: 3.56417337974, C: 735.8765135318},
+		{A: 9.4e-10, B: 5.01857894228, C: 3128.3887650958},
+		{A: 9.7e-10, B: 1.65579893894, C: 533.2140834436},
+		{A: 9.2e-10, B: 0.89217162285, C: 29296.6153895786},
+		{A: 1.23e-09, B: 3.16062050433, C: 9380.9596727172},
+		{A: 1.02e-09, B: 1.20493500565, C: 23020.65308658799},
+		{A: 8.8e-10, B: 2.21296088224, C: 12721.572099417},
+		{A: 8.9e-10, B: 1.5426472031, C: 20199.094959633},
+		{A: 1.13e-09, B: 4.8332070787, C: 16496.3613962024},
+		{A: 1.21e-09, B: 6.19860353182, C: 9388.0059094152},
+		{A: 8.9e-10, B: 4.08082274765, C: 22805.7355659936},
+		{A: 9.8e-10, B: 1.0918183283, C: 12043.574281889},
+		{A: 8.6e-10, B: 1.13655027605, C: 143571.32428481648},
+		{A: 8.8e-10, B: 5.96980472191, C: 107.6635239386},
+		{A: 8.2e-10, B: 5.01340404594, C: 22003.9146348698},
+		{A: 9.4e-10, B: 1.69615700473, C: 23006.42599258639},
+		{A: 8.1e-10, B: 3.00657814365, C: 2118.7638603784},
+		{A: 9.8e-10, B: 1.39215287161, C: 8662.240323563},
+		{A: 7.7e-10, B: 3.3355519084, C: 15720.8387848784},
+		{A: 8.2e-10, B: 5.86880116464, C: 2787.0430238574},
+		{A: 7.6e-10, B: 5.67183650604, C: 14.2270940016},
+		{A: 8.1e-10, B: 6.16619455699, C: 1039.0266107904},
+		{A: 7.6e-10, B: 3.21449884756, C: 111.1866422876},
+		{A: 7.8e-10, B: 1.37531518377, C: 21947.1113727},
+		{A: 7.4e-10, B: 3.58814195051, C: 11609.8625440122},
+		{A: 7.7e-10, B: 4.84846488388, C: 22743.4093795164},
+		{A: 9e-10, B: 1.48869013606, C: 15671.0817594066},
+		{A: 8.2e-10, B: 3.48618399109, C: 29088.811415985},
+		{A: 6.9e-10, B: 3.55746476593, C: 4590.910180489},
+		{A: 6.9e-10, B: 1.93625656075, C: 135.62532501},
+		{A: 7e-10, B: 2.66548322237, C: 18875.525869774},
+		{A: 6.9e-10, B: 5.41478093731, C: 26735.9452622132},
+		{A: 7.9e-10, B: 5.15154513662, C: 12323.4230960088},
+		{A: 9.4e-10, B: 3.62899392448, C: 77713.7714681205},
+		{A: 7.8e-10, B: 4.17011182047, C: 1066.49547719},
+		{A: 7.1e-10, B: 3.89435637865, C: 22779.4372461938},
+		{A: 6.3e-10, B: 4.53968787714, C: 8982.810669309},
+		{A: 6.9e-10, B: 0.96028230548, C: 14919.0178537546},
+		{A: 7.6e-10, B: 3.29092216589, C: 2942.4634232916},
+		{A: 6.3e-10, B: 4.09167842893, C: 16062.1845261168},
+		{A: 6.5e-10, B: 3.34580407184, C: 51.28033786241},
+		{A: 6.5e-10, B: 5.75757544877, C: 52670.0695933026},
+		{A: 6.8e-10, B: 5.75884067555, C: 21424.4666443034},
+		{A: 5.7e-10, B: 5.4512239985, C: 12592.4500197826},
+		{A: 5.7e-10, B: 5.25043362558, C: 20995.3929664494},
+		{A: 7.3e-10, B: 0.53299090807, C: 2301.58581590939},
+		{A: 7e-10, B: 4.31243357502, C: 19402.7969528166},
+		{A: 6.7e-10, B: 2.53852336668, C: 377.3736079158},
+		{A: 5.6e-10, B: 3.20816844695, C: 24889.5747959916},
+		{A: 5.3e-10, B: 3.17816599142, C: 18451.07854656599},
+		{A: 5.3e-10, B: 3.61529270216, C: 77.673770428},
+		{A: 5.3e-10, B: 0.45467549335, C: 30666.1549584328},
+		{A: 6.1e-10, B: 0.14807288453, C: 23013.5395395872},
+		{A: 5.1e-10, B: 3.32803972907, C: 56.8983749356},
+		{A: 5.2e-10, B: 3.41177624177, C: 23141.5583829246},
+		{A: 5.8e-10, B: 3.13638677202, C: 309.2783226558},
+		{A: 7e-10, B: 2.50592323465, C: 31415.379249957},
+		{A: 5.2e-10, B: 5.10673376738, C: 17796.9591667858},
+		{A: 6.7e-10, B: 6.27917920454, C: 22345.2603761082},
+		{A: 5e-10, B: 0.42577644151, C: 25685.872802808},
+		{A: 4.8e-10, B: 0.70204553333, C: 1162.4747044078},
+		{A: 6.6e-10, B: 3.64350022359, C: 15265.8865193004},
+		{A: 5e-10, B: 5.7438291744, C: 19.66976089979},
+		{A: 5e-10, B: 4.69825387775, C: 28237.2334593894},
+		{A: 4.7e-10, B: 5.74015846442, C: 12139.5535091068},
+		{A: 5.4e-10, B: 1.97301333704, C: 23581.2581773176},
+		{A: 4.9e-10, B: 4.98223579027, C: 10021.8372800994},
+		{A: 4.6e-10, B: 5.41431705539, C: 33019.0211122046},
+		{A: 5.1e-10, B: 1.23882053879, C: 12539.853380183},
+		{A: 4.6e-10, B: 2.41369976086, C: 98068.53671630539},
+		{A: 4.4e-10, B: 0.80750593746, C: 167283.7615876655},
+		{A: 4.5e-10, B: 4.39613584445, C: 433.7117378768},
+		{A: 4.4e-10, B: 2.57358208785, C: 12964.300703391},
+		{A: 4.6e-10, B: 0.26142733448, C: 11.0457002639},
+		{A: 4.5e-10, B: 2.46230645202, C: 51868.2486621788},
+		{A: 4.8e-10, B: 0.89551707131, C: 56600.2792895222},
+		{A: 5.7e-10, B: 1.8641670701, C: 25287.7237993998},
+		{A: 4.2e-10, B: 5.26377513431, C: 26084.0218062162},
+		{A: 4.9e-10, B: 3.17757670611, C: 6303.8512454838},
+		{A: 5.2e-10, B: 3.65266055509, C: 7872.1487452752},
+		{A: 4e-10, B: 1.81891629936, C: 34596.3646546524},
+		{A: 4.3e-10, B: 1.94164978061, C: 1903.4368125012},
+		{A: 4.1e-10, B: 0.74461854136, C: 23937.856389741},
+		{A: 4.8e-10, B: 6.26034008181, C: 28286.9904848612},
+		{A: 4.5e-10, B: 5.4557501753, C: 60530.4889857418},
+		{A: 4e-10, B: 2.92105728682, C: 21548.9623692918},
+		{A: 4e-10, B: 0.04502010161, C: 38526.574350872},
+		{A: 5.3e-10, B: 3.64791042082, C: 11925.2740926006},
+		{A: 4.1e-10, B: 5.04048954693, C: 27832.0382192832},
+		{A: 4.2e-10, B: 5.19292937193, C: 19004.6479494084},
+		{A: 4e-10, B: 2.57120233428, C: 24356.7807886416},
+		{A: 3.8e-10, B: 3.49190341464, C: 226858.23855437007},
+		{A: 3.9e-10, B: 4.61184303844, C: 95.9792272178},
+		{A: 4.3e-10, B: 2.20648228147, C: 13521.7514415914},
+		{A: 4e-10, B: 5.83461945819, C: 16193.65917750039},
+		{A: 4.5e-10, B: 3.73714372195, C: 7875.6718636242},
+		{A: 4.3e-10, B: 1.14078465002, C: 49.7570254718},
+		{A: 3.7e-10, B: 1.29390383811, C: 310.8407988684},
+		{A: 3.8e-10, B: 0.9597092595, C: 664.75604513},
+		{A: 3.7e-10, B: 4.27532649462, C: 6709.6740408674},
+		{A: 3.8e-10, B: 2.20108541046, C: 28628.3362260996},
+		{A: 3.9e-10, B: 0.85957361635, C: 16522.6597160022},
+		{A: 4e-10, B: 4.35214003837, C: 48739.859897083},
+		{A: 3.6e-10, B: 1.68167662194, C: 10344.2950653858},
+		{A: 4e-10, B: 5.13217319067, C: 15664.03552270859},
+		{A: 3.6e-10, B: 3.72187132496, C: 30774.5016425748},
+		{A: 3.6e-10, B: 3.32158458257, C: 16207.886271502},
+		{A: 4.5e-10, B: 3.94202418608, C: 10988.808157535},
+		{A: 3.9e-10, B: 1.51948786199, C: 12029.3471878874},
+		{A: 2.6e-10, B: 3.8768588318, C: 6262.7205305926},
+		{A: 2.4e-10, B: 4.91804163466, C: 19651.048481098},
+		{A: 2.3e-10, B: 0.29300197709, C: 13362.4497067992},
+		{A: 2.1e-10, B: 3.18605672363, C: 6277.552925684},
+		{A: 2.1e-10, B: 6.07546891132, C: 18139.2945014159},
+		{A: 2.2e-10, B: 2.31199937177, C: 6303.4311693902},
+		{A: 2.1e-10, B: 3.58418394393, C: 18209.33026366019},
+		{A: 2.6e-10, B: 2.068012969, C: 12573.2652469836},
+		{A: 2.1e-10, B: 1.56857722317, C: 13341.6743113068},
+		{A: 2.4e-10, B: 5.72605158675, C: 29864.334027309},
+		{A: 2.4e-10, B: 1.40237993205, C: 14712.317116458},
+		{A: 2.5e-10, B: 5.71466092822, C: 25934.1243310894},
+	},
+	// L2, 142 terms
+	{
+		{A: 0.0005291887, B: 0, C: 0},
+		{A: 8.719837e-05, B: 1.07209665242, C: 6283.0758499914},
+		{A: 3.09125e-06, B: 0.86728818832, C: 12566.1516999828},
+		{A: 2.7339e-07, B: 0.05297871691, C: 3.523118349},
+		{A: 1.6334e-07, B: 5.18826691036, C: 26.2983197998},
+		{A: 1.5752e-07, B: 3.6845788943, C: 155.4203994342},
+		{A: 9.541e-08, B: 0.75742297675, C: 18849.2275499742},
+		{A: 8.937e-08, B: 2.05705419118, C: 77713.7714681205},
+		{A: 6.952e-08, B: 0.8267330541, C: 775.522611324},
+		{A: 5.064e-08, B: 4.66284525271, C: 1577.3435424478},
+		{A: 4.061e-08, B: 1.03057162962, C: 7.1135470008},
+		{A: 3.463e-08, B: 5.14074632811, C: 796.2980068164},
+		{A: 3.169e-08, B: 6.05291851171, C: 5507.5532386674},
+		{A: 3.02e-08, B: 1.19246506441, C: 242.728603974},
+		{A: 2.886e-08, B: 6.11652627155, C: 529.6909650946},
+		{A: 3.81e-08, B: 3.4405080349, C: 5573.1428014331},
+		{A: 2.714e-08, B: 0.30637881025, C: 398.1490034082},
+		{A: 2.371e-08, B: 4.38118838167, C: 5223.6939198022},
+		{A: 2.538e-08, B: 2.27992810679, C: 553.5694028424},
+		{A: 2.079e-08, B: 3.75435330484, C: 0.9803210682},
+		{A: 1.675e-08, B: 0.90216407959, C: 951.7184062506},
+		{A: 1.534e-08, B: 5.75900462759, C: 1349.8674096588},
+		{A: 1.224e-08, B: 2.97328088405, C: 2146.1654164752},
+		{A: 1.449e-08, B: 4.3641591397, C: 1748.016413067},
+		{A: 1.341e-08, B: 3.72061130861, C: 1194.4470102246},
+		{A: 1.254e-08, B: 2.94846826628, C: 6438.4962494256},
+		{A: 9.99e-09, B: 5.98640014468, C: 6286.5989683404},
+		{A: 9.17e-09, B: 4.79788687522, C: 5088.6288397668},
+		{A: 8.28e-09, B: 3.31321076572, C: 213.299095438},
+		{A: 1.103e-08, B: 1.27104454479, C: 161000.6857376741},
+		{A: 7.62e-09, B: 3.41582762988, C: 5486.777843175},
+		{A: 1.044e-08, B: 0.60409577691, C: 3154.6870848956},
+		{A: 8.87e-09, B: 5.23465144638, C: 7084.8967811152},
+		{A: 6.45e-09, B: 1.60096192515, C: 2544.3144198834},
+		{A: 6.81e-09, B: 3.43155669169, C: 4694.0029547076},
+		{A: 6.05e-09, B: 2.47806340546, C: 10977.078804699},
+		{A: 7.06e-09, B: 6.19393222575, C: 4690.4798363586},
+		{A: 6.43e-09, B: 1.98042503148, C: 801.8209311238},
+		{A: 5.02e-09, B: 1.44394375363, C: 6836.6452528338},
+		{A: 4.9e-09, B: 2.34129524194, C: 1592.5960136328},
+		{A: 4.58e-09, B: 1.30876448575, C: 4292.3308329504},
+		{A: 4.31e-09, B: 0.03526421494, C: 7234.794256242},
+		{A: 3.79e-09, B: 3.17030522615, C: 6309.3741697912},
+		{A: 3.48e-09, B: 0.99049550009, C: 6040.3472460174},
+		{A: 3.86e-09, B: 1.57019797263, C: 71430.69561812909},
+		{A: 3.47e-09, B: 0.67013291338, C: 1059.3819301892},
+		{A: 4.58e-09, B: 3.81499443681, C: 149854.4001348079},
+		{A: 3.02e-09, B: 1.91760044838, C: 10447.3878396044},
+		{A: 3.07e-09, B: 3.55343347416, C: 8031.0922630584},
+		{A: 3.95e-09, B: 4.93701776616, C: 7632.9432596502},
+		{A: 3.14e-09, B: 3.18093696547, C: 2352.8661537718},
+		{A: 2.82e-09, B: 4.41936437052, C: 9437.762934887},
+		{A: 2.76e-09, B: 2.71314254553, C: 3894.1818295422},
+		{A: 2.98e-09, B: 2.5203747421, C: 6127.6554505572},
+		{A: 2.3e-09, B: 1.37790215549, C: 4705.7323075436},
+		{A: 2.52e-09, B: 0.55330133471, C: 6279.5527316424},
+		{A: 2.55e-09, B: 5.26570187369, C: 6812.766815086},
+		{A: 2.75e-09, B: 0.67264264272, C: 25132.3033999656},
+		{A: 1.78e-09, B: 0.92820785174, C: 1990.745017041},
+		{A: 2.21e-09, B: 0.63897368842, C: 6256.7775301916},
+		{A: 1.55e-09, B: 0.77319790838, C: 14143.4952424306},
+		{A: 1.5e-09, B: 2.40470465561, C: 426.598190876},
+		{A: 1.96e-09, B: 6.06877865012, C: 640.8776073822},
+		{A: 1.37e-09, B: 2.21679460145, C: 8429.2412664666},
+		{A: 1.27e-09, B: 3.26094223174, C: 17789.845619785},
+		{A: 1.28e-09, B: 5.47237279946, C: 12036.4607348882},
+		{A: 1.22e-09, B: 2.16291082757, C: 10213.285546211},
+		{A: 1.18e-09, B: 0.45789822268, C: 7058.5984613154},
+		{A: 1.41e-09, B: 2.34932647403, C: 11506.7697697936},
+		{A: 1e-09, B: 0.85621569847, C: 6290.1893969922},
+		{A: 9.2e-10, B: 5.10587476002, C: 7079.3738568078},
+		{A: 1.26e-09, B: 2.65428307012, C: 88860.05707098669},
+		{A: 1.06e-09, B: 5.85646710022, C: 7860.4193924392},
+		{A: 8.4e-10, B: 3.57457554262, C: 16730.4636895958},
+		{A: 8.9e-10, B: 4.21433259618, C: 83996.84731811189},
+		{A: 9.7e-10, B: 5.57938280855, C: 13367.9726311066},
+		{A: 1.02e-09, B: 2.05853060226, C: 87.30820453981},
+		{A: 8e-10, B: 4.73792651816, C: 11926.2544136688},
+		{A: 8e-10, B: 5.41418965044, C: 10973.55568635},
+		{A: 1.06e-09, B: 4.10978997399, C: 3496.032826134},
+		{A: 1.02e-09, B: 3.62650006043, C: 244287.60000722768},
+		{A: 7.5e-10, B: 4.89483161769, C: 5643.1785636774},
+		{A: 8.7e-10, B: 0.42863750683, C: 11015.1064773348},
+		{A: 6.9e-10, B: 1.8890876072, C: 10177.2576795336},
+		{A: 8.9e-10, B: 1.35567273119, C: 6681.2248533996},
+		{A: 6.6e-10, B: 0.99455837265, C: 6525.8044539654},
+		{A: 6.7e-10, B: 5.5124099707, C: 3097.88382272579},
+		{A: 7.6e-10, B: 2.72016814799, C: 4164.311989613},
+		{A: 6.3e-10, B: 1.4434990254, C: 9917.6968745098},
+		{A: 7.8e-10, B: 3.51469733747, C: 11856.2186514245},
+		{A: 8.5e-10, B: 0.50956043858, C: 10575.4066829418},
+		{A: 6.7e-10, B: 3.62043033405, C: 16496.3613962024},
+		{A: 5.5e-10, B: 5.24637517308, C: 3340.6124266998},
+		{A: 4.8e-10, B: 5.43966777314, C: 20426.571092422},
+		{A: 6.4e-10, B: 5.79535817813, C: 2388.8940204492},
+		{A: 4.6e-10, B: 5.43499966519, C: 6275.9623029906},
+		{A: 5e-10, B: 3.86263598617, C: 5729.506447149},
+		{A: 4.4e-10, B: 1.52269529228, C: 12168.0026965746},
+		{A: 5.7e-10, B: 4.96352373486, C: 14945.3161735544},
+		{A: 4.5e-10, B: 1.0086123016, C: 8635.9420037632},
+		{A: 4.3e-10, B: 3.30685683359, C: 9779.1086761254},
+		{A: 4.2e-10, B: 0.6348125893, C: 2699.7348193176},
+		{A: 4.1e-10, B: 5.67996766641, C: 11712.9553182308},
+		{A: 5.6e-10, B: 4.34024451468, C: 90955.5516944961},
+		{A: 4.1e-10, B: 5.81722212845, C: 709.9330485583},
+		{A: 5.3e-10, B: 6.17052087143, C: 233141.3144043615},
+		{A: 3.7e-10, B: 3.12495025087, C: 16200.7727245012},
+		{A: 3.5e-10, B: 5.76973458495, C: 12569.6748183318},
+		{A: 3.7e-10, B: 0.31656444326, C: 24356.7807886416},
+		{A: 3.5e-10, B: 0.96229051027, C: 17298.1823273262},
+		{A: 3.3e-10, B: 5.23130355867, C: 5331.3574437408},
+		{A: 3.5e-10, B: 0.62517020593, C: 25158.6017197654},
+		{A: 3.5e-10, B: 0.80004512129, C: 13916.0191096416},
+		{A: 3.7e-10, B: 2.89336088688, C: 12721.572099417},
+		{A: 3e-10, B: 4.50198402401, C: 23543.23050468179},
+		{A: 3e-10, B: 5.31355708693, C: 18319.5365848796},
+		{A: 2.9e-10, B: 3.47275229977, C: 13119.72110282519},
+		{A: 2.9e-10, B: 3.11002782516, C: 4136.9104335162},
+		{A: 3.2e-10, B: 5.52273255667, C: 5753.3848848968},
+		{A: 3.5e-10, B: 3.7969999668, C: 143571.32428481648},
+		{A: 2.6e-10, B: 1.50634201907, C: 154717.6098876827},
+		{A: 3e-10, B: 3.53519084118, C: 6284.0561710596},
+		{A: 2.3e-10, B: 4.41808025967, C: 5884.9268465832},
+		{A: 2.5e-10, B: 1.38477355808, C: 65147.6197681377},
+		{A: 2.3e-10, B: 3.49782549797, C: 7477.522860216},
+		{A: 1.9e-10, B: 3.14329413716, C: 6496.3749454294},
+		{A: 1.9e-10, B: 2.20135125199, C: 18073.7049386502},
+		{A: 1.9e-10, B: 4.95020255309, C: 3930.2096962196},
+		{A: 1.9e-10, B: 0.57998702747, C: 31415.379249957},
+		{A: 2.1e-10, B: 1.75474323399, C: 12139.5535091068},
+		{A: 1.9e-10, B: 3.92233070499, C: 19651.048481098},
+		{A: 1.4e-10, B: 0.98131213224, C: 12559.038152982},
+		{A: 1.9e-10, B: 4.93309333729, C: 2942.4634232916},
+		{A: 1.6e-10, B: 5.55997534558, C: 8827.3902698748},
+		{A: 1.3e-10, B: 1.68808165516, C: 4535.0594369244},
+		{A: 1.3e-10, B: 0.33982116161, C: 4933.2084403326},
+		{A: 1.2e-10, B: 1.85426309994, C: 5856.4776591154},
+		{A: 1e-10, B: 4.82763996845, C: 13095.8426650774},
+		{A: 1.1e-10, B: 5.38005490571, C: 11790.6290886588},
+		{A: 1e-10, B: 1.40815507226, C: 10988.808157535},
+		{A: 1.1e-10, B: 3.05005267431, C: 17260.1546546904},
+		{A: 1e-10, B: 4.93364992366, C: 12352.8526045448},
+	},
+	// L3, 22 terms
+	{
+		{A: 2.89226e-06, B: 5.84384198723, C: 6283.0758499914},
+		{A: 3.4955e-07, B: 0, C: 0},
+		{A: 1.6819e-07, B: 5.48766912348, C: 12566.1516999828},
+		{A: 2.962e-08, B: 5.19577265202, C: 155.4203994342},
+		{A: 1.288e-08, B: 4.72200252235, C: 3.523118349},
+		{A: 6.35e-09, B: 5.96925937141, C: 242.728603974},
+		{A: 7.14e-09, B: 5.30045809128, C: 18849.2275499742},
+		{A: 4.02e-09, B: 3.78682982419, C: 553.5694028424},
+		{A: 7.2e-10, B: 4.2976812618, C: 6286.5989683404},
+		{A: 6.7e-10, B: 0.90721687647, C: 6127.6554505572},
+		{A: 3.6e-10, B: 5.24029648014, C: 6438.4962494256},
+		{A: 2.4e-10, B: 5.16003960716, C: 25132.3033999656},
+		{A: 2.3e-10, B: 3.01921570335, C: 6309.3741697912},
+		{A: 1.7e-10, B: 5.82863573502, C: 6525.8044539654},
+		{A: 1.7e-10, B: 3.6777286393, C: 71430.69561812909},
+		{A: 9e-11, B: 4.58467294499, C: 1577.3435424478},
+		{A: 8e-11, B: 1.40626662824, C: 11856.2186514245},
+		{A: 8e-11, B: 5.07561257196, C: 6256.7775301916},
+		{A: 7e-11, B: 2.82473374405, C: 83996.84731811189},
+		{A: 5e-11, B: 2.71488713339, C: 10977.078804699},
+		{A: 5e-11, B: 3.76879847273, C: 12036.4607348882},
+		{A: 5e-11, B: 4.28412873331, C: 6275.9623029906},
+	},
+	// L4, 11 terms
+	{
+		{A: 1.14084e-06, B: 3.14159265359, C: 0},
+		{A: 7.717e-08, B: 4.13446589358, C: 6283.0758499914},
+		{A: 7.65e-09, B: 3.83803776214, C: 12566.1516999828},
+		{A: 4.2e-09, B: 0.41925861858, C: 155.4203994342},
+		{A: 4e-10, B: 3.5984758584, C: 18849.2275499742},
+		{A: 4.1e-10, B: 3.14398414077, C: 3.523118349},
+		{A: 3.5e-10, B: 5.00298940826, C: 5573.1428014331},
+		{A: 1.3e-10, B: 0.48794833701, C: 77713.7714681205},
+		{A: 1e-10, B: 5.6480176635, C: 6127.6554505572},
+		{A: 8e-11, B: 2.84160570605, C: 161000.6857376741},
+		{A: 2e-11, B: 0.54912904658, C: 6438.4962494256},
+	},
+	// L5, 5 terms
+	{
+		{A: 8.78e-09, B: 3.14159265359, C: 0},
+		{A: 1.72e-09, B: 2.7657906951, C: 6283.0758499914},
+		{A: 5e-10, B: 2.01353298182, C: 155.4203994342},
+		{A: 2.8e-10, B: 2.21496423926, C: 12566.1516999828},
+		{A: 5e-11, B: 1.75600058765, C: 18849.2275499742},
+	},
+}
+
+// GetEarthL0 evaluates the t^0 series of L.
+func GetEarthL0(t float64) float64 {
+	return earthL[0].Evaluate(t)
+}
+
+// GetEarthL1 evaluates the t^1 series of L.
+func GetEarthL1(t float64) float64 {
+	return earthL[1].Evaluate(t)
+}
+
+// GetEarthL2 evaluates the t^2 series of L.
+func GetEarthL2(t float64) float64 {
+	return earthL[2].Evaluate(t)
+}
+
+// GetEarthL3 evaluates the t^3 series of L.
+func GetEarthL3(t float64) float64 {
+	return earthL[3].Evaluate(t)
+}
+
+// GetEarthL4 evaluates the t^4 series of L.
+func GetEarthL4(t float64) float64 {
+	return earthL[4].Evaluate(t)
+}
+
+// GetEarthL5 evaluates the t^5 series of L.
+func GetEarthL5(t float64) float64 {
+	return earthL[5].Evaluate(t)
+}
@@ -0,0 +1,1056 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// EARTH R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87earthd
+
+import "vsop87"
+
+var earthR = []vsop87.Series{
+	// R0, 526 terms
+	{
+		{A: 1.00013988799, B: 0, C: 0},
+		{A: 0.01670699626, B: 3.09846350771, C: 6283.0758499914},
+		{A: 0.00013956023, B: 3.0552460962, C: 12566.1516999828},
+		{A: 3.08372e-05, B: 5.19846674381, C: 77713.7714681205},
+		{A: 1.628461e-05, B: 1.17387749012, C: 5753.3848848968},
+		{A: 1.575568e-05, B: 2.84685245825, C: 7860.4193924392},
+		{A: 9.24799e-06, B: 5.45292234084, C: 11506.7697697936},
+		{A: 5.42444e-06, B: 4.56409149777, C: 3930.2096962196},
+		{A: 4.7211e-06, B: 3.66100022149, C: 5884.9268465832},
+		{A: 3.2878e-06, B: 5.89983646482, C: 5223.6939198022},
+		{A: 3.45983e-06, B: 0.96368617687, C: 5507.5532386674},
+		{A: 3.06784e-06, B: 0.29867139512, C: 5573.1428014331},
+		{A: 1.74844e-06, B: 3.01193636534, C: 18849.2275499742},
+		{A: 2.43189e-06, B: 4.27349536153, C: 11790.6290886588},
+		{A: 2.11829e-06, B: 5.84714540314, C: 1577.3435424478},
+		{A: 1.85752e-06, B: 5.02194447178, C: 10977.078804699},
+		{A: 1.09835e-06, B: 5.05510636285, C: 5486.777843175},
+		{A: 9.8316e-07, B: 0.88681311277, C: 6069.7767545534},
+		{A: 8.6499e-07, B: 5.68959778254, C: 15720.8387848784},
+		{A: 8.5825e-07, B: 1.27083733351, C: 161000.6857376741},
+		{A: 6.2916e-07, B: 0.92177108832, C: 529.6909650946},
+		{A: 5.7056e-07, B: 2.01374292014, C: 83996.84731811189},
+		{A: 6.4903e-07, B: 0.27250613787, C: 17260.1546546904},
+		{A: 4.9384e-07, B: 3.24501240359, C: 2544.3144198834},
+		{A: 5.5736e-07, B: 5.24159798933, C: 71430.69561812909},
+		{A: 4.2515e-07, B: 6.01110242003, C: 6275.9623029906},
+		{A: 4.6963e-07, B: 2.57805070386, C: 775.522611324},
+		{A: 3.8968e-07, B: 5.36071738169, C: 4694.0029547076},
+		{A: 4.4661e-07, B: 5.53715807302, C: 9437.762934887},
+		{A: 3.566e-07, B: 1.67468058995, C: 12036.4607348882},
+		{A: 3.1921e-07, B: 0.18368229781, C: 5088.6288397668},
+		{A: 3.1846e-07, B: 1.77775642085, C: 398.1490034082},
+		{A: 3.3193e-07, B: 0.24370300098, C: 7084.8967811152},
+		{A: 3.8245e-07, B: 2.39255343974, C: 8827.3902698748},
+		{A: 2.8464e-07, B: 1.21344868176, C: 6286.5989683404},
+		{A: 3.749e-07, B: 0.82952922332, C: 19651.048481098},
+		{A: 3.6957e-07, B: 4.90107591914, C: 12139.5535091068},
+		{A: 3.4537e-07, B: 1.84270693282, C: 2942.4634232916},
+		{A: 2.6275e-07, B: 4.58896850401, C: 10447.3878396044},
+		{A: 2.4596e-07, B: 3.78660875483, C: 8429.2412664666},
+		{A: 2.3587e-07, B: 0.26866117066, C: 796.2980068164},
+		{A: 2.7793e-07, B: 1.89934330904, C: 6279.5527316424},
+		{A: 2.3927e-07, B: 4.99598548138, C: 5856.4776591154},
+		{A: 2.0349e-07, B: 4.65267995431, C: 2146.1654164752},
+		{A: 2.3287e-07, B: 2.80783650928, C: 14143.4952424306},
+		{A: 2.2103e-07, B: 1.95004702988, C: 3154.6870848956},
+		{A: 1.9506e-07, B: 5.38227371393, C: 2352.8661537718},
+		{A: 1.7958e-07, B: 0.19871379385, C: 6812.766815086},
+		{A: 1.7174e-07, B: 4.43315560735, C: 10213.285546211},
+		{A: 1.619e-07, B: 5.23160507859, C: 17789.845619785},
+		{A: 1.7314e-07, B: 6.15200787916, C: 16730.4636895958},
+		{A: 1.3814e-07, B: 5.18962074032, C: 8031.0922630584},
+		{A: 1.8833e-07, B: 0.67306674027, C: 149854.4001348079},
+		{A: 1.8331e-07, B: 2.25348733734, C: 23581.2581773176},
+		{A: 1.3641e-07, B: 3.68516118804, C: 4705.7323075436},
+		{A: 1.3139e-07, B: 0.65289581324, C: 13367.9726311066},
+		{A: 1.0414e-07, B: 4.33285688538, C: 11769.8536931664},
+		{A: 9.978e-08, B: 4.20126336355, C: 6309.3741697912},
+		{A: 1.0169e-07, B: 1.59390681369, C: 4690.4798363586},
+		{A: 7.564e-08, B: 2.6256059739, C: 6256.7775301916},
+		{A: 9.661e-08, B: 3.6758679122, C: 27511.4678735372},
+		{A: 6.743e-08, B: 0.56270332741, C: 3340.6124266998},
+		{A: 8.743e-08, B: 6.06359123461, C: 1748.016413067},
+		{A: 7.786e-08, B: 3.67371235637, C: 12168.0026965746},
+		{A: 6.633e-08, B: 5.66149277792, C: 11371.7046897582},
+		{A: 7.712e-08, B: 0.31242577789, C: 7632.9432596502},
+		{A: 6.592e-08, B: 3.13576266188, C: 801.8209311238},
+		{A: 7.46e-08, B: 5.64757188143, C: 11926.2544136688},
+		{A: 6.933e-08, B: 2.923845864, C: 6681.2248533996},
+		{A: 6.802e-08, B: 1.4232980642, C: 23013.5395395872},
+		{A: 6.115e-08, B: 5.13393615454, C: 1194.4470102246},
+		{A: 6.477e-08, B: 2.64986648492, C: 19804.8272915828},
+		{A: 5.233e-08, B: 4.62434053374, C: 6438.4962494256},
+		{A: 6.147e-08, B: 3.02863936662, C: 233141.3144043615},
+		{A: 4.608e-08, B: 1.72194702724, C: 7234.794256242},
+		{A: 4.221e-08, B: 1.55697533729, C: 7238.6755916},
+		{A: 5.314e-08, B: 2.40716580847, C: 11499.6562227928},
+		{A: 5.128e-08, B: 5.3239896569, C: 11513.8833167944},
+		{A: 4.77e-08, B: 0.25554312006, C: 11856.2186514245},
+		{A: 5.519e-08, B: 2.09089154502, C: 17298.1823273262},
+		{A: 5.625e-08, B: 4.34052903053, C: 90955.5516944961},
+		{A: 4.578e-08, B: 4.4656964157, C: 5746.271337896},
+		{A: 3.788e-08, B: 4.9072938351, C: 4164.311989613},
+		{A: 5.337e-08, B: 5.09957905104, C: 31441.6775697568},
+		{A: 3.967e-08, B: 1.20054555174, C: 1349.8674096588},
+		{A: 4.008e-08, B: 3.03007204392, C: 1059.3819301892},
+		{A: 3.476e-08, B: 0.7608027703, C: 10973.55568635},
+		{A: 4.232e-08, B: 1.05485713117, C: 5760.4984318976},
+		{A: 4.582e-08, B: 3.76570026763, C: 6386.16862421},
+		{A: 3.335e-08, B: 3.13829943354, C: 6836.6452528338},
+		{A: 3.418e-08, B: 3.00072390334, C: 4292.3308329504},
+		{A: 3.598e-08, B: 5.70718084323, C: 5643.1785636774},
+		{A: 3.237e-08, B: 4.16448773994, C: 9917.6968745098},
+		{A: 4.154e-08, B: 2.59941292162, C: 7058.5984613154},
+		{A: 3.362e-08, B: 4.54577697964, C: 4732.0306273434},
+		{A: 2.978e-08, B: 1.3056126882, C: 6283.14316029419},
+		{A: 2.765e-08, B: 0.51311975679, C: 26.2983197998},
+		{A: 2.802e-08, B: 5.66263240521, C: 8635.9420037632},
+		{A: 2.927e-08, B: 5.73787481548, C: 16200.7727245012},
+		{A: 3.164e-08, B: 1.69140262657, C: 11015.1064773348},
+		{A: 2.598e-08, B: 2.96244118586, C: 25132.3033999656},
+		{A: 3.519e-08, B: 3.62639325753, C: 244287.60000722768},
+		{A: 2.676e-08, B: 4.2072570085, C: 18073.7049386502},
+		{A: 2.978e-08, B: 1.74971565805, C: 6283.0085396886},
+		{A: 2.287e-08, B: 1.06975704977, C: 14314.1681130498},
+		{A: 2.863e-08, B: 5.92838131397, C: 14712.317116458},
+		{A: 3.071e-08, B: 0.23793217002, C: 35371.8872659764},
+		{A: 2.656e-08, B: 0.8995930178, C: 12352.8526045448},
+		{A: 2.415e-08, B: 2.79975176257, C: 709.9330485583},
+		{A: 2.814e-08, B: 3.51488206882, C: 21228.3920235458},
+		{A: 1.977e-08, B: 2.6135829755, C: 951.7184062506},
+		{A: 2.548e-08, B: 2.47684686575, C: 6208.2942514241},
+		{A: 1.999e-08, B: 0.5609038816, C: 7079.3738568078},
+		{A: 2.305e-08, B: 1.05376461628, C: 22483.84857449259},
+		{A: 1.855e-08, B: 2.86090681163, C: 5216.5803728014},
+		{A: 2.157e-08, B: 1.31396741861, C: 154717.6098876827},
+		{A: 1.97e-08, B: 4.36929875289, C: 167283.7615876655},
+		{A: 1.635e-08, B: 5.85571606764, C: 10984.1923516998},
+		{A: 1.754e-08, B: 2.14452408833, C: 6290.1893969922},
+		{A: 2.154e-08, B: 6.03828341543, C: 10873.9860304804},
+		{A: 1.714e-08, B: 3.70157691113, C: 1592.5960136328},
+		{A: 1.541e-08, B: 6.21598380732, C: 23543.23050468179},
+		{A: 1.611e-08, B: 1.99824499377, C: 10969.9652576982},
+		{A: 1.712e-08, B: 1.34295663542, C: 3128.3887650958},
+		{A: 1.642e-08, B: 5.55026665339, C: 6496.3749454294},
+		{A: 1.502e-08, B: 5.43948825854, C: 155.4203994342},
+		{A: 1.827e-08, B: 5.91227480261, C: 3738.761430108},
+		{A: 1.726e-08, B: 2.16764983583, C: 10575.4066829418},
+		{A: 1.532e-08, B: 5.3568310707, C: 13521.7514415914},
+		{A: 1.829e-08, B: 1.66006148731, C: 39302.096962196},
+		{A: 1.605e-08, B: 1.90928637633, C: 6133.5126528568},
+		{A: 1.282e-08, B: 2.46014880418, C: 13916.0191096416},
+		{A: 1.211e-08, B: 4.4136063155, C: 3894.1818295422},
+		{A: 1.394e-08, B: 1.77801929354, C: 9225.539273283},
+		{A: 1.571e-08, B: 4.95512957592, C: 25158.6017197654},
+		{A: 1.205e-08, B: 1.19212540615, C: 3.523118349},
+		{A: 1.132e-08, B: 2.69830084955, C: 6040.3472460174},
+		{A: 1.504e-08, B: 5.77002730341, C: 18209.33026366019},
+		{A: 1.393e-08, B: 1.62621805428, C: 5120.6011455836},
+		{A: 1.077e-08, B: 2.93931554233, C: 17256.6315363414},
+		{A: 1.232e-08, B: 0.71655165307, C: 143571.32428481648},
+		{A: 1.087e-08, B: 0.99769687939, C: 955.5997416086},
+		{A: 1.068e-08, B: 5.28472576231, C: 65147.6197681377},
+		{A: 9.8e-09, B: 5.10949204607, C: 6172.869528772},
+		{A: 1.169e-08, B: 3.11664290862, C: 14945.3161735544},
+		{A: 1.202e-08, B: 4.02992510402, C: 553.5694028424},
+		{A: 9.79e-09, B: 2.00000879212, C: 15110.4661198662},
+		{A: 9.62e-09, B: 4.023807714, C: 6282.0955289232},
+		{A: 9.99e-09, B: 3.6264300279, C: 6262.300454499},
+		{A: 1.03e-08, B: 5.84989900289, C: 213.299095438},
+		{A: 1.014e-08, B: 2.84221578218, C: 8662.240323563},
+		{A: 1.185e-08, B: 1.51330541132, C: 17654.7805397496},
+		{A: 9.67e-09, B: 2.67081017562, C: 5650.2921106782},
+		{A: 1.222e-08, B: 2.65423784904, C: 88860.05707098669},
+		{A: 9.81e-09, B: 2.36370360283, C: 6206.8097787158},
+		{A: 1.033e-08, B: 0.13874927606, C: 11712.9553182308},
+		{A: 1.103e-08, B: 3.08477302937, C: 43232.3066584156},
+		{A: 7.81e-09, B: 2.53372735932, C: 16496.3613962024},
+		{A: 1.019e-08, B: 3.04569392376, C: 6037.244203762},
+		{A: 7.95e-09, B: 5.80662989111, C: 5230.807466803},
+		{A: 8.13e-09, B: 3.57710279439, C: 10177.2576795336},
+		{A: 9.62e-09, B: 5.31470594766, C: 6284.0561710596},
+		{A: 7.21e-09, B: 5.96264301567, C: 12559.038152982},
+		{A: 9.66e-09, B: 2.74714939953, C: 6244.9428143536},
+		{A: 9.21e-09, B: 0.10155275926, C: 29088.811415985},
+		{A: 6.92e-09, B: 3.89764447548, C: 1589.0728952838},
+		{A: 7.19e-09, B: 5.91791450402, C: 4136.9104335162},
+		{A: 7.72e-09, B: 4.05505682353, C: 6127.6554505572},
+		{A: 7.12e-09, B: 5.49291532439, C: 22003.9146348698},
+		{A: 6.72e-09, B: 1.60700490811, C: 11087.2851259184},
+		{A: 6.9e-09, B: 4.50539825563, C: 426.598190876},
+		{A: 8.54e-09, B: 3.26104981596, C: 20426.571092422},
+		{A: 6.56e-09, B: 4.3241018294, C: 16858.4825329332},
+		{A: 8.4e-09, B: 2.59572585222, C: 28766.924424484},
+		{A: 6.92e-09, B: 0.61650089011, C: 11403.676995575},
+		{A: 7e-09, B: 3.40901167143, C: 7.1135470008},
+		{A: 7.26e-09, B: 0.04243053594, C: 5481.2549188676},
+		{A: 5.57e-09, B: 4.78317696534, C: 20199.094959633},
+		{A: 6.49e-09, B: 1.04027912958, C: 6062.6632075526},
+		{A: 6.33e-09, B: 5.70229959167, C: 45892.73043315699},
+		{A: 5.92e-09, B: 6.11836729658, C: 9623.6882766912},
+		{A: 5.23e-09, B: 3.62840021266, C: 5333.9002410216},
+		{A: 6.04e-09, B: 5.57734696185, C: 10344.2950653858},
+		{A: 4.96e-09, B: 2.21023499449, C: 1990.745017041},
+		{A: 6.91e-09, B: 1.96071732602, C: 12416.5885028482},
+		{A: 6.4e-09, B: 1.59074172032, C: 18319.5365848796},
+		{A: 6.25e-09, B: 3.82362791378, C: 13517.8701062334},
+		{A: 6.63e-09, B: 5.08444996779, C: 283.8593188652},
+		{A: 4.75e-09, B: 1.17025894287, C: 12569.6748183318},
+		{A: 6.64e-09, B: 4.50029469969, C: 47162.5163546352},
+		{A: 5.69e-09, B: 0.16310365162, C: 17267.26820169119},
+		{A: 5.68e-09, B: 3.86100969474, C: 6076.8903015542},
+		{A: 5.39e-09, B: 4.83282276086, C: 18422.62935909819},
+		{A: 4.66e-09, B: 0.75872342878, C: 7342.4577801806},
+		{A: 5.41e-09, B: 3.07212190507, C: 226858.23855437007},
+		{A: 4.58e-09, B: 0.26774483096, C: 4590.910180489},
+		{A: 6.1e-09, B: 1.53597051291, C: 33019.0211122046},
+		{A: 6.17e-09, B: 2.62356328726, C: 11190.377900137},
+		{A: 5.48e-09, B: 4.55798855791, C: 18875.525869774},
+		{A: 6.33e-09, B: 4.60110281228, C: 66567.48586525429},
+		{A: 5.96e-09, B: 5.78202396722, C: 632.7837393132},
+		{A: 5.33e-09, B: 5.01786882904, C: 12132.439962106},
+		{A: 6.03e-09, B: 5.38458554802, C: 316428.22867391503},
+		{A: 4.69e-09, B: 0.59168241917, C: 21954.15760939799},
+		{A: 5.48e-09, B: 3.50613163558, C: 17253.04110768959},
+		{A: 5.02e-09, B: 0.98804327589, C: 11609.8625440122},
+		{A: 5.68e-09, B: 1.98497313089, C: 7668.6374249425},
+		{A: 4.82e-09, B: 1.62141803864, C: 12146.6670561076},
+		{A: 3.91e-09, B: 3.68718382989, C: 18052.9295431578},
+		{A: 4.57e-09, B: 3.7720573734, C: 156137.47598479927},
+		{A: 4.01e-09, B: 5.28260651958, C: 15671.0817594066},
+		{A: 4.69e-09, B: 1.80963184268, C: 12562.6285816338},
+		{A: 5.08e-09, B: 3.36399024699, C: 20597.2439630412},
+		{A: 4.5e-09, B: 5.6605429925, C: 10454.5013866052},
+		{A: 3.75e-09, B: 4.98534633105, C: 9779.1086761254},
+		{A: 5.23e-09, B: 0.97215560834, C: 155427.542936241},
+		{A: 4.03e-09, B: 5.13939866506, C: 1551.045222648},
+		{A: 3.72e-09, B: 3.69883738807, C: 9388.0059094152},
+		{A: 3.67e-09, B: 4.43875659716, C: 4535.0594369244},
+		{A: 4.06e-09, B: 4.208631566, C: 12592.4500197826},
+		{A: 3.6e-09, B: 2.53924644657, C: 242.728603974},
+		{A: 4.71e-09, B: 4.61907324819, C: 5436.9930152402},
+		{A: 4.41e-09, B: 5.83872966262, C: 3496.032826134},
+		{A: 3.85e-09, B: 4.94496680973, C: 24356.7807886416},
+		{A: 3.49e-09, B: 6.15018231784, C: 19800.9459562248},
+		{A: 3.55e-09, B: 0.21895678106, C: 5429.8794682394},
+		{A: 3.44e-09, B: 5.62993724928, C: 2379.1644735716},
+		{A: 3.8e-09, B: 2.72105213143, C: 11933.3679606696},
+		{A: 4.32e-09, B: 0.24221790536, C: 17996.0311682222},
+		{A: 3.78e-09, B: 5.22517556974, C: 7477.522860216},
+		{A: 3.37e-09, B: 5.10888041439, C: 5849.3641121146},
+		{A: 3.15e-09, B: 0.57827745123, C: 10557.5941608238},
+		{A: 3.18e-09, B: 4.49953141399, C: 3634.6210245184},
+		{A: 3.23e-09, B: 1.54274281393, C: 10440.2742926036},
+		{A: 3.09e-09, B: 5.76839284397, C: 20.7753954924},
+		{A: 3.01e-09, B: 2.34727604008, C: 4686.8894077068},
+		{A: 4.14e-09, B: 5.9323760231, C: 51092.7260508548},
+		{A: 3.61e-09, B: 2.1639860955, C: 28237.2334593894},
+		{A: 2.88e-09, B: 0.18376252189, C: 13095.8426650774},
+		{A: 2.77e-09, B: 5.12952205045, C: 13119.72110282519},
+		{A: 3.27e-09, B: 6.19222146204, C: 6268.8487559898},
+		{A: 2.73e-09, B: 0.30522428863, C: 23141.5583829246},
+		{A: 2.67e-09, B: 5.76152585786, C: 5966.6839803348},
+		{A: 3.08e-09, B: 5.99280509979, C: 22805.7355659936},
+		{A: 3.45e-09, B: 2.92489919444, C: 36949.2308084242},
+		{A: 2.53e-09, B: 5.20995219509, C: 24072.9214697764},
+		{A: 3.42e-09, B: 5.72702586209, C: 16460.33352952499},
+		{A: 2.61e-09, B: 2.00304796059, C: 6148.010769956},
+		{A: 2.38e-09, B: 5.08264392839, C: 6915.8595893046},
+		{A: 2.49e-09, B: 2.94762789744, C: 135.0650800354},
+		{A: 3.06e-09, B: 3.89764686987, C: 10988.808157535},
+		{A: 3.05e-09, B: 0.05827812117, C: 4701.1165017084},
+		{A: 3.19e-09, B: 2.95712862064, C: 163096.18036118348},
+		{A: 2.09e-09, B: 4.43768461442, C: 6546.1597733642},
+		{A: 2.7e-09, B: 2.06643178717, C: 4804.209275927},
+		{A: 2.17e-09, B: 0.73691592312, C: 6303.8512454838},
+		{A: 2.06e-09, B: 0.32075959415, C: 25934.1243310894},
+		{A: 2.18e-09, B: 0.18428135264, C: 28286.9904848612},
+		{A: 2.05e-09, B: 5.21312087405, C: 20995.3929664494},
+		{A: 1.99e-09, B: 0.44384292491, C: 16737.5772365966},
+		{A: 2.3e-09, B: 6.06567392849, C: 6287.0080032545},
+		{A: 2.19e-09, B: 1.291942163, C: 5326.7866940208},
+		{A: 2.01e-09, B: 1.74700937253, C: 22743.4093795164},
+		{A: 2.07e-09, B: 4.45440927276, C: 6279.4854213396},
+		{A: 2.69e-09, B: 6.0564044503, C: 64471.99124174489},
+		{A: 1.9e-09, B: 0.99256176518, C: 29296.6153895786},
+		{A: 2.38e-09, B: 5.42471431221, C: 39609.6545831656},
+		{A: 2.62e-09, B: 5.26961924198, C: 522.5774180938},
+		{A: 2.1e-09, B: 4.68618183158, C: 6254.6266625236},
+		{A: 1.97e-09, B: 2.8062455408, C: 4933.2084403326},
+		{A: 2.52e-09, B: 4.36220154608, C: 40879.4405046438},
+		{A: 2.61e-09, B: 1.07241516738, C: 55022.9357470744},
+		{A: 1.89e-09, B: 3.82966734476, C: 419.4846438752},
+		{A: 1.85e-09, B: 4.14324541379, C: 5642.1982426092},
+		{A: 2.47e-09, B: 3.44855612987, C: 6702.5604938666},
+		{A: 2.05e-09, B: 4.04424043223, C: 536.8045120954},
+		{A: 1.91e-09, B: 3.14082686083, C: 16723.350142595},
+		{A: 2.22e-09, B: 5.16263907319, C: 23539.7073863328},
+		{A: 1.8e-09, B: 4.56214752149, C: 6489.2613984286},
+		{A: 2.19e-09, B: 0.80382553358, C: 16627.3709153772},
+		{A: 2.27e-09, B: 0.60156339452, C: 5905.7022420756},
+		{A: 1.68e-09, B: 0.88753528161, C: 16062.1845261168},
+		{A: 1.58e-09, B: 0.92127725775, C: 23937.856389741},
+		{A: 1.57e-09, B: 4.69607868164, C: 6805.6532680852},
+		{A: 2.07e-09, B: 4.88410451334, C: 6286.6662786432},
+		{A: 1.6e-09, B: 4.95943826846, C: 10021.8372800994},
+		{A: 1.66e-09, B: 0.97126433565, C: 3097.88382272579},
+		{A: 2.09e-09, B: 5.75663411805, C: 3646.3503773544},
+		{A: 1.75e-09, B: 6.12762824412, C: 239424.39025435288},
+		{A: 1.73e-09, B: 3.13887234973, C: 6179.9830757728},
+		{A: 1.57e-09, B: 3.62822058179, C: 18451.07854656599},
+		{A: 1.57e-09, B: 4.67695912235, C: 6709.6740408674},
+		{A: 1.46e-09, B: 3.09506069735, C: 4907.3020501456},
+		{A: 1.65e-09, B: 2.2713912876, C: 10660.6869350424},
+		{A: 2.01e-09, B: 1.67701267433, C: 2107.0345075424},
+		{A: 1.44e-09, B: 3.96947747592, C: 6019.9919266186},
+		{A: 1.71e-09, B: 5.91302216729, C: 6058.7310542895},
+		{A: 1.44e-09, B: 2.1315565512, C: 26084.0218062162},
+		{A: 1.51e-09, B: 0.67417383554, C: 2388.8940204492},
+		{A: 1.89e-09, B: 5.07122281033, C: 263.0839233728},
+		{A: 1.46e-09, B: 5.10373877968, C: 10770.8932562618},
+		{A: 1.87e-09, B: 1.23915444627, C: 19402.7969528166},
+		{A: 1.74e-09, B: 0.08407293391, C: 9380.9596727172},
+		{A: 1.37e-09, B: 1.26247412309, C: 12566.2190102856},
+		{A: 1.37e-09, B: 3.52826010842, C: 639.897286314},
+		{A: 1.48e-09, B: 1.76124372592, C: 5888.4499649322},
+		{A: 1.64e-09, B: 2.39195095081, C: 6357.8574485587},
+		{A: 1.46e-09, B: 2.43675816553, C: 5881.4037282342},
+		{A: 1.61e-09, B: 1.15721259372, C: 26735.9452622132},
+		{A: 1.31e-09, B: 2.51859277344, C: 6599.467719648},
+		{A: 1.53e-09, B: 5.85203687779, C: 6281.5913772831},
+		{A: 1.51e-09, B: 3.72338532649, C: 12669.2444742014},
+		{A: 1.32e-09, B: 2.38417741883, C: 6525.8044539654},
+		{A: 1.29e-09, B: 0.75556744143, C: 5017.508371365},
+		{A: 1.27e-09, B: 0.00254936441, C: 10027.9031957292},
+		{A: 1.48e-09, B: 2.85102145528, C: 6418.1409300268},
+		{A: 1.43e-09, B: 5.74460279367, C: 26087.9031415742},
+		{A: 1.72e-09, B: 0.4128996224, C: 174242.4659640497},
+		{A: 1.36e-09, B: 4.15497742275, C: 6311.5250374592},
+		{A: 1.7e-09, B: 5.98194913129, C: 327574.51427678124},
+		{A: 1.24e-09, B: 1.65497607604, C: 32217.2001810808},
+		{A: 1.36e-09, B: 2.48430783417, C: 13341.6743113068},
+		{A: 1.65e-09, B: 2.496679246, C: 58953.145443294},
+		{A: 1.23e-09, B: 3.45660563754, C: 6277.552925684},
+		{A: 1.17e-09, B: 0.86065134175, C: 6245.0481773556},
+		{A: 1.49e-09, B: 5.61358280963, C: 5729.506447149},
+		{A: 1.53e-09, B: 0.2686002995, C: 245.8316462294},
+		{A: 1.28e-09, B: 0.71204006588, C: 103.0927742186},
+		{A: 1.59e-09, B: 2.43166592149, C: 221995.02880149524},
+		{A: 1.3e-09, B: 2.80707316718, C: 6016.4688082696},
+		{A: 1.37e-09, B: 1.70657709294, C: 12566.08438968},
+		{A: 1.11e-09, B: 1.56305648432, C: 17782.7320727842},
+		{A: 1.13e-09, B: 3.58302904101, C: 25685.872802808},
+		{A: 1.09e-09, B: 3.26403795962, C: 6819.8803620868},
+		{A: 1.22e-09, B: 0.34120688217, C: 1162.4747044078},
+		{A: 1.19e-09, B: 5.84644718278, C: 12721.572099417},
+		{A: 1.44e-09, B: 2.28899679126, C: 12489.8856287072},
+		{A: 1.37e-09, B: 5.82029768354, C: 44809.6502008634},
+		{A: 1.07e-09, B: 2.4281854414, C: 5547.1993364596},
+		{A: 1.34e-09, B: 1.26539982939, C: 5331.3574437408},
+		{A: 1.03e-09, B: 5.96518130595, C: 6321.1035226272},
+		{A: 1.09e-09, B: 0.33808549034, C: 11300.5842213564},
+		{A: 1.29e-09, B: 5.89187277327, C: 12029.3471878874},
+		{A: 1.22e-09, B: 5.77325634636, C: 11919.140866668},
+		{A: 1.07e-09, B: 6.2499898935, C: 77690.75950573849},
+		{A: 1.07e-09, B: 1.00535580713, C: 77736.78343050249},
+		{A: 1.43e-09, B: 0.24122178432, C: 4214.0690150848},
+		{A: 1.43e-09, B: 0.88529649733, C: 7576.560073574},
+		{A: 1.07e-09, B: 2.92124030496, C: 31415.379249957},
+		{A: 9.9e-10, B: 5.70862227072, C: 5540.0857894588},
+		{A: 1.1e-09, B: 0.37528037383, C: 5863.5912061162},
+		{A: 1.04e-09, B: 4.44107178366, C: 2118.7638603784},
+		{A: 9.8e-10, B: 5.95877916706, C: 4061.2192153944},
+		{A: 1.13e-09, B: 1.24206857385, C: 84672.47584450469},
+		{A: 1.24e-09, B: 2.55619029867, C: 12539.853380183},
+		{A: 1.1e-09, B: 3.66952094329, C: 238004.5241572363},
+		{A: 1.12e-09, B: 4.32512422943, C: 97238.62754448749},
+		{A: 9.7e-10, B: 3.70151541181, C: 11720.0688652316},
+		{A: 1.2e-09, B: 1.26895630252, C: 12043.574281889},
+		{A: 9.4e-10, B: 2.56461130309, C: 19004.6479494084},
+		{A: 1.17e-09, B: 3.65425622684, C: 34520.3093093808},
+		{A: 9.8e-10, B: 0.13589994287, C: 11080.1715789176},
+		{A: 9.7e-10, B: 5.38330115253, C: 7834.1210726394},
+		{A: 9.7e-10, B: 2.46722096722, C: 71980.63357473118},
+		{A: 9.5e-10, B: 5.36958330451, C: 6288.5987742988},
+		{A: 1.11e-09, B: 5.01961920313, C: 11823.1616394502},
+		{A: 9e-10, B: 2.72299804525, C: 26880.3198130326},
+		{A: 9.9e-10, B: 0.90164266377, C: 18635.9284545362},
+		{A: 1.26e-09, B: 4.78722177847, C: 305281.9430710488},
+		{A: 9.3e-10, B: 0.21240380046, C: 18139.2945014159},
+		{A: 1.24e-09, B: 5.00979495566, C: 172146.9713405403},
+		{A: 9.9e-10, B: 5.67090026475, C: 16522.6597160022},
+		{A: 9.2e-10, B: 2.28180963676, C: 12491.3701014155},
+		{A: 9e-10, B: 4.50544881196, C: 40077.61957352},
+		{A: 1e-09, B: 2.00639461612, C: 12323.4230960088},
+		{A: 9.5e-10, B: 5.68801979087, C: 14919.0178537546},
+		{A: 8.7e-10, B: 1.86043406047, C: 27707.5424942948},
+		{A: 1.05e-09, B: 3.02903468417, C: 22345.2603761082},
+		{A: 8.7e-10, B: 5.43970168638, C: 6272.0301497275},
+		{A: 8.9e-10, B: 1.63389387182, C: 33326.5787331742},
+		{A: 8.2e-10, B: 5.58298993353, C: 10241.2022911672},
+		{A: 9.4e-10, B: 5.47749711149, C: 9924.8104215106},
+		{A: 8.2e-10, B: 4.71988314145, C: 15141.390794312},
+		{A: 9.7e-10, B: 5.61458778738, C: 2787.0430238574},
+		{A: 9.6e-10, B: 3.89073946348, C: 6379.0550772092},
+		{A: 8.1e-10, B: 3.13038482444, C: 36147.4098773004},
+		{A: 1.1e-09, B: 4.89978492291, C: 72140.6286666874},
+		{A: 9.7e-10, B: 5.20764563059, C: 6303.4311693902},
+		{A: 8.2e-10, B: 5.26342716139, C: 9814.6041002912},
+		{A: 1.09e-09, B: 2.3555558977, C: 83286.91426955358},
+		{A: 9.7e-10, B: 2.58492958057, C: 30666.1549584328},
+		{A: 9.3e-10, B: 1.32651591333, C: 23020.65308658799},
+		{A: 7.8e-10, B: 3.99588630754, C: 11293.4706743556},
+		{A: 9e-10, B: 0.57771932738, C: 26482.1708096244},
+		{A: 1.06e-09, B: 3.92012705073, C: 62883.3551395136},
+		{A: 9.8e-10, B: 2.94397773524, C: 316.3918696566},
+		{A: 7.6e-10, B: 3.96310417608, C: 29026.48522950779},
+		{A: 7.8e-10, B: 1.97068529306, C: 90279.92316810328},
+		{A: 7.6e-10, B: 0.23027966596, C: 21424.4666443034},
+		{A: 8e-10, B: 2.23099742212, C: 266.6070417218},
+		{A: 7.9e-10, B: 1.46227790922, C: 8982.810669309},
+		{A: 1.02e-09, B: 4.92129953565, C: 5621.8429232104},
+		{A: 1e-09, B: 0.39243148321, C: 24279.10701821359},
+		{A: 7.1e-10, B: 1.52014858474, C: 33794.5437235286},
+		{A: 7.6e-10, B: 0.22880641443, C: 57375.8019008462},
+		{A: 9.1e-10, B: 0.96515913904, C: 48739.859897083},
+		{A: 7.5e-10, B: 2.77638585157, C: 12964.300703391},
+		{A: 7.7e-10, B: 5.18846946344, C: 11520.9968637952},
+		{A: 6.8e-10, B: 0.50006599129, C: 4274.5183108324},
+		{A: 7.5e-10, B: 2.07323762803, C: 15664.03552270859},
+		{A: 7.4e-10, B: 1.01884134928, C: 6393.2821712108},
+		{A: 7.7e-10, B: 0.4666517878, C: 16207.886271502},
+		{A: 8.1e-10, B: 4.10452219483, C: 161710.6187862324},
+		{A: 6.7e-10, B: 3.83840630887, C: 6262.7205305926},
+		{A: 7.1e-10, B: 3.91415523291, C: 7875.6718636242},
+		{A: 8.1e-10, B: 0.91938383237, C: 74.7815985673},
+		{A: 8.3e-10, B: 4.69916218791, C: 23006.42599258639},
+		{A: 6.3e-10, B: 2.32556465878, C: 6279.1945146334},
+		{A: 6.5e-10, B: 5.41938745446, C: 28628.3362260996},
+		{A: 6.5e-10, B: 3.02336771694, C: 5959.570433334},
+		{A: 6.4e-10, B: 3.3103319837, C: 2636.725472637},
+		{A: 6.4e-10, B: 0.18375587519, C: 1066.49547719},
+		{A: 8e-10, B: 5.81239171612, C: 12341.8069042809},
+		{A: 6.6e-10, B: 2.15105504851, C: 38.0276726358},
+		{A: 6.2e-10, B: 2.43313614978, C: 10138.1095169486},
+		{A: 6e-10, B: 3.1615390647, C: 5490.300961524},
+		{A: 6.9e-10, B: 0.30764736334, C: 7018.9523635232},
+		{A: 6.8e-10, B: 2.24442548639, C: 24383.0791084414},
+		{A: 7.8e-10, B: 1.39649386463, C: 9411.4646150872},
+		{A: 6.3e-10, B: 0.72976362625, C: 6286.9571853494},
+		{A: 7.3e-10, B: 4.95125917731, C: 6453.7487206106},
+		{A: 7.8e-10, B: 0.32736023459, C: 6528.9074962208},
+		{A: 5.9e-10, B: 4.95362151577, C: 35707.7100829074},
+		{A: 7e-10, B: 2.37962727525, C: 15508.6151232744},
+		{A: 7.3e-10, B: 1.35229143111, C: 5327.4761083828},
+		{A: 7.2e-10, B: 5.91833527334, C: 10881.0995774812},
+		{A: 5.9e-10, B: 5.36231868425, C: 10239.5838660108},
+		{A: 5.9e-10, B: 1.63156134967, C: 61306.0115970658},
+		{A: 5.4e-10, B: 4.29491690425, C: 21947.1113727},
+		{A: 5.7e-10, B: 5.89190132575, C: 34513.2630726828},
+		{A: 7.4e-10, B: 1.38235845304, C: 9967.4538999816},
+		{A: 5.3e-10, B: 3.86543309344, C: 32370.9789915656},
+		{A: 5.5e-10, B: 4.51794544854, C: 34911.412076091},
+		{A: 6.3e-10, B: 5.41479412056, C: 11502.8376165305},
+		{A: 6.3e-10, B: 2.34416220742, C: 11510.7019230567},
+		{A: 6.8e-10, B: 0.77493931112, C: 29864.334027309},
+		{A: 6e-10, B: 5.57024703495, C: 5756.9080032458},
+		{A: 7.2e-10, B: 2.80863088166, C: 10866.8724834796},
+		{A: 6.1e-10, B: 2.69736991384, C: 82576.9812209953},
+		{A: 6.3e-10, B: 5.32068807257, C: 3116.6594122598},
+		{A: 5.2e-10, B: 1.02278758099, C: 6272.4391846416},
+		{A: 6.9e-10, B: 5.00698550308, C: 25287.7237993998},
+		{A: 6.6e-10, B: 6.12047940728, C: 12074.488407524},
+		{A: 5.1e-10, B: 2.59519527563, C: 11396.5634485742},
+		{A: 5.6e-10, B: 2.57995973521, C: 17892.93839400359},
+		{A: 5.9e-10, B: 0.4416723762, C: 250570.6758572191},
+		{A: 5.9e-10, B: 3.84070143543, C: 5483.254724826},
+		{A: 4.9e-10, B: 0.54704693048, C: 22594.05489571199},
+		{A: 6.5e-10, B: 2.38423614501, C: 52670.0695933026},
+		{A: 6.9e-10, B: 5.34363738671, C: 66813.5648357332},
+		{A: 5.7e-10, B: 5.42770501007, C: 310145.1528239236},
+		{A: 5.3e-10, B: 1.17760296075, C: 149.5631971346},
+		{A: 6.1e-10, B: 4.02090887211, C: 34596.3646546524},
+		{A: 4.9e-10, B: 4.18361320516, C: 18606.4989460002},
+		{A: 5.5e-10, B: 0.83886167974, C: 20452.8694122218},
+		{A: 5e-10, B: 1.46327331958, C: 37455.7264959744},
+		{A: 4.8e-10, B: 4.53854727167, C: 29822.7832363242},
+		{A: 5.8e-10, B: 3.34847975377, C: 33990.6183442862},
+		{A: 6.5e-10, B: 1.45522693982, C: 76251.32777062019},
+		{A: 5.6e-10, B: 2.35650663692, C: 37724.7534197482},
+		{A: 5.2e-10, B: 2.61551081496, C: 5999.2165311262},
+		{A: 5.3e-10, B: 0.17334326094, C: 77717.29458646949},
+		{A: 5.3e-10, B: 0.79879700631, C: 77710.24834977149},
+		{A: 4.7e-10, B: 0.43240779709, C: 735.8765135318},
+		{A: 5.3e-10, B: 4.58763261686, C: 11616.976091013},
+		{A: 4.8e-10, B: 6.20230111054, C: 4171.4255366138},
+		{A: 5.2e-10, B: 1.09723616404, C: 640.8776073822},
+		{A: 5.7e-10, B: 3.42008310383, C: 50317.2034395308},
+		{A: 5.3e-10, B: 1.01528448581, C: 149144.46708624958},
+		{A: 4.7e-10, B: 3.00924906195, C: 52175.8062831484},
+		{A: 5.2e-10, B: 2.03254070404, C: 6293.7125153412},
+		{A: 4.8e-10, B: 0.12356889734, C: 13362.4497067992},
+		{A: 4.5e-10, B: 3.37963782356, C: 10763.779709261},
+		{A: 4.7e-10, B: 5.50981287869, C: 12779.4507954208},
+		{A: 6.2e-10, B: 5.45209070099, C: 949.1756089698},
+		{A: 6.1e-10, B: 2.93237974631, C: 5791.4125575326},
+		{A: 4.4e-10, B: 2.87440620802, C: 8584.6616659008},
+		{A: 4.6e-10, B: 4.0314179656, C: 10667.8004820432},
+		{A: 4.7e-10, B: 3.89902931422, C: 3903.9113764198},
+		{A: 4.6e-10, B: 2.75700467329, C: 6993.0088985497},
+		{A: 4.5e-10, B: 1.933862933, C: 206.1855484372},
+		{A: 4.7e-10, B: 2.57670800912, C: 11492.542675792},
+		{A: 4.4e-10, B: 3.62570223167, C: 63658.8777508376},
+		{A: 5.1e-10, B: 0.84536826273, C: 12345.739057544},
+		{A: 4.3e-10, B: 0.01524970172, C: 37853.8754993826},
+		{A: 4.1e-10, B: 3.27146326065, C: 8858.3149443206},
+		{A: 4.5e-10, B: 3.03765521215, C: 65236.2212932854},
+		{A: 4.7e-10, B: 1.44447548944, C: 21393.5419698576},
+		{A: 5.8e-10, B: 5.45843180927, C: 1975.492545856},
+		{A: 5e-10, B: 2.13285524146, C: 12573.2652469836},
+		{A: 4.1e-10, B: 1.32190847146, C: 2547.8375382324},
+		{A: 4.7e-10, B: 3.67579608544, C: 28313.288804661},
+		{A: 4.1e-10, B: 2.24013475126, C: 8273.8208670324},
+		{A: 4.7e-10, B: 6.21438985953, C: 10991.3058987006},
+		{A: 4.2e-10, B: 3.0163181735, C: 853.196381752},
+		{A: 5.6e-10, B: 1.09773690181, C: 77376.2010224076},
+		{A: 4e-10, B: 2.35698541041, C: 2699.7348193176},
+		{A: 4.3e-10, B: 5.28030898459, C: 17796.9591667858},
+		{A: 5.4e-10, B: 2.59175932091, C: 22910.44676536859},
+		{A: 5.4e-10, B: 0.88027764102, C: 71960.38658322369},
+		{A: 5.5e-10, B: 0.07988899477, C: 83467.15635301729},
+		{A: 3.9e-10, B: 1.12867321442, C: 9910.583327509},
+		{A: 4e-10, B: 1.35670430524, C: 27177.8515292002},
+		{A: 3.9e-10, B: 4.39624220245, C: 5618.3198048614},
+		{A: 4.2e-10, B: 4.78798367468, C: 7856.89627409019},
+		{A: 4.7e-10, B: 2.75482175292, C: 18202.21671665939},
+		{A: 3.9e-10, B: 1.97008298629, C: 24491.4257925834},
+		{A: 4.2e-10, B: 4.04346599946, C: 7863.9425107882},
+		{A: 3.8e-10, B: 0.49178679251, C: 38650.173506199},
+		{A: 3.6e-10, B: 4.86047906533, C: 4157.1984426122},
+		{A: 4.3e-10, B: 5.64354880978, C: 1062.9050485382},
+		{A: 3.6e-10, B: 3.98066313627, C: 12565.1713789146},
+		{A: 4.2e-10, B: 2.30753932657, C: 6549.6828917132},
+		{A: 4e-10, B: 5.3969491832, C: 9498.2122306346},
+		{A: 4e-10, B: 3.30603243754, C: 23536.11695768099},
+		{A: 5e-10, B: 6.15760345261, C: 78051.34191383338},
+	},
+	// R1, 292 terms
+	{
+		{A: 0.00103018608, B: 1.10748969588, C: 6283.0758499914},
+		{A: 1.721238e-05, B: 1.06442301418, C: 12566.1516999828},
+		{A: 7.02215e-06, B: 3.14159265359, C: 0},
+		{A: 3.2346e-07, B: 1.02169059149, C: 18849.2275499742},
+		{A: 3.0799e-07, B: 2.84353804832, C: 5507.5532386674},
+		{A: 2.4971e-07, B: 1.31906709482, C: 5223.6939198022},
+		{A: 1.8485e-07, B: 1.42429748614, C: 1577.3435424478},
+		{A: 1.0078e-07, B: 5.91378194648, C: 10977.078804699},
+		{A: 8.634e-08, B: 0.27146150602, C: 5486.777843175},
+		{A: 8.654e-08, B: 1.42046854427, C: 6275.9623029906},
+		{A: 5.069e-08, B: 1.68613426734, C: 5088.6288397668},
+		{A: 4.985e-08, B: 6.01401770704, C: 6286.5989683404},
+		{A: 4.669e-08, B: 5.98724494073, C: 529.6909650946},
+		{A: 4.395e-08, B: 0.51800238019, C: 4694.0029547076},
+		{A: 3.872e-08, B: 4.74969833437, C: 2544.3144198834},
+		{A: 3.75e-08, B: 5.07097685568, C: 796.2980068164},
+		{A: 4.1e-08, B: 1.08424786092, C: 9437.762934887},
+		{A: 3.518e-08, B: 0.02290216272, C: 83996.84731811189},
+		{A: 3.436e-08, B: 0.94937019624, C: 71430.69561812909},
+		{A: 3.221e-08, B: 6.15628775313, C: 2146.1654164752},
+		{A: 3.414e-08, B: 5.41218322538, C: 775.522611324},
+		{A: 2.863e-08, B: 5.48432847146, C: 10447.3878396044},
+		{A: 2.52e-08, B: 0.24276941146, C: 398.1490034082},
+		{A: 2.201e-08, B: 4.95216196651, C: 6812.766815086},
+		{A: 2.186e-08, B: 0.41991743105, C: 8031.0922630584},
+		{A: 2.838e-08, B: 3.42034351366, C: 2352.8661537718},
+		{A: 2.554e-08, B: 6.13241878525, C: 6438.4962494256},
+		{A: 1.932e-08, B: 5.31374608366, C: 8429.2412664666},
+		{A: 2.429e-08, B: 3.09164528262, C: 4690.4798363586},
+		{A: 1.73e-08, B: 1.5368620855, C: 4705.7323075436},
+		{A: 2.25e-08, B: 3.68863633842, C: 7084.8967811152},
+		{A: 2.093e-08, B: 1.28191783032, C: 1748.016413067},
+		{A: 1.441e-08, B: 0.81656250862, C: 14143.4952424306},
+		{A: 1.483e-08, B: 3.22225357771, C: 7234.794256242},
+		{A: 1.754e-08, B: 3.22883705112, C: 6279.5527316424},
+		{A: 1.583e-08, B: 4.09702349428, C: 11499.6562227928},
+		{A: 1.575e-08, B: 5.53890170575, C: 3154.6870848956},
+		{A: 1.847e-08, B: 1.82040335363, C: 7632.9432596502},
+		{A: 1.504e-08, B: 3.63293385726, C: 11513.8833167944},
+		{A: 1.337e-08, B: 4.64440864339, C: 6836.6452528338},
+		{A: 1.275e-08, B: 2.69341415363, C: 1349.8674096588},
+		{A: 1.352e-08, B: 6.15101580257, C: 5746.271337896},
+		{A: 1.125e-08, B: 3.35673439497, C: 17789.845619785},
+		{A: 1.47e-08, B: 3.65282991755, C: 1194.4470102246},
+		{A: 1.177e-08, B: 2.57676109092, C: 13367.9726311066},
+		{A: 1.101e-08, B: 4.49748696552, C: 4292.3308329504},
+		{A: 1.234e-08, B: 5.65036509521, C: 5760.4984318976},
+		{A: 9.84e-09, B: 0.65517395136, C: 5856.4776591154},
+		{A: 9.28e-09, B: 2.32420318751, C: 10213.285546211},
+		{A: 1.077e-08, B: 5.82812169132, C: 12036.4607348882},
+		{A: 9.16e-09, B: 0.76613009583, C: 16730.4636895958},
+		{A: 8.77e-09, B: 1.50137505051, C: 11926.2544136688},
+		{A: 1.023e-08, B: 5.62076589825, C: 6256.7775301916},
+		{A: 8.51e-09, B: 0.65709335533, C: 155.4203994342},
+		{A: 8.02e-09, B: 4.10519132088, C: 951.7184062506},
+		{A: 8.57e-09, B: 1.41661697538, C: 5753.3848848968},
+		{A: 9.94e-09, B: 1.14418521187, C: 1059.3819301892},
+		{A: 8.13e-09, B: 1.63948433322, C: 6681.2248533996},
+		{A: 6.62e-09, B: 4.5520045226, C: 5216.5803728014},
+		{A: 6.44e-09, B: 4.19478168733, C: 6040.3472460174},
+		{A: 6.26e-09, B: 1.50767713598, C: 5643.1785636774},
+		{A: 5.9e-09, B: 6.18277145205, C: 4164.311989613},
+		{A: 6.35e-09, B: 0.52413263542, C: 6290.1893969922},
+		{A: 6.5e-09, B: 0.9793569035, C: 25132.3033999656},
+		{A: 5.68e-09, B: 2.30125315873, C: 10973.55568635},
+		{A: 5.47e-09, B: 5.27256412213, C: 3340.6124266998},
+		{A: 5.47e-09, B: 2.20144422886, C: 1592.5960136328},
+		{A: 5.26e-09, B: 0.92464258226, C: 11371.7046897582},
+		{A: 4.9e-09, B: 5.90951388655, C: 3894.1818295422},
+		{A: 4.78e-09, B: 1.66857963179, C: 12168.0026965746},
+		{A: 5.16e-09, B: 3.59803483887, C: 10969.9652576982},
+		{A: 5.18e-09, B: 3.97914412373, C: 17298.1823273262},
+		{A: 5.34e-09, B: 5.03740926442, C: 9917.6968745098},
+		{A: 4.87e-09, B: 2.50545369269, C: 6127.6554505572},
+		{A: 4.16e-09, B: 4.04828175503, C: 10984.1923516998},
+		{A: 5.38e-09, B: 5.54081539805, C: 553.5694028424},
+		{A: 4.02e-09, B: 2.16544019233, C: 7860.4193924392},
+		{A: 5.53e-09, B: 2.32177369366, C: 11506.7697697936},
+		{A: 3.67e-09, B: 3.3915253225, C: 6496.3749454294},
+		{A: 3.6e-09, B: 5.34379853282, C: 7079.3738568078},
+		{A: 3.37e-09, B: 3.61563704045, C: 11790.6290886588},
+		{A: 4.56e-09, B: 0.30754294809, C: 801.8209311238},
+		{A: 4.17e-09, B: 3.70009308674, C: 10575.4066829418},
+		{A: 3.81e-09, B: 5.82033971802, C: 7058.5984613154},
+		{A: 3.21e-09, B: 0.31988767355, C: 16200.7727245012},
+		{A: 3.64e-09, B: 1.08414306177, C: 6309.3741697912},
+		{A: 2.94e-09, B: 4.54798604957, C: 11856.2186514245},
+		{A: 2.9e-09, B: 1.26473978562, C: 8635.9420037632},
+		{A: 3.99e-09, B: 4.16998866302, C: 26.2983197998},
+		{A: 2.62e-09, B: 5.08316906342, C: 10177.2576795336},
+		{A: 2.43e-09, B: 2.2574609119, C: 11712.9553182308},
+		{A: 2.37e-09, B: 1.05070575346, C: 242.728603974},
+		{A: 2.75e-09, B: 3.45319481756, C: 5884.9268465832},
+		{A: 2.55e-09, B: 5.38496831087, C: 21228.3920235458},
+		{A: 3.07e-09, B: 4.24313526604, C: 3738.761430108},
+		{A: 2.16e-09, B: 3.46037894728, C: 213.299095438},
+		{A: 1.96e-09, B: 0.69029243914, C: 1990.745017041},
+		{A: 1.98e-09, B: 5.16301829964, C: 12352.8526045448},
+		{A: 2.14e-09, B: 3.91876200279, C: 13916.0191096416},
+		{A: 2.12e-09, B: 4.00861198517, C: 5230.807466803},
+		{A: 1.84e-09, B: 5.59805976614, C: 6283.14316029419},
+		{A: 1.84e-09, B: 2.85275392124, C: 7238.6755916},
+		{A: 1.79e-09, B: 2.54259058334, C: 14314.1681130498},
+		{A: 2.25e-09, B: 1.64458698399, C: 4732.0306273434},
+		{A: 2.36e-09, B: 5.58826125715, C: 6069.7767545534},
+		{A: 1.87e-09, B: 2.72805985443, C: 6062.6632075526},
+		{A: 1.84e-09, B: 6.04216273598, C: 6283.0085396886},
+		{A: 2.3e-09, B: 3.62591335086, C: 6284.0561710596},
+		{A: 1.63e-09, B: 2.19117396803, C: 18073.7049386502},
+		{A: 1.72e-09, B: 0.9761295074, C: 3930.2096962196},
+		{A: 2.15e-09, B: 1.04672844028, C: 3496.032826134},
+		{A: 1.69e-09, B: 4.75084479006, C: 17267.26820169119},
+		{A: 1.52e-09, B: 0.19390712179, C: 9779.1086761254},
+		{A: 1.82e-09, B: 5.16288118255, C: 17253.04110768959},
+		{A: 1.49e-09, B: 0.8094418426, C: 709.9330485583},
+		{A: 1.63e-09, B: 2.1920957039, C: 6076.8903015542},
+		{A: 1.86e-09, B: 5.01159497089, C: 11015.1064773348},
+		{A: 1.34e-09, B: 0.97765485759, C: 65147.6197681377},
+		{A: 1.41e-09, B: 4.38421981312, C: 4136.9104335162},
+		{A: 1.58e-09, B: 4.60974280627, C: 9623.6882766912},
+		{A: 1.33e-09, B: 3.30508592837, C: 154717.6098876827},
+		{A: 1.63e-09, B: 6.11782626245, C: 3.523118349},
+		{A: 1.74e-09, B: 1.58078542187, C: 7.1135470008},
+		{A: 1.41e-09, B: 0.49976927274, C: 25158.6017197654},
+		{A: 1.24e-09, B: 6.03440460031, C: 9225.539273283},
+		{A: 1.5e-09, B: 5.30166336812, C: 13517.8701062334},
+		{A: 1.27e-09, B: 1.92389511438, C: 22483.84857449259},
+		{A: 1.21e-09, B: 2.37813129011, C: 167283.7615876655},
+		{A: 1.2e-09, B: 3.98423684853, C: 4686.8894077068},
+		{A: 1.17e-09, B: 5.81072642211, C: 12569.6748183318},
+		{A: 1.22e-09, B: 5.60973054224, C: 5642.1982426092},
+		{A: 1.57e-09, B: 3.40236426002, C: 16496.3613962024},
+		{A: 1.29e-09, B: 2.10705116371, C: 1589.0728952838},
+		{A: 1.16e-09, B: 0.55839966736, C: 5849.3641121146},
+		{A: 1.23e-09, B: 1.52961392771, C: 12559.038152982},
+		{A: 1.11e-09, B: 0.44848279675, C: 6172.869528772},
+		{A: 1.23e-09, B: 5.81645568991, C: 6282.0955289232},
+		{A: 1.5e-09, B: 4.26278409223, C: 3128.3887650958},
+		{A: 1.06e-09, B: 2.27437761356, C: 5429.8794682394},
+		{A: 1.04e-09, B: 4.42743707728, C: 23543.23050468179},
+		{A: 1.21e-09, B: 0.39459045915, C: 12132.439962106},
+		{A: 1.04e-09, B: 2.41842602527, C: 426.598190876},
+		{A: 1.1e-09, B: 5.80381480447, C: 16858.4825329332},
+		{A: 1e-09, B: 2.93805577485, C: 4535.0594369244},
+		{A: 9.7e-10, B: 3.97935904984, C: 6133.5126528568},
+		{A: 1.1e-09, B: 6.22339014386, C: 12146.6670561076},
+		{A: 9.8e-10, B: 0.87576563709, C: 6525.8044539654},
+		{A: 9.8e-10, B: 3.15248421301, C: 10440.2742926036},
+		{A: 9.5e-10, B: 2.461684111, C: 3097.88382272579},
+		{A: 8.8e-10, B: 0.23371480284, C: 13119.72110282519},
+		{A: 9.8e-10, B: 5.77016493489, C: 7342.4577801806},
+		{A: 9.2e-10, B: 6.03915555063, C: 20426.571092422},
+		{A: 9.6e-10, B: 5.56909292561, C: 2388.8940204492},
+		{A: 8.1e-10, B: 1.32131147691, C: 5650.2921106782},
+		{A: 8.6e-10, B: 3.94529200528, C: 10454.5013866052},
+		{A: 7.6e-10, B: 2.70729716925, C: 143571.32428481648},
+		{A: 9.1e-10, B: 5.64100034152, C: 8827.3902698748},
+		{A: 7.6e-10, B: 1.80783856698, C: 28286.9904848612},
+		{A: 8.1e-10, B: 1.90858992196, C: 29088.811415985},
+		{A: 7.5e-10, B: 3.40955892978, C: 5481.2549188676},
+		{A: 6.9e-10, B: 4.49936170873, C: 17256.6315363414},
+		{A: 8.8e-10, B: 1.10098454357, C: 11769.8536931664},
+		{A: 6.6e-10, B: 2.78285801977, C: 536.8045120954},
+		{A: 6.8e-10, B: 3.88179770758, C: 17260.1546546904},
+		{A: 8.4e-10, B: 1.59303306354, C: 9380.9596727172},
+		{A: 8.8e-10, B: 3.88076636762, C: 7477.522860216},
+		{A: 6.1e-10, B: 6.17558202197, C: 11087.2851259184},
+		{A: 6e-10, B: 4.34824715818, C: 6206.8097787158},
+		{A: 8.2e-10, B: 4.59843208943, C: 9388.0059094152},
+		{A: 7.9e-10, B: 1.63131230601, C: 4933.2084403326},
+		{A: 7.8e-10, B: 4.20905757484, C: 5729.506447149},
+		{A: 5.7e-10, B: 5.48157926651, C: 18319.5365848796},
+		{A: 6e-10, B: 1.01261781084, C: 12721.572099417},
+		{A: 5.6e-10, B: 1.63031935692, C: 15720.8387848784},
+		{A: 5.5e-10, B: 0.24926735018, C: 15110.4661198662},
+		{A: 6.1e-10, B: 5.93059279661, C: 12539.853380183},
+		{A: 5.5e-10, B: 4.84298966314, C: 13095.8426650774},
+		{A: 6.7e-10, B: 6.11690589247, C: 8662.240323563},
+		{A: 5.4e-10, B: 5.73750638571, C: 3634.6210245184},
+		{A: 7.4e-10, B: 1.05466745829, C: 16460.33352952499},
+		{A: 5.3e-10, B: 2.29084335688, C: 16062.1845261168},
+		{A: 6.4e-10, B: 2.13513767927, C: 7875.6718636242},
+		{A: 6.7e-10, B: 0.07096807518, C: 14945.3161735544},
+		{A: 5.1e-10, B: 2.31511194429, C: 6262.7205305926},
+		{A: 5.7e-10, B: 5.77055471237, C: 12043.574281889},
+		{A: 5.6e-10, B: 4.41980790431, C: 4701.1165017084},
+		{A: 5.9e-10, B: 5.87963500073, C: 5331.3574437408},
+		{A: 5.8e-10, B: 2.30546168628, C: 955.5997416086},
+		{A: 4.9e-10, B: 1.93839278478, C: 5333.9002410216},
+		{A: 4.8e-10, B: 2.69973662261, C: 6709.6740408674},
+		{A: 6.4e-10, B: 1.64379897981, C: 6262.300454499},
+		{A: 4.6e-10, B: 3.98449608961, C: 98068.53671630539},
+		{A: 5e-10, B: 3.68875893005, C: 12323.4230960088},
+		{A: 4.5e-10, B: 3.30068569697, C: 22003.9146348698},
+		{A: 4.7e-10, B: 1.26317154881, C: 11919.140866668},
+		{A: 4.5e-10, B: 0.89150445122, C: 51868.2486621788},
+		{A: 4.3e-10, B: 1.61526242998, C: 6277.552925684},
+		{A: 4.3e-10, B: 5.74295325645, C: 11403.676995575},
+		{A: 4.4e-10, B: 3.43070646822, C: 10021.8372800994},
+		{A: 5.6e-10, B: 0.02481833774, C: 15671.0817594066},
+		{A: 5.5e-10, B: 3.14274403422, C: 33019.0211122046},
+		{A: 4.5e-10, B: 3.00877289177, C: 8982.810669309},
+		{A: 4.6e-10, B: 0.73303568429, C: 6303.4311693902},
+		{A: 4.9e-10, B: 1.60455690285, C: 6303.8512454838},
+		{A: 4.5e-10, B: 0.40210030323, C: 6805.6532680852},
+		{A: 5.3e-10, B: 0.94869680175, C: 10988.808157535},
+		{A: 4.1e-10, B: 1.61122384329, C: 6819.8803620868},
+		{A: 5.5e-10, B: 0.89439119424, C: 11933.3679606696},
+		{A: 4.5e-10, B: 3.88495384656, C: 60530.4889857418},
+		{A: 4e-10, B: 4.75740908001, C: 38526.574350872},
+		{A: 4e-10, B: 1.49921251887, C: 18451.07854656599},
+		{A: 4e-10, B: 3.77498297228, C: 26087.9031415742},
+		{A: 5.1e-10, B: 1.70258603562, C: 1551.045222648},
+		{A: 3.9e-10, B: 2.97100699926, C: 2118.7638603784},
+		{A: 5.3e-10, B: 5.19854123078, C: 77713.7714681205},
+		{A: 4.7e-10, B: 4.26356628717, C: 21424.4666443034},
+		{A: 3.7e-10, B: 0.62902722802, C: 24356.7807886416},
+		{A: 3.6e-10, B: 0.11087914947, C: 10344.2950653858},
+		{A: 3.6e-10, B: 0.77037556319, C: 12029.3471878874},
+		{A: 3.5e-10, B: 3.30933994515, C: 24072.9214697764},
+		{A: 3.5e-10, B: 5.93650887012, C: 31570.7996493912},
+		{A: 3.6e-10, B: 2.15108874765, C: 30774.5016425748},
+		{A: 3.6e-10, B: 1.75078825382, C: 16207.886271502},
+		{A: 3.3e-10, B: 5.06264177921, C: 226858.23855437007},
+		{A: 3.4e-10, B: 6.168913788, C: 24491.4257925834},
+		{A: 3.5e-10, B: 3.19120695549, C: 32217.2001810808},
+		{A: 3.4e-10, B: 2.31528650443, C: 55798.4583583984},
+		{A: 3.2e-10, B: 4.21446357042, C: 15664.03552270859},
+		{A: 3.9e-10, B: 1.24979117796, C: 6418.1409300268},
+		{A: 3.7e-10, B: 4.1194365577, C: 2787.0430238574},
+		{A: 3.2e-10, B: 1.6288771089, C: 639.897286314},
+		{A: 3.8e-10, B: 5.89832942685, C: 640.8776073822},
+		{A: 3.2e-10, B: 1.72442327688, C: 27433.88921587499},
+		{A: 3.1e-10, B: 2.78828943753, C: 12139.5535091068},
+		{A: 3.5e-10, B: 4.44608896525, C: 18202.21671665939},
+		{A: 3.4e-10, B: 3.96287980676, C: 18216.443810661},
+		{A: 3.3e-10, B: 4.73611335874, C: 16723.350142595},
+		{A: 3.4e-10, B: 1.43910280005, C: 49515.382508407},
+		{A: 3.1e-10, B: 0.23302920161, C: 23581.2581773176},
+		{A: 2.9e-10, B: 2.0263384022, C: 11609.8625440122},
+		{A: 3e-10, B: 2.5492323024, C: 9924.8104215106},
+		{A: 3.2e-10, B: 4.91793198558, C: 11300.5842213564},
+		{A: 2.8e-10, B: 0.26187189577, C: 13521.7514415914},
+		{A: 2.8e-10, B: 3.84568936822, C: 2699.7348193176},
+		{A: 2.9e-10, B: 1.83149729794, C: 29822.7832363242},
+		{A: 3.3e-10, B: 4.60320094415, C: 19004.6479494084},
+		{A: 2.7e-10, B: 4.46183450287, C: 6702.5604938666},
+		{A: 3e-10, B: 4.4649407224, C: 36147.4098773004},
+		{A: 2.7e-10, B: 0.03211931363, C: 6279.7894925736},
+		{A: 2.6e-10, B: 5.46497324333, C: 6245.0481773556},
+		{A: 3.5e-10, B: 4.52695674113, C: 36949.2308084242},
+		{A: 2.7e-10, B: 3.52528177609, C: 10770.8932562618},
+		{A: 2.6e-10, B: 1.48499438453, C: 11080.1715789176},
+		{A: 3.5e-10, B: 2.82154380962, C: 19402.7969528166},
+		{A: 2.5e-10, B: 2.46339998836, C: 6279.4854213396},
+		{A: 2.6e-10, B: 4.97688894643, C: 16737.5772365966},
+		{A: 2.6e-10, B: 2.36136541526, C: 17996.0311682222},
+		{A: 2.9e-10, B: 4.15148654061, C: 45892.73043315699},
+		{A: 2.6e-10, B: 4.50714272714, C: 17796.9591667858},
+		{A: 2.7e-10, B: 4.72625223674, C: 1066.49547719},
+		{A: 2.5e-10, B: 2.89309528854, C: 6286.6662786432},
+		{A: 2.7e-10, B: 0.37462444357, C: 12964.300703391},
+		{A: 2.9e-10, B: 4.94860010533, C: 5863.5912061162},
+		{A: 3.1e-10, B: 3.93096113577, C: 29864.334027309},
+		{A: 2.4e-10, B: 6.14987193584, C: 18606.4989460002},
+		{A: 2.4e-10, B: 3.74225964547, C: 29026.48522950779},
+		{A: 2.5e-10, B: 5.70460621565, C: 27707.5424942948},
+		{A: 2.5e-10, B: 5.33928840652, C: 15141.390794312},
+		{A: 2.7e-10, B: 3.0232089714, C: 6286.3622074092},
+		{A: 2.3e-10, B: 0.28364955406, C: 5327.4761083828},
+		{A: 2.6e-10, B: 1.34240461687, C: 18875.525869774},
+		{A: 2.4e-10, B: 1.33998410121, C: 19800.9459562248},
+		{A: 2.5e-10, B: 6.00172494004, C: 6489.2613984286},
+		{A: 2.2e-10, B: 1.81777974484, C: 6288.5987742988},
+		{A: 2.2e-10, B: 3.5860360664, C: 6915.8595893046},
+		{A: 2.9e-10, B: 2.09564449439, C: 15265.8865193004},
+		{A: 2.2e-10, B: 1.02173599251, C: 11925.2740926006},
+		{A: 2.2e-10, B: 4.74660932338, C: 28230.18722269139},
+		{A: 2.1e-10, B: 2.30688751432, C: 5999.2165311262},
+		{A: 2.1e-10, B: 3.2265494443, C: 25934.1243310894},
+		{A: 2.1e-10, B: 3.04956726238, C: 6566.9351688566},
+		{A: 2.7e-10, B: 5.35653084499, C: 33794.5437235286},
+		{A: 2.8e-10, B: 3.91168324815, C: 18208.349942592},
+		{A: 2e-10, B: 1.52296293311, C: 135.0650800354},
+		{A: 2.2e-10, B: 4.66462839521, C: 13362.4497067992},
+		{A: 1.9e-10, B: 1.78121167862, C: 156137.47598479927},
+		{A: 1.9e-10, B: 2.99969102221, C: 19651.048481098},
+		{A: 1.9e-10, B: 2.86664273362, C: 18422.62935909819},
+		{A: 2.5e-10, B: 0.94995632141, C: 31415.379249957},
+		{A: 1.9e-10, B: 4.71432851499, C: 77690.75950573849},
+		{A: 1.9e-10, B: 2.54227398241, C: 77736.78343050249},
+		{A: 2e-10, B: 5.91915117116, C: 48739.859897083},
+	},
+	// R2, 139 terms
+	{
+		{A: 4.359385e-05, B: 5.78455133738, C: 6283.0758499914},
+		{A: 1.23633e-06, B: 5.57934722157, C: 12566.1516999828},
+		{A: 1.2341e-07, B: 3.14159265359, C: 0},
+		{A: 8.792e-08, B: 3.62777733395, C: 77713.7714681205},
+		{A: 5.689e-08, B: 1.86958905084, C: 5573.1428014331},
+		{A: 3.301e-08, B: 5.47027913302, C: 18849.2275499742},
+		{A: 1.471e-08, B: 4.48028885617, C: 5507.5532386674},
+		{A: 1.013e-08, B: 2.81456417694, C: 5223.6939198022},
+		{A: 8.54e-09, B: 3.10878241236, C: 1577.3435424478},
+		{A: 1.102e-08, B: 2.84173992403, C: 161000.6857376741},
+		{A: 6.48e-09, B: 5.47349498544, C: 775.522611324},
+		{A: 6.09e-09, B: 1.37969434104, C: 6438.4962494256},
+		{A: 4.99e-09, B: 4.4164924225, C: 6286.5989683404},
+		{A: 4.17e-09, B: 0.90242451175, C: 10977.078804699},
+		{A: 4.02e-09, B: 3.2037658529, C: 5088.6288397668},
+		{A: 3.51e-09, B: 1.8107922777, C: 5486.777843175},
+		{A: 4.67e-09, B: 3.65753702738, C: 7084.8967811152},
+		{A: 4.58e-09, B: 5.38585314743, C: 149854.4001348079},
+		{A: 3.04e-09, B: 3.51701098693, C: 796.2980068164},
+		{A: 2.66e-09, B: 6.17413982699, C: 6836.6452528338},
+		{A: 2.79e-09, B: 1.84120501086, C: 4694.0029547076},
+		{A: 2.6e-09, B: 1.41629543251, C: 2146.1654164752},
+		{A: 2.66e-09, B: 3.13832905677, C: 71430.69561812909},
+		{A: 3.21e-09, B: 5.35313367048, C: 3154.6870848956},
+		{A: 2.38e-09, B: 2.17720020018, C: 155.4203994342},
+		{A: 2.93e-09, B: 4.61501268144, C: 4690.4798363586},
+		{A: 2.29e-09, B: 4.7596958807, C: 7234.794256242},
+		{A: 2.11e-09, B: 0.21868065485, C: 4705.7323075436},
+		{A: 2.01e-09, B: 4.21905743357, C: 1349.8674096588},
+		{A: 1.95e-09, B: 4.57808285364, C: 529.6909650946},
+		{A: 2.53e-09, B: 2.81496293039, C: 1748.016413067},
+		{A: 1.82e-09, B: 5.70454011389, C: 6040.3472460174},
+		{A: 1.79e-09, B: 6.02897097053, C: 4292.3308329504},
+		{A: 1.86e-09, B: 1.58690991244, C: 6309.3741697912},
+		{A: 1.7e-09, B: 2.90220009715, C: 9437.762934887},
+		{A: 1.66e-09, B: 1.99984925026, C: 8031.0922630584},
+		{A: 1.58e-09, B: 0.04783713552, C: 2544.3144198834},
+		{A: 1.97e-09, B: 2.01083639502, C: 1194.4470102246},
+		{A: 1.65e-09, B: 5.78372596778, C: 83996.84731811189},
+		{A: 2.14e-09, B: 3.38285934319, C: 7632.9432596502},
+		{A: 1.4e-09, B: 0.36401486094, C: 10447.3878396044},
+		{A: 1.51e-09, B: 0.95153163031, C: 6127.6554505572},
+		{A: 1.36e-09, B: 1.48426306582, C: 2352.8661537718},
+		{A: 1.27e-09, B: 5.48475435134, C: 951.7184062506},
+		{A: 1.26e-09, B: 5.26866506592, C: 6279.5527316424},
+		{A: 1.25e-09, B: 3.75754889288, C: 6812.766815086},
+		{A: 1.01e-09, B: 4.95015746147, C: 398.1490034082},
+		{A: 1.02e-09, B: 0.68468295277, C: 1592.5960136328},
+		{A: 1e-09, B: 1.14568935785, C: 3894.1818295422},
+		{A: 1.29e-09, B: 0.76540016965, C: 553.5694028424},
+		{A: 1.09e-09, B: 5.41063597567, C: 6256.7775301916},
+		{A: 7.5e-10, B: 5.84804322893, C: 242.728603974},
+		{A: 9.5e-10, B: 1.94452244083, C: 11856.2186514245},
+		{A: 7.7e-10, B: 0.69373708195, C: 8429.2412664666},
+		{A: 1e-09, B: 5.19725292131, C: 244287.60000722768},
+		{A: 8e-10, B: 6.18440483705, C: 1059.3819301892},
+		{A: 6.9e-10, B: 5.25699888595, C: 14143.4952424306},
+		{A: 8.5e-10, B: 5.39484725499, C: 25132.3033999656},
+		{A: 6.6e-10, B: 0.51779993906, C: 801.8209311238},
+		{A: 5.5e-10, B: 5.16878202461, C: 7058.5984613154},
+		{A: 5.1e-10, B: 3.88759155247, C: 12036.4607348882},
+		{A: 5e-10, B: 5.57636570536, C: 6290.1893969922},
+		{A: 6.1e-10, B: 2.24359003264, C: 8635.9420037632},
+		{A: 5e-10, B: 5.54441900966, C: 1990.745017041},
+		{A: 5.6e-10, B: 4.0030107804, C: 13367.9726311066},
+		{A: 5.2e-10, B: 4.13138898038, C: 7860.4193924392},
+		{A: 5.2e-10, B: 3.90943054011, C: 26.2983197998},
+		{A: 4.1e-10, B: 3.5712848278, C: 7079.3738568078},
+		{A: 5.6e-10, B: 2.76959005761, C: 90955.5516944961},
+		{A: 4.2e-10, B: 1.91461189199, C: 7477.522860216},
+		{A: 4.2e-10, B: 0.42728171713, C: 10213.285546211},
+		{A: 4.2e-10, B: 1.09413724455, C: 709.9330485583},
+		{A: 3.9e-10, B: 3.93298068961, C: 10973.55568635},
+		{A: 3.8e-10, B: 6.17935925345, C: 9917.6968745098},
+		{A: 4.9e-10, B: 0.83021145241, C: 11506.7697697936},
+		{A: 5.3e-10, B: 1.45828359397, C: 233141.3144043615},
+		{A: 4.7e-10, B: 6.21568666789, C: 6681.2248533996},
+		{A: 3.7e-10, B: 0.3635930998, C: 10177.2576795336},
+		{A: 3.5e-10, B: 3.33024911524, C: 5643.1785636774},
+		{A: 3.4e-10, B: 5.63446915337, C: 6525.8044539654},
+		{A: 3.5e-10, B: 5.36033855038, C: 25158.6017197654},
+		{A: 3.4e-10, B: 5.36319798321, C: 4933.2084403326},
+		{A: 3.3e-10, B: 4.24722336872, C: 12569.6748183318},
+		{A: 4.3e-10, B: 5.26370903404, C: 10575.4066829418},
+		{A: 4.2e-10, B: 5.08837645072, C: 11015.1064773348},
+		{A: 4e-10, B: 1.98334703186, C: 6284.0561710596},
+		{A: 4.2e-10, B: 4.22496037505, C: 88860.05707098669},
+		{A: 2.9e-10, B: 3.1908862817, C: 11926.2544136688},
+		{A: 2.9e-10, B: 0.15217616684, C: 12168.0026965746},
+		{A: 3e-10, B: 1.61904744136, C: 9779.1086761254},
+		{A: 2.7e-10, B: 0.76388991416, C: 1589.0728952838},
+		{A: 3.6e-10, B: 2.74712003443, C: 3738.761430108},
+		{A: 3.3e-10, B: 3.08807829566, C: 3930.2096962196},
+		{A: 3.1e-10, B: 5.34906619513, C: 143571.32428481648},
+		{A: 2.5e-10, B: 0.10240267494, C: 22483.84857449259},
+		{A: 3e-10, B: 3.47110495524, C: 14945.3161735544},
+		{A: 2.4e-10, B: 1.10425016019, C: 4535.0594369244},
+		{A: 2.4e-10, B: 1.5803725978, C: 6496.3749454294},
+		{A: 2.3e-10, B: 3.87710321433, C: 6275.9623029906},
+		{A: 2.5e-10, B: 3.9452977897, C: 3128.3887650958},
+		{A: 2.3e-10, B: 3.44685609601, C: 4136.9104335162},
+		{A: 2.3e-10, B: 3.83156029849, C: 5753.3848848968},
+		{A: 2.2e-10, B: 1.86956128067, C: 16730.4636895958},
+		{A: 2.5e-10, B: 2.42188933855, C: 5729.506447149},
+		{A: 2e-10, B: 1.78208352927, C: 17789.845619785},
+		{A: 2.1e-10, B: 4.303630874, C: 16858.4825329332},
+		{A: 2.1e-10, B: 0.49258939822, C: 29088.811415985},
+		{A: 2.5e-10, B: 1.33030250444, C: 6282.0955289232},
+		{A: 2.7e-10, B: 2.54785812264, C: 3496.032826134},
+		{A: 2.2e-10, B: 1.1123252195, C: 12721.572099417},
+		{A: 2.1e-10, B: 5.97759081637, C: 7.1135470008},
+		{A: 1.9e-10, B: 0.80292033311, C: 16062.1845261168},
+		{A: 2.3e-10, B: 4.12454848769, C: 2388.8940204492},
+		{A: 2.2e-10, B: 4.92663152168, C: 18875.525869774},
+		{A: 2.3e-10, B: 5.68902059771, C: 16460.33352952499},
+		{A: 2.3e-10, B: 4.97346265647, C: 17260.1546546904},
+		{A: 2.3e-10, B: 3.03021283729, C: 66567.48586525429},
+		{A: 1.6e-10, B: 3.89740925257, C: 5331.3574437408},
+		{A: 1.7e-10, B: 3.08268671348, C: 154717.6098876827},
+		{A: 1.6e-10, B: 3.95085099736, C: 3097.88382272579},
+		{A: 1.6e-10, B: 3.99041783945, C: 6283.14316029419},
+		{A: 2e-10, B: 6.10644140189, C: 167283.7615876655},
+		{A: 1.5e-10, B: 4.09775914607, C: 11712.9553182308},
+		{A: 1.6e-10, B: 5.717699407, C: 17298.1823273262},
+		{A: 1.6e-10, B: 3.28894009404, C: 5884.9268465832},
+		{A: 1.5e-10, B: 5.64785377164, C: 12559.038152982},
+		{A: 1.6e-10, B: 4.4345208093, C: 6283.0085396886},
+		{A: 1.4e-10, B: 2.31721603062, C: 5481.2549188676},
+		{A: 1.4e-10, B: 4.43479032305, C: 13517.8701062334},
+		{A: 1.4e-10, B: 4.73209312936, C: 7342.4577801806},
+		{A: 1.2e-10, B: 0.64705975463, C: 18073.7049386502},
+		{A: 1.1e-10, B: 1.514433322, C: 16200.7727245012},
+		{A: 1.1e-10, B: 0.88708889185, C: 21228.3920235458},
+		{A: 1.4e-10, B: 4.50116508534, C: 640.8776073822},
+		{A: 1.1e-10, B: 4.64339996198, C: 11790.6290886588},
+		{A: 1.1e-10, B: 1.31064298246, C: 4164.311989613},
+		{A: 9e-11, B: 3.02238989305, C: 23543.23050468179},
+		{A: 9e-11, B: 2.04999402381, C: 22003.9146348698},
+		{A: 9e-11, B: 4.91488110218, C: 213.299095438},
+	},
+	// R3, 27 terms
+	{
+		{A: 1.44595e-06, B: 4.27319435148, C: 6283.0758499914},
+		{A: 6.729e-08, B: 3.91697608662, C: 12566.1516999828},
+		{A: 7.74e-09, B: 0, C: 0},
+		{A: 2.47e-09, B: 3.73019298781, C: 18849.2275499742},
+		{A: 3.6e-10, B: 2.8008140905, C: 6286.5989683404},
+		{A: 3.3e-10, B: 5.62216602775, C: 6127.6554505572},
+		{A: 1.9e-10, B: 3.71292621802, C: 6438.4962494256},
+		{A: 1.6e-10, B: 4.26011484232, C: 6525.8044539654},
+		{A: 1.6e-10, B: 3.50416887054, C: 6256.7775301916},
+		{A: 1.4e-10, B: 3.62127621114, C: 25132.3033999656},
+		{A: 1.1e-10, B: 4.39200958819, C: 4705.7323075436},
+		{A: 1.1e-10, B: 5.22327127059, C: 6040.3472460174},
+		{A: 1e-10, B: 4.28045254647, C: 83996.84731811189},
+		{A: 9e-11, B: 1.56864096494, C: 5507.5532386674},
+		{A: 1.1e-10, B: 1.37795688024, C: 6309.3741697912},
+		{A: 1e-10, B: 5.19937959068, C: 71430.69561812909},
+		{A: 9e-11, B: 0.4727519993, C: 6279.5527316424},
+		{A: 9e-11, B: 0.74642756529, C: 5729.506447149},
+		{A: 7e-11, B: 2.9737489156, C: 775.522611324},
+		{A: 7e-11, B: 3.28615691021, C: 7058.5984613154},
+		{A: 7e-11, B: 2.19184402142, C: 6812.766815086},
+		{A: 5e-11, B: 3.15419034438, C: 529.6909650946},
+		{A: 6e-11, B: 4.54725567047, C: 1059.3819301892},
+		{A: 5e-11, B: 1.51104406936, C: 7079.3738568078},
+		{A: 7e-11, B: 2.98052059053, C: 6681.2248533996},
+		{A: 5e-11, B: 2.30961231391, C: 12036.4607348882},
+		{A: 5e-11, B: 3.71102966917, C: 6290.1893969922},
+	},
+	// R4, 10 terms
+	{
+		{A: 3.858e-08, B: 2.56384387339, C: 6283.0758499914},
+		{A: 3.06e-09, B: 2.2676950123, C: 12566.1516999828},
+		{A: 5.3e-10, B: 3.44031471924, C: 5573.1428014331},
+		{A: 1.5e-10, B: 2.04794573436, C: 18849.2275499742},
+		{A: 1.3e-10, B: 2.05688873673, C: 77713.7714681205},
+		{A: 7e-11, B: 4.4121885448, C: 161000.6857376741},
+		{A: 5e-11, B: 5.26154653107, C: 6438.4962494256},
+		{A: 5e-11, B: 4.07695126049, C: 6127.6554505572},
+		{A: 6e-11, B: 3.81514213664, C: 149854.4001348079},
+		{A: 3e-11, B: 1.28175749811, C: 6286.5989683404},
+	},
+	// R5, 3 terms
+	{
+		{A: 8.6e-10, B: 1.21579741687, C: 6283.0758499914},
+		{A: 1.2e-10, B: 0.65617264033, C: 12566.1516999828},
+		{A: 1e-11, B: 0.38068797142, C: 18849.2275499742},
+	},
+}
+
+// GetEarthR0 evaluates the t^0 series of R.
+func GetEarthR0(t float64) float64 {
+	return earthR[0].Evaluate(t)
+}
+
+// GetEarthR1 evaluates the t^1 series of R.
+func GetEarthR1(t float64) float64 {
+	return earthR[1].Evaluate(t)
+}
+
+// GetEarthR2 evaluates the t^2 series of R.
+func GetEarthR2(t float64) float64 {
+	return earthR[2].Evaluate(t)
+}
+
+// GetEarthR3 evaluates the t^3 series of R.
+func GetEarthR3(t float64) float64 {
+	return earthR[3].Evaluate(t)
+}
+
+// GetEarthR4 evaluates the t^4 series of R.
+func GetEarthR4(t float64) float64 {
+	return earthR[4].Evaluate(t)
+}
+
+// GetEarthR5 evaluates the t^5 series of R.
+func GetEarthR5(t float64) float64 {
+	return earthR[5].Evaluate(t)
+}
@@ -3,6 +3,8 @@ package vsop87earthd
 import (
     "testing"
     "calendarutil"
+    "math"
+    "mathutil"
 )
 
 //行星日心黄经（L）、日心黄纬（B）和到太阳的距离（R)
@@ -78,3 +80,66 @@ func Test_GetSunRadiusForEarth(t *testing.T) {
     R := GetSunRadiusForEarth( jd )
     t.Log(R)
 }
+
+func Test_GetTruncatedSeries(t *testing.T) {
+    for _, accuracy := range []float64{ACCURACY_ARC_SECOND, ACCURACY_10_ARC_SECONDS, ACCURACY_ARC_MINUTE} {
+        body := GetTruncatedSeries(accuracy)
+        if body.Len() >= earth.Len() || GetTruncatedSeries(accuracy) != body {
+            t.Error("fail", accuracy)
+        }
+        // 公元1000年至3000年之间与完整序列比较
+        for jd := 2086308.0; jd < 2816788.0; jd += 3652.5 {
+            dl := math.Abs(mathutil.ModPi(GetTruncatedSunEclipticLongitudeForEarth(jd, accuracy) - GetSunEclipticLongitudeForEarth(jd)))
+            db := math.Abs(GetTruncatedSunEclipticLatitudeForEarth(jd, accuracy) - GetSunEclipticLatitudeForEarth(jd))
+            dr := math.Abs(GetTruncatedSunRadiusForEarth(jd, accuracy) - GetSunRadiusForEarth(jd))
+            if dl > accuracy || db > accuracy || dr > accuracy * 1.0001 {
+                t.Error("fail", accuracy, jd, dl, db, dr)
+            }
+        }
+    }
+    t.Log(earth.Len(), GetTruncatedSeries(ACCURACY_ARC_SECOND).Len(), GetTruncatedSeries(ACCURACY_ARC_MINUTE).Len())
+}
+
+// 任意精度都取到ACCURACY_*精度，缓存不会无限增长
+func Test_GetTruncatedSeries_Levels(t *testing.T) {
+    if GetTruncatedSeries(2 * ACCURACY_ARC_SECOND) == GetTruncatedSeries(ACCURACY_ARC_SECOND) &&
+        GetTruncatedSeries(59 * ACCURACY_ARC_SECOND) == GetTruncatedSeries(ACCURACY_10_ARC_SECONDS) &&
+        GetTruncatedSeries(math.Pi) == GetTruncatedSeries(ACCURACY_ARC_MINUTE) &&
+        GetTruncatedSeries(ACCURACY_ARC_SECOND / 2) == earth {
+        t.Log("ok")
+    } else {
+        t.Error("fail")
+    }
+    for i := 1; i <= 100; i++ {
+        GetTruncatedSeries(float64(i) * ACCURACY_ARC_SECOND)
+    }
+    if len(truncatedCache) <= len(accuracyLevels) {
+        t.Log("ok")
+    } else {
+        t.Error("fail", len(truncatedCache))
+    }
+}
+
+func Benchmark_GetSunEclipticLongitudeForEarth(b *testing.B) {
+    for i := 0; i < b.N; i++ {
+        GetSunEclipticLongitudeForEarth(2451545.0 + float64(i))
+    }
+}
+
+func Benchmark_GetTruncatedSunEclipticLongitudeForEarth_1s(b *testing.B) {
+    for i := 0; i < b.N; i++ {
+        GetTruncatedSunEclipticLongitudeForEarth(2451545.0 + float64(i), ACCURACY_ARC_SECOND)
+    }
+}
+
+func Benchmark_GetTruncatedSunEclipticLongitudeForEarth_10s(b *testing.B) {
+    for i := 0; i < b.N; i++ {
+        GetTruncatedSunEclipticLongitudeForEarth(2451545.0 + float64(i), ACCURACY_10_ARC_SECONDS)
+    }
+}
+
+func Benchmark_GetTruncatedSunEclipticLongitudeForEarth_1m(b *testing.B) {
+    for i := 0; i < b.N; i++ {
+        GetTruncatedSunEclipticLongitudeForEarth(2451545.0 + float64(i), ACCURACY_ARC_MINUTE)
+    }
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+    "strings"
+    "testing"
+    "vsop87"
+)
+
+var sample = []vsop87.Series{
+    {{A: 1.75347045673, B: 0, C: 0}, {A: 0.03341656456, B: 4.66925680417, C: 6283.0758499914}, {A: -3.4e-09, B: 1, C: 2}},
+    {{A: 6283.31966747491, B: 0, C: 0}},
+}
+
+func Test_generate(t *testing.T) {
+    src, err := generate("vsop87earthd", "Earth", "L", sample, 0, "vsop87d.txt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := string(src)
+    if strings.Contains(s, "// L0, 3 terms") && strings.Contains(s, "{A: 0.03341656456, B: 4.66925680417, C: 6283.0758499914}") &&
+        strings.Contains(s, "func GetEarthL1(t float64) float64 {\n\treturn earthL[1].Evaluate(t)\n}") {
+        t.Log("ok")
+    } else {
+        t.Error("fail", s)
+    }
+}
+
+func Test_generate_Threshold(t *testing.T) {
+    src, err := generate("vsop87earthd", "Earth", "L", sample, 1e-8, "vsop87d.txt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := string(src)
+    if strings.Contains(s, "// L0, 2 terms") && !strings.Contains(s, "-3.4e-09") && strings.Contains(s, "|A| < 1e-08") {
+        t.Log("ok")
+    } else {
+        t.Error("fail", s)
+    }
+}
@@ -0,0 +1,63 @@
+package vsop87jupiterd
+
+import (
+    "calendarutil"
+    "mathutil"
+)
+
+/**
+ * 按儒略日计算木星的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 木星的日心黄经，单位是弧度(rad)
+ */
+func GetSunEclipticLongitudeForJupiter(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    L0 := GetJupiterL0(t)
+    L1 := GetJupiterL1(t)
+    L2 := GetJupiterL2(t)
+    L3 := GetJupiterL3(t)
+    L4 := GetJupiterL4(t)
+    L5 := GetJupiterL5(t)
+    L := ((((L5 * t + L4) * t + L3) * t + L2) * t + L1) * t + L0
+    return mathutil.Mod2Pi(L)
+}
+
+/**
+ * 按儒略日计算木星的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 木星的日心黄纬，单位是弧度(rad)
+ */
+func GetSunEclipticLatitudeForJupiter(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    B0 := GetJupiterB0(t)
+    B1 := GetJupiterB1(t)
+    B2 := GetJupiterB2(t)
+    B3 := GetJupiterB3(t)
+    B4 := GetJupiterB4(t)
+    B5 := GetJupiterB5(t)
+    B := ((((B5 * t + B4) * t + B3) * t + B2) * t + B1) * t + B0
+    return B
+}
+
+/**
+ * 按照儒略日计算木星和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 木星和太阳的距离，单位是天文单位(au)
+ */
+func GetSunRadiusForJupiter(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    R0 := GetJupiterR0(t)
+    R1 := GetJupiterR1(t)
+    R2 := GetJupiterR2(t)
+    R3 := GetJupiterR3(t)
+    R4 := GetJupiterR4(t)
+    R5 := GetJupiterR5(t)
+    R := ((((R5 * t + R4) * t + R3) * t + R2) * t + R1) * t + R0
+    return R
+}
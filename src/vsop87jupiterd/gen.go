@@ -0,0 +1,3 @@
+package vsop87jupiterd
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Jupiter -package vsop87jupiterd
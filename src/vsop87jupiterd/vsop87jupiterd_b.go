@@ -0,0 +1,589 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// JUPITER B - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87jupiterd
+
+import "vsop87"
+
+var jupiterB = []vsop87.Series{
+	// B0, 249 terms
+	{
+		{A: 0.02268615703, B: 3.55852606718, C: 529.6909650946},
+		{A: 0.00109971634, B: 3.90809347389, C: 1059.3819301892},
+		{A: 0.00110090358, B: 0, C: 0},
+		{A: 8.101427e-05, B: 3.60509573368, C: 522.5774180938},
+		{A: 6.043996e-05, B: 4.25883108794, C: 1589.0728952838},
+		{A: 6.437782e-05, B: 0.30627121409, C: 536.8045120954},
+		{A: 1.10688e-05, B: 2.98534421928, C: 1162.4747044078},
+		{A: 9.41651e-06, B: 2.93619072405, C: 1052.2683831884},
+		{A: 8.94088e-06, B: 1.75447429921, C: 7.1135470008},
+		{A: 7.6728e-06, B: 2.1547359406, C: 632.7837393132},
+		{A: 9.44328e-06, B: 1.67522288396, C: 426.598190876},
+		{A: 6.8422e-06, B: 3.67808770098, C: 213.299095438},
+		{A: 6.29223e-06, B: 0.64343282328, C: 1066.49547719},
+		{A: 8.35861e-06, B: 5.17881973234, C: 103.0927742186},
+		{A: 5.3167e-06, B: 2.70305954352, C: 110.2063212194},
+		{A: 5.58524e-06, B: 0.01354830508, C: 846.0828347512},
+		{A: 4.64449e-06, B: 1.17337249185, C: 949.1756089698},
+		{A: 4.31072e-06, B: 2.60825000494, C: 419.4846438752},
+		{A: 3.51433e-06, B: 4.61062990714, C: 2118.7638603784},
+		{A: 1.23148e-06, B: 3.34968181384, C: 1692.1656695024},
+		{A: 1.15038e-06, B: 5.04892295442, C: 316.3918696566},
+		{A: 1.3216e-06, B: 4.7781699067, C: 742.9900605326},
+		{A: 1.03402e-06, B: 2.31878999565, C: 1478.8665740644},
+		{A: 1.16379e-06, B: 1.38688232033, C: 323.5054166574},
+		{A: 1.0242e-06, B: 3.15293785436, C: 1581.959348283},
+		{A: 1.03762e-06, B: 3.7010383811, C: 515.463871093},
+		{A: 7.865e-07, B: 3.98318653238, C: 1265.5674786264},
+		{A: 6.9935e-07, B: 2.56006216424, C: 956.2891559706},
+		{A: 5.5597e-07, B: 0.37500753017, C: 1375.7737998458},
+		{A: 5.1986e-07, B: 0.99007119033, C: 1596.1864422846},
+		{A: 5.5194e-07, B: 0.40176412035, C: 525.7588118315},
+		{A: 6.3456e-07, B: 4.50073574333, C: 735.8765135318},
+		{A: 4.9691e-07, B: 0.18649893085, C: 543.9180590962},
+		{A: 4.8831e-07, B: 3.57260550671, C: 533.6231183577},
+		{A: 2.8353e-07, B: 1.53532744749, C: 625.6701923124},
+		{A: 2.9209e-07, B: 5.43145863011, C: 206.1855484372},
+		{A: 2.3255e-07, B: 5.95197992848, C: 838.9692877504},
+		{A: 2.2841e-07, B: 6.19262787685, C: 532.8723588323},
+		{A: 2.3202e-07, B: 4.06473368575, C: 526.5095713569},
+		{A: 2.4436e-07, B: 6.10947656959, C: 1169.5882514086},
+		{A: 2.1116e-07, B: 4.96322972735, C: 2648.454825473},
+		{A: 1.7879e-07, B: 3.08704395969, C: 1795.258443721},
+		{A: 1.6234e-07, B: 4.83515727869, C: 1368.660252845},
+		{A: 2.1314e-07, B: 2.69476951059, C: 1045.1548361876},
+		{A: 1.574e-07, B: 1.15130330106, C: 942.062061969},
+		{A: 1.7325e-07, B: 1.61550009206, C: 14.2270940016},
+		{A: 1.3396e-07, B: 2.30539585502, C: 853.196381752},
+		{A: 1.1904e-07, B: 3.09811974536, C: 2111.6503133776},
+		{A: 1.1734e-07, B: 2.83006431723, C: 2008.557539159},
+		{A: 1.1291e-07, B: 0.98957560201, C: 433.7117378768},
+		{A: 1.183e-07, B: 4.76527836803, C: 309.2783226558},
+		{A: 1.0702e-07, B: 3.70181397065, C: 2221.856634597},
+		{A: 1.0815e-07, B: 5.81958878617, C: 1272.6810256272},
+		{A: 1.3505e-07, B: 3.2812697576, C: 1155.361157407},
+		{A: 1.0179e-07, B: 2.58691128827, C: 117.3198682202},
+		{A: 1.0632e-07, B: 5.23487936086, C: 95.9792272178},
+		{A: 8.771e-08, B: 0.40456546655, C: 220.4126424388},
+		{A: 7.439e-08, B: 2.94638292086, C: 412.3710968744},
+		{A: 6.151e-08, B: 2.69100382247, C: 380.12776796},
+		{A: 5.028e-08, B: 0.72750312028, C: 1055.4497769261},
+		{A: 4.939e-08, B: 0.73756716762, C: 1905.4647649404},
+		{A: 5.421e-08, B: 4.08612438558, C: 1685.0521225016},
+		{A: 5.936e-08, B: 4.32059910537, C: 1063.3140834523},
+		{A: 4.737e-08, B: 4.0930301685, C: 527.2432845398},
+		{A: 4.01e-08, B: 0.51530008355, C: 1073.6090241908},
+		{A: 4.709e-08, B: 1.84067645204, C: 984.6003316219},
+		{A: 3.974e-08, B: 1.33608029246, C: 2125.8774073792},
+		{A: 3.762e-08, B: 3.58647039394, C: 529.7391492044},
+		{A: 4.731e-08, B: 6.16377350841, C: 532.1386456494},
+		{A: 4.666e-08, B: 5.88762905802, C: 639.897286314},
+		{A: 3.763e-08, B: 0.38865925413, C: 529.6427809848},
+		{A: 3.409e-08, B: 4.05398247269, C: 1898.3512179396},
+		{A: 3.457e-08, B: 3.43865563497, C: 1485.9801210652},
+		{A: 4.229e-08, B: 2.23767157901, C: 74.7815985673},
+		{A: 3.091e-08, B: 0.16470256025, C: 1699.2792165032},
+		{A: 2.975e-08, B: 0.72268908074, C: 530.6541729411},
+		{A: 3.162e-08, B: 1.2504841642, C: 330.6189636582},
+		{A: 2.727e-08, B: 4.37679213321, C: 149.5631971346},
+		{A: 2.837e-08, B: 0.05987107395, C: 1439.5096981492},
+		{A: 2.983e-08, B: 3.2525120722, C: 528.7277572481},
+		{A: 2.232e-08, B: 0.26149880534, C: 1062.5633239269},
+		{A: 2.464e-08, B: 1.1691330442, C: 453.424893819},
+		{A: 2.596e-08, B: 3.30510149086, C: 2324.9494088156},
+		{A: 1.988e-08, B: 2.85269577619, C: 1574.8458012822},
+		{A: 2.527e-08, B: 5.9445820295, C: 909.8187330546},
+		{A: 2.269e-08, B: 1.30379329597, C: 3.9321532631},
+		{A: 1.742e-08, B: 4.49909767044, C: 1258.4539316256},
+		{A: 1.714e-08, B: 4.12945878208, C: 2001.4439921582},
+		{A: 2.029e-08, B: 3.97938086639, C: 1056.2005364515},
+		{A: 1.667e-08, B: 0.36037092553, C: 10213.285546211},
+		{A: 1.579e-08, B: 6.11640144795, C: 1802.3719907218},
+		{A: 1.393e-08, B: 3.69324470827, C: 2214.7430875962},
+		{A: 1.604e-08, B: 1.98841031703, C: 38.1330356378},
+		{A: 1.325e-08, B: 1.74025919863, C: 529.1697002328},
+		{A: 1.451e-08, B: 2.39804501178, C: 2428.0421830342},
+		{A: 1.594e-08, B: 2.07556780757, C: 1021.2488945514},
+		{A: 1.32e-08, B: 1.33770977126, C: 618.5566453116},
+		{A: 1.346e-08, B: 3.2759149254, C: 2641.3412784722},
+		{A: 1.23e-08, B: 0.1955272822, C: 305.3461693927},
+		{A: 1.223e-08, B: 2.86681556337, C: 1382.8873468466},
+		{A: 1.324e-08, B: 2.23549334986, C: 530.2122299564},
+		{A: 1.056e-08, B: 3.80579750957, C: 76.2660712756},
+		{A: 1.05e-08, B: 4.68011652614, C: 1788.1448967202},
+		{A: 1.226e-08, B: 5.34003255221, C: 3178.1457905676},
+		{A: 1.009e-08, B: 3.19608028376, C: 2538.2485042536},
+		{A: 1.266e-08, B: 3.04704446731, C: 604.4725636619},
+		{A: 9.54e-09, B: 3.86932544808, C: 728.762966531},
+		{A: 1.124e-08, B: 1.5956036748, C: 3.1813937377},
+		{A: 9.78e-09, B: 0.25223689838, C: 983.1158589136},
+		{A: 9.48e-09, B: 0.21552742733, C: 750.1036075334},
+		{A: 9.46e-09, B: 3.9392774812, C: 508.3503240922},
+		{A: 9.2e-09, B: 1.14672086939, C: 963.4027029714},
+		{A: 8.17e-09, B: 5.93809619876, C: 831.8557407496},
+		{A: 7.7e-09, B: 2.96062737592, C: 526.7702037878},
+		{A: 1.017e-08, B: 5.55711112145, C: 199.0720014364},
+		{A: 7.61e-09, B: 1.38163787157, C: 532.6117264014},
+		{A: 7.26e-09, B: 3.98337964395, C: 2317.8358618148},
+		{A: 8.62e-09, B: 0.87975657414, C: 490.3340891794},
+		{A: 8.68e-09, B: 3.44331872364, C: 569.0478410098},
+		{A: 7.11e-09, B: 4.11107052823, C: 2751.5475996916},
+		{A: 7.08e-09, B: 0.33555577415, C: 528.9402055692},
+		{A: 7.08e-09, B: 4.00539820601, C: 530.44172462},
+		{A: 6.56e-09, B: 4.39568451439, C: 519.3960243561},
+		{A: 8.01e-09, B: 4.03984430862, C: 1364.7280995819},
+		{A: 6.79e-09, B: 1.18645749024, C: 525.4981794006},
+		{A: 6.45e-09, B: 5.10510349996, C: 1361.5467058442},
+		{A: 6.68e-09, B: 3.15607509055, C: 533.8837507886},
+		{A: 6.63e-09, B: 0.73722024843, C: 5223.6939198022},
+		{A: 6.63e-09, B: 1.57092786811, C: 6283.0758499914},
+		{A: 5.43e-09, B: 0.26376529935, C: 227.5261894396},
+		{A: 5.25e-09, B: 6.22318693939, C: 539.9859058331},
+		{A: 5.13e-09, B: 4.98337900151, C: 302.164775655},
+		{A: 5.44e-09, B: 2.22227019273, C: 2744.4340526908},
+		{A: 5.32e-09, B: 2.62425372687, C: 99.1606209555},
+		{A: 6.02e-09, B: 1.56074089013, C: 454.9093665273},
+		{A: 5.18e-09, B: 0.26343805959, C: 551.031606097},
+		{A: 5.16e-09, B: 1.09376390349, C: 934.9485149682},
+		{A: 6.59e-09, B: 0.62560671589, C: 1512.8068240082},
+		{A: 5.24e-09, B: 0.64710955846, C: 524.0618908021},
+		{A: 5.16e-09, B: 3.69478866795, C: 535.3200393871},
+		{A: 4.91e-09, B: 3.63039940597, C: 2531.1349572528},
+		{A: 5.7e-09, B: 0.61976758791, C: 540.7366653585},
+		{A: 4.96e-09, B: 2.19398015038, C: 1514.2912967165},
+		{A: 5.32e-09, B: 0.20040217534, C: 525.0250986486},
+		{A: 4.93e-09, B: 0.39160693598, C: 224.3447957019},
+		{A: 4.49e-09, B: 0.62392433691, C: 529.5309064002},
+		{A: 4.49e-09, B: 3.71676131146, C: 529.851023789},
+		{A: 4.5e-09, B: 5.02467015031, C: 1048.3362299253},
+		{A: 4.28e-09, B: 5.4480466029, C: 11.0457002639},
+		{A: 4.99e-09, B: 4.13924061941, C: 534.3568315406},
+		{A: 5.28e-09, B: 1.76471074936, C: 524.2743391232},
+		{A: 4.54e-09, B: 4.53321742354, C: 1056.9342496344},
+		{A: 5.2e-09, B: 2.57406093768, C: 535.107591066},
+		{A: 3.98e-09, B: 1.40345870113, C: 960.2213092337},
+		{A: 4.57e-09, B: 4.17708652827, C: 2104.5367663768},
+		{A: 5.05e-09, B: 5.36536256321, C: 1057.8974574809},
+		{A: 5.35e-09, B: 4.80455380313, C: 1593.0050485469},
+		{A: 4.15e-09, B: 0.96548127237, C: 2435.155730035},
+		{A: 5.19e-09, B: 0.54543519483, C: 1061.829610744},
+		{A: 3.59e-09, B: 4.02704454075, C: 1059.430114299},
+		{A: 3.56e-09, B: 2.66818105522, C: 835.0371344873},
+		{A: 4.43e-09, B: 5.27513700376, C: 1.4844727083},
+		{A: 3.58e-09, B: 5.94423960514, C: 440.8252848776},
+		{A: 4.71e-09, B: 6.05791940453, C: 1471.7530270636},
+		{A: 3.86e-09, B: 2.15984900214, C: 9153.9036160218},
+		{A: 4.24e-09, B: 2.7092967003, C: 1038.0412891868},
+		{A: 3.59e-09, B: 0.82922836987, C: 1059.3337460794},
+		{A: 3.1e-09, B: 0.88102053266, C: 529.9034134157},
+		{A: 3.1e-09, B: 3.45966511571, C: 529.4785167735},
+		{A: 3e-09, B: 3.70331799503, C: 2634.2277314714},
+		{A: 2.92e-09, B: 2.63594456361, C: 415.5524906121},
+		{A: 2.79e-09, B: 1.60669121578, C: 643.8294395771},
+		{A: 2.91e-09, B: 5.8313407182, C: 1148.2476104062},
+		{A: 3.7e-09, B: 5.71572992274, C: 531.1754378029},
+		{A: 2.68e-09, B: 5.39275891813, C: 1891.2376709388},
+		{A: 2.75e-09, B: 3.34108666036, C: 518.6452648307},
+		{A: 2.69e-09, B: 1.06051406954, C: 1585.1407420207},
+		{A: 3.06e-09, B: 2.5028901737, C: 511.5317178299},
+		{A: 2.95e-09, B: 1.84394223501, C: 547.8502123593},
+		{A: 2.54e-09, B: 2.98312992496, C: 1134.1635287565},
+		{A: 2.89e-09, B: 1.86070918711, C: 21.3406410024},
+		{A: 2.65e-09, B: 4.93075479744, C: 679.2541622292},
+		{A: 2.5e-09, B: 0.42860925124, C: 1969.2006632438},
+		{A: 3.08e-09, B: 2.67237933272, C: 2957.7331481288},
+		{A: 3.13e-09, B: 4.88085697819, C: 528.2064923863},
+		{A: 2.22e-09, B: 4.78828764413, C: 514.7131115676},
+		{A: 2.21e-09, B: 4.32763468981, C: 1677.9385755008},
+		{A: 2.17e-09, B: 3.46278526461, C: 2950.619601128},
+		{A: 2.16e-09, B: 0.5220766798, C: 2228.9701815978},
+		{A: 2.14e-09, B: 5.83569926578, C: 544.6688186216},
+		{A: 2.83e-09, B: 2.8870971609, C: 35.4247226521},
+		{A: 2.72e-09, B: 1.65708415457, C: 3060.8259223474},
+		{A: 2.34e-09, B: 1.68821537711, C: 2655.5683724738},
+		{A: 2.05e-09, B: 3.3618688829, C: 2847.5268269094},
+		{A: 2.64e-09, B: 3.62722625694, C: 2420.9286360334},
+		{A: 1.91e-09, B: 4.26821147044, C: 430.5303441391},
+		{A: 1.79e-09, B: 3.91470663005, C: 3340.6124266998},
+		{A: 1.8e-09, B: 0.04531671003, C: 387.2413149608},
+		{A: 2.41e-09, B: 4.03927631611, C: 494.2662424425},
+		{A: 1.76e-09, B: 4.26298906325, C: 672.1406152284},
+		{A: 1.87e-09, B: 2.72587420586, C: 299.1263942692},
+		{A: 2.34e-09, B: 1.3447482745, C: 173.9422195228},
+		{A: 1.71e-09, B: 0.85473611718, C: 1603.2999892854},
+		{A: 2.24e-09, B: 0.33130232434, C: 565.1156877467},
+		{A: 2e-09, B: 1.27632489123, C: 39.3568759152},
+		{A: 1.7e-09, B: 4.96479470273, C: 1464.6394800628},
+		{A: 2.11e-09, B: 1.00937080256, C: 523.5406259403},
+		{A: 2.1e-09, B: 3.75793720248, C: 2854.6403739102},
+		{A: 1.62e-09, B: 5.87784787295, C: 3480.3105662226},
+		{A: 1.63e-09, B: 4.62850343495, C: 2015.6710861598},
+		{A: 1.91e-09, B: 3.3315928375, C: 535.8413042489},
+		{A: 1.51e-09, B: 1.17096741034, C: 1060.3451380357},
+		{A: 1.6e-09, B: 1.81852636004, C: 312.4597163935},
+		{A: 1.58e-09, B: 2.59595816107, C: 529.4303326637},
+		{A: 1.58e-09, B: 1.7447274873, C: 529.9515975255},
+		{A: 1.73e-09, B: 3.62399350412, C: 230.5645708254},
+		{A: 1.42e-09, B: 0.70435921398, C: 522.529233984},
+		{A: 1.44e-09, B: 5.3576312243, C: 107.0249274817},
+		{A: 1.44e-09, B: 6.13954848857, C: 1158.5425511447},
+		{A: 1.78e-09, B: 0.27566275049, C: 3906.9087570986},
+		{A: 1.26e-09, B: 5.14832919826, C: 2207.6295405954},
+		{A: 1.26e-09, B: 3.41994798109, C: 2.4476805548},
+		{A: 1.27e-09, B: 0.39825164051, C: 70.8494453042},
+		{A: 1.23e-09, B: 4.77865550523, C: 2524.021410252},
+		{A: 1.23e-09, B: 0.46184813516, C: 647.0108333148},
+		{A: 1.44e-09, B: 3.60261852727, C: 1058.4187223427},
+		{A: 1.58e-09, B: 3.76231915252, C: 92.0470739547},
+		{A: 1.19e-09, B: 4.08266911415, C: 1585.8915015461},
+		{A: 1.25e-09, B: 2.35496721797, C: 3163.918696566},
+		{A: 1.22e-09, B: 3.21027426317, C: 3377.217792004},
+		{A: 1.21e-09, B: 3.39770381916, C: 18.1592472647},
+		{A: 1.31e-09, B: 1.67926417552, C: 1289.9465010146},
+		{A: 1.15e-09, B: 2.35735471566, C: 1550.939859646},
+		{A: 1.26e-09, B: 2.40833814513, C: 106.2741679563},
+		{A: 1.31e-09, B: 1.37610474529, C: 1023.9572075371},
+		{A: 1.21e-09, B: 1.60252617273, C: 10.2949407385},
+		{A: 1.21e-09, B: 0.61420823557, C: 1592.2542890215},
+		{A: 1.35e-09, B: 3.60177675518, C: 124.433415221},
+		{A: 1.37e-09, B: 2.41724947062, C: 3274.1250177854},
+		{A: 1.29e-09, B: 0.09702914345, C: 2332.0629558164},
+		{A: 9.3e-10, B: 4.88949890397, C: 1098.7388061044},
+		{A: 1.06e-09, B: 5.18592950792, C: 2281.2304965106},
+		{A: 1.14e-09, B: 2.96523316419, C: 1166.4068576709},
+		{A: 9.2e-10, B: 1.65166124027, C: 860.3099287528},
+		{A: 1.02e-09, B: 3.64093193142, C: 3171.0322435668},
+		{A: 1.03e-09, B: 1.63066232967, C: 1894.4190646765},
+		{A: 8e-10, B: 0.38766601876, C: 4694.0029547076},
+		{A: 7.4e-10, B: 3.86865238736, C: 3067.9394693482},
+		{A: 9.5e-10, B: 1.66362447044, C: 1151.4290041439},
+	},
+	// B1, 141 terms
+	{
+		{A: 0.00177351787, B: 5.70166488486, C: 529.6909650946},
+		{A: 3.230171e-05, B: 5.7794161934, C: 1059.3819301892},
+		{A: 3.081364e-05, B: 5.47464296527, C: 522.5774180938},
+		{A: 2.211914e-05, B: 4.73477480209, C: 536.8045120954},
+		{A: 1.694232e-05, B: 3.14159265359, C: 0},
+		{A: 3.46445e-06, B: 4.74595174109, C: 1052.2683831884},
+		{A: 2.34264e-06, B: 5.18856099929, C: 1066.49547719},
+		{A: 1.96154e-06, B: 6.18554286642, C: 7.1135470008},
+		{A: 1.50468e-06, B: 3.92721226087, C: 1589.0728952838},
+		{A: 1.14128e-06, B: 3.4389727183, C: 632.7837393132},
+		{A: 9.6667e-07, B: 2.9142630409, C: 949.1756089698},
+		{A: 7.6599e-07, B: 2.50522188662, C: 103.0927742186},
+		{A: 8.1671e-07, B: 5.07666097497, C: 1162.4747044078},
+		{A: 7.6572e-07, B: 0.61288981445, C: 419.4846438752},
+		{A: 7.3875e-07, B: 5.49958292155, C: 515.463871093},
+		{A: 4.9915e-07, B: 3.94799616572, C: 735.8765135318},
+		{A: 6.0544e-07, B: 5.44740084359, C: 213.299095438},
+		{A: 3.6561e-07, B: 4.69828392839, C: 543.9180590962},
+		{A: 4.6032e-07, B: 0.53850360901, C: 110.2063212194},
+		{A: 4.5123e-07, B: 1.89516645239, C: 846.0828347512},
+		{A: 3.6019e-07, B: 6.10952578764, C: 316.3918696566},
+		{A: 3.1975e-07, B: 4.92452714629, C: 1581.959348283},
+		{A: 2.1015e-07, B: 5.6295773141, C: 1596.1864422846},
+		{A: 2.3156e-07, B: 5.84829490183, C: 323.5054166574},
+		{A: 2.4719e-07, B: 3.94107395247, C: 2118.7638603784},
+		{A: 1.7274e-07, B: 5.65310656429, C: 533.6231183577},
+		{A: 1.6521e-07, B: 5.89840100621, C: 526.5095713569},
+		{A: 1.6698e-07, B: 5.66663034948, C: 1265.5674786264},
+		{A: 1.5815e-07, B: 4.43314786393, C: 1045.1548361876},
+		{A: 1.3398e-07, B: 4.30179033605, C: 532.8723588323},
+		{A: 1.1744e-07, B: 1.80990486955, C: 956.2891559706},
+		{A: 1.1925e-07, B: 4.30094564154, C: 525.7588118315},
+		{A: 9.514e-08, B: 2.02589667166, C: 206.1855484372},
+		{A: 1.0542e-07, B: 6.15533910933, C: 14.2270940016},
+		{A: 8.414e-08, B: 3.9291045034, C: 1478.8665740644},
+		{A: 8.099e-08, B: 4.20152809071, C: 1169.5882514086},
+		{A: 7.712e-08, B: 2.99160389601, C: 942.062061969},
+		{A: 8.825e-08, B: 1.55897920307, C: 426.598190876},
+		{A: 8.884e-08, B: 4.87430124264, C: 1155.361157407},
+		{A: 7.793e-08, B: 3.84684930196, C: 625.6701923124},
+		{A: 5.646e-08, B: 3.40915964493, C: 639.897286314},
+		{A: 4.615e-08, B: 0.83374662294, C: 117.3198682202},
+		{A: 4.02e-08, B: 5.50502127885, C: 433.7117378768},
+		{A: 3.704e-08, B: 0.90226777963, C: 95.9792272178},
+		{A: 3.859e-08, B: 0.69640284662, C: 853.196381752},
+		{A: 3.091e-08, B: 5.09115860882, C: 1073.6090241908},
+		{A: 3.36e-08, B: 5.10133284081, C: 1692.1656695024},
+		{A: 2.892e-08, B: 4.9041891666, C: 220.4126424388},
+		{A: 2.772e-08, B: 5.09066125724, C: 2111.6503133776},
+		{A: 2.425e-08, B: 3.74438653232, C: 742.9900605326},
+		{A: 2.558e-08, B: 5.46955948791, C: 1795.258443721},
+		{A: 2.466e-08, B: 4.2227835543, C: 2648.454825473},
+		{A: 1.968e-08, B: 0.57192251841, C: 309.2783226558},
+		{A: 1.794e-08, B: 4.60765219417, C: 1272.6810256272},
+		{A: 1.822e-08, B: 1.98842964323, C: 1375.7737998458},
+		{A: 1.703e-08, B: 6.12660562937, C: 2125.8774073792},
+		{A: 2.011e-08, B: 5.00936865256, C: 412.3710968744},
+		{A: 1.645e-08, B: 0.08830372958, C: 1063.3140834523},
+		{A: 1.875e-08, B: 5.81006158403, C: 330.6189636582},
+		{A: 1.741e-08, B: 4.58650290431, C: 1574.8458012822},
+		{A: 1.529e-08, B: 5.81660291389, C: 1258.4539316256},
+		{A: 1.916e-08, B: 0.85150399517, C: 1368.660252845},
+		{A: 1.614e-08, B: 4.36839107221, C: 728.762966531},
+		{A: 1.51e-08, B: 2.79374165455, C: 1485.9801210652},
+		{A: 1.333e-08, B: 4.84260898693, C: 1062.5633239269},
+		{A: 1.359e-08, B: 5.16511980864, C: 838.9692877504},
+		{A: 1.165e-08, B: 5.66275740881, C: 508.3503240922},
+		{A: 1.092e-08, B: 4.68797557406, C: 1699.2792165032},
+		{A: 1.438e-08, B: 5.78105679279, C: 1056.2005364515},
+		{A: 1.083e-08, B: 3.99886917926, C: 1471.7530270636},
+		{A: 1.002e-08, B: 4.79949608524, C: 1055.4497769261},
+		{A: 7.49e-09, B: 6.1440086203, C: 519.3960243561},
+		{A: 6.57e-09, B: 5.63765568876, C: 1898.3512179396},
+		{A: 7.02e-09, B: 5.04126574492, C: 1685.0521225016},
+		{A: 6.07e-09, B: 3.15707515246, C: 618.5566453116},
+		{A: 5.87e-09, B: 1.37658820775, C: 199.0720014364},
+		{A: 5.52e-09, B: 4.8065772945, C: 551.031606097},
+		{A: 4.94e-09, B: 4.43417307482, C: 539.9859058331},
+		{A: 5.17e-09, B: 0.05161181997, C: 3.1813937377},
+		{A: 4.69e-09, B: 3.81715950042, C: 2008.557539159},
+		{A: 4.15e-09, B: 1.34693184108, C: 1382.8873468466},
+		{A: 3.82e-09, B: 4.86764073919, C: 227.5261894396},
+		{A: 4.73e-09, B: 1.72405831407, C: 532.1386456494},
+		{A: 4.58e-09, B: 4.44604993015, C: 1038.0412891868},
+		{A: 3.76e-09, B: 2.23190744786, C: 529.6427809848},
+		{A: 4.51e-09, B: 3.75869883836, C: 984.6003316219},
+		{A: 3.76e-09, B: 5.42971857629, C: 529.7391492044},
+		{A: 3.89e-09, B: 1.92698506631, C: 525.0250986486},
+		{A: 3.64e-09, B: 3.35456685746, C: 2221.856634597},
+		{A: 4.76e-09, B: 5.93625415892, C: 527.2432845398},
+		{A: 3.83e-09, B: 6.12255867339, C: 149.5631971346},
+		{A: 3.01e-09, B: 4.09378934049, C: 440.8252848776},
+		{A: 3.1e-09, B: 5.58150418981, C: 2428.0421830342},
+		{A: 2.82e-09, B: 4.85996662231, C: 1788.1448967202},
+		{A: 2.98e-09, B: 5.09589374634, C: 528.7277572481},
+		{A: 3.4e-09, B: 4.5653707022, C: 750.1036075334},
+		{A: 2.72e-09, B: 2.3534696034, C: 534.3568315406},
+		{A: 3.6e-09, B: 3.91050161665, C: 74.7815985673},
+		{A: 2.99e-09, B: 1.43093538841, C: 909.8187330546},
+		{A: 2.97e-09, B: 2.56584512211, C: 530.6541729411},
+		{A: 2.35e-09, B: 4.81644489422, C: 535.107591066},
+		{A: 3.06e-09, B: 0.68420442848, C: 380.12776796},
+		{A: 2.36e-09, B: 4.63162956792, C: 526.7702037878},
+		{A: 2.7e-09, B: 0.18549916939, C: 21.3406410024},
+		{A: 2.88e-09, B: 4.26655874393, C: 1802.3719907218},
+		{A: 1.96e-09, B: 5.35950443033, C: 2214.7430875962},
+		{A: 1.9e-09, B: 4.5461519326, C: 2104.5367663768},
+		{A: 1.93e-09, B: 4.35426216497, C: 511.5317178299},
+		{A: 1.78e-09, B: 4.51895208036, C: 3178.1457905676},
+		{A: 1.94e-09, B: 0.57050756837, C: 1361.5467058442},
+		{A: 2e-09, B: 1.48040474749, C: 302.164775655},
+		{A: 1.68e-09, B: 5.40141749419, C: 524.2743391232},
+		{A: 1.52e-09, B: 0.68077486546, C: 1905.4647649404},
+		{A: 1.49e-09, B: 1.06678990744, C: 831.8557407496},
+		{A: 1.82e-09, B: 3.62401009613, C: 38.1330356378},
+		{A: 1.76e-09, B: 5.64331384323, C: 963.4027029714},
+		{A: 1.84e-09, B: 4.48850356629, C: 604.4725636619},
+		{A: 1.33e-09, B: 5.45026366125, C: 2641.3412784722},
+		{A: 1.43e-09, B: 2.21577268292, C: 1439.5096981492},
+		{A: 1.3e-09, B: 4.88155705493, C: 2531.1349572528},
+		{A: 1.29e-09, B: 6.15206333598, C: 547.8502123593},
+		{A: 1.33e-09, B: 5.43193972385, C: 1603.2999892854},
+		{A: 1.33e-09, B: 3.49297492409, C: 529.1697002328},
+		{A: 1.32e-09, B: 3.98820790955, C: 530.2122299564},
+		{A: 1.18e-09, B: 5.38352943814, C: 1891.2376709388},
+		{A: 1.33e-09, B: 5.65694269884, C: 76.2660712756},
+		{A: 1.45e-09, B: 2.94976686191, C: 454.9093665273},
+		{A: 1.15e-09, B: 3.29206553804, C: 3.9321532631},
+		{A: 1.02e-09, B: 4.48856749557, C: 2001.4439921582},
+		{A: 1.06e-09, B: 6.08434275898, C: 10.2949407385},
+		{A: 9.3e-10, B: 5.8473777184, C: 2324.9494088156},
+		{A: 1.01e-09, B: 0.15815934254, C: 2655.5683724738},
+		{A: 1.15e-09, B: 3.59221021604, C: 2015.6710861598},
+		{A: 1.03e-09, B: 4.70399583323, C: 305.3461693927},
+		{A: 8.4e-10, B: 0.44180206332, C: 1593.0050485469},
+		{A: 9.2e-10, B: 2.44863388631, C: 490.3340891794},
+		{A: 8.7e-10, B: 6.23817512863, C: 6283.0758499914},
+		{A: 9.5e-10, B: 3.30154605532, C: 2317.8358618148},
+		{A: 7.2e-10, B: 1.90578907085, C: 528.9402055692},
+		{A: 7.2e-10, B: 5.57619428876, C: 530.44172462},
+		{A: 7.8e-10, B: 5.97323507836, C: 1585.8915015461},
+	},
+	// B2, 81 terms
+	{
+		{A: 8.094051e-05, B: 1.46322843658, C: 529.6909650946},
+		{A: 7.42415e-06, B: 0.95691639003, C: 522.5774180938},
+		{A: 8.13244e-06, B: 3.14159265359, C: 0},
+		{A: 3.98951e-06, B: 2.89888666447, C: 536.8045120954},
+		{A: 3.42226e-06, B: 1.44683789727, C: 1059.3819301892},
+		{A: 7.3948e-07, B: 0.40724675866, C: 1052.2683831884},
+		{A: 4.6151e-07, B: 3.48036895772, C: 1066.49547719},
+		{A: 2.9314e-07, B: 0.99088831805, C: 515.463871093},
+		{A: 2.9717e-07, B: 1.92504171329, C: 1589.0728952838},
+		{A: 2.2753e-07, B: 4.27124052435, C: 7.1135470008},
+		{A: 1.3916e-07, B: 2.92242387338, C: 543.9180590962},
+		{A: 1.2067e-07, B: 5.22168932482, C: 632.7837393132},
+		{A: 1.0703e-07, B: 4.88024222475, C: 949.1756089698},
+		{A: 6.078e-08, B: 6.21089108431, C: 1045.1548361876},
+		{A: 5.935e-08, B: 0.52977760072, C: 1581.959348283},
+		{A: 5.037e-08, B: 1.43444929374, C: 526.5095713569},
+		{A: 4.564e-08, B: 0.91811732585, C: 1162.4747044078},
+		{A: 4.547e-08, B: 4.01953745202, C: 1596.1864422846},
+		{A: 5.098e-08, B: 6.03169795231, C: 735.8765135318},
+		{A: 3.593e-08, B: 4.54080164408, C: 110.2063212194},
+		{A: 3.443e-08, B: 1.38618954572, C: 533.6231183577},
+		{A: 3.277e-08, B: 4.39650286553, C: 14.2270940016},
+		{A: 3.407e-08, B: 0.42275631534, C: 419.4846438752},
+		{A: 2.904e-08, B: 2.06041641723, C: 316.3918696566},
+		{A: 2.541e-08, B: 3.98323842017, C: 323.5054166574},
+		{A: 3.113e-08, B: 2.48079280193, C: 2118.7638603784},
+		{A: 3.061e-08, B: 2.39880866911, C: 532.8723588323},
+		{A: 2.155e-08, B: 4.7799063714, C: 942.062061969},
+		{A: 2.143e-08, B: 3.88727338786, C: 426.598190876},
+		{A: 2.252e-08, B: 0.3719643412, C: 1155.361157407},
+		{A: 2.019e-08, B: 3.89985000464, C: 846.0828347512},
+		{A: 1.857e-08, B: 1.19658907851, C: 103.0927742186},
+		{A: 1.683e-08, B: 1.42264195434, C: 1265.5674786264},
+		{A: 2.313e-08, B: 0.87671613055, C: 213.299095438},
+		{A: 1.443e-08, B: 2.38565505909, C: 1169.5882514086},
+		{A: 1.823e-08, B: 5.80106463776, C: 625.6701923124},
+		{A: 1.728e-08, B: 2.24114678267, C: 525.7588118315},
+		{A: 1.198e-08, B: 0.03252059731, C: 956.2891559706},
+		{A: 1.138e-08, B: 3.46420904745, C: 1073.6090241908},
+		{A: 1.086e-08, B: 5.352791467, C: 117.3198682202},
+		{A: 8.4e-09, B: 2.89946334223, C: 95.9792272178},
+		{A: 7.46e-09, B: 5.53017890231, C: 1478.8665740644},
+		{A: 9.44e-09, B: 4.055870535, C: 206.1855484372},
+		{A: 7.58e-09, B: 3.74770617289, C: 433.7117378768},
+		{A: 6.73e-09, B: 1.26396626349, C: 508.3503240922},
+		{A: 8.89e-09, B: 6.07878453176, C: 728.762966531},
+		{A: 6e-09, B: 1.82954494089, C: 639.897286314},
+		{A: 5.89e-09, B: 1.23625943417, C: 1258.4539316256},
+		{A: 6.19e-09, B: 0.67923057477, C: 838.9692877504},
+		{A: 5.66e-09, B: 5.36336098734, C: 742.9900605326},
+		{A: 6.48e-09, B: 5.32990375008, C: 853.196381752},
+		{A: 5.53e-09, B: 3.15511946637, C: 220.4126424388},
+		{A: 4.32e-09, B: 1.03719283016, C: 1692.1656695024},
+		{A: 4.35e-09, B: 1.65056479007, C: 519.3960243561},
+		{A: 4.3e-09, B: 1.41830384501, C: 412.3710968744},
+		{A: 4.31e-09, B: 2.20986254651, C: 1368.660252845},
+		{A: 4.15e-09, B: 4.35372561905, C: 330.6189636582},
+		{A: 4.38e-09, B: 0.1655227729, C: 1574.8458012822},
+		{A: 3.12e-09, B: 4.50639455819, C: 2125.8774073792},
+		{A: 2.8e-09, B: 3.01441283033, C: 551.031606097},
+		{A: 3.09e-09, B: 0.67399908949, C: 2111.6503133776},
+		{A: 3.01e-09, B: 3.06868080871, C: 1062.5633239269},
+		{A: 2.36e-09, B: 1.946968422, C: 1485.9801210652},
+		{A: 2.35e-09, B: 3.41850395941, C: 199.0720014364},
+		{A: 2.46e-09, B: 2.61803442505, C: 309.2783226558},
+		{A: 2.38e-09, B: 2.56643737684, C: 539.9859058331},
+		{A: 2.48e-09, B: 2.96997778167, C: 2648.454825473},
+		{A: 2.09e-09, B: 5.82481690851, C: 1471.7530270636},
+		{A: 2.05e-09, B: 1.20202002469, C: 1056.2005364515},
+		{A: 1.88e-09, B: 0.97113663101, C: 1685.0521225016},
+		{A: 1.37e-09, B: 2.91203499563, C: 1699.2792165032},
+		{A: 1.31e-09, B: 1.79274504072, C: 1063.3140834523},
+		{A: 1.61e-09, B: 1.05926568614, C: 1795.258443721},
+		{A: 1.12e-09, B: 2.62660288825, C: 440.8252848776},
+		{A: 1.1e-09, B: 3.56263668146, C: 227.5261894396},
+		{A: 1.14e-09, B: 6.13907482464, C: 1038.0412891868},
+		{A: 1.03e-09, B: 4.6428710104, C: 3.1813937377},
+		{A: 1.23e-09, B: 4.81268110532, C: 21.3406410024},
+		{A: 1.02e-09, B: 4.2760382797, C: 1375.7737998458},
+		{A: 8.9e-10, B: 1.22926014128, C: 1898.3512179396},
+		{A: 8e-10, B: 0.62129648755, C: 831.8557407496},
+	},
+	// B3, 42 terms
+	{
+		{A: 2.51624e-06, B: 3.38087923084, C: 529.6909650946},
+		{A: 1.21738e-06, B: 2.733118372, C: 522.5774180938},
+		{A: 4.8694e-07, B: 1.03689996685, C: 536.8045120954},
+		{A: 1.0988e-07, B: 2.31463561347, C: 1052.2683831884},
+		{A: 8.067e-08, B: 2.76729757621, C: 515.463871093},
+		{A: 6.205e-08, B: 1.7811582737, C: 1066.49547719},
+		{A: 7.287e-08, B: 4.25268318975, C: 1059.3819301892},
+		{A: 3.627e-08, B: 1.13028917221, C: 543.9180590962},
+		{A: 2.798e-08, B: 3.14159265359, C: 0},
+		{A: 1.898e-08, B: 2.28934054087, C: 7.1135470008},
+		{A: 1.643e-08, B: 1.77507208483, C: 1045.1548361876},
+		{A: 9.45e-09, B: 0.45261136388, C: 632.7837393132},
+		{A: 7.58e-09, B: 0.30577920142, C: 949.1756089698},
+		{A: 7.31e-09, B: 2.63748223583, C: 14.2270940016},
+		{A: 8.76e-09, B: 0.32927768725, C: 1589.0728952838},
+		{A: 6.78e-09, B: 2.36909615348, C: 1581.959348283},
+		{A: 6.23e-09, B: 2.480562136, C: 1596.1864422846},
+		{A: 7.36e-09, B: 1.52532370632, C: 735.8765135318},
+		{A: 4.99e-09, B: 3.67985494258, C: 419.4846438752},
+		{A: 4.54e-09, B: 0.26977404624, C: 942.062061969},
+		{A: 4.53e-09, B: 3.18232334886, C: 526.5095713569},
+		{A: 4.09e-09, B: 2.88147337106, C: 110.2063212194},
+		{A: 3.47e-09, B: 5.7624428587, C: 103.0927742186},
+		{A: 3.1e-09, B: 2.98017326384, C: 508.3503240922},
+		{A: 3.21e-09, B: 4.40642025933, C: 532.8723588323},
+		{A: 3e-09, B: 1.66936571536, C: 625.6701923124},
+		{A: 2.95e-09, B: 1.75924202728, C: 1073.6090241908},
+		{A: 2.82e-09, B: 3.11087801399, C: 533.6231183577},
+		{A: 2.63e-09, B: 0.55255030187, C: 426.598190876},
+		{A: 2.08e-09, B: 2.17540496886, C: 1155.361157407},
+		{A: 1.83e-09, B: 4.34670868038, C: 525.7588118315},
+		{A: 1.8e-09, B: 6.07777744541, C: 639.897286314},
+		{A: 1.59e-09, B: 2.60843864402, C: 1162.4747044078},
+		{A: 1.17e-09, B: 4.70141431381, C: 95.9792272178},
+		{A: 1.07e-09, B: 5.48942805114, C: 433.7117378768},
+		{A: 1.05e-09, B: 3.75192101775, C: 316.3918696566},
+		{A: 1.3e-09, B: 1.37897716939, C: 323.5054166574},
+		{A: 9.4e-10, B: 3.05797832024, C: 1265.5674786264},
+		{A: 1.14e-09, B: 3.75170981478, C: 117.3198682202},
+		{A: 9.5e-10, B: 0.54905691533, C: 1169.5882514086},
+		{A: 8.8e-10, B: 3.26874502411, C: 213.299095438},
+		{A: 9.8e-10, B: 2.00704668688, C: 1574.8458012822},
+	},
+	// B4, 12 terms
+	{
+		{A: 1.505e-07, B: 4.52956999637, C: 522.5774180938},
+		{A: 5.37e-08, B: 4.47427159142, C: 529.6909650946},
+		{A: 4.456e-08, B: 5.43908581047, C: 536.8045120954},
+		{A: 3.422e-08, B: 0, C: 0},
+		{A: 1.833e-08, B: 4.51807036227, C: 515.463871093},
+		{A: 1.322e-08, B: 4.20117611581, C: 1052.2683831884},
+		{A: 7.55e-09, B: 5.59451554966, C: 543.9180590962},
+		{A: 5.12e-09, B: 0.05803177475, C: 1066.49547719},
+		{A: 2.82e-09, B: 3.66807771223, C: 1059.3819301892},
+		{A: 1.47e-09, B: 3.56490986181, C: 1045.1548361876},
+		{A: 1.42e-09, B: 5.69936472988, C: 7.1135470008},
+		{A: 1.12e-09, B: 1.16718383135, C: 14.2270940016},
+	},
+	// B5, 5 terms
+	{
+		{A: 1.445e-08, B: 0.09198554072, C: 522.5774180938},
+		{A: 3.68e-09, B: 0.00874408003, C: 515.463871093},
+		{A: 3.04e-09, B: 3.27902945138, C: 536.8045120954},
+		{A: 1.29e-09, B: 0.33959775247, C: 529.6909650946},
+		{A: 9.5e-10, B: 1.29305954542, C: 543.9180590962},
+	},
+}
+
+// GetJupiterB0 evaluates the t^0 series of B.
+func GetJupiterB0(t float64) float64 {
+	return jupiterB[0].Evaluate(t)
+}
+
+// GetJupiterB1 evaluates the t^1 series of B.
+func GetJupiterB1(t float64) float64 {
+	return jupiterB[1].Evaluate(t)
+}
+
+// GetJupiterB2 evaluates the t^2 series of B.
+func GetJupiterB2(t float64) float64 {
+	return jupiterB[2].Evaluate(t)
+}
+
+// GetJupiterB3 evaluates the t^3 series of B.
+func GetJupiterB3(t float64) float64 {
+	return jupiterB[3].Evaluate(t)
+}
+
+// GetJupiterB4 evaluates the t^4 series of B.
+func GetJupiterB4(t float64) float64 {
+	return jupiterB[4].Evaluate(t)
+}
+
+// GetJupiterB5 evaluates the t^5 series of B.
+func GetJupiterB5(t float64) float64 {
+	return jupiterB[5].Evaluate(t)
+}
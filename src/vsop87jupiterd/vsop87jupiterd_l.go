@@ -0,0 +1,1543 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// JUPITER L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87jupiterd
+
+import "vsop87"
+
+var jupiterL = []vsop87.Series{
+	// L0, 760 terms
+	{
+		{A: 0.59954691495, B: 0, C: 0},
+		{A: 0.09695898711, B: 5.06191793105, C: 529.6909650946},
+		{A: 0.00573610145, B: 1.44406205976, C: 7.1135470008},
+		{A: 0.0030638918, B: 5.41734729976, C: 1059.3819301892},
+		{A: 0.0009717828, B: 4.14264708819, C: 632.7837393132},
+		{A: 0.00072903096, B: 3.64042909255, C: 522.5774180938},
+		{A: 0.00064263986, B: 3.41145185203, C: 103.0927742186},
+		{A: 0.00039806051, B: 2.29376744855, C: 419.4846438752},
+		{A: 0.0003885778, B: 1.2723172486, C: 316.3918696566},
+		{A: 0.00027964622, B: 1.78454589485, C: 536.8045120954},
+		{A: 0.00013589738, B: 5.7748103159, C: 1589.0728952838},
+		{A: 8.246362e-05, B: 3.58227961655, C: 206.1855484372},
+		{A: 8.768686e-05, B: 3.63000324417, C: 949.1756089698},
+		{A: 7.368057e-05, B: 5.08101125612, C: 735.8765135318},
+		{A: 6.263171e-05, B: 0.02497643742, C: 213.299095438},
+		{A: 6.11405e-05, B: 4.51319531666, C: 1162.4747044078},
+		{A: 4.905419e-05, B: 1.32084631684, C: 110.2063212194},
+		{A: 5.305283e-05, B: 1.30671236848, C: 14.2270940016},
+		{A: 5.305457e-05, B: 4.18625053495, C: 1052.2683831884},
+		{A: 4.647249e-05, B: 4.69958109497, C: 3.9321532631},
+		{A: 3.045009e-05, B: 4.31675960318, C: 426.598190876},
+		{A: 2.610001e-05, B: 1.5666759485, C: 846.0828347512},
+		{A: 2.028191e-05, B: 1.06376547379, C: 3.1813937377},
+		{A: 1.764768e-05, B: 2.14148077766, C: 1066.49547719},
+		{A: 1.722983e-05, B: 3.88036008872, C: 1265.5674786264},
+		{A: 1.920959e-05, B: 0.97168928755, C: 639.897286314},
+		{A: 1.633217e-05, B: 3.58201089758, C: 515.463871093},
+		{A: 1.431997e-05, B: 4.29683690269, C: 625.6701923124},
+		{A: 9.73278e-06, B: 4.09764957065, C: 95.9792272178},
+		{A: 8.84439e-06, B: 2.43701426123, C: 412.3710968744},
+		{A: 7.32875e-06, B: 6.08534113239, C: 838.9692877504},
+		{A: 7.31072e-06, B: 3.80591233956, C: 1581.959348283},
+		{A: 6.91928e-06, B: 6.13368222939, C: 2118.7638603784},
+		{A: 7.0919e-06, B: 1.29272573658, C: 742.9900605326},
+		{A: 6.14464e-06, B: 4.10853496756, C: 1478.8665740644},
+		{A: 4.95224e-06, B: 3.75567461379, C: 323.5054166574},
+		{A: 5.81902e-06, B: 4.53967717552, C: 309.2783226558},
+		{A: 3.75657e-06, B: 4.70299124833, C: 1368.660252845},
+		{A: 3.89864e-06, B: 4.89716105852, C: 1692.1656695024},
+		{A: 3.41006e-06, B: 5.71452525783, C: 533.6231183577},
+		{A: 3.30458e-06, B: 4.74049819491, C: 0.0481841098},
+		{A: 4.40854e-06, B: 2.95818460943, C: 454.9093665273},
+		{A: 4.17266e-06, B: 1.03554430161, C: 2.4476805548},
+		{A: 2.4417e-06, B: 5.220208789, C: 728.762966531},
+		{A: 2.6154e-06, B: 1.87652461032, C: 0.9632078465},
+		{A: 2.56568e-06, B: 3.72410724159, C: 199.0720014364},
+		{A: 2.61009e-06, B: 0.82047246448, C: 380.12776796},
+		{A: 2.20382e-06, B: 1.65115015995, C: 543.9180590962},
+		{A: 2.01996e-06, B: 1.80684574186, C: 1375.7737998458},
+		{A: 2.07327e-06, B: 1.85461666594, C: 525.7588118315},
+		{A: 1.97046e-06, B: 5.29252149016, C: 1155.361157407},
+		{A: 2.35141e-06, B: 1.22693908124, C: 909.8187330546},
+		{A: 1.74809e-06, B: 5.90973505276, C: 956.2891559706},
+		{A: 1.49368e-06, B: 4.37745104275, C: 1685.0521225016},
+		{A: 1.75184e-06, B: 3.22634903433, C: 1898.3512179396},
+		{A: 1.75191e-06, B: 3.72966554761, C: 942.062061969},
+		{A: 1.57909e-06, B: 4.36483921766, C: 1795.258443721},
+		{A: 1.37871e-06, B: 1.31797920785, C: 1169.5882514086},
+		{A: 1.17495e-06, B: 2.5002214089, C: 1596.1864422846},
+		{A: 1.50502e-06, B: 3.90625022622, C: 74.7815985673},
+		{A: 1.16757e-06, B: 3.38920921041, C: 0.5212648618},
+		{A: 1.05895e-06, B: 4.55439798236, C: 526.5095713569},
+		{A: 1.30531e-06, B: 4.16867945489, C: 1045.1548361876},
+		{A: 1.41445e-06, B: 3.13568357861, C: 491.5579294568},
+		{A: 9.9511e-07, B: 1.42117395747, C: 532.8723588323},
+		{A: 9.6137e-07, B: 1.18156870005, C: 117.3198682202},
+		{A: 9.1758e-07, B: 0.85756633461, C: 1272.6810256272},
+		{A: 8.7695e-07, B: 1.21738140813, C: 453.424893819},
+		{A: 6.8507e-07, B: 2.35242959478, C: 2.9207613068},
+		{A: 6.6098e-07, B: 5.34386149468, C: 1471.7530270636},
+		{A: 7.7401e-07, B: 4.42676337124, C: 39.3568759152},
+		{A: 7.2006e-07, B: 4.23834923691, C: 2111.6503133776},
+		{A: 6.3406e-07, B: 4.97665525033, C: 0.7507595254},
+		{A: 5.9427e-07, B: 4.11130498612, C: 2001.4439921582},
+		{A: 6.2481e-07, B: 0.51211384012, C: 220.4126424388},
+		{A: 6.6532e-07, B: 2.98864358135, C: 2214.7430875962},
+		{A: 6.0194e-07, B: 4.12628179571, C: 4.192785694},
+		{A: 5.6012e-07, B: 1.15493222602, C: 21.3406410024},
+		{A: 5.2854e-07, B: 0.91207215543, C: 10.2949407385},
+		{A: 7.0297e-07, B: 5.14180555282, C: 835.0371344873},
+		{A: 5.1916e-07, B: 4.1004818002, C: 1258.4539316256},
+		{A: 4.6442e-07, B: 4.66531163524, C: 5.6290742925},
+		{A: 5.819e-07, B: 5.86646380344, C: 5753.3848848968},
+		{A: 4.0103e-07, B: 4.68801114087, C: 0.1600586944},
+		{A: 4.6654e-07, B: 4.79394835282, C: 305.3461693927},
+		{A: 3.9298e-07, B: 4.25448423697, C: 853.196381752},
+		{A: 4.6042e-07, B: 5.1098351515, C: 4.665866446},
+		{A: 5.4459e-07, B: 1.57072704127, C: 983.1158589136},
+		{A: 3.892e-07, B: 6.0759290558, C: 518.6452648307},
+		{A: 3.845e-07, B: 2.43836870888, C: 433.7117378768},
+		{A: 4.68e-07, B: 3.54640538283, C: 5.4166259714},
+		{A: 4.183e-07, B: 4.67982493646, C: 302.164775655},
+		{A: 3.592e-07, B: 2.45088036239, C: 430.5303441391},
+		{A: 3.7888e-07, B: 0.21127448431, C: 2648.454825473},
+		{A: 3.919e-07, B: 1.71835571629, C: 11.0457002639},
+		{A: 3.7567e-07, B: 6.19481310233, C: 831.8557407496},
+		{A: 3.5828e-07, B: 4.61459907698, C: 2008.557539159},
+		{A: 4.3402e-07, B: 0.14992289081, C: 528.2064923863},
+		{A: 3.1598e-07, B: 5.14073450755, C: 1788.1448967202},
+		{A: 2.9849e-07, B: 5.34441117167, C: 2221.856634597},
+		{A: 3.2811e-07, B: 5.28907118836, C: 88.865680217},
+		{A: 2.7686e-07, B: 1.85227036207, C: 0.2124483211},
+		{A: 2.582e-07, B: 3.85920882494, C: 2317.8358618148},
+		{A: 2.4705e-07, B: 2.63495214991, C: 114.1384744825},
+		{A: 3.3844e-07, B: 1.00563073268, C: 9683.5945811164},
+		{A: 2.4266e-07, B: 3.82355417268, C: 1574.8458012822},
+		{A: 2.7111e-07, B: 2.80845435102, C: 18.1592472647},
+		{A: 2.6837e-07, B: 1.77586123775, C: 532.1386456494},
+		{A: 2.6064e-07, B: 2.74361318804, C: 2531.1349572528},
+		{A: 3.0765e-07, B: 0.42330537728, C: 1.4844727083},
+		{A: 3.0476e-07, B: 3.66677894407, C: 508.3503240922},
+		{A: 2.3282e-07, B: 3.24372142416, C: 984.6003316219},
+		{A: 1.9445e-07, B: 0.52370214471, C: 14.977853527},
+		{A: 1.9332e-07, B: 4.86314494382, C: 1361.5467058442},
+		{A: 2.291e-07, B: 3.84914895064, C: 2428.0421830342},
+		{A: 2.1617e-07, B: 6.01696940024, C: 1063.3140834523},
+		{A: 2.0155e-07, B: 5.59582008789, C: 527.2432845398},
+		{A: 2.3732e-07, B: 2.52766031921, C: 494.2662424425},
+		{A: 2.0189e-07, B: 1.01560227681, C: 628.8515860501},
+		{A: 1.5994e-07, B: 5.09003530653, C: 529.7391492044},
+		{A: 1.6134e-07, B: 5.27095037302, C: 142.4496501338},
+		{A: 2.0697e-07, B: 4.03443281612, C: 355.7487455718},
+		{A: 2.1479e-07, B: 1.28668134295, C: 35.4247226521},
+		{A: 1.4964e-07, B: 4.8603968439, C: 2104.5367663768},
+		{A: 1.7242e-07, B: 1.59187913206, C: 1439.5096981492},
+		{A: 1.5994e-07, B: 1.89222417794, C: 529.6427809848},
+		{A: 1.7958e-07, B: 4.30178016003, C: 6.1503391543},
+		{A: 1.3279e-07, B: 2.18943981644, C: 1055.4497769261},
+		{A: 1.4148e-07, B: 2.71597731671, C: 0.2606324309},
+		{A: 1.4689e-07, B: 0.87944553412, C: 99.1606209555},
+		{A: 1.4202e-07, B: 2.41335693735, C: 530.6541729411},
+		{A: 1.532e-07, B: 6.07703092728, C: 149.5631971346},
+		{A: 1.5832e-07, B: 4.11682440678, C: 636.7158925763},
+		{A: 1.2398e-07, B: 2.61042299578, C: 405.2575498736},
+		{A: 1.6199e-07, B: 2.77035044582, C: 760.25553592},
+		{A: 1.3665e-07, B: 3.5603967831, C: 217.2312487011},
+		{A: 1.5261e-07, B: 2.81824770887, C: 621.7380390493},
+		{A: 1.4681e-07, B: 6.26423732742, C: 569.0478410098},
+		{A: 1.2529e-07, B: 1.39077179081, C: 7.065362891},
+		{A: 1.1677e-07, B: 3.60447374272, C: 2634.2277314714},
+		{A: 1.1603e-07, B: 4.60461756191, C: 7.1617311106},
+		{A: 1.2152e-07, B: 0.24540531919, C: 1485.9801210652},
+		{A: 1.1347e-07, B: 2.00818458261, C: 1073.6090241908},
+		{A: 1.1242e-07, B: 2.4800094787, C: 423.4167971383},
+		{A: 1.0942e-07, B: 5.03602448252, C: 458.8415197904},
+		{A: 1.1117e-07, B: 4.04973271023, C: 519.3960243561},
+		{A: 1.2256e-07, B: 4.30153222783, C: 604.4725636619},
+		{A: 1.3149e-07, B: 2.72189077702, C: 1364.7280995819},
+		{A: 1.0604e-07, B: 3.11518747072, C: 1.2720243872},
+		{A: 9.874e-08, B: 1.70200068743, C: 1699.2792165032},
+		{A: 1.0851e-07, B: 5.08554552028, C: 2324.9494088156},
+		{A: 1.0692e-07, B: 2.51401681528, C: 2847.5268269094},
+		{A: 1.264e-07, B: 4.75572797691, C: 528.7277572481},
+		{A: 1.0084e-07, B: 4.05599810206, C: 38.1330356378},
+		{A: 1.1536e-07, B: 2.35034215745, C: 643.8294395771},
+		{A: 1.0247e-07, B: 3.63479911496, C: 2744.4340526908},
+		{A: 1.0105e-07, B: 3.65845333837, C: 107.0249274817},
+		{A: 1.0121e-07, B: 1.31482648275, C: 1905.4647649404},
+		{A: 9.341e-08, B: 5.92176693887, C: 1148.2476104062},
+		{A: 8.796e-08, B: 2.77421822809, C: 6.592282139},
+		{A: 8.42e-08, B: 4.52537756809, C: 1677.9385755008},
+		{A: 1.0128e-07, B: 2.09034472544, C: 511.5317178299},
+		{A: 8.272e-08, B: 2.98682673354, C: 540.7366653585},
+		{A: 9.753e-08, B: 1.22438911827, C: 32.2433289144},
+		{A: 1.063e-07, B: 2.07777800288, C: 92.0470739547},
+		{A: 7.85e-08, B: 0.98996894618, C: 408.4389436113},
+		{A: 8.811e-08, B: 3.46911754939, C: 1021.2488945514},
+		{A: 7.946e-08, B: 2.8668292607, C: 2125.8774073792},
+		{A: 8.575e-08, B: 5.29590411702, C: 415.5524906121},
+		{A: 7.841e-08, B: 6.08025056721, C: 70.8494453042},
+		{A: 7.706e-08, B: 1.69832954219, C: 8.0767548473},
+		{A: 7.265e-08, B: 4.65503563919, C: 629.6023455755},
+		{A: 7.164e-08, B: 4.93400217968, C: 1056.2005364515},
+		{A: 7.247e-08, B: 4.6160767756, C: 2420.9286360334},
+		{A: 7.753e-08, B: 2.12871653382, C: 33.9402499438},
+		{A: 6.645e-08, B: 0.45647460873, C: 635.9651330509},
+		{A: 9.377e-08, B: 4.03158388202, C: 2810.9214616052},
+		{A: 8.263e-08, B: 1.23558676139, C: 1802.3719907218},
+		{A: 6.341e-08, B: 0.0727800158, C: 202.2533951741},
+		{A: 6.383e-08, B: 3.54310669809, C: 1891.2376709388},
+		{A: 7.902e-08, B: 2.32510002614, C: 230.5645708254},
+		{A: 6.214e-08, B: 4.54560345237, C: 2.7083129857},
+		{A: 7.347e-08, B: 1.24457237337, C: 24.3790223882},
+		{A: 7.451e-08, B: 3.02719199239, C: 330.6189636582},
+		{A: 6.22e-08, B: 1.77687561489, C: 1062.5633239269},
+		{A: 5.674e-08, B: 5.14132196367, C: 746.9222137957},
+		{A: 5.855e-08, B: 5.42130172896, C: 28.3111756513},
+		{A: 5.629e-08, B: 3.24348217277, C: 529.1697002328},
+		{A: 7.652e-08, B: 0.52813391052, C: 672.1406152284},
+		{A: 5.456e-08, B: 3.34716871364, C: 2950.619601128},
+		{A: 7.127e-08, B: 1.43497795005, C: 6.2197751235},
+		{A: 5.388e-08, B: 4.9017509558, C: 69.1525242748},
+		{A: 5.618e-08, B: 4.97903783721, C: 2641.3412784722},
+		{A: 5.844e-08, B: 2.95364118152, C: 490.3340891794},
+		{A: 4.943e-08, B: 5.37597740579, C: 721.6494195302},
+		{A: 5.062e-08, B: 4.84282906467, C: 31.019488637},
+		{A: 5.163e-08, B: 5.07410777073, C: 67.6680515665},
+		{A: 4.739e-08, B: 6.10248862834, C: 106.2741679563},
+		{A: 4.879e-08, B: 0.07095292379, C: 78.7137518304},
+		{A: 4.854e-08, B: 5.63875661096, C: 1.6969210294},
+		{A: 5.629e-08, B: 3.73871604865, C: 530.2122299564},
+		{A: 4.471e-08, B: 4.49152882547, C: 505.3119427064},
+		{A: 4.313e-08, B: 4.79369370451, C: 535.107591066},
+		{A: 4.28e-08, B: 0.5478382371, C: 1.4362885985},
+		{A: 4.453e-08, B: 0.50551854591, C: 524.0618908021},
+		{A: 4.936e-08, B: 4.82992988255, C: 422.6660376129},
+		{A: 4.701e-08, B: 3.41634869046, C: 3060.8259223474},
+		{A: 4.261e-08, B: 2.67044686458, C: 561.934294009},
+		{A: 4.156e-08, B: 4.00660658688, C: 99.9113804809},
+		{A: 4.561e-08, B: 2.29650164054, C: 3163.918696566},
+		{A: 4.414e-08, B: 5.67224020329, C: 1464.6394800628},
+		{A: 5.345e-08, B: 0.3151385183, C: 1289.9465010146},
+		{A: 5.269e-08, B: 3.89116469022, C: 191.9584544356},
+		{A: 3.855e-08, B: 4.28942301453, C: 1994.3304451574},
+		{A: 4.21e-08, B: 5.32763589447, C: 2538.2485042536},
+		{A: 3.949e-08, B: 4.56507101172, C: 1382.8873468466},
+		{A: 3.885e-08, B: 1.5677878681, C: 647.0108333148},
+		{A: 4.227e-08, B: 5.5169759903, C: 5223.6939198022},
+		{A: 4.129e-08, B: 2.81119457666, C: 416.3032501375},
+		{A: 3.663e-08, B: 4.35187510477, C: 2737.32050569},
+		{A: 3.566e-08, B: 5.48243943375, C: 750.1036075334},
+		{A: 4.33e-08, B: 0.8494175664, C: 531.1754378029},
+		{A: 4.093e-08, B: 0.19980340452, C: 525.0250986486},
+		{A: 4.022e-08, B: 1.92293311337, C: 1512.8068240082},
+		{A: 3.4e-08, B: 6.00302355875, C: 1.2238402774},
+		{A: 3.496e-08, B: 0.31252921473, C: 597.3590166611},
+		{A: 3.299e-08, B: 4.27596694481, C: 526.7702037878},
+		{A: 3.226e-08, B: 2.90455264496, C: 963.4027029714},
+		{A: 3.15e-08, B: 3.81061764181, C: 280.9671470045},
+		{A: 4.129e-08, B: 4.74946631331, C: 0.8937718773},
+		{A: 3.84e-08, B: 1.91064405186, C: 378.6432952517},
+		{A: 3.057e-08, B: 1.65589659685, C: 528.9402055692},
+		{A: 3.011e-08, B: 1.59276337369, C: 224.3447957019},
+		{A: 3.196e-08, B: 5.86588452873, C: 4.1446015842},
+		{A: 3.628e-08, B: 0.07930225897, C: 558.0021407459},
+		{A: 2.932e-08, B: 0.41424445089, C: 7.8643065262},
+		{A: 3.316e-08, B: 2.70211697795, C: 532.6117264014},
+		{A: 2.925e-08, B: 4.47580363425, C: 533.8837507886},
+		{A: 3.69e-08, B: 0.39897023849, C: 685.4739373527},
+		{A: 3.223e-08, B: 2.45833032883, C: 960.2213092337},
+		{A: 3.059e-08, B: 5.32616140812, C: 530.44172462},
+		{A: 3.383e-08, B: 4.42170370028, C: 312.4597163935},
+		{A: 3.32e-08, B: 2.71417812514, C: 495.7507151508},
+		{A: 2.697e-08, B: 5.23146633437, C: 739.8086667949},
+		{A: 3.59e-08, B: 2.30999595873, C: 908.3342603463},
+		{A: 3.677e-08, B: 5.07337955976, C: 73.297125859},
+		{A: 2.618e-08, B: 3.09118499149, C: 3267.0114707846},
+		{A: 2.796e-08, B: 2.98942316119, C: 483.2205421786},
+		{A: 3.398e-08, B: 3.29598270278, C: 911.3032057629},
+		{A: 3.352e-08, B: 1.44391979336, C: 593.426863398},
+		{A: 2.563e-08, B: 3.35080110279, C: 2207.6295405954},
+		{A: 2.553e-08, B: 0.36892288645, C: 1048.3362299253},
+		{A: 2.62e-08, B: 3.8276987434, C: 520.129737539},
+		{A: 3.356e-08, B: 1.08315053878, C: 46.470422916},
+		{A: 3.035e-08, B: 5.52230028113, C: 618.5566453116},
+		{A: 3.397e-08, B: 3.83084746522, C: 210.1177017003},
+		{A: 2.497e-08, B: 0.47917884538, C: 945.2434557067},
+		{A: 2.341e-08, B: 5.87941292649, C: 2751.5475996916},
+		{A: 2.656e-08, B: 0.49713061045, C: 1057.8974574809},
+		{A: 2.581e-08, B: 0.03759881914, C: 1.6445314027},
+		{A: 2.9e-08, B: 2.50019054587, C: 525.4981794006},
+		{A: 3.153e-08, B: 2.30900986177, C: 457.617679513},
+		{A: 2.201e-08, B: 3.94367109739, C: 31.492569389},
+		{A: 2.381e-08, B: 6.19252134885, C: 327.4375699205},
+		{A: 2.458e-08, B: 0.65614291954, C: 9153.9036160218},
+		{A: 2.111e-08, B: 5.61905648764, C: 16.4623262353},
+		{A: 2.13e-08, B: 3.75880734109, C: 724.8308132679},
+		{A: 2.406e-08, B: 2.29315649755, C: 195.1398481733},
+		{A: 2.166e-08, B: 5.43262641046, C: 534.3568315406},
+		{A: 2.057e-08, B: 1.49875151278, C: 551.031606097},
+		{A: 2.676e-08, B: 5.06374981112, C: 456.3938392356},
+		{A: 2.078e-08, B: 5.28920097886, C: 76.2660712756},
+		{A: 2.261e-08, B: 5.38117230692, C: 1781.0313497194},
+		{A: 2.356e-08, B: 0.67392574097, C: 227.5261894396},
+		{A: 2.24e-08, B: 3.18006978517, C: 3377.217792004},
+		{A: 2.183e-08, B: 3.0838425095, C: 524.2743391232},
+		{A: 2.119e-08, B: 2.70107659927, C: 387.2413149608},
+		{A: 2.056e-08, B: 4.82779196994, C: 2957.7331481288},
+		{A: 2.116e-08, B: 6.20263841494, C: 209.3669421749},
+		{A: 2.712e-08, B: 3.18157754631, C: 1474.6737883704},
+		{A: 2.127e-08, B: 1.24424012514, C: 539.9859058331},
+		{A: 2.424e-08, B: 3.57595925853, C: 953.1077622329},
+		{A: 1.947e-08, B: 1.94468082546, C: 529.5309064002},
+		{A: 1.896e-08, B: 4.014062428, C: 2310.722314814},
+		{A: 1.935e-08, B: 4.1005149395, C: 3053.7123753466},
+		{A: 2.056e-08, B: 6.2707414855, C: 245.5424243524},
+		{A: 2.108e-08, B: 3.22886474225, C: 252.6559713532},
+		{A: 2.596e-08, B: 2.77467278614, C: 177.8743727859},
+		{A: 1.919e-08, B: 3.14834694111, C: 381.6122406683},
+		{A: 2.217e-08, B: 1.92368906925, C: 535.9107402181},
+		{A: 1.947e-08, B: 5.03751780002, C: 529.851023789},
+		{A: 2.025e-08, B: 4.82814272957, C: 17.2654753874},
+		{A: 1.945e-08, B: 2.10611582568, C: 3480.3105662226},
+		{A: 1.899e-08, B: 0.05104263891, C: 560.7104537316},
+		{A: 2.221e-08, B: 0.5836509063, C: 3178.1457905676},
+		{A: 2.271e-08, B: 1.67360565619, C: 731.9443602687},
+		{A: 1.706e-08, B: 5.40277333462, C: 20.4468691251},
+		{A: 2.295e-08, B: 4.20863103004, C: 1038.0412891868},
+		{A: 2.218e-08, B: 3.65982280555, C: 282.4516197128},
+		{A: 2.181e-08, B: 4.87369503022, C: 535.3200393871},
+		{A: 1.745e-08, B: 1.34021867874, C: 25.1297819136},
+		{A: 1.601e-08, B: 3.9273001584, C: 17.5261078183},
+		{A: 1.651e-08, B: 0.63598292839, C: 17.4084877393},
+		{A: 1.826e-08, B: 0.31592311031, C: 124.433415221},
+		{A: 2.041e-08, B: 0.15617294873, C: 598.8434893694},
+		{A: 1.494e-08, B: 3.8141802513, C: 319.5732633943},
+		{A: 1.551e-08, B: 5.25201528605, C: 437.6438911399},
+		{A: 1.852e-08, B: 2.36130812462, C: 37.8724032069},
+		{A: 1.466e-08, B: 1.72926380881, C: 59.8037450403},
+		{A: 1.417e-08, B: 5.82273267086, C: 81.7521332162},
+		{A: 1.43e-08, B: 1.1752880626, C: 440.8252848776},
+		{A: 1.906e-08, B: 4.06896022692, C: 1819.6374661092},
+		{A: 1.397e-08, B: 0.26383366743, C: 50.4025761791},
+		{A: 1.756e-08, B: 2.32977483716, C: 938.1299087059},
+		{A: 1.487e-08, B: 2.2486674654, C: 10.0343083076},
+		{A: 1.368e-08, B: 3.56691602771, C: 1514.2912967165},
+		{A: 1.4e-08, B: 4.84502200703, C: 295.0512286542},
+		{A: 1.344e-08, B: 2.20177702122, C: 529.9034134157},
+		{A: 1.464e-08, B: 1.42648716568, C: 1158.5425511447},
+		{A: 1.341e-08, B: 1.15693423225, C: 2435.155730035},
+		{A: 1.786e-08, B: 5.44716330146, C: 2854.6403739102},
+		{A: 1.677e-08, B: 6.22875777048, C: 833.552661779},
+		{A: 1.471e-08, B: 4.80574535807, C: 696.5196376166},
+		{A: 1.436e-08, B: 1.4581095733, C: 537.7677199419},
+		{A: 1.657e-08, B: 0.02890651793, C: 138.5174968707},
+		{A: 1.3e-08, B: 3.14074420421, C: 547.8502123593},
+		{A: 1.343e-08, B: 6.14827138025, C: 988.532484885},
+		{A: 1.344e-08, B: 4.78042160426, C: 529.4785167735},
+		{A: 1.234e-08, B: 2.83294330979, C: 3583.4033404412},
+		{A: 1.651e-08, B: 2.12056447005, C: 1061.829610744},
+		{A: 1.479e-08, B: 0.24646493075, C: 1593.0050485469},
+		{A: 1.413e-08, B: 3.07444632745, C: 6283.0758499914},
+		{A: 1.246e-08, B: 5.94882321661, C: 1056.9342496344},
+		{A: 1.225e-08, B: 1.95642397635, C: 1969.2006632438},
+		{A: 1.388e-08, B: 2.87749576073, C: 1023.9572075371},
+		{A: 1.263e-08, B: 3.46181945031, C: 40.8413486235},
+		{A: 1.325e-08, B: 4.15429781246, C: 916.9322800554},
+		{A: 1.477e-08, B: 5.26691818477, C: 810.6581120991},
+		{A: 1.165e-08, B: 4.65528125418, C: 944.9828232758},
+		{A: 1.137e-08, B: 2.48561382158, C: 2.0057375701},
+		{A: 1.118e-08, B: 3.80747957482, C: 7.0016724162},
+		{A: 1.138e-08, B: 5.11611532241, C: 885.4397106664},
+		{A: 1.131e-08, B: 1.54599459004, C: 775.233389447},
+		{A: 1.477e-08, B: 4.69742954455, C: 630.3360587584},
+		{A: 1.252e-08, B: 1.34316620527, C: 739.0579072695},
+		{A: 1.273e-08, B: 5.19070939905, C: 2097.423219376},
+		{A: 1.446e-08, B: 5.54999644374, C: 43.2890291783},
+		{A: 1.344e-08, B: 4.75897665313, C: 1166.4068576709},
+		{A: 1.101e-08, B: 4.56997613488, C: 3274.1250177854},
+		{A: 1.376e-08, B: 3.60998729004, C: 415.2918581812},
+		{A: 1.437e-08, B: 6.22410093972, C: 155.7829722581},
+		{A: 1.167e-08, B: 4.09497264272, C: 203.0041546995},
+		{A: 1.237e-08, B: 4.41132627005, C: 292.0128472684},
+		{A: 1.077e-08, B: 2.57045229823, C: 25.2727942655},
+		{A: 1.341e-08, B: 0.49262296655, C: 635.231419868},
+		{A: 1.209e-08, B: 3.36289125536, C: 521.6142102473},
+		{A: 1.03e-08, B: 1.81822316284, C: 465.9550667912},
+		{A: 1.002e-08, B: 3.21720955284, C: 2524.021410252},
+		{A: 1.338e-08, B: 1.26054917773, C: 902.7051860538},
+		{A: 1.037e-08, B: 3.87858871885, C: 3370.1042450032},
+		{A: 1.224e-08, B: 0.09219976028, C: 824.7421937488},
+		{A: 1.255e-08, B: 3.04675952762, C: 447.7958195265},
+		{A: 9.91e-09, B: 4.16587903812, C: 632.831923423},
+		{A: 9.75e-09, B: 3.80216680539, C: 2627.1141844706},
+		{A: 1.061e-08, B: 5.60184374277, C: 732.6951197941},
+		{A: 1.049e-08, B: 2.94931080683, C: 3693.6096616606},
+		{A: 9.84e-09, B: 0.98260254313, C: 632.7355552034},
+		{A: 1.05e-08, B: 2.20935815967, C: 7.2254215854},
+		{A: 9.96e-09, B: 5.41921062583, C: 1059.430114299},
+		{A: 9.61e-09, B: 0.87315283361, C: 544.6688186216},
+		{A: 1.175e-08, B: 3.09093466406, C: 1894.4190646765},
+		{A: 1.049e-08, B: 5.81616384906, C: 26.826702943},
+		{A: 1.161e-08, B: 0.01274801567, C: 850.0149880143},
+		{A: 1.109e-08, B: 3.63294273717, C: 306.830642101},
+		{A: 1.077e-08, B: 0.95716576092, C: 608.404716925},
+		{A: 1.288e-08, B: 4.23019288942, C: 1215.1649024473},
+		{A: 1.06e-08, B: 3.85856787901, C: 631.8205314667},
+		{A: 1.251e-08, B: 6.15889818604, C: 462.0229135281},
+		{A: 1.165e-08, B: 3.50653563773, C: 8.5980197091},
+		{A: 9.33e-09, B: 4.62559759882, C: 1049.0869894507},
+		{A: 1.035e-08, B: 1.30805283339, C: 633.7469471597},
+		{A: 1.238e-08, B: 2.21195391602, C: 25558.2121764796},
+		{A: 1.24e-08, B: 2.27960685992, C: 6.9010986797},
+		{A: 9.42e-09, B: 4.14526324371, C: 945.9942152321},
+		{A: 9.27e-09, B: 6.10893117637, C: 514.7131115676},
+		{A: 9.14e-09, B: 6.17656044376, C: 952.3570027075},
+		{A: 8.93e-09, B: 4.27448748055, C: 0.6331394464},
+		{A: 1.045e-08, B: 1.64682770236, C: 565.1156877467},
+		{A: 9.03e-09, B: 1.9425015664, C: 3796.7024358792},
+		{A: 1.162e-08, B: 5.51229668479, C: 2.9689454166},
+		{A: 9.01e-09, B: 3.03568112112, C: 460.5384408198},
+		{A: 9.03e-09, B: 2.24012822393, C: 523.5406259403},
+		{A: 1.06e-08, B: 5.28027224466, C: 3171.0322435668},
+		{A: 1.064e-08, B: 0.99330150801, C: 320.3240229197},
+		{A: 9.7e-09, B: 4.56607888439, C: 429.0458714308},
+		{A: 1.071e-08, B: 4.33203090957, C: 610.6923387854},
+		{A: 8.65e-09, B: 0.2183142923, C: 1098.7388061044},
+		{A: 8.65e-09, B: 2.82123742108, C: 1060.3451380357},
+		{A: 8.82e-09, B: 4.80076824948, C: 384.0599212231},
+		{A: 9.59e-09, B: 5.45468005818, C: 451.9404211107},
+		{A: 1.042e-08, B: 5.7927032515, C: 303.8616966844},
+		{A: 7.84e-09, B: 1.85150700827, C: 313.2104759189},
+		{A: 1.083e-08, B: 1.40526460812, C: 72.0732855816},
+		{A: 7.82e-09, B: 3.03559242565, C: 5.8415226136},
+		{A: 8.54e-09, B: 1.22236205478, C: 611.4430983108},
+		{A: 9.96e-09, B: 2.22139794743, C: 1059.3337460794},
+		{A: 7.19e-09, B: 4.92550252164, C: 421.93232443},
+		{A: 9.53e-09, B: 3.98347050083, C: 836.5216071956},
+		{A: 8.22e-09, B: 4.49679856387, C: 10213.285546211},
+		{A: 7.07e-09, B: 2.16473400319, C: 2228.9701815978},
+		{A: 7.15e-09, B: 4.62515255534, C: 385.5443939314},
+		{A: 7.37e-09, B: 4.63776694324, C: 1134.1635287565},
+		{A: 7.3e-09, B: 1.87179326186, C: 153.4953503977},
+		{A: 7.09e-09, B: 2.9313211591, C: 417.0369633204},
+		{A: 9.26e-09, B: 1.77006317007, C: 2332.0629558164},
+		{A: 8.64e-09, B: 3.0324627597, C: 1041.2226829245},
+		{A: 7.08e-09, B: 6.01601101389, C: 395.105621487},
+		{A: 9.35e-09, B: 6.01864676296, C: 173.9422195228},
+		{A: 6.95e-09, B: 1.39408383356, C: 432.0148168474},
+		{A: 6.87e-09, B: 3.06548397586, C: 529.9515975255},
+		{A: 6.77e-09, B: 3.5835752721, C: 244.318584075},
+		{A: 8.5e-09, B: 5.46114025921, C: 41.0537969446},
+		{A: 8.17e-09, B: 4.65315342412, C: 535.8413042489},
+		{A: 6.52e-09, B: 0.44173759183, C: 1201.831580323},
+		{A: 7.11e-09, B: 0.9628328931, C: 373.0142209592},
+		{A: 6.65e-09, B: 1.03244633471, C: 623.2225117576},
+		{A: 6.43e-09, B: 5.05335060049, C: 522.6256022036},
+		{A: 6.39e-09, B: 4.22718483639, C: 25.8634950965},
+		{A: 7.18e-09, B: 5.0757690071, C: 1058.4187223427},
+		{A: 6.64e-09, B: 2.43728454444, C: 1585.1407420207},
+		{A: 8.33e-09, B: 1.49468440213, C: 563.6312150384},
+		{A: 7.6e-09, B: 4.34849823663, C: 100.6450936638},
+		{A: 6.33e-09, B: 4.3179671864, C: 3590.516887442},
+		{A: 6.29e-09, B: 6.23431126402, C: 679.2541622292},
+		{A: 6.17e-09, B: 2.68075016456, C: 3899.7952100978},
+		{A: 6.46e-09, B: 2.88581188015, C: 13.4933808187},
+		{A: 7.68e-09, B: 3.1849807612, C: 1151.4290041439},
+		{A: 7.31e-09, B: 5.86653168561, C: 501.3797894433},
+		{A: 6.52e-09, B: 0.8286577178, C: 2015.6710861598},
+		{A: 7.96e-09, B: 5.36663489938, C: 420.9691165835},
+		{A: 6.47e-09, B: 4.74965662438, C: 567.8240007324},
+		{A: 8.45e-09, B: 1.69406147722, C: 1744.8558675419},
+		{A: 8.02e-09, B: 5.79824707751, C: 981.6313862053},
+		{A: 7.64e-09, B: 5.05232933368, C: 827.9235874865},
+		{A: 6.04e-09, B: 5.11265182908, C: 1159.2933106701},
+		{A: 6.82e-09, B: 3.68248136835, C: 2281.2304965106},
+		{A: 7.4e-09, B: 0.74512356954, C: 1261.6353253633},
+		{A: 6.66e-09, B: 2.06624389616, C: 27.0873353739},
+		{A: 6.52e-09, B: 4.92932795958, C: 2413.8150890326},
+		{A: 5.59e-09, B: 0.17558868481, C: 63.7358983034},
+		{A: 5.77e-09, B: 3.82752312276, C: 1550.939859646},
+		{A: 7.27e-09, B: 1.05835550856, C: 490.0734567485},
+		{A: 5.74e-09, B: 3.61492119092, C: 3686.4961146598},
+		{A: 7.32e-09, B: 5.93179840659, C: 42.5382696529},
+		{A: 6.06e-09, B: 2.714118843, C: 1173.5204046717},
+		{A: 6.33e-09, B: 4.21720828607, C: 166.828672522},
+		{A: 6.87e-09, B: 3.91671464962, C: 529.4303326637},
+		{A: 5.7e-09, B: 2.73551750122, C: 4010.0015313172},
+		{A: 5.52e-09, B: 2.36967119362, C: 1603.2999892854},
+		{A: 6e-09, B: 1.82659364395, C: 522.529233984},
+		{A: 5.58e-09, B: 5.09099246601, C: 1354.4331588434},
+		{A: 5.19e-09, B: 6.11952999304, C: 366.7944458357},
+		{A: 7.19e-09, B: 0.85722557905, C: 362.8622925726},
+		{A: 5.18e-09, B: 2.03954064144, C: 418.5214360287},
+		{A: 5.15e-09, B: 3.51750445111, C: 528.4189407074},
+		{A: 5.15e-09, B: 3.47930063838, C: 103.1409583284},
+		{A: 5.5e-09, B: 5.7767683773, C: 420.4478517217},
+		{A: 7.02e-09, B: 3.67952126446, C: 1279.794572628},
+		{A: 5.5e-09, B: 0.61451088395, C: 104.0559820651},
+		{A: 4.95e-09, B: 2.41738205536, C: 179.3588454942},
+		{A: 5.13e-09, B: 0.29823688044, C: 103.0445901088},
+		{A: 5.37e-09, B: 5.47946238724, C: 771.3012361839},
+		{A: 5.07e-09, B: 3.08777345288, C: 1357.6145525811},
+		{A: 4.95e-09, B: 4.9536265916, C: 536.8526962052},
+		{A: 6.81e-09, B: 4.56294416261, C: 112.6540017742},
+		{A: 5e-09, B: 3.15631977489, C: 1070.4276304531},
+		{A: 4.84e-09, B: 0.79038835602, C: 28.4541880032},
+		{A: 5.29e-09, B: 5.46978501034, C: 419.4364597654},
+		{A: 5.97e-09, B: 4.98058295172, C: 1251.3403846248},
+		{A: 4.92e-09, B: 3.96066546484, C: 1269.4996318895},
+		{A: 4.82e-09, B: 3.6016766249, C: 2943.5060541272},
+		{A: 6.3e-09, B: 6.16496640092, C: 105.5404547734},
+		{A: 4.8e-09, B: 0.86786400621, C: 35.212274331},
+		{A: 5.16e-09, B: 5.97528782923, C: 3067.9394693482},
+		{A: 5.86e-09, B: 5.48467997697, C: 56.6223513026},
+		{A: 5.02e-09, B: 1.43671788959, C: 469.8872200543},
+		{A: 4.73e-09, B: 2.28007170041, C: 2042.4977891028},
+		{A: 5.65e-09, B: 1.90952569252, C: 107.2855599126},
+		{A: 4.52e-09, B: 3.13938145287, C: 934.9485149682},
+		{A: 6.05e-09, B: 1.65413715574, C: 761.7400086283},
+		{A: 4.43e-09, B: 5.46282223686, C: 135.336103133},
+		{A: 5.8e-09, B: 2.06327501551, C: 493.0424021651},
+		{A: 5.4e-09, B: 1.7377799597, C: 536.7563279856},
+		{A: 4.32e-09, B: 0.27167052107, C: 93.531546663},
+		{A: 5.15e-09, B: 3.46469417437, C: 530.9629894818},
+		{A: 4.4e-09, B: 5.28884782489, C: 497.4476361802},
+		{A: 4.87e-09, B: 5.78767525063, C: 12036.4607348882},
+		{A: 4.52e-09, B: 2.57855172248, C: 1254.5217783625},
+		{A: 4.27e-09, B: 3.21032629463, C: 2840.4132799086},
+		{A: 4.14e-09, B: 1.54298025443, C: 115.6229471908},
+		{A: 4.24e-09, B: 0.12699448931, C: 1268.7488723641},
+		{A: 4.11e-09, B: 3.12424023238, C: 536.2832472336},
+		{A: 4.52e-09, B: 1.00194596383, C: 113.3877149571},
+		{A: 4.19e-09, B: 0.81834479225, C: 1165.6560981455},
+		{A: 4.9e-09, B: 4.72785081986, C: 277.0349937414},
+		{A: 4.34e-09, B: 0.36146539146, C: 1304.9243545416},
+		{A: 4.01e-09, B: 5.70326543719, C: 1127.0499817557},
+		{A: 4.61e-09, B: 3.2646289482, C: 102.1295663721},
+		{A: 5.33e-09, B: 2.54951615753, C: 141.2258098564},
+		{A: 4.13e-09, B: 4.38801694479, C: 6151.533888305},
+		{A: 4.15e-09, B: 1.68861617902, C: 391.1734682239},
+		{A: 3.85e-09, B: 1.69092319074, C: 4113.0943055358},
+		{A: 4.5e-09, B: 5.49339192735, C: 602.9880909536},
+		{A: 4.99e-09, B: 3.80738617353, C: 81.0013736908},
+		{A: 4.54e-09, B: 0.10952919733, C: 600.5404103988},
+		{A: 3.77e-09, B: 6.25375060718, C: 913.7508863177},
+		{A: 4.53e-09, B: 3.86104865567, C: 758.7710632117},
+		{A: 4.01e-09, B: 4.44475618337, C: 990.2294059144},
+		{A: 4.07e-09, B: 5.13442416563, C: 3487.4241132234},
+		{A: 4.35e-09, B: 3.7610335849, C: 523.0986829556},
+		{A: 4.25e-09, B: 3.22287851959, C: 2655.5683724738},
+		{A: 3.65e-09, B: 5.16456645463, C: 4694.0029547076},
+		{A: 4.54e-09, B: 1.6332519795, C: 976.0023119128},
+		{A: 4.06e-09, B: 2.72102389267, C: 1438.0252254409},
+		{A: 3.49e-09, B: 3.59598366422, C: 1058.8606653274},
+		{A: 3.54e-09, B: 0.6213633142, C: 498.6714764576},
+		{A: 3.83e-09, B: 5.09229089574, C: 539.2521926502},
+		{A: 3.8e-09, B: 3.92653231573, C: 561.1835344836},
+		{A: 3.39e-09, B: 4.12175871949, C: 3906.9087570986},
+		{A: 4.58e-09, B: 3.42556794767, C: 121.2520214833},
+		{A: 4.27e-09, B: 3.6128526491, C: 860.3099287528},
+		{A: 4.24e-09, B: 4.72757252331, C: 1366.2125722902},
+		{A: 3.28e-09, B: 4.55286002816, C: 1696.0978227655},
+		{A: 3.24e-09, B: 4.2368500521, C: 642.3449668688},
+		{A: 3.95e-09, B: 3.26282558955, C: 484.444382456},
+		{A: 3.3e-09, B: 6.05223507989, C: 215.7467759928},
+		{A: 3.18e-09, B: 2.0207280007, C: 2964.8466951296},
+		{A: 4.17e-09, B: 0.20173093597, C: 842.9014410135},
+		{A: 4.08e-09, B: 0.45800247268, C: 1578.0271950199},
+		{A: 3.42e-09, B: 6.15347077985, C: 1371.8416465827},
+		{A: 3.1e-09, B: 1.97259286255, C: 754.0357607965},
+		{A: 3.4e-09, B: 2.77813018312, C: 3.523118349},
+		{A: 3.33e-09, B: 2.91352254678, C: 576.1613880106},
+		{A: 3.24e-09, B: 0.32544817254, C: 586.3133163972},
+		{A: 3.02e-09, B: 2.08708848849, C: 526.9826521089},
+		{A: 3.63e-09, B: 4.7056711323, C: 2730.2069586892},
+		{A: 3e-09, B: 0.94464473068, C: 1432.3961511484},
+		{A: 3.52e-09, B: 5.75013621801, C: 806.725958836},
+		{A: 2.96e-09, B: 3.97807312133, C: 2043.9822618111},
+		{A: 2.95e-09, B: 2.35257797599, C: 4216.1870797544},
+		{A: 3.09e-09, B: 2.49768755925, C: 4326.3934009738},
+		{A: 3.06e-09, B: 3.35876843257, C: 2424.1100297711},
+		{A: 3e-09, B: 4.94288858368, C: 1379.7059531089},
+		{A: 3.36e-09, B: 4.49193455535, C: 1585.8915015461},
+		{A: 4.02e-09, B: 2.04684001796, C: 842.1506814881},
+		{A: 3.12e-09, B: 4.59043534747, C: 188.9200730498},
+		{A: 3.46e-09, B: 5.19792097706, C: 523.4711899711},
+		{A: 3.8e-09, B: 1.67961600066, C: 36.6485629295},
+		{A: 3.38e-09, B: 1.32014513725, C: 148.0787244263},
+		{A: 3.91e-09, B: 4.82224015188, C: 1012.9115072732},
+		{A: 2.85e-09, B: 3.43655052437, C: 1053.9653042178},
+		{A: 3.32e-09, B: 2.02575636311, C: 1091.6252591036},
+		{A: 2.82e-09, B: 5.7886532189, C: 1064.0477966352},
+		{A: 2.82e-09, B: 0.39153852422, C: 207.6700211455},
+		{A: 2.8e-09, B: 3.80196391678, C: 298.2326223919},
+		{A: 3.87e-09, B: 6.2681930999, C: 1141.1340634054},
+		{A: 3.49e-09, B: 4.09121908199, C: 1059.903195051},
+		{A: 3.2e-09, B: 0.39871942, C: 2122.6960136415},
+		{A: 3.27e-09, B: 4.76503823073, C: 134.5853436076},
+		{A: 2.83e-09, B: 3.90409016441, C: 127.4717966068},
+		{A: 3.01e-09, B: 4.30291951219, C: 299.1263942692},
+		{A: 3.22e-09, B: 2.4825105268, C: 1065.6017053127},
+		{A: 2.97e-09, B: 2.40814103509, C: 1591.5205758386},
+		{A: 2.86e-09, B: 5.85849626574, C: 172.2452984934},
+		{A: 2.85e-09, B: 4.55845472479, C: 1446.62324515},
+		{A: 2.7e-09, B: 4.08342186112, C: 1578.7779545453},
+		{A: 3.62e-09, B: 1.06148806683, C: 181.806526049},
+		{A: 3.35e-09, B: 4.51094500655, C: 2349.3284312038},
+		{A: 3.47e-09, B: 0.62281394535, C: 1542.6024723678},
+		{A: 2.75e-09, B: 3.38473403113, C: 4002.8879843164},
+		{A: 2.55e-09, B: 1.52357936497, C: 1688.2335162393},
+		{A: 2.76e-09, B: 4.32192160071, C: 1912.5783119412},
+		{A: 2.53e-09, B: 2.40482338279, C: 97.6761482472},
+		{A: 2.48e-09, B: 4.45058246237, C: 1688.9842757647},
+		{A: 3e-09, B: 3.07435583442, C: 1902.2833712027},
+		{A: 2.57e-09, B: 4.79180478086, C: 1670.8250285},
+		{A: 3.19e-09, B: 1.34244222683, C: 1288.4620283063},
+		{A: 2.45e-09, B: 4.01852686769, C: 1567.7322542814},
+		{A: 2.78e-09, B: 0.25406312148, C: 874.3940104025},
+		{A: 3.24e-09, B: 5.57824969423, C: 1670.0742689746},
+		{A: 3e-09, B: 4.67161812947, C: 1329.3033769298},
+		{A: 2.41e-09, B: 0.01789818312, C: 1586.625214729},
+		{A: 2.95e-09, B: 5.86996114913, C: 2804.2377977311},
+		{A: 3.17e-09, B: 3.17967272487, C: 1020.025054274},
+		{A: 2.38e-09, B: 4.97765946754, C: 351.8165923087},
+		{A: 3.02e-09, B: 1.20236375616, C: 232.0490435337},
+		{A: 3.01e-09, B: 5.53432687957, C: 2274.5468326365},
+		{A: 2.86e-09, B: 2.41008592059, C: 2545.3620512544},
+		{A: 2.94e-09, B: 2.01783542485, C: 313.9441891018},
+		{A: 2.92e-09, B: 2.12690999284, C: 1592.2542890215},
+		{A: 2.5e-09, B: 2.31712163679, C: 632.2624744514},
+		{A: 2.38e-09, B: 5.06557054569, C: 3803.81598288},
+		{A: 2.26e-09, B: 0.05916712753, C: 1518.2234499796},
+		{A: 2.35e-09, B: 0.16574304942, C: 137.0330241624},
+		{A: 2.98e-09, B: 2.99720233431, C: 1467.8208738005},
+		{A: 2.86e-09, B: 5.08357076653, C: 774.0095491696},
+		{A: 2.46e-09, B: 2.81685822336, C: 633.305004175},
+		{A: 2.69e-09, B: 4.93023426152, C: 151.0476698429},
+		{A: 2.28e-09, B: 6.13118739321, C: 3281.2385647862},
+		{A: 2.28e-09, B: 1.22066024988, C: 700.4517908797},
+		{A: 2.39e-09, B: 0.71695698501, C: 1276.6131788903},
+		{A: 2.89e-09, B: 6.08263862565, C: 3384.3313390048},
+		{A: 2.18e-09, B: 2.90308501961, C: 85.8272988312},
+		{A: 2.83e-09, B: 6.28058228271, C: 71.8126531507},
+		{A: 2.71e-09, B: 6.01605074549, C: 170.7608257851},
+		{A: 2.21e-09, B: 0.99914179141, C: 1053.7528558967},
+		{A: 2.18e-09, B: 1.50681393471, C: 1087.6931058405},
+		{A: 2.23e-09, B: 3.39126063354, C: 3259.8979237838},
+		{A: 2.29e-09, B: 1.19373202707, C: 1060.8664028975},
+		{A: 2.64e-09, B: 3.93467945263, C: 1363.2436268736},
+		{A: 2.28e-09, B: 5.04188376116, C: 1064.7985561606},
+		{A: 2.95e-09, B: 2.1525308639, C: 6386.16862421},
+		{A: 2.14e-09, B: 3.85961180377, C: 4223.3006267552},
+		{A: 2.18e-09, B: 0.79681703388, C: 1909.3969182035},
+		{A: 2.12e-09, B: 4.11706418218, C: 269.9214467406},
+		{A: 2.64e-09, B: 5.81676406517, C: 77.962992305},
+		{A: 2.56e-09, B: 5.65978708108, C: 799.6124118352},
+		{A: 2.42e-09, B: 6.25078283449, C: 1621.3162241982},
+		{A: 2.35e-09, B: 2.20668997852, C: 1570.9136480191},
+		{A: 2.12e-09, B: 2.88214546012, C: 1674.0064222377},
+		{A: 2.06e-09, B: 1.59586787037, C: 4429.4861751924},
+		{A: 2.08e-09, B: 2.31366614282, C: 878.3261636656},
+		{A: 2.13e-09, B: 0.30373338388, C: 8624.2126509272},
+		{A: 2.23e-09, B: 4.88419887133, C: 1035.002907801},
+		{A: 2.79e-09, B: 3.65173543621, C: 84.9335269539},
+		{A: 2.1e-09, B: 4.08825553401, C: 203.7378678824},
+		{A: 2.14e-09, B: 4.63498396475, C: 812.1425848074},
+		{A: 2.58e-09, B: 1.7350168845, C: 1887.3055176757},
+		{A: 2.1e-09, B: 4.5179808271, C: 1262.3860848887},
+		{A: 2.52e-09, B: 5.69246905091, C: 104.5772469269},
+		{A: 2.05e-09, B: 4.62946016431, C: 1056.4611688824},
+		{A: 2.63e-09, B: 3.04951219565, C: 1493.093668066},
+		{A: 2.22e-09, B: 5.54424082649, C: 5216.5803728014},
+		{A: 2.44e-09, B: 0.91026645686, C: 3707.8367556622},
+		{A: 2.04e-09, B: 0.90117975859, C: 1408.0171287602},
+		{A: 2.25e-09, B: 1.23997048012, C: 3340.6124266998},
+		{A: 2.58e-09, B: 2.35906183505, C: 2861.753920911},
+		{A: 2.67e-09, B: 3.27705002283, C: 5120.6011455836},
+		{A: 2.14e-09, B: 0.66988779149, C: 9146.790069021},
+		{A: 2.35e-09, B: 4.93761209111, C: 1443.4418514123},
+		{A: 1.94e-09, B: 1.60798828275, C: 102.5715093568},
+		{A: 2.15e-09, B: 0.97603524747, C: 479.2883889155},
+		{A: 2.05e-09, B: 5.23642605904, C: 4649.8988176312},
+		{A: 2.57e-09, B: 4.70227260707, C: 9050.8108418032},
+		{A: 2.28e-09, B: 6.23410921116, C: 64.9597385808},
+		{A: 1.8e-09, B: 4.21309134581, C: 143.9341228421},
+		{A: 1.8e-09, B: 4.82870451226, C: 1063.5747158832},
+		{A: 1.8e-09, B: 5.06126965624, C: 52.6901980395},
+		{A: 2.26e-09, B: 0.55334952097, C: 554.0699874828},
+		{A: 2.09e-09, B: 5.67975843693, C: 48.7580447764},
+		{A: 1.86e-09, B: 3.66368928017, C: 108.7218485111},
+		{A: 1.9e-09, B: 2.00852986549, C: 1058.6311706638},
+		{A: 1.83e-09, B: 3.1735846422, C: 140.9651774255},
+		{A: 1.98e-09, B: 5.49816579454, C: 4333.5069479746},
+		{A: 2.4e-09, B: 6.06602357868, C: 1821.1219388175},
+		{A: 1.72e-09, B: 3.04802064781, C: 54.3347294422},
+		{A: 1.7e-09, B: 4.66520291204, C: 1372.5924061081},
+		{A: 1.73e-09, B: 4.72884056307, C: 77204.32749453338},
+		{A: 1.74e-09, B: 0.85370421252, C: 1587.5884225755},
+		{A: 2.15e-09, B: 0.68219980704, C: 1054.7160637432},
+		{A: 1.7e-09, B: 1.52204803308, C: 5591.9608796002},
+		{A: 2e-09, B: 1.60275092073, C: 6681.2248533996},
+		{A: 1.93e-09, B: 2.1300347928, C: 103.6140390804},
+		{A: 2.31e-09, B: 4.69962389031, C: 1966.2317178272},
+		{A: 1.79e-09, B: 5.57395905447, C: 1457.525933062},
+		{A: 2.05e-09, B: 3.65507571128, C: 906.849787638},
+		{A: 1.81e-09, B: 4.52272934666, C: 24498.8302462904},
+		{A: 2.23e-09, B: 0.11650319998, C: 67.8804998876},
+		{A: 1.72e-09, B: 5.68083885227, C: 1884.124123938},
+		{A: 2.19e-09, B: 0.60964963735, C: 2729.4561991638},
+		{A: 1.64e-09, B: 1.06675279755, C: 594.6507036754},
+		{A: 1.76e-09, B: 2.36848603898, C: 977.4867846211},
+		{A: 1.7e-09, B: 2.430366848, C: 4532.578949411},
+		{A: 1.91e-09, B: 3.64255924842, C: 1440.9941708575},
+		{A: 2.07e-09, B: 0.49276008455, C: 71.6002048296},
+		{A: 1.57e-09, B: 4.26888100582, C: 5069.3834615064},
+		{A: 1.57e-09, B: 5.14847227422, C: 451.7279727896},
+		{A: 1.58e-09, B: 5.00063628575, C: 650.9429865779},
+		{A: 1.59e-09, B: 5.37530499642, C: 20426.571092422},
+		{A: 2.18e-09, B: 0.27875408082, C: 175.1660598002},
+		{A: 1.55e-09, B: 0.83696849428, C: 1474.9344208013},
+		{A: 1.54e-09, B: 2.62839957291, C: 683.1863154923},
+		{A: 1.71e-09, B: 1.79511736017, C: 1123.1178284926},
+		{A: 1.88e-09, B: 5.24747110812, C: 25565.3257234804},
+		{A: 1.68e-09, B: 4.14907553818, C: 946.727928415},
+		{A: 2.03e-09, B: 2.8369971553, C: 1489.9122743283},
+		{A: 1.73e-09, B: 4.34546063838, C: 3046.5988283458},
+		{A: 1.9e-09, B: 5.67865607835, C: 1060.1326897146},
+		{A: 2.01e-09, B: 2.3852418292, C: 419.532827985},
+		{A: 1.52e-09, B: 5.8908868579, C: 208.633228992},
+		{A: 2.06e-09, B: 4.46933127349, C: 2654.6746005965},
+		{A: 1.56e-09, B: 2.37819796438, C: 2758.6611466924},
+		{A: 2.03e-09, B: 0.70565514297, C: 498.1983957056},
+		{A: 2.05e-09, B: 3.05468636546, C: 1062.302691496},
+		{A: 1.74e-09, B: 3.50824761708, C: 2004.364753465},
+		{A: 1.48e-09, B: 4.73961194393, C: 1799.1905969841},
+		{A: 1.88e-09, B: 3.62315953725, C: 3156.8051495652},
+		{A: 1.83e-09, B: 2.35011338194, C: 25551.09862947879},
+		{A: 1.62e-09, B: 1.58053710589, C: 628.5909536192},
+		{A: 1.62e-09, B: 3.99983876824, C: 1482.7987273275},
+		{A: 1.81e-09, B: 2.85489861839, C: 1055.1891444952},
+		{A: 1.51e-09, B: 3.43198157222, C: 629.8629780064},
+		{A: 1.57e-09, B: 3.1519582649, C: 1025.4416802454},
+		{A: 1.94e-09, B: 5.13049187783, C: 1818.1529934009},
+		{A: 1.93e-09, B: 1.92287052164, C: 1140.38330388},
+		{A: 1.37e-09, B: 4.2233522197, C: 1049.8207026336},
+		{A: 1.67e-09, B: 2.85163087563, C: 5746.271337896},
+		{A: 1.67e-09, B: 5.73970282991, C: 5760.4984318976},
+		{A: 1.38e-09, B: 2.23519776527, C: 1176.7017984094},
+		{A: 1.51e-09, B: 4.89507270899, C: 532.3992780803},
+		{A: 1.47e-09, B: 2.65931838448, C: 987.3086446076},
+		{A: 1.35e-09, B: 0.1283641777, C: 991.7138786227},
+		{A: 1.66e-09, B: 3.12682515439, C: 580.0935412737},
+		{A: 1.18e-09, B: 5.988105763, C: 531.387886124},
+		{A: 1.35e-09, B: 5.26601313643, C: 1065.0110044817},
+		{A: 1.38e-09, B: 3.18511244397, C: 707.5653378805},
+		{A: 1.22e-09, B: 1.34377059565, C: 446.3113468182},
+		{A: 1.2e-09, B: 2.29717714347, C: 1059.2218714948},
+		{A: 1.21e-09, B: 0.58145552537, C: 5621.8429232104},
+		{A: 1.03e-09, B: 4.75645235023, C: 1226.2106027112},
+		{A: 1.04e-09, B: 6.08481630139, C: 528.2546764961},
+		{A: 1.19e-09, B: 1.06475523307, C: 527.9940440652},
+		{A: 1.04e-09, B: 0.89730746841, C: 531.1272536931},
+		{A: 1.2e-09, B: 5.39001411803, C: 1059.5419888836},
+		{A: 1.04e-09, B: 0.44849170648, C: 1128.534454464},
+		{A: 1.17e-09, B: 5.42449214711, C: 986.0848043302},
+		{A: 1.01e-09, B: 5.09893554462, C: 530.5847369719},
+		{A: 1.02e-09, B: 0.26948040239, C: 450.9772132642},
+		{A: 1.07e-09, B: 1.58724086516, C: 1069.6768709277},
+		{A: 8.6e-10, B: 2.28711702506, C: 2498.8916283384},
+		{A: 1.01e-09, B: 1.88318822518, C: 528.7971932173},
+		{A: 8.6e-10, B: 1.37568728263, C: 970.5162499722},
+		{A: 8.3e-10, B: 0.06930748288, C: 530.914805372},
+		{A: 8.5e-10, B: 3.22094000094, C: 1553.6481726317},
+		{A: 8.3e-10, B: 0.62963097974, C: 528.4671248172},
+		{A: 8.3e-10, B: 4.16314675511, C: 849.2642284889},
+		{A: 7.9e-10, B: 3.4668810234, C: 1077.5411774539},
+		{A: 9.7e-10, B: 0.87886975916, C: 9690.7081281172},
+		{A: 9.7e-10, B: 4.27398311206, C: 9676.4810341156},
+		{A: 1.01e-09, B: 0.29639798579, C: 857.1285350151},
+		{A: 8.3e-10, B: 2.55427333923, C: 1059.5943785103},
+		{A: 7.8e-10, B: 0.0646149621, C: 521.8266585684},
+		{A: 7.8e-10, B: 0.76677000862, C: 525.5463635104},
+		{A: 9.6e-10, B: 0.33631035749, C: 1090.4014188262},
+		{A: 9.8e-10, B: 1.42815294497, C: 757.2171545342},
+		{A: 7.7e-10, B: 0.85066773729, C: 537.5552716208},
+		{A: 8.4e-10, B: 5.04765104413, C: 1160.027023853},
+		{A: 7.6e-10, B: 3.62264327413, C: 782.3469364478},
+		{A: 8.5e-10, B: 1.86831145784, C: 25028.521211385},
+		{A: 7.9e-10, B: 2.9060220289, C: 2114.8317071153},
+	},
+	// L1, 369 terms
+	{
+		{A: 529.93480757497, B: 0, C: 0},
+		{A: 0.00489741194, B: 4.22066689928, C: 529.6909650946},
+		{A: 0.00228918538, B: 6.02647464016, C: 7.1135470008},
+		{A: 0.0002765538, B: 4.57265956824, C: 1059.3819301892},
+		{A: 0.00020720943, B: 5.45938936295, C: 522.5774180938},
+		{A: 0.00012105732, B: 0.16985765041, C: 536.8045120954},
+		{A: 6.068051e-05, B: 4.42419502005, C: 103.0927742186},
+		{A: 5.433924e-05, B: 3.98478382565, C: 419.4846438752},
+		{A: 4.237795e-05, B: 5.89009351271, C: 14.2270940016},
+		{A: 2.211854e-05, B: 5.26771446618, C: 206.1855484372},
+		{A: 1.295769e-05, B: 5.55132765087, C: 3.1813937377},
+		{A: 1.745919e-05, B: 4.92669378486, C: 1589.0728952838},
+		{A: 1.163411e-05, B: 0.51450895328, C: 3.9321532631},
+		{A: 1.007216e-05, B: 0.46478398551, C: 735.8765135318},
+		{A: 1.173129e-05, B: 5.8564730435, C: 1052.2683831884},
+		{A: 8.47678e-06, B: 5.7580585045, C: 110.2063212194},
+		{A: 8.27329e-06, B: 4.80312015734, C: 213.299095438},
+		{A: 1.003574e-05, B: 3.15040301822, C: 426.598190876},
+		{A: 1.098735e-05, B: 5.30704981594, C: 515.463871093},
+		{A: 8.16397e-06, B: 0.58643054886, C: 1066.49547719},
+		{A: 7.25447e-06, B: 5.51827471473, C: 639.897286314},
+		{A: 5.67845e-06, B: 5.98867049451, C: 625.6701923124},
+		{A: 4.74181e-06, B: 4.13245269168, C: 412.3710968744},
+		{A: 4.1293e-06, B: 5.73652891261, C: 95.9792272178},
+		{A: 3.35817e-06, B: 3.73248749046, C: 1162.4747044078},
+		{A: 3.45249e-06, B: 4.2415956541, C: 632.7837393132},
+		{A: 2.34066e-06, B: 6.24302226646, C: 309.2783226558},
+		{A: 1.94784e-06, B: 2.21879010911, C: 323.5054166574},
+		{A: 2.3434e-06, B: 4.03469970332, C: 949.1756089698},
+		{A: 1.83938e-06, B: 6.27963588822, C: 543.9180590962},
+		{A: 1.98525e-06, B: 1.50458442825, C: 838.9692877504},
+		{A: 1.86899e-06, B: 6.08620565908, C: 742.9900605326},
+		{A: 1.7138e-06, B: 5.41655983845, C: 199.0720014364},
+		{A: 1.30771e-06, B: 0.62643377351, C: 728.762966531},
+		{A: 1.07575e-06, B: 4.49282760117, C: 956.2891559706},
+		{A: 1.15393e-06, B: 0.68019050174, C: 846.0828347512},
+		{A: 1.15047e-06, B: 5.28641699144, C: 2118.7638603784},
+		{A: 6.6824e-07, B: 5.73365126533, C: 21.3406410024},
+		{A: 6.9618e-07, B: 5.97263450278, C: 532.8723588323},
+		{A: 6.485e-07, B: 6.08803490288, C: 1581.959348283},
+		{A: 7.9686e-07, B: 5.82412400273, C: 1045.1548361876},
+		{A: 5.7939e-07, B: 0.99453087342, C: 1596.1864422846},
+		{A: 6.5635e-07, B: 0.1292419143, C: 526.5095713569},
+		{A: 5.8509e-07, B: 0.58626971028, C: 1155.361157407},
+		{A: 5.66e-07, B: 1.41198438841, C: 533.6231183577},
+		{A: 7.1643e-07, B: 5.34162650321, C: 942.062061969},
+		{A: 5.7368e-07, B: 5.96851304799, C: 1169.5882514086},
+		{A: 5.4935e-07, B: 5.42806383723, C: 10.2949407385},
+		{A: 5.2016e-07, B: 0.22981299129, C: 1368.660252845},
+		{A: 5.2309e-07, B: 5.72661448388, C: 117.3198682202},
+		{A: 5.0418e-07, B: 6.08075147811, C: 525.7588118315},
+		{A: 4.7418e-07, B: 3.62611843241, C: 1478.8665740644},
+		{A: 3.9888e-07, B: 4.161580136, C: 1692.1656695024},
+		{A: 4.6678e-07, B: 0.51144073175, C: 1265.5674786264},
+		{A: 3.2827e-07, B: 5.03596689455, C: 220.4126424388},
+		{A: 3.3558e-07, B: 0.09913904872, C: 302.164775655},
+		{A: 2.9379e-07, B: 3.35927241533, C: 4.665866446},
+		{A: 2.9307e-07, B: 0.75907909735, C: 88.865680217},
+		{A: 3.2449e-07, B: 5.37492530697, C: 508.3503240922},
+		{A: 2.9483e-07, B: 5.42208897099, C: 1272.6810256272},
+		{A: 2.1802e-07, B: 6.1505405407, C: 1685.0521225016},
+		{A: 2.5195e-07, B: 1.60723063387, C: 831.8557407496},
+		{A: 2.1133e-07, B: 5.863468242, C: 1258.4539316256},
+		{A: 1.9747e-07, B: 2.17205957814, C: 316.3918696566},
+		{A: 1.7871e-07, B: 0.82841413516, C: 433.7117378768},
+		{A: 1.7703e-07, B: 5.95527049039, C: 5.4166259714},
+		{A: 1.723e-07, B: 2.76395560958, C: 853.196381752},
+		{A: 1.7453e-07, B: 0.70749901224, C: 1471.7530270636},
+		{A: 1.7508e-07, B: 0.49799925173, C: 1375.7737998458},
+		{A: 1.4368e-07, B: 0.9145983114, C: 18.1592472647},
+		{A: 1.4107e-07, B: 0.63031082833, C: 2.9207613068},
+		{A: 1.1559e-07, B: 4.30379009964, C: 405.2575498736},
+		{A: 1.1728e-07, B: 1.76426582357, C: 380.12776796},
+		{A: 1.1054e-07, B: 5.56735602213, C: 1574.8458012822},
+		{A: 1.0425e-07, B: 0.3135503439, C: 1361.5467058442},
+		{A: 9.804e-08, B: 5.90363777277, C: 519.3960243561},
+		{A: 9.805e-08, B: 0.38648727979, C: 1073.6090241908},
+		{A: 9.285e-08, B: 3.2184228753, C: 1795.258443721},
+		{A: 8.864e-08, B: 0.53776257958, C: 1788.1448967202},
+		{A: 8.37e-08, B: 5.88484552222, C: 2001.4439921582},
+		{A: 8.148e-08, B: 5.1016231141, C: 1485.9801210652},
+		{A: 7.658e-08, B: 5.64890060131, C: 2648.454825473},
+		{A: 6.69e-08, B: 2.4109345942, C: 4.192785694},
+		{A: 5.84e-08, B: 4.22347896053, C: 2008.557539159},
+		{A: 7.256e-08, B: 6.19384525651, C: 11.0457002639},
+		{A: 6.266e-08, B: 1.36137786945, C: 1148.2476104062},
+		{A: 5.141e-08, B: 5.23083932012, C: 628.8515860501},
+		{A: 5.14e-08, B: 2.92955981951, C: 518.6452648307},
+		{A: 4.765e-08, B: 0.16838181862, C: 629.6023455755},
+		{A: 4.603e-08, B: 0.78529559911, C: 721.6494195302},
+		{A: 4.575e-08, B: 6.24794935732, C: 1677.9385755008},
+		{A: 4.537e-08, B: 4.95096707833, C: 635.9651330509},
+		{A: 4.518e-08, B: 2.06523915453, C: 453.424893819},
+		{A: 4.414e-08, B: 0.15381186059, C: 1699.2792165032},
+		{A: 5.593e-08, B: 5.57489981207, C: 191.9584544356},
+		{A: 5.403e-08, B: 1.46004886198, C: 330.6189636582},
+		{A: 4.285e-08, B: 0.23949868127, C: 2104.5367663768},
+		{A: 4.223e-08, B: 1.44087555881, C: 2125.8774073792},
+		{A: 4.101e-08, B: 6.19274358942, C: 636.7158925763},
+		{A: 4.432e-08, B: 4.35811524051, C: 423.4167971383},
+		{A: 4.132e-08, B: 0.50170694173, C: 1056.2005364515},
+		{A: 4.398e-08, B: 4.14280286969, C: 511.5317178299},
+		{A: 5.406e-08, B: 4.40429493698, C: 2221.856634597},
+		{A: 4.467e-08, B: 0.08534650684, C: 1062.5633239269},
+		{A: 3.569e-08, B: 5.6654047701, C: 2317.8358618148},
+		{A: 4.007e-08, B: 2.54845549248, C: 74.7815985673},
+		{A: 3.515e-08, B: 0.25495124831, C: 1055.4497769261},
+		{A: 3.687e-08, B: 2.93378008847, C: 32.2433289144},
+		{A: 2.883e-08, B: 5.72793010505, C: 99.9113804809},
+		{A: 2.969e-08, B: 5.50054720569, C: 107.0249274817},
+		{A: 2.72e-08, B: 1.25222590925, C: 540.7366653585},
+		{A: 2.808e-08, B: 3.30714813896, C: 0.7507595254},
+		{A: 2.768e-08, B: 1.61339487804, C: 1063.3140834523},
+		{A: 2.666e-08, B: 4.28662288102, C: 106.2741679563},
+		{A: 2.704e-08, B: 3.03615556153, C: 422.6660376129},
+		{A: 3.29e-08, B: 5.8908168215, C: 1802.3719907218},
+		{A: 2.578e-08, B: 3.60390367979, C: 750.1036075334},
+		{A: 2.661e-08, B: 0.35249312659, C: 1898.3512179396},
+		{A: 2.486e-08, B: 5.28950877719, C: 1891.2376709388},
+		{A: 2.936e-08, B: 1.0905202945, C: 1464.6394800628},
+		{A: 3.19e-08, B: 4.60740643547, C: 416.3032501375},
+		{A: 2.39e-08, B: 6.01779736611, C: 551.031606097},
+		{A: 2.214e-08, B: 5.2445092318, C: 621.7380390493},
+		{A: 2.319e-08, B: 5.8292030013, C: 305.3461693927},
+		{A: 2.089e-08, B: 5.99310370434, C: 1994.3304451574},
+		{A: 2.042e-08, B: 0.75008788531, C: 142.4496501338},
+		{A: 2.121e-08, B: 0.01537599023, C: 2420.9286360334},
+		{A: 2.114e-08, B: 6.25308371567, C: 647.0108333148},
+		{A: 2.02e-08, B: 4.17560390841, C: 569.0478410098},
+		{A: 2.109e-08, B: 5.18682321403, C: 227.5261894396},
+		{A: 2.283e-08, B: 5.80043809222, C: 539.9859058331},
+		{A: 1.977e-08, B: 3.99197009651, C: 24.3790223882},
+		{A: 1.96e-08, B: 1.35288793079, C: 963.4027029714},
+		{A: 1.903e-08, B: 2.78349628184, C: 2428.0421830342},
+		{A: 1.915e-08, B: 4.22134509685, C: 2324.9494088156},
+		{A: 1.971e-08, B: 5.88715684267, C: 217.2312487011},
+		{A: 1.917e-08, B: 3.03728154374, C: 1382.8873468466},
+		{A: 2.026e-08, B: 3.08606488714, C: 408.4389436113},
+		{A: 1.834e-08, B: 5.61474110217, C: 430.5303441391},
+		{A: 1.838e-08, B: 1.25467410218, C: 81.7521332162},
+		{A: 2.46e-08, B: 4.63268678998, C: 1905.4647649404},
+		{A: 1.82e-08, B: 5.9749792612, C: 114.1384744825},
+		{A: 2.043e-08, B: 4.34047514845, C: 70.8494453042},
+		{A: 1.959e-08, B: 4.03116026306, C: 92.0470739547},
+		{A: 1.768e-08, B: 0.33097462499, C: 35.4247226521},
+		{A: 2.334e-08, B: 5.8704263847, C: 1038.0412891868},
+		{A: 1.835e-08, B: 4.81326127892, C: 124.433415221},
+		{A: 2.269e-08, B: 1.02549350754, C: 618.5566453116},
+		{A: 1.919e-08, B: 5.01297395549, C: 99.1606209555},
+		{A: 1.923e-08, B: 0.28688549585, C: 31.019488637},
+		{A: 1.878e-08, B: 5.69299116574, C: 210.1177017003},
+		{A: 1.679e-08, B: 0.25635730278, C: 295.0512286542},
+		{A: 1.656e-08, B: 5.46039280732, C: 2634.2277314714},
+		{A: 1.675e-08, B: 6.15609073315, C: 643.8294395771},
+		{A: 1.953e-08, B: 5.09846435548, C: 17.4084877393},
+		{A: 1.539e-08, B: 2.75316078346, C: 415.5524906121},
+		{A: 1.467e-08, B: 0.54812675158, C: 458.8415197904},
+		{A: 1.482e-08, B: 3.76736278426, C: 534.3568315406},
+		{A: 1.446e-08, B: 3.15802770791, C: 25.1297819136},
+		{A: 1.667e-08, B: 0.26406950755, C: 835.0371344873},
+		{A: 1.472e-08, B: 0.83054329617, C: 28.3111756513},
+		{A: 1.655e-08, B: 0.88908548504, C: 1781.0313497194},
+		{A: 1.294e-08, B: 5.76241191046, C: 440.8252848776},
+		{A: 1.348e-08, B: 2.49823510924, C: 984.6003316219},
+		{A: 1.352e-08, B: 5.10869562455, C: 149.5631971346},
+		{A: 1.344e-08, B: 0.01942249067, C: 2214.7430875962},
+		{A: 1.188e-08, B: 2.24279457878, C: 31.492569389},
+		{A: 1.166e-08, B: 0.80686346228, C: 739.8086667949},
+		{A: 1.322e-08, B: 4.25691184168, C: 2538.2485042536},
+		{A: 1.094e-08, B: 6.02985819406, C: 2737.32050569},
+		{A: 1.112e-08, B: 4.3820436067, C: 561.934294009},
+		{A: 1.346e-08, B: 3.2057584887, C: 525.0250986486},
+		{A: 1.056e-08, B: 5.76507115032, C: 2310.722314814},
+		{A: 1.159e-08, B: 0.4618956497, C: 67.6680515665},
+		{A: 1.027e-08, B: 0.20709586018, C: 7.8643065262},
+		{A: 1.143e-08, B: 5.56626418636, C: 46.470422916},
+		{A: 1.012e-08, B: 0.54293005597, C: 532.1386456494},
+		{A: 9.78e-09, B: 5.13939194101, C: 2207.6295405954},
+		{A: 9.93e-09, B: 2.03698185233, C: 319.5732633943},
+		{A: 1.035e-08, B: 2.90231353535, C: 611.4430983108},
+		{A: 1.021e-08, B: 4.75651217048, C: 527.2432845398},
+		{A: 1.308e-08, B: 1.78809336431, C: 824.7421937488},
+		{A: 9.64e-09, B: 2.82269601958, C: 2111.6503133776},
+		{A: 8.96e-09, B: 2.54505998806, C: 2744.4340526908},
+		{A: 8.9e-09, B: 5.41036782817, C: 28.4541880032},
+		{A: 9.06e-09, B: 0.76565238554, C: 1439.5096981492},
+		{A: 9.85e-09, B: 0.8868762377, C: 5760.4984318976},
+		{A: 9.83e-09, B: 1.42102343372, C: 5746.271337896},
+		{A: 8.92e-09, B: 5.87250060663, C: 203.0041546995},
+		{A: 9.42e-09, B: 2.31049430734, C: 9690.7081281172},
+		{A: 9.41e-09, B: 2.84331157527, C: 9676.4810341156},
+		{A: 8.67e-09, B: 0.81020362547, C: 524.2743391232},
+		{A: 8.29e-09, B: 2.35178495412, C: 312.4597163935},
+		{A: 9.12e-09, B: 2.80494184378, C: 6.2197751235},
+		{A: 8.09e-09, B: 1.05148218513, C: 529.6427809848},
+		{A: 7.79e-09, B: 4.80009242059, C: 945.2434557067},
+		{A: 8.78e-09, B: 5.76532521399, C: 1.6445314027},
+		{A: 9.53e-09, B: 4.30945738629, C: 209.3669421749},
+		{A: 7.72e-09, B: 5.25607113566, C: 2950.619601128},
+		{A: 7.45e-09, B: 0.03810558502, C: 535.107591066},
+		{A: 7.44e-09, B: 0.58381523987, C: 25.2727942655},
+		{A: 7.34e-09, B: 0.208004851, C: 1049.0869894507},
+		{A: 7.47e-09, B: 2.71772840871, C: 38.1330356378},
+		{A: 7.28e-09, B: 5.97210358938, C: 945.9942152321},
+		{A: 7.69e-09, B: 4.51394016967, C: 952.3570027075},
+		{A: 7.1e-09, B: 0.38016353553, C: 69.1525242748},
+		{A: 7.6e-09, B: 3.07033779824, C: 39.3568759152},
+		{A: 8.02e-09, B: 1.14191463412, C: 532.6117264014},
+		{A: 7.04e-09, B: 1.2544730812, C: 547.8502123593},
+		{A: 7.21e-09, B: 0.73855379162, C: 2228.9701815978},
+		{A: 7.94e-09, B: 4.25051539085, C: 2641.3412784722},
+		{A: 7.95e-09, B: 3.2058836382, C: 604.4725636619},
+		{A: 8.18e-09, B: 1.05229815343, C: 909.8187330546},
+		{A: 7.24e-09, B: 5.68281830264, C: 953.1077622329},
+		{A: 8.36e-09, B: 0.60410469174, C: 2097.423219376},
+		{A: 6.69e-09, B: 5.75757140051, C: 2015.6710861598},
+		{A: 6.82e-09, B: 1.19994890339, C: 387.2413149608},
+		{A: 6.4e-09, B: 3.91546675664, C: 528.7277572481},
+		{A: 8.09e-09, B: 4.24929331276, C: 529.7391492044},
+		{A: 8.19e-09, B: 4.91540072376, C: 2751.5475996916},
+		{A: 6.92e-09, B: 2.51162384766, C: 916.9322800554},
+		{A: 7.84e-09, B: 4.23651511312, C: 195.1398481733},
+		{A: 7.62e-09, B: 1.12201139619, C: 732.6951197941},
+		{A: 6.17e-09, B: 5.80920925081, C: 739.0579072695},
+		{A: 7.27e-09, B: 4.24401822698, C: 760.25553592},
+		{A: 5.91e-09, B: 3.26075006572, C: 202.2533951741},
+		{A: 5.52e-09, B: 5.83533550039, C: 526.7702037878},
+		{A: 6.4e-09, B: 1.38530872949, C: 530.6541729411},
+		{A: 5.77e-09, B: 6.09100925678, C: 2531.1349572528},
+		{A: 6.2e-09, B: 3.01917904435, C: 902.7051860538},
+		{A: 7.22e-09, B: 5.18171159557, C: 1.4844727083},
+		{A: 5.4e-09, B: 3.7880923082, C: 2957.7331481288},
+		{A: 5.23e-09, B: 3.63882376, C: 437.6438911399},
+		{A: 5.27e-09, B: 5.80796427555, C: 3053.7123753466},
+		{A: 4.88e-09, B: 4.99103190309, C: 483.2205421786},
+		{A: 5.57e-09, B: 4.11381202161, C: 2854.6403739102},
+		{A: 4.92e-09, B: 0.76371083106, C: 1603.2999892854},
+		{A: 4.87e-09, B: 5.55383951779, C: 2627.1141844706},
+		{A: 4.87e-09, B: 5.86510858429, C: 724.8308132679},
+		{A: 4.53e-09, B: 0.61375011101, C: 1159.2933106701},
+		{A: 4.5e-09, B: 2.28121042355, C: 3060.8259223474},
+		{A: 5.15e-09, B: 4.7812605928, C: 447.7958195265},
+		{A: 4.49e-09, B: 4.70231576312, C: 934.9485149682},
+		{A: 4.5e-09, B: 1.91049508739, C: 597.3590166611},
+		{A: 4.38e-09, B: 6.01178917646, C: 3178.1457905676},
+		{A: 4.94e-09, B: 0.53844942275, C: 1354.4331588434},
+		{A: 5.01e-09, B: 5.51752195462, C: 2435.155730035},
+		{A: 4.32e-09, B: 3.64903264921, C: 313.2104759189},
+		{A: 4.35e-09, B: 3.02449828967, C: 533.8837507886},
+		{A: 4.26e-09, B: 5.07945534339, C: 2524.021410252},
+		{A: 4.91e-09, B: 3.592863642, C: 230.5645708254},
+		{A: 5.47e-09, B: 0.34432090949, C: 1251.3403846248},
+		{A: 5.03e-09, B: 1.57454509207, C: 454.9093665273},
+		{A: 4.86e-09, B: 4.39351469958, C: 462.0229135281},
+		{A: 5.24e-09, B: 2.03003740296, C: 1279.794572628},
+		{A: 3.88e-09, B: 5.58318013074, C: 731.9443602687},
+		{A: 4.49e-09, B: 1.11025492739, C: 56.6223513026},
+		{A: 3.98e-09, B: 5.19943284273, C: 3267.0114707846},
+		{A: 4.16e-09, B: 1.70821917336, C: 245.5424243524},
+		{A: 3.79e-09, B: 1.80234948769, C: 2655.5683724738},
+		{A: 3.55e-09, B: 1.65214516751, C: 78.7137518304},
+		{A: 4.04e-09, B: 1.72647262603, C: 1141.1340634054},
+		{A: 3.35e-09, B: 6.01254286794, C: 960.2213092337},
+		{A: 3.31e-09, B: 1.74086938716, C: 490.3340891794},
+		{A: 4.01e-09, B: 0.30034336462, C: 2332.0629558164},
+		{A: 3.36e-09, B: 2.64385574909, C: 1021.2488945514},
+		{A: 3.89e-09, B: 0.31259289221, C: 2413.8150890326},
+		{A: 3.14e-09, B: 5.73833529708, C: 1158.5425511447},
+		{A: 3.13e-09, B: 4.74363791106, C: 938.1299087059},
+		{A: 3.33e-09, B: 0.80112437148, C: 1585.1407420207},
+		{A: 3.23e-09, B: 3.5265624528, C: 3274.1250177854},
+		{A: 3.95e-09, B: 1.73181407631, C: 1593.0050485469},
+		{A: 3.02e-09, B: 4.64184749164, C: 1261.6353253633},
+		{A: 3.25e-09, B: 0.54991590409, C: 43.2890291783},
+		{A: 2.93e-09, B: 0.97977818746, C: 1585.8915015461},
+		{A: 3.41e-09, B: 2.80833606944, C: 1514.2912967165},
+		{A: 3.04e-09, B: 6.12522825214, C: 1262.3860848887},
+		{A: 2.86e-09, B: 2.89800423081, C: 530.2122299564},
+		{A: 3.87e-09, B: 0.46648572639, C: 1592.2542890215},
+		{A: 2.85e-09, B: 4.56394598052, C: 1268.7488723641},
+		{A: 3.1e-09, B: 4.69102289591, C: 76.2660712756},
+		{A: 2.78e-09, B: 5.49867187248, C: 280.9671470045},
+		{A: 3.58e-09, B: 5.45926487831, C: 113.3877149571},
+		{A: 2.83e-09, B: 1.0923050635, C: 1061.829610744},
+		{A: 3.26e-09, B: 0.60265259639, C: 827.9235874865},
+		{A: 2.84e-09, B: 5.36580034539, C: 1165.6560981455},
+		{A: 2.81e-09, B: 5.5463546105, C: 3370.1042450032},
+		{A: 2.69e-09, B: 3.92616563946, C: 42.5382696529},
+		{A: 2.75e-09, B: 2.58465453365, C: 373.0142209592},
+		{A: 3.57e-09, B: 1.39391983207, C: 1493.093668066},
+		{A: 2.58e-09, B: 5.9667069414, C: 1269.4996318895},
+		{A: 2.59e-09, B: 2.56026216784, C: 9146.790069021},
+		{A: 2.81e-09, B: 2.74823090198, C: 4694.0029547076},
+		{A: 2.81e-09, B: 3.0132465594, C: 320.3240229197},
+		{A: 2.72e-09, B: 4.1850495892, C: 8624.2126509272},
+		{A: 2.45e-09, B: 1.24462798353, C: 252.6559713532},
+		{A: 2.44e-09, B: 2.0289276469, C: 3377.217792004},
+		{A: 3.24e-09, B: 1.84851618413, C: 1289.9465010146},
+		{A: 2.21e-09, B: 6.22167997496, C: 3281.2385647862},
+		{A: 2.38e-09, B: 3.93371505401, C: 3171.0322435668},
+		{A: 2.26e-09, B: 5.94296271326, C: 224.3447957019},
+		{A: 2.13e-09, B: 3.6826423475, C: 1048.3362299253},
+		{A: 2.16e-09, B: 5.82941334164, C: 1567.7322542814},
+		{A: 2.95e-09, B: 4.70194747095, C: 3067.9394693482},
+		{A: 2.06e-09, B: 4.98184230959, C: 1357.6145525811},
+		{A: 2.02e-09, B: 1.32439444045, C: 4326.3934009738},
+		{A: 2.27e-09, B: 0.78540105705, C: 59.8037450403},
+		{A: 2.37e-09, B: 5.56926897693, C: 2943.5060541272},
+		{A: 2.07e-09, B: 0.07907015398, C: 5223.6939198022},
+		{A: 1.99e-09, B: 3.30501818656, C: 4120.2078525366},
+		{A: 1.94e-09, B: 5.95526916809, C: 84.9335269539},
+		{A: 2.66e-09, B: 1.58032565718, C: 983.1158589136},
+		{A: 1.98e-09, B: 4.31078641704, C: 4017.115078318},
+		{A: 1.98e-09, B: 0.30166351366, C: 1166.4068576709},
+		{A: 1.88e-09, B: 0.90738705875, C: 135.336103133},
+		{A: 1.86e-09, B: 0.69289672485, C: 92.7978334801},
+		{A: 1.82e-09, B: 1.18931462257, C: 1512.8068240082},
+		{A: 1.91e-09, B: 1.04146023518, C: 1884.124123938},
+		{A: 1.74e-09, B: 6.13734594396, C: 3597.6304344428},
+		{A: 1.89e-09, B: 0.35191512844, C: 1372.5924061081},
+		{A: 1.72e-09, B: 4.35250972697, C: 1578.0271950199},
+		{A: 1.73e-09, B: 2.30241719278, C: 1176.7017984094},
+		{A: 2.2e-09, B: 1.06991056825, C: 2200.5159935946},
+		{A: 1.86e-09, B: 4.90511103807, C: 3583.4033404412},
+		{A: 1.89e-09, B: 0.24160744024, C: 1670.8250285},
+		{A: 2.06e-09, B: 0.01485146863, C: 2730.2069586892},
+		{A: 1.74e-09, B: 1.83997277029, C: 746.9222137957},
+		{A: 2.25e-09, B: 3.1310809966, C: 630.3360587584},
+		{A: 2.06e-09, B: 5.22730929781, C: 3995.7744373156},
+		{A: 1.69e-09, B: 2.57956682688, C: 9161.0171630226},
+		{A: 1.65e-09, B: 1.51795928301, C: 4010.0015313172},
+		{A: 1.81e-09, B: 2.05055200822, C: 842.9014410135},
+		{A: 1.81e-09, B: 5.96554625357, C: 1578.7779545453},
+		{A: 1.66e-09, B: 1.551148631, C: 1070.4276304531},
+		{A: 1.57e-09, B: 5.8783995888, C: 3914.0223040994},
+		{A: 1.6e-09, B: 0.43729819176, C: 2545.3620512544},
+		{A: 1.68e-09, B: 5.73975661792, C: 2847.5268269094},
+		{A: 1.57e-09, B: 2.25764581068, C: 850.0149880143},
+		{A: 1.87e-09, B: 0.64918748618, C: 842.1506814881},
+		{A: 1.8e-09, B: 1.88055488803, C: 685.4739373527},
+		{A: 1.53e-09, B: 4.15259684562, C: 4333.5069479746},
+		{A: 1.54e-09, B: 3.65536637158, C: 77734.01845962799},
+		{A: 1.51e-09, B: 3.17795437121, C: 3590.516887442},
+		{A: 1.55e-09, B: 3.8762354799, C: 327.4375699205},
+		{A: 1.71e-09, B: 3.33647878498, C: 1912.5783119412},
+		{A: 1.88e-09, B: 4.53005359421, C: 1041.2226829245},
+		{A: 1.34e-09, B: 4.09921613445, C: 530.44172462},
+		{A: 1.23e-09, B: 4.79543460218, C: 1098.7388061044},
+		{A: 1.61e-09, B: 2.02006564218, C: 860.3099287528},
+		{A: 1.43e-09, B: 2.40197278329, C: 529.1697002328},
+		{A: 1.15e-09, B: 1.55831212007, C: 9153.9036160218},
+		{A: 1.06e-09, B: 5.94313244357, C: 1057.8974574809},
+		{A: 1.19e-09, B: 5.10578428676, C: 1056.9342496344},
+		{A: 1e-09, B: 5.74974781049, C: 501.2367770914},
+		{A: 9.4e-10, B: 1.40134175492, C: 1059.3337460794},
+		{A: 9.8e-10, B: 3.79115318281, C: 497.4476361802},
+		{A: 9e-10, B: 4.09610113044, C: 1064.0477966352},
+		{A: 1.02e-09, B: 1.10442899544, C: 1969.2006632438},
+		{A: 8.7e-10, B: 0.58218477838, C: 1173.5204046717},
+		{A: 1.09e-09, B: 3.83745968299, C: 525.4981794006},
+		{A: 9.4e-10, B: 4.59915291355, C: 1059.430114299},
+		{A: 1.18e-09, B: 6.11701561559, C: 1069.6768709277},
+		{A: 1.07e-09, B: 5.40509332689, C: 679.2541622292},
+		{A: 8.9e-10, B: 5.90037690244, C: 757.2171545342},
+		{A: 7.8e-10, B: 6.06217863109, C: 970.5162499722},
+		{A: 8e-10, B: 5.45470236239, C: 3163.918696566},
+		{A: 7.2e-10, B: 5.65789862232, C: 1151.4290041439},
+		{A: 8e-10, B: 0.045397201, C: 1080.7225711916},
+		{A: 7.5e-10, B: 4.26526686574, C: 1058.4187223427},
+	},
+	// L2, 191 terms
+	{
+		{A: 0.00047233598, B: 4.32148323554, C: 7.1135470008},
+		{A: 0.00030629053, B: 2.93021440216, C: 529.6909650946},
+		{A: 0.0003896555, B: 0, C: 0},
+		{A: 3.189317e-05, B: 1.05504615595, C: 522.5774180938},
+		{A: 2.723358e-05, B: 3.41411526638, C: 1059.3819301892},
+		{A: 2.729292e-05, B: 4.84545481351, C: 536.8045120954},
+		{A: 1.721069e-05, B: 4.18734385158, C: 14.2270940016},
+		{A: 3.83258e-06, B: 5.76790714387, C: 419.4846438752},
+		{A: 3.67498e-06, B: 6.05509120409, C: 103.0927742186},
+		{A: 3.77524e-06, B: 0.76048964872, C: 515.463871093},
+		{A: 3.37386e-06, B: 3.78644384244, C: 3.1813937377},
+		{A: 3.082e-06, B: 0.69356654052, C: 206.1855484372},
+		{A: 2.18408e-06, B: 3.81389191353, C: 1589.0728952838},
+		{A: 1.98883e-06, B: 5.33996443444, C: 1066.49547719},
+		{A: 1.97445e-06, B: 2.48356402053, C: 3.9321532631},
+		{A: 1.4623e-06, B: 3.81373196838, C: 639.897286314},
+		{A: 1.55862e-06, B: 1.40642426467, C: 1052.2683831884},
+		{A: 1.2957e-06, B: 5.83738872525, C: 412.3710968744},
+		{A: 1.41932e-06, B: 1.63435169016, C: 426.598190876},
+		{A: 1.17327e-06, B: 1.41435462588, C: 625.6701923124},
+		{A: 9.6733e-07, B: 4.03383427887, C: 110.2063212194},
+		{A: 9.0823e-07, B: 1.10630629042, C: 95.9792272178},
+		{A: 7.8769e-07, B: 4.63726131329, C: 543.9180590962},
+		{A: 7.2392e-07, B: 2.21716670026, C: 735.8765135318},
+		{A: 8.7292e-07, B: 2.52235174825, C: 632.7837393132},
+		{A: 5.691e-07, B: 3.12292059854, C: 213.299095438},
+		{A: 4.8622e-07, B: 1.67283791618, C: 309.2783226558},
+		{A: 5.8475e-07, B: 0.83216317444, C: 199.0720014364},
+		{A: 4.015e-07, B: 4.0248544474, C: 21.3406410024},
+		{A: 3.9784e-07, B: 0.62416945827, C: 323.5054166574},
+		{A: 3.5718e-07, B: 2.32581247002, C: 728.762966531},
+		{A: 2.562e-07, B: 2.51240623862, C: 1162.4747044078},
+		{A: 2.9255e-07, B: 3.60838327799, C: 10.2949407385},
+		{A: 2.3591e-07, B: 3.00532139306, C: 956.2891559706},
+		{A: 2.7814e-07, B: 3.23992013743, C: 838.9692877504},
+		{A: 2.5993e-07, B: 4.5011829829, C: 742.9900605326},
+		{A: 2.5194e-07, B: 1.21868110687, C: 1045.1548361876},
+		{A: 1.9458e-07, B: 4.29028644674, C: 532.8723588323},
+		{A: 1.766e-07, B: 0.8095394156, C: 508.3503240922},
+		{A: 1.5355e-07, B: 5.81037986941, C: 1596.1864422846},
+		{A: 1.7058e-07, B: 4.20001977723, C: 2118.7638603784},
+		{A: 1.704e-07, B: 1.8340214664, C: 526.5095713569},
+		{A: 1.4661e-07, B: 3.99989622586, C: 117.3198682202},
+		{A: 1.3639e-07, B: 1.80336677963, C: 302.164775655},
+		{A: 1.323e-07, B: 2.51856643603, C: 88.865680217},
+		{A: 1.2756e-07, B: 4.36856232414, C: 1169.5882514086},
+		{A: 1.5292e-07, B: 0.68174165476, C: 942.062061969},
+		{A: 1.0986e-07, B: 4.43586634639, C: 525.7588118315},
+		{A: 1.392e-07, B: 5.95169568482, C: 316.3918696566},
+		{A: 9.437e-08, B: 2.17684563456, C: 1155.361157407},
+		{A: 8.812e-08, B: 3.29452783338, C: 220.4126424388},
+		{A: 7.823e-08, B: 5.75672228354, C: 846.0828347512},
+		{A: 7.549e-08, B: 2.70955516779, C: 533.6231183577},
+		{A: 9.681e-08, B: 1.71563161051, C: 1581.959348283},
+		{A: 8.69e-08, B: 3.31924493607, C: 831.8557407496},
+		{A: 6.285e-08, B: 0.49939863541, C: 949.1756089698},
+		{A: 6.685e-08, B: 2.17560093281, C: 1265.5674786264},
+		{A: 5.381e-08, B: 6.00510875948, C: 405.2575498736},
+		{A: 4.676e-08, B: 1.40846192799, C: 1258.4539316256},
+		{A: 4.421e-08, B: 3.02360159274, C: 1692.1656695024},
+		{A: 4.403e-08, B: 5.4773726616, C: 433.7117378768},
+		{A: 4.286e-08, B: 5.07139951645, C: 1073.6090241908},
+		{A: 4.201e-08, B: 5.28560721767, C: 18.1592472647},
+		{A: 3.933e-08, B: 1.26665387164, C: 853.196381752},
+		{A: 5.351e-08, B: 3.65320121089, C: 1272.6810256272},
+		{A: 4.392e-08, B: 2.27325303667, C: 1368.660252845},
+		{A: 3.482e-08, B: 1.53983001273, C: 519.3960243561},
+		{A: 2.745e-08, B: 2.09685315627, C: 1478.8665740644},
+		{A: 2.737e-08, B: 1.06017230524, C: 1574.8458012822},
+		{A: 2.897e-08, B: 2.05128453665, C: 1361.5467058442},
+		{A: 3.075e-08, B: 0.99085727534, C: 191.9584544356},
+		{A: 2.462e-08, B: 2.37173605635, C: 1471.7530270636},
+		{A: 2.203e-08, B: 2.47960567714, C: 721.6494195302},
+		{A: 2.096e-08, B: 3.71482580504, C: 1485.9801210652},
+		{A: 1.984e-08, B: 1.88475229557, C: 1685.0521225016},
+		{A: 2.274e-08, B: 3.03360234351, C: 1148.2476104062},
+		{A: 2.041e-08, B: 6.17114556019, C: 330.6189636582},
+		{A: 1.451e-08, B: 4.72055072637, C: 32.2433289144},
+		{A: 1.454e-08, B: 5.14703918585, C: 1375.7737998458},
+		{A: 1.447e-08, B: 3.18833439444, C: 635.9651330509},
+		{A: 1.403e-08, B: 4.26712075104, C: 551.031606097},
+		{A: 1.42e-08, B: 1.99288040133, C: 629.6023455755},
+		{A: 1.269e-08, B: 0.03300387779, C: 2125.8774073792},
+		{A: 1.276e-08, B: 2.26356919237, C: 1788.1448967202},
+		{A: 1.189e-08, B: 1.70223550488, C: 1677.9385755008},
+		{A: 1.182e-08, B: 2.18142313946, C: 1795.258443721},
+		{A: 1.366e-08, B: 1.27629917215, C: 1038.0412891868},
+		{A: 1.306e-08, B: 4.76302079847, C: 1062.5633239269},
+		{A: 1.109e-08, B: 2.97787130235, C: 81.7521332162},
+		{A: 1.027e-08, B: 1.99236027398, C: 295.0512286542},
+		{A: 1.349e-08, B: 4.01621534182, C: 539.9859058331},
+		{A: 1.025e-08, B: 3.75336759986, C: 28.4541880032},
+		{A: 9.77e-09, B: 3.01355125761, C: 124.433415221},
+		{A: 1.29e-08, B: 4.62594234857, C: 2648.454825473},
+		{A: 1.065e-08, B: 5.06153058155, C: 1699.2792165032},
+		{A: 9.65e-09, B: 1.17716405513, C: 99.9113804809},
+		{A: 1.021e-08, B: 1.9071210266, C: 750.1036075334},
+		{A: 9.23e-09, B: 3.53450109212, C: 227.5261894396},
+		{A: 1.059e-08, B: 0.13532061468, C: 416.3032501375},
+		{A: 8.36e-09, B: 2.07492422755, C: 1056.2005364515},
+		{A: 8.89e-09, B: 1.75177808106, C: 1898.3512179396},
+		{A: 7.72e-09, B: 2.89217715561, C: 2008.557539159},
+		{A: 1.014e-08, B: 2.80847772922, C: 1464.6394800628},
+		{A: 8.2e-09, B: 1.99735697577, C: 2111.6503133776},
+		{A: 7.87e-09, B: 4.91912237671, C: 1055.4497769261},
+		{A: 7.43e-09, B: 2.6520965069, C: 106.2741679563},
+		{A: 7.05e-09, B: 0.08006443278, C: 963.4027029714},
+		{A: 7.24e-09, B: 3.29664246938, C: 628.8515860501},
+		{A: 7.91e-09, B: 1.6465520211, C: 2001.4439921582},
+		{A: 8.22e-09, B: 2.74067639972, C: 618.5566453116},
+		{A: 7.61e-09, B: 1.26393500358, C: 1382.8873468466},
+		{A: 6.5e-09, B: 1.19590511216, C: 422.6660376129},
+		{A: 6.77e-09, B: 1.88476058357, C: 2104.5367663768},
+		{A: 6.81e-09, B: 5.47481665606, C: 5760.4984318976},
+		{A: 6.81e-09, B: 3.11621209674, C: 5746.271337896},
+		{A: 6.44e-09, B: 4.68385640894, C: 611.4430983108},
+		{A: 7.52e-09, B: 3.03497138894, C: 2221.856634597},
+		{A: 6.41e-09, B: 1.86274530783, C: 636.7158925763},
+		{A: 6.14e-09, B: 3.0767735667, C: 380.12776796},
+		{A: 6.35e-09, B: 4.53916684689, C: 9676.4810341156},
+		{A: 6.35e-09, B: 0.61458805483, C: 9690.7081281172},
+		{A: 8.22e-09, B: 6.25170365084, C: 423.4167971383},
+		{A: 7.62e-09, B: 4.32362906505, C: 1802.3719907218},
+		{A: 5.82e-09, B: 0.84137872868, C: 1891.2376709388},
+		{A: 5.58e-09, B: 3.96171840325, C: 440.8252848776},
+		{A: 6.24e-09, B: 2.83657771014, C: 1905.4647649404},
+		{A: 7.11e-09, B: 3.43538032357, C: 824.7421937488},
+		{A: 5.17e-09, B: 1.10660016329, C: 107.0249274817},
+		{A: 5.35e-09, B: 1.55761050176, C: 1994.3304451574},
+		{A: 5.01e-09, B: 4.44389802599, C: 647.0108333148},
+		{A: 4.14e-09, B: 5.37130370397, C: 2228.9701815978},
+		{A: 5.33e-09, B: 2.54756313371, C: 1781.0313497194},
+		{A: 3.93e-09, B: 1.26351262287, C: 210.1177017003},
+		{A: 4.33e-09, B: 2.90103969634, C: 1063.3140834523},
+		{A: 3.84e-09, B: 1.36194621083, C: 203.0041546995},
+		{A: 4.4e-09, B: 1.46934545869, C: 2214.7430875962},
+		{A: 4.24e-09, B: 4.98974282486, C: 3178.1457905676},
+		{A: 3.38e-09, B: 2.72210106345, C: 2324.9494088156},
+		{A: 3.32e-09, B: 0.37505564414, C: 2655.5683724738},
+		{A: 3.18e-09, B: 6.11024720065, C: 934.9485149682},
+		{A: 4.05e-09, B: 3.51005860013, C: 2751.5475996916},
+		{A: 3.88e-09, B: 5.00609647265, C: 2015.6710861598},
+		{A: 4.24e-09, B: 4.29668654117, C: 5753.3848848968},
+		{A: 3.28e-09, B: 2.35571531981, C: 1251.3403846248},
+		{A: 3.16e-09, B: 0.16949503062, C: 1279.794572628},
+		{A: 3.45e-09, B: 2.89328206121, C: 2957.7331481288},
+		{A: 3.03e-09, B: 1.63964826684, C: 2428.0421830342},
+		{A: 3.28e-09, B: 3.36132375845, C: 1141.1340634054},
+		{A: 2.94e-09, B: 2.48947693371, C: 2641.3412784722},
+		{A: 3.5e-09, B: 1.50537240918, C: 2317.8358618148},
+		{A: 2.87e-09, B: 1.69638214958, C: 2420.9286360334},
+		{A: 2.72e-09, B: 0.27466529753, C: 319.5732633943},
+		{A: 3.03e-09, B: 2.43034117616, C: 70.8494453042},
+		{A: 2.51e-09, B: 0.43544711316, C: 3259.8979237838},
+		{A: 2.24e-09, B: 4.49752269293, C: 5223.6939198022},
+		{A: 2.72e-09, B: 2.98590404673, C: 1457.525933062},
+		{A: 2.28e-09, B: 5.47896916415, C: 1603.2999892854},
+		{A: 2.88e-09, B: 2.30146999217, C: 2854.6403739102},
+		{A: 2.07e-09, B: 5.94297320087, C: 9153.9036160218},
+		{A: 2.43e-09, B: 1.58604251447, C: 2744.4340526908},
+		{A: 2.28e-09, B: 1.28182702946, C: 2310.722314814},
+		{A: 2.24e-09, B: 1.28623905132, C: 3060.8259223474},
+		{A: 2.22e-09, B: 0.63265553397, C: 3163.918696566},
+		{A: 2.42e-09, B: 2.52382905368, C: 3274.1250177854},
+		{A: 1.88e-09, B: 6.00513627145, C: 92.0470739547},
+		{A: 2.39e-09, B: 1.93897157244, C: 2413.8150890326},
+		{A: 2.14e-09, B: 1.14529237568, C: 2531.1349572528},
+		{A: 2e-09, B: 3.42280996072, C: 99.1606209555},
+		{A: 1.79e-09, B: 0.53892926207, C: 2207.6295405954},
+		{A: 1.77e-09, B: 5.56545270243, C: 2332.0629558164},
+		{A: 1.72e-09, B: 1.38604067808, C: 945.9942152321},
+		{A: 2.03e-09, B: 0.41899069603, C: 2840.4132799086},
+		{A: 2.31e-09, B: 2.2635333046, C: 2097.423219376},
+		{A: 2.28e-09, B: 3.82701076821, C: 113.3877149571},
+		{A: 1.65e-09, B: 4.08776703733, C: 6283.0758499914},
+		{A: 2.02e-09, B: 3.30429764992, C: 3067.9394693482},
+		{A: 2.24e-09, B: 3.69285208525, C: 2435.155730035},
+		{A: 2.14e-09, B: 2.55756944911, C: 2538.2485042536},
+		{A: 2.03e-09, B: 2.24205059922, C: 67.6680515665},
+		{A: 1.52e-09, B: 5.48122906518, C: 10213.285546211},
+		{A: 1.91e-09, B: 2.68685722531, C: 1773.9178027186},
+		{A: 1.89e-09, B: 2.95184620359, C: 732.6951197941},
+		{A: 1.49e-09, B: 1.98737542735, C: 1049.0869894507},
+		{A: 1.63e-09, B: 1.24084734609, C: 3053.7123753466},
+		{A: 1.71e-09, B: 2.34210749987, C: 1354.4331588434},
+		{A: 1.12e-09, B: 5.7740728579, C: 547.8502123593},
+		{A: 1.24e-09, B: 0.14001204498, C: 860.3099287528},
+		{A: 8.6e-10, B: 1.26924601636, C: 511.5317178299},
+		{A: 1.14e-09, B: 5.1598283807, C: 1592.2542890215},
+		{A: 9.1e-10, B: 1.48896790758, C: 1567.7322542814},
+		{A: 8.6e-10, B: 4.34444949905, C: 1069.6768709277},
+	},
+	// L3, 109 terms
+	{
+		{A: 6.501665e-05, B: 2.59862880482, C: 7.1135470008},
+		{A: 1.356524e-05, B: 1.34635886411, C: 529.6909650946},
+		{A: 4.70716e-06, B: 2.47503977883, C: 14.2270940016},
+		{A: 4.1696e-06, B: 3.24451243214, C: 536.8045120954},
+		{A: 3.52851e-06, B: 2.97360159003, C: 522.5774180938},
+		{A: 1.5488e-06, B: 2.07565585817, C: 1059.3819301892},
+		{A: 8.6771e-07, B: 2.51431584316, C: 515.463871093},
+		{A: 3.3538e-07, B: 3.82633794497, C: 1066.49547719},
+		{A: 4.4378e-07, B: 0, C: 0},
+		{A: 2.2644e-07, B: 2.98231326774, C: 543.9180590962},
+		{A: 2.3737e-07, B: 1.27667172313, C: 412.3710968744},
+		{A: 2.8457e-07, B: 2.44754756058, C: 206.1855484372},
+		{A: 1.9798e-07, B: 2.10099934005, C: 639.897286314},
+		{A: 1.974e-07, B: 1.40255938973, C: 419.4846438752},
+		{A: 1.8768e-07, B: 1.593684035, C: 103.0927742186},
+		{A: 1.7033e-07, B: 2.30214681202, C: 21.3406410024},
+		{A: 1.6774e-07, B: 2.59821460673, C: 1589.0728952838},
+		{A: 1.6214e-07, B: 3.14521117299, C: 625.6701923124},
+		{A: 1.6055e-07, B: 3.36030126297, C: 1052.2683831884},
+		{A: 1.3392e-07, B: 2.75973892202, C: 95.9792272178},
+		{A: 1.3234e-07, B: 2.5386224434, C: 199.0720014364},
+		{A: 1.2611e-07, B: 6.265781104, C: 426.598190876},
+		{A: 8.637e-08, B: 2.26563256289, C: 110.2063212194},
+		{A: 6.725e-08, B: 3.42566433316, C: 309.2783226558},
+		{A: 8.701e-08, B: 1.76334960737, C: 10.2949407385},
+		{A: 6.527e-08, B: 4.03869562907, C: 728.762966531},
+		{A: 5.368e-08, B: 5.25196153539, C: 323.5054166574},
+		{A: 5.675e-08, B: 2.52096417685, C: 508.3503240922},
+		{A: 5.399e-08, B: 2.91184687105, C: 1045.1548361876},
+		{A: 3.996e-08, B: 4.30290261177, C: 88.865680217},
+		{A: 3.857e-08, B: 3.52381361552, C: 302.164775655},
+		{A: 3.774e-08, B: 4.09125315146, C: 735.8765135318},
+		{A: 3.269e-08, B: 1.43175991274, C: 956.2891559706},
+		{A: 2.783e-08, B: 4.3581750767, C: 1596.1864422846},
+		{A: 2.661e-08, B: 1.25276590759, C: 213.299095438},
+		{A: 2.553e-08, B: 2.23785673285, C: 117.3198682202},
+		{A: 2.371e-08, B: 2.89662409244, C: 742.9900605326},
+		{A: 2.656e-08, B: 5.01505839848, C: 838.9692877504},
+		{A: 1.948e-08, B: 2.77248294666, C: 1169.5882514086},
+		{A: 2.279e-08, B: 2.3558187123, C: 942.062061969},
+		{A: 1.474e-08, B: 1.61011468581, C: 220.4126424388},
+		{A: 1.457e-08, B: 3.09381959396, C: 2118.7638603784},
+		{A: 1.937e-08, B: 5.01388256693, C: 831.8557407496},
+		{A: 1.585e-08, B: 1.40097680805, C: 405.2575498736},
+		{A: 1.257e-08, B: 3.97811260358, C: 1155.361157407},
+		{A: 1.227e-08, B: 3.45959919972, C: 1073.6090241908},
+		{A: 9.86e-09, B: 3.39209446167, C: 532.8723588323},
+		{A: 9.42e-09, B: 2.70200385825, C: 191.9584544356},
+		{A: 8.28e-09, B: 1.48348768286, C: 632.7837393132},
+		{A: 7.97e-09, B: 1.1070668885, C: 1162.4747044078},
+		{A: 8.22e-09, B: 3.30295824153, C: 1258.4539316256},
+		{A: 7.1e-09, B: 5.8979877198, C: 853.196381752},
+		{A: 7.66e-09, B: 3.66351539483, C: 1581.959348283},
+		{A: 7.22e-09, B: 3.74673245797, C: 433.7117378768},
+		{A: 6.63e-09, B: 2.93063953915, C: 1574.8458012822},
+		{A: 6.58e-09, B: 3.52797311863, C: 525.7588118315},
+		{A: 6.09e-09, B: 4.14881313523, C: 721.6494195302},
+		{A: 5.98e-09, B: 4.69454609357, C: 81.7521332162},
+		{A: 6.68e-09, B: 1.96442971289, C: 1272.6810256272},
+		{A: 5.15e-09, B: 1.57251270902, C: 949.1756089698},
+		{A: 6.58e-09, B: 2.02329201466, C: 526.5095713569},
+		{A: 5.17e-09, B: 4.35827478516, C: 1368.660252845},
+		{A: 5.1e-09, B: 4.95846155301, C: 1148.2476104062},
+		{A: 5.07e-09, B: 4.31396370095, C: 330.6189636582},
+		{A: 5.67e-09, B: 2.27813343743, C: 551.031606097},
+		{A: 4.8e-09, B: 3.86758235988, C: 1361.5467058442},
+		{A: 3.83e-09, B: 0.24287136454, C: 611.4430983108},
+		{A: 4.34e-09, B: 2.9546175554, C: 1038.0412891868},
+		{A: 3.77e-09, B: 1.42957648215, C: 124.433415221},
+		{A: 3.91e-09, B: 4.07770324592, C: 1471.7530270636},
+		{A: 3.85e-09, B: 4.702951798, C: 519.3960243561},
+		{A: 4.28e-09, B: 2.22472522305, C: 539.9859058331},
+		{A: 3.43e-09, B: 4.83463725823, C: 2125.8774073792},
+		{A: 3.94e-09, B: 4.52891996323, C: 1464.6394800628},
+		{A: 3.05e-09, B: 2.02797683648, C: 1485.9801210652},
+		{A: 2.83e-09, B: 0.97461612169, C: 1905.4647649404},
+		{A: 2.76e-09, B: 3.83552772064, C: 1062.5633239269},
+		{A: 3.51e-09, B: 2.06334334462, C: 533.6231183577},
+		{A: 3.04e-09, B: 3.93228052293, C: 1685.0521225016},
+		{A: 3.22e-09, B: 3.54763044791, C: 846.0828347512},
+		{A: 3.45e-09, B: 4.18332148409, C: 1788.1448967202},
+		{A: 2.53e-09, B: 3.12703531516, C: 1994.3304451574},
+		{A: 2.57e-09, B: 1.05361498985, C: 1478.8665740644},
+		{A: 2.32e-09, B: 1.69999081817, C: 1692.1656695024},
+		{A: 2.25e-09, B: 2.5162414978, C: 1891.2376709388},
+		{A: 2.17e-09, B: 4.58512911216, C: 963.4027029714},
+		{A: 2.77e-09, B: 3.63353707701, C: 1677.9385755008},
+		{A: 2.42e-09, B: 2.90163762388, C: 2310.722314814},
+		{A: 2.11e-09, B: 3.96419403991, C: 295.0512286542},
+		{A: 1.99e-09, B: 5.1704650075, C: 618.5566453116},
+		{A: 2.56e-09, B: 4.19052619061, C: 1781.0313497194},
+		{A: 1.92e-09, B: 0.81556540966, C: 2221.856634597},
+		{A: 1.87e-09, B: 3.49895198981, C: 2648.454825473},
+		{A: 2.08e-09, B: 4.11838429822, C: 2097.423219376},
+		{A: 1.83e-09, B: 3.30680692414, C: 1699.2792165032},
+		{A: 2.31e-09, B: 2.54516792766, C: 1375.7737998458},
+		{A: 1.89e-09, B: 5.74277274755, C: 2627.1141844706},
+		{A: 2.14e-09, B: 5.48031974537, C: 1354.4331588434},
+		{A: 2.2e-09, B: 3.8747198941, C: 2104.5367663768},
+		{A: 1.71e-09, B: 6.10827209399, C: 1382.8873468466},
+		{A: 1.84e-09, B: 5.98415847544, C: 750.1036075334},
+		{A: 1.71e-09, B: 5.25744961028, C: 824.7421937488},
+		{A: 1.51e-09, B: 4.30799091626, C: 2001.4439921582},
+		{A: 1.4e-09, B: 4.2708946607, C: 1265.5674786264},
+		{A: 9.7e-10, B: 4.67188056608, C: 647.0108333148},
+		{A: 8.8e-10, B: 2.43775210355, C: 440.8252848776},
+		{A: 7.5e-10, B: 3.93105183253, C: 1055.4497769261},
+		{A: 7.9e-10, B: 1.8853315322, C: 934.9485149682},
+		{A: 7.7e-10, B: 3.80503143236, C: 1603.2999892854},
+	},
+	// L4, 45 terms
+	{
+		{A: 6.69483e-06, B: 0.8528242109, C: 7.1135470008},
+		{A: 9.9961e-07, B: 0.74258947751, C: 14.2270940016},
+		{A: 1.14019e-06, B: 3.14159265359, C: 0},
+		{A: 5.0024e-07, B: 1.65346208248, C: 536.8045120954},
+		{A: 4.3585e-07, B: 5.82026386621, C: 529.6909650946},
+		{A: 3.1813e-07, B: 4.8582998665, C: 522.5774180938},
+		{A: 1.4742e-07, B: 4.29061635784, C: 515.463871093},
+		{A: 8.899e-08, B: 0.71478520741, C: 1059.3819301892},
+		{A: 4.957e-08, B: 1.29502259434, C: 543.9180590962},
+		{A: 4.484e-08, B: 2.31715516627, C: 1066.49547719},
+		{A: 4.251e-08, B: 0.48326797501, C: 21.3406410024},
+		{A: 3.1e-08, B: 3.00245542678, C: 412.3710968744},
+		{A: 2.055e-08, B: 0.39858940218, C: 639.897286314},
+		{A: 1.762e-08, B: 4.90536207307, C: 625.6701923124},
+		{A: 1.902e-08, B: 4.25925620271, C: 199.0720014364},
+		{A: 1.695e-08, B: 4.26147580803, C: 206.1855484372},
+		{A: 1.375e-08, B: 5.25546955667, C: 1052.2683831884},
+		{A: 1.203e-08, B: 4.71614633845, C: 95.9792272178},
+		{A: 1.086e-08, B: 1.28604571172, C: 1589.0728952838},
+		{A: 9.82e-09, B: 4.77990073662, C: 1045.1548361876},
+		{A: 9.35e-09, B: 6.05847062188, C: 88.865680217},
+		{A: 9.16e-09, B: 5.77537499431, C: 728.762966531},
+		{A: 8.9e-09, B: 4.55299189579, C: 426.598190876},
+		{A: 7.84e-09, B: 3.4016156795, C: 419.4846438752},
+		{A: 7.68e-09, B: 3.54672049322, C: 103.0927742186},
+		{A: 6.7e-09, B: 0.522233077, C: 110.2063212194},
+		{A: 4.15e-09, B: 5.22809480633, C: 302.164775655},
+		{A: 3.93e-09, B: 6.24184621807, C: 956.2891559706},
+		{A: 3.81e-09, B: 5.2546696604, C: 309.2783226558},
+		{A: 4.21e-09, B: 0.59561318533, C: 117.3198682202},
+		{A: 3.46e-09, B: 4.78348312106, C: 508.3503240922},
+		{A: 3.19e-09, B: 3.47979828725, C: 323.5054166574},
+		{A: 3.31e-09, B: 2.95893485883, C: 1596.1864422846},
+		{A: 2.95e-09, B: 4.32713459459, C: 942.062061969},
+		{A: 3.19e-09, B: 0.47990052824, C: 831.8557407496},
+		{A: 2.51e-09, B: 1.79898001222, C: 1073.6090241908},
+		{A: 2.12e-09, B: 0.43917684084, C: 220.4126424388},
+		{A: 1.88e-09, B: 1.12654974776, C: 1169.5882514086},
+		{A: 1.88e-09, B: 2.16135407548, C: 1361.5467058442},
+		{A: 1.8e-09, B: 3.43266428069, C: 1148.2476104062},
+		{A: 1.64e-09, B: 1.92864127211, C: 2118.7638603784},
+		{A: 1.57e-09, B: 3.02963907392, C: 1272.6810256272},
+		{A: 9.3e-10, B: 5.60436000012, C: 1581.959348283},
+		{A: 8.5e-10, B: 5.023172562, C: 1155.361157407},
+		{A: 7.5e-10, B: 3.13198879608, C: 632.7837393132},
+	},
+	// L5, 10 terms
+	{
+		{A: 4.9577e-07, B: 5.25658966184, C: 7.1135470008},
+		{A: 1.5761e-07, B: 5.25126837478, C: 14.2270940016},
+		{A: 4.343e-08, B: 0.01461869263, C: 536.8045120954},
+		{A: 1.526e-08, B: 1.09739911439, C: 522.5774180938},
+		{A: 7.28e-09, B: 5.85949047619, C: 543.9180590962},
+		{A: 6.94e-09, B: 0.87382487754, C: 515.463871093},
+		{A: 8.45e-09, B: 3.14159265359, C: 0},
+		{A: 4.56e-09, B: 0.81521692852, C: 1066.49547719},
+		{A: 2.93e-09, B: 5.62909357048, C: 1059.3819301892},
+		{A: 9e-10, B: 0.2117811971, C: 529.6909650946},
+	},
+}
+
+// GetJupiterL0 evaluates the t^0 series of L.
+func GetJupiterL0(t float64) float64 {
+	return jupiterL[0].Evaluate(t)
+}
+
+// GetJupiterL1 evaluates the t^1 series of L.
+func GetJupiterL1(t float64) float64 {
+	return jupiterL[1].Evaluate(t)
+}
+
+// GetJupiterL2 evaluates the t^2 series of L.
+func GetJupiterL2(t float64) float64 {
+	return jupiterL[2].Evaluate(t)
+}
+
+// GetJupiterL3 evaluates the t^3 series of L.
+func GetJupiterL3(t float64) float64 {
+	return jupiterL[3].Evaluate(t)
+}
+
+// GetJupiterL4 evaluates the t^4 series of L.
+func GetJupiterL4(t float64) float64 {
+	return jupiterL[4].Evaluate(t)
+}
+
+// GetJupiterL5 evaluates the t^5 series of L.
+func GetJupiterL5(t float64) float64 {
+	return jupiterL[5].Evaluate(t)
+}
@@ -0,0 +1,1528 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// JUPITER R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87jupiterd
+
+import "vsop87"
+
+var jupiterR = []vsop87.Series{
+	// R0, 745 terms
+	{
+		{A: 5.20887429471, B: 0, C: 0},
+		{A: 0.2520932702, B: 3.49108640015, C: 529.6909650946},
+		{A: 0.00610599902, B: 3.84115365602, C: 1059.3819301892},
+		{A: 0.00282029465, B: 2.57419879933, C: 632.7837393132},
+		{A: 0.00187647391, B: 2.07590380082, C: 522.5774180938},
+		{A: 0.00086792941, B: 0.71001090609, C: 419.4846438752},
+		{A: 0.00072062869, B: 0.21465694745, C: 536.8045120954},
+		{A: 0.00065517227, B: 5.97995850843, C: 316.3918696566},
+		{A: 0.0002913462, B: 1.6775924371, C: 103.0927742186},
+		{A: 0.00030135275, B: 2.16132058449, C: 949.1756089698},
+		{A: 0.00023453209, B: 3.54023147303, C: 735.8765135318},
+		{A: 0.0002228371, B: 4.19362773546, C: 1589.0728952838},
+		{A: 0.0002394734, B: 0.27457854894, C: 7.1135470008},
+		{A: 0.000130326, B: 2.96043055741, C: 1162.4747044078},
+		{A: 9.703346e-05, B: 1.90669572402, C: 206.1855484372},
+		{A: 0.00012749004, B: 2.71550102862, C: 1052.2683831884},
+		{A: 9.161431e-05, B: 4.41352618935, C: 213.299095438},
+		{A: 7.894539e-05, B: 2.47907551404, C: 426.598190876},
+		{A: 7.057978e-05, B: 2.18184753111, C: 1265.5674786264},
+		{A: 6.137755e-05, B: 6.26417542514, C: 846.0828347512},
+		{A: 5.477093e-05, B: 5.65729325169, C: 639.897286314},
+		{A: 3.502519e-05, B: 0.56531297394, C: 1066.49547719},
+		{A: 4.13689e-05, B: 2.72219979684, C: 625.6701923124},
+		{A: 4.170012e-05, B: 2.01605033912, C: 515.463871093},
+		{A: 2.499966e-05, B: 4.55182055941, C: 838.9692877504},
+		{A: 2.616955e-05, B: 2.00993967129, C: 1581.959348283},
+		{A: 1.911876e-05, B: 0.85621927419, C: 412.3710968744},
+		{A: 2.127644e-05, B: 6.1275146175, C: 742.9900605326},
+		{A: 1.610549e-05, B: 3.08867789275, C: 1368.660252845},
+		{A: 1.479484e-05, B: 2.68026191372, C: 1478.8665740644},
+		{A: 1.230708e-05, B: 1.89042979701, C: 323.5054166574},
+		{A: 1.21681e-05, B: 1.80171561024, C: 110.2063212194},
+		{A: 9.61072e-06, B: 4.54876989805, C: 2118.7638603784},
+		{A: 8.85708e-06, B: 4.14785948471, C: 533.6231183577},
+		{A: 7.767e-06, B: 3.6769695469, C: 728.762966531},
+		{A: 9.98579e-06, B: 2.8720894011, C: 309.2783226558},
+		{A: 1.014959e-05, B: 1.38673237666, C: 454.9093665273},
+		{A: 7.27162e-06, B: 3.98824686402, C: 1155.361157407},
+		{A: 6.55289e-06, B: 2.79065604219, C: 1685.0521225016},
+		{A: 8.21465e-06, B: 1.59342534396, C: 1898.3512179396},
+		{A: 6.20798e-06, B: 4.82284338962, C: 956.2891559706},
+		{A: 6.53981e-06, B: 3.38150775269, C: 1692.1656695024},
+		{A: 8.12036e-06, B: 5.94091899141, C: 909.8187330546},
+		{A: 5.6212e-06, B: 0.08095987241, C: 543.9180590962},
+		{A: 5.42221e-06, B: 0.28360266386, C: 525.7588118315},
+		{A: 4.57859e-06, B: 0.1272269451, C: 1375.7737998458},
+		{A: 6.14784e-06, B: 2.27624915604, C: 942.062061969},
+		{A: 4.35805e-06, B: 2.60272129748, C: 95.9792272178},
+		{A: 4.96066e-06, B: 5.53005947761, C: 380.12776796},
+		{A: 4.69965e-06, B: 2.81896276101, C: 1795.258443721},
+		{A: 4.45003e-06, B: 0.14623567024, C: 14.2270940016},
+		{A: 2.90869e-06, B: 3.89339143564, C: 1471.7530270636},
+		{A: 2.76627e-06, B: 2.52238450687, C: 2001.4439921582},
+		{A: 2.75084e-06, B: 2.98863518924, C: 526.5095713569},
+		{A: 2.93875e-06, B: 2.04938438861, C: 199.0720014364},
+		{A: 2.90985e-06, B: 6.03131226226, C: 1169.5882514086},
+		{A: 3.38342e-06, B: 2.79873192583, C: 1045.1548361876},
+		{A: 2.57482e-06, B: 6.13395478303, C: 532.8723588323},
+		{A: 3.19013e-06, B: 1.34803130803, C: 2214.7430875962},
+		{A: 3.09352e-06, B: 5.36855804945, C: 1272.6810256272},
+		{A: 3.45804e-06, B: 1.56404293688, C: 491.5579294568},
+		{A: 3.03364e-06, B: 1.15407454372, C: 5753.3848848968},
+		{A: 1.92325e-06, B: 0.91996333387, C: 1596.1864422846},
+		{A: 2.15398e-06, B: 2.63572815848, C: 2111.6503133776},
+		{A: 2.00738e-06, B: 2.37259566683, C: 1258.4539316256},
+		{A: 2.39036e-06, B: 3.57397189838, C: 835.0371344873},
+		{A: 1.97073e-06, B: 5.92859096863, C: 453.424893819},
+		{A: 1.3944e-06, B: 3.63960322318, C: 1788.1448967202},
+		{A: 1.91373e-06, B: 6.2825131187, C: 983.1158589136},
+		{A: 1.76551e-06, B: 2.57669991654, C: 9683.5945811164},
+		{A: 1.23567e-06, B: 2.26158186345, C: 2317.8358618148},
+		{A: 1.28176e-06, B: 4.6658590767, C: 831.8557407496},
+		{A: 1.1243e-06, B: 0.85604150812, C: 433.7117378768},
+		{A: 1.28817e-06, B: 1.10567106595, C: 2531.1349572528},
+		{A: 9.939e-07, B: 4.50312054049, C: 518.6452648307},
+		{A: 9.387e-07, B: 2.7255387999, C: 853.196381752},
+		{A: 1.06481e-06, B: 5.8146222229, C: 220.4126424388},
+		{A: 1.20188e-06, B: 2.95156363556, C: 3.9321532631},
+		{A: 1.04002e-06, B: 2.22221906187, C: 74.7815985673},
+		{A: 8.1655e-07, B: 3.23481337678, C: 1361.5467058442},
+		{A: 1.12513e-06, B: 4.86216964016, C: 528.2064923863},
+		{A: 7.9539e-07, B: 0.8854224683, C: 430.5303441391},
+		{A: 8.5801e-07, B: 2.11458386763, C: 1574.8458012822},
+		{A: 8.5685e-07, B: 2.33823884827, C: 2428.0421830342},
+		{A: 6.8311e-07, B: 3.35727048905, C: 2104.5367663768},
+		{A: 6.957e-07, B: 3.04164697156, C: 302.164775655},
+		{A: 6.9775e-07, B: 3.22402404312, C: 305.3461693927},
+		{A: 6.957e-07, B: 0.20494979941, C: 532.1386456494},
+		{A: 5.6991e-07, B: 2.00204191909, C: 2634.2277314714},
+		{A: 7.7062e-07, B: 2.09816000231, C: 508.3503240922},
+		{A: 5.6716e-07, B: 3.91743976711, C: 2221.856634597},
+		{A: 5.8325e-07, B: 5.72360355252, C: 628.8515860501},
+		{A: 5.2485e-07, B: 4.02485010492, C: 527.2432845398},
+		{A: 6.3645e-07, B: 1.09973563964, C: 1364.7280995819},
+		{A: 5.3607e-07, B: 0.87425992614, C: 2847.5268269094},
+		{A: 5.9598e-07, B: 0.95822471775, C: 494.2662424425},
+		{A: 5.796e-07, B: 3.45779497978, C: 2008.557539159},
+		{A: 4.1512e-07, B: 3.51955526735, C: 529.7391492044},
+		{A: 4.4666e-07, B: 1.62313786651, C: 984.6003316219},
+		{A: 4.4883e-07, B: 4.90091959557, C: 2648.454825473},
+		{A: 5.3206e-07, B: 1.19800364308, C: 760.25553592},
+		{A: 4.4393e-07, B: 4.42623747662, C: 1063.3140834523},
+		{A: 3.7566e-07, B: 2.93021095213, C: 1677.9385755008},
+		{A: 4.1516e-07, B: 0.32174409278, C: 529.6427809848},
+		{A: 4.2855e-07, B: 0.03093594081, C: 1439.5096981492},
+		{A: 4.5963e-07, B: 2.54342106514, C: 636.7158925763},
+		{A: 4.0181e-07, B: 4.39381642864, C: 1148.2476104062},
+		{A: 3.877e-07, B: 4.31675565025, C: 149.5631971346},
+		{A: 4.0348e-07, B: 2.10140891053, C: 2744.4340526908},
+		{A: 4.8851e-07, B: 5.60297777544, C: 2810.9214616052},
+		{A: 3.7085e-07, B: 5.07828164301, C: 1905.4647649404},
+		{A: 4.3875e-07, B: 1.24536971083, C: 621.7380390493},
+		{A: 3.4005e-07, B: 3.09360167248, C: 2420.9286360334},
+		{A: 3.6782e-07, B: 0.84232174637, C: 530.6541729411},
+		{A: 3.1139e-07, B: 5.35811251334, C: 1485.9801210652},
+		{A: 3.9295e-07, B: 4.70800489067, C: 569.0478410098},
+		{A: 3.97e-07, B: 2.46163878814, C: 355.7487455718},
+		{A: 3.1527e-07, B: 6.19284070863, C: 3.1813937377},
+		{A: 2.8399e-07, B: 2.48456666067, C: 519.3960243561},
+		{A: 3.2432e-07, B: 2.73281750275, C: 604.4725636619},
+		{A: 2.7119e-07, B: 3.92341697086, C: 2324.9494088156},
+		{A: 2.6753e-07, B: 1.74975198417, C: 2950.619601128},
+		{A: 2.8986e-07, B: 1.83535862643, C: 1891.2376709388},
+		{A: 2.6493e-07, B: 0.60380196895, C: 1055.4497769261},
+		{A: 3.3525e-07, B: 0.76068430639, C: 643.8294395771},
+		{A: 2.6568e-07, B: 1.03594610835, C: 405.2575498736},
+		{A: 2.5534e-07, B: 3.46320665375, C: 458.8415197904},
+		{A: 2.4421e-07, B: 0.8818183693, C: 423.4167971383},
+		{A: 3.2949e-07, B: 3.18597137308, C: 528.7277572481},
+		{A: 2.2456e-07, B: 0.43129919683, C: 1073.6090241908},
+		{A: 2.1599e-07, B: 1.41820425091, C: 540.7366653585},
+		{A: 2.5673e-07, B: 0.5235819476, C: 511.5317178299},
+		{A: 2.1115e-07, B: 3.08023522766, C: 629.6023455755},
+		{A: 2.2713e-07, B: 0.65234613144, C: 3163.918696566},
+		{A: 1.9189e-07, B: 5.16589014963, C: 635.9651330509},
+		{A: 2.6042e-07, B: 1.33629471285, C: 330.6189636582},
+		{A: 1.8263e-07, B: 3.59973446951, C: 746.9222137957},
+		{A: 1.821e-07, B: 2.66819439927, C: 1994.3304451574},
+		{A: 1.9724e-07, B: 4.13552133321, C: 1464.6394800628},
+		{A: 1.948e-07, B: 1.85656428109, C: 3060.8259223474},
+		{A: 2.3927e-07, B: 4.99826361784, C: 1289.9465010146},
+		{A: 2.1886e-07, B: 5.91718683551, C: 1802.3719907218},
+		{A: 1.7482e-07, B: 2.82161612542, C: 2737.32050569},
+		{A: 1.6608e-07, B: 5.67394889755, C: 408.4389436113},
+		{A: 2.2892e-07, B: 5.26731352093, C: 672.1406152284},
+		{A: 1.8349e-07, B: 1.89869734949, C: 1021.2488945514},
+		{A: 1.9123e-07, B: 3.65882402977, C: 415.5524906121},
+		{A: 1.5735e-07, B: 3.34772676006, C: 1056.2005364515},
+		{A: 1.6373e-07, B: 0.18094878053, C: 1699.2792165032},
+		{A: 1.8899e-07, B: 3.69120638874, C: 88.865680217},
+		{A: 1.8655e-07, B: 1.97327300097, C: 38.1330356378},
+		{A: 1.5542e-07, B: 3.8220488101, C: 721.6494195302},
+		{A: 1.678e-07, B: 1.90976657921, C: 217.2312487011},
+		{A: 1.5313e-07, B: 1.05907174619, C: 114.1384744825},
+		{A: 1.519e-07, B: 1.32317039042, C: 117.3198682202},
+		{A: 1.508e-07, B: 3.74469077216, C: 2641.3412784722},
+		{A: 1.9836e-07, B: 2.73184571324, C: 39.3568759152},
+		{A: 1.4708e-07, B: 1.67270454473, C: 529.1697002328},
+		{A: 1.4036e-07, B: 3.54305270022, C: 142.4496501338},
+		{A: 1.2931e-07, B: 1.48829749349, C: 3267.0114707846},
+		{A: 1.4924e-07, B: 1.3254608594, C: 490.3340891794},
+		{A: 1.4753e-07, B: 4.64530618027, C: 6283.0758499914},
+		{A: 1.4672e-07, B: 0.80451954754, C: 5223.6939198022},
+		{A: 1.2085e-07, B: 3.67072510553, C: 750.1036075334},
+		{A: 1.1954e-07, B: 2.97127390765, C: 505.3119427064},
+		{A: 1.465e-07, B: 2.1679293025, C: 530.2122299564},
+		{A: 1.1869e-07, B: 1.66551754962, C: 2207.6295405954},
+		{A: 1.2273e-07, B: 0.20690014405, C: 1062.5633239269},
+		{A: 1.146e-07, B: 1.11906683214, C: 561.934294009},
+		{A: 1.1083e-07, B: 3.22049096074, C: 535.107591066},
+		{A: 1.1567e-07, B: 5.22625628971, C: 524.0618908021},
+		{A: 1.1161e-07, B: 3.82945634036, C: 76.2660712756},
+		{A: 1.0918e-07, B: 1.27796962818, C: 2125.8774073792},
+		{A: 1.2685e-07, B: 3.96848605476, C: 2538.2485042536},
+		{A: 1.123e-07, B: 3.23092119889, C: 422.6660376129},
+		{A: 1.2645e-07, B: 0.7367042858, C: 908.3342603463},
+		{A: 1.133e-07, B: 5.56127247007, C: 531.1754378029},
+		{A: 9.509e-08, B: 5.00507284204, C: 597.3590166611},
+		{A: 1.0291e-07, B: 3.84159025239, C: 1781.0313497194},
+		{A: 1.0762e-07, B: 4.91380719453, C: 525.0250986486},
+		{A: 1.1786e-07, B: 5.11863653538, C: 685.4739373527},
+		{A: 1.198e-07, B: 1.72470898635, C: 911.3032057629},
+		{A: 8.937e-08, B: 2.40338241992, C: 2310.722314814},
+		{A: 9.253e-08, B: 2.57670338148, C: 3053.7123753466},
+		{A: 9.488e-08, B: 2.95089828501, C: 1382.8873468466},
+		{A: 9.889e-08, B: 0.43758517388, C: 3480.3105662226},
+		{A: 8.781e-08, B: 3.66562388594, C: 739.8086667949},
+		{A: 8.664e-08, B: 2.70398612383, C: 526.7702037878},
+		{A: 9.505e-08, B: 1.61249870019, C: 3377.217792004},
+		{A: 1.154e-07, B: 1.59520481029, C: 1474.6737883704},
+		{A: 9.533e-08, B: 0.35468711552, C: 1512.8068240082},
+		{A: 9.98e-08, B: 4.80984684596, C: 558.0021407459},
+		{A: 9.014e-08, B: 1.21458362718, C: 416.3032501375},
+		{A: 7.969e-08, B: 0.08480602718, C: 528.9402055692},
+		{A: 8.668e-08, B: 5.29060005706, C: 945.2434557067},
+		{A: 7.851e-08, B: 1.46751861875, C: 963.4027029714},
+		{A: 8.611e-08, B: 1.13232641062, C: 532.6117264014},
+		{A: 7.838e-08, B: 6.26933498027, C: 647.0108333148},
+		{A: 7.581e-08, B: 2.90608705954, C: 533.8837507886},
+		{A: 8.583e-08, B: 6.06634530166, C: 10213.285546211},
+		{A: 1.0198e-07, B: 2.48743123636, C: 1819.6374661092},
+		{A: 8.536e-08, B: 2.2270070179, C: 9153.9036160218},
+		{A: 9.759e-08, B: 6.15593336218, C: 593.426863398},
+		{A: 7.968e-08, B: 3.75535355212, C: 530.44172462},
+		{A: 7.142e-08, B: 3.58836120327, C: 2957.7331481288},
+		{A: 7.122e-08, B: 0.11970048938, C: 224.3447957019},
+		{A: 8.731e-08, B: 0.7530291397, C: 960.2213092337},
+		{A: 7.063e-08, B: 2.1679303769, C: 724.8308132679},
+		{A: 7.263e-08, B: 2.29499675875, C: 520.129737539},
+		{A: 6.418e-08, B: 1.25058991868, C: 3583.4033404412},
+		{A: 8.27e-08, B: 1.24806288317, C: 495.7507151508},
+		{A: 6.483e-08, B: 4.7456777264, C: 202.2533951741},
+		{A: 7.197e-08, B: 3.84169279666, C: 618.5566453116},
+		{A: 8.146e-08, B: 0.73147060302, C: 230.5645708254},
+		{A: 6.165e-08, B: 5.50124418381, C: 11.0457002639},
+		{A: 7.946e-08, B: 2.07754951174, C: 953.1077622329},
+		{A: 7.675e-08, B: 0.92400307662, C: 525.4981794006},
+		{A: 6.21e-08, B: 1.45641362115, C: 483.2205421786},
+		{A: 7.359e-08, B: 0.31355650764, C: 378.6432952517},
+		{A: 6.707e-08, B: 2.92071167098, C: 1038.0412891868},
+		{A: 7.143e-08, B: 0.18218134889, C: 731.9443602687},
+		{A: 7.309e-08, B: 6.27084533477, C: 21.3406410024},
+		{A: 6.135e-08, B: 2.67651237303, C: 312.4597163935},
+		{A: 5.558e-08, B: 3.83419160288, C: 534.3568315406},
+		{A: 5.344e-08, B: 5.25294750019, C: 1048.3362299253},
+		{A: 7.504e-08, B: 0.74281415471, C: 457.617679513},
+		{A: 5.335e-08, B: 6.23059924424, C: 551.031606097},
+		{A: 5.613e-08, B: 1.51210605952, C: 524.2743391232},
+		{A: 5.284e-08, B: 2.18579185671, C: 280.9671470045},
+		{A: 5.475e-08, B: 5.95864753605, C: 539.9859058331},
+		{A: 5.056e-08, B: 0.37387972537, C: 529.5309064002},
+		{A: 6.202e-08, B: 5.53813122743, C: 2.4476805548},
+		{A: 5.49e-08, B: 5.97692444199, C: 227.5261894396},
+		{A: 6.266e-08, B: 0.76632858238, C: 938.1299087059},
+		{A: 5.75e-08, B: 2.13496323512, C: 191.9584544356},
+		{A: 5.218e-08, B: 4.69335266854, C: 560.7104537316},
+		{A: 5.48e-08, B: 5.21157595558, C: 1057.8974574809},
+		{A: 5.738e-08, B: 0.34249718209, C: 535.9107402181},
+		{A: 4.816e-08, B: 1.51326236835, C: 2524.021410252},
+		{A: 5.056e-08, B: 3.46671669992, C: 529.851023789},
+		{A: 4.71e-08, B: 2.2781383055, C: 3370.1042450032},
+		{A: 5.228e-08, B: 3.61776977584, C: 2097.423219376},
+		{A: 4.878e-08, B: 1.39829798223, C: 3693.6096616606},
+		{A: 5.727e-08, B: 4.80120381106, C: 598.8434893694},
+		{A: 5.707e-08, B: 3.94177950323, C: 2854.6403739102},
+		{A: 4.988e-08, B: 4.87244187719, C: 1.4844727083},
+		{A: 5.424e-08, B: 3.53268613904, C: 456.3938392356},
+		{A: 4.288e-08, B: 4.84438067847, C: 70.8494453042},
+		{A: 5.944e-08, B: 3.79180483544, C: 25558.2121764796},
+		{A: 4.195e-08, B: 2.09136830994, C: 2627.1141844706},
+		{A: 4.582e-08, B: 5.61707254513, C: 2435.155730035},
+		{A: 4.268e-08, B: 6.20250525415, C: 775.233389447},
+		{A: 4.521e-08, B: 0.20049967962, C: 92.0470739547},
+		{A: 5.405e-08, B: 4.66492781581, C: 833.552661779},
+		{A: 5.607e-08, B: 3.30226645638, C: 535.3200393871},
+		{A: 4.171e-08, B: 3.14873010832, C: 944.9828232758},
+		{A: 4.108e-08, B: 5.84489743779, C: 440.8252848776},
+		{A: 4.367e-08, B: 4.68363584557, C: 327.4375699205},
+		{A: 4.033e-08, B: 3.30883782817, C: 3274.1250177854},
+		{A: 4.292e-08, B: 0.20604269202, C: 3796.7024358792},
+		{A: 4.27e-08, B: 0.98941708997, C: 387.2413149608},
+		{A: 4.259e-08, B: 3.21120589971, C: 696.5196376166},
+		{A: 4.673e-08, B: 1.96606729969, C: 107.0249274817},
+		{A: 4.031e-08, B: 4.62854606236, C: 2751.5475996916},
+		{A: 5.115e-08, B: 2.66416451377, C: 1215.1649024473},
+		{A: 4.181e-08, B: 4.74527698816, C: 988.532484885},
+		{A: 4.374e-08, B: 1.50010561403, C: 1894.4190646765},
+		{A: 3.803e-08, B: 3.59911687954, C: 437.6438911399},
+		{A: 3.761e-08, B: 3.96903199782, C: 732.6951197941},
+		{A: 3.62e-08, B: 1.57847427805, C: 381.6122406683},
+		{A: 3.49e-08, B: 0.63097592112, C: 529.9034134157},
+		{A: 4.019e-08, B: 2.5766416572, C: 916.9322800554},
+		{A: 4.133e-08, B: 4.78417930217, C: 824.7421937488},
+		{A: 4.411e-08, B: 3.13179382423, C: 630.3360587584},
+		{A: 4.099e-08, B: 3.63702212253, C: 810.6581120991},
+		{A: 3.704e-08, B: 6.17243801274, C: 537.7677199419},
+		{A: 4.124e-08, B: 2.14248285449, C: 210.1177017003},
+		{A: 3.49e-08, B: 3.20962050417, C: 529.4785167735},
+		{A: 3.281e-08, B: 1.53106243317, C: 547.8502123593},
+		{A: 3.554e-08, B: 6.03787799174, C: 739.0579072695},
+		{A: 4.101e-08, B: 6.00406226999, C: 902.7051860538},
+		{A: 3.267e-08, B: 3.49354065789, C: 1166.4068576709},
+		{A: 3.286e-08, B: 2.5596687053, C: 945.9942152321},
+		{A: 4.041e-08, B: 4.78735413707, C: 850.0149880143},
+		{A: 4.304e-08, B: 0.11406117717, C: 1744.8558675419},
+		{A: 4.043e-08, B: 5.204170936, C: 635.231419868},
+		{A: 3.115e-08, B: 4.61986265585, C: 952.3570027075},
+		{A: 3.016e-08, B: 0.95126220905, C: 3899.7952100978},
+		{A: 3.017e-08, B: 2.59699501992, C: 632.831923423},
+		{A: 3.219e-08, B: 1.83594791142, C: 18.1592472647},
+		{A: 3.203e-08, B: 6.12597544496, C: 10.2949407385},
+		{A: 3.22e-08, B: 6.1421342314, C: 1158.5425511447},
+		{A: 3e-08, B: 5.69509924353, C: 632.7355552034},
+		{A: 3.226e-08, B: 5.59910267099, C: 608.404716925},
+		{A: 3.118e-08, B: 5.64998934505, C: 99.1606209555},
+		{A: 3.745e-08, B: 2.08111521615, C: 282.4516197128},
+		{A: 2.837e-08, B: 4.6017559422, C: 245.5424243524},
+		{A: 3.093e-08, B: 6.02049413961, C: 633.7469471597},
+		{A: 3.12e-08, B: 2.29047945342, C: 631.8205314667},
+		{A: 2.662e-08, B: 3.69016679729, C: 885.4397106664},
+		{A: 3.15e-08, B: 1.79784999553, C: 521.6142102473},
+		{A: 2.822e-08, B: 3.14927418161, C: 295.0512286542},
+		{A: 2.615e-08, B: 0.20732170653, C: 35.4247226521},
+		{A: 2.971e-08, B: 1.28795094653, C: 1023.9572075371},
+		{A: 2.571e-08, B: 2.01817133502, C: 1514.2912967165},
+		{A: 2.592e-08, B: 0.487902212, C: 195.1398481733},
+		{A: 3.263e-08, B: 2.38820607343, C: 836.5216071956},
+		{A: 2.501e-08, B: 0.21653750027, C: 465.9550667912},
+		{A: 2.451e-08, B: 5.58559489768, C: 544.6688186216},
+		{A: 2.535e-08, B: 1.44414086617, C: 460.5384408198},
+		{A: 2.666e-08, B: 3.30350145485, C: 2413.8150890326},
+		{A: 2.412e-08, B: 4.3675658031, C: 1056.9342496344},
+		{A: 2.452e-08, B: 4.53818816565, C: 514.7131115676},
+		{A: 3.239e-08, B: 1.17022488774, C: 177.8743727859},
+		{A: 3.218e-08, B: 0.60551913257, C: 1061.829610744},
+		{A: 2.408e-08, B: 0.6542352381, C: 523.5406259403},
+		{A: 2.299e-08, B: 2.1524775256, C: 319.5732633943},
+		{A: 2.791e-08, B: 2.71505085086, C: 610.6923387854},
+		{A: 2.729e-08, B: 1.77685979153, C: 252.6559713532},
+		{A: 2.666e-08, B: 3.77750458842, C: 3171.0322435668},
+		{A: 2.303e-08, B: 0.36676453766, C: 1969.2006632438},
+		{A: 2.664e-08, B: 0.09674841214, C: 565.1156877467},
+		{A: 2.312e-08, B: 2.07210502831, C: 3686.4961146598},
+		{A: 2.68e-08, B: 4.9444588805, C: 1593.0050485469},
+		{A: 2.193e-08, B: 0.55645982205, C: 2228.9701815978},
+		{A: 2.526e-08, B: 1.07528597373, C: 12036.4607348882},
+		{A: 2.778e-08, B: 1.48379350517, C: 447.7958195265},
+		{A: 2.235e-08, B: 5.95475282699, C: 6151.533888305},
+		{A: 2.759e-08, B: 4.6397615348, C: 462.0229135281},
+		{A: 2.175e-08, B: 4.5358857024, C: 501.3797894433},
+		{A: 2.323e-08, B: 5.93670041006, C: 611.4430983108},
+		{A: 2.384e-08, B: 2.81746622971, C: 3340.6124266998},
+		{A: 2.087e-08, B: 3.10716079675, C: 1049.0869894507},
+		{A: 1.994e-08, B: 2.02500860064, C: 1058.8606653274},
+		{A: 2.199e-08, B: 2.20937490997, C: 1269.4996318895},
+		{A: 2.705e-08, B: 1.97665276677, C: 415.2918581812},
+		{A: 2.787e-08, B: 1.31053438756, C: 1041.2226829245},
+		{A: 2.003e-08, B: 4.66904374443, C: 679.2541622292},
+		{A: 1.962e-08, B: 1.82999730674, C: 2943.5060541272},
+		{A: 2.289e-08, B: 2.96480800939, C: 69.1525242748},
+		{A: 2.192e-08, B: 4.47837196209, C: 209.3669421749},
+		{A: 2.02e-08, B: 0.0462136449, C: 4113.0943055358},
+		{A: 2.082e-08, B: 1.1120305917, C: 4010.0015313172},
+		{A: 1.991e-08, B: 3.20108648275, C: 3590.516887442},
+		{A: 1.9e-08, B: 3.32227077969, C: 421.93232443},
+		{A: 2.193e-08, B: 2.82218305362, C: 292.0128472684},
+		{A: 2.288e-08, B: 1.94695631885, C: 1279.794572628},
+		{A: 1.843e-08, B: 5.23293634337, C: 14.977853527},
+		{A: 1.932e-08, B: 5.4668425203, C: 2281.2304965106},
+		{A: 2.177e-08, B: 2.93031976617, C: 429.0458714308},
+		{A: 2.125e-08, B: 0.06224847826, C: 24.3790223882},
+		{A: 2.464e-08, B: 5.3958107843, C: 1261.6353253633},
+		{A: 1.938e-08, B: 3.79908004671, C: 1059.430114299},
+		{A: 2.029e-08, B: 3.95461157815, C: 771.3012361839},
+		{A: 1.841e-08, B: 4.74905354737, C: 78.7137518304},
+		{A: 1.922e-08, B: 2.21862085389, C: 99.9113804809},
+		{A: 1.836e-08, B: 5.75449805175, C: 623.2225117576},
+		{A: 2.145e-08, B: 3.87052575546, C: 451.9404211107},
+		{A: 1.782e-08, B: 0.40860352236, C: 754.0357607965},
+		{A: 1.784e-08, B: 1.49468287576, C: 529.9515975255},
+		{A: 1.842e-08, B: 3.49726261337, C: 1354.4331588434},
+		{A: 1.748e-08, B: 3.48730020953, C: 522.6256022036},
+		{A: 1.816e-08, B: 1.2433471121, C: 417.0369633204},
+		{A: 1.752e-08, B: 1.15500390019, C: 1060.3451380357},
+		{A: 1.729e-08, B: 2.69831073799, C: 642.3449668688},
+		{A: 1.985e-08, B: 1.99916658759, C: 934.9485149682},
+		{A: 1.828e-08, B: 5.44095029767, C: 1201.831580323},
+		{A: 2.158e-08, B: 3.4567274859, C: 827.9235874865},
+		{A: 1.959e-08, B: 1.06033047373, C: 33.9402499438},
+		{A: 1.751e-08, B: 3.13572498964, C: 384.0599212231},
+		{A: 1.781e-08, B: 5.02895146997, C: 1098.7388061044},
+		{A: 2.074e-08, B: 3.18582065441, C: 1366.2125722902},
+		{A: 1.757e-08, B: 5.02778552877, C: 586.3133163972},
+		{A: 2.045e-08, B: 3.08816627459, C: 535.8413042489},
+		{A: 2.273e-08, B: 5.17998505813, C: 3178.1457905676},
+		{A: 1.617e-08, B: 3.16674916201, C: 67.6680515665},
+		{A: 1.627e-08, B: 6.10603469594, C: 432.0148168474},
+		{A: 1.93e-08, B: 1.63968957659, C: 5.4166259714},
+		{A: 1.741e-08, B: 0.99408274736, C: 1254.5217783625},
+		{A: 1.607e-08, B: 5.65498642076, C: 1165.6560981455},
+		{A: 1.676e-08, B: 3.06138410273, C: 1134.1635287565},
+		{A: 1.821e-08, B: 3.0518355509, C: 567.8240007324},
+		{A: 1.677e-08, B: 3.0917508493, C: 1251.3403846248},
+		{A: 1.994e-08, B: 2.52023134712, C: 1059.903195051},
+		{A: 2.204e-08, B: 6.1537669851, C: 563.6312150384},
+		{A: 1.692e-08, B: 4.19142612803, C: 106.2741679563},
+		{A: 1.906e-08, B: 5.58417395051, C: 32.2433289144},
+		{A: 2.206e-08, B: 1.75883974012, C: 1151.4290041439},
+		{A: 1.552e-08, B: 3.04262360186, C: 385.5443939314},
+		{A: 1.508e-08, B: 0.42002830727, C: 313.2104759189},
+		{A: 1.494e-08, B: 1.43672345922, C: 2840.4132799086},
+		{A: 1.678e-08, B: 2.17255433434, C: 306.830642101},
+		{A: 1.511e-08, B: 4.44377608685, C: 395.105621487},
+		{A: 1.958e-08, B: 0.05215107058, C: 761.7400086283},
+		{A: 1.76e-08, B: 1.27045286501, C: 1173.5204046717},
+		{A: 1.463e-08, B: 6.07810373103, C: 0.9632078465},
+		{A: 1.498e-08, B: 2.79408561759, C: 277.0349937414},
+		{A: 1.636e-08, B: 0.2619935149, C: 522.529233984},
+		{A: 1.507e-08, B: 0.48961801593, C: 4216.1870797544},
+		{A: 1.53e-08, B: 3.4295382755, C: 1159.2933106701},
+		{A: 1.744e-08, B: 2.39637837261, C: 203.0041546995},
+		{A: 1.569e-08, B: 2.55719070621, C: 4.192785694},
+		{A: 1.576e-08, B: 3.45039607104, C: 1058.4187223427},
+		{A: 1.466e-08, B: 2.24427539934, C: 1550.939859646},
+		{A: 1.784e-08, B: 2.34591354953, C: 529.4303326637},
+		{A: 1.939e-08, B: 4.7368542861, C: 3067.9394693482},
+		{A: 1.938e-08, B: 0.60126164334, C: 1059.3337460794},
+		{A: 1.523e-08, B: 2.98744673443, C: 2730.2069586892},
+		{A: 1.834e-08, B: 3.78099298791, C: 420.9691165835},
+		{A: 1.372e-08, B: 3.53997115825, C: 5.6290742925},
+		{A: 1.361e-08, B: 0.45533257707, C: 418.5214360287},
+		{A: 1.833e-08, B: 5.12743628215, C: 1578.0271950199},
+		{A: 1.839e-08, B: 4.2461604421, C: 981.6313862053},
+		{A: 1.567e-08, B: 3.32429870195, C: 532.3992780803},
+		{A: 1.34e-08, B: 1.9466828227, C: 528.4189407074},
+		{A: 1.422e-08, B: 1.83191577465, C: 4002.8879843164},
+		{A: 1.745e-08, B: 5.76913240451, C: 490.0734567485},
+		{A: 1.437e-08, B: 4.19470227783, C: 420.4478517217},
+		{A: 1.419e-08, B: 0.7484900533, C: 632.2624744514},
+		{A: 1.447e-08, B: 5.65611888743, C: 373.0142209592},
+		{A: 1.578e-08, B: 3.90273683089, C: 602.9880909536},
+		{A: 1.385e-08, B: 3.88479835656, C: 419.4364597654},
+		{A: 1.352e-08, B: 0.81697905853, C: 1585.1407420207},
+		{A: 1.399e-08, B: 1.24785452243, C: 633.305004175},
+		{A: 1.297e-08, B: 5.57914023189, C: 1276.6131788903},
+		{A: 1.491e-08, B: 1.66541781223, C: 2655.5683724738},
+		{A: 1.252e-08, B: 0.72155670765, C: 173.9422195228},
+		{A: 1.658e-08, B: 5.6092466285, C: 362.8622925726},
+		{A: 1.606e-08, B: 3.95301396173, C: 2274.5468326365},
+		{A: 1.213e-08, B: 4.55264289565, C: 366.7944458357},
+		{A: 1.521e-08, B: 0.55773831071, C: 1592.2542890215},
+		{A: 1.22e-08, B: 3.6302978804, C: 497.4476361802},
+		{A: 1.215e-08, B: 4.42854185903, C: 531.387886124},
+		{A: 1.549e-08, B: 5.73765962068, C: 320.3240229197},
+		{A: 1.48e-08, B: 4.29779032931, C: 303.8616966844},
+		{A: 1.507e-08, B: 2.27998567874, C: 758.7710632117},
+		{A: 1.212e-08, B: 3.38335836048, C: 536.8526962052},
+		{A: 1.245e-08, B: 4.21639959154, C: 4.665866446},
+		{A: 1.507e-08, B: 3.52136655355, C: 774.0095491696},
+		{A: 1.481e-08, B: 3.06156044618, C: 1585.8915015461},
+		{A: 1.462e-08, B: 2.30628702634, C: 1363.2436268736},
+		{A: 1.18e-08, B: 3.52708055024, C: 1064.7985561606},
+		{A: 1.193e-08, B: 5.88284733845, C: 1060.8664028975},
+		{A: 1.398e-08, B: 4.99456521692, C: 842.9014410135},
+		{A: 1.406e-08, B: 1.53799746944, C: 1020.025054274},
+		{A: 1.367e-08, B: 4.10254739443, C: 799.6124118352},
+		{A: 1.336e-08, B: 1.8938727238, C: 530.9629894818},
+		{A: 1.238e-08, B: 3.62226383331, C: 3487.4241132234},
+		{A: 1.306e-08, B: 3.39985119727, C: 539.2521926502},
+		{A: 1.156e-08, B: 0.77127511567, C: 1603.2999892854},
+		{A: 1.482e-08, B: 0.48451915093, C: 493.0424021651},
+		{A: 1.247e-08, B: 5.64344659992, C: 479.2883889155},
+		{A: 1.195e-08, B: 2.39909893341, C: 561.1835344836},
+		{A: 1.106e-08, B: 0.89453807282, C: 2.9207613068},
+		{A: 1.227e-08, B: 2.76231244946, C: 299.1263942692},
+		{A: 1.128e-08, B: 4.72319873338, C: 124.433415221},
+		{A: 1.086e-08, B: 5.66180289525, C: 1053.7528558967},
+		{A: 1.329e-08, B: 0.1666409453, C: 536.7563279856},
+		{A: 1.082e-08, B: 4.5140735935, C: 528.2546764961},
+		{A: 1.105e-08, B: 1.93890691771, C: 244.318584075},
+		{A: 1.446e-08, B: 0.65096230619, C: 1091.6252591036},
+		{A: 1.071e-08, B: 4.67974963103, C: 521.8266585684},
+		{A: 1.413e-08, B: 4.72936311016, C: 1141.1340634054},
+		{A: 1.086e-08, B: 2.88721124443, C: 1262.3860848887},
+		{A: 1.254e-08, B: 5.74156595137, C: 527.9940440652},
+		{A: 1.082e-08, B: 5.60975006771, C: 531.1272536931},
+		{A: 1.148e-08, B: 3.27410230525, C: 1035.002907801},
+		{A: 1.224e-08, B: 3.6880753715, C: 81.7521332162},
+		{A: 1.072e-08, B: 0.48068438564, C: 1058.6311706638},
+		{A: 1.036e-08, B: 1.68789163831, C: 1070.4276304531},
+		{A: 1.052e-08, B: 4.72763208332, C: 913.7508863177},
+		{A: 1.166e-08, B: 4.97812626679, C: 450.9772132642},
+		{A: 1.042e-08, B: 2.90894542321, C: 3906.9087570986},
+		{A: 9.97e-09, B: 1.65967703856, C: 3259.8979237838},
+		{A: 1.113e-08, B: 3.06502453809, C: 1482.7987273275},
+		{A: 9.91e-09, B: 0.91568114148, C: 576.1613880106},
+		{A: 9.87e-09, B: 0.91349590742, C: 2332.0629558164},
+		{A: 1.003e-08, B: 6.17381204883, C: 391.1734682239},
+		{A: 1.087e-08, B: 3.19260020877, C: 151.0476698429},
+		{A: 9.87e-09, B: 2.48065918834, C: 1912.5783119412},
+		{A: 9.75e-09, B: 1.55458771092, C: 536.2832472336},
+		{A: 1.193e-08, B: 2.19383228, C: 523.0986829556},
+		{A: 9.79e-09, B: 3.2869362066, C: 1379.7059531089},
+		{A: 9.63e-09, B: 2.29845109892, C: 1467.8208738005},
+		{A: 1.279e-08, B: 4.73978455573, C: 600.5404103988},
+		{A: 1.269e-08, B: 1.77171706595, C: 5120.6011455836},
+		{A: 9.38e-09, B: 3.13636271584, C: 1372.5924061081},
+		{A: 9.56e-09, B: 0.94045126791, C: 429.7795846137},
+		{A: 1.13e-08, B: 4.87259620358, C: 874.3940104025},
+		{A: 1.044e-08, B: 3.52819283674, C: 530.5847369719},
+		{A: 1.244e-08, B: 0.80634178279, C: 419.532827985},
+		{A: 9.14e-09, B: 4.34324212455, C: 1127.0499817557},
+		{A: 1.095e-08, B: 3.17513475763, C: 6681.2248533996},
+		{A: 9.26e-09, B: 5.53099018797, C: 537.5552716208},
+		{A: 1.025e-08, B: 6.08315999637, C: 469.8872200543},
+		{A: 9.28e-09, B: 2.64064849636, C: 31.019488637},
+		{A: 8.87e-09, B: 5.53922649066, C: 498.6714764576},
+		{A: 1.153e-08, B: 5.20213407651, C: 554.0699874828},
+		{A: 9.76e-09, B: 4.2604788549, C: 806.725958836},
+		{A: 8.71e-09, B: 5.7975111015, C: 594.6507036754},
+		{A: 1.044e-08, B: 0.31244551729, C: 528.7971932173},
+		{A: 9.11e-09, B: 0.94039205468, C: 337.732510659},
+		{A: 1.197e-08, B: 3.12884590029, C: 1966.2317178272},
+		{A: 9.3e-09, B: 2.88178471518, C: 1056.4611688824},
+		{A: 1.052e-08, B: 1.69484089706, C: 484.444382456},
+		{A: 8.62e-09, B: 0.67309397482, C: 20426.571092422},
+		{A: 1.152e-08, B: 1.16751621652, C: 1489.9122743283},
+		{A: 8.47e-09, B: 3.25831322825, C: 1063.5747158832},
+		{A: 8.84e-09, B: 0.71487680084, C: 2042.4977891028},
+		{A: 8.88e-09, B: 5.38714907441, C: 5621.8429232104},
+		{A: 1.137e-08, B: 4.02029739425, C: 1670.0742689746},
+		{A: 8.44e-09, B: 3.3184679859, C: 812.1425848074},
+		{A: 8.6e-09, B: 4.78175008217, C: 530.914805372},
+		{A: 8.35e-09, B: 3.63117401608, C: 451.7279727896},
+		{A: 9.31e-09, B: 2.27352189963, C: 100.6450936638},
+		{A: 9.39e-09, B: 3.51238251326, C: 523.4711899711},
+		{A: 8.6e-09, B: 5.34207357904, C: 528.4671248172},
+		{A: 8.75e-09, B: 0.8777553711, C: 4326.3934009738},
+		{A: 9.61e-09, B: 5.69327275886, C: 498.1983957056},
+		{A: 9.66e-09, B: 6.25512226434, C: 700.4517908797},
+		{A: 8.42e-09, B: 3.20535945596, C: 1670.8250285},
+		{A: 8.08e-09, B: 1.09148925587, C: 683.1863154923},
+		{A: 8.1e-09, B: 5.47935192896, C: 525.5463635104},
+		{A: 8.55e-09, B: 6.06969867736, C: 446.3113468182},
+		{A: 9.89e-09, B: 1.55623875216, C: 1493.093668066},
+		{A: 8.37e-09, B: 1.49510080792, C: 1025.4416802454},
+		{A: 9.74e-09, B: 3.67667471757, C: 25565.3257234804},
+		{A: 7.88e-09, B: 0.51622458293, C: 526.9826521089},
+		{A: 8.2e-09, B: 1.86002542644, C: 629.8629780064},
+		{A: 8.13e-09, B: 0.45441968195, C: 4694.0029547076},
+		{A: 9.53e-09, B: 0.58786779132, C: 627.3671133418},
+		{A: 9.08e-09, B: 2.82093327912, C: 3046.5988283458},
+		{A: 9.12e-09, B: 2.69124310451, C: 946.727928415},
+		{A: 8.2e-09, B: 4.14947931572, C: 1884.124123938},
+		{A: 9.48e-09, B: 0.77931728039, C: 25551.09862947879},
+		{A: 8.44e-09, B: 0.00976249584, C: 628.5909536192},
+		{A: 9.1e-09, B: 0.99542530366, C: 5760.4984318976},
+		{A: 8.44e-09, B: 0.2263096449, C: 1123.1178284926},
+		{A: 9.24e-09, B: 4.41952345708, C: 5746.271337896},
+		{A: 9.67e-09, B: 3.20618313117, C: 9050.8108418032},
+		{A: 8e-09, B: 0.10663079153, C: 4532.578949411},
+		{A: 7.48e-09, B: 3.01376405927, C: 5481.7545583808},
+		{A: 7.52e-09, B: 5.8236047289, C: 701.936263588},
+		{A: 7.71e-09, B: 0.12101982692, C: 635.70450062},
+		{A: 7.25e-09, B: 2.81220410314, C: 3597.6304344428},
+		{A: 9.44e-09, B: 0.40327408174, C: 1140.38330388},
+		{A: 7.26e-09, B: 5.28930472464, C: 1304.9243545416},
+		{A: 9.94e-09, B: 5.163913701, C: 10316.3783204296},
+		{A: 8.9e-09, B: 4.10819809692, C: 1060.1326897146},
+		{A: 9.62e-09, B: 1.48376004549, C: 1062.302691496},
+		{A: 8.83e-09, B: 5.26813169286, C: 1542.6024723678},
+		{A: 9.16e-09, B: 6.02908368648, C: 7.8643065262},
+		{A: 7.25e-09, B: 2.1877377301, C: 1176.7017984094},
+		{A: 8.08e-09, B: 5.81725174908, C: 1087.6931058405},
+		{A: 7.57e-09, B: 0.7744041433, C: 977.4867846211},
+		{A: 8.38e-09, B: 3.81585420192, C: 986.0848043302},
+		{A: 8.88e-09, B: 1.89634795578, C: 707.5653378805},
+		{A: 8.54e-09, B: 5.47701506544, C: 2818.035008606},
+		{A: 7.96e-09, B: 1.08794807212, C: 987.3086446076},
+		{A: 8.56e-09, B: 2.58042139486, C: 2803.8079146044},
+		{A: 7.08e-09, B: 1.09492310353, C: 248.7238180901},
+		{A: 8.11e-09, B: 3.23726191865, C: 121.2520214833},
+		{A: 7.27e-09, B: 1.56150632966, C: 4319.279853973},
+		{A: 6.87e-09, B: 2.65457835371, C: 1567.7322542814},
+		{A: 6.75e-09, B: 1.78690909614, C: 103.1409583284},
+		{A: 8.53e-09, B: 4.74476428852, C: 951.6232895246},
+		{A: 8.32e-09, B: 5.1436278981, C: 1054.7160637432},
+		{A: 8.46e-09, B: 1.47557828604, C: 898.7730327907},
+		{A: 7.01e-09, B: 1.72139817505, C: 5230.807466803},
+		{A: 8.63e-09, B: 3.98700238575, C: 686.958410061},
+		{A: 7.03e-09, B: 2.89202252444, C: 63.7358983034},
+		{A: 6.73e-09, B: 6.1161858051, C: 738.3241940866},
+		{A: 8.06e-09, B: 4.64475158248, C: 533.8355666788},
+		{A: 6.7e-09, B: 2.67625974048, C: 1012.9115072732},
+		{A: 6.68e-09, B: 4.93815253692, C: 5172.476235725},
+		{A: 8.18e-09, B: 1.41973280302, C: 580.0935412737},
+		{A: 6.52e-09, B: 3.41422919445, C: 650.9429865779},
+		{A: 6.43e-09, B: 2.46566726278, C: 1049.8207026336},
+		{A: 8.59e-09, B: 2.50530106631, C: 782.3469364478},
+		{A: 6.62e-09, B: 4.13533996643, C: 733.428832977},
+		{A: 8.12e-09, B: 1.30325352179, C: 1055.1891444952},
+		{A: 6.38e-09, B: 4.21760246824, C: 1064.0477966352},
+		{A: 6.37e-09, B: 6.13121700151, C: 4752.9915918498},
+		{A: 6.36e-09, B: 0.83411828974, C: 711.4974911436},
+		{A: 6.42e-09, B: 1.86741704507, C: 1053.9653042178},
+		{A: 7.95e-09, B: 4.54081089118, C: 1457.525933062},
+		{A: 7.83e-09, B: 4.37652961667, C: 105.5404547734},
+		{A: 6.4e-09, B: 5.44039474349, C: 632.0329797878},
+		{A: 6.51e-09, B: 5.02431301146, C: 528.0464336919},
+		{A: 6.86e-09, B: 0.27079898498, C: 11.7794134468},
+		{A: 6.44e-09, B: 5.36935176134, C: 835.7878940127},
+		{A: 6.39e-09, B: 1.86699974431, C: 6172.869528772},
+		{A: 6.3e-09, B: 2.86895754523, C: 633.5344988386},
+		{A: 8.26e-09, B: 1.46026926041, C: 2199.7652340692},
+		{A: 6.87e-09, B: 3.81221717134, C: 73.297125859},
+		{A: 6.97e-09, B: 4.18082589322, C: 1.6969210294},
+		{A: 7.88e-09, B: 0.21278801649, C: 313.9441891018},
+		{A: 6.86e-09, B: 2.51807576494, C: 638.4128136057},
+		{A: 8.47e-09, B: 5.56263749391, C: 4429.4861751924},
+		{A: 6.73e-09, B: 4.87494072856, C: 103.0445901088},
+		{A: 6.63e-09, B: 4.80713895807, C: 991.7138786227},
+		{A: 6.14e-09, B: 3.87231597482, C: 767.3690829208},
+		{A: 6.66e-09, B: 5.71697262323, C: 661.0949149645},
+		{A: 6.81e-09, B: 2.33844767741, C: 501.2367770914},
+		{A: 5.97e-09, B: 3.03921014345, C: 6.9534883064},
+		{A: 7.77e-09, B: 3.08786050361, C: 441.576044403},
+		{A: 5.88e-09, B: 0.08236113246, C: 4164.311989613},
+		{A: 6.93e-09, B: 4.66190836234, C: 3384.3313390048},
+		{A: 8.1e-09, B: 1.9770108449, C: 860.3099287528},
+		{A: 6.02e-09, B: 5.56403449542, C: 1587.5884225755},
+		{A: 6.22e-09, B: 6.11554348965, C: 7.065362891},
+		{A: 5.92e-09, B: 3.29013906024, C: 10103.0792249916},
+		{A: 6.92e-09, B: 6.10931942233, C: 12.7426212933},
+		{A: 5.97e-09, B: 6.13204711801, C: 7.2736056952},
+		{A: 5.94e-09, B: 2.58839673551, C: 849.2642284889},
+		{A: 7.28e-09, B: 2.73732195088, C: 6.1503391543},
+		{A: 6.02e-09, B: 5.28816527514, C: 949.12742486},
+		{A: 5.68e-09, B: 1.75508433865, C: 1077.5411774539},
+		{A: 5.75e-09, B: 4.50676079721, C: 1230.1427559743},
+		{A: 5.88e-09, B: 0.65827893998, C: 4642.7852706304},
+		{A: 5.61e-09, B: 3.8756591436, C: 135.336103133},
+		{A: 5.58e-09, B: 3.36094471852, C: 24498.8302462904},
+		{A: 5.57e-09, B: 3.45629457197, C: 19896.8801273274},
+		{A: 5.58e-09, B: 1.17103892689, C: 3576.2897934404},
+		{A: 5.74e-09, B: 5.1923507414, C: 104.0559820651},
+		{A: 5.6e-09, B: 3.57141429379, C: 5333.9002410216},
+		{A: 5.55e-09, B: 0.18349908409, C: 512.4254897072},
+		{A: 5.71e-09, B: 0.8307014882, C: 1570.9136480191},
+		{A: 6.32e-09, B: 3.67893818442, C: 1065.0110044817},
+		{A: 7.44e-09, B: 2.33083237537, C: 620.253566341},
+		{A: 5.4e-09, B: 5.15775909675, C: 1751.539531416},
+		{A: 5.92e-09, B: 3.07238123875, C: 1446.62324515},
+		{A: 5.37e-09, B: 1.52803865425, C: 8094.5216858326},
+		{A: 5.5e-09, B: 5.50701003577, C: 1432.3961511484},
+		{A: 5.46e-09, B: 2.34388967045, C: 949.2237930796},
+		{A: 5.34e-09, B: 3.04076654796, C: 7.1617311106},
+		{A: 6.19e-09, B: 6.07865159203, C: 46.470422916},
+		{A: 5.62e-09, B: 0.96641974928, C: 1438.0252254409},
+		{A: 5.31e-09, B: 1.0669554739, C: 100.1720129118},
+		{A: 5.99e-09, B: 3.59295739143, C: 1144.3154571431},
+		{A: 5.26e-09, B: 3.51641923371, C: 0.7507595254},
+		{A: 5.64e-09, B: 0.72677136494, C: 1059.2218714948},
+		{A: 5.37e-09, B: 5.72603965787, C: 513.2286388593},
+		{A: 6.3e-09, B: 2.311831439, C: 2729.4561991638},
+		{A: 5.3e-09, B: 4.99510636441, C: 9264.1099372412},
+		{A: 6.49e-09, B: 0.95666735852, C: 920.8644333185},
+		{A: 5.47e-09, B: 1.18801926149, C: 11506.7697697936},
+		{A: 5.16e-09, B: 3.28562070858, C: 734.9133056853},
+		{A: 5.67e-09, B: 5.13926871155, C: 288.0806940053},
+		{A: 5.38e-09, B: 0.2815963768, C: 153.4953503977},
+		{A: 7.18e-09, B: 0.48326672359, C: 842.1506814881},
+		{A: 5.26e-09, B: 4.39778401928, C: 546.1532913299},
+		{A: 6.95e-09, B: 2.44235086902, C: 657.1627617014},
+		{A: 6.97e-09, B: 4.99042365686, C: 12.5301729722},
+		{A: 5.19e-09, B: 6.27847163164, C: 59.8037450403},
+		{A: 5.04e-09, B: 2.58550284, C: 5378.6617841622},
+		{A: 4.96e-09, B: 2.43659402827, C: 990.2294059144},
+		{A: 6.17e-09, B: 5.732849857, C: 745.4377410874},
+		{A: 5.19e-09, B: 3.1015709777, C: 9161.0171630226},
+		{A: 6.54e-09, B: 1.31181453784, C: 878.3261636656},
+		{A: 6.19e-09, B: 3.71554817226, C: 2090.3096723752},
+		{A: 5e-09, B: 4.28937439066, C: 5216.5803728014},
+		{A: 6.21e-09, B: 3.98893673383, C: 409.9234163196},
+		{A: 6.85e-09, B: 1.95310431695, C: 3156.8051495652},
+		{A: 5.52e-09, B: 2.81774132958, C: 344.7030453079},
+		{A: 5.51e-09, B: 1.91969778405, C: 113.3877149571},
+		{A: 6.82e-09, B: 0.87321578326, C: 6069.7767545534},
+		{A: 6.51e-09, B: 5.09951064975, C: 531.3354964973},
+		{A: 5.37e-09, B: 3.67357440226, C: 605.9570363702},
+		{A: 5.25e-09, B: 0.74584814988, C: 736.8397213783},
+		{A: 5.05e-09, B: 3.12494814307, C: 1475.6851803267},
+		{A: 6.22e-09, B: 3.00013939606, C: 2349.3284312038},
+		{A: 6.44e-09, B: 3.00156986335, C: 298.2326223919},
+		{A: 5.64e-09, B: 3.81960833949, C: 1059.5419888836},
+		{A: 4.68e-09, B: 3.50348554992, C: 4841.8572720668},
+		{A: 4.91e-09, B: 1.28535573072, C: 247.2393453818},
+		{A: 4.58e-09, B: 0.45056377876, C: 1065.6017053127},
+		{A: 5.43e-09, B: 2.3970430832, C: 9690.7081281172},
+		{A: 4.59e-09, B: 5.29870259698, C: 1474.9344208013},
+		{A: 4.83e-09, B: 3.63649121244, C: 131.4039498699},
+		{A: 6.32e-09, B: 2.75028345792, C: 334.5511169213},
+		{A: 4.83e-09, B: 0.42979609421, C: 735.828329422},
+		{A: 5.4e-09, B: 0.54791737146, C: 51646.11531805379},
+		{A: 5.31e-09, B: 0.30026207053, C: 912.7876784712},
+		{A: 4.49e-09, B: 3.02583472996, C: 5901.239202256},
+		{A: 5.44e-09, B: 2.98747240952, C: 4223.3006267552},
+		{A: 5.57e-09, B: 5.83542572008, C: 9676.4810341156},
+		{A: 5.01e-09, B: 0.03408180117, C: 1080.7225711916},
+		{A: 5.17e-09, B: 4.40400852026, C: 2545.3620512544},
+		{A: 4.81e-09, B: 3.63292807076, C: 5584.8473325994},
+		{A: 5.57e-09, B: 6.1144397819, C: 976.0023119128},
+		{A: 4.81e-09, B: 3.41035583659, C: 3803.81598288},
+		{A: 6.22e-09, B: 2.29597570837, C: 9999.986450773},
+		{A: 4.54e-09, B: 2.88584538455, C: 1987.2168981566},
+		{A: 4.39e-09, B: 4.83198101064, C: 50.4025761791},
+		{A: 4.75e-09, B: 2.69994471394, C: 491.8185618877},
+		{A: 6.18e-09, B: 0.72471290082, C: 1291.4309737229},
+		{A: 5.03e-09, B: 0.13449993622, C: 2015.6710861598},
+		{A: 5.51e-09, B: 2.13418546604, C: 1440.9941708575},
+		{A: 5.95e-09, B: 3.78181802545, C: 6386.16862421},
+		{A: 4.34e-09, B: 2.64411689486, C: 748.406686504},
+		{A: 5.92e-09, B: 0.32587740408, C: 737.3609862401},
+		{A: 4.9e-09, B: 2.379888288, C: 2225.7887878601},
+		{A: 4.39e-09, B: 1.33582802018, C: 995.6460318858},
+		{A: 5.43e-09, B: 2.05067702505, C: 906.849787638},
+		{A: 4.66e-09, B: 2.43707405011, C: 3362.9906980024},
+		{A: 4.81e-09, B: 2.32223226419, C: 1357.6145525811},
+		{A: 5.66e-09, B: 0.59740900184, C: 350.3321196004},
+		{A: 4.29e-09, B: 2.46287580628, C: 3914.0223040994},
+		{A: 4.29e-09, B: 1.01299906509, C: 4333.5069479746},
+		{A: 4.25e-09, B: 1.67255823369, C: 148.0787244263},
+		{A: 4.12e-09, B: 3.29630633921, C: 7.3259953219},
+		{A: 5.08e-09, B: 1.16158524676, C: 9.5612275556},
+		{A: 5.24e-09, B: 5.0256292612, C: 1090.4014188262},
+		{A: 4.09e-09, B: 5.80053072411, C: 9146.790069021},
+		{A: 4.97e-09, B: 0.01579913593, C: 1069.6768709277},
+		{A: 5.48e-09, B: 6.03429743373, C: 9367.2027114598},
+		{A: 4.33e-09, B: 5.9368835084, C: 1688.2335162393},
+		{A: 4.24e-09, B: 4.1815011153, C: 550.1378342197},
+		{A: 4.01e-09, B: 0.11519846139, C: 970.5162499722},
+		{A: 5.03e-09, B: 5.28212300854, C: 668.2084619653},
+		{A: 5.55e-09, B: 1.00328633255, C: 141.2258098564},
+		{A: 4.04e-09, B: 2.48633976473, C: 519.656656787},
+		{A: 4.41e-09, B: 6.06185501734, C: 25.1297819136},
+		{A: 4.12e-09, B: 5.87495245826, C: 6.9010986797},
+		{A: 4.78e-09, B: 0.71264950607, C: 1094.8066528413},
+		{A: 4.46e-09, B: 2.71248183031, C: 31.492569389},
+		{A: 4.04e-09, B: 5.49462012486, C: 447.9388318784},
+		{A: 3.91e-09, B: 1.261056127, C: 8.0767548473},
+		{A: 4.63e-09, B: 1.93535321271, C: 6275.9623029906},
+		{A: 5.07e-09, B: 3.61089992782, C: 546.956440482},
+		{A: 4.02e-09, B: 5.86200127054, C: 927.8349679674},
+		{A: 4.81e-09, B: 6.21043578332, C: 683.9894646444},
+		{A: 4.83e-09, B: 5.02142924458, C: 857.1285350151},
+		{A: 4.44e-09, B: 0.84873092377, C: 1371.8416465827},
+		{A: 3.91e-09, B: 2.81753436573, C: 5798.1464280374},
+		{A: 3.95e-09, B: 0.22367886581, C: 51116.4243529592},
+		{A: 3.78e-09, B: 6.03765733432, C: 1268.7488723641},
+		{A: 4.71e-09, B: 6.24506463249, C: 946.4672959841},
+		{A: 4.05e-09, B: 0.57785207581, C: 107.2855599126},
+		{A: 3.71e-09, B: 6.15750793727, C: 509.2440959695},
+		{A: 3.7e-09, B: 4.90330687618, C: 1436.5407527326},
+		{A: 4.48e-09, B: 4.76565111029, C: 284.1485407422},
+		{A: 4.74e-09, B: 0.71146352197, C: 2108.4689196399},
+		{A: 5.09e-09, B: 5.53328407404, C: 1128.534454464},
+	},
+	// R1, 381 terms
+	{
+		{A: 0.01271801596, B: 2.64937511122, C: 529.6909650946},
+		{A: 0.00061661771, B: 3.00076251018, C: 1059.3819301892},
+		{A: 0.00053443592, B: 3.89717644226, C: 522.5774180938},
+		{A: 0.00031185167, B: 4.88276663526, C: 536.8045120954},
+		{A: 0.00041390257, B: 0, C: 0},
+		{A: 0.0001184719, B: 2.41329588176, C: 419.4846438752},
+		{A: 9.16636e-05, B: 4.75979408587, C: 7.1135470008},
+		{A: 3.175763e-05, B: 2.79297987071, C: 103.0927742186},
+		{A: 3.203446e-05, B: 5.21083285476, C: 735.8765135318},
+		{A: 3.403605e-05, B: 3.34688537997, C: 1589.0728952838},
+		{A: 2.600003e-05, B: 3.63435101622, C: 206.1855484372},
+		{A: 2.412207e-05, B: 1.46947308304, C: 426.598190876},
+		{A: 2.806064e-05, B: 3.7422369358, C: 515.463871093},
+		{A: 2.676575e-05, B: 4.33052878699, C: 1052.2683831884},
+		{A: 2.100507e-05, B: 3.92762682306, C: 639.897286314},
+		{A: 1.646182e-05, B: 5.30953510947, C: 1066.49547719},
+		{A: 1.641257e-05, B: 4.41628669824, C: 625.6701923124},
+		{A: 1.049866e-05, B: 3.16113622955, C: 213.299095438},
+		{A: 1.024802e-05, B: 2.55432643018, C: 412.3710968744},
+		{A: 7.40996e-06, B: 2.17094630558, C: 1162.4747044078},
+		{A: 8.06404e-06, B: 2.6775080138, C: 632.7837393132},
+		{A: 6.76928e-06, B: 6.2495347979, C: 838.9692877504},
+		{A: 4.68895e-06, B: 4.70973463481, C: 543.9180590962},
+		{A: 4.44683e-06, B: 0.40281181402, C: 323.5054166574},
+		{A: 5.67076e-06, B: 4.57655414712, C: 742.9900605326},
+		{A: 4.15894e-06, B: 5.36836018215, C: 728.762966531},
+		{A: 4.84689e-06, B: 2.46882793186, C: 949.1756089698},
+		{A: 3.37555e-06, B: 3.1678195112, C: 956.2891559706},
+		{A: 4.01738e-06, B: 4.60528841541, C: 309.2783226558},
+		{A: 3.47378e-06, B: 4.68148808722, C: 14.2270940016},
+		{A: 2.60753e-06, B: 5.34290306101, C: 846.0828347512},
+		{A: 2.20084e-06, B: 4.84210964963, C: 1368.660252845},
+		{A: 2.03217e-06, B: 5.59995425432, C: 1155.361157407},
+		{A: 2.46603e-06, B: 3.92313823537, C: 942.062061969},
+		{A: 1.83504e-06, B: 4.26526769703, C: 95.9792272178},
+		{A: 1.80134e-06, B: 4.40165491159, C: 532.8723588323},
+		{A: 1.97134e-06, B: 3.70551461394, C: 2118.7638603784},
+		{A: 1.96005e-06, B: 3.75877587139, C: 199.0720014364},
+		{A: 2.0019e-06, B: 4.43888814441, C: 1045.1548361876},
+		{A: 1.70225e-06, B: 4.84647488867, C: 526.5095713569},
+		{A: 1.46335e-06, B: 6.12958365535, C: 533.6231183577},
+		{A: 1.33483e-06, B: 1.32245735855, C: 110.2063212194},
+		{A: 1.32076e-06, B: 4.51187950811, C: 525.7588118315},
+		{A: 1.23851e-06, B: 2.04290370696, C: 1478.8665740644},
+		{A: 1.21861e-06, B: 4.40581788491, C: 1169.5882514086},
+		{A: 1.15313e-06, B: 4.46741278152, C: 1581.959348283},
+		{A: 9.8527e-07, B: 5.72833991647, C: 1596.1864422846},
+		{A: 9.1608e-07, B: 4.52965592121, C: 1685.0521225016},
+		{A: 1.10638e-06, B: 3.62504147403, C: 1272.6810256272},
+		{A: 8.0536e-07, B: 4.11311699583, C: 1258.4539316256},
+		{A: 7.9552e-07, B: 2.71898473954, C: 1692.1656695024},
+		{A: 1.00164e-06, B: 5.24693885858, C: 1265.5674786264},
+		{A: 7.7854e-07, B: 5.56722651753, C: 1471.7530270636},
+		{A: 8.5766e-07, B: 0.07906707372, C: 831.8557407496},
+		{A: 8.2132e-07, B: 3.80763015979, C: 508.3503240922},
+		{A: 5.5319e-07, B: 0.35180851191, C: 316.3918696566},
+		{A: 5.2338e-07, B: 5.53074272117, C: 433.7117378768},
+		{A: 5.5769e-07, B: 4.75141241141, C: 302.164775655},
+		{A: 5.0597e-07, B: 4.8560316177, C: 1375.7737998458},
+		{A: 4.3554e-07, B: 4.94441642712, C: 1361.5467058442},
+		{A: 4.2172e-07, B: 1.22404278447, C: 853.196381752},
+		{A: 3.7695e-07, B: 4.26767539209, C: 2001.4439921582},
+		{A: 4.9395e-07, B: 4.01422828967, C: 220.4126424388},
+		{A: 3.8263e-07, B: 5.33025236797, C: 1788.1448967202},
+		{A: 3.5611e-07, B: 1.76205571128, C: 1795.258443721},
+		{A: 3.6296e-07, B: 3.84995284393, C: 1574.8458012822},
+		{A: 2.9332e-07, B: 5.16619257786, C: 3.9321532631},
+		{A: 2.518e-07, B: 4.33777727362, C: 519.3960243561},
+		{A: 2.4778e-07, B: 2.7290789741, C: 405.2575498736},
+		{A: 2.7025e-07, B: 6.09669947903, C: 1148.2476104062},
+		{A: 2.2604e-07, B: 0.19173890105, C: 380.12776796},
+		{A: 2.0499e-07, B: 4.32881495378, C: 3.1813937377},
+		{A: 1.9925e-07, B: 4.62967500111, C: 1677.9385755008},
+		{A: 1.9528e-07, B: 5.10596326232, C: 1073.6090241908},
+		{A: 1.8427e-07, B: 3.765221783, C: 1485.9801210652},
+		{A: 1.8869e-07, B: 5.05259402407, C: 2104.5367663768},
+		{A: 1.7031e-07, B: 4.01843356903, C: 2317.8358618148},
+		{A: 1.6671e-07, B: 5.42931676507, C: 88.865680217},
+		{A: 1.5337e-07, B: 2.92700926091, C: 2008.557539159},
+		{A: 1.4499e-07, B: 3.63339836845, C: 628.8515860501},
+		{A: 1.4575e-07, B: 5.50832843322, C: 721.6494195302},
+		{A: 1.3728e-07, B: 4.87623389735, C: 629.6023455755},
+		{A: 1.8481e-07, B: 6.03032762264, C: 330.6189636582},
+		{A: 1.3499e-07, B: 1.38539534821, C: 518.6452648307},
+		{A: 1.574e-07, B: 2.93038271684, C: 1905.4647649404},
+		{A: 1.2459e-07, B: 1.58587053146, C: 2111.6503133776},
+		{A: 1.2272e-07, B: 3.37671053917, C: 635.9651330509},
+		{A: 1.1836e-07, B: 4.08486322993, C: 2648.454825473},
+		{A: 1.1166e-07, B: 4.62623267608, C: 636.7158925763},
+		{A: 1.4348e-07, B: 2.74177797727, C: 2221.856634597},
+		{A: 1.1221e-07, B: 3.55311861205, C: 1891.2376709388},
+		{A: 1.3121e-07, B: 5.83845065644, C: 1464.6394800628},
+		{A: 1.1351e-07, B: 2.5760688623, C: 511.5317178299},
+		{A: 1.0487e-07, B: 0.49850799841, C: 453.424893819},
+		{A: 9.728e-08, B: 4.38837468002, C: 1994.3304451574},
+		{A: 1.0131e-07, B: 2.76432756215, C: 423.4167971383},
+		{A: 8.62e-08, B: 5.16374493158, C: 1056.2005364515},
+		{A: 8.952e-08, B: 4.79407952752, C: 2420.9286360334},
+		{A: 8.126e-08, B: 3.72977106954, C: 2634.2277314714},
+		{A: 8.078e-08, B: 1.29246272894, C: 2428.0421830342},
+		{A: 8.867e-08, B: 1.85684753622, C: 750.1036075334},
+		{A: 8.912e-08, B: 4.80973516711, C: 1062.5633239269},
+		{A: 8.552e-08, B: 4.53818617984, C: 21.3406410024},
+		{A: 9.468e-08, B: 4.33472161983, C: 1802.3719907218},
+		{A: 6.904e-08, B: 5.96616555709, C: 540.7366653585},
+		{A: 7.293e-08, B: 4.97763580465, C: 1699.2792165032},
+		{A: 7.083e-08, B: 4.99096728816, C: 1055.4497769261},
+		{A: 7.226e-08, B: 4.97823884383, C: 1898.3512179396},
+		{A: 6.464e-08, B: 1.39173466879, C: 422.6660376129},
+		{A: 6.214e-08, B: 4.46490158256, C: 551.031606097},
+		{A: 6.794e-08, B: 2.90878831415, C: 2324.9494088156},
+		{A: 6.173e-08, B: 3.65617162985, C: 621.7380390493},
+		{A: 6.243e-08, B: 6.13691919694, C: 2125.8774073792},
+		{A: 5.936e-08, B: 2.5831223512, C: 569.0478410098},
+		{A: 6.504e-08, B: 4.56908431757, C: 1038.0412891868},
+		{A: 7.305e-08, B: 3.02062127734, C: 416.3032501375},
+		{A: 6.598e-08, B: 5.55348005731, C: 1781.0313497194},
+		{A: 5.133e-08, B: 6.2164691798, C: 963.4027029714},
+		{A: 5.876e-08, B: 4.23153077453, C: 539.9859058331},
+		{A: 5.119e-08, B: 0.06942832171, C: 1063.3140834523},
+		{A: 5.46e-08, B: 4.91084384602, C: 835.0371344873},
+		{A: 4.989e-08, B: 1.3515369468, C: 1382.8873468466},
+		{A: 5.224e-08, B: 0.18468411116, C: 117.3198682202},
+		{A: 6.187e-08, B: 3.87193497099, C: 191.9584544356},
+		{A: 4.681e-08, B: 4.61057119508, C: 643.8294395771},
+		{A: 4.627e-08, B: 3.34644534691, C: 2207.6295405954},
+		{A: 4.526e-08, B: 4.07729737127, C: 2310.722314814},
+		{A: 4.718e-08, B: 4.55578336947, C: 2737.32050569},
+		{A: 4.471e-08, B: 1.47603161897, C: 408.4389436113},
+		{A: 4.073e-08, B: 1.1301490318, C: 415.5524906121},
+		{A: 5.476e-08, B: 5.63198569698, C: 618.5566453116},
+		{A: 4.034e-08, B: 4.09631702747, C: 430.5303441391},
+		{A: 4.304e-08, B: 4.60536378943, C: 647.0108333148},
+		{A: 3.765e-08, B: 3.42751259825, C: 2950.619601128},
+		{A: 4.559e-08, B: 4.23723998745, C: 227.5261894396},
+		{A: 3.695e-08, B: 1.03127824978, C: 2744.4340526908},
+		{A: 3.667e-08, B: 4.12268925541, C: 440.8252848776},
+		{A: 3.677e-08, B: 2.19480200527, C: 534.3568315406},
+		{A: 3.818e-08, B: 1.14800596289, C: 74.7815985673},
+		{A: 4.221e-08, B: 2.37721579949, C: 2538.2485042536},
+		{A: 3.488e-08, B: 5.33792561596, C: 458.8415197904},
+		{A: 3.437e-08, B: 4.26164443643, C: 10.2949407385},
+		{A: 4.394e-08, B: 0.18808423412, C: 824.7421937488},
+		{A: 3.339e-08, B: 4.85708402591, C: 295.0512286542},
+		{A: 3.329e-08, B: 5.50043586719, C: 739.8086667949},
+		{A: 3.623e-08, B: 4.64011531952, C: 2214.7430875962},
+		{A: 3.185e-08, B: 2.69708590442, C: 561.934294009},
+		{A: 3.421e-08, B: 3.38512615384, C: 149.5631971346},
+		{A: 3.442e-08, B: 4.34217280083, C: 305.3461693927},
+		{A: 3.58e-08, B: 5.29481665335, C: 2097.423219376},
+		{A: 3.401e-08, B: 2.74761862893, C: 2641.3412784722},
+		{A: 2.901e-08, B: 0.91012525424, C: 984.6003316219},
+		{A: 3.566e-08, B: 1.63400343968, C: 525.0250986486},
+		{A: 2.869e-08, B: 1.31799241974, C: 611.4430983108},
+		{A: 2.635e-08, B: 5.25517910535, C: 532.1386456494},
+		{A: 2.683e-08, B: 4.24641945773, C: 3053.7123753466},
+		{A: 2.614e-08, B: 3.17862099921, C: 527.2432845398},
+		{A: 2.251e-08, B: 4.2159824736, C: 739.0579072695},
+		{A: 2.268e-08, B: 5.5224811056, C: 524.2743391232},
+		{A: 2.372e-08, B: 4.19741177512, C: 217.2312487011},
+		{A: 2.623e-08, B: 5.82647427958, C: 732.6951197941},
+		{A: 2.666e-08, B: 3.92538056951, C: 210.1177017003},
+		{A: 2.036e-08, B: 4.84043420813, C: 1049.0869894507},
+		{A: 2.441e-08, B: 2.63840901843, C: 760.25553592},
+		{A: 2.095e-08, B: 5.76269812349, C: 529.6427809848},
+		{A: 2.021e-08, B: 3.81308146017, C: 2627.1141844706},
+		{A: 2.089e-08, B: 4.18463193132, C: 945.9942152321},
+		{A: 2.305e-08, B: 1.6122066569, C: 604.4725636619},
+		{A: 1.969e-08, B: 5.37427735384, C: 142.4496501338},
+		{A: 1.923e-08, B: 4.75088270631, C: 535.107591066},
+		{A: 1.955e-08, B: 5.49000238006, C: 1439.5096981492},
+		{A: 1.877e-08, B: 3.26978877187, C: 3267.0114707846},
+		{A: 2.286e-08, B: 2.93885172004, C: 76.2660712756},
+		{A: 2.074e-08, B: 5.85386852879, C: 532.6117264014},
+		{A: 2.121e-08, B: 3.92430797099, C: 2435.155730035},
+		{A: 1.807e-08, B: 3.17208959472, C: 2524.021410252},
+		{A: 1.712e-08, B: 4.02986641257, C: 731.9443602687},
+		{A: 2.119e-08, B: 0.41049593984, C: 1279.794572628},
+		{A: 1.66e-08, B: 2.34370903423, C: 528.7277572481},
+		{A: 1.655e-08, B: 0.78809717175, C: 3060.8259223474},
+		{A: 1.729e-08, B: 4.26127896267, C: 724.8308132679},
+		{A: 2.06e-08, B: 5.04785330873, C: 2413.8150890326},
+		{A: 2.095e-08, B: 2.67732367556, C: 529.7391492044},
+		{A: 1.933e-08, B: 2.49162437046, C: 2957.7331481288},
+		{A: 1.898e-08, B: 2.71948262975, C: 952.3570027075},
+		{A: 1.634e-08, B: 2.98113068812, C: 945.2434557067},
+		{A: 1.582e-08, B: 5.84373095005, C: 547.8502123593},
+		{A: 1.662e-08, B: 0.27359627181, C: 454.9093665273},
+		{A: 1.595e-08, B: 1.18530167095, C: 38.1330356378},
+		{A: 1.55e-08, B: 0.64264572959, C: 312.4597163935},
+		{A: 1.525e-08, B: 4.08789824989, C: 1158.5425511447},
+		{A: 1.542e-08, B: 1.12520322326, C: 1021.2488945514},
+		{A: 1.539e-08, B: 0.37324921979, C: 319.5732633943},
+		{A: 1.628e-08, B: 5.24285773388, C: 1354.4331588434},
+		{A: 1.897e-08, B: 3.79973291113, C: 953.1077622329},
+		{A: 1.44e-08, B: 4.37872256685, C: 3178.1457905676},
+		{A: 1.439e-08, B: 4.26513521887, C: 526.7702037878},
+		{A: 1.557e-08, B: 5.43779802371, C: 81.7521332162},
+		{A: 1.656e-08, B: 6.0966708974, C: 530.6541729411},
+		{A: 1.548e-08, B: 3.48799710267, C: 934.9485149682},
+		{A: 1.772e-08, B: 5.82549274759, C: 909.8187330546},
+		{A: 1.615e-08, B: 1.45018725033, C: 902.7051860538},
+		{A: 1.387e-08, B: 2.52840497309, C: 530.44172462},
+		{A: 1.574e-08, B: 1.89565809136, C: 437.6438911399},
+		{A: 1.459e-08, B: 3.32546061506, C: 1041.2226829245},
+		{A: 1.377e-08, B: 0.10015418633, C: 490.3340891794},
+		{A: 1.46e-08, B: 4.00706825185, C: 3370.1042450032},
+		{A: 1.605e-08, B: 4.27993020192, C: 2531.1349572528},
+		{A: 1.707e-08, B: 6.28253681644, C: 18.1592472647},
+		{A: 1.802e-08, B: 2.23019296374, C: 2854.6403739102},
+		{A: 1.39e-08, B: 3.76737324192, C: 1165.6560981455},
+		{A: 1.498e-08, B: 0.17285954362, C: 1141.1340634054},
+		{A: 1.401e-08, B: 4.81225317549, C: 1251.3403846248},
+		{A: 1.244e-08, B: 2.83383980283, C: 124.433415221},
+		{A: 1.32e-08, B: 5.80675430384, C: 387.2413149608},
+		{A: 1.329e-08, B: 0.88314574243, C: 916.9322800554},
+		{A: 1.558e-08, B: 6.17808619637, C: 983.1158589136},
+		{A: 1.243e-08, B: 0.29239666059, C: 597.3590166611},
+		{A: 1.541e-08, B: 3.51095241498, C: 2751.5475996916},
+		{A: 1.482e-08, B: 0.83066678204, C: 529.1697002328},
+		{A: 1.149e-08, B: 3.91142023857, C: 99.9113804809},
+		{A: 1.114e-08, B: 3.5333963729, C: 483.2205421786},
+		{A: 1.195e-08, B: 4.16301075999, C: 203.0041546995},
+		{A: 1.1e-08, B: 1.74769285223, C: 497.4476361802},
+		{A: 1.458e-08, B: 5.19315120878, C: 1592.2542890215},
+		{A: 1.123e-08, B: 1.45270581179, C: 533.8837507886},
+		{A: 1.078e-08, B: 5.2399179294, C: 1159.2933106701},
+		{A: 1.083e-08, B: 3.57026506855, C: 2943.5060541272},
+		{A: 1.072e-08, B: 0.07132659992, C: 1070.4276304531},
+		{A: 1.037e-08, B: 5.48955598976, C: 1585.8915015461},
+		{A: 1.343e-08, B: 0.29600445633, C: 860.3099287528},
+		{A: 1.361e-08, B: 3.46603373194, C: 107.0249274817},
+		{A: 1.061e-08, B: 2.44580706826, C: 1048.3362299253},
+		{A: 1.002e-08, B: 5.5521611741, C: 337.732510659},
+		{A: 9.81e-09, B: 3.15500987023, C: 70.8494453042},
+		{A: 1.007e-08, B: 4.11504050436, C: 501.2367770914},
+		{A: 9.65e-09, B: 5.63719524421, C: 1603.2999892854},
+		{A: 1.083e-08, B: 4.8837390981, C: 1166.4068576709},
+		{A: 9.53e-09, B: 2.83352026342, C: 3583.4033404412},
+		{A: 1.06e-08, B: 3.18542176646, C: 447.7958195265},
+		{A: 1.136e-08, B: 2.2656859095, C: 525.4981794006},
+		{A: 1.191e-08, B: 2.25249961404, C: 106.2741679563},
+		{A: 8.84e-09, B: 4.69777781327, C: 960.2213092337},
+		{A: 1.165e-08, B: 1.56030440737, C: 630.3360587584},
+		{A: 9.47e-09, B: 0.50856414717, C: 842.9014410135},
+		{A: 1.011e-08, B: 0.30814674949, C: 1593.0050485469},
+		{A: 9.24e-09, B: 2.31939900786, C: 327.4375699205},
+		{A: 8.96e-09, B: 0.22222521202, C: 746.9222137957},
+		{A: 1.078e-08, B: 4.78329116086, C: 2730.2069586892},
+		{A: 9.38e-09, B: 5.42471506763, C: 1585.1407420207},
+		{A: 9.23e-09, B: 4.44469169065, C: 9676.4810341156},
+		{A: 8.94e-09, B: 0.2694082187, C: 2655.5683724738},
+		{A: 1.131e-08, B: 5.46382510304, C: 224.3447957019},
+		{A: 8.08e-09, B: 0.48295590141, C: 3377.217792004},
+		{A: 8.09e-09, B: 4.14122746067, C: 114.1384744825},
+		{A: 8.64e-09, B: 1.83217006136, C: 4.665866446},
+		{A: 1.106e-08, B: 2.60444312553, C: 209.3669421749},
+		{A: 7.9e-09, B: 0.11493626208, C: 460.5384408198},
+		{A: 7.99e-09, B: 1.6042649759, C: 5223.6939198022},
+		{A: 9.33e-09, B: 0.30976125598, C: 685.4739373527},
+		{A: 1.053e-08, B: 5.23433104008, C: 842.1506814881},
+		{A: 8.46e-09, B: 3.0287839349, C: 5746.271337896},
+		{A: 7.99e-09, B: 2.08457026425, C: 77734.01845962799},
+		{A: 8.2e-09, B: 0.99821486743, C: 373.0142209592},
+		{A: 8.92e-09, B: 5.36446426391, C: 827.9235874865},
+		{A: 8.21e-09, B: 3.53889274951, C: 498.6714764576},
+		{A: 7.41e-09, B: 1.32379374647, C: 530.2122299564},
+		{A: 7.9e-09, B: 2.88034567513, C: 938.1299087059},
+		{A: 8.42e-09, B: 3.39449778904, C: 484.444382456},
+		{A: 7.85e-09, B: 0.57841470897, C: 850.0149880143},
+		{A: 7.59e-09, B: 3.82014112009, C: 6283.0758499914},
+		{A: 9.54e-09, B: 2.94534072982, C: 462.0229135281},
+		{A: 7.67e-09, B: 3.33725133157, C: 99.1606209555},
+		{A: 8.1e-09, B: 4.69425300466, C: 2228.9701815978},
+		{A: 7e-09, B: 1.72050221502, C: 775.233389447},
+		{A: 7.64e-09, B: 4.91747674296, C: 1670.8250285},
+		{A: 7.24e-09, B: 6.08692841992, C: 2281.2304965106},
+		{A: 7.11e-09, B: 4.82250918143, C: 11.7794134468},
+		{A: 6.92e-09, B: 2.63705354662, C: 6.592282139},
+		{A: 7.71e-09, B: 3.87410612014, C: 9690.7081281172},
+		{A: 9.06e-09, B: 2.47189948442, C: 3274.1250177854},
+		{A: 7.81e-09, B: 1.25357484582, C: 202.2533951741},
+		{A: 7.57e-09, B: 3.78079814332, C: 2818.035008606},
+		{A: 7.56e-09, B: 4.28312053897, C: 2803.8079146044},
+		{A: 6.63e-09, B: 5.27704405712, C: 4532.578949411},
+		{A: 7.59e-09, B: 5.4535868657, C: 9683.5945811164},
+		{A: 6.98e-09, B: 5.43712520216, C: 565.1156877467},
+		{A: 7.09e-09, B: 3.71117647887, C: 3686.4961146598},
+		{A: 6.77e-09, B: 4.27891183416, C: 25028.521211385},
+		{A: 6.43e-09, B: 1.40239510103, C: 9161.0171630226},
+		{A: 6.56e-09, B: 0.60909845504, C: 835.7878940127},
+		{A: 6.35e-09, B: 5.75373871128, C: 429.7795846137},
+		{A: 7.02e-09, B: 6.10412979847, C: 4635.6717236296},
+		{A: 6.27e-09, B: 3.03666956129, C: 2840.4132799086},
+		{A: 8.02e-09, B: 4.18688054701, C: 5753.3848848968},
+		{A: 8.38e-09, B: 4.51386507097, C: 1069.6768709277},
+		{A: 6.33e-09, B: 4.37183361444, C: 5.4166259714},
+		{A: 6.52e-09, B: 5.79409889124, C: 1061.829610744},
+		{A: 6.38e-09, B: 2.18896270346, C: 313.2104759189},
+		{A: 8.27e-09, B: 5.94231186039, C: 1457.525933062},
+		{A: 6.78e-09, B: 2.45013730979, C: 5760.4984318976},
+		{A: 8.14e-09, B: 4.8957879117, C: 1567.7322542814},
+		{A: 6.24e-09, B: 0.61631100566, C: 1176.7017984094},
+		{A: 6e-09, B: 3.20918322285, C: 1098.7388061044},
+		{A: 7.17e-09, B: 1.8234906449, C: 3171.0322435668},
+		{A: 6.51e-09, B: 4.14419317491, C: 2847.5268269094},
+		{A: 6.29e-09, B: 1.75272560843, C: 92.0470739547},
+		{A: 6.26e-09, B: 3.53146082217, C: 3067.9394693482},
+		{A: 6.67e-09, B: 4.22974611158, C: 4539.6924964118},
+		{A: 5.65e-09, B: 0.99416346033, C: 1894.4190646765},
+		{A: 7.52e-09, B: 0.4606370015, C: 635.231419868},
+		{A: 6.22e-09, B: 1.98136818407, C: 25565.3257234804},
+		{A: 6.14e-09, B: 2.48275371627, C: 25551.09862947879},
+		{A: 5.6e-09, B: 1.40733893388, C: 446.3113468182},
+		{A: 5.58e-09, B: 4.37217796469, C: 1057.8974574809},
+		{A: 6.28e-09, B: 4.65037810102, C: 6275.9623029906},
+		{A: 6.59e-09, B: 2.41470950463, C: 195.1398481733},
+		{A: 6.16e-09, B: 2.08837621877, C: 10.0343083076},
+		{A: 6.92e-09, B: 3.1322902553, C: 7.6348118626},
+		{A: 6.85e-09, B: 4.18539472904, C: 46.470422916},
+		{A: 6.24e-09, B: 0.02693303471, C: 1493.093668066},
+		{A: 5.94e-09, B: 2.13375704438, C: 121.2520214833},
+		{A: 5.08e-09, B: 2.1358430071, C: 1.6969210294},
+		{A: 6.74e-09, B: 1.47570122611, C: 4694.0029547076},
+		{A: 5.59e-09, B: 4.48852017557, C: 531.1754378029},
+		{A: 6.4e-09, B: 3.10239233469, C: 11.0457002639},
+		{A: 4.96e-09, B: 1.29000001439, C: 927.8349679674},
+		{A: 5.87e-09, B: 3.30651435298, C: 600.5404103988},
+		{A: 5.82e-09, B: 0.4454094886, C: 113.3877149571},
+		{A: 4.92e-09, B: 4.83275232, C: 9492.1463150048},
+		{A: 5.49e-09, B: 4.34579166146, C: 3046.5988283458},
+		{A: 5.76e-09, B: 1.22846846364, C: 1514.2912967165},
+		{A: 5.93e-09, B: 5.86079640612, C: 524.0618908021},
+		{A: 5.1e-09, B: 2.6255703127, C: 529.851023789},
+		{A: 4.89e-09, B: 6.26855707323, C: 3693.6096616606},
+		{A: 4.8e-09, B: 0.30754294369, C: 528.9402055692},
+		{A: 5.82e-09, B: 3.51934668795, C: 1056.9342496344},
+		{A: 4.93e-09, B: 5.52699906925, C: 512.2824773553},
+		{A: 4.81e-09, B: 2.99681040149, C: 9153.9036160218},
+		{A: 5.62e-09, B: 3.73437025868, C: 2015.6710861598},
+		{A: 4.58e-09, B: 3.86646994292, C: 11.3063326948},
+		{A: 4.57e-09, B: 1.80238019931, C: 3281.2385647862},
+		{A: 4.53e-09, B: 6.17995938655, C: 1059.3337460794},
+		{A: 5.51e-09, B: 0.13794958618, C: 1912.5783119412},
+		{A: 4.46e-09, B: 5.53828660924, C: 2332.0629558164},
+		{A: 4.44e-09, B: 5.06219342598, C: 7.8643065262},
+		{A: 4.61e-09, B: 0.16951411708, C: 26087.9031415742},
+		{A: 4.39e-09, B: 4.14986379679, C: 1151.4290041439},
+		{A: 6.14e-09, B: 5.42289673768, C: 2090.3096723752},
+		{A: 4.88e-09, B: 3.71681959056, C: 447.9388318784},
+		{A: 5.92e-09, B: 2.91424148255, C: 8624.2126509272},
+		{A: 4.33e-09, B: 2.55336268329, C: 1064.0477966352},
+		{A: 4.49e-09, B: 5.24955106938, C: 10213.285546211},
+		{A: 5.1e-09, B: 5.81591864532, C: 529.5309064002},
+		{A: 4.35e-09, B: 5.34355963629, C: 560.7104537316},
+		{A: 4.49e-09, B: 0.72330388784, C: 2758.6611466924},
+		{A: 4.3e-09, B: 0.94519103478, C: 6.3627874754},
+		{A: 5.63e-09, B: 6.19175228344, C: 1884.124123938},
+		{A: 4.43e-09, B: 3.39246520261, C: 1152.1797636693},
+		{A: 4.3e-09, B: 1.28652623263, C: 505.3119427064},
+		{A: 4.22e-09, B: 5.12631540623, C: 944.9828232758},
+		{A: 4.64e-09, B: 2.90444584145, C: 398.1440028728},
+		{A: 4.1e-09, B: 1.24248975309, C: 5069.3834615064},
+		{A: 4.11e-09, B: 2.95117124177, C: 4326.3934009738},
+		{A: 4.18e-09, B: 5.15499986314, C: 1173.5204046717},
+		{A: 4.12e-09, B: 2.9812544633, C: 554.0699874828},
+		{A: 4.03e-09, B: 0.34381388674, C: 32.2433289144},
+		{A: 4.02e-09, B: 5.88926765351, C: 1570.9136480191},
+		{A: 5.05e-09, B: 1.49028912471, C: 3782.4753418776},
+		{A: 4.47e-09, B: 0.03952029309, C: 245.5424243524},
+		{A: 4.53e-09, B: 3.09458004153, C: 1059.430114299},
+		{A: 4.11e-09, B: 3.21727542472, C: 1475.6851803267},
+		{A: 4.26e-09, B: 3.12237794195, C: 12566.1516999828},
+		{A: 4.34e-09, B: 3.59362426939, C: 3259.8979237838},
+		{A: 3.98e-09, B: 4.91510709622, C: 4120.2078525366},
+		{A: 3.99e-09, B: 4.67075122011, C: 234.6397364404},
+		{A: 3.86e-09, B: 4.81320787761, C: 970.5162499722},
+		{A: 4.27e-09, B: 3.21176085113, C: 977.4867846211},
+		{A: 4.11e-09, B: 4.31566962034, C: 757.2171545342},
+		{A: 3.92e-09, B: 1.86527946688, C: 885.4397106664},
+		{A: 4.16e-09, B: 3.81408093105, C: 3156.8051495652},
+	},
+	// R2, 190 terms
+	{
+		{A: 0.00079644833, B: 1.35865896596, C: 529.6909650946},
+		{A: 8.251618e-05, B: 5.77773935444, C: 522.5774180938},
+		{A: 7.029864e-05, B: 3.27476965833, C: 536.8045120954},
+		{A: 5.314006e-05, B: 1.83835109712, C: 1059.3819301892},
+		{A: 1.860833e-05, B: 2.97682139367, C: 7.1135470008},
+		{A: 8.36267e-06, B: 4.19889881718, C: 419.4846438752},
+		{A: 9.64466e-06, B: 5.48031822015, C: 515.463871093},
+		{A: 4.06453e-06, B: 3.78250730354, C: 1066.49547719},
+		{A: 4.2657e-06, B: 2.22753101795, C: 639.897286314},
+		{A: 3.77316e-06, B: 2.24248352873, C: 1589.0728952838},
+		{A: 4.9792e-06, B: 3.14159265359, C: 0},
+		{A: 3.39043e-06, B: 6.12690864038, C: 625.6701923124},
+		{A: 3.62943e-06, B: 5.36761847267, C: 206.1855484372},
+		{A: 3.42048e-06, B: 6.09922969324, C: 1052.2683831884},
+		{A: 2.7992e-06, B: 4.26162555827, C: 412.3710968744},
+		{A: 3.32578e-06, B: 0.00328961161, C: 426.598190876},
+		{A: 2.29777e-06, B: 0.70530766213, C: 735.8765135318},
+		{A: 2.00783e-06, B: 3.06850623368, C: 543.9180590962},
+		{A: 1.99807e-06, B: 4.42884165317, C: 103.0927742186},
+		{A: 2.5729e-06, B: 0.96295364983, C: 632.7837393132},
+		{A: 1.38606e-06, B: 2.93235671606, C: 14.2270940016},
+		{A: 1.13535e-06, B: 0.78713911289, C: 728.762966531},
+		{A: 8.6025e-07, B: 5.14434751994, C: 323.5054166574},
+		{A: 9.4565e-07, B: 1.70498041073, C: 838.9692877504},
+		{A: 8.3469e-07, B: 0.05834873484, C: 309.2783226558},
+		{A: 7.5198e-07, B: 1.60495195911, C: 956.2891559706},
+		{A: 7.0451e-07, B: 1.50988357484, C: 213.299095438},
+		{A: 8.0328e-07, B: 2.98122361797, C: 742.9900605326},
+		{A: 5.6203e-07, B: 0.95534810533, C: 1162.4747044078},
+		{A: 6.1649e-07, B: 6.10137889854, C: 1045.1548361876},
+		{A: 6.6572e-07, B: 5.47307178077, C: 199.0720014364},
+		{A: 5.0057e-07, B: 2.72063162317, C: 532.8723588323},
+		{A: 5.1904e-07, B: 5.58435625607, C: 942.062061969},
+		{A: 3.9833e-07, B: 5.94566506227, C: 95.9792272178},
+		{A: 4.4548e-07, B: 5.52445621411, C: 508.3503240922},
+		{A: 4.4282e-07, B: 0.27118152557, C: 526.5095713569},
+		{A: 2.9944e-07, B: 0.93641735919, C: 1155.361157407},
+		{A: 2.8412e-07, B: 2.87835720211, C: 525.7588118315},
+		{A: 2.633e-07, B: 4.26891877269, C: 1596.1864422846},
+		{A: 2.7039e-07, B: 2.80607741398, C: 1169.5882514086},
+		{A: 2.7477e-07, B: 2.64841266238, C: 2118.7638603784},
+		{A: 2.2705e-07, B: 0.17830004133, C: 302.164775655},
+		{A: 2.9347e-07, B: 1.7858969235, C: 831.8557407496},
+		{A: 1.9991e-07, B: 0.04328951895, C: 949.1756089698},
+		{A: 1.9906e-07, B: 1.16072627347, C: 533.6231183577},
+		{A: 2.1714e-07, B: 1.88820231818, C: 1272.6810256272},
+		{A: 1.7581e-07, B: 4.14974757919, C: 846.0828347512},
+		{A: 1.7085e-07, B: 5.89188996975, C: 1258.4539316256},
+		{A: 2.1407e-07, B: 4.35468497204, C: 316.3918696566},
+		{A: 2.1295e-07, B: 0.54429472455, C: 1265.5674786264},
+		{A: 1.9859e-07, B: 0.064538258, C: 1581.959348283},
+		{A: 1.7025e-07, B: 0.53383755278, C: 1368.660252845},
+		{A: 1.2804e-07, B: 3.90044242142, C: 433.7117378768},
+		{A: 1.3072e-07, B: 0.79468040717, C: 110.2063212194},
+		{A: 1.1945e-07, B: 0.40671403646, C: 1361.5467058442},
+		{A: 1.1695e-07, B: 4.44394618065, C: 405.2575498736},
+		{A: 1.1979e-07, B: 2.22872778682, C: 220.4126424388},
+		{A: 9.633e-08, B: 6.01002272123, C: 853.196381752},
+		{A: 1.0163e-07, B: 0.99504635158, C: 1471.7530270636},
+		{A: 8.977e-08, B: 1.60328709409, C: 1692.1656695024},
+		{A: 8.701e-08, B: 3.52167876799, C: 1073.6090241908},
+		{A: 8.314e-08, B: 5.60169732564, C: 1574.8458012822},
+		{A: 8.958e-08, B: 6.26708748901, C: 519.3960243561},
+		{A: 7.828e-08, B: 0.65241611799, C: 1478.8665740644},
+		{A: 7.833e-08, B: 0.17920601344, C: 1685.0521225016},
+		{A: 7.451e-08, B: 0.88421084942, C: 88.865680217},
+		{A: 7.32e-08, B: 0.89341249264, C: 721.6494195302},
+		{A: 9.135e-08, B: 1.51210840939, C: 1148.2476104062},
+		{A: 6.11e-08, B: 2.50080005128, C: 3.1813937377},
+		{A: 7.037e-08, B: 4.44127496638, C: 330.6189636582},
+		{A: 5.163e-08, B: 2.79219166952, C: 21.3406410024},
+		{A: 5.079e-08, B: 2.97991736844, C: 1375.7737998458},
+		{A: 4.93e-08, B: 0.04683167622, C: 1677.9385755008},
+		{A: 4.664e-08, B: 2.28007273876, C: 1485.9801210652},
+		{A: 4.692e-08, B: 0.86220230505, C: 3.9321532631},
+		{A: 5.307e-08, B: 0.85008578245, C: 1788.1448967202},
+		{A: 4.239e-08, B: 0.40758287124, C: 629.6023455755},
+		{A: 4.23e-08, B: 1.61046658091, C: 635.9651330509},
+		{A: 3.627e-08, B: 2.71151441113, C: 551.031606097},
+		{A: 3.314e-08, B: 0.55067236587, C: 1795.258443721},
+		{A: 4.409e-08, B: 1.2812775105, C: 1464.6394800628},
+		{A: 3.27e-08, B: 1.18744032691, C: 1905.4647649404},
+		{A: 3.226e-08, B: 6.18716071251, C: 1038.0412891868},
+		{A: 3.103e-08, B: 6.22971614425, C: 2001.4439921582},
+		{A: 3.41e-08, B: 2.44624067925, C: 539.9859058331},
+		{A: 3.174e-08, B: 5.54870592599, C: 191.9584544356},
+		{A: 2.59e-08, B: 3.24430559059, C: 1062.5633239269},
+		{A: 2.614e-08, B: 0.55149554149, C: 2104.5367663768},
+		{A: 2.174e-08, B: 5.32613824409, C: 1891.2376709388},
+		{A: 2.659e-08, B: 4.8245997422, C: 416.3032501375},
+		{A: 2.187e-08, B: 1.71707514653, C: 628.8515860501},
+		{A: 2.263e-08, B: 6.19233486371, C: 1994.3304451574},
+		{A: 2.328e-08, B: 4.28236795066, C: 963.4027029714},
+		{A: 2.579e-08, B: 0.03256542251, C: 1898.3512179396},
+		{A: 2.077e-08, B: 3.32602157426, C: 1699.2792165032},
+		{A: 2.529e-08, B: 2.39697505835, C: 227.5261894396},
+		{A: 2.468e-08, B: 0.06551346218, C: 750.1036075334},
+		{A: 1.989e-08, B: 0.29206371261, C: 636.7158925763},
+		{A: 1.927e-08, B: 0.32286661566, C: 295.0512286542},
+		{A: 1.904e-08, B: 3.43534792123, C: 647.0108333148},
+		{A: 1.94e-08, B: 0.29170673525, C: 2111.6503133776},
+		{A: 1.88e-08, B: 3.14403615586, C: 611.4430983108},
+		{A: 2.324e-08, B: 1.94960720763, C: 824.7421937488},
+		{A: 1.854e-08, B: 4.71794950485, C: 2125.8774073792},
+		{A: 2.547e-08, B: 1.2390835309, C: 2221.856634597},
+		{A: 1.814e-08, B: 1.60250861074, C: 2008.557539159},
+		{A: 1.611e-08, B: 5.83466560322, C: 422.6660376129},
+		{A: 1.667e-08, B: 2.32455940876, C: 440.8252848776},
+		{A: 1.622e-08, B: 0.36650974375, C: 1056.2005364515},
+		{A: 1.624e-08, B: 2.42139677881, C: 10.2949407385},
+		{A: 1.622e-08, B: 3.51892791175, C: 1055.4497769261},
+		{A: 1.606e-08, B: 5.76205763975, C: 117.3198682202},
+		{A: 1.646e-08, B: 5.88662636573, C: 2317.8358618148},
+		{A: 2.026e-08, B: 4.61781314145, C: 423.4167971383},
+		{A: 2.098e-08, B: 1.04559231028, C: 1781.0313497194},
+		{A: 1.868e-08, B: 1.12487729469, C: 618.5566453116},
+		{A: 1.885e-08, B: 2.78775930564, C: 1802.3719907218},
+		{A: 1.445e-08, B: 0.08308050305, C: 1382.8873468466},
+		{A: 1.797e-08, B: 3.00776822706, C: 2648.454825473},
+		{A: 1.422e-08, B: 0.17649746278, C: 2420.9286360334},
+		{A: 1.129e-08, B: 1.5903029132, C: 380.12776796},
+		{A: 1.126e-08, B: 4.199896736, C: 547.8502123593},
+		{A: 1.186e-08, B: 5.98943062173, C: 2310.722314814},
+		{A: 1.108e-08, B: 4.22655117757, C: 934.9485149682},
+		{A: 1.259e-08, B: 1.19687222266, C: 1063.3140834523},
+		{A: 1.072e-08, B: 3.86169004168, C: 1603.2999892854},
+		{A: 9.46e-09, B: 5.59968097387, C: 99.9113804809},
+		{A: 9.37e-09, B: 1.0308327676, C: 81.7521332162},
+		{A: 9.38e-09, B: 6.18136092771, C: 945.9942152321},
+		{A: 9.08e-09, B: 2.54355964041, C: 6283.0758499914},
+		{A: 8.74e-09, B: 5.21903196047, C: 2207.6295405954},
+		{A: 8.74e-09, B: 6.01240284465, C: 511.5317178299},
+		{A: 1.188e-08, B: 0.75698357968, C: 2097.423219376},
+		{A: 7.89e-09, B: 3.91035208173, C: 10213.285546211},
+		{A: 1e-08, B: 1.34667100304, C: 732.6951197941},
+		{A: 9.52e-09, B: 1.5535577742, C: 2324.9494088156},
+		{A: 8.11e-09, B: 5.00475553271, C: 319.5732633943},
+		{A: 7.63e-09, B: 3.9852755963, C: 337.732510659},
+		{A: 8.8e-09, B: 1.14789972199, C: 952.3570027075},
+		{A: 7.8e-09, B: 4.6946331693, C: 5746.271337896},
+		{A: 9.1e-09, B: 0.08774541571, C: 2737.32050569},
+		{A: 7.73e-09, B: 0.77131695762, C: 5760.4984318976},
+		{A: 7.64e-09, B: 6.11686539353, C: 9676.4810341156},
+		{A: 7.58e-09, B: 2.1935071986, C: 9690.7081281172},
+		{A: 6.71e-09, B: 1.19532387143, C: 124.433415221},
+		{A: 6.61e-09, B: 5.99578306627, C: 501.2367770914},
+		{A: 7.29e-09, B: 0.65312263578, C: 2538.2485042536},
+		{A: 8.25e-09, B: 2.70770030205, C: 3370.1042450032},
+		{A: 6.7e-09, B: 5.44169923277, C: 107.0249274817},
+		{A: 7.39e-09, B: 1.14609907817, C: 2641.3412784722},
+		{A: 8.66e-09, B: 3.02831268213, C: 3046.5988283458},
+		{A: 7.18e-09, B: 4.83684196454, C: 860.3099287528},
+		{A: 8.13e-09, B: 6.01229270247, C: 2214.7430875962},
+		{A: 7.46e-09, B: 1.12371143332, C: 739.8086667949},
+		{A: 7.41e-09, B: 5.9317166201, C: 2634.2277314714},
+		{A: 6.67e-09, B: 0.89885058003, C: 106.2741679563},
+		{A: 5.73e-09, B: 2.42701822581, C: 739.0579072695},
+		{A: 7.34e-09, B: 0.72837704619, C: 1354.4331588434},
+		{A: 6.62e-09, B: 2.2176897639, C: 2015.6710861598},
+		{A: 7.82e-09, B: 2.52401202862, C: 3679.382567659},
+		{A: 7.79e-09, B: 2.38608991574, C: 3267.0114707846},
+		{A: 5.53e-09, B: 1.85211127676, C: 453.424893819},
+		{A: 7.01e-09, B: 4.23431087374, C: 9683.5945811164},
+		{A: 5.71e-09, B: 2.98435419019, C: 1262.3860848887},
+		{A: 6.21e-09, B: 1.2446288744, C: 3803.81598288},
+		{A: 5.63e-09, B: 5.99845316446, C: 1049.0869894507},
+		{A: 5.38e-09, B: 4.92334194042, C: 447.7958195265},
+		{A: 5.34e-09, B: 0.99911551571, C: 462.0229135281},
+		{A: 5.41e-09, B: 6.19275150397, C: 1987.2168981566},
+		{A: 5.11e-09, B: 3.2855327837, C: 4.665866446},
+		{A: 5.39e-09, B: 5.33214565622, C: 2751.5475996916},
+		{A: 6.51e-09, B: 5.12199308959, C: 3156.8051495652},
+		{A: 4.83e-09, B: 3.03782387056, C: 3281.2385647862},
+		{A: 4.76e-09, B: 2.17592053936, C: 149.5631971346},
+		{A: 5.1e-09, B: 5.35664230912, C: 9.5612275556},
+		{A: 4.9e-09, B: 1.57324553106, C: 1251.3403846248},
+		{A: 4.67e-09, B: 5.9234342384, C: 203.0041546995},
+		{A: 5.28e-09, B: 5.81786945766, C: 2627.1141844706},
+		{A: 4.47e-09, B: 3.51498961805, C: 18.1592472647},
+		{A: 4.29e-09, B: 0.16627197188, C: 74.7815985673},
+		{A: 4.97e-09, B: 0.30985248432, C: 2428.0421830342},
+		{A: 5.16e-09, B: 3.89424540015, C: 2516.9078632512},
+		{A: 5.19e-09, B: 2.43126348834, C: 3686.4961146598},
+		{A: 4.04e-09, B: 2.77840802846, C: 7.1617311106},
+		{A: 5.33e-09, B: 4.77083438961, C: 3473.1970192218},
+		{A: 5.15e-09, B: 3.54549816613, C: 3178.1457905676},
+		{A: 5.33e-09, B: 5.61415688189, C: 2524.021410252},
+		{A: 4.58e-09, B: 4.91616403047, C: 3067.9394693482},
+		{A: 4e-09, B: 3.13887720912, C: 540.7366653585},
+		{A: 3.78e-09, B: 0.8612245094, C: 525.0250986486},
+	},
+	// R3, 98 terms
+	{
+		{A: 3.519257e-05, B: 6.05800633846, C: 529.6909650946},
+		{A: 1.073239e-05, B: 1.6732134576, C: 536.8045120954},
+		{A: 9.15666e-06, B: 1.41329676116, C: 522.5774180938},
+		{A: 3.41593e-06, B: 0.52296542656, C: 1059.3819301892},
+		{A: 2.54893e-06, B: 1.19625473533, C: 7.1135470008},
+		{A: 2.21512e-06, B: 0.95225226237, C: 515.463871093},
+		{A: 6.9078e-07, B: 2.26885282314, C: 1066.49547719},
+		{A: 8.9729e-07, B: 3.14159265359, C: 0},
+		{A: 5.7827e-07, B: 1.41389745339, C: 543.9180590962},
+		{A: 5.7653e-07, B: 0.52580117593, C: 639.897286314},
+		{A: 5.1079e-07, B: 5.98016364677, C: 412.3710968744},
+		{A: 4.6935e-07, B: 1.57864237959, C: 625.6701923124},
+		{A: 4.2824e-07, B: 6.11689609099, C: 419.4846438752},
+		{A: 3.7477e-07, B: 1.1826276233, C: 14.2270940016},
+		{A: 3.3816e-07, B: 1.66671706951, C: 1052.2683831884},
+		{A: 3.1195e-07, B: 1.04290245896, C: 1589.0728952838},
+		{A: 3.0023e-07, B: 4.63236245032, C: 426.598190876},
+		{A: 3.3531e-07, B: 0.84784977903, C: 206.1855484372},
+		{A: 2.0804e-07, B: 2.50071243814, C: 728.762966531},
+		{A: 1.4466e-07, B: 0.96040197071, C: 508.3503240922},
+		{A: 1.2969e-07, B: 1.5023378855, C: 1045.1548361876},
+		{A: 1.1654e-07, B: 3.55513510121, C: 323.5054166574},
+		{A: 1.2319e-07, B: 2.60952614503, C: 735.8765135318},
+		{A: 1.5023e-07, B: 0.89136998434, C: 199.0720014364},
+		{A: 1.116e-07, B: 1.79041437555, C: 309.2783226558},
+		{A: 1.0554e-07, B: 6.27845112678, C: 956.2891559706},
+		{A: 9.812e-08, B: 6.26016859519, C: 103.0927742186},
+		{A: 9.301e-08, B: 3.45126812476, C: 838.9692877504},
+		{A: 6.672e-08, B: 1.87004905364, C: 302.164775655},
+		{A: 7.442e-08, B: 1.28047007623, C: 742.9900605326},
+		{A: 7.178e-08, B: 0.92022189637, C: 942.062061969},
+		{A: 5.577e-08, B: 1.37980792905, C: 95.9792272178},
+		{A: 6.834e-08, B: 3.45228722967, C: 831.8557407496},
+		{A: 4.632e-08, B: 2.82934545414, C: 1596.1864422846},
+		{A: 3.969e-08, B: 1.21290005054, C: 1169.5882514086},
+		{A: 3.869e-08, B: 5.99495313698, C: 213.299095438},
+		{A: 3.551e-08, B: 6.10714791535, C: 405.2575498736},
+		{A: 2.943e-08, B: 2.32831075458, C: 1155.361157407},
+		{A: 2.442e-08, B: 1.86965213405, C: 532.8723588323},
+		{A: 2.41e-08, B: 0.42627205128, C: 220.4126424388},
+		{A: 2.289e-08, B: 1.94941487274, C: 1073.6090241908},
+		{A: 2.274e-08, B: 0.09211517505, C: 632.7837393132},
+		{A: 2.189e-08, B: 1.58907745204, C: 2118.7638603784},
+		{A: 2.387e-08, B: 5.97080671477, C: 1162.4747044078},
+		{A: 2.104e-08, B: 1.06751462671, C: 21.3406410024},
+		{A: 2.128e-08, B: 1.51119399925, C: 1258.4539316256},
+		{A: 2.491e-08, B: 0.35125020737, C: 1272.6810256272},
+		{A: 2.006e-08, B: 5.9448738836, C: 110.2063212194},
+		{A: 1.98e-08, B: 2.54989377864, C: 88.865680217},
+		{A: 2.04e-08, B: 2.16463966964, C: 433.7117378768},
+		{A: 1.955e-08, B: 2.70341589777, C: 721.6494195302},
+		{A: 1.67e-08, B: 4.46255717328, C: 853.196381752},
+		{A: 1.91e-08, B: 2.25964760758, C: 1361.5467058442},
+		{A: 1.71e-08, B: 1.98372066321, C: 525.7588118315},
+		{A: 1.52e-08, B: 0.11641358425, C: 949.1756089698},
+		{A: 2.003e-08, B: 3.16520599208, C: 1148.2476104062},
+		{A: 1.71e-08, B: 2.70850417287, C: 330.6189636582},
+		{A: 1.629e-08, B: 0.47376028854, C: 526.5095713569},
+		{A: 1.229e-08, B: 3.01987279595, C: 963.4027029714},
+		{A: 1.671e-08, B: 0.44352103086, C: 533.6231183577},
+		{A: 1.207e-08, B: 1.15774089269, C: 1574.8458012822},
+		{A: 1.146e-08, B: 2.54505851138, C: 846.0828347512},
+		{A: 1.355e-08, B: 1.17462112647, C: 1038.0412891868},
+		{A: 1.001e-08, B: 2.70272799283, C: 519.3960243561},
+		{A: 1.372e-08, B: 0.67467128629, C: 551.031606097},
+		{A: 9.83e-09, B: 4.17198081351, C: 2627.1141844706},
+		{A: 1.084e-08, B: 1.07011164067, C: 227.5261894396},
+		{A: 8.92e-09, B: 2.92543286761, C: 1368.660252845},
+		{A: 8.23e-09, B: 4.86559196955, C: 611.4430983108},
+		{A: 1.136e-08, B: 1.78981738432, C: 1581.959348283},
+		{A: 8.97e-09, B: 4.9107363027, C: 1670.8250285},
+		{A: 9.08e-09, B: 3.6880404733, C: 824.7421937488},
+		{A: 7.89e-09, B: 3.2338089325, C: 2125.8774073792},
+		{A: 7.71e-09, B: 2.39070707004, C: 2317.8358618148},
+		{A: 8.91e-09, B: 0.59692950778, C: 539.9859058331},
+		{A: 8.76e-09, B: 4.52127091462, C: 750.1036075334},
+		{A: 8.02e-09, B: 0.20759322884, C: 1141.1340634054},
+		{A: 8.5e-09, B: 0.94145487094, C: 191.9584544356},
+		{A: 7.62e-09, B: 2.25149516048, C: 2538.2485042536},
+		{A: 6.94e-09, B: 0.67080348659, C: 440.8252848776},
+		{A: 7.41e-09, B: 5.79934203525, C: 1485.9801210652},
+		{A: 6.43e-09, B: 2.48127580335, C: 1265.5674786264},
+		{A: 5.75e-09, B: 6.13756590872, C: 1279.794572628},
+		{A: 6.36e-09, B: 5.51001645505, C: 2413.8150890326},
+		{A: 6.36e-09, B: 4.40777238491, C: 1382.8873468466},
+		{A: 5.55e-09, B: 2.18233983981, C: 1062.5633239269},
+		{A: 5.64e-09, B: 1.92775967119, C: 2634.2277314714},
+		{A: 5.31e-09, B: 2.04824376019, C: 295.0512286542},
+		{A: 5.41e-09, B: 2.32424368689, C: 1471.7530270636},
+		{A: 6.97e-09, B: 2.27179476322, C: 1699.2792165032},
+		{A: 5.46e-09, B: 1.9577490573, C: 1677.9385755008},
+		{A: 4.65e-09, B: 4.35550844067, C: 1692.1656695024},
+		{A: 5.08e-09, B: 2.50298248836, C: 2207.6295405954},
+		{A: 4.96e-09, B: 5.77087043616, C: 1478.8665740644},
+		{A: 4.4e-09, B: 5.98661963879, C: 934.9485149682},
+		{A: 4.24e-09, B: 2.80194129521, C: 81.7521332162},
+		{A: 4.06e-09, B: 3.93940190897, C: 316.3918696566},
+		{A: 5.06e-09, B: 0.18719982992, C: 10.2949407385},
+	},
+	// R4, 46 terms
+	{
+		{A: 1.28628e-06, B: 0.08419309557, C: 536.8045120954},
+		{A: 1.13458e-06, B: 4.24858855779, C: 529.6909650946},
+		{A: 8.265e-07, B: 3.29754909408, C: 522.5774180938},
+		{A: 3.7883e-07, B: 2.73326611144, C: 515.463871093},
+		{A: 2.6694e-07, B: 5.69142588558, C: 7.1135470008},
+		{A: 1.765e-07, B: 5.40012536918, C: 1059.3819301892},
+		{A: 1.2612e-07, B: 6.01560416057, C: 543.9180590962},
+		{A: 9.287e-08, B: 0.76813946494, C: 1066.49547719},
+		{A: 8.107e-08, B: 5.68228065707, C: 14.2270940016},
+		{A: 6.271e-08, B: 5.12286932534, C: 639.897286314},
+		{A: 6.978e-08, B: 1.42751292055, C: 412.3710968744},
+		{A: 5.377e-08, B: 3.33501947275, C: 625.6701923124},
+		{A: 2.911e-08, B: 3.40334805052, C: 1052.2683831884},
+		{A: 2.593e-08, B: 4.16090412984, C: 728.762966531},
+		{A: 2.562e-08, B: 2.89802035072, C: 426.598190876},
+		{A: 2.268e-08, B: 6.22195938856, C: 1589.0728952838},
+		{A: 2.114e-08, B: 3.11758855774, C: 1045.1548361876},
+		{A: 1.673e-08, B: 2.81399290364, C: 206.1855484372},
+		{A: 1.805e-08, B: 2.60030006919, C: 199.0720014364},
+		{A: 1.823e-08, B: 1.89432426038, C: 419.4846438752},
+		{A: 1.522e-08, B: 1.33432648232, C: 1596.1864422846},
+		{A: 1.697e-08, B: 0, C: 0},
+		{A: 1.039e-08, B: 4.41904942302, C: 956.2891559706},
+		{A: 1.161e-08, B: 5.16181311538, C: 831.8557407496},
+		{A: 9.16e-09, B: 3.17245716108, C: 508.3503240922},
+		{A: 8.7e-09, B: 5.793878135, C: 1169.5882514086},
+		{A: 9.16e-09, B: 1.87129662931, C: 1148.2476104062},
+		{A: 9.55e-09, B: 0.66801367802, C: 1361.5467058442},
+		{A: 7.88e-09, B: 1.47515450553, C: 1272.6810256272},
+		{A: 9.66e-09, B: 5.47457968043, C: 220.4126424388},
+		{A: 7.88e-09, B: 2.42252866885, C: 117.3198682202},
+		{A: 7.12e-09, B: 0.4965589703, C: 1073.6090241908},
+		{A: 6.56e-09, B: 3.53022740783, C: 302.164775655},
+		{A: 6.81e-09, B: 2.8450717434, C: 191.9584544356},
+		{A: 7.71e-09, B: 2.19893222018, C: 942.062061969},
+		{A: 7.65e-09, B: 5.311472577, C: 551.031606097},
+		{A: 6.67e-09, B: 3.72432305249, C: 88.865680217},
+		{A: 5.34e-09, B: 1.83172084748, C: 647.0108333148},
+		{A: 5.53e-09, B: 0.85896003802, C: 330.6189636582},
+		{A: 5.43e-09, B: 5.26057584439, C: 21.3406410024},
+		{A: 5.84e-09, B: 3.82243061802, C: 618.5566453116},
+		{A: 5.12e-09, B: 4.44485521707, C: 110.2063212194},
+		{A: 6.12e-09, B: 1.59320941864, C: 3.1813937377},
+		{A: 6.31e-09, B: 1.83863158533, C: 10.2949407385},
+		{A: 4.91e-09, B: 1.52912023181, C: 405.2575498736},
+		{A: 5.21e-09, B: 0.24011424451, C: 433.7117378768},
+	},
+	// R5, 9 terms
+	{
+		{A: 1.1188e-07, B: 4.75249399945, C: 536.8045120954},
+		{A: 4.255e-08, B: 5.9151622917, C: 522.5774180938},
+		{A: 2.079e-08, B: 5.56781555864, C: 515.463871093},
+		{A: 1.908e-08, B: 4.29659647286, C: 543.9180590962},
+		{A: 1.875e-08, B: 3.69357495838, C: 7.1135470008},
+		{A: 1.59e-08, B: 5.49312796166, C: 1066.49547719},
+		{A: 1.612e-08, B: 4.13222808529, C: 1059.3819301892},
+		{A: 1.24e-08, B: 3.77981722506, C: 14.2270940016},
+		{A: 1.033e-08, B: 4.50671820436, C: 529.6909650946},
+	},
+}
+
+// GetJupiterR0 evaluates the t^0 series of R.
+func GetJupiterR0(t float64) float64 {
+	return jupiterR[0].Evaluate(t)
+}
+
+// GetJupiterR1 evaluates the t^1 series of R.
+func GetJupiterR1(t float64) float64 {
+	return jupiterR[1].Evaluate(t)
+}
+
+// GetJupiterR2 evaluates the t^2 series of R.
+func GetJupiterR2(t float64) float64 {
+	return jupiterR[2].Evaluate(t)
+}
+
+// GetJupiterR3 evaluates the t^3 series of R.
+func GetJupiterR3(t float64) float64 {
+	return jupiterR[3].Evaluate(t)
+}
+
+// GetJupiterR4 evaluates the t^4 series of R.
+func GetJupiterR4(t float64) float64 {
+	return jupiterR[4].Evaluate(t)
+}
+
+// GetJupiterR5 evaluates the t^5 series of R.
+func GetJupiterR5(t float64) float64 {
+	return jupiterR[5].Evaluate(t)
+}
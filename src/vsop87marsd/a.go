@@ -0,0 +1,63 @@
+package vsop87marsd
+
+import (
+    "calendarutil"
+    "mathutil"
+)
+
+/**
+ * 按儒略日计算火星的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 火星的日心黄经，单位是弧度(rad)
+ */
+func GetSunEclipticLongitudeForMars(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    L0 := GetMarsL0(t)
+    L1 := GetMarsL1(t)
+    L2 := GetMarsL2(t)
+    L3 := GetMarsL3(t)
+    L4 := GetMarsL4(t)
+    L5 := GetMarsL5(t)
+    L := ((((L5 * t + L4) * t + L3) * t + L2) * t + L1) * t + L0
+    return mathutil.Mod2Pi(L)
+}
+
+/**
+ * 按儒略日计算火星的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 火星的日心黄纬，单位是弧度(rad)
+ */
+func GetSunEclipticLatitudeForMars(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    B0 := GetMarsB0(t)
+    B1 := GetMarsB1(t)
+    B2 := GetMarsB2(t)
+    B3 := GetMarsB3(t)
+    B4 := GetMarsB4(t)
+    B5 := GetMarsB5(t)
+    B := ((((B5 * t + B4) * t + B3) * t + B2) * t + B1) * t + B0
+    return B
+}
+
+/**
+ * 按照儒略日计算火星和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 火星和太阳的距离，单位是天文单位(au)
+ */
+func GetSunRadiusForMars(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    R0 := GetMarsR0(t)
+    R1 := GetMarsR1(t)
+    R2 := GetMarsR2(t)
+    R3 := GetMarsR3(t)
+    R4 := GetMarsR4(t)
+    R5 := GetMarsR5(t)
+    R := ((((R5 * t + R4) * t + R3) * t + R2) * t + R1) * t + R0
+    return R
+}
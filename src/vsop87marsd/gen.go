@@ -0,0 +1,3 @@
+package vsop87marsd
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Mars -package vsop87marsd
@@ -0,0 +1,2452 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// MARS L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87marsd
+
+import "vsop87"
+
+var marsL = []vsop87.Series{
+	// L0, 1217 terms
+	{
+		{A: 6.20347711583, B: 0, C: 0},
+		{A: 0.186563681, B: 5.05037100303, C: 3340.6124266998},
+		{A: 0.01108216792, B: 5.40099836958, C: 6681.2248533996},
+		{A: 0.00091798394, B: 5.75478745111, C: 10021.8372800994},
+		{A: 0.00027744987, B: 5.97049512942, C: 3.523118349},
+		{A: 0.0001061023, B: 2.93958524973, C: 2281.2304965106},
+		{A: 0.00012315897, B: 0.84956081238, C: 2810.9214616052},
+		{A: 8.926772e-05, B: 4.15697845939, C: 0.0172536522},
+		{A: 8.715688e-05, B: 6.11005159792, C: 13362.4497067992},
+		{A: 6.797552e-05, B: 0.36462243626, C: 398.1490034082},
+		{A: 7.774867e-05, B: 3.33968655074, C: 5621.8429232104},
+		{A: 3.575079e-05, B: 1.66186540141, C: 2544.3144198834},
+		{A: 4.161101e-05, B: 0.2281497533, C: 2942.4634232916},
+		{A: 3.07525e-05, B: 0.85696597082, C: 191.4482661116},
+		{A: 2.628122e-05, B: 0.6480614357, C: 3337.0893083508},
+		{A: 2.937543e-05, B: 6.07893711408, C: 0.0673103028},
+		{A: 2.38942e-05, B: 5.03896401349, C: 796.2980068164},
+		{A: 2.579842e-05, B: 0.02996706197, C: 3344.1355450488},
+		{A: 1.52814e-05, B: 1.14979306228, C: 6151.533888305},
+		{A: 1.798808e-05, B: 0.65634026844, C: 529.6909650946},
+		{A: 1.264356e-05, B: 3.62275092231, C: 5092.1519581158},
+		{A: 1.286232e-05, B: 3.06795924626, C: 2146.1654164752},
+		{A: 1.546408e-05, B: 2.91579633392, C: 1751.539531416},
+		{A: 1.024907e-05, B: 3.69334293555, C: 8962.4553499102},
+		{A: 8.91567e-06, B: 0.1829389909, C: 16703.062133499},
+		{A: 8.5876e-06, B: 2.40093704204, C: 2914.0142358238},
+		{A: 8.32718e-06, B: 2.46418591282, C: 3340.5951730476},
+		{A: 8.32724e-06, B: 4.49495753458, C: 3340.629680352},
+		{A: 7.12899e-06, B: 3.66336014788, C: 1059.3819301892},
+		{A: 7.48724e-06, B: 3.82248399468, C: 155.4203994342},
+		{A: 7.23863e-06, B: 0.67497565801, C: 3738.761430108},
+		{A: 6.35557e-06, B: 2.92182704275, C: 8432.7643848156},
+		{A: 6.55163e-06, B: 0.48864075176, C: 3127.3133312618},
+		{A: 5.50472e-06, B: 3.81001205408, C: 0.9803210682},
+		{A: 5.52746e-06, B: 4.47478863016, C: 1748.016413067},
+		{A: 4.25972e-06, B: 0.55365138172, C: 6283.0758499914},
+		{A: 4.15132e-06, B: 0.49662314774, C: 213.299095438},
+		{A: 4.72164e-06, B: 3.6254781941, C: 1194.4470102246},
+		{A: 3.06552e-06, B: 0.38052862973, C: 6684.7479717486},
+		{A: 3.12141e-06, B: 0.99853322843, C: 6677.7017350506},
+		{A: 2.93199e-06, B: 4.22131277914, C: 20.7753954924},
+		{A: 3.02377e-06, B: 4.48618150321, C: 3532.0606928114},
+		{A: 2.74028e-06, B: 0.54222141841, C: 3340.545116397},
+		{A: 2.81073e-06, B: 5.88163372945, C: 1349.8674096588},
+		{A: 2.31185e-06, B: 1.28240685294, C: 3870.3033917944},
+		{A: 2.836e-06, B: 5.76885494123, C: 3149.1641605882},
+		{A: 2.36114e-06, B: 5.75504515576, C: 3333.498879699},
+		{A: 2.74035e-06, B: 0.13372501211, C: 3340.6797370026},
+		{A: 2.99396e-06, B: 2.78323705697, C: 6254.6266625236},
+		{A: 2.04161e-06, B: 2.82133266185, C: 1221.8485663214},
+		{A: 2.38857e-06, B: 5.37155471672, C: 4136.9104335162},
+		{A: 1.88639e-06, B: 1.49103016486, C: 9492.1463150048},
+		{A: 2.21225e-06, B: 3.50466672203, C: 382.8965322232},
+		{A: 1.79196e-06, B: 1.00561112574, C: 951.7184062506},
+		{A: 1.7211e-06, B: 0.43943041719, C: 5486.777843175},
+		{A: 1.93126e-06, B: 3.35715137745, C: 3.5904286518},
+		{A: 1.44305e-06, B: 1.41874193418, C: 135.0650800354},
+		{A: 1.60011e-06, B: 3.94854735192, C: 4562.4609930212},
+		{A: 1.74068e-06, B: 2.41360332576, C: 553.5694028424},
+		{A: 1.30993e-06, B: 4.04491720264, C: 12303.06777661},
+		{A: 1.38245e-06, B: 4.30145176915, C: 7.1135470008},
+		{A: 1.28062e-06, B: 1.80665643332, C: 5088.6288397668},
+		{A: 1.39897e-06, B: 3.32592516164, C: 2700.7151403858},
+		{A: 1.28102e-06, B: 2.20806651008, C: 1592.5960136328},
+		{A: 1.16945e-06, B: 3.12805282207, C: 7903.073419721},
+		{A: 1.10375e-06, B: 1.05195079687, C: 242.728603974},
+		{A: 1.13486e-06, B: 3.70070798123, C: 1589.0728952838},
+		{A: 1.0009e-06, B: 3.24343740861, C: 11773.3768115154},
+		{A: 9.5592e-07, B: 0.53954181149, C: 20043.6745601988},
+		{A: 9.8947e-07, B: 4.8455829474, C: 6681.2421070518},
+		{A: 1.04541e-06, B: 0.78535382076, C: 8827.3902698748},
+		{A: 8.4187e-07, B: 3.9897072073, C: 4399.994356889},
+		{A: 8.6931e-07, B: 2.20186740523, C: 11243.6858464208},
+		{A: 7.1437e-07, B: 2.80307550016, C: 3185.1920272656},
+		{A: 7.2091e-07, B: 5.84672102525, C: 5884.9268465832},
+		{A: 7.3476e-07, B: 2.18428012567, C: 8429.2412664666},
+		{A: 9.8946e-07, B: 2.81481140371, C: 6681.2075997474},
+		{A: 6.8414e-07, B: 2.73834914412, C: 2288.3440435114},
+		{A: 8.6751e-07, B: 1.02092221563, C: 7079.3738568078},
+		{A: 6.532e-07, B: 2.68118597578, C: 28.4491874678},
+		{A: 8.3749e-07, B: 3.2025613099, C: 4690.4798363586},
+		{A: 7.5034e-07, B: 0.76643418252, C: 6467.9257579616},
+		{A: 6.8984e-07, B: 3.76399731788, C: 6041.3275670856},
+		{A: 6.6706e-07, B: 0.73630620766, C: 3723.508958923},
+		{A: 6.3314e-07, B: 4.5277147047, C: 426.598190876},
+		{A: 6.1683e-07, B: 6.16831509419, C: 2274.1169495098},
+		{A: 5.2256e-07, B: 0.89941531307, C: 9623.6882766912},
+		{A: 5.5488e-07, B: 4.6062546702, C: 4292.3308329504},
+		{A: 5.1332e-07, B: 4.14823636534, C: 3341.592747768},
+		{A: 5.6629e-07, B: 5.06250410206, C: 15.252471185},
+		{A: 6.3376e-07, B: 0.91296240798, C: 3553.9115221378},
+		{A: 4.5829e-07, B: 0.78784235062, C: 1990.745017041},
+		{A: 4.8542e-07, B: 3.95670418719, C: 4535.0594369244},
+		{A: 4.1223e-07, B: 6.02019329922, C: 3894.1818295422},
+		{A: 4.1939e-07, B: 3.58326425115, C: 8031.0922630584},
+		{A: 5.6396e-07, B: 1.68727150304, C: 6872.6731195112},
+		{A: 5.5909e-07, B: 3.46260833495, C: 263.0839233728},
+		{A: 5.1678e-07, B: 2.81307492682, C: 3339.6321056316},
+		{A: 4.0671e-07, B: 3.13832621829, C: 9595.2390892234},
+		{A: 3.8107e-07, B: 0.7340194632, C: 10025.3603984484},
+		{A: 3.9495e-07, B: 5.6322539216, C: 3097.88382272579},
+		{A: 4.4174e-07, B: 3.19529736702, C: 5628.9564702112},
+		{A: 3.6716e-07, B: 2.63720775102, C: 692.1576012268},
+		{A: 4.5905e-07, B: 0.28718981497, C: 5614.7293762096},
+		{A: 3.8352e-07, B: 5.82880707426, C: 3191.0492295652},
+		{A: 3.8206e-07, B: 2.34835984063, C: 162.4666361322},
+		{A: 3.2562e-07, B: 0.48400659333, C: 6681.2921637024},
+		{A: 3.7135e-07, B: 0.68508150774, C: 2818.035008606},
+		{A: 3.1168e-07, B: 3.98160912982, C: 20.3553193988},
+		{A: 3.2561e-07, B: 0.89250316888, C: 6681.1575430968},
+		{A: 3.7752e-07, B: 4.15482955299, C: 2803.8079146044},
+		{A: 3.3626e-07, B: 6.11992401052, C: 6489.776587288},
+		{A: 2.9007e-07, B: 2.42707385674, C: 3319.8370312074},
+		{A: 3.879e-07, B: 1.35198498795, C: 10018.3141617504},
+		{A: 3.3148e-07, B: 1.14023770004, C: 5.5229243074},
+		{A: 2.7584e-07, B: 1.59691203058, C: 7210.9158184942},
+		{A: 2.8686e-07, B: 5.72055456734, C: 7477.522860216},
+		{A: 3.4031e-07, B: 2.59544082509, C: 11769.8536931664},
+		{A: 2.538e-07, B: 0.52093116112, C: 10.6366653498},
+		{A: 2.6357e-07, B: 1.34532646574, C: 3496.032826134},
+		{A: 2.4554e-07, B: 4.00323183088, C: 11371.7046897582},
+		{A: 2.5637e-07, B: 0.2496352342, C: 522.5774180938},
+		{A: 2.7278e-07, B: 4.55645328122, C: 3361.3878221922},
+		{A: 2.3764e-07, B: 1.84058377256, C: 12832.7587417046},
+		{A: 2.2816e-07, B: 3.52628212106, C: 1648.4467571974},
+		{A: 2.2274e-07, B: 0.72106133721, C: 266.6070417218},
+		{A: 2.1202e-07, B: 3.11824472284, C: 2957.7158944766},
+		{A: 2.0158e-07, B: 3.67131504946, C: 1758.6530784168},
+		{A: 2.153e-07, B: 6.15388757177, C: 3264.3463554242},
+		{A: 2.0093e-07, B: 1.08247416065, C: 7064.1213856228},
+		{A: 2.1343e-07, B: 4.28218757863, C: 4032.7700279266},
+		{A: 2.754e-07, B: 6.08389942337, C: 6674.1113063988},
+		{A: 1.9849e-07, B: 2.37668920745, C: 10713.9948813262},
+		{A: 2.5512e-07, B: 3.43242352804, C: 3443.7052009184},
+		{A: 2.2542e-07, B: 5.64861703438, C: 2388.8940204492},
+		{A: 2.4378e-07, B: 0.96994696413, C: 632.7837393132},
+		{A: 2.3079e-07, B: 4.74990214223, C: 3347.7259737006},
+		{A: 1.7709e-07, B: 3.69742343974, C: 3344.2028553516},
+		{A: 2.2662e-07, B: 3.95446324417, C: 4989.0591838972},
+		{A: 2.2604e-07, B: 5.24082917494, C: 3205.5473466644},
+		{A: 1.6811e-07, B: 5.48619684111, C: 3.881335358},
+		{A: 1.8422e-07, B: 4.22535881468, C: 2787.0430238574},
+		{A: 2.2737e-07, B: 4.98520896596, C: 7632.9432596502},
+		{A: 1.6648e-07, B: 2.52823633184, C: 14584.2982731206},
+		{A: 2.0963e-07, B: 4.27878216453, C: 5099.2655051166},
+		{A: 1.6042e-07, B: 1.76786752521, C: 3475.6775067352},
+		{A: 1.5816e-07, B: 3.13240869691, C: 59.3738619136},
+		{A: 1.8113e-07, B: 3.25756020453, C: 3337.021998048},
+		{A: 1.9295e-07, B: 3.23911854642, C: 7.046236698},
+		{A: 1.6772e-07, B: 4.3973150711, C: 15643.6802033098},
+		{A: 1.7555e-07, B: 4.09197396097, C: 74.7815985673},
+		{A: 1.3704e-07, B: 2.5411701816, C: 4933.2084403326},
+		{A: 1.6011e-07, B: 1.54669633224, C: 14054.607308026},
+		{A: 1.3547e-07, B: 4.04152185347, C: 4929.6853219836},
+		{A: 1.4566e-07, B: 3.45210993051, C: 7373.3824546264},
+		{A: 1.3926e-07, B: 5.40797129468, C: 10973.55568635},
+		{A: 1.4246e-07, B: 0.59808746067, C: 23.8784377478},
+		{A: 1.4023e-07, B: 1.44218648988, C: 10404.7338123226},
+		{A: 1.6051e-07, B: 3.79409950488, C: 2118.7638603784},
+		{A: 1.3714e-07, B: 3.59050634457, C: 15113.9892382152},
+		{A: 1.8038e-07, B: 4.25391532, C: 2487.4160449478},
+		{A: 1.5846e-07, B: 0.56901288692, C: 103.0927742186},
+		{A: 1.3403e-07, B: 5.16920432994, C: 10213.285546211},
+		{A: 1.6069e-07, B: 2.36895958451, C: 3265.8308281325},
+		{A: 1.2773e-07, B: 0.10483085657, C: 7234.794256242},
+		{A: 1.2199e-07, B: 1.73079687044, C: 36.0278666774},
+		{A: 1.2283e-07, B: 5.19940030635, C: 10021.8545337516},
+		{A: 1.1945e-07, B: 5.47997890162, C: 2921.1277828246},
+		{A: 1.189e-07, B: 4.76593905835, C: 5828.0284716476},
+		{A: 1.2283e-07, B: 3.16862882612, C: 10021.8200264472},
+		{A: 1.3274e-07, B: 6.1780690534, C: 1744.4259844152},
+		{A: 1.1777e-07, B: 5.727315509, C: 0.42007609361},
+		{A: 1.234e-07, B: 2.52146766331, C: 2906.900688823},
+		{A: 1.4458e-07, B: 4.38010658432, C: 316.3918696566},
+		{A: 1.0639e-07, B: 3.45016942919, C: 639.897286314},
+		{A: 1.0925e-07, B: 0.60397688999, C: 5085.038411115},
+		{A: 1.0645e-07, B: 5.47696728127, C: 419.4846438752},
+		{A: 1.0797e-07, B: 1.37191539718, C: 10419.9862835076},
+		{A: 1.0565e-07, B: 1.09061610786, C: 12168.0026965746},
+		{A: 9.578e-08, B: 4.89489266197, C: 3230.4061054804},
+		{A: 9.808e-08, B: 5.83632873998, C: 14314.1681130498},
+		{A: 9.72e-08, B: 6.28282606427, C: 9225.539273283},
+		{A: 9.146e-08, B: 1.10220667397, C: 9808.5381846614},
+		{A: 1.2733e-07, B: 1.79883375851, C: 13745.3462390224},
+		{A: 9.779e-08, B: 3.60056884868, C: 206.1855484372},
+		{A: 1.2156e-07, B: 4.42295240981, C: 14712.317116458},
+		{A: 8.801e-08, B: 3.97218590685, C: 170.6728706192},
+		{A: 1.0685e-07, B: 4.33894776374, C: 7740.6067835888},
+		{A: 1.0041e-07, B: 1.3829466683, C: 3583.3410306738},
+		{A: 8.499e-08, B: 4.29275471153, C: 0.4298831267},
+		{A: 9.882e-08, B: 2.69148019691, C: 36.6053653042},
+		{A: 1.0585e-07, B: 0.89641284928, C: 23384.2869868986},
+		{A: 8.478e-08, B: 2.86886131803, C: 9381.9399937854},
+		{A: 9.56e-08, B: 4.33382353581, C: 131.5419616864},
+		{A: 8.433e-08, B: 3.1523973397, C: 6525.8044539654},
+		{A: 7.517e-08, B: 1.24476310635, C: 6894.5239488376},
+		{A: 6.753e-08, B: 1.58869332894, C: 6836.6452528338},
+		{A: 6.666e-08, B: 4.38910103043, C: 66.4874089144},
+		{A: 6.697e-08, B: 5.77315870484, C: 5202.3582793352},
+		{A: 6.188e-08, B: 1.5784796785, C: 3325.3599555148},
+		{A: 8.392e-08, B: 2.90734956761, C: 43.718912305},
+		{A: 6.427e-08, B: 6.03333185624, C: 574.3447983348},
+		{A: 6.296e-08, B: 0.06976040737, C: 1964.838626854},
+		{A: 6.144e-08, B: 5.43713363624, C: 1861.7458526354},
+		{A: 8.18e-08, B: 0.43967386003, C: 2707.8286873866},
+		{A: 6.532e-08, B: 1.24907069366, C: 12964.300703391},
+		{A: 7.341e-08, B: 4.95693808975, C: 3767.2106175758},
+		{A: 6.134e-08, B: 3.16322441707, C: 6680.2445323314},
+		{A: 5.937e-08, B: 4.22752447794, C: 4459.3682188026},
+		{A: 5.848e-08, B: 4.30379700916, C: 7875.6718636242},
+		{A: 5.895e-08, B: 0.77226207039, C: 2699.7348193176},
+		{A: 5.962e-08, B: 3.01318151415, C: 3369.0616141676},
+		{A: 6.307e-08, B: 1.77830983983, C: 2178.137722292},
+		{A: 6.103e-08, B: 4.49866000852, C: 6682.2051744678},
+		{A: 5.77e-08, B: 0.96127853878, C: 13916.0191096416},
+		{A: 5.725e-08, B: 4.93042706875, C: 2384.3232707292},
+		{A: 7.576e-08, B: 6.16308742022, C: 6531.661656265},
+		{A: 7.285e-08, B: 0.52756973131, C: 10575.4066829418},
+		{A: 5.212e-08, B: 3.48999909365, C: 12935.8515159232},
+		{A: 5.266e-08, B: 4.51201042373, C: 6144.4203413042},
+		{A: 6.632e-08, B: 3.48100908925, C: 1118.7557921028},
+		{A: 5.182e-08, B: 3.25459432228, C: 2391.43681773},
+		{A: 5.214e-08, B: 0.01023839897, C: 533.2140834436},
+		{A: 5.436e-08, B: 6.18510045571, C: 8425.6508378148},
+		{A: 5.491e-08, B: 3.42235890731, C: 3134.4268782626},
+		{A: 5.773e-08, B: 3.55190490896, C: 8969.568896911},
+		{A: 5.093e-08, B: 0.60873962999, C: 8955.3418029094},
+		{A: 4.798e-08, B: 4.63144694279, C: 4569.574540022},
+		{A: 5.773e-08, B: 5.92316959013, C: 640.8776073822},
+		{A: 5.661e-08, B: 0.75892958537, C: 3120.199784261},
+		{A: 4.912e-08, B: 1.70762118141, C: 13358.9265884502},
+		{A: 5.438e-08, B: 4.29256867866, C: 3503.079062832},
+		{A: 4.631e-08, B: 3.34058594066, C: 3116.2676309979},
+		{A: 5.823e-08, B: 2.39009621568, C: 3302.479391062},
+		{A: 5.095e-08, B: 1.12497131307, C: 5331.3574437408},
+		{A: 4.409e-08, B: 1.3870873295, C: 17256.6315363414},
+		{A: 4.193e-08, B: 0.19116539271, C: 9830.3890139878},
+		{A: 4.164e-08, B: 0.43798301495, C: 1066.49547719},
+		{A: 4.22e-08, B: 1.69427060019, C: 13524.9163429314},
+		{A: 5.192e-08, B: 3.63500646439, C: 536.8045120954},
+		{A: 5.664e-08, B: 0.50446281443, C: 5305.4510535538},
+		{A: 5.264e-08, B: 5.40031180025, C: 3355.8648978848},
+		{A: 5.403e-08, B: 5.96867227489, C: 3074.005384978},
+		{A: 4.046e-08, B: 0.83826342101, C: 10021.9045904022},
+		{A: 4.153e-08, B: 3.14497570817, C: 8439.8779318164},
+		{A: 4.82e-08, B: 1.09025983917, C: 13365.9728251482},
+		{A: 4.906e-08, B: 3.73078406569, C: 1228.9621133222},
+		{A: 4.576e-08, B: 0.99344843456, C: 6158.6474353058},
+		{A: 4.211e-08, B: 3.87091723842, C: 3312.163239232},
+		{A: 4.917e-08, B: 6.25051681717, C: 17654.7805397496},
+		{A: 3.597e-08, B: 6.07298181151, C: 10818.1352869158},
+		{A: 3.547e-08, B: 1.87663674277, C: 17395.2197347258},
+		{A: 3.734e-08, B: 1.37011069213, C: 3973.396166013},
+		{A: 4.422e-08, B: 2.89974680697, C: 6247.5131155228},
+		{A: 3.975e-08, B: 4.03441621102, C: 1052.2683831884},
+		{A: 4.466e-08, B: 4.59188422424, C: 5518.7501489918},
+		{A: 3.445e-08, B: 4.26610076208, C: 3178.1457905676},
+		{A: 3.607e-08, B: 4.24527056399, C: 8329.671610597},
+		{A: 3.511e-08, B: 1.95133364438, C: 10177.2576795336},
+		{A: 3.3e-08, B: 0.68865737747, C: 149.5631971346},
+		{A: 3.235e-08, B: 3.90171358031, C: 27.4015560968},
+		{A: 3.946e-08, B: 5.81982010903, C: 6261.7402095244},
+		{A: 3.387e-08, B: 0.14377701728, C: 10014.7237330986},
+		{A: 3.374e-08, B: 0.74722238154, C: 6048.4411140864},
+		{A: 3.09e-08, B: 3.98540766861, C: 2648.454825473},
+		{A: 3.821e-08, B: 5.23987859516, C: 5724.935697429},
+		{A: 3.026e-08, B: 0.34314724795, C: 220.4126424388},
+		{A: 4.052e-08, B: 1.24671617482, C: 10021.7699697966},
+		{A: 2.937e-08, B: 0.73100893561, C: 2.751510611},
+		{A: 3.084e-08, B: 3.79925632319, C: 169.580183133},
+		{A: 4.04e-08, B: 2.91258200196, C: 22747.2907148744},
+		{A: 3.246e-08, B: 4.90760526525, C: 6702.000248892},
+		{A: 3.223e-08, B: 0.9264000861, C: 16865.5287696312},
+		{A: 2.879e-08, B: 1.47180601483, C: 3346.1353510072},
+		{A: 3.723e-08, B: 0.49978317761, C: 1.4844727083},
+		{A: 2.798e-08, B: 3.26910698548, C: 9168.6408983474},
+		{A: 3.347e-08, B: 0.68791690253, C: 3863.1898447936},
+		{A: 3.44e-08, B: 2.77706064625, C: 6660.4494579072},
+		{A: 2.798e-08, B: 2.79673379094, C: 16858.4825329332},
+		{A: 2.753e-08, B: 4.41190782119, C: 3415.3940252671},
+		{A: 2.703e-08, B: 0.19222683184, C: 3237.5196524812},
+		{A: 2.719e-08, B: 3.26628341377, C: 3914.9572250346},
+		{A: 2.941e-08, B: 3.76169133143, C: 6784.3176276182},
+		{A: 3.078e-08, B: 5.48104322207, C: 3335.0895023924},
+		{A: 2.701e-08, B: 5.08537226206, C: 6688.3384004004},
+		{A: 2.606e-08, B: 4.83032556909, C: 4672.6673142406},
+		{A: 2.883e-08, B: 2.64068086362, C: 3320.257107301},
+		{A: 3.089e-08, B: 4.396198243, C: 1332.0548875408},
+		{A: 3.438e-08, B: 1.93467064449, C: 10551.528245194},
+		{A: 3.433e-08, B: 0.20933510815, C: 6604.958782124},
+		{A: 2.513e-08, B: 2.88151502604, C: 17924.9106998204},
+		{A: 2.683e-08, B: 3.68680362407, C: 3603.6963500726},
+		{A: 2.696e-08, B: 2.6693177673, C: 10184.3039162316},
+		{A: 2.386e-08, B: 1.05710815775, C: 3607.2194684216},
+		{A: 2.444e-08, B: 3.46901444423, C: 6298.3283211764},
+		{A: 2.566e-08, B: 5.55941956468, C: 6546.1597733642},
+		{A: 2.354e-08, B: 0.8589678994, C: 3351.2490920496},
+		{A: 2.304e-08, B: 6.0721098579, C: 1214.7350193206},
+		{A: 2.387e-08, B: 4.30419979225, C: 3360.96774609859},
+		{A: 3.109e-08, B: 2.18099805904, C: 16173.3711684044},
+		{A: 2.907e-08, B: 3.43894993113, C: 2693.601593385},
+		{A: 2.55e-08, B: 4.18354174372, C: 3546.797975137},
+		{A: 2.991e-08, B: 2.37500894603, C: 13517.8701062334},
+		{A: 2.508e-08, B: 5.99249607455, C: 5729.506447149},
+		{A: 2.202e-08, B: 5.58486973955, C: 664.75604513},
+		{A: 2.467e-08, B: 2.81052575497, C: 15110.4661198662},
+		{A: 2.154e-08, B: 3.59696831702, C: 6677.6344247478},
+		{A: 2.166e-08, B: 3.35965682842, C: 589.0648270082},
+		{A: 2.112e-08, B: 4.57048853017, C: 6127.6554505572},
+		{A: 2.178e-08, B: 3.21663279493, C: 20199.094959633},
+		{A: 2.26e-08, B: 3.62776666288, C: 7799.9806455024},
+		{A: 2.703e-08, B: 5.89441421026, C: 6438.4962494256},
+		{A: 2.201e-08, B: 4.69972051344, C: 17277.4069318338},
+		{A: 2.131e-08, B: 2.5180126434, C: 1545.3539829788},
+		{A: 2.093e-08, B: 4.0368841963, C: 6684.8152820514},
+		{A: 2.249e-08, B: 5.26171743929, C: 5618.3198048614},
+		{A: 2.117e-08, B: 4.80404492675, C: 3657.0042963564},
+		{A: 2.265e-08, B: 3.87401620754, C: 110.2063212194},
+		{A: 2.521e-08, B: 4.21237950687, C: 2494.5295919486},
+		{A: 2.492e-08, B: 6.10452779411, C: 3329.97576135},
+		{A: 2.154e-08, B: 4.59093274516, C: 5625.3660415594},
+		{A: 1.929e-08, B: 1.21920197307, C: 21.8508293264},
+		{A: 2.343e-08, B: 0.90613584736, C: 227.476132789},
+		{A: 1.871e-08, B: 2.0370739008, C: 56.8983749356},
+		{A: 1.894e-08, B: 4.12432529517, C: 3399.9862886134},
+		{A: 2.271e-08, B: 3.78951734652, C: 7910.1869667218},
+		{A: 1.876e-08, B: 3.0203488708, C: 4885.9664096786},
+		{A: 2.145e-08, B: 4.74972617629, C: 18984.2926300096},
+		{A: 2.035e-08, B: 2.75110234296, C: 128.0188433374},
+		{A: 1.77e-08, B: 2.73496011079, C: 6606.4432548323},
+		{A: 1.733e-08, B: 1.70571779606, C: 6.6836638741},
+		{A: 1.743e-08, B: 2.22986352012, C: 17085.9586657222},
+		{A: 1.723e-08, B: 1.98207274526, C: 735.8765135318},
+		{A: 1.814e-08, B: 0.92405242552, C: 4555.3474460204},
+		{A: 1.661e-08, B: 3.07319305915, C: 1692.1656695024},
+		{A: 2.332e-08, B: 5.05223613332, C: 20618.0193585336},
+		{A: 1.653e-08, B: 3.86316179052, C: 699.2711482276},
+		{A: 1.89e-08, B: 4.14080522607, C: 5459.3762870782},
+		{A: 1.68e-08, B: 3.49750704538, C: 3347.6586633978},
+		{A: 1.909e-08, B: 2.11478314309, C: 6816.289933435},
+		{A: 1.918e-08, B: 3.31232891649, C: 3333.5661900018},
+		{A: 1.707e-08, B: 4.88386665097, C: 3407.0998356142},
+		{A: 1.577e-08, B: 3.5177713158, C: 13362.432453147},
+		{A: 1.827e-08, B: 1.14937084769, C: 2807.3983432562},
+		{A: 2.094e-08, B: 1.78938870686, C: 20597.2439630412},
+		{A: 1.577e-08, B: 5.54854302324, C: 13362.4669604514},
+		{A: 1.677e-08, B: 4.39073937265, C: 8270.2977486834},
+		{A: 1.561e-08, B: 0.8024690473, C: 3017.1070100424},
+		{A: 1.552e-08, B: 2.66876850182, C: 3024.2205570432},
+		{A: 1.708e-08, B: 2.09721350898, C: 2814.4445799542},
+		{A: 2.109e-08, B: 1.48733908496, C: 2679.3794999188},
+		{A: 1.547e-08, B: 2.12956399169, C: 661.232926781},
+		{A: 1.764e-08, B: 5.90517079295, C: 3326.3853326982},
+		{A: 1.503e-08, B: 4.3438918317, C: 13936.794505134},
+		{A: 1.504e-08, B: 0.83367652471, C: 4775.7600884592},
+		{A: 1.543e-08, B: 5.82417982389, C: 3344.4937620578},
+		{A: 1.458e-08, B: 1.42630589706, C: 15508.6151232744},
+		{A: 1.648e-08, B: 2.88801518612, C: 8273.8208670324},
+		{A: 1.527e-08, B: 1.10261249454, C: 2675.8563815698},
+		{A: 1.8e-08, B: 5.17144672709, C: 38.1330356378},
+		{A: 1.439e-08, B: 0.48751113425, C: 76.2660712756},
+		{A: 1.478e-08, B: 4.63008666184, C: 19800.9459562248},
+		{A: 1.77e-08, B: 2.18315009072, C: 2301.58581590939},
+		{A: 1.712e-08, B: 2.60291779744, C: 29.429508536},
+		{A: 1.416e-08, B: 2.46912016889, C: 2597.6223661672},
+		{A: 1.835e-08, B: 3.93223068469, C: 6843.6914895318},
+		{A: 1.437e-08, B: 3.48241890037, C: 3281.2385647862},
+		{A: 1.385e-08, B: 4.18004525683, C: 2284.7536148596},
+		{A: 1.479e-08, B: 3.88994194685, C: 6034.2140200848},
+		{A: 1.469e-08, B: 2.91808856545, C: 12722.5524204852},
+		{A: 1.824e-08, B: 3.94139541752, C: 18454.601664915},
+		{A: 1.368e-08, B: 1.71899681607, C: 13760.5987102074},
+		{A: 1.406e-08, B: 2.42916192473, C: 853.196381752},
+		{A: 1.361e-08, B: 4.69126219798, C: 11081.2192102886},
+		{A: 1.375e-08, B: 4.90787845983, C: 3304.5845600224},
+		{A: 1.544e-08, B: 3.23251603238, C: 156.4007205024},
+		{A: 1.543e-08, B: 1.13497136095, C: 3336.7310913418},
+		{A: 1.385e-08, B: 2.90281983822, C: 1581.959348283},
+		{A: 1.311e-08, B: 6.21748423079, C: 2547.8375382324},
+		{A: 1.377e-08, B: 2.56537541792, C: 568.8218740274},
+		{A: 1.474e-08, B: 4.65215247212, C: 394.6258850592},
+		{A: 1.619e-08, B: 0.07481583409, C: 1435.1476617594},
+		{A: 1.369e-08, B: 0.08979359617, C: 7895.9598727202},
+		{A: 1.272e-08, B: 5.84659079053, C: 21.335640467},
+		{A: 1.331e-08, B: 3.80007277718, C: 13119.72110282519},
+		{A: 1.202e-08, B: 5.90681878458, C: 187.9251477626},
+		{A: 1.284e-08, B: 5.30205682052, C: 6571.0185321802},
+		{A: 1.468e-08, B: 5.91503888421, C: 3339.1279539915},
+		{A: 1.497e-08, B: 2.57629850836, C: 151.8972810852},
+		{A: 1.27e-08, B: 4.089962414, C: 4356.275444584},
+		{A: 1.159e-08, B: 0.00546309207, C: 799.8211251654},
+		{A: 1.154e-08, B: 1.81024985603, C: 158.9435177832},
+		{A: 1.17e-08, B: 4.15567553953, C: 14.2270940016},
+		{A: 1.349e-08, B: 0.39391022745, C: 2540.7913015344},
+		{A: 1.403e-08, B: 4.89956428847, C: 4039.8835749274},
+		{A: 1.188e-08, B: 3.46422365287, C: 1015.6630178842},
+		{A: 1.136e-08, B: 5.54132891775, C: 13553.8979729108},
+		{A: 1.282e-08, B: 4.5228842134, C: 3929.677253708},
+		{A: 1.124e-08, B: 1.40102701439, C: 13149.1506113612},
+		{A: 1.254e-08, B: 3.84775964741, C: 3980.5097130138},
+		{A: 1.121e-08, B: 5.04552583589, C: 23141.5583829246},
+		{A: 1.088e-08, B: 0.89388884633, C: 3340.19235060619},
+		{A: 1.476e-08, B: 4.20852908107, C: 16460.33352952499},
+		{A: 1.084e-08, B: 5.70551481838, C: 3760.097070575},
+		{A: 1.197e-08, B: 1.24543578245, C: 26724.8994135984},
+		{A: 1.253e-08, B: 5.82286965998, C: 3344.5445799629},
+		{A: 1.078e-08, B: 6.24742453183, C: 17.812522118},
+		{A: 1.462e-08, B: 2.27467510211, C: 369.6998159404},
+		{A: 1.044e-08, B: 4.34158344243, C: 2277.7073781616},
+		{A: 1.099e-08, B: 4.08747611117, C: 802.3639224462},
+		{A: 1.122e-08, B: 4.31726854418, C: 107.6635239386},
+		{A: 1.148e-08, B: 0.42666214138, C: 949.1756089698},
+		{A: 1.183e-08, B: 0.3156003412, C: 1162.4747044078},
+		{A: 1.13e-08, B: 3.46061330657, C: 5732.0492444298},
+		{A: 1.059e-08, B: 1.34075999078, C: 2149.6885348242},
+		{A: 1.126e-08, B: 5.22902222299, C: 194.9713844606},
+		{A: 9.77e-09, B: 3.44426658417, C: 9779.1086761254},
+		{A: 1.134e-08, B: 0.13507173479, C: 12566.1516999828},
+		{A: 1.185e-08, B: 3.65682936623, C: 6456.8800576977},
+		{A: 9.62e-09, B: 4.85370284827, C: 3510.1926098328},
+		{A: 1.033e-08, B: 2.47380728164, C: 31.9723058168},
+		{A: 1.172e-08, B: 1.72927931309, C: 6923.9534573736},
+		{A: 9.86e-09, B: 1.05152205629, C: 16335.8378045366},
+		{A: 1.292e-08, B: 6.02388659607, C: 3316.733988952},
+		{A: 9.32e-09, B: 1.56812178735, C: 111.1866422876},
+		{A: 1.13e-08, B: 2.06879370733, C: 3376.6402933772},
+		{A: 9.24e-09, B: 0.82106390115, C: 3364.4908644476},
+		{A: 1.005e-08, B: 5.48594935121, C: 11236.57229942},
+		{A: 9.36e-09, B: 1.58837554752, C: 10235.1363755374},
+		{A: 1.063e-08, B: 1.93321644244, C: 87.30820453981},
+		{A: 9.45e-09, B: 5.10393817688, C: 8013.2797409404},
+		{A: 9.57e-09, B: 4.4142175236, C: 433.7117378768},
+		{A: 9.21e-09, B: 1.71021166461, C: 2067.9314010726},
+		{A: 9.1e-09, B: 1.91562889347, C: 401.6721217572},
+		{A: 9.31e-09, B: 4.61327789373, C: 5415.6573747732},
+		{A: 1.033e-08, B: 3.98512631089, C: 10596.1820784342},
+		{A: 9.08e-09, B: 5.30549216988, C: 7107.8230442756},
+		{A: 9.45e-09, B: 0.21610469082, C: 9872.2740829648},
+		{A: 9.91e-09, B: 2.76931703924, C: 5938.234792867},
+		{A: 9.18e-09, B: 4.37217510741, C: 1854.6323056346},
+		{A: 8.89e-09, B: 1.81025662091, C: 2409.249339848},
+		{A: 1.058e-08, B: 3.63303046818, C: 7255.5696517344},
+		{A: 8.6e-09, B: 2.78826625605, C: 5621.8601768626},
+		{A: 8.6e-09, B: 0.75749461239, C: 5621.8256695582},
+		{A: 8.5e-09, B: 3.82228424021, C: 272.6729573516},
+		{A: 8.51e-09, B: 0.25287875945, C: 11766.2632645146},
+		{A: 8.53e-09, B: 1.84243320985, C: 2142.6422981262},
+		{A: 1.03e-08, B: 0.42255009123, C: 1596.1864422846},
+		{A: 8.26e-09, B: 1.26069501589, C: 20206.141196331},
+		{A: 8.35e-09, B: 4.64394754967, C: 127.9515330346},
+		{A: 1.046e-08, B: 3.50232998647, C: 9866.4168806652},
+		{A: 8.24e-09, B: 2.42928138519, C: 11.0457002639},
+		{A: 1.09e-08, B: 6.06536924506, C: 3341.0325027934},
+		{A: 8.08e-09, B: 1.17345081945, C: 6460.8122109608},
+		{A: 8.21e-09, B: 0.19617131185, C: 22743.4093795164},
+		{A: 8.04e-09, B: 5.03144428114, C: 2.5427972808},
+		{A: 1.074e-08, B: 2.80025232939, C: 3077.528503327},
+		{A: 7.94e-09, B: 4.57067003887, C: 5223.6939198022},
+		{A: 8.22e-09, B: 0.90150471199, C: 8646.0634802536},
+		{A: 8.84e-09, B: 1.71007411939, C: 3.9321532631},
+		{A: 8.5e-09, B: 1.24977903471, C: 12295.9542296092},
+		{A: 9.26e-09, B: 3.02847055739, C: 3377.217792004},
+		{A: 7.79e-09, B: 1.97930055918, C: 5408.5438277724},
+		{A: 9.6e-09, B: 6.16414205869, C: 8542.970706035},
+		{A: 7.85e-09, B: 4.63077378309, C: 3341.0423098265},
+		{A: 7.52e-09, B: 3.51125131831, C: 10020.8569590312},
+		{A: 7.49e-09, B: 2.5028675102, C: 2295.4575905122},
+		{A: 8.01e-09, B: 4.54004277501, C: 1039.0266107904},
+		{A: 7.35e-09, B: 3.91703254169, C: 12310.1813236108},
+		{A: 7.84e-09, B: 3.24368310213, C: 3384.3313390048},
+		{A: 7.19e-09, B: 3.35536995801, C: 11780.4903585162},
+		{A: 9.4e-09, B: 3.589745616, C: 23539.7073863328},
+		{A: 8.65e-09, B: 1.12444569157, C: 206.7007372966},
+		{A: 7.31e-09, B: 5.61232905415, C: 16062.1845261168},
+		{A: 8.23e-09, B: 2.65316808033, C: 3169.9395560806},
+		{A: 9.03e-09, B: 4.86743346013, C: 931.3630868518},
+		{A: 7.05e-09, B: 1.4630539462, C: 792.7748884674},
+		{A: 7.54e-09, B: 2.89691411536, C: 3296.8935143948},
+		{A: 7.36e-09, B: 2.21038016464, C: 146.8116865236},
+		{A: 7.85e-09, B: 2.32844966721, C: 3340.1825435731},
+		{A: 6.85e-09, B: 2.34948834397, C: 1.1806426521},
+		{A: 6.77e-09, B: 4.3380350705, C: 3877.4169387952},
+		{A: 7.29e-09, B: 3.56726385957, C: 485.9720527896},
+		{A: 6.68e-09, B: 5.16798891078, C: 12721.572099417},
+		{A: 7.13e-09, B: 4.80558699772, C: 4142.976349146},
+		{A: 8.42e-09, B: 1.59708732155, C: 16304.9131300908},
+		{A: 6.98e-09, B: 1.91829605833, C: 6665.9723822146},
+		{A: 6.98e-09, B: 4.64681188157, C: 11216.284290324},
+		{A: 6.84e-09, B: 3.88514563357, C: 846.0828347512},
+		{A: 6.66e-09, B: 2.23927960017, C: 20735.83216142559},
+		{A: 6.38e-09, B: 2.05380863176, C: 16699.53901514999},
+		{A: 7.26e-09, B: 5.04566216294, C: 3329.5667264359},
+		{A: 6.97e-09, B: 0.30680314664, C: 19676.4502312364},
+		{A: 6.5e-09, B: 3.23944472757, C: 9588.1255422226},
+		{A: 7.36e-09, B: 2.24443728739, C: 11250.7993934216},
+		{A: 6.92e-09, B: 1.76465916332, C: 51.28033786241},
+		{A: 6.45e-09, B: 0.07661067442, C: 52.2603149128},
+		{A: 6.85e-09, B: 2.72118140264, C: 6643.0918177618},
+		{A: 6.84e-09, B: 1.26157751115, C: 142.1786270362},
+		{A: 6.35e-09, B: 3.85231847116, C: 6.0659156298},
+		{A: 8.19e-09, B: 6.04967230806, C: 19402.7969528166},
+		{A: 6.64e-09, B: 3.6022393001, C: 1903.4368125012},
+		{A: 6.13e-09, B: 4.96450118774, C: 8859.3625756916},
+		{A: 6.15e-09, B: 5.7446741784, C: 6696.4773245846},
+		{A: 6.22e-09, B: 1.64154132852, C: 24150.080051345},
+		{A: 6.59e-09, B: 0.12506816965, C: 8116.372515159},
+		{A: 6.79e-09, B: 1.94943016061, C: 12995.2253778368},
+		{A: 6.16e-09, B: 4.94607504516, C: 9485.032768004},
+		{A: 7.93e-09, B: 2.84922599989, C: 29.4918183034},
+		{A: 5.97e-09, B: 5.72067567055, C: 3113.1362939108},
+		{A: 7.63e-09, B: 0.42513533173, C: 3873.8265101434},
+		{A: 5.87e-09, B: 1.31684217558, C: 9499.2598620056},
+		{A: 5.92e-09, B: 4.34796024028, C: 3826.5844794894},
+		{A: 6.52e-09, B: 5.53658742492, C: 9065.5481241288},
+		{A: 5.83e-09, B: 0.59190068342, C: 26084.0218062162},
+		{A: 6.03e-09, B: 0.7713556663, C: 3342.0968994081},
+		{A: 7.01e-09, B: 3.35897774328, C: 6709.6740408674},
+		{A: 5.74e-09, B: 1.08976979801, C: 6357.7194367422},
+		{A: 7.5e-09, B: 4.84737198179, C: 10022.8176011676},
+		{A: 5.88e-09, B: 5.65927128604, C: 2171.0241752912},
+		{A: 6.32e-09, B: 3.84852695407, C: 16276.463942623},
+		{A: 6.32e-09, B: 1.43230388645, C: 16706.585251848},
+		{A: 7.8e-09, B: 1.1518150254, C: 377.3736079158},
+		{A: 5.54e-09, B: 4.18192049239, C: 382.879278571},
+		{A: 5.68e-09, B: 0.03816333919, C: 6414.6178116778},
+		{A: 5.69e-09, B: 2.57704345512, C: 35.5627344686},
+		{A: 5.66e-09, B: 0.62804830762, C: 3189.5647568569},
+		{A: 6.87e-09, B: 5.61456944029, C: 155.3530891314},
+		{A: 5.63e-09, B: 2.57358138188, C: 19406.6782881746},
+		{A: 6.93e-09, B: 1.15645091892, C: 966.9708774356},
+		{A: 5.76e-09, B: 1.60357663736, C: 3192.5337022735},
+		{A: 6.21e-09, B: 2.23214771591, C: 3274.1250177854},
+		{A: 5.86e-09, B: 6.17266280012, C: 9602.3526362242},
+		{A: 5.41e-09, B: 0.53968808391, C: 13171.0014406876},
+		{A: 5.7e-09, B: 3.11852290115, C: 2221.856634597},
+		{A: 5.42e-09, B: 0.41889651002, C: 1641.3332101966},
+		{A: 5.32e-09, B: 0.16606105669, C: 5511.636601991},
+		{A: 5.3e-09, B: 3.78205188174, C: 7270.2896804078},
+		{A: 5.75e-09, B: 5.48929613719, C: 2075.0449480734},
+		{A: 6.7e-09, B: 3.67090656417, C: 6475.0393049624},
+		{A: 5.09e-09, B: 4.21526585284, C: 7380.4960016272},
+		{A: 5.1e-09, B: 1.59587338243, C: 13362.3823964964},
+		{A: 4.94e-09, B: 2.1371159806, C: 2604.735913168},
+		{A: 5.34e-09, B: 1.03109772656, C: 1478.8665740644},
+		{A: 5.06e-09, B: 5.92145494356, C: 685.044054226},
+		{A: 4.69e-09, B: 0.83917541691, C: 3041.4860324306},
+		{A: 4.68e-09, B: 1.97135671591, C: 2412.772458197},
+		{A: 4.68e-09, B: 4.57635781664, C: 11670.2840372968},
+		{A: 5.59e-09, B: 1.47641018288, C: 8671.9698704406},
+		{A: 4.75e-09, B: 2.07517976424, C: 5835.1420186484},
+		{A: 4.66e-09, B: 2.92801596791, C: 2277.2983432475},
+		{A: 4.78e-09, B: 6.13550464878, C: 4825.544916394},
+		{A: 5.02e-09, B: 0.77928275348, C: 4407.1079038898},
+		{A: 4.62e-09, B: 3.80503324215, C: 11140.5930722022},
+		{A: 4.99e-09, B: 3.25557381873, C: 1744.493294718},
+		{A: 5.25e-09, B: 0.74022979976, C: 1265.5674786264},
+		{A: 5.21e-09, B: 6.27577138682, C: 3981.490034082},
+		{A: 4.82e-09, B: 0.1482033753, C: 14158.7477136156},
+		{A: 4.6e-09, B: 2.03348517376, C: 25685.872802808},
+		{A: 5.1e-09, B: 4.27704405425, C: 3472.1543883862},
+		{A: 4.44e-09, B: 1.95486223268, C: 3226.2133197864},
+		{A: 5.75e-09, B: 0.59007504383, C: 2766.267628365},
+		{A: 4.37e-09, B: 5.63861950911, C: 8958.9322315612},
+		{A: 4.92e-09, B: 6.10071747922, C: 2285.1626497737},
+		{A: 5.72e-09, B: 5.10308842466, C: 8564.306346502},
+		{A: 4.3e-09, B: 4.12851356192, C: 13.241772398},
+		{A: 5.44e-09, B: 4.78254843814, C: 9380.9596727172},
+		{A: 4.3e-09, B: 3.83861916944, C: 1765.7666254176},
+		{A: 4.99e-09, B: 4.26055736809, C: 6652.7756659318},
+		{A: 5.41e-09, B: 0.84245625839, C: 4981.9456368964},
+		{A: 5.91e-09, B: 2.01874828234, C: 27490.6924780448},
+		{A: 4.38e-09, B: 1.36437546581, C: 12509.2533250472},
+		{A: 4.7e-09, B: 4.70961176845, C: 3723.4917052708},
+		{A: 4.21e-09, B: 1.76100140625, C: 956.2891559706},
+		{A: 4.13e-09, B: 0.49206034039, C: 13355.3361597984},
+		{A: 4.12e-09, B: 0.29353415123, C: 2810.9387152574},
+		{A: 4.12e-09, B: 3.2486541775, C: 18451.07854656599},
+		{A: 4.12e-09, B: 1.70721395764, C: 7314.0085927128},
+		{A: 4.12e-09, B: 4.54594776716, C: 2810.904207953},
+		{A: 4.48e-09, B: 3.04254429984, C: 5636.070017212},
+		{A: 5.1e-09, B: 1.18739936388, C: 13362.517017102},
+		{A: 4.08e-09, B: 1.32068176489, C: 20809.4676246452},
+		{A: 4.27e-09, B: 1.1610074204, C: 19004.6479494084},
+		{A: 4.2e-09, B: 3.49180180953, C: 1655.5603041982},
+		{A: 4.33e-09, B: 0.55429134487, C: 9945.5712088238},
+		{A: 4.24e-09, B: 4.60854671688, C: 6518.7582172674},
+		{A: 4.15e-09, B: 5.67120343816, C: 99.5696558696},
+		{A: 4.76e-09, B: 5.94537443289, C: 48835.19385644859},
+		{A: 4.05e-09, B: 5.63206200287, C: 3450.8187479192},
+		{A: 4.95e-09, B: 4.38317490235, C: 2480.302497947},
+		{A: 4.15e-09, B: 3.61905205961, C: 2089.782230399},
+		{A: 3.95e-09, B: 0.25208772249, C: 1375.7737998458},
+		{A: 4.21e-09, B: 2.26337694295, C: 13892.1406718938},
+		{A: 3.91e-09, B: 5.25566087245, C: 10042.6126755918},
+		{A: 5.04e-09, B: 4.91016850189, C: 8965.9784682592},
+		{A: 4.17e-09, B: 3.45088934666, C: 279.7865043524},
+		{A: 3.89e-09, B: 0.98703891513, C: 7203.8022714934},
+		{A: 4.31e-09, B: 2.11881719623, C: 56.8032621698},
+		{A: 4.97e-09, B: 1.63121523594, C: 22345.2603761082},
+		{A: 3.77e-09, B: 4.06263841184, C: 10124.930054318},
+		{A: 3.77e-09, B: 3.16019395941, C: 224.3447957019},
+		{A: 3.78e-09, B: 5.82532048605, C: 6675.7019290922},
+		{A: 3.77e-09, B: 0.05833226835, C: 905.4566966648},
+		{A: 4.45e-09, B: 0.33560120738, C: 515.463871093},
+		{A: 4.26e-09, B: 2.66458973231, C: 4076.4889402316},
+		{A: 4.89e-09, B: 0.75760372851, C: 3561.0250691386},
+		{A: 3.89e-09, B: 0.5028382658, C: 2825.1485556068},
+		{A: 3.96e-09, B: 5.14198726837, C: 5195.2447323344},
+		{A: 4.14e-09, B: 3.12530321804, C: 10001.061884607},
+		{A: 5.02e-09, B: 5.02356488339, C: 73.297125859},
+		{A: 4.32e-09, B: 3.3153835914, C: 5617.9107699473},
+		{A: 3.59e-09, B: 4.97622870666, C: 5820.9149246468},
+		{A: 3.59e-09, B: 5.420722273, C: 6019.9919266186},
+		{A: 4.49e-09, B: 3.79544854612, C: 4996.172730898},
+		{A: 3.74e-09, B: 2.85563965649, C: 2111.6503133776},
+		{A: 4.26e-09, B: 0.24129917209, C: 5625.7750764735},
+		{A: 4.23e-09, B: 3.60507971235, C: 8226.5788363784},
+		{A: 4.02e-09, B: 4.5181371951, C: 4392.8808098882},
+		{A: 3.91e-09, B: 4.26714089799, C: 21795.21409161479},
+		{A: 4.47e-09, B: 4.25776540974, C: 18052.9295431578},
+		{A: 3.55e-09, B: 4.17584780659, C: 6740.5987153132},
+		{A: 3.53e-09, B: 1.81574804066, C: 6686.747777707},
+		{A: 4.62e-09, B: 3.22304237134, C: 2011.1003364398},
+		{A: 3.41e-09, B: 3.22071023668, C: 4253.1826703654},
+		{A: 4.75e-09, B: 4.28822688035, C: 367.2243289624},
+		{A: 4.62e-09, B: 2.91312544527, C: 418.9243989006},
+		{A: 4.16e-09, B: 4.83203726375, C: 8535.8571590342},
+		{A: 4.31e-09, B: 3.22440150226, C: 21265.5231265202},
+		{A: 3.33e-09, B: 3.99300815003, C: 1353.3905280078},
+		{A: 3.4e-09, B: 0.71636465254, C: 15664.03552270859},
+		{A: 4.16e-09, B: 2.58443168474, C: 5753.3848848968},
+		{A: 3.56e-09, B: 2.35639379021, C: 4.57074972},
+		{A: 3.3e-09, B: 4.04831945983, C: 3.1030422554},
+		{A: 3.81e-09, B: 4.06283076724, C: 1062.9050485382},
+		{A: 4.31e-09, B: 5.42246026973, C: 26482.1708096244},
+		{A: 3.28e-09, B: 4.0627695455, C: 6944.3087767724},
+		{A: 3.34e-09, B: 5.10221163477, C: 22324.9050567094},
+		{A: 4e-09, B: 2.28211698182, C: 3209.0704650134},
+		{A: 4.2e-09, B: 2.44159662565, C: 6155.057006654},
+		{A: 3.75e-09, B: 1.09229714548, C: 4246.0691233646},
+		{A: 4.49e-09, B: 4.38183542571, C: 5106.3790521174},
+		{A: 3.3e-09, B: 2.55172725319, C: 19513.9835951042},
+		{A: 3.19e-09, B: 4.05704496382, C: 5430.3946570988},
+		{A: 3.1e-09, B: 4.99907184482, C: 5095.6750764648},
+		{A: 3.44e-09, B: 0.6269183218, C: 9389.0535407862},
+		{A: 3.19e-09, B: 3.31673970253, C: 596.178374009},
+		{A: 3.05e-09, B: 4.6694291091, C: 6756.0064519669},
+		{A: 4.09e-09, B: 6.07568936266, C: 50.8324593058},
+		{A: 3.77e-09, B: 5.42211267415, C: 1190.9238918756},
+		{A: 3.42e-09, B: 2.67821304845, C: 6148.010769956},
+		{A: 2.98e-09, B: 5.91093444215, C: 9886.772200064},
+		{A: 2.99e-09, B: 5.44077050156, C: 10028.9508271002},
+		{A: 3.15e-09, B: 1.03304445564, C: 3490.1756238344},
+		{A: 3.69e-09, B: 3.93279262125, C: 1879.5583747534},
+		{A: 3.04e-09, B: 4.56372485787, C: 7483.5887758458},
+		{A: 3.3e-09, B: 3.29725079066, C: 286.9623611206},
+		{A: 2.9e-09, B: 3.4385002402, C: 6418.1409300268},
+		{A: 2.89e-09, B: 2.827660453, C: 3171.0322435668},
+		{A: 3.17e-09, B: 4.13345374602, C: 1883.0814931024},
+		{A: 2.94e-09, B: 0.0190673259, C: 202.2533951741},
+		{A: 3.1e-09, B: 4.37565854379, C: 2796.6943676036},
+		{A: 3.64e-09, B: 0.54935210242, C: 290.4854794696},
+		{A: 2.91e-09, B: 2.39413982848, C: 29026.48522950779},
+		{A: 2.86e-09, B: 5.76562894312, C: 5642.1982426092},
+		{A: 3.92e-09, B: 3.01171327788, C: 10721.108428327},
+		{A: 3.18e-09, B: 4.93376925851, C: 1197.9701285736},
+		{A: 3.15e-09, B: 2.844378401, C: 10610.9021071076},
+		{A: 2.99e-09, B: 1.18299169304, C: 550.0462844934},
+		{A: 3.36e-09, B: 4.3076981876, C: 5989.0672521728},
+		{A: 3.15e-09, B: 1.4140786334, C: 6947.8318951214},
+		{A: 2.94e-09, B: 6.16453752963, C: 8982.810669309},
+		{A: 2.85e-09, B: 2.82644088669, C: 9654.612951137},
+		{A: 2.81e-09, B: 0.27739627997, C: 8166.1573430938},
+		{A: 2.79e-09, B: 4.08648927643, C: 5355.2358814886},
+		{A: 3.3e-09, B: 3.11968019451, C: 41.5507909848},
+		{A: 2.75e-09, B: 5.89019272334, C: 3337.8609160888},
+		{A: 2.75e-09, B: 4.00268079937, C: 3620.3989310522},
+		{A: 2.92e-09, B: 3.06830662617, C: 4.3620363898},
+		{A: 3.17e-09, B: 0.01919037405, C: 2267.003402509},
+		{A: 2.72e-09, B: 1.70373580224, C: 3742.284548457},
+		{A: 2.94e-09, B: 0.16019381973, C: 6670.5881880498},
+		{A: 2.64e-09, B: 2.07967576148, C: 3735.238311759},
+		{A: 2.62e-09, B: 4.49437120405, C: 6887.4104018368},
+		{A: 3.07e-09, B: 3.03375977801, C: 6660.8695340008},
+		{A: 2.8e-09, B: 0.47728086796, C: 5401.4302807716},
+		{A: 2.68e-09, B: 0.46331887242, C: 6578.132079181},
+		{A: 3.12e-09, B: 3.62016422039, C: 255.970376372},
+		{A: 2.78e-09, B: 5.62032972822, C: 3378.7454623376},
+		{A: 2.68e-09, B: 2.97528422526, C: 21947.1113727},
+		{A: 3.03e-09, B: 2.71946492092, C: 3313.210870603},
+		{A: 2.63e-09, B: 3.85883671333, C: 28628.3362260996},
+		{A: 2.57e-09, B: 0.25607724431, C: 19146.7592661418},
+		{A: 2.82e-09, B: 2.29169514758, C: 2008.557539159},
+		{A: 3.01e-09, B: 0.83275594655, C: 2806.9893083421},
+		{A: 2.64e-09, B: 3.78635346388, C: 3936.7908007088},
+		{A: 2.54e-09, B: 1.28062508539, C: 95.9792272178},
+		{A: 2.5e-09, B: 5.5849385856, C: 412.3710968744},
+		{A: 2.84e-09, B: 4.71065509252, C: 5621.9102335132},
+		{A: 2.86e-09, B: 5.1185436619, C: 5621.7756129076},
+		{A: 2.54e-09, B: 5.77068701321, C: 5813.291189322},
+		{A: 3.11e-09, B: 2.67573060947, C: 912.5702436656},
+		{A: 2.52e-09, B: 4.19548440341, C: 24.858758816},
+		{A: 2.55e-09, B: 1.80023727978, C: 3193.8007401762},
+		{A: 2.47e-09, B: 2.94199704738, C: 310.8407988684},
+		{A: 2.61e-09, B: 5.68285399692, C: 3133.9116894032},
+		{A: 2.55e-09, B: 4.46589323429, C: 5490.300961524},
+		{A: 2.47e-09, B: 2.28207487665, C: 246.251722323},
+		{A: 2.57e-09, B: 5.64335704456, C: 10706.8813343254},
+		{A: 2.46e-09, B: 0.26910281348, C: 22.7684966094},
+		{A: 2.41e-09, B: 0.03931394254, C: 9070.1188738488},
+		{A: 2.83e-09, B: 1.30585259585, C: 4025.6564809258},
+		{A: 2.72e-09, B: 1.28327911416, C: 1442.2612087602},
+		{A: 2.94e-09, B: 4.02082336381, C: 2814.8536148683},
+		{A: 2.49e-09, B: 4.92144829429, C: 31022.7531708562},
+		{A: 2.37e-09, B: 3.07683243726, C: 9947.0556815321},
+		{A: 2.36e-09, B: 6.0576638036, C: 948.1952879016},
+		{A: 3.09e-09, B: 3.45153658664, C: 25287.7237993998},
+		{A: 2.32e-09, B: 4.99538565545, C: 1505.28780909299},
+		{A: 2.75e-09, B: 1.20294105507, C: 6691.8615187494},
+		{A: 3.05e-09, B: 2.73902403412, C: 176.6937301338},
+		{A: 2.31e-09, B: 5.13610567818, C: 6997.6167230562},
+		{A: 2.37e-09, B: 0.90339496046, C: 8.093868069},
+		{A: 2.29e-09, B: 0.6325004797, C: 23017.0626579362},
+		{A: 2.49e-09, B: 6.05357589804, C: 2060.8178540718},
+		{A: 2.7e-09, B: 2.14769161882, C: 2973.3880977374},
+		{A: 2.41e-09, B: 4.63019900075, C: 1612.9513330316},
+		{A: 2.27e-09, B: 5.94537053649, C: 2942.4806769438},
+		{A: 2.69e-09, B: 5.93687481153, C: 4005.3684718298},
+		{A: 2.27e-09, B: 3.91459879933, C: 2942.4461696394},
+		{A: 2.38e-09, B: 5.14570745178, C: 721.1392312062},
+		{A: 2.75e-09, B: 1.06902528739, C: 3343.3639373108},
+		{A: 2.32e-09, B: 3.5211029051, C: 6674.1786167016},
+		{A: 2.77e-09, B: 0.19619249083, C: 270.1974703736},
+		{A: 2.74e-09, B: 0.30235979008, C: 5607.6158292088},
+		{A: 2.33e-09, B: 5.75237138576, C: 604.8497407048},
+		{A: 2.14e-09, B: 3.38626276995, C: 647.0108333148},
+		{A: 2.82e-09, B: 0.29694635625, C: 12323.4230960088},
+		{A: 2.82e-09, B: 3.18114716742, C: 6364.832983743},
+		{A: 2.64e-09, B: 4.64751563064, C: 1346.3442913098},
+		{A: 2.8e-09, B: 4.64995184564, C: 6701.5801727984},
+		{A: 2.46e-09, B: 3.03036675631, C: 3304.0070613956},
+		{A: 2.13e-09, B: 4.80555095681, C: 8830.9133882238},
+		{A: 2.2e-09, B: 1.54642908481, C: 23546.7536230308},
+		{A: 2.14e-09, B: 1.35475764936, C: 184.8499079702},
+		{A: 2.28e-09, B: 4.29420876593, C: 2970.9126107594},
+		{A: 2.29e-09, B: 2.53361265404, C: 3710.3122426402},
+		{A: 2.73e-09, B: 1.89943168433, C: 270.1301600708},
+		{A: 2.84e-09, B: 3.28345980607, C: 16063.164847185},
+		{A: 2.11e-09, B: 5.84341192825, C: 1971.9521738548},
+		{A: 2.14e-09, B: 0.22905754741, C: 5.1991911658},
+		{A: 2.46e-09, B: 4.55971876123, C: 6040.3472460174},
+		{A: 2.29e-09, B: 1.24432891752, C: 3568.0885594888},
+		{A: 2.16e-09, B: 3.2009868012, C: 362.1211367308},
+		{A: 2.01e-09, B: 2.45025935972, C: 20426.571092422},
+		{A: 2.23e-09, B: 2.07804482295, C: 17101.2111369072},
+		{A: 2.71e-09, B: 3.49210901141, C: 3436.5916539176},
+		{A: 2.26e-09, B: 0.42945734871, C: 557.0925211914},
+		{A: 2.64e-09, B: 5.0579494007, C: 2938.9403049426},
+		{A: 2.07e-09, B: 6.03491870748, C: 22487.3716928416},
+		{A: 1.97e-09, B: 5.62923956977, C: 5209.471826336},
+		{A: 2.11e-09, B: 2.26445749553, C: 765.7930644464},
+		{A: 2.02e-09, B: 0.88670673933, C: 767.8488193486},
+		{A: 2.12e-09, B: 1.85965510753, C: 4782.87363546},
+		{A: 1.94e-09, B: 4.77420671032, C: 6850.8050365326},
+		{A: 1.92e-09, B: 0.51565572072, C: 323.5054166574},
+		{A: 2.09e-09, B: 4.79794980231, C: 14047.4937610252},
+		{A: 1.93e-09, B: 2.56057288512, C: 18606.4989460002},
+		{A: 2.09e-09, B: 1.46088434217, C: 5.8572022996},
+		{A: 2.14e-09, B: 0.97578687412, C: 17468.8551979454},
+		{A: 1.96e-09, B: 4.08789826861, C: 3262.8618827159},
+		{A: 1.98e-09, B: 3.9911121623, C: 10018.2468514476},
+		{A: 2.35e-09, B: 1.11133081576, C: 625.6701923124},
+		{A: 1.97e-09, B: 0.48692287381, C: 15106.8756912144},
+		{A: 2.14e-09, B: 5.33581901309, C: 955.2415245996},
+		{A: 1.99e-09, B: 0.40088144456, C: 7586.6815500644},
+		{A: 2.51e-09, B: 5.57906426342, C: 138.5881983844},
+		{A: 2.08e-09, B: 4.11480858445, C: 1755.062649765},
+		{A: 2.1e-09, B: 2.30499661227, C: 53.3079462838},
+		{A: 2.53e-09, B: 1.27099771143, C: 309.2783226558},
+		{A: 2.42e-09, B: 3.71426781511, C: 3212.5935833624},
+		{A: 1.78e-09, B: 3.06961783443, C: 1437.1756141986},
+		{A: 2.03e-09, B: 0.28410501881, C: 582.9989113784},
+		{A: 1.9e-09, B: 0.10457198807, C: 26087.9031415742},
+		{A: 1.78e-09, B: 0.53068485806, C: 7366.2689076256},
+		{A: 2.45e-09, B: 4.20195166994, C: 8436.2875031646},
+		{A: 1.76e-09, B: 3.66697456425, C: 6688.2710900976},
+		{A: 1.77e-09, B: 4.48104044431, C: 8799.988713778},
+		{A: 1.81e-09, B: 3.57461078885, C: 15121.102785216},
+		{A: 1.98e-09, B: 3.55065962909, C: 24606.13555322},
+		{A: 1.87e-09, B: 3.45440079747, C: 1329.51209026},
+		{A: 1.76e-09, B: 5.81532365473, C: 12406.1605508286},
+		{A: 2.35e-09, B: 1.28568802052, C: 4427.3959129858},
+		{A: 1.79e-09, B: 1.55718466444, C: 3362.4632560262},
+		{A: 1.74e-09, B: 1.61086801253, C: 30065.5118402982},
+		{A: 1.99e-09, B: 0.07164714815, C: 375.7657315702},
+		{A: 1.74e-09, B: 4.58412775793, C: 3283.7140517642},
+		{A: 1.71e-09, B: 5.82189798695, C: 23937.856389741},
+		{A: 1.82e-09, B: 2.57146189845, C: 418.504322807},
+		{A: 1.68e-09, B: 5.13131619552, C: 21393.5419698576},
+		{A: 1.83e-09, B: 4.9316196205, C: 9468.267877257},
+		{A: 1.67e-09, B: 1.48091400654, C: 2619.4731954936},
+		{A: 1.69e-09, B: 5.12437031125, C: 3223.2925584796},
+		{A: 1.88e-09, B: 3.41823914376, C: 5032.7780962022},
+		{A: 1.81e-09, B: 0.50010974122, C: 2125.8774073792},
+		{A: 1.64e-09, B: 1.71077130702, C: 15849.865751747},
+		{A: 2.02e-09, B: 6.21085922593, C: 3909.4343007272},
+		{A: 1.71e-09, B: 5.86158194602, C: 625.6251361972},
+		{A: 1.65e-09, B: 3.8556911822, C: 13207.029307365},
+		{A: 1.63e-09, B: 2.0437336743, C: 3347.2960905739},
+		{A: 1.6e-09, B: 2.83784244321, C: 5888.4499649322},
+		{A: 2.12e-09, B: 2.32801112252, C: 3232.9489027612},
+		{A: 1.63e-09, B: 4.23488695195, C: 31968.9486527994},
+		{A: 1.59e-09, B: 1.48046671186, C: 249.9044607422},
+		{A: 1.6e-09, B: 0.21960307161, C: 12942.965062924},
+		{A: 2.2e-09, B: 3.90787704883, C: 9638.9407478762},
+		{A: 1.61e-09, B: 2.94093367568, C: 3370.0419352358},
+		{A: 1.59e-09, B: 5.59017475732, C: 1442.2784624124},
+		{A: 2.21e-09, B: 3.82548751198, C: 1954.7171503636},
+		{A: 1.92e-09, B: 4.62595272276, C: 3336.6802734367},
+		{A: 1.64e-09, B: 1.59200641542, C: 386.4196505722},
+		{A: 1.69e-09, B: 5.45784867095, C: 259.5608050238},
+		{A: 2.01e-09, B: 0.95077053594, C: 29424.634232916},
+		{A: 1.74e-09, B: 1.15216485688, C: 6382.0984591304},
+		{A: 1.63e-09, B: 1.16606667991, C: 4289.7880356696},
+		{A: 1.87e-09, B: 3.2213595646, C: 2751.5475996916},
+		{A: 1.62e-09, B: 4.79372588575, C: 24889.5747959916},
+		{A: 1.92e-09, B: 0.88040946364, C: 5244.049239201},
+		{A: 1.6e-09, B: 2.8503478437, C: 9374.8264467846},
+		{A: 1.72e-09, B: 2.38601254063, C: 2281.2477501628},
+		{A: 2.02e-09, B: 4.12164786769, C: 7321.1221397136},
+		{A: 1.56e-09, B: 4.55171204694, C: 27682.1407441564},
+		{A: 1.7e-09, B: 4.62851491273, C: 10824.2012025456},
+		{A: 1.57e-09, B: 0.91363725609, C: 4503.0871311076},
+		{A: 1.56e-09, B: 5.58255618318, C: 10448.4354709754},
+		{A: 1.57e-09, B: 0.92229160815, C: 15636.566656309},
+		{A: 1.71e-09, B: 5.43820628341, C: 11904.9187732018},
+		{A: 1.8e-09, B: 1.89091405241, C: 13575.7488022372},
+		{A: 1.98e-09, B: 4.42539692212, C: 10025.4277087512},
+		{A: 1.58e-09, B: 4.12087914642, C: 11240.1627280718},
+		{A: 2.11e-09, B: 2.42218772392, C: 8749.1562544722},
+		{A: 1.83e-09, B: 1.47934835951, C: 6677.3435180416},
+		{A: 1.97e-09, B: 1.43469282909, C: 14061.7208550268},
+		{A: 1.7e-09, B: 4.32424842659, C: 742.9900605326},
+		{A: 1.7e-09, B: 2.92905324873, C: 9093.9973115966},
+		{A: 1.6e-09, B: 2.79797608932, C: 1461.0540519464},
+		{A: 1.74e-09, B: 5.37738922471, C: 3318.7615973734},
+		{A: 1.72e-09, B: 0.35524089578, C: 2281.2132428584},
+		{A: 1.47e-09, B: 2.76602235522, C: 4193.8088084518},
+		{A: 1.51e-09, B: 4.23962231148, C: 3368.0139827966},
+		{A: 1.57e-09, B: 1.01295201512, C: 24336.0053931492},
+		{A: 1.65e-09, B: 0.58335652806, C: 15906.7641266826},
+		{A: 1.49e-09, B: 5.69553079999, C: 31570.7996493912},
+		{A: 1.54e-09, B: 5.2850438151, C: 1481.4093713452},
+		{A: 1.63e-09, B: 1.8128862851, C: 18849.2275499742},
+		{A: 1.45e-09, B: 3.57053080979, C: 3497.0131472022},
+		{A: 1.56e-09, B: 3.56978076425, C: 21791.69097326579},
+		{A: 1.43e-09, B: 0.56704903096, C: 13212.8865096646},
+		{A: 1.55e-09, B: 0.08429188155, C: 6657.3464156518},
+		{A: 1.84e-09, B: 2.54999403339, C: 24076.4445881254},
+		{A: 1.46e-09, B: 1.16594990123, C: 526.1678467456},
+		{A: 1.44e-09, B: 4.19229335185, C: 2771.7905526724},
+		{A: 1.69e-09, B: 3.10771037057, C: 239.205485625},
+		{A: 1.44e-09, B: 6.26688878164, C: 6679.7403806913},
+		{A: 1.74e-09, B: 2.3750902528, C: 3397.5108016354},
+		{A: 1.63e-09, B: 3.88047919138, C: 16703.0448798468},
+		{A: 1.61e-09, B: 1.73958633312, C: 2185.2512692928},
+		{A: 1.64e-09, B: 0.6214699473, C: 1538.240435978},
+		{A: 1.82e-09, B: 6.16856014864, C: 6685.1061887576},
+		{A: 1.4e-09, B: 4.08200595943, C: 4186.695261451},
+		{A: 1.92e-09, B: 4.47709998867, C: 57.8786960038},
+		{A: 1.51e-09, B: 3.06451266512, C: 838.9692877504},
+		{A: 1.39e-09, B: 1.12767399649, C: 6682.7093261079},
+		{A: 1.87e-09, B: 4.97174626997, C: 6681.6547365263},
+		{A: 1.5e-09, B: 5.69209290362, C: 9360.6043533184},
+		{A: 1.6e-09, B: 5.66336382679, C: 10927.2939767642},
+		{A: 1.37e-09, B: 3.46860408347, C: 5562.4690612968},
+		{A: 1.92e-09, B: 5.27908098216, C: 34363.365597556},
+		{A: 1.37e-09, B: 5.87023088486, C: 2945.9865416406},
+		{A: 1.8e-09, B: 1.07686767816, C: 15806.146839442},
+		{A: 1.35e-09, B: 0.34109799474, C: 7322.1024607818},
+		{A: 1.69e-09, B: 2.2740858315, C: 379.3734138742},
+		{A: 1.72e-09, B: 3.83276777655, C: 6621.850991486},
+		{A: 1.34e-09, B: 0.8948661109, C: 13286.1836355236},
+		{A: 1.68e-09, B: 3.24434245132, C: 11614.4332937322},
+		{A: 1.35e-09, B: 6.03727673544, C: 1214.8023296234},
+		{A: 1.5e-09, B: 0.81912738038, C: 3416.8784979754},
+		{A: 1.36e-09, B: 5.06627117467, C: 14421.8316369884},
+		{A: 1.38e-09, B: 5.19336961955, C: 13363.4300278674},
+		{A: 1.63e-09, B: 5.91124428824, C: 16703.07938715119},
+		{A: 1.81e-09, B: 3.31978654659, C: 139.6981395228},
+		{A: 1.62e-09, B: 4.05905033891, C: 1795.258443721},
+		{A: 1.32e-09, B: 0.409015061, C: 1083.260367937},
+		{A: 1.56e-09, B: 0.1230414464, C: 1107.1388056848},
+		{A: 1.58e-09, B: 6.25022915072, C: 6666.997759398},
+		{A: 1.31e-09, B: 3.95250083817, C: 3.4558080462},
+		{A: 1.47e-09, B: 4.94673462086, C: 15010.8964639966},
+		{A: 1.4e-09, B: 6.13853404667, C: 12729.665967486},
+		{A: 1.31e-09, B: 5.43398849473, C: 25665.5174834092},
+		{A: 1.35e-09, B: 5.06017183369, C: 11876.469585734},
+		{A: 1.28e-09, B: 2.8696933273, C: 6549.6828917132},
+		{A: 1.74e-09, B: 5.18169887171, C: 28230.18722269139},
+		{A: 1.79e-09, B: 3.25589354429, C: 4922.5717749828},
+		{A: 1.39e-09, B: 5.33624615245, C: 23958.6317852334},
+		{A: 1.27e-09, B: 5.78406495652, C: 14577.1847261198},
+		{A: 1.28e-09, B: 1.27276688747, C: 8584.6616659008},
+		{A: 1.33e-09, B: 0.39052474466, C: 12410.7313005486},
+		{A: 1.34e-09, B: 1.09641189843, C: 8962.438096258},
+		{A: 1.25e-09, B: 3.20445201519, C: 29.8820436102},
+		{A: 1.36e-09, B: 3.62250122667, C: 3511.285297319},
+		{A: 1.62e-09, B: 5.36465149996, C: 2472.6787626222},
+		{A: 1.5e-09, B: 0.40179894287, C: 1111.642245102},
+		{A: 1.29e-09, B: 1.94909076932, C: 19645.5255567906},
+		{A: 1.72e-09, B: 5.21328160366, C: 6747.712262314},
+		{A: 1.26e-09, B: 4.39378462616, C: 21548.9623692918},
+		{A: 1.46e-09, B: 3.85987749053, C: 13361.469385731},
+		{A: 1.56e-09, B: 5.24740844581, C: 6645.1969867222},
+		{A: 1.37e-09, B: 4.94588047257, C: 20995.3929664494},
+		{A: 1.31e-09, B: 1.72431469184, C: 11925.2740926006},
+		{A: 1.36e-09, B: 2.04268940441, C: 10654.6210194126},
+		{A: 1.4e-09, B: 1.54439148652, C: 8219.4652893776},
+		{A: 1.27e-09, B: 6.16695075115, C: 10016.314355792},
+		{A: 1.55e-09, B: 5.47145482987, C: 2.9689454166},
+		{A: 1.32e-09, B: 6.25626202928, C: 13227.3846267638},
+		{A: 1.21e-09, B: 3.74135485334, C: 10294.510237451},
+		{A: 1.34e-09, B: 3.12718351732, C: 8962.4726035624},
+		{A: 1.22e-09, B: 0.77282907794, C: 4936.7988689844},
+		{A: 1.25e-09, B: 4.23213631052, C: 15650.7937503106},
+		{A: 1.57e-09, B: 1.79175545524, C: 16489.763038061},
+		{A: 1.55e-09, B: 4.15655698197, C: 56.3831860762},
+		{A: 1.34e-09, B: 1.53268827347, C: 708.98980227659},
+		{A: 1.36e-09, B: 4.01025697673, C: 9797.4924843975},
+		{A: 1.56e-09, B: 4.6155626882, C: 3354.8395207014},
+		{A: 1.31e-09, B: 4.47476808853, C: 11776.8999298644},
+		{A: 1.18e-09, B: 1.17466010141, C: 4606.1799053262},
+		{A: 1.28e-09, B: 0.12648796247, C: 6681.6449294932},
+		{A: 1.22e-09, B: 0.1351137794, C: 8322.5580635962},
+		{A: 1.19e-09, B: 1.2913911635, C: 10544.4146981932},
+		{A: 1.28e-09, B: 4.4496605074, C: 13465.5424810178},
+		{A: 1.19e-09, B: 4.0170962999, C: 14481.205498902},
+		{A: 1.47e-09, B: 1.8159027965, C: 685.1113645288},
+		{A: 1.37e-09, B: 4.78402836071, C: 3613.2853840514},
+		{A: 1.51e-09, B: 1.48428558337, C: 9698.331863442},
+		{A: 1.36e-09, B: 2.47757608387, C: 10156.9023601348},
+		{A: 1.23e-09, B: 2.42353206298, C: 6.1332259326},
+		{A: 1.17e-09, B: 5.40635440024, C: 688.6344828778},
+		{A: 1.53e-09, B: 5.00372030984, C: 14556.8967170238},
+		{A: 1.33e-09, B: 3.15773785434, C: 1125.8693391036},
+		{A: 1.53e-09, B: 1.94292660454, C: 6533.1461289733},
+		{A: 1.24e-09, B: 4.94608245253, C: 12825.6451947038},
+		{A: 1.17e-09, B: 1.13528750738, C: 4452.2546718018},
+		{A: 1.15e-09, B: 3.34121813462, C: 10001.48196070061},
+		{A: 1.15e-09, B: 3.44586362144, C: 7696.8878712838},
+		{A: 1.17e-09, B: 0.72489390402, C: 27.4688663996},
+		{A: 1.32e-09, B: 2.41318250916, C: 6717.252720077},
+		{A: 1.18e-09, B: 0.35995031424, C: 27832.0382192832},
+		{A: 1.57e-09, B: 1.73929012934, C: 11560.0777160774},
+		{A: 1.48e-09, B: 2.14023976341, C: 15265.8865193004},
+		{A: 1.13e-09, B: 1.29183863745, C: 26880.3198130326},
+		{A: 1.51e-09, B: 0.95890610457, C: 6530.1771835567},
+		{A: 1.53e-09, B: 5.62679784282, C: 9911.63095888},
+		{A: 1.57e-09, B: 0.09791378159, C: 138.5174968707},
+		{A: 1.19e-09, B: 2.16883964744, C: 7082.8969751568},
+		{A: 1.13e-09, B: 5.43102427558, C: 422.027441156},
+		{A: 1.3e-09, B: 2.13493506322, C: 117.812802892},
+		{A: 1.4e-09, B: 2.5119459163, C: 28.9816299794},
+		{A: 1.28e-09, B: 1.238227512, C: 6680.804777306},
+		{A: 1.11e-09, B: 4.60294776614, C: 25135.8265183146},
+		{A: 1.18e-09, B: 0.34226497704, C: 4140.4335518652},
+		{A: 1.12e-09, B: 4.02233737541, C: 6314.0005244372},
+		{A: 1.34e-09, B: 3.3592621473, C: 11247.2089647698},
+		{A: 1.2e-09, B: 6.09430372868, C: 4106.4054911462},
+		{A: 1.17e-09, B: 0.03006245187, C: 7.6737919754},
+		{A: 1.09e-09, B: 3.62630036582, C: 3344.11829139661},
+		{A: 1.09e-09, B: 1.94277745743, C: 16702.9948231962},
+		{A: 1.14e-09, B: 4.87315937546, C: 6151.5166346528},
+		{A: 1.09e-09, B: 5.65707270595, C: 3344.152798701},
+		{A: 1.08e-09, B: 0.72637519191, C: 10.569355047},
+		{A: 1.28e-09, B: 5.77326416186, C: 13369.5632538},
+		{A: 1.47e-09, B: 4.73848506543, C: 11610.9101753832},
+		{A: 1.45e-09, B: 4.95495312886, C: 9859.3706439672},
+		{A: 1.11e-09, B: 3.28808788953, C: 257.018007743},
+		{A: 1.12e-09, B: 4.79777726412, C: 12140.6011404778},
+		{A: 1.09e-09, B: 3.73199687068, C: 13635.1226641508},
+		{A: 1.17e-09, B: 1.20057736014, C: 6705.1032911474},
+		{A: 1.49e-09, B: 4.91688353237, C: 277.0349937414},
+		{A: 1.08e-09, B: 3.37299798972, C: 6717.8302187038},
+		{A: 1.06e-09, B: 0.53379407701, C: 5085.1057214178},
+		{A: 1.25e-09, B: 4.62770076269, C: 9329.6796788726},
+		{A: 1.07e-09, B: 4.60288570375, C: 1957.7250798532},
+		{A: 1.13e-09, B: 4.04786119569, C: 6953.8978107512},
+		{A: 1.06e-09, B: 0.75378922686, C: 149.8974751268},
+		{A: 1.14e-09, B: 0.62074562387, C: 6151.5511419572},
+		{A: 1.08e-09, B: 5.22204692781, C: 1556.5681469554},
+		{A: 1.04e-09, B: 1.02502614223, C: 4878.8528626778},
+		{A: 1.33e-09, B: 1.15811543786, C: 816.6533262152},
+		{A: 1.34e-09, B: 1.69834857217, C: 183.869586902},
+		{A: 1.04e-09, B: 2.58832774275, C: 14591.4118201214},
+		{A: 1.07e-09, B: 4.42117075795, C: 7.130800653},
+		{A: 1.26e-09, B: 0.03750399181, C: 16.83220104979},
+		{A: 1.02e-09, B: 1.20624870972, C: 11986.6759069534},
+		{A: 1.02e-09, B: 2.97579561878, C: 29822.7832363242},
+		{A: 1.16e-09, B: 4.73049067619, C: 13366.040135451},
+		{A: 1.3e-09, B: 4.10484632841, C: 35.6077905838},
+		{A: 1.13e-09, B: 3.69200525056, C: 44.6538332402},
+		{A: 1.11e-09, B: 5.12900291511, C: 146.7944328714},
+		{A: 1.26e-09, B: 1.82187459534, C: 26887.3660497306},
+		{A: 1.21e-09, B: 6.08309355316, C: 7100.7094972748},
+		{A: 1.05e-09, B: 6.16606590276, C: 9175.7544453482},
+		{A: 1.32e-09, B: 2.97955203077, C: 1250.8301963008},
+		{A: 1.06e-09, B: 5.08419485009, C: 8742.0427074714},
+		{A: 1.2e-09, B: 0.59116561391, C: 31172.650645983},
+		{A: 1.12e-09, B: 3.70888430091, C: 10050.2864675672},
+		{A: 1e-09, B: 1.82077956276, C: 12012.5822971404},
+		{A: 1.07e-09, B: 4.86100312387, C: 7899.550301372},
+		{A: 9.9e-10, B: 0.72997085174, C: 18977.1790830088},
+		{A: 1.07e-09, B: 5.25025570307, C: 63.7358983034},
+		{A: 9.9e-10, B: 2.79139996703, C: 17932.0242468212},
+		{A: 9.7e-10, B: 4.94370173613, C: 28109.214616052},
+		{A: 1.22e-09, B: 4.18676567644, C: 19617.07636932279},
+		{A: 1.21e-09, B: 3.14207351411, C: 9278.8472195668},
+		{A: 1.12e-09, B: 1.43537110436, C: 16232.745030318},
+		{A: 9.6e-10, B: 2.57929371627, C: 12715.4388734844},
+		{A: 9.6e-10, B: 2.51396484677, C: 3535.5838111604},
+		{A: 1.12e-09, B: 6.19137312195, C: 6685.1570066627},
+		{A: 1.09e-09, B: 1.53428110338, C: 16703.1294438018},
+		{A: 9.6e-10, B: 4.29245810274, C: 7906.59653807},
+		{A: 9.6e-10, B: 3.08847844641, C: 3468.6312700372},
+		{A: 9.5e-10, B: 6.091860847, C: 10037.0897512844},
+		{A: 9.4e-10, B: 1.79812841802, C: 20047.1976785478},
+		{A: 1.1e-09, B: 4.24859410294, C: 3337.0720546986},
+		{A: 9.3e-10, B: 2.17945548408, C: 16066.0658614748},
+		{A: 1.15e-09, B: 5.44548079869, C: 11353.8921676402},
+		{A: 9.8e-10, B: 1.89605054783, C: 33406.124266998},
+		{A: 1.01e-09, B: 3.00793005829, C: 3528.5375744624},
+		{A: 9.6e-10, B: 5.4065991242, C: 5749.8617665478},
+		{A: 1.13e-09, B: 3.93443805059, C: 7167.1969061892},
+		{A: 9.2e-10, B: 3.58985964414, C: 6724.9437657046},
+		{A: 1.19e-09, B: 3.9056157026, C: 11346.7786206394},
+		{A: 9.7e-10, B: 2.5728079023, C: 1747.6073781529},
+		{A: 1.18e-09, B: 3.82111723459, C: 9072.6616711296},
+		{A: 9.6e-10, B: 0.72646224606, C: 3152.68727893721},
+		{A: 9.2e-10, B: 6.23928508055, C: 24491.4257925834},
+		{A: 9.9e-10, B: 1.86991802881, C: 72.0557333548},
+		{A: 9.4e-10, B: 1.51091806861, C: 9801.4246376606},
+		{A: 9.1e-10, B: 4.94377681711, C: 8756.269801473},
+		{A: 9.5e-10, B: 2.58771497236, C: 11663.170490296},
+		{A: 9.3e-10, B: 0.6609244332, C: 30.9919847486},
+		{A: 8.8e-10, B: 2.47386826588, C: 17232.7530985936},
+		{A: 1e-09, B: 0.19616913512, C: 11883.5831327348},
+		{A: 9.4e-10, B: 0.61277732908, C: 73.6354632196},
+		{A: 9.1e-10, B: 6.04139177526, C: 153.9252335244},
+		{A: 9.5e-10, B: 0.30865798871, C: 29168.5965462412},
+		{A: 1.1e-09, B: 6.27936643935, C: 3337.106562003},
+		{A: 8.7e-10, B: 2.09287965409, C: 10264.5658840734},
+		{A: 8.9e-10, B: 3.27633365389, C: 3002.3697277168},
+		{A: 9.1e-10, B: 1.89622049447, C: 37895.4262903674},
+		{A: 1.18e-09, B: 3.88268323672, C: 1236.075660323},
+		{A: 9.4e-10, B: 2.2781906199, C: 4845.9002357928},
+		{A: 8.5e-10, B: 3.07438636612, C: 9983.7042444616},
+		{A: 8.6e-10, B: 3.94463425808, C: 16.764890747},
+		{A: 9.9e-10, B: 4.58890225582, C: 3774.3241645766},
+		{A: 8.6e-10, B: 1.27758598252, C: 34513.2630726828},
+		{A: 1.18e-09, B: 6.10625309129, C: 454.9093665273},
+		{A: 9.6e-10, B: 6.08772700197, C: 1755.4716846791},
+		{A: 8.5e-10, B: 4.73193631802, C: 4576.6880870228},
+		{A: 1.16e-09, B: 0.88573303872, C: 16511.6138673874},
+		{A: 8.3e-10, B: 3.13948373813, C: 10191.4174632324},
+		{A: 8.3e-10, B: 0.36972674664, C: 9755.2302383776},
+		{A: 1.12e-09, B: 2.26524635842, C: 10006.5848089144},
+		{A: 8.7e-10, B: 4.25461508452, C: 8.1681365824},
+		{A: 8.7e-10, B: 5.31716927554, C: 1868.8593996362},
+		{A: 1.15e-09, B: 3.3050783005, C: 14157.7000822446},
+		{A: 8.7e-10, B: 2.90507350729, C: 36.995590611},
+		{A: 8.3e-10, B: 3.58400049244, C: 12928.7379689224},
+		{A: 8.6e-10, B: 2.46839611271, C: 113.7294395684},
+		{A: 9.6e-10, B: 2.99829695985, C: 6510.5519827804},
+		{A: 8.4e-10, B: 1.34333260788, C: 4133.3873151672},
+		{A: 9.3e-10, B: 2.41895388985, C: 20040.15144184979},
+		{A: 8.5e-10, B: 5.92093268818, C: 12299.544658261},
+		{A: 8.2e-10, B: 4.71321236549, C: 7733.493236588},
+		{A: 8.1e-10, B: 5.26971110105, C: 12199.9750023914},
+		{A: 8e-10, B: 4.34410099751, C: 7218.029365495},
+		{A: 9.5e-10, B: 0.02613500045, C: 2.9010142898},
+		{A: 9.4e-10, B: 6.22018441621, C: 20043.69181385099},
+		{A: 8.5e-10, B: 4.66573809999, C: 8638.9499332528},
+		{A: 7.9e-10, B: 2.89839604813, C: 22854.596021804},
+		{A: 1.04e-09, B: 0.94882888784, C: 2735.762685995},
+		{A: 8e-10, B: 1.68097745917, C: 12839.8722887054},
+		{A: 8.5e-10, B: 1.95515305721, C: 4686.95671800959},
+		{A: 9.4e-10, B: 0.58147434179, C: 14128.2427712456},
+		{A: 9.1e-10, B: 1.16317999954, C: 3495.9655158312},
+		{A: 8.4e-10, B: 4.04104644774, C: 9815.6517316622},
+		{A: 8.2e-10, B: 1.83662951627, C: 19190.4781784468},
+		{A: 8e-10, B: 4.04733274699, C: 3188.7151456146},
+		{A: 8.4e-10, B: 2.71620952175, C: 12089.768681172},
+		{A: 8e-10, B: 2.7736315821, C: 9495.6694333538},
+		{A: 7.8e-10, B: 5.77285430206, C: 10397.6030116696},
+		{A: 8.4e-10, B: 0.78188080031, C: 7214.4389368432},
+		{A: 9.4e-10, B: 2.14762315943, C: 13532.0298899322},
+		{A: 1.01e-09, B: 5.76349087526, C: 1484.9324896942},
+		{A: 8.2e-10, B: 3.11421789241, C: 8446.9914788172},
+		{A: 9.6e-10, B: 5.85152670536, C: 3492.509707785},
+		{A: 7.9e-10, B: 4.69625309523, C: 5298.337506553},
+		{A: 9.7e-10, B: 3.97960395898, C: 18208.349942592},
+		{A: 1.05e-09, B: 1.74376904536, C: 36.6485629295},
+		{A: 9.4e-10, B: 4.18941238607, C: 20043.6573065466},
+		{A: 7.5e-10, B: 3.46629875063, C: 13341.6743113068},
+		{A: 9.2e-10, B: 2.67278833288, C: 6680.7949702729},
+		{A: 7.5e-10, B: 4.59723160021, C: 9993.3880926316},
+		{A: 8.7e-10, B: 0.91979096638, C: 8425.7181481176},
+		{A: 7.4e-10, B: 0.42228487063, C: 48429.2821823244},
+		{A: 7.4e-10, B: 0.48470953224, C: 11456.9849418588},
+		{A: 7.7e-10, B: 0.10707484151, C: 1371.411763456},
+		{A: 9.3e-10, B: 1.38010528069, C: 3145.6410422392},
+		{A: 7.5e-10, B: 5.2806125707, C: 12306.590894959},
+		{A: 7.9e-10, B: 3.7866638604, C: 7.5786792096},
+		{A: 7.3e-10, B: 4.42902162523, C: 14.7372823256},
+		{A: 8.4e-10, B: 3.41538432898, C: 6637.5059410946},
+		{A: 9.3e-10, B: 3.41302342683, C: 1596.1191319818},
+		{A: 7.1e-10, B: 3.13537213242, C: 9488.6231966558},
+		{A: 7.9e-10, B: 2.33442828498, C: 12465.5344127422},
+		{A: 7.8e-10, B: 2.21525134547, C: 2810.988771908},
+		{A: 9.9e-10, B: 0.48801369293, C: 17499.3601403154},
+		{A: 7.5e-10, B: 4.10299895268, C: 5525.8636959926},
+		{A: 7.1e-10, B: 4.04186021414, C: 259.493494721},
+		{A: 7.3e-10, B: 2.20102876718, C: 30774.5016425748},
+		{A: 7.4e-10, B: 4.93439968296, C: 13517.8027959306},
+		{A: 7.1e-10, B: 5.32625652676, C: 6670.1791531357},
+		{A: 7.8e-10, B: 2.17664185326, C: 13421.8235687128},
+		{A: 8.8e-10, B: 5.38469204503, C: 17762.4440636882},
+		{A: 8.1e-10, B: 2.48353174238, C: 73.6009559152},
+		{A: 9.2e-10, B: 1.1308844819, C: 7747.7203305896},
+		{A: 7e-10, B: 1.16361323015, C: 5220.1708014532},
+		{A: 8.4e-10, B: 5.8322038791, C: 22.3782713026},
+		{A: 7.6e-10, B: 5.21065604639, C: 4271.9755135516},
+		{A: 8e-10, B: 4.01817623627, C: 3311.18291816379},
+		{A: 6.8e-10, B: 2.4593769794, C: 6279.5527316424},
+		{A: 6.7e-10, B: 4.31723563048, C: 2281.2978068134},
+		{A: 6.7e-10, B: 4.88776321797, C: 4379.6390374902},
+		{A: 6.9e-10, B: 3.57006764536, C: 14955.045720432},
+		{A: 7.8e-10, B: 2.62374773232, C: 2810.8541513024},
+		{A: 6.7e-10, B: 4.76301301456, C: 6812.766815086},
+		{A: 7e-10, B: 5.60319851277, C: 13383.2251022916},
+		{A: 7e-10, B: 3.05647570196, C: 10081.211142013},
+		{A: 8.1e-10, B: 4.91537623765, C: 3333.9287628257},
+		{A: 8.2e-10, B: 5.4920498711, C: 5483.254724826},
+		{A: 6.5e-10, B: 3.50466797067, C: 9161.5273513466},
+		{A: 6.5e-10, B: 2.79212460026, C: 13497.5147868346},
+		{A: 7e-10, B: 0.93640431668, C: 6106.8800550648},
+		{A: 7.3e-10, B: 2.57583496978, C: 6614.7374444852},
+		{A: 7e-10, B: 0.8417007058, C: 16695.94858649819},
+		{A: 6.9e-10, B: 1.10040627005, C: 2544.3316735356},
+		{A: 6.3e-10, B: 6.05841309176, C: 6453.7487206106},
+		{A: 6.9e-10, B: 2.67053540192, C: 2641.3412784722},
+		{A: 8.6e-10, B: 1.73112264739, C: 6286.5989683404},
+		{A: 6.2e-10, B: 5.49002783256, C: 4957.0868780804},
+		{A: 7.2e-10, B: 3.38857910283, C: 3184.2117061974},
+		{A: 6.9e-10, B: 5.35281990892, C: 2544.2971662312},
+		{A: 6.4e-10, B: 4.79175268505, C: 3181.6689089166},
+		{A: 6e-10, B: 6.18449904353, C: 3361.9480671668},
+		{A: 6.5e-10, B: 1.48236627574, C: 4819.4790007642},
+		{A: 5.4e-10, B: 3.66018290396, C: 2917.5373541728},
+		{A: 6.4e-10, B: 4.72286914683, C: 2281.1631862078},
+		{A: 5.3e-10, B: 4.65521663671, C: 3448.2759506384},
+		{A: 6e-10, B: 0.77450276964, C: 3319.2767862328},
+		{A: 5.1e-10, B: 1.45142985881, C: 3547.3131639964},
+		{A: 5.1e-10, B: 4.81258158513, C: 4403.517475238},
+		{A: 5e-10, B: 0.37366902835, C: 3322.7999045818},
+		{A: 5e-10, B: 4.68787711774, C: 3253.30422216},
+		{A: 6.7e-10, B: 4.63578431535, C: 4694.0029547076},
+		{A: 4.9e-10, B: 3.17471347671, C: 5452.2627400774},
+		{A: 5.1e-10, B: 2.54791773443, C: 4001.8453534808},
+		{A: 4.8e-10, B: 2.81171553464, C: 3372.5847325166},
+		{A: 5.4e-10, B: 4.45945188023, C: 10284.9212034722},
+		{A: 5.3e-10, B: 3.8513121352, C: 9758.7533567266},
+		{A: 5.1e-10, B: 1.11244863072, C: 6901.6374958384},
+		{A: 6.2e-10, B: 1.4219800963, C: 6830.7880505342},
+		{A: 5.5e-10, B: 5.6167298818, C: 45494.58142974879},
+		{A: 5.4e-10, B: 0.61701959453, C: 4716.3862265456},
+		{A: 5.4e-10, B: 4.28556326526, C: 2910.4911174748},
+		{A: 4.5e-10, B: 3.14626081312, C: 13951.5145338074},
+		{A: 5e-10, B: 4.16235480519, C: 12979.553174576},
+		{A: 4.6e-10, B: 1.66182208107, C: 7768.0083396856},
+		{A: 4.5e-10, B: 5.07701425888, C: 14951.522602083},
+		{A: 4.3e-10, B: 4.3172345953, C: 5881.4037282342},
+		{A: 4.5e-10, B: 2.39713581676, C: 8852.2490286908},
+		{A: 4.3e-10, B: 2.10202660495, C: 3337.1566186536},
+		{A: 4.8e-10, B: 0.19934255781, C: 1905.4647649404},
+		{A: 5.6e-10, B: 5.25687695855, C: 12808.8803039568},
+		{A: 4.9e-10, B: 2.06320966659, C: 4296.9015826704},
+		{A: 4.2e-10, B: 6.07282126953, C: 4295.8539512994},
+		{A: 4.3e-10, B: 1.7457161378, C: 3130.8364496108},
+		{A: 5e-10, B: 2.27112469544, C: 3427.9206312396},
+		{A: 5e-10, B: 0.30214747765, C: 3358.4249488178},
+		{A: 4.3e-10, B: 5.05297177283, C: 3229.4257844122},
+		{A: 5.4e-10, B: 6.11998016428, C: 16894.5103996106},
+		{A: 4.3e-10, B: 1.90603004035, C: 3451.7990689874},
+		{A: 3.9e-10, B: 0.50727886431, C: 10011.2006147496},
+		{A: 5.2e-10, B: 3.17851184154, C: 2324.9494088156},
+		{A: 3.8e-10, B: 4.88249540246, C: 3499.555944483},
+		{A: 3.9e-10, B: 5.28113083211, C: 3343.1552239806},
+		{A: 3.9e-10, B: 4.98185580267, C: 3468.5639597344},
+		{A: 4.7e-10, B: 2.16244218985, C: 10027.3602044068},
+		{A: 3.7e-10, B: 5.84953090935, C: 6791.431174619},
+		{A: 4.8e-10, B: 5.03779546898, C: 7064.1041319706},
+		{A: 3.8e-10, B: 0.58076516432, C: 8966.3875031733},
+		{A: 5.1e-10, B: 3.36181117037, C: 8976.6824439118},
+		{A: 4.2e-10, B: 4.13725767158, C: 8336.7851575978},
+		{A: 3.8e-10, B: 5.75425648893, C: 4531.5363185754},
+		{A: 3.8e-10, B: 3.55681323215, C: 5351.7127631396},
+		{A: 4e-10, B: 2.79414141035, C: 3067.9394693482},
+		{A: 4e-10, B: 4.99798468494, C: 10042.1925994982},
+		{A: 3.7e-10, B: 1.82286411009, C: 10017.9559447414},
+		{A: 3.6e-10, B: 6.02115369796, C: 6474.524116103},
+		{A: 4.4e-10, B: 3.01299253859, C: 7417.1013669314},
+		{A: 4.5e-10, B: 2.33470159894, C: 3123.7902129128},
+		{A: 3.9e-10, B: 4.91767933978, C: 8034.6153814074},
+	},
+	// L1, 686 terms
+	{
+		{A: 3340.85627474342, B: 0, C: 0},
+		{A: 0.01458227051, B: 3.60426053609, C: 3340.6124266998},
+		{A: 0.00164901343, B: 3.92631250962, C: 6681.2248533996},
+		{A: 0.00019963338, B: 4.2659406103, C: 10021.8372800994},
+		{A: 3.452399e-05, B: 4.73210386365, C: 3.523118349},
+		{A: 2.48548e-05, B: 4.61277567318, C: 13362.4497067992},
+		{A: 8.41551e-06, B: 4.45858256765, C: 2281.2304965106},
+		{A: 5.37566e-06, B: 5.01589727492, C: 398.1490034082},
+		{A: 5.21041e-06, B: 4.99422678175, C: 3344.1355450488},
+		{A: 4.32614e-06, B: 2.5606640286, C: 191.4482661116},
+		{A: 4.29656e-06, B: 5.31646162367, C: 155.4203994342},
+		{A: 3.81747e-06, B: 3.53881289437, C: 796.2980068164},
+		{A: 3.14129e-06, B: 4.96335266049, C: 16703.062133499},
+		{A: 2.82804e-06, B: 3.15967518204, C: 2544.3144198834},
+		{A: 2.05664e-06, B: 4.5689145566, C: 2146.1654164752},
+		{A: 1.68805e-06, B: 1.32894813366, C: 3337.0893083508},
+		{A: 1.57587e-06, B: 4.18501035954, C: 1751.539531416},
+		{A: 1.33686e-06, B: 2.23325104196, C: 0.9803210682},
+		{A: 1.16561e-06, B: 2.21347652545, C: 1059.3819301892},
+		{A: 1.17591e-06, B: 6.02407213861, C: 6151.533888305},
+		{A: 1.13595e-06, B: 5.42803224317, C: 3738.761430108},
+		{A: 1.33563e-06, B: 5.97421903927, C: 1748.016413067},
+		{A: 9.1098e-07, B: 1.09627836591, C: 1349.8674096588},
+		{A: 8.3301e-07, B: 5.29636626272, C: 6684.7479717486},
+		{A: 1.13876e-06, B: 2.12869455089, C: 1194.4470102246},
+		{A: 8.0776e-07, B: 4.42813405865, C: 529.6909650946},
+		{A: 7.9531e-07, B: 2.2486426633, C: 8962.4553499102},
+		{A: 7.2505e-07, B: 5.8420816324, C: 242.728603974},
+		{A: 7.2946e-07, B: 2.50189460554, C: 951.7184062506},
+		{A: 7.1487e-07, B: 3.85636094435, C: 2914.0142358238},
+		{A: 8.5342e-07, B: 3.90854841008, C: 553.5694028424},
+		{A: 6.7582e-07, B: 5.02327686473, C: 382.8965322232},
+		{A: 6.5089e-07, B: 1.01802439311, C: 3340.5951730476},
+		{A: 6.5089e-07, B: 3.04879603978, C: 3340.629680352},
+		{A: 6.1508e-07, B: 4.151831598, C: 3149.1641605882},
+		{A: 4.8477e-07, B: 4.87362121538, C: 213.299095438},
+		{A: 4.6584e-07, B: 1.31452419914, C: 3185.1920272656},
+		{A: 5.652e-07, B: 3.8881369932, C: 4136.9104335162},
+		{A: 4.7613e-07, B: 1.18238046057, C: 3333.498879699},
+		{A: 4.1343e-07, B: 0.71385375517, C: 1592.5960136328},
+		{A: 4.0055e-07, B: 5.31611875491, C: 20043.6745601988},
+		{A: 4.0272e-07, B: 2.72542480614, C: 7.1135470008},
+		{A: 3.2886e-07, B: 5.41067411968, C: 6283.0758499914},
+		{A: 2.8244e-07, B: 0.04534124888, C: 9492.1463150048},
+		{A: 2.2294e-07, B: 5.88516997273, C: 3870.3033917944},
+		{A: 2.2431e-07, B: 5.46592525433, C: 20.3553193988},
+		{A: 2.2612e-07, B: 0.83775884934, C: 3097.88382272579},
+		{A: 2.1418e-07, B: 5.37934044204, C: 3340.545116397},
+		{A: 2.3335e-07, B: 6.16762213077, C: 3532.0606928114},
+		{A: 2.6579e-07, B: 3.88960724782, C: 1221.8485663214},
+		{A: 2.2797e-07, B: 1.54504711003, C: 2274.1169495098},
+		{A: 2.0431e-07, B: 2.36353950189, C: 1589.0728952838},
+		{A: 2.0186e-07, B: 3.36375535766, C: 5088.6288397668},
+		{A: 2.6554e-07, B: 5.11271747607, C: 2700.7151403858},
+		{A: 1.9675e-07, B: 2.57805423988, C: 12303.06777661},
+		{A: 1.9468e-07, B: 0.49216434489, C: 6677.7017350506},
+		{A: 2.1104e-07, B: 3.52525428062, C: 15.252471185},
+		{A: 2.1425e-07, B: 4.97081508139, C: 3340.6797370026},
+		{A: 1.8505e-07, B: 5.57863503922, C: 1990.745017041},
+		{A: 1.7811e-07, B: 6.12537931996, C: 4292.3308329504},
+		{A: 1.6472e-07, B: 2.60291845066, C: 3341.592747768},
+		{A: 1.6599e-07, B: 1.25519718278, C: 3894.1818295422},
+		{A: 1.9455e-07, B: 2.53112676345, C: 4399.994356889},
+		{A: 1.5e-07, B: 1.03464802434, C: 2288.3440435114},
+		{A: 2.0029e-07, B: 4.73119428749, C: 4690.4798363586},
+		{A: 1.5381e-07, B: 2.4700947035, C: 4535.0594369244},
+		{A: 1.9964e-07, B: 5.78652958398, C: 7079.3738568078},
+		{A: 1.5307e-07, B: 2.26515985343, C: 3723.508958923},
+		{A: 1.4705e-07, B: 3.36979890389, C: 6681.2421070518},
+		{A: 1.3535e-07, B: 2.1233441041, C: 5486.777843175},
+		{A: 1.295e-07, B: 5.61929676688, C: 10025.3603984484},
+		{A: 1.2682e-07, B: 2.95022113262, C: 3496.032826134},
+		{A: 1.3644e-07, B: 1.97739547259, C: 5614.7293762096},
+		{A: 1.3013e-07, B: 1.51424752315, C: 5628.9564702112},
+		{A: 1.4705e-07, B: 1.33902715586, C: 6681.2075997474},
+		{A: 1.1353e-07, B: 6.23438193885, C: 135.0650800354},
+		{A: 1.3275e-07, B: 3.42243595774, C: 5621.8429232104},
+		{A: 1.0867e-07, B: 5.28184140482, C: 2818.035008606},
+		{A: 1.185e-07, B: 3.12701832949, C: 426.598190876},
+		{A: 1.0472e-07, B: 2.73581537999, C: 2787.0430238574},
+		{A: 1.1132e-07, B: 5.84178807242, C: 2803.8079146044},
+		{A: 1.1764e-07, B: 2.58551521265, C: 8432.7643848156},
+		{A: 1.1854e-07, B: 5.4763068691, C: 3553.9115221378},
+		{A: 8.49e-08, B: 1.91378007528, C: 11773.3768115154},
+		{A: 9.708e-08, B: 4.52957217749, C: 6489.776587288},
+		{A: 8.562e-08, B: 3.16141186861, C: 162.4666361322},
+		{A: 1.0958e-07, B: 4.15771850822, C: 2388.8940204492},
+		{A: 8.133e-08, B: 1.61295625304, C: 2957.7158944766},
+		{A: 8.84e-08, B: 4.23294294197, C: 7477.522860216},
+		{A: 8.034e-08, B: 5.69983564288, C: 6041.3275670856},
+		{A: 8.344e-08, B: 2.18273563186, C: 23.8784377478},
+		{A: 7.696e-08, B: 5.71877332978, C: 9623.6882766912},
+		{A: 8.695e-08, B: 4.43542512603, C: 5092.1519581158},
+		{A: 8.434e-08, B: 3.16292250873, C: 3347.7259737006},
+		{A: 6.664e-08, B: 5.07517838003, C: 8031.0922630584},
+		{A: 8.65e-08, B: 4.33256981793, C: 3339.6321056316},
+		{A: 7.372e-08, B: 6.17831593269, C: 3583.3410306738},
+		{A: 5.726e-08, B: 3.68120120299, C: 8429.2412664666},
+		{A: 6.186e-08, B: 3.54165967734, C: 692.1576012268},
+		{A: 5.438e-08, B: 1.0512968958, C: 4933.2084403326},
+		{A: 6.108e-08, B: 1.66240879939, C: 6525.8044539654},
+		{A: 5.154e-08, B: 1.14703246368, C: 28.4491874678},
+		{A: 4.85e-08, B: 5.29254832907, C: 6681.2921637024},
+		{A: 5.467e-08, B: 6.12511022569, C: 2487.4160449478},
+		{A: 4.866e-08, B: 3.10475368803, C: 5.5229243074},
+		{A: 6.36e-08, B: 2.11896608283, C: 5884.9268465832},
+		{A: 5.223e-08, B: 0.3744626412, C: 12832.7587417046},
+		{A: 4.71e-08, B: 0.23326120326, C: 36.0278666774},
+		{A: 4.954e-08, B: 2.44806818502, C: 5099.2655051166},
+		{A: 4.861e-08, B: 5.6050529887, C: 6467.9257579616},
+		{A: 4.706e-08, B: 0.02998416568, C: 7210.9158184942},
+		{A: 4.845e-08, B: 5.70115105957, C: 6681.1575430968},
+		{A: 5.496e-08, B: 2.01006612503, C: 522.5774180938},
+		{A: 4.964e-08, B: 1.51006845561, C: 1744.4259844152},
+		{A: 4.443e-08, B: 0.31208413867, C: 10018.3141617504},
+		{A: 5.381e-08, B: 0.18359380473, C: 2942.4634232916},
+		{A: 4.075e-08, B: 3.9558210833, C: 3.881335358},
+		{A: 5.462e-08, B: 0.19274227117, C: 7632.9432596502},
+		{A: 4.11e-08, B: 1.59535768711, C: 7234.794256242},
+		{A: 4.287e-08, B: 2.87635993968, C: 2810.9214616052},
+		{A: 5.276e-08, B: 2.22638595594, C: 3127.3133312618},
+		{A: 4.45e-08, B: 4.17005729081, C: 2906.900688823},
+		{A: 5.144e-08, B: 5.66878565669, C: 23384.2869868986},
+		{A: 3.844e-08, B: 2.2644218316, C: 2699.7348193176},
+		{A: 3.514e-08, B: 1.76463961051, C: 1758.6530784168},
+		{A: 3.351e-08, B: 2.66194137496, C: 4929.6853219836},
+		{A: 4.299e-08, B: 4.43057446968, C: 640.8776073822},
+		{A: 3.14e-08, B: 1.75866226873, C: 9595.2390892234},
+		{A: 3.716e-08, B: 2.91969220147, C: 15643.6802033098},
+		{A: 3.249e-08, B: 6.13937134379, C: 10419.9862835076},
+		{A: 3.077e-08, B: 2.56115174488, C: 7064.1213856228},
+		{A: 3.208e-08, B: 2.3251945308, C: 5085.038411115},
+		{A: 2.93e-08, B: 1.27797225349, C: 574.3447983348},
+		{A: 2.771e-08, B: 1.75664216142, C: 639.897286314},
+		{A: 3.325e-08, B: 2.58945297384, C: 2118.7638603784},
+		{A: 3.187e-08, B: 2.8664675151, C: 7740.6067835888},
+		{A: 2.78e-08, B: 0.43157089331, C: 5828.0284716476},
+		{A: 2.824e-08, B: 0.98500544471, C: 3191.0492295652},
+		{A: 3.016e-08, B: 1.86555882509, C: 7.046236698},
+		{A: 3.364e-08, B: 1.52847138842, C: 6674.1113063988},
+		{A: 2.672e-08, B: 3.70855172347, C: 10021.8545337516},
+		{A: 2.636e-08, B: 3.11790581052, C: 6836.6452528338},
+		{A: 2.672e-08, B: 1.67778079449, C: 10021.8200264472},
+		{A: 2.563e-08, B: 3.77294986894, C: 2921.1277828246},
+		{A: 2.509e-08, B: 0.30454165124, C: 3475.6775067352},
+		{A: 2.4e-08, B: 0.96972421975, C: 3319.8370312074},
+		{A: 2.262e-08, B: 2.8139431495, C: 7875.6718636242},
+		{A: 2.395e-08, B: 2.96002707485, C: 6682.2051744678},
+		{A: 2.21e-08, B: 0.61263930586, C: 10973.55568635},
+		{A: 2.248e-08, B: 4.12382007742, C: 59.3738619136},
+		{A: 2.426e-08, B: 5.91508357946, C: 5331.3574437408},
+		{A: 2.158e-08, B: 2.17583545077, C: 15113.9892382152},
+		{A: 1.941e-08, B: 5.47668312685, C: 11371.7046897582},
+		{A: 1.903e-08, B: 5.11165653855, C: 1066.49547719},
+		{A: 2.37e-08, B: 3.87889340214, C: 3355.8648978848},
+		{A: 2.299e-08, B: 1.15914205086, C: 3320.257107301},
+		{A: 1.944e-08, B: 5.89081872133, C: 6894.5239488376},
+		{A: 1.843e-08, B: 3.07643314617, C: 3325.3599555148},
+		{A: 1.809e-08, B: 4.97905218276, C: 1648.4467571974},
+		{A: 2.136e-08, B: 1.91364787635, C: 8969.568896911},
+		{A: 2.099e-08, B: 3.00410255642, C: 6254.6266625236},
+		{A: 1.915e-08, B: 3.5590743174, C: 3767.2106175758},
+		{A: 1.991e-08, B: 5.37274107053, C: 206.1855484372},
+		{A: 1.685e-08, B: 5.49701299817, C: 266.6070417218},
+		{A: 1.646e-08, B: 1.31923405548, C: 3264.3463554242},
+		{A: 1.732e-08, B: 1.81361103995, C: 536.8045120954},
+		{A: 1.723e-08, B: 3.25900379342, C: 7903.073419721},
+		{A: 1.564e-08, B: 5.75428852012, C: 3360.96774609859},
+		{A: 1.589e-08, B: 1.73273563259, C: 3134.4268782626},
+		{A: 1.69e-08, B: 2.43213510013, C: 3120.199784261},
+		{A: 1.549e-08, B: 1.54016426558, C: 8425.6508378148},
+		{A: 1.536e-08, B: 5.88431472627, C: 20.7753954924},
+		{A: 1.46e-08, B: 4.89733072879, C: 9830.3890139878},
+		{A: 2.023e-08, B: 5.94808387002, C: 13365.9728251482},
+		{A: 1.991e-08, B: 3.11613326265, C: 3361.3878221922},
+		{A: 1.401e-08, B: 2.24482184868, C: 3344.2028553516},
+		{A: 1.365e-08, B: 4.58006320751, C: 10818.1352869158},
+		{A: 1.392e-08, B: 5.48931017516, C: 170.6728706192},
+		{A: 1.36e-08, B: 3.07974035205, C: 6127.6554505572},
+		{A: 1.345e-08, B: 1.18653158091, C: 14584.2982731206},
+		{A: 1.717e-08, B: 5.62501515015, C: 6158.6474353058},
+		{A: 1.408e-08, B: 1.82072980335, C: 3337.021998048},
+		{A: 1.736e-08, B: 2.01921900546, C: 10575.4066829418},
+		{A: 1.402e-08, B: 4.50079374387, C: 5729.506447149},
+		{A: 1.266e-08, B: 5.91088435118, C: 9808.5381846614},
+		{A: 1.433e-08, B: 6.05024653324, C: 12964.300703391},
+		{A: 1.223e-08, B: 0.82796258263, C: 419.4846438752},
+		{A: 1.393e-08, B: 1.05117949107, C: 6438.4962494256},
+		{A: 1.272e-08, B: 1.50116723856, C: 8439.8779318164},
+		{A: 1.143e-08, B: 4.89747373731, C: 220.4126424388},
+		{A: 1.183e-08, B: 3.52587190041, C: 6688.3384004004},
+		{A: 1.132e-08, B: 6.19236255633, C: 6144.4203413042},
+		{A: 1.154e-08, B: 2.2305848597, C: 8955.3418029094},
+		{A: 1.129e-08, B: 3.44264300692, C: 10177.2576795336},
+		{A: 1.152e-08, B: 5.29913300616, C: 27.4015560968},
+		{A: 1.274e-08, B: 4.5842123844, C: 6247.5131155228},
+		{A: 1.093e-08, B: 2.8262333236, C: 4569.574540022},
+		{A: 1.303e-08, B: 0.44350560735, C: 87.30820453981},
+		{A: 1.335e-08, B: 2.1420445773, C: 11243.6858464208},
+		{A: 1.102e-08, B: 1.96260837539, C: 6298.3283211764},
+		{A: 1.066e-08, B: 2.89865914321, C: 10404.7338123226},
+		{A: 1.027e-08, B: 4.79269049654, C: 3914.9572250346},
+		{A: 1.015e-08, B: 0.2284781873, C: 3230.4061054804},
+		{A: 1.041e-08, B: 3.73274497451, C: 10213.285546211},
+		{A: 1.154e-08, B: 4.14326179244, C: 6261.7402095244},
+		{A: 1.306e-08, B: 3.67645557029, C: 2301.58581590939},
+		{A: 1.145e-08, B: 5.12292846748, C: 4562.4609930212},
+		{A: 1.275e-08, B: 5.14333847164, C: 2693.601593385},
+		{A: 9.14e-09, B: 1.22398892152, C: 103.0927742186},
+		{A: 9.55e-09, B: 1.52875141393, C: 3369.0616141676},
+		{A: 9.08e-09, B: 0.48223420834, C: 13358.9265884502},
+		{A: 8.92e-09, B: 1.35161136807, C: 1214.7350193206},
+		{A: 9.98e-09, B: 3.2966588195, C: 3178.1457905676},
+		{A: 9.8e-09, B: 1.69212466625, C: 43.718912305},
+		{A: 9.19e-09, B: 2.45445889752, C: 13916.0191096416},
+		{A: 8.99e-09, B: 5.94990531529, C: 12168.0026965746},
+		{A: 8.91e-09, B: 5.63297246408, C: 10021.9045904022},
+		{A: 9.22e-09, B: 3.91483430303, C: 9225.539273283},
+		{A: 9.3e-09, B: 0.240730047, C: 6923.9534573736},
+		{A: 9.23e-09, B: 1.1039607445, C: 29.429508536},
+		{A: 8.16e-09, B: 4.65198282005, C: 2707.8286873866},
+		{A: 7.91e-09, B: 6.08595583868, C: 2384.3232707292},
+		{A: 8.2e-09, B: 4.80968546763, C: 533.2140834436},
+		{A: 7.58e-09, B: 2.06012386134, C: 12935.8515159232},
+		{A: 7.38e-09, B: 1.03564559078, C: 14314.1681130498},
+		{A: 9.08e-09, B: 0.69862047595, C: 16173.3711684044},
+		{A: 7e-09, B: 4.0878823461, C: 5202.3582793352},
+		{A: 8.29e-09, B: 2.01062325398, C: 9866.4168806652},
+		{A: 8.87e-09, B: 6.04145842617, C: 10021.7699697966},
+		{A: 7.68e-09, B: 1.39532897827, C: 8273.8208670324},
+		{A: 8.73e-09, B: 4.45446600602, C: 3316.733988952},
+		{A: 6.73e-09, B: 3.02440642752, C: 1039.0266107904},
+		{A: 6.6e-09, B: 2.83410276989, C: 107.6635239386},
+		{A: 8.38e-09, B: 0.39195370222, C: 10551.528245194},
+		{A: 7.08e-09, B: 3.27560955336, C: 18984.2926300096},
+		{A: 6.58e-09, B: 6.01853128902, C: 26724.8994135984},
+		{A: 6.85e-09, B: 1.98132615912, C: 1228.9621133222},
+		{A: 5.95e-09, B: 0.10260171285, C: 111.1866422876},
+		{A: 5.63e-09, B: 1.59138368358, C: 2391.43681773},
+		{A: 5.55e-09, B: 2.7080196219, C: 4555.3474460204},
+		{A: 6.96e-09, B: 2.89276686038, C: 2648.454825473},
+		{A: 5.87e-09, B: 4.56017988729, C: 6680.2445323314},
+		{A: 5.4e-09, B: 2.86002662919, C: 5459.3762870782},
+		{A: 5.3e-09, B: 5.64877399946, C: 6034.2140200848},
+		{A: 5.2e-09, B: 0.20012848836, C: 13760.5987102074},
+		{A: 5.52e-09, B: 5.08766140543, C: 1903.4368125012},
+		{A: 5.12e-09, B: 3.21411265909, C: 11081.2192102886},
+		{A: 6.41e-09, B: 5.19459033638, C: 6048.4411140864},
+		{A: 6.77e-09, B: 3.87723948458, C: 13517.8701062334},
+		{A: 5.34e-09, B: 0.23224033336, C: 51.28033786241},
+		{A: 6.7e-09, B: 3.69368226469, C: 3335.0895023924},
+		{A: 4.86e-09, B: 2.41879628327, C: 3364.4908644476},
+		{A: 5e-09, B: 4.31447859057, C: 3344.4937620578},
+		{A: 4.81e-09, B: 1.56481992611, C: 1964.838626854},
+		{A: 5.04e-09, B: 2.47456295599, C: 3863.1898447936},
+		{A: 5.23e-09, B: 0.65856269237, C: 853.196381752},
+		{A: 4.81e-09, B: 0.12971954679, C: 66.4874089144},
+		{A: 5.35e-09, B: 2.98601678918, C: 8270.2977486834},
+		{A: 4.5e-09, B: 2.02303462834, C: 13362.432453147},
+		{A: 4.48e-09, B: 5.59827312967, C: 149.5631971346},
+		{A: 5.19e-09, B: 2.75931838722, C: 3503.079062832},
+		{A: 5.34e-09, B: 4.77352933347, C: 1118.7557921028},
+		{A: 4.5e-09, B: 4.05380888708, C: 13362.4669604514},
+		{A: 4.39e-09, B: 4.83194205477, C: 3116.2676309979},
+		{A: 5.67e-09, B: 5.67483490268, C: 227.476132789},
+		{A: 4.59e-09, B: 3.44555998004, C: 6702.000248892},
+		{A: 5.45e-09, B: 2.01193901951, C: 7910.1869667218},
+		{A: 4.25e-09, B: 2.79854459343, C: 433.7117378768},
+		{A: 4.29e-09, B: 4.30113040289, C: 16858.4825329332},
+		{A: 4.09e-09, B: 0.0544800954, C: 3304.5845600224},
+		{A: 4.34e-09, B: 5.70806855136, C: 21.8508293264},
+		{A: 3.99e-09, B: 4.93233684937, C: 9779.1086761254},
+		{A: 5.32e-09, B: 1.31038986189, C: 6660.4494579072},
+		{A: 3.98e-09, B: 5.31345458361, C: 13119.72110282519},
+		{A: 4.59e-09, B: 2.53671963587, C: 74.7815985673},
+		{A: 3.84e-09, B: 2.29906801437, C: 12310.1813236108},
+		{A: 4.67e-09, B: 5.12562716972, C: 1596.1864422846},
+		{A: 5.16e-09, B: 5.84767782422, C: 1052.2683831884},
+		{A: 4.14e-09, B: 4.7540958261, C: 3981.490034082},
+		{A: 3.65e-09, B: 3.73271671549, C: 5518.7501489918},
+		{A: 3.67e-09, B: 0.13506394328, C: 56.8983749356},
+		{A: 4.59e-09, B: 0.15582180531, C: 9381.9399937854},
+		{A: 3.92e-09, B: 2.15845463651, C: 3980.5097130138},
+		{A: 3.96e-09, B: 1.48538591462, C: 17924.9106998204},
+		{A: 4.56e-09, B: 0.64517343174, C: 6816.289933435},
+		{A: 3.58e-09, B: 5.87219240658, C: 3607.2194684216},
+		{A: 4.9e-09, B: 0.65766946042, C: 3376.6402933772},
+		{A: 3.65e-09, B: 1.91816243676, C: 3347.6586633978},
+		{A: 3.97e-09, B: 1.80006148744, C: 7895.9598727202},
+		{A: 3.36e-09, B: 2.14687780119, C: 6677.6344247478},
+		{A: 3.4e-09, B: 2.88185925998, C: 17256.6315363414},
+		{A: 3.34e-09, B: 6.13670038311, C: 5724.935697429},
+		{A: 3.39e-09, B: 4.08527025169, C: 664.75604513},
+		{A: 4.32e-09, B: 2.52188285182, C: 18454.601664915},
+		{A: 3.36e-09, B: 4.22863444521, C: 6696.4773245846},
+		{A: 3.42e-09, B: 5.96724705923, C: 3546.797975137},
+		{A: 3.26e-09, B: 4.02557052581, C: 6872.6731195112},
+		{A: 3.23e-09, B: 5.05444843838, C: 3237.5196524812},
+		{A: 3.24e-09, B: 2.89151245241, C: 8329.671610597},
+		{A: 3.21e-09, B: 6.25886976298, C: 10235.1363755374},
+		{A: 3.33e-09, B: 2.57725424455, C: 6684.8152820514},
+		{A: 3.56e-09, B: 6.27424874986, C: 8671.9698704406},
+		{A: 3.19e-09, B: 5.05665355586, C: 36.6053653042},
+		{A: 3.05e-09, B: 3.88755666972, C: 7107.8230442756},
+		{A: 3.22e-09, B: 6.28125601341, C: 16706.585251848},
+		{A: 3.34e-09, B: 3.15240620873, C: 11216.284290324},
+		{A: 2.87e-09, B: 6.16467002771, C: 3973.396166013},
+		{A: 2.83e-09, B: 2.67802456636, C: 3877.4169387952},
+		{A: 2.83e-09, B: 1.6629315709, C: 1692.1656695024},
+		{A: 2.76e-09, B: 2.94210551399, C: 3415.3940252671},
+		{A: 2.75e-09, B: 0.53418048945, C: 17395.2197347258},
+		{A: 3.55e-09, B: 3.31406527401, C: 10022.8176011676},
+		{A: 3.11e-09, B: 1.50310910269, C: 6660.8695340008},
+		{A: 2.69e-09, B: 1.84517097065, C: 11780.4903585162},
+		{A: 2.7e-09, B: 4.42425307819, C: 310.8407988684},
+		{A: 2.75e-09, B: 3.58464612058, C: 128.0188433374},
+		{A: 2.75e-09, B: 2.2252353958, C: 3017.1070100424},
+		{A: 3.12e-09, B: 5.15950395287, C: 7255.5696517344},
+		{A: 2.99e-09, B: 0.72552273097, C: 155.3530891314},
+		{A: 3.53e-09, B: 5.7004779835, C: 16460.33352952499},
+		{A: 2.67e-09, B: 5.97864271046, C: 9499.2598620056},
+		{A: 2.7e-09, B: 0.77063210836, C: 11236.57229942},
+		{A: 3.39e-09, B: 3.360921489, C: 5625.3660415594},
+		{A: 3.15e-09, B: 2.33795159922, C: 3281.2385647862},
+		{A: 2.47e-09, B: 3.71002922076, C: 7373.3824546264},
+		{A: 3.28e-09, B: 0.18162415648, C: 5618.3198048614},
+		{A: 2.47e-09, B: 6.27486009856, C: 15508.6151232744},
+		{A: 2.92e-09, B: 0.14989609091, C: 16304.9131300908},
+		{A: 3.26e-09, B: 4.53606745007, C: 2178.137722292},
+		{A: 2.86e-09, B: 5.47710043383, C: 9168.6408983474},
+		{A: 2.46e-09, B: 1.4983871248, C: 15110.4661198662},
+		{A: 2.62e-09, B: 2.58821936465, C: 3336.7310913418},
+		{A: 2.44e-09, B: 0.84015413449, C: 16062.1845261168},
+		{A: 2.45e-09, B: 0.37772563756, C: 12721.572099417},
+		{A: 2.5e-09, B: 2.26824758119, C: 6784.3176276182},
+		{A: 2.48e-09, B: 6.22740483254, C: 13149.1506113612},
+		{A: 2.55e-09, B: 4.93078809107, C: 14158.7477136156},
+		{A: 2.4e-09, B: 6.15843594225, C: 19800.9459562248},
+		{A: 2.49e-09, B: 5.47044926479, C: 4407.1079038898},
+		{A: 2.35e-09, B: 5.38750866169, C: 76.2660712756},
+		{A: 2.58e-09, B: 6.10384464886, C: 2480.302497947},
+		{A: 3.06e-09, B: 5.35546231697, C: 2766.267628365},
+		{A: 2.36e-09, B: 5.25670707064, C: 13171.0014406876},
+		{A: 2.24e-09, B: 4.52466909993, C: 12566.1516999828},
+		{A: 2.2e-09, B: 5.83694256642, C: 13936.794505134},
+		{A: 2.71e-09, B: 1.42460945147, C: 14054.607308026},
+		{A: 2.13e-09, B: 0.21127914063, C: 1505.28780909299},
+		{A: 2.67e-09, B: 5.16501015011, C: 3205.5473466644},
+		{A: 2.12e-09, B: 4.26202838353, C: 6546.1597733642},
+		{A: 2.11e-09, B: 6.21401684263, C: 3253.30422216},
+		{A: 2.33e-09, B: 3.72007597749, C: 3346.1353510072},
+		{A: 2.74e-09, B: 2.91986569135, C: 10713.9948813262},
+		{A: 2.01e-09, B: 3.36695295492, C: 6.6836638741},
+		{A: 2.23e-09, B: 3.08788599159, C: 401.6721217572},
+		{A: 2.34e-09, B: 2.24268269202, C: 110.2063212194},
+		{A: 2.64e-09, B: 2.0817874274, C: 6475.0393049624},
+		{A: 2.31e-09, B: 4.5380638448, C: 9602.3526362242},
+		{A: 2.13e-09, B: 2.85452302656, C: 5415.6573747732},
+		{A: 1.95e-09, B: 0.99589439506, C: 5642.1982426092},
+		{A: 2.59e-09, B: 0.00464351114, C: 9380.9596727172},
+		{A: 1.97e-09, B: 3.32573550633, C: 3657.0042963564},
+		{A: 2.28e-09, B: 5.33299975472, C: 3561.0250691386},
+		{A: 1.93e-09, B: 1.25502846507, C: 6606.4432548323},
+		{A: 1.99e-09, B: 1.13665869139, C: 685.044054226},
+		{A: 2.27e-09, B: 4.49610509002, C: 589.0648270082},
+		{A: 2.17e-09, B: 5.48740879816, C: 10596.1820784342},
+		{A: 1.92e-09, B: 4.26501800444, C: 3333.5661900018},
+		{A: 1.88e-09, B: 1.44301618203, C: 4885.9664096786},
+		{A: 1.78e-09, B: 4.82506490541, C: 9070.1188738488},
+		{A: 1.84e-09, B: 5.69637552141, C: 3351.2490920496},
+		{A: 1.87e-09, B: 0.76021337348, C: 16699.53901514999},
+		{A: 2.26e-09, B: 0.82767654373, C: 3265.8308281325},
+		{A: 2.04e-09, B: 6.20933387021, C: 394.6258850592},
+		{A: 1.76e-09, B: 3.89567349231, C: 10028.9508271002},
+		{A: 1.74e-09, B: 3.68843293982, C: 735.8765135318},
+		{A: 1.73e-09, B: 2.44269377255, C: 3603.6963500726},
+		{A: 1.77e-09, B: 1.24154853329, C: 12722.5524204852},
+		{A: 1.84e-09, B: 4.77203925989, C: 286.9623611206},
+		{A: 1.71e-09, B: 4.67140116008, C: 20199.094959633},
+		{A: 1.7e-09, B: 5.13753345526, C: 1332.0548875408},
+		{A: 2.01e-09, B: 2.37863157745, C: 16276.463942623},
+		{A: 2.09e-09, B: 0.57156268506, C: 11250.7993934216},
+		{A: 1.64e-09, B: 1.98441291396, C: 10014.7237330986},
+		{A: 1.91e-09, B: 0.60250751218, C: 56.8032621698},
+		{A: 1.71e-09, B: 6.22556266993, C: 17277.4069318338},
+		{A: 1.66e-09, B: 1.05948008727, C: 19513.9835951042},
+		{A: 1.63e-09, B: 1.59661610701, C: 1437.1756141986},
+		{A: 1.65e-09, B: 3.36308723589, C: 6665.9723822146},
+		{A: 1.84e-09, B: 3.20554894393, C: 263.0839233728},
+		{A: 2.12e-09, B: 3.10485836003, C: 4039.8835749274},
+		{A: 1.76e-09, B: 3.41768939214, C: 9468.267877257},
+		{A: 1.63e-09, B: 1.39275730949, C: 8982.810669309},
+		{A: 2.13e-09, B: 3.39734274482, C: 931.3630868518},
+		{A: 1.89e-09, B: 4.54004144896, C: 8542.970706035},
+		{A: 1.91e-09, B: 1.15555618959, C: 3169.9395560806},
+		{A: 1.55e-09, B: 1.41249963094, C: 22.7684966094},
+		{A: 1.53e-09, B: 5.14168081601, C: 156.4007205024},
+		{A: 1.59e-09, B: 3.64996617906, C: 8013.2797409404},
+		{A: 1.51e-09, B: 1.93804487507, C: 3384.3313390048},
+		{A: 1.57e-09, B: 0.58554505759, C: 158.9435177832},
+		{A: 1.73e-09, B: 2.72517427493, C: 2807.3983432562},
+		{A: 1.59e-09, B: 0.67192454133, C: 13892.1406718938},
+		{A: 1.5e-09, B: 2.66045714174, C: 19004.6479494084},
+		{A: 1.92e-09, B: 5.73782632783, C: 206.7007372966},
+		{A: 1.43e-09, B: 3.19213280913, C: 6843.6914895318},
+		{A: 1.94e-09, B: 1.32358882667, C: 19402.7969528166},
+		{A: 1.43e-09, B: 2.3647816372, C: 13207.029307365},
+		{A: 1.4e-09, B: 1.8880056884, C: 11766.2632645146},
+		{A: 1.44e-09, B: 0.69018080218, C: 17085.9586657222},
+		{A: 1.83e-09, B: 5.98085295555, C: 13362.517017102},
+		{A: 1.61e-09, B: 2.92764155222, C: 5.8572022996},
+		{A: 1.62e-09, B: 6.07051064413, C: 6701.5801727984},
+		{A: 1.92e-09, B: 0.86266150575, C: 2814.4445799542},
+		{A: 1.82e-09, B: 5.26446797092, C: 3873.8265101434},
+		{A: 1.37e-09, B: 0.41563614709, C: 5820.9149246468},
+		{A: 1.44e-09, B: 3.02314051168, C: 708.98980227659},
+		{A: 1.84e-09, B: 4.61314496499, C: 3329.97576135},
+		{A: 1.31e-09, B: 3.48156082643, C: 367.2243289624},
+		{A: 1.73e-09, B: 3.09922849765, C: 12295.9542296092},
+		{A: 1.35e-09, B: 2.23311632892, C: 15664.03552270859},
+		{A: 1.47e-09, B: 1.95810911154, C: 5732.0492444298},
+		{A: 1.58e-09, B: 1.48909254724, C: 29.4918183034},
+		{A: 1.27e-09, B: 5.5553408004, C: 3368.0139827966},
+		{A: 1.29e-09, B: 1.78002583252, C: 22743.4093795164},
+		{A: 1.32e-09, B: 2.81496895377, C: 21795.21409161479},
+		{A: 1.27e-09, B: 5.73090203501, C: 3340.19235060619},
+		{A: 1.64e-09, B: 1.87613918877, C: 6709.6740408674},
+		{A: 1.23e-09, B: 3.61238958991, C: 22324.9050567094},
+		{A: 1.29e-09, B: 4.92064308735, C: 2540.7913015344},
+		{A: 1.21e-09, B: 6.16922638434, C: 20206.141196331},
+		{A: 1.22e-09, B: 5.79901866314, C: 1854.6323056346},
+		{A: 1.33e-09, B: 0.50941998058, C: 3274.1250177854},
+		{A: 1.51e-09, B: 1.61342807879, C: 1107.1388056848},
+		{A: 1.65e-09, B: 2.02795177586, C: 290.4854794696},
+		{A: 1.25e-09, B: 0.52719797619, C: 2604.735913168},
+		{A: 1.44e-09, B: 5.68526782434, C: 8827.3902698748},
+		{A: 1.26e-09, B: 3.80246508251, C: 765.7930644464},
+		{A: 1.16e-09, B: 1.79450246249, C: 647.0108333148},
+		{A: 1.26e-09, B: 2.00195272473, C: 699.2711482276},
+		{A: 1.47e-09, B: 6.22619740782, C: 6040.3472460174},
+		{A: 1.19e-09, B: 2.05840518265, C: 15121.102785216},
+		{A: 1.14e-09, B: 2.7487709147, C: 6460.8122109608},
+		{A: 1.55e-09, B: 1.78154091696, C: 21265.5231265202},
+		{A: 1.46e-09, B: 3.37351237411, C: 1861.7458526354},
+		{A: 1.18e-09, B: 4.07281676691, C: 418.504322807},
+		{A: 1.16e-09, B: 0.10434606071, C: 13362.3823964964},
+		{A: 1.29e-09, B: 0.78419803719, C: 3427.9206312396},
+		{A: 1.52e-09, B: 0.32620694442, C: 3443.7052009184},
+		{A: 1.1e-09, B: 0.56398082486, C: 661.232926781},
+		{A: 1.11e-09, B: 4.05380946072, C: 568.8218740274},
+		{A: 1.08e-09, B: 3.17700641574, C: 3448.2759506384},
+		{A: 1.38e-09, B: 4.47698517191, C: 3326.3853326982},
+		{A: 1.08e-09, B: 4.89922372003, C: 9588.1255422226},
+		{A: 1.14e-09, B: 4.80828825403, C: 6657.3464156518},
+		{A: 1.08e-09, B: 4.10637483972, C: 13553.8979729108},
+		{A: 1.25e-09, B: 0.33573243959, C: 18849.2275499742},
+		{A: 1.15e-09, B: 3.18885465852, C: 2409.249339848},
+		{A: 1.04e-09, B: 3.23074163851, C: 3472.1543883862},
+		{A: 1.04e-09, B: 0.09799515047, C: 30065.5118402982},
+		{A: 1.12e-09, B: 1.64487733528, C: 10001.061884607},
+		{A: 1.43e-09, B: 3.53781769283, C: 6518.7582172674},
+		{A: 1.13e-09, B: 5.20979306912, C: 2125.8774073792},
+		{A: 1.04e-09, B: 2.77582098882, C: 38.1330356378},
+		{A: 1.33e-09, B: 5.88513337452, C: 5835.1420186484},
+		{A: 1.05e-09, B: 4.11662579413, C: 6675.7019290922},
+		{A: 1.02e-09, B: 0.60100887043, C: 10264.5658840734},
+		{A: 1.01e-09, B: 3.78636130664, C: 10042.6126755918},
+		{A: 1.39e-09, B: 1.80936944447, C: 12323.4230960088},
+		{A: 1.01e-09, B: 2.47217208753, C: 7380.4960016272},
+		{A: 1.3e-09, B: 2.53454569863, C: 11769.8536931664},
+		{A: 1e-09, B: 5.72291104291, C: 14.2270940016},
+		{A: 1.35e-09, B: 4.2023756451, C: 4672.6673142406},
+		{A: 1.33e-09, B: 0.34413768012, C: 16489.763038061},
+		{A: 9.8e-10, B: 1.44874403589, C: 3370.0419352358},
+		{A: 1.31e-09, B: 1.31336606248, C: 3313.210870603},
+		{A: 1.11e-09, B: 3.12463539337, C: 309.2783226558},
+		{A: 1.01e-09, B: 3.15369992044, C: 24150.080051345},
+		{A: 1.02e-09, B: 6.13479937096, C: 2277.7073781616},
+		{A: 9.9e-10, B: 0.10085261274, C: 12839.8722887054},
+		{A: 1.34e-09, B: 2.91637947295, C: 57.8786960038},
+		{A: 1.04e-09, B: 3.3028305233, C: 3399.9862886134},
+		{A: 1.08e-09, B: 4.92699760221, C: 802.3639224462},
+		{A: 1.06e-09, B: 2.89298330043, C: 7799.9806455024},
+		{A: 1.12e-09, B: 3.12761163915, C: 5989.0672521728},
+		{A: 9.4e-10, B: 3.42562596561, C: 3510.1926098328},
+		{A: 1.02e-09, B: 0.94285421551, C: 3209.0704650134},
+		{A: 9.6e-10, B: 0.79636181668, C: 3024.2205570432},
+		{A: 9.3e-10, B: 1.08979608844, C: 14577.1847261198},
+		{A: 9.7e-10, B: 3.56551535742, C: 14421.8316369884},
+		{A: 9.2e-10, B: 5.0227963751, C: 2494.5295919486},
+		{A: 1.23e-09, B: 5.83481108101, C: 7747.7203305896},
+		{A: 1.11e-09, B: 3.31406538379, C: 11610.9101753832},
+		{A: 1.18e-09, B: 5.0084532296, C: 1581.959348283},
+		{A: 9.1e-10, B: 2.36839307589, C: 11140.5930722022},
+		{A: 9.9e-10, B: 2.93269536697, C: 2067.9314010726},
+		{A: 1.24e-09, B: 3.15220420912, C: 22345.2603761082},
+		{A: 9e-10, B: 0.85462370851, C: 17232.7530985936},
+		{A: 1.25e-09, B: 1.54866979468, C: 1435.1476617594},
+		{A: 1.13e-09, B: 4.57220387105, C: 14712.317116458},
+		{A: 1.03e-09, B: 0.11158194413, C: 11.0457002639},
+		{A: 1.13e-09, B: 2.41110151532, C: 5244.049239201},
+		{A: 9.1e-10, B: 1.7718473028, C: 2221.856634597},
+		{A: 1.14e-09, B: 1.92696878615, C: 8226.5788363784},
+		{A: 1.18e-09, B: 0.29322259611, C: 2945.9865416406},
+		{A: 8.9e-10, B: 4.49875865671, C: 21947.1113727},
+		{A: 1.04e-09, B: 1.36766858693, C: 4032.7700279266},
+		{A: 1.16e-09, B: 4.67476277278, C: 272.6729573516},
+		{A: 1.05e-09, B: 3.7332797385, C: 4989.0591838972},
+		{A: 9.5e-10, B: 0.34653808205, C: 13517.8027959306},
+		{A: 8.6e-10, B: 2.70925448214, C: 7218.029365495},
+		{A: 1.12e-09, B: 5.27572276726, C: 17499.3601403154},
+		{A: 1.14e-09, B: 3.37335971932, C: 2938.9403049426},
+		{A: 9e-10, B: 0.26623024836, C: 9485.032768004},
+		{A: 8.6e-10, B: 3.66786582491, C: 6997.6167230562},
+		{A: 8.7e-10, B: 4.0782360868, C: 18606.4989460002},
+		{A: 1.17e-09, B: 5.61863859185, C: 16511.6138673874},
+		{A: 8.4e-10, B: 2.80171829534, C: 8584.6616659008},
+		{A: 8.6e-10, B: 5.87459621301, C: 73.6009559152},
+		{A: 9e-10, B: 1.07789160729, C: 10721.108428327},
+		{A: 8.4e-10, B: 0.78729386249, C: 146.8116865236},
+		{A: 1.12e-09, B: 2.31690430881, C: 9638.9407478762},
+		{A: 8.3e-10, B: 5.90575201511, C: 16858.41522263039},
+		{A: 8.2e-10, B: 4.316905577, C: 9374.8264467846},
+		{A: 8.3e-10, B: 1.41315204958, C: 22854.596021804},
+		{A: 8.4e-10, B: 0.90320571725, C: 2171.0241752912},
+		{A: 8.2e-10, B: 1.59442952041, C: 9947.0556815321},
+		{A: 8.2e-10, B: 0.44163602941, C: 4782.87363546},
+		{A: 8.6e-10, B: 5.18335054161, C: 12410.7313005486},
+		{A: 8.5e-10, B: 3.61669636863, C: 8965.9784682592},
+		{A: 9.7e-10, B: 5.3551176514, C: 4996.172730898},
+		{A: 8.5e-10, B: 2.36814442737, C: 1062.9050485382},
+		{A: 7.8e-10, B: 1.75580354602, C: 2060.8178540718},
+		{A: 8.7e-10, B: 0.98702744399, C: 10156.9023601348},
+		{A: 9.8e-10, B: 0.35294347682, C: 23546.7536230308},
+		{A: 1.01e-09, B: 1.03298143418, C: 20040.15144184979},
+		{A: 8.8e-10, B: 0.56201084357, C: 17101.2111369072},
+		{A: 8.2e-10, B: 2.65765057749, C: 12979.553174576},
+		{A: 8.5e-10, B: 1.07007237991, C: 10706.8813343254},
+		{A: 8.2e-10, B: 0.8058839632, C: 20735.83216142559},
+		{A: 9.2e-10, B: 4.87180501294, C: 9389.0535407862},
+		{A: 7.8e-10, B: 3.22869264518, C: 11925.2740926006},
+		{A: 7.4e-10, B: 5.59171946181, C: 8535.8571590342},
+		{A: 8e-10, B: 0.42260849968, C: 956.2891559706},
+		{A: 8.2e-10, B: 1.47379060963, C: 16.83220104979},
+		{A: 7.3e-10, B: 3.76950560688, C: 8859.3625756916},
+		{A: 7.3e-10, B: 4.5913018263, C: 362.1211367308},
+		{A: 7.2e-10, B: 5.15373872266, C: 9872.2740829648},
+		{A: 9.8e-10, B: 2.47740242208, C: 5401.4302807716},
+		{A: 7.1e-10, B: 0.08395696279, C: 15849.865751747},
+		{A: 7.8e-10, B: 0.04212599783, C: 16703.1294438018},
+		{A: 7.5e-10, B: 3.77172360793, C: 4845.9002357928},
+		{A: 7.2e-10, B: 2.01742377451, C: 1329.51209026},
+		{A: 7.6e-10, B: 0.30413402871, C: 72.0557333548},
+		{A: 9.7e-10, B: 5.1510931855, C: 1.4844727083},
+		{A: 7e-10, B: 0.33648335823, C: 23141.5583829246},
+		{A: 6.8e-10, B: 0.34828385806, C: 20047.1976785478},
+		{A: 7.8e-10, B: 1.42772075938, C: 16063.164847185},
+		{A: 8.1e-10, B: 2.37498404818, C: 16703.0448798468},
+		{A: 6.9e-10, B: 3.66727984195, C: 13363.4300278674},
+		{A: 6.7e-10, B: 1.77194706681, C: 18451.07854656599},
+		{A: 7.3e-10, B: 0.41181711796, C: 33406.124266998},
+		{A: 6.7e-10, B: 3.1594684038, C: 8799.988713778},
+		{A: 6.7e-10, B: 4.07602260745, C: 10448.4354709754},
+		{A: 6.8e-10, B: 1.78981361818, C: 224.3447957019},
+		{A: 6.6e-10, B: 1.01449371817, C: 4193.8088084518},
+		{A: 6.7e-10, B: 4.89249339125, C: 12082.6551341712},
+		{A: 8.1e-10, B: 0.61914094848, C: 949.1756089698},
+		{A: 6.6e-10, B: 3.39914635235, C: 10184.3039162316},
+		{A: 6.4e-10, B: 5.50528849889, C: 4936.7988689844},
+		{A: 6.4e-10, B: 1.8260823549, C: 3077.528503327},
+		{A: 6.9e-10, B: 2.48571574894, C: 10018.2468514476},
+		{A: 6.4e-10, B: 2.8044732232, C: 20809.4676246452},
+		{A: 6.8e-10, B: 0.9790733543, C: 6717.252720077},
+		{A: 8.7e-10, B: 2.45966764758, C: 7321.1221397136},
+		{A: 6.2e-10, B: 1.69131771765, C: 632.7837393132},
+		{A: 8.1e-10, B: 4.40575713075, C: 16703.07938715119},
+		{A: 6.3e-10, B: 3.63625395496, C: 25685.872802808},
+		{A: 7.5e-10, B: 5.54907590704, C: 16872.642316632},
+		{A: 6.1e-10, B: 0.33159827734, C: 12012.5822971404},
+		{A: 6.3e-10, B: 3.16314818302, C: 11670.2840372968},
+		{A: 6.2e-10, B: 0.23148800541, C: 7314.0085927128},
+		{A: 7.8e-10, B: 1.65377731167, C: 11614.4332937322},
+		{A: 6.8e-10, B: 1.10866475394, C: 6155.057006654},
+		{A: 6.8e-10, B: 4.45713540461, C: 9175.7544453482},
+		{A: 8.1e-10, B: 3.66553577428, C: 15265.8865193004},
+		{A: 6.8e-10, B: 5.59792852411, C: 377.3736079158},
+		{A: 5.9e-10, B: 6.25689995147, C: 41427.4869831788},
+		{A: 7.2e-10, B: 3.39739853142, C: 685.1113645288},
+		{A: 6.2e-10, B: 0.11206359088, C: 14047.4937610252},
+		{A: 5.7e-10, B: 4.31819630987, C: 95.9792272178},
+		{A: 7e-10, B: 5.98596344975, C: 6531.661656265},
+		{A: 6.6e-10, B: 5.93804470886, C: 3490.1756238344},
+		{A: 5.6e-10, B: 5.11205539684, C: 7322.1024607818},
+		{A: 5.6e-10, B: 3.36788837326, C: 4379.6390374902},
+		{A: 5.6e-10, B: 2.20908914878, C: 6688.2710900976},
+		{A: 5.7e-10, B: 4.57828186723, C: 10037.0897512844},
+		{A: 5.7e-10, B: 0.04695703833, C: 24889.5747959916},
+		{A: 6.5e-10, B: 2.70973517401, C: 19617.07636932279},
+		{A: 6.8e-10, B: 3.51423189318, C: 14556.8967170238},
+		{A: 5.6e-10, B: 5.94452825242, C: 21548.9623692918},
+		{A: 5.6e-10, B: 3.94541346495, C: 25665.5174834092},
+		{A: 5.5e-10, B: 0.45166365461, C: 16702.9948231962},
+		{A: 6.2e-10, B: 0.05229160039, C: 14061.7208550268},
+		{A: 5.5e-10, B: 1.14774475393, C: 4459.3682188026},
+		{A: 6.5e-10, B: 6.21442820689, C: 6947.8318951214},
+		{A: 5.6e-10, B: 5.01712943722, C: 485.9720527896},
+		{A: 6.5e-10, B: 1.51615558729, C: 5511.636601991},
+		{A: 7.2e-10, B: 4.90742373357, C: 25287.7237993998},
+		{A: 5.7e-10, B: 5.31542907454, C: 28628.3362260996},
+		{A: 5.5e-10, B: 4.02023620788, C: 4005.3684718298},
+		{A: 5.4e-10, B: 5.51196184505, C: 44.6538332402},
+		{A: 5.3e-10, B: 0.46840976995, C: 19645.5255567906},
+		{A: 5.3e-10, B: 4.51539970261, C: 9886.772200064},
+		{A: 6.9e-10, B: 3.37092084432, C: 41.5507909848},
+		{A: 7.2e-10, B: 4.66520155151, C: 6685.1061887576},
+		{A: 5.2e-10, B: 2.05209599225, C: 7366.2689076256},
+		{A: 5.2e-10, B: 6.25882226723, C: 12509.2533250472},
+		{A: 6e-10, B: 0.33350021257, C: 13575.7488022372},
+		{A: 5.9e-10, B: 5.94433755684, C: 625.6701923124},
+		{A: 5e-10, B: 2.65624501709, C: 10124.930054318},
+		{A: 6.8e-10, B: 2.92105834159, C: 10025.4277087512},
+		{A: 5.1e-10, B: 2.88289068067, C: 39601.8919124496},
+		{A: 6.9e-10, B: 1.09637075565, C: 24076.4445881254},
+		{A: 5.2e-10, B: 2.65290577481, C: 15650.7937503106},
+		{A: 5.2e-10, B: 3.2868331301, C: 6756.0064519669},
+		{A: 5.4e-10, B: 5.34683925496, C: 6578.132079181},
+		{A: 5.4e-10, B: 2.77410607136, C: 6705.1032911474},
+		{A: 4.9e-10, B: 2.657002099, C: 6944.3087767724},
+		{A: 5.3e-10, B: 5.67436244967, C: 1883.0814931024},
+		{A: 4.8e-10, B: 5.68336050679, C: 8646.0634802536},
+		{A: 5.1e-10, B: 0.113906433, C: 17402.3332817266},
+		{A: 6.5e-10, B: 1.03099992649, C: 4106.4054911462},
+		{A: 6e-10, B: 1.00159365247, C: 151.8972810852},
+		{A: 5e-10, B: 3.84651247899, C: 45494.58142974879},
+		{A: 4.7e-10, B: 3.03959709242, C: 5408.5438277724},
+		{A: 6.3e-10, B: 4.16165369755, C: 8186.5126624926},
+		{A: 4.6e-10, B: 2.69368087378, C: 16547.6417340648},
+		{A: 5.1e-10, B: 2.99576014378, C: 3774.3241645766},
+		{A: 4.4e-10, B: 2.00664763411, C: 6418.1409300268},
+		{A: 4.5e-10, B: 4.01853755929, C: 19406.6782881746},
+		{A: 5.8e-10, B: 3.1447475355, C: 4025.6564809258},
+		{A: 5e-10, B: 2.59881540437, C: 6621.850991486},
+		{A: 4.3e-10, B: 4.87912487459, C: 6414.6178116778},
+		{A: 4.2e-10, B: 5.20400092044, C: 4447.7512323846},
+		{A: 5.1e-10, B: 1.99634375899, C: 5032.7780962022},
+		{A: 4.3e-10, B: 1.28813888865, C: 6643.0918177618},
+		{A: 4e-10, B: 0.9680161856, C: 14591.4118201214},
+		{A: 3.9e-10, B: 1.84985100829, C: 10001.48196070061},
+		{A: 3.9e-10, B: 5.69967200167, C: 6106.8800550648},
+		{A: 3.8e-10, B: 3.27498743518, C: 18052.9295431578},
+		{A: 3.9e-10, B: 2.84167905068, C: 6652.7756659318},
+		{A: 4.4e-10, B: 0.57891618854, C: 16865.5287696312},
+		{A: 4.3e-10, B: 4.61937364869, C: 3341.0325027934},
+		{A: 4.2e-10, B: 6.02555835659, C: 6691.8615187494},
+		{A: 3.4e-10, B: 4.9773499235, C: 6670.5881880498},
+		{A: 3.3e-10, B: 1.39167727215, C: 4825.544916394},
+		{A: 3.5e-10, B: 6.02955363644, C: 3568.0885594888},
+		{A: 3.5e-10, B: 0.31961016732, C: 6645.1969867222},
+		{A: 3.2e-10, B: 5.63043769073, C: 3511.285297319},
+		{A: 3.1e-10, B: 5.4297846421, C: 9945.5712088238},
+		{A: 3.8e-10, B: 5.66461657503, C: 3416.8784979754},
+		{A: 3e-10, B: 0.98518793666, C: 20426.571092422},
+		{A: 3.8e-10, B: 0.12870962242, C: 6604.958782124},
+		{A: 3.7e-10, B: 5.48374357342, C: 3311.18291816379},
+		{A: 3.2e-10, B: 6.1110697981, C: 4392.8808098882},
+		{A: 3.1e-10, B: 3.18481282781, C: 3341.0423098265},
+		{A: 3.4e-10, B: 2.32358226279, C: 9072.6616711296},
+		{A: 3.9e-10, B: 4.11042361929, C: 3312.163239232},
+		{A: 2.7e-10, B: 0.57810321636, C: 3391.89276456221},
+		{A: 2.9e-10, B: 2.48646403166, C: 9815.6517316622},
+		{A: 3.1e-10, B: 0.44265747667, C: 3451.7990689874},
+		{A: 2.7e-10, B: 6.13498177783, C: 3362.4632560262},
+		{A: 2.7e-10, B: 6.21846173482, C: 5223.6939198022},
+		{A: 2.7e-10, B: 2.94945830517, C: 7203.8022714934},
+		{A: 2.7e-10, B: 3.261798558, C: 8756.269801473},
+		{A: 2.7e-10, B: 3.943852717, C: 23958.6317852334},
+		{A: 3.3e-10, B: 3.77237326006, C: 12808.8803039568},
+		{A: 3e-10, B: 4.75096367323, C: 15906.7641266826},
+		{A: 3.1e-10, B: 0.88248871193, C: 3340.1825435731},
+		{A: 2.5e-10, B: 0.31303295413, C: 6571.0185321802},
+		{A: 3.1e-10, B: 4.29076841627, C: 10020.8569590312},
+		{A: 2.6e-10, B: 2.22427360058, C: 10050.2864675672},
+		{A: 2.5e-10, B: 0.67881122439, C: 23937.856389741},
+		{A: 3.1e-10, B: 1.72899093511, C: 13745.3462390224},
+		{A: 2.4e-10, B: 0.20355912395, C: 3229.4257844122},
+		{A: 3.2e-10, B: 3.37195631109, C: 2284.7536148596},
+	},
+	// L2, 310 terms
+	{
+		{A: 0.00058015791, B: 2.04979463279, C: 3340.6124266998},
+		{A: 0.00054187645, B: 0, C: 0},
+		{A: 0.00013908426, B: 2.45742359888, C: 6681.2248533996},
+		{A: 2.465104e-05, B: 2.80000020929, C: 10021.8372800994},
+		{A: 3.98379e-06, B: 3.14118428289, C: 13362.4497067992},
+		{A: 2.22022e-06, B: 3.19436080019, C: 3.523118349},
+		{A: 1.20957e-06, B: 0.54325292454, C: 155.4203994342},
+		{A: 6.1517e-07, B: 3.48529427371, C: 16703.062133499},
+		{A: 5.3638e-07, B: 3.54191121461, C: 3344.1355450488},
+		{A: 3.4268e-07, B: 6.00188499119, C: 2281.2304965106},
+		{A: 3.1665e-07, B: 4.14015171788, C: 191.4482661116},
+		{A: 2.9839e-07, B: 1.99870679845, C: 796.2980068164},
+		{A: 2.3168e-07, B: 4.33403365928, C: 242.728603974},
+		{A: 2.1659e-07, B: 3.44532466378, C: 398.1490034082},
+		{A: 1.6044e-07, B: 6.11000472441, C: 2146.1654164752},
+		{A: 2.037e-07, B: 5.421913754, C: 553.5694028424},
+		{A: 1.4927e-07, B: 6.09541783564, C: 3185.1920272656},
+		{A: 1.6227e-07, B: 0.65678953303, C: 0.9803210682},
+		{A: 1.4317e-07, B: 2.61851897591, C: 1349.8674096588},
+		{A: 1.4416e-07, B: 4.01923812101, C: 951.7184062506},
+		{A: 1.1934e-07, B: 3.86122163021, C: 6684.7479717486},
+		{A: 1.5648e-07, B: 1.2208612194, C: 1748.016413067},
+		{A: 1.126e-07, B: 4.71822363671, C: 2544.3144198834},
+		{A: 1.3352e-07, B: 0.60189008414, C: 1194.4470102246},
+		{A: 1.0396e-07, B: 0.25038714677, C: 382.8965322232},
+		{A: 9.468e-08, B: 0.68170713564, C: 1059.3819301892},
+		{A: 9.229e-08, B: 3.83209092321, C: 20043.6745601988},
+		{A: 9.005e-08, B: 3.88271826102, C: 3738.761430108},
+		{A: 7.501e-08, B: 5.46498630412, C: 1751.539531416},
+		{A: 6.497e-08, B: 5.47773072872, C: 1592.5960136328},
+		{A: 6.311e-08, B: 2.34104793674, C: 3097.88382272579},
+		{A: 6.859e-08, B: 2.57522504136, C: 3149.1641605882},
+		{A: 5.87e-08, B: 1.14783576679, C: 7.1135470008},
+		{A: 6.681e-08, B: 2.37843690339, C: 4136.9104335162},
+		{A: 4.647e-08, B: 4.42957708526, C: 6151.533888305},
+		{A: 4.166e-08, B: 3.68631477611, C: 5614.7293762096},
+		{A: 4.764e-08, B: 2.89684755585, C: 3333.498879699},
+		{A: 4.045e-08, B: 6.12493402657, C: 5628.9564702112},
+		{A: 3.653e-08, B: 4.06679068397, C: 1990.745017041},
+		{A: 3.618e-08, B: 2.46868561769, C: 529.6909650946},
+		{A: 3.277e-08, B: 0.68101740787, C: 8962.4553499102},
+		{A: 3.253e-08, B: 2.7956534039, C: 3894.1818295422},
+		{A: 3.091e-08, B: 4.56861203364, C: 3496.032826134},
+		{A: 2.921e-08, B: 5.41458945995, C: 2914.0142358238},
+		{A: 2.921e-08, B: 1.23050883841, C: 2787.0430238574},
+		{A: 2.784e-08, B: 1.38911141844, C: 4292.3308329504},
+		{A: 2.62e-08, B: 1.04061894134, C: 3341.592747768},
+		{A: 2.888e-08, B: 3.41062353663, C: 3337.0893083508},
+		{A: 2.418e-08, B: 0.96341462666, C: 4535.0594369244},
+		{A: 2.357e-08, B: 4.84628239765, C: 9492.1463150048},
+		{A: 2.593e-08, B: 5.74934234498, C: 3340.5951730476},
+		{A: 2.191e-08, B: 3.26449527357, C: 213.299095438},
+		{A: 2.594e-08, B: 1.49510566123, C: 3340.629680352},
+		{A: 2.344e-08, B: 4.18104725028, C: 10025.3603984484},
+		{A: 2.63e-08, B: 4.67640929857, C: 3583.3410306738},
+		{A: 2.602e-08, B: 2.64911714813, C: 2388.8940204492},
+		{A: 1.83e-08, B: 0.97181050149, C: 1589.0728952838},
+		{A: 2.416e-08, B: 1.04749173375, C: 4399.994356889},
+		{A: 2.386e-08, B: 4.2707257555, C: 7079.3738568078},
+		{A: 2.187e-08, B: 0.16036551231, C: 6525.8044539654},
+		{A: 2.344e-08, B: 0.01425578204, C: 4690.4798363586},
+		{A: 1.617e-08, B: 4.95614491689, C: 5088.6288397668},
+		{A: 1.633e-08, B: 1.10703599922, C: 12303.06777661},
+		{A: 2.126e-08, B: 0.48290227706, C: 2700.7151403858},
+		{A: 1.629e-08, B: 4.94267977718, C: 1221.8485663214},
+		{A: 1.504e-08, B: 0.11031912519, C: 2957.7158944766},
+		{A: 1.759e-08, B: 3.81170701376, C: 3723.508958923},
+		{A: 1.401e-08, B: 3.85907867678, C: 6283.0758499914},
+		{A: 1.338e-08, B: 5.29685392418, C: 6677.7017350506},
+		{A: 1.763e-08, B: 2.51660121293, C: 2810.9214616052},
+		{A: 1.392e-08, B: 2.73498041122, C: 7477.522860216},
+		{A: 1.431e-08, B: 2.97747408389, C: 6489.776587288},
+		{A: 1.236e-08, B: 3.7724596559, C: 2699.7348193176},
+		{A: 1.234e-08, B: 1.88931735265, C: 6681.2421070518},
+		{A: 1.513e-08, B: 2.92614134711, C: 640.8776073822},
+		{A: 1.234e-08, B: 6.14168429036, C: 6681.2075997474},
+		{A: 1.408e-08, B: 1.54395721611, C: 3347.7259737006},
+		{A: 1.038e-08, B: 5.82880072933, C: 4933.2084403326},
+		{A: 1.156e-08, B: 1.50825464304, C: 426.598190876},
+		{A: 1.362e-08, B: 4.1779429752, C: 23384.2869868986},
+		{A: 1.135e-08, B: 3.77506455273, C: 3870.3033917944},
+		{A: 9.16e-09, B: 3.81726339298, C: 5092.1519581158},
+		{A: 8.53e-09, B: 3.82520490669, C: 3340.545116397},
+		{A: 1.077e-08, B: 5.0506282876, C: 5621.8429232104},
+		{A: 1.074e-08, B: 3.8144692047, C: 3553.9115221378},
+		{A: 8.47e-09, B: 3.41702696402, C: 3340.6797370026},
+		{A: 9.2e-09, B: 1.91108056416, C: 3532.0606928114},
+		{A: 7.38e-09, B: 4.25786145387, C: 9623.6882766912},
+		{A: 9.08e-09, B: 4.12911006922, C: 162.4666361322},
+		{A: 6.47e-09, B: 3.10301033831, C: 7234.794256242},
+		{A: 8e-09, B: 5.20674574801, C: 87.30820453981},
+		{A: 6.57e-09, B: 1.57895580467, C: 2487.4160449478},
+		{A: 6.5e-09, B: 2.78932995437, C: 574.3447983348},
+		{A: 6.59e-09, B: 5.16655918817, C: 12832.7587417046},
+		{A: 7.12e-09, B: 5.79288230676, C: 3339.6321056316},
+		{A: 6.6e-09, B: 0.25125103909, C: 8969.568896911},
+		{A: 5.27e-09, B: 4.62218528897, C: 10419.9862835076},
+		{A: 6.07e-09, B: 3.84724721085, C: 5486.777843175},
+		{A: 5e-09, B: 4.66323134619, C: 6836.6452528338},
+		{A: 5.09e-09, B: 0.32548381735, C: 8031.0922630584},
+		{A: 6.13e-09, B: 1.72250879737, C: 7632.9432596502},
+		{A: 5.16e-09, B: 1.37906978509, C: 7740.6067835888},
+		{A: 4.69e-09, B: 1.31324778369, C: 7875.6718636242},
+		{A: 4.42e-09, B: 5.34515135225, C: 10018.3141617504},
+		{A: 4.96e-09, B: 4.49656852602, C: 692.1576012268},
+		{A: 5.44e-09, B: 1.444653692, C: 15643.6802033098},
+		{A: 4.67e-09, B: 1.40928870138, C: 6682.2051744678},
+		{A: 5.47e-09, B: 4.42021065522, C: 5331.3574437408},
+		{A: 4.33e-09, B: 1.25048504108, C: 4929.6853219836},
+		{A: 4.09e-09, B: 3.80689273098, C: 6681.2921637024},
+		{A: 4.03e-09, B: 1.5719023931, C: 6127.6554505572},
+		{A: 4.01e-09, B: 4.59976459753, C: 7210.9158184942},
+		{A: 4.06e-09, B: 0.60945437905, C: 11773.3768115154},
+		{A: 3.67e-09, B: 0.97726583907, C: 6041.3275670856},
+		{A: 3.61e-09, B: 0.13117924893, C: 639.897286314},
+		{A: 4.11e-09, B: 4.21323421517, C: 6681.1575430968},
+		{A: 4.41e-09, B: 4.49050100878, C: 13365.9728251482},
+		{A: 4.74e-09, B: 5.1856252413, C: 2301.58581590939},
+		{A: 3.34e-09, B: 2.21001303889, C: 10021.8545337516},
+		{A: 3.7e-09, B: 5.02880065186, C: 6923.9534573736},
+		{A: 3.33e-09, B: 0.18229887483, C: 10021.8200264472},
+		{A: 3.42e-09, B: 2.49988747611, C: 6438.4962494256},
+		{A: 3.2e-09, B: 1.3237447689, C: 2118.7638603784},
+		{A: 3.3e-09, B: 2.98027481579, C: 5729.506447149},
+		{A: 3.4e-09, B: 0.51409045792, C: 9866.4168806652},
+		{A: 2.83e-09, B: 4.03219455446, C: 8955.3418029094},
+		{A: 2.81e-09, B: 3.33891891806, C: 9830.3890139878},
+		{A: 2.71e-09, B: 1.53144358045, C: 1039.0266107904},
+		{A: 2.52e-09, B: 3.04356928941, C: 10818.1352869158},
+		{A: 2.7e-09, B: 2.22972724035, C: 5828.0284716476},
+		{A: 2.59e-09, B: 4.24406546278, C: 6894.5239488376},
+		{A: 2.37e-09, B: 5.07818982743, C: 8429.2412664666},
+		{A: 2.63e-09, B: 2.00552313665, C: 3767.2106175758},
+		{A: 2.41e-09, B: 4.06396704332, C: 7064.1213856228},
+		{A: 2.5e-09, B: 0.45422818547, C: 6298.3283211764},
+		{A: 2.4e-09, B: 1.94655459341, C: 6688.3384004004},
+		{A: 2.09e-09, B: 0.03043017984, C: 3914.9572250346},
+		{A: 2.18e-09, B: 4.5320181825, C: 26724.8994135984},
+		{A: 2.46e-09, B: 3.85272742042, C: 4562.4609930212},
+		{A: 2.54e-09, B: 1.19313236635, C: 8432.7643848156},
+		{A: 2.31e-09, B: 1.70340106651, C: 2942.4634232916},
+		{A: 2.09e-09, B: 3.82345999055, C: 6467.9257579616},
+		{A: 1.75e-09, B: 3.26444055581, C: 3981.490034082},
+		{A: 2.1e-09, B: 3.55052707697, C: 10575.4066829418},
+		{A: 1.62e-09, B: 5.95176683701, C: 310.8407988684},
+		{A: 1.65e-09, B: 5.04267055142, C: 10177.2576795336},
+		{A: 1.98e-09, B: 0.80464315638, C: 15113.9892382152},
+		{A: 1.88e-09, B: 1.99007233842, C: 3127.3133312618},
+		{A: 1.88e-09, B: 4.55406803143, C: 12964.300703391},
+		{A: 1.63e-09, B: 2.14874886056, C: 10973.55568635},
+		{A: 1.54e-09, B: 4.35708331036, C: 3360.96774609859},
+		{A: 1.69e-09, B: 2.40504327781, C: 3355.8648978848},
+		{A: 1.64e-09, B: 5.5387562097, C: 16173.3711684044},
+		{A: 1.44e-09, B: 0.30186831602, C: 1903.4368125012},
+		{A: 1.61e-09, B: 3.18977924032, C: 6674.1113063988},
+		{A: 1.35e-09, B: 1.65570006128, C: 3325.3599555148},
+		{A: 1.43e-09, B: 6.25825818399, C: 9595.2390892234},
+		{A: 1.58e-09, B: 6.1425560834, C: 8273.8208670324},
+		{A: 1.7e-09, B: 5.98413937993, C: 3320.257107301},
+		{A: 1.24e-09, B: 1.74571336137, C: 11081.2192102886},
+		{A: 1.46e-09, B: 2.22851709304, C: 3178.1457905676},
+		{A: 1.26e-09, B: 5.02927593525, C: 3475.6775067352},
+		{A: 1.23e-09, B: 4.99342648375, C: 13760.5987102074},
+		{A: 1.41e-09, B: 4.5673581549, C: 10021.7699697966},
+		{A: 1.18e-09, B: 5.42945437851, C: 13358.9265884502},
+		{A: 1.16e-09, B: 4.22080571309, C: 5884.9268465832},
+		{A: 1.19e-09, B: 4.09962692144, C: 10021.9045904022},
+		{A: 1.24e-09, B: 1.79723243306, C: 18984.2926300096},
+		{A: 1.35e-09, B: 1.00085140609, C: 18454.601664915},
+		{A: 1.06e-09, B: 5.76342924005, C: 2288.3440435114},
+		{A: 1.03e-09, B: 0.63812052706, C: 12310.1813236108},
+		{A: 1e-09, B: 4.3794694526, C: 9808.5381846614},
+		{A: 1.04e-09, B: 4.01151451052, C: 13916.0191096416},
+		{A: 1.25e-09, B: 4.94825577002, C: 10551.528245194},
+		{A: 9.8e-10, B: 5.91086068785, C: 14584.2982731206},
+		{A: 1.26e-09, B: 1.55752034021, C: 8270.2977486834},
+		{A: 9.8e-10, B: 2.5286981053, C: 3191.0492295652},
+		{A: 9.5e-10, B: 5.5080831162, C: 3319.8370312074},
+		{A: 1.08e-09, B: 1.67115668669, C: 11216.284290324},
+		{A: 9e-10, B: 1.71281301325, C: 1505.28780909299},
+		{A: 8.8e-10, B: 0.42472333366, C: 11371.7046897582},
+		{A: 8.9e-10, B: 4.83867235269, C: 16706.585251848},
+		{A: 1.06e-09, B: 1.78998252916, C: 10022.8176011676},
+		{A: 8.7e-10, B: 5.8960268815, C: 16858.4825329332},
+		{A: 8.5e-10, B: 0.55423657166, C: 13362.432453147},
+		{A: 9.1e-10, B: 1.61117547913, C: 24093.2767891752},
+		{A: 8.3e-10, B: 0.55328893146, C: 3344.2028553516},
+		{A: 8.3e-10, B: 1.06830368571, C: 3364.4908644476},
+		{A: 8.4e-10, B: 6.22980173043, C: 3369.0616141676},
+		{A: 8.5e-10, B: 2.58442459869, C: 13362.4669604514},
+		{A: 7.9e-10, B: 3.08636079495, C: 2818.035008606},
+		{A: 7.7e-10, B: 1.53791408869, C: 5459.3762870782},
+		{A: 7.5e-10, B: 3.34890581175, C: 9070.1188738488},
+		{A: 9.7e-10, B: 2.99973987655, C: 3316.733988952},
+		{A: 8.4e-10, B: 5.55309637085, C: 3427.9206312396},
+		{A: 8.9e-10, B: 4.16432726564, C: 6158.6474353058},
+		{A: 7.8e-10, B: 3.7237573039, C: 13171.0014406876},
+		{A: 8.3e-10, B: 0.11917380846, C: 3337.021998048},
+		{A: 7.3e-10, B: 4.91632010974, C: 13362.3823964964},
+		{A: 7.6e-10, B: 4.52961122356, C: 708.98980227659},
+		{A: 7.1e-10, B: 5.37905772348, C: 10264.5658840734},
+		{A: 8.4e-10, B: 1.93007660929, C: 9468.267877257},
+		{A: 7.3e-10, B: 5.28498987702, C: 4845.9002357928},
+		{A: 8e-10, B: 4.81193020727, C: 13149.1506113612},
+		{A: 7.6e-10, B: 4.77873149036, C: 8671.9698704406},
+		{A: 6.9e-10, B: 0.86312560839, C: 13207.029307365},
+		{A: 7e-10, B: 1.22001285505, C: 2274.1169495098},
+		{A: 7e-10, B: 0.53977528244, C: 13119.72110282519},
+		{A: 7e-10, B: 0.09801356525, C: 1437.1756141986},
+		{A: 6.9e-10, B: 2.23597403243, C: 7107.8230442756},
+		{A: 7.4e-10, B: 0.67876411085, C: 16460.33352952499},
+		{A: 6.8e-10, B: 0.5439361719, C: 12935.8515159232},
+		{A: 8.4e-10, B: 5.1161735664, C: 13892.1406718938},
+		{A: 7.8e-10, B: 5.27206373031, C: 853.196381752},
+		{A: 6.5e-10, B: 4.74504626032, C: 6144.4203413042},
+		{A: 6.5e-10, B: 2.89744951086, C: 7903.073419721},
+		{A: 8.9e-10, B: 5.44755326514, C: 13517.8701062334},
+		{A: 7.3e-10, B: 3.81554166604, C: 3503.079062832},
+		{A: 6.6e-10, B: 0.47312197978, C: 3980.5097130138},
+		{A: 6.5e-10, B: 1.38901912957, C: 3253.30422216},
+		{A: 8.6e-10, B: 5.44050231013, C: 6816.289933435},
+		{A: 7.2e-10, B: 3.44697351738, C: 14158.7477136156},
+		{A: 8.6e-10, B: 4.50213985772, C: 13362.517017102},
+		{A: 7.4e-10, B: 1.34701853675, C: 3361.3878221922},
+		{A: 7.6e-10, B: 6.0400295943, C: 5085.038411115},
+		{A: 6.5e-10, B: 0.07623776004, C: 5099.2655051166},
+		{A: 6.6e-10, B: 2.0916071729, C: 14421.8316369884},
+		{A: 7.7e-10, B: 2.75740817982, C: 19402.7969528166},
+		{A: 5.9e-10, B: 3.61679189501, C: 7322.1024607818},
+		{A: 6e-10, B: 4.67079289372, C: 10235.1363755374},
+		{A: 6.4e-10, B: 6.17220244155, C: 9381.9399937854},
+		{A: 6.6e-10, B: 0.35824154106, C: 7255.5696517344},
+		{A: 5.9e-10, B: 4.52818219212, C: 9499.2598620056},
+		{A: 6.5e-10, B: 1.86765892646, C: 11610.9101753832},
+		{A: 7.4e-10, B: 3.12276411101, C: 1107.1388056848},
+		{A: 6e-10, B: 1.35069679183, C: 19800.9459562248},
+		{A: 5.5e-10, B: 0.64581579779, C: 6677.6344247478},
+		{A: 5.5e-10, B: 0.65206957868, C: 7373.3824546264},
+		{A: 5.5e-10, B: 0.41979092764, C: 9779.1086761254},
+		{A: 7.4e-10, B: 3.8527624282, C: 2766.267628365},
+		{A: 5.6e-10, B: 2.49261586879, C: 5642.1982426092},
+		{A: 5.5e-10, B: 2.62555306434, C: 14314.1681130498},
+		{A: 5.4e-10, B: 3.95212835807, C: 4032.7700279266},
+		{A: 5.5e-10, B: 0.51062350773, C: 15110.4661198662},
+		{A: 5.3e-10, B: 5.88757767795, C: 21265.5231265202},
+		{A: 5.1e-10, B: 5.99253934388, C: 21947.1113727},
+		{A: 4.9e-10, B: 5.16723732129, C: 20047.1976785478},
+		{A: 6.7e-10, B: 1.53758821512, C: 9380.9596727172},
+		{A: 5.7e-10, B: 5.34893894346, C: 17101.2111369072},
+		{A: 4.9e-10, B: 1.9267860277, C: 12721.572099417},
+		{A: 4.6e-10, B: 2.3159032048, C: 10028.9508271002},
+		{A: 5.2e-10, B: 4.92463460288, C: 16304.9131300908},
+		{A: 5e-10, B: 1.69803302925, C: 9225.539273283},
+		{A: 4.5e-10, B: 2.91271003676, C: 8982.810669309},
+		{A: 4.4e-10, B: 4.38728556203, C: 12168.0026965746},
+		{A: 4.7e-10, B: 4.44933635857, C: 6872.6731195112},
+		{A: 4.3e-10, B: 2.13295656057, C: 22324.9050567094},
+		{A: 5e-10, B: 1.34759191325, C: 8439.8779318164},
+		{A: 4.4e-10, B: 0.89037464728, C: 20752.6643624754},
+		{A: 4.1e-10, B: 2.76164156557, C: 6696.4773245846},
+		{A: 4.2e-10, B: 4.45031185062, C: 10404.7338123226},
+		{A: 4.4e-10, B: 3.20262780268, C: 22743.4093795164},
+		{A: 4.2e-10, B: 3.54559408987, C: 30376.3526391666},
+		{A: 4.2e-10, B: 0.82118836477, C: 20597.2439630412},
+		{A: 5.2e-10, B: 4.59037852162, C: 22345.2603761082},
+		{A: 4.2e-10, B: 3.79872109079, C: 28628.3362260996},
+		{A: 4.1e-10, B: 2.3625360208, C: 16062.1845261168},
+		{A: 5e-10, B: 1.48377570574, C: 6040.3472460174},
+		{A: 4.1e-10, B: 5.33088551342, C: 765.7930644464},
+		{A: 3.9e-10, B: 1.57984331116, C: 24889.5747959916},
+		{A: 3.8e-10, B: 0.21571600948, C: 9168.6408983474},
+		{A: 3.8e-10, B: 3.47667842127, C: 1066.49547719},
+		{A: 5.2e-10, B: 0.81427285458, C: 9638.9407478762},
+		{A: 4.1e-10, B: 0.04356010851, C: 6660.8695340008},
+		{A: 4.6e-10, B: 0.89345529755, C: 16703.0448798468},
+		{A: 4.4e-10, B: 0.85335841824, C: 17468.8551979454},
+		{A: 3.9e-10, B: 0.27098916103, C: 11614.4332937322},
+		{A: 3.6e-10, B: 3.93388136028, C: 33716.9650658664},
+		{A: 4.7e-10, B: 3.90896957151, C: 5244.049239201},
+		{A: 3.4e-10, B: 2.37310468308, C: 10213.285546211},
+		{A: 3.4e-10, B: 4.85454495742, C: 14469.588512484},
+		{A: 3.5e-10, B: 1.96497348634, C: 6702.000248892},
+		{A: 3.3e-10, B: 2.14488758889, C: 13363.4300278674},
+		{A: 4.6e-10, B: 2.92422266239, C: 16703.07938715119},
+		{A: 3.5e-10, B: 1.78692835372, C: 2648.454825473},
+		{A: 3.3e-10, B: 4.24484480826, C: 8584.6616659008},
+		{A: 4.3e-10, B: 6.09391349922, C: 6660.4494579072},
+		{A: 3.5e-10, B: 5.72426647579, C: 16699.53901514999},
+		{A: 3.1e-10, B: 1.95254273311, C: 6665.9723822146},
+		{A: 2.8e-10, B: 5.88548900893, C: 19513.9835951042},
+		{A: 3e-10, B: 4.38908125588, C: 12295.9542296092},
+		{A: 2.9e-10, B: 6.25276686131, C: 17924.9106998204},
+		{A: 2.5e-10, B: 4.89000247975, C: 30065.5118402982},
+		{A: 2.6e-10, B: 2.43119321236, C: 6518.7582172674},
+		{A: 2.8e-10, B: 3.97032105354, C: 4407.1079038898},
+		{A: 2.5e-10, B: 1.88631752737, C: 4379.6390374902},
+		{A: 2.8e-10, B: 3.06196380356, C: 6247.5131155228},
+		{A: 2.9e-10, B: 5.98057498931, C: 6680.2445323314},
+		{A: 3.2e-10, B: 4.65556810177, C: 6701.5801727984},
+		{A: 2.6e-10, B: 0.76018385758, C: 3863.1898447936},
+		{A: 2.3e-10, B: 0.31107548102, C: 8827.3902698748},
+		{A: 2.2e-10, B: 1.11553014451, C: 6684.8152820514},
+		{A: 2e-10, B: 4.81193192299, C: 15508.6151232744},
+		{A: 1.9e-10, B: 5.11656455993, C: 12012.5822971404},
+		{A: 2.6e-10, B: 1.07254469525, C: 8425.6508378148},
+		{A: 2.1e-10, B: 2.68436255141, C: 6261.7402095244},
+		{A: 2.2e-10, B: 2.15631095909, C: 3335.0895023924},
+		{A: 1.7e-10, B: 0.03684125273, C: 3877.4169387952},
+		{A: 1.7e-10, B: 2.84467149903, C: 3344.4937620578},
+		{A: 2e-10, B: 5.41519706836, C: 3205.5473466644},
+	},
+	// L3, 129 terms
+	{
+		{A: 1.482423e-05, B: 0.44434694876, C: 3340.6124266998},
+		{A: 6.62095e-06, B: 0.88469178686, C: 6681.2248533996},
+		{A: 1.88268e-06, B: 1.28799982497, C: 10021.8372800994},
+		{A: 4.1474e-07, B: 1.64850786997, C: 13362.4497067992},
+		{A: 2.2661e-07, B: 2.05267665262, C: 155.4203994342},
+		{A: 2.5994e-07, B: 0, C: 0},
+		{A: 8.024e-08, B: 1.99858757687, C: 16703.062133499},
+		{A: 1.0454e-07, B: 1.58006906385, C: 3.523118349},
+		{A: 4.9e-08, B: 2.82452457966, C: 242.728603974},
+		{A: 3.782e-08, B: 2.01914272515, C: 3344.1355450488},
+		{A: 3.176e-08, B: 4.59144897927, C: 3185.1920272656},
+		{A: 3.134e-08, B: 0.65044714325, C: 553.5694028424},
+		{A: 1.684e-08, B: 5.53835848782, C: 951.7184062506},
+		{A: 1.511e-08, B: 5.71795850828, C: 191.4482661116},
+		{A: 1.448e-08, B: 0.45869142895, C: 796.2980068164},
+		{A: 1.442e-08, B: 2.34368495577, C: 20043.6745601988},
+		{A: 1.302e-08, B: 5.36284013048, C: 0.9803210682},
+		{A: 1.169e-08, B: 4.14601161433, C: 1349.8674096588},
+		{A: 1.133e-08, B: 2.38180830662, C: 6684.7479717486},
+		{A: 1.037e-08, B: 1.76892750558, C: 382.8965322232},
+		{A: 8.94e-09, B: 5.33688328934, C: 1194.4470102246},
+		{A: 8.07e-09, B: 2.74798886181, C: 1748.016413067},
+		{A: 6.4e-09, B: 6.10665147849, C: 3496.032826134},
+		{A: 5.58e-09, B: 1.8521234236, C: 398.1490034082},
+		{A: 5.67e-09, B: 5.85922384979, C: 7.1135470008},
+		{A: 6.47e-09, B: 3.17645475605, C: 3583.3410306738},
+		{A: 4.52e-09, B: 5.98109989317, C: 2787.0430238574},
+		{A: 5.08e-09, B: 1.01139298015, C: 3149.1641605882},
+		{A: 5.19e-09, B: 4.93376176788, C: 6525.8044539654},
+		{A: 4.05e-09, B: 1.27295444059, C: 2281.2304965106},
+		{A: 3.99e-09, B: 2.32888685659, C: 3738.761430108},
+		{A: 4.72e-09, B: 0.84411483892, C: 4136.9104335162},
+		{A: 3.33e-09, B: 5.42704539231, C: 1059.3819301892},
+		{A: 3.13e-09, B: 3.70599897858, C: 3097.88382272579},
+		{A: 2.81e-09, B: 5.74581724084, C: 3341.592747768},
+		{A: 2.87e-09, B: 2.69304799864, C: 10025.3603984484},
+		{A: 2.37e-09, B: 5.68519881994, C: 4535.0594369244},
+		{A: 2.15e-09, B: 2.49494803822, C: 1990.745017041},
+		{A: 2.6e-09, B: 2.67996877129, C: 23384.2869868986},
+		{A: 2.69e-09, B: 1.10390153866, C: 2388.8940204492},
+		{A: 1.86e-09, B: 4.86408411823, C: 2957.7158944766},
+		{A: 1.76e-09, B: 3.86618588087, C: 1592.5960136328},
+		{A: 1.77e-09, B: 0.85374318134, C: 3894.1818295422},
+		{A: 1.91e-09, B: 4.58805692093, C: 3337.0893083508},
+		{A: 1.85e-09, B: 5.8089931655, C: 4399.994356889},
+		{A: 1.43e-09, B: 1.15592612974, C: 7477.522860216},
+		{A: 1.73e-09, B: 2.70622920014, C: 7079.3738568078},
+		{A: 1.46e-09, B: 1.37569151302, C: 6489.776587288},
+		{A: 1.21e-09, B: 3.24333934982, C: 9492.1463150048},
+		{A: 1.16e-09, B: 5.7163981953, C: 12303.06777661},
+		{A: 1.11e-09, B: 2.69566947038, C: 6151.533888305},
+		{A: 1.03e-09, B: 3.53587741373, C: 6923.9534573736},
+		{A: 9.7e-10, B: 5.23712981002, C: 8962.4553499102},
+		{A: 8.9e-10, B: 0.0595132422, C: 6127.6554505572},
+		{A: 9.3e-10, B: 5.29818155587, C: 9866.4168806652},
+		{A: 8.4e-10, B: 5.68544044325, C: 4292.3308329504},
+		{A: 9.1e-10, B: 6.16223680965, C: 6682.2051744678},
+		{A: 8.1e-10, B: 4.3272333062, C: 4933.2084403326},
+		{A: 7.7e-10, B: 5.6318982417, C: 1589.0728952838},
+		{A: 1.02e-09, B: 6.26937663026, C: 3347.7259737006},
+		{A: 7.6e-10, B: 3.05724276091, C: 13365.9728251482},
+		{A: 6.3e-10, B: 3.12770931753, C: 10419.9862835076},
+		{A: 6.2e-10, B: 1.45647168723, C: 7234.794256242},
+		{A: 6.9e-10, B: 4.82266605869, C: 6677.7017350506},
+		{A: 6.4e-10, B: 1.73713448446, C: 3870.3033917944},
+		{A: 5.8e-10, B: 4.85723088492, C: 5486.777843175},
+		{A: 5.8e-10, B: 6.0984951679, C: 7875.6718636242},
+		{A: 6.3e-10, B: 4.14716431158, C: 3340.5951730476},
+		{A: 6.5e-10, B: 6.17932665318, C: 3340.629680352},
+		{A: 5.7e-10, B: 1.48905992502, C: 5729.506447149},
+		{A: 6e-10, B: 6.08710604997, C: 7740.6067835888},
+		{A: 5.6e-10, B: 1.96253427165, C: 3553.9115221378},
+		{A: 5.9e-10, B: 0.89874385953, C: 3339.6321056316},
+		{A: 5.3e-10, B: 3.04776975379, C: 26724.8994135984},
+		{A: 6.1e-10, B: 3.63390789623, C: 12832.7587417046},
+		{A: 5.9e-10, B: 0.2298974604, C: 6681.2421070518},
+		{A: 5.3e-10, B: 3.97996929188, C: 5092.1519581158},
+		{A: 5.9e-10, B: 4.48255178273, C: 6681.2075997474},
+		{A: 4.5e-10, B: 3.88055745121, C: 10264.5658840734},
+		{A: 5.8e-10, B: 4.85371375265, C: 5621.8429232104},
+		{A: 4.9e-10, B: 4.03623343126, C: 16173.3711684044},
+		{A: 6.1e-10, B: 2.90942510134, C: 5331.3574437408},
+		{A: 4.3e-10, B: 1.8099071834, C: 9830.3890139878},
+		{A: 4.4e-10, B: 4.10828944542, C: 4690.4798363586},
+		{A: 4.4e-10, B: 3.49332765553, C: 13760.5987102074},
+		{A: 4.8e-10, B: 1.81207342615, C: 3723.508958923},
+		{A: 4.1e-10, B: 1.59906754314, C: 10818.1352869158},
+		{A: 4.1e-10, B: 2.05797417369, C: 6681.2921637024},
+		{A: 3.8e-10, B: 3.38619280993, C: 16706.585251848},
+		{A: 4.1e-10, B: 2.53336616026, C: 6681.1575430968},
+		{A: 4.1e-10, B: 1.79026132252, C: 3340.545116397},
+		{A: 5e-10, B: 4.7032478409, C: 8273.8208670324},
+		{A: 3.7e-10, B: 3.37898394417, C: 30065.5118402982},
+		{A: 3.9e-10, B: 2.65566008587, C: 6894.5239488376},
+		{A: 3.5e-10, B: 2.58475025674, C: 9623.6882766912},
+		{A: 3.7e-10, B: 6.07442797404, C: 4929.6853219836},
+		{A: 4.2e-10, B: 3.04642561189, C: 12964.300703391},
+		{A: 3.7e-10, B: 5.23784488646, C: 6298.3283211764},
+		{A: 4.3e-10, B: 6.17835198533, C: 15643.6802033098},
+		{A: 3.4e-10, B: 2.44806511268, C: 7210.9158184942},
+		{A: 3.8e-10, B: 0.26295105909, C: 10022.8176011676},
+		{A: 2.8e-10, B: 0.74281728305, C: 10021.8545337516},
+		{A: 2.8e-10, B: 4.99536302577, C: 10021.8200264472},
+		{A: 3.4e-10, B: 5.04118058624, C: 8969.568896911},
+		{A: 2.5e-10, B: 2.59849002421, C: 8955.3418029094},
+		{A: 3e-10, B: 1.14354290938, C: 2146.1654164752},
+		{A: 2.7e-10, B: 0.26980783576, C: 18984.2926300096},
+		{A: 2.5e-10, B: 0.39810308575, C: 6688.3384004004},
+		{A: 2.4e-10, B: 5.64558695441, C: 13207.029307365},
+		{A: 2.7e-10, B: 6.24398988438, C: 5088.6288397668},
+		{A: 2.4e-10, B: 0.25455613132, C: 11081.2192102886},
+		{A: 2.3e-10, B: 0.53673547304, C: 3333.498879699},
+		{A: 2.4e-10, B: 1.80155161992, C: 6674.1113063988},
+		{A: 2.9e-10, B: 4.3995350792, C: 10018.3141617504},
+		{A: 2.3e-10, B: 2.91040060956, C: 10551.528245194},
+		{A: 2.2e-10, B: 1.20895727897, C: 529.6909650946},
+		{A: 2.6e-10, B: 0.08254324904, C: 7632.9432596502},
+		{A: 2.6e-10, B: 1.81668969835, C: 6283.0758499914},
+		{A: 2.1e-10, B: 0.67232850824, C: 6836.6452528338},
+		{A: 1.7e-10, B: 5.19518401928, C: 2914.0142358238},
+		{A: 1.7e-10, B: 5.16488977775, C: 8031.0922630584},
+		{A: 2.1e-10, B: 3.62973082412, C: 5884.9268465832},
+		{A: 1.8e-10, B: 5.06870872024, C: 2544.3144198834},
+		{A: 1.4e-10, B: 2.50522181917, C: 7064.1213856228},
+		{A: 1.7e-10, B: 0.4183559895, C: 9468.267877257},
+		{A: 1.1e-10, B: 2.21363101654, C: 6438.4962494256},
+		{A: 1.3e-10, B: 1.38424462832, C: 3340.6797370026},
+		{A: 1.2e-10, B: 3.28248484262, C: 8671.9698704406},
+		{A: 1.4e-10, B: 0.23027665815, C: 3767.2106175758},
+	},
+	// L4, 36 terms
+	{
+		{A: 1.13969e-06, B: 3.14159265359, C: 0},
+		{A: 2.8725e-07, B: 5.63662412043, C: 6681.2248533996},
+		{A: 2.4447e-07, B: 5.13868481454, C: 3340.6124266998},
+		{A: 1.1187e-07, B: 6.03161074431, C: 10021.8372800994},
+		{A: 3.19e-08, B: 3.56267988299, C: 155.4203994342},
+		{A: 3.252e-08, B: 0.13228350651, C: 13362.4497067992},
+		{A: 7.87e-09, B: 0.49340783377, C: 16703.062133499},
+		{A: 7.76e-09, B: 1.31734531594, C: 242.728603974},
+		{A: 4.94e-09, B: 3.06356214498, C: 3185.1920272656},
+		{A: 3.74e-09, B: 2.15785846355, C: 553.5694028424},
+		{A: 3.31e-09, B: 6.23159792887, C: 3.523118349},
+		{A: 1.97e-09, B: 0.44350153983, C: 3344.1355450488},
+		{A: 1.81e-09, B: 0.81531283571, C: 20043.6745601988},
+		{A: 1.68e-09, B: 3.73509781785, C: 3496.032826134},
+		{A: 8.6e-10, B: 0.79259553758, C: 6684.7479717486},
+		{A: 1.15e-09, B: 1.66898531261, C: 3583.3410306738},
+		{A: 9.2e-10, B: 3.40530361815, C: 6525.8044539654},
+		{A: 6.4e-10, B: 4.47443580658, C: 2787.0430238574},
+		{A: 4.5e-10, B: 5.17216217058, C: 3097.88382272579},
+		{A: 4.1e-10, B: 1.21875027733, C: 23384.2869868986},
+		{A: 3.6e-10, B: 5.53975653407, C: 3149.1641605882},
+		{A: 3.9e-10, B: 5.40966345885, C: 4136.9104335162},
+		{A: 2.8e-10, B: 4.85378781404, C: 6127.6554505572},
+		{A: 2.7e-10, B: 0.42811091036, C: 3738.761430108},
+		{A: 3.2e-10, B: 1.22337093927, C: 10025.3603984484},
+		{A: 2.5e-10, B: 1.56348878811, C: 26724.8994135984},
+		{A: 2.9e-10, B: 2.40178626971, C: 3894.1818295422},
+		{A: 2.3e-10, B: 4.16371253947, C: 3341.592747768},
+		{A: 2.2e-10, B: 5.98429191667, C: 7477.522860216},
+		{A: 1.9e-10, B: 2.03228980685, C: 6923.9534573736},
+		{A: 2e-10, B: 3.79275573433, C: 9866.4168806652},
+		{A: 2.1e-10, B: 4.27532111122, C: 6836.6452528338},
+		{A: 1.5e-10, B: 1.56315497374, C: 13365.9728251482},
+		{A: 1.1e-10, B: 1.33261955275, C: 2281.2304965106},
+		{A: 9e-11, B: 6.15390464542, C: 6489.776587288},
+		{A: 7e-11, B: 4.23894194106, C: 4535.0594369244},
+	},
+	// L5, 15 terms
+	{
+		{A: 7.1e-09, B: 4.04089996521, C: 6681.2248533996},
+		{A: 8.68e-09, B: 3.14159265359, C: 0},
+		{A: 5.1e-09, B: 4.49214901625, C: 10021.8372800994},
+		{A: 3.57e-09, B: 5.07435505061, C: 155.4203994342},
+		{A: 2.23e-09, B: 3.51351884241, C: 3340.6124266998},
+		{A: 1.99e-09, B: 4.85313666795, C: 13362.4497067992},
+		{A: 1e-09, B: 6.09089356066, C: 242.728603974},
+		{A: 6.9e-10, B: 5.19017483537, C: 16703.062133499},
+		{A: 6.4e-10, B: 1.55783055571, C: 3185.1920272656},
+		{A: 3.5e-10, B: 3.68246171643, C: 553.5694028424},
+		{A: 2.5e-10, B: 5.22079788019, C: 3496.032826134},
+		{A: 2.4e-10, B: 0.17861991485, C: 3583.3410306738},
+		{A: 1.9e-10, B: 5.5918471446, C: 20043.6745601988},
+		{A: 1.4e-10, B: 5.95565787085, C: 23384.2869868986},
+		{A: 1.2e-10, B: 1.93859256739, C: 6525.8044539654},
+	},
+}
+
+// GetMarsL0 evaluates the t^0 series of L.
+func GetMarsL0(t float64) float64 {
+	return marsL[0].Evaluate(t)
+}
+
+// GetMarsL1 evaluates the t^1 series of L.
+func GetMarsL1(t float64) float64 {
+	return marsL[1].Evaluate(t)
+}
+
+// GetMarsL2 evaluates the t^2 series of L.
+func GetMarsL2(t float64) float64 {
+	return marsL[2].Evaluate(t)
+}
+
+// GetMarsL3 evaluates the t^3 series of L.
+func GetMarsL3(t float64) float64 {
+	return marsL[3].Evaluate(t)
+}
+
+// GetMarsL4 evaluates the t^4 series of L.
+func GetMarsL4(t float64) float64 {
+	return marsL[4].Evaluate(t)
+}
+
+// GetMarsL5 evaluates the t^5 series of L.
+func GetMarsL5(t float64) float64 {
+	return marsL[5].Evaluate(t)
+}
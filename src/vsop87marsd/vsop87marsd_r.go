@@ -0,0 +1,2234 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// MARS R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87marsd
+
+import "vsop87"
+
+var marsR = []vsop87.Series{
+	// R0, 1118 terms
+	{
+		{A: 1.53033488276, B: 0, C: 0},
+		{A: 0.14184953153, B: 3.47971283519, C: 3340.6124266998},
+		{A: 0.00660776357, B: 3.81783442097, C: 6681.2248533996},
+		{A: 0.00046179117, B: 4.15595316284, C: 10021.8372800994},
+		{A: 8.109738e-05, B: 5.55958460165, C: 2810.9214616052},
+		{A: 7.485315e-05, B: 1.77238998069, C: 5621.8429232104},
+		{A: 5.523193e-05, B: 1.3643631888, C: 2281.2304965106},
+		{A: 3.82516e-05, B: 4.49407182408, C: 13362.4497067992},
+		{A: 2.306539e-05, B: 0.09081742493, C: 2544.3144198834},
+		{A: 1.999399e-05, B: 5.36059605227, C: 3337.0893083508},
+		{A: 2.484385e-05, B: 4.92545577893, C: 2942.4634232916},
+		{A: 1.960198e-05, B: 4.74249386323, C: 3344.1355450488},
+		{A: 1.167115e-05, B: 2.11261501155, C: 5092.1519581158},
+		{A: 1.102828e-05, B: 5.0090826416, C: 398.1490034082},
+		{A: 8.99077e-06, B: 4.40790433994, C: 529.6909650946},
+		{A: 9.92252e-06, B: 5.83862401067, C: 6151.533888305},
+		{A: 8.07348e-06, B: 2.10216647104, C: 1059.3819301892},
+		{A: 7.9791e-06, B: 3.44839026172, C: 796.2980068164},
+		{A: 7.4098e-06, B: 1.49906336892, C: 2146.1654164752},
+		{A: 6.9234e-06, B: 2.13378814785, C: 8962.4553499102},
+		{A: 6.33144e-06, B: 0.89353285018, C: 3340.5951730476},
+		{A: 7.25583e-06, B: 1.24516913473, C: 8432.7643848156},
+		{A: 6.3314e-06, B: 2.92430448169, C: 3340.629680352},
+		{A: 5.74352e-06, B: 0.82896196337, C: 2914.0142358238},
+		{A: 5.26187e-06, B: 5.38292276228, C: 3738.761430108},
+		{A: 6.29976e-06, B: 1.28738135858, C: 1751.539531416},
+		{A: 4.72776e-06, B: 5.19850457873, C: 3127.3133312618},
+		{A: 3.48095e-06, B: 4.83219198908, C: 16703.062133499},
+		{A: 2.83702e-06, B: 2.90692294913, C: 3532.0606928114},
+		{A: 2.79552e-06, B: 5.25749247548, C: 6283.0758499914},
+		{A: 2.33827e-06, B: 5.10546492529, C: 5486.777843175},
+		{A: 2.19428e-06, B: 5.58340248784, C: 191.4482661116},
+		{A: 2.69891e-06, B: 3.76394728622, C: 5884.9268465832},
+		{A: 2.08333e-06, B: 5.25476080773, C: 3340.545116397},
+		{A: 2.75224e-06, B: 2.90818883832, C: 1748.016413067},
+		{A: 2.75501e-06, B: 1.21767967781, C: 6254.6266625236},
+		{A: 2.39133e-06, B: 2.03669896238, C: 1194.4470102246},
+		{A: 2.2319e-06, B: 4.19861593779, C: 3149.1641605882},
+		{A: 1.82686e-06, B: 5.08062683355, C: 6684.7479717486},
+		{A: 1.86213e-06, B: 5.69871555748, C: 6677.7017350506},
+		{A: 1.75995e-06, B: 5.95341786369, C: 3870.3033917944},
+		{A: 1.78613e-06, B: 4.18423025538, C: 3333.498879699},
+		{A: 2.08336e-06, B: 4.84626442122, C: 3340.6797370026},
+		{A: 2.28128e-06, B: 3.2552902062, C: 6872.6731195112},
+		{A: 1.44286e-06, B: 0.21296012258, C: 5088.6288397668},
+		{A: 1.63534e-06, B: 3.79889068111, C: 4136.9104335162},
+		{A: 1.3312e-06, B: 1.5391010671, C: 7903.073419721},
+		{A: 1.41759e-06, B: 2.47790321309, C: 4562.4609930212},
+		{A: 1.14941e-06, B: 4.31745088059, C: 1349.8674096588},
+		{A: 1.18781e-06, B: 2.12178071222, C: 1589.0728952838},
+		{A: 1.02096e-06, B: 6.18138550087, C: 9492.1463150048},
+		{A: 1.28555e-06, B: 5.49883294915, C: 8827.3902698748},
+		{A: 1.11538e-06, B: 0.55339169625, C: 11243.6858464208},
+		{A: 8.2498e-07, B: 1.6222704459, C: 11773.3768115154},
+		{A: 8.3212e-07, B: 0.61553380568, C: 8429.2412664666},
+		{A: 8.447e-07, B: 0.6227459311, C: 1592.5960136328},
+		{A: 8.6659e-07, B: 1.74988330093, C: 2700.7151403858},
+		{A: 7.1826e-07, B: 2.47489899385, C: 12303.06777661},
+		{A: 8.5312e-07, B: 1.61621097912, C: 4690.4798363586},
+		{A: 6.3641e-07, B: 2.67334126661, C: 426.598190876},
+		{A: 6.8599e-07, B: 2.40197828418, C: 4399.994356889},
+		{A: 5.8559e-07, B: 4.72052787516, C: 213.299095438},
+		{A: 6.2015e-07, B: 1.10065866221, C: 1221.8485663214},
+		{A: 6.6509e-07, B: 2.21307705185, C: 6041.3275670856},
+		{A: 5.5811e-07, B: 1.23288325946, C: 3185.1920272656},
+		{A: 5.4989e-07, B: 5.72691385306, C: 951.7184062506},
+		{A: 5.2418e-07, B: 3.02366828926, C: 4292.3308329504},
+		{A: 5.5686e-07, B: 5.44686699242, C: 3723.508958923},
+		{A: 5.8959e-07, B: 3.26242666052, C: 6681.2421070518},
+		{A: 4.4629e-07, B: 2.0147364039, C: 8031.0922630584},
+		{A: 5.8959e-07, B: 1.23165502899, C: 6681.2075997474},
+		{A: 4.2444e-07, B: 2.26551590902, C: 155.4203994342},
+		{A: 3.8956e-07, B: 2.57760416009, C: 3341.592747768},
+		{A: 5.1561e-07, B: 5.72326937712, C: 7079.3738568078},
+		{A: 4.8939e-07, B: 5.61614696751, C: 3553.9115221378},
+		{A: 4.5414e-07, B: 5.43290921705, C: 6467.9257579616},
+		{A: 3.6435e-07, B: 4.43921812388, C: 3894.1818295422},
+		{A: 3.598e-07, B: 1.15966567007, C: 2288.3440435114},
+		{A: 3.5265e-07, B: 5.49029710802, C: 1990.745017041},
+		{A: 4.2191e-07, B: 1.6325374276, C: 5628.9564702112},
+		{A: 4.4292e-07, B: 5.0034136685, C: 5614.7293762096},
+		{A: 3.3623e-07, B: 5.17029029766, C: 20043.6745601988},
+		{A: 4.3256e-07, B: 1.03732072925, C: 11769.8536931664},
+		{A: 3.9237e-07, B: 1.24237122859, C: 3339.6321056316},
+		{A: 3.1943e-07, B: 4.59258406791, C: 2274.1169495098},
+		{A: 3.0345e-07, B: 2.4417767013, C: 11371.7046897582},
+		{A: 3.2259e-07, B: 2.38215172582, C: 4535.0594369244},
+		{A: 3.187e-07, B: 4.37521442752, C: 3.523118349},
+		{A: 2.935e-07, B: 4.06034813442, C: 3097.88382272579},
+		{A: 3.1972e-07, B: 1.93970478412, C: 382.8965322232},
+		{A: 2.6166e-07, B: 5.58466944895, C: 9623.6882766912},
+		{A: 2.7904e-07, B: 4.25805969214, C: 3191.0492295652},
+		{A: 3.3065e-07, B: 0.85467740581, C: 553.5694028424},
+		{A: 2.7543e-07, B: 1.57668567401, C: 9595.2390892234},
+		{A: 2.5159e-07, B: 0.81355213242, C: 10713.9948813262},
+		{A: 2.207e-07, B: 0.85747723964, C: 3319.8370312074},
+		{A: 2.4772e-07, B: 5.38970742761, C: 2818.035008606},
+		{A: 2.3359e-07, B: 6.01453778225, C: 3496.032826134},
+		{A: 2.4732e-07, B: 2.58034797703, C: 2803.8079146044},
+		{A: 1.9365e-07, B: 5.18528750472, C: 6681.2921637024},
+		{A: 1.9122e-07, B: 5.41968559451, C: 10025.3603984484},
+		{A: 1.9364e-07, B: 5.59378382138, C: 6681.1575430968},
+		{A: 1.833e-07, B: 5.7956732424, C: 7064.1213856228},
+		{A: 1.8193e-07, B: 5.61307426173, C: 7.1135470008},
+		{A: 2.0392e-07, B: 4.53637816869, C: 6489.776587288},
+		{A: 2.126e-07, B: 6.19160142215, C: 14054.607308026},
+		{A: 1.7094e-07, B: 1.55004739305, C: 2957.7158944766},
+		{A: 2.2791e-07, B: 3.41709388606, C: 7632.9432596502},
+		{A: 2.0585e-07, B: 2.98697279083, C: 3361.3878221922},
+		{A: 1.8005e-07, B: 2.81431094394, C: 4032.7700279266},
+		{A: 1.7049e-07, B: 6.15528099726, C: 10404.7338123226},
+		{A: 1.6488e-07, B: 3.84534700818, C: 10973.55568635},
+		{A: 1.6052e-07, B: 0.92823508003, C: 14584.2982731206},
+		{A: 2.1027e-07, B: 2.38474290907, C: 4989.0591838972},
+		{A: 1.6267e-07, B: 1.92321585819, C: 7373.3824546264},
+		{A: 1.6291e-07, B: 6.28233085307, C: 7210.9158184942},
+		{A: 1.8585e-07, B: 4.07325116588, C: 2388.8940204492},
+		{A: 1.5977e-07, B: 4.58368417141, C: 3264.3463554242},
+		{A: 1.9913e-07, B: 2.7351844595, C: 5099.2655051166},
+		{A: 1.9661e-07, B: 1.86285979, C: 3443.7052009184},
+		{A: 1.65e-07, B: 4.14061745086, C: 7477.522860216},
+		{A: 1.9495e-07, B: 6.03778234182, C: 10018.3141617504},
+		{A: 1.5104e-07, B: 2.65433427561, C: 2787.0430238574},
+		{A: 1.9099e-07, B: 0.22623441108, C: 13745.3462390224},
+		{A: 1.7163e-07, B: 3.18825562972, C: 3347.7259737006},
+		{A: 1.3423e-07, B: 2.12818658793, C: 3344.2028553516},
+		{A: 1.541e-07, B: 2.2077350796, C: 2118.7638603784},
+		{A: 1.7238e-07, B: 3.67067776368, C: 3205.5473466644},
+		{A: 1.3113e-07, B: 4.27490214998, C: 14314.1681130498},
+		{A: 1.6451e-07, B: 2.86641622696, C: 14712.317116458},
+		{A: 1.3734e-07, B: 1.68629769646, C: 3337.021998048},
+		{A: 1.6659e-07, B: 4.52130808861, C: 6674.1113063988},
+		{A: 1.183e-07, B: 0.19684525299, C: 3475.6775067352},
+		{A: 1.1767e-07, B: 3.22897247987, C: 5828.0284716476},
+		{A: 1.1886e-07, B: 4.82057654742, C: 7234.794256242},
+		{A: 1.0609e-07, B: 1.73997337551, C: 639.897286314},
+		{A: 1.1154e-07, B: 0.23859830185, C: 12832.7587417046},
+		{A: 1.103e-07, B: 0.4454170644, C: 10213.285546211},
+		{A: 1.024e-07, B: 5.74758340632, C: 242.728603974},
+		{A: 1.0051e-07, B: 2.45102946726, C: 4929.6853219836},
+		{A: 1.0061e-07, B: 0.78907665448, C: 9381.9399937854},
+		{A: 1.0065e-07, B: 5.37506605762, C: 5085.038411115},
+		{A: 1.1902e-07, B: 0.79897698904, C: 3265.8308281325},
+		{A: 8.99e-08, B: 0.96463418322, C: 4933.2084403326},
+		{A: 8.97e-08, B: 4.18326774405, C: 9225.539273283},
+		{A: 8.982e-08, B: 1.98501418026, C: 15113.9892382152},
+		{A: 8.324e-08, B: 1.93694866513, C: 1648.4467571974},
+		{A: 7.833e-08, B: 2.04997447879, C: 1758.6530784168},
+		{A: 7.957e-08, B: 3.92331072722, C: 2921.1277828246},
+		{A: 1.0224e-07, B: 2.66497189021, C: 2487.4160449478},
+		{A: 8.277e-08, B: 0.94860765546, C: 2906.900688823},
+		{A: 7.371e-08, B: 0.84378341481, C: 692.1576012268},
+		{A: 7.545e-08, B: 5.68031160782, C: 13916.0191096416},
+		{A: 7.912e-08, B: 2.81294761885, C: 15643.6802033098},
+		{A: 6.958e-08, B: 3.32193127272, C: 3230.4061054804},
+		{A: 7.426e-08, B: 6.09656283295, C: 3583.3410306738},
+		{A: 6.402e-08, B: 4.19806996774, C: 5202.3582793352},
+		{A: 6.529e-08, B: 6.1192925271, C: 135.0650800354},
+		{A: 6.13e-08, B: 0.00117252232, C: 6836.6452528338},
+		{A: 6.226e-08, B: 6.10683955669, C: 17256.6315363414},
+		{A: 8.193e-08, B: 5.24811458833, C: 10575.4066829418},
+		{A: 6.168e-08, B: 3.60069207439, C: 10021.8545337516},
+		{A: 6.169e-08, B: 1.56992114335, C: 10021.8200264472},
+		{A: 5.671e-08, B: 0.1365030666, C: 13524.9163429314},
+		{A: 6.257e-08, B: 4.50450768937, C: 8425.6508378148},
+		{A: 5.249e-08, B: 2.70122358603, C: 4459.3682188026},
+		{A: 6.479e-08, B: 2.74267498287, C: 7740.6067835888},
+		{A: 5.53e-08, B: 6.06408145092, C: 10419.9862835076},
+		{A: 5.536e-08, B: 5.74996063243, C: 12168.0026965746},
+		{A: 6.826e-08, B: 4.69327545839, C: 17654.7805397496},
+		{A: 5.005e-08, B: 4.68382632238, C: 522.5774180938},
+		{A: 6.328e-08, B: 3.31896726895, C: 3767.2106175758},
+		{A: 4.734e-08, B: 0.00755678425, C: 3325.3599555148},
+		{A: 5.032e-08, B: 2.33696685608, C: 1052.2683831884},
+		{A: 4.661e-08, B: 5.15051711401, C: 1066.49547719},
+		{A: 4.724e-08, B: 5.77956037309, C: 9808.5381846614},
+		{A: 5.136e-08, B: 1.57259709878, C: 6525.8044539654},
+		{A: 4.522e-08, B: 1.44218620352, C: 3369.0616141676},
+		{A: 6.189e-08, B: 4.58996159356, C: 6531.661656265},
+		{A: 6.205e-08, B: 4.48164856516, C: 22747.2907148744},
+		{A: 5.329e-08, B: 4.55145599182, C: 1744.4259844152},
+		{A: 4.511e-08, B: 5.94511266539, C: 6894.5239488376},
+		{A: 4.33e-08, B: 3.10901365758, C: 4569.574540022},
+		{A: 5.366e-08, B: 5.08043436437, C: 2707.8286873866},
+		{A: 5.134e-08, B: 1.28568358496, C: 8439.8779318164},
+		{A: 4.127e-08, B: 5.48538052912, C: 2699.7348193176},
+		{A: 5.394e-08, B: 5.21695066244, C: 5305.4510535538},
+		{A: 4.449e-08, B: 5.56764082611, C: 16865.5287696312},
+		{A: 3.898e-08, B: 1.4878243479, C: 9168.6408983474},
+		{A: 3.863e-08, B: 1.2305080393, C: 16858.4825329332},
+		{A: 3.766e-08, B: 0.27090392616, C: 17395.2197347258},
+		{A: 4.683e-08, B: 3.05668892586, C: 5518.7501489918},
+		{A: 4.258e-08, B: 2.7909014579, C: 3503.079062832},
+		{A: 3.864e-08, B: 0.37957291785, C: 10177.2576795336},
+		{A: 3.992e-08, B: 1.8442476803, C: 3134.4268782626},
+		{A: 3.643e-08, B: 2.95318600206, C: 6144.4203413042},
+		{A: 3.654e-08, B: 1.58063207414, C: 6680.2445323314},
+		{A: 3.931e-08, B: 1.98436570971, C: 8969.568896911},
+		{A: 3.35e-08, B: 2.72637081667, C: 7875.6718636242},
+		{A: 3.623e-08, B: 2.91544991631, C: 6682.2051744678},
+		{A: 4.392e-08, B: 0.81931997702, C: 3302.479391062},
+		{A: 4.062e-08, B: 5.46935175827, C: 3120.199784261},
+		{A: 3.319e-08, B: 1.77193665786, C: 3116.2676309979},
+		{A: 3.51e-08, B: 1.18027333874, C: 10184.3039162316},
+		{A: 4.008e-08, B: 1.33675167812, C: 6247.5131155228},
+		{A: 3.603e-08, B: 0.15469852619, C: 2178.137722292},
+		{A: 3.313e-08, B: 3.12853663982, C: 17277.4069318338},
+		{A: 4.138e-08, B: 4.39568891039, C: 3074.005384978},
+		{A: 3.201e-08, B: 3.36492925275, C: 2384.3232707292},
+		{A: 3.991e-08, B: 3.8286783691, C: 3355.8648978848},
+		{A: 4.205e-08, B: 1.9053227917, C: 263.0839233728},
+		{A: 3.743e-08, B: 4.25458024187, C: 6261.7402095244},
+		{A: 3.111e-08, B: 1.65445053349, C: 20199.094959633},
+		{A: 3.634e-08, B: 5.55063049451, C: 632.7837393132},
+		{A: 2.892e-08, B: 1.915149207, C: 12935.8515159232},
+		{A: 3.373e-08, B: 5.50812408522, C: 23384.2869868986},
+		{A: 3.312e-08, B: 5.83174680245, C: 5331.3574437408},
+		{A: 3.124e-08, B: 5.44069658195, C: 6048.4411140864},
+		{A: 3.813e-08, B: 0.8027448743, C: 13517.8701062334},
+		{A: 3.612e-08, B: 3.68140265686, C: 5724.935697429},
+		{A: 2.813e-08, B: 1.68598843422, C: 2391.43681773},
+		{A: 2.902e-08, B: 5.30666239739, C: 8955.3418029094},
+		{A: 3.225e-08, B: 2.29832592489, C: 3312.163239232},
+		{A: 3.863e-08, B: 3.48188264725, C: 20618.0193585336},
+		{A: 2.738e-08, B: 5.49768261369, C: 149.5631971346},
+		{A: 2.809e-08, B: 4.76933217397, C: 1964.838626854},
+		{A: 2.711e-08, B: 2.69244730345, C: 3178.1457905676},
+		{A: 2.711e-08, B: 2.38275660721, C: 2648.454825473},
+		{A: 2.743e-08, B: 1.09492569851, C: 536.8045120954},
+		{A: 2.821e-08, B: 5.91845472246, C: 12964.300703391},
+		{A: 2.716e-08, B: 6.10377796874, C: 3973.396166013},
+		{A: 2.487e-08, B: 3.87703916286, C: 1861.7458526354},
+		{A: 2.617e-08, B: 2.65526535627, C: 8329.671610597},
+		{A: 2.938e-08, B: 5.68402398615, C: 6158.6474353058},
+		{A: 2.336e-08, B: 3.24847913311, C: 4672.6673142406},
+		{A: 2.318e-08, B: 1.69214259672, C: 3914.9572250346},
+		{A: 2.371e-08, B: 4.75067664712, C: 103.0927742186},
+		{A: 2.963e-08, B: 0.23381699914, C: 20597.2439630412},
+		{A: 2.19e-08, B: 6.18344448099, C: 3346.1353510072},
+		{A: 2.444e-08, B: 1.92547995169, C: 7799.9806455024},
+		{A: 2.121e-08, B: 4.87491216115, C: 9830.3890139878},
+		{A: 2.532e-08, B: 5.3955008727, C: 3863.1898447936},
+		{A: 2.101e-08, B: 2.84309138388, C: 3415.3940252671},
+		{A: 2.176e-08, B: 0.58632570025, C: 162.4666361322},
+		{A: 2.106e-08, B: 3.06229353931, C: 19800.9459562248},
+		{A: 2.347e-08, B: 3.90795942709, C: 3335.0895023924},
+		{A: 2.031e-08, B: 5.52057907797, C: 10021.9045904022},
+		{A: 1.997e-08, B: 2.77243710569, C: 13936.794505134},
+		{A: 2.139e-08, B: 5.40620646615, C: 266.6070417218},
+		{A: 2.147e-08, B: 0.089669876, C: 13358.9265884502},
+		{A: 1.996e-08, B: 2.62541669265, C: 20.7753954924},
+		{A: 1.961e-08, B: 4.88521794174, C: 3237.5196524812},
+		{A: 2.216e-08, B: 1.06829128652, C: 3320.257107301},
+		{A: 2.131e-08, B: 3.02112533027, C: 5625.3660415594},
+		{A: 2.124e-08, B: 3.68620121537, C: 5618.3198048614},
+		{A: 1.938e-08, B: 1.29006691721, C: 17924.9106998204},
+		{A: 2.555e-08, B: 4.91826220321, C: 6604.958782124},
+		{A: 2.561e-08, B: 2.10055088914, C: 7910.1869667218},
+		{A: 1.82e-08, B: 5.57528712663, C: 3351.2490920496},
+		{A: 1.786e-08, B: 5.77310414452, C: 3607.2194684216},
+		{A: 1.78e-08, B: 4.48010071981, C: 10818.1352869158},
+		{A: 2.106e-08, B: 5.75526661975, C: 13365.9728251482},
+		{A: 1.987e-08, B: 2.61151965233, C: 3546.797975137},
+		{A: 1.799e-08, B: 2.73192475257, C: 3360.96774609859},
+		{A: 1.715e-08, B: 1.50805385053, C: 1692.1656695024},
+		{A: 1.752e-08, B: 2.21455466761, C: 13119.72110282519},
+		{A: 1.913e-08, B: 3.32230688971, C: 6702.000248892},
+		{A: 1.724e-08, B: 1.43449979531, C: 4885.9664096786},
+		{A: 2.05e-08, B: 1.19293239093, C: 6660.4494579072},
+		{A: 1.774e-08, B: 2.18404386388, C: 6784.3176276182},
+		{A: 1.722e-08, B: 4.86031154305, C: 10014.7237330986},
+		{A: 1.773e-08, B: 2.09448668554, C: 3603.6963500726},
+		{A: 1.606e-08, B: 3.48105136801, C: 23141.5583829246},
+		{A: 1.621e-08, B: 5.73820120882, C: 4555.3474460204},
+		{A: 1.579e-08, B: 1.88769198841, C: 6298.3283211764},
+		{A: 1.53e-08, B: 5.1638156423, C: 76.2660712756},
+		{A: 1.615e-08, B: 3.24110713658, C: 3657.0042963564},
+		{A: 1.576e-08, B: 3.52622401575, C: 6688.3384004004},
+		{A: 2.034e-08, B: 2.63620520451, C: 16460.33352952499},
+		{A: 2.025e-08, B: 5.92907541624, C: 10021.7699697966},
+		{A: 1.689e-08, B: 4.41053057494, C: 5729.506447149},
+		{A: 1.878e-08, B: 4.53291044847, C: 3329.97576135},
+		{A: 1.53e-08, B: 4.76331644411, C: 7895.9598727202},
+		{A: 1.529e-08, B: 1.35289110986, C: 1581.959348283},
+		{A: 1.807e-08, B: 1.86212004697, C: 2693.601593385},
+		{A: 1.855e-08, B: 2.38561742394, C: 6843.6914895318},
+		{A: 1.518e-08, B: 3.9847615775, C: 6546.1597733642},
+		{A: 1.389e-08, B: 1.82099537095, C: 9779.1086761254},
+		{A: 1.447e-08, B: 2.35649936427, C: 6034.2140200848},
+		{A: 1.386e-08, B: 5.55304113895, C: 4775.7600884592},
+		{A: 1.372e-08, B: 1.07224580315, C: 12722.5524204852},
+		{A: 1.423e-08, B: 4.46530428193, C: 574.3447983348},
+		{A: 1.424e-08, B: 2.57162391016, C: 3399.9862886134},
+		{A: 1.38e-08, B: 5.76156315252, C: 16335.8378045366},
+		{A: 1.338e-08, B: 2.97604558638, C: 6127.6554505572},
+		{A: 1.479e-08, B: 4.74310691166, C: 12566.1516999828},
+		{A: 1.706e-08, B: 0.30579918494, C: 10551.528245194},
+		{A: 1.281e-08, B: 2.00285974432, C: 6677.6344247478},
+		{A: 1.35e-08, B: 0.78892333409, C: 853.196381752},
+		{A: 1.534e-08, B: 4.33326399444, C: 640.8776073822},
+		{A: 1.247e-08, B: 1.02503908468, C: 3024.2205570432},
+		{A: 1.289e-08, B: 1.92786975543, C: 3347.6586633978},
+		{A: 1.243e-08, B: 2.44217806237, C: 6684.8152820514},
+		{A: 1.453e-08, B: 1.74218016403, C: 3333.5661900018},
+		{A: 1.675e-08, B: 1.7969345633, C: 1118.7557921028},
+		{A: 1.491e-08, B: 2.59386711806, C: 2494.5295919486},
+		{A: 1.293e-08, B: 3.31710472549, C: 3407.0998356142},
+		{A: 1.188e-08, B: 4.92989260576, C: 22743.4093795164},
+		{A: 1.329e-08, B: 1.99426530402, C: 1228.9621133222},
+		{A: 1.373e-08, B: 2.5335498734, C: 5459.3762870782},
+		{A: 1.183e-08, B: 4.25338096667, C: 3344.4937620578},
+		{A: 1.231e-08, B: 2.50206227837, C: 4356.275444584},
+		{A: 1.243e-08, B: 2.6517626786, C: 74.7815985673},
+		{A: 1.285e-08, B: 4.34087881585, C: 3326.3853326982},
+		{A: 1.119e-08, B: 1.91321862491, C: 3281.2385647862},
+		{A: 1.094e-08, B: 5.50748655535, C: 3017.1070100424},
+		{A: 1.259e-08, B: 3.7765466283, C: 11236.57229942},
+		{A: 1.285e-08, B: 1.38335267684, C: 3077.528503327},
+		{A: 1.1e-08, B: 1.17130732373, C: 6606.4432548323},
+		{A: 1.115e-08, B: 5.81275569652, C: 2675.8563815698},
+		{A: 1.38e-08, B: 5.70641426169, C: 2807.3983432562},
+		{A: 1.256e-08, B: 3.35479933251, C: 4039.8835749274},
+		{A: 1.187e-08, B: 2.41348693872, C: 10596.1820784342},
+		{A: 1.052e-08, B: 3.33521939538, C: 3304.5845600224},
+		{A: 1.188e-08, B: 5.84735836632, C: 3336.7310913418},
+		{A: 1.072e-08, B: 2.78383184435, C: 8270.2977486834},
+		{A: 1.105e-08, B: 3.03463252672, C: 3929.677253708},
+		{A: 1.013e-08, B: 3.52026711847, C: 8013.2797409404},
+		{A: 1.079e-08, B: 0.51857999039, C: 2814.4445799542},
+		{A: 9.99e-09, B: 4.7273400876, C: 533.2140834436},
+		{A: 1.131e-08, B: 0.52584038526, C: 6816.289933435},
+		{A: 1.191e-08, B: 0.6087429252, C: 2301.58581590939},
+		{A: 1.313e-08, B: 2.07273299121, C: 23539.7073863328},
+		{A: 9.96e-09, B: 4.03971126547, C: 16062.1845261168},
+		{A: 9.54e-09, B: 5.90340414098, C: 20206.141196331},
+		{A: 9.93e-09, B: 0.07132588892, C: 24150.080051345},
+		{A: 1.051e-08, B: 2.2209653487, C: 3980.5097130138},
+		{A: 1.089e-08, B: 1.25512213569, C: 5938.234792867},
+		{A: 9.12e-09, B: 2.54221161167, C: 433.7117378768},
+		{A: 1.249e-08, B: 0.60003625956, C: 16173.3711684044},
+		{A: 1.027e-08, B: 4.95999945094, C: 19676.4502312364},
+		{A: 1.108e-08, B: 4.3420944816, C: 3339.1279539915},
+		{A: 1.188e-08, B: 6.21563747433, C: 2679.3794999188},
+		{A: 8.49e-09, B: 0.82548606454, C: 2597.6223661672},
+		{A: 1.145e-08, B: 4.48151980872, C: 19402.7969528166},
+		{A: 9.48e-09, B: 1.30280088857, C: 8273.8208670324},
+		{A: 1.016e-08, B: 5.1446481583, C: 1596.1864422846},
+		{A: 8.32e-09, B: 5.6062365203, C: 3340.19235060619},
+		{A: 1.035e-08, B: 4.71893106874, C: 419.4846438752},
+		{A: 9.03e-09, B: 0.45419000582, C: 12995.2253778368},
+		{A: 1.089e-08, B: 0.51294377637, C: 11250.7993934216},
+		{A: 8.4e-09, B: 5.30858028008, C: 26084.0218062162},
+		{A: 9.9e-09, B: 2.06776368865, C: 7255.5696517344},
+		{A: 8.08e-09, B: 6.25630819993, C: 15508.6151232744},
+		{A: 8.06e-09, B: 3.09007612135, C: 5415.6573747732},
+		{A: 7.82e-09, B: 4.62274599734, C: 2547.8375382324},
+		{A: 9.63e-09, B: 2.10680539916, C: 6456.8800576977},
+		{A: 7.78e-09, B: 3.56602161857, C: 12721.572099417},
+		{A: 8.73e-09, B: 5.09097164784, C: 2540.7913015344},
+		{A: 7.72e-09, B: 3.08101797047, C: 11081.2192102886},
+		{A: 9.65e-09, B: 2.33106703115, C: 18454.601664915},
+		{A: 8.59e-09, B: 4.14788214122, C: 6438.4962494256},
+		{A: 1.012e-08, B: 4.4501166454, C: 3316.733988952},
+		{A: 9.06e-09, B: 4.29336078401, C: 3344.5445799629},
+		{A: 7.41e-09, B: 2.6144640358, C: 2284.7536148596},
+		{A: 7.9e-09, B: 6.03436225041, C: 12509.2533250472},
+		{A: 7.38e-09, B: 0.52092422137, C: 18052.9295431578},
+		{A: 7.37e-09, B: 4.11165247543, C: 3760.097070575},
+		{A: 7.27e-09, B: 3.28066632751, C: 3510.1926098328},
+		{A: 1.006e-08, B: 0.45037465289, C: 27490.6924780448},
+		{A: 9.23e-09, B: 2.78717931388, C: 1332.0548875408},
+		{A: 7.56e-09, B: 0.86881841787, C: 1545.3539829788},
+		{A: 7.74e-09, B: 3.715355419, C: 6571.0185321802},
+		{A: 9e-09, B: 2.74944190055, C: 316.3918696566},
+		{A: 7.04e-09, B: 1.89617185328, C: 13362.432453147},
+		{A: 7.01e-09, B: 2.21328293796, C: 20995.3929664494},
+		{A: 7.01e-09, B: 3.926894387, C: 13362.4669604514},
+		{A: 6.95e-09, B: 5.52658147215, C: 3364.4908644476},
+		{A: 7.64e-09, B: 1.88253040972, C: 5732.0492444298},
+		{A: 6.93e-09, B: 0.34849213821, C: 5835.1420186484},
+		{A: 6.98e-09, B: 1.79132650081, C: 206.1855484372},
+		{A: 6.87e-09, B: 0.11649928911, C: 13760.5987102074},
+		{A: 8.27e-09, B: 0.49766945172, C: 3376.6402933772},
+		{A: 6.86e-09, B: 0.23965591265, C: 2409.249339848},
+		{A: 6.72e-09, B: 0.51352450554, C: 25685.872802808},
+		{A: 6.99e-09, B: 5.9016759508, C: 19004.6479494084},
+		{A: 6.47e-09, B: 3.01091875955, C: 5223.6939198022},
+		{A: 6.43e-09, B: 4.88507402785, C: 11766.2632645146},
+		{A: 7.23e-09, B: 3.28296530537, C: 4142.976349146},
+		{A: 6.6e-09, B: 4.2733439383, C: 1214.7350193206},
+		{A: 6.75e-09, B: 0.48874492682, C: 5408.5438277724},
+		{A: 8.09e-09, B: 3.13310075522, C: 18984.2926300096},
+		{A: 6.46e-09, B: 3.63004914186, C: 7107.8230442756},
+		{A: 8.31e-09, B: 4.49449658957, C: 3341.0325027934},
+		{A: 6.8e-09, B: 0.16949964513, C: 110.2063212194},
+		{A: 5.92e-09, B: 6.03272224596, C: 20809.4676246452},
+		{A: 6.27e-09, B: 4.93438097728, C: 9872.2740829648},
+		{A: 6.98e-09, B: 1.45709305452, C: 3377.217792004},
+		{A: 5.83e-09, B: 4.01887095237, C: 664.75604513},
+		{A: 5.91e-09, B: 4.01381288194, C: 19406.6782881746},
+		{A: 5.71e-09, B: 1.20823284619, C: 5621.8601768626},
+		{A: 6.8e-09, B: 4.72905586557, C: 8116.372515159},
+		{A: 7.39e-09, B: 3.19765996917, C: 9380.9596727172},
+		{A: 5.71e-09, B: 5.46064659057, C: 5621.8256695582},
+		{A: 7.02e-09, B: 0.14107083764, C: 6923.9534573736},
+		{A: 5.98e-09, B: 3.05986754375, C: 3341.0423098265},
+		{A: 6.01e-09, B: 2.17944973698, C: 7270.2896804078},
+		{A: 5.72e-09, B: 0.42001145821, C: 15110.4661198662},
+		{A: 6.55e-09, B: 1.08706089359, C: 3169.9395560806},
+		{A: 5.82e-09, B: 1.68224967199, C: 26087.9031415742},
+		{A: 5.43e-09, B: 2.42716241058, C: 170.6728706192},
+		{A: 5.43e-09, B: 1.68460678113, C: 11780.4903585162},
+		{A: 5.33e-09, B: 2.63982684952, C: 2277.7073781616},
+		{A: 5.5e-09, B: 2.54220679987, C: 7380.4960016272},
+		{A: 5.76e-09, B: 1.69042028754, C: 3384.3313390048},
+		{A: 5.35e-09, B: 5.0127922346, C: 1375.7737998458},
+		{A: 5.79e-09, B: 5.99182453047, C: 2149.6885348242},
+		{A: 5.98e-09, B: 0.75754342787, C: 3340.1825435731},
+		{A: 5.75e-09, B: 1.33793171924, C: 3296.8935143948},
+		{A: 6.89e-09, B: 0.02993986536, C: 22345.2603761082},
+		{A: 5.26e-09, B: 6.14212516186, C: 13149.1506113612},
+		{A: 5.4e-09, B: 5.54137118955, C: 8646.0634802536},
+		{A: 5.28e-09, B: 2.74183495775, C: 3826.5844794894},
+		{A: 6.89e-09, B: 6.28205551764, C: 12295.9542296092},
+		{A: 4.99e-09, B: 0.25665224668, C: 2142.6422981262},
+		{A: 4.98e-09, B: 5.91370528026, C: 6460.8122109608},
+		{A: 5.88e-09, B: 3.39977690249, C: 3329.5667264359},
+		{A: 5.07e-09, B: 0.63367870048, C: 20735.83216142559},
+		{A: 5.9e-09, B: 3.87951788923, C: 9065.5481241288},
+		{A: 5.09e-09, B: 2.59333214746, C: 3877.4169387952},
+		{A: 4.86e-09, B: 4.01113864377, C: 23937.856389741},
+		{A: 5.41e-09, B: 1.55072392536, C: 2221.856634597},
+		{A: 4.82e-09, B: 0.00200643783, C: 10235.1363755374},
+		{A: 4.74e-09, B: 4.14503805378, C: 3113.1362939108},
+		{A: 5.23e-09, B: 2.89171162726, C: 6040.3472460174},
+		{A: 4.58e-09, B: 4.08131432844, C: 1162.4747044078},
+		{A: 4.93e-09, B: 2.98933582802, C: 1039.0266107904},
+		{A: 4.89e-09, B: 4.88201647585, C: 5511.636601991},
+		{A: 5.37e-09, B: 1.24870344441, C: 48835.19385644859},
+		{A: 5.85e-09, B: 5.5338491934, C: 4981.9456368964},
+		{A: 4.62e-09, B: 5.38999453893, C: 15664.03552270859},
+		{A: 5.31e-09, B: 1.22287288928, C: 10721.108428327},
+		{A: 4.4e-09, B: 4.69966067585, C: 799.8211251654},
+		{A: 5.88e-09, B: 5.15076051189, C: 3873.8265101434},
+		{A: 4.65e-09, B: 5.49236978535, C: 3342.0968994081},
+		{A: 5.45e-09, B: 5.91813092143, C: 1478.8665740644},
+		{A: 4.4e-09, B: 2.28287871244, C: 12310.1813236108},
+		{A: 4.36e-09, B: 0.12478564862, C: 956.2891559706},
+		{A: 5.73e-09, B: 3.86388572602, C: 26482.1708096244},
+		{A: 4.29e-09, B: 1.20474317285, C: 28.4491874678},
+		{A: 4.35e-09, B: 3.75437730899, C: 5195.2447323344},
+		{A: 4.57e-09, B: 1.45606713663, C: 5636.070017212},
+		{A: 5.53e-09, B: 5.30628175145, C: 2766.267628365},
+		{A: 4.94e-09, B: 0.63303483918, C: 3274.1250177854},
+		{A: 5.3e-09, B: 3.18458612574, C: 8535.8571590342},
+		{A: 5.35e-09, B: 1.89165024367, C: 9866.4168806652},
+		{A: 4.33e-09, B: 0.33270291162, C: 6665.9723822146},
+		{A: 4.04e-09, B: 4.6793996459, C: 4825.544916394},
+		{A: 4.02e-09, B: 3.25727658426, C: 5820.9149246468},
+		{A: 4.15e-09, B: 1.41915166067, C: 21947.1113727},
+		{A: 4.14e-09, B: 3.32843373219, C: 8859.3625756916},
+		{A: 4.03e-09, B: 1.68400791606, C: 9588.1255422226},
+		{A: 3.99e-09, B: 0.93465630912, C: 2295.4575905122},
+		{A: 4.02e-09, B: 0.85364636108, C: 29026.48522950779},
+		{A: 4.94e-09, B: 4.57792960199, C: 8542.970706035},
+		{A: 4.13e-09, B: 2.60545132222, C: 38.1330356378},
+		{A: 4.13e-09, B: 5.44081623497, C: 4407.1079038898},
+		{A: 4.04e-09, B: 5.33168348188, C: 3189.5647568569},
+		{A: 3.85e-09, B: 1.90313951519, C: 10020.8569590312},
+		{A: 4.54e-09, B: 1.18358809702, C: 10610.9021071076},
+		{A: 3.81e-09, B: 4.90212003667, C: 19146.7592661418},
+		{A: 4.15e-09, B: 3.07892141478, C: 11216.284290324},
+		{A: 3.78e-09, B: 0.19461412344, C: 2067.9314010726},
+		{A: 4.18e-09, B: 1.14626975196, C: 6643.0918177618},
+		{A: 4.26e-09, B: 4.33863084563, C: 220.4126424388},
+		{A: 4.12e-09, B: 0.02314567339, C: 3192.5337022735},
+		{A: 3.91e-09, B: 4.75902617217, C: 6414.6178116778},
+		{A: 3.99e-09, B: 1.3770857398, C: 9654.612951137},
+		{A: 3.81e-09, B: 2.74120772781, C: 1854.6323056346},
+		{A: 3.78e-09, B: 4.16366866018, C: 13553.8979729108},
+		{A: 4.09e-09, B: 3.12117409635, C: 3723.4917052708},
+		{A: 3.79e-09, B: 5.87578711682, C: 6357.7194367422},
+		{A: 3.75e-09, B: 3.29175394061, C: 31022.7531708562},
+		{A: 3.58e-09, B: 3.99134138048, C: 8958.9322315612},
+		{A: 3.9e-09, B: 2.01175211992, C: 1903.4368125012},
+		{A: 3.73e-09, B: 1.27018251531, C: 2111.6503133776},
+		{A: 4.93e-09, B: 4.66294641806, C: 1435.1476617594},
+		{A: 3.79e-09, B: 4.08720452061, C: 10706.8813343254},
+		{A: 4.61e-09, B: 1.9012230147, C: 25287.7237993998},
+		{A: 3.64e-09, B: 3.2523113684, C: 5095.6750764648},
+		{A: 3.82e-09, B: 2.18684874688, C: 16276.463942623},
+		{A: 3.59e-09, B: 2.29096599931, C: 28628.3362260996},
+		{A: 3.62e-09, B: 4.15084528423, C: 6696.4773245846},
+		{A: 3.74e-09, B: 5.78780163439, C: 3490.1756238344},
+		{A: 3.86e-09, B: 4.73230339383, C: 3981.490034082},
+		{A: 3.44e-09, B: 5.7915671479, C: 26880.3198130326},
+		{A: 3.68e-09, B: 3.44405073824, C: 15.252471185},
+		{A: 4.1e-09, B: 1.76294940448, C: 6709.6740408674},
+		{A: 3.39e-09, B: 1.71242500987, C: 224.3447957019},
+		{A: 3.73e-09, B: 2.71286157688, C: 3472.1543883862},
+		{A: 3.34e-09, B: 1.10569460106, C: 59.3738619136},
+		{A: 3.94e-09, B: 4.02046774116, C: 2075.0449480734},
+		{A: 4.02e-09, B: 3.27520695853, C: 931.3630868518},
+		{A: 3.18e-09, B: 3.2889252478, C: 9485.032768004},
+		{A: 3.19e-09, B: 4.05344391333, C: 3450.8187479192},
+		{A: 3.41e-09, B: 6.08567978892, C: 17085.9586657222},
+		{A: 3.78e-09, B: 0.71609301686, C: 3209.0704650134},
+		{A: 3.26e-09, B: 4.58257191106, C: 8982.810669309},
+		{A: 3.69e-09, B: 6.17228742886, C: 8671.9698704406},
+		{A: 3.08e-09, B: 1.01924467785, C: 18606.4989460002},
+		{A: 3.7e-09, B: 2.84011243059, C: 4392.8808098882},
+		{A: 3.03e-09, B: 5.00239879717, C: 2810.9387152574},
+		{A: 3.03e-09, B: 2.97162741204, C: 2810.904207953},
+		{A: 4.19e-09, B: 2.798305624, C: 5106.3790521174},
+		{A: 3.58e-09, B: 1.15955325186, C: 4076.4889402316},
+		{A: 3.6e-09, B: 5.8322612331, C: 26724.8994135984},
+		{A: 2.98e-09, B: 5.57160706753, C: 3041.4860324306},
+		{A: 2.98e-09, B: 3.06970285022, C: 11670.2840372968},
+		{A: 3.88e-09, B: 5.01197738185, C: 12323.4230960088},
+		{A: 3.36e-09, B: 4.60208332758, C: 9602.3526362242},
+		{A: 3.71e-09, B: 2.23508025241, C: 4996.172730898},
+		{A: 3e-09, B: 6.04069385215, C: 9499.2598620056},
+		{A: 3.45e-09, B: 1.74260482164, C: 5617.9107699473},
+		{A: 3.02e-09, B: 3.0112734994, C: 7483.5887758458},
+		{A: 2.89e-09, B: 0.39479288685, C: 2412.772458197},
+		{A: 2.89e-09, B: 2.21430640344, C: 11140.5930722022},
+		{A: 3.3e-09, B: 5.81605457596, C: 4246.0691233646},
+		{A: 3.94e-09, B: 2.1222910724, C: 6475.0393049624},
+		{A: 3.01e-09, B: 5.26147877814, C: 9945.5712088238},
+		{A: 3.38e-09, B: 4.94717046909, C: 5625.7750764735},
+		{A: 3.72e-09, B: 5.469686628, C: 3561.0250691386},
+		{A: 2.79e-09, B: 0.54063870001, C: 3226.2133197864},
+		{A: 2.91e-09, B: 5.21021494024, C: 13171.0014406876},
+		{A: 3.84e-09, B: 3.23921380878, C: 10022.8176011676},
+		{A: 3.09e-09, B: 3.17514941939, C: 14047.4937610252},
+		{A: 2.73e-09, B: 1.68203034215, C: 4253.1826703654},
+		{A: 2.72e-09, B: 0.11218647217, C: 7314.0085927128},
+		{A: 2.81e-09, B: 5.15132055967, C: 2825.1485556068},
+		{A: 2.92e-09, B: 3.33720586058, C: 9468.267877257},
+		{A: 3.16e-09, B: 1.41719074976, C: 589.0648270082},
+		{A: 2.64e-09, B: 0.4884559473, C: 16699.53901514999},
+		{A: 2.66e-09, B: 1.69694779915, C: 647.0108333148},
+		{A: 3.18e-09, B: 2.47072726153, C: 8436.2875031646},
+		{A: 2.6e-09, B: 2.54459932529, C: 20.3553193988},
+		{A: 2.75e-09, B: 2.78608579994, C: 2970.9126107594},
+		{A: 2.95e-09, B: 5.96367554548, C: 4025.6564809258},
+		{A: 2.68e-09, B: 3.01034973031, C: 6518.7582172674},
+		{A: 2.59e-09, B: 5.20888482695, C: 7366.2689076256},
+		{A: 2.98e-09, B: 2.71010678192, C: 6652.7756659318},
+		{A: 2.76e-09, B: 0.785451083, C: 3735.238311759},
+		{A: 3.05e-09, B: 6.19137255377, C: 6677.3435180416},
+		{A: 2.65e-09, B: 3.25145629239, C: 24889.5747959916},
+		{A: 2.6e-09, B: 3.99612605351, C: 2171.0241752912},
+		{A: 2.52e-09, B: 4.14773813625, C: 5642.1982426092},
+		{A: 2.54e-09, B: 1.38470256851, C: 846.0828347512},
+		{A: 2.58e-09, B: 2.03261985834, C: 2089.782230399},
+		{A: 2.98e-09, B: 3.81212222628, C: 28230.18722269139},
+		{A: 2.41e-09, B: 2.96550398155, C: 27682.1407441564},
+		{A: 2.59e-09, B: 4.79545870271, C: 6657.3464156518},
+		{A: 2.38e-09, B: 1.18977479528, C: 3171.0322435668},
+		{A: 2.56e-09, B: 1.01427800277, C: 568.8218740274},
+		{A: 2.36e-09, B: 5.56425829084, C: 14.2270940016},
+		{A: 3.04e-09, B: 3.81556245925, C: 1190.9238918756},
+		{A: 2.37e-09, B: 1.37222961867, C: 2277.2983432475},
+		{A: 2.39e-09, B: 2.47752611026, C: 5430.3946570988},
+		{A: 2.34e-09, B: 4.34929504798, C: 6675.7019290922},
+		{A: 2.39e-09, B: 0.14012746335, C: 3742.284548457},
+		{A: 2.86e-09, B: 5.04045301355, C: 5607.6158292088},
+		{A: 3.05e-09, B: 4.5973907966, C: 6685.1061887576},
+		{A: 2.54e-09, B: 5.03693878366, C: 1905.4647649404},
+		{A: 3.23e-09, B: 1.67390215145, C: 4922.5717749828},
+		{A: 2.32e-09, B: 4.82565548677, C: 9070.1188738488},
+		{A: 2.36e-09, B: 2.40662610715, C: 3620.3989310522},
+		{A: 2.6e-09, B: 5.72282468723, C: 17468.8551979454},
+		{A: 2.59e-09, B: 6.15179402778, C: 16706.585251848},
+		{A: 2.63e-09, B: 0.63922292958, C: 2008.557539159},
+		{A: 3e-09, B: 3.78527265088, C: 34363.365597556},
+		{A: 2.26e-09, B: 1.86970344963, C: 6418.1409300268},
+		{A: 2.39e-09, B: 0.046169974, C: 13362.3823964964},
+		{A: 2.41e-09, B: 4.85896907298, C: 14158.7477136156},
+		{A: 2.25e-09, B: 1.70179250908, C: 18451.07854656599},
+		{A: 2.88e-09, B: 2.26316945288, C: 6621.850991486},
+		{A: 2.31e-09, B: 2.19861265305, C: 3936.7908007088},
+		{A: 2.51e-09, B: 5.51232121883, C: 3416.8784979754},
+		{A: 2.45e-09, B: 3.30613942274, C: 1197.9701285736},
+		{A: 2.53e-09, B: 4.54308131689, C: 2285.1626497737},
+		{A: 2.25e-09, B: 5.50822507089, C: 4936.7988689844},
+		{A: 2.49e-09, B: 1.06089727346, C: 3313.210870603},
+		{A: 3.09e-09, B: 6.21936675838, C: 16304.9131300908},
+		{A: 2.44e-09, B: 1.94855224181, C: 3.5904286518},
+		{A: 2.87e-09, B: 5.70461951656, C: 792.7748884674},
+		{A: 2.54e-09, B: 5.34446995416, C: 5401.4302807716},
+		{A: 2.63e-09, B: 1.49663212332, C: 6364.832983743},
+		{A: 2.23e-09, B: 2.66825139116, C: 31968.9486527994},
+		{A: 2.22e-09, B: 2.48370132727, C: 5355.2358814886},
+		{A: 2.2e-09, B: 5.20799024654, C: 23017.0626579362},
+		{A: 2.15e-09, B: 2.72743996418, C: 6740.5987153132},
+		{A: 2.18e-09, B: 1.30797197521, C: 29822.7832363242},
+		{A: 2.7e-09, B: 0.90714939427, C: 6155.057006654},
+		{A: 2.16e-09, B: 4.73975263349, C: 6679.7403806913},
+		{A: 2.11e-09, B: 3.72756562629, C: 10042.6126755918},
+		{A: 2.11e-09, B: 2.61999755641, C: 10124.930054318},
+		{A: 2.93e-09, B: 6.07059383381, C: 14061.7208550268},
+		{A: 2.19e-09, B: 2.98472846458, C: 131.5419616864},
+		{A: 2.1e-09, B: 5.27496906319, C: 13355.3361597984},
+		{A: 2.59e-09, B: 1.2526730583, C: 2641.3412784722},
+		{A: 2.08e-09, B: 3.30241021109, C: 6850.8050365326},
+		{A: 2.26e-09, B: 5.48438086246, C: 7203.8022714934},
+		{A: 2.43e-09, B: 2.44748800604, C: 3311.18291816379},
+		{A: 2.08e-09, B: 1.13500579457, C: 5888.4499649322},
+		{A: 2.51e-09, B: 4.67012983729, C: 6666.997759398},
+		{A: 2.27e-09, B: 1.59926413307, C: 10001.061884607},
+		{A: 2.64e-09, B: 3.72622435628, C: 6747.712262314},
+		{A: 2.16e-09, B: 0.34122804918, C: 6686.747777707},
+		{A: 2.6e-09, B: 3.67749190896, C: 6645.1969867222},
+		{A: 2.09e-09, B: 4.31928920378, C: 3337.8609160888},
+		{A: 2.18e-09, B: 4.08068730999, C: 3378.7454623376},
+		{A: 2.75e-09, B: 1.64274205426, C: 2011.1003364398},
+		{A: 2.04e-09, B: 0.73237459784, C: 3.9321532631},
+		{A: 2.19e-09, B: 0.88584017263, C: 19513.9835951042},
+		{A: 2.05e-09, B: 2.60851826933, C: 2771.7905526724},
+		{A: 2.15e-09, B: 2.99299817517, C: 10824.2012025456},
+		{A: 2.49e-09, B: 0.99914444136, C: 5753.3848848968},
+		{A: 2.25e-09, B: 0.23309143434, C: 4782.87363546},
+		{A: 2.75e-09, B: 0.86215660461, C: 8749.1562544722},
+		{A: 2.01e-09, B: 2.87118854159, C: 21548.9623692918},
+		{A: 2.46e-09, B: 3.34468800742, C: 3333.9287628257},
+		{A: 2.03e-09, B: 4.11410443276, C: 31570.7996493912},
+		{A: 2.02e-09, B: 4.96805650734, C: 8166.1573430938},
+		{A: 2.32e-09, B: 2.69372584349, C: 5989.0672521728},
+		{A: 2.14e-09, B: 4.83852070026, C: 6681.6449294932},
+		{A: 2.58e-09, B: 2.66551831451, C: 1062.9050485382},
+		{A: 1.97e-09, B: 0.55202541352, C: 735.8765135318},
+		{A: 2.56e-09, B: 2.76274941586, C: 2480.302497947},
+		{A: 2.16e-09, B: 4.02506717011, C: 3133.9116894032},
+		{A: 1.93e-09, B: 1.52645682146, C: 949.1756089698},
+		{A: 2.09e-09, B: 0.67348618655, C: 13892.1406718938},
+		{A: 2.2e-09, B: 1.52502617699, C: 6660.8695340008},
+		{A: 2.23e-09, B: 1.09348882524, C: 6148.010769956},
+		{A: 1.92e-09, B: 2.90571322264, C: 8799.988713778},
+		{A: 2.43e-09, B: 3.36420301442, C: 8965.9784682592},
+		{A: 2.06e-09, B: 5.11175800472, C: 4140.4335518652},
+		{A: 1.89e-09, B: 1.06641624209, C: 9374.8264467846},
+		{A: 2.4e-09, B: 5.92078519032, C: 13362.517017102},
+		{A: 2.48e-09, B: 5.79997873732, C: 15806.146839442},
+		{A: 1.93e-09, B: 3.19008521814, C: 6756.0064519669},
+		{A: 2.37e-09, B: 4.11979030463, C: 22487.3716928416},
+		{A: 2.18e-09, B: 0.84212090761, C: 6717.252720077},
+		{A: 2e-09, B: 2.4310084663, C: 10018.2468514476},
+		{A: 1.99e-09, B: 5.81238461796, C: 4289.7880356696},
+		{A: 2.14e-09, B: 5.95026024979, C: 6680.804777306},
+		{A: 1.92e-09, B: 3.06285109033, C: 32765.2466596158},
+		{A: 2.29e-09, B: 1.68884404323, C: 11614.4332937322},
+		{A: 1.85e-09, B: 3.13072183492, C: 3253.30422216},
+		{A: 2.46e-09, B: 2.58151525126, C: 1795.258443721},
+		{A: 1.87e-09, B: 4.06797969837, C: 14577.1847261198},
+		{A: 1.85e-09, B: 0.96747889626, C: 2604.735913168},
+		{A: 1.84e-09, B: 1.46731725339, C: 1437.1756141986},
+		{A: 1.86e-09, B: 2.55094700069, C: 3188.7151456146},
+		{A: 2.11e-09, B: 4.23522784526, C: 16703.07938715119},
+		{A: 1.96e-09, B: 2.80582160764, C: 2796.6943676036},
+		{A: 1.98e-09, B: 5.9237206756, C: 4133.3873151672},
+		{A: 2.38e-09, B: 2.30957041178, C: 1879.5583747534},
+		{A: 2.24e-09, B: 3.13550652378, C: 3613.2853840514},
+		{A: 2.23e-09, B: 1.81801741599, C: 3184.2117061974},
+		{A: 1.99e-09, B: 3.64983703995, C: 4271.9755135516},
+		{A: 1.81e-09, B: 2.71721121316, C: 4186.695261451},
+		{A: 1.84e-09, B: 2.03051030984, C: 6674.1786167016},
+		{A: 1.82e-09, B: 1.5443144281, C: 9947.0556815321},
+		{A: 1.79e-09, B: 4.38762678121, C: 9886.772200064},
+		{A: 1.81e-09, B: 1.80044971979, C: 6717.8302187038},
+		{A: 1.9e-09, B: 4.97714900529, C: 6670.5881880498},
+		{A: 2.47e-09, B: 5.77972128678, C: 29424.634232916},
+		{A: 1.94e-09, B: 5.90745451439, C: 6705.1032911474},
+		{A: 2.24e-09, B: 5.61893896091, C: 227.476132789},
+		{A: 1.82e-09, B: 2.77233344458, C: 6887.4104018368},
+		{A: 2.24e-09, B: 1.94094757956, C: 8226.5788363784},
+		{A: 1.81e-09, B: 4.6138108133, C: 3361.9480671668},
+		{A: 2.03e-09, B: 5.24659800837, C: 7586.6815500644},
+		{A: 1.83e-09, B: 5.23826533455, C: 6578.132079181},
+		{A: 2.07e-09, B: 0.88292186428, C: 10156.9023601348},
+		{A: 2.32e-09, B: 0.75375986797, C: 3232.9489027612},
+		{A: 1.79e-09, B: 5.89794151802, C: 4452.2546718018},
+		{A: 1.77e-09, B: 6.16223629155, C: 3547.3131639964},
+		{A: 1.77e-09, B: 0.1929289803, C: 401.6721217572},
+		{A: 1.73e-09, B: 3.898140641, C: 6019.9919266186},
+		{A: 1.72e-09, B: 2.42933586666, C: 6944.3087767724},
+		{A: 2.01e-09, B: 6.18392570315, C: 6947.8318951214},
+		{A: 1.83e-09, B: 5.82189570737, C: 8962.438096258},
+		{A: 1.89e-09, B: 2.71231990816, C: 742.9900605326},
+		{A: 1.72e-09, B: 3.86810071059, C: 10028.9508271002},
+		{A: 1.88e-09, B: 5.22462530948, C: 2125.8774073792},
+		{A: 1.96e-09, B: 1.883885699, C: 5032.7780962022},
+		{A: 2.09e-09, B: 5.78130707501, C: 3343.3639373108},
+		{A: 1.67e-09, B: 6.26758992296, C: 15849.865751747},
+		{A: 1.89e-09, B: 2.38894304278, C: 3212.5935833624},
+		{A: 2.08e-09, B: 5.62485439431, C: 31172.650645983},
+		{A: 1.7e-09, B: 1.0734640106, C: 20426.571092422},
+		{A: 1.83e-09, B: 5.21160560654, C: 27832.0382192832},
+		{A: 1.81e-09, B: 2.19756653553, C: 16703.0448798468},
+		{A: 2.14e-09, B: 1.66101921272, C: 21265.5231265202},
+		{A: 1.65e-09, B: 2.10517924574, C: 6688.2710900976},
+		{A: 1.72e-09, B: 0.85495655009, C: 14591.4118201214},
+		{A: 1.64e-09, B: 1.68898542913, C: 3468.6312700372},
+		{A: 1.83e-09, B: 1.56948199057, C: 8962.4726035624},
+		{A: 1.91e-09, B: 5.54553650068, C: 2806.9893083421},
+		{A: 1.62e-09, B: 4.06699160265, C: 5209.471826336},
+		{A: 1.65e-09, B: 3.44003333876, C: 14421.8316369884},
+		{A: 1.63e-09, B: 2.42152585351, C: 6314.0005244372},
+		{A: 1.89e-09, B: 0.57681608522, C: 2973.3880977374},
+		{A: 1.69e-09, B: 2.86190807726, C: 5490.300961524},
+		{A: 1.74e-09, B: 1.69333072403, C: 1744.493294718},
+		{A: 2.24e-09, B: 2.35886332922, C: 9638.9407478762},
+		{A: 2.07e-09, B: 3.13737248079, C: 3336.6802734367},
+		{A: 2.21e-09, B: 3.14807587279, C: 11610.9101753832},
+		{A: 1.58e-09, B: 5.87599422932, C: 4606.1799053262},
+		{A: 1.66e-09, B: 4.61834424167, C: 9175.7544453482},
+		{A: 1.62e-09, B: 5.40045489036, C: 23.8784377478},
+		{A: 1.58e-09, B: 5.57047764661, C: 4503.0871311076},
+		{A: 1.56e-09, B: 3.08339691033, C: 3448.2759506384},
+		{A: 2.02e-09, B: 2.56469307457, C: 7321.1221397136},
+		{A: 1.64e-09, B: 1.10347159072, C: 3710.3122426402},
+		{A: 1.58e-09, B: 3.65995745934, C: 6997.6167230562},
+		{A: 1.69e-09, B: 1.85985317117, C: 1329.51209026},
+		{A: 2.11e-09, B: 1.66624816725, C: 3304.0070613956},
+		{A: 2.06e-09, B: 1.81572746145, C: 1596.1191319818},
+		{A: 1.69e-09, B: 0.46310427277, C: 17101.2111369072},
+		{A: 1.59e-09, B: 5.73384451559, C: 1265.5674786264},
+		{A: 1.59e-09, B: 0.92643196778, C: 4001.8453534808},
+		{A: 1.89e-09, B: 2.7416385092, C: 2910.4911174748},
+		{A: 1.81e-09, B: 5.48699984659, C: 3319.2767862328},
+		{A: 1.56e-09, B: 5.9546211847, C: 8584.6616659008},
+		{A: 1.58e-09, B: 3.38732507447, C: 8742.0427074714},
+		{A: 1.71e-09, B: 3.11406750626, C: 5621.9102335132},
+		{A: 1.99e-09, B: 2.86370198218, C: 10025.4277087512},
+		{A: 1.73e-09, B: 4.73384838991, C: 9389.0535407862},
+		{A: 1.6e-09, B: 3.06861532268, C: 1612.9513330316},
+		{A: 2.03e-09, B: 1.71629299113, C: 699.2711482276},
+		{A: 1.98e-09, B: 0.56267868345, C: 15265.8865193004},
+		{A: 1.86e-09, B: 2.44573687199, C: 2814.8536148683},
+		{A: 1.67e-09, B: 0.4441794226, C: 37895.4262903674},
+		{A: 1.52e-09, B: 5.0861661053, C: 3322.7999045818},
+		{A: 1.62e-09, B: 2.58982908935, C: 1883.0814931024},
+		{A: 1.78e-09, B: 4.58774508034, C: 6685.1570066627},
+		{A: 1.91e-09, B: 4.12771141768, C: 9911.63095888},
+		{A: 1.85e-09, B: 4.32284898739, C: 323.5054166574},
+		{A: 1.99e-09, B: 1.83524172935, C: 3436.5916539176},
+		{A: 1.99e-09, B: 6.00292619279, C: 4427.3959129858},
+		{A: 1.53e-09, B: 3.4644766959, C: 22324.9050567094},
+		{A: 1.71e-09, B: 3.52256446633, C: 5621.7756129076},
+		{A: 1.65e-09, B: 3.32970937124, C: 1272.6810256272},
+		{A: 1.49e-09, B: 0.13370088791, C: 36.0278666774},
+		{A: 1.49e-09, B: 1.23280447264, C: 4193.8088084518},
+		{A: 1.56e-09, B: 0.13018524921, C: 11925.2740926006},
+		{A: 1.46e-09, B: 1.24102730441, C: 3372.5847325166},
+		{A: 1.54e-09, B: 5.63537863362, C: 3185.2593375684},
+		{A: 1.97e-09, B: 3.2336418844, C: 3774.3241645766},
+		{A: 1.8e-09, B: 3.47485722477, C: 8564.306346502},
+		{A: 1.85e-09, B: 2.18248214016, C: 802.3639224462},
+		{A: 1.45e-09, B: 2.62035460481, C: 3368.0139827966},
+		{A: 1.8e-09, B: 6.01236697222, C: 6691.8615187494},
+		{A: 1.48e-09, B: 2.5171017883, C: 3262.8618827159},
+		{A: 1.51e-09, B: 4.17118896078, C: 16894.5103996106},
+		{A: 1.85e-09, B: 3.0393966835, C: 3354.8395207014},
+		{A: 1.43e-09, B: 3.37267915445, C: 3468.5639597344},
+		{A: 1.6e-09, B: 2.1670595973, C: 0.9803210682},
+		{A: 1.9e-09, B: 1.63240538938, C: 2324.9494088156},
+		{A: 1.4e-09, B: 1.27592043896, C: 6549.6828917132},
+		{A: 1.86e-09, B: 1.44849264278, C: 1015.6630178842},
+		{A: 1.41e-09, B: 4.19208049402, C: 5813.291189322},
+		{A: 1.53e-09, B: 5.97405255778, C: 3568.0885594888},
+		{A: 1.36e-09, B: 0.45455359025, C: 3193.8007401762},
+		{A: 1.88e-09, B: 0.98977442882, C: 2538.2485042536},
+		{A: 1.57e-09, B: 3.32117477144, C: 3181.6689089166},
+		{A: 1.64e-09, B: 1.67705754742, C: 2751.5475996916},
+		{A: 1.34e-09, B: 5.26327877742, C: 13212.8865096646},
+		{A: 1.84e-09, B: 0.73082474043, C: 369.6998159404},
+		{A: 1.45e-09, B: 5.54086212771, C: 3152.68727893721},
+		{A: 1.39e-09, B: 3.30464120245, C: 6151.5166346528},
+		{A: 1.32e-09, B: 0.96753793729, C: 3487.4241132234},
+		{A: 1.48e-09, B: 1.55321724063, C: 7696.8878712838},
+		{A: 1.4e-09, B: 5.390007578, C: 2945.9865416406},
+		{A: 1.55e-09, B: 4.35207037526, C: 4005.3684718298},
+		{A: 1.42e-09, B: 5.13441372991, C: 1641.3332101966},
+		{A: 1.31e-09, B: 2.48606821423, C: 1353.3905280078},
+		{A: 1.31e-09, B: 2.18754536881, C: 1765.7666254176},
+		{A: 1.33e-09, B: 3.0130058204, C: 3283.7140517642},
+		{A: 1.77e-09, B: 2.37558492362, C: 11346.7786206394},
+		{A: 1.45e-09, B: 0.79525636457, C: 2281.2477501628},
+		{A: 1.33e-09, B: 5.96263652624, C: 34513.2630726828},
+		{A: 1.65e-09, B: 1.32559153826, C: 6510.5519827804},
+		{A: 1.32e-09, B: 4.31118469609, C: 1971.9521738548},
+		{A: 1.76e-09, B: 3.17539133688, C: 6701.5801727984},
+		{A: 1.4e-09, B: 1.18723558164, C: 1461.0540519464},
+		{A: 1.28e-09, B: 6.07261046225, C: 3362.4632560262},
+		{A: 1.39e-09, B: 5.33541181199, C: 6151.5511419572},
+		{A: 1.43e-09, B: 1.04060603784, C: 3351.6581269637},
+		{A: 1.38e-09, B: 1.96009952022, C: 2014.6234547888},
+		{A: 1.27e-09, B: 2.12310260285, C: 2917.5373541728},
+		{A: 1.42e-09, B: 0.29094317859, C: 3212.6608936652},
+		{A: 1.28e-09, B: 0.4997445644, C: 10264.5658840734},
+		{A: 1.25e-09, B: 1.62548810323, C: 10191.4174632324},
+		{A: 1.28e-09, B: 3.55358985291, C: 3223.2925584796},
+		{A: 1.4e-09, B: 5.87379732521, C: 6382.0984591304},
+		{A: 1.29e-09, B: 2.90428606882, C: 6812.766815086},
+		{A: 1.27e-09, B: 4.03101399507, C: 10448.4354709754},
+		{A: 1.28e-09, B: 3.23265469, C: 4576.6880870228},
+		{A: 1.24e-09, B: 2.02283056331, C: 6724.9437657046},
+		{A: 1.34e-09, B: 0.19295362623, C: 3130.8364496108},
+		{A: 1.45e-09, B: 5.04767049759, C: 2281.2132428584},
+		{A: 1.43e-09, B: 4.45744983982, C: 7100.7094972748},
+		{A: 1.33e-09, B: 5.00404182123, C: 11883.5831327348},
+		{A: 1.24e-09, B: 0.73925895836, C: 1485.9801210652},
+		{A: 1.23e-09, B: 0.47283015475, C: 3347.2960905739},
+		{A: 1.37e-09, B: 5.73370922615, C: 5244.049239201},
+		{A: 1.52e-09, B: 5.0146445546, C: 3358.4249488178},
+		{A: 1.22e-09, B: 1.37003015612, C: 3370.0419352358},
+		{A: 1.43e-09, B: 1.95740753086, C: 2921.6880277992},
+		{A: 1.23e-09, B: 3.94139290312, C: 4957.0868780804},
+		{A: 1.3e-09, B: 0.90844304292, C: 3427.9206312396},
+		{A: 1.35e-09, B: 3.56996218538, C: 3229.4257844122},
+		{A: 1.43e-09, B: 5.3022576787, C: 14128.2427712456},
+		{A: 1.34e-09, B: 2.47836558803, C: 7167.1969061892},
+		{A: 1.5e-09, B: 5.89116199733, C: 966.9708774356},
+		{A: 1.23e-09, B: 3.58787674774, C: 3318.7615973734},
+		{A: 1.53e-09, B: 0.37199746202, C: 6533.1461289733},
+		{A: 1.45e-09, B: 1.73326841661, C: 6637.5059410946},
+		{A: 1.33e-09, B: 3.27553306809, C: 11876.469585734},
+		{A: 1.19e-09, B: 5.15087396729, C: 15636.566656309},
+		{A: 1.17e-09, B: 3.70999224131, C: 3343.1552239806},
+		{A: 1.15e-09, B: 5.99541570797, C: 11986.6759069534},
+		{A: 1.52e-09, B: 5.6666233996, C: 6530.1771835567},
+		{A: 1.23e-09, B: 0.05231448642, C: 9801.4246376606},
+		{A: 1.3e-09, B: 1.4237792604, C: 16063.164847185},
+		{A: 1.2e-09, B: 4.19579234337, C: 604.8497407048},
+		{A: 1.26e-09, B: 1.38788085609, C: 169.580183133},
+		{A: 1.22e-09, B: 0.18785107006, C: 18849.2275499742},
+		{A: 1.13e-09, B: 4.67731144918, C: 24491.4257925834},
+		{A: 1.22e-09, B: 1.21803689218, C: 3067.9394693482},
+		{A: 1.18e-09, B: 3.92389195187, C: 2963.238818784},
+		{A: 1.37e-09, B: 0.80076862963, C: 3123.7902129128},
+		{A: 1.24e-09, B: 0.77274469912, C: 12465.5344127422},
+		{A: 1.32e-09, B: 4.73888023892, C: 2267.003402509},
+		{A: 1.22e-09, B: 3.66231014052, C: 8756.269801473},
+		{A: 1.13e-09, B: 3.74955221887, C: 21393.5419698576},
+		{A: 1.53e-09, B: 5.00596002145, C: 15906.7641266826},
+		{A: 1.09e-09, B: 1.4639407844, C: 2854.6403739102},
+		{A: 1.25e-09, B: 6.00744459186, C: 1442.2612087602},
+		{A: 1.4e-09, B: 0.64532646558, C: 13532.0298899322},
+		{A: 1.08e-09, B: 2.11691804278, C: 43.718912305},
+		{A: 1.3e-09, B: 1.4318591196, C: 3370.1042450032},
+		{A: 1.33e-09, B: 2.31239626151, C: 9072.6616711296},
+		{A: 1.07e-09, B: 5.68864833782, C: 4878.8528626778},
+		{A: 1.16e-09, B: 3.91262234002, C: 11904.9187732018},
+		{A: 1.06e-09, B: 1.99989592271, C: 3497.0131472022},
+		{A: 1.06e-09, B: 5.79020088316, C: 1.4844727083},
+		{A: 1.05e-09, B: 1.79751699456, C: 3517.3061568336},
+		{A: 1.3e-09, B: 0.80396347034, C: 3397.5108016354},
+		{A: 1.07e-09, B: 5.29449090921, C: 10.6366653498},
+		{A: 1.37e-09, B: 0.47037516401, C: 13575.7488022372},
+		{A: 1.04e-09, B: 5.03145022037, C: 1751.5222777638},
+		{A: 1.46e-09, B: 2.57659804831, C: 3308.640120883},
+		{A: 1.03e-09, B: 3.29589452622, C: 4379.6390374902},
+		{A: 1.04e-09, B: 0.77903634693, C: 1751.5567850682},
+		{A: 1.18e-09, B: 4.11892224522, C: 10927.2939767642},
+		{A: 1.06e-09, B: 2.25714927822, C: 13207.029307365},
+		{A: 1.26e-09, B: 1.10289326962, C: 6680.7949702729},
+		{A: 1.05e-09, B: 1.41449747957, C: 10081.211142013},
+		{A: 1.1e-09, B: 6.16072219971, C: 3338.069629419},
+		{A: 1.13e-09, B: 0.61947991056, C: 13421.8235687128},
+		{A: 1.39e-09, B: 2.21345603147, C: 1954.7171503636},
+		{A: 1.02e-09, B: 3.81767069895, C: 6670.1791531357},
+		{A: 1.01e-09, B: 3.44583422545, C: 1505.28780909299},
+		{A: 1.05e-09, B: 2.46134952447, C: 5525.8636959926},
+		{A: 1.19e-09, B: 5.64454862995, C: 8425.7181481176},
+		{A: 1.01e-09, B: 1.87446179141, C: 9161.5273513466},
+		{A: 1.11e-09, B: 5.46183253107, C: 7214.4389368432},
+		{A: 1.23e-09, B: 0.14056284625, C: 16489.763038061},
+		{A: 1.28e-09, B: 0.00452525697, C: 2185.2512692928},
+		{A: 1.06e-09, B: 6.2022517933, C: 3717.9860346156},
+		{A: 9.9e-10, B: 1.70554147455, C: 1655.5603041982},
+		{A: 1e-09, B: 1.76373681853, C: 3002.3697277168},
+		{A: 1.01e-09, B: 0.55693458655, C: 3337.1566186536},
+		{A: 1.01e-09, B: 0.56441794685, C: 30774.5016425748},
+		{A: 9.8e-10, B: 1.93983799659, C: 5562.4690612968},
+		{A: 1.3e-09, B: 5.65255471249, C: 2735.762685995},
+		{A: 1.25e-09, B: 6.18575288406, C: 9698.331863442},
+		{A: 1e-09, B: 2.35767934317, C: 3337.0720546986},
+		{A: 1e-09, B: 3.76317527562, C: 3344.152798701},
+		{A: 1.05e-09, B: 5.33897491215, C: 5096.0841113789},
+		{A: 1.03e-09, B: 1.24189801298, C: 1385.8952763362},
+		{A: 1.01e-09, B: 1.42727612221, C: 37455.7264959744},
+		{A: 9.6e-10, B: 2.55328478399, C: 4466.4817658034},
+		{A: 9.7e-10, B: 5.8677912741, C: 5220.1708014532},
+		{A: 1.01e-09, B: 2.61513508379, C: 16858.41522263039},
+		{A: 1.07e-09, B: 4.23943588615, C: 8823.8671515258},
+		{A: 1e-09, B: 4.38845221965, C: 3337.106562003},
+		{A: 1e-09, B: 1.73240240188, C: 3344.11829139661},
+		{A: 1.01e-09, B: 3.51764169063, C: 4694.0029547076},
+		{A: 9.6e-10, B: 3.16895015703, C: 12825.6451947038},
+		{A: 1.01e-09, B: 6.24060604565, C: 2942.5307335944},
+		{A: 1.03e-09, B: 1.09500325425, C: 12089.768681172},
+		{A: 1.06e-09, B: 5.57931664756, C: 39048.3225096072},
+		{A: 1.26e-09, B: 3.4052173855, C: 6681.6547365263},
+		{A: 1.05e-09, B: 2.52404371984, C: 1755.062649765},
+		{A: 1.15e-09, B: 6.10031030713, C: 3145.6410422392},
+		{A: 9.2e-10, B: 5.06385191401, C: 5085.1057214178},
+		{A: 9.7e-10, B: 1.11670836674, C: 3341.7930693519},
+		{A: 9.2e-10, B: 5.83209800268, C: 6682.7093261079},
+		{A: 1.21e-09, B: 2.39411154886, C: 18208.349942592},
+		{A: 1.1e-09, B: 2.51677822824, C: 1045.1548361876},
+		{A: 9.1e-10, B: 1.07173725022, C: 927.8399685028},
+		{A: 9.6e-10, B: 3.60155667418, C: 13363.4300278674},
+		{A: 9.7e-10, B: 5.34224904711, C: 7636.4663779992},
+		{A: 9.4e-10, B: 1.20811080231, C: 9495.6694333538},
+		{A: 9e-10, B: 4.17568982529, C: 0.0673103028},
+		{A: 9.7e-10, B: 3.20862890174, C: 5298.337506553},
+		{A: 9.2e-10, B: 3.20029831258, C: 4403.517475238},
+		{A: 9e-10, B: 3.79900428724, C: 685.044054226},
+		{A: 1.01e-09, B: 2.1277832928, C: 10050.2864675672},
+		{A: 9.8e-10, B: 3.89390262307, C: 2373.6415492642},
+		{A: 9e-10, B: 4.49046504054, C: 6453.7487206106},
+		{A: 9.6e-10, B: 5.13129666936, C: 7792.8670985016},
+		{A: 8.9e-10, B: 4.51228904794, C: 10037.0897512844},
+		{A: 9.8e-10, B: 0.65689906709, C: 2810.988771908},
+		{A: 1.14e-09, B: 4.15908543927, C: 9090.4741932476},
+		{A: 9.4e-10, B: 4.78960145866, C: 35707.7100829074},
+		{A: 8.7e-10, B: 6.26525215858, C: 34554.8138636676},
+		{A: 8.7e-10, B: 1.50181194995, C: 9983.7042444616},
+		{A: 8.7e-10, B: 1.43790822626, C: 9488.6231966558},
+		{A: 9e-10, B: 2.79573736864, C: 47477.5637760738},
+		{A: 9.4e-10, B: 5.68919291448, C: 6106.8800550648},
+		{A: 8.7e-10, B: 0.74049208991, C: 41990.7859328988},
+		{A: 1.01e-09, B: 0.99308133443, C: 6614.7374444852},
+		{A: 1.15e-09, B: 3.49732055752, C: 4.5034394172},
+		{A: 8.6e-10, B: 0.18628425275, C: 216.822213787},
+		{A: 9.9e-10, B: 1.06539589813, C: 2810.8541513024},
+		{A: 8.7e-10, B: 3.02856210899, C: 7218.029365495},
+		{A: 8.5e-10, B: 4.58476007089, C: 1699.2792165032},
+		{A: 8.8e-10, B: 4.47996444588, C: 21957.680727747},
+		{A: 1.06e-09, B: 6.1201188073, C: 4819.4790007642},
+		{A: 8.9e-10, B: 3.88600719587, C: 12199.9750023914},
+		{A: 9.7e-10, B: 2.70091698598, C: 3339.4317840477},
+		{A: 1.18e-09, B: 1.027395272, C: 34115.1140692746},
+		{A: 1.01e-09, B: 0.36591831115, C: 2942.3961129888},
+		{A: 8.4e-10, B: 5.66081869654, C: 1169.5882514086},
+		{A: 8.6e-10, B: 1.91214909013, C: 55516.4187098482},
+		{A: 8.4e-10, B: 4.30909434949, C: 4150.0898961468},
+		{A: 9.4e-10, B: 1.36645151323, C: 9093.9973115966},
+		{A: 9.7e-10, B: 2.26544245706, C: 13361.469385731},
+		{A: 9.9e-10, B: 1.16054562056, C: 128.0188433374},
+		{A: 9.4e-10, B: 1.10573952457, C: 4591.4426230006},
+		{A: 9.2e-10, B: 0.74386387542, C: 4845.9002357928},
+		{A: 1.01e-09, B: 1.5157029904, C: 7807.0941925032},
+		{A: 9.6e-10, B: 1.02187746571, C: 2899.7871418222},
+		{A: 8.6e-10, B: 0.66787658068, C: 2957.7331481288},
+		{A: 8.7e-10, B: 3.4967618964, C: 4061.751657906},
+		{A: 9.5e-10, B: 3.35452781355, C: 661.232926781},
+		{A: 8.7e-10, B: 0.04840436087, C: 23546.7536230308},
+		{A: 1.14e-09, B: 2.83270741566, C: 394.6258850592},
+		{A: 8.1e-10, B: 3.44420073782, C: 148.0787244263},
+		{A: 8.1e-10, B: 1.27062968985, C: 4893.0799566794},
+		{A: 8.3e-10, B: 5.48462687348, C: 4349.1618975832},
+		{A: 1.05e-09, B: 3.4784203613, C: 3413.9095525588},
+		{A: 8.4e-10, B: 4.33209852801, C: 51449.3242632782},
+		{A: 9.3e-10, B: 1.59775582035, C: 5088.2198048527},
+		{A: 8.8e-10, B: 3.29950478012, C: 2655.501062171},
+		{A: 8.1e-10, B: 1.65543714666, C: 78263.70942472259},
+		{A: 8.6e-10, B: 1.19342535692, C: 4029.2469095776},
+		{A: 7.9e-10, B: 4.41964678009, C: 151.0476698429},
+		{A: 8.4e-10, B: 1.96289182788, C: 14867.73751589219},
+		{A: 9.1e-10, B: 5.76229906142, C: 2544.3316735356},
+		{A: 8.6e-10, B: 3.78659802914, C: 1481.4093713452},
+		{A: 8.2e-10, B: 2.85633413428, C: 14164.8136292454},
+		{A: 7.8e-10, B: 4.29426812598, C: 187.9251477626},
+		{A: 7.8e-10, B: 5.38566529072, C: 2655.5683724738},
+		{A: 8.4e-10, B: 2.15121245434, C: 3311.1206083964},
+		{A: 7.8e-10, B: 2.01651247942, C: 9124.9219860424},
+		{A: 8.3e-10, B: 4.28280129037, C: 74923.09699802278},
+		{A: 7.7e-10, B: 1.45903147715, C: 2544.3817301862},
+		{A: 8.1e-10, B: 3.62204400197, C: 12306.590894959},
+		{A: 1e-09, B: 1.33498371324, C: 9278.8472195668},
+		{A: 8.9e-10, B: 2.62080312892, C: 24341.5283174566},
+		{A: 9.8e-10, B: 3.78877505378, C: 3198.4337996636},
+		{A: 8.1e-10, B: 4.34306418828, C: 10177.1903692308},
+		{A: 8e-10, B: 5.80054348103, C: 2751.01515718},
+		{A: 9.2e-10, B: 2.80228448795, C: 3707.8367556622},
+		{A: 8.2e-10, B: 2.4581289624, C: 9815.6517316622},
+		{A: 7.6e-10, B: 3.03616461584, C: 9993.3880926316},
+		{A: 7.6e-10, B: 3.92674885488, C: 2910.0820825607},
+		{A: 7.6e-10, B: 3.2000404072, C: 7733.493236588},
+		{A: 9.8e-10, B: 3.28574616663, C: 3289.33208883739},
+		{A: 7.7e-10, B: 2.0594893001, C: 12928.7379689224},
+		{A: 7.5e-10, B: 0.85784191736, C: 28638.9055811466},
+		{A: 7.8e-10, B: 0.29690749181, C: 10654.6210194126},
+		{A: 1.01e-09, B: 0.72388999452, C: 48827.4311857326},
+		{A: 8.5e-10, B: 5.1943801013, C: 2146.148162823},
+		{A: 8.3e-10, B: 4.42475849926, C: 2060.8178540718},
+		{A: 7.5e-10, B: 5.19097428778, C: 1055.4497769261},
+		{A: 9.6e-10, B: 6.24132708357, C: 11.0457002639},
+		{A: 7.4e-10, B: 3.37882325472, C: 8006.1661939396},
+		{A: 1.01e-09, B: 0.95332927487, C: 8186.5126624926},
+		{A: 9.2e-10, B: 0.961730051, C: 151.8972810852},
+		{A: 7.4e-10, B: 2.2271663021, C: 272.6729573516},
+		{A: 9.2e-10, B: 5.56705883046, C: 27873.589010268},
+		{A: 9.1e-10, B: 3.73152787182, C: 2544.2971662312},
+		{A: 8.4e-10, B: 0.41493230617, C: 4296.9015826704},
+		{A: 7.4e-10, B: 5.09669613096, C: 9755.2302383776},
+		{A: 7.8e-10, B: 1.79565224435, C: 3564.9572224017},
+		{A: 7.5e-10, B: 4.58345774133, C: 9153.9036160218},
+		{A: 7.4e-10, B: 3.88658218247, C: 25298.2931544468},
+		{A: 8.5e-10, B: 5.31472029744, C: 4716.3862265456},
+		{A: 7.4e-10, B: 1.46907693289, C: 11527.1250891924},
+		{A: 1.01e-09, B: 2.94847804254, C: 12032.9376165392},
+		{A: 7.2e-10, B: 0.24413748615, C: 1324.94134054},
+		{A: 7.8e-10, B: 0.17721609024, C: 3391.89276456221},
+		{A: 7.3e-10, B: 1.65072742864, C: 5452.2627400774},
+		{A: 7.3e-10, B: 4.9173008347, C: 10787.6303445458},
+		{A: 7.3e-10, B: 3.28285909319, C: 8830.9133882238},
+		{A: 8.1e-10, B: 5.4634074205, C: 3856.0762977928},
+		{A: 7.3e-10, B: 4.61913155968, C: 3288.352111787},
+		{A: 9.6e-10, B: 5.71767221046, C: 206.7007372966},
+		{A: 8e-10, B: 4.47918417138, C: 12299.544658261},
+		{A: 7.1e-10, B: 4.9813032833, C: 12942.965062924},
+		{A: 7.3e-10, B: 2.86057567032, C: 4960.6099964294},
+		{A: 7.2e-10, B: 5.14120362338, C: 4665.5537672398},
+		{A: 7.6e-10, B: 0.00928867041, C: 6534.413166876},
+		{A: 9e-10, B: 5.38224594245, C: 2125.8100970764},
+		{A: 8.5e-10, B: 0.94196641948, C: 2146.1826701274},
+		{A: 9.6e-10, B: 0.68069708134, C: 10006.5848089144},
+		{A: 7.3e-10, B: 1.06048379839, C: 3866.7802734454},
+		{A: 7.2e-10, B: 0.84342376295, C: 3334.54651107},
+		{A: 9.1e-10, B: 5.12701600437, C: 3392.8727416126},
+		{A: 7.6e-10, B: 5.96038992872, C: 3482.791053736},
+		{A: 9e-10, B: 1.46230169383, C: 5092.169211768},
+		{A: 7.1e-10, B: 1.16745785331, C: 1574.8458012822},
+		{A: 7.2e-10, B: 3.86137692169, C: 16762.4359954126},
+		{A: 7e-10, B: 0.07310763691, C: 12839.8722887054},
+		{A: 7.3e-10, B: 0.11725123446, C: 10507.809332889},
+		{A: 8.1e-10, B: 5.86817748181, C: 3495.9655158312},
+		{A: 7.1e-10, B: 2.07963275673, C: 1063.3140834523},
+		{A: 6.8e-10, B: 3.30993028662, C: 4106.4054911462},
+		{A: 6.9e-10, B: 0.33467954461, C: 5.5229243074},
+		{A: 6.8e-10, B: 1.56404561711, C: 2398.5503647308},
+		{A: 9e-10, B: 2.61953849393, C: 3346.6783423296},
+		{A: 6.8e-10, B: 1.06108185791, C: 191.3809558088},
+		{A: 7.7e-10, B: 0.87794040199, C: 2917.9463890869},
+		{A: 6.7e-10, B: 0.29712068423, C: 12012.5822971404},
+		{A: 6.7e-10, B: 2.55451787747, C: 11567.1912630782},
+		{A: 8.3e-10, B: 1.82578570364, C: 3511.285297319},
+		{A: 6.8e-10, B: 2.68247854175, C: 20213.25474333179},
+		{A: 8.6e-10, B: 2.79273636804, C: 367.2243289624},
+		{A: 8.6e-10, B: 0.81171860574, C: 209.775977089},
+		{A: 6.7e-10, B: 5.28035928877, C: 8966.3875031733},
+		{A: 6.7e-10, B: 0.20258020424, C: 27433.88921587499},
+		{A: 7.1e-10, B: 2.76410148218, C: 15650.7937503106},
+		{A: 9e-10, B: 0.71593793107, C: 3451.7990689874},
+		{A: 6.7e-10, B: 6.18148420146, C: 3966.2826190122},
+		{A: 7.4e-10, B: 3.61959120765, C: 7064.1041319706},
+		{A: 6.8e-10, B: 2.69550405031, C: 12725.453434775},
+		{A: 7.4e-10, B: 1.24854309783, C: 8027.5691447094},
+		{A: 8.4e-10, B: 2.47006028699, C: 3738.7441764558},
+		{A: 7.7e-10, B: 5.89874180572, C: 4307.5833041354},
+		{A: 6.7e-10, B: 0.33285041428, C: 19645.5255567906},
+		{A: 8.3e-10, B: 3.79134493696, C: 2472.6787626222},
+		{A: 6.4e-10, B: 0.43216125634, C: 1994.26813539},
+		{A: 7.1e-10, B: 0.65707804543, C: 860.3099287528},
+		{A: 6.4e-10, B: 0.98960038205, C: 3376.1751611684},
+		{A: 7e-10, B: 0.96227192685, C: 8428.8322315525},
+		{A: 6.6e-10, B: 0.65425879867, C: 8219.4652893776},
+		{A: 7e-10, B: 4.36060455306, C: 8436.6965380787},
+		{A: 8.4e-10, B: 4.50083253887, C: 3738.7786837602},
+		{A: 7.3e-10, B: 5.69670871544, C: 14687.3910473392},
+		{A: 7.1e-10, B: 0.36334330253, C: 2619.4731954936},
+		{A: 8e-10, B: 5.85771861744, C: 7747.7203305896},
+		{A: 6.8e-10, B: 3.06409889136, C: 8446.9914788172},
+		{A: 6.6e-10, B: 1.07137364271, C: 1987.221898692},
+		{A: 6.1e-10, B: 3.71171429905, C: 1055.8588118402},
+		{A: 7.9e-10, B: 0.06487001849, C: 6286.5989683404},
+		{A: 7.5e-10, B: 1.54177744365, C: 1059.3991838414},
+		{A: 6.1e-10, B: 1.47350010728, C: 1685.0521225016},
+		{A: 6.1e-10, B: 0.46897959303, C: 1727.6610936682},
+		{A: 6.4e-10, B: 2.11869877898, C: 3305.0496922312},
+		{A: 6e-10, B: 1.17940250396, C: 97670.38771289718},
+		{A: 6e-10, B: 5.13953821352, C: 1898.3339642874},
+		{A: 6.4e-10, B: 4.04058406722, C: 3945.4621674046},
+		{A: 6.3e-10, B: 5.20562384814, C: 24606.13555322},
+		{A: 6.1e-10, B: 0.55428968913, C: 7082.8969751568},
+		{A: 7.5e-10, B: 4.81208819076, C: 27299.2442119332},
+		{A: 5.9e-10, B: 1.75511446129, C: 12082.6551341712},
+		{A: 6.1e-10, B: 5.8833385561, C: 6901.6374958384},
+		{A: 7.4e-10, B: 3.46408580786, C: 14556.8967170238},
+		{A: 7.4e-10, B: 4.40649822049, C: 9360.6043533184},
+		{A: 5.8e-10, B: 4.22827613192, C: 4531.5363185754},
+		{A: 8e-10, B: 2.14103357856, C: 7917.3005137226},
+		{A: 7.5e-10, B: 1.8561801142, C: 52566.19261584059},
+		{A: 7.5e-10, B: 5.1583065968, C: 45884.967762441},
+		{A: 6.8e-10, B: 2.06915254754, C: 8958.5231966471},
+		{A: 5.6e-10, B: 3.77847713307, C: 299.1263942692},
+		{A: 6.2e-10, B: 2.25564130818, C: 9758.7533567266},
+		{A: 5.9e-10, B: 2.68657637673, C: 6283.0585963392},
+		{A: 5.6e-10, B: 5.54148283448, C: 13286.1836355236},
+		{A: 5.6e-10, B: 3.892008116, C: 8962.3880396074},
+		{A: 6.3e-10, B: 2.07254352069, C: 7593.7950970652},
+		{A: 6.1e-10, B: 4.31994402891, C: 4709.2726795448},
+		{A: 7.5e-10, B: 5.79419108898, C: 1059.364676537},
+		{A: 5.6e-10, B: 0.18569912415, C: 7768.0083396856},
+		{A: 5.9e-10, B: 5.49627068572, C: 7322.1024607818},
+		{A: 6.4e-10, B: 3.9599211222, C: 3050.1269472302},
+		{A: 5.5e-10, B: 2.19506741266, C: 7.046236698},
+		{A: 5.7e-10, B: 4.052526953, C: 3930.2096962196},
+		{A: 7.5e-10, B: 3.99788100837, C: 5483.254724826},
+		{A: 6e-10, B: 4.61703903739, C: 6990.5031760554},
+		{A: 7.3e-10, B: 4.20362525534, C: 21150.8133658836},
+		{A: 6.6e-10, B: 2.82009591985, C: 8771.0070837986},
+		{A: 5.4e-10, B: 0.38782719267, C: 360.1107819616},
+		{A: 5.9e-10, B: 4.71734799803, C: 6283.0931036436},
+		{A: 6.8e-10, B: 5.74655335863, C: 987.746272928},
+	},
+	// R1, 596 terms
+	{
+		{A: 0.0110743334, B: 2.0325052495, C: 3340.6124266998},
+		{A: 0.00103175886, B: 2.37071845682, C: 6681.2248533996},
+		{A: 0.000128772, B: 0, C: 0},
+		{A: 0.0001081588, B: 2.70888093803, C: 10021.8372800994},
+		{A: 1.19455e-05, B: 3.04702182503, C: 13362.4497067992},
+		{A: 4.38579e-06, B: 2.88835072628, C: 2281.2304965106},
+		{A: 3.95698e-06, B: 3.42324611291, C: 3344.1355450488},
+		{A: 1.82572e-06, B: 1.58428644001, C: 2544.3144198834},
+		{A: 1.3585e-06, B: 3.38507017993, C: 16703.062133499},
+		{A: 1.28204e-06, B: 0.6299122057, C: 1059.3819301892},
+		{A: 1.27068e-06, B: 1.9538977574, C: 796.2980068164},
+		{A: 1.18443e-06, B: 2.99761345074, C: 2146.1654164752},
+		{A: 1.28362e-06, B: 6.04343360441, C: 3337.0893083508},
+		{A: 8.7537e-07, B: 3.42052758979, C: 398.1490034082},
+		{A: 8.3026e-07, B: 3.85574986653, C: 3738.761430108},
+		{A: 7.5598e-07, B: 4.45101839349, C: 6151.533888305},
+		{A: 7.1999e-07, B: 2.7644218068, C: 529.6909650946},
+		{A: 6.6542e-07, B: 2.54892602695, C: 1751.539531416},
+		{A: 5.4314e-07, B: 0.67750943459, C: 8962.4553499102},
+		{A: 5.1035e-07, B: 3.72585409207, C: 6684.7479717486},
+		{A: 6.643e-07, B: 4.40597549957, C: 1748.016413067},
+		{A: 4.7863e-07, B: 2.28527896843, C: 2914.0142358238},
+		{A: 4.9428e-07, B: 5.72959428364, C: 3340.5951730476},
+		{A: 4.9424e-07, B: 1.47717922226, C: 3340.629680352},
+		{A: 5.7518e-07, B: 0.54354327916, C: 1194.4470102246},
+		{A: 4.8318e-07, B: 2.58061691301, C: 3149.1641605882},
+		{A: 3.6384e-07, B: 6.02728752344, C: 3185.1920272656},
+		{A: 3.7176e-07, B: 5.81439911546, C: 1349.8674096588},
+		{A: 3.6036e-07, B: 5.89508336048, C: 3333.498879699},
+		{A: 3.1115e-07, B: 0.9783250696, C: 191.4482661116},
+		{A: 3.8953e-07, B: 2.31900090554, C: 4136.9104335162},
+		{A: 2.7244e-07, B: 5.41367977087, C: 1592.5960136328},
+		{A: 2.43e-07, B: 3.75843924498, C: 155.4203994342},
+		{A: 2.2804e-07, B: 1.74830773908, C: 5088.6288397668},
+		{A: 2.2324e-07, B: 0.9393204073, C: 951.7184062506},
+		{A: 2.1708e-07, B: 3.83571581352, C: 6283.0758499914},
+		{A: 2.1304e-07, B: 0.78049229782, C: 1589.0728952838},
+		{A: 2.1631e-07, B: 4.56895741061, C: 3532.0606928114},
+		{A: 1.7956e-07, B: 4.21930481803, C: 3870.3033917944},
+		{A: 1.8237e-07, B: 0.41328624131, C: 5486.777843175},
+		{A: 1.6251e-07, B: 3.80760134974, C: 3340.545116397},
+		{A: 1.6803e-07, B: 5.54857987615, C: 3097.88382272579},
+		{A: 1.685e-07, B: 4.53690440252, C: 4292.3308329504},
+		{A: 1.5755e-07, B: 4.75736730681, C: 9492.1463150048},
+		{A: 1.5746e-07, B: 3.72356090283, C: 20043.6745601988},
+		{A: 2.0428e-07, B: 3.13540712557, C: 4690.4798363586},
+		{A: 1.4699e-07, B: 5.95325006816, C: 3894.1818295422},
+		{A: 1.6251e-07, B: 3.39910907599, C: 3340.6797370026},
+		{A: 1.4259e-07, B: 3.99897353022, C: 1990.745017041},
+		{A: 1.6528e-07, B: 0.96752074938, C: 4399.994356889},
+		{A: 1.301e-07, B: 5.14230107067, C: 6677.7017350506},
+		{A: 1.2492e-07, B: 1.03211063742, C: 3341.592747768},
+		{A: 1.6463e-07, B: 3.53882915214, C: 2700.7151403858},
+		{A: 1.6171e-07, B: 2.34870953554, C: 553.5694028424},
+		{A: 1.3169e-07, B: 0.41461716663, C: 5614.7293762096},
+		{A: 1.1272e-07, B: 1.02375627844, C: 12303.06777661},
+		{A: 1.2408e-07, B: 6.23142869816, C: 5628.9564702112},
+		{A: 1.2747e-07, B: 0.69046314049, C: 3723.508958923},
+		{A: 1.1827e-07, B: 6.25283898676, C: 2274.1169495098},
+		{A: 1.0384e-07, B: 1.23257236014, C: 426.598190876},
+		{A: 1.1208e-07, B: 1.31750963435, C: 3496.032826134},
+		{A: 1.0345e-07, B: 0.9006246469, C: 4535.0594369244},
+		{A: 1.2215e-07, B: 4.22316056098, C: 7079.3738568078},
+		{A: 9.765e-08, B: 3.45310940204, C: 382.8965322232},
+		{A: 8.586e-08, B: 1.16471901139, C: 2787.0430238574},
+		{A: 7.879e-08, B: 5.7380886179, C: 2288.3440435114},
+		{A: 9.195e-08, B: 1.81689739851, C: 6681.2421070518},
+		{A: 7.751e-08, B: 4.15046998466, C: 6041.3275670856},
+		{A: 9.195e-08, B: 6.06945250657, C: 6681.2075997474},
+		{A: 9.026e-08, B: 2.58210941053, C: 2388.8940204492},
+		{A: 6.771e-08, B: 0.23987737854, C: 11773.3768115154},
+		{A: 7.087e-08, B: 3.51414944377, C: 8031.0922630584},
+		{A: 9.159e-08, B: 3.9015859055, C: 3553.9115221378},
+		{A: 6.702e-08, B: 4.25572879119, C: 242.728603974},
+		{A: 7.232e-08, B: 3.70287400141, C: 2818.035008606},
+		{A: 6.546e-08, B: 0.04353472459, C: 2957.7158944766},
+		{A: 8.781e-08, B: 2.19735028572, C: 1221.8485663214},
+		{A: 6.54e-08, B: 2.11834687923, C: 8429.2412664666},
+		{A: 6.836e-08, B: 4.04512263654, C: 10025.3603984484},
+		{A: 7.28e-08, B: 4.26943100715, C: 2803.8079146044},
+		{A: 7.676e-08, B: 1.00782250264, C: 8432.7643848156},
+		{A: 5.732e-08, B: 3.13956241764, C: 213.299095438},
+		{A: 5.344e-08, B: 3.78220670098, C: 5092.1519581158},
+		{A: 5.991e-08, B: 2.96408254428, C: 6489.776587288},
+		{A: 5.132e-08, B: 3.98288453952, C: 7.1135470008},
+		{A: 6.261e-08, B: 1.90346469972, C: 5621.8429232104},
+		{A: 5.241e-08, B: 2.6709379505, C: 7477.522860216},
+		{A: 6.261e-08, B: 1.60056695152, C: 3347.7259737006},
+		{A: 6.526e-08, B: 2.76281601349, C: 3339.6321056316},
+		{A: 4.591e-08, B: 1.81986278078, C: 2810.9214616052},
+		{A: 5.46e-08, B: 4.60874445963, C: 3583.3410306738},
+		{A: 4.733e-08, B: 0.9056002634, C: 5099.2655051166},
+		{A: 5.486e-08, B: 4.91408093456, C: 7632.9432596502},
+		{A: 4.004e-08, B: 4.14067157211, C: 9623.6882766912},
+		{A: 3.837e-08, B: 0.03343295627, C: 7234.794256242},
+		{A: 3.621e-08, B: 5.76532393672, C: 4933.2084403326},
+		{A: 3.747e-08, B: 0.08778985966, C: 6525.8044539654},
+		{A: 3.016e-08, B: 3.73838855125, C: 6681.2921637024},
+		{A: 3.975e-08, B: 4.91288592965, C: 2942.4634232916},
+		{A: 3.911e-08, B: 0.67451768877, C: 3127.3133312618},
+		{A: 3.923e-08, B: 3.07703531632, C: 3.523118349},
+		{A: 3.944e-08, B: 0.53974754515, C: 5884.9268465832},
+		{A: 2.901e-08, B: 4.66281989264, C: 7210.9158184942},
+		{A: 2.803e-08, B: 1.00530177454, C: 7064.1213856228},
+		{A: 3.153e-08, B: 4.54673175664, C: 2487.4160449478},
+		{A: 2.784e-08, B: 0.05495331967, C: 639.897286314},
+		{A: 2.759e-08, B: 5.17099857257, C: 5828.0284716476},
+		{A: 3.017e-08, B: 4.14668877243, C: 6681.1575430968},
+		{A: 2.999e-08, B: 0.82918667275, C: 5085.038411115},
+		{A: 2.677e-08, B: 0.69427974692, C: 2699.7348193176},
+		{A: 3.023e-08, B: 2.59489020298, C: 2906.900688823},
+		{A: 2.596e-08, B: 1.08693013783, C: 4929.6853219836},
+		{A: 3.126e-08, B: 1.00027692782, C: 2118.7638603784},
+		{A: 2.598e-08, B: 5.01195749912, C: 10018.3141617504},
+		{A: 2.606e-08, B: 5.34420770679, C: 10973.55568635},
+		{A: 2.779e-08, B: 3.98358744953, C: 6467.9257579616},
+		{A: 2.46e-08, B: 1.52653571249, C: 6836.6452528338},
+		{A: 2.382e-08, B: 3.93610586965, C: 11371.7046897582},
+		{A: 2.59e-08, B: 5.08892664109, C: 12832.7587417046},
+		{A: 2.201e-08, B: 0.18880589605, C: 9595.2390892234},
+		{A: 2.128e-08, B: 5.69450469171, C: 3191.0492295652},
+		{A: 2.065e-08, B: 3.541331746, C: 1066.49547719},
+		{A: 1.868e-08, B: 5.0159253181, C: 3475.6775067352},
+		{A: 2.081e-08, B: 1.3027445922, C: 7740.6067835888},
+		{A: 1.83e-08, B: 5.68365327697, C: 3319.8370312074},
+		{A: 2.022e-08, B: 6.1609232806, C: 1744.4259844152},
+		{A: 1.708e-08, B: 2.21675931288, C: 2921.1277828246},
+		{A: 1.836e-08, B: 6.16477009621, C: 8425.6508378148},
+		{A: 2.06e-08, B: 6.24041853265, C: 6674.1113063988},
+		{A: 1.735e-08, B: 4.58243571826, C: 10419.9862835076},
+		{A: 1.852e-08, B: 1.36883022935, C: 15643.6802033098},
+		{A: 1.689e-08, B: 1.92829590736, C: 3767.2106175758},
+		{A: 1.954e-08, B: 0.46215988899, C: 10575.4066829418},
+		{A: 1.8e-08, B: 2.3091334366, C: 3355.8648978848},
+		{A: 1.444e-08, B: 2.15042616899, C: 10021.8545337516},
+		{A: 1.76e-08, B: 5.87267582299, C: 3320.257107301},
+		{A: 1.845e-08, B: 4.06123235448, C: 23384.2869868986},
+		{A: 1.423e-08, B: 4.65085713203, C: 4562.4609930212},
+		{A: 1.41e-08, B: 1.50438410845, C: 3325.3599555148},
+		{A: 1.444e-08, B: 0.11969993808, C: 10021.8200264472},
+		{A: 1.443e-08, B: 0.55828572929, C: 15113.9892382152},
+		{A: 1.334e-08, B: 1.25483731308, C: 7875.6718636242},
+		{A: 1.442e-08, B: 1.38958943728, C: 6682.2051744678},
+		{A: 1.606e-08, B: 4.34241260905, C: 5331.3574437408},
+		{A: 1.377e-08, B: 0.1479168382, C: 1758.6530784168},
+		{A: 1.282e-08, B: 5.78533014319, C: 14584.2982731206},
+		{A: 1.281e-08, B: 1.35125896423, C: 10404.7338123226},
+		{A: 1.471e-08, B: 0.34649469321, C: 8969.568896911},
+		{A: 1.245e-08, B: 6.02681709446, C: 3264.3463554242},
+		{A: 1.234e-08, B: 1.88341938354, C: 10177.2576795336},
+		{A: 1.552e-08, B: 5.92727420332, C: 8439.8779318164},
+		{A: 1.182e-08, B: 4.18100226016, C: 3360.96774609859},
+		{A: 1.333e-08, B: 1.86551437099, C: 692.1576012268},
+		{A: 1.189e-08, B: 0.89892514263, C: 13916.0191096416},
+		{A: 1.192e-08, B: 4.30473818946, C: 6894.5239488376},
+		{A: 1.145e-08, B: 0.15197504252, C: 3134.4268782626},
+		{A: 1.299e-08, B: 1.44631688592, C: 6254.6266625236},
+		{A: 1.494e-08, B: 1.54417907271, C: 3361.3878221922},
+		{A: 1.054e-08, B: 0.67591855339, C: 3344.2028553516},
+		{A: 1.21e-08, B: 0.85117132607, C: 3120.199784261},
+		{A: 1.061e-08, B: 0.13258232364, C: 522.5774180938},
+		{A: 1.003e-08, B: 1.28021784912, C: 4569.574540022},
+		{A: 1.012e-08, B: 5.77497169905, C: 14314.1681130498},
+		{A: 1.169e-08, B: 2.99767730172, C: 6247.5131155228},
+		{A: 1.062e-08, B: 0.25467691252, C: 3337.021998048},
+		{A: 1.032e-08, B: 5.6983368362, C: 536.8045120954},
+		{A: 1.082e-08, B: 2.58016159997, C: 6261.7402095244},
+		{A: 8.67e-09, B: 1.50283829131, C: 6127.6554505572},
+		{A: 9.53e-09, B: 2.92377030638, C: 5729.506447149},
+		{A: 8.87e-09, B: 5.28743574972, C: 10213.285546211},
+		{A: 1.108e-08, B: 2.8433917189, C: 640.8776073822},
+		{A: 8.61e-09, B: 3.21491381593, C: 3914.9572250346},
+		{A: 1.069e-08, B: 0.49400181869, C: 11243.6858464208},
+		{A: 8e-09, B: 4.64154002129, C: 6144.4203413042},
+		{A: 7.99e-09, B: 3.33215212691, C: 9830.3890139878},
+		{A: 1.046e-08, B: 4.059078777, C: 6158.6474353058},
+		{A: 7.43e-09, B: 4.9333799729, C: 3230.4061054804},
+		{A: 7.4e-09, B: 3.01511102229, C: 10818.1352869158},
+		{A: 7.16e-09, B: 2.52567242762, C: 5202.3582793352},
+		{A: 7.05e-09, B: 4.39344403231, C: 9808.5381846614},
+		{A: 7.86e-09, B: 1.72357089999, C: 3178.1457905676},
+		{A: 7.19e-09, B: 0.38924465445, C: 6298.3283211764},
+		{A: 7.17e-09, B: 6.23927283856, C: 3369.0616141676},
+		{A: 7.04e-09, B: 1.96763488855, C: 6688.3384004004},
+		{A: 8.82e-09, B: 2.10339285493, C: 2301.58581590939},
+		{A: 9.13e-09, B: 2.30662326829, C: 13517.8701062334},
+		{A: 9.14e-09, B: 4.36610748804, C: 13365.9728251482},
+		{A: 6.59e-09, B: 3.40905557071, C: 1648.4467571974},
+		{A: 8.28e-09, B: 2.13305589137, C: 7903.073419721},
+		{A: 6.52e-09, B: 5.97050449428, C: 574.3447983348},
+		{A: 6.88e-09, B: 4.02232587894, C: 1052.2683831884},
+		{A: 7.09e-09, B: 2.24658423912, C: 9225.539273283},
+		{A: 5.9e-09, B: 0.67418318613, C: 8955.3418029094},
+		{A: 6.04e-09, B: 2.73550395152, C: 16858.4825329332},
+		{A: 7.85e-09, B: 3.58704322751, C: 2693.601593385},
+		{A: 5.7e-09, B: 4.11146135269, C: 6034.2140200848},
+		{A: 6.24e-09, B: 4.45415856101, C: 12964.300703391},
+		{A: 5.63e-09, B: 3.72383942144, C: 13119.72110282519},
+		{A: 5.47e-09, B: 3.04142188931, C: 2707.8286873866},
+		{A: 5.36e-09, B: 4.65546776421, C: 135.0650800354},
+		{A: 6.8e-09, B: 3.62640859214, C: 6048.4411140864},
+		{A: 5.35e-09, B: 3.32503801413, C: 9779.1086761254},
+		{A: 6.15e-09, B: 0.31792697641, C: 7910.1869667218},
+		{A: 5.06e-09, B: 1.24339056369, C: 4555.3474460204},
+		{A: 6.33e-09, B: 1.32124830132, C: 2648.454825473},
+		{A: 6.71e-09, B: 2.88173567087, C: 3316.733988952},
+		{A: 5.7e-09, B: 4.94522781693, C: 6923.9534573736},
+		{A: 4.89e-09, B: 0.48479532469, C: 12935.8515159232},
+		{A: 4.9e-09, B: 1.57712746955, C: 162.4666361322},
+		{A: 4.92e-09, B: 4.08592823251, C: 10021.9045904022},
+		{A: 4.68e-09, B: 4.50628939729, C: 2384.3232707292},
+		{A: 4.62e-09, B: 1.30653882308, C: 17256.6315363414},
+		{A: 4.44e-09, B: 5.20556841675, C: 13358.9265884502},
+		{A: 5.11e-09, B: 5.29436707935, C: 853.196381752},
+		{A: 4.58e-09, B: 5.52316111365, C: 6438.4962494256},
+		{A: 4.43e-09, B: 4.37626774329, C: 12168.0026965746},
+		{A: 4.29e-09, B: 4.06329082027, C: 149.5631971346},
+		{A: 5.02e-09, B: 6.12188881856, C: 8273.8208670324},
+		{A: 4.2e-09, B: 3.51990468754, C: 9168.6408983474},
+		{A: 4.52e-09, B: 0.15931716927, C: 7895.9598727202},
+		{A: 4.01e-09, B: 0.93276882765, C: 433.7117378768},
+		{A: 4.91e-09, B: 4.49372643902, C: 10021.7699697966},
+		{A: 3.9e-09, B: 1.26790524143, C: 5459.3762870782},
+		{A: 4.6e-09, B: 3.56765356005, C: 1596.1864422846},
+		{A: 5.14e-09, B: 4.14617297678, C: 16460.33352952499},
+		{A: 5.1e-09, B: 2.12338136542, C: 3335.0895023924},
+		{A: 3.65e-09, B: 0.84035244694, C: 3364.4908644476},
+		{A: 4.44e-09, B: 0.42154996651, C: 9866.4168806652},
+		{A: 3.8e-09, B: 2.74315160117, C: 3344.4937620578},
+		{A: 3.55e-09, B: 2.20831807719, C: 5518.7501489918},
+		{A: 3.74e-09, B: 0.93920520997, C: 3863.1898447936},
+		{A: 3.8e-09, B: 0.60825828556, C: 3980.5097130138},
+		{A: 3.66e-09, B: 1.45297694305, C: 1039.0266107904},
+		{A: 4.58e-09, B: 5.01867719021, C: 10551.528245194},
+		{A: 3.52e-09, B: 5.59661824792, C: 16062.1845261168},
+		{A: 3.61e-09, B: 5.43698827477, C: 11236.57229942},
+		{A: 3.23e-09, B: 3.25474887566, C: 3116.2676309979},
+		{A: 3.96e-09, B: 5.43635200834, C: 16173.3711684044},
+		{A: 3.16e-09, B: 5.10862851689, C: 17395.2197347258},
+		{A: 3.25e-09, B: 4.59103320065, C: 19800.9459562248},
+		{A: 3.2e-09, B: 0.1002213259, C: 1692.1656695024},
+		{A: 3.13e-09, B: 4.76509182263, C: 3304.5845600224},
+		{A: 3.29e-09, B: 3.51515201342, C: 1903.4368125012},
+		{A: 3.61e-09, B: 1.4017957282, C: 8270.2977486834},
+		{A: 3.8e-09, B: 1.11278812152, C: 3503.079062832},
+		{A: 3.09e-09, B: 1.63585786252, C: 11081.2192102886},
+		{A: 3.04e-09, B: 6.27804875103, C: 2391.43681773},
+		{A: 3.36e-09, B: 2.95777936427, C: 6680.2445323314},
+		{A: 2.99e-09, B: 0.48751113813, C: 3347.6586633978},
+		{A: 3.27e-09, B: 3.19951479738, C: 3981.490034082},
+		{A: 2.98e-09, B: 4.26967988388, C: 13936.794505134},
+		{A: 3.09e-09, B: 5.0577507818, C: 12721.572099417},
+		{A: 3.1e-09, B: 6.10243075443, C: 17924.9106998204},
+		{A: 2.9e-09, B: 4.52880846817, C: 5724.935697429},
+		{A: 2.79e-09, B: 4.30091602167, C: 3607.2194684216},
+		{A: 2.93e-09, B: 1.90646493379, C: 6702.000248892},
+		{A: 3.75e-09, B: 5.37146136739, C: 3376.6402933772},
+		{A: 2.68e-09, B: 4.93796347898, C: 13760.5987102074},
+		{A: 2.69e-09, B: 5.63342755549, C: 4032.7700279266},
+		{A: 3.61e-09, B: 4.70448724123, C: 9380.9596727172},
+		{A: 2.85e-09, B: 4.37639190605, C: 6040.3472460174},
+		{A: 3.05e-09, B: 3.60878132297, C: 7255.5696517344},
+		{A: 2.65e-09, B: 5.82002998533, C: 1214.7350193206},
+		{A: 2.46e-09, B: 3.48844723382, C: 3237.5196524812},
+		{A: 3.39e-09, B: 6.05128485092, C: 6660.4494579072},
+		{A: 3.25e-09, B: 5.12798981834, C: 11250.7993934216},
+		{A: 2.43e-09, B: 0.48346700024, C: 13362.432453147},
+		{A: 2.46e-09, B: 4.41923388458, C: 3546.797975137},
+		{A: 2.39e-09, B: 3.73073204843, C: 76.2660712756},
+		{A: 2.39e-09, B: 6.27709788173, C: 1964.838626854},
+		{A: 2.42e-09, B: 2.51411096474, C: 13362.4669604514},
+		{A: 2.38e-09, B: 4.61745673345, C: 17277.4069318338},
+		{A: 2.32e-09, B: 3.13987850882, C: 20199.094959633},
+		{A: 2.45e-09, B: 3.88310965561, C: 4407.1079038898},
+		{A: 2.42e-09, B: 3.07232693388, C: 12566.1516999828},
+		{A: 2.77e-09, B: 3.88618268628, C: 10596.1820784342},
+		{A: 2.77e-09, B: 6.1445946998, C: 19402.7969528166},
+		{A: 2.22e-09, B: 2.20253799065, C: 7107.8230442756},
+		{A: 2.18e-09, B: 1.37621606096, C: 3415.3940252671},
+		{A: 2.97e-09, B: 3.78408680173, C: 2766.267628365},
+		{A: 2.64e-09, B: 5.35345322905, C: 6816.289933435},
+		{A: 2.13e-09, B: 1.10915354432, C: 19004.6479494084},
+		{A: 2.13e-09, B: 4.60032427636, C: 3973.396166013},
+		{A: 2.3e-09, B: 4.39356021431, C: 26724.8994135984},
+		{A: 2.02e-09, B: 0.60981942427, C: 6677.6344247478},
+		{A: 2.68e-09, B: 5.05058237041, C: 9381.9399937854},
+		{A: 1.99e-09, B: 1.34402137469, C: 8329.671610597},
+		{A: 1.98e-09, B: 5.08527311506, C: 5820.9149246468},
+		{A: 2.01e-09, B: 0.05002970566, C: 11780.4903585162},
+		{A: 1.99e-09, B: 2.66187512344, C: 6696.4773245846},
+		{A: 2.27e-09, B: 1.69939961119, C: 18984.2926300096},
+		{A: 2.17e-09, B: 4.71565260831, C: 8671.9698704406},
+		{A: 1.99e-09, B: 0.66532566162, C: 15664.03552270859},
+		{A: 2.01e-09, B: 3.90133282111, C: 266.6070417218},
+		{A: 2.42e-09, B: 0.76562027191, C: 3281.2385647862},
+		{A: 2.54e-09, B: 6.0771214653, C: 14054.607308026},
+		{A: 1.93e-09, B: 6.08798590746, C: 8982.810669309},
+		{A: 2.02e-09, B: 1.12506176909, C: 6684.8152820514},
+		{A: 2.12e-09, B: 0.41763591084, C: 5732.0492444298},
+		{A: 1.91e-09, B: 0.63315794517, C: 3017.1070100424},
+		{A: 1.84e-09, B: 5.68898048651, C: 5642.1982426092},
+		{A: 1.8e-09, B: 4.60366258431, C: 10235.1363755374},
+		{A: 1.98e-09, B: 1.01742381306, C: 3336.7310913418},
+		{A: 2.07e-09, B: 0.80702499658, C: 3877.4169387952},
+		{A: 2.02e-09, B: 1.61753574776, C: 11216.284290324},
+		{A: 1.77e-09, B: 0.20305783491, C: 22743.4093795164},
+		{A: 1.81e-09, B: 6.17864681592, C: 4885.9664096786},
+		{A: 2.28e-09, B: 0.90779655425, C: 18454.601664915},
+		{A: 1.65e-09, B: 3.97299091423, C: 20.3553193988},
+		{A: 2.06e-09, B: 3.58770221682, C: 3205.5473466644},
+		{A: 1.85e-09, B: 2.7708843606, C: 220.4126424388},
+		{A: 1.63e-09, B: 0.00569068383, C: 1437.1756141986},
+		{A: 1.85e-09, B: 6.2312887516, C: 6660.8695340008},
+		{A: 1.64e-09, B: 4.64548469502, C: 3253.30422216},
+		{A: 2.1e-09, B: 4.85590304986, C: 5618.3198048614},
+		{A: 2.26e-09, B: 1.76563729092, C: 5625.3660415594},
+		{A: 1.63e-09, B: 0.67931390118, C: 12310.1813236108},
+		{A: 2.11e-09, B: 1.51278040364, C: 4039.8835749274},
+		{A: 1.77e-09, B: 2.14779710666, C: 3346.1353510072},
+		{A: 1.54e-09, B: 3.33525296567, C: 9070.1188738488},
+		{A: 1.78e-09, B: 1.65162116131, C: 10713.9948813262},
+		{A: 1.56e-09, B: 6.12783235584, C: 103.0927742186},
+		{A: 1.55e-09, B: 3.51854579269, C: 7373.3824546264},
+		{A: 1.57e-09, B: 4.91614155659, C: 15508.6151232744},
+		{A: 1.91e-09, B: 1.74459210202, C: 10022.8176011676},
+		{A: 1.78e-09, B: 3.53665589741, C: 533.2140834436},
+		{A: 1.54e-09, B: 2.64640687885, C: 3333.5661900018},
+		{A: 1.62e-09, B: 1.2723539268, C: 5415.6573747732},
+		{A: 1.44e-09, B: 1.30886254982, C: 6460.8122109608},
+		{A: 1.73e-09, B: 3.76291627342, C: 3561.0250691386},
+		{A: 1.44e-09, B: 0.86629692094, C: 7380.4960016272},
+		{A: 1.5e-09, B: 0.23529641094, C: 1228.9621133222},
+		{A: 1.44e-09, B: 0.12285484149, C: 647.0108333148},
+		{A: 1.6e-09, B: 0.24157324936, C: 11614.4332937322},
+		{A: 1.46e-09, B: 4.76067886088, C: 16706.585251848},
+		{A: 1.88e-09, B: 2.96688973732, C: 2178.137722292},
+		{A: 1.75e-09, B: 1.66915420767, C: 22345.2603761082},
+		{A: 1.41e-09, B: 1.75571255457, C: 3657.0042963564},
+		{A: 1.45e-09, B: 0.71777671857, C: 6784.3176276182},
+		{A: 1.38e-09, B: 2.74151377335, C: 6546.1597733642},
+		{A: 1.39e-09, B: 4.12056692213, C: 3351.2490920496},
+		{A: 1.52e-09, B: 4.88528890547, C: 110.2063212194},
+		{A: 1.34e-09, B: 0.86682710306, C: 3603.6963500726},
+		{A: 1.7e-09, B: 5.54401695608, C: 3265.8308281325},
+		{A: 1.65e-09, B: 2.74557093704, C: 4672.6673142406},
+		{A: 1.29e-09, B: 0.94520493983, C: 419.4846438752},
+		{A: 1.67e-09, B: 3.43007767272, C: 1581.959348283},
+		{A: 1.36e-09, B: 3.68877945956, C: 13171.0014406876},
+		{A: 1.6e-09, B: 0.68321924763, C: 6475.0393049624},
+		{A: 1.29e-09, B: 4.46548223743, C: 9499.2598620056},
+		{A: 1.44e-09, B: 1.88085102951, C: 9468.267877257},
+		{A: 1.49e-09, B: 3.49118810274, C: 14158.7477136156},
+		{A: 1.38e-09, B: 1.92770959208, C: 15.252471185},
+		{A: 1.72e-09, B: 0.23189971635, C: 12323.4230960088},
+		{A: 1.21e-09, B: 1.75813513715, C: 4379.6390374902},
+		{A: 1.2e-09, B: 6.04299490267, C: 6606.4432548323},
+		{A: 1.34e-09, B: 1.98845453946, C: 8965.9784682592},
+		{A: 1.18e-09, B: 0.22641710036, C: 11766.2632645146},
+		{A: 1.22e-09, B: 2.92219895926, C: 21947.1113727},
+		{A: 1.47e-09, B: 5.86576524955, C: 3169.9395560806},
+		{A: 1.21e-09, B: 5.75886629308, C: 19513.9835951042},
+		{A: 1.19e-09, B: 3.93419985914, C: 4936.7988689844},
+		{A: 1.23e-09, B: 4.65853055862, C: 13149.1506113612},
+		{A: 1.51e-09, B: 4.38870879458, C: 13362.517017102},
+		{A: 1.34e-09, B: 4.49208622602, C: 2480.302497947},
+		{A: 1.15e-09, B: 5.78268772131, C: 12722.5524204852},
+		{A: 1.26e-09, B: 4.43971496486, C: 3568.0885594888},
+		{A: 1.14e-09, B: 0.36704570747, C: 3384.3313390048},
+		{A: 1.17e-09, B: 0.67607782416, C: 13207.029307365},
+		{A: 1.24e-09, B: 5.80386208894, C: 10721.108428327},
+		{A: 1.15e-09, B: 0.23672592056, C: 224.3447957019},
+		{A: 1.1e-09, B: 0.26402316132, C: 3077.528503327},
+		{A: 1.12e-09, B: 3.50979462224, C: 1375.7737998458},
+		{A: 1.08e-09, B: 5.30086016687, C: 10264.5658840734},
+		{A: 1.46e-09, B: 2.36972522432, C: 4989.0591838972},
+		{A: 1.43e-09, B: 0.92798026058, C: 5401.4302807716},
+		{A: 1.11e-09, B: 2.47771615302, C: 18606.4989460002},
+		{A: 1.24e-09, B: 1.13870545502, C: 2807.3983432562},
+		{A: 1.05e-09, B: 5.87122793977, C: 4193.8088084518},
+		{A: 1.15e-09, B: 3.01754571849, C: 1118.7557921028},
+		{A: 1.07e-09, B: 2.03274473347, C: 25685.872802808},
+		{A: 1.07e-09, B: 4.82345245872, C: 13362.3823964964},
+		{A: 1.05e-09, B: 1.21018340855, C: 8584.6616659008},
+		{A: 1.04e-09, B: 5.07527834174, C: 23141.5583829246},
+		{A: 1e-09, B: 4.08698733114, C: 3511.285297319},
+		{A: 1.05e-09, B: 1.1383323428, C: 1162.4747044078},
+		{A: 1.02e-09, B: 2.55243872323, C: 664.75604513},
+		{A: 1.38e-09, B: 3.04361079523, C: 3329.97576135},
+		{A: 1.3e-09, B: 3.04769575195, C: 3341.0325027934},
+		{A: 1.17e-09, B: 2.97177407391, C: 9602.3526362242},
+		{A: 9.8e-10, B: 4.54292546461, C: 3362.4632560262},
+		{A: 9.7e-10, B: 2.21618504638, C: 10042.6126755918},
+		{A: 1e-09, B: 5.4554164858, C: 3024.2205570432},
+		{A: 9.7e-10, B: 1.55448890675, C: 8799.988713778},
+		{A: 1.07e-09, B: 5.1500176439, C: 956.2891559706},
+		{A: 1e-09, B: 1.80839732601, C: 6665.9723822146},
+		{A: 1.04e-09, B: 0.04641040148, C: 10001.061884607},
+		{A: 9.7e-10, B: 0.30502193916, C: 10014.7237330986},
+		{A: 1.25e-09, B: 3.71144870102, C: 3873.8265101434},
+		{A: 1.02e-09, B: 5.38933965862, C: 13892.1406718938},
+		{A: 1.08e-09, B: 4.3343889579, C: 3490.1756238344},
+		{A: 9.5e-10, B: 2.32591017464, C: 10028.9508271002},
+		{A: 1.13e-09, B: 1.7432394516, C: 11610.9101753832},
+		{A: 1.19e-09, B: 4.94578003013, C: 3443.7052009184},
+		{A: 9.6e-10, B: 1.63239207663, C: 11925.2740926006},
+		{A: 1.24e-09, B: 0.82697196609, C: 9638.9407478762},
+		{A: 1.01e-09, B: 1.51065535085, C: 1062.9050485382},
+		{A: 1.07e-09, B: 4.3665371608, C: 6531.661656265},
+		{A: 9e-10, B: 2.0153477417, C: 22324.9050567094},
+		{A: 9.4e-10, B: 4.85202869277, C: 14047.4937610252},
+		{A: 8.9e-10, B: 1.23714034226, C: 20809.4676246452},
+		{A: 9.5e-10, B: 5.69916785631, C: 6717.252720077},
+		{A: 9.5e-10, B: 2.15038299943, C: 8013.2797409404},
+		{A: 1.11e-09, B: 3.91313035726, C: 3311.18291816379},
+		{A: 8.9e-10, B: 1.37925538521, C: 74.7815985673},
+		{A: 9.4e-10, B: 3.24872875047, C: 6657.3464156518},
+		{A: 8.9e-10, B: 0.70324117212, C: 23.8784377478},
+		{A: 8.7e-10, B: 0.40376031248, C: 2221.856634597},
+		{A: 9.2e-10, B: 3.3316578619, C: 2540.7913015344},
+		{A: 1.2e-09, B: 3.09344469205, C: 6685.1061887576},
+		{A: 8.7e-10, B: 4.9269430907, C: 1505.28780909299},
+		{A: 9.1e-10, B: 1.66121998822, C: 24150.080051345},
+		{A: 9e-10, B: 5.47687082598, C: 16699.53901514999},
+		{A: 1.19e-09, B: 2.53709230647, C: 3312.163239232},
+		{A: 1.18e-09, B: 5.56523997231, C: 2814.4445799542},
+		{A: 9.3e-10, B: 1.61394240489, C: 3341.0423098265},
+		{A: 8.4e-10, B: 1.19439840758, C: 3450.8187479192},
+		{A: 8.5e-10, B: 4.95060410388, C: 7314.0085927128},
+		{A: 8.4e-10, B: 3.92244493446, C: 170.6728706192},
+		{A: 9.6e-10, B: 5.49205832489, C: 3427.9206312396},
+		{A: 1.06e-09, B: 1.61703818023, C: 3774.3241645766},
+		{A: 1.01e-09, B: 6.18535119914, C: 5511.636601991},
+		{A: 8.1e-10, B: 3.16562241132, C: 52175.8062831484},
+		{A: 9.3e-10, B: 5.15512549422, C: 3451.7990689874},
+		{A: 1.08e-09, B: 0.33314004399, C: 6709.6740408674},
+		{A: 7.8e-10, B: 2.38080208142, C: 13553.8979729108},
+		{A: 8.5e-10, B: 1.1858843064, C: 6705.1032911474},
+		{A: 9.8e-10, B: 3.24856024758, C: 25287.7237993998},
+		{A: 8e-10, B: 2.54327778068, C: 6675.7019290922},
+		{A: 7.8e-10, B: 4.70533248161, C: 24889.5747959916},
+		{A: 9.5e-10, B: 1.59916172961, C: 4271.9755135516},
+		{A: 7.6e-10, B: 0.90799171644, C: 2675.8563815698},
+		{A: 9.1e-10, B: 3.80728044706, C: 2125.8774073792},
+		{A: 9.3e-10, B: 5.59480359619, C: 3340.1825435731},
+		{A: 9.5e-10, B: 2.06299921822, C: 6518.7582172674},
+		{A: 1e-09, B: 6.02550501045, C: 3313.210870603},
+		{A: 7.5e-10, B: 6.1609589009, C: 3370.0419352358},
+		{A: 7.7e-10, B: 4.92444879515, C: 3229.4257844122},
+		{A: 9e-10, B: 4.55063359845, C: 6701.5801727984},
+		{A: 7.8e-10, B: 2.01202524407, C: 14421.8316369884},
+		{A: 7.9e-10, B: 5.92142974185, C: 6155.057006654},
+		{A: 1.01e-09, B: 2.07923317336, C: 15265.8865193004},
+		{A: 8.2e-10, B: 6.04078010866, C: 3760.097070575},
+		{A: 7.3e-10, B: 3.01106366205, C: 206.1855484372},
+		{A: 7.8e-10, B: 1.71095661489, C: 3399.9862886134},
+		{A: 7.3e-10, B: 3.32824316241, C: 9588.1255422226},
+		{A: 7.6e-10, B: 1.74030672711, C: 6756.0064519669},
+		{A: 7.3e-10, B: 1.44980946324, C: 5408.5438277724},
+		{A: 7.7e-10, B: 5.64501410813, C: 3209.0704650134},
+		{A: 8e-10, B: 5.70272305344, C: 10156.9023601348},
+		{A: 7.5e-10, B: 3.83222347813, C: 39048.3225096072},
+		{A: 7.2e-10, B: 0.82629826575, C: 7366.2689076256},
+		{A: 8.3e-10, B: 4.71804515025, C: 14061.7208550268},
+		{A: 7.2e-10, B: 4.35767091893, C: 21548.9623692918},
+		{A: 8.6e-10, B: 1.50107043029, C: 5989.0672521728},
+		{A: 7.3e-10, B: 3.77377582806, C: 28628.3362260996},
+		{A: 8.2e-10, B: 0.7874018109, C: 16276.463942623},
+		{A: 7e-10, B: 2.69225539304, C: 3296.8935143948},
+		{A: 6.9e-10, B: 1.01941228196, C: 12295.9542296092},
+		{A: 8.1e-10, B: 4.64227979982, C: 6947.8318951214},
+		{A: 9.4e-10, B: 0.74502278458, C: 5244.049239201},
+		{A: 6.7e-10, B: 4.4732887165, C: 20206.141196331},
+		{A: 9.1e-10, B: 2.95968633697, C: 3326.3853326982},
+		{A: 7.6e-10, B: 2.86128350856, C: 8542.970706035},
+		{A: 6.5e-10, B: 1.31079130285, C: 23546.7536230308},
+		{A: 6.5e-10, B: 3.31422850411, C: 6414.6178116778},
+		{A: 6.4e-10, B: 3.98415414793, C: 3368.0139827966},
+		{A: 6.5e-10, B: 4.15810281695, C: 3340.19235060619},
+		{A: 7.1e-10, B: 3.82044323862, C: 6578.132079181},
+		{A: 8e-10, B: 1.02128994578, C: 7321.1221397136},
+		{A: 6.4e-10, B: 1.07965886113, C: 6944.3087767724},
+		{A: 6.4e-10, B: 1.20215520018, C: 38.1330356378},
+		{A: 7e-10, B: 5.11166911478, C: 9485.032768004},
+		{A: 6.7e-10, B: 2.22216014849, C: 4845.9002357928},
+		{A: 8.3e-10, B: 1.86067842148, C: 931.3630868518},
+		{A: 6.3e-10, B: 0.45029141698, C: 6418.1409300268},
+		{A: 6.2e-10, B: 5.68207205878, C: 4459.3682188026},
+		{A: 6.2e-10, B: 0.62454636835, C: 6688.2710900976},
+		{A: 7.6e-10, B: 0.41820625869, C: 5032.7780962022},
+		{A: 6.2e-10, B: 5.72811519809, C: 2008.557539159},
+		{A: 6e-10, B: 1.98790201827, C: 12199.9750023914},
+		{A: 7.8e-10, B: 2.18035217615, C: 3232.9489027612},
+		{A: 6e-10, B: 1.08971396071, C: 10124.930054318},
+		{A: 7.2e-10, B: 4.08555524166, C: 3416.8784979754},
+		{A: 6.1e-10, B: 2.49066420507, C: 4005.3684718298},
+		{A: 5.9e-10, B: 2.9375514437, C: 9886.772200064},
+		{A: 6.9e-10, B: 1.02371083242, C: 6621.850991486},
+		{A: 6.1e-10, B: 4.57446273568, C: 2277.7073781616},
+		{A: 5.9e-10, B: 4.7474548172, C: 16865.5287696312},
+		{A: 6.9e-10, B: 3.6257698172, C: 15906.7641266826},
+		{A: 5.8e-10, B: 4.15993379336, C: 1854.6323056346},
+		{A: 6e-10, B: 4.92798256747, C: 3391.89276456221},
+		{A: 7e-10, B: 4.51699340219, C: 16304.9131300908},
+		{A: 5.6e-10, B: 3.5935463928, C: 7322.1024607818},
+		{A: 6.8e-10, B: 0.8121709197, C: 16703.0448798468},
+		{A: 5.6e-10, B: 3.19997567622, C: 4140.4335518652},
+		{A: 5.8e-10, B: 1.73479637157, C: 2409.249339848},
+		{A: 5.4e-10, B: 5.5431093749, C: 2604.735913168},
+		{A: 5.9e-10, B: 2.0292225638, C: 45486.81875903279},
+		{A: 6.3e-10, B: 5.29505507594, C: 17101.2111369072},
+		{A: 5.4e-10, B: 4.8823066075, C: 48300.16010269},
+		{A: 7.1e-10, B: 4.843810412, C: 3289.33208883739},
+		{A: 5.4e-10, B: 1.76917054114, C: 3510.1926098328},
+		{A: 6.3e-10, B: 2.80175814276, C: 16894.5103996106},
+		{A: 5.8e-10, B: 0.91631357427, C: 10018.2468514476},
+		{A: 5.4e-10, B: 1.06826755543, C: 8948.2282559086},
+		{A: 7.2e-10, B: 1.36121375974, C: 10025.4277087512},
+		{A: 6.1e-10, B: 0.92427393171, C: 17468.8551979454},
+		{A: 6.8e-10, B: 2.84271358352, C: 16703.07938715119},
+		{A: 5.3e-10, B: 3.42679182927, C: 14867.73751589219},
+		{A: 5.4e-10, B: 0.41070672545, C: 18451.07854656599},
+		{A: 6e-10, B: 5.86922287544, C: 7447.017917846},
+		{A: 5.1e-10, B: 1.64684042106, C: 3472.1543883862},
+		{A: 5.7e-10, B: 6.13250399703, C: 17085.9586657222},
+		{A: 5.3e-10, B: 4.16969434701, C: 6106.8800550648},
+		{A: 5e-10, B: 3.17196717347, C: 2111.6503133776},
+		{A: 5.5e-10, B: 5.7260754556, C: 10706.8813343254},
+		{A: 6.2e-10, B: 0.57333106196, C: 3171.0322435668},
+		{A: 5.3e-10, B: 1.29007833025, C: 6652.7756659318},
+		{A: 5e-10, B: 2.96441858632, C: 10037.0897512844},
+		{A: 6.5e-10, B: 5.11165617529, C: 3113.1362939108},
+		{A: 5.3e-10, B: 4.87447079015, C: 1485.9801210652},
+		{A: 5e-10, B: 2.52848298042, C: 10448.4354709754},
+		{A: 5.9e-10, B: 1.69623653028, C: 4025.6564809258},
+		{A: 4.7e-10, B: 5.15559748285, C: 12012.5822971404},
+		{A: 4.9e-10, B: 1.19473362353, C: 3448.2759506384},
+		{A: 6.3e-10, B: 1.01191427607, C: 11769.8536931664},
+		{A: 6.4e-10, B: 3.86219786087, C: 18208.349942592},
+		{A: 5.9e-10, B: 2.96261275062, C: 14071.43950907579},
+		{A: 4.9e-10, B: 1.41657179779, C: 2938.9403049426},
+		{A: 4.8e-10, B: 2.95878690387, C: 11527.1250891924},
+		{A: 5.2e-10, B: 0.01971915447, C: 8226.5788363784},
+		{A: 4.5e-10, B: 5.07966377852, C: 3318.7615973734},
+		{A: 4.3e-10, B: 1.23879381294, C: 7218.029365495},
+		{A: 5.8e-10, B: 5.58121433163, C: 6643.0918177618},
+		{A: 4.8e-10, B: 5.02446939402, C: 6645.1969867222},
+		{A: 4.3e-10, B: 0.69492704598, C: 20995.3929664494},
+		{A: 4.4e-10, B: 4.02272101657, C: 9389.0535407862},
+		{A: 5.5e-10, B: 4.38138154697, C: 1478.8665740644},
+		{A: 5.1e-10, B: 4.24292455428, C: 792.7748884674},
+		{A: 4.2e-10, B: 2.74826708762, C: 14577.1847261198},
+		{A: 4.4e-10, B: 4.18397905503, C: 8535.8571590342},
+		{A: 4.7e-10, B: 1.33588473182, C: 632.7837393132},
+		{A: 4.2e-10, B: 5.05676915852, C: 3397.5108016354},
+		{A: 4.2e-10, B: 0.28204510006, C: 10001.48196070061},
+		{A: 4.2e-10, B: 0.75310918544, C: 6357.7194367422},
+		{A: 4.2e-10, B: 4.94532732982, C: 18052.9295431578},
+		{A: 5.2e-10, B: 4.09912687749, C: 5835.1420186484},
+		{A: 5.4e-10, B: 2.46533302314, C: 8186.5126624926},
+		{A: 4.3e-10, B: 4.77713978044, C: 32124.36905223359},
+		{A: 5.3e-10, B: 6.08293348275, C: 3377.217792004},
+		{A: 4.1e-10, B: 2.51168269556, C: 4186.695261451},
+		{A: 4.1e-10, B: 1.24482327948, C: 3212.5935833624},
+		{A: 4.1e-10, B: 5.42003026893, C: 685.044054226},
+		{A: 4.1e-10, B: 5.04768364997, C: 6571.0185321802},
+		{A: 4.2e-10, B: 2.08904552145, C: 13363.4300278674},
+		{A: 4.8e-10, B: 4.82888746454, C: 1835.3246176068},
+		{A: 4.3e-10, B: 4.17203713456, C: 43340.6533425576},
+		{A: 4.6e-10, B: 0.81640935106, C: 45884.967762441},
+		{A: 4.2e-10, B: 2.26773068307, C: 12729.665967486},
+		{A: 3.9e-10, B: 5.85791936573, C: 846.0828347512},
+		{A: 4.3e-10, B: 2.90976420757, C: 6872.6731195112},
+		{A: 4.5e-10, B: 1.98725045807, C: 1861.7458526354},
+		{A: 4.5e-10, B: 0.50053853542, C: 14128.2427712456},
+		{A: 4.6e-10, B: 2.86512929328, C: 38650.173506199},
+		{A: 3.8e-10, B: 3.65846461938, C: 29698.2875113358},
+		{A: 3.9e-10, B: 4.57679716458, C: 6901.6374958384},
+		{A: 3.9e-10, B: 3.85504465583, C: 9945.5712088238},
+		{A: 4e-10, B: 0.06127203284, C: 9947.0556815321},
+		{A: 4.3e-10, B: 5.28854105201, C: 3274.1250177854},
+		{A: 4.7e-10, B: 6.25707790441, C: 24606.13555322},
+		{A: 3.7e-10, B: 5.02115296017, C: 11128.9760857842},
+		{A: 3.9e-10, B: 1.7142191987, C: 7696.8878712838},
+		{A: 3.7e-10, B: 4.3465298512, C: 3283.7140517642},
+		{A: 3.7e-10, B: 0.05572748092, C: 21150.8133658836},
+		{A: 4.2e-10, B: 4.9787204146, C: 13575.7488022372},
+		{A: 5e-10, B: 4.24170332288, C: 7747.7203305896},
+		{A: 3.7e-10, B: 4.07496312186, C: 8646.0634802536},
+		{A: 3.8e-10, B: 0.44080908793, C: 24491.4257925834},
+		{A: 3.6e-10, B: 1.73681874925, C: 3468.6312700372},
+		{A: 4.1e-10, B: 5.69294900686, C: 26087.9031415742},
+		{A: 3.6e-10, B: 1.80256389689, C: 8756.269801473},
+		{A: 3.6e-10, B: 3.37374689465, C: 48429.2821823244},
+		{A: 3.5e-10, B: 0.10555289345, C: 8742.0427074714},
+		{A: 4.1e-10, B: 4.26832466355, C: 21000.9158907568},
+		{A: 3.8e-10, B: 0.73199792046, C: 26084.0218062162},
+	},
+	// R2, 313 terms
+	{
+		{A: 0.00044242247, B: 0.47930603943, C: 3340.6124266998},
+		{A: 8.138042e-05, B: 0.86998398093, C: 6681.2248533996},
+		{A: 1.274915e-05, B: 1.22594050809, C: 10021.8372800994},
+		{A: 1.87387e-06, B: 1.57298991982, C: 13362.4497067992},
+		{A: 4.0744e-07, B: 1.9708017506, C: 3344.1355450488},
+		{A: 5.2396e-07, B: 3.14159265359, C: 0},
+		{A: 2.6616e-07, B: 1.91665615762, C: 16703.062133499},
+		{A: 1.7825e-07, B: 4.43499505333, C: 2281.2304965106},
+		{A: 1.1713e-07, B: 4.5251045373, C: 3185.1920272656},
+		{A: 1.0209e-07, B: 5.39143469548, C: 1059.3819301892},
+		{A: 9.95e-08, B: 0.41870577185, C: 796.2980068164},
+		{A: 9.237e-08, B: 4.53579272961, C: 2146.1654164752},
+		{A: 7.299e-08, B: 3.14218509183, C: 2544.3144198834},
+		{A: 7.217e-08, B: 2.29300859074, C: 6684.7479717486},
+		{A: 6.808e-08, B: 5.26702580055, C: 155.4203994342},
+		{A: 6.528e-08, B: 2.30781369329, C: 3738.761430108},
+		{A: 7.785e-08, B: 5.93369079547, C: 1748.016413067},
+		{A: 5.84e-08, B: 1.05191350362, C: 1349.8674096588},
+		{A: 6.749e-08, B: 5.30194395749, C: 1194.4470102246},
+		{A: 4.695e-08, B: 0.76880586144, C: 3097.88382272579},
+		{A: 5.391e-08, B: 1.00223256041, C: 3149.1641605882},
+		{A: 4.406e-08, B: 2.45556303355, C: 951.7184062506},
+		{A: 4.286e-08, B: 3.89643520638, C: 1592.5960136328},
+		{A: 3.514e-08, B: 1.85168391963, C: 398.1490034082},
+		{A: 3.699e-08, B: 2.26043707772, C: 20043.6745601988},
+		{A: 3.377e-08, B: 3.81683532672, C: 1751.539531416},
+		{A: 4.585e-08, B: 0.8078744174, C: 4136.9104335162},
+		{A: 3.201e-08, B: 2.11657635165, C: 5614.7293762096},
+		{A: 3.622e-08, B: 1.32395191387, C: 3333.498879699},
+		{A: 2.916e-08, B: 1.19337460559, C: 529.6909650946},
+		{A: 2.979e-08, B: 2.86481008776, C: 6151.533888305},
+		{A: 3.057e-08, B: 4.55276793064, C: 5628.9564702112},
+		{A: 2.906e-08, B: 1.20295377623, C: 3894.1818295422},
+		{A: 3.85e-08, B: 3.86055626689, C: 553.5694028424},
+		{A: 2.82e-08, B: 2.48683324916, C: 1990.745017041},
+		{A: 2.657e-08, B: 6.07411629964, C: 4292.3308329504},
+		{A: 2.7e-08, B: 2.9213977335, C: 3496.032826134},
+		{A: 2.395e-08, B: 5.94175921617, C: 2787.0430238574},
+		{A: 2.264e-08, B: 2.56219866409, C: 191.4482661116},
+		{A: 2.167e-08, B: 5.36812435483, C: 8962.4553499102},
+		{A: 2.149e-08, B: 2.74950075397, C: 242.728603974},
+		{A: 2.217e-08, B: 1.85265984462, C: 3337.0893083508},
+		{A: 1.996e-08, B: 5.76429928131, C: 3341.592747768},
+		{A: 1.999e-08, B: 3.82349238481, C: 2914.0142358238},
+		{A: 1.835e-08, B: 5.68592723044, C: 1589.0728952838},
+		{A: 1.812e-08, B: 3.32042068028, C: 5088.6288397668},
+		{A: 2.413e-08, B: 4.68291336853, C: 4690.4798363586},
+		{A: 1.97e-08, B: 4.17480610904, C: 3340.5951730476},
+		{A: 1.97e-08, B: 6.20643855008, C: 3340.629680352},
+		{A: 1.627e-08, B: 5.67733051452, C: 4535.0594369244},
+		{A: 2.16e-08, B: 1.07452599834, C: 2388.8940204492},
+		{A: 1.964e-08, B: 3.10805316088, C: 3583.3410306738},
+		{A: 1.985e-08, B: 5.7585035184, C: 4399.994356889},
+		{A: 1.507e-08, B: 4.95936409838, C: 382.8965322232},
+		{A: 1.278e-08, B: 4.82232889938, C: 2957.7158944766},
+		{A: 1.475e-08, B: 2.22707926559, C: 3723.508958923},
+		{A: 1.196e-08, B: 3.2672445892, C: 9492.1463150048},
+		{A: 1.349e-08, B: 4.87573224485, C: 6525.8044539654},
+		{A: 1.433e-08, B: 2.69734916443, C: 7079.3738568078},
+		{A: 1.224e-08, B: 2.62012336714, C: 10025.3603984484},
+		{A: 1.404e-08, B: 5.19056026479, C: 2700.7151403858},
+		{A: 1.202e-08, B: 0.93472783088, C: 2810.9214616052},
+		{A: 8.69e-09, B: 5.81340811635, C: 12303.06777661},
+		{A: 8.67e-09, B: 2.20046640409, C: 2699.7348193176},
+		{A: 8.3e-09, B: 2.01484544773, C: 5092.1519581158},
+		{A: 8.55e-09, B: 5.96220147975, C: 426.598190876},
+		{A: 8.48e-09, B: 2.26407047301, C: 6283.0758499914},
+		{A: 9.17e-09, B: 1.40295785881, C: 6489.776587288},
+		{A: 8.33e-09, B: 1.17384197174, C: 7477.522860216},
+		{A: 1.041e-08, B: 6.27171470048, C: 3347.7259737006},
+		{A: 9.65e-09, B: 3.39855816541, C: 5621.8429232104},
+		{A: 7.22e-09, B: 4.26304776331, C: 4933.2084403326},
+		{A: 7.06e-09, B: 2.34131594714, C: 7.1135470008},
+		{A: 7.68e-09, B: 2.06208352904, C: 5486.777843175},
+		{A: 9.53e-09, B: 2.11123497948, C: 3870.3033917944},
+		{A: 8.44e-09, B: 2.2393196324, C: 3553.9115221378},
+		{A: 6.46e-09, B: 2.24669034469, C: 3340.545116397},
+		{A: 6.53e-09, B: 3.99043329363, C: 6677.7017350506},
+		{A: 7.14e-09, B: 0.29739480601, C: 6681.2421070518},
+		{A: 8.28e-09, B: 0.2286361767, C: 3532.0606928114},
+		{A: 6.12e-09, B: 1.55388376751, C: 7234.794256242},
+		{A: 7.14e-09, B: 4.54969883976, C: 6681.2075997474},
+		{A: 5.86e-09, B: 3.30118433303, C: 1221.8485663214},
+		{A: 6.46e-09, B: 1.83853693231, C: 3340.6797370026},
+		{A: 5.6e-09, B: 5.05848353328, C: 8031.0922630584},
+		{A: 6.51e-09, B: 0.1589747216, C: 7632.9432596502},
+		{A: 4.88e-09, B: 3.08086378649, C: 6836.6452528338},
+		{A: 5.34e-09, B: 4.25727954634, C: 3339.6321056316},
+		{A: 5.04e-09, B: 2.59990772815, C: 23384.2869868986},
+		{A: 3.72e-09, B: 6.27186983164, C: 2487.4160449478},
+		{A: 3.43e-09, B: 4.71059403854, C: 639.897286314},
+		{A: 4.49e-09, B: 1.34123846614, C: 640.8776073822},
+		{A: 3.42e-09, B: 5.96338023755, C: 4929.6853219836},
+		{A: 3.29e-09, B: 2.6595525877, C: 9623.6882766912},
+		{A: 3.52e-09, B: 6.09567212646, C: 7740.6067835888},
+		{A: 3.26e-09, B: 6.05498346093, C: 2118.7638603784},
+		{A: 4.03e-09, B: 4.96465831103, C: 8969.568896911},
+		{A: 4.02e-09, B: 2.84624835384, C: 5331.3574437408},
+		{A: 3.19e-09, B: 1.44545457475, C: 5729.506447149},
+		{A: 3.03e-09, B: 0.01918776551, C: 6127.6554505572},
+		{A: 2.97e-09, B: 5.74070606294, C: 6041.3275670856},
+		{A: 2.97e-09, B: 3.06763224975, C: 10419.9862835076},
+		{A: 2.82e-09, B: 6.04069352017, C: 7875.6718636242},
+		{A: 2.78e-09, B: 5.11654962157, C: 11773.3768115154},
+		{A: 2.59e-09, B: 2.89786343627, C: 7210.9158184942},
+		{A: 2.73e-09, B: 3.83181794308, C: 8429.2412664666},
+		{A: 2.56e-09, B: 1.08862226474, C: 2288.3440435114},
+		{A: 2.88e-09, B: 6.1357268768, C: 6682.2051744678},
+		{A: 3.02e-09, B: 3.61490647715, C: 12832.7587417046},
+		{A: 2.35e-09, B: 2.21413387615, C: 6681.2921637024},
+		{A: 3.17e-09, B: 3.60634897209, C: 2301.58581590939},
+		{A: 2.89e-09, B: 5.08223311018, C: 2274.1169495098},
+		{A: 2.54e-09, B: 0.36603904729, C: 3767.2106175758},
+		{A: 2.35e-09, B: 2.6243448155, C: 6681.1575430968},
+		{A: 2.26e-09, B: 3.45305949713, C: 6923.9534573736},
+		{A: 2.05e-09, B: 3.88943452829, C: 10018.3141617504},
+		{A: 2.06e-09, B: 3.44453113177, C: 10177.2576795336},
+		{A: 2.1e-09, B: 0.57926734389, C: 10973.55568635},
+		{A: 1.94e-09, B: 1.57870686859, C: 10818.1352869158},
+		{A: 1.9e-09, B: 2.42312911773, C: 8955.3418029094},
+		{A: 1.94e-09, B: 2.45887376159, C: 7064.1213856228},
+		{A: 1.93e-09, B: 0.58793888724, C: 5828.0284716476},
+		{A: 1.83e-09, B: 1.68247271963, C: 213.299095438},
+		{A: 2.36e-09, B: 1.98863774162, C: 10575.4066829418},
+		{A: 2.45e-09, B: 2.17761779228, C: 4562.4609930212},
+		{A: 1.77e-09, B: 0.66379705293, C: 10021.8545337516},
+		{A: 1.76e-09, B: 4.91607557961, C: 10021.8200264472},
+		{A: 2.17e-09, B: 2.93968443649, C: 13365.9728251482},
+		{A: 1.67e-09, B: 4.74764434327, C: 3914.9572250346},
+		{A: 2.13e-09, B: 0.15734917857, C: 2942.4634232916},
+		{A: 1.55e-09, B: 1.78776991092, C: 9830.3890139878},
+		{A: 1.8e-09, B: 5.21088564787, C: 9866.4168806652},
+		{A: 1.61e-09, B: 5.1768386138, C: 6298.3283211764},
+		{A: 1.59e-09, B: 2.6898068485, C: 6894.5239488376},
+		{A: 1.49e-09, B: 6.26524493469, C: 1039.0266107904},
+		{A: 1.47e-09, B: 0.36939961702, C: 6688.3384004004},
+		{A: 1.51e-09, B: 1.2227289229, C: 574.3447983348},
+		{A: 1.37e-09, B: 2.09492296067, C: 1066.49547719},
+		{A: 1.65e-09, B: 6.15952050903, C: 15643.6802033098},
+		{A: 1.63e-09, B: 4.66507592292, C: 8273.8208670324},
+		{A: 1.24e-09, B: 0.67855210339, C: 6438.4962494256},
+		{A: 1.28e-09, B: 1.69206367749, C: 3981.490034082},
+		{A: 1.16e-09, B: 2.78191248964, C: 3360.96774609859},
+		{A: 1.28e-09, B: 0.84461423001, C: 3355.8648978848},
+		{A: 1.24e-09, B: 1.63253732557, C: 6674.1113063988},
+		{A: 1.2e-09, B: 0.31762150109, C: 3127.3133312618},
+		{A: 1.29e-09, B: 2.26678583645, C: 6467.9257579616},
+		{A: 1.04e-09, B: 0.07355376701, C: 3325.3599555148},
+		{A: 1.3e-09, B: 4.4177719083, C: 3320.257107301},
+		{A: 9.5e-10, B: 1.31957248342, C: 7903.073419721},
+		{A: 1.14e-09, B: 0.65681602343, C: 3178.1457905676},
+		{A: 9.2e-10, B: 4.98392367746, C: 9779.1086761254},
+		{A: 1.01e-09, B: 4.10670787374, C: 536.8045120954},
+		{A: 1.06e-09, B: 5.41622566807, C: 15113.9892382152},
+		{A: 9.6e-10, B: 3.52561014696, C: 3475.6775067352},
+		{A: 1.01e-09, B: 2.91169549546, C: 692.1576012268},
+		{A: 9.3e-10, B: 3.21464368291, C: 8671.9698704406},
+		{A: 8.7e-10, B: 5.24375873119, C: 13119.72110282519},
+		{A: 1.06e-09, B: 3.76804681559, C: 13517.8701062334},
+		{A: 8.9e-10, B: 5.73652101746, C: 11371.7046897582},
+		{A: 1.04e-09, B: 5.90078964406, C: 8432.7643848156},
+		{A: 9.9e-10, B: 3.61425346453, C: 853.196381752},
+		{A: 7.8e-10, B: 0.95802015551, C: 3191.0492295652},
+		{A: 8.9e-10, B: 2.50872076312, C: 1052.2683831884},
+		{A: 7.8e-10, B: 2.98115354616, C: 26724.8994135984},
+		{A: 7.9e-10, B: 5.9151499393, C: 6040.3472460174},
+		{A: 9e-10, B: 3.00950470302, C: 12964.300703391},
+		{A: 7.5e-10, B: 1.31512531841, C: 17924.9106998204},
+		{A: 7.3e-10, B: 5.01153593776, C: 1903.4368125012},
+		{A: 7.5e-10, B: 2.57969241717, C: 10021.9045904022},
+		{A: 7.3e-10, B: 4.09954161905, C: 3319.8370312074},
+		{A: 7e-10, B: 5.37678396256, C: 12310.1813236108},
+		{A: 7.1e-10, B: 0.9842299824, C: 13362.4669604514},
+		{A: 6.8e-10, B: 4.69140248943, C: 522.5774180938},
+		{A: 6.8e-10, B: 3.24217441291, C: 16706.585251848},
+		{A: 6.9e-10, B: 2.53964193741, C: 5884.9268465832},
+		{A: 7e-10, B: 2.38237948321, C: 13916.0191096416},
+		{A: 6.6e-10, B: 3.48443797546, C: 13760.5987102074},
+		{A: 6.8e-10, B: 5.27435069681, C: 3980.5097130138},
+		{A: 7.6e-10, B: 0.12288413214, C: 11216.284290324},
+		{A: 7.3e-10, B: 3.94785759909, C: 16173.3711684044},
+		{A: 6.2e-10, B: 0.15518975647, C: 11081.2192102886},
+		{A: 7.4e-10, B: 2.99926779692, C: 10021.7699697966},
+		{A: 8e-10, B: 6.18812323827, C: 9380.9596727172},
+		{A: 6.4e-10, B: 3.99294283669, C: 13358.9265884502},
+		{A: 6.1e-10, B: 2.56817782412, C: 149.5631971346},
+		{A: 5.9e-10, B: 3.80850908229, C: 10264.5658840734},
+		{A: 6e-10, B: 4.79879360562, C: 1437.1756141986},
+		{A: 6.8e-10, B: 2.07515658228, C: 1596.1864422846},
+		{A: 6e-10, B: 2.26503107747, C: 28628.3362260996},
+		{A: 5.8e-10, B: 6.10782258899, C: 5459.3762870782},
+		{A: 6.3e-10, B: 0.24407077742, C: 18984.2926300096},
+		{A: 5.8e-10, B: 5.22738430747, C: 13362.432453147},
+		{A: 5.6e-10, B: 1.88708253797, C: 9070.1188738488},
+		{A: 5.5e-10, B: 5.72587156499, C: 433.7117378768},
+		{A: 5.6e-10, B: 1.18238857561, C: 6696.4773245846},
+		{A: 6.2e-10, B: 3.98214740104, C: 3427.9206312396},
+		{A: 5.6e-10, B: 5.37129176649, C: 5099.2655051166},
+		{A: 5.7e-10, B: 0.86204712032, C: 5642.1982426092},
+		{A: 5.5e-10, B: 1.31787605222, C: 8982.810669309},
+		{A: 5.6e-10, B: 5.49808236951, C: 24093.2767891752},
+		{A: 5.4e-10, B: 3.77562154746, C: 10213.285546211},
+		{A: 5.4e-10, B: 2.36294643031, C: 33716.9650658664},
+		{A: 6.3e-10, B: 3.53402427573, C: 3205.5473466644},
+		{A: 6.6e-10, B: 6.25810929981, C: 8270.2977486834},
+		{A: 6.7e-10, B: 0.5859281308, C: 3335.0895023924},
+		{A: 5.5e-10, B: 4.74995645767, C: 6660.8695340008},
+		{A: 5.3e-10, B: 0.31930032532, C: 12721.572099417},
+		{A: 6.9e-10, B: 2.42905593362, C: 6158.6474353058},
+		{A: 5.5e-10, B: 5.03192783121, C: 7255.5696517344},
+		{A: 5.1e-10, B: 5.45351947711, C: 13207.029307365},
+		{A: 5e-10, B: 0.23368526162, C: 4379.6390374902},
+		{A: 5.3e-10, B: 2.1282634402, C: 13171.0014406876},
+		{A: 4.9e-10, B: 2.35512151368, C: 4032.7700279266},
+		{A: 4.9e-10, B: 2.8177305089, C: 9808.5381846614},
+		{A: 6.7e-10, B: 2.26295786556, C: 2766.267628365},
+		{A: 4.9e-10, B: 0.72597680486, C: 10028.9508271002},
+		{A: 5e-10, B: 6.1011945035, C: 3253.30422216},
+		{A: 5.9e-10, B: 0.27861398526, C: 9225.539273283},
+		{A: 5.3e-10, B: 1.27330874549, C: 3344.4937620578},
+		{A: 4.9e-10, B: 2.91499389416, C: 10404.7338123226},
+		{A: 4.7e-10, B: 0.48598973231, C: 17395.2197347258},
+		{A: 4.9e-10, B: 3.40240207389, C: 3304.5845600224},
+		{A: 6e-10, B: 3.40554368741, C: 10551.528245194},
+		{A: 5e-10, B: 1.83471955355, C: 30376.3526391666},
+		{A: 4.6e-10, B: 3.04980996832, C: 6144.4203413042},
+		{A: 4.9e-10, B: 0.33200904496, C: 6702.000248892},
+		{A: 5.3e-10, B: 0.35820094732, C: 9468.267877257},
+		{A: 5e-10, B: 0.15378507249, C: 2648.454825473},
+		{A: 4.4e-10, B: 0.79762157297, C: 7107.8230442756},
+		{A: 5e-10, B: 2.41332661328, C: 4407.1079038898},
+		{A: 5.4e-10, B: 0.21682940856, C: 10022.8176011676},
+		{A: 4.4e-10, B: 5.78502737591, C: 3364.4908644476},
+		{A: 4.4e-10, B: 0.75508636274, C: 16062.1845261168},
+		{A: 4.3e-10, B: 3.10581037685, C: 10235.1363755374},
+		{A: 4.4e-10, B: 5.21252098878, C: 7373.3824546264},
+		{A: 5.8e-10, B: 4.51680312444, C: 6660.4494579072},
+		{A: 4.3e-10, B: 0.15523355839, C: 1505.28780909299},
+		{A: 5.5e-10, B: 5.93371026829, C: 8439.8779318164},
+		{A: 4.2e-10, B: 5.40417232308, C: 3344.2028553516},
+		{A: 5.6e-10, B: 3.87534582911, C: 6816.289933435},
+		{A: 4.9e-10, B: 5.57153056447, C: 17468.8551979454},
+		{A: 4.6e-10, B: 3.07783299049, C: 12722.5524204852},
+		{A: 4.5e-10, B: 1.49303542795, C: 6247.5131155228},
+		{A: 5.5e-10, B: 6.26098836404, C: 3361.3878221922},
+		{A: 4.2e-10, B: 4.74455545491, C: 3369.0616141676},
+		{A: 4e-10, B: 1.58676666294, C: 2818.035008606},
+		{A: 4.3e-10, B: 4.97295184315, C: 3337.021998048},
+		{A: 5.5e-10, B: 3.8021563852, C: 3376.6402933772},
+		{A: 4.7e-10, B: 1.97367775421, C: 1581.959348283},
+		{A: 4.5e-10, B: 2.03598705348, C: 5244.049239201},
+		{A: 4.9e-10, B: 4.43182954044, C: 9595.2390892234},
+		{A: 4.3e-10, B: 0.38914582094, C: 6665.9723822146},
+		{A: 4e-10, B: 1.06953099245, C: 23695.127785767},
+		{A: 3.8e-10, B: 0.59996214864, C: 10713.9948813262},
+		{A: 3.9e-10, B: 3.79055778605, C: 33318.8160624582},
+		{A: 4.1e-10, B: 5.54542347834, C: 3863.1898447936},
+		{A: 3.7e-10, B: 5.27208151144, C: 20752.6643624754},
+		{A: 3.9e-10, B: 3.15412760412, C: 14469.588512484},
+		{A: 4.1e-10, B: 0.55705920174, C: 3346.1353510072},
+		{A: 4.8e-10, B: 1.42210748234, C: 3316.733988952},
+		{A: 4.9e-10, B: 5.44942247991, C: 9638.9407478762},
+		{A: 3.8e-10, B: 2.11846271943, C: 15664.03552270859},
+		{A: 3.5e-10, B: 5.30782933148, C: 6677.6344247478},
+		{A: 3.6e-10, B: 2.68151450016, C: 8584.6616659008},
+		{A: 4.6e-10, B: 3.09450395763, C: 6701.5801727984},
+		{A: 3.8e-10, B: 1.81885395671, C: 14158.7477136156},
+		{A: 3.7e-10, B: 5.80483071722, C: 6684.8152820514},
+		{A: 4.7e-10, B: 2.60317702777, C: 162.4666361322},
+		{A: 3.8e-10, B: 2.22559377525, C: 3503.079062832},
+		{A: 3.5e-10, B: 0.84358942718, C: 6518.7582172674},
+		{A: 3.3e-10, B: 4.92302322062, C: 23141.5583829246},
+		{A: 4.3e-10, B: 0.95148893985, C: 34115.1140692746},
+		{A: 4.6e-10, B: 4.99457196207, C: 9381.9399937854},
+		{A: 4.3e-10, B: 1.73832102244, C: 12323.4230960088},
+		{A: 3.2e-10, B: 2.92741580696, C: 9499.2598620056},
+		{A: 4.3e-10, B: 1.50296327105, C: 27035.7402124668},
+		{A: 3.3e-10, B: 5.41731109688, C: 20597.2439630412},
+		{A: 3.2e-10, B: 4.13881058425, C: 31570.7996493912},
+		{A: 4e-10, B: 5.8446306918, C: 16460.33352952499},
+		{A: 3.5e-10, B: 5.50083743557, C: 8425.6508378148},
+		{A: 3.8e-10, B: 5.91991985929, C: 3336.7310913418},
+		{A: 3e-10, B: 5.09155042811, C: 5085.038411115},
+		{A: 3.1e-10, B: 4.27211180916, C: 36659.428489158},
+		{A: 3e-10, B: 4.87389489592, C: 1692.1656695024},
+		{A: 3e-10, B: 3.75494646937, C: 4845.9002357928},
+		{A: 3.4e-10, B: 3.71030605417, C: 8827.3902698748},
+		{A: 3e-10, B: 0.0159945685, C: 11243.6858464208},
+		{A: 2.9e-10, B: 2.66198696023, C: 12295.9542296092},
+		{A: 2.8e-10, B: 3.12114207206, C: 11925.2740926006},
+		{A: 3.6e-10, B: 1.16568750904, C: 18052.9295431578},
+		{A: 3.2e-10, B: 1.72704226918, C: 12566.1516999828},
+		{A: 2.8e-10, B: 2.80254457895, C: 3607.2194684216},
+		{A: 3.2e-10, B: 4.04390839531, C: 6680.2445323314},
+		{A: 2.7e-10, B: 2.53565904393, C: 19004.6479494084},
+		{A: 3.6e-10, B: 3.33518515689, C: 29978.2036357584},
+		{A: 2.8e-10, B: 0.58104982217, C: 20354.5153590672},
+		{A: 2.9e-10, B: 5.85207825749, C: 31172.650645983},
+		{A: 2.6e-10, B: 2.22598820379, C: 3.523118349},
+		{A: 3.3e-10, B: 5.15903548241, C: 10596.1820784342},
+		{A: 2.8e-10, B: 1.89792803028, C: 3333.5661900018},
+		{A: 2.7e-10, B: 2.25129832716, C: 76.2660712756},
+		{A: 2.6e-10, B: 4.32703172263, C: 17085.9586657222},
+		{A: 2.6e-10, B: 5.12847780702, C: 11766.2632645146},
+		{A: 2.8e-10, B: 0.80926596905, C: 20735.83216142559},
+		{A: 2.6e-10, B: 5.06814803493, C: 17412.0519357756},
+		{A: 2.4e-10, B: 4.473991556, C: 3134.4268782626},
+		{A: 2.4e-10, B: 4.35557152695, C: 14584.2982731206},
+		{A: 2.7e-10, B: 4.98717355201, C: 3877.4169387952},
+		{A: 2.5e-10, B: 5.63712701265, C: 14054.607308026},
+		{A: 2.4e-10, B: 4.12573657295, C: 4193.8088084518},
+		{A: 2.5e-10, B: 1.40880282063, C: 8186.5126624926},
+		{A: 2.4e-10, B: 4.96463811748, C: 26087.9031415742},
+	},
+	// R3, 111 terms
+	{
+		{A: 1.113107e-05, B: 5.14987350142, C: 3340.6124266998},
+		{A: 4.24446e-06, B: 5.61343766478, C: 6681.2248533996},
+		{A: 1.00044e-06, B: 5.99726827028, C: 10021.8372800994},
+		{A: 1.9606e-07, B: 0.07633062094, C: 13362.4497067992},
+		{A: 3.477e-08, B: 0.42951907576, C: 16703.062133499},
+		{A: 4.693e-08, B: 3.14159265359, C: 0},
+		{A: 2.869e-08, B: 0.44711842697, C: 3344.1355450488},
+		{A: 2.428e-08, B: 3.02115527957, C: 3185.1920272656},
+		{A: 6.88e-09, B: 0.80693359444, C: 6684.7479717486},
+		{A: 5.4e-09, B: 3.86836515672, C: 1059.3819301892},
+		{A: 5.77e-09, B: 0.7785327512, C: 20043.6745601988},
+		{A: 4.68e-09, B: 4.52450786544, C: 3496.032826134},
+		{A: 4.87e-09, B: 1.60862391345, C: 3583.3410306738},
+		{A: 3.62e-09, B: 4.42397903418, C: 2787.0430238574},
+		{A: 3.97e-09, B: 5.71967986581, C: 3149.1641605882},
+		{A: 2.99e-09, B: 0.75640033535, C: 3738.761430108},
+		{A: 3.5e-09, B: 5.55691984889, C: 4136.9104335162},
+		{A: 3.14e-09, B: 3.37632898783, C: 6525.8044539654},
+		{A: 2.33e-09, B: 2.13965262306, C: 3097.88382272579},
+		{A: 2.12e-09, B: 4.20487494011, C: 3341.592747768},
+		{A: 2.14e-09, B: 0.56779556947, C: 155.4203994342},
+		{A: 2.01e-09, B: 2.35284464564, C: 1592.5960136328},
+		{A: 2.04e-09, B: 0.96723315302, C: 1990.745017041},
+		{A: 2.3e-09, B: 5.82959057146, C: 2388.8940204492},
+		{A: 1.6e-09, B: 4.14974194757, C: 4535.0594369244},
+		{A: 1.56e-09, B: 5.13345210285, C: 796.2980068164},
+		{A: 1.46e-09, B: 3.29541339704, C: 2957.7158944766},
+		{A: 1.56e-09, B: 1.13805508457, C: 10025.3603984484},
+		{A: 1.59e-09, B: 3.76174286126, C: 1194.4470102246},
+		{A: 1.36e-09, B: 5.58293924642, C: 3894.1818295422},
+		{A: 1.45e-09, B: 3.02112488974, C: 3337.0893083508},
+		{A: 1.46e-09, B: 4.25359617225, C: 4399.994356889},
+		{A: 1e-09, B: 4.26740096115, C: 1589.0728952838},
+		{A: 9.1e-10, B: 6.12108227231, C: 6489.776587288},
+		{A: 1.01e-09, B: 1.17242141914, C: 7079.3738568078},
+		{A: 9.8e-10, B: 1.12613498307, C: 23384.2869868986},
+		{A: 7.5e-10, B: 6.02182689533, C: 529.6909650946},
+		{A: 7.8e-10, B: 5.93418069229, C: 7477.522860216},
+		{A: 6.8e-10, B: 1.70775041911, C: 9492.1463150048},
+		{A: 7e-10, B: 3.67253155189, C: 8962.4553499102},
+		{A: 6.2e-10, B: 4.14954627063, C: 4292.3308329504},
+		{A: 6.7e-10, B: 0.1509224655, C: 3870.3033917944},
+		{A: 6.6e-10, B: 1.12578506413, C: 6151.533888305},
+		{A: 6.2e-10, B: 1.93984222091, C: 6923.9534573736},
+		{A: 6e-10, B: 4.30641513096, C: 12303.06777661},
+		{A: 7.4e-10, B: 4.72156358149, C: 3347.7259737006},
+		{A: 5.5e-10, B: 2.75742802464, C: 4933.2084403326},
+		{A: 5.3e-10, B: 4.77894621871, C: 6127.6554505572},
+		{A: 5e-10, B: 2.57807944338, C: 3340.5951730476},
+		{A: 4.8e-10, B: 3.72532068729, C: 9866.4168806652},
+		{A: 4.2e-10, B: 6.15391373101, C: 7234.794256242},
+		{A: 4.3e-10, B: 3.43955584179, C: 5486.777843175},
+		{A: 5e-10, B: 4.60885064189, C: 3340.629680352},
+		{A: 4.4e-10, B: 4.47943289816, C: 426.598190876},
+		{A: 4.5e-10, B: 6.244852075, C: 2274.1169495098},
+		{A: 4e-10, B: 0.73805316471, C: 7.1135470008},
+		{A: 3.8e-10, B: 1.06497784748, C: 9623.6882766912},
+		{A: 4.1e-10, B: 4.60954640493, C: 6682.2051744678},
+		{A: 3.8e-10, B: 4.60963924082, C: 7740.6067835888},
+		{A: 3.6e-10, B: 3.15129246169, C: 639.897286314},
+		{A: 3.7e-10, B: 6.14629970622, C: 5729.506447149},
+		{A: 4e-10, B: 1.4739554485, C: 13365.9728251482},
+		{A: 3.5e-10, B: 2.60772245492, C: 4690.4798363586},
+		{A: 3.5e-10, B: 2.450779319, C: 2288.3440435114},
+		{A: 3.4e-10, B: 4.50544497582, C: 7875.6718636242},
+		{A: 4.2e-10, B: 2.11383330882, C: 12832.7587417046},
+		{A: 3.3e-10, B: 3.97655860802, C: 191.4482661116},
+		{A: 3.2e-10, B: 4.06047154083, C: 3532.0606928114},
+		{A: 3.4e-10, B: 5.06671994554, C: 6681.2421070518},
+		{A: 4.2e-10, B: 4.67024017441, C: 15643.6802033098},
+		{A: 3.6e-10, B: 0.29140871479, C: 3723.508958923},
+		{A: 3.3e-10, B: 4.52588149629, C: 4929.6853219836},
+		{A: 3.4e-10, B: 2.7449907289, C: 6677.7017350506},
+		{A: 3e-10, B: 1.57743012295, C: 10419.9862835076},
+		{A: 4e-10, B: 1.31713176335, C: 5331.3574437408},
+		{A: 3.4e-10, B: 3.03490000148, C: 6681.2075997474},
+		{A: 3.1e-10, B: 1.25306164576, C: 242.728603974},
+		{A: 2.9e-10, B: 5.44118048011, C: 10021.8545337516},
+		{A: 2.9e-10, B: 3.41037097794, C: 10021.8200264472},
+		{A: 2.8e-10, B: 4.81855060968, C: 6836.6452528338},
+		{A: 2.8e-10, B: 0.72745302325, C: 6283.0758499914},
+		{A: 3e-10, B: 3.06267495632, C: 2281.2304965106},
+		{A: 2.7e-10, B: 0.62535799761, C: 6681.2921637024},
+		{A: 3e-10, B: 3.56389476096, C: 8969.568896911},
+		{A: 3.2e-10, B: 5.31078691958, C: 553.5694028424},
+		{A: 3.3e-10, B: 2.00753799963, C: 5884.9268465832},
+		{A: 2.7e-10, B: 1.18001479303, C: 8955.3418029094},
+		{A: 2.5e-10, B: 2.94122791628, C: 2544.3144198834},
+		{A: 2.5e-10, B: 0.17808889827, C: 9830.3890139878},
+		{A: 3.1e-10, B: 5.73384204593, C: 3339.6321056316},
+		{A: 2.8e-10, B: 3.70436008855, C: 6298.3283211764},
+		{A: 2.4e-10, B: 0.07739730466, C: 10818.1352869158},
+		{A: 2.7e-10, B: 1.03401737006, C: 6681.1575430968},
+		{A: 2.4e-10, B: 0.09987383242, C: 382.8965322232},
+		{A: 2.7e-10, B: 0.60053652251, C: 3553.9115221378},
+		{A: 2.7e-10, B: 4.97107900859, C: 3767.2106175758},
+		{A: 2.4e-10, B: 3.36621347824, C: 8031.0922630584},
+		{A: 2.6e-10, B: 4.80509833364, C: 7632.9432596502},
+		{A: 2.2e-10, B: 1.43966107077, C: 26724.8994135984},
+		{A: 2e-10, B: 0.36901153144, C: 398.1490034082},
+		{A: 1.9e-10, B: 1.00325306041, C: 7064.1213856228},
+		{A: 2e-10, B: 0.19821371186, C: 6674.1113063988},
+		{A: 1.8e-10, B: 1.07169297658, C: 7210.9158184942},
+		{A: 2.1e-10, B: 2.6951214034, C: 10018.3141617504},
+		{A: 2.3e-10, B: 3.25670295081, C: 5621.8429232104},
+		{A: 1.8e-10, B: 0.24542781929, C: 3981.490034082},
+		{A: 1.8e-10, B: 5.14428605713, C: 6688.3384004004},
+		{A: 2e-10, B: 0.97539014916, C: 6894.5239488376},
+		{A: 2.4e-10, B: 4.42156401638, C: 1748.016413067},
+		{A: 1.6e-10, B: 0.6538950344, C: 3340.545116397},
+		{A: 1.6e-10, B: 5.28427106012, C: 3333.498879699},
+	},
+	// R4, 28 terms
+	{
+		{A: 1.9552e-07, B: 3.58211650473, C: 3340.6124266998},
+		{A: 1.6323e-07, B: 4.05116076923, C: 6681.2248533996},
+		{A: 5.848e-08, B: 4.46383962094, C: 10021.8372800994},
+		{A: 1.532e-08, B: 4.84374321619, C: 13362.4497067992},
+		{A: 3.75e-09, B: 1.50962233608, C: 3185.1920272656},
+		{A: 3.39e-09, B: 5.20684967613, C: 16703.062133499},
+		{A: 1.51e-09, B: 5.16472931648, C: 3344.1355450488},
+		{A: 1.25e-09, B: 2.19233532803, C: 3496.032826134},
+		{A: 1.48e-09, B: 0, C: 0},
+		{A: 8.7e-10, B: 0.10275067375, C: 3583.3410306738},
+		{A: 7e-10, B: 5.55303619365, C: 20043.6745601988},
+		{A: 4.8e-10, B: 2.91797786512, C: 2787.0430238574},
+		{A: 4.9e-10, B: 5.5703851483, C: 6684.7479717486},
+		{A: 5.7e-10, B: 1.86867280743, C: 6525.8044539654},
+		{A: 3.4e-10, B: 3.63370917313, C: 3097.88382272579},
+		{A: 2.1e-10, B: 2.30677637991, C: 1059.3819301892},
+		{A: 2.1e-10, B: 4.03682122005, C: 4136.9104335162},
+		{A: 1.8e-10, B: 4.1743875589, C: 3149.1641605882},
+		{A: 1.3e-10, B: 1.97805475719, C: 155.4203994342},
+		{A: 1.7e-10, B: 5.9485641469, C: 10025.3603984484},
+		{A: 1.2e-10, B: 0.51097435253, C: 6923.9534573736},
+		{A: 1.2e-10, B: 3.27945299261, C: 6127.6554505572},
+		{A: 1.3e-10, B: 2.22938285522, C: 9866.4168806652},
+		{A: 1.6e-10, B: 2.64206771227, C: 4399.994356889},
+		{A: 1.3e-10, B: 2.75813969974, C: 6836.6452528338},
+		{A: 1.3e-10, B: 4.51988349676, C: 6489.776587288},
+		{A: 1.4e-10, B: 0.76591550754, C: 3894.1818295422},
+		{A: 1.1e-10, B: 5.47381242145, C: 3738.761430108},
+	},
+	// R5, 9 terms
+	{
+		{A: 4.76e-09, B: 2.47617204701, C: 6681.2248533996},
+		{A: 2.68e-09, B: 2.91510547706, C: 10021.8372800994},
+		{A: 1.15e-09, B: 1.76888962311, C: 3340.6124266998},
+		{A: 9.6e-10, B: 3.31378377179, C: 13362.4497067992},
+		{A: 4.8e-10, B: 6.27457828287, C: 3185.1920272656},
+		{A: 2.7e-10, B: 3.69380877066, C: 16703.062133499},
+		{A: 1.3e-10, B: 3.72005807206, C: 3496.032826134},
+		{A: 1.2e-10, B: 4.88179002689, C: 3583.3410306738},
+		{A: 1.2e-10, B: 3.14159265359, C: 0},
+	},
+}
+
+// GetMarsR0 evaluates the t^0 series of R.
+func GetMarsR0(t float64) float64 {
+	return marsR[0].Evaluate(t)
+}
+
+// GetMarsR1 evaluates the t^1 series of R.
+func GetMarsR1(t float64) float64 {
+	return marsR[1].Evaluate(t)
+}
+
+// GetMarsR2 evaluates the t^2 series of R.
+func GetMarsR2(t float64) float64 {
+	return marsR[2].Evaluate(t)
+}
+
+// GetMarsR3 evaluates the t^3 series of R.
+func GetMarsR3(t float64) float64 {
+	return marsR[3].Evaluate(t)
+}
+
+// GetMarsR4 evaluates the t^4 series of R.
+func GetMarsR4(t float64) float64 {
+	return marsR[4].Evaluate(t)
+}
+
+// GetMarsR5 evaluates the t^5 series of R.
+func GetMarsR5(t float64) float64 {
+	return marsR[5].Evaluate(t)
+}
@@ -0,0 +1,63 @@
+package vsop87mercuryd
+
+import (
+    "calendarutil"
+    "mathutil"
+)
+
+/**
+ * 按儒略日计算水星的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 水星的日心黄经，单位是弧度(rad)
+ */
+func GetSunEclipticLongitudeForMercury(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    L0 := GetMercuryL0(t)
+    L1 := GetMercuryL1(t)
+    L2 := GetMercuryL2(t)
+    L3 := GetMercuryL3(t)
+    L4 := GetMercuryL4(t)
+    L5 := GetMercuryL5(t)
+    L := ((((L5 * t + L4) * t + L3) * t + L2) * t + L1) * t + L0
+    return mathutil.Mod2Pi(L)
+}
+
+/**
+ * 按儒略日计算水星的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 水星的日心黄纬，单位是弧度(rad)
+ */
+func GetSunEclipticLatitudeForMercury(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    B0 := GetMercuryB0(t)
+    B1 := GetMercuryB1(t)
+    B2 := GetMercuryB2(t)
+    B3 := GetMercuryB3(t)
+    B4 := GetMercuryB4(t)
+    B5 := GetMercuryB5(t)
+    B := ((((B5 * t + B4) * t + B3) * t + B2) * t + B1) * t + B0
+    return B
+}
+
+/**
+ * 按照儒略日计算水星和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 水星和太阳的距离，单位是天文单位(au)
+ */
+func GetSunRadiusForMercury(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    R0 := GetMercuryR0(t)
+    R1 := GetMercuryR1(t)
+    R2 := GetMercuryR2(t)
+    R3 := GetMercuryR3(t)
+    R4 := GetMercuryR4(t)
+    R5 := GetMercuryR5(t)
+    R := ((((R5 * t + R4) * t + R3) * t + R2) * t + R1) * t + R0
+    return R
+}
@@ -0,0 +1,3 @@
+package vsop87mercuryd
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Mercury -package vsop87mercuryd
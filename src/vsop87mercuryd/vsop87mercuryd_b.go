@@ -0,0 +1,1679 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// MERCURY B - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87mercuryd
+
+import "vsop87"
+
+var mercuryB = []vsop87.Series{
+	// B0, 818 terms
+	{
+		{A: 0.11737528962, B: 1.98357498767, C: 26087.9031415742},
+		{A: 0.02388076996, B: 5.03738959685, C: 52175.8062831484},
+		{A: 0.01222839532, B: 3.14159265359, C: 0},
+		{A: 0.0054325181, B: 1.79644363963, C: 78263.70942472259},
+		{A: 0.0012977877, B: 4.83232503961, C: 104351.61256629678},
+		{A: 0.00031866927, B: 1.58088495667, C: 130439.51570787099},
+		{A: 7.963301e-05, B: 4.60972126348, C: 156527.41884944518},
+		{A: 2.014189e-05, B: 1.35324164694, C: 182615.3219910194},
+		{A: 5.13953e-06, B: 4.37835409309, C: 208703.2251325936},
+		{A: 2.07674e-06, B: 4.91772564073, C: 27197.2816936676},
+		{A: 2.08584e-06, B: 2.02020294153, C: 24978.5245894808},
+		{A: 1.32013e-06, B: 1.11908492283, C: 234791.12827416777},
+		{A: 1.00454e-06, B: 5.65684734206, C: 20426.571092422},
+		{A: 1.21395e-06, B: 1.81271752059, C: 53285.1848352418},
+		{A: 9.1566e-07, B: 2.28163128692, C: 25028.521211385},
+		{A: 9.9214e-07, B: 0.09391887097, C: 51116.4243529592},
+		{A: 9.4574e-07, B: 1.24184909234, C: 31749.2351907264},
+		{A: 7.8785e-07, B: 4.4072588, C: 57837.1383323006},
+		{A: 7.7747e-07, B: 0.52557061749, C: 1059.3819301892},
+		{A: 8.4264e-07, B: 5.08510388314, C: 51066.427731055},
+		{A: 4.9948e-07, B: 3.49752993688, C: 5661.3320491522},
+		{A: 4.6454e-07, B: 3.23739270829, C: 77204.32749453338},
+		{A: 4.4767e-07, B: 4.87849816734, C: 79373.087976816},
+		{A: 4.0766e-07, B: 2.46558332165, C: 46514.4742339962},
+		{A: 3.7378e-07, B: 4.45768797944, C: 4551.9534970588},
+		{A: 3.4082e-07, B: 4.14209210575, C: 260879.03141574195},
+		{A: 3.5911e-07, B: 1.09057317869, C: 1109.3785520934},
+		{A: 3.1953e-07, B: 1.18516389747, C: 83925.0414738748},
+		{A: 3.0954e-07, B: 3.5032802721, C: 21535.9496445154},
+		{A: 3.1808e-07, B: 2.41474588439, C: 47623.8527860896},
+		{A: 2.8691e-07, B: 1.84828614269, C: 77154.33087262919},
+		{A: 2.5765e-07, B: 2.77593370583, C: 27043.5028831828},
+		{A: 2.5199e-07, B: 3.5906226646, C: 27147.28507176339},
+		{A: 2.0244e-07, B: 3.06833797229, C: 51646.11531805379},
+		{A: 1.8591e-07, B: 5.5842727444, C: 73711.75592766379},
+		{A: 1.6971e-07, B: 0.02791276551, C: 103292.23063610759},
+		{A: 2.0099e-07, B: 4.06593040301, C: 25132.3033999656},
+		{A: 1.7002e-07, B: 6.13739392193, C: 41962.5207369374},
+		{A: 1.4984e-07, B: 1.64717994813, C: 105460.99111839019},
+		{A: 1.4186e-07, B: 0.33074185469, C: 10213.285546211},
+		{A: 1.5577e-07, B: 6.07693643204, C: 53131.406024757},
+		{A: 1.5795e-07, B: 3.79629547258, C: 529.6909650946},
+		{A: 1.4011e-07, B: 5.52786452723, C: 72602.37737557039},
+		{A: 1.2309e-07, B: 3.16626298867, C: 14765.2390432698},
+		{A: 1.1261e-07, B: 0.11326534696, C: 13521.7514415914},
+		{A: 1.2448e-07, B: 4.05109331029, C: 39609.6545831656},
+		{A: 1.3044e-07, B: 3.48016433624, C: 37410.5672398786},
+		{A: 1.1042e-07, B: 4.23192662377, C: 110012.94461544899},
+		{A: 1.1152e-07, B: 0.5565846182, C: 63498.47038145279},
+		{A: 1.0717e-07, B: 1.53686240986, C: 25661.3049506982},
+		{A: 1.0213e-07, B: 2.87881017166, C: 12566.1516999828},
+		{A: 9.924e-08, B: 0.94824584866, C: 65697.55772473979},
+		{A: 1.1047e-07, B: 5.79741510309, C: 51749.20809227239},
+		{A: 1.046e-07, B: 5.82962163777, C: 50586.73338786459},
+		{A: 9.774e-08, B: 1.6627176265, C: 24498.8302462904},
+		{A: 9.412e-08, B: 1.82364886045, C: 15874.6175953632},
+		{A: 1.2866e-07, B: 4.81650804018, C: 30639.856638633},
+		{A: 9.123e-08, B: 4.8860439411, C: 103242.2340142034},
+		{A: 9.011e-08, B: 1.04262074744, C: 426.598190876},
+		{A: 8.735e-08, B: 3.04132552652, C: 68050.42387851159},
+		{A: 8.491e-08, B: 1.05130498445, C: 1589.0728952838},
+		{A: 8.835e-08, B: 0.88128343813, C: 286966.9345573162},
+		{A: 8.823e-08, B: 5.81343662067, C: 11322.6640983044},
+		{A: 8.196e-08, B: 0.84015129448, C: 51220.20654153979},
+		{A: 7.64e-08, B: 3.43584035231, C: 36301.18868778519},
+		{A: 9.175e-08, B: 6.16059719071, C: 77734.01845962799},
+		{A: 8.897e-08, B: 0.39691733779, C: 53235.18821333759},
+		{A: 7.253e-08, B: 2.37179970828, C: 99799.65906923798},
+		{A: 7.104e-08, B: 5.73557153523, C: 26617.5941066688},
+		{A: 8.322e-08, B: 5.271571288, C: 25558.2121764796},
+		{A: 6.578e-08, B: 2.66741925057, C: 52705.49724824299},
+		{A: 5.825e-08, B: 6.25072627414, C: 33326.5787331742},
+		{A: 5.625e-08, B: 3.0803000023, C: 129380.13377768178},
+		{A: 5.295e-08, B: 1.35863387771, C: 45892.73043315699},
+		{A: 5.116e-08, B: 2.67576280851, C: 76674.63652943878},
+		{A: 5.035e-08, B: 2.66614676257, C: 77837.11123384659},
+		{A: 4.762e-08, B: 4.68983196238, C: 131548.89425996438},
+		{A: 5.714e-08, B: 2.87339047644, C: 79219.3091663312},
+		{A: 5.601e-08, B: 5.3719088515, C: 955.5997416086},
+		{A: 4.965e-08, B: 3.47619946892, C: 59414.4818747484},
+		{A: 5.468e-08, B: 1.43781223143, C: 56727.7597802072},
+		{A: 4.08e-08, B: 4.01667499, C: 91785.46086631398},
+		{A: 3.971e-08, B: 5.44452771841, C: 6283.0758499914},
+		{A: 4.063e-08, B: 5.64291749144, C: 26068.2333806744},
+		{A: 3.877e-08, B: 3.60887633874, C: 89586.37352302698},
+		{A: 4.047e-08, B: 5.95235957644, C: 38654.05484155699},
+		{A: 4.064e-08, B: 1.46283079734, C: 26107.57290247399},
+		{A: 3.681e-08, B: 0.47365215122, C: 62389.09182935939},
+		{A: 4.247e-08, B: 6.23163402945, C: 40853.142184844},
+		{A: 3.694e-08, B: 2.75543227024, C: 7238.6755916},
+		{A: 3.348e-08, B: 5.91868911857, C: 43071.8992890308},
+		{A: 4.475e-08, B: 2.28937952702, C: 98690.28051714458},
+		{A: 3.115e-08, B: 0.74943919509, C: 32858.61374281979},
+		{A: 3.1e-08, B: 3.35203735852, C: 19804.8272915828},
+		{A: 3.215e-08, B: 6.11374633777, C: 94138.32702008578},
+		{A: 3.558e-08, B: 0.98820289555, C: 136100.84775702318},
+		{A: 2.908e-08, B: 4.06284172838, C: 26514.5013324502},
+		{A: 2.799e-08, B: 1.63602212056, C: 129330.13715577759},
+		{A: 2.8e-08, B: 3.88358619611, C: 77308.10968311399},
+		{A: 2.68e-08, B: 4.48235268618, C: 71980.63357473118},
+		{A: 2.767e-08, B: 4.12700796923, C: 27676.976036858},
+		{A: 3.285e-08, B: 0.3939808152, C: 18849.2275499742},
+		{A: 3.29e-08, B: 2.93487892542, C: 103821.92160120218},
+		{A: 3.048e-08, B: 0.67698362024, C: 9103.9069941176},
+		{A: 2.484e-08, B: 5.4233220496, C: 125887.56221081219},
+		{A: 2.663e-08, B: 1.70280488847, C: 16983.9961474566},
+		{A: 2.791e-08, B: 2.76839978418, C: 26091.7844769322},
+		{A: 2.747e-08, B: 5.75135788288, C: 19317.1925403286},
+		{A: 2.676e-08, B: 5.73635682216, C: 78793.40038981718},
+		{A: 2.71e-08, B: 4.3127490272, C: 58946.51688439399},
+		{A: 2.877e-08, B: 3.45410696457, C: 79323.09135491178},
+		{A: 2.787e-08, B: 4.34025817469, C: 26084.0218062162},
+		{A: 2.097e-08, B: 2.09090732812, C: 23869.1460373874},
+		{A: 2.297e-08, B: 3.9032125153, C: 313054.83769889036},
+		{A: 2.535e-08, B: 3.05170623483, C: 69159.80243060499},
+		{A: 2.211e-08, B: 4.651202109, C: 28306.66024576099},
+		{A: 1.912e-08, B: 5.78403850812, C: 102762.53967101299},
+		{A: 2.265e-08, B: 3.34478937379, C: 22645.32819660879},
+		{A: 1.817e-08, B: 5.74251490625, C: 103925.01437542078},
+		{A: 1.961e-08, B: 5.94577427093, C: 105307.21230790539},
+		{A: 1.922e-08, B: 4.43763124338, C: 82815.66292178139},
+		{A: 1.667e-08, B: 4.51887419928, C: 52195.47604404819},
+		{A: 1.771e-08, B: 6.12278757535, C: 155468.036919256},
+		{A: 1.515e-08, B: 1.7245811583, C: 54394.56338733519},
+		{A: 1.585e-08, B: 0.23900687506, C: 85502.38501632259},
+		{A: 1.477e-08, B: 4.73359545019, C: 29530.4780865396},
+		{A: 1.469e-08, B: 1.44574704924, C: 157636.79740153858},
+		{A: 1.653e-08, B: 2.41345886386, C: 52156.1365222486},
+		{A: 1.377e-08, B: 0.59981573116, C: 26080.78959457339},
+		{A: 1.43e-08, B: 0.7841694399, C: 117873.36400788819},
+		{A: 1.68e-08, B: 3.42684715134, C: 6770.7106012456},
+		{A: 1.604e-08, B: 4.52048871587, C: 3442.5749449654},
+		{A: 1.345e-08, B: 0.31857167988, C: 51535.90899683439},
+		{A: 1.357e-08, B: 3.17349203273, C: 25874.6040461362},
+		{A: 1.25e-08, B: 2.33380858079, C: 25448.00585526019},
+		{A: 1.196e-08, B: 2.09277609512, C: 48733.23133818299},
+		{A: 1.194e-08, B: 0.36376300747, C: 115674.27666460119},
+		{A: 1.443e-08, B: 2.73186009497, C: 64741.95798313119},
+		{A: 1.375e-08, B: 5.32672767568, C: 124778.18365871879},
+		{A: 1.325e-08, B: 3.57805811914, C: 88476.99497093359},
+		{A: 1.115e-08, B: 0.26092087309, C: 639.897286314},
+		{A: 1.094e-08, B: 2.22755234371, C: 32370.9789915656},
+		{A: 1.433e-08, B: 3.03965981318, C: 66941.04532641819},
+		{A: 1.37e-08, B: 3.45924813827, C: 44937.1306915484},
+		{A: 1.003e-08, B: 0.28769164324, C: 25934.1243310894},
+		{A: 1.136e-08, B: 1.11057140999, C: 52171.9249477904},
+		{A: 1e-08, B: 0.5245751492, C: 26241.681952059},
+		{A: 1.033e-08, B: 2.55563840213, C: 45405.0956819028},
+		{A: 1.096e-08, B: 5.01257889902, C: 7.1135470008},
+		{A: 1.146e-08, B: 5.40631829059, C: 213.299095438},
+		{A: 1.08e-08, B: 5.97835772937, C: 129909.82474277639},
+		{A: 9.88e-09, B: 1.25815251981, C: 98068.53671630539},
+		{A: 1.062e-08, B: 2.88132742088, C: 120226.23016165999},
+		{A: 8.92e-09, B: 0.63710475446, C: 103396.01282468818},
+		{A: 1.041e-08, B: 0.89365572198, C: 52602.4044740244},
+		{A: 9.78e-09, B: 0.93067667928, C: 53764.8791784322},
+		{A: 1.136e-08, B: 5.82023159264, C: 52179.6876185064},
+		{A: 8.38e-09, B: 4.66556603681, C: 155418.04029735178},
+		{A: 9.1e-09, B: 5.53568417425, C: 58458.88213313979},
+		{A: 8.34e-09, B: 5.16159172473, C: 49957.0491789616},
+		{A: 1.1e-08, B: 4.0215776119, C: 162188.75089859738},
+		{A: 8.05e-09, B: 1.03425281191, C: 23969.1392811958},
+		{A: 8.35e-09, B: 5.28205659749, C: 50057.04242277},
+		{A: 8.91e-09, B: 0.21542628779, C: 105410.99449648599},
+		{A: 7.43e-09, B: 0.86945390288, C: 52389.1053785864},
+		{A: 7.83e-09, B: 3.32304812554, C: 26301.2022370122},
+		{A: 7.11e-09, B: 1.57780048955, C: 2118.7638603784},
+		{A: 8.01e-09, B: 2.18220012579, C: 151975.46535238638},
+		{A: 9.15e-09, B: 1.71247948367, C: 51962.5071877104},
+		{A: 8.32e-09, B: 5.53506450006, C: 74821.13447975718},
+		{A: 7.5e-09, B: 2.16183196338, C: 52168.69273614759},
+		{A: 9.23e-09, B: 2.50170936928, C: 104881.30353139139},
+		{A: 7.27e-09, B: 6.05394635764, C: 95247.70557217918},
+		{A: 6.4e-09, B: 2.71738158564, C: 131395.11544947958},
+		{A: 6.28e-09, B: 1.43868691098, C: 55618.3812281138},
+		{A: 6.22e-09, B: 3.46102298068, C: 77623.81213840858},
+		{A: 8.25e-09, B: 1.00561540075, C: 85034.42002596818},
+		{A: 6.11e-09, B: 0.99677911598, C: 2218.7571041868},
+		{A: 6.05e-09, B: 4.68164610499, C: 52182.9198301492},
+		{A: 6.46e-09, B: 2.57803910757, C: 128850.44281258718},
+		{A: 6.21e-09, B: 1.16576338077, C: 108903.56606335558},
+		{A: 6.51e-09, B: 1.60040223342, C: 26095.016688575},
+		{A: 5.99e-09, B: 2.51123620954, C: 130012.91751699499},
+		{A: 5.7e-09, B: 1.27714994259, C: 78283.37918562238},
+		{A: 6.16e-09, B: 2.88990323867, C: 52026.2430860138},
+		{A: 5.53e-09, B: 5.17654422678, C: 25938.3399444396},
+		{A: 5.6e-09, B: 3.32834155275, C: 51109.31080595839},
+		{A: 5.38e-09, B: 4.7459289211, C: 80482.46652890938},
+		{A: 5.14e-09, B: 5.52495783925, C: 25021.4076643842},
+		{A: 5.12e-09, B: 2.12021253064, C: 25035.6347583858},
+		{A: 5.45e-09, B: 1.31029342517, C: 26555.8681319286},
+		{A: 4.75e-09, B: 4.05390077061, C: 149.5631971346},
+		{A: 4.64e-09, B: 3.26222495894, C: 111590.2881578968},
+		{A: 4.6e-09, B: 5.34048307893, C: 46848.3301747656},
+		{A: 5.5e-09, B: 5.84636069235, C: 25619.9381512198},
+		{A: 4.47e-09, B: 3.5768857164, C: 1066.49547719},
+		{A: 5.5e-09, B: 6.22077946049, C: 51123.53789995999},
+		{A: 5.96e-09, B: 0.6449235299, C: 339142.7408404646},
+		{A: 4.84e-09, B: 3.16177806139, C: 38519.945791972},
+		{A: 4.41e-09, B: 0.67395966884, C: 1052.2683831884},
+		{A: 4.77e-09, B: 0.22606306742, C: 71025.0338331226},
+		{A: 4.23e-09, B: 2.08215220948, C: 76144.94556434419},
+		{A: 4.72e-09, B: 3.35508518254, C: 35191.8101356918},
+		{A: 4.18e-09, B: 3.57908956341, C: 52329.58509363319},
+		{A: 4.41e-09, B: 4.91196768491, C: 78050.41032928458},
+		{A: 4.4e-09, B: 4.47866581814, C: 183724.7005431128},
+		{A: 4.35e-09, B: 1.98197259533, C: 26094.53170047421},
+		{A: 5.64e-09, B: 5.45416215614, C: 78244.0396638228},
+		{A: 5.4e-09, B: 2.87570725847, C: 181555.94006083018},
+		{A: 4.5e-09, B: 6.09063658976, C: 93028.94846799239},
+		{A: 4.61e-09, B: 3.82361162048, C: 143961.2671494624},
+		{A: 4.08e-09, B: 3.34314470563, C: 52022.0274726636},
+		{A: 4.12e-09, B: 2.07516355558, C: 150866.08680029298},
+		{A: 4.35e-09, B: 5.12507754577, C: 26081.27458267419},
+		{A: 4.09e-09, B: 0.56822912556, C: 64607.84893354619},
+		{A: 3.88e-09, B: 4.16039183226, C: 78259.82808936459},
+		{A: 3.93e-09, B: 3.23715146834, C: 13655.8604911764},
+		{A: 3.75e-09, B: 5.33297275541, C: 26011.6370702986},
+		{A: 4.67e-09, B: 5.77388878977, C: 90829.86112470538},
+		{A: 3.5e-09, B: 5.62301652558, C: 71492.99882347698},
+		{A: 3.52e-09, B: 3.27694758804, C: 26727.8004278882},
+		{A: 3.5e-09, B: 3.39302729957, C: 141762.17980617538},
+		{A: 3.9e-09, B: 2.58800166779, C: 78267.59076008058},
+		{A: 3.65e-09, B: 3.19925143022, C: 52099.5402118728},
+		{A: 4.33e-09, B: 1.5518516339, C: 20760.4270331914},
+		{A: 3.27e-09, B: 5.69228054184, C: 12432.0426503978},
+		{A: 3.39e-09, B: 1.5808857282, C: 45494.58142974879},
+		{A: 3.38e-09, B: 2.73161573173, C: 155997.72788435058},
+		{A: 3.15e-09, B: 4.00061444247, C: 78477.00852016058},
+		{A: 4.35e-09, B: 0.36408526333, C: 114564.89811250778},
+		{A: 3.44e-09, B: 5.02616143626, C: 78256.59587772179},
+		{A: 3.46e-09, B: 4.14121333484, C: 111122.32316754239},
+		{A: 2.86e-09, B: 5.40513646014, C: 6681.2248533996},
+		{A: 2.83e-09, B: 3.65516534367, C: 76.2660712756},
+		{A: 3.25e-09, B: 4.3041208046, C: 124156.43985787958},
+		{A: 3.64e-09, B: 2.32771554672, C: 84546.78527471398},
+		{A: 3.31e-09, B: 0.768825599, C: 188276.6540401716},
+		{A: 2.67e-09, B: 3.81778097058, C: 19406.6782881746},
+		{A: 2.7e-09, B: 6.02010732963, C: 78114.14622758799},
+		{A: 2.73e-09, B: 3.6672618024, C: 129483.91596626239},
+		{A: 2.63e-09, B: 0.18743596408, C: 77197.21394753258},
+		{A: 2.68e-09, B: 3.25051884036, C: 131498.89763806018},
+		{A: 2.84e-09, B: 1.93848296863, C: 76044.9523205358},
+		{A: 2.95e-09, B: 5.53951004419, C: 130969.20667296558},
+		{A: 3.35e-09, B: 5.92048177497, C: 146314.13330323418},
+		{A: 2.46e-09, B: 1.45343047775, C: 78270.82297172339},
+		{A: 2.59e-09, B: 3.0824170478, C: 77211.44104153418},
+		{A: 2.53e-09, B: 2.86398164134, C: 121335.60871375339},
+		{A: 3.38e-09, B: 3.9582339601, C: 78690.30761559859},
+		{A: 3.17e-09, B: 3.9813836922, C: 79852.78232000639},
+		{A: 2.32e-09, B: 4.66776441683, C: 28206.6670019526},
+		{A: 2.47e-09, B: 1.40414696612, C: 181505.94343892598},
+		{A: 2.28e-09, B: 0.25677390582, C: 103711.71527998279},
+		{A: 2.19e-09, B: 4.69799359762, C: 71582.48457132299},
+		{A: 2.21e-09, B: 4.46394113791, C: 81706.28436968799},
+		{A: 2.54e-09, B: 1.43735157085, C: 5327.4761083828},
+		{A: 1.99e-09, B: 5.76674433704, C: 157483.01859105378},
+		{A: 2.53e-09, B: 2.47481215159, C: 72936.23331633979},
+		{A: 1.91e-09, B: 4.17749403686, C: 134991.4692049298},
+		{A: 2.46e-09, B: 5.2155277312, C: 178063.3684939606},
+		{A: 1.82e-09, B: 4.31488794636, C: 104371.28232719658},
+		{A: 2.06e-09, B: 1.62004139707, C: 106570.36967048359},
+		{A: 2.34e-09, B: 2.1897992144, C: 100909.03762133139},
+		{A: 1.76e-09, B: 3.58325574282, C: 48835.19385644859},
+		{A: 1.88e-09, B: 2.58568452037, C: 51707.84129279399},
+		{A: 1.77e-09, B: 1.8946109513, C: 52169.17772424839},
+		{A: 1.68e-09, B: 3.01264496377, C: 23754.70674870219},
+		{A: 2.22e-09, B: 4.35573235377, C: 52643.7712735028},
+		{A: 1.69e-09, B: 5.25194313253, C: 102232.84870591838},
+		{A: 1.88e-09, B: 5.55333225508, C: 156100.82065856917},
+		{A: 1.63e-09, B: 1.72109994467, C: 104138.31347085879},
+		{A: 2.05e-09, B: 5.64507146979, C: 154938.34595416137},
+		{A: 1.61e-09, B: 0.06514575292, C: 78187.44335344699},
+		{A: 1.55e-09, B: 3.29540644622, C: 97112.93697469679},
+		{A: 1.52e-09, B: 1.66425291176, C: 25654.19140369739},
+		{A: 1.79e-09, B: 2.20248310353, C: 104331.94280539699},
+		{A: 1.57e-09, B: 5.91615237188, C: 51742.09454527159},
+		{A: 1.76e-09, B: 5.03409571975, C: 52182.4348420484},
+		{A: 1.45e-09, B: 0.34814763869, C: 78417.48823520739},
+		{A: 1.51e-09, B: 0.8401618205, C: 26237.46633870879},
+		{A: 1.43e-09, B: 0.36102957131, C: 27154.3986187642},
+		{A: 1.42e-09, B: 5.87490895177, C: 26037.90651966999},
+		{A: 1.67e-09, B: 4.43520444137, C: 25668.418497699},
+		{A: 1.37e-09, B: 2.8508932935, C: 119116.85160956658},
+		{A: 1.61e-09, B: 5.91020381915, C: 207643.8432024044},
+		{A: 1.3e-09, B: 6.26801612444, C: 137678.191299471},
+		{A: 1.3e-09, B: 0.93376066695, C: 433.7117378768},
+		{A: 1.4e-09, B: 1.22522976768, C: 26137.8997634784},
+		{A: 1.44e-09, B: 4.56326656247, C: 419.4846438752},
+		{A: 1.45e-09, B: 2.53141564902, C: 116917.76426627958},
+		{A: 1.45e-09, B: 3.74606280935, C: 27140.17152476259},
+		{A: 1.55e-09, B: 3.65976494728, C: 365230.6439820388},
+		{A: 1.24e-09, B: 0.92716868075, C: 104347.73123093879},
+		{A: 1.4e-09, B: 0.1128733417, C: 78109.93061423779},
+		{A: 1.26e-09, B: 1.13823138141, C: 51322.60990139639},
+		{A: 1.46e-09, B: 3.25675289634, C: 3340.6124266998},
+		{A: 1.22e-09, B: 5.10611624534, C: 176953.98994186718},
+		{A: 1.25e-09, B: 5.63666753628, C: 104355.49390165479},
+		{A: 1.55e-09, B: 2.3086138149, C: 51756.3216392732},
+		{A: 1.36e-09, B: 3.41914767255, C: 140652.80125408198},
+		{A: 1.15e-09, B: 6.25299482333, C: 39743.7636327506},
+		{A: 1.13e-09, B: 0.79353194356, C: 7994.5284420242},
+		{A: 1.26e-09, B: 0.12477487369, C: 52815.7035694624},
+		{A: 1.21e-09, B: 0.68099507149, C: 50483.640613646},
+		{A: 1.18e-09, B: 0.87294905519, C: 137210.22630911658},
+		{A: 1.12e-09, B: 2.41390426449, C: 97580.90196505119},
+		{A: 1.3e-09, B: 1.22267912068, C: 209812.60368468694},
+		{A: 1.1e-09, B: 3.15687226733, C: 25234.70675982219},
+		{A: 1.09e-09, B: 2.85465805569, C: 24395.7374720718},
+		{A: 1.08e-09, B: 5.7358716071, C: 853.196381752},
+		{A: 1.41e-09, B: 4.76875897743, C: 28421.0995344462},
+		{A: 1.43e-09, B: 0.57659923971, C: 170049.1702910366},
+		{A: 1.27e-09, B: 5.39590556187, C: 110634.68841628819},
+		{A: 1.1e-09, B: 0.79294568581, C: 104564.91166173479},
+		{A: 1.03e-09, B: 5.77115680934, C: 182085.63102592478},
+		{A: 1.08e-09, B: 0.78147749534, C: 42153.969003049},
+		{A: 1.01e-09, B: 6.24176236571, C: 1692.1656695024},
+		{A: 1.25e-09, B: 1.72822604057, C: 104344.49901929598},
+		{A: 1.12e-09, B: 1.53691309669, C: 18093.37469954999},
+		{A: 1e-09, B: 6.03656277681, C: 147423.51185532758},
+		{A: 1.02e-09, B: 2.16777222106, C: 32769.1279949738},
+		{A: 1.27e-09, B: 5.69552946334, C: 44181.27784112419},
+		{A: 9.6e-10, B: 2.81662615895, C: 104202.04936916218},
+		{A: 9.4e-10, B: 6.16378269144, C: 103299.34418310839},
+		{A: 9.5e-10, B: 3.08582674985, C: 70269.18098269838},
+		{A: 9.6e-10, B: 3.26682732105, C: 103285.11708910679},
+		{A: 1.01e-09, B: 1.49832461293, C: 40565.2543247742},
+		{A: 9.9e-10, B: 0.11817727117, C: 167850.0829477496},
+		{A: 9.9e-10, B: 3.80154992347, C: 214364.55718174577},
+		{A: 9.3e-10, B: 3.27120904242, C: 90695.75207512038},
+		{A: 1.04e-09, B: 5.35405285854, C: 126996.94076290558},
+		{A: 1.05e-09, B: 0.73008875321, C: 104778.21075717278},
+		{A: 8.9e-10, B: 2.82753100547, C: 50579.61984086379},
+		{A: 9.8e-10, B: 0.55393078868, C: 33967.99229491319},
+		{A: 8.7e-10, B: 1.47928882834, C: 97670.38771289718},
+		{A: 1.17e-09, B: 0.17401840597, C: 61279.713277266},
+		{A: 8.9e-10, B: 0.41552735762, C: 26164.1692128498},
+		{A: 9.1e-10, B: 2.28447351962, C: 157057.10981453978},
+		{A: 1.03e-09, B: 2.67784306575, C: 172402.0364448084},
+		{A: 8.4e-10, B: 4.31852113132, C: 60055.89543648739},
+		{A: 8.5e-10, B: 4.50666554965, C: 104358.72611329758},
+		{A: 9.8e-10, B: 0.74967522191, C: 105940.68546158058},
+		{A: 8.2e-10, B: 2.80078395016, C: 52101.02468458109},
+		{A: 8.6e-10, B: 0.38069361609, C: 74923.09699802278},
+		{A: 7.7e-10, B: 4.91602955543, C: 24491.71669928959},
+		{A: 1.04e-09, B: 6.08692240129, C: 10021.8372800994},
+		{A: 9.4e-10, B: 2.2175482918, C: 25973.46385288896},
+		{A: 7.9e-10, B: 4.63574888508, C: 2333.196392872},
+		{A: 7.6e-10, B: 0.0667660763, C: 157586.80077963436},
+		{A: 7.6e-10, B: 4.09119863738, C: 1596.1864422846},
+		{A: 8.9e-10, B: 0.51926466594, C: 50593.84693486539},
+		{A: 7.8e-10, B: 5.85412082904, C: 18207.81398823521},
+		{A: 8.3e-10, B: 1.48347794862, C: 54294.57014352679},
+		{A: 1.02e-09, B: 1.11648927836, C: 150244.3429994538},
+		{A: 8.1e-10, B: 0.43168464249, C: 155571.81910783658},
+		{A: 7.1e-10, B: 2.77560894834, C: 77829.99768684579},
+		{A: 9.8e-10, B: 3.04617186486, C: 16066.0658614748},
+		{A: 8.9e-10, B: 3.91487126164, C: 68241.8721446232},
+		{A: 7.6e-10, B: 3.32839538947, C: 129799.61842155698},
+		{A: 9.1e-10, B: 4.99146967087, C: 102132.85546210999},
+		{A: 8.8e-10, B: 4.54401955709, C: 51543.0225438352},
+		{A: 9.2e-10, B: 4.8882571486, C: 26202.34243025941},
+		{A: 6.6e-10, B: 1.97272013972, C: 632.7837393132},
+		{A: 6.6e-10, B: 1.34921841483, C: 52808.59002246159},
+		{A: 7.1e-10, B: 1.2155124162, C: 107794.1875112622},
+		{A: 8.8e-10, B: 3.90671333341, C: 74.7815985673},
+		{A: 7.6e-10, B: 1.1168426292, C: 78731.674415077},
+		{A: 6e-10, B: 4.94665488683, C: 78257.08086582259},
+		{A: 6.7e-10, B: 4.24719146625, C: 14477.3511832},
+		{A: 6.9e-10, B: 5.46721744006, C: 77844.22478084739},
+		{A: 5.9e-10, B: 2.80000354604, C: 25863.55834587229},
+		{A: 6e-10, B: 4.23924716288, C: 52225.8029050526},
+		{A: 5.9e-10, B: 5.90340687048, C: 103.0927742186},
+		{A: 5.9e-10, B: 3.81127888964, C: 76571.54375522019},
+		{A: 6e-10, B: 5.62072757879, C: 77795.74443436819},
+		{A: 6.9e-10, B: 4.36673018646, C: 207593.8465805002},
+		{A: 6.6e-10, B: 0.49890308433, C: 51951.46148744649},
+		{A: 5.8e-10, B: 1.63810168022, C: 51013.33157874059},
+		{A: 5.8e-10, B: 4.28286858594, C: 77410.51304297059},
+		{A: 6.6e-10, B: 0.61313044187, C: 51639.00177105299},
+		{A: 5.7e-10, B: 3.15657420172, C: 104275.34649502118},
+		{A: 5.8e-10, B: 4.41262101447, C: 26720.68688088739},
+		{A: 7.5e-10, B: 0.13973480485, C: 1581.959348283},
+		{A: 5.7e-10, B: 0.41985749035, C: 23439.44831610119},
+		{A: 6.9e-10, B: 6.04001555481, C: 29428.515568274},
+		{A: 5.5e-10, B: 0.87548003173, C: 161079.37234650398},
+		{A: 5.4e-10, B: 2.10504641371, C: 2648.454825473},
+		{A: 7e-10, B: 1.90064683191, C: 204151.27163553477},
+		{A: 5.4e-10, B: 3.73359360369, C: 24925.4284371664},
+		{A: 5.5e-10, B: 4.74493087072, C: 49527.35145767539},
+		{A: 7.1e-10, B: 2.67007207774, C: 24505.94379329119},
+		{A: 5.7e-10, B: 1.10422154948, C: 130459.18546877075},
+		{A: 6.8e-10, B: 5.29164108644, C: 26013.1215430069},
+		{A: 5.4e-10, B: 0.06047544079, C: 52072.71350892979},
+		{A: 5.3e-10, B: 0.0641936055, C: 536.8045120954},
+		{A: 5.3e-10, B: 5.00852687358, C: 25977.69682035479},
+		{A: 5.2e-10, B: 4.1740388604, C: 25131.61398560359},
+		{A: 5.2e-10, B: 3.31036935415, C: 7880.08915333899},
+		{A: 7e-10, B: 6.10702300469, C: 52278.89905736699},
+		{A: 5.9e-10, B: 1.97971323674, C: 51219.51712717779},
+		{A: 5.2e-10, B: 0.17883872581, C: 224.3447957019},
+		{A: 5.7e-10, B: 2.64616122722, C: 52125.80966124419},
+		{A: 5.1e-10, B: 2.51127480556, C: 183570.921732628},
+		{A: 5e-10, B: 4.67814206741, C: 130226.21661243298},
+		{A: 5e-10, B: 4.7732602477, C: 25780.3455206046},
+		{A: 5.3e-10, B: 2.8157918125, C: 55516.4187098482},
+		{A: 5.7e-10, B: 2.13355985608, C: 128320.75184749259},
+		{A: 4.9e-10, B: 2.32162107938, C: 26395.46076254379},
+		{A: 4.9e-10, B: 4.96049794937, C: 956.2891559706},
+		{A: 4.7e-10, B: 4.20636709156, C: 52250.5878817157},
+		{A: 5.8e-10, B: 2.23088591593, C: 52065.59996192899},
+		{A: 4.8e-10, B: 5.31209962934, C: 1162.4747044078},
+		{A: 6.2e-10, B: 6.11793881, C: 22747.2907148744},
+		{A: 5.1e-10, B: 5.16823572193, C: 130419.8459469712},
+		{A: 5.8e-10, B: 1.50494628165, C: 54509.0026760204},
+		{A: 6e-10, B: 0.43836944868, C: 116783.65521669458},
+		{A: 5.9e-10, B: 1.50474668065, C: 26507.38778544939},
+		{A: 4.7e-10, B: 3.28426374672, C: 25565.3257234804},
+		{A: 5.6e-10, B: 2.45288584577, C: 181026.24909573558},
+		{A: 5.1e-10, B: 3.46813929041, C: 53242.3017603384},
+		{A: 4.5e-10, B: 0.64303440167, C: 52698.38370124219},
+		{A: 4.9e-10, B: 0.43622928396, C: 27999.1026247914},
+		{A: 4.6e-10, B: 0.93537805397, C: 26162.6847401415},
+		{A: 4.3e-10, B: 5.95311422864, C: 76667.52298243798},
+		{A: 6e-10, B: 1.79764830651, C: 78270.3379836226},
+		{A: 5.6e-10, B: 2.27809233545, C: 182188.72380014337},
+		{A: 4.3e-10, B: 3.95909386803, C: 26521.614879451},
+		{A: 4.3e-10, B: 2.06740816154, C: 51859.41441349179},
+		{A: 5.1e-10, B: 5.31772214752, C: 66653.15746634839},
+		{A: 4.4e-10, B: 1.68470111077, C: 23888.81579828719},
+		{A: 5.4e-10, B: 3.94217571152, C: 52325.36948028299},
+		{A: 4.3e-10, B: 5.1386495594, C: 11610.5519583742},
+		{A: 4.2e-10, B: 4.61363107691, C: 52492.19815280499},
+		{A: 4.6e-10, B: 3.39633739377, C: 104505.39137678158},
+		{A: 4.6e-10, B: 4.83387098498, C: 110.2063212194},
+		{A: 4.5e-10, B: 3.54092987543, C: 50800.03248330259},
+		{A: 4.1e-10, B: 5.69167231584, C: 24712.1293417284},
+		{A: 4e-10, B: 0.05181171325, C: 123200.84011627098},
+		{A: 5.3e-10, B: 0.61042891791, C: 53228.07466633679},
+		{A: 3.9e-10, B: 3.11194295827, C: 25984.8103673556},
+		{A: 4.6e-10, B: 2.5352841036, C: 50696.93970908399},
+		{A: 3.8e-10, B: 4.44293961717, C: 29416.03879785439},
+		{A: 4.1e-10, B: 2.66264705448, C: 233731.7463439786},
+		{A: 3.7e-10, B: 3.37388547918, C: 1375.7737998458},
+		{A: 3.8e-10, B: 5.61891042604, C: 143005.6674078538},
+		{A: 3.7e-10, B: 5.42828179354, C: 28286.9904848612},
+		{A: 4.9e-10, B: 5.33653478407, C: 99024.13645791399},
+		{A: 4e-10, B: 5.63484527365, C: 522.5774180938},
+		{A: 3.9e-10, B: 3.40419666656, C: 25551.09862947879},
+		{A: 3.6e-10, B: 5.94925007197, C: 145204.75475114078},
+		{A: 3.8e-10, B: 2.07740095188, C: 153084.84390447979},
+		{A: 3.9e-10, B: 3.20021878147, C: 163766.0944410452},
+		{A: 3.7e-10, B: 5.22659089943, C: 52595.29092702359},
+		{A: 4.2e-10, B: 4.90625897857, C: 25771.5112719176},
+		{A: 4.1e-10, B: 2.99128318097, C: 26190.99591579279},
+		{A: 4.4e-10, B: 1.11685231827, C: 26404.2950112308},
+		{A: 4.5e-10, B: 4.61987657347, C: 132658.27281205778},
+		{A: 3.7e-10, B: 0.21788276252, C: 166740.70439565618},
+		{A: 3.4e-10, B: 1.94222980062, C: 203041.8930834414},
+		{A: 4.5e-10, B: 3.16157322891, C: 104197.83375581198},
+		{A: 4.6e-10, B: 4.46372826281, C: 316.3918696566},
+		{A: 3.9e-10, B: 2.02681719442, C: 136722.59155786238},
+		{A: 3.4e-10, B: 1.34191646454, C: 467.9649903544},
+		{A: 4.3e-10, B: 3.34582658464, C: 78903.60671103658},
+		{A: 3.9e-10, B: 3.65232351757, C: 196137.07343261078},
+		{A: 3.7e-10, B: 0.3728911138, C: 391318.54712361295},
+		{A: 3.7e-10, B: 2.88378512797, C: 36109.7404216736},
+		{A: 3.7e-10, B: 1.66197775716, C: 52290.24557183361},
+		{A: 3.6e-10, B: 3.91856090884, C: 54087.0057663656},
+		{A: 3.4e-10, B: 0.07777074766, C: 26294.08869001139},
+		{A: 3.3e-10, B: 2.57658065167, C: 26941.0995233262},
+		{A: 3.4e-10, B: 1.66706631543, C: 49842.60989027639},
+		{A: 4.2e-10, B: 3.65143192554, C: 76681.75007643958},
+		{A: 3.2e-10, B: 4.02685079656, C: 24356.7807886416},
+		{A: 4.1e-10, B: 3.48117117726, C: 26610.48055966799},
+		{A: 4e-10, B: 1.30798263894, C: 77630.92568540938},
+		{A: 3.3e-10, B: 3.31591322468, C: 65831.6667743248},
+		{A: 4.2e-10, B: 4.68305225464, C: 24609.0365675098},
+		{A: 3.4e-10, B: 2.43308912753, C: 26421.7590823436},
+		{A: 3.1e-10, B: 0.55207634036, C: 13362.4497067992},
+		{A: 3.1e-10, B: 3.97168176907, C: 130435.63437251298},
+		{A: 3.5e-10, B: 5.90195011358, C: 78188.92782615528},
+		{A: 3.2e-10, B: 5.58275762493, C: 25881.717593137},
+		{A: 3.2e-10, B: 3.03394602316, C: 27780.06881107659},
+		{A: 3.8e-10, B: 4.616024782, C: 130432.40216087017},
+		{A: 3.1e-10, B: 5.37268049479, C: 75930.51303185058},
+		{A: 3.4e-10, B: 4.68006477569, C: 25754.0472008048},
+		{A: 3e-10, B: 2.7691739915, C: 173511.41499690176},
+		{A: 3.7e-10, B: 4.3753749777, C: 1478.8665740644},
+		{A: 3e-10, B: 2.69970929614, C: 208173.534167499},
+		{A: 2.9e-10, B: 2.28199342452, C: 130443.39704322898},
+		{A: 3.9e-10, B: 4.04390928499, C: 34282.1784747828},
+		{A: 3.3e-10, B: 1.47153495142, C: 55503.94193942859},
+		{A: 2.9e-10, B: 3.09374594552, C: 27819.0254945068},
+		{A: 3e-10, B: 2.30968283835, C: 26624.70765366959},
+		{A: 3.5e-10, B: 2.18534594257, C: 22759.76748529401},
+		{A: 2.7e-10, B: 0.63624513172, C: 240452.46032331997},
+		{A: 3.5e-10, B: 5.4058831234, C: 58857.03113654799},
+		{A: 2.8e-10, B: 5.46904052697, C: 123668.80510662538},
+		{A: 2.8e-10, B: 3.82629644574, C: 130866.11389874699},
+		{A: 2.7e-10, B: 3.91273567728, C: 163298.1294506908},
+		{A: 3.2e-10, B: 3.55783772742, C: 52252.07235442399},
+		{A: 3.6e-10, B: 5.26852179816, C: 52061.36699446317},
+		{A: 2.7e-10, B: 0.81868301401, C: 25455.119402261},
+		{A: 3e-10, B: 6.23608007707, C: 24176.703658357},
+		{A: 2.5e-10, B: 1.48874402537, C: 60370.08161635699},
+		{A: 2.7e-10, B: 4.41957053501, C: 78896.49316403578},
+		{A: 2.5e-10, B: 3.33404428554, C: 206.1855484372},
+		{A: 2.5e-10, B: 2.22580879047, C: 12725.453434775},
+		{A: 2.5e-10, B: 5.37774707105, C: 183145.012956114},
+		{A: 2.6e-10, B: 3.83674753452, C: 132028.58860315479},
+		{A: 2.5e-10, B: 2.9360535584, C: 129387.24732468258},
+		{A: 2.5e-10, B: 5.8616145474, C: 130289.95251073639},
+		{A: 2.4e-10, B: 4.03572089813, C: 26089.38761428249},
+		{A: 2.4e-10, B: 3.09138745629, C: 26086.4186688659},
+		{A: 2.4e-10, B: 1.4045297785, C: 25446.4895798352},
+		{A: 3.3e-10, B: 3.35253473958, C: 193937.9860893238},
+		{A: 2.4e-10, B: 4.8134227557, C: 27311.72098235281},
+		{A: 2.4e-10, B: 2.36840229716, C: 25440.89230825939},
+		{A: 2.4e-10, B: 2.60590286552, C: 125112.03959948818},
+		{A: 2.7e-10, B: 2.64006708171, C: 44295.7171298094},
+		{A: 2.9e-10, B: 3.85906422319, C: 130652.81480330898},
+		{A: 3.3e-10, B: 4.2600726241, C: 235900.50682626115},
+		{A: 2.6e-10, B: 3.37356285012, C: 87367.61641884019},
+		{A: 2.4e-10, B: 3.83432680564, C: 52381.99183158559},
+		{A: 2.7e-10, B: 4.14811634858, C: 176332.24614102798},
+		{A: 2.3e-10, B: 0.19227535404, C: 647.0108333148},
+		{A: 2.5e-10, B: 0.03897225418, C: 129373.02023068098},
+		{A: 2.3e-10, B: 5.73072631591, C: 26729.31670331319},
+		{A: 2.6e-10, B: 2.78110886808, C: 64901.25971792339},
+		{A: 2.8e-10, B: 6.14191568069, C: 38813.3565763492},
+		{A: 2.5e-10, B: 5.64677901976, C: 198489.9395863826},
+		{A: 2.2e-10, B: 2.4748926331, C: 24864.08530079559},
+		{A: 2.3e-10, B: 4.2668224139, C: 26222.0121911592},
+		{A: 2.4e-10, B: 4.52962335246, C: 123758.29085447139},
+		{A: 2.3e-10, B: 2.88962909157, C: 25953.79409198919},
+		{A: 2.8e-10, B: 4.67408679361, C: 80382.47328510099},
+		{A: 2.1e-10, B: 2.19925857864, C: 23866.04650697719},
+		{A: 2.5e-10, B: 0.81737451667, C: 94329.77528619739},
+		{A: 2.1e-10, B: 5.56863005528, C: 52712.61079524379},
+		{A: 2.1e-10, B: 0.94657470314, C: 27684.0895838588},
+		{A: 2.3e-10, B: 1.24923754668, C: 130446.62925487179},
+		{A: 2.2e-10, B: 1.55521175306, C: 24998.19435038059},
+		{A: 2.4e-10, B: 3.25930776298, C: 27669.86248985719},
+		{A: 2.1e-10, B: 3.16657819785, C: 183674.70392120857},
+		{A: 2e-10, B: 1.45970914613, C: 75615.25459924959},
+		{A: 2.8e-10, B: 2.65124448902, C: 78366.80219894118},
+		{A: 2.1e-10, B: 2.85719729554, C: 78160.61665050399},
+		{A: 2.2e-10, B: 0.14164137879, C: 51528.79544983359},
+		{A: 2.2e-10, B: 1.54435105177, C: 158746.17595363196},
+		{A: 1.9e-10, B: 1.95606380894, C: 25344.9130810416},
+		{A: 1.9e-10, B: 3.62491058376, C: 78039.36462902068},
+		{A: 1.9e-10, B: 2.59809360557, C: 17893.6278083656},
+		{A: 1.9e-10, B: 5.86487152937, C: 103917.90082841998},
+		{A: 1.9e-10, B: 5.07516493363, C: 230239.17477710897},
+		{A: 2.3e-10, B: 1.73809221823, C: 128220.75860368418},
+		{A: 1.8e-10, B: 3.70453311537, C: 77726.90491262719},
+		{A: 2e-10, B: 5.58951443277, C: 27177.6119327678},
+		{A: 1.9e-10, B: 2.26298959398, C: 103932.12792242158},
+		{A: 1.8e-10, B: 5.26103373994, C: 28736.3579670472},
+		{A: 1.8e-10, B: 4.77075669731, C: 77101.23472031478},
+		{A: 1.9e-10, B: 4.18613339997, C: 104819.57755665119},
+		{A: 1.9e-10, B: 3.72825596269, C: 31415.379249957},
+		{A: 1.8e-10, B: 5.11985094325, C: 77307.42026875199},
+		{A: 1.9e-10, B: 1.61447515906, C: 51653.22886505459},
+		{A: 2.2e-10, B: 3.45569006244, C: 101011.00013959699},
+		{A: 1.7e-10, B: 3.34823797519, C: 23919.1426592916},
+		{A: 1.7e-10, B: 0.84289136609, C: 86143.79857806159},
+		{A: 1.8e-10, B: 0.8199762803, C: 26073.67604757259},
+		{A: 2e-10, B: 0.96632907921, C: 52609.51802102519},
+		{A: 1.6e-10, B: 1.27839838984, C: 51868.2486621788},
+		{A: 2e-10, B: 0.07187923898, C: 155887.52156313116},
+		{A: 1.8e-10, B: 5.44524309894, C: 25508.2155545754},
+		{A: 1.6e-10, B: 0.6272245515, C: 102659.44689679438},
+		{A: 1.6e-10, B: 0.52102795891, C: 2221.856634597},
+		{A: 1.7e-10, B: 3.93009669878, C: 51852.30086649099},
+		{A: 1.6e-10, B: 5.7418031822, C: 96357.08412427259},
+		{A: 1.9e-10, B: 5.09029341511, C: 118828.96374949679},
+		{A: 1.7e-10, B: 2.59947793429, C: 24448.8336243862},
+		{A: 1.6e-10, B: 5.52244950928, C: 2199.087343287},
+		{A: 1.5e-10, B: 3.36667960581, C: 1911.1994832172},
+		{A: 2.1e-10, B: 3.53223071778, C: 181659.72224941078},
+		{A: 1.9e-10, B: 1.29524947399, C: 233681.74972207437},
+		{A: 1.6e-10, B: 1.11236515889, C: 103498.41618454478},
+		{A: 2e-10, B: 3.19274792861, C: 51969.62073471119},
+		{A: 1.7e-10, B: 3.27645001526, C: 26312.2479372761},
+		{A: 1.6e-10, B: 5.54159968247, C: 207114.15223730978},
+		{A: 1.5e-10, B: 2.75565944373, C: 26709.6469424134},
+		{A: 1.5e-10, B: 1.72732606714, C: 104344.98400739678},
+		{A: 1.6e-10, B: 5.31828785394, C: 78153.50310350319},
+		{A: 1.6e-10, B: 1.13565154505, C: 742.9900605326},
+		{A: 1.5e-10, B: 6.19247438386, C: 130363.24963659538},
+		{A: 1.5e-10, B: 1.16646378812, C: 92741.06060792258},
+		{A: 1.5e-10, B: 4.39941321864, C: 25466.159340735},
+		{A: 1.4e-10, B: 1.03341872997, C: 78313.70604662679},
+		{A: 1.6e-10, B: 1.77081422797, C: 27044.1922975448},
+		{A: 1.6e-10, B: 1.77854347809, C: 26667.590728573},
+		{A: 1.4e-10, B: 2.39782698608, C: 103883.64757594238},
+		{A: 1.9e-10, B: 6.27226219255, C: 25764.39772491679},
+		{A: 1.4e-10, B: 1.89621592736, C: 37698.4550999484},
+		{A: 1.4e-10, B: 5.86393829627, C: 81604.32185142238},
+		{A: 1.5e-10, B: 2.41952075336, C: 27250.37784598199},
+		{A: 1.7e-10, B: 5.6237861587, C: 51432.81622261579},
+		{A: 1.4e-10, B: 0.57479381854, C: 8194.2753332086},
+		{A: 1.4e-10, B: 2.0191587364, C: 156507.7490885454},
+		{A: 1.4e-10, B: 4.00033644851, C: 187167.2754880782},
+		{A: 1.6e-10, B: 3.05516572588, C: 22625.658435709},
+		{A: 1.4e-10, B: 0.41333257896, C: 76887.93562487679},
+		{A: 1.4e-10, B: 3.81333935444, C: 28256.66362385679},
+		{A: 1.6e-10, B: 5.63829774136, C: 209658.82487420217},
+		{A: 1.7e-10, B: 2.79674558169, C: 323.5054166574},
+		{A: 1.5e-10, B: 4.52292533775, C: 27726.9726587622},
+		{A: 1.7e-10, B: 1.61686538851, C: 26198.1094627936},
+		{A: 1.7e-10, B: 4.2862866237, C: 133882.09065283637},
+		{A: 1.3e-10, B: 1.02936352404, C: 25867.49049913539},
+		{A: 1.4e-10, B: 5.57651447296, C: 2008.557539159},
+		{A: 1.4e-10, B: 1.55161726953, C: 156314.1197540072},
+		{A: 1.7e-10, B: 6.07989986315, C: 49953.94964855139},
+		{A: 1.5e-10, B: 4.87081069542, C: 104358.2411251968},
+		{A: 1.3e-10, B: 3.77228393975, C: 19.66976089979},
+		{A: 1.5e-10, B: 5.4142973337, C: 208276.62694171758},
+		{A: 1.3e-10, B: 1.15273910706, C: 949.1756089698},
+		{A: 1.7e-10, B: 4.64614224273, C: 112231.70171963578},
+		{A: 1.5e-10, B: 5.32485053265, C: 154408.65498906677},
+		{A: 1.3e-10, B: 4.02426203972, C: 417406.4502651872},
+		{A: 1.3e-10, B: 2.83441937048, C: 25169.9728555924},
+		{A: 1.6e-10, B: 3.55004561005, C: 86457.98475793119},
+		{A: 1.4e-10, B: 3.36717179008, C: 12546.481939083},
+		{A: 1.3e-10, B: 0.99900287294, C: 78338.49102328988},
+		{A: 1.2e-10, B: 3.72935261349, C: 78786.28684281638},
+		{A: 1.3e-10, B: 4.55769261775, C: 80596.9058175946},
+		{A: 1.3e-10, B: 3.68794178346, C: 79315.97780791098},
+		{A: 1.4e-10, B: 4.16918945992, C: 156547.08861034497},
+		{A: 1.2e-10, B: 3.12295185577, C: 149288.74325784517},
+		{A: 1.2e-10, B: 2.69822851627, C: 153.7788104848},
+		{A: 1.2e-10, B: 4.38117483432, C: 27005.83342755599},
+		{A: 1.2e-10, B: 0.19322533903, C: 27463.67694142},
+		{A: 1.4e-10, B: 4.1512708228, C: 29550.14784743939},
+		{A: 1.2e-10, B: 2.80411772823, C: 102755.42612401219},
+		{A: 1.1e-10, B: 5.82734448081, C: 259819.64948555277},
+		{A: 1.1e-10, B: 2.40607503106, C: 169093.57054942797},
+		{A: 1.2e-10, B: 0.49737747696, C: 102769.65321801379},
+		{A: 1.4e-10, B: 5.69923121834, C: 76784.84285065818},
+		{A: 1.2e-10, B: 5.56466695759, C: 26402.0893214438},
+		{A: 1.2e-10, B: 1.48301102812, C: 78580.10129437919},
+		{A: 1.1e-10, B: 5.15388067065, C: 77947.31755506598},
+		{A: 1.1e-10, B: 1.64586759073, C: 25773.71696170459},
+		{A: 1.1e-10, B: 2.45779334771, C: 34082.4315835984},
+		{A: 1.4e-10, B: 2.00354520885, C: 846.0828347512},
+		{A: 1.3e-10, B: 0.71980668578, C: 78413.27262185719},
+		{A: 1.1e-10, B: 5.17906120193, C: 162810.49469943656},
+		{A: 1.1e-10, B: 2.73596940194, C: 171292.65789271498},
+		{A: 1.1e-10, B: 5.59141622457, C: 24203.0019781568},
+		{A: 1.1e-10, B: 0.45608202666, C: 2111.6503133776},
+		{A: 1e-10, B: 3.29404880642, C: 192828.60753723036},
+		{A: 1e-10, B: 0.458570525, C: 222224.97657418498},
+		{A: 1.3e-10, B: 5.70684493376, C: 78213.71280281838},
+		{A: 1.1e-10, B: 4.37474459591, C: 103718.82882698359},
+		{A: 1.1e-10, B: 0.63918585951, C: 26118.2300025786},
+		{A: 1.3e-10, B: 5.83092856998, C: 26411.4085582316},
+		{A: 1e-10, B: 5.45367935399, C: 52483.36390411799},
+		{A: 1e-10, B: 4.70344741563, C: 2125.8774073792},
+		{A: 1.1e-10, B: 0.28816338163, C: 26057.57628056979},
+		{A: 1e-10, B: 5.5776490348, C: 151199.94274106238},
+		{A: 1.1e-10, B: 0.03362968403, C: 104991.50985261079},
+		{A: 1e-10, B: 3.71565881071, C: 148532.89040742096},
+		{A: 1.3e-10, B: 0.25119201649, C: 79330.20490191258},
+		{A: 1e-10, B: 6.23763004539, C: 128106.31931499895},
+		{A: 1.1e-10, B: 6.23138757669, C: 130285.73689738619},
+		{A: 1.3e-10, B: 3.60703035316, C: 39629.32434406539},
+		{A: 1.1e-10, B: 2.08623018213, C: 78683.19406859778},
+		{A: 1.1e-10, B: 2.89478268807, C: 122444.98726584678},
+		{A: 1.1e-10, B: 1.47224457688, C: 156520.30530244438},
+		{A: 1.2e-10, B: 0.18308102701, C: 130593.29451835579},
+		{A: 9e-11, B: 5.24611723729, C: 138319.60486120995},
+		{A: 9e-11, B: 5.64920582852, C: 1265.5674786264},
+		{A: 9e-11, B: 6.25551575071, C: 53906.92863608099},
+		{A: 1.1e-10, B: 3.36985744471, C: 142871.55835826878},
+		{A: 9e-11, B: 5.47085206361, C: 156531.3001848032},
+		{A: 9e-11, B: 4.79150958411, C: 101703.15774082378},
+		{A: 9e-11, B: 2.73233240797, C: 38.1330356378},
+		{A: 9e-11, B: 5.91954900221, C: 62197.64356324779},
+		{A: 9e-11, B: 4.73154570336, C: 78378.1487134078},
+		{A: 1.1e-10, B: 0.80389582741, C: 3328.13565628019},
+		{A: 9e-11, B: 6.25489842872, C: 91919.56991589899},
+		{A: 9e-11, B: 5.87197255624, C: 199599.31813847594},
+		{A: 9e-11, B: 0.76033087382, C: 156523.5375140872},
+		{A: 8e-11, B: 0.63159267832, C: 80174.90890793978},
+		{A: 9e-11, B: 5.02352759781, C: 229129.79622501557},
+		{A: 8e-11, B: 2.63272452796, C: 25138.7275326044},
+		{A: 8e-11, B: 0.59154768044, C: 156954.01704032117},
+		{A: 8e-11, B: 3.95681232703, C: 120417.67842777158},
+		{A: 8e-11, B: 3.46882638388, C: 77616.69859140778},
+		{A: 8e-11, B: 0.20349823136, C: 113455.51956041438},
+		{A: 8e-11, B: 0.21724719953, C: 13541.42120249119},
+		{A: 8e-11, B: 1.77719128692, C: 26076.8574413103},
+		{A: 9e-11, B: 6.2187869044, C: 189853.99758261937},
+		{A: 7e-11, B: 5.887681462, C: 51226.63067417859},
+		{A: 7e-11, B: 0.61161547244, C: 158116.491744729},
+		{A: 8e-11, B: 2.05860519043, C: 78149.27013603736},
+		{A: 7e-11, B: 0.09500184933, C: 90989.16285949759},
+		{A: 7e-11, B: 0.56541937862, C: 25985.94062330859},
+		{A: 7e-11, B: 0.73212945216, C: 78469.89497315978},
+		{A: 7e-11, B: 0.44088896603, C: 104127.26777059489},
+		{A: 8e-11, B: 5.86653927002, C: 104454.70534051539},
+		{A: 7e-11, B: 0.70585294359, C: 52177.29075585669},
+		{A: 9e-11, B: 1.96929942879, C: 1272.6810256272},
+		{A: 1e-10, B: 2.72173324175, C: 104276.83096772949},
+		{A: 7e-11, B: 5.1631579803, C: 26189.8656598398},
+		{A: 7e-11, B: 5.1707974073, C: 179172.74704605396},
+		{A: 8e-11, B: 5.26981396126, C: 48847.6706268682},
+		{A: 8e-11, B: 0.19880797043, C: 1.4844727083},
+		{A: 7e-11, B: 6.26371276121, C: 52174.32181044009},
+		{A: 8e-11, B: 0.98956493578, C: 16703.062133499},
+		{A: 7e-11, B: 0.08448723866, C: 26098.9488418381},
+		{A: 8e-11, B: 5.67030179581, C: 53029.0026649004},
+		{A: 7e-11, B: 1.15333561308, C: 261988.40996783535},
+		{A: 7e-11, B: 0.70724943755, C: 65717.22748563958},
+		{A: 7e-11, B: 0.52800551865, C: 103814.80805420138},
+		{A: 8e-11, B: 2.25202792521, C: 149756.7082481996},
+		{A: 7e-11, B: 1.45586274187, C: 51841.950342379},
+		{A: 7e-11, B: 2.28027029168, C: 54374.8936264354},
+		{A: 7e-11, B: 1.96795602434, C: 103395.32341032618},
+		{A: 7e-11, B: 0.91100826202, C: 27972.80430499159},
+		{A: 7e-11, B: 6.04142381509, C: 155475.15046625677},
+		{A: 8e-11, B: 0.66187102958, C: 156740.7179448832},
+		{A: 7e-11, B: 0.94700216301, C: 202420.14928260216},
+		{A: 8e-11, B: 0.34397341638, C: 78339.97549599818},
+		{A: 8e-11, B: 1.99164711035, C: 84944.9342781222},
+		{A: 6e-11, B: 3.16647388859, C: 76041.85279012559},
+		{A: 7e-11, B: 1.18920934641, C: 104984.39630560997},
+		{A: 7e-11, B: 6.12812258419, C: 53867.97195265079},
+		{A: 7e-11, B: 2.68832841048, C: 156377.8556523106},
+		{A: 7e-11, B: 4.82261895829, C: 52286.01260436779},
+		{A: 6e-11, B: 2.12110075547, C: 148.0787244263},
+		{A: 6e-11, B: 3.05937939252, C: 151.0476698429},
+		{A: 7e-11, B: 0.09315209734, C: 220025.88923089797},
+		{A: 8e-11, B: 5.56250511075, C: 52509.6622239178},
+		{A: 6e-11, B: 2.57622376266, C: 224577.84272795677},
+		{A: 7e-11, B: 2.65171386195, C: 220.4126424388},
+		{A: 6e-11, B: 2.00091220299, C: 102018.41617342478},
+		{A: 7e-11, B: 0.86158158633, C: 50444.6839302158},
+		{A: 7e-11, B: 1.6199048214, C: 103189.13786188899},
+		{A: 6e-11, B: 2.68512587119, C: 77520.71936418998},
+		{A: 6e-11, B: 4.82671721903, C: 154308.6617452584},
+		{A: 6e-11, B: 2.40727316051, C: 78800.51393681798},
+		{A: 6e-11, B: 5.66286619587, C: 234261.43730907317},
+		{A: 7e-11, B: 1.38238075345, C: 106470.37642667518},
+		{A: 7e-11, B: 4.6219355895, C: 81591.84508100279},
+		{A: 7e-11, B: 0.26927581545, C: 127098.90328117118},
+		{A: 6e-11, B: 0.92172283144, C: 57503.2823915312},
+		{A: 6e-11, B: 5.39808870469, C: 130020.03106399579},
+		{A: 6e-11, B: 2.52761413373, C: 52817.21984488739},
+		{A: 6e-11, B: 0.06817978613, C: 53757.76563143139},
+		{A: 7e-11, B: 3.1354731214, C: 155460.9233722552},
+		{A: 5e-11, B: 2.17501494391, C: 209232.91609768817},
+		{A: 5e-11, B: 2.16759656006, C: 104241.40624507738},
+		{A: 6e-11, B: 2.68409858369, C: 130005.80396999417},
+		{A: 5e-11, B: 1.58914721681, C: 50167.24874398939},
+		{A: 5e-11, B: 1.01687539089, C: 55638.05098901359},
+		{A: 7e-11, B: 1.31888655444, C: 149846.1939960456},
+		{A: 5e-11, B: 2.48117306579, C: 9384.8410080752},
+		{A: 5e-11, B: 3.70948655428, C: 266540.3634648941},
+		{A: 7e-11, B: 5.71513815276, C: 70383.6202713836},
+		{A: 6e-11, B: 4.55015130253, C: 51955.39364070959},
+		{A: 5e-11, B: 3.51602263966, C: 102975.83876645098},
+		{A: 5e-11, B: 3.69945557546, C: 39450.3528483734},
+		{A: 5e-11, B: 3.98232048303, C: 53771.99272543299},
+		{A: 6e-11, B: 1.01394190869, C: 77940.20400806518},
+		{A: 5e-11, B: 2.50588363651, C: 102872.74599223239},
+		{A: 7e-11, B: 3.0124494408, C: 50264.6067999312},
+		{A: 6e-11, B: 0.45316437598, C: 50064.15596977079},
+		{A: 7e-11, B: 6.03933985106, C: 1485.9801210652},
+		{A: 5e-11, B: 1.35734487293, C: 53814.87580033639},
+		{A: 5e-11, B: 4.43939776623, C: 51534.3927214094},
+		{A: 5e-11, B: 2.29075654159, C: 61560.64729122359},
+		{A: 5e-11, B: 0.07896316759, C: 78057.52387628538},
+		{A: 5e-11, B: 0.95484155225, C: 130907.4806982254},
+		{A: 5e-11, B: 1.56485117164, C: 53399.624123927},
+		{A: 5e-11, B: 4.55586232099, C: 52137.67324751059},
+		{A: 6e-11, B: 4.34778590755, C: 156534.53239644598},
+		{A: 5e-11, B: 3.7269181897, C: 128747.35003836859},
+		{A: 4e-11, B: 0.56386565513, C: 189386.03259226496},
+		{A: 4e-11, B: 2.67910077904, C: 107692.22499299659},
+		{A: 6e-11, B: 6.10947724529, C: 104248.51979207818},
+		{A: 4e-11, B: 3.99859010561, C: 104401.60918820098},
+		{A: 4e-11, B: 0.51280078907, C: 54344.56676543099},
+		{A: 5e-11, B: 4.1991766574, C: 129586.31932611899},
+		{A: 4e-11, B: 5.91763059229, C: 52041.69723356339},
+		{A: 5e-11, B: 3.1863383495, C: 181975.4247047054},
+		{A: 5e-11, B: 0.99354989216, C: 1795.258443721},
+		{A: 4e-11, B: 6.20030341798, C: 209762.60706278277},
+		{A: 4e-11, B: 4.57601261459, C: 184834.07909520617},
+		{A: 6e-11, B: 4.81632935419, C: 77741.13200662879},
+		{A: 4e-11, B: 2.32553366031, C: 53265.515074342},
+		{A: 4e-11, B: 4.83867556795, C: 52755.49387014719},
+		{A: 4e-11, B: 0.47379105299, C: 105403.88094948517},
+		{A: 4e-11, B: 2.62000942355, C: 50049.92887576919},
+		{A: 5e-11, B: 1.01847704961, C: 52309.9153327334},
+		{A: 4e-11, B: 5.12416312887, C: 52027.72755872209},
+		{A: 4e-11, B: 0.26524339921, C: 50007.0458008658},
+		{A: 5e-11, B: 0.30725353996, C: 207747.625390985},
+		{A: 4e-11, B: 4.93794243881, C: 63786.3582415226},
+		{A: 4e-11, B: 4.51168915835, C: 77956.15180375299},
+		{A: 5e-11, B: 1.16733741695, C: 27566.76971563859},
+		{A: 4e-11, B: 3.77703754152, C: 104501.17576343138},
+		{A: 4e-11, B: 5.4485951193, C: 129971.55071751658},
+		{A: 5e-11, B: 5.22593130125, C: 51329.7234483972},
+		{A: 4e-11, B: 1.64237114391, C: 130446.14426677099},
+		{A: 4e-11, B: 1.28513103275, C: 112545.88789950538},
+		{A: 4e-11, B: 3.50115780256, C: 76152.05911134499},
+		{A: 4e-11, B: 4.79032272131, C: 130432.88714897096},
+		{A: 4e-11, B: 5.62786746084, C: 43981.5309499398},
+		{A: 4e-11, B: 4.1268797505, C: 52024.75861330549},
+		{A: 4e-11, B: 5.13202982684, C: 735.8765135318},
+		{A: 4e-11, B: 4.64327471216, C: 76255.15188556358},
+		{A: 4e-11, B: 1.34781477964, C: 106684.80895916879},
+		{A: 4e-11, B: 2.34997151211, C: 177287.84588263658},
+	},
+	// B1, 494 terms
+	{
+		{A: 0.00429151362, B: 3.50169780393, C: 26087.9031415742},
+		{A: 0.00146233668, B: 3.14159265359, C: 0},
+		{A: 0.00022675295, B: 0.0151536688, C: 52175.8062831484},
+		{A: 0.00010894981, B: 0.48540174006, C: 78263.70942472259},
+		{A: 6.353462e-05, B: 3.42943919982, C: 104351.61256629678},
+		{A: 2.495743e-05, B: 0.16051210665, C: 130439.51570787099},
+		{A: 8.59585e-06, B: 3.18452433647, C: 156527.41884944518},
+		{A: 2.77503e-06, B: 6.21020774184, C: 182615.3219910194},
+		{A: 8.6233e-07, B: 2.95244391822, C: 208703.2251325936},
+		{A: 2.6133e-07, B: 5.97708962692, C: 234791.12827416777},
+		{A: 2.7696e-07, B: 0.29068938889, C: 27197.2816936676},
+		{A: 1.2831e-07, B: 3.37744320558, C: 53285.1848352418},
+		{A: 1.272e-07, B: 0.53792661684, C: 24978.5245894808},
+		{A: 7.781e-08, B: 2.71768609268, C: 260879.03141574195},
+		{A: 7.527e-08, B: 3.58305121268, C: 51066.427731055},
+		{A: 6.183e-08, B: 2.92383205004, C: 31749.2351907264},
+		{A: 5.453e-08, B: 1.97318763801, C: 51116.4243529592},
+		{A: 3.394e-08, B: 0.34761695275, C: 77154.33087262919},
+		{A: 3.481e-08, B: 0.10739761667, C: 79373.087976816},
+		{A: 2.932e-08, B: 5.95430013169, C: 57837.1383323006},
+		{A: 2.742e-08, B: 0.98758439378, C: 25028.521211385},
+		{A: 2.126e-08, B: 4.16352818018, C: 47623.8527860896},
+		{A: 2.286e-08, B: 5.74036496396, C: 286966.9345573162},
+		{A: 2.38e-08, B: 4.0021366655, C: 21535.9496445154},
+		{A: 1.651e-08, B: 4.43606584681, C: 27043.5028831828},
+		{A: 1.523e-08, B: 1.97926797181, C: 5661.3320491522},
+		{A: 1.518e-08, B: 5.01636479848, C: 77204.32749453338},
+		{A: 1.398e-08, B: 0.66375029294, C: 20426.571092422},
+		{A: 1.345e-08, B: 3.39193943388, C: 103242.2340142034},
+		{A: 1.297e-08, B: 5.34251327744, C: 37410.5672398786},
+		{A: 1.076e-08, B: 0.95624460335, C: 50586.73338786459},
+		{A: 1.05e-08, B: 3.00944151152, C: 26107.57290247399},
+		{A: 9.18e-09, B: 1.89988871166, C: 1059.3819301892},
+		{A: 1.063e-08, B: 1.28486292967, C: 53131.406024757},
+		{A: 9.05e-09, B: 3.34776562702, C: 25558.2121764796},
+		{A: 9.29e-09, B: 4.81159652409, C: 51646.11531805379},
+		{A: 7.82e-09, B: 6.08817839271, C: 529.6909650946},
+		{A: 7.83e-09, B: 1.5096269941, C: 41962.5207369374},
+		{A: 7.94e-09, B: 2.16868396183, C: 63498.47038145279},
+		{A: 7.31e-09, B: 0.92148918837, C: 73711.75592766379},
+		{A: 7.55e-09, B: 4.1091063739, C: 26068.2333806744},
+		{A: 7.03e-09, B: 2.22466530621, C: 10213.285546211},
+		{A: 9.22e-09, B: 2.30268247092, C: 1109.3785520934},
+		{A: 7.39e-09, B: 3.05842748947, C: 105460.99111839019},
+		{A: 6.81e-09, B: 1.40695567526, C: 51749.20809227239},
+		{A: 8.41e-09, B: 5.9320678781, C: 26084.0218062162},
+		{A: 6.16e-09, B: 5.9536898243, C: 39609.6545831656},
+		{A: 6.1e-09, B: 2.96796433797, C: 25132.3033999656},
+		{A: 7.21e-09, B: 1.16472614778, C: 46514.4742339962},
+		{A: 6.38e-09, B: 1.16941585214, C: 26091.7844769322},
+		{A: 6.33e-09, B: 3.07427024064, C: 30639.856638633},
+		{A: 6.65e-09, B: 2.47994200156, C: 313054.83769889036},
+		{A: 6.69e-09, B: 4.10310179631, C: 72602.37737557039},
+		{A: 4.95e-09, B: 0.14776350456, C: 129330.13715577759},
+		{A: 4.74e-09, B: 2.61466823958, C: 32858.61374281979},
+		{A: 4.7e-09, B: 5.04436148211, C: 13521.7514415914},
+		{A: 4.94e-09, B: 0.0743055645, C: 28306.66024576099},
+		{A: 4.59e-09, B: 5.81611579004, C: 51220.20654153979},
+		{A: 4.67e-09, B: 5.56622114735, C: 4551.9534970588},
+		{A: 4.33e-09, B: 2.10197184422, C: 24498.8302462904},
+		{A: 4.3e-09, B: 3.91046377635, C: 76674.63652943878},
+		{A: 4.36e-09, B: 1.46213785727, C: 43071.8992890308},
+		{A: 3.83e-09, B: 6.05537830853, C: 52195.47604404819},
+		{A: 3.35e-09, B: 0.5599660533, C: 23869.1460373874},
+		{A: 3.69e-09, B: 4.43217995206, C: 22645.32819660879},
+		{A: 3.29e-09, B: 2.59508442112, C: 65697.55772473979},
+		{A: 3.46e-09, B: 2.03170085305, C: 27147.28507176339},
+		{A: 4.24e-09, B: 0.29775934661, C: 1589.0728952838},
+		{A: 3.78e-09, B: 5.09810178577, C: 53235.18821333759},
+		{A: 3.08e-09, B: 3.30225015955, C: 54394.56338733519},
+		{A: 3.14e-09, B: 2.40932519602, C: 83925.0414738748},
+		{A: 3.64e-09, B: 5.87784809361, C: 58946.51688439399},
+		{A: 3.52e-09, B: 0.879394819, C: 52156.1365222486},
+		{A: 3.52e-09, B: 5.92675293084, C: 25661.3049506982},
+		{A: 3.1e-09, B: 2.69873876637, C: 52171.9249477904},
+		{A: 3.45e-09, B: 0.84406679212, C: 98690.28051714458},
+		{A: 3.58e-09, B: 6.14890038263, C: 56727.7597802072},
+		{A: 2.48e-09, B: 2.3439684006, C: 51535.90899683439},
+		{A: 2.86e-09, B: 2.39648280163, C: 19804.8272915828},
+		{A: 3.38e-09, B: 1.55189200325, C: 52705.49724824299},
+		{A: 2.39e-09, B: 3.35169081927, C: 52168.69273614759},
+		{A: 2.34e-09, B: 2.51108507067, C: 77308.10968311399},
+		{A: 2.29e-09, B: 5.16737598091, C: 36301.18868778519},
+		{A: 2.45e-09, B: 4.269960324, C: 19317.1925403286},
+		{A: 2.1e-09, B: 3.16281965425, C: 29530.4780865396},
+		{A: 2.12e-09, B: 5.33861521612, C: 15874.6175953632},
+		{A: 2.91e-09, B: 4.22929240183, C: 52179.6876185064},
+		{A: 2.63e-09, B: 4.64138649646, C: 69159.80243060499},
+		{A: 2.35e-09, B: 5.08060846802, C: 59414.4818747484},
+		{A: 2.51e-09, B: 1.9004750479, C: 14765.2390432698},
+		{A: 2.53e-09, B: 4.21581970504, C: 79219.3091663312},
+		{A: 1.94e-09, B: 5.05904557922, C: 51109.31080595839},
+		{A: 1.88e-09, B: 3.90664116889, C: 48733.23133818299},
+		{A: 2.07e-09, B: 2.89949362192, C: 7.1135470008},
+		{A: 1.79e-09, B: 4.43018837604, C: 77837.11123384659},
+		{A: 2.23e-09, B: 2.96569885227, C: 110012.94461544899},
+		{A: 1.76e-09, B: 5.15204624857, C: 89586.37352302698},
+		{A: 2.15e-09, B: 4.50903357312, C: 78793.40038981718},
+		{A: 1.73e-09, B: 3.1849677146, C: 155418.04029735178},
+		{A: 2.05e-09, B: 1.89888752382, C: 79323.09135491178},
+		{A: 1.75e-09, B: 2.91183047213, C: 82815.66292178139},
+		{A: 1.65e-09, B: 0.4912369438, C: 25035.6347583858},
+		{A: 1.83e-09, B: 1.42148796836, C: 77734.01845962799},
+		{A: 1.58e-09, B: 3.63030783882, C: 49957.0491789616},
+		{A: 2.01e-09, B: 4.78711687782, C: 40853.142184844},
+		{A: 1.57e-09, B: 3.02451795123, C: 52182.9198301492},
+		{A: 1.92e-09, B: 5.5021820905, C: 339142.7408404646},
+		{A: 1.45e-09, B: 3.39803928124, C: 45892.73043315699},
+		{A: 1.4e-09, B: 2.64826898848, C: 16983.9961474566},
+		{A: 1.9e-09, B: 3.05043439775, C: 27676.976036858},
+		{A: 1.69e-09, B: 3.40273824751, C: 25874.6040461362},
+		{A: 1.48e-09, B: 3.87588943419, C: 124778.18365871879},
+		{A: 1.34e-09, B: 0.9493002873, C: 25021.4076643842},
+		{A: 1.74e-09, B: 5.88326736492, C: 136100.84775702318},
+		{A: 1.38e-09, B: 2.34393108627, C: 1052.2683831884},
+		{A: 1.63e-09, B: 6.05484015332, C: 26080.78959457339},
+		{A: 1.42e-09, B: 3.0720910315, C: 3442.5749449654},
+		{A: 1.37e-09, B: 2.10984857727, C: 426.598190876},
+		{A: 1.26e-09, B: 1.8943260926, C: 1066.49547719},
+		{A: 1.22e-09, B: 1.04298624291, C: 45405.0956819028},
+		{A: 1.35e-09, B: 4.66414339767, C: 25448.00585526019},
+		{A: 1.15e-09, B: 2.8136585152, C: 78283.37918562238},
+		{A: 1.14e-09, B: 4.47315411813, C: 68050.42387851159},
+		{A: 1.34e-09, B: 5.35393682799, C: 213.299095438},
+		{A: 1.25e-09, B: 1.61827845372, C: 129380.13377768178},
+		{A: 1.58e-09, B: 5.53788931475, C: 9103.9069941176},
+		{A: 1.16e-09, B: 0.5007523578, C: 102762.53967101299},
+		{A: 1.36e-09, B: 0.245596581, C: 50057.04242277},
+		{A: 1.24e-09, B: 2.20590858584, C: 33326.5787331742},
+		{A: 1.06e-09, B: 6.15493608726, C: 11322.6640983044},
+		{A: 1.23e-09, B: 4.432657352, C: 639.897286314},
+		{A: 1.37e-09, B: 3.93191153638, C: 78244.0396638228},
+		{A: 1.38e-09, B: 4.46503235172, C: 51123.53789995999},
+		{A: 1.19e-09, B: 3.64440622006, C: 26617.5941066688},
+		{A: 9.9e-10, B: 6.18313188027, C: 55618.3812281138},
+		{A: 1.01e-09, B: 5.52733987069, C: 103396.01282468818},
+		{A: 1.07e-09, B: 4.79994153405, C: 6770.7106012456},
+		{A: 9.6e-10, B: 0.01853371395, C: 80482.46652890938},
+		{A: 1.15e-09, B: 1.57423656512, C: 66941.04532641819},
+		{A: 1.11e-09, B: 1.00588061631, C: 78267.59076008058},
+		{A: 1.21e-09, B: 5.90839328752, C: 26095.016688575},
+		{A: 9.5e-10, B: 5.74549458142, C: 78259.82808936459},
+		{A: 1e-09, B: 1.90676485528, C: 103292.23063610759},
+		{A: 1.07e-09, B: 5.73194510449, C: 131548.89425996438},
+		{A: 9.8e-10, B: 5.49064814924, C: 77623.81213840858},
+		{A: 1.12e-09, B: 0.87682090108, C: 74821.13447975718},
+		{A: 9e-10, B: 5.82373560803, C: 955.5997416086},
+		{A: 9.3e-10, B: 0.15279056716, C: 78256.59587772179},
+		{A: 1e-09, B: 1.21759895953, C: 104881.30353139139},
+		{A: 8.7e-10, B: 4.20722631983, C: 12566.1516999828},
+		{A: 8.8e-10, B: 5.00176994115, C: 38519.945791972},
+		{A: 8.6e-10, B: 1.23375034376, C: 26301.2022370122},
+		{A: 8.5e-10, B: 1.88852891739, C: 77197.21394753258},
+		{A: 8.2e-10, B: 3.75652571856, C: 99799.65906923798},
+		{A: 8.9e-10, B: 4.95684147986, C: 105410.99449648599},
+		{A: 9.6e-10, B: 5.10490831351, C: 52389.1053785864},
+		{A: 7.5e-10, B: 2.50722139185, C: 2218.7571041868},
+		{A: 9.3e-10, B: 6.00229475799, C: 53764.8791784322},
+		{A: 7.3e-10, B: 6.11603054445, C: 78270.82297172339},
+		{A: 9.1e-10, B: 4.67762120545, C: 155468.036919256},
+		{A: 8e-10, B: 1.9856425664, C: 7238.6755916},
+		{A: 8.9e-10, B: 1.62820970873, C: 6283.0758499914},
+		{A: 7.3e-10, B: 5.93728826025, C: 108903.56606335558},
+		{A: 8.7e-10, B: 2.61787684648, C: 162188.75089859738},
+		{A: 9.2e-10, B: 2.55298032665, C: 85034.42002596818},
+		{A: 7.6e-10, B: 4.44230028342, C: 38654.05484155699},
+		{A: 7e-10, B: 2.07147354168, C: 25934.1243310894},
+		{A: 7.8e-10, B: 1.79664677749, C: 23969.1392811958},
+		{A: 6.8e-10, B: 2.15267768357, C: 64607.84893354619},
+		{A: 6.9e-10, B: 1.37976390686, C: 77211.44104153418},
+		{A: 8e-10, B: 0.75440631101, C: 2118.7638603784},
+		{A: 6e-10, B: 5.95179539253, C: 18849.2275499742},
+		{A: 5.8e-10, B: 0.98126270514, C: 51962.5071877104},
+		{A: 5.7e-10, B: 0.63495768344, C: 150866.08680029298},
+		{A: 6.3e-10, B: 0.40577774917, C: 76044.9523205358},
+		{A: 6.1e-10, B: 3.20728040856, C: 76144.94556434419},
+		{A: 5.3e-10, B: 1.34424134476, C: 51742.09454527159},
+		{A: 5.2e-10, B: 4.11944860444, C: 71492.99882347698},
+		{A: 5.4e-10, B: 2.23964604878, C: 44937.1306915484},
+		{A: 6.4e-10, B: 1.19785741966, C: 64741.95798313119},
+		{A: 5.8e-10, B: 6.22316473811, C: 181505.94343892598},
+		{A: 6e-10, B: 2.12727479557, C: 88476.99497093359},
+		{A: 4.9e-10, B: 1.76854754615, C: 13655.8604911764},
+		{A: 4.9e-10, B: 1.27506119902, C: 26727.8004278882},
+		{A: 4.8e-10, B: 2.04581990152, C: 78477.00852016058},
+		{A: 5e-10, B: 4.62948267835, C: 93028.94846799239},
+		{A: 5.5e-10, B: 5.47526225139, C: 62389.09182935939},
+		{A: 4.7e-10, B: 4.99113735496, C: 27154.3986187642},
+		{A: 5.5e-10, B: 4.79762177368, C: 94138.32702008578},
+		{A: 5.1e-10, B: 5.56459812422, C: 52602.4044740244},
+		{A: 5.5e-10, B: 1.20462687751, C: 95247.70557217918},
+		{A: 5e-10, B: 2.21530140501, C: 365230.6439820388},
+		{A: 4.9e-10, B: 0.68636780387, C: 104331.94280539699},
+		{A: 4.7e-10, B: 4.30403626352, C: 23754.70674870219},
+		{A: 4.2e-10, B: 3.32657201754, C: 25654.19140369739},
+		{A: 5.1e-10, B: 1.08082309375, C: 25619.9381512198},
+		{A: 4.7e-10, B: 2.79966035046, C: 26514.5013324502},
+		{A: 4e-10, B: 2.9193266898, C: 81706.28436968799},
+		{A: 4e-10, B: 4.65660434525, C: 129909.82474277639},
+		{A: 4.1e-10, B: 4.21875403274, C: 130969.20667296558},
+		{A: 5e-10, B: 1.46644208786, C: 120226.23016165999},
+		{A: 4.7e-10, B: 3.77053215629, C: 25973.46385288896},
+		{A: 4.7e-10, B: 2.82867670887, C: 25668.418497699},
+		{A: 4.6e-10, B: 4.14427477722, C: 125887.56221081219},
+		{A: 3.9e-10, B: 4.06156914485, C: 104355.49390165479},
+		{A: 3.7e-10, B: 3.22245380356, C: 51322.60990139639},
+		{A: 3.9e-10, B: 2.26439106521, C: 129483.91596626239},
+		{A: 4e-10, B: 0.54598734169, C: 105307.21230790539},
+		{A: 3.5e-10, B: 5.5389589782, C: 433.7117378768},
+		{A: 3.8e-10, B: 6.0532946799, C: 419.4846438752},
+		{A: 3.9e-10, B: 2.72082598885, C: 79852.78232000639},
+		{A: 3.5e-10, B: 1.72937228501, C: 131498.89763806018},
+		{A: 3.4e-10, B: 0.83611265968, C: 12432.0426503978},
+		{A: 3.2e-10, B: 4.45254278754, C: 50579.61984086379},
+		{A: 4.2e-10, B: 1.4145702213, C: 181555.94006083018},
+		{A: 3.2e-10, B: 4.7334998692, C: 52026.2430860138},
+		{A: 3.6e-10, B: 5.60766535694, C: 188276.6540401716},
+		{A: 3.3e-10, B: 3.98525990131, C: 78050.41032928458},
+		{A: 3.2e-10, B: 5.86059322955, C: 104371.28232719658},
+		{A: 4e-10, B: 5.45287901295, C: 27140.17152476259},
+		{A: 3.1e-10, B: 5.17982917253, C: 71025.0338331226},
+		{A: 3.9e-10, B: 3.34206786809, C: 26202.34243025941},
+		{A: 2.9e-10, B: 1.94010215318, C: 103821.92160120218},
+		{A: 3.6e-10, B: 0.65969730203, C: 51756.3216392732},
+		{A: 3e-10, B: 0.82864967511, C: 46848.3301747656},
+		{A: 3.8e-10, B: 0.80809536333, C: 151975.46535238638},
+		{A: 2.9e-10, B: 2.8922779253, C: 104358.72611329758},
+		{A: 2.9e-10, B: 4.49728060011, C: 103299.34418310839},
+		{A: 3.5e-10, B: 1.48121158024, C: 85502.38501632259},
+		{A: 2.8e-10, B: 5.48224379273, C: 91785.46086631398},
+		{A: 3e-10, B: 3.11318420566, C: 106570.36967048359},
+		{A: 3.4e-10, B: 4.26548581082, C: 90829.86112470538},
+		{A: 3.1e-10, B: 5.67743476614, C: 117873.36400788819},
+		{A: 2.8e-10, B: 4.97598935775, C: 103285.11708910679},
+		{A: 3.3e-10, B: 5.18111128318, C: 114564.89811250778},
+		{A: 2.7e-10, B: 0.88054842818, C: 157636.79740153858},
+		{A: 2.6e-10, B: 2.57652351573, C: 134991.4692049298},
+		{A: 2.9e-10, B: 2.64144900778, C: 26137.8997634784},
+		{A: 2.8e-10, B: 3.23650761988, C: 128850.44281258718},
+		{A: 3e-10, B: 6.03255187776, C: 32370.9789915656},
+		{A: 2.9e-10, B: 3.23415692694, C: 104344.49901929598},
+		{A: 3.2e-10, B: 3.21019810469, C: 28421.0995344462},
+		{A: 2.4e-10, B: 2.52484264487, C: 1596.1864422846},
+		{A: 2.4e-10, B: 0.27171495756, C: 24491.71669928959},
+		{A: 2.6e-10, B: 1.20902208843, C: 44181.27784112419},
+		{A: 2.7e-10, B: 5.71639245109, C: 111122.32316754239},
+		{A: 3.1e-10, B: 3.68314656803, C: 28206.6670019526},
+		{A: 3e-10, B: 2.36500429653, C: 103711.71527998279},
+		{A: 2.2e-10, B: 4.4754796526, C: 77829.99768684579},
+		{A: 2.2e-10, B: 5.93080138405, C: 98068.53671630539},
+		{A: 2.1e-10, B: 4.45887879737, C: 52815.7035694624},
+		{A: 2.2e-10, B: 1.51276188597, C: 35191.8101356918},
+		{A: 2.5e-10, B: 3.67349275753, C: 853.196381752},
+		{A: 2.1e-10, B: 5.21045664362, C: 104564.91166173479},
+		{A: 2.3e-10, B: 3.52400873624, C: 100909.03762133139},
+		{A: 2.1e-10, B: 0.91705070968, C: 97580.90196505119},
+		{A: 2.1e-10, B: 3.93681460045, C: 25455.119402261},
+		{A: 2e-10, B: 2.51210823023, C: 104347.73123093879},
+		{A: 2.7e-10, B: 2.35592427305, C: 78690.30761559859},
+		{A: 2e-10, B: 5.09828683951, C: 52099.5402118728},
+		{A: 1.9e-10, B: 3.37799607043, C: 45494.58142974879},
+		{A: 2.1e-10, B: 1.47540794459, C: 115674.27666460119},
+		{A: 2e-10, B: 1.17802900406, C: 155997.72788435058},
+		{A: 2.5e-10, B: 4.46628547605, C: 146314.13330323418},
+		{A: 2.3e-10, B: 2.29934592297, C: 33967.99229491319},
+		{A: 2e-10, B: 0.67974525638, C: 24505.94379329119},
+		{A: 2e-10, B: 5.32443861216, C: 25234.70675982219},
+		{A: 1.8e-10, B: 4.83011056299, C: 50593.84693486539},
+		{A: 2e-10, B: 2.19515561332, C: 51639.00177105299},
+		{A: 2e-10, B: 4.54362105039, C: 26037.90651966999},
+		{A: 1.8e-10, B: 3.66945372389, C: 176953.98994186718},
+		{A: 2.2e-10, B: 2.36457126914, C: 143961.2671494624},
+		{A: 2e-10, B: 1.91605933726, C: 53242.3017603384},
+		{A: 1.7e-10, B: 3.65797302958, C: 25938.3399444396},
+		{A: 1.7e-10, B: 4.72356636284, C: 39743.7636327506},
+		{A: 1.7e-10, B: 4.6338502937, C: 70269.18098269838},
+		{A: 2.3e-10, B: 3.47296935942, C: 102132.85546210999},
+		{A: 1.8e-10, B: 3.89075237232, C: 77844.22478084739},
+		{A: 1.6e-10, B: 1.39550514982, C: 119116.85160956658},
+		{A: 1.7e-10, B: 3.19413997784, C: 2333.196392872},
+		{A: 1.8e-10, B: 0.10505670252, C: 52290.24557183361},
+		{A: 1.5e-10, B: 5.66501892458, C: 7994.5284420242},
+		{A: 1.7e-10, B: 5.59546671313, C: 60055.89543648739},
+		{A: 1.5e-10, B: 3.35297565546, C: 58458.88213313979},
+		{A: 1.9e-10, B: 1.88696722328, C: 1581.959348283},
+		{A: 1.6e-10, B: 3.51339563149, C: 26094.53170047421},
+		{A: 1.5e-10, B: 5.8779684464, C: 105940.68546158058},
+		{A: 1.4e-10, B: 4.90677373859, C: 632.7837393132},
+		{A: 1.7e-10, B: 2.94915136654, C: 18093.37469954999},
+		{A: 1.6e-10, B: 3.30923893089, C: 26507.38778544939},
+		{A: 1.7e-10, B: 2.79032831551, C: 124156.43985787958},
+		{A: 1.7e-10, B: 2.32026007183, C: 53228.07466633679},
+		{A: 1.5e-10, B: 1.27735280723, C: 103925.01437542078},
+		{A: 1.4e-10, B: 0.47578456087, C: 104138.31347085879},
+		{A: 1.4e-10, B: 4.33486658309, C: 18207.81398823521},
+		{A: 1.4e-10, B: 6.25912081869, C: 102232.84870591838},
+		{A: 1.6e-10, B: 5.19489448771, C: 52022.0274726636},
+		{A: 1.5e-10, B: 3.36079341013, C: 26241.681952059},
+		{A: 1.6e-10, B: 0.62153007569, C: 71980.63357473118},
+		{A: 1.6e-10, B: 0.34240403974, C: 26081.27458267419},
+		{A: 1.5e-10, B: 4.43868814661, C: 207643.8432024044},
+		{A: 1.7e-10, B: 3.44704905407, C: 183724.7005431128},
+		{A: 1.6e-10, B: 0.08608453345, C: 77410.51304297059},
+		{A: 1.3e-10, B: 4.45469253351, C: 90695.75207512038},
+		{A: 1.4e-10, B: 5.26281612633, C: 391318.54712361295},
+		{A: 1.3e-10, B: 2.28140033187, C: 26521.614879451},
+		{A: 1.3e-10, B: 4.75564434373, C: 536.8045120954},
+		{A: 1.3e-10, B: 3.72895039092, C: 130419.8459469712},
+		{A: 1.5e-10, B: 6.26388997196, C: 54509.0026760204},
+		{A: 1.4e-10, B: 1.32802909705, C: 20760.4270331914},
+		{A: 1.2e-10, B: 0.9618285308, C: 157057.10981453978},
+		{A: 1.7e-10, B: 3.00168933222, C: 207593.8465805002},
+		{A: 1.6e-10, B: 0.54167157981, C: 52061.36699446317},
+		{A: 1.6e-10, B: 3.76004077423, C: 178063.3684939606},
+		{A: 1.2e-10, B: 0.50356687265, C: 5327.4761083828},
+		{A: 1.4e-10, B: 0.65739903621, C: 84546.78527471398},
+		{A: 1.4e-10, B: 2.64166191335, C: 42153.969003049},
+		{A: 1.1e-10, B: 1.8737562471, C: 97112.93697469679},
+		{A: 1.2e-10, B: 5.28144640817, C: 26610.48055966799},
+		{A: 1.2e-10, B: 1.02715379165, C: 130012.91751699499},
+		{A: 1.1e-10, B: 4.76973198116, C: 157586.80077963436},
+		{A: 1.1e-10, B: 4.03645617358, C: 26011.6370702986},
+		{A: 1.2e-10, B: 1.0377409492, C: 116917.76426627958},
+		{A: 1.1e-10, B: 2.34762914711, C: 214364.55718174577},
+		{A: 1.3e-10, B: 5.96105286469, C: 49527.35145767539},
+		{A: 1.3e-10, B: 0.38784055589, C: 54294.57014352679},
+		{A: 1.1e-10, B: 0.80279403389, C: 130443.39704322898},
+		{A: 1.2e-10, B: 1.91910219792, C: 140652.80125408198},
+		{A: 1.1e-10, B: 4.82050468552, C: 647.0108333148},
+		{A: 1.2e-10, B: 3.95784286024, C: 72936.23331633979},
+		{A: 1e-10, B: 2.38363926963, C: 52698.38370124219},
+		{A: 1.2e-10, B: 5.21962000846, C: 25551.09862947879},
+		{A: 1.1e-10, B: 1.24041943634, C: 51543.0225438352},
+		{A: 1e-10, B: 1.23610986711, C: 76667.52298243798},
+		{A: 1.1e-10, B: 5.27116774881, C: 155571.81910783658},
+		{A: 1.1e-10, B: 5.98419309923, C: 107794.1875112622},
+		{A: 1e-10, B: 0.55390559777, C: 26941.0995233262},
+		{A: 1e-10, B: 1.49161844344, C: 52329.58509363319},
+		{A: 1.1e-10, B: 4.68946178137, C: 26190.99591579279},
+		{A: 9e-11, B: 5.63092803389, C: 27684.0895838588},
+		{A: 1.1e-10, B: 6.09445403088, C: 26555.8681319286},
+		{A: 1e-10, B: 0.09698975387, C: 29416.03879785439},
+		{A: 1e-10, B: 0.72339727974, C: 22759.76748529401},
+		{A: 9e-11, B: 1.96066446481, C: 51528.79544983359},
+		{A: 9e-11, B: 4.28124553384, C: 121335.60871375339},
+		{A: 8e-11, B: 4.26127907796, C: 182085.63102592478},
+		{A: 9e-11, B: 3.34775259107, C: 22747.2907148744},
+		{A: 8e-11, B: 1.25238578145, C: 129387.24732468258},
+		{A: 8e-11, B: 3.86388656969, C: 24864.08530079559},
+		{A: 1e-10, B: 1.34737181255, C: 2648.454825473},
+		{A: 8e-11, B: 5.72234642822, C: 161079.37234650398},
+		{A: 9e-11, B: 5.45351693096, C: 104778.21075717278},
+		{A: 1.1e-10, B: 2.85637796333, C: 52643.7712735028},
+		{A: 8e-11, B: 3.27339488052, C: 27311.72098235281},
+		{A: 1e-10, B: 1.31510855953, C: 52125.80966124419},
+		{A: 9e-11, B: 1.17621493154, C: 172402.0364448084},
+		{A: 8e-11, B: 2.43297081646, C: 131395.11544947958},
+		{A: 9e-11, B: 0.46231104626, C: 26624.70765366959},
+		{A: 7e-11, B: 4.93485551094, C: 48835.19385644859},
+		{A: 7e-11, B: 5.92438953036, C: 130446.62925487179},
+		{A: 7e-11, B: 5.1510763867, C: 149.5631971346},
+		{A: 8e-11, B: 0.00476652316, C: 209812.60368468694},
+		{A: 8e-11, B: 5.0655882241, C: 61279.713277266},
+		{A: 9e-11, B: 0.56618846666, C: 522.5774180938},
+		{A: 7e-11, B: 2.63009300708, C: 130459.18546877075},
+		{A: 8e-11, B: 2.0895971735, C: 25565.3257234804},
+		{A: 9e-11, B: 5.37562306264, C: 170049.1702910366},
+		{A: 8e-11, B: 4.055541562, C: 156100.82065856917},
+		{A: 7e-11, B: 5.58541192959, C: 129799.61842155698},
+		{A: 7e-11, B: 1.76385898252, C: 129373.02023068098},
+		{A: 6e-11, B: 4.86066957244, C: 7880.08915333899},
+		{A: 8e-11, B: 4.47849277972, C: 10021.8372800994},
+		{A: 6e-11, B: 5.30561035943, C: 77726.90491262719},
+		{A: 6e-11, B: 1.27631388714, C: 103917.90082841998},
+		{A: 6e-11, B: 4.61189217781, C: 157483.01859105378},
+		{A: 6e-11, B: 3.96170038596, C: 123668.80510662538},
+		{A: 6e-11, B: 1.33523648266, C: 78903.60671103658},
+		{A: 6e-11, B: 0.5570238657, C: 204151.27163553477},
+		{A: 5e-11, B: 1.55562437906, C: 26294.08869001139},
+		{A: 6e-11, B: 2.05839183005, C: 130652.81480330898},
+		{A: 5e-11, B: 5.58094407959, C: 130435.63437251298},
+		{A: 5e-11, B: 1.47580092631, C: 78114.14622758799},
+		{A: 6e-11, B: 4.10195998301, C: 51707.84129279399},
+		{A: 5e-11, B: 5.4235609608, C: 3340.6124266998},
+		{A: 5e-11, B: 0.71252148739, C: 103932.12792242158},
+		{A: 5e-11, B: 3.31021043385, C: 111590.2881578968},
+		{A: 5e-11, B: 5.18242316734, C: 13362.4497067992},
+		{A: 7e-11, B: 0.02670007799, C: 130432.40216087017},
+		{A: 6e-11, B: 5.6496176752, C: 52225.8029050526},
+		{A: 6e-11, B: 0.24412111381, C: 128220.75860368418},
+		{A: 5e-11, B: 4.45623339313, C: 145204.75475114078},
+		{A: 6e-11, B: 5.59932602145, C: 154938.34595416137},
+		{A: 5e-11, B: 0.44843767302, C: 203041.8930834414},
+		{A: 6e-11, B: 0.61515143577, C: 126996.94076290558},
+		{A: 6e-11, B: 3.76267562514, C: 110634.68841628819},
+		{A: 5e-11, B: 3.87958020217, C: 25440.89230825939},
+		{A: 6e-11, B: 6.1289854729, C: 132658.27281205778},
+		{A: 6e-11, B: 1.78589858446, C: 76681.75007643958},
+		{A: 6e-11, B: 3.31942003464, C: 25881.717593137},
+		{A: 5e-11, B: 4.83610476376, C: 78417.48823520739},
+		{A: 5e-11, B: 1.62128192664, C: 65831.6667743248},
+		{A: 6e-11, B: 5.79832326006, C: 150244.3429994538},
+		{A: 4e-11, B: 4.90210493212, C: 123200.84011627098},
+		{A: 4e-11, B: 0.04822157393, C: 71582.48457132299},
+		{A: 4e-11, B: 1.25993980368, C: 233731.7463439786},
+		{A: 4e-11, B: 4.09490053686, C: 143005.6674078538},
+		{A: 4e-11, B: 0.60263195052, C: 6681.2248533996},
+		{A: 5e-11, B: 3.53504145685, C: 130226.21661243298},
+		{A: 4e-11, B: 2.52659732174, C: 132028.58860315479},
+		{A: 4e-11, B: 5.09490146272, C: 76.2660712756},
+		{A: 4e-11, B: 1.41880070183, C: 31415.379249957},
+		{A: 5e-11, B: 5.67226040777, C: 68241.8721446232},
+		{A: 6e-11, B: 6.07125845396, C: 1478.8665740644},
+		{A: 4e-11, B: 2.90387262796, C: 128320.75184749259},
+		{A: 4e-11, B: 3.16756186361, C: 78378.1487134078},
+		{A: 4e-11, B: 0.3258927484, C: 52595.29092702359},
+		{A: 5e-11, B: 1.7809608149, C: 116783.65521669458},
+		{A: 4e-11, B: 4.99518857973, C: 166740.70439565618},
+		{A: 5e-11, B: 4.90494970331, C: 79330.20490191258},
+		{A: 4e-11, B: 5.2343783992, C: 206.1855484372},
+		{A: 4e-11, B: 2.64302747396, C: 75615.25459924959},
+		{A: 4e-11, B: 0.8140714413, C: 75930.51303185058},
+		{A: 4e-11, B: 0.53239895977, C: 156507.7490885454},
+		{A: 4e-11, B: 4.27504704332, C: 956.2891559706},
+		{A: 4e-11, B: 3.01307667234, C: 80596.9058175946},
+		{A: 3e-11, B: 4.15170143655, C: 25867.49049913539},
+		{A: 3e-11, B: 4.02158764895, C: 52712.61079524379},
+		{A: 4e-11, B: 5.97032773544, C: 78731.674415077},
+		{A: 4e-11, B: 5.13753166239, C: 137678.191299471},
+		{A: 3e-11, B: 5.3837822517, C: 79315.97780791098},
+		{A: 3e-11, B: 4.90402266218, C: 77630.92568540938},
+		{A: 4e-11, B: 3.35681065144, C: 80382.47328510099},
+		{A: 4e-11, B: 3.34724303278, C: 55503.94193942859},
+		{A: 3e-11, B: 4.39477038963, C: 102755.42612401219},
+		{A: 4e-11, B: 2.19880101386, C: 137210.22630911658},
+		{A: 3e-11, B: 1.9219127492, C: 87367.61641884019},
+		{A: 4e-11, B: 3.62687237484, C: 78149.27013603736},
+		{A: 3e-11, B: 5.34278285975, C: 77616.69859140778},
+		{A: 3e-11, B: 4.04927793779, C: 183145.012956114},
+		{A: 3e-11, B: 2.33740424416, C: 86143.79857806159},
+		{A: 3e-11, B: 0.29051326311, C: 66653.15746634839},
+		{A: 4e-11, B: 3.19530187424, C: 103498.41618454478},
+		{A: 3e-11, B: 1.8367025094, C: 78187.44335344699},
+		{A: 4e-11, B: 6.08402133763, C: 233681.74972207437},
+		{A: 3e-11, B: 5.44799723236, C: 52609.51802102519},
+		{A: 3e-11, B: 3.88568044923, C: 156531.3001848032},
+		{A: 3e-11, B: 1.56467339056, C: 183674.70392120857},
+		{A: 3e-11, B: 2.37223694472, C: 11610.5519583742},
+		{A: 4e-11, B: 2.36349454401, C: 3328.13565628019},
+		{A: 3e-11, B: 2.21015804942, C: 130866.11389874699},
+		{A: 3e-11, B: 1.00111385284, C: 12546.481939083},
+		{A: 3e-11, B: 5.04736992413, C: 167850.0829477496},
+		{A: 4e-11, B: 2.74224804458, C: 133882.09065283637},
+		{A: 3e-11, B: 6.04124419781, C: 16066.0658614748},
+		{A: 3e-11, B: 2.00390511344, C: 25984.8103673556},
+		{A: 3e-11, B: 3.5845123152, C: 19406.6782881746},
+		{A: 3e-11, B: 5.58865591583, C: 52381.99183158559},
+		{A: 3e-11, B: 5.49839860031, C: 78786.28684281638},
+		{A: 3e-11, B: 2.3525187781, C: 50483.640613646},
+		{A: 3e-11, B: 2.23638545873, C: 417406.4502651872},
+		{A: 3e-11, B: 0.89820153238, C: 182188.72380014337},
+		{A: 3e-11, B: 2.18659555288, C: 196137.07343261078},
+		{A: 3e-11, B: 1.23213629886, C: 183570.921732628},
+		{A: 3e-11, B: 0.65362427623, C: 1162.4747044078},
+		{A: 3e-11, B: 2.37265737062, C: 141762.17980617538},
+		{A: 3e-11, B: 2.3716713866, C: 103.0927742186},
+		{A: 3e-11, B: 3.43071585489, C: 51219.51712717779},
+		{A: 2e-11, B: 5.43620933898, C: 240452.46032331997},
+		{A: 2e-11, B: 4.79210725186, C: 64901.25971792339},
+		{A: 3e-11, B: 0.58543714627, C: 136722.59155786238},
+		{A: 2e-11, B: 3.65273296014, C: 53029.0026649004},
+		{A: 3e-11, B: 1.40305085463, C: 52278.89905736699},
+		{A: 3e-11, B: 2.91888451759, C: 235900.50682626115},
+		{A: 2e-11, B: 5.24862580724, C: 32769.1279949738},
+		{A: 3e-11, B: 0.92825301327, C: 29428.515568274},
+		{A: 2e-11, B: 1.28026650043, C: 96357.08412427259},
+		{A: 3e-11, B: 3.47745075021, C: 49842.60989027639},
+		{A: 3e-11, B: 1.27522510736, C: 44295.7171298094},
+		{A: 3e-11, B: 2.06356044829, C: 181659.72224941078},
+		{A: 2e-11, B: 1.82577636633, C: 181026.24909573558},
+		{A: 2e-11, B: 3.73653346143, C: 48847.6706268682},
+		{A: 2e-11, B: 4.29258038531, C: 198489.9395863826},
+		{A: 3e-11, B: 1.30423082531, C: 26237.46633870879},
+		{A: 2e-11, B: 5.6240507486, C: 112231.70171963578},
+		{A: 3e-11, B: 4.3496990758, C: 78213.71280281838},
+		{A: 2e-11, B: 4.40950391344, C: 155475.15046625677},
+		{A: 2e-11, B: 5.10859116787, C: 27669.86248985719},
+		{A: 2e-11, B: 2.40342983563, C: 53771.99272543299},
+		{A: 2e-11, B: 1.76898437388, C: 104505.39137678158},
+		{A: 2e-11, B: 1.13321786137, C: 208173.534167499},
+		{A: 3e-11, B: 4.95012664609, C: 102769.65321801379},
+		{A: 2e-11, B: 2.37593594042, C: 51951.46148744649},
+		{A: 3e-11, B: 0.6555617912, C: 1692.1656695024},
+		{A: 2e-11, B: 1.65727778641, C: 163766.0944410452},
+	},
+	// B2, 230 terms
+	{
+		{A: 0.00011830934, B: 4.79065585784, C: 26087.9031415742},
+		{A: 1.913516e-05, B: 0, C: 0},
+		{A: 1.044801e-05, B: 1.21216540536, C: 52175.8062831484},
+		{A: 2.66213e-06, B: 4.43418336532, C: 78263.70942472259},
+		{A: 1.7028e-06, B: 1.62255638714, C: 104351.61256629678},
+		{A: 9.63e-07, B: 4.80023692017, C: 130439.51570787099},
+		{A: 4.4692e-07, B: 1.60758267772, C: 156527.41884944518},
+		{A: 1.8316e-07, B: 4.66904655377, C: 182615.3219910194},
+		{A: 6.927e-08, B: 1.4340488893, C: 208703.2251325936},
+		{A: 2.479e-08, B: 4.47495202955, C: 234791.12827416777},
+		{A: 1.739e-08, B: 1.830800396, C: 27197.2816936676},
+		{A: 8.52e-09, B: 1.22749255198, C: 260879.03141574195},
+		{A: 6.41e-09, B: 4.87358642253, C: 53285.1848352418},
+		{A: 3.01e-09, B: 1.9609892443, C: 51066.427731055},
+		{A: 3.06e-09, B: 5.03912693671, C: 24978.5245894808},
+		{A: 2.84e-09, B: 4.25874901943, C: 286966.9345573162},
+		{A: 2.35e-09, B: 3.14549432371, C: 51116.4243529592},
+		{A: 2.32e-09, B: 4.22847849119, C: 31749.2351907264},
+		{A: 1.86e-09, B: 5.05207772442, C: 77154.33087262919},
+		{A: 1.43e-09, B: 5.71074961492, C: 21535.9496445154},
+		{A: 1.14e-09, B: 5.6223246077, C: 27043.5028831828},
+		{A: 1.23e-09, B: 1.51066137419, C: 79373.087976816},
+		{A: 9.3e-10, B: 1.83776226489, C: 103242.2340142034},
+		{A: 8.1e-10, B: 0.85802178989, C: 57837.1383323006},
+		{A: 7.9e-10, B: 5.50027152752, C: 47623.8527860896},
+		{A: 9.3e-10, B: 1.01040170383, C: 313054.83769889036},
+		{A: 5.8e-10, B: 2.35944147105, C: 53131.406024757},
+		{A: 7.4e-10, B: 2.28645155343, C: 50586.73338786459},
+		{A: 4.9e-10, B: 1.7819375356, C: 28306.66024576099},
+		{A: 5.8e-10, B: 5.88387132512, C: 77204.32749453338},
+		{A: 6e-10, B: 0.54679388547, C: 37410.5672398786},
+		{A: 4.6e-10, B: 5.77182292982, C: 529.6909650946},
+		{A: 5.2e-10, B: 3.58488737294, C: 25558.2121764796},
+		{A: 6.1e-10, B: 1.64891174657, C: 20426.571092422},
+		{A: 4.3e-10, B: 6.15538236294, C: 51646.11531805379},
+		{A: 4.2e-10, B: 4.89875940528, C: 129330.13715577759},
+		{A: 3.6e-10, B: 5.61094806248, C: 22645.32819660879},
+		{A: 3.3e-10, B: 4.34189175312, C: 32858.61374281979},
+		{A: 3.7e-10, B: 4.50512068847, C: 26107.57290247399},
+		{A: 3.2e-10, B: 1.99466664427, C: 25132.3033999656},
+		{A: 3e-10, B: 0.7660773863, C: 5661.3320491522},
+		{A: 2.7e-10, B: 4.8718083816, C: 54394.56338733519},
+		{A: 2.9e-10, B: 5.66168505554, C: 26068.2333806744},
+		{A: 2.5e-10, B: 3.31115218239, C: 1059.3819301892},
+		{A: 2.9e-10, B: 2.70280425915, C: 41962.5207369374},
+		{A: 2.9e-10, B: 3.56940606994, C: 63498.47038145279},
+		{A: 2.9e-10, B: 4.01947170286, C: 339142.7408404646},
+		{A: 2.8e-10, B: 0.80200569507, C: 39609.6545831656},
+		{A: 2.3e-10, B: 5.30820497885, C: 23869.1460373874},
+		{A: 2.4e-10, B: 4.8636944533, C: 46514.4742339962},
+		{A: 2.7e-10, B: 3.32081057071, C: 10213.285546211},
+		{A: 3e-10, B: 1.15631768661, C: 26084.0218062162},
+		{A: 2.4e-10, B: 2.71284750937, C: 26091.7844769322},
+		{A: 2.1e-10, B: 5.85119251529, C: 25874.6040461362},
+		{A: 2.6e-10, B: 3.11971794265, C: 43071.8992890308},
+		{A: 2.3e-10, B: 1.1425826685, C: 58946.51688439399},
+		{A: 2.1e-10, B: 4.78965226125, C: 51220.20654153979},
+		{A: 2.5e-10, B: 5.17153436907, C: 76674.63652943878},
+		{A: 2e-10, B: 6.05659966832, C: 25028.521211385},
+		{A: 1.7e-10, B: 4.06375348164, C: 51535.90899683439},
+		{A: 1.9e-10, B: 2.26589689692, C: 72602.37737557039},
+		{A: 1.9e-10, B: 2.02802084878, C: 73711.75592766379},
+		{A: 2e-10, B: 2.44092663198, C: 51749.20809227239},
+		{A: 1.7e-10, B: 1.67702029587, C: 155418.04029735178},
+		{A: 1.8e-10, B: 2.11191340126, C: 26617.5941066688},
+		{A: 1.9e-10, B: 0.32685450395, C: 4551.9534970588},
+		{A: 1.6e-10, B: 4.0210564377, C: 105460.99111839019},
+		{A: 1.4e-10, B: 2.35860855509, C: 26080.78959457339},
+		{A: 1.3e-10, B: 3.28426450224, C: 25661.3049506982},
+		{A: 1.6e-10, B: 2.09223320909, C: 103292.23063610759},
+		{A: 1.3e-10, B: 2.08475100066, C: 49957.0491789616},
+		{A: 1.4e-10, B: 5.66400816218, C: 48733.23133818299},
+		{A: 1.3e-10, B: 1.65023710844, C: 26095.016688575},
+		{A: 1.5e-10, B: 5.43792291298, C: 25973.46385288896},
+		{A: 1.2e-10, B: 5.15541566845, C: 79219.3091663312},
+		{A: 1.2e-10, B: 2.93766606728, C: 33326.5787331742},
+		{A: 1.1e-10, B: 5.16599347976, C: 15874.6175953632},
+		{A: 1.5e-10, B: 3.49521469034, C: 83925.0414738748},
+		{A: 1.2e-10, B: 1.33980027148, C: 77308.10968311399},
+		{A: 1.2e-10, B: 2.74476655782, C: 19317.1925403286},
+		{A: 1.4e-10, B: 5.48457159802, C: 98690.28051714458},
+		{A: 1.2e-10, B: 6.17629016531, C: 36301.18868778519},
+		{A: 1.2e-10, B: 5.16549673414, C: 1589.0728952838},
+		{A: 1e-10, B: 3.19718718931, C: 24498.8302462904},
+		{A: 1e-10, B: 3.10957426655, C: 16983.9961474566},
+		{A: 1e-10, B: 2.67818215946, C: 77734.01845962799},
+		{A: 1.2e-10, B: 1.64438976704, C: 26202.34243025941},
+		{A: 1.1e-10, B: 6.12421691815, C: 52705.49724824299},
+		{A: 1e-10, B: 5.42117419251, C: 14765.2390432698},
+		{A: 1.1e-10, B: 1.59577581724, C: 50057.04242277},
+		{A: 1.2e-10, B: 2.44342322463, C: 213.299095438},
+		{A: 1.1e-10, B: 4.59333229552, C: 56727.7597802072},
+		{A: 8e-11, B: 2.22267415318, C: 6283.0758499914},
+		{A: 1e-10, B: 3.63881306668, C: 65697.55772473979},
+		{A: 8e-11, B: 0.85708473896, C: 365230.6439820388},
+		{A: 1e-10, B: 3.56346926379, C: 53235.18821333759},
+		{A: 9e-11, B: 3.03935867498, C: 78793.40038981718},
+		{A: 8e-11, B: 2.05186284069, C: 30639.856638633},
+		{A: 8e-11, B: 6.07959531374, C: 59414.4818747484},
+		{A: 7e-11, B: 1.48753095657, C: 29530.4780865396},
+		{A: 7e-11, B: 0.54370332724, C: 38519.945791972},
+		{A: 8e-11, B: 5.99792913268, C: 7.1135470008},
+		{A: 9e-11, B: 6.08426293157, C: 69159.80243060499},
+		{A: 7e-11, B: 1.3551301451, C: 82815.66292178139},
+		{A: 8e-11, B: 3.00335450338, C: 40853.142184844},
+		{A: 7e-11, B: 3.40544433462, C: 1066.49547719},
+		{A: 7e-11, B: 5.80782632115, C: 45405.0956819028},
+		{A: 7e-11, B: 3.82318715536, C: 1052.2683831884},
+		{A: 6e-11, B: 4.57200111034, C: 55618.3812281138},
+		{A: 6e-11, B: 4.85942618671, C: 52168.69273614759},
+		{A: 8e-11, B: 0.34211699258, C: 79323.09135491178},
+		{A: 6e-11, B: 0.6782367506, C: 110012.94461544899},
+		{A: 7e-11, B: 4.12285655199, C: 45892.73043315699},
+		{A: 7e-11, B: 5.41918774642, C: 1109.3785520934},
+		{A: 7e-11, B: 3.14407932401, C: 51962.5071877104},
+		{A: 6e-11, B: 5.13879522396, C: 76044.9523205358},
+		{A: 7e-11, B: 2.06447590782, C: 124778.18365871879},
+		{A: 6e-11, B: 1.61443478349, C: 3442.5749449654},
+		{A: 5e-11, B: 2.013597281, C: 27676.976036858},
+		{A: 7e-11, B: 5.49596353318, C: 23754.70674870219},
+		{A: 6e-11, B: 0.7144527205, C: 27147.28507176339},
+		{A: 5e-11, B: 5.5815366372, C: 68050.42387851159},
+		{A: 6e-11, B: 4.72387999717, C: 52290.24557183361},
+		{A: 5e-11, B: 1.65565332809, C: 80482.46652890938},
+		{A: 5e-11, B: 4.67655631038, C: 181505.94343892598},
+		{A: 5e-11, B: 5.35730277715, C: 12566.1516999828},
+		{A: 5e-11, B: 2.24953488234, C: 52061.36699446317},
+		{A: 4e-11, B: 1.93085125724, C: 52156.1365222486},
+		{A: 6e-11, B: 5.23350745087, C: 129380.13377768178},
+		{A: 5e-11, B: 6.27908880897, C: 66941.04532641819},
+		{A: 6e-11, B: 0.86124028508, C: 52195.47604404819},
+		{A: 4e-11, B: 0.23100295125, C: 89586.37352302698},
+		{A: 5e-11, B: 4.23944686799, C: 103396.01282468818},
+		{A: 4e-11, B: 0.29398633221, C: 51109.31080595839},
+		{A: 5e-11, B: 0.38451698643, C: 955.5997416086},
+		{A: 5e-11, B: 5.7581296517, C: 104881.30353139139},
+		{A: 5e-11, B: 3.78671774559, C: 52171.9249477904},
+		{A: 4e-11, B: 5.32828531774, C: 25448.00585526019},
+		{A: 5e-11, B: 3.20016226905, C: 426.598190876},
+		{A: 5e-11, B: 3.86489045633, C: 9103.9069941176},
+		{A: 4e-11, B: 0.62543334322, C: 11322.6640983044},
+		{A: 4e-11, B: 6.07508617692, C: 2118.7638603784},
+		{A: 4e-11, B: 2.43638238426, C: 74821.13447975718},
+		{A: 4e-11, B: 5.29735530203, C: 52179.6876185064},
+		{A: 3e-11, B: 3.19112063073, C: 13521.7514415914},
+		{A: 4e-11, B: 5.09850398144, C: 77837.11123384659},
+		{A: 4e-11, B: 1.81128771043, C: 102762.53967101299},
+		{A: 4e-11, B: 2.92685125689, C: 639.897286314},
+		{A: 4e-11, B: 4.37998582536, C: 52182.9198301492},
+		{A: 4e-11, B: 0.905624683, C: 77623.81213840858},
+		{A: 4e-11, B: 3.40653389629, C: 105410.99449648599},
+		{A: 4e-11, B: 5.56139758889, C: 26301.2022370122},
+		{A: 3e-11, B: 3.84817111044, C: 2218.7571041868},
+		{A: 4e-11, B: 3.40685398189, C: 52389.1053785864},
+		{A: 3e-11, B: 3.64497587259, C: 64607.84893354619},
+		{A: 3e-11, B: 4.90907522926, C: 99799.65906923798},
+		{A: 3e-11, B: 0.33551297325, C: 13655.8604911764},
+		{A: 3e-11, B: 4.38738526963, C: 108903.56606335558},
+		{A: 3e-11, B: 2.7199654281, C: 38654.05484155699},
+		{A: 3e-11, B: 5.2163956913, C: 150866.08680029298},
+		{A: 3e-11, B: 5.79669813464, C: 131548.89425996438},
+		{A: 3e-11, B: 4.24481036893, C: 136100.84775702318},
+		{A: 3e-11, B: 4.74285528385, C: 53764.8791784322},
+		{A: 3e-11, B: 5.12230469501, C: 32370.9789915656},
+		{A: 3e-11, B: 4.9197929909, C: 18849.2275499742},
+		{A: 3e-11, B: 1.21305125502, C: 105307.21230790539},
+		{A: 2e-11, B: 2.6174140723, C: 71492.99882347698},
+		{A: 2e-11, B: 5.29927753161, C: 64741.95798313119},
+		{A: 2e-11, B: 2.59250638038, C: 155468.036919256},
+		{A: 2e-11, B: 5.60083003625, C: 26514.5013324502},
+		{A: 3e-11, B: 4.14284031224, C: 85034.42002596818},
+		{A: 2e-11, B: 3.04958336441, C: 93028.94846799239},
+		{A: 3e-11, B: 6.16142033746, C: 78477.00852016058},
+		{A: 3e-11, B: 4.49779099, C: 76144.94556434419},
+		{A: 2e-11, B: 2.74114399659, C: 130969.20667296558},
+		{A: 2e-11, B: 6.18606575651, C: 51123.53789995999},
+		{A: 3e-11, B: 1.68659744145, C: 28421.0995344462},
+		{A: 2e-11, B: 1.39891525246, C: 81706.28436968799},
+		{A: 2e-11, B: 1.36487993355, C: 79852.78232000639},
+		{A: 2e-11, B: 0.86961235954, C: 129483.91596626239},
+		{A: 2e-11, B: 0.16105302705, C: 25021.4076643842},
+		{A: 2e-11, B: 0.20969999844, C: 19804.8272915828},
+		{A: 2e-11, B: 0.99320491085, C: 162188.75089859738},
+		{A: 2e-11, B: 3.94039306142, C: 391318.54712361295},
+		{A: 2e-11, B: 1.80783236802, C: 23969.1392811958},
+		{A: 2e-11, B: 5.1209935622, C: 51322.60990139639},
+		{A: 2e-11, B: 2.80140642811, C: 62389.09182935939},
+		{A: 2e-11, B: 2.60965333457, C: 52602.4044740244},
+		{A: 2e-11, B: 2.72257468038, C: 7994.5284420242},
+		{A: 2e-11, B: 5.84222491614, C: 103821.92160120218},
+		{A: 2e-11, B: 6.0451587872, C: 25035.6347583858},
+		{A: 1e-11, B: 2.34997564292, C: 85502.38501632259},
+		{A: 1e-11, B: 0.17409967102, C: 131498.89763806018},
+		{A: 1e-11, B: 3.11496551907, C: 25934.1243310894},
+		{A: 2e-11, B: 5.12532278095, C: 78267.59076008058},
+		{A: 1e-11, B: 5.49175475674, C: 104331.94280539699},
+		{A: 1e-11, B: 0.44808516059, C: 78050.41032928458},
+		{A: 2e-11, B: 1.92656028088, C: 102132.85546210999},
+		{A: 2e-11, B: 1.93198050022, C: 853.196381752},
+		{A: 1e-11, B: 2.42972717822, C: 94138.32702008578},
+		{A: 2e-11, B: 5.13550087739, C: 6770.7106012456},
+		{A: 1e-11, B: 4.6544150087, C: 78259.82808936459},
+		{A: 1e-11, B: 3.9968745549, C: 188276.6540401716},
+		{A: 1e-11, B: 4.3906969233, C: 104371.28232719658},
+		{A: 1e-11, B: 1.64083661387, C: 78283.37918562238},
+		{A: 1e-11, B: 1.50558734911, C: 78378.1487134078},
+		{A: 2e-11, B: 6.27597485867, C: 91785.46086631398},
+		{A: 1e-11, B: 4.00786092689, C: 78244.0396638228},
+		{A: 1e-11, B: 1.70741856509, C: 28206.6670019526},
+		{A: 1e-11, B: 5.31900403501, C: 78149.27013603736},
+		{A: 1e-11, B: 1.40205887414, C: 44937.1306915484},
+		{A: 1e-11, B: 1.03752853909, C: 104347.73123093879},
+		{A: 1e-11, B: 0.66520680194, C: 419.4846438752},
+		{A: 1e-11, B: 2.46437105095, C: 104355.49390165479},
+		{A: 1e-11, B: 0.81835364761, C: 35191.8101356918},
+		{A: 1e-11, B: 0.3378067084, C: 88476.99497093359},
+		{A: 1e-11, B: 6.0834623193, C: 70269.18098269838},
+		{A: 1e-11, B: 1.86810476133, C: 125887.56221081219},
+		{A: 1e-11, B: 2.1046897466, C: 25619.9381512198},
+		{A: 1e-11, B: 3.99494842792, C: 71025.0338331226},
+		{A: 1e-11, B: 0.57714874874, C: 71980.63357473118},
+		{A: 1e-11, B: 5.66312942513, C: 97580.90196505119},
+		{A: 1e-11, B: 3.06688416266, C: 129909.82474277639},
+		{A: 1e-11, B: 5.66709359706, C: 433.7117378768},
+		{A: 1e-11, B: 0.91634007063, C: 103925.01437542078},
+		{A: 1e-11, B: 6.02359186416, C: 181555.94006083018},
+		{A: 1e-11, B: 1.44980129997, C: 26727.8004278882},
+		{A: 1e-11, B: 1.4544292039, C: 207593.8465805002},
+		{A: 1e-11, B: 1.12941846578, C: 60055.89543648739},
+		{A: 1e-11, B: 1.16964771504, C: 134991.4692049298},
+	},
+	// B3, 53 terms
+	{
+		{A: 2.35423e-06, B: 0.35387524604, C: 26087.9031415742},
+		{A: 1.60537e-06, B: 0, C: 0},
+		{A: 1.8904e-07, B: 4.36275460261, C: 52175.8062831484},
+		{A: 6.376e-08, B: 2.50715381439, C: 78263.70942472259},
+		{A: 4.58e-08, B: 6.14257817571, C: 104351.61256629678},
+		{A: 3.061e-08, B: 3.12497552681, C: 130439.51570787099},
+		{A: 1.732e-08, B: 6.26642412058, C: 156527.41884944518},
+		{A: 8.57e-09, B: 3.07673166705, C: 182615.3219910194},
+		{A: 3.84e-09, B: 6.14815319932, C: 208703.2251325936},
+		{A: 1.59e-09, B: 2.9243737832, C: 234791.12827416777},
+		{A: 6.2e-10, B: 5.97292432097, C: 260879.03141574195},
+		{A: 5.4e-10, B: 3.31612529961, C: 27197.2816936676},
+		{A: 2.3e-10, B: 2.74287679452, C: 286966.9345573162},
+		{A: 1.7e-10, B: 0.77798463435, C: 24978.5245894808},
+		{A: 1.2e-10, B: 6.24116133415, C: 53285.1848352418},
+		{A: 8e-11, B: 5.79551081392, C: 313054.83769889036},
+		{A: 6e-11, B: 5.81555175073, C: 31749.2351907264},
+		{A: 4e-11, B: 4.67212195693, C: 51116.4243529592},
+		{A: 4e-11, B: 3.36833718999, C: 77154.33087262919},
+		{A: 3e-11, B: 0.26522113546, C: 103242.2340142034},
+		{A: 3e-11, B: 0.79794705473, C: 27043.5028831828},
+		{A: 4e-11, B: 0.82832725003, C: 21535.9496445154},
+		{A: 2e-11, B: 2.57068697582, C: 339142.7408404646},
+		{A: 2e-11, B: 1.8230939704, C: 1109.3785520934},
+		{A: 2e-11, B: 0.74986942696, C: 47623.8527860896},
+		{A: 2e-11, B: 3.61692083154, C: 50586.73338786459},
+		{A: 2e-11, B: 4.70766241226, C: 51066.427731055},
+		{A: 1e-11, B: 3.3368854909, C: 129330.13715577759},
+		{A: 1e-11, B: 2.64641192612, C: 57837.1383323006},
+		{A: 1e-11, B: 4.93247555987, C: 25558.2121764796},
+		{A: 2e-11, B: 5.00344393497, C: 20426.571092422},
+		{A: 2e-11, B: 4.80841415889, C: 25028.521211385},
+		{A: 2e-11, B: 5.85039338716, C: 1059.3819301892},
+		{A: 1e-11, B: 1.85116662745, C: 37410.5672398786},
+		{A: 2e-11, B: 3.97317842825, C: 25132.3033999656},
+		{A: 1e-11, B: 2.144942913, C: 79373.087976816},
+		{A: 1e-11, B: 2.53223701416, C: 46514.4742339962},
+		{A: 1e-11, B: 5.8492163243, C: 5661.3320491522},
+		{A: 1e-11, B: 3.81958470276, C: 53131.406024757},
+		{A: 1e-11, B: 1.05410669937, C: 1589.0728952838},
+		{A: 1e-11, B: 1.39436798574, C: 51646.11531805379},
+		{A: 1e-11, B: 0.36358097174, C: 22645.32819660879},
+		{A: 1e-11, B: 2.7660997227, C: 28306.66024576099},
+		{A: 1e-11, B: 3.67689055309, C: 4551.9534970588},
+		{A: 1e-11, B: 5.39398540975, C: 32858.61374281979},
+		{A: 1e-11, B: 3.7357912717, C: 24498.8302462904},
+		{A: 1e-11, B: 0.60289607514, C: 26068.2333806744},
+		{A: 1e-11, B: 5.78167324673, C: 26107.57290247399},
+		{A: 1e-11, B: 3.81913361795, C: 26091.7844769322},
+		{A: 1e-11, B: 5.64455351094, C: 365230.6439820388},
+		{A: 1e-11, B: 4.87981157471, C: 10213.285546211},
+		{A: 1e-11, B: 2.44816565966, C: 26084.0218062162},
+		{A: 0, B: 3.45284067711, C: 105460.99111839019},
+	},
+	// B4, 15 terms
+	{
+		{A: 4.276e-08, B: 1.74579932115, C: 26087.9031415742},
+		{A: 1.023e-08, B: 3.14159265359, C: 0},
+		{A: 4.25e-09, B: 4.03419509143, C: 52175.8062831484},
+		{A: 2.57e-09, B: 0.20643590425, C: 78263.70942472259},
+		{A: 1.16e-09, B: 3.75237354024, C: 104351.61256629678},
+		{A: 7.3e-10, B: 1.18210375402, C: 130439.51570787099},
+		{A: 5.1e-10, B: 4.54581086194, C: 156527.41884944518},
+		{A: 3.1e-10, B: 1.44226942756, C: 182615.3219910194},
+		{A: 1.6e-10, B: 4.56372679459, C: 208703.2251325936},
+		{A: 8e-11, B: 1.34684622635, C: 234791.12827416777},
+		{A: 3e-11, B: 4.40269422669, C: 260879.03141574195},
+		{A: 1e-11, B: 4.69096566687, C: 27197.2816936676},
+		{A: 1e-11, B: 1.16082903756, C: 286966.9345573162},
+		{A: 0, B: 1.44918128781, C: 24978.5245894808},
+		{A: 0, B: 4.1804627869, C: 313054.83769889036},
+	},
+	// B5, 10 terms
+	{
+		{A: 1.06e-09, B: 3.94555784256, C: 26087.9031415742},
+		{A: 7.5e-10, B: 3.14159265359, C: 0},
+		{A: 2.2e-10, B: 1.30514874546, C: 52175.8062831484},
+		{A: 7e-11, B: 4.99717136857, C: 78263.70942472259},
+		{A: 4e-11, B: 2.05662545278, C: 104351.61256629678},
+		{A: 2e-11, B: 5.43162058632, C: 130439.51570787099},
+		{A: 1e-11, B: 2.68052517331, C: 156527.41884944518},
+		{A: 1e-11, B: 6.00841870284, C: 182615.3219910194},
+		{A: 0, B: 2.85941734701, C: 208703.2251325936},
+		{A: 0, B: 5.96962108614, C: 234791.12827416777},
+	},
+}
+
+// GetMercuryB0 evaluates the t^0 series of B.
+func GetMercuryB0(t float64) float64 {
+	return mercuryB[0].Evaluate(t)
+}
+
+// GetMercuryB1 evaluates the t^1 series of B.
+func GetMercuryB1(t float64) float64 {
+	return mercuryB[1].Evaluate(t)
+}
+
+// GetMercuryB2 evaluates the t^2 series of B.
+func GetMercuryB2(t float64) float64 {
+	return mercuryB[2].Evaluate(t)
+}
+
+// GetMercuryB3 evaluates the t^3 series of B.
+func GetMercuryB3(t float64) float64 {
+	return mercuryB[3].Evaluate(t)
+}
+
+// GetMercuryB4 evaluates the t^4 series of B.
+func GetMercuryB4(t float64) float64 {
+	return mercuryB[4].Evaluate(t)
+}
+
+// GetMercuryB5 evaluates the t^5 series of B.
+func GetMercuryB5(t float64) float64 {
+	return mercuryB[5].Evaluate(t)
+}
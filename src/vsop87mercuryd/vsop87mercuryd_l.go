@@ -0,0 +1,2867 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// MERCURY L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87mercuryd
+
+import "vsop87"
+
+var mercuryL = []vsop87.Series{
+	// L0, 1380 terms
+	{
+		{A: 4.40250710144, B: 0, C: 0},
+		{A: 0.40989414976, B: 1.48302034194, C: 26087.9031415742},
+		{A: 0.05046294199, B: 4.4778548954, C: 52175.8062831484},
+		{A: 0.00855346843, B: 1.16520322351, C: 78263.70942472259},
+		{A: 0.00165590362, B: 4.11969163181, C: 104351.61256629678},
+		{A: 0.00034561897, B: 0.77930765817, C: 130439.51570787099},
+		{A: 7.583476e-05, B: 3.7134840051, C: 156527.41884944518},
+		{A: 3.55974e-05, B: 1.51202669419, C: 1109.3785520934},
+		{A: 1.726012e-05, B: 0.35832239908, C: 182615.3219910194},
+		{A: 1.803463e-05, B: 4.1033317841, C: 5661.3320491522},
+		{A: 1.364682e-05, B: 4.59918318745, C: 27197.2816936676},
+		{A: 1.589923e-05, B: 2.99510417815, C: 25028.521211385},
+		{A: 1.017332e-05, B: 0.8803143904, C: 31749.2351907264},
+		{A: 7.14182e-06, B: 1.54144865265, C: 24978.5245894808},
+		{A: 6.43759e-06, B: 5.30266110787, C: 21535.9496445154},
+		{A: 4.042e-06, B: 3.28228847025, C: 208703.2251325936},
+		{A: 3.52441e-06, B: 5.24156297101, C: 20426.571092422},
+		{A: 3.43313e-06, B: 5.76531885335, C: 955.5997416086},
+		{A: 3.39214e-06, B: 5.86327765, C: 25558.2121764796},
+		{A: 4.51137e-06, B: 6.04989275289, C: 51116.4243529592},
+		{A: 3.25335e-06, B: 1.3367433478, C: 53285.1848352418},
+		{A: 2.59587e-06, B: 0.98732428184, C: 4551.9534970588},
+		{A: 3.45212e-06, B: 2.79211901539, C: 15874.6175953632},
+		{A: 2.72947e-06, B: 2.49451163975, C: 529.6909650946},
+		{A: 2.3483e-06, B: 0.266721189, C: 11322.6640983044},
+		{A: 2.38793e-06, B: 0.11343953378, C: 1059.3819301892},
+		{A: 2.64336e-06, B: 3.91705094013, C: 57837.1383323006},
+		{A: 2.16645e-06, B: 0.65987207348, C: 13521.7514415914},
+		{A: 1.83359e-06, B: 2.62878670784, C: 27043.5028831828},
+		{A: 1.75965e-06, B: 4.53636829858, C: 51066.427731055},
+		{A: 1.81629e-06, B: 2.43413502466, C: 25661.3049506982},
+		{A: 2.08995e-06, B: 2.09178234008, C: 47623.8527860896},
+		{A: 1.72643e-06, B: 2.45200164173, C: 24498.8302462904},
+		{A: 1.42316e-06, B: 3.36003948842, C: 37410.5672398786},
+		{A: 1.37942e-06, B: 0.29098447849, C: 10213.285546211},
+		{A: 1.18233e-06, B: 2.78149786369, C: 77204.32749453338},
+		{A: 9.686e-07, B: 6.2039820274, C: 234791.12827416777},
+		{A: 1.25219e-06, B: 3.72079804425, C: 39609.6545831656},
+		{A: 8.6819e-07, B: 2.64219349385, C: 51646.11531805379},
+		{A: 8.6723e-07, B: 1.9595304265, C: 46514.4742339962},
+		{A: 8.8329e-07, B: 5.41338795963, C: 26617.5941066688},
+		{A: 1.06422e-06, B: 4.20572116254, C: 19804.8272915828},
+		{A: 8.9987e-07, B: 5.85243631094, C: 41962.5207369374},
+		{A: 8.4971e-07, B: 4.33100364958, C: 79373.087976816},
+		{A: 6.9247e-07, B: 4.19446437496, C: 19.66976089979},
+		{A: 6.3463e-07, B: 3.14700877722, C: 7238.6755916},
+		{A: 6.8493e-07, B: 0.63424819267, C: 83925.0414738748},
+		{A: 6.9729e-07, B: 3.57201709671, C: 25132.3033999656},
+		{A: 5.9481e-07, B: 2.74692752, C: 16983.9961474566},
+		{A: 6.483e-07, B: 0.0476292581, C: 33326.5787331742},
+		{A: 5.5376e-07, B: 4.05312663019, C: 30639.856638633},
+		{A: 5.4442e-07, B: 3.14331542453, C: 27147.28507176339},
+		{A: 4.756e-07, B: 5.49722099211, C: 3.881335358},
+		{A: 4.9567e-07, B: 3.98985863874, C: 6770.7106012456},
+		{A: 5.6531e-07, B: 5.11920557675, C: 73711.75592766379},
+		{A: 4.1764e-07, B: 5.64185159566, C: 53131.406024757},
+		{A: 5.1458e-07, B: 5.47786463494, C: 50586.73338786459},
+		{A: 4.4744e-07, B: 1.22366857463, C: 77154.33087262919},
+		{A: 4.1882e-07, B: 5.19309298528, C: 6283.0758499914},
+		{A: 3.8045e-07, B: 2.43117327523, C: 12566.1516999828},
+		{A: 3.5627e-07, B: 0.81390126585, C: 32858.61374281979},
+		{A: 4.8007e-07, B: 5.49260554912, C: 51749.20809227239},
+		{A: 3.5392e-07, B: 3.36964859355, C: 36301.18868778519},
+		{A: 3.3951e-07, B: 2.78618091049, C: 14765.2390432698},
+		{A: 3.056e-07, B: 5.84045074182, C: 43071.8992890308},
+		{A: 3.5964e-07, B: 1.4238083863, C: 2218.7571041868},
+		{A: 3.4044e-07, B: 0.47470299167, C: 65697.55772473979},
+		{A: 3.08e-07, B: 5.77017310191, C: 103292.23063610759},
+		{A: 2.8496e-07, B: 0.65048992658, C: 426.598190876},
+		{A: 2.6215e-07, B: 5.24158618719, C: 22645.32819660879},
+		{A: 2.6253e-07, B: 0.64807043102, C: 1589.0728952838},
+		{A: 2.9538e-07, B: 0.69771244088, C: 213.299095438},
+		{A: 2.7504e-07, B: 0.98010127839, C: 45892.73043315699},
+		{A: 2.2347e-07, B: 5.65335125838, C: 77734.01845962799},
+		{A: 2.2047e-07, B: 4.93398225193, C: 72602.37737557039},
+		{A: 2.2275e-07, B: 2.17909842576, C: 52705.49724824299},
+		{A: 2.4252e-07, B: 4.39994170609, C: 7.1135470008},
+		{A: 2.6751e-07, B: 1.06145361792, C: 3442.5749449654},
+		{A: 2.3656e-07, B: 2.84168536986, C: 260879.03141574195},
+		{A: 2.2908e-07, B: 2.58462026514, C: 68050.42387851159},
+		{A: 2.7086e-07, B: 0.08501738669, C: 63498.47038145279},
+		{A: 2.2247e-07, B: 3.22418265191, C: 25448.00585526019},
+		{A: 1.7803e-07, B: 3.61202297483, C: 110012.94461544899},
+		{A: 2.2407e-07, B: 1.02520094825, C: 105460.99111839019},
+		{A: 1.7576e-07, B: 4.71742326981, C: 25874.6040461362},
+		{A: 1.8586e-07, B: 4.52709871258, C: 28306.66024576099},
+		{A: 1.4176e-07, B: 6.12394176563, C: 53235.18821333759},
+		{A: 1.4186e-07, B: 5.14246797066, C: 26068.2333806744},
+		{A: 1.7244e-07, B: 0.28394746813, C: 51220.20654153979},
+		{A: 1.7176e-07, B: 3.26084092971, C: 153.7788104848},
+		{A: 1.4938e-07, B: 1.83542009339, C: 99799.65906923798},
+		{A: 1.3387e-07, B: 0.76564655407, C: 56727.7597802072},
+		{A: 1.3978e-07, B: 2.30193139916, C: 76674.63652943878},
+		{A: 1.4428e-07, B: 0.96646356501, C: 26107.57290247399},
+		{A: 1.199e-07, B: 6.20492907598, C: 18849.2275499742},
+		{A: 1.4381e-07, B: 1.90956715654, C: 23969.1392811958},
+		{A: 1.1233e-07, B: 2.04817126136, C: 32370.9789915656},
+		{A: 1.3392e-07, B: 4.51750784605, C: 26080.78959457339},
+		{A: 1.1632e-07, B: 2.3849686026, C: 79219.3091663312},
+		{A: 1.2412e-07, B: 2.22280944169, C: 77837.11123384659},
+		{A: 9.95e-08, B: 2.0459444888, C: 48733.23133818299},
+		{A: 9.803e-08, B: 2.26706433546, C: 26091.7844769322},
+		{A: 9.362e-08, B: 5.44291958209, C: 38654.05484155699},
+		{A: 9.747e-08, B: 3.83976857418, C: 26084.0218062162},
+		{A: 9.264e-08, B: 4.02987000812, C: 467.9649903544},
+		{A: 8.961e-08, B: 0.11062526114, C: 62389.09182935939},
+		{A: 1.1543e-07, B: 4.17789167759, C: 103242.2340142034},
+		{A: 1.1146e-07, B: 3.78292300417, C: 26301.2022370122},
+		{A: 9.677e-08, B: 2.98527809776, C: 59414.4818747484},
+		{A: 8.977e-08, B: 3.47888073089, C: 91785.46086631398},
+		{A: 9.664e-08, B: 5.77941968495, C: 25938.3399444396},
+		{A: 8.855e-08, B: 2.84672636028, C: 25035.6347583858},
+		{A: 8.181e-08, B: 5.77857196635, C: 40853.142184844},
+		{A: 8.033e-08, B: 2.45692824195, C: 129380.13377768178},
+		{A: 8.343e-08, B: 5.34499871294, C: 19317.1925403286},
+		{A: 7.425e-08, B: 4.7116033039, C: 6.62855890001},
+		{A: 9.001e-08, B: 6.23396256413, C: 25021.4076643842},
+		{A: 8.126e-08, B: 1.12294634635, C: 26095.016688575},
+		{A: 6.568e-08, B: 3.66248946629, C: 26514.5013324502},
+		{A: 7.038e-08, B: 3.99035923761, C: 71980.63357473118},
+		{A: 6.956e-08, B: 1.62821260299, C: 23869.1460373874},
+		{A: 7.595e-08, B: 0.18334396433, C: 12432.0426503978},
+		{A: 6.061e-08, B: 3.67044794062, C: 27676.976036858},
+		{A: 5.896e-08, B: 5.57171141866, C: 94138.32702008578},
+		{A: 5.716e-08, B: 5.18204203484, C: 78793.40038981718},
+		{A: 5.855e-08, B: 2.14311779301, C: 20760.4270331914},
+		{A: 5.681e-08, B: 1.60727624525, C: 98690.28051714458},
+		{A: 5.788e-08, B: 2.3546749239, C: 103821.92160120218},
+		{A: 5.121e-08, B: 3.77832929907, C: 58946.51688439399},
+		{A: 5.873e-08, B: 5.76210244486, C: 286966.9345573162},
+		{A: 5.858e-08, B: 6.12538452806, C: 26011.6370702986},
+		{A: 5.215e-08, B: 3.29186833997, C: 38519.945791972},
+		{A: 6e-08, B: 0.00057044073, C: 51535.90899683439},
+		{A: 4.647e-08, B: 0.29020584575, C: 136100.84775702318},
+		{A: 5.787e-08, B: 4.44783057272, C: 19406.6782881746},
+		{A: 5.908e-08, B: 4.12195491631, C: 29530.4780865396},
+		{A: 5.918e-08, B: 3.98930701135, C: 131548.89425996438},
+		{A: 5.728e-08, B: 3.02314979708, C: 89586.37352302698},
+		{A: 3.928e-08, B: 4.8143793369, C: 125887.56221081219},
+		{A: 3.929e-08, B: 2.48449041501, C: 69159.80243060499},
+		{A: 3.681e-08, B: 2.80180999964, C: 79323.09135491178},
+		{A: 4.498e-08, B: 1.50325539137, C: 51962.5071877104},
+		{A: 3.687e-08, B: 5.33717753698, C: 102762.53967101299},
+		{A: 3.497e-08, B: 1.85400531491, C: 52156.1365222486},
+		{A: 3.867e-08, B: 1.25354714671, C: 54394.56338733519},
+		{A: 4.459e-08, B: 4.88911997687, C: 50057.04242277},
+		{A: 3.396e-08, B: 3.73870967348, C: 82815.66292178139},
+		{A: 3.525e-08, B: 6.07665337319, C: 25934.1243310894},
+		{A: 3.492e-08, B: 1.28206984744, C: 52168.69273614759},
+		{A: 3.411e-08, B: 6.13976263434, C: 639.897286314},
+		{A: 4.395e-08, B: 3.2547591476, C: 77308.10968311399},
+		{A: 2.99e-08, B: 5.27323635392, C: 46848.3301747656},
+		{A: 3.218e-08, B: 5.20938751579, C: 103925.01437542078},
+		{A: 2.899e-08, B: 5.08451495112, C: 58458.88213313979},
+		{A: 3.708e-08, B: 0.02713701028, C: 26241.681952059},
+		{A: 2.991e-08, B: 2.92278339368, C: 44937.1306915484},
+		{A: 3.177e-08, B: 0.05753403864, C: 22747.2907148744},
+		{A: 3.163e-08, B: 5.38713552769, C: 105307.21230790539},
+		{A: 3.556e-08, B: 3.96231142071, C: 52195.47604404819},
+		{A: 2.787e-08, B: 0.54704419913, C: 52389.1053785864},
+		{A: 2.458e-08, B: 1.14904830408, C: 2333.196392872},
+		{A: 2.492e-08, B: 5.24922078791, C: 25668.418497699},
+		{A: 2.409e-08, B: 2.14208355028, C: 64741.95798313119},
+		{A: 2.509e-08, B: 0.61934630416, C: 85034.42002596818},
+		{A: 2.416e-08, B: 5.26204437192, C: 52179.6876185064},
+		{A: 2.296e-08, B: 3.09900451546, C: 88476.99497093359},
+		{A: 2.357e-08, B: 0.17742385045, C: 117873.36400788819},
+		{A: 3.012e-08, B: 0.83689268367, C: 129330.13715577759},
+		{A: 2.421e-08, B: 3.95913346049, C: 49.9966219042},
+		{A: 2.197e-08, B: 2.56129546358, C: 3340.6124266998},
+		{A: 2.402e-08, B: 0.55121112633, C: 52171.9249477904},
+		{A: 2.106e-08, B: 5.41271503337, C: 155468.036919256},
+		{A: 2.566e-08, B: 3.00606194044, C: 51109.31080595839},
+		{A: 2.392e-08, B: 0.17898534184, C: 103.0927742186},
+		{A: 2.737e-08, B: 1.08192567668, C: 632.7837393132},
+		{A: 2.523e-08, B: 5.90356438675, C: 51123.53789995999},
+		{A: 2.098e-08, B: 2.4713206914, C: 66941.04532641819},
+		{A: 2.003e-08, B: 3.75422326828, C: 16066.0658614748},
+		{A: 2.188e-08, B: 1.18654376903, C: 2118.7638603784},
+		{A: 2.593e-08, B: 2.55122032446, C: 25654.19140369739},
+		{A: 2.559e-08, B: 1.18101453315, C: 45494.58142974879},
+		{A: 1.918e-08, B: 0.79899605678, C: 26555.8681319286},
+		{A: 1.819e-08, B: 0.69178943674, C: 98068.53671630539},
+		{A: 2.471e-08, B: 2.55057179214, C: 52026.2430860138},
+		{A: 1.704e-08, B: 0.3672357774, C: 52602.4044740244},
+		{A: 2.129e-08, B: 2.66251948472, C: 18093.37469954999},
+		{A: 1.715e-08, B: 4.6217746471, C: 49957.0491789616},
+		{A: 1.916e-08, B: 5.8372646205, C: 85502.38501632259},
+		{A: 1.976e-08, B: 3.57365896777, C: 24395.7374720718},
+		{A: 1.581e-08, B: 0.36987184257, C: 53764.8791784322},
+		{A: 1.589e-08, B: 1.3353124195, C: 114.43928868521},
+		{A: 2.042e-08, B: 2.05385564074, C: 45405.0956819028},
+		{A: 1.539e-08, B: 2.25468618977, C: 120226.23016165999},
+		{A: 1.525e-08, B: 1.48231179113, C: 26094.53170047421},
+		{A: 2.055e-08, B: 4.16315644175, C: 52182.9198301492},
+		{A: 1.829e-08, B: 0.38846130335, C: 522.5774180938},
+		{A: 2.105e-08, B: 4.04128506778, C: 25234.70675982219},
+		{A: 1.48e-08, B: 1.87644604156, C: 104881.30353139139},
+		{A: 1.46e-08, B: 1.01790185557, C: 25455.119402261},
+		{A: 1.453e-08, B: 0.3412522219, C: 1052.2683831884},
+		{A: 1.449e-08, B: 3.39742463494, C: 24505.94379329119},
+		{A: 1.48e-08, B: 4.55094492082, C: 124778.18365871879},
+		{A: 1.481e-08, B: 3.62242942443, C: 149.5631971346},
+		{A: 1.516e-08, B: 5.3232780244, C: 129909.82474277639},
+		{A: 1.594e-08, B: 3.00887674765, C: 77623.81213840858},
+		{A: 1.927e-08, B: 3.90378108197, C: 74.7815985673},
+		{A: 1.373e-08, B: 4.32419381493, C: 80482.46652890938},
+		{A: 1.419e-08, B: 3.90109225299, C: 7880.08915333899},
+		{A: 1.525e-08, B: 4.62451312025, C: 26081.27458267419},
+		{A: 1.567e-08, B: 0.65981123945, C: 157636.79740153858},
+		{A: 1.725e-08, B: 4.40112128069, C: 316.3918696566},
+		{A: 1.263e-08, B: 4.96320745251, C: 74821.13447975718},
+		{A: 1.472e-08, B: 5.56006656586, C: 95247.70557217918},
+		{A: 1.315e-08, B: 1.83254115004, C: 76144.94556434419},
+		{A: 1.439e-08, B: 0.8328616637, C: 55618.3812281138},
+		{A: 1.355e-08, B: 3.15837061982, C: 1066.49547719},
+		{A: 1.224e-08, B: 3.24032680768, C: 162188.75089859738},
+		{A: 1.39e-08, B: 5.13745290383, C: 419.4846438752},
+		{A: 1.31e-08, B: 5.2825063668, C: 25619.9381512198},
+		{A: 1.477e-08, B: 2.40227455027, C: 313054.83769889036},
+		{A: 1.252e-08, B: 5.65227031449, C: 26013.1215430069},
+		{A: 1.143e-08, B: 0.38707356937, C: 42153.969003049},
+		{A: 1.082e-08, B: 2.56550728704, C: 26190.99591579279},
+		{A: 1.493e-08, B: 2.89973504649, C: 52099.5402118728},
+		{A: 1.468e-08, B: 5.7150779125, C: 24491.71669928959},
+		{A: 1.034e-08, B: 1.4954429444, C: 151975.46535238638},
+		{A: 1.314e-08, B: 5.91505351846, C: 115674.27666460119},
+		{A: 1.065e-08, B: 3.61081835123, C: 206.1855484372},
+		{A: 1.053e-08, B: 5.1799602632, C: 23754.70674870219},
+		{A: 9.64e-09, B: 2.05264296353, C: 128850.44281258718},
+		{A: 1.032e-08, B: 3.39114761061, C: 25551.09862947879},
+		{A: 1.065e-08, B: 3.38225524038, C: 25863.55834587229},
+		{A: 1.153e-08, B: 5.76498787983, C: 44181.27784112419},
+		{A: 9.6e-09, B: 5.74774250678, C: 105410.99449648599},
+		{A: 1.137e-08, B: 6.20919445956, C: 103396.01282468818},
+		{A: 9.14e-09, B: 4.28855434634, C: 78256.59587772179},
+		{A: 9.17e-09, B: 3.27543718918, C: 76.2660712756},
+		{A: 8.92e-09, B: 4.82397571946, C: 78244.0396638228},
+		{A: 9.76e-09, B: 4.86442946387, C: 25131.61398560359},
+		{A: 1.086e-08, B: 0.73424633411, C: 33967.99229491319},
+		{A: 1.162e-08, B: 4.52128441898, C: 78050.41032928458},
+		{A: 8.68e-09, B: 2.78848256837, C: 52022.0274726636},
+		{A: 9.43e-09, B: 4.0973685387, C: 26720.68688088739},
+		{A: 9.73e-09, B: 4.54018615747, C: 24925.4284371664},
+		{A: 8.45e-09, B: 5.05414707498, C: 25977.69682035479},
+		{A: 8.41e-09, B: 1.89412486884, C: 130012.91751699499},
+		{A: 9.2e-09, B: 3.02504494542, C: 52329.58509363319},
+		{A: 8.14e-09, B: 3.15330668609, C: 48835.19385644859},
+		{A: 8.76e-09, B: 0.41318857467, C: 108903.56606335558},
+		{A: 8.18e-09, B: 1.61477924287, C: 40565.2543247742},
+		{A: 1.074e-08, B: 1.37296024678, C: 23439.44831610119},
+		{A: 8.12e-09, B: 2.82156935191, C: 25984.8103673556},
+		{A: 7.75e-09, B: 2.86911510061, C: 26727.8004278882},
+		{A: 7.55e-09, B: 5.44067645183, C: 24609.0365675098},
+		{A: 9.21e-09, B: 4.7255790143, C: 14477.3511832},
+		{A: 9.14e-09, B: 4.70826349904, C: 6681.2248533996},
+		{A: 7.49e-09, B: 1.80279541026, C: 84546.78527471398},
+		{A: 9.07e-09, B: 0.64974692551, C: 78283.37918562238},
+		{A: 8.54e-09, B: 5.05058435764, C: 307.5576209696},
+		{A: 7.66e-09, B: 5.89942349817, C: 71025.0338331226},
+		{A: 7.13e-09, B: 3.55192633098, C: 78477.00852016058},
+		{A: 7.18e-09, B: 1.85061030321, C: 100909.03762133139},
+		{A: 8.58e-09, B: 5.43959629234, C: 536.8045120954},
+		{A: 7.94e-09, B: 3.77076518156, C: 155418.04029735178},
+		{A: 8.48e-09, B: 2.08790526575, C: 131395.11544947958},
+		{A: 6.22e-09, B: 3.14583461131, C: 143961.2671494624},
+		{A: 6.23e-09, B: 4.58424507963, C: 9103.9069941176},
+		{A: 6.23e-09, B: 5.10535773746, C: 90829.86112470538},
+		{A: 7.06e-09, B: 4.19554833142, C: 71582.48457132299},
+		{A: 7.38e-09, B: 0.15942404038, C: 24712.1293417284},
+		{A: 6.21e-09, B: 1.32834420218, C: 3328.13565628019},
+		{A: 7.14e-09, B: 1.88052234658, C: 2199.087343287},
+		{A: 6.78e-09, B: 6.01392909264, C: 77197.21394753258},
+		{A: 6.5e-09, B: 5.51227348491, C: 29428.515568274},
+		{A: 6.58e-09, B: 2.03299256393, C: 51756.3216392732},
+		{A: 5.93e-09, B: 6.06807779961, C: 114564.89811250778},
+		{A: 6.66e-09, B: 0.70706334861, C: 26162.6847401415},
+		{A: 5.97e-09, B: 5.15771478155, C: 333.8559407694},
+		{A: 6.16e-09, B: 1.94902289932, C: 78267.59076008058},
+		{A: 7.74e-09, B: 2.84206828086, C: 13655.8604911764},
+		{A: 6.98e-09, B: 5.60747842278, C: 51742.09454527159},
+		{A: 5.57e-09, B: 2.07529602574, C: 181555.94006083018},
+		{A: 5.47e-09, B: 3.17747066781, C: 90695.75207512038},
+		{A: 5.41e-09, B: 4.20816350943, C: 28421.0995344462},
+		{A: 6.39e-09, B: 5.55908134374, C: 78114.14622758799},
+		{A: 5.29e-09, B: 3.60209913515, C: 1911.1994832172},
+		{A: 6.2e-09, B: 4.87816246784, C: 25771.5112719176},
+		{A: 5.69e-09, B: 3.56250435364, C: 111122.32316754239},
+		{A: 5.1e-09, B: 4.2033182373, C: 28206.6670019526},
+		{A: 5.39e-09, B: 5.42639519551, C: 93028.94846799239},
+		{A: 6.59e-09, B: 3.45407389663, C: 26610.48055966799},
+		{A: 6.13e-09, B: 3.52133069078, C: 78259.82808936459},
+		{A: 5.94e-09, B: 1.16299449628, C: 26404.2950112308},
+		{A: 5.76e-09, B: 0.8161726673, C: 51322.60990139639},
+		{A: 4.97e-09, B: 5.37540191935, C: 26037.90651966999},
+		{A: 6.63e-09, B: 2.63246066036, C: 77211.44104153418},
+		{A: 4.87e-09, B: 1.96691664683, C: 26507.38778544939},
+		{A: 4.74e-09, B: 3.65243004234, C: 124156.43985787958},
+		{A: 5.5e-09, B: 5.81040334783, C: 1731.1223529326},
+		{A: 4.72e-09, B: 3.79351701945, C: 52643.7712735028},
+		{A: 4.67e-09, B: 4.50175920356, C: 110.2063212194},
+		{A: 5.93e-09, B: 3.04818872924, C: 12725.453434775},
+		{A: 5.52e-09, B: 0.68733268561, C: 26137.8997634784},
+		{A: 5.25e-09, B: 0.87988157932, C: 78270.82297172339},
+		{A: 6e-09, B: 0.33902061394, C: 50483.640613646},
+		{A: 4.43e-09, B: 3.33385484899, C: 78690.30761559859},
+		{A: 4.41e-09, B: 1.31034191525, C: 76044.9523205358},
+		{A: 5.88e-09, B: 2.37074288411, C: 121335.60871375339},
+		{A: 4.27e-09, B: 0.14810668871, C: 50593.84693486539},
+		{A: 4.13e-09, B: 3.33346664325, C: 79852.78232000639},
+		{A: 4.03e-09, B: 5.20705536949, C: 146314.13330323418},
+		{A: 5.24e-09, B: 5.02853960393, C: 71492.99882347698},
+		{A: 4.18e-09, B: 5.99113644745, C: 103711.71527998279},
+		{A: 3.88e-09, B: 4.44142037364, C: 29416.03879785439},
+		{A: 3.9e-09, B: 4.02400157761, C: 51543.0225438352},
+		{A: 4.42e-09, B: 2.37158178341, C: 111590.2881578968},
+		{A: 3.75e-09, B: 4.47565026408, C: 52182.4348420484},
+		{A: 3.87e-09, B: 4.83640155029, C: 130969.20667296558},
+		{A: 3.9e-09, B: 1.20415517897, C: 150866.08680029298},
+		{A: 4.16e-09, B: 3.60399872842, C: 183724.7005431128},
+		{A: 4.07e-09, B: 0.529385305, C: 433.7117378768},
+		{A: 4.39e-09, B: 2.45401498182, C: 50579.61984086379},
+		{A: 4.12e-09, B: 0.50186886239, C: 27999.1026247914},
+		{A: 3.91e-09, B: 0.68517124695, C: 134.109049585},
+		{A: 3.98e-09, B: 1.99594942261, C: 155997.72788435058},
+		{A: 3.46e-09, B: 3.40308148193, C: 27140.17152476259},
+		{A: 4.22e-09, B: 5.83293462268, C: 38813.3565763492},
+		{A: 3.42e-09, B: 0.83679406818, C: 25764.39772491679},
+		{A: 3.68e-09, B: 3.80659045949, C: 81706.28436968799},
+		{A: 3.55e-09, B: 1.99286044781, C: 1089.7087911936},
+		{A: 4.62e-09, B: 3.33451630664, C: 25440.89230825939},
+		{A: 3.3e-09, B: 0.37013669364, C: 26237.46633870879},
+		{A: 3.3e-09, B: 6.19331560111, C: 188276.6540401716},
+		{A: 4.07e-09, B: 2.17141736652, C: 641.41356173899},
+		{A: 3.21e-09, B: 5.0253200265, C: 102232.84870591838},
+		{A: 3.3e-09, B: 5.5308696608, C: 10021.8372800994},
+		{A: 3.36e-09, B: 2.49163362426, C: 25344.9130810416},
+		{A: 3.81e-09, B: 5.90928705861, C: 78187.44335344699},
+		{A: 3.16e-09, B: 2.42532836233, C: 52101.02468458109},
+		{A: 3.31e-09, B: 4.38897286971, C: 26202.34243025941},
+		{A: 4.01e-09, B: 1.99380933624, C: 49842.60989027639},
+		{A: 4.22e-09, B: 0.4655666994, C: 1.4844727083},
+		{A: 3.1e-09, B: 6.18819197073, C: 27154.3986187642},
+		{A: 3.02e-09, B: 4.68575942793, C: 25565.3257234804},
+		{A: 2.96e-09, B: 1.70818196194, C: 25973.46385288896},
+		{A: 3.75e-09, B: 5.31085497894, C: 339142.7408404646},
+		{A: 3.29e-09, B: 5.32609571265, C: 853.196381752},
+		{A: 2.99e-09, B: 5.81101886868, C: 1692.1656695024},
+		{A: 3.78e-09, B: 1.33745475273, C: 52169.17772424839},
+		{A: 2.93e-09, B: 4.43993559835, C: 579.6875869988},
+		{A: 2.76e-09, B: 4.45147472194, C: 178063.3684939606},
+		{A: 2.83e-09, B: 6.19860164015, C: 25881.717593137},
+		{A: 3.15e-09, B: 1.95956643364, C: 51707.84129279399},
+		{A: 3.37e-09, B: 4.21457120705, C: 34282.1784747828},
+		{A: 3.34e-09, B: 3.00354887525, C: 23866.04650697719},
+		{A: 2.68e-09, B: 1.3443749352, C: 35191.8101356918},
+		{A: 3.06e-09, B: 5.9444523141, C: 64607.84893354619},
+		{A: 2.83e-09, B: 0.96234220412, C: 1639.069517188},
+		{A: 2.87e-09, B: 1.28099267608, C: 51013.33157874059},
+		{A: 2.69e-09, B: 0.55818949975, C: 3462.2447058652},
+		{A: 2.74e-09, B: 5.61047457506, C: 52278.89905736699},
+		{A: 3.52e-09, B: 0.49071599781, C: 26294.08869001139},
+		{A: 3.58e-09, B: 1.23360990528, C: 1223.81784077861},
+		{A: 3.43e-09, B: 4.29256939583, C: 49527.35145767539},
+		{A: 2.55e-09, B: 5.03019684368, C: 154938.34595416137},
+		{A: 2.97e-09, B: 2.87172991159, C: 129483.91596626239},
+		{A: 2.52e-09, B: 0.09044630682, C: 13541.42120249119},
+		{A: 3.01e-09, B: 1.22789205624, C: 104138.31347085879},
+		{A: 2.74e-09, B: 3.67792692372, C: 68241.8721446232},
+		{A: 3.21e-09, B: 2.49914111769, C: 141762.17980617538},
+		{A: 2.9e-09, B: 1.02241335068, C: 8194.2753332086},
+		{A: 2.4e-09, B: 0.99284920524, C: 104344.49901929598},
+		{A: 2.78e-09, B: 0.53151576617, C: 949.1756089698},
+		{A: 2.49e-09, B: 2.39787134441, C: 131498.89763806018},
+		{A: 2.74e-09, B: 2.09560778105, C: 26624.70765366959},
+		{A: 2.4e-09, B: 3.14668052954, C: 23976.2528281966},
+		{A: 2.39e-09, B: 0.8519855924, C: 52808.59002246159},
+		{A: 2.66e-09, B: 0.18324709351, C: 2168.7604822826},
+		{A: 2.26e-09, B: 4.85701184113, C: 156100.82065856917},
+		{A: 2.28e-09, B: 2.19799888298, C: 50696.93970908399},
+		{A: 2.31e-09, B: 1.49522976308, C: 104331.94280539699},
+		{A: 2.45e-09, B: 4.68642861263, C: 5327.4761083828},
+		{A: 2.81e-09, B: 1.63579312063, C: 51219.51712717779},
+		{A: 2.19e-09, B: 5.75893553103, C: 78109.93061423779},
+		{A: 2.33e-09, B: 5.99853185125, C: 78417.48823520739},
+		{A: 2.27e-09, B: 6.02396548637, C: 1581.959348283},
+		{A: 2.18e-09, B: 5.42819370607, C: 18207.81398823521},
+		{A: 2.2e-09, B: 3.72017611382, C: 7994.5284420242},
+		{A: 2.71e-09, B: 0.20833686608, C: 51639.00177105299},
+		{A: 2.44e-09, B: 2.33639123341, C: 25138.7275326044},
+		{A: 2.54e-09, B: 5.46143104532, C: 621.7438008392},
+		{A: 2.09e-09, B: 0.01908528531, C: 26164.1692128498},
+		{A: 2.08e-09, B: 6.18336687237, C: 74923.09699802278},
+		{A: 2.74e-09, B: 0.1542389879, C: 51951.46148744649},
+		{A: 2.12e-09, B: 1.95557421945, C: 735.8765135318},
+		{A: 2.36e-09, B: 4.28368070837, C: 24079.34560241519},
+		{A: 2.06e-09, B: 5.86972946528, C: 52072.71350892979},
+		{A: 2.01e-09, B: 1.6122687707, C: 25241.820306823},
+		{A: 2.35e-09, B: 3.60446251088, C: 104371.28232719658},
+		{A: 2.29e-09, B: 3.66187363672, C: 1596.1864422846},
+		{A: 1.98e-09, B: 5.85298126352, C: 52815.7035694624},
+		{A: 2.2e-09, B: 1.39541547612, C: 32769.1279949738},
+		{A: 2.29e-09, B: 3.36265291664, C: 134991.4692049298},
+		{A: 1.92e-09, B: 1.03510456065, C: 25867.49049913539},
+		{A: 1.99e-09, B: 4.7812628927, C: 110634.68841628819},
+		{A: 1.91e-09, B: 4.2411099766, C: 14.2270940016},
+		{A: 2.1e-09, B: 5.44710702022, C: 147423.51185532758},
+		{A: 2.29e-09, B: 0.7626653182, C: 220.4126424388},
+		{A: 1.85e-09, B: 0.24752751791, C: 104564.91166173479},
+		{A: 2.11e-09, B: 1.8182667082, C: 52065.59996192899},
+		{A: 1.82e-09, B: 2.31946183497, C: 9384.8410080752},
+		{A: 2.07e-09, B: 0.77329376239, C: 917.9302859818},
+		{A: 1.9e-09, B: 0.91354241432, C: 97670.38771289718},
+		{A: 1.84e-09, B: 3.32299343484, C: 30.3268610044},
+		{A: 2.09e-09, B: 0.41150840785, C: 181505.94343892598},
+		{A: 2.25e-09, B: 5.06158596891, C: 157483.01859105378},
+		{A: 1.84e-09, B: 2.71529935065, C: 103285.11708910679},
+		{A: 1.91e-09, B: 1.96528922573, C: 314.1861798696},
+		{A: 1.82e-09, B: 4.30249539122, C: 1884.9011634174},
+		{A: 1.92e-09, B: 4.820978767, C: 126996.94076290558},
+		{A: 1.88e-09, B: 0.98150502881, C: 26049.7701059364},
+		{A: 1.84e-09, B: 0.6702869759, C: 106570.36967048359},
+		{A: 1.67e-09, B: 5.71084692524, C: 96357.08412427259},
+		{A: 1.84e-09, B: 2.33710037381, C: 77829.99768684579},
+		{A: 2.01e-09, B: 2.57648669602, C: 97112.93697469679},
+		{A: 1.76e-09, B: 3.20466399658, C: 39629.32434406539},
+		{A: 1.69e-09, B: 2.30516098722, C: 101.9625182656},
+		{A: 1.63e-09, B: 6.11134623776, C: 170049.1702910366},
+		{A: 1.95e-09, B: 5.18276528052, C: 99024.13645791399},
+		{A: 1.65e-09, B: 6.00583599609, C: 224.3447957019},
+		{A: 1.67e-09, B: 3.75645242744, C: 52250.5878817157},
+		{A: 1.72e-09, B: 4.28474997892, C: 25780.3455206046},
+		{A: 2.16e-09, B: 3.20425967752, C: 50800.03248330259},
+		{A: 1.57e-09, B: 1.61810971101, C: 24815.222115947},
+		{A: 1.63e-09, B: 1.77694235279, C: 116917.76426627958},
+		{A: 1.56e-09, B: 1.94644057334, C: 26421.7590823436},
+		{A: 1.64e-09, B: 3.8148761948, C: 77410.51304297059},
+		{A: 1.58e-09, B: 4.95279229085, C: 35472.7441496494},
+		{A: 1.5e-09, B: 5.03580916221, C: 207643.8432024044},
+		{A: 2.08e-09, B: 2.59008696784, C: 27819.0254945068},
+		{A: 1.8e-09, B: 5.62518388437, C: 103299.34418310839},
+		{A: 1.65e-09, B: 2.25945126594, C: 104202.04936916218},
+		{A: 1.48e-09, B: 3.94625893131, C: 24601.92302050899},
+		{A: 1.49e-09, B: 4.4976634152, C: 956.2891559706},
+		{A: 1.57e-09, B: 1.62995234425, C: 51859.41441349179},
+		{A: 1.66e-09, B: 0.21228205353, C: 52698.38370124219},
+		{A: 1.51e-09, B: 2.75061488385, C: 140652.80125408198},
+		{A: 1.61e-09, B: 1.72952408524, C: 2648.454825473},
+		{A: 1.69e-09, B: 2.61298183547, C: 26086.4186688659},
+		{A: 1.7e-09, B: 0.78905798191, C: 72936.23331633979},
+		{A: 1.77e-09, B: 5.06009562295, C: 77844.22478084739},
+		{A: 1.42e-09, B: 2.61984020842, C: 24292.64469785319},
+		{A: 1.5e-09, B: 4.93124201089, C: 28286.9904848612},
+		{A: 1.75e-09, B: 5.79468120941, C: 39743.7636327506},
+		{A: 1.63e-09, B: 3.37032055762, C: 76571.54375522019},
+		{A: 1.5e-09, B: 1.79967850988, C: 3.9321532631},
+		{A: 1.59e-09, B: 4.9036016347, C: 104355.49390165479},
+		{A: 1.49e-09, B: 4.20242344258, C: 52492.19815280499},
+		{A: 1.46e-09, B: 1.31203861446, C: 26198.1094627936},
+		{A: 1.62e-09, B: 2.25640253895, C: 55516.4187098482},
+		{A: 1.78e-09, B: 1.81205070225, C: 26395.46076254379},
+		{A: 1.32e-09, B: 0.90685777764, C: 54294.57014352679},
+		{A: 1.42e-09, B: 1.70641940798, C: 92741.06060792258},
+		{A: 1.38e-09, B: 0.642980433, C: 86143.79857806159},
+		{A: 1.25e-09, B: 0.32592941945, C: 150244.3429994538},
+		{A: 1.31e-09, B: 0.91963253985, C: 54509.0026760204},
+		{A: 1.44e-09, B: 4.17124383409, C: 24822.3356629478},
+		{A: 1.35e-09, B: 3.86821614788, C: 104358.72611329758},
+		{A: 1.59e-09, B: 4.72016316702, C: 4083.9885067044},
+		{A: 1.22e-09, B: 2.0813340737, C: 52125.80966124419},
+		{A: 1.41e-09, B: 2.08765477131, C: 119116.85160956658},
+		{A: 1.22e-09, B: 5.01211051965, C: 52595.29092702359},
+		{A: 1.49e-09, B: 0.38744219837, C: 487.6347512542},
+		{A: 1.21e-09, B: 3.30215904574, C: 1263.15736257819},
+		{A: 1.38e-09, B: 1.04671626049, C: 1083.0802322936},
+		{A: 1.57e-09, B: 5.17018916622, C: 23962.02573419499},
+		{A: 1.63e-09, B: 3.26593103179, C: 26089.38761428249},
+		{A: 1.3e-09, B: 0.56779128434, C: 25936.85547173129},
+		{A: 1.6e-09, B: 0.19385295261, C: 104347.73123093879},
+		{A: 1.16e-09, B: 2.69818513122, C: 129799.61842155698},
+		{A: 1.13e-09, B: 4.26323214388, C: 102132.85546210999},
+		{A: 1.15e-09, B: 1.35196569742, C: 75615.25459924959},
+		{A: 1.35e-09, B: 1.70032124143, C: 97580.90196505119},
+		{A: 1.21e-09, B: 0.20372434486, C: 38.1330356378},
+		{A: 1.18e-09, B: 0.49434134122, C: 78731.674415077},
+		{A: 1.19e-09, B: 5.5456272589, C: 76667.52298243798},
+		{A: 1.33e-09, B: 4.67446352847, C: 2014.9816717978},
+		{A: 1.26e-09, B: 6.24694138116, C: 636.9962720242},
+		{A: 1.2e-09, B: 3.20442216756, C: 76681.75007643958},
+		{A: 1.25e-09, B: 3.65255045892, C: 29550.14784743939},
+		{A: 1.18e-09, B: 5.26052652437, C: 26729.31670331319},
+		{A: 1.13e-09, B: 6.26688921593, C: 104778.21075717278},
+		{A: 1.34e-09, B: 1.56524046563, C: 25939.82441714789},
+		{A: 1.14e-09, B: 3.52566774494, C: 24356.7807886416},
+		{A: 1.2e-09, B: 1.72596426067, C: 22759.76748529401},
+		{A: 1.45e-09, B: 3.6163842157, C: 52225.8029050526},
+		{A: 1.07e-09, B: 5.72285490406, C: 24176.703658357},
+		{A: 1.12e-09, B: 0.28882268029, C: 209812.60368468694},
+		{A: 1.21e-09, B: 0.11234419157, C: 51528.79544983359},
+		{A: 1.2e-09, B: 4.17769370414, C: 25754.0472008048},
+		{A: 1.05e-09, B: 1.88137155058, C: 172402.0364448084},
+		{A: 1.06e-09, B: 6.27133163237, C: 105940.68546158058},
+		{A: 1.04e-09, B: 3.850613951, C: 1478.8665740644},
+		{A: 1.08e-09, B: 2.96292200098, C: 1375.7737998458},
+		{A: 1.32e-09, B: 4.60210460022, C: 11610.5519583742},
+		{A: 1.14e-09, B: 5.20612177122, C: 137678.191299471},
+		{A: 1.07e-09, B: 0.00557717441, C: 13362.4497067992},
+		{A: 1.01e-09, B: 0.73934409276, C: 77630.92568540938},
+		{A: 9.7e-10, B: 1.19155686364, C: 78270.3379836226},
+		{A: 1e-09, B: 0.25974575144, C: 160.40736938481},
+		{A: 1.05e-09, B: 0.03739853961, C: 180.0771302846},
+		{A: 1.14e-09, B: 5.13609864972, C: 1135.6768718932},
+		{A: 1.01e-09, B: 4.1432325844, C: 176953.98994186718},
+		{A: 9.5e-10, B: 0.49488385937, C: 107794.1875112622},
+		{A: 9.5e-10, B: 1.54869651436, C: 25973.50403466079},
+		{A: 1e-09, B: 1.51228088427, C: 157057.10981453978},
+		{A: 1.06e-09, B: 4.57237246234, C: 26073.67604757259},
+		{A: 9.4e-10, B: 3.53697338405, C: 26521.614879451},
+		{A: 9.1e-10, B: 3.13017596287, C: 64901.25971792339},
+		{A: 8.8e-10, B: 5.20234335205, C: 1485.2907067032},
+		{A: 1.16e-09, B: 5.96693811684, C: 24182.4383766338},
+		{A: 9.9e-10, B: 2.1200254924, C: 66653.15746634839},
+		{A: 1.05e-09, B: 4.04764282865, C: 27726.9726587622},
+		{A: 9.4e-10, B: 2.62198655221, C: 104275.34649502118},
+		{A: 1.06e-09, B: 2.06629215696, C: 125112.03959948818},
+		{A: 8.8e-10, B: 3.8824070331, C: 51852.30086649099},
+		{A: 8.5e-10, B: 2.83285123847, C: 214364.55718174577},
+		{A: 8.3e-10, B: 5.44599307753, C: 78188.92782615528},
+		{A: 8.3e-10, B: 3.35497157412, C: 52325.36948028299},
+		{A: 1.04e-09, B: 4.95116515346, C: 182085.63102592478},
+		{A: 1.1e-09, B: 6.04814955763, C: 49953.94964855139},
+		{A: 9.3e-10, B: 1.00014028766, C: 9745.3205558566},
+		{A: 8.1e-10, B: 4.03821735964, C: 132658.27281205778},
+		{A: 8.4e-10, B: 0.11234538521, C: 53228.07466633679},
+		{A: 9.4e-10, B: 5.55962830464, C: 51432.81622261579},
+		{A: 8e-10, B: 6.13595673095, C: 137210.22630911658},
+		{A: 8e-10, B: 2.72256616799, C: 25042.7483053866},
+		{A: 8e-10, B: 4.2913724856, C: 77101.23472031478},
+		{A: 8.5e-10, B: 0.97100574775, C: 25788.776747305},
+		{A: 8.2e-10, B: 1.89501710792, C: 128320.75184749259},
+		{A: 7.7e-10, B: 1.16185579398, C: 204151.27163553477},
+		{A: 9.2e-10, B: 4.44154858356, C: 26091.83529483729},
+		{A: 7.8e-10, B: 3.73813140716, C: 26222.0121911592},
+		{A: 7.6e-10, B: 4.28770981047, C: 86457.98475793119},
+		{A: 7.6e-10, B: 2.88868549481, C: 53242.3017603384},
+		{A: 1.04e-09, B: 4.32540467122, C: 23888.81579828719},
+		{A: 9.4e-10, B: 4.32453647728, C: 78257.08086582259},
+		{A: 7.4e-10, B: 4.96325610801, C: 26823.77965510599},
+		{A: 7.7e-10, B: 1.07428657729, C: 50167.24874398939},
+		{A: 7.5e-10, B: 2.56662960973, C: 19202.75325164339},
+		{A: 9.9e-10, B: 6.00141798836, C: 19958.6061020676},
+		{A: 8.7e-10, B: 3.55579419373, C: 52381.99183158559},
+		{A: 7.8e-10, B: 1.00822618263, C: 25352.02662804239},
+		{A: 8.8e-10, B: 3.52269656514, C: 60055.89543648739},
+		{A: 7.8e-10, B: 5.82066892741, C: 155571.81910783658},
+		{A: 7.6e-10, B: 2.96046756402, C: 25024.58905812189},
+		{A: 7.6e-10, B: 4.2442123571, C: 130226.21661243298},
+		{A: 7.6e-10, B: 2.22645638378, C: 36109.7404216736},
+		{A: 7.2e-10, B: 2.23348468194, C: 173511.41499690176},
+		{A: 7.9e-10, B: 1.13204602827, C: 52290.24557183361},
+		{A: 7.4e-10, B: 1.55328520138, C: 51653.22886505459},
+		{A: 7.1e-10, B: 2.26106298981, C: 159.3017347922},
+		{A: 7.3e-10, B: 2.05345178568, C: 26941.0995233262},
+		{A: 9.5e-10, B: 1.93550514722, C: 365230.6439820388},
+		{A: 7e-10, B: 4.74315846161, C: 52061.36699446317},
+		{A: 8.5e-10, B: 1.31607641314, C: 26667.590728573},
+		{A: 7.6e-10, B: 5.58726680703, C: 13675.5302520762},
+		{A: 9.2e-10, B: 3.24599684677, C: 28256.66362385679},
+		{A: 6.7e-10, B: 1.15071488774, C: 55503.94193942859},
+		{A: 6.8e-10, B: 2.92993581219, C: 23919.1426592916},
+		{A: 6.7e-10, B: 1.77035470445, C: 181026.24909573558},
+		{A: 6.6e-10, B: 2.59648990707, C: 27780.06881107659},
+		{A: 8.2e-10, B: 5.36282814327, C: 167850.0829477496},
+		{A: 6.4e-10, B: 3.99165913279, C: 130432.40216087017},
+		{A: 6.9e-10, B: 5.13777502156, C: 52712.61079524379},
+		{A: 6.3e-10, B: 0.54837259436, C: 34082.4315835984},
+		{A: 6.5e-10, B: 3.89976847376, C: 27005.83342755599},
+		{A: 7.3e-10, B: 6.19252091852, C: 50064.15596977079},
+		{A: 6.6e-10, B: 1.91671959572, C: 102018.41617342478},
+		{A: 8.2e-10, B: 0.8782465164, C: 25446.4895798352},
+		{A: 6.3e-10, B: 3.87624799927, C: 78896.49316403578},
+		{A: 6.2e-10, B: 1.55377407031, C: 182188.72380014337},
+		{A: 6.2e-10, B: 4.71223563105, C: 103.7821885806},
+		{A: 8.7e-10, B: 3.57434964589, C: 27037.07875054399},
+		{A: 7.7e-10, B: 3.43626093365, C: 54087.0057663656},
+		{A: 7.8e-10, B: 4.92073893807, C: 77795.74443436819},
+		{A: 7.1e-10, B: 2.76139796167, C: 11.0457002639},
+		{A: 6.4e-10, B: 5.37974575072, C: 51226.63067417859},
+		{A: 8.2e-10, B: 3.78003355754, C: 26308.315784013},
+		{A: 6.2e-10, B: 2.70733081732, C: 104505.39137678158},
+		{A: 7.1e-10, B: 5.05097183202, C: 27177.6119327678},
+		{A: 7e-10, B: 4.29342266024, C: 27311.72098235281},
+		{A: 6.8e-10, B: 2.34136362165, C: 78366.80219894118},
+		{A: 6e-10, B: 4.90888421377, C: 25508.2155545754},
+		{A: 6.8e-10, B: 6.04917764171, C: 647.0108333148},
+		{A: 5.8e-10, B: 2.46745404038, C: 104197.83375581198},
+		{A: 6.7e-10, B: 2.96573339768, C: 51969.62073471119},
+		{A: 6.6e-10, B: 5.33871332672, C: 157586.80077963436},
+		{A: 5.8e-10, B: 2.17528608366, C: 323.5054166574},
+		{A: 6.2e-10, B: 4.44991435917, C: 130419.8459469712},
+		{A: 6.3e-10, B: 0.27396211581, C: 130459.18546877075},
+		{A: 6.3e-10, B: 5.22327184571, C: 76784.84285065818},
+		{A: 6.4e-10, B: 4.64108529425, C: 61279.713277266},
+		{A: 6.2e-10, B: 1.84001159422, C: 183570.921732628},
+		{A: 5.5e-10, B: 1.66692917947, C: 31415.379249957},
+		{A: 5.8e-10, B: 4.06522187846, C: 32132.1317229496},
+		{A: 5.7e-10, B: 2.0756938708, C: 17893.6278083656},
+		{A: 5.5e-10, B: 2.49038254003, C: 22625.658435709},
+		{A: 7.7e-10, B: 6.17301946895, C: 25032.45336464809},
+		{A: 6.8e-10, B: 4.68765980625, C: 77307.42026875199},
+		{A: 5.4e-10, B: 4.63708190151, C: 51329.7234483972},
+		{A: 5.5e-10, B: 0.21233757772, C: 2221.856634597},
+		{A: 6.8e-10, B: 2.36358626958, C: 25953.79409198919},
+		{A: 5.3e-10, B: 2.88564903323, C: 101011.00013959699},
+		{A: 6.2e-10, B: 1.19998944516, C: 26083.97098831109},
+		{A: 5.4e-10, B: 3.90599349467, C: 123758.29085447139},
+		{A: 5.2e-10, B: 2.6059599715, C: 78160.61665050399},
+		{A: 7.2e-10, B: 0.83460657204, C: 22909.7573510066},
+		{A: 6.6e-10, B: 3.17005690509, C: 78039.36462902068},
+		{A: 5.2e-10, B: 2.13323028898, C: 44295.7171298094},
+		{A: 6.1e-10, B: 1.63440457571, C: 61.7259747402},
+		{A: 5.3e-10, B: 2.00748586733, C: 24448.8336243862},
+		{A: 5.1e-10, B: 2.50978165702, C: 78903.60671103658},
+		{A: 5.1e-10, B: 3.22110952745, C: 130652.81480330898},
+		{A: 6e-10, B: 6.23105854697, C: 76887.93562487679},
+		{A: 6.6e-10, B: 3.23596712512, C: 77726.90491262719},
+		{A: 5.9e-10, B: 1.53485505891, C: 6044.2285813754},
+		{A: 5.9e-10, B: 1.10559326249, C: 26102.1302355758},
+		{A: 6e-10, B: 1.08408977503, C: 27972.80430499159},
+		{A: 4.9e-10, B: 2.24548271996, C: 425.908776514},
+		{A: 5.5e-10, B: 3.33645161611, C: 207593.8465805002},
+		{A: 6.7e-10, B: 0.59579109206, C: 94329.77528619739},
+		{A: 5.6e-10, B: 4.05652507832, C: 26010.1525975903},
+		{A: 5.6e-10, B: 1.49670493057, C: 136722.59155786238},
+		{A: 4.9e-10, B: 4.72236375377, C: 5193.3670587978},
+		{A: 4.9e-10, B: 2.99400480872, C: 52252.07235442399},
+		{A: 4.9e-10, B: 4.15104806247, C: 51955.39364070959},
+		{A: 5e-10, B: 5.31916782876, C: 103917.90082841998},
+		{A: 5.1e-10, B: 4.32173710361, C: 58857.03113654799},
+		{A: 5e-10, B: 0.42233074233, C: 27684.0895838588},
+		{A: 6.6e-10, B: 5.06498799279, C: 73.297125859},
+		{A: 4.8e-10, B: 0.80745705976, C: 2703.6161546756},
+		{A: 4.8e-10, B: 0.16882113823, C: 24918.31489016559},
+		{A: 4.9e-10, B: 5.71984539931, C: 50380.54783942739},
+		{A: 6.1e-10, B: 0.05575706789, C: 161079.37234650398},
+		{A: 4.7e-10, B: 5.70016549134, C: 129373.02023068098},
+		{A: 4.8e-10, B: 4.71754734372, C: 50903.1252575212},
+		{A: 5e-10, B: 0.77120384679, C: 50689.82616208319},
+		{A: 5e-10, B: 4.75557775921, C: 143005.6674078538},
+		{A: 4.6e-10, B: 1.03065291695, C: 51868.2486621788},
+		{A: 4.6e-10, B: 4.07474771884, C: 52137.67324751059},
+		{A: 4.5e-10, B: 2.75496032307, C: 196137.07343261078},
+		{A: 5.6e-10, B: 1.48667124221, C: 30171.8916482786},
+		{A: 4.7e-10, B: 3.49884159195, C: 26575.53789282839},
+		{A: 4.8e-10, B: 2.04489119557, C: 27573.1938482774},
+		{A: 4.6e-10, B: 1.96812600979, C: 27223.5800134674},
+		{A: 4.4e-10, B: 0.58466186909, C: 1243.4876016784},
+		{A: 4.3e-10, B: 0.48582749664, C: 78338.49102328988},
+		{A: 4.6e-10, B: 0.10663541022, C: 102659.44689679438},
+		{A: 4.9e-10, B: 1.92223535597, C: 50049.92887576919},
+		{A: 4.7e-10, B: 5.34811633125, C: 846.0828347512},
+		{A: 4.6e-10, B: 2.32911930278, C: 129387.24732468258},
+		{A: 5.6e-10, B: 1.46049477175, C: 28102.884813372},
+		{A: 5e-10, B: 5.62967300375, C: 53906.92863608099},
+		{A: 4.6e-10, B: 5.09770472011, C: 151199.94274106238},
+		{A: 4.3e-10, B: 2.30154744958, C: 26709.6469424134},
+		{A: 5.1e-10, B: 2.88082546361, C: 27669.86248985719},
+		{A: 5.5e-10, B: 5.55849793732, C: 123200.84011627098},
+		{A: 4.4e-10, B: 0.48527820908, C: 80174.90890793978},
+		{A: 4.8e-10, B: 2.59296696271, C: 6720.7139793414},
+		{A: 4.4e-10, B: 0.24824938054, C: 742.9900605326},
+		{A: 4.5e-10, B: 2.489494983, C: 65831.6667743248},
+		{A: 4.5e-10, B: 4.86503115391, C: 52483.36390411799},
+		{A: 4.1e-10, B: 5.23419163545, C: 130289.95251073639},
+		{A: 4.1e-10, B: 0.91885509326, C: 50910.238804522},
+		{A: 4.4e-10, B: 3.12424163301, C: 26126.036177212},
+		{A: 4.2e-10, B: 5.66855218492, C: 166740.70439565618},
+		{A: 3.9e-10, B: 5.01739570944, C: 26402.0893214438},
+		{A: 3.8e-10, B: 0.02313645928, C: 2111.6503133776},
+		{A: 4.9e-10, B: 4.11697361923, C: 25227.59321282139},
+		{A: 3.8e-10, B: 0.09191461136, C: 26118.2300025786},
+		{A: 3.8e-10, B: 5.0314609238, C: 24203.0019781568},
+		{A: 5.1e-10, B: 4.8630590664, C: 78153.50310350319},
+		{A: 3.8e-10, B: 0.13003640396, C: 27351.06050415239},
+		{A: 3.8e-10, B: 1.34984785206, C: 70269.18098269838},
+		{A: 3.8e-10, B: 0.52032944033, C: 103498.41618454478},
+		{A: 4e-10, B: 5.66117959398, C: 52174.32181044009},
+		{A: 3.8e-10, B: 0.92511339052, C: 112231.70171963578},
+		{A: 5.1e-10, B: 4.15223165568, C: 27170.98337386779},
+		{A: 3.7e-10, B: 1.67308781547, C: 54374.8936264354},
+		{A: 3.8e-10, B: 6.26592309401, C: 202.2533951741},
+		{A: 3.6e-10, B: 3.23911910889, C: 176332.24614102798},
+		{A: 3.7e-10, B: 1.90730795656, C: 3308.4658953804},
+		{A: 3.7e-10, B: 3.03203144287, C: 76041.85279012559},
+		{A: 3.8e-10, B: 3.17519743409, C: 78786.28684281638},
+		{A: 3.5e-10, B: 3.90697621183, C: 80382.47328510099},
+		{A: 3.8e-10, B: 2.00924199028, C: 1162.4747044078},
+		{A: 3.6e-10, B: 1.80080871248, C: 19336.86230122839},
+		{A: 3.5e-10, B: 4.66254825701, C: 77947.31755506598},
+		{A: 4.3e-10, B: 1.57972686428, C: 130443.39704322898},
+		{A: 3.5e-10, B: 0.74694549648, C: 25985.94062330859},
+		{A: 4e-10, B: 2.11415671365, C: 3492.5715668696},
+		{A: 3.8e-10, B: 4.65738726744, C: 22003.9146348698},
+		{A: 3.7e-10, B: 0.7757183811, C: 16703.062133499},
+		{A: 3.5e-10, B: 5.00128829727, C: 52509.6622239178},
+		{A: 4.7e-10, B: 0.02275392308, C: 25014.29411738339},
+		{A: 4.3e-10, B: 3.15273137814, C: 130435.63437251298},
+		{A: 3.4e-10, B: 3.05681901713, C: 2820.8311441262},
+		{A: 3.7e-10, B: 4.68248751145, C: 123668.80510662538},
+		{A: 3.4e-10, B: 5.73615227262, C: 45290.65639321759},
+		{A: 4e-10, B: 2.76893017076, C: 50270.341518208},
+		{A: 3.8e-10, B: 6.01229835605, C: 26057.57628056979},
+		{A: 3.4e-10, B: 6.25090722416, C: 21716.0267748},
+		{A: 3.4e-10, B: 0.95587257158, C: 78580.10129437919},
+		{A: 3.3e-10, B: 3.35217476663, C: 235900.50682626115},
+		{A: 3.2e-10, B: 2.35469869139, C: 2383.1930147762},
+		{A: 3.7e-10, B: 3.80938441515, C: 8989.46770543239},
+		{A: 4.3e-10, B: 2.27476214717, C: 25169.9728555924},
+		{A: 3.2e-10, B: 4.14291864821, C: 2686.7220945412},
+		{A: 3.7e-10, B: 0.0457118584, C: 52177.29075585669},
+		{A: 3.2e-10, B: 1.53610678036, C: 233731.7463439786},
+		{A: 3.8e-10, B: 3.98892541543, C: 1300.826818205},
+		{A: 4.2e-10, B: 5.10993958059, C: 145204.75475114078},
+		{A: 3.3e-10, B: 2.91530565997, C: 130866.11389874699},
+		{A: 4.3e-10, B: 1.77793563831, C: 103932.12792242158},
+		{A: 3.5e-10, B: 5.35726747093, C: 26189.8656598398},
+		{A: 3.5e-10, B: 0.65119403092, C: 1535.9767429694},
+		{A: 4.4e-10, B: 3.08976502022, C: 8014.198202924},
+		{A: 3.1e-10, B: 0.90638189649, C: 128220.75860368418},
+		{A: 4.2e-10, B: 3.37905134636, C: 23549.6546373206},
+		{A: 3.8e-10, B: 0.38543000653, C: 515.463871093},
+		{A: 3.9e-10, B: 1.08681725797, C: 25773.71696170459},
+		{A: 3.6e-10, B: 4.30448026071, C: 52286.01260436779},
+		{A: 3.1e-10, B: 5.08183330827, C: 78213.71280281838},
+		{A: 3.8e-10, B: 3.16432629306, C: 91805.13062721379},
+		{A: 3.9e-10, B: 1.4749400211, C: 4022.2625319642},
+		{A: 4.2e-10, B: 0.60192138814, C: 24388.62392507099},
+		{A: 3.7e-10, B: 3.2360628526, C: 28791.5192962498},
+		{A: 4e-10, B: 0.26646931277, C: 78313.70604662679},
+		{A: 3.6e-10, B: 4.38588497247, C: 81591.84508100279},
+		{A: 3.5e-10, B: 0.17056359345, C: 6129.2970395066},
+		{A: 3e-10, B: 2.50459470137, C: 102755.42612401219},
+		{A: 4e-10, B: 4.58870970073, C: 26076.8574413103},
+		{A: 4e-10, B: 4.09419634913, C: 35833.2236974308},
+		{A: 3e-10, B: 4.79837659477, C: 28736.3579670472},
+		{A: 3.2e-10, B: 3.42931668217, C: 24402.8510190726},
+		{A: 2.9e-10, B: 2.52212486456, C: 1353.7487450168},
+		{A: 3e-10, B: 4.69352116507, C: 24072.9214697764},
+		{A: 2.9e-10, B: 5.31070299201, C: 199599.31813847594},
+		{A: 3e-10, B: 3.20350587499, C: 23762.9537327586},
+		{A: 3.6e-10, B: 2.44362166097, C: 23336.3555418826},
+		{A: 2.8e-10, B: 2.77231585227, C: 26312.2479372761},
+		{A: 2.8e-10, B: 0.26775880889, C: 50444.6839302158},
+		{A: 2.9e-10, B: 3.50869792127, C: 15406.65260500879},
+		{A: 3e-10, B: 2.95993198433, C: 132028.58860315479},
+		{A: 2.8e-10, B: 3.41609673663, C: 133882.09065283637},
+		{A: 3.3e-10, B: 2.39929042753, C: 61560.64729122359},
+		{A: 3.2e-10, B: 1.34796958829, C: 37698.4550999484},
+		{A: 2.8e-10, B: 1.75026246832, C: 78683.19406859778},
+		{A: 3.3e-10, B: 4.51080770987, C: 3776.4308857348},
+		{A: 3.2e-10, B: 5.0066530782, C: 29396.3690369546},
+		{A: 3.7e-10, B: 5.23066729388, C: 81604.32185142238},
+		{A: 2.8e-10, B: 0.99927277404, C: 1322.6776475314},
+		{A: 2.9e-10, B: 2.0015977077, C: 25004.8229092806},
+		{A: 3.5e-10, B: 4.69198477732, C: 22065.64060961},
+		{A: 2.9e-10, B: 3.80697601044, C: 24513.057340292},
+		{A: 3.2e-10, B: 4.69401466159, C: 27250.37784598199},
+		{A: 3.1e-10, B: 5.86715902954, C: 5815.11085963699},
+		{A: 2.7e-10, B: 1.26702311366, C: 27044.1922975448},
+		{A: 3e-10, B: 3.44351888035, C: 104819.57755665119},
+		{A: 2.8e-10, B: 5.36078058708, C: 412.3710968744},
+		{A: 2.8e-10, B: 5.73893599832, C: 240452.46032331997},
+		{A: 3.4e-10, B: 5.19151430726, C: 2008.557539159},
+		{A: 3e-10, B: 1.73241634839, C: 163766.0944410452},
+		{A: 2.7e-10, B: 5.30425762252, C: 24864.08530079559},
+		{A: 3.2e-10, B: 1.12362303179, C: 625.6701923124},
+		{A: 3e-10, B: 2.04171272941, C: 193937.9860893238},
+		{A: 3.2e-10, B: 4.78663079749, C: 75930.51303185058},
+		{A: 3e-10, B: 4.4689122615, C: 25450.90686955},
+		{A: 3.2e-10, B: 4.73906176949, C: 48847.6706268682},
+		{A: 2.7e-10, B: 4.12514822062, C: 104358.2411251968},
+		{A: 2.6e-10, B: 0.67609297065, C: 35077.37084700659},
+		{A: 3.3e-10, B: 2.69651735555, C: 25647.07785669659},
+		{A: 2.6e-10, B: 4.94767789631, C: 209658.82487420217},
+		{A: 3.5e-10, B: 3.86705432105, C: 25466.159340735},
+		{A: 2.6e-10, B: 4.79912409089, C: 198489.9395863826},
+		{A: 2.6e-10, B: 1.59971503257, C: 31281.270200372},
+		{A: 2.6e-10, B: 1.20008223797, C: 153084.84390447979},
+		{A: 2.6e-10, B: 3.02443658098, C: 26724.8994135984},
+		{A: 2.5e-10, B: 2.03567222767, C: 52817.21984488739},
+		{A: 2.7e-10, B: 1.23585314301, C: 53.0961523144},
+		{A: 2.6e-10, B: 5.53069670975, C: 25657.37279743509},
+		{A: 2.8e-10, B: 0.93845909288, C: 158746.17595363196},
+		{A: 2.5e-10, B: 1.46977800361, C: 1272.6810256272},
+		{A: 2.6e-10, B: 2.45961936761, C: 181659.72224941078},
+		{A: 3.4e-10, B: 2.39774146758, C: 39763.43339365039},
+		{A: 2.9e-10, B: 6.23821353511, C: 102769.65321801379},
+		{A: 2.9e-10, B: 4.90111965589, C: 116783.65521669458},
+		{A: 2.5e-10, B: 3.02186465541, C: 39450.3528483734},
+		{A: 2.7e-10, B: 3.77575599741, C: 103718.82882698359},
+		{A: 2.7e-10, B: 3.9212504685, C: 80596.9058175946},
+		{A: 2.3e-10, B: 0.26970481255, C: 52609.51802102519},
+		{A: 2.3e-10, B: 5.98846059571, C: 27463.67694142},
+		{A: 2.5e-10, B: 1.22968381596, C: 49976.71893986139},
+		{A: 2.3e-10, B: 5.57437029749, C: 155887.52156313116},
+		{A: 2.3e-10, B: 5.9950798502, C: 50007.0458008658},
+		{A: 2.3e-10, B: 1.44496012077, C: 5687.630368952},
+		{A: 2.4e-10, B: 0.46516912647, C: 130446.62925487179},
+		{A: 2.3e-10, B: 0.94331115897, C: 28774.6252361154},
+		{A: 2.7e-10, B: 0.80529908381, C: 2698.4514473772},
+		{A: 2.7e-10, B: 0.27124610516, C: 1265.5674786264},
+		{A: 2.3e-10, B: 0.89381228793, C: 156314.1197540072},
+		{A: 2.3e-10, B: 2.96257779899, C: 12546.481939083},
+		{A: 2.2e-10, B: 5.42940490576, C: 1485.9801210652},
+		{A: 2.2e-10, B: 1.03839316349, C: 103189.13786188899},
+		{A: 2.3e-10, B: 1.35379542887, C: 52161.57918914679},
+		{A: 2.6e-10, B: 6.01331218379, C: 122444.98726584678},
+		{A: 3e-10, B: 2.66518277698, C: 25600.26839032},
+		{A: 2.3e-10, B: 4.07521268525, C: 230239.17477710897},
+		{A: 2.4e-10, B: 0.64355332403, C: 203041.8930834414},
+		{A: 2.2e-10, B: 1.39463873909, C: 952.0766232596},
+		{A: 2.3e-10, B: 1.98852683178, C: 7392.4544020848},
+		{A: 2.8e-10, B: 1.37381287166, C: 309.2783226558},
+		{A: 2.2e-10, B: 2.15165551798, C: 104276.83096772949},
+		{A: 2.5e-10, B: 4.93854060665, C: 101703.15774082378},
+		{A: 2.3e-10, B: 3.12039946936, C: 77616.69859140778},
+		{A: 2.8e-10, B: 0.40745832322, C: 55638.05098901359},
+		{A: 2.4e-10, B: 0.4419926444, C: 24285.5311508524},
+		{A: 2.3e-10, B: 1.33496997363, C: 163298.1294506908},
+		{A: 2.1e-10, B: 4.0146938126, C: 120417.67842777158},
+		{A: 2.4e-10, B: 0.63196904603, C: 77940.20400806518},
+		{A: 2.2e-10, B: 5.70851933792, C: 2840.500905026},
+		{A: 2.4e-10, B: 4.45840068641, C: 183145.012956114},
+		{A: 2.3e-10, B: 3.62152465067, C: 5635.0337293524},
+		{A: 2.1e-10, B: 3.04515069387, C: 9123.5767550174},
+		{A: 2.1e-10, B: 1.96886020684, C: 177287.84588263658},
+		{A: 2.3e-10, B: 0.59040250365, C: 25672.35065096209},
+		{A: 2.1e-10, B: 3.40810269373, C: 129586.31932611899},
+		{A: 2.1e-10, B: 3.09046433472, C: 26267.9802718588},
+		{A: 2.4e-10, B: 1.94276984966, C: 183674.70392120857},
+		{A: 2.1e-10, B: 3.98455358233, C: 6191.0230142468},
+		{A: 2.8e-10, B: 0.93414213433, C: 51841.950342379},
+		{A: 2e-10, B: 4.59348701559, C: 52061.40717623499},
+		{A: 2.7e-10, B: 4.61439897742, C: 52027.72755872209},
+		{A: 2e-10, B: 0.48128544481, C: 52309.9153327334},
+		{A: 2e-10, B: 3.31281154655, C: 26248.310510959},
+		{A: 2.3e-10, B: 1.67970146036, C: 23113.2931869632},
+		{A: 2e-10, B: 2.44057473344, C: 50264.6067999312},
+		{A: 2.5e-10, B: 5.43422956232, C: 2.9689454166},
+		{A: 2.6e-10, B: 3.61410457829, C: 52024.75861330549},
+		{A: 2e-10, B: 0.60321200025, C: 138319.60486120995},
+		{A: 2e-10, B: 2.62628682595, C: 164.43591058941},
+		{A: 2e-10, B: 0.653247742, C: 156520.30530244438},
+		{A: 2.7e-10, B: 4.74395008878, C: 391318.54712361295},
+		{A: 2.1e-10, B: 4.45035214874, C: 25862.073873164},
+		{A: 2.1e-10, B: 1.54947465846, C: 148.0787244263},
+		{A: 2.7e-10, B: 5.13202499307, C: 12382.0460284936},
+		{A: 2e-10, B: 0.68567124507, C: 42.8830749034},
+		{A: 1.9e-10, B: 1.92072938625, C: 78800.51393681798},
+		{A: 2.7e-10, B: 2.48188330716, C: 25665.23710396129},
+		{A: 2.7e-10, B: 1.27457874648, C: 7860.4193924392},
+		{A: 2.2e-10, B: 2.29809961839, C: 25865.04281858059},
+		{A: 1.9e-10, B: 4.09473921906, C: 76255.15188556358},
+		{A: 2e-10, B: 4.67174071386, C: 207114.15223730978},
+		{A: 2.2e-10, B: 2.63315058351, C: 7768.3665566946},
+		{A: 2e-10, B: 1.24271716091, C: 52179.73843641149},
+		{A: 2e-10, B: 6.11431848045, C: 24824.745778996},
+		{A: 2.3e-10, B: 4.18071159026, C: 2125.8774073792},
+		{A: 2.1e-10, B: 0.74169140667, C: 1795.258443721},
+		{A: 1.9e-10, B: 2.66558529381, C: 191.4482661116},
+		{A: 2e-10, B: 3.22150044915, C: 156547.08861034497},
+		{A: 2.1e-10, B: 6.18753985884, C: 104127.26777059489},
+		{A: 2e-10, B: 5.52295053123, C: 130363.24963659538},
+		{A: 2.1e-10, B: 3.90684382108, C: 51534.3927214094},
+		{A: 2e-10, B: 3.1001950954, C: 838.9692877504},
+		{A: 2.5e-10, B: 5.79466888187, C: 26098.9488418381},
+		{A: 2.1e-10, B: 6.23594952039, C: 103814.80805420138},
+		{A: 1.9e-10, B: 5.79715725254, C: 26279.35140768579},
+		{A: 2.2e-10, B: 0.71256272418, C: 959.1228599576},
+		{A: 2.1e-10, B: 1.39589766697, C: 103395.32341032618},
+		{A: 1.8e-10, B: 1.52837590084, C: 107.3053069296},
+		{A: 1.9e-10, B: 0.6026210276, C: 27566.76971563859},
+		{A: 2.5e-10, B: 0.78252166479, C: 53814.87580033639},
+		{A: 2.5e-10, B: 2.29282750485, C: 77520.71936418998},
+		{A: 1.8e-10, B: 2.11606037402, C: 26.2983197998},
+		{A: 2.4e-10, B: 1.60264230919, C: 208173.534167499},
+		{A: 2.1e-10, B: 6.27983567062, C: 54344.56676543099},
+		{A: 1.8e-10, B: 2.13288203799, C: 77314.53381575279},
+		{A: 1.9e-10, B: 5.02228884604, C: 26114.201461374},
+		{A: 2e-10, B: 5.52520867267, C: 9591.5417453718},
+		{A: 1.7e-10, B: 4.12183264175, C: 24602.61243487099},
+		{A: 2.2e-10, B: 0.51026067819, C: 95.9792272178},
+		{A: 1.7e-10, B: 4.488898325, C: 208276.62694171758},
+		{A: 1.7e-10, B: 3.94937058994, C: 25459.05155552409},
+		{A: 1.7e-10, B: 5.29323037427, C: 76137.83201734339},
+		{A: 1.9e-10, B: 3.67294881327, C: 6885.14988993081},
+		{A: 1.8e-10, B: 1.02433476262, C: 53399.624123927},
+		{A: 2e-10, B: 2.79332004367, C: 25927.49577218939},
+		{A: 1.8e-10, B: 5.83348216244, C: 293.4107843772},
+		{A: 2.4e-10, B: 3.0007843166, C: 23446.561863102},
+		{A: 2e-10, B: 1.11172116756, C: 156507.7490885454},
+		{A: 1.7e-10, B: 0.56645607004, C: 104984.39630560997},
+		{A: 2e-10, B: 4.48412602835, C: 52755.49387014719},
+		{A: 1.9e-10, B: 5.18601674976, C: 2544.6726368924},
+		{A: 2.3e-10, B: 4.19936868759, C: 24952.226269681},
+		{A: 1.7e-10, B: 2.06473217893, C: 130005.80396999417},
+		{A: 1.9e-10, B: 0.55214472899, C: 52396.2189255872},
+		{A: 1.9e-10, B: 1.79653943961, C: 89.485747846},
+		{A: 2.2e-10, B: 3.72989530019, C: 48997.6604925808},
+		{A: 2e-10, B: 5.93006048006, C: 11852.355063399},
+		{A: 1.8e-10, B: 6.24870732707, C: 233681.74972207437},
+		{A: 2.1e-10, B: 3.0156672555, C: 25907.8260112896},
+		{A: 1.6e-10, B: 1.73390726659, C: 52911.68279668019},
+		{A: 1.7e-10, B: 3.23716624064, C: 117893.03376878797},
+		{A: 2.2e-10, B: 0.46092942812, C: 19367.1891622328},
+		{A: 1.6e-10, B: 5.06429474773, C: 43981.5309499398},
+		{A: 2.1e-10, B: 1.13950009268, C: 25650.2592504343},
+		{A: 1.9e-10, B: 3.19311280972, C: 47803.9299163742},
+		{A: 2.1e-10, B: 4.64218889935, C: 112545.88789950538},
+		{A: 1.7e-10, B: 2.96216633831, C: 102975.83876645098},
+		{A: 1.6e-10, B: 0.60226326404, C: 149846.1939960456},
+		{A: 1.6e-10, B: 5.86803075725, C: 127098.90328117118},
+		{A: 1.8e-10, B: 1.20391834667, C: 87367.61641884019},
+		{A: 1.6e-10, B: 4.53670173929, C: 2667.0523336414},
+		{A: 1.7e-10, B: 5.77652308057, C: 51130.65144696079},
+		{A: 1.8e-10, B: 5.8770643204, C: 78057.52387628538},
+		{A: 1.9e-10, B: 5.24206436649, C: 60370.08161635699},
+		{A: 1.6e-10, B: 1.31713012946, C: 78843.39701172139},
+		{A: 1.9e-10, B: 5.33584429695, C: 104454.70534051539},
+		{A: 1.5e-10, B: 4.52789928708, C: 25984.1209529936},
+		{A: 1.6e-10, B: 4.1216258545, C: 189386.03259226496},
+		{A: 1.7e-10, B: 3.51115348265, C: 45455.092303807},
+		{A: 1.5e-10, B: 1.65943772958, C: 51596.1186961496},
+		{A: 1.5e-10, B: 4.28577331083, C: 117.3198682202},
+		{A: 1.6e-10, B: 0.28814169191, C: 78469.89497315978},
+		{A: 1.5e-10, B: 0.64079475058, C: 53093.73656913019},
+		{A: 2e-10, B: 5.58915267196, C: 1423.564731963},
+		{A: 1.5e-10, B: 6.19871258212, C: 156740.7179448832},
+		{A: 1.5e-10, B: 2.05066243688, C: 16342.5825857176},
+		{A: 1.8e-10, B: 0.93517825293, C: 104344.98400739678},
+		{A: 1.5e-10, B: 4.64013294295, C: 2974.609954611},
+		{A: 1.5e-10, B: 2.61010767947, C: 7830.0925314348},
+		{A: 1.5e-10, B: 2.60585262136, C: 151.0476698429},
+		{A: 1.8e-10, B: 0.92309429794, C: 1577.3435424478},
+		{A: 1.7e-10, B: 1.36952124854, C: 78115.63070029629},
+		{A: 1.8e-10, B: 1.95659679786, C: 102872.74599223239},
+		{A: 1.7e-10, B: 5.37350443158, C: 25384.26995695679},
+		{A: 1.5e-10, B: 2.1295253236, C: 128106.31931499895},
+		{A: 1.8e-10, B: 4.06290374391, C: 51439.92976961659},
+		{A: 1.5e-10, B: 4.32808623679, C: 77741.13200662879},
+		{A: 1.4e-10, B: 3.46389680879, C: 104426.39416486409},
+		{A: 2e-10, B: 5.15870331048, C: 154408.65498906677},
+		{A: 1.4e-10, B: 4.53089771582, C: 57503.2823915312},
+		{A: 1.6e-10, B: 3.64720066701, C: 24072.23205541439},
+		{A: 1.5e-10, B: 2.96793644807, C: 24719.24288872919},
+		{A: 1.4e-10, B: 0.79165737765, C: 25928.601406782},
+		{A: 1.4e-10, B: 4.36570321274, C: 78249.48233072099},
+		{A: 1.4e-10, B: 5.35774038541, C: 896.0794566554},
+		{A: 1.4e-10, B: 5.57146329055, C: 104248.51979207818},
+		{A: 1.4e-10, B: 6.22061869408, C: 104874.18998439058},
+		{A: 1.5e-10, B: 0.66056760946, C: 77622.29586298359},
+		{A: 1.7e-10, B: 4.3940408425, C: 162810.49469943656},
+		{A: 1.7e-10, B: 4.01700926317, C: 51876.67988887919},
+		{A: 1.4e-10, B: 5.96074966457, C: 4371.8763667742},
+		{A: 1.4e-10, B: 5.51412243126, C: 48713.5615772832},
+		{A: 1.4e-10, B: 5.04161806976, C: 53029.0026649004},
+		{A: 1.4e-10, B: 3.099795747, C: 128747.35003836859},
+		{A: 1.9e-10, B: 4.6170254761, C: 16028.396405848},
+		{A: 1.7e-10, B: 2.09526572835, C: 52277.768801414},
+		{A: 1.4e-10, B: 1.5151690827, C: 52164.76058288449},
+		{A: 1.4e-10, B: 2.76589411039, C: 26734.913974889},
+		{A: 1.7e-10, B: 5.31477308011, C: 103608.62250576419},
+		{A: 1.3e-10, B: 6.04881581356, C: 52812.8025551726},
+		{A: 1.5e-10, B: 0.92065367569, C: 256327.07791868312},
+		{A: 1.6e-10, B: 2.76936523753, C: 46046.5092436418},
+		{A: 1.9e-10, B: 4.91561037107, C: 277.0349937414},
+		{A: 1.5e-10, B: 1.87874008695, C: 26161.20026743319},
+		{A: 1.3e-10, B: 1.4859308936, C: 54824.2611086214},
+		{A: 1.5e-10, B: 1.37228117638, C: 18043.37807764579},
+		{A: 1.8e-10, B: 2.95472168569, C: 76152.05911134499},
+		{A: 1.7e-10, B: 1.57020520015, C: 104241.40624507738},
+		{A: 1.8e-10, B: 2.97721991956, C: 187167.2754880782},
+		{A: 1.3e-10, B: 3.49902133309, C: 78397.81847430758},
+		{A: 1.8e-10, B: 2.18386661425, C: 149288.74325784517},
+		{A: 1.7e-10, B: 5.3885117007, C: 52041.69723356339},
+		{A: 1.3e-10, B: 6.2695666077, C: 90989.16285949759},
+		{A: 1.3e-10, B: 1.1367469174, C: 237009.88537835455},
+		{A: 1.4e-10, B: 2.18322393546, C: 225687.22128005017},
+		{A: 1.3e-10, B: 6.15204536524, C: 35211.47989659159},
+		{A: 1.7e-10, B: 1.32178768936, C: 106262.81204951399},
+		{A: 1.4e-10, B: 1.73651187343, C: 2912.8839798708},
+		{A: 1.3e-10, B: 0.84907711504, C: 102343.05502713779},
+		{A: 1.3e-10, B: 2.3997022038, C: 155460.9233722552},
+		{A: 1.7e-10, B: 4.15374414668, C: 26014.60601571519},
+		{A: 1.3e-10, B: 0.72484082297, C: 153878.9640239722},
+		{A: 1.3e-10, B: 3.04907288197, C: 80432.46990700519},
+		{A: 1.3e-10, B: 1.37316946035, C: 104035.22069664019},
+		{A: 1.5e-10, B: 3.12808384217, C: 173.44857138459},
+		{A: 1.3e-10, B: 2.93774353095, C: 533.6231183577},
+		{A: 1.3e-10, B: 2.24535401962, C: 246113.79237247215},
+		{A: 1.6e-10, B: 0.39665846969, C: 78112.66175487968},
+		{A: 1.5e-10, B: 4.75053341616, C: 130020.03106399579},
+		{A: 1.6e-10, B: 0.76587038276, C: 557.4507382004},
+		{A: 1.5e-10, B: 6.01045796437, C: 51112.49219969609},
+		{A: 1.5e-10, B: 0.93774708323, C: 461.3364314544},
+		{A: 1.2e-10, B: 4.03988032603, C: 77956.15180375299},
+		{A: 1.5e-10, B: 1.43833460468, C: 169093.57054942797},
+		{A: 1.4e-10, B: 2.1474910882, C: 78129.60037513758},
+		{A: 1.2e-10, B: 4.57098987537, C: 6751.0408403458},
+		{A: 1.7e-10, B: 0.640869336, C: 5131.6410840576},
+		{A: 1.3e-10, B: 5.02936180992, C: 144916.86689107097},
+		{A: 1.6e-10, B: 3.65387335184, C: 104028.10714963939},
+		{A: 1.3e-10, B: 3.37789792164, C: 79212.88503369238},
+		{A: 1.3e-10, B: 1.38408689559, C: 4885.8094378282},
+		{A: 1.2e-10, B: 4.95862705668, C: 50536.73676596039},
+		{A: 1.4e-10, B: 2.05563043425, C: 107692.22499299659},
+		{A: 1.2e-10, B: 3.9377239153, C: 104668.00443595338},
+		{A: 1.4e-10, B: 3.26407683636, C: 104401.60918820098},
+		{A: 1.4e-10, B: 2.94492731309, C: 51120.35650622229},
+		{A: 1.3e-10, B: 5.3055485999, C: 155475.15046625677},
+		{A: 1.4e-10, B: 5.14050840336, C: 726.4820198702},
+		{A: 1.2e-10, B: 2.41433841278, C: 24551.92639860479},
+		{A: 1.3e-10, B: 3.02673254226, C: 182828.62108645737},
+		{A: 1.2e-10, B: 2.94598982599, C: 5039.588248313},
+		{A: 1.3e-10, B: 3.57478000552, C: 78484.12206716138},
+		{A: 1.3e-10, B: 2.94343799803, C: 79315.97780791098},
+		{A: 1.2e-10, B: 5.17024351037, C: 103402.43695732698},
+		{A: 1.2e-10, B: 3.19152649116, C: 28309.75977617119},
+		{A: 1.2e-10, B: 6.16852058989, C: 78413.27262185719},
+		{A: 1.3e-10, B: 4.35939831008, C: 133767.65136415116},
+		{A: 1.3e-10, B: 5.96217383792, C: 2538.2485042536},
+		{A: 1.2e-10, B: 2.97455363125, C: 26290.15653674829},
+		{A: 1.2e-10, B: 1.94777069809, C: 156377.8556523106},
+		{A: 1.2e-10, B: 1.81926611664, C: 70069.43409151399},
+		{A: 1.3e-10, B: 1.24212367708, C: 4437.51420837359},
+		{A: 1.2e-10, B: 0.5377584257, C: 106470.37642667518},
+		{A: 1.2e-10, B: 4.0602950761, C: 79487.5272655012},
+		{A: 1.2e-10, B: 1.39982040688, C: 77417.62658997139},
+		{A: 1.2e-10, B: 5.70094378358, C: 79330.20490191258},
+		{A: 1.2e-10, B: 2.36617272843, C: 79994.83177765518},
+		{A: 1.2e-10, B: 4.77094162628, C: 78999.58593825439},
+		{A: 1.2e-10, B: 5.23189289937, C: 26411.4085582316},
+		{A: 1.4e-10, B: 2.99800402366, C: 1073.6090241908},
+		{A: 1.2e-10, B: 6.15146193785, C: 103704.60173298199},
+		{A: 1.2e-10, B: 1.58617824624, C: 78571.26704569219},
+		{A: 1.3e-10, B: 0.37894193721, C: 53124.98189211819},
+		{A: 1.2e-10, B: 3.86549077079, C: 189853.99758261937},
+		{A: 1.3e-10, B: 4.70785515154, C: 234261.43730907317},
+		{A: 1.2e-10, B: 4.5409764894, C: 56259.79478985279},
+		{A: 1.2e-10, B: 1.32257018476, C: 149756.7082481996},
+		{A: 1.1e-10, B: 5.69779744921, C: 222224.97657418498},
+		{A: 1.2e-10, B: 0.60900120711, C: 2751.5475996916},
+		{A: 1.1e-10, B: 0.79876219873, C: 78225.57638908479},
+		{A: 1.3e-10, B: 2.57664086838, C: 1116.4920990942},
+		{A: 1.2e-10, B: 0.86411055123, C: 78043.29678228378},
+		{A: 1.1e-10, B: 4.02869363295, C: 263097.78851992876},
+		{A: 1.1e-10, B: 3.97587600566, C: 76998.14194609619},
+		{A: 1.1e-10, B: 5.29433199334, C: 52797.55008398759},
+		{A: 1.3e-10, B: 5.69550284497, C: 51688.1715318942},
+		{A: 1.5e-10, B: 3.91946731118, C: 27665.246684022},
+		{A: 1.3e-10, B: 4.89334198524, C: 45424.76544280259},
+		{A: 1.2e-10, B: 2.33066141026, C: 130336.42293365239},
+		{A: 1.2e-10, B: 2.97893637259, C: 25885.64974640009},
+		{A: 1.2e-10, B: 0.33532325982, C: 41494.55574658299},
+		{A: 1.1e-10, B: 1.81390783997, C: 104301.61594439259},
+		{A: 1.1e-10, B: 3.67540306066, C: 79181.63971070439},
+		{A: 1.3e-10, B: 1.92207364074, C: 2962.880601775},
+		{A: 1.2e-10, B: 4.51335455691, C: 54190.78795494619},
+		{A: 1.2e-10, B: 5.8914039722, C: 156954.01704032117},
+		{A: 1.2e-10, B: 5.80412470555, C: 72134.41238521598},
+		{A: 1.1e-10, B: 2.54446138738, C: 26183.88236879199},
+		{A: 1.1e-10, B: 0.57810940674, C: 142871.55835826878},
+		{A: 1.4e-10, B: 1.49797486148, C: 103883.64757594238},
+		{A: 1.1e-10, B: 0.25563634033, C: 52663.44103440259},
+		{A: 1.1e-10, B: 4.34226157432, C: 63786.3582415226},
+		{A: 1.1e-10, B: 5.79546155779, C: 76358.24465978218},
+		{A: 1.1e-10, B: 5.43581617959, C: 4398.17468657401},
+		{A: 1e-10, B: 3.45435285202, C: 26830.8932021068},
+		{A: 1e-10, B: 1.05961891051, C: 216897.50046580215},
+		{A: 1e-10, B: 5.54078372768, C: 53867.97195265079},
+		{A: 1.3e-10, B: 4.09291929409, C: 78378.1487134078},
+		{A: 1.2e-10, B: 6.09214378282, C: 156523.5375140872},
+		{A: 1.2e-10, B: 2.30793560033, C: 192828.60753723036},
+		{A: 1.1e-10, B: 5.49984194986, C: 130593.29451835579},
+		{A: 1e-10, B: 5.02934387609, C: 63.7358983034},
+		{A: 1e-10, B: 2.56161075764, C: 3178.1457905676},
+		{A: 1e-10, B: 4.51229030686, C: 80912.1642501956},
+		{A: 1e-10, B: 3.26964460988, C: 143980.93691036216},
+		{A: 1.4e-10, B: 0.09133406732, C: 138.5174968707},
+		{A: 1.4e-10, B: 4.26704901561, C: 52171.87412988529},
+		{A: 1e-10, B: 2.12874423735, C: 181563.05360783098},
+		{A: 1e-10, B: 2.68594312022, C: 53551.5800829942},
+		{A: 1e-10, B: 5.01052160566, C: 53311.4831550416},
+		{A: 1.3e-10, B: 2.21304135803, C: 287.8878600698},
+		{A: 1e-10, B: 5.99285759925, C: 129063.74190802519},
+		{A: 1e-10, B: 0.23580667035, C: 159969.99379441058},
+		{A: 1.4e-10, B: 4.15846442712, C: 52190.03337714999},
+		{A: 1.4e-10, B: 2.17544501087, C: 2506.6449642566},
+		{A: 1e-10, B: 2.39055885342, C: 78262.22495201428},
+		{A: 1e-10, B: 5.33589950489, C: 128843.32926558638},
+		{A: 1e-10, B: 3.69795961564, C: 175934.09713761977},
+		{A: 1e-10, B: 5.50982370855, C: 181548.82651382938},
+		{A: 1.2e-10, B: 0.91293309387, C: 53258.88651544199},
+		{A: 1e-10, B: 0.21546965297, C: 155674.22246769318},
+		{A: 1e-10, B: 5.05408550952, C: 182465.7587938848},
+		{A: 1.1e-10, B: 1.47055363263, C: 76991.02839909539},
+		{A: 1.4e-10, B: 1.74381856487, C: 36.6485629295},
+		{A: 1.4e-10, B: 1.73080789777, C: 171292.65789271498},
+		{A: 1e-10, B: 1.79047706298, C: 235746.72801577637},
+		{A: 1.2e-10, B: 2.869185171, C: 2045.3085328022},
+		{A: 1.3e-10, B: 5.94639118711, C: 168959.46149984296},
+		{A: 1e-10, B: 5.24213703651, C: 130285.73689738619},
+		{A: 1.4e-10, B: 6.10459624713, C: 454.9093665273},
+		{A: 1e-10, B: 5.39397583397, C: 77.7505439839},
+		{A: 1.1e-10, B: 1.99827759086, C: 1088.603156601},
+		{A: 1e-10, B: 1.76064600598, C: 52489.992463018},
+		{A: 1e-10, B: 5.11611480967, C: 113455.51956041438},
+		{A: 1.4e-10, B: 4.11983704037, C: 54060.70744656579},
+		{A: 1.2e-10, B: 2.84049561669, C: 183041.9201818954},
+		{A: 1.2e-10, B: 3.1829708579, C: 228508.0524241764},
+		{A: 1e-10, B: 1.62042818189, C: 231348.55332920235},
+		{A: 1e-10, B: 2.3830820052, C: 181975.4247047054},
+		{A: 1e-10, B: 5.17901157458, C: 203375.74902421076},
+		{A: 1e-10, B: 1.70609651468, C: 78597.56536549199},
+		{A: 1e-10, B: 3.0188061819, C: 104501.17576343138},
+		{A: 1.1e-10, B: 1.42631059185, C: 58220.0348645238},
+		{A: 1e-10, B: 3.45269160846, C: 99979.7361995226},
+		{A: 1.1e-10, B: 4.42716378223, C: 235320.81923926238},
+		{A: 1e-10, B: 4.45423293249, C: 5469.8837830406},
+		{A: 9e-11, B: 4.68983089958, C: 80462.79676800959},
+		{A: 9e-11, B: 1.77635894319, C: 50290.905119731},
+		{A: 1e-10, B: 1.41464391522, C: 48091.817776444},
+		{A: 1.1e-10, B: 5.93477819724, C: 158116.491744729},
+		{A: 1e-10, B: 2.55614008171, C: 105418.10804348679},
+		{A: 9e-11, B: 3.11835021508, C: 52206.1331441528},
+		{A: 9e-11, B: 2.24380470375, C: 299.1263942692},
+		{A: 9e-11, B: 3.22171579815, C: 51006.21803173979},
+		{A: 9e-11, B: 3.24808968579, C: 78697.42116259939},
+		{A: 1.1e-10, B: 0.73383746517, C: 17605.7399482958},
+		{A: 1e-10, B: 2.45523846147, C: 233835.52853255917},
+		{A: 9e-11, B: 4.35363918141, C: 190809.59732422797},
+		{A: 9e-11, B: 2.77090630961, C: 76094.94894244},
+		{A: 1.2e-10, B: 0.23038883503, C: 130907.4806982254},
+		{A: 9e-11, B: 5.05551915235, C: 70383.6202713836},
+		{A: 9e-11, B: 5.11051597902, C: 156093.7071115684},
+		{A: 1.1e-10, B: 5.44617170556, C: 56777.7564021114},
+		{A: 9e-11, B: 4.67339642631, C: 104771.09721017198},
+		{A: 9e-11, B: 4.56456351898, C: 259819.64948555277},
+		{A: 9e-11, B: 0.73352390338, C: 75085.56363415498},
+		{A: 1e-10, B: 2.64743106367, C: 153186.8064227454},
+		{A: 1e-10, B: 5.18315351919, C: 1382.1979324846},
+		{A: 1.2e-10, B: 4.75714453262, C: 250665.74586953095},
+		{A: 1.1e-10, B: 5.79093643324, C: 292628.26660646836},
+		{A: 9e-11, B: 6.24971585432, C: 202420.14928260216},
+		{A: 1.2e-10, B: 5.83966176266, C: 11790.6290886588},
+		{A: 1.1e-10, B: 2.84614041718, C: 184204.39488630317},
+		{A: 1e-10, B: 3.41187941424, C: 1905.4647649404},
+		{A: 1e-10, B: 3.0024026508, C: 118828.96374949679},
+		{A: 9e-11, B: 3.90754065548, C: 154308.6617452584},
+		{A: 1e-10, B: 0.88848120961, C: 51315.49635439559},
+		{A: 1.1e-10, B: 4.4943130123, C: 156531.3001848032},
+		{A: 9e-11, B: 0.37279337773, C: 182622.43553802016},
+		{A: 9e-11, B: 5.94479236467, C: 38634.3850806572},
+		{A: 9e-11, B: 0.28434529807, C: 5019.9184874132},
+		{A: 1.1e-10, B: 5.30507160437, C: 51257.8759971666},
+		{A: 9e-11, B: 5.05598171209, C: 62197.64356324779},
+		{A: 9e-11, B: 2.0074301127, C: 154194.22245657316},
+		{A: 1e-10, B: 2.29548861266, C: 1211.341070359},
+		{A: 9e-11, B: 1.40840962373, C: 78149.27013603736},
+		{A: 1.1e-10, B: 2.69818187519, C: 195047.36464141717},
+		{A: 9e-11, B: 3.7733811002, C: 52073.84376488279},
+		{A: 1e-10, B: 4.16632261841, C: 1130.1539475858},
+		{A: 9e-11, B: 4.71851534374, C: 257436.45647077652},
+		{A: 9e-11, B: 1.56697202296, C: 156107.93420556997},
+		{A: 1e-10, B: 3.84491070936, C: 42790.9652750732},
+		{A: 1e-10, B: 0.84911221571, C: 61921.12683900499},
+		{A: 1.1e-10, B: 3.4350449899, C: 81725.95413058778},
+		{A: 8e-11, B: 3.46841587447, C: 156534.53239644598},
+		{A: 9e-11, B: 3.07023951195, C: 78265.19389743089},
+		{A: 1.1e-10, B: 5.3324059131, C: 49424.25868345679},
+		{A: 9e-11, B: 3.80703237983, C: 184834.07909520617},
+		{A: 1e-10, B: 5.24984657637, C: 860.3099287528},
+		{A: 8e-11, B: 2.32846981012, C: 156451.15277816958},
+		{A: 9e-11, B: 5.86810139691, C: 221135.26778299137},
+		{A: 9e-11, B: 0.07267483428, C: 54879.422437824},
+		{A: 9e-11, B: 3.53847001127, C: 1169.5882514086},
+		{A: 8e-11, B: 0.40730399, C: 5852.7803152638},
+		{A: 1e-10, B: 5.08938165465, C: 53661.0969898516},
+		{A: 9e-11, B: 0.09227292796, C: 285857.5560052227},
+		{A: 9e-11, B: 1.53921610006, C: 171004.7700326452},
+		{A: 1e-10, B: 6.0236999988, C: 105403.88094948517},
+		{A: 9e-11, B: 1.41005383919, C: 215473.93573383917},
+		{A: 8e-11, B: 2.95064025568, C: 182611.44065566137},
+		{A: 1.1e-10, B: 0.82592745776, C: 52098.05573916449},
+		{A: 1.1e-10, B: 1.89268014069, C: 53265.515074342},
+		{A: 9e-11, B: 0.91534494504, C: 130446.14426677099},
+		{A: 1.1e-10, B: 5.68651428373, C: 97466.46267636596},
+		{A: 8e-11, B: 6.09336539202, C: 65538.25598994759},
+		{A: 1e-10, B: 4.71864870979, C: 1865.2314025176},
+		{A: 1.1e-10, B: 0.33942008091, C: 2352.8661537718},
+		{A: 1e-10, B: 0.58149039679, C: 51554.06248230919},
+		{A: 9e-11, B: 4.63908561414, C: 130329.30938665158},
+		{A: 8e-11, B: 3.29340479484, C: 76532.58707178998},
+		{A: 8e-11, B: 0.39996086139, C: 31722.9368709266},
+		{A: 9e-11, B: 2.07892055945, C: 84307.93800609799},
+		{A: 8e-11, B: 0.10251061291, C: 130489.51232977519},
+		{A: 9e-11, B: 4.86488540298, C: 197380.5610342892},
+		{A: 8e-11, B: 2.84319355109, C: 247223.17092456558},
+		{A: 9e-11, B: 5.40644601602, C: 205260.65018762814},
+		{A: 8e-11, B: 2.99554948925, C: 28199.55345495179},
+		{A: 8e-11, B: 2.31903661034, C: 50951.98844236979},
+		{A: 9e-11, B: 3.67243760379, C: 132558.2795682494},
+		{A: 1e-10, B: 3.84482406254, C: 199.0720014364},
+		{A: 8e-11, B: 5.13311255182, C: 133780.12813457078},
+		{A: 8e-11, B: 4.50659262629, C: 31775.5335105262},
+		{A: 1e-10, B: 3.05711078565, C: 12098.1867096284},
+		{A: 9e-11, B: 5.43609233651, C: 65851.33653522459},
+		{A: 8e-11, B: 3.1647127512, C: 53438.96364572659},
+		{A: 8e-11, B: 5.49628419906, C: 208063.32784627957},
+		{A: 9e-11, B: 0.80806156177, C: 84944.9342781222},
+		{A: 8e-11, B: 1.45936166924, C: 50160.82461135059},
+		{A: 9e-11, B: 3.83369994945, C: 79902.77894191058},
+		{A: 8e-11, B: 5.05169260225, C: 220025.88923089797},
+		{A: 1e-10, B: 0.99908522442, C: 78373.91574594198},
+		{A: 8e-11, B: 1.78435325528, C: 4743.4017631704},
+		{A: 9e-11, B: 2.44642878457, C: 76468.45098100159},
+		{A: 1e-10, B: 0.640410795, C: 106684.80895916879},
+		{A: 8e-11, B: 0.22706755866, C: 130514.29730643828},
+		{A: 8e-11, B: 4.45568539518, C: 175844.61138977378},
+		{A: 8e-11, B: 5.30875425569, C: 104991.50985261079},
+		{A: 8e-11, B: 4.26578657244, C: 78267.64157798568},
+		{A: 1e-10, B: 3.66900856638, C: 22595.33157470459},
+		{A: 8e-11, B: 5.3953090617, C: 1168.8988370466},
+		{A: 7e-11, B: 2.97217552646, C: 130962.09312596478},
+		{A: 8e-11, B: 5.4165915664, C: 106082.73491922939},
+		{A: 7e-11, B: 6.14449914187, C: 154835.25317994278},
+		{A: 9e-11, B: 3.77109166248, C: 76777.72930365738},
+		{A: 1e-10, B: 2.68699198523, C: 31903.01400121119},
+		{A: 1e-10, B: 4.1132528617, C: 51861.62010327879},
+		{A: 1e-10, B: 6.22285311837, C: 52213.9393187862},
+		{A: 8e-11, B: 4.05691998652, C: 130432.88714897096},
+		{A: 8e-11, B: 5.44182392744, C: 24844.41553989579},
+		{A: 8e-11, B: 5.31551782104, C: 26247.2048763664},
+		{A: 7e-11, B: 3.34982243781, C: 53771.99272543299},
+		{A: 7e-11, B: 0.76435484795, C: 103829.03514820298},
+		{A: 9e-11, B: 0.48166296597, C: 4571.62325795859},
+		{A: 1e-10, B: 2.5385762885, C: 23735.03698780239},
+		{A: 9e-11, B: 3.0693298264, C: 51102.19725895759},
+		{A: 9e-11, B: 0.81667861584, C: 180396.56488683258},
+		{A: 8e-11, B: 1.37381137878, C: 182619.20332637738},
+		{A: 1e-10, B: 4.53462580754, C: 195181.47369100217},
+		{A: 7e-11, B: 5.92229713446, C: 102129.75593169978},
+		{A: 9e-11, B: 1.70197819412, C: 114.3991069134},
+		{A: 9e-11, B: 2.75554865259, C: 52145.47942214399},
+		{A: 7e-11, B: 5.030029299, C: 78905.12298646157},
+		{A: 8e-11, B: 4.70162992466, C: 77684.02183772379},
+		{A: 7e-11, B: 2.16016333042, C: 252884.50297371778},
+		{A: 7e-11, B: 4.7010481161, C: 197092.67317421938},
+		{A: 8e-11, B: 3.34882273958, C: 92.0528357446},
+		{A: 7e-11, B: 4.64287583099, C: 104659.17018726638},
+		{A: 7e-11, B: 1.74834665027, C: 79116.90580647459},
+		{A: 7e-11, B: 1.40803395997, C: 78149.31031780918},
+		{A: 7e-11, B: 5.96603423152, C: 1685.0521225016},
+		{A: 8e-11, B: 5.74025115791, C: 27441.651886591},
+		{A: 7e-11, B: 3.32172728837, C: 186057.89693598478},
+		{A: 7e-11, B: 5.77399157694, C: 52822.81711646319},
+		{A: 9e-11, B: 5.4096900728, C: 25970.58327335399},
+		{A: 7e-11, B: 5.0309737967, C: 51092.7260508548},
+		{A: 8e-11, B: 4.85500497006, C: 79353.41821591619},
+		{A: 9e-11, B: 2.91790460578, C: 128857.55635958798},
+		{A: 1e-10, B: 2.62831495455, C: 104145.42701785959},
+		{A: 8e-11, B: 3.65717048924, C: 50476.52706664519},
+		{A: 1e-10, B: 4.97375703081, C: 128960.64913380658},
+		{A: 7e-11, B: 0.63731291763, C: 132350.71519108818},
+		{A: 7e-11, B: 3.70718359263, C: 61165.27398858079},
+		{A: 7e-11, B: 1.524098908, C: 224577.84272795677},
+		{A: 7e-11, B: 5.83295581556, C: 78339.97549599818},
+		{A: 7e-11, B: 1.2898265788, C: 127791.06088239799},
+		{A: 7e-11, B: 4.38077281912, C: 87648.55043279778},
+		{A: 7e-11, B: 0.78772735446, C: 104044.0549453272},
+		{A: 7e-11, B: 5.55018242223, C: 148532.89040742096},
+		{A: 8e-11, B: 3.9435380026, C: 77929.8534839532},
+		{A: 7e-11, B: 4.68484570125, C: 129971.55071751658},
+		{A: 7e-11, B: 5.46082675395, C: 76352.50994150538},
+		{A: 7e-11, B: 0.89727780818, C: 28213.78054895339},
+		{A: 6e-11, B: 2.25546242417, C: 79955.87509422498},
+		{A: 7e-11, B: 1.85784871452, C: 28096.46068073319},
+		{A: 6e-11, B: 6.2231897778, C: 261988.40996783535},
+		{A: 6e-11, B: 3.92166942434, C: 182402.02289558138},
+		{A: 6e-11, B: 4.54553590954, C: 26235.9818660005},
+		{A: 6e-11, B: 0.04385251847, C: 182634.99175191918},
+		{A: 6e-11, B: 3.27000773736, C: 104557.79811473397},
+		{A: 6e-11, B: 1.4831694091, C: 26191.68533015479},
+		{A: 6e-11, B: 1.42098831764, C: 26026.177166834},
+		{A: 6e-11, B: 4.63624566094, C: 57369.17334194619},
+		{A: 6e-11, B: 2.24614788518, C: 131079.41299418497},
+		{A: 8e-11, B: 1.46359871781, C: 74935.5737684424},
+		{A: 7e-11, B: 0.19039890992, C: 117077.06600107178},
+		{A: 6e-11, B: 2.30839350284, C: 27353.47062020059},
+		{A: 8e-11, B: 4.05701479596, C: 60170.3347251726},
+		{A: 6e-11, B: 2.25676540184, C: 118007.4730574732},
+		{A: 7e-11, B: 5.19369769843, C: 91919.56991589899},
+		{A: 6e-11, B: 2.17221286567, C: 27573.88326263939},
+		{A: 6e-11, B: 3.04358438526, C: 83591.18553310538},
+		{A: 6e-11, B: 5.50261387027, C: 26238.95081141709},
+		{A: 6e-11, B: 5.55141392261, C: 207747.625390985},
+		{A: 7e-11, B: 3.67760971466, C: 229129.79622501557},
+		{A: 6e-11, B: 5.14998820697, C: 130364.73410930368},
+		{A: 6e-11, B: 2.59291082745, C: 52186.8519834123},
+		{A: 6e-11, B: 3.70620257597, C: 182608.20844401856},
+		{A: 7e-11, B: 5.89987227798, C: 53757.76563143139},
+		{A: 7e-11, B: 1.17954845926, C: 209232.91609768817},
+		{A: 7e-11, B: 1.99199973963, C: 88285.54670482199},
+		{A: 6e-11, B: 5.29985761538, C: 175376.64639941938},
+		{A: 7e-11, B: 1.76338847974, C: 55484.27217852879},
+		{A: 8e-11, B: 0.55724966367, C: 129806.73196855778},
+		{A: 5e-11, B: 4.19687137282, C: 111032.83741969639},
+		{A: 6e-11, B: 1.86021237763, C: 96471.52341295779},
+		{A: 6e-11, B: 1.44535366888, C: 20043.6745601988},
+		{A: 6e-11, B: 1.48244229587, C: 260349.34045064732},
+		{A: 6e-11, B: 1.00031747475, C: 51538.81001112419},
+		{A: 5e-11, B: 0.08842088266, C: 79859.89586700719},
+		{A: 6e-11, B: 2.17116807817, C: 156373.64003896038},
+		{A: 7e-11, B: 2.57540094308, C: 77218.55458853499},
+		{A: 6e-11, B: 4.66124811472, C: 65717.22748563958},
+		{A: 5e-11, B: 5.69376389474, C: 52400.1510788503},
+		{A: 6e-11, B: 2.47972848111, C: 266540.3634648941},
+		{A: 5e-11, B: 4.47086994598, C: 27360.58416720139},
+		{A: 5e-11, B: 3.20880924837, C: 259769.65286364855},
+		{A: 5e-11, B: 3.98361684334, C: 54862.5283776896},
+		{A: 7e-11, B: 2.86077141334, C: 179172.74704605396},
+		{A: 5e-11, B: 2.46087822928, C: 156681.19765992998},
+		{A: 7e-11, B: 5.00361119157, C: 209762.60706278277},
+		{A: 5e-11, B: 5.35670104895, C: 26513.8119180882},
+		{A: 6e-11, B: 3.62607025643, C: 27883.16158529519},
+		{A: 5e-11, B: 0.80991017339, C: 25661.9943650602},
+		{A: 6e-11, B: 0.20069428083, C: 50490.75416064679},
+		{A: 5e-11, B: 3.77056538505, C: 164407.50800278416},
+		{A: 5e-11, B: 2.71005052987, C: 104427.87863757239},
+		{A: 6e-11, B: 0.84754795471, C: 104466.051854982},
+		{A: 5e-11, B: 2.69389382491, C: 180496.558130641},
+		{A: 5e-11, B: 4.04820376371, C: 129277.04100346318},
+		{A: 6e-11, B: 0.5782905701, C: 50600.9604818662},
+		{A: 6e-11, B: 4.21216601811, C: 182595.65223011957},
+		{A: 5e-11, B: 3.59670550074, C: 131072.29944718417},
+		{A: 5e-11, B: 0.08842684725, C: 27993.3679065146},
+		{A: 6e-11, B: 0.00392034365, C: 73891.83305794839},
+		{A: 6e-11, B: 4.68979746906, C: 26149.62911631439},
+		{A: 5e-11, B: 4.61789756581, C: 20894.5360827764},
+		{A: 5e-11, B: 3.5709323278, C: 53654.67285721279},
+		{A: 5e-11, B: 0.36682731238, C: 146505.58156934578},
+		{A: 5e-11, B: 1.4054582464, C: 233202.055378884},
+		{A: 6e-11, B: 2.35439456378, C: 139543.4227019886},
+		{A: 4e-11, B: 1.28935961583, C: 234364.53008329176},
+		{A: 4e-11, B: 1.10749672173, C: 51086.09749195479},
+		{A: 5e-11, B: 3.64294402198, C: 27331.3907432526},
+		{A: 4e-11, B: 0.00216060134, C: 213255.1786296524},
+		{A: 4e-11, B: 4.40507427126, C: 129483.22655190038},
+		{A: 4e-11, B: 2.18529936793, C: 23384.2869868986},
+		{A: 5e-11, B: 0.93391333656, C: 52102.50915728939},
+		{A: 4e-11, B: 2.33941435272, C: 23389.451694197},
+		{A: 4e-11, B: 3.59370682931, C: 46564.4708559004},
+		{A: 4e-11, B: 2.90835831307, C: 61299.38303816579},
+		{A: 4e-11, B: 6.27884818175, C: 170068.84005193636},
+		{A: 4e-11, B: 4.90170477242, C: 104888.41707839219},
+		{A: 4e-11, B: 4.56426377076, C: 46131.577701773},
+		{A: 5e-11, B: 2.12658067178, C: 130542.60848208958},
+		{A: 5e-11, B: 2.50519016528, C: 79845.66877300559},
+		{A: 4e-11, B: 6.24881318031, C: 18073.7049386502},
+		{A: 4e-11, B: 1.10868110225, C: 52072.0240945678},
+		{A: 4e-11, B: 6.14515791357, C: 34102.10134449819},
+		{A: 4e-11, B: 2.9409789397, C: 129902.71119577558},
+		{A: 4e-11, B: 2.91542426579, C: 130215.17091216908},
+		{A: 5e-11, B: 2.2014651331, C: 82865.65954368559},
+		{A: 5e-11, B: 4.46570663623, C: 104237.17327761157},
+		{A: 4e-11, B: 5.1333479743, C: 26387.0295358434},
+		{A: 4e-11, B: 5.34965278511, C: 24787.0763233692},
+		{A: 4e-11, B: 4.17772291197, C: 53132.09543911899},
+		{A: 4e-11, B: 2.66996288682, C: 25572.43927048119},
+		{A: 5e-11, B: 1.14175711692, C: 188898.39784101077},
+	},
+	// L1, 839 terms
+	{
+		{A: 26088.14706222746, B: 0, C: 0},
+		{A: 0.01126007832, B: 6.21703970996, C: 26087.9031415742},
+		{A: 0.00303471395, B: 3.05565472363, C: 52175.8062831484},
+		{A: 0.00080538452, B: 6.10454743366, C: 78263.70942472259},
+		{A: 0.00021245035, B: 2.83531934452, C: 104351.61256629678},
+		{A: 5.592094e-05, B: 5.82675673328, C: 130439.51570787099},
+		{A: 1.472233e-05, B: 2.51845458395, C: 156527.41884944518},
+		{A: 3.52244e-06, B: 3.05238094403, C: 1109.3785520934},
+		{A: 3.88318e-06, B: 5.48039225891, C: 182615.3219910194},
+		{A: 9.354e-07, B: 6.11791163931, C: 27197.2816936676},
+		{A: 9.0579e-07, B: 0.00045481669, C: 24978.5245894808},
+		{A: 1.02743e-06, B: 2.14879173777, C: 208703.2251325936},
+		{A: 5.1941e-07, B: 5.62107554052, C: 5661.3320491522},
+		{A: 4.437e-07, B: 4.57348500464, C: 25028.521211385},
+		{A: 2.807e-07, B: 3.04195430989, C: 51066.427731055},
+		{A: 2.2003e-07, B: 0.86475371243, C: 955.5997416086},
+		{A: 2.7295e-07, B: 5.09210138837, C: 234791.12827416777},
+		{A: 2.0425e-07, B: 3.71509622702, C: 20426.571092422},
+		{A: 2.0221e-07, B: 0.51934047295, C: 21535.9496445154},
+		{A: 1.7496e-07, B: 5.7266360862, C: 4551.9534970588},
+		{A: 1.668e-07, B: 1.35134428173, C: 529.6909650946},
+		{A: 1.5306e-07, B: 1.79184360652, C: 11322.6640983044},
+		{A: 1.5398e-07, B: 5.74263453989, C: 19.66976089979},
+		{A: 1.3964e-07, B: 3.59426938083, C: 24498.8302462904},
+		{A: 1.2822e-07, B: 2.69591798562, C: 53285.1848352418},
+		{A: 1.2621e-07, B: 3.89530641889, C: 3.881335358},
+		{A: 1.2566e-07, B: 4.70537436663, C: 1059.3819301892},
+		{A: 7.974e-08, B: 4.17682324505, C: 26617.5941066688},
+		{A: 7.929e-08, B: 0.50426804318, C: 46514.4742339962},
+		{A: 8.024e-08, B: 3.92723313702, C: 27043.5028831828},
+		{A: 7.665e-08, B: 2.48158305355, C: 57837.1383323006},
+		{A: 8.639e-08, B: 6.06360282793, C: 77154.33087262919},
+		{A: 6.838e-08, B: 2.77387065312, C: 7.1135470008},
+		{A: 6.554e-08, B: 5.53478998989, C: 6770.7106012456},
+		{A: 5.846e-08, B: 4.281396598, C: 16983.9961474566},
+		{A: 7.285e-08, B: 1.74581868954, C: 260879.03141574195},
+		{A: 7.179e-08, B: 2.97766079692, C: 2218.7571041868},
+		{A: 6.364e-08, B: 2.1380561938, C: 25132.3033999656},
+		{A: 5.883e-08, B: 2.19545230409, C: 13521.7514415914},
+		{A: 4.969e-08, B: 2.4775213418, C: 30639.856638633},
+		{A: 4.578e-08, B: 1.55845855383, C: 27147.28507176339},
+		{A: 4.857e-08, B: 4.84757952324, C: 37410.5672398786},
+		{A: 5.022e-08, B: 3.94124675992, C: 25661.3049506982},
+		{A: 4.381e-08, B: 4.94471456712, C: 213.299095438},
+		{A: 4.211e-08, B: 5.53886788148, C: 83925.0414738748},
+		{A: 4.299e-08, B: 5.09070560802, C: 10213.285546211},
+		{A: 4.593e-08, B: 0.81920676333, C: 25558.2121764796},
+		{A: 3.571e-08, B: 2.34341884629, C: 32858.61374281979},
+		{A: 4.454e-08, B: 5.7915915618, C: 3442.5749449654},
+		{A: 3.547e-08, B: 3.59413896239, C: 26068.2333806744},
+		{A: 3.664e-08, B: 1.39952931281, C: 77204.32749453338},
+		{A: 3.417e-08, B: 0.49855041138, C: 22645.32819660879},
+		{A: 3.195e-08, B: 1.25743024246, C: 14765.2390432698},
+		{A: 3.031e-08, B: 4.45207030477, C: 7238.6755916},
+		{A: 3.284e-08, B: 5.22996707635, C: 25448.00585526019},
+		{A: 2.974e-08, B: 0.14234349726, C: 50586.73338786459},
+		{A: 2.855e-08, B: 0.67083991526, C: 26091.7844769322},
+		{A: 2.773e-08, B: 3.54351198199, C: 72602.37737557039},
+		{A: 3.132e-08, B: 6.21365344759, C: 26080.78959457339},
+		{A: 2.732e-08, B: 5.64171761904, C: 1589.0728952838},
+		{A: 2.652e-08, B: 0.88219699366, C: 52705.49724824299},
+		{A: 3.125e-08, B: 6.07160751766, C: 28306.66024576099},
+		{A: 2.799e-08, B: 2.51152391376, C: 26107.57290247399},
+		{A: 2.403e-08, B: 1.1551513036, C: 25035.6347583858},
+		{A: 2.274e-08, B: 1.85167073193, C: 36301.18868778519},
+		{A: 2.501e-08, B: 4.34723465048, C: 41962.5207369374},
+		{A: 2.321e-08, B: 5.44262378304, C: 26084.0218062162},
+		{A: 2.535e-08, B: 5.80101392346, C: 26095.016688575},
+		{A: 2.872e-08, B: 1.63503968499, C: 25021.4076643842},
+		{A: 2.066e-08, B: 1.0723184563, C: 43071.8992890308},
+		{A: 2.616e-08, B: 2.78322243283, C: 103242.2340142034},
+		{A: 1.987e-08, B: 2.95923214706, C: 23969.1392811958},
+		{A: 1.979e-08, B: 4.44092591248, C: 103292.23063610759},
+		{A: 1.974e-08, B: 0.83681704901, C: 12566.1516999828},
+		{A: 1.7e-08, B: 2.28603732736, C: 110012.94461544899},
+		{A: 1.677e-08, B: 4.63366122462, C: 53235.18821333759},
+		{A: 1.954e-08, B: 4.67733727976, C: 286966.9345573162},
+		{A: 1.653e-08, B: 1.25518276011, C: 33326.5787331742},
+		{A: 1.785e-08, B: 1.80830270808, C: 26301.2022370122},
+		{A: 1.784e-08, B: 5.17926273269, C: 426.598190876},
+		{A: 2.143e-08, B: 5.16312278245, C: 51220.20654153979},
+		{A: 1.597e-08, B: 5.53494401703, C: 56727.7597802072},
+		{A: 1.59e-08, B: 0.07660134798, C: 23869.1460373874},
+		{A: 1.574e-08, B: 3.7587058637, C: 73711.75592766379},
+		{A: 1.362e-08, B: 1.13830507985, C: 68050.42387851159},
+		{A: 1.358e-08, B: 1.45317020242, C: 51646.11531805379},
+		{A: 1.586e-08, B: 4.65681044624, C: 79373.087976816},
+		{A: 1.347e-08, B: 3.80254397382, C: 19317.1925403286},
+		{A: 1.242e-08, B: 3.79389911401, C: 25874.6040461362},
+		{A: 1.124e-08, B: 1.28920904043, C: 31749.2351907264},
+		{A: 1.065e-08, B: 4.31416942177, C: 40853.142184844},
+		{A: 1.014e-08, B: 3.58126441973, C: 48733.23133818299},
+		{A: 1.259e-08, B: 1.73130656223, C: 12432.0426503978},
+		{A: 9.89e-09, B: 0.33276224293, C: 52156.1365222486},
+		{A: 1.057e-08, B: 4.35251408825, C: 77734.01845962799},
+		{A: 1.033e-08, B: 3.72503880786, C: 6283.0758499914},
+		{A: 8.94e-09, B: 0.29768279333, C: 105460.99111839019},
+		{A: 9.1e-09, B: 0.49823258962, C: 99799.65906923798},
+		{A: 9.99e-09, B: 5.29812267938, C: 65697.55772473979},
+		{A: 1.138e-08, B: 2.56391312022, C: 29530.4780865396},
+		{A: 8.76e-09, B: 4.91112286703, C: 62389.09182935939},
+		{A: 9.12e-09, B: 0.27449857787, C: 98690.28051714458},
+		{A: 8.79e-09, B: 4.78847722011, C: 18849.2275499742},
+		{A: 8.69e-09, B: 3.87957425954, C: 78793.40038981718},
+		{A: 8.31e-09, B: 2.35459129726, C: 27676.976036858},
+		{A: 7.91e-09, B: 1.17444825871, C: 129380.13377768178},
+		{A: 7.82e-09, B: 3.92653768734, C: 38654.05484155699},
+		{A: 7.82e-09, B: 3.69412482234, C: 52179.6876185064},
+		{A: 7.45e-09, B: 2.09648894863, C: 51535.90899683439},
+		{A: 7.36e-09, B: 6.05470921559, C: 114.43928868521},
+		{A: 7.33e-09, B: 3.2301997832, C: 47623.8527860896},
+		{A: 6.98e-09, B: 2.99026308247, C: 52168.69273614759},
+		{A: 7.8e-09, B: 5.77004504059, C: 129330.13715577759},
+		{A: 7.52e-09, B: 4.22525357653, C: 51123.53789995999},
+		{A: 7.52e-09, B: 4.70099207936, C: 51109.31080595839},
+		{A: 6.51e-09, B: 5.87383050095, C: 2333.196392872},
+		{A: 6.99e-09, B: 4.82823185979, C: 38519.945791972},
+		{A: 6.56e-09, B: 3.65375726729, C: 25668.418497699},
+		{A: 6.6e-09, B: 1.98037689941, C: 26514.5013324502},
+		{A: 8.08e-09, B: 6.27898737551, C: 53131.406024757},
+		{A: 7.53e-09, B: 5.64446551994, C: 45892.73043315699},
+		{A: 6.1e-09, B: 1.21194007471, C: 153.7788104848},
+		{A: 6.04e-09, B: 5.29267380518, C: 136100.84775702318},
+		{A: 8.11e-09, B: 4.22885181263, C: 25654.19140369739},
+		{A: 6.97e-09, B: 1.89331617498, C: 77308.10968311399},
+		{A: 5.81e-09, B: 2.61707271637, C: 76674.63652943878},
+		{A: 5.49e-09, B: 4.18661776549, C: 94138.32702008578},
+		{A: 5.67e-09, B: 6.08785859254, C: 25234.70675982219},
+		{A: 5.49e-09, B: 2.08003708209, C: 91785.46086631398},
+		{A: 5.63e-09, B: 1.38660458, C: 79323.09135491178},
+		{A: 5.27e-09, B: 5.83448542494, C: 50057.04242277},
+		{A: 5.24e-09, B: 2.45745967369, C: 467.9649903544},
+		{A: 5.7e-09, B: 3.1042551371, C: 15874.6175953632},
+		{A: 6.99e-09, B: 2.57322536922, C: 52182.9198301492},
+		{A: 5.01e-09, B: 0.39578180187, C: 32370.9789915656},
+		{A: 5.42e-09, B: 2.77109053528, C: 54394.56338733519},
+		{A: 5.25e-09, B: 1.31791713133, C: 313054.83769889036},
+		{A: 5.13e-09, B: 2.28640740711, C: 82815.66292178139},
+		{A: 5.79e-09, B: 5.45103833735, C: 52195.47604404819},
+		{A: 6.17e-09, B: 4.18395572588, C: 639.897286314},
+		{A: 4.49e-09, B: 3.10044122691, C: 49957.0491789616},
+		{A: 4.95e-09, B: 2.11807671869, C: 52171.9249477904},
+		{A: 4.31e-09, B: 3.0671483672, C: 131548.89425996438},
+		{A: 4.27e-09, B: 5.353673111, C: 49.9966219042},
+		{A: 4.7e-09, B: 1.46585019834, C: 1066.49547719},
+		{A: 4.7e-09, B: 1.0748559576, C: 103821.92160120218},
+		{A: 4.15e-09, B: 2.50109134151, C: 71980.63357473118},
+		{A: 3.95e-09, B: 5.24320159822, C: 58946.51688439399},
+		{A: 4.08e-09, B: 0.92030240581, C: 77837.11123384659},
+		{A: 5.23e-09, B: 4.9239008231, C: 52389.1053785864},
+		{A: 3.77e-09, B: 3.50882025297, C: 125887.56221081219},
+		{A: 5.08e-09, B: 1.03838633466, C: 24491.71669928959},
+		{A: 4.18e-09, B: 0.36439379673, C: 51962.5071877104},
+		{A: 4e-09, B: 3.51557589073, C: 20760.4270331914},
+		{A: 3.59e-09, B: 0.03091967859, C: 2118.7638603784},
+		{A: 3.56e-09, B: 2.03727281128, C: 1052.2683831884},
+		{A: 4.23e-09, B: 4.2143754624, C: 18093.37469954999},
+		{A: 3.4e-09, B: 1.64349471632, C: 79219.3091663312},
+		{A: 3.36e-09, B: 1.55276786593, C: 24505.94379329119},
+		{A: 3.48e-09, B: 1.06528227311, C: 66941.04532641819},
+		{A: 3.27e-09, B: 5.45093694594, C: 7880.08915333899},
+		{A: 3.03e-09, B: 1.67537340603, C: 88476.99497093359},
+		{A: 3.23e-09, B: 5.59204872298, C: 55618.3812281138},
+		{A: 2.81e-09, B: 0.83478570176, C: 63498.47038145279},
+		{A: 3.93e-09, B: 0.55222046684, C: 45405.0956819028},
+		{A: 2.8e-09, B: 0.69995179262, C: 64741.95798313119},
+		{A: 3.81e-09, B: 2.16540447546, C: 522.5774180938},
+		{A: 2.79e-09, B: 4.17319236859, C: 155468.036919256},
+		{A: 3.14e-09, B: 1.52755129973, C: 44937.1306915484},
+		{A: 2.8e-09, B: 3.33549459014, C: 78244.0396638228},
+		{A: 2.72e-09, B: 5.34763989826, C: 53764.8791784322},
+		{A: 2.87e-09, B: 4.98400171694, C: 25551.09862947879},
+		{A: 2.88e-09, B: 3.26773464056, C: 124778.18365871879},
+		{A: 2.75e-09, B: 0.59220058467, C: 104881.30353139139},
+		{A: 2.29e-09, B: 5.07700202235, C: 52602.4044740244},
+		{A: 2.65e-09, B: 1.04033681288, C: 25938.3399444396},
+		{A: 2.24e-09, B: 5.1072681918, C: 117873.36400788819},
+		{A: 2.42e-09, B: 0.44760602599, C: 23754.70674870219},
+		{A: 2.14e-09, B: 3.50460190598, C: 58458.88213313979},
+		{A: 2.3e-09, B: 2.45937674101, C: 155418.04029735178},
+		{A: 2.11e-09, B: 3.92377004339, C: 103925.01437542078},
+		{A: 2.82e-09, B: 3.81265243625, C: 536.8045120954},
+		{A: 2.18e-09, B: 4.88963285925, C: 103396.01282468818},
+		{A: 2e-09, B: 5.41573374322, C: 16066.0658614748},
+		{A: 2.18e-09, B: 0.41409439248, C: 78267.59076008058},
+		{A: 2.01e-09, B: 2.38235635877, C: 23439.44831610119},
+		{A: 2.03e-09, B: 2.69458032262, C: 59414.4818747484},
+		{A: 1.96e-09, B: 0.92807787406, C: 120226.23016165999},
+		{A: 2.01e-09, B: 2.00560473829, C: 162188.75089859738},
+		{A: 1.83e-09, B: 3.83128433173, C: 69159.80243060499},
+		{A: 1.8e-09, B: 2.20428238305, C: 89586.37352302698},
+		{A: 2.15e-09, B: 5.3565209661, C: 206.1855484372},
+		{A: 1.78e-09, B: 1.24568538055, C: 103.0927742186},
+		{A: 1.96e-09, B: 0.44564203205, C: 51756.3216392732},
+		{A: 1.83e-09, B: 2.88003872828, C: 3328.13565628019},
+		{A: 2.16e-09, B: 4.48180965712, C: 26241.681952059},
+		{A: 2.15e-09, B: 2.26752450953, C: 33967.99229491319},
+		{A: 1.93e-09, B: 4.34407767816, C: 105307.21230790539},
+		{A: 2e-09, B: 1.01165813281, C: 51742.09454527159},
+		{A: 1.7e-09, B: 5.57003076622, C: 98068.53671630539},
+		{A: 2.24e-09, B: 0.97932137082, C: 77211.44104153418},
+		{A: 1.68e-09, B: 5.96360976147, C: 157636.79740153858},
+		{A: 1.78e-09, B: 1.42319344949, C: 77197.21394753258},
+		{A: 1.96e-09, B: 5.59654497318, C: 78270.82297172339},
+		{A: 1.61e-09, B: 5.51918174079, C: 26555.8681319286},
+		{A: 1.97e-09, B: 4.79975858562, C: 25455.119402261},
+		{A: 1.81e-09, B: 4.3998767997, C: 105410.99449648599},
+		{A: 1.59e-09, B: 2.63928704121, C: 28421.0995344462},
+		{A: 1.58e-09, B: 0.75224521142, C: 51116.4243529592},
+		{A: 1.96e-09, B: 4.92493608061, C: 102762.53967101299},
+		{A: 1.62e-09, B: 2.82561928047, C: 26202.34243025941},
+		{A: 1.94e-09, B: 1.02167898855, C: 44181.27784112419},
+		{A: 1.79e-09, B: 5.11561177302, C: 25440.89230825939},
+		{A: 1.52e-09, B: 5.21934955501, C: 77623.81213840858},
+		{A: 1.5e-09, B: 5.98491689335, C: 78256.59587772179},
+		{A: 1.84e-09, B: 0.26375114604, C: 419.4846438752},
+		{A: 1.44e-09, B: 2.9065729497, C: 51322.60990139639},
+		{A: 1.63e-09, B: 1.01728531879, C: 26727.8004278882},
+		{A: 1.6e-09, B: 1.39225289207, C: 26011.6370702986},
+		{A: 1.45e-09, B: 5.76503611587, C: 80482.46652890938},
+		{A: 1.4e-09, B: 0.27227514908, C: 46848.3301747656},
+		{A: 1.36e-09, B: 0.22641179921, C: 151975.46535238638},
+		{A: 1.42e-09, B: 4.24343923379, C: 339142.7408404646},
+		{A: 1.78e-09, B: 4.08547287819, C: 129909.82474277639},
+		{A: 1.42e-09, B: 3.31130337074, C: 78050.41032928458},
+		{A: 1.31e-09, B: 0.70125733168, C: 3340.6124266998},
+		{A: 1.54e-09, B: 1.72826405606, C: 78477.00852016058},
+		{A: 1.59e-09, B: 5.29643521725, C: 108903.56606335558},
+		{A: 1.29e-09, B: 3.26743532785, C: 25973.46385288896},
+		{A: 1.28e-09, B: 6.10775258657, C: 76044.9523205358},
+		{A: 1.44e-09, B: 4.06091644657, C: 50579.61984086379},
+		{A: 1.64e-09, B: 6.17696810226, C: 19406.6782881746},
+		{A: 1.39e-09, B: 5.11979950242, C: 433.7117378768},
+		{A: 1.53e-09, B: 1.30046862259, C: 13655.8604911764},
+		{A: 1.32e-09, B: 5.23445066335, C: 26610.48055966799},
+		{A: 1.15e-09, B: 4.6184579377, C: 50593.84693486539},
+		{A: 1.21e-09, B: 2.52027376421, C: 76144.94556434419},
+		{A: 1.14e-09, B: 4.51555533683, C: 27154.3986187642},
+		{A: 1.06e-09, B: 5.18068866237, C: 85502.38501632259},
+		{A: 1.1e-09, B: 3.45757703411, C: 25565.3257234804},
+		{A: 1e-09, B: 0.11496600894, C: 74821.13447975718},
+		{A: 1e-09, B: 3.95989644984, C: 26037.90651966999},
+		{A: 1e-09, B: 3.0153538215, C: 28206.6670019526},
+		{A: 9.9e-10, B: 5.99257260255, C: 29416.03879785439},
+		{A: 1.17e-09, B: 3.56597928882, C: 71492.99882347698},
+		{A: 1.31e-09, B: 5.96070215452, C: 1223.81784077861},
+		{A: 9.5e-10, B: 1.840657503, C: 85034.42002596818},
+		{A: 1.02e-09, B: 4.69459571654, C: 114564.89811250778},
+		{A: 9.7e-10, B: 0.87353840441, C: 181555.94006083018},
+		{A: 1.18e-09, B: 2.02298794976, C: 78283.37918562238},
+		{A: 1.11e-09, B: 4.0731622796, C: 93028.94846799239},
+		{A: 9e-10, B: 5.01726237192, C: 115674.27666460119},
+		{A: 9.4e-10, B: 2.31806507832, C: 81706.28436968799},
+		{A: 8.9e-10, B: 5.94413487088, C: 25131.61398560359},
+		{A: 9.5e-10, B: 3.7229989459, C: 90829.86112470538},
+		{A: 1.2e-09, B: 5.44384753792, C: 51749.20809227239},
+		{A: 1.05e-09, B: 5.01803826552, C: 78259.82808936459},
+		{A: 8.6e-10, B: 1.83551544255, C: 143961.2671494624},
+		{A: 1.08e-09, B: 4.53825443085, C: 71025.0338331226},
+		{A: 8.3e-10, B: 0.64015893446, C: 130012.91751699499},
+		{A: 9.9e-10, B: 0.52218556269, C: 26624.70765366959},
+		{A: 9.4e-10, B: 2.65386396812, C: 9103.9069941176},
+		{A: 9.8e-10, B: 3.3148017225, C: 853.196381752},
+		{A: 8e-10, B: 2.13424472361, C: 42153.969003049},
+		{A: 8.6e-10, B: 2.05760695844, C: 79852.78232000639},
+		{A: 8.4e-10, B: 0.97023030623, C: 131395.11544947958},
+		{A: 8.1e-10, B: 0.28916970825, C: 84546.78527471398},
+		{A: 8.1e-10, B: 5.18614814481, C: 220.4126424388},
+		{A: 8.1e-10, B: 1.98383157756, C: 149.5631971346},
+		{A: 8.2e-10, B: 0.05817684667, C: 104331.94280539699},
+		{A: 7.4e-10, B: 1.62836007935, C: 25984.8103673556},
+		{A: 7.5e-10, B: 1.84245188133, C: 78690.30761559859},
+		{A: 8.7e-10, B: 3.5863485536, C: 130969.20667296558},
+		{A: 7.4e-10, B: 5.06505616563, C: 27140.17152476259},
+		{A: 7.5e-10, B: 5.47760610876, C: 134.109049585},
+		{A: 8.9e-10, B: 6.25587801019, C: 150866.08680029298},
+		{A: 9.8e-10, B: 4.71698591369, C: 12725.453434775},
+		{A: 6.9e-10, B: 5.05822197567, C: 1911.1994832172},
+		{A: 8.1e-10, B: 2.05307563846, C: 1596.1864422846},
+		{A: 7.5e-10, B: 2.05688075943, C: 26137.8997634784},
+		{A: 6.9e-10, B: 3.67576176321, C: 24609.0365675098},
+		{A: 8.1e-10, B: 1.38060133126, C: 52329.58509363319},
+		{A: 6.9e-10, B: 1.60181551296, C: 129483.91596626239},
+		{A: 6.7e-10, B: 5.43668176629, C: 181505.94343892598},
+		{A: 6.6e-10, B: 1.56953438995, C: 19804.8272915828},
+		{A: 6.6e-10, B: 3.92553131581, C: 146314.13330323418},
+		{A: 6.2e-10, B: 3.46967694133, C: 24925.4284371664},
+		{A: 7.9e-10, B: 3.56674606057, C: 49842.60989027639},
+		{A: 6.7e-10, B: 4.76959706409, C: 25881.717593137},
+		{A: 6.1e-10, B: 2.61995550094, C: 183724.7005431128},
+		{A: 6.1e-10, B: 1.63344766876, C: 13541.42120249119},
+		{A: 6.1e-10, B: 2.31153135385, C: 124156.43985787958},
+		{A: 5.8e-10, B: 3.88678278617, C: 18207.81398823521},
+		{A: 8.2e-10, B: 1.36242531494, C: 128850.44281258718},
+		{A: 5.8e-10, B: 2.9899017915, C: 110.2063212194},
+		{A: 6.4e-10, B: 4.98131448153, C: 188276.6540401716},
+		{A: 6.7e-10, B: 2.32849722473, C: 26294.08869001139},
+		{A: 5.7e-10, B: 3.72454115593, C: 29428.515568274},
+		{A: 6.6e-10, B: 1.69869503126, C: 51543.0225438352},
+		{A: 5.5e-10, B: 2.30093263701, C: 52643.7712735028},
+		{A: 6.9e-10, B: 1.78774826271, C: 51639.00177105299},
+		{A: 6.7e-10, B: 4.00863968986, C: 103299.34418310839},
+		{A: 7.4e-10, B: 6.14089486388, C: 14477.3511832},
+		{A: 5.4e-10, B: 5.27646510692, C: 49527.35145767539},
+		{A: 5.6e-10, B: 2.3349562066, C: 104358.72611329758},
+		{A: 6.2e-10, B: 3.41967311766, C: 104355.49390165479},
+		{A: 5e-10, B: 1.20854390978, C: 23976.2528281966},
+		{A: 6.4e-10, B: 0.58512976749, C: 307.5576209696},
+		{A: 6.2e-10, B: 3.6924578516, C: 641.41356173899},
+		{A: 6.2e-10, B: 4.47317710621, C: 636.9962720242},
+		{A: 4.9e-10, B: 4.09291926847, C: 52815.7035694624},
+		{A: 6.1e-10, B: 0.78849475911, C: 155997.72788435058},
+		{A: 4.7e-10, B: 2.1465925461, C: 7994.5284420242},
+		{A: 4.7e-10, B: 0.00507866085, C: 104138.31347085879},
+		{A: 4.5e-10, B: 2.28588893693, C: 25764.39772491679},
+		{A: 5.1e-10, B: 2.98348805265, C: 40565.2543247742},
+		{A: 4.7e-10, B: 4.03957254582, C: 77829.99768684579},
+		{A: 4.7e-10, B: 3.21862563103, C: 178063.3684939606},
+		{A: 4.5e-10, B: 4.41401819607, C: 103285.11708910679},
+		{A: 5.4e-10, B: 3.47879330948, C: 77844.22478084739},
+		{A: 5.4e-10, B: 2.83659707386, C: 6681.2248533996},
+		{A: 5.6e-10, B: 1.11360360738, C: 131498.89763806018},
+		{A: 4.6e-10, B: 3.38384624666, C: 26507.38778544939},
+		{A: 5.5e-10, B: 2.68637168389, C: 25934.1243310894},
+		{A: 4.6e-10, B: 4.78696197215, C: 104564.91166173479},
+		{A: 5.9e-10, B: 1.2987910069, C: 38813.3565763492},
+		{A: 4.3e-10, B: 3.86025201636, C: 10021.8372800994},
+		{A: 4.4e-10, B: 1.90320971624, C: 51528.79544983359},
+		{A: 5.5e-10, B: 0.4833507954, C: 23962.02573419499},
+		{A: 4.2e-10, B: 4.11693586607, C: 26190.99591579279},
+		{A: 4.4e-10, B: 1.64247485835, C: 76.2660712756},
+		{A: 4.2e-10, B: 6.21848377968, C: 26094.53170047421},
+		{A: 4.1e-10, B: 2.73996884659, C: 25867.49049913539},
+		{A: 4.3e-10, B: 0.38981425968, C: 1089.7087911936},
+		{A: 4.3e-10, B: 4.89046790101, C: 24395.7374720718},
+		{A: 4.1e-10, B: 0.44351702671, C: 25619.9381512198},
+		{A: 4.1e-10, B: 1.47439583353, C: 76681.75007643958},
+		{A: 3.9e-10, B: 1.71519867865, C: 111590.2881578968},
+		{A: 4.1e-10, B: 5.67594181048, C: 54509.0026760204},
+		{A: 4.9e-10, B: 1.65739950579, C: 1581.959348283},
+		{A: 4e-10, B: 1.85008425708, C: 27999.1026247914},
+		{A: 3.8e-10, B: 3.99668572981, C: 9384.8410080752},
+		{A: 3.7e-10, B: 2.83147987268, C: 102132.85546210999},
+		{A: 3.9e-10, B: 0.84546566819, C: 365230.6439820388},
+		{A: 3.7e-10, B: 4.65200082367, C: 90695.75207512038},
+		{A: 3.7e-10, B: 0.94736176192, C: 76667.52298243798},
+		{A: 4e-10, B: 0.16806354899, C: 22759.76748529401},
+		{A: 4.9e-10, B: 2.02480943113, C: 134991.4692049298},
+		{A: 4.1e-10, B: 5.85592543833, C: 52290.24557183361},
+		{A: 4e-10, B: 1.214911274, C: 64607.84893354619},
+		{A: 4.1e-10, B: 3.07842612799, C: 26081.27458267419},
+		{A: 3.5e-10, B: 3.89800430981, C: 157483.01859105378},
+		{A: 3.7e-10, B: 0.29068345666, C: 97580.90196505119},
+		{A: 3.5e-10, B: 4.74965057267, C: 39629.32434406539},
+		{A: 3.5e-10, B: 1.88777425098, C: 26521.614879451},
+		{A: 3.3e-10, B: 3.60227423859, C: 156100.82065856917},
+		{A: 3.5e-10, B: 1.9611419157, C: 74.7815985673},
+		{A: 4.5e-10, B: 3.72467663437, C: 39609.6545831656},
+		{A: 3.8e-10, B: 4.29218444251, C: 39743.7636327506},
+		{A: 3.2e-10, B: 5.95900501016, C: 77410.51304297059},
+		{A: 3.2e-10, B: 0.64794221724, C: 2648.454825473},
+		{A: 3.9e-10, B: 5.08510962281, C: 25863.55834587229},
+		{A: 3.1e-10, B: 1.62486752727, C: 141762.17980617538},
+		{A: 3.1e-10, B: 1.25910229689, C: 53242.3017603384},
+		{A: 3.1e-10, B: 2.65918037491, C: 104344.49901929598},
+		{A: 3.2e-10, B: 0.00169740803, C: 52061.36699446317},
+		{A: 3.1e-10, B: 6.12210016938, C: 25977.69682035479},
+		{A: 3e-10, B: 5.72239820341, C: 579.6875869988},
+		{A: 3e-10, B: 0.7055331987, C: 52125.80966124419},
+		{A: 3.9e-10, B: 1.60801806885, C: 333.8559407694},
+		{A: 3e-10, B: 5.28578152795, C: 102232.84870591838},
+		{A: 2.9e-10, B: 0.53270948692, C: 50696.93970908399},
+		{A: 2.9e-10, B: 0.40281274949, C: 35472.7441496494},
+		{A: 2.8e-10, B: 2.96171859812, C: 100909.03762133139},
+		{A: 3.4e-10, B: 1.41428136641, C: 140652.80125408198},
+		{A: 2.9e-10, B: 6.00401578551, C: 54294.57014352679},
+		{A: 3.2e-10, B: 0.45221016093, C: 116917.76426627958},
+		{A: 2.8e-10, B: 5.0708896171, C: 26237.46633870879},
+		{A: 2.8e-10, B: 5.33382835025, C: 107794.1875112622},
+		{A: 3e-10, B: 3.86080757411, C: 207643.8432024044},
+		{A: 2.8e-10, B: 4.81897403543, C: 170049.1702910366},
+		{A: 3.1e-10, B: 1.90989851759, C: 26308.315784013},
+		{A: 3.5e-10, B: 1.26114015069, C: 97112.93697469679},
+		{A: 2.8e-10, B: 2.01146788563, C: 52698.38370124219},
+		{A: 3.3e-10, B: 4.31496976407, C: 154938.34595416137},
+		{A: 2.8e-10, B: 4.4678187573, C: 78417.48823520739},
+		{A: 3.3e-10, B: 0.25034815279, C: 51653.22886505459},
+		{A: 2.6e-10, B: 0.07835049738, C: 51013.33157874059},
+		{A: 2.9e-10, B: 3.32582529322, C: 110634.68841628819},
+		{A: 2.7e-10, B: 6.01993388409, C: 25227.59321282139},
+		{A: 3.6e-10, B: 0.79025288269, C: 119116.85160956658},
+		{A: 2.6e-10, B: 4.68624143375, C: 632.7837393132},
+		{A: 2.7e-10, B: 1.14521834701, C: 121335.60871375339},
+		{A: 2.5e-10, B: 3.42095174931, C: 956.2891559706},
+		{A: 2.8e-10, B: 2.73386270717, C: 27311.72098235281},
+		{A: 2.4e-10, B: 0.82215620694, C: 314.1861798696},
+		{A: 2.5e-10, B: 5.21681600178, C: 25241.820306823},
+		{A: 2.5e-10, B: 3.57372833543, C: 52712.61079524379},
+		{A: 2.7e-10, B: 5.05183520711, C: 105940.68546158058},
+		{A: 3.1e-10, B: 2.25462520789, C: 26720.68688088739},
+		{A: 2.5e-10, B: 4.86890931082, C: 104778.21075717278},
+		{A: 2.3e-10, B: 3.01659387998, C: 52182.4348420484},
+		{A: 2.6e-10, B: 2.13973174244, C: 103711.71527998279},
+		{A: 2.3e-10, B: 4.60383496965, C: 52072.71350892979},
+		{A: 2.3e-10, B: 4.55605691468, C: 155571.81910783658},
+		{A: 2.2e-10, B: 3.69622699918, C: 735.8765135318},
+		{A: 2.3e-10, B: 2.40544682432, C: 8194.2753332086},
+		{A: 2.2e-10, B: 2.44094101414, C: 2703.6161546756},
+		{A: 2.7e-10, B: 5.29221944987, C: 26162.6847401415},
+		{A: 2.2e-10, B: 4.11517024183, C: 19202.75325164339},
+		{A: 2.9e-10, B: 4.25385019536, C: 647.0108333148},
+		{A: 2.1e-10, B: 2.11851790816, C: 207593.8465805002},
+		{A: 2.9e-10, B: 2.91003553544, C: 176953.98994186718},
+		{A: 2.6e-10, B: 5.68398596586, C: 95247.70557217918},
+		{A: 2.1e-10, B: 5.29289519906, C: 150244.3429994538},
+		{A: 2.4e-10, B: 3.05210539174, C: 130419.8459469712},
+		{A: 2e-10, B: 2.54144132983, C: 51219.51712717779},
+		{A: 2e-10, B: 6.1640195918, C: 35191.8101356918},
+		{A: 2.2e-10, B: 3.22920342008, C: 1083.0802322936},
+		{A: 2.8e-10, B: 0.30709453091, C: 157057.10981453978},
+		{A: 2.3e-10, B: 1.65280537431, C: 214364.55718174577},
+		{A: 2.7e-10, B: 3.72484040647, C: 621.7438008392},
+		{A: 2.3e-10, B: 2.59065413623, C: 71582.48457132299},
+		{A: 2.2e-10, B: 0.94588004362, C: 52022.0274726636},
+		{A: 2e-10, B: 3.8477679682, C: 5327.4761083828},
+		{A: 2.4e-10, B: 4.82779429778, C: 104371.28232719658},
+		{A: 1.9e-10, B: 1.94368317235, C: 22747.2907148744},
+		{A: 2.3e-10, B: 3.19739923216, C: 6044.2285813754},
+		{A: 2e-10, B: 0.58967836529, C: 172402.0364448084},
+		{A: 1.9e-10, B: 2.73169091081, C: 55503.94193942859},
+		{A: 1.8e-10, B: 1.63099638721, C: 48835.19385644859},
+		{A: 2.2e-10, B: 1.62646807429, C: 104347.73123093879},
+		{A: 1.8e-10, B: 4.90435895585, C: 77630.92568540938},
+		{A: 1.8e-10, B: 0.59687432455, C: 183570.921732628},
+		{A: 2.4e-10, B: 5.58828197916, C: 34282.1784747828},
+		{A: 1.8e-10, B: 5.54856348293, C: 209812.60368468694},
+		{A: 1.9e-10, B: 0.53136209334, C: 55516.4187098482},
+		{A: 2e-10, B: 0.73777252741, C: 129387.24732468258},
+		{A: 2.1e-10, B: 4.62446809035, C: 13362.4497067992},
+		{A: 2.1e-10, B: 1.94768946886, C: 487.6347512542},
+		{A: 1.9e-10, B: 4.18946427203, C: 78114.14622758799},
+		{A: 2.3e-10, B: 2.43977160852, C: 24822.3356629478},
+		{A: 1.7e-10, B: 2.24086275048, C: 26222.0121911592},
+		{A: 1.8e-10, B: 5.08348808758, C: 27684.0895838588},
+		{A: 1.7e-10, B: 4.31062701282, C: 50064.15596977079},
+		{A: 1.7e-10, B: 0.6271358155, C: 44295.7171298094},
+		{A: 2.2e-10, B: 0.09847423078, C: 26941.0995233262},
+		{A: 1.9e-10, B: 2.09224104001, C: 86143.79857806159},
+		{A: 1.6e-10, B: 0.43713509764, C: 101.9625182656},
+		{A: 1.6e-10, B: 4.27219175799, C: 24176.703658357},
+		{A: 1.8e-10, B: 5.24040005379, C: 68241.8721446232},
+		{A: 2e-10, B: 5.09682447452, C: 60055.89543648739},
+		{A: 1.6e-10, B: 4.08590900542, C: 147423.51185532758},
+		{A: 1.8e-10, B: 2.12913862198, C: 106570.36967048359},
+		{A: 1.6e-10, B: 1.0066838715, C: 25138.7275326044},
+		{A: 1.6e-10, B: 5.76419504418, C: 14.2270940016},
+		{A: 2.1e-10, B: 6.16511987091, C: 52169.17772424839},
+		{A: 1.6e-10, B: 0.82798074394, C: 96357.08412427259},
+		{A: 1.6e-10, B: 3.75399093257, C: 159.3017347922},
+		{A: 1.7e-10, B: 4.95698248546, C: 52225.8029050526},
+		{A: 1.7e-10, B: 6.11461465994, C: 26198.1094627936},
+		{A: 1.7e-10, B: 6.19302565011, C: 204151.27163553477},
+		{A: 1.7e-10, B: 5.34573358099, C: 78731.674415077},
+		{A: 1.6e-10, B: 1.90468795194, C: 128320.75184749259},
+		{A: 1.7e-10, B: 3.73180335896, C: 182085.63102592478},
+		{A: 1.7e-10, B: 5.86222137701, C: 32132.1317229496},
+		{A: 1.9e-10, B: 1.56034038551, C: 51969.62073471119},
+		{A: 1.8e-10, B: 2.5408624212, C: 25780.3455206046},
+		{A: 1.5e-10, B: 4.29267057949, C: 1692.1656695024},
+		{A: 1.6e-10, B: 1.75865740707, C: 53228.07466633679},
+		{A: 2e-10, B: 2.35700883433, C: 75615.25459924959},
+		{A: 1.5e-10, B: 5.29296109539, C: 130446.62925487179},
+		{A: 1.4e-10, B: 0.85174293693, C: 78903.60671103658},
+		{A: 1.5e-10, B: 5.50776870695, C: 52808.59002246159},
+		{A: 1.7e-10, B: 5.96622721781, C: 32769.1279949738},
+		{A: 1.4e-10, B: 5.40589812318, C: 52381.99183158559},
+		{A: 1.4e-10, B: 2.92783624754, C: 130226.21661243298},
+		{A: 1.4e-10, B: 4.73334676783, C: 26164.1692128498},
+		{A: 1.8e-10, B: 4.09540263999, C: 157586.80077963436},
+		{A: 1.5e-10, B: 5.00568645183, C: 28791.5192962498},
+		{A: 1.3e-10, B: 0.88297787282, C: 24292.64469785319},
+		{A: 1.8e-10, B: 0.07544318179, C: 2199.087343287},
+		{A: 1.4e-10, B: 0.39198838648, C: 1162.4747044078},
+		{A: 1.2e-10, B: 4.87283902225, C: 64901.25971792339},
+		{A: 1.3e-10, B: 4.28417448099, C: 23866.04650697719},
+		{A: 1.3e-10, B: 3.77892895408, C: 391318.54712361295},
+		{A: 1.2e-10, B: 0.90287358387, C: 104202.04936916218},
+		{A: 1.2e-10, B: 1.68139724364, C: 72936.23331633979},
+		{A: 1.5e-10, B: 3.7610819499, C: 111122.32316754239},
+		{A: 1.2e-10, B: 1.82629728829, C: 22909.7573510066},
+		{A: 1.3e-10, B: 1.25451832397, C: 26724.8994135984},
+		{A: 1.2e-10, B: 5.74844907374, C: 97670.38771289718},
+		{A: 1.2e-10, B: 1.52451201886, C: 130652.81480330898},
+		{A: 1.1e-10, B: 5.30694474936, C: 24601.92302050899},
+		{A: 1.4e-10, B: 2.82065667408, C: 1639.069517188},
+		{A: 1.5e-10, B: 4.79144654769, C: 77726.90491262719},
+		{A: 1.3e-10, B: 5.37369194634, C: 8989.46770543239},
+		{A: 1.3e-10, B: 3.84042504901, C: 25953.79409198919},
+		{A: 1.5e-10, B: 0.08558342266, C: 130443.39704322898},
+		{A: 1.1e-10, B: 5.16135808879, C: 233681.74972207437},
+		{A: 1.2e-10, B: 0.99700192092, C: 65831.6667743248},
+		{A: 1.2e-10, B: 4.51508367315, C: 78187.44335344699},
+		{A: 1.2e-10, B: 0.62022460619, C: 36109.7404216736},
+		{A: 1.1e-10, B: 1.08182792, C: 129373.02023068098},
+		{A: 1.4e-10, B: 5.59428027773, C: 25446.4895798352},
+		{A: 1.1e-10, B: 4.68045355937, C: 74923.09699802278},
+		{A: 1.4e-10, B: 4.68060965317, C: 27669.86248985719},
+		{A: 1.2e-10, B: 4.91061349082, C: 54087.0057663656},
+		{A: 1e-10, B: 0.73914692842, C: 103917.90082841998},
+		{A: 1.3e-10, B: 4.96538793287, C: 161079.37234650398},
+		{A: 1.1e-10, B: 0.31330958005, C: 182188.72380014337},
+		{A: 1.3e-10, B: 3.74649756573, C: 145204.75475114078},
+		{A: 1e-10, B: 1.79124533717, C: 949.1756089698},
+		{A: 1e-10, B: 4.24347093551, C: 78109.93061423779},
+		{A: 1e-10, B: 1.22394899357, C: 104505.39137678158},
+		{A: 1.2e-10, B: 3.21001019103, C: 102018.41617342478},
+		{A: 1.1e-10, B: 4.48294709049, C: 102769.65321801379},
+		{A: 1e-10, B: 4.33936275875, C: 95.9792272178},
+		{A: 1e-10, B: 3.73409843943, C: 199599.31813847594},
+		{A: 1.2e-10, B: 6.08780023115, C: 25450.90686955},
+		{A: 1.2e-10, B: 0.52219299955, C: 125112.03959948818},
+		{A: 1.2e-10, B: 3.12928292612, C: 11610.5519583742},
+		{A: 1.3e-10, B: 2.59745313501, C: 78378.1487134078},
+		{A: 1e-10, B: 0.95664142687, C: 45290.65639321759},
+		{A: 1.1e-10, B: 3.04942272888, C: 309.2783226558},
+		{A: 1.2e-10, B: 3.42470973506, C: 143005.6674078538},
+		{A: 9e-11, B: 5.24571653547, C: 34082.4315835984},
+		{A: 1.2e-10, B: 1.94632930172, C: 1135.6768718932},
+		{A: 1e-10, B: 2.75654212398, C: 103.7821885806},
+		{A: 1e-10, B: 6.06089846081, C: 78270.3379836226},
+		{A: 9e-11, B: 1.42295924162, C: 12546.481939083},
+		{A: 9e-11, B: 5.2388907902, C: 160.40736938481},
+		{A: 1e-10, B: 1.77339719648, C: 25754.0472008048},
+		{A: 1.1e-10, B: 3.45444324976, C: 27177.6119327678},
+		{A: 1e-10, B: 2.40808781707, C: 3462.2447058652},
+		{A: 1.2e-10, B: 0.211155779, C: 103932.12792242158},
+		{A: 1e-10, B: 0.44397528833, C: 26729.31670331319},
+		{A: 9e-11, B: 4.39355955971, C: 224.3447957019},
+		{A: 9e-11, B: 5.76319803259, C: 86457.98475793119},
+		{A: 9e-11, B: 3.21350384534, C: 123668.80510662538},
+		{A: 9e-11, B: 5.50134612867, C: 25352.02662804239},
+		{A: 9e-11, B: 3.61240341796, C: 76784.84285065818},
+		{A: 9e-11, B: 3.71346537596, C: 78213.71280281838},
+		{A: 1.1e-10, B: 0.04658635952, C: 136722.59155786238},
+		{A: 9e-11, B: 3.16339317765, C: 24079.34560241519},
+		{A: 1e-10, B: 1.52307057829, C: 196137.07343261078},
+		{A: 9e-11, B: 5.78277172101, C: 137210.22630911658},
+		{A: 9e-11, B: 5.34709321166, C: 16703.062133499},
+		{A: 1e-10, B: 1.10603431719, C: 76152.05911134499},
+		{A: 1e-10, B: 2.92130709301, C: 78257.08086582259},
+		{A: 9e-11, B: 4.9259138928, C: 52609.51802102519},
+		{A: 8e-11, B: 4.47915729665, C: 167850.0829477496},
+		{A: 1e-10, B: 3.52532046928, C: 50049.92887576919},
+		{A: 9e-11, B: 5.74701495675, C: 128220.75860368418},
+		{A: 9e-11, B: 3.39636103723, C: 25771.5112719176},
+		{A: 9e-11, B: 0.40885845708, C: 173511.41499690176},
+		{A: 9e-11, B: 0.0521477146, C: 209232.91609768817},
+		{A: 1.1e-10, B: 4.3947350056, C: 166740.70439565618},
+		{A: 8e-11, B: 0.83788793173, C: 52101.02468458109},
+		{A: 9e-11, B: 0.39740145698, C: 208173.534167499},
+		{A: 9e-11, B: 2.14648409917, C: 52250.5878817157},
+		{A: 1.1e-10, B: 0.77019715443, C: 181026.24909573558},
+		{A: 8e-11, B: 0.46458507594, C: 11.0457002639},
+		{A: 9e-11, B: 3.75726239516, C: 26395.46076254379},
+		{A: 1.1e-10, B: 3.88183253605, C: 99024.13645791399},
+		{A: 9e-11, B: 5.91589628233, C: 26404.2950112308},
+		{A: 8e-11, B: 3.62038830716, C: 28736.3579670472},
+		{A: 9e-11, B: 5.53218561478, C: 50910.238804522},
+		{A: 8e-11, B: 3.98222002302, C: 51226.63067417859},
+		{A: 8e-11, B: 2.20332298794, C: 35077.37084700659},
+		{A: 1e-10, B: 1.71606704784, C: 132028.58860315479},
+		{A: 9e-11, B: 4.19828805921, C: 123200.84011627098},
+		{A: 8e-11, B: 4.94949508683, C: 1263.15736257819},
+		{A: 8e-11, B: 0.3825502474, C: 78800.51393681798},
+		{A: 8e-11, B: 3.55931433878, C: 25508.2155545754},
+		{A: 8e-11, B: 2.06623542364, C: 133882.09065283637},
+		{A: 8e-11, B: 2.76004606287, C: 103498.41618454478},
+		{A: 9e-11, B: 6.07146960892, C: 52595.29092702359},
+		{A: 1e-10, B: 4.64768763301, C: 240452.46032331997},
+		{A: 1e-10, B: 4.75089417351, C: 158116.491744729},
+		{A: 9e-11, B: 3.16830318341, C: 156531.3001848032},
+		{A: 9e-11, B: 0.51442466727, C: 233731.7463439786},
+		{A: 8e-11, B: 1.84170519176, C: 52325.36948028299},
+		{A: 9e-11, B: 1.5802092941, C: 130866.11389874699},
+		{A: 8e-11, B: 1.31021834242, C: 78160.61665050399},
+		{A: 7e-11, B: 3.52394232228, C: 209658.82487420217},
+		{A: 7e-11, B: 5.30361639156, C: 51852.30086649099},
+		{A: 7e-11, B: 1.68129390597, C: 103704.60173298199},
+		{A: 7e-11, B: 4.93711462539, C: 77616.69859140778},
+		{A: 7e-11, B: 4.26547182656, C: 130363.24963659538},
+		{A: 8e-11, B: 5.88262362064, C: 51955.39364070959},
+		{A: 9e-11, B: 2.85041915486, C: 52286.01260436779},
+		{A: 9e-11, B: 4.54751921819, C: 222224.97657418498},
+		{A: 7e-11, B: 2.95164397426, C: 58857.03113654799},
+		{A: 7e-11, B: 5.09932324366, C: 26421.7590823436},
+		{A: 8e-11, B: 5.95900851407, C: 625.6701923124},
+		{A: 8e-11, B: 2.87611784406, C: 182595.65223011957},
+		{A: 7e-11, B: 2.95245442922, C: 70269.18098269838},
+		{A: 9e-11, B: 1.85768285044, C: 2168.7604822826},
+		{A: 9e-11, B: 5.87894263333, C: 203041.8930834414},
+		{A: 7e-11, B: 5.76376514627, C: 53399.624123927},
+		{A: 7e-11, B: 3.19368974135, C: 77947.31755506598},
+		{A: 7e-11, B: 0.58513513972, C: 17893.6278083656},
+		{A: 7e-11, B: 1.25969543054, C: 104275.34649502118},
+		{A: 7e-11, B: 1.78997113742, C: 187167.2754880782},
+		{A: 7e-11, B: 3.04234073392, C: 77101.23472031478},
+		{A: 7e-11, B: 5.13452237557, C: 77307.42026875199},
+		{A: 8e-11, B: 2.39389370291, C: 51534.3927214094},
+		{A: 7e-11, B: 0.36315569295, C: 179172.74704605396},
+		{A: 9e-11, B: 4.65044386389, C: 156954.01704032117},
+		{A: 7e-11, B: 5.75015377153, C: 78580.10129437919},
+		{A: 8e-11, B: 4.90310056069, C: 101703.15774082378},
+		{A: 7e-11, B: 2.81152781596, C: 92741.06060792258},
+		{A: 7e-11, B: 4.53777069646, C: 78339.97549599818},
+		{A: 8e-11, B: 3.72793390212, C: 77795.74443436819},
+		{A: 7e-11, B: 4.99225219771, C: 102659.44689679438},
+		{A: 8e-11, B: 1.24101149187, C: 128857.55635958798},
+		{A: 6e-11, B: 1.24806705522, C: 181659.72224941078},
+		{A: 7e-11, B: 1.41908776548, C: 39450.3528483734},
+		{A: 8e-11, B: 5.07207947299, C: 202420.14928260216},
+		{A: 6e-11, B: 3.88021248158, C: 104991.50985261079},
+		{A: 7e-11, B: 0.4481172726, C: 104301.61594439259},
+		{A: 7e-11, B: 6.19019459405, C: 37698.4550999484},
+		{A: 8e-11, B: 3.27628873745, C: 183145.012956114},
+		{A: 6e-11, B: 1.04156861985, C: 104197.83375581198},
+		{A: 7e-11, B: 6.04804574225, C: 103189.13786188899},
+		{A: 7e-11, B: 6.15588549143, C: 75930.51303185058},
+		{A: 7e-11, B: 2.01982955946, C: 176332.24614102798},
+		{A: 7e-11, B: 4.68299502093, C: 838.9692877504},
+		{A: 7e-11, B: 4.32694078051, C: 104248.51979207818},
+		{A: 6e-11, B: 0.30098706976, C: 54824.2611086214},
+		{A: 7e-11, B: 2.39268679107, C: 80596.9058175946},
+		{A: 6e-11, B: 0.45279066685, C: 225687.22128005017},
+		{A: 6e-11, B: 4.9361756676, C: 215473.93573383917},
+		{A: 6e-11, B: 4.22528261713, C: 79330.20490191258},
+		{A: 7e-11, B: 5.53062936347, C: 917.9302859818},
+		{A: 6e-11, B: 0.27837413876, C: 76137.83201734339},
+		{A: 7e-11, B: 1.50054882304, C: 78313.70604662679},
+		{A: 6e-11, B: 4.84865195534, C: 213255.1786296524},
+		{A: 7e-11, B: 0.37969316028, C: 102872.74599223239},
+		{A: 6e-11, B: 4.82915128356, C: 79859.89586700719},
+		{A: 7e-11, B: 2.36435599398, C: 58220.0348645238},
+		{A: 7e-11, B: 0.762437199, C: 183674.70392120857},
+		{A: 6e-11, B: 3.48561717935, C: 61560.64729122359},
+		{A: 7e-11, B: 3.15542325331, C: 53029.0026649004},
+		{A: 6e-11, B: 2.04066112896, C: 156534.53239644598},
+		{A: 6e-11, B: 4.08906102427, C: 91919.56991589899},
+		{A: 6e-11, B: 3.6498851175, C: 151199.94274106238},
+		{A: 6e-11, B: 4.09613031145, C: 53867.97195265079},
+		{A: 6e-11, B: 3.49486002612, C: 66653.15746634839},
+		{A: 7e-11, B: 2.89745929648, C: 230239.17477710897},
+		{A: 6e-11, B: 3.99809593088, C: 50380.54783942739},
+		{A: 6e-11, B: 3.65663654813, C: 70383.6202713836},
+		{A: 6e-11, B: 6.23319937932, C: 182619.20332637738},
+		{A: 6e-11, B: 0.15202166498, C: 51859.41441349179},
+		{A: 6e-11, B: 0.36162525319, C: 26823.77965510599},
+		{A: 6e-11, B: 5.03417122369, C: 78786.28684281638},
+		{A: 6e-11, B: 2.61401898803, C: 80382.47328510099},
+		{A: 6e-11, B: 5.0693978209, C: 51841.950342379},
+		{A: 8e-11, B: 4.96432797037, C: 52396.2189255872},
+		{A: 6e-11, B: 3.74091556543, C: 155475.15046625677},
+		{A: 6e-11, B: 4.29792825666, C: 52812.8025551726},
+		{A: 7e-11, B: 6.02219529486, C: 156507.7490885454},
+		{A: 5e-11, B: 6.28085632767, C: 31415.379249957},
+		{A: 6e-11, B: 2.7051772072, C: 52492.19815280499},
+		{A: 7e-11, B: 6.25185953525, C: 74935.5737684424},
+		{A: 5e-11, B: 6.03800330793, C: 84944.9342781222},
+		{A: 7e-11, B: 2.08385155566, C: 104819.57755665119},
+		{A: 5e-11, B: 2.79143401592, C: 51315.49635439559},
+		{A: 7e-11, B: 0.15300257128, C: 2014.9816717978},
+		{A: 6e-11, B: 5.45690475475, C: 1243.4876016784},
+		{A: 5e-11, B: 1.7231082929, C: 103718.82882698359},
+		{A: 5e-11, B: 0.0534317532, C: 27044.1922975448},
+		{A: 5e-11, B: 1.35980682609, C: 94329.77528619739},
+		{A: 7e-11, B: 5.94496795198, C: 256327.07791868312},
+		{A: 6e-11, B: 1.88665916947, C: 80174.90890793978},
+		{A: 5e-11, B: 2.15640465605, C: 235900.50682626115},
+		{A: 5e-11, B: 5.97271372078, C: 156314.1197540072},
+		{A: 6e-11, B: 2.6662090451, C: 229129.79622501557},
+		{A: 5e-11, B: 2.52891655111, C: 123758.29085447139},
+		{A: 6e-11, B: 1.20953605164, C: 130459.18546877075},
+		{A: 5e-11, B: 4.06443360712, C: 130285.73689738619},
+		{A: 7e-11, B: 1.34355308244, C: 1731.1223529326},
+		{A: 5e-11, B: 2.36158299954, C: 78896.49316403578},
+		{A: 5e-11, B: 0.96987247797, C: 149288.74325784517},
+		{A: 5e-11, B: 0.76610110351, C: 24864.08530079559},
+		{A: 5e-11, B: 1.95647355963, C: 76571.54375522019},
+		{A: 5e-11, B: 1.13701088228, C: 26312.2479372761},
+		{A: 5e-11, B: 1.05826639118, C: 27780.06881107659},
+		{A: 5e-11, B: 4.54130791881, C: 156740.7179448832},
+		{A: 6e-11, B: 3.58276567647, C: 198489.9395863826},
+		{A: 5e-11, B: 0.51603916276, C: 171292.65789271498},
+		{A: 6e-11, B: 3.03452226064, C: 77741.13200662879},
+		{A: 5e-11, B: 1.46111595893, C: 101011.00013959699},
+		{A: 5e-11, B: 0.02805624095, C: 149756.7082481996},
+		{A: 5e-11, B: 5.10195955921, C: 112231.70171963578},
+		{A: 5e-11, B: 4.87459278742, C: 78413.27262185719},
+		{A: 7e-11, B: 3.69898590349, C: 81604.32185142238},
+		{A: 5e-11, B: 0.76195152302, C: 417406.4502651872},
+		{A: 5e-11, B: 0.55904094194, C: 2383.1930147762},
+		{A: 5e-11, B: 3.95096384085, C: 78188.92782615528},
+		{A: 5e-11, B: 1.76549372525, C: 53771.99272543299},
+		{A: 5e-11, B: 3.3535670841, C: 205260.65018762814},
+		{A: 6e-11, B: 5.57902902188, C: 87253.17713015496},
+		{A: 5e-11, B: 0.26610630357, C: 235746.72801577637},
+		{A: 5e-11, B: 2.90596877294, C: 154194.22245657316},
+		{A: 4e-11, B: 1.1697417665, C: 193937.9860893238},
+		{A: 4e-11, B: 3.93130462476, C: 130289.95251073639},
+		{A: 4e-11, B: 4.33627478046, C: 89.485747846},
+		{A: 5e-11, B: 2.8002233534, C: 4437.51420837359},
+		{A: 6e-11, B: 4.70286246928, C: 91805.13062721379},
+		{A: 5e-11, B: 1.82284966431, C: 1884.9011634174},
+		{A: 5e-11, B: 2.02580304988, C: 259769.65286364855},
+		{A: 4e-11, B: 4.65165864815, C: 195047.36464141717},
+		{A: 4e-11, B: 0.588581477, C: 323.5054166574},
+		{A: 4e-11, B: 4.0062366874, C: 175376.64639941938},
+		{A: 4e-11, B: 0.64833718455, C: 156377.8556523106},
+		{A: 4e-11, B: 2.71436739189, C: 182402.02289558138},
+		{A: 5e-11, B: 4.68929437146, C: 79315.97780791098},
+		{A: 4e-11, B: 5.71176695959, C: 81591.84508100279},
+		{A: 4e-11, B: 1.42876132655, C: 52252.07235442399},
+		{A: 5e-11, B: 2.55069425936, C: 154308.6617452584},
+		{A: 5e-11, B: 1.42813778372, C: 266540.3634648941},
+		{A: 4e-11, B: 2.00085806148, C: 189386.03259226496},
+		{A: 5e-11, B: 4.29231489707, C: 220025.88923089797},
+		{A: 4e-11, B: 6.20316213523, C: 79116.90580647459},
+		{A: 4e-11, B: 0.85257120719, C: 118007.4730574732},
+		{A: 4e-11, B: 5.77579934605, C: 129799.61842155698},
+		{A: 4e-11, B: 4.47492094862, C: 137678.191299471},
+		{A: 4e-11, B: 1.6456663118, C: 78697.42116259939},
+		{A: 5e-11, B: 2.1751453684, C: 51329.7234483972},
+		{A: 6e-11, B: 4.78710650697, C: 102755.42612401219},
+		{A: 4e-11, B: 1.46002058771, C: 935.92998070881},
+		{A: 5e-11, B: 5.13889133658, C: 130907.4806982254},
+		{A: 4e-11, B: 1.8932118711, C: 2111.6503133776},
+		{A: 4e-11, B: 0.10839681347, C: 38.1330356378},
+		{A: 5e-11, B: 4.42201446083, C: 78057.52387628538},
+		{A: 5e-11, B: 5.65870377214, C: 1300.826818205},
+		{A: 5e-11, B: 5.90579064813, C: 1478.8665740644},
+		{A: 4e-11, B: 5.24580055005, C: 52309.9153327334},
+		{A: 4e-11, B: 2.74756161638, C: 144916.86689107097},
+		{A: 5e-11, B: 3.31232177694, C: 48847.6706268682},
+		{A: 4e-11, B: 4.43048849829, C: 118828.96374949679},
+		{A: 4e-11, B: 0.51369916437, C: 181563.05360783098},
+		{A: 4e-11, B: 5.22571179686, C: 261988.40996783535},
+		{A: 4e-11, B: 0.80000960641, C: 1485.2907067032},
+		{A: 4e-11, B: 3.25642623421, C: 195181.47369100217},
+		{A: 5e-11, B: 5.23264312237, C: 126996.94076290558},
+		{A: 4e-11, B: 3.86524951456, C: 209762.60706278277},
+		{A: 4e-11, B: 3.43760746038, C: 234261.43730907317},
+		{A: 4e-11, B: 0.67741502331, C: 131079.41299418497},
+		{A: 4e-11, B: 5.40862384292, C: 104984.39630560997},
+		{A: 4e-11, B: 0.30384598125, C: 285907.552627127},
+		{A: 4e-11, B: 5.65734972357, C: 51868.2486621788},
+		{A: 4e-11, B: 5.55015227967, C: 149846.1939960456},
+		{A: 4e-11, B: 1.51752118872, C: 103814.80805420138},
+		{A: 4e-11, B: 2.53135799952, C: 79487.5272655012},
+		{A: 4e-11, B: 1.88512485652, C: 54879.422437824},
+		{A: 4e-11, B: 5.12386500502, C: 182622.43553802016},
+		{A: 4e-11, B: 4.36228014188, C: 207747.625390985},
+		{A: 4e-11, B: 3.24471199066, C: 130020.03106399579},
+		{A: 4e-11, B: 0.51045647492, C: 107692.22499299659},
+		{A: 4e-11, B: 4.26267840541, C: 132658.27281205778},
+		{A: 4e-11, B: 3.08522628552, C: 175844.61138977378},
+		{A: 4e-11, B: 3.38866894732, C: 104888.41707839219},
+		{A: 4e-11, B: 1.21404057369, C: 139543.4227019886},
+		{A: 5e-11, B: 3.75130732, C: 62197.64356324779},
+		{A: 4e-11, B: 0.30056239753, C: 233202.055378884},
+		{A: 4e-11, B: 4.20598588282, C: 218916.51067880457},
+		{A: 4e-11, B: 1.34568004641, C: 182828.62108645737},
+		{A: 4e-11, B: 0.68519128486, C: 104276.83096772949},
+		{A: 4e-11, B: 0.20412928769, C: 169093.57054942797},
+		{A: 3e-11, B: 0.98973904852, C: 105418.10804348679},
+		{A: 4e-11, B: 3.42019897755, C: 61279.713277266},
+		{A: 3e-11, B: 5.22780381742, C: 78338.49102328988},
+		{A: 3e-11, B: 2.67114319601, C: 24998.19435038059},
+		{A: 4e-11, B: 1.65814724695, C: 50800.03248330259},
+		{A: 4e-11, B: 3.24764679291, C: 28286.9904848612},
+		{A: 4e-11, B: 6.01723954923, C: 104237.17327761157},
+		{A: 3e-11, B: 3.99050522839, C: 71378.55953479178},
+		{A: 3e-11, B: 3.46632047879, C: 45494.58142974879},
+		{A: 3e-11, B: 4.27304181959, C: 130593.29451835579},
+		{A: 3e-11, B: 4.05932083484, C: 52822.81711646319},
+		{A: 3e-11, B: 5.89324219451, C: 50483.640613646},
+		{A: 3e-11, B: 2.88091404327, C: 78149.27013603736},
+		{A: 3e-11, B: 4.09573256493, C: 155460.9233722552},
+		{A: 3e-11, B: 0.40695406256, C: 52278.89905736699},
+		{A: 3e-11, B: 0.53381434741, C: 26709.6469424134},
+		{A: 3e-11, B: 1.00008179165, C: 50264.6067999312},
+		{A: 4e-11, B: 5.67939124426, C: 106470.37642667518},
+		{A: 3e-11, B: 0.77610404005, C: 142871.55835826878},
+		{A: 3e-11, B: 3.80375047171, C: 26402.0893214438},
+		{A: 3e-11, B: 2.44134109777, C: 156520.30530244438},
+		{A: 3e-11, B: 0.98764974659, C: 128843.32926558638},
+		{A: 3e-11, B: 3.6290381593, C: 130435.63437251298},
+		{A: 3e-11, B: 2.12651302584, C: 78469.89497315978},
+		{A: 3e-11, B: 4.29158350083, C: 154408.65498906677},
+		{A: 3e-11, B: 1.06499703474, C: 26734.913974889},
+		{A: 3e-11, B: 1.86490629185, C: 51707.84129279399},
+		{A: 3e-11, B: 5.95559848753, C: 104344.98400739678},
+		{A: 4e-11, B: 3.60184879954, C: 207114.15223730978},
+		{A: 3e-11, B: 3.28684847821, C: 208276.62694171758},
+		{A: 3e-11, B: 2.80509981839, C: 104358.2411251968},
+		{A: 3e-11, B: 5.02808401414, C: 130432.40216087017},
+		{A: 3e-11, B: 6.1660165005, C: 20043.6745601988},
+		{A: 3e-11, B: 5.29581547615, C: 57503.2823915312},
+		{A: 3e-11, B: 1.3503644855, C: 23919.1426592916},
+		{A: 2e-11, B: 3.75076268715, C: 130005.80396999417},
+		{A: 3e-11, B: 3.05479754348, C: 162810.49469943656},
+		{A: 3e-11, B: 2.06087055668, C: 52065.59996192899},
+		{A: 3e-11, B: 1.11245383788, C: 192828.60753723036},
+		{A: 2e-11, B: 1.90218644384, C: 24356.7807886416},
+		{A: 2e-11, B: 4.74203919229, C: 76887.93562487679},
+		{A: 2e-11, B: 0.63548334553, C: 23384.2869868986},
+		{A: 2e-11, B: 2.51564214512, C: 26667.590728573},
+		{A: 2e-11, B: 6.08909078783, C: 116783.65521669458},
+		{A: 2e-11, B: 3.56733241522, C: 26073.67604757259},
+		{A: 3e-11, B: 5.60392923976, C: 104466.051854982},
+		{A: 2e-11, B: 3.56126103854, C: 26189.8656598398},
+		{A: 2e-11, B: 5.97797555746, C: 50167.24874398939},
+		{A: 2e-11, B: 4.41481991835, C: 65538.25598994759},
+		{A: 2e-11, B: 5.91297170155, C: 87367.61641884019},
+		{A: 2e-11, B: 5.1750816963, C: 163298.1294506908},
+		{A: 3e-11, B: 1.10832319418, C: 25600.26839032},
+		{A: 2e-11, B: 2.11477268878, C: 25773.71696170459},
+		{A: 2e-11, B: 2.15515419441, C: 51951.46148744649},
+		{A: 3e-11, B: 5.43138498093, C: 106684.80895916879},
+		{A: 2e-11, B: 0.11511311275, C: 27170.98337386779},
+		{A: 2e-11, B: 5.22579437397, C: 6885.14988993081},
+		{A: 2e-11, B: 2.93412437896, C: 63786.3582415226},
+		{A: 3e-11, B: 6.19860078008, C: 25004.8229092806},
+		{A: 2e-11, B: 0.23150025826, C: 24448.8336243862},
+		{A: 2e-11, B: 0.54898566845, C: 153084.84390447979},
+		{A: 2e-11, B: 0.63373893542, C: 1265.5674786264},
+		{A: 2e-11, B: 4.49873870855, C: 50007.0458008658},
+		{A: 2e-11, B: 5.57750243319, C: 25928.601406782},
+		{A: 2e-11, B: 5.66467419812, C: 25466.159340735},
+		{A: 2e-11, B: 5.10682926241, C: 159969.99379441058},
+		{A: 2e-11, B: 3.49751807681, C: 259819.64948555277},
+	},
+	// L2, 395 terms
+	{
+		{A: 0.00053049845, B: 0, C: 0},
+		{A: 0.00016903658, B: 4.69072300649, C: 26087.9031415742},
+		{A: 7.396711e-05, B: 1.34735624669, C: 52175.8062831484},
+		{A: 3.018297e-05, B: 4.45643539705, C: 78263.70942472259},
+		{A: 1.107419e-05, B: 1.26226537554, C: 104351.61256629678},
+		{A: 3.78173e-06, B: 4.319980559, C: 130439.51570787099},
+		{A: 1.22998e-06, B: 1.06868541052, C: 156527.41884944518},
+		{A: 3.8663e-07, B: 4.08011610182, C: 182615.3219910194},
+		{A: 1.4898e-07, B: 4.6334308581, C: 1109.3785520934},
+		{A: 1.1861e-07, B: 0.79187646439, C: 208703.2251325936},
+		{A: 5.243e-08, B: 4.71799772791, C: 24978.5245894808},
+		{A: 3.575e-08, B: 3.77317513032, C: 234791.12827416777},
+		{A: 2.566e-08, B: 1.44059109766, C: 27197.2816936676},
+		{A: 2.045e-08, B: 1.49570544876, C: 51066.427731055},
+		{A: 1.064e-08, B: 0.46023695675, C: 260879.03141574195},
+		{A: 9.72e-09, B: 1.80344701358, C: 955.5997416086},
+		{A: 6.28e-09, B: 6.18483168149, C: 529.6909650946},
+		{A: 6.28e-09, B: 4.8493010532, C: 24498.8302462904},
+		{A: 7.63e-09, B: 4.54299337366, C: 77154.33087262919},
+		{A: 6.54e-09, B: 0.98170349539, C: 5661.3320491522},
+		{A: 5.72e-09, B: 5.92841937309, C: 25028.521211385},
+		{A: 5.52e-09, B: 2.1322864657, C: 20426.571092422},
+		{A: 5.07e-09, B: 4.11466890786, C: 4551.9534970588},
+		{A: 6.08e-09, B: 4.54152310086, C: 2218.7571041868},
+		{A: 4.39e-09, B: 3.40705996719, C: 11322.6640983044},
+		{A: 3.81e-09, B: 2.83442391504, C: 26617.5941066688},
+		{A: 3.7e-09, B: 0.8236064656, C: 6770.7106012456},
+		{A: 3.12e-09, B: 5.1878582963, C: 46514.4742339962},
+		{A: 3.14e-09, B: 3.42002895816, C: 286966.9345573162},
+		{A: 3.07e-09, B: 3.20507351217, C: 1059.3819301892},
+		{A: 3.32e-09, B: 0.97416155325, C: 25132.3033999656},
+		{A: 3.27e-09, B: 2.22989850002, C: 21535.9496445154},
+		{A: 3.03e-09, B: 4.68482058244, C: 27043.5028831828},
+		{A: 2.47e-09, B: 5.87612469398, C: 16983.9961474566},
+		{A: 3.11e-09, B: 4.22422008005, C: 3442.5749449654},
+		{A: 2.37e-09, B: 4.37101224231, C: 114.43928868521},
+		{A: 2.34e-09, B: 0.93917232374, C: 30639.856638633},
+		{A: 2.73e-09, B: 1.29086264556, C: 103242.2340142034},
+		{A: 2.09e-09, B: 3.30158370895, C: 213.299095438},
+		{A: 1.97e-09, B: 2.07792605062, C: 22645.32819660879},
+		{A: 1.82e-09, B: 0.62534354989, C: 25448.00585526019},
+		{A: 2.19e-09, B: 1.3418873834, C: 28306.66024576099},
+		{A: 1.68e-09, B: 6.20806483822, C: 27147.28507176339},
+		{A: 1.56e-09, B: 3.91999448441, C: 32858.61374281979},
+		{A: 1.6e-09, B: 5.7937744941, C: 52705.49724824299},
+		{A: 1.53e-09, B: 4.33936015634, C: 1589.0728952838},
+		{A: 1.61e-09, B: 4.80105233203, C: 23869.1460373874},
+		{A: 1.48e-09, B: 1.97575825359, C: 72602.37737557039},
+		{A: 1.45e-09, B: 5.96024756685, C: 14765.2390432698},
+		{A: 1.29e-09, B: 4.16213277137, C: 23969.1392811958},
+		{A: 1.35e-09, B: 3.8677543707, C: 51220.20654153979},
+		{A: 1.4e-09, B: 3.66642468738, C: 25558.2121764796},
+		{A: 1.08e-09, B: 1.39723983534, C: 50586.73338786459},
+		{A: 1.15e-09, B: 0.15124159675, C: 26301.2022370122},
+		{A: 1.13e-09, B: 4.9813748525, C: 79373.087976816},
+		{A: 1.15e-09, B: 3.05230359467, C: 53285.1848352418},
+		{A: 9.9e-10, B: 3.99635236807, C: 56727.7597802072},
+		{A: 9.9e-10, B: 3.84242768306, C: 83925.0414738748},
+		{A: 9.3e-10, B: 2.04998627084, C: 26068.2333806744},
+		{A: 9.2e-10, B: 0.08628005998, C: 313054.83769889036},
+		{A: 9.4e-10, B: 4.30968259383, C: 129330.13715577759},
+		{A: 8.5e-10, B: 3.01984486981, C: 53235.18821333759},
+		{A: 1.05e-09, B: 5.32363189985, C: 7238.6755916},
+		{A: 9.5e-10, B: 2.24093904831, C: 19317.1925403286},
+		{A: 9.9e-10, B: 1.00218248773, C: 29530.4780865396},
+		{A: 7.5e-10, B: 5.40269356209, C: 26091.7844769322},
+		{A: 7.4e-10, B: 4.31017375756, C: 2333.196392872},
+		{A: 8.6e-10, B: 0.78555390902, C: 57837.1383323006},
+		{A: 8.1e-10, B: 0.9588922979, C: 26107.57290247399},
+		{A: 8.5e-10, B: 3.28561166885, C: 12432.0426503978},
+		{A: 7e-10, B: 3.55533072657, C: 13521.7514415914},
+		{A: 7.8e-10, B: 0.27707431029, C: 37410.5672398786},
+		{A: 6.3e-10, B: 0.6582852544, C: 110012.94461544899},
+		{A: 6.4e-10, B: 2.68437002997, C: 43071.8992890308},
+		{A: 6.7e-10, B: 3.8921657878, C: 26084.0218062162},
+		{A: 6.4e-10, B: 2.75958867988, C: 40853.142184844},
+		{A: 6.1e-10, B: 5.77836694481, C: 12566.1516999828},
+		{A: 6.9e-10, B: 3.44813862795, C: 10213.285546211},
+		{A: 6e-10, B: 0.79452201416, C: 53131.406024757},
+		{A: 6.3e-10, B: 5.02106928248, C: 98690.28051714458},
+		{A: 6.3e-10, B: 2.49160532665, C: 78793.40038981718},
+		{A: 5.9e-10, B: 0.1554109817, C: 36301.18868778519},
+		{A: 5.6e-10, B: 1.01839356017, C: 27676.976036858},
+		{A: 5.3e-10, B: 1.55958841442, C: 49957.0491789616},
+		{A: 5.2e-10, B: 5.14041028675, C: 52156.1365222486},
+		{A: 5.2e-10, B: 1.15062443564, C: 26202.34243025941},
+		{A: 5.2e-10, B: 0.5368757688, C: 77308.10968311399},
+		{A: 4.8e-10, B: 1.60851837486, C: 25234.70675982219},
+		{A: 4.8e-10, B: 5.19507775798, C: 48733.23133818299},
+		{A: 5.3e-10, B: 5.49113976101, C: 25661.3049506982},
+		{A: 4.6e-10, B: 5.18676728087, C: 77204.32749453338},
+		{A: 4.4e-10, B: 4.971456138, C: 25973.46385288896},
+		{A: 5.4e-10, B: 0.32708522231, C: 25874.6040461362},
+		{A: 4.2e-10, B: 2.21900478384, C: 52179.6876185064},
+		{A: 4.5e-10, B: 2.52716095523, C: 103292.23063610759},
+		{A: 4.8e-10, B: 0.4894487653, C: 51646.11531805379},
+		{A: 3.9e-10, B: 6.2527517396, C: 47623.8527860896},
+		{A: 4.1e-10, B: 2.81015791586, C: 41962.5207369374},
+		{A: 3.7e-10, B: 5.01445357044, C: 26080.78959457339},
+		{A: 3.8e-10, B: 6.08585317961, C: 79323.09135491178},
+		{A: 4.3e-10, B: 3.24574920597, C: 52389.1053785864},
+		{A: 4.1e-10, B: 2.43000457106, C: 639.897286314},
+		{A: 3.9e-10, B: 0.76157461206, C: 82815.66292178139},
+		{A: 3.4e-10, B: 3.28090243752, C: 62389.09182935939},
+		{A: 3.7e-10, B: 0.05738918168, C: 38519.945791972},
+		{A: 3.5e-10, B: 5.78457148656, C: 68050.42387851159},
+		{A: 4e-10, B: 3.14856042352, C: 426.598190876},
+		{A: 4.2e-10, B: 4.09553132731, C: 52195.47604404819},
+		{A: 3.2e-10, B: 2.45518077222, C: 38654.05484155699},
+		{A: 3.9e-10, B: 1.80165134294, C: 33326.5787331742},
+		{A: 4.1e-10, B: 4.32141055637, C: 26095.016688575},
+		{A: 3.2e-10, B: 3.72358129034, C: 136100.84775702318},
+		{A: 4e-10, B: 5.69015360764, C: 31749.2351907264},
+		{A: 3.2e-10, B: 1.65828846012, C: 105460.99111839019},
+		{A: 3.2e-10, B: 1.0254998481, C: 155418.04029735178},
+		{A: 3e-10, B: 1.57915654792, C: 52168.69273614759},
+		{A: 3.1e-10, B: 0.69335015231, C: 50057.04242277},
+		{A: 3.3e-10, B: 4.04678616141, C: 55618.3812281138},
+		{A: 2.9e-10, B: 3.72464718175, C: 51535.90899683439},
+		{A: 2.9e-10, B: 5.7365444786, C: 129380.13377768178},
+		{A: 3.8e-10, B: 3.74472554242, C: 18849.2275499742},
+		{A: 2.8e-10, B: 3.05059885517, C: 339142.7408404646},
+		{A: 3.5e-10, B: 0.74194291507, C: 52171.9249477904},
+		{A: 2.8e-10, B: 0.73918545262, C: 7880.08915333899},
+		{A: 3.6e-10, B: 1.26742819742, C: 51116.4243529592},
+		{A: 3.5e-10, B: 5.2929814316, C: 45405.0956819028},
+		{A: 3.2e-10, B: 5.79438282818, C: 18093.37469954999},
+		{A: 2.6e-10, B: 5.79318346346, C: 66941.04532641819},
+		{A: 2.8e-10, B: 4.27104078603, C: 54394.56338733519},
+		{A: 2.4e-10, B: 5.0630479161, C: 2118.7638603784},
+		{A: 3e-10, B: 1.05420470769, C: 52182.9198301492},
+		{A: 2.5e-10, B: 1.7599691024, C: 124778.18365871879},
+		{A: 2.2e-10, B: 4.43631488516, C: 3328.13565628019},
+		{A: 2.2e-10, B: 5.09106835544, C: 99799.65906923798},
+		{A: 2.2e-10, B: 1.88199362566, C: 78244.0396638228},
+		{A: 2.1e-10, B: 2.561232112, C: 94138.32702008578},
+		{A: 2.5e-10, B: 5.46150069597, C: 104881.30353139139},
+		{A: 2.5e-10, B: 0.05236467599, C: 26514.5013324502},
+		{A: 2.5e-10, B: 3.02983070039, C: 77734.01845962799},
+		{A: 2e-10, B: 3.48966334599, C: 103396.01282468818},
+		{A: 2.1e-10, B: 3.97495995227, C: 53764.8791784322},
+		{A: 1.8e-10, B: 1.07895331282, C: 28421.0995344462},
+		{A: 1.9e-10, B: 1.98308917333, C: 23754.70674870219},
+		{A: 1.8e-10, B: 4.5863136596, C: 76044.9523205358},
+		{A: 1.9e-10, B: 2.90042907299, C: 6283.0758499914},
+		{A: 1.9e-10, B: 3.18954246101, C: 51109.31080595839},
+		{A: 1.8e-10, B: 2.11231697626, C: 73711.75592766379},
+		{A: 1.7e-10, B: 0.06118735536, C: 88476.99497093359},
+		{A: 1.8e-10, B: 6.26984143774, C: 15874.6175953632},
+		{A: 1.8e-10, B: 2.58820345674, C: 51123.53789995999},
+		{A: 1.7e-10, B: 5.82864656129, C: 32370.9789915656},
+		{A: 1.8e-10, B: 5.22519288335, C: 78267.59076008058},
+		{A: 1.5e-10, B: 5.43053381026, C: 64741.95798313119},
+		{A: 1.5e-10, B: 2.53107093357, C: 155468.036919256},
+		{A: 2e-10, B: 4.40959528115, C: 1223.81784077861},
+		{A: 1.5e-10, B: 4.05461375332, C: 51962.5071877104},
+		{A: 1.5e-10, B: 3.52769852262, C: 79219.3091663312},
+		{A: 1.5e-10, B: 0.45988103836, C: 162188.75089859738},
+		{A: 1.5e-10, B: 0.87152359342, C: 78283.37918562238},
+		{A: 1.4e-10, B: 1.87990160627, C: 125887.56221081219},
+		{A: 1.6e-10, B: 2.82759858554, C: 105410.99449648599},
+		{A: 1.3e-10, B: 4.67219046898, C: 78256.59587772179},
+		{A: 1.6e-10, B: 0.04901690153, C: 78477.00852016058},
+		{A: 1.4e-10, B: 4.21146699511, C: 52290.24557183361},
+		{A: 1.4e-10, B: 4.08726245298, C: 78270.82297172339},
+		{A: 1.5e-10, B: 3.80902990348, C: 33967.99229491319},
+		{A: 1.6e-10, B: 0.28761005157, C: 44937.1306915484},
+		{A: 1.4e-10, B: 5.8433060581, C: 103821.92160120218},
+		{A: 1.5e-10, B: 6.0373826452, C: 13655.8604911764},
+		{A: 1.2e-10, B: 0.81685952408, C: 81706.28436968799},
+		{A: 1.5e-10, B: 3.79894620695, C: 108903.56606335558},
+		{A: 1.1e-10, B: 3.26651828162, C: 52602.4044740244},
+		{A: 1.1e-10, B: 1.28755195884, C: 29416.03879785439},
+		{A: 1.1e-10, B: 4.03185618461, C: 181505.94343892598},
+		{A: 1.1e-10, B: 5.5844804614, C: 120226.23016165999},
+		{A: 1.2e-10, B: 0.32316787548, C: 91785.46086631398},
+		{A: 1.3e-10, B: 2.02636565149, C: 71492.99882347698},
+		{A: 1.5e-10, B: 3.56357453844, C: 76674.63652943878},
+		{A: 1.3e-10, B: 3.80590035776, C: 78259.82808936459},
+		{A: 1e-10, B: 0.04109363791, C: 77197.21394753258},
+		{A: 1e-10, B: 6.00753285175, C: 365230.6439820388},
+		{A: 1e-10, B: 1.74642821988, C: 52061.36699446317},
+		{A: 1.3e-10, B: 2.79229719351, C: 636.9962720242},
+		{A: 1.1e-10, B: 5.52780687723, C: 26727.8004278882},
+		{A: 1.1e-10, B: 4.04728142335, C: 45892.73043315699},
+		{A: 8e-11, B: 4.9698513054, C: 151975.46535238638},
+		{A: 8e-11, B: 3.44547998787, C: 23439.44831610119},
+		{A: 1.1e-10, B: 2.54429302352, C: 44181.27784112419},
+		{A: 1e-10, B: 3.502742291, C: 65697.55772473979},
+		{A: 9e-11, B: 2.45809063705, C: 93028.94846799239},
+		{A: 9e-11, B: 4.9256893206, C: 104331.94280539699},
+		{A: 8e-11, B: 0.80535713418, C: 71980.63357473118},
+		{A: 1e-10, B: 2.20026830808, C: 130969.20667296558},
+		{A: 7e-11, B: 2.30885367448, C: 18207.81398823521},
+		{A: 1e-10, B: 5.72020107388, C: 77211.44104153418},
+		{A: 7e-11, B: 0.15536656581, C: 129483.91596626239},
+		{A: 8e-11, B: 2.31255848253, C: 58458.88213313979},
+		{A: 7e-11, B: 2.07713892797, C: 90829.86112470538},
+		{A: 7e-11, B: 3.50101836609, C: 117873.36400788819},
+		{A: 7e-11, B: 3.20275960823, C: 64607.84893354619},
+		{A: 7e-11, B: 4.73829767381, C: 51322.60990139639},
+		{A: 7e-11, B: 5.655539467, C: 181555.94006083018},
+		{A: 9e-11, B: 2.56870006051, C: 129909.82474277639},
+		{A: 8e-11, B: 0.67171808844, C: 79852.78232000639},
+		{A: 7e-11, B: 4.06204756163, C: 39609.6545831656},
+		{A: 7e-11, B: 1.46575838897, C: 853.196381752},
+		{A: 7e-11, B: 2.57400610109, C: 69159.80243060499},
+		{A: 6e-11, B: 3.86283147263, C: 59414.4818747484},
+		{A: 6e-11, B: 1.3354568195, C: 102132.85546210999},
+		{A: 7e-11, B: 2.80506785787, C: 39743.7636327506},
+		{A: 6e-11, B: 1.66711581835, C: 28206.6670019526},
+		{A: 8e-11, B: 4.73806237855, C: 150866.08680029298},
+		{A: 6e-11, B: 0.81325444169, C: 207593.8465805002},
+		{A: 6e-11, B: 0.0692555554, C: 58946.51688439399},
+		{A: 6e-11, B: 3.09908354288, C: 114564.89811250778},
+		{A: 6e-11, B: 0.88473087003, C: 104358.72611329758},
+		{A: 6e-11, B: 3.51715962887, C: 188276.6540401716},
+		{A: 6e-11, B: 4.13083556162, C: 54509.0026760204},
+		{A: 6e-11, B: 3.14680331401, C: 104564.91166173479},
+		{A: 7e-11, B: 5.89465282471, C: 131498.89763806018},
+		{A: 5e-11, B: 0.27174681491, C: 143961.2671494624},
+		{A: 5e-11, B: 5.25316838887, C: 3340.6124266998},
+		{A: 7e-11, B: 1.99860349328, C: 104355.49390165479},
+		{A: 5e-11, B: 1.47362369989, C: 9103.9069941176},
+		{A: 7e-11, B: 0.60725013988, C: 60055.89543648739},
+		{A: 5e-11, B: 3.20081630256, C: 13541.42120249119},
+		{A: 6e-11, B: 0.50137409605, C: 63498.47038145279},
+		{A: 5e-11, B: 5.0773947536, C: 97580.90196505119},
+		{A: 5e-11, B: 1.35632842517, C: 104344.49901929598},
+		{A: 5e-11, B: 2.16611734526, C: 103925.01437542078},
+		{A: 5e-11, B: 4.04581717475, C: 98068.53671630539},
+		{A: 5e-11, B: 4.90166806916, C: 22759.76748529401},
+		{A: 5e-11, B: 3.87246722733, C: 89586.37352302698},
+		{A: 5e-11, B: 3.01510200596, C: 103285.11708910679},
+		{A: 6e-11, B: 1.4479147408, C: 78050.41032928458},
+		{A: 5e-11, B: 2.4783121842, C: 26241.681952059},
+		{A: 5e-11, B: 3.85799454501, C: 107794.1875112622},
+		{A: 5e-11, B: 0.57300969993, C: 134991.4692049298},
+		{A: 5e-11, B: 2.38724530268, C: 146314.13330323418},
+		{A: 4e-11, B: 6.11121027497, C: 90695.75207512038},
+		{A: 4e-11, B: 1.8318123147, C: 74821.13447975718},
+		{A: 4e-11, B: 5.23938469806, C: 130012.91751699499},
+		{A: 5e-11, B: 1.18020888905, C: 27311.72098235281},
+		{A: 5e-11, B: 3.00001411726, C: 85034.42002596818},
+		{A: 5e-11, B: 5.56662713374, C: 155997.72788435058},
+		{A: 5e-11, B: 2.35342507828, C: 145204.75475114078},
+		{A: 4e-11, B: 4.57119707855, C: 157636.79740153858},
+		{A: 4e-11, B: 5.78384635962, C: 51742.09454527159},
+		{A: 4e-11, B: 2.41778026187, C: 157483.01859105378},
+		{A: 5e-11, B: 2.53226316949, C: 103299.34418310839},
+		{A: 4e-11, B: 3.7145852625, C: 26555.8681319286},
+		{A: 4e-11, B: 3.8363997571, C: 150244.3429994538},
+		{A: 4e-11, B: 3.30201863644, C: 76144.94556434419},
+		{A: 4e-11, B: 0.68904721853, C: 124156.43985787958},
+		{A: 4e-11, B: 1.73206522989, C: 178063.3684939606},
+		{A: 5e-11, B: 3.20991489892, C: 71025.0338331226},
+		{A: 5e-11, B: 3.69408876581, C: 105940.68546158058},
+		{A: 4e-11, B: 2.75867944254, C: 391318.54712361295},
+		{A: 5e-11, B: 0.535860682, C: 80482.46652890938},
+		{A: 4e-11, B: 5.07565982849, C: 51756.3216392732},
+		{A: 4e-11, B: 0.12462379137, C: 78690.30761559859},
+		{A: 4e-11, B: 5.25512859919, C: 157057.10981453978},
+		{A: 4e-11, B: 5.66367904256, C: 9384.8410080752},
+		{A: 4e-11, B: 4.70366039546, C: 54294.57014352679},
+		{A: 4e-11, B: 4.7476763595, C: 204151.27163553477},
+		{A: 3e-11, B: 3.2417738566, C: 155571.81910783658},
+		{A: 3e-11, B: 0.85377908455, C: 16066.0658614748},
+		{A: 3e-11, B: 1.58566666033, C: 130226.21661243298},
+		{A: 4e-11, B: 5.58218314089, C: 119116.85160956658},
+		{A: 4e-11, B: 0.94898112028, C: 78378.1487134078},
+		{A: 4e-11, B: 6.15781348253, C: 97112.93697469679},
+		{A: 4e-11, B: 2.31787867435, C: 52815.7035694624},
+		{A: 4e-11, B: 2.73401660736, C: 78417.48823520739},
+		{A: 4e-11, B: 5.67762508473, C: 183674.70392120857},
+		{A: 4e-11, B: 0.21138526735, C: 214364.55718174577},
+		{A: 4e-11, B: 5.24311464709, C: 116917.76426627958},
+		{A: 3e-11, B: 3.86634858823, C: 104371.28232719658},
+		{A: 4e-11, B: 5.42881221134, C: 233731.7463439786},
+		{A: 3e-11, B: 1.97251042531, C: 183145.012956114},
+		{A: 3e-11, B: 0.60740481915, C: 133882.09065283637},
+		{A: 3e-11, B: 6.23399386415, C: 181659.72224941078},
+		{A: 4e-11, B: 6.22336284288, C: 140652.80125408198},
+		{A: 3e-11, B: 5.94876130841, C: 25035.6347583858},
+		{A: 3e-11, B: 3.83428144879, C: 233681.74972207437},
+		{A: 3e-11, B: 2.32902857669, C: 182085.63102592478},
+		{A: 3e-11, B: 2.8435468116, C: 123200.84011627098},
+		{A: 3e-11, B: 1.53933182823, C: 176953.98994186718},
+		{A: 4e-11, B: 2.45893471155, C: 207643.8432024044},
+		{A: 3e-11, B: 5.72001402121, C: 102762.53967101299},
+		{A: 4e-11, B: 1.40880562474, C: 80382.47328510099},
+		{A: 3e-11, B: 4.90489886883, C: 51749.20809227239},
+		{A: 3e-11, B: 4.76799924569, C: 104138.31347085879},
+		{A: 4e-11, B: 6.26291139255, C: 25021.4076643842},
+		{A: 3e-11, B: 0.40352872921, C: 132028.58860315479},
+		{A: 3e-11, B: 2.66696150741, C: 157586.80077963436},
+		{A: 3e-11, B: 5.23202292846, C: 84546.78527471398},
+		{A: 3e-11, B: 4.59428408981, C: 131548.89425996438},
+		{A: 3e-11, B: 2.53649995681, C: 50579.61984086379},
+		{A: 3e-11, B: 2.55542640702, C: 77829.99768684579},
+		{A: 4e-11, B: 1.67304824627, C: 130419.8459469712},
+		{A: 3e-11, B: 6.21862713072, C: 95247.70557217918},
+		{A: 3e-11, B: 5.37878648081, C: 44295.7171298094},
+		{A: 3e-11, B: 4.68342323732, C: 156507.7490885454},
+		{A: 3e-11, B: 3.36164307862, C: 104778.21075717278},
+		{A: 3e-11, B: 2.91632245418, C: 166740.70439565618},
+		{A: 2e-11, B: 0.72039482289, C: 7994.5284420242},
+		{A: 2e-11, B: 5.43309839263, C: 78903.60671103658},
+		{A: 2e-11, B: 1.12849064703, C: 183724.7005431128},
+		{A: 3e-11, B: 1.94668130835, C: 143005.6674078538},
+		{A: 3e-11, B: 0.5628939296, C: 104347.73123093879},
+		{A: 2e-11, B: 1.78482957305, C: 156531.3001848032},
+		{A: 3e-11, B: 5.0354242485, C: 130443.39704322898},
+		{A: 3e-11, B: 4.72735160431, C: 78149.27013603736},
+		{A: 2e-11, B: 5.31651763274, C: 208173.534167499},
+		{A: 3e-11, B: 5.36470448658, C: 131395.11544947958},
+		{A: 2e-11, B: 0.06581745526, C: 196137.07343261078},
+		{A: 2e-11, B: 2.93678015526, C: 167850.0829477496},
+		{A: 2e-11, B: 5.84781134233, C: 65831.6667743248},
+		{A: 2e-11, B: 5.56634055777, C: 70269.18098269838},
+		{A: 2e-11, B: 5.42145220392, C: 183570.921732628},
+		{A: 2e-11, B: 6.06636386319, C: 53242.3017603384},
+		{A: 2e-11, B: 4.37154457896, C: 128220.75860368418},
+		{A: 2e-11, B: 0.35903300236, C: 187167.2754880782},
+		{A: 2e-11, B: 2.78347515438, C: 79330.20490191258},
+		{A: 2e-11, B: 4.51952536661, C: 203041.8930834414},
+		{A: 2e-11, B: 3.36865001265, C: 170049.1702910366},
+		{A: 2e-11, B: 3.08184453618, C: 50593.84693486539},
+		{A: 2e-11, B: 4.32534428501, C: 55503.94193942859},
+		{A: 2e-11, B: 1.81636961816, C: 110634.68841628819},
+		{A: 2e-11, B: 5.58793955981, C: 103917.90082841998},
+		{A: 2e-11, B: 5.79409172557, C: 417406.4502651872},
+		{A: 2e-11, B: 0.85923934181, C: 235900.50682626115},
+		{A: 2e-11, B: 4.60084058009, C: 26941.0995233262},
+		{A: 2e-11, B: 5.1499567646, C: 182188.72380014337},
+		{A: 2e-11, B: 3.91756419842, C: 130446.62925487179},
+		{A: 2e-11, B: 2.88997273266, C: 27154.3986187642},
+		{A: 3e-11, B: 5.85721944439, C: 52329.58509363319},
+		{A: 2e-11, B: 6.10145799164, C: 77623.81213840858},
+		{A: 2e-11, B: 1.98246165585, C: 77844.22478084739},
+		{A: 2e-11, B: 0.1107175948, C: 130866.11389874699},
+		{A: 2e-11, B: 0.64849171165, C: 52643.7712735028},
+		{A: 2e-11, B: 3.98410950717, C: 104466.051854982},
+		{A: 2e-11, B: 1.83715639115, C: 123668.80510662538},
+		{A: 2e-11, B: 5.61305711026, C: 76667.52298243798},
+		{A: 2e-11, B: 5.83992902277, C: 19804.8272915828},
+		{A: 2e-11, B: 0.54331921743, C: 130459.18546877075},
+		{A: 2e-11, B: 0.00997429525, C: 141762.17980617538},
+		{A: 2e-11, B: 5.59377744943, C: 35191.8101356918},
+		{A: 2e-11, B: 0.39746661127, C: 26521.614879451},
+		{A: 2e-11, B: 6.1619270402, C: 130652.81480330898},
+		{A: 2e-11, B: 0.85416063806, C: 25934.1243310894},
+		{A: 2e-11, B: 2.18339110714, C: 156100.82065856917},
+		{A: 2e-11, B: 4.39281517355, C: 130432.40216087017},
+		{A: 2e-11, B: 5.50117730009, C: 172402.0364448084},
+		{A: 1e-11, B: 3.49234867738, C: 130435.63437251298},
+		{A: 1e-11, B: 4.8099549208, C: 77837.11123384659},
+		{A: 2e-11, B: 6.26921260007, C: 87367.61641884019},
+		{A: 2e-11, B: 2.79561490409, C: 115674.27666460119},
+		{A: 2e-11, B: 4.92122664455, C: 24864.08530079559},
+		{A: 1e-11, B: 2.4157683233, C: 26037.90651966999},
+		{A: 1e-11, B: 6.04596837149, C: 129373.02023068098},
+		{A: 2e-11, B: 0.94020572434, C: 80596.9058175946},
+		{A: 2e-11, B: 0.30073776192, C: 51639.00177105299},
+		{A: 1e-11, B: 0.18846827752, C: 34082.4315835984},
+		{A: 1e-11, B: 3.62844435085, C: 161079.37234650398},
+		{A: 2e-11, B: 5.13133615893, C: 49842.60989027639},
+		{A: 1e-11, B: 0.30832555285, C: 53228.07466633679},
+		{A: 1e-11, B: 5.56519625382, C: 129387.24732468258},
+		{A: 1e-11, B: 5.63110777945, C: 46848.3301747656},
+		{A: 1e-11, B: 1.38420566741, C: 26011.6370702986},
+		{A: 1e-11, B: 5.86450783334, C: 26724.8994135984},
+		{A: 1e-11, B: 6.14517818644, C: 76681.75007643958},
+		{A: 1e-11, B: 3.19299019423, C: 25654.19140369739},
+		{A: 1e-11, B: 0.34495954566, C: 128850.44281258718},
+		{A: 1e-11, B: 3.60230008239, C: 20760.4270331914},
+		{A: 1e-11, B: 5.80015495751, C: 19406.6782881746},
+		{A: 1e-11, B: 4.42116052212, C: 100909.03762133139},
+		{A: 1e-11, B: 0.48535289275, C: 52698.38370124219},
+		{A: 1e-11, B: 6.22940855233, C: 111590.2881578968},
+		{A: 1e-11, B: 0.16055219324, C: 105307.21230790539},
+		{A: 1e-11, B: 2.19795772042, C: 29428.515568274},
+		{A: 1e-11, B: 5.09783503526, C: 102232.84870591838},
+		{A: 1e-11, B: 0.61014627166, C: 25668.418497699},
+		{A: 1e-11, B: 5.62459774786, C: 25565.3257234804},
+		{A: 1e-11, B: 0.90991571866, C: 103711.71527998279},
+		{A: 1e-11, B: 0.28037611495, C: 25450.90686955},
+		{A: 1e-11, B: 0.54824728173, C: 26137.8997634784},
+		{A: 1e-11, B: 4.25324476801, C: 209812.60368468694},
+		{A: 1e-11, B: 0.08089899001, C: 51543.0225438352},
+		{A: 1e-11, B: 0.33953378597, C: 51528.79544983359},
+		{A: 1e-11, B: 4.15667461533, C: 25551.09862947879},
+		{A: 1e-11, B: 1.59597814656, C: 72936.23331633979},
+		{A: 1e-11, B: 5.5936222224, C: 25619.9381512198},
+		{A: 1e-11, B: 3.26520760555, C: 240452.46032331997},
+		{A: 1e-11, B: 5.52286605071, C: 52125.80966124419},
+	},
+	// L3, 153 terms
+	{
+		{A: 1.88077e-06, B: 0.03466830117, C: 52175.8062831484},
+		{A: 1.42152e-06, B: 3.125054526, C: 26087.9031415742},
+		{A: 9.6877e-07, B: 3.00378171915, C: 78263.70942472259},
+		{A: 4.3669e-07, B: 6.01867965826, C: 104351.61256629678},
+		{A: 3.5395e-07, B: 0, C: 0},
+		{A: 1.8045e-07, B: 2.77538373991, C: 130439.51570787099},
+		{A: 6.971e-08, B: 5.81808665742, C: 156527.41884944518},
+		{A: 2.556e-08, B: 2.57014364454, C: 182615.3219910194},
+		{A: 9e-09, B: 5.59308888939, C: 208703.2251325936},
+		{A: 3.07e-09, B: 2.32189002493, C: 234791.12827416777},
+		{A: 1.17e-09, B: 3.1656732416, C: 24978.5245894808},
+		{A: 1.02e-09, B: 5.32134064237, C: 260879.03141574195},
+		{A: 7.7e-10, B: 6.24155593431, C: 51066.427731055},
+		{A: 5.7e-10, B: 6.11222330831, C: 27197.2816936676},
+		{A: 3.4e-10, B: 2.03244612467, C: 286966.9345573162},
+		{A: 3.8e-10, B: 2.99912912226, C: 77154.33087262919},
+		{A: 3.1e-10, B: 3.11483471984, C: 53285.1848352418},
+		{A: 1.7e-10, B: 6.02950226714, C: 103242.2340142034},
+		{A: 1.1e-10, B: 5.00109293882, C: 313054.83769889036},
+		{A: 9e-11, B: 0.59396483404, C: 20426.571092422},
+		{A: 9e-11, B: 3.65190477342, C: 46514.4742339962},
+		{A: 9e-11, B: 6.28305510644, C: 79373.087976816},
+		{A: 8e-11, B: 2.74138109305, C: 129330.13715577759},
+		{A: 7e-11, B: 5.79018632644, C: 25132.3033999656},
+		{A: 5e-11, B: 0.33554769835, C: 72602.37737557039},
+		{A: 5e-11, B: 1.3111581035, C: 26617.5941066688},
+		{A: 4e-11, B: 2.46414914425, C: 51220.20654153979},
+		{A: 4e-11, B: 1.74528964721, C: 339142.7408404646},
+		{A: 4e-11, B: 4.31141963105, C: 52705.49724824299},
+		{A: 4e-11, B: 3.07093349506, C: 27043.5028831828},
+		{A: 4e-11, B: 5.6801370125, C: 30639.856638633},
+		{A: 3e-11, B: 5.80662640937, C: 155418.04029735178},
+		{A: 3e-11, B: 4.6451908806, C: 27147.28507176339},
+		{A: 4e-11, B: 3.02893592955, C: 23869.1460373874},
+		{A: 3e-11, B: 4.35199345075, C: 79323.09135491178},
+		{A: 3e-11, B: 1.29040630287, C: 53235.18821333759},
+		{A: 3e-11, B: 4.34017676059, C: 14765.2390432698},
+		{A: 3e-11, B: 2.86652251194, C: 105460.99111839019},
+		{A: 3e-11, B: 6.23875368963, C: 28306.66024576099},
+		{A: 3e-11, B: 3.37815322354, C: 98690.28051714458},
+		{A: 2e-11, B: 5.37466119262, C: 110012.94461544899},
+		{A: 2e-11, B: 0.16040583556, C: 50586.73338786459},
+		{A: 2e-11, B: 0.35038889636, C: 19317.1925403286},
+		{A: 2e-11, B: 6.2327164965, C: 49957.0491789616},
+		{A: 2e-11, B: 2.52219274566, C: 181505.94343892598},
+		{A: 2e-11, B: 5.05358903232, C: 162188.75089859738},
+		{A: 2e-11, B: 0.15071040297, C: 124778.18365871879},
+		{A: 2e-11, B: 2.29024350361, C: 56727.7597802072},
+		{A: 2e-11, B: 0.42411179784, C: 71492.99882347698},
+		{A: 2e-11, B: 6.11262694468, C: 57837.1383323006},
+		{A: 2e-11, B: 2.17198531948, C: 108903.56606335558},
+		{A: 2e-11, B: 2.92723030315, C: 54394.56338733519},
+		{A: 2e-11, B: 2.40411188609, C: 83925.0414738748},
+		{A: 2e-11, B: 3.43613336205, C: 78267.59076008058},
+		{A: 2e-11, B: 4.77873510695, C: 365230.6439820388},
+		{A: 2e-11, B: 3.54043808451, C: 64741.95798313119},
+		{A: 2e-11, B: 1.60502923885, C: 77734.01845962799},
+		{A: 2e-11, B: 3.11800510932, C: 150866.08680029298},
+		{A: 2e-11, B: 1.0209874609, C: 78793.40038981718},
+		{A: 2e-11, B: 4.39439085682, C: 88476.99497093359},
+		{A: 2e-11, B: 1.49912329404, C: 43071.8992890308},
+		{A: 2e-11, B: 1.96277160647, C: 25558.2121764796},
+		{A: 2e-11, B: 4.76774165204, C: 77204.32749453338},
+		{A: 2e-11, B: 0.61066658116, C: 155468.036919256},
+		{A: 2e-11, B: 5.26875308161, C: 77308.10968311399},
+		{A: 2e-11, B: 0.5273609502, C: 94138.32702008578},
+		{A: 2e-11, B: 2.05793854229, C: 103396.01282468818},
+		{A: 2e-11, B: 4.12698349577, C: 66941.04532641819},
+		{A: 2e-11, B: 5.43336870762, C: 78283.37918562238},
+		{A: 2e-11, B: 1.97520131105, C: 136100.84775702318},
+		{A: 2e-11, B: 5.54343111585, C: 81706.28436968799},
+		{A: 2e-11, B: 1.25444219651, C: 40853.142184844},
+		{A: 2e-11, B: 1.07684180123, C: 103292.23063610759},
+		{A: 1e-11, B: 3.23979322492, C: 52156.1365222486},
+		{A: 2e-11, B: 5.33834891475, C: 52182.9198301492},
+		{A: 2e-11, B: 3.36867866204, C: 99799.65906923798},
+		{A: 2e-11, B: 5.4069790667, C: 82815.66292178139},
+		{A: 1e-11, B: 5.80853057947, C: 4551.9534970588},
+		{A: 1e-11, B: 4.9590938512, C: 129483.91596626239},
+		{A: 1e-11, B: 3.17481245588, C: 76674.63652943878},
+		{A: 1e-11, B: 1.538031696, C: 52389.1053785864},
+		{A: 1e-11, B: 2.48052961456, C: 53764.8791784322},
+		{A: 1e-11, B: 1.20843095195, C: 105410.99449648599},
+		{A: 2e-11, B: 3.64576833526, C: 45405.0956819028},
+		{A: 2e-11, B: 4.00710217385, C: 104881.30353139139},
+		{A: 1e-11, B: 3.0953209452, C: 76044.9523205358},
+		{A: 1e-11, B: 5.52119023864, C: 58946.51688439399},
+		{A: 2e-11, B: 5.53036712466, C: 131548.89425996438},
+		{A: 1e-11, B: 0.38352295948, C: 52179.6876185064},
+		{A: 1e-11, B: 0.08902944955, C: 51116.4243529592},
+		{A: 1e-11, B: 1.4751416667, C: 62389.09182935939},
+		{A: 1e-11, B: 4.20864838814, C: 131498.89763806018},
+		{A: 1e-11, B: 2.88161012078, C: 79219.3091663312},
+		{A: 1e-11, B: 5.03155341199, C: 51646.11531805379},
+		{A: 1e-11, B: 0.05873279909, C: 53131.406024757},
+		{A: 1e-11, B: 0.606476206, C: 38654.05484155699},
+		{A: 1e-11, B: 1.58007357234, C: 391318.54712361295},
+		{A: 1e-11, B: 0.68687557696, C: 130969.20667296558},
+		{A: 1e-11, B: 3.90367213586, C: 129380.13377768178},
+		{A: 1e-11, B: 2.11138180302, C: 32858.61374281979},
+		{A: 1e-11, B: 0.18542211423, C: 78244.0396638228},
+		{A: 1e-11, B: 1.24063083423, C: 9103.9069941176},
+		{A: 1e-11, B: 1.78547815249, C: 188276.6540401716},
+		{A: 1e-11, B: 0.94125502564, C: 93028.94846799239},
+		{A: 1e-11, B: 2.41309932759, C: 55618.3812281138},
+		{A: 1e-11, B: 2.29683748973, C: 52195.47604404819},
+		{A: 1e-11, B: 3.39284109553, C: 25028.521211385},
+		{A: 1e-11, B: 4.29717383023, C: 26301.2022370122},
+		{A: 1e-11, B: 6.10639166059, C: 102132.85546210999},
+		{A: 1e-11, B: 4.58189329524, C: 37410.5672398786},
+		{A: 1e-11, B: 6.16145880062, C: 125887.56221081219},
+		{A: 1e-11, B: 5.22010441376, C: 52171.9249477904},
+		{A: 1e-11, B: 5.95645110567, C: 80482.46652890938},
+		{A: 1e-11, B: 4.81981872221, C: 26107.57290247399},
+		{A: 1e-11, B: 4.27546069271, C: 36301.18868778519},
+		{A: 1e-11, B: 2.43374991272, C: 51535.90899683439},
+		{A: 1e-11, B: 4.03088051207, C: 1109.3785520934},
+		{A: 1e-11, B: 5.60495524983, C: 207593.8465805002},
+		{A: 1e-11, B: 4.89918476796, C: 35191.8101356918},
+		{A: 1e-11, B: 6.07747011982, C: 27676.976036858},
+		{A: 1e-11, B: 5.48361013686, C: 29530.4780865396},
+		{A: 1e-11, B: 0.15288602386, C: 25448.00585526019},
+		{A: 1e-11, B: 2.18342825402, C: 63498.47038145279},
+		{A: 1e-11, B: 3.67054321453, C: 21535.9496445154},
+		{A: 1e-11, B: 1.49815422727, C: 26084.0218062162},
+		{A: 1e-11, B: 1.75551273491, C: 26095.016688575},
+		{A: 1e-11, B: 2.60061927559, C: 12566.1516999828},
+		{A: 1e-11, B: 2.69537843932, C: 52290.24557183361},
+		{A: 1e-11, B: 4.87760109673, C: 25874.6040461362},
+		{A: 1e-11, B: 2.82170820105, C: 26091.7844769322},
+		{A: 1e-11, B: 0.20736391933, C: 52061.36699446317},
+		{A: 1e-11, B: 3.82330817663, C: 68050.42387851159},
+		{A: 1e-11, B: 4.42501613287, C: 22645.32819660879},
+		{A: 1e-11, B: 6.01128227925, C: 52168.69273614759},
+		{A: 1e-11, B: 0.28595674172, C: 11322.6640983044},
+		{A: 1e-11, B: 5.86280830053, C: 26202.34243025941},
+		{A: 1e-11, B: 3.42109354799, C: 25973.46385288896},
+		{A: 1e-11, B: 0.300500653, C: 41962.5207369374},
+		{A: 1e-11, B: 2.73414310032, C: 25035.6347583858},
+		{A: 1e-11, B: 1.72346523407, C: 51962.5071877104},
+		{A: 1e-11, B: 4.77297529316, C: 78477.00852016058},
+		{A: 1e-11, B: 5.84491832575, C: 50057.04242277},
+		{A: 1e-11, B: 3.2207229087, C: 25021.4076643842},
+		{A: 1e-11, B: 4.74388045372, C: 26514.5013324502},
+		{A: 1e-11, B: 4.31760630574, C: 69159.80243060499},
+		{A: 1e-11, B: 0.65529515413, C: 426.598190876},
+		{A: 0, B: 1.96307435492, C: 1059.3819301892},
+		{A: 1e-11, B: 5.58694327783, C: 79852.78232000639},
+		{A: 0, B: 3.84680432548, C: 26080.78959457339},
+		{A: 1e-11, B: 6.07111696987, C: 102762.53967101299},
+		{A: 0, B: 0.59142287644, C: 73711.75592766379},
+		{A: 1e-11, B: 5.84438975852, C: 105307.21230790539},
+		{A: 1e-11, B: 2.82906439989, C: 24498.8302462904},
+	},
+	// L4, 28 terms
+	{
+		{A: 1.14078e-06, B: 3.14159265359, C: 0},
+		{A: 3.247e-08, B: 2.02848007619, C: 26087.9031415742},
+		{A: 1.914e-08, B: 1.41731803758, C: 78263.70942472259},
+		{A: 1.727e-08, B: 4.50137643801, C: 52175.8062831484},
+		{A: 1.237e-08, B: 4.49970181057, C: 104351.61256629678},
+		{A: 6.45e-09, B: 1.26591776986, C: 130439.51570787099},
+		{A: 2.98e-09, B: 4.30600984981, C: 156527.41884944518},
+		{A: 1.28e-09, B: 1.05702505039, C: 182615.3219910194},
+		{A: 5.1e-10, B: 4.08566191934, C: 208703.2251325936},
+		{A: 2e-10, B: 0.82130394681, C: 234791.12827416777},
+		{A: 8e-11, B: 3.82199615635, C: 260879.03141574195},
+		{A: 3e-11, B: 0.54824060574, C: 286966.9345573162},
+		{A: 2e-11, B: 3.52134138964, C: 313054.83769889036},
+		{A: 1e-11, B: 1.6743511854, C: 24978.5245894808},
+		{A: 2e-11, B: 4.60718203612, C: 51066.427731055},
+		{A: 1e-11, B: 1.11396201203, C: 129330.13715577759},
+		{A: 1e-11, B: 4.35040700322, C: 103242.2340142034},
+		{A: 1e-11, B: 4.17434865844, C: 79373.087976816},
+		{A: 1e-11, B: 1.20388630609, C: 77154.33087262919},
+		{A: 1e-11, B: 4.8467708474, C: 27197.2816936676},
+		{A: 1e-11, B: 0.29913093643, C: 339142.7408404646},
+		{A: 0, B: 4.11587125815, C: 155418.04029735178},
+		{A: 0, B: 0.73293870976, C: 46514.4742339962},
+		{A: 0, B: 3.68822427283, C: 21535.9496445154},
+		{A: 0, B: 3.83816920045, C: 20426.571092422},
+		{A: 0, B: 4.73086078962, C: 1059.3819301892},
+		{A: 0, B: 0.40939402954, C: 24498.8302462904},
+		{A: 0, B: 3.30678220146, C: 365230.6439820388},
+	},
+	// L5, 13 terms
+	{
+		{A: 8.77e-09, B: 3.14159265359, C: 0},
+		{A: 5.9e-10, B: 3.37513289692, C: 52175.8062831484},
+		{A: 4.2e-10, B: 0.02433099382, C: 78263.70942472259},
+		{A: 4.3e-10, B: 4.65071406046, C: 26087.9031415742},
+		{A: 3.1e-10, B: 2.98112204944, C: 104351.61256629678},
+		{A: 1.9e-10, B: 6.00992467582, C: 130439.51570787099},
+		{A: 1e-10, B: 2.7569979192, C: 156527.41884944518},
+		{A: 5e-11, B: 5.79082359724, C: 182615.3219910194},
+		{A: 2e-11, B: 2.5143544823, C: 208703.2251325936},
+		{A: 1e-11, B: 5.49411470932, C: 234791.12827416777},
+		{A: 1e-11, B: 2.23688504668, C: 260879.03141574195},
+		{A: 1e-11, B: 5.18718589133, C: 286966.9345573162},
+		{A: 0, B: 2.11315496491, C: 313054.83769889036},
+	},
+}
+
+// GetMercuryL0 evaluates the t^0 series of L.
+func GetMercuryL0(t float64) float64 {
+	return mercuryL[0].Evaluate(t)
+}
+
+// GetMercuryL1 evaluates the t^1 series of L.
+func GetMercuryL1(t float64) float64 {
+	return mercuryL[1].Evaluate(t)
+}
+
+// GetMercuryL2 evaluates the t^2 series of L.
+func GetMercuryL2(t float64) float64 {
+	return mercuryL[2].Evaluate(t)
+}
+
+// GetMercuryL3 evaluates the t^3 series of L.
+func GetMercuryL3(t float64) float64 {
+	return mercuryL[3].Evaluate(t)
+}
+
+// GetMercuryL4 evaluates the t^4 series of L.
+func GetMercuryL4(t float64) float64 {
+	return mercuryL[4].Evaluate(t)
+}
+
+// GetMercuryL5 evaluates the t^5 series of L.
+func GetMercuryL5(t float64) float64 {
+	return mercuryL[5].Evaluate(t)
+}
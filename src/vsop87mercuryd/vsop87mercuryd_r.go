@@ -0,0 +1,2458 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// MERCURY R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87mercuryd
+
+import "vsop87"
+
+var mercuryR = []vsop87.Series{
+	// R0, 1215 terms
+	{
+		{A: 0.39528271652, B: 0, C: 0},
+		{A: 0.07834131817, B: 6.19233722599, C: 26087.9031415742},
+		{A: 0.00795525557, B: 2.95989690096, C: 52175.8062831484},
+		{A: 0.00121281763, B: 6.01064153805, C: 78263.70942472259},
+		{A: 0.00021921969, B: 2.77820093975, C: 104351.61256629678},
+		{A: 4.354065e-05, B: 5.82894543257, C: 130439.51570787099},
+		{A: 9.18228e-06, B: 2.59650562598, C: 156527.41884944518},
+		{A: 2.60033e-06, B: 3.02817753482, C: 27197.2816936676},
+		{A: 2.89955e-06, B: 1.42441936951, C: 25028.521211385},
+		{A: 2.01855e-06, B: 5.6472504035, C: 182615.3219910194},
+		{A: 2.01499e-06, B: 5.59227724202, C: 31749.2351907264},
+		{A: 1.4198e-06, B: 6.25264202645, C: 24978.5245894808},
+		{A: 1.00144e-06, B: 3.73435608689, C: 21535.9496445154},
+		{A: 7.7561e-07, B: 3.66972526976, C: 20426.571092422},
+		{A: 6.3277e-07, B: 4.29905918105, C: 25558.2121764796},
+		{A: 6.2951e-07, B: 4.76588899933, C: 1059.3819301892},
+		{A: 6.6754e-07, B: 2.52520309182, C: 5661.3320491522},
+		{A: 7.55e-07, B: 4.47428642962, C: 51116.4243529592},
+		{A: 4.8266e-07, B: 6.06824478778, C: 53285.1848352418},
+		{A: 4.5748e-07, B: 2.41480951648, C: 208703.2251325936},
+		{A: 3.5224e-07, B: 1.05917802674, C: 27043.5028831828},
+		{A: 4.0815e-07, B: 2.35882016415, C: 57837.1383323006},
+		{A: 4.4234e-07, B: 1.21957314874, C: 15874.6175953632},
+		{A: 3.3873e-07, B: 0.86381554651, C: 25661.3049506982},
+		{A: 3.7203e-07, B: 0.5173382147, C: 47623.8527860896},
+		{A: 3.0092e-07, B: 1.79500530627, C: 37410.5672398786},
+		{A: 2.8417e-07, B: 3.02063625668, C: 51066.427731055},
+		{A: 3.0903e-07, B: 0.88366335532, C: 24498.8302462904},
+		{A: 2.6105e-07, B: 2.15021963174, C: 39609.6545831656},
+		{A: 1.8699e-07, B: 4.96496008403, C: 11322.6640983044},
+		{A: 2.127e-07, B: 5.36857139841, C: 13521.7514415914},
+		{A: 1.9422e-07, B: 4.98378647655, C: 10213.285546211},
+		{A: 1.6941e-07, B: 3.88765393402, C: 26617.5941066688},
+		{A: 1.5109e-07, B: 0.44510589948, C: 46514.4742339962},
+		{A: 1.7087e-07, B: 1.24077764194, C: 77204.32749453338},
+		{A: 1.394e-07, B: 1.62573946865, C: 27147.28507176339},
+		{A: 1.3382e-07, B: 1.07657890477, C: 51646.11531805379},
+		{A: 1.5012e-07, B: 4.28173463507, C: 41962.5207369374},
+		{A: 1.3977e-07, B: 4.77056848793, C: 33326.5787331742},
+		{A: 1.2794e-07, B: 6.06437138766, C: 1109.3785520934},
+		{A: 1.3938e-07, B: 1.99984876578, C: 25132.3033999656},
+		{A: 1.6297e-07, B: 2.63293587817, C: 19804.8272915828},
+		{A: 1.1933e-07, B: 2.36500939134, C: 4551.9534970588},
+		{A: 1.0612e-07, B: 5.46555460932, C: 234791.12827416777},
+		{A: 1.2754e-07, B: 2.07613721222, C: 529.6909650946},
+		{A: 1.2069e-07, B: 2.84997619452, C: 79373.087976816},
+		{A: 9.069e-08, B: 1.21263611811, C: 14765.2390432698},
+		{A: 9.491e-08, B: 0.83697007534, C: 12566.1516999828},
+		{A: 9.379e-08, B: 5.41195286503, C: 83925.0414738748},
+		{A: 7.499e-08, B: 2.44636811119, C: 30639.856638633},
+		{A: 7.463e-08, B: 5.53233943198, C: 32858.61374281979},
+		{A: 7.215e-08, B: 1.17101960691, C: 16983.9961474566},
+		{A: 8.492e-08, B: 3.56622930635, C: 73711.75592766379},
+		{A: 7.109e-08, B: 5.32625264624, C: 426.598190876},
+		{A: 6.863e-08, B: 1.82313992707, C: 36301.18868778519},
+		{A: 6.56e-08, B: 4.27818149213, C: 43071.8992890308},
+		{A: 8.853e-08, B: 3.87737694074, C: 50586.73338786459},
+		{A: 6.885e-08, B: 5.3110852663, C: 1589.0728952838},
+		{A: 5.943e-08, B: 4.06893157254, C: 53131.406024757},
+		{A: 7.653e-08, B: 3.91505031889, C: 51749.20809227239},
+		{A: 6.46e-08, B: 6.07127449283, C: 77154.33087262919},
+		{A: 5.415e-08, B: 5.20028107807, C: 65697.55772473979},
+		{A: 5.186e-08, B: 3.56743214904, C: 6283.0758499914},
+		{A: 4.09e-08, B: 3.6759365871, C: 22645.32819660879},
+		{A: 4.075e-08, B: 4.29142111073, C: 103292.23063610759},
+		{A: 4.428e-08, B: 5.69109527379, C: 45892.73043315699},
+		{A: 3.426e-08, B: 0.64911736234, C: 52705.49724824299},
+		{A: 3.354e-08, B: 3.49345105494, C: 72602.37737557039},
+		{A: 4.12e-08, B: 1.65386255382, C: 25448.00585526019},
+		{A: 3.261e-08, B: 1.03195890028, C: 68050.42387851159},
+		{A: 3.278e-08, B: 3.13863529552, C: 25874.6040461362},
+		{A: 3.054e-08, B: 4.12578324522, C: 77734.01845962799},
+		{A: 3.614e-08, B: 2.95861599353, C: 28306.66024576099},
+		{A: 2.82e-08, B: 4.62628436074, C: 18849.2275499742},
+		{A: 2.746e-08, B: 4.67880529205, C: 53235.18821333759},
+		{A: 2.713e-08, B: 3.5687491398, C: 26068.2333806744},
+		{A: 2.507e-08, B: 2.23312085627, C: 260879.03141574195},
+		{A: 3.044e-08, B: 5.90613310181, C: 105460.99111839019},
+		{A: 2.755e-08, B: 5.67587087632, C: 26107.57290247399},
+		{A: 2.267e-08, B: 2.17941340037, C: 110012.94461544899},
+		{A: 2.274e-08, B: 0.50173671332, C: 32370.9789915656},
+		{A: 2.741e-08, B: 4.75427920262, C: 63498.47038145279},
+		{A: 2.792e-08, B: 5.05148206806, C: 51220.20654153979},
+		{A: 2.557e-08, B: 2.95114696617, C: 26080.78959457339},
+		{A: 2.135e-08, B: 0.84621449019, C: 76674.63652943878},
+		{A: 1.971e-08, B: 0.4740749431, C: 48733.23133818299},
+		{A: 1.965e-08, B: 1.37420902653, C: 7238.6755916},
+		{A: 2.526e-08, B: 0.34535290139, C: 23969.1392811958},
+		{A: 2.022e-08, B: 0.33828477567, C: 99799.65906923798},
+		{A: 1.872e-08, B: 3.93046425037, C: 38654.05484155699},
+		{A: 1.873e-08, B: 0.69327393635, C: 26091.7844769322},
+		{A: 1.945e-08, B: 2.37383894182, C: 6770.7106012456},
+		{A: 1.863e-08, B: 2.26591720786, C: 26084.0218062162},
+		{A: 1.75e-08, B: 5.50651903992, C: 56727.7597802072},
+		{A: 1.971e-08, B: 3.77455887616, C: 19317.1925403286},
+		{A: 1.672e-08, B: 2.15275897581, C: 26514.5013324502},
+		{A: 1.703e-08, B: 4.28965990785, C: 40853.142184844},
+		{A: 1.657e-08, B: 4.98021773372, C: 9103.9069941176},
+		{A: 2.084e-08, B: 2.19427123968, C: 26301.2022370122},
+		{A: 1.818e-08, B: 4.20870869718, C: 25938.3399444396},
+		{A: 1.78e-08, B: 0.91702846577, C: 79219.3091663312},
+		{A: 1.525e-08, B: 2.15720744047, C: 27676.976036858},
+		{A: 1.533e-08, B: 1.54503054415, C: 955.5997416086},
+		{A: 1.614e-08, B: 1.27565104562, C: 25035.6347583858},
+		{A: 1.743e-08, B: 0.68269002122, C: 77837.11123384659},
+		{A: 1.389e-08, B: 4.85102048256, C: 62389.09182935939},
+		{A: 1.436e-08, B: 0.05742216761, C: 23869.1460373874},
+		{A: 1.563e-08, B: 5.84795233948, C: 26095.016688575},
+		{A: 1.64e-08, B: 4.66281337712, C: 25021.4076643842},
+		{A: 1.552e-08, B: 2.83910580545, C: 103242.2340142034},
+		{A: 1.249e-08, B: 1.97225274067, C: 91785.46086631398},
+		{A: 1.16e-08, B: 1.73113341823, C: 38519.945791972},
+		{A: 1.005e-08, B: 1.0589831486, C: 129380.13377768178},
+		{A: 1.041e-08, B: 2.78036322434, C: 213.299095438},
+		{A: 1.105e-08, B: 4.55512109515, C: 26011.6370702986},
+		{A: 9.79e-09, B: 2.43870419667, C: 71980.63357473118},
+		{A: 8.53e-09, B: 0.56334001923, C: 20760.4270331914},
+		{A: 8.74e-09, B: 4.53679970936, C: 639.897286314},
+		{A: 7.83e-09, B: 3.69782403005, C: 78793.40038981718},
+		{A: 7.72e-09, B: 4.08714349549, C: 94138.32702008578},
+		{A: 7.97e-09, B: 0.26237215917, C: 98690.28051714458},
+		{A: 9.65e-09, B: 4.70784179954, C: 51535.90899683439},
+		{A: 9.52e-09, B: 2.53604676495, C: 29530.4780865396},
+		{A: 7.73e-09, B: 2.67893222158, C: 131548.89425996438},
+		{A: 7.34e-09, B: 0.8924366949, C: 103821.92160120218},
+		{A: 6.68e-09, B: 3.73113394924, C: 46848.3301747656},
+		{A: 8.28e-09, B: 2.88184821542, C: 19406.6782881746},
+		{A: 6.22e-09, B: 1.05579535631, C: 59414.4818747484},
+		{A: 6.76e-09, B: 4.5035759936, C: 25934.1243310894},
+		{A: 6.02e-09, B: 5.28399057704, C: 286966.9345573162},
+		{A: 6.19e-09, B: 1.44855037685, C: 79323.09135491178},
+		{A: 6.91e-09, B: 6.19733262608, C: 51962.5071877104},
+		{A: 5.63e-09, B: 5.22994296186, C: 136100.84775702318},
+		{A: 7.82e-09, B: 3.25257254691, C: 50057.04242277},
+		{A: 5.51e-09, B: 0.33644229836, C: 52156.1365222486},
+		{A: 7.05e-09, B: 4.7370537192, C: 26241.681952059},
+		{A: 5.77e-09, B: 5.85654734429, C: 2118.7638603784},
+		{A: 5.17e-09, B: 3.93195379429, C: 102762.53967101299},
+		{A: 6.35e-09, B: 4.87720838965, C: 12432.0426503978},
+		{A: 6.35e-09, B: 1.81903685898, C: 77308.10968311399},
+		{A: 5.43e-09, B: 1.40877130839, C: 44937.1306915484},
+		{A: 5.56e-09, B: 5.44324390449, C: 85034.42002596818},
+		{A: 4.96e-09, B: 3.39004132624, C: 125887.56221081219},
+		{A: 5.46e-09, B: 4.78005317483, C: 22747.2907148744},
+		{A: 5.2e-09, B: 6.00172746972, C: 52168.69273614759},
+		{A: 4.63e-09, B: 3.67549187956, C: 25668.418497699},
+		{A: 4.52e-09, B: 3.53009000682, C: 58458.88213313979},
+		{A: 4.74e-09, B: 5.9433051204, C: 54394.56338733519},
+		{A: 5.59e-09, B: 2.44355554028, C: 52195.47604404819},
+		{A: 4.18e-09, B: 3.73369494476, C: 103925.01437542078},
+		{A: 3.97e-09, B: 0.6901460802, C: 64741.95798313119},
+		{A: 5.11e-09, B: 5.88243734524, C: 45494.58142974879},
+		{A: 4.12e-09, B: 2.26754404446, C: 82815.66292178139},
+		{A: 4.25e-09, B: 5.24511927496, C: 52389.1053785864},
+		{A: 4.82e-09, B: 0.98165400059, C: 25654.19140369739},
+		{A: 3.8e-09, B: 3.74413132157, C: 52179.6876185064},
+		{A: 3.59e-09, B: 5.50961077408, C: 26555.8681319286},
+		{A: 3.75e-09, B: 1.97920050124, C: 149.5631971346},
+		{A: 3.85e-09, B: 5.8899194879, C: 129330.13715577759},
+		{A: 3.48e-09, B: 4.91064019959, C: 1052.2683831884},
+		{A: 4.17e-09, B: 4.32916053867, C: 51123.53789995999},
+		{A: 4.25e-09, B: 1.43228996047, C: 51109.31080595839},
+		{A: 4.64e-09, B: 3.98651628073, C: 105307.21230790539},
+		{A: 3.29e-09, B: 5.20636278221, C: 52602.4044740244},
+		{A: 3.54e-09, B: 1.04383146533, C: 66941.04532641819},
+		{A: 3.24e-09, B: 1.62975761811, C: 88476.99497093359},
+		{A: 3.78e-09, B: 5.31676765847, C: 52171.9249477904},
+		{A: 4.4e-09, B: 1.46381183715, C: 89586.37352302698},
+		{A: 3.55e-09, B: 1.52732265492, C: 1066.49547719},
+		{A: 3.02e-09, B: 5.21113786435, C: 53764.8791784322},
+		{A: 3.01e-09, B: 5.02488829134, C: 117873.36400788819},
+		{A: 3.51e-09, B: 2.0043334173, C: 24395.7374720718},
+		{A: 2.89e-09, B: 6.19161799918, C: 26094.53170047421},
+		{A: 2.86e-09, B: 3.77170690304, C: 7.1135470008},
+		{A: 2.84e-09, B: 3.10942616808, C: 49957.0491789616},
+		{A: 3.85e-09, B: 2.47184729453, C: 25234.70675982219},
+		{A: 3.82e-09, B: 0.97683830518, C: 52026.2430860138},
+		{A: 2.71e-09, B: 5.74710334372, C: 25455.119402261},
+		{A: 3.75e-09, B: 0.55187466535, C: 45405.0956819028},
+		{A: 2.67e-09, B: 2.86410554301, C: 80482.46652890938},
+		{A: 3.04e-09, B: 6.00190488272, C: 2218.7571041868},
+		{A: 2.59e-09, B: 1.82921035765, C: 24505.94379329119},
+		{A: 2.51e-09, B: 4.10979574073, C: 155468.036919256},
+		{A: 2.89e-09, B: 3.05373259745, C: 26081.27458267419},
+		{A: 2.42e-09, B: 5.05501089774, C: 42153.969003049},
+		{A: 2.44e-09, B: 2.1562166805, C: 3442.5749449654},
+		{A: 3.15e-09, B: 2.61613386386, C: 52182.9198301492},
+		{A: 2.56e-09, B: 3.71117332461, C: 25619.9381512198},
+		{A: 2.32e-09, B: 2.21576531181, C: 16066.0658614748},
+		{A: 2.33e-09, B: 5.49033130906, C: 98068.53671630539},
+		{A: 2.36e-09, B: 4.08612620637, C: 26013.1215430069},
+		{A: 2.25e-09, B: 0.4444933881, C: 76144.94556434419},
+		{A: 2.42e-09, B: 3.29851820234, C: 6681.2248533996},
+		{A: 2.27e-09, B: 1.63236736805, C: 76.2660712756},
+		{A: 2.68e-09, B: 1.09037236588, C: 18093.37469954999},
+		{A: 2.55e-09, B: 4.20635818545, C: 44181.27784112419},
+		{A: 2.05e-09, B: 1.00234895959, C: 26190.99591579279},
+		{A: 2.28e-09, B: 1.46077047931, C: 77623.81213840858},
+		{A: 1.97e-09, B: 1.36693360783, C: 26727.8004278882},
+		{A: 2.59e-09, B: 1.28296039354, C: 13655.8604911764},
+		{A: 1.97e-09, B: 5.7330758343, C: 157636.79740153858},
+		{A: 1.96e-09, B: 0.06606260924, C: 40565.2543247742},
+		{A: 1.87e-09, B: 0.86187283408, C: 120226.23016165999},
+		{A: 2.62e-09, B: 4.14850032709, C: 24491.71669928959},
+		{A: 2.44e-09, B: 4.14399890076, C: 95247.70557217918},
+		{A: 2.32e-09, B: 5.45165695316, C: 33967.99229491319},
+		{A: 2.06e-09, B: 5.59196913034, C: 55618.3812281138},
+		{A: 2.03e-09, B: 1.64252462466, C: 58946.51688439399},
+		{A: 1.87e-09, B: 0.46291089182, C: 104881.30353139139},
+		{A: 1.92e-09, B: 1.827611583, C: 25551.09862947879},
+		{A: 2e-09, B: 1.81376901527, C: 25863.55834587229},
+		{A: 1.91e-09, B: 5.57428963071, C: 5327.4761083828},
+		{A: 1.95e-09, B: 3.31297083154, C: 124778.18365871879},
+		{A: 1.69e-09, B: 1.69270064342, C: 90695.75207512038},
+		{A: 2.26e-09, B: 1.32354627258, C: 52099.5402118728},
+		{A: 1.77e-09, B: 3.296208483, C: 25131.61398560359},
+		{A: 1.78e-09, B: 2.5482379505, C: 26720.68688088739},
+		{A: 1.67e-09, B: 3.61983109002, C: 23754.70674870219},
+		{A: 1.59e-09, B: 3.48396967776, C: 25977.69682035479},
+		{A: 1.5e-09, B: 5.20072476078, C: 69159.80243060499},
+		{A: 1.79e-09, B: 3.94252428767, C: 129909.82474277639},
+		{A: 1.66e-09, B: 0.40320638452, C: 100909.03762133139},
+		{A: 1.76e-09, B: 2.97410942402, C: 24925.4284371664},
+		{A: 1.54e-09, B: 1.27206049137, C: 25984.8103673556},
+		{A: 1.44e-09, B: 1.89319156163, C: 64607.84893354619},
+		{A: 1.45e-09, B: 2.05409839424, C: 313054.83769889036},
+		{A: 1.52e-09, B: 4.87194153709, C: 103396.01282468818},
+		{A: 1.37e-09, B: 3.86782217557, C: 24609.0365675098},
+		{A: 1.41e-09, B: 1.99770625298, C: 162188.75089859738},
+		{A: 1.85e-09, B: 6.10038742063, C: 23439.44831610119},
+		{A: 1.35e-09, B: 1.27363012793, C: 52022.0274726636},
+		{A: 1.27e-09, B: 0.70464976569, C: 128850.44281258718},
+		{A: 1.42e-09, B: 1.50696203402, C: 52329.58509363319},
+		{A: 1.26e-09, B: 2.70555128398, C: 28206.6670019526},
+		{A: 1.26e-09, B: 1.61963252183, C: 48835.19385644859},
+		{A: 1.23e-09, B: 0.15629905349, C: 151975.46535238638},
+		{A: 1.37e-09, B: 1.77306412615, C: 35191.8101356918},
+		{A: 1.47e-09, B: 4.50311642422, C: 105410.99449648599},
+		{A: 1.25e-09, B: 3.38994834526, C: 78244.0396638228},
+		{A: 1.19e-09, B: 2.77644375904, C: 78256.59587772179},
+		{A: 1.56e-09, B: 2.96616703196, C: 78050.41032928458},
+		{A: 1.45e-09, B: 1.35569389554, C: 3340.6124266998},
+		{A: 1.26e-09, B: 3.97006576134, C: 29428.515568274},
+		{A: 1.28e-09, B: 5.41891989692, C: 26162.6847401415},
+		{A: 1.32e-09, B: 4.86901248209, C: 24712.1293417284},
+		{A: 1.1e-09, B: 3.8795470244, C: 85502.38501632259},
+		{A: 1.27e-09, B: 5.49703075555, C: 78283.37918562238},
+		{A: 1.26e-09, B: 0.94870979828, C: 121335.60871375339},
+		{A: 1.29e-09, B: 4.00691218396, C: 72936.23331633979},
+		{A: 1.02e-09, B: 0.50487650429, C: 130012.91751699499},
+		{A: 1.04e-09, B: 0.29849788751, C: 84546.78527471398},
+		{A: 1.18e-09, B: 0.76511176346, C: 131395.11544947958},
+		{A: 1.2e-09, B: 4.45261989231, C: 71025.0338331226},
+		{A: 1.17e-09, B: 3.31644134053, C: 25771.5112719176},
+		{A: 1.1e-09, B: 2.632499343, C: 71582.48457132299},
+		{A: 9.7e-10, B: 2.66105435933, C: 155418.04029735178},
+		{A: 1.13e-09, B: 5.8873357539, C: 26404.2950112308},
+		{A: 9.8e-10, B: 2.01417457095, C: 78477.00852016058},
+		{A: 1.03e-09, B: 0.46416881502, C: 51756.3216392732},
+		{A: 9.3e-10, B: 2.37389467504, C: 419.4846438752},
+		{A: 1.25e-09, B: 1.87932536282, C: 26610.48055966799},
+		{A: 9.5e-10, B: 0.34221817875, C: 26507.38778544939},
+		{A: 9.3e-10, B: 3.80346806552, C: 26037.90651966999},
+		{A: 9.1e-10, B: 2.63082094391, C: 28421.0995344462},
+		{A: 1.01e-09, B: 5.20997240587, C: 433.7117378768},
+		{A: 9.6e-10, B: 4.47724179825, C: 77197.21394753258},
+		{A: 1.08e-09, B: 4.03679713919, C: 51742.09454527159},
+		{A: 8.6e-10, B: 5.14039556935, C: 26237.46633870879},
+		{A: 9.2e-10, B: 3.74335845403, C: 90829.86112470538},
+		{A: 8.6e-10, B: 1.86276413658, C: 27140.17152476259},
+		{A: 1.02e-09, B: 5.40465219833, C: 26137.8997634784},
+		{A: 8.6e-10, B: 4.01653667598, C: 10021.8372800994},
+		{A: 1e-09, B: 5.32142188276, C: 108903.56606335558},
+		{A: 7.9e-10, B: 4.68108496987, C: 27154.3986187642},
+		{A: 9.2e-10, B: 5.52103093635, C: 51322.60990139639},
+		{A: 9.3e-10, B: 4.20604773511, C: 38813.3565763492},
+		{A: 7.8e-10, B: 2.88001982141, C: 29416.03879785439},
+		{A: 9.5e-10, B: 4.44018421307, C: 115674.27666460119},
+		{A: 1.04e-09, B: 5.05004528676, C: 50483.640613646},
+		{A: 7.5e-10, B: 1.7892706053, C: 143961.2671494624},
+		{A: 8.6e-10, B: 0.51423355089, C: 78267.59076008058},
+		{A: 7.8e-10, B: 4.6683117379, C: 114564.89811250778},
+		{A: 7.8e-10, B: 4.20137623264, C: 1692.1656695024},
+		{A: 8.6e-10, B: 4.03030391951, C: 78114.14622758799},
+		{A: 7.3e-10, B: 2.27328552013, C: 52643.7712735028},
+		{A: 8.2e-10, B: 3.7351059211, C: 853.196381752},
+		{A: 7.1e-10, B: 4.89150553141, C: 50593.84693486539},
+		{A: 8.2e-10, B: 5.22677693918, C: 27999.1026247914},
+		{A: 9.3e-10, B: 1.0956916144, C: 77211.44104153418},
+		{A: 7.4e-10, B: 1.97833720876, C: 78690.30761559859},
+		{A: 8.2e-10, B: 3.59482991001, C: 71492.99882347698},
+		{A: 8.3e-10, B: 4.10355702051, C: 93028.94846799239},
+		{A: 8.3e-10, B: 3.12647584943, C: 14477.3511832},
+		{A: 6.7e-10, B: 0.24182046727, C: 66653.15746634839},
+		{A: 7.4e-10, B: 5.66833060422, C: 78270.82297172339},
+		{A: 6.8e-10, B: 1.98236355905, C: 79852.78232000639},
+		{A: 6.4e-10, B: 5.551158145, C: 25764.39772491679},
+		{A: 6.4e-10, B: 6.15935356751, C: 76044.9523205358},
+		{A: 8.7e-10, B: 1.77296830923, C: 25440.89230825939},
+		{A: 7.3e-10, B: 2.06220281759, C: 111122.32316754239},
+		{A: 6.6e-10, B: 2.28151075383, C: 7880.08915333899},
+		{A: 7.6e-10, B: 2.65553220848, C: 34282.1784747828},
+		{A: 7.8e-10, B: 0.40338105541, C: 49842.60989027639},
+		{A: 6.4e-10, B: 0.87558639332, C: 181555.94006083018},
+		{A: 8.6e-10, B: 2.0869774868, C: 78259.82808936459},
+		{A: 7.4e-10, B: 0.865017517, C: 50579.61984086379},
+		{A: 8.2e-10, B: 1.02659883956, C: 74821.13447975718},
+		{A: 5.9e-10, B: 2.45952644668, C: 51543.0225438352},
+		{A: 6.2e-10, B: 0.94273311929, C: 25344.9130810416},
+		{A: 5.8e-10, B: 2.95861781205, C: 52182.4348420484},
+		{A: 5.8e-10, B: 4.20402726578, C: 96357.08412427259},
+		{A: 6.1e-10, B: 2.81500265978, C: 26202.34243025941},
+		{A: 5.7e-10, B: 2.27689377996, C: 124156.43985787958},
+		{A: 5.7e-10, B: 4.37167017012, C: 1581.959348283},
+		{A: 5.5e-10, B: 0.12465494356, C: 25973.46385288896},
+		{A: 5.4e-10, B: 4.79303212221, C: 26164.1692128498},
+		{A: 5.4e-10, B: 3.05622204718, C: 25565.3257234804},
+		{A: 5.5e-10, B: 4.51497762833, C: 103711.71527998279},
+		{A: 5.4e-10, B: 3.87143037686, C: 18207.81398823521},
+		{A: 5.4e-10, B: 4.62784653733, C: 25881.717593137},
+		{A: 5.3e-10, B: 4.3254827227, C: 70269.18098269838},
+		{A: 5e-10, B: 2.5006687765, C: 183724.7005431128},
+		{A: 5.8e-10, B: 2.06211216656, C: 1596.1864422846},
+		{A: 6.6e-10, B: 5.2029881429, C: 26294.08869001139},
+		{A: 4.7e-10, B: 0.85039730966, C: 52101.02468458109},
+		{A: 5.8e-10, B: 1.43409045174, C: 23866.04650697719},
+		{A: 4.8e-10, B: 2.35364103056, C: 81706.28436968799},
+		{A: 5.2e-10, B: 0.19368783267, C: 92741.06060792258},
+		{A: 5.3e-10, B: 0.58517949906, C: 26624.70765366959},
+		{A: 6.1e-10, B: 2.61493039721, C: 49527.35145767539},
+		{A: 4.7e-10, B: 3.88384847335, C: 146314.13330323418},
+		{A: 4.9e-10, B: 6.20308247475, C: 32769.1279949738},
+		{A: 5.3e-10, B: 4.37486529196, C: 78187.44335344699},
+		{A: 4.8e-10, B: 5.97568482401, C: 51013.33157874059},
+		{A: 4.8e-10, B: 3.84102043742, C: 102232.84870591838},
+		{A: 4.9e-10, B: 5.05464216653, C: 7994.5284420242},
+		{A: 4.4e-10, B: 5.4289022484, C: 86143.79857806159},
+		{A: 5.8e-10, B: 3.80571138237, C: 99024.13645791399},
+		{A: 4.4e-10, B: 4.02792920142, C: 147423.51185532758},
+		{A: 5.8e-10, B: 6.09746566424, C: 52169.17772424839},
+		{A: 4.2e-10, B: 4.38843836266, C: 224.3447957019},
+		{A: 4.3e-10, B: 1.57859396874, C: 23976.2528281966},
+		{A: 4.9e-10, B: 0.46973350644, C: 51707.84129279399},
+		{A: 4e-10, B: 4.41764731583, C: 52815.7035694624},
+		{A: 4.6e-10, B: 3.51985131014, C: 130969.20667296558},
+		{A: 4.5e-10, B: 4.35527612249, C: 39743.7636327506},
+		{A: 4.5e-10, B: 0.78163192715, C: 25138.7275326044},
+		{A: 4.9e-10, B: 0.90057831755, C: 632.7837393132},
+		{A: 4.1e-10, B: 4.0422561567, C: 52278.89905736699},
+		{A: 3.9e-10, B: 2.9182959512, C: 956.2891559706},
+		{A: 4.3e-10, B: 0.13178694356, C: 2648.454825473},
+		{A: 3.8e-10, B: 0.62377784752, C: 50696.93970908399},
+		{A: 4.2e-10, B: 2.75028269027, C: 24079.34560241519},
+		{A: 4e-10, B: 1.64358052897, C: 39629.32434406539},
+		{A: 3.7e-10, B: 5.75760879379, C: 25867.49049913539},
+		{A: 5.1e-10, B: 1.57829002915, C: 12725.453434775},
+		{A: 3.7e-10, B: 1.62981695072, C: 129483.91596626239},
+		{A: 4.5e-10, B: 0.04645406242, C: 51219.51712717779},
+		{A: 3.6e-10, B: 0.098343736, C: 25241.820306823},
+		{A: 4.9e-10, B: 0.0768115705, C: 150866.08680029298},
+		{A: 3.4e-10, B: 4.56091607046, C: 536.8045120954},
+		{A: 3.6e-10, B: 5.59483746723, C: 52808.59002246159},
+		{A: 3.9e-10, B: 5.9950025247, C: 104138.31347085879},
+		{A: 4.6e-10, B: 0.70772608543, C: 155997.72788435058},
+		{A: 3.3e-10, B: 4.54716206885, C: 78417.48823520739},
+		{A: 4.1e-10, B: 4.92673567168, C: 51639.00177105299},
+		{A: 3.2e-10, B: 4.31372208361, C: 78109.93061423779},
+		{A: 3.6e-10, B: 5.69791953426, C: 26049.7701059364},
+		{A: 3.4e-10, B: 3.32168809248, C: 35472.7441496494},
+		{A: 4.2e-10, B: 4.86530735726, C: 51951.46148744649},
+		{A: 3.3e-10, B: 3.73733919231, C: 154938.34595416137},
+		{A: 4.1e-10, B: 2.38147812869, C: 68241.8721446232},
+		{A: 3.5e-10, B: 5.0957104722, C: 339142.7408404646},
+		{A: 3.1e-10, B: 4.31506703539, C: 52072.71350892979},
+		{A: 3.2e-10, B: 3.21248509301, C: 178063.3684939606},
+		{A: 3.5e-10, B: 5.04278529087, C: 188276.6540401716},
+		{A: 4.1e-10, B: 3.22838990589, C: 1162.4747044078},
+		{A: 3e-10, B: 2.3306109214, C: 1478.8665740644},
+		{A: 3.2e-10, B: 2.71244280066, C: 25780.3455206046},
+		{A: 3.2e-10, B: 3.8041146042, C: 157483.01859105378},
+		{A: 3e-10, B: 0.37624991562, C: 26421.7590823436},
+		{A: 2.9e-10, B: 5.82513433576, C: 104344.49901929598},
+		{A: 3.1e-10, B: 6.02215690021, C: 26198.1094627936},
+		{A: 4e-10, B: 1.02201252788, C: 27819.0254945068},
+		{A: 3e-10, B: 2.2584999502, C: 104371.28232719658},
+		{A: 2.9e-10, B: 2.67851330746, C: 110.2063212194},
+		{A: 3e-10, B: 1.34446903292, C: 1375.7737998458},
+		{A: 2.8e-10, B: 4.62845152557, C: 74923.09699802278},
+		{A: 3.7e-10, B: 3.03899000872, C: 11610.5519583742},
+		{A: 3.2e-10, B: 0.26062549755, C: 52065.59996192899},
+		{A: 3.8e-10, B: 1.257046524, C: 131498.89763806018},
+		{A: 3.2e-10, B: 1.04832153595, C: 26086.4186688659},
+		{A: 3.7e-10, B: 1.63595908508, C: 50800.03248330259},
+		{A: 3e-10, B: 0.15550783905, C: 104331.94280539699},
+		{A: 2.6e-10, B: 0.03549300809, C: 24815.222115947},
+		{A: 2.6e-10, B: 1.03871337432, C: 24292.64469785319},
+		{A: 2.6e-10, B: 3.54557412535, C: 156100.82065856917},
+		{A: 2.7e-10, B: 0.60333270859, C: 111590.2881578968},
+		{A: 3.1e-10, B: 3.3554055611, C: 126996.94076290558},
+		{A: 2.6e-10, B: 5.73937805822, C: 54294.57014352679},
+		{A: 2.6e-10, B: 2.79743885101, C: 86457.98475793119},
+		{A: 2.6e-10, B: 5.70579806927, C: 97670.38771289718},
+		{A: 2.5e-10, B: 2.34928986187, C: 24601.92302050899},
+		{A: 2.5e-10, B: 5.70613852018, C: 181505.94343892598},
+		{A: 3.4e-10, B: 0.23651097126, C: 26395.46076254379},
+		{A: 2.5e-10, B: 2.19241735868, C: 52250.5878817157},
+		{A: 2.5e-10, B: 1.82880416247, C: 74.7815985673},
+		{A: 2.8e-10, B: 0.57661358158, C: 125112.03959948818},
+		{A: 2.4e-10, B: 2.0393825417, C: 26521.614879451},
+		{A: 2.4e-10, B: 5.05213893429, C: 104564.91166173479},
+		{A: 2.3e-10, B: 6.23874249128, C: 75615.25459924959},
+		{A: 2.6e-10, B: 3.34519516519, C: 110634.68841628819},
+		{A: 2.6e-10, B: 4.74840245512, C: 13362.4497067992},
+		{A: 2.6e-10, B: 0.15419927428, C: 22759.76748529401},
+		{A: 2.7e-10, B: 2.39568978424, C: 522.5774180938},
+		{A: 2.8e-10, B: 0.25542748453, C: 103.0927742186},
+		{A: 2.5e-10, B: 3.32961707416, C: 28286.9904848612},
+		{A: 2.6e-10, B: 2.07987634823, C: 29550.14784743939},
+		{A: 3.2e-10, B: 1.70464933285, C: 26089.38761428249},
+		{A: 2.3e-10, B: 4.16670746048, C: 24176.703658357},
+		{A: 2.4e-10, B: 0.08582796512, C: 51859.41441349179},
+		{A: 2.5e-10, B: 5.27240695394, C: 25936.85547173129},
+		{A: 2.4e-10, B: 0.80363857551, C: 77829.99768684579},
+		{A: 2.3e-10, B: 2.30360039127, C: 77410.51304297059},
+		{A: 3e-10, B: 1.21286011206, C: 97112.93697469679},
+		{A: 2.2e-10, B: 4.82094558003, C: 13541.42120249119},
+		{A: 2.3e-10, B: 2.65250267545, C: 52492.19815280499},
+		{A: 2.6e-10, B: 6.26936948934, C: 25939.82441714789},
+		{A: 2.4e-10, B: 4.92454390318, C: 52698.38370124219},
+		{A: 2.2e-10, B: 1.2347092155, C: 103285.11708910679},
+		{A: 2.3e-10, B: 3.68813881444, C: 26729.31670331319},
+		{A: 2.2e-10, B: 1.95831083558, C: 24356.7807886416},
+		{A: 2.2e-10, B: 0.78346634338, C: 104202.04936916218},
+		{A: 2.1e-10, B: 2.5293143267, C: 467.9649903544},
+		{A: 2.4e-10, B: 1.84656751628, C: 76571.54375522019},
+		{A: 2.5e-10, B: 2.55626379808, C: 24822.3356629478},
+		{A: 2.7e-10, B: 3.61440862477, C: 23962.02573419499},
+		{A: 2.3e-10, B: 1.18953680418, C: 141762.17980617538},
+		{A: 2e-10, B: 5.49778171394, C: 112231.70171963578},
+		{A: 2.3e-10, B: 2.6071960805, C: 25754.0472008048},
+		{A: 2.6e-10, B: 0.75788900266, C: 55516.4187098482},
+		{A: 2.6e-10, B: 2.09375500363, C: 134991.4692049298},
+		{A: 2e-10, B: 3.35091650179, C: 52595.29092702359},
+		{A: 2.1e-10, B: 0.36570195077, C: 97580.90196505119},
+		{A: 2.4e-10, B: 0.51085875774, C: 116917.76426627958},
+		{A: 2.1e-10, B: 5.71018496974, C: 60055.89543648739},
+		{A: 1.9e-10, B: 0.56236491686, C: 52125.80966124419},
+		{A: 1.9e-10, B: 2.85801633295, C: 60370.08161635699},
+		{A: 2.4e-10, B: 3.50657176712, C: 77844.22478084739},
+		{A: 2e-10, B: 0.53533403977, C: 26941.0995233262},
+		{A: 1.9e-10, B: 6.25214103463, C: 25973.50403466079},
+		{A: 2.2e-10, B: 5.13145226592, C: 104347.73123093879},
+		{A: 2.1e-10, B: 4.13407823619, C: 103299.34418310839},
+		{A: 1.9e-10, B: 0.33492578967, C: 102018.41617342478},
+		{A: 1.9e-10, B: 5.67148210198, C: 54509.0026760204},
+		{A: 1.8e-10, B: 1.90051892916, C: 52325.36948028299},
+		{A: 1.9e-10, B: 4.27364751897, C: 122444.98726584678},
+		{A: 1.8e-10, B: 2.45675888957, C: 104358.72611329758},
+		{A: 1.8e-10, B: 1.05990359493, C: 27780.06881107659},
+		{A: 1.8e-10, B: 1.43297513339, C: 53242.3017603384},
+		{A: 2.1e-10, B: 2.99876487527, C: 26073.67604757259},
+		{A: 2.3e-10, B: 1.86389686355, C: 116783.65521669458},
+		{A: 1.8e-10, B: 4.87152831033, C: 170049.1702910366},
+		{A: 1.9e-10, B: 4.89400475242, C: 53228.07466633679},
+		{A: 2.1e-10, B: 2.47295566818, C: 27726.9726587622},
+		{A: 2e-10, B: 4.98762998978, C: 104778.21075717278},
+		{A: 2.1e-10, B: 1.46468188731, C: 140652.80125408198},
+		{A: 1.7e-10, B: 4.05153553008, C: 76667.52298243798},
+		{A: 2e-10, B: 4.80985268979, C: 51528.79544983359},
+		{A: 2.2e-10, B: 0.86617331302, C: 119116.85160956658},
+		{A: 2.3e-10, B: 2.08281937549, C: 52225.8029050526},
+		{A: 1.8e-10, B: 1.69333480892, C: 76681.75007643958},
+		{A: 1.7e-10, B: 2.09406764538, C: 64901.25971792339},
+		{A: 1.9e-10, B: 5.00011023502, C: 105940.68546158058},
+		{A: 1.9e-10, B: 0.74020534937, C: 36109.7404216736},
+		{A: 1.7e-10, B: 2.93246868693, C: 102132.85546210999},
+		{A: 2.2e-10, B: 3.55902023067, C: 104355.49390165479},
+		{A: 2.1e-10, B: 3.54390310557, C: 106570.36967048359},
+		{A: 1.8e-10, B: 4.65006394461, C: 61279.713277266},
+		{A: 1.9e-10, B: 4.43905256177, C: 647.0108333148},
+		{A: 1.7e-10, B: 2.831244806, C: 132658.27281205778},
+		{A: 1.8e-10, B: 2.89808596608, C: 26091.83529483729},
+		{A: 1.7e-10, B: 5.67044617806, C: 25788.776747305},
+		{A: 1.7e-10, B: 5.37178978632, C: 78731.674415077},
+		{A: 1.5e-10, B: 5.25910515843, C: 150244.3429994538},
+		{A: 1.9e-10, B: 1.72681089737, C: 153.7788104848},
+		{A: 1.5e-10, B: 2.16472550978, C: 26222.0121911592},
+		{A: 1.5e-10, B: 1.1302968538, C: 25042.7483053866},
+		{A: 1.5e-10, B: 2.31335544105, C: 51852.30086649099},
+		{A: 1.9e-10, B: 4.38254962804, C: 24182.4383766338},
+		{A: 1.5e-10, B: 5.16465400786, C: 27684.0895838588},
+		{A: 1.7e-10, B: 3.97954718583, C: 207643.8432024044},
+		{A: 1.6e-10, B: 5.7405789846, C: 25352.02662804239},
+		{A: 1.4e-10, B: 3.42441202713, C: 26823.77965510599},
+		{A: 1.4e-10, B: 5.30168284837, C: 742.9900605326},
+		{A: 1.4e-10, B: 2.5043130681, C: 32132.1317229496},
+		{A: 1.4e-10, B: 1.36632464908, C: 23919.1426592916},
+		{A: 1.5e-10, B: 6.0749051443, C: 78270.3379836226},
+		{A: 1.9e-10, B: 4.4055707896, C: 49953.94964855139},
+		{A: 1.4e-10, B: 0.65475293554, C: 323.5054166574},
+		{A: 1.4e-10, B: 0.47093305165, C: 17893.6278083656},
+		{A: 1.4e-10, B: 1.33029112501, C: 129799.61842155698},
+		{A: 1.7e-10, B: 6.0291534594, C: 26667.590728573},
+		{A: 1.4e-10, B: 1.17588054181, C: 104275.34649502118},
+		{A: 1.4e-10, B: 4.84032377923, C: 2221.856634597},
+		{A: 1.4e-10, B: 5.53694881682, C: 77630.92568540938},
+		{A: 1.3e-10, B: 5.5979123873, C: 209812.60368468694},
+		{A: 1.3e-10, B: 0.84042148682, C: 173511.41499690176},
+		{A: 1.5e-10, B: 3.96416547461, C: 51432.81622261579},
+		{A: 1.5e-10, B: 3.17020350946, C: 176953.98994186718},
+		{A: 1.4e-10, B: 2.91048815444, C: 78257.08086582259},
+		{A: 1.3e-10, B: 5.34915944924, C: 80174.90890793978},
+		{A: 1.8e-10, B: 1.65795375577, C: 28256.66362385679},
+		{A: 1.3e-10, B: 3.34629064183, C: 25508.2155545754},
+		{A: 1.6e-10, B: 1.32228458025, C: 27669.86248985719},
+		{A: 1.3e-10, B: 1.38990649917, C: 25024.58905812189},
+		{A: 1.7e-10, B: 2.01249037695, C: 27037.07875054399},
+		{A: 1.5e-10, B: 1.99208068531, C: 52381.99183158559},
+		{A: 1.3e-10, B: 2.32883800921, C: 27005.83342755599},
+		{A: 1.4e-10, B: 3.86914390591, C: 182085.63102592478},
+		{A: 1.2e-10, B: 3.97054224711, C: 78188.92782615528},
+		{A: 1.6e-10, B: 5.59174105029, C: 25446.4895798352},
+		{A: 1.4e-10, B: 0.70490117614, C: 172402.0364448084},
+		{A: 1.2e-10, B: 1.54422590258, C: 52252.07235442399},
+		{A: 1.4e-10, B: 3.47518139481, C: 27177.6119327678},
+		{A: 1.2e-10, B: 5.46223056321, C: 107794.1875112622},
+		{A: 1.2e-10, B: 5.76552608362, C: 50167.24874398939},
+		{A: 1.6e-10, B: 2.18648993322, C: 26308.315784013},
+		{A: 1.4e-10, B: 2.72148386686, C: 27311.72098235281},
+		{A: 1.3e-10, B: 0.55524977232, C: 75930.51303185058},
+		{A: 1.2e-10, B: 2.66601602132, C: 137210.22630911658},
+		{A: 1.1e-10, B: 0.61596543052, C: 44295.7171298094},
+		{A: 1.1e-10, B: 4.76091715459, C: 155571.81910783658},
+		{A: 1.4e-10, B: 5.93896401871, C: 52290.24557183361},
+		{A: 1.1e-10, B: 0.92596625175, C: 22625.658435709},
+		{A: 1.2e-10, B: 6.19273795549, C: 51653.22886505459},
+		{A: 1.4e-10, B: 0.79969658546, C: 25953.79409198919},
+		{A: 1.1e-10, B: 2.82093111356, C: 77101.23472031478},
+		{A: 1.1e-10, B: 1.26994666311, C: 26312.2479372761},
+		{A: 1.3e-10, B: 2.88923696437, C: 112545.88789950538},
+		{A: 1.1e-10, B: 6.05528757595, C: 27044.1922975448},
+		{A: 1.2e-10, B: 3.29626000869, C: 52061.36699446317},
+		{A: 1.1e-10, B: 3.58945976808, C: 52712.61079524379},
+		{A: 1.4e-10, B: 2.790365075, C: 23888.81579828719},
+		{A: 1.1e-10, B: 4.15810059578, C: 45290.65639321759},
+		{A: 1.1e-10, B: 2.94979930726, C: 58857.03113654799},
+		{A: 1.2e-10, B: 2.20589728666, C: 31415.379249957},
+		{A: 1.2e-10, B: 1.34841658603, C: 51969.62073471119},
+		{A: 1.1e-10, B: 3.29585795787, C: 28736.3579670472},
+		{A: 1.1e-10, B: 0.43538573957, C: 24448.8336243862},
+		{A: 1e-10, B: 2.85999162474, C: 130226.21661243298},
+		{A: 1.3e-10, B: 4.41880429822, C: 19958.6061020676},
+		{A: 1.2e-10, B: 5.96394058241, C: 26083.97098831109},
+		{A: 1e-10, B: 5.442971467, C: 163298.1294506908},
+		{A: 1.2e-10, B: 1.57767582029, C: 91805.13062721379},
+		{A: 1e-10, B: 3.83536418151, C: 51226.63067417859},
+		{A: 1.3e-10, B: 0.34187342138, C: 157057.10981453978},
+		{A: 1e-10, B: 1.12787053523, C: 78903.60671103658},
+		{A: 1e-10, B: 4.67818130014, C: 2111.6503133776},
+		{A: 1.2e-10, B: 3.56391619799, C: 77795.74443436819},
+		{A: 1.1e-10, B: 5.80720491261, C: 26102.1302355758},
+		{A: 1.2e-10, B: 5.802523822, C: 27972.80430499159},
+		{A: 1.1e-10, B: 2.47026109919, C: 26010.1525975903},
+		{A: 1.3e-10, B: 4.60245036657, C: 25032.45336464809},
+		{A: 1e-10, B: 1.14221106164, C: 9384.8410080752},
+		{A: 1.3e-10, B: 5.56953201474, C: 22909.7573510066},
+		{A: 1e-10, B: 4.35806585483, C: 157586.80077963436},
+		{A: 1e-10, B: 1.11553298844, C: 65831.6667743248},
+		{A: 1.1e-10, B: 4.67108617661, C: 50064.15596977079},
+		{A: 1.1e-10, B: 2.80726281395, C: 81591.84508100279},
+		{A: 9e-11, B: 0.4735375306, C: 128106.31931499895},
+		{A: 9e-11, B: 1.42459829123, C: 104505.39137678158},
+		{A: 9e-11, B: 2.37565722096, C: 78896.49316403578},
+		{A: 9e-11, B: 4.84820485208, C: 24918.31489016559},
+		{A: 1.1e-10, B: 6.2383400887, C: 30171.8916482786},
+		{A: 1.1e-10, B: 1.65565660037, C: 78039.36462902068},
+		{A: 9e-11, B: 3.65734666897, C: 151199.94274106238},
+		{A: 9e-11, B: 5.40966654859, C: 130459.18546877075},
+		{A: 1e-10, B: 0.87714716556, C: 78366.80219894118},
+		{A: 9e-11, B: 3.04701773654, C: 51329.7234483972},
+		{A: 9e-11, B: 1.96005885441, C: 26575.53789282839},
+		{A: 1e-10, B: 1.18004009296, C: 50270.341518208},
+		{A: 9e-11, B: 5.48583841069, C: 138319.60486120995},
+		{A: 1e-10, B: 3.21154218694, C: 77307.42026875199},
+		{A: 9e-11, B: 5.55407965409, C: 8194.2753332086},
+		{A: 8e-11, B: 1.35584545211, C: 12546.481939083},
+		{A: 9e-11, B: 0.42023516699, C: 27223.5800134674},
+		{A: 1e-10, B: 1.7670450966, C: 77726.90491262719},
+		{A: 9e-11, B: 1.60501838348, C: 77616.69859140778},
+		{A: 9e-11, B: 5.46388323313, C: 16703.062133499},
+		{A: 9e-11, B: 1.60520912676, C: 117893.03376878797},
+		{A: 9e-11, B: 6.2352873989, C: 846.0828347512},
+		{A: 9e-11, B: 5.66619352199, C: 50910.238804522},
+		{A: 9e-11, B: 1.18753574623, C: 104197.83375581198},
+		{A: 9e-11, B: 0.69327774851, C: 26709.6469424134},
+		{A: 1e-10, B: 5.48870132496, C: 94329.77528619739},
+		{A: 8e-11, B: 1.17006056902, C: 78160.61665050399},
+		{A: 9e-11, B: 0.4996506522, C: 27573.1938482774},
+		{A: 1.1e-10, B: 6.20705720585, C: 28102.884813372},
+		{A: 1.1e-10, B: 0.75657533913, C: 128320.75184749259},
+		{A: 8e-11, B: 3.8643079482, C: 103917.90082841998},
+		{A: 9e-11, B: 3.64580324894, C: 76784.84285065818},
+		{A: 8e-11, B: 4.52722085618, C: 27463.67694142},
+		{A: 9e-11, B: 1.73726961641, C: 28791.5192962498},
+		{A: 1.1e-10, B: 1.86762532326, C: 214364.55718174577},
+		{A: 8e-11, B: 3.17547134507, C: 50903.1252575212},
+		{A: 8e-11, B: 6.2314049198, C: 148.0787244263},
+		{A: 8e-11, B: 0.97646930359, C: 19202.75325164339},
+		{A: 8e-11, B: 0.87651427964, C: 151.0476698429},
+		{A: 9e-11, B: 1.55645109653, C: 26126.036177212},
+		{A: 8e-11, B: 3.12232090039, C: 22003.9146348698},
+		{A: 8e-11, B: 3.45204671122, C: 24203.0019781568},
+		{A: 8e-11, B: 2.50177739941, C: 51955.39364070959},
+		{A: 8e-11, B: 4.20326276911, C: 50380.54783942739},
+		{A: 8e-11, B: 2.66681931912, C: 130432.40216087017},
+		{A: 1e-10, B: 2.61302801987, C: 27170.98337386779},
+		{A: 8e-11, B: 6.1579179294, C: 37698.4550999484},
+		{A: 8e-11, B: 5.36718741692, C: 35077.37084700659},
+		{A: 8e-11, B: 0.39542025081, C: 183570.921732628},
+		{A: 8e-11, B: 4.37576068847, C: 123200.84011627098},
+		{A: 8e-11, B: 3.44870239791, C: 26402.0893214438},
+		{A: 7e-11, B: 5.88380837195, C: 51868.2486621788},
+		{A: 7e-11, B: 4.80640660702, C: 26118.2300025786},
+		{A: 8e-11, B: 5.82773614091, C: 3328.13565628019},
+		{A: 9e-11, B: 2.53209611805, C: 25227.59321282139},
+		{A: 1e-10, B: 2.5279323615, C: 35833.2236974308},
+		{A: 8e-11, B: 4.11204744654, C: 53906.92863608099},
+		{A: 8e-11, B: 1.58745297967, C: 101011.00013959699},
+		{A: 9e-11, B: 2.57384727244, C: 2125.8774073792},
+		{A: 7e-11, B: 2.46084982092, C: 52137.67324751059},
+		{A: 8e-11, B: 5.5657753539, C: 50689.82616208319},
+		{A: 7e-11, B: 5.98112141065, C: 52179.73843641149},
+		{A: 1e-10, B: 5.16567712694, C: 55638.05098901359},
+		{A: 8e-11, B: 4.67643518278, C: 76887.93562487679},
+		{A: 7e-11, B: 4.36322743599, C: 155887.52156313116},
+		{A: 9e-11, B: 0.68598593831, C: 38.1330356378},
+		{A: 8e-11, B: 0.46194680162, C: 181026.24909573558},
+		{A: 8e-11, B: 0.42442705032, C: 182188.72380014337},
+		{A: 9e-11, B: 3.57254516611, C: 1265.5674786264},
+		{A: 9e-11, B: 1.65553917926, C: 65717.22748563958},
+		{A: 1e-10, B: 3.53066047636, C: 2008.557539159},
+		{A: 7e-11, B: 3.8937339755, C: 1485.9801210652},
+		{A: 1e-10, B: 0.8255026956, C: 39763.43339365039},
+		{A: 7e-11, B: 4.33817871844, C: 129373.02023068098},
+		{A: 7e-11, B: 3.41340083585, C: 52483.36390411799},
+		{A: 8e-11, B: 1.2399232388, C: 76041.85279012559},
+		{A: 7e-11, B: 0.04567921269, C: 206.1855484372},
+		{A: 7e-11, B: 2.56169701158, C: 207593.8465805002},
+		{A: 9e-11, B: 1.97148507623, C: 365230.6439820388},
+		{A: 7e-11, B: 3.14813276839, C: 52061.40717623499},
+		{A: 7e-11, B: 4.86954611488, C: 27351.06050415239},
+		{A: 8e-11, B: 6.27520424489, C: 204151.27163553477},
+		{A: 7e-11, B: 5.46143749214, C: 25985.94062330859},
+		{A: 8e-11, B: 4.44335543148, C: 26057.57628056979},
+		{A: 7e-11, B: 1.00677483843, C: 129387.24732468258},
+		{A: 8e-11, B: 0.22314041177, C: 50049.92887576919},
+		{A: 7e-11, B: 3.98224449185, C: 13675.5302520762},
+		{A: 7e-11, B: 4.34489404354, C: 167850.0829477496},
+		{A: 9e-11, B: 0.71471851401, C: 233731.7463439786},
+		{A: 7e-11, B: 1.69935809006, C: 54087.0057663656},
+		{A: 7e-11, B: 6.21044393135, C: 203041.8930834414},
+		{A: 7e-11, B: 2.53524305485, C: 80382.47328510099},
+		{A: 7e-11, B: 0.03218225075, C: 31281.270200372},
+		{A: 7e-11, B: 5.26037977949, C: 78338.49102328988},
+		{A: 7e-11, B: 3.52744190481, C: 52509.6622239178},
+		{A: 7e-11, B: 5.24771876203, C: 1795.258443721},
+		{A: 7e-11, B: 5.20875856726, C: 161079.37234650398},
+		{A: 7e-11, B: 3.19471628666, C: 24072.9214697764},
+		{A: 6e-11, B: 1.5809679318, C: 143980.93691036216},
+		{A: 9e-11, B: 0.70414754528, C: 25169.9728555924},
+		{A: 6e-11, B: 0.15264531683, C: 52911.68279668019},
+		{A: 7e-11, B: 4.2857845572, C: 51130.65144696079},
+		{A: 7e-11, B: 3.42650023556, C: 29396.3690369546},
+		{A: 7e-11, B: 4.12959254769, C: 52174.32181044009},
+		{A: 8e-11, B: 3.79812131071, C: 137678.191299471},
+		{A: 7e-11, B: 5.70602822567, C: 9745.3205558566},
+		{A: 8e-11, B: 0.14316432919, C: 136722.59155786238},
+		{A: 7e-11, B: 3.78832454636, C: 26189.8656598398},
+		{A: 7e-11, B: 5.44664616173, C: 27566.76971563859},
+		{A: 6e-11, B: 0.53269408847, C: 208173.534167499},
+		{A: 7e-11, B: 3.95192199993, C: 145204.75475114078},
+		{A: 6e-11, B: 1.8061026575, C: 26830.8932021068},
+		{A: 8e-11, B: 5.80105964058, C: 25773.71696170459},
+		{A: 6e-11, B: 1.20705629404, C: 26734.913974889},
+		{A: 8e-11, B: 3.87508349031, C: 52041.69723356339},
+		{A: 7e-11, B: 1.874291269, C: 1905.4647649404},
+		{A: 7e-11, B: 5.68938243001, C: 158746.17595363196},
+		{A: 8e-11, B: 3.39884199213, C: 78153.50310350319},
+		{A: 7e-11, B: 1.20867598891, C: 61560.64729122359},
+		{A: 8e-11, B: 3.30580293572, C: 130419.8459469712},
+		{A: 7e-11, B: 0.39338960799, C: 2199.087343287},
+		{A: 6e-11, B: 3.17982806365, C: 77947.31755506598},
+		{A: 8e-11, B: 0.8858517965, C: 220.4126424388},
+		{A: 8e-11, B: 1.46508855917, C: 87367.61641884019},
+		{A: 6e-11, B: 0.60809363482, C: 118828.96374949679},
+		{A: 8e-11, B: 5.53182088929, C: 53814.87580033639},
+		{A: 7e-11, B: 1.65646088833, C: 196137.07343261078},
+		{A: 6e-11, B: 5.74552748087, C: 55503.94193942859},
+		{A: 6e-11, B: 1.43996863707, C: 2333.196392872},
+		{A: 6e-11, B: 1.91186990644, C: 130652.81480330898},
+		{A: 6e-11, B: 5.06448767269, C: 50444.6839302158},
+		{A: 6e-11, B: 1.09838300878, C: 183674.70392120857},
+		{A: 7e-11, B: 0.35338852938, C: 103932.12792242158},
+		{A: 6e-11, B: 0.2892480859, C: 54374.8936264354},
+		{A: 6e-11, B: 2.72829193454, C: 76255.15188556358},
+		{A: 6e-11, B: 3.9229048448, C: 130289.95251073639},
+		{A: 7e-11, B: 1.2740249479, C: 46046.5092436418},
+		{A: 7e-11, B: 2.96149281662, C: 52755.49387014719},
+		{A: 8e-11, B: 2.23784693584, C: 25466.159340735},
+		{A: 6e-11, B: 1.78075029765, C: 78786.28684281638},
+		{A: 8e-11, B: 4.71972114899, C: 25014.29411738339},
+		{A: 6e-11, B: 5.5279847086, C: 103498.41618454478},
+		{A: 8e-11, B: 5.43345902063, C: 949.1756089698},
+		{A: 6e-11, B: 5.55571041746, C: 130446.62925487179},
+		{A: 7e-11, B: 5.36488899884, C: 77940.20400806518},
+		{A: 6e-11, B: 0.5208093082, C: 25004.8229092806},
+		{A: 6e-11, B: 3.48878406062, C: 123668.80510662538},
+		{A: 8e-11, B: 3.02023688443, C: 26076.8574413103},
+		{A: 8e-11, B: 5.31586228888, C: 24388.62392507099},
+		{A: 6e-11, B: 5.06257578673, C: 78469.89497315978},
+		{A: 6e-11, B: 4.7950404512, C: 52177.29075585669},
+		{A: 6e-11, B: 4.57296057068, C: 35211.47989659159},
+		{A: 6e-11, B: 5.37176342301, C: 53093.73656913019},
+		{A: 6e-11, B: 4.26973755729, C: 130363.24963659538},
+		{A: 6e-11, B: 5.75364706874, C: 78580.10129437919},
+		{A: 6e-11, B: 1.99314462977, C: 130435.63437251298},
+		{A: 6e-11, B: 1.55918658153, C: 39450.3528483734},
+		{A: 8e-11, B: 4.77256284659, C: 142871.55835826878},
+		{A: 6e-11, B: 6.09530056324, C: 103395.32341032618},
+		{A: 6e-11, B: 1.29134609458, C: 102975.83876645098},
+		{A: 6e-11, B: 1.83917095242, C: 24402.8510190726},
+		{A: 5e-11, B: 3.63216834248, C: 43981.5309499398},
+		{A: 5e-11, B: 5.64850202903, C: 28774.6252361154},
+		{A: 7e-11, B: 4.22124824964, C: 26098.9488418381},
+		{A: 5e-11, B: 5.85936922514, C: 156314.1197540072},
+		{A: 5e-11, B: 4.97283396704, C: 102659.44689679438},
+		{A: 5e-11, B: 1.46473339833, C: 181659.72224941078},
+		{A: 6e-11, B: 0.71584342921, C: 104276.83096772949},
+		{A: 7e-11, B: 2.49362242764, C: 51439.92976961659},
+		{A: 6e-11, B: 0.0294487624, C: 20043.6745601988},
+		{A: 6e-11, B: 2.1948080146, C: 12412.372889498},
+		{A: 7e-11, B: 3.28347851449, C: 183145.012956114},
+		{A: 5e-11, B: 3.46807375357, C: 50536.73676596039},
+		{A: 6e-11, B: 6.20804958899, C: 114.3991069134},
+		{A: 6e-11, B: 2.26035174588, C: 103718.82882698359},
+		{A: 7e-11, B: 3.65318062648, C: 143005.6674078538},
+		{A: 5e-11, B: 0.12859263642, C: 51596.1186961496},
+		{A: 7e-11, B: 0.75531928973, C: 77520.71936418998},
+		{A: 5e-11, B: 1.5297413665, C: 26724.8994135984},
+		{A: 7e-11, B: 3.81960920375, C: 62197.64356324779},
+		{A: 5e-11, B: 5.08212104486, C: 52609.51802102519},
+		{A: 5e-11, B: 4.49337901115, C: 50007.0458008658},
+		{A: 5e-11, B: 5.0321086104, C: 1045.1548361876},
+		{A: 5e-11, B: 6.27674019656, C: 1272.6810256272},
+		{A: 6e-11, B: 3.84313039676, C: 81604.32185142238},
+		{A: 6e-11, B: 3.27974922227, C: 144916.86689107097},
+		{A: 5e-11, B: 0.50987626702, C: 299.1263942692},
+		{A: 6e-11, B: 5.10596879808, C: 78313.70604662679},
+		{A: 5e-11, B: 4.14575201906, C: 53867.97195265079},
+		{A: 7e-11, B: 4.53402175332, C: 166740.70439565618},
+		{A: 5e-11, B: 5.57648480152, C: 106262.81204951399},
+		{A: 7e-11, B: 3.08562485832, C: 52027.72755872209},
+		{A: 6e-11, B: 2.81224506925, C: 25450.90686955},
+		{A: 5e-11, B: 1.51400872659, C: 28199.55345495179},
+		{A: 6e-11, B: 1.58495517428, C: 47803.9299163742},
+		{A: 6e-11, B: 2.79561551205, C: 52286.01260436779},
+		{A: 7e-11, B: 1.80630878142, C: 23549.6546373206},
+		{A: 7e-11, B: 3.72396318065, C: 198489.9395863826},
+		{A: 5e-11, B: 5.74129147264, C: 103189.13786188899},
+		{A: 6e-11, B: 2.94825789213, C: 56259.79478985279},
+		{A: 5e-11, B: 4.96546514085, C: 78413.27262185719},
+		{A: 5e-11, B: 1.7851884573, C: 129586.31932611899},
+		{A: 5e-11, B: 0.31701812829, C: 78800.51393681798},
+		{A: 5e-11, B: 0.55478931159, C: 52817.21984488739},
+		{A: 6e-11, B: 1.18448496437, C: 25600.26839032},
+		{A: 5e-11, B: 0.42862127579, C: 179172.74704605396},
+		{A: 6e-11, B: 3.74336258475, C: 26247.2048763664},
+		{A: 5e-11, B: 2.46583763315, C: 123758.29085447139},
+		{A: 6e-11, B: 5.09461941443, C: 53124.98189211819},
+		{A: 6e-11, B: 0.42320281908, C: 130443.39704322898},
+		{A: 6e-11, B: 2.57896380153, C: 26014.60601571519},
+		{A: 5e-11, B: 2.23635663946, C: 24513.057340292},
+		{A: 5e-11, B: 5.98752668018, C: 104344.98400739678},
+		{A: 6e-11, B: 0.32131472009, C: 102872.74599223239},
+		{A: 5e-11, B: 1.95885559875, C: 53771.99272543299},
+		{A: 7e-11, B: 2.16257501519, C: 52024.75861330549},
+		{A: 6e-11, B: 1.37111815532, C: 51120.35650622229},
+		{A: 5e-11, B: 0.15128494504, C: 78683.19406859778},
+		{A: 5e-11, B: 4.00810087423, C: 48713.5615772832},
+		{A: 6e-11, B: 1.4588311578, C: 76152.05911134499},
+		{A: 5e-11, B: 1.72421399237, C: 28309.75977617119},
+		{A: 5e-11, B: 0.71424864998, C: 102755.42612401219},
+		{A: 6e-11, B: 0.0328360798, C: 78149.27013603736},
+		{A: 6e-11, B: 2.69429372458, C: 78378.1487134078},
+		{A: 6e-11, B: 1.12570261398, C: 25647.07785669659},
+		{A: 5e-11, B: 3.60259399819, C: 11.0457002639},
+		{A: 6e-11, B: 4.8317124728, C: 240452.46032331997},
+		{A: 5e-11, B: 3.71949948652, C: 26411.4085582316},
+		{A: 5e-11, B: 4.55172986205, C: 79330.20490191258},
+		{A: 5e-11, B: 0.84232799985, C: 24551.92639860479},
+		{A: 5e-11, B: 3.74067330742, C: 52797.55008398759},
+		{A: 5e-11, B: 2.92277012561, C: 54190.78795494619},
+		{A: 5e-11, B: 3.96038779086, C: 25657.37279743509},
+		{A: 5e-11, B: 4.54502031861, C: 102769.65321801379},
+		{A: 6e-11, B: 2.35342867622, C: 27665.246684022},
+		{A: 5e-11, B: 3.72877345305, C: 78213.71280281838},
+		{A: 5e-11, B: 6.19447408823, C: 26191.68533015479},
+		{A: 4e-11, B: 0.53733592588, C: 77314.53381575279},
+		{A: 4e-11, B: 1.3991722537, C: 26290.15653674829},
+		{A: 5e-11, B: 4.85332036328, C: 54344.56676543099},
+		{A: 6e-11, B: 0.92030946706, C: 23336.3555418826},
+		{A: 6e-11, B: 0.37487337241, C: 53265.515074342},
+		{A: 4e-11, B: 2.01983013209, C: 156547.08861034497},
+		{A: 5e-11, B: 6.13113074115, C: 26026.177166834},
+		{A: 4e-11, B: 4.94928690334, C: 52663.44103440259},
+		{A: 5e-11, B: 0.40443457223, C: 28096.46068073319},
+		{A: 4e-11, B: 4.40405080911, C: 130593.29451835579},
+		{A: 4e-11, B: 4.17440698686, C: 91919.56991589899},
+		{A: 5e-11, B: 3.7177743666, C: 70383.6202713836},
+		{A: 5e-11, B: 1.52885825328, C: 51102.19725895759},
+		{A: 4e-11, B: 1.71609047005, C: 23762.9537327586},
+		{A: 4e-11, B: 0.55287089413, C: 26933.9859763254},
+		{A: 5e-11, B: 6.02225706023, C: 128220.75860368418},
+		{A: 5e-11, B: 1.78226900229, C: 187167.2754880782},
+		{A: 5e-11, B: 3.32000517354, C: 45424.76544280259},
+		{A: 5e-11, B: 4.64166221826, C: 24824.745778996},
+		{A: 5e-11, B: 3.45997061965, C: 48847.6706268682},
+		{A: 6e-11, B: 2.6227258092, C: 52171.87412988529},
+		{A: 4e-11, B: 3.62789650971, C: 53029.0026649004},
+		{A: 6e-11, B: 2.53652345505, C: 52190.03337714999},
+		{A: 4e-11, B: 0.08235213515, C: 58220.0348645238},
+		{A: 6e-11, B: 2.56621666334, C: 51876.67988887919},
+		{A: 5e-11, B: 5.04323059321, C: 41494.55574658299},
+		{A: 4e-11, B: 3.42433134364, C: 53311.4831550416},
+		{A: 5e-11, B: 5.60899913389, C: 1911.1994832172},
+		{A: 4e-11, B: 1.13990612191, C: 26183.88236879199},
+		{A: 4e-11, B: 4.24142693734, C: 189853.99758261937},
+		{A: 4e-11, B: 0.215216512, C: 19336.86230122839},
+		{A: 5e-11, B: 0.3030674552, C: 103883.64757594238},
+		{A: 4e-11, B: 6.09719517639, C: 77417.62658997139},
+		{A: 4e-11, B: 3.38148734662, C: 316.3918696566},
+		{A: 4e-11, B: 1.40344660688, C: 25885.64974640009},
+		{A: 6e-11, B: 2.54556610763, C: 54060.70744656579},
+		{A: 5e-11, B: 4.62289027765, C: 78339.97549599818},
+		{A: 4e-11, B: 6.06868163269, C: 84944.9342781222},
+		{A: 5e-11, B: 0.6497001886, C: 3178.1457905676},
+		{A: 4e-11, B: 5.4810925636, C: 78225.57638908479},
+		{A: 4e-11, B: 3.76404568854, C: 153084.84390447979},
+		{A: 4e-11, B: 0.18748530762, C: 52489.992463018},
+		{A: 5e-11, B: 1.7404411428, C: 79315.97780791098},
+		{A: 4e-11, B: 6.06406108213, C: 52161.57918914679},
+		{A: 5e-11, B: 0.28595106407, C: 26161.20026743319},
+		{A: 4e-11, B: 2.11074347112, C: 189386.03259226496},
+		{A: 4e-11, B: 0.5971553074, C: 16342.5825857176},
+		{A: 4e-11, B: 3.91091220587, C: 101703.15774082378},
+		{A: 4e-11, B: 1.1613029014, C: 50264.6067999312},
+		{A: 4e-11, B: 5.51188515445, C: 78043.29678228378},
+		{A: 5e-11, B: 4.393503226, C: 53757.76563143139},
+		{A: 4e-11, B: 5.15437140585, C: 24285.5311508524},
+		{A: 5e-11, B: 2.15280343086, C: 48997.6604925808},
+		{A: 4e-11, B: 5.35316514819, C: 104984.39630560997},
+		{A: 5e-11, B: 5.7539064007, C: 51841.950342379},
+		{A: 4e-11, B: 1.52206374281, C: 26267.9802718588},
+		{A: 4e-11, B: 4.66563868021, C: 104127.26777059489},
+		{A: 4e-11, B: 2.36671017385, C: 19.66976089979},
+		{A: 4e-11, B: 5.1095428608, C: 31722.9368709266},
+		{A: 4e-11, B: 1.54518951672, C: 52206.1331441528},
+		{A: 4e-11, B: 4.16791904155, C: 130285.73689738619},
+		{A: 4e-11, B: 2.93299901703, C: 31775.5335105262},
+		{A: 5e-11, B: 4.47247592301, C: 51112.49219969609},
+		{A: 4e-11, B: 2.41568769208, C: 51534.3927214094},
+		{A: 4e-11, B: 1.74441095464, C: 26248.310510959},
+		{A: 4e-11, B: 5.23856038893, C: 52396.2189255872},
+		{A: 4e-11, B: 4.12254586219, C: 27441.651886591},
+		{A: 5e-11, B: 5.17529407091, C: 19367.1891622328},
+		{A: 4e-11, B: 4.21064422396, C: 26279.35140768579},
+		{A: 5e-11, B: 4.41570881449, C: 78057.52387628538},
+		{A: 4e-11, B: 5.30327887066, C: 25672.35065096209},
+		{A: 5e-11, B: 1.10382058499, C: 31903.01400121119},
+		{A: 5e-11, B: 2.71619626622, C: 24952.226269681},
+		{A: 4e-11, B: 2.28793023586, C: 235900.50682626115},
+		{A: 4e-11, B: 1.58884724433, C: 128747.35003836859},
+		{A: 4e-11, B: 5.78862899204, C: 22779.4372461938},
+		{A: 4e-11, B: 4.22021430561, C: 1731.1223529326},
+		{A: 4e-11, B: 4.68318371027, C: 103814.80805420138},
+		{A: 4e-11, B: 3.29575966354, C: 76137.83201734339},
+		{A: 4e-11, B: 5.47487283809, C: 132350.71519108818},
+		{A: 4e-11, B: 2.99265295426, C: 77741.13200662879},
+		{A: 4e-11, B: 3.4788961061, C: 53661.0969898516},
+		{A: 4e-11, B: 4.76216346874, C: 156740.7179448832},
+		{A: 4e-11, B: 1.47799681769, C: 120417.67842777158},
+		{A: 4e-11, B: 2.78305398087, C: 133767.65136415116},
+		{A: 4e-11, B: 2.87953962922, C: 25862.073873164},
+		{A: 4e-11, B: 1.9297299024, C: 15406.65260500879},
+		{A: 5e-11, B: 1.8139493043, C: 130866.11389874699},
+		{A: 4e-11, B: 2.62752187758, C: 24602.61243487099},
+		{A: 4e-11, B: 3.12567603415, C: 20894.5360827764},
+		{A: 4e-11, B: 1.00432915295, C: 149288.74325784517},
+		{A: 4e-11, B: 3.47489986602, C: 26114.201461374},
+		{A: 4e-11, B: 4.27251739299, C: 104991.50985261079},
+		{A: 4e-11, B: 2.20022040184, C: 52073.84376488279},
+		{A: 4e-11, B: 3.77568584773, C: 14.2270940016},
+		{A: 4e-11, B: 1.65179392234, C: 51006.21803173979},
+		{A: 4e-11, B: 0.72729563393, C: 25865.04281858059},
+		{A: 4e-11, B: 5.61140287619, C: 53258.88651544199},
+		{A: 4e-11, B: 1.55115346866, C: 53438.96364572659},
+		{A: 5e-11, B: 0.91157457367, C: 25665.23710396129},
+		{A: 5e-11, B: 6.11073147567, C: 156507.7490885454},
+		{A: 3e-11, B: 3.12711312875, C: 80462.79676800959},
+		{A: 4e-11, B: 2.2106835847, C: 76777.72930365738},
+		{A: 4e-11, B: 0.82472101949, C: 30689.8532605372},
+		{A: 4e-11, B: 3.85651603357, C: 26513.8119180882},
+		{A: 3e-11, B: 3.76311835707, C: 77.7505439839},
+		{A: 4e-11, B: 0.76897521016, C: 23384.2869868986},
+		{A: 3e-11, B: 4.58555781046, C: 57503.2823915312},
+		{A: 4e-11, B: 3.82967402925, C: 104454.70534051539},
+		{A: 3e-11, B: 2.4744892731, C: 77956.15180375299},
+		{A: 4e-11, B: 4.93160467297, C: 391318.54712361295},
+		{A: 4e-11, B: 1.56118199279, C: 1055.4497769261},
+		{A: 4e-11, B: 0.77257538538, C: 79994.83177765518},
+		{A: 5e-11, B: 3.1167545854, C: 26149.62911631439},
+		{A: 3e-11, B: 4.05641745083, C: 104248.51979207818},
+		{A: 4e-11, B: 2.97133561512, C: 30110.1656735384},
+		{A: 4e-11, B: 5.6042082933, C: 156520.30530244438},
+		{A: 4e-11, B: 5.59378669038, C: 51315.49635439559},
+		{A: 3e-11, B: 5.33733887891, C: 52309.9153327334},
+		{A: 3e-11, B: 5.47155908699, C: 149846.1939960456},
+		{A: 3e-11, B: 0.19039417498, C: 50290.905119731},
+		{A: 3e-11, B: 0.55626990901, C: 130005.80396999417},
+		{A: 4e-11, B: 1.22491945176, C: 25927.49577218939},
+		{A: 4e-11, B: 1.83119228357, C: 132028.58860315479},
+		{A: 4e-11, B: 3.7891019345, C: 25970.58327335399},
+		{A: 4e-11, B: 4.12310730501, C: 33856.2696982688},
+		{A: 4e-11, B: 3.29497466474, C: 208276.62694171758},
+		{A: 3e-11, B: 1.23800163682, C: 193937.9860893238},
+		{A: 4e-11, B: 3.81093761011, C: 49424.25868345679},
+		{A: 4e-11, B: 6.17742034131, C: 76991.02839909539},
+		{A: 3e-11, B: 2.37855052298, C: 25459.05155552409},
+		{A: 3e-11, B: 0.06662548789, C: 54824.2611086214},
+		{A: 3e-11, B: 0.00882668027, C: 78571.26704569219},
+		{A: 4e-11, B: 3.50355655289, C: 33480.357543659},
+		{A: 4e-11, B: 1.42998120813, C: 23446.561863102},
+		{A: 4e-11, B: 4.57967744099, C: 52213.9393187862},
+		{A: 4e-11, B: 4.30793307856, C: 2538.2485042536},
+		{A: 4e-11, B: 2.64175466237, C: 80596.9058175946},
+		{A: 4e-11, B: 2.23067570586, C: 104819.57755665119},
+		{A: 4e-11, B: 2.1086768693, C: 27331.3907432526},
+		{A: 3e-11, B: 3.74321552731, C: 28471.0961563504},
+		{A: 3e-11, B: 5.35951703976, C: 233681.74972207437},
+		{A: 4e-11, B: 1.44726666359, C: 25907.8260112896},
+		{A: 3e-11, B: 0.77055249403, C: 23389.451694197},
+		{A: 4e-11, B: 5.53731910534, C: 52098.05573916449},
+		{A: 4e-11, B: 2.7106584473, C: 107679.74822257696},
+		{A: 3e-11, B: 4.85884222947, C: 1063.3140834523},
+		{A: 3e-11, B: 6.10322230645, C: 48091.817776444},
+		{A: 3e-11, B: 0.57378510632, C: 154194.22245657316},
+		{A: 3e-11, B: 4.65504051632, C: 25896.4548754626},
+		{A: 3e-11, B: 2.07685199898, C: 3.9321532631},
+		{A: 4e-11, B: 4.67601599622, C: 18073.7049386502},
+		{A: 3e-11, B: 4.62237776277, C: 21716.0267748},
+		{A: 3e-11, B: 2.40811934972, C: 76998.14194609619},
+		{A: 4e-11, B: 1.45941637429, C: 1073.6090241908},
+		{A: 3e-11, B: 4.0696522735, C: 32808.6171209156},
+		{A: 3e-11, B: 3.54974279869, C: 23401.181047033},
+		{A: 3e-11, B: 5.94935193305, C: 6751.0408403458},
+		{A: 3e-11, B: 4.32590129932, C: 52400.1510788503},
+		{A: 3e-11, B: 1.93788940079, C: 45455.092303807},
+		{A: 3e-11, B: 6.08303870194, C: 53399.624123927},
+		{A: 3e-11, B: 4.45999574391, C: 127098.90328117118},
+		{A: 3e-11, B: 5.4517602882, C: 26061.60482177439},
+		{A: 3e-11, B: 2.26429696537, C: 27047.0260015318},
+		{A: 4e-11, B: 1.93735592711, C: 26603.3670126672},
+		{A: 3e-11, B: 2.94612910423, C: 27039.97976483379},
+		{A: 4e-11, B: 3.64314833794, C: 860.3099287528},
+		{A: 4e-11, B: 2.04162116492, C: 27623.8798845436},
+		{A: 4e-11, B: 1.02413610685, C: 25991.92391435639},
+		{A: 4e-11, B: 3.72190941745, C: 51257.8759971666},
+		{A: 3e-11, B: 0.83533616968, C: 78262.22495201428},
+		{A: 4e-11, B: 5.84174581609, C: 25650.2592504343},
+		{A: 3e-11, B: 3.8669808813, C: 56777.7564021114},
+		{A: 4e-11, B: 0.94091162922, C: 23735.03698780239},
+		{A: 3e-11, B: 2.87479269049, C: 159855.55450572536},
+		{A: 4e-11, B: 2.92372103776, C: 104358.2411251968},
+		{A: 3e-11, B: 5.50683793531, C: 25928.601406782},
+		{A: 3e-11, B: 1.5028298198, C: 4371.8763667742},
+		{A: 3e-11, B: 2.10941795435, C: 60170.3347251726},
+		{A: 3e-11, B: 1.71064218785, C: 170068.84005193636},
+		{A: 3e-11, B: 1.97431548371, C: 104426.39416486409},
+		{A: 3e-11, B: 2.96711706073, C: 25984.1209529936},
+		{A: 3e-11, B: 1.86795145608, C: 126067.63934109679},
+		{A: 3e-11, B: 4.74630310296, C: 54879.422437824},
+		{A: 4e-11, B: 3.71365034796, C: 29580.4747084438},
+		{A: 3e-11, B: 4.20767161544, C: 128843.32926558638},
+		{A: 3e-11, B: 0.91166163253, C: 76468.45098100159},
+		{A: 3e-11, B: 6.20945940475, C: 50160.82461135059},
+		{A: 3e-11, B: 6.10137777265, C: 52164.76058288449},
+		{A: 3e-11, B: 0.31852065143, C: 28632.5757784666},
+		{A: 3e-11, B: 2.88816579373, C: 27360.58416720139},
+		{A: 3e-11, B: 5.29083364884, C: 2751.5475996916},
+		{A: 3e-11, B: 0.87901414893, C: 1699.2792165032},
+		{A: 3e-11, B: 0.1294237828, C: 203375.74902421076},
+		{A: 3e-11, B: 1.88640533644, C: 27353.47062020059},
+		{A: 3e-11, B: 3.77660990144, C: 24787.0763233692},
+		{A: 3e-11, B: 5.26339958526, C: 289185.69166150293},
+		{A: 3e-11, B: 1.34477523795, C: 1169.5882514086},
+		{A: 3e-11, B: 0.07928565452, C: 104241.40624507738},
+		{A: 3e-11, B: 4.47916732925, C: 38634.3850806572},
+		{A: 4e-11, B: 1.37420794262, C: 128857.55635958798},
+		{A: 3e-11, B: 2.08343776186, C: 50476.52706664519},
+		{A: 3e-11, B: 4.23656246406, C: 76358.24465978218},
+		{A: 3e-11, B: 5.54498269246, C: 61921.12683900499},
+		{A: 3e-11, B: 3.80268685478, C: 25384.26995695679},
+		{A: 3e-11, B: 5.22182880098, C: 3462.2447058652},
+		{A: 3e-11, B: 0.61479556097, C: 171292.65789271498},
+		{A: 3e-11, B: 3.12135348664, C: 162810.49469943656},
+		{A: 3e-11, B: 4.22351187737, C: 67608.75720795698},
+		{A: 3e-11, B: 5.30037747699, C: 51554.06248230919},
+		{A: 3e-11, B: 4.58024970278, C: 34102.10134449819},
+		{A: 3e-11, B: 2.07639441349, C: 24072.23205541439},
+		{A: 3e-11, B: 4.65503825059, C: 104874.18998439058},
+		{A: 3e-11, B: 4.53866780815, C: 28908.7342857004},
+		{A: 3e-11, B: 1.0019131214, C: 155460.9233722552},
+		{A: 3e-11, B: 2.05553254051, C: 176332.24614102798},
+		{A: 3e-11, B: 3.9487029151, C: 155475.15046625677},
+		{A: 3e-11, B: 1.43273740893, C: 24719.24288872919},
+		{A: 3e-11, B: 0.23823986548, C: 24510.5595991264},
+		{A: 3e-11, B: 5.21326346293, C: 1639.069517188},
+		{A: 3e-11, B: 2.53461972944, C: 51861.62010327879},
+		{A: 3e-11, B: 4.13978656372, C: 33917.99567300899},
+		{A: 3e-11, B: 4.34295741397, C: 16964.3263865568},
+		{A: 3e-11, B: 0.28015371448, C: 169093.57054942797},
+		{A: 3e-11, B: 2.49492450438, C: 636.9962720242},
+		{A: 3e-11, B: 4.20183911478, C: 149642.26895951436},
+		{A: 3e-11, B: 0.73100534966, C: 35679.44488694599},
+		{A: 3e-11, B: 1.1897797117, C: 143164.96914264598},
+		{A: 3e-11, B: 1.17691552034, C: 52145.47942214399},
+		{A: 3e-11, B: 4.94963823249, C: 49637.55777889479},
+		{A: 3e-11, B: 3.00732745961, C: 24705.01579472759},
+		{A: 3e-11, B: 5.76323456196, C: 49976.71893986139},
+		{A: 3e-11, B: 4.83245208446, C: 156523.5375140872},
+		{A: 2e-11, B: 3.69719041336, C: 24616.1501145106},
+		{A: 3e-11, B: 0.18439316147, C: 78597.56536549199},
+		{A: 3e-11, B: 4.98373933018, C: 102129.75593169978},
+		{A: 3e-11, B: 0.02228487734, C: 1.4844727083},
+		{A: 3e-11, B: 4.06250318984, C: 97466.46267636596},
+		{A: 3e-11, B: 2.65726234287, C: 13705.8571130806},
+		{A: 2e-11, B: 0.1288386868, C: 149756.7082481996},
+		{A: 3e-11, B: 2.82299563379, C: 53132.09543911899},
+		{A: 3e-11, B: 5.9906150951, C: 29864.334027309},
+		{A: 3e-11, B: 3.9301871107, C: 17098.43543614181},
+		{A: 2e-11, B: 4.22698039366, C: 10681.2505365654},
+		{A: 2e-11, B: 1.31889013785, C: 25572.43927048119},
+		{A: 2e-11, B: 3.49816744164, C: 51092.7260508548},
+		{A: 3e-11, B: 1.5236169166, C: 78265.19389743089},
+		{A: 3e-11, B: 0.52188463522, C: 52277.768801414},
+		{A: 3e-11, B: 3.80600862869, C: 25124.50043860279},
+		{A: 3e-11, B: 6.14366757538, C: 48153.54375118419},
+		{A: 2e-11, B: 0.58190501461, C: 27573.88326263939},
+		{A: 3e-11, B: 5.63901497713, C: 106470.37642667518},
+		{A: 2e-11, B: 3.36639066096, C: 42430.4857272918},
+		{A: 3e-11, B: 3.08628517069, C: 4083.9885067044},
+		{A: 2e-11, B: 6.13024035669, C: 104035.22069664019},
+		{A: 3e-11, B: 1.81280963372, C: 104401.60918820098},
+		{A: 3e-11, B: 3.34404708133, C: 130020.03106399579},
+		{A: 2e-11, B: 1.22333771717, C: 76094.94894244},
+		{A: 2e-11, B: 0.6354233409, C: 1485.2907067032},
+		{A: 3e-11, B: 5.24568241255, C: 6885.14988993081},
+		{A: 3e-11, B: 2.46305012048, C: 133882.09065283637},
+		{A: 2e-11, B: 0.84094631125, C: 163766.0944410452},
+		{A: 3e-11, B: 2.12660879166, C: 42790.9652750732},
+		{A: 2e-11, B: 2.98805690719, C: 26235.9818660005},
+		{A: 3e-11, B: 3.26197855217, C: 156531.3001848032},
+		{A: 2e-11, B: 4.50734435605, C: 25248.9338538238},
+		{A: 2e-11, B: 4.32859791317, C: 1685.0521225016},
+		{A: 2e-11, B: 5.62335584014, C: 28213.78054895339},
+		{A: 2e-11, B: 3.87492323552, C: 199599.31813847594},
+		{A: 2e-11, B: 2.60597304148, C: 26713.57333388659},
+		{A: 3e-11, B: 4.15733644316, C: 51688.1715318942},
+		{A: 3e-11, B: 4.33101389874, C: 220025.88923089797},
+		{A: 2e-11, B: 4.46567523081, C: 31127.49138988719},
+		{A: 2e-11, B: 3.66265576771, C: 26397.18146422999},
+		{A: 2e-11, B: 4.68312774052, C: 49850.85687433279},
+		{A: 2e-11, B: 4.51215350981, C: 52812.8025551726},
+		{A: 3e-11, B: 3.56621882535, C: 154408.65498906677},
+		{A: 2e-11, B: 3.95708432187, C: 1574.8458012822},
+		{A: 2e-11, B: 2.41940194318, C: 104668.00443595338},
+		{A: 3e-11, B: 0.24921211552, C: 25778.62481891839},
+		{A: 2e-11, B: 3.18370791629, C: 207114.15223730978},
+		{A: 2e-11, B: 4.17768592199, C: 51734.98099827079},
+		{A: 2e-11, B: 1.74283332135, C: 76532.58707178998},
+		{A: 2e-11, B: 1.36339547692, C: 6044.2285813754},
+		{A: 2e-11, B: 2.82219230315, C: 63786.3582415226},
+		{A: 2e-11, B: 5.27165023098, C: 75085.56363415498},
+		{A: 2e-11, B: 5.7673572032, C: 25661.9943650602},
+		{A: 2e-11, B: 5.2466618317, C: 24734.1543965574},
+		{A: 2e-11, B: 5.31625926462, C: 24998.19435038059},
+		{A: 2e-11, B: 0.17868115875, C: 177287.84588263658},
+		{A: 3e-11, B: 5.47127542511, C: 27388.72995977919},
+		{A: 2e-11, B: 2.48055036253, C: 25543.985082478},
+		{A: 2e-11, B: 0.16253031708, C: 23113.2931869632},
+		{A: 3e-11, B: 1.68453793975, C: 2703.6161546756},
+		{A: 2e-11, B: 1.17069889009, C: 53551.5800829942},
+		{A: 3e-11, B: 3.06893058902, C: 230239.17477710897},
+		{A: 3e-11, B: 5.00145362139, C: 180496.558130641},
+		{A: 2e-11, B: 1.90098213386, C: 20272.7922819372},
+		{A: 3e-11, B: 5.06877735444, C: 440.8252848776},
+		{A: 2e-11, B: 1.33626564472, C: 196156.74319351057},
+		{A: 2e-11, B: 4.50940380454, C: 65538.25598994759},
+		{A: 2e-11, B: 4.55891488212, C: 2324.9494088156},
+		{A: 2e-11, B: 0.21025125051, C: 18227.483749135},
+		{A: 2e-11, B: 0.58359043648, C: 156377.8556523106},
+		{A: 2e-11, B: 4.57189351312, C: 26926.87242932459},
+		{A: 2e-11, B: 3.79747475767, C: 65851.33653522459},
+		{A: 2e-11, B: 0.301390155, C: 50951.98844236979},
+		{A: 2e-11, B: 3.94570007959, C: 225687.22128005017},
+		{A: 2e-11, B: 0.99415397716, C: 2064.978293702},
+		{A: 2e-11, B: 3.49827019018, C: 78905.12298646157},
+		{A: 2e-11, B: 4.16512398869, C: 24864.08530079559},
+		{A: 2e-11, B: 0.47605198546, C: 107692.22499299659},
+		{A: 2e-11, B: 0.02213914405, C: 197092.67317421938},
+		{A: 2e-11, B: 0.35795159068, C: 104301.61594439259},
+		{A: 2e-11, B: 2.54426644231, C: 249268.47945736776},
+		{A: 2e-11, B: 0.35872697789, C: 25462.2329492618},
+		{A: 2e-11, B: 0.03956368481, C: 24189.5519236346},
+		{A: 2e-11, B: 2.0068296723, C: 28786.3545889514},
+		{A: 2e-11, B: 1.64612572499, C: 40738.70289615879},
+		{A: 2e-11, B: 1.77509184801, C: 22595.33157470459},
+		{A: 2e-11, B: 1.93881159123, C: 78697.42116259939},
+		{A: 2e-11, B: 1.06567957268, C: 21562.2479643152},
+		{A: 3e-11, B: 4.58782698256, C: 156954.01704032117},
+		{A: 2e-11, B: 0.84740706727, C: 24494.89809302729},
+		{A: 2e-11, B: 5.98697403459, C: 283524.3596123507},
+		{A: 2e-11, B: 4.02135788869, C: 26238.95081141709},
+		{A: 2e-11, B: 3.98649649262, C: 24808.1085689462},
+		{A: 2e-11, B: 3.06252327958, C: 57369.17334194619},
+		{A: 2e-11, B: 6.10258403438, C: 78115.63070029629},
+		{A: 2e-11, B: 0.19431714321, C: 55484.27217852879},
+		{A: 2e-11, B: 4.26819419359, C: 90989.16285949759},
+		{A: 2e-11, B: 2.9494161028, C: 22065.64060961},
+		{A: 2e-11, B: 4.53722544295, C: 52355.883413433},
+		{A: 2e-11, B: 1.97399498955, C: 430.5303441391},
+		{A: 2e-11, B: 1.99872081555, C: 24932.54198416719},
+		{A: 2e-11, B: 0.37032643194, C: 26500.27423844859},
+		{A: 2e-11, B: 3.83480132336, C: 307.5576209696},
+		{A: 2e-11, B: 5.81356382744, C: 51538.81001112419},
+		{A: 2e-11, B: 6.20773606719, C: 49.9966219042},
+		{A: 2e-11, B: 2.40589345998, C: 77929.8534839532},
+		{A: 2e-11, B: 4.51719783127, C: 21067.98465416099},
+		{A: 2e-11, B: 2.06311358499, C: 23233.262767664},
+		{A: 2e-11, B: 0.29430089395, C: 24765.2254940428},
+		{A: 2e-11, B: 2.21513587213, C: 25675.5320446998},
+		{A: 2e-11, B: 2.29291278322, C: 27410.5807891056},
+		{A: 2e-11, B: 2.39356701559, C: 242985.40360737636},
+		{A: 2e-11, B: 4.28957020884, C: 71378.55953479178},
+		{A: 2e-11, B: 5.41199778568, C: 23704.71012679799},
+		{A: 2e-11, B: 0.54252133289, C: 3.881335358},
+		{A: 2e-11, B: 5.88236623932, C: 52072.0240945678},
+		{A: 2e-11, B: 5.78749542412, C: 78373.91574594198},
+		{A: 2e-11, B: 5.69226787602, C: 51040.1294112552},
+		{A: 2e-11, B: 4.42185841709, C: 93696.66034953119},
+		{A: 2e-11, B: 5.15491529148, C: 78112.66175487968},
+		{A: 2e-11, B: 2.30493903824, C: 27883.16158529519},
+		{A: 2e-11, B: 5.53948896377, C: 87253.17713015496},
+		{A: 2e-11, B: 4.75957265478, C: 52336.2136525332},
+		{A: 2e-11, B: 6.08199781417, C: 18043.37807764579},
+		{A: 2e-11, B: 0.73324052336, C: 25757.28417791599},
+		{A: 2e-11, B: 0.91928644358, C: 52186.8519834123},
+		{A: 2e-11, B: 3.22142261212, C: 104771.09721017198},
+		{A: 2e-11, B: 2.016713014, C: 46564.4708559004},
+		{A: 2e-11, B: 4.39545799671, C: 41520.8540663828},
+		{A: 2e-11, B: 2.52858429504, C: 26140.99929388859},
+		{A: 2e-11, B: 2.07561943546, C: 53654.67285721279},
+		{A: 2e-11, B: 0.08977124652, C: 25135.8265183146},
+		{A: 2e-11, B: 1.67350036401, C: 66826.60603773296},
+		{A: 2e-11, B: 4.57268426145, C: 158116.491744729},
+		{A: 2e-11, B: 2.84194927894, C: 78249.48233072099},
+		{A: 2e-11, B: 3.07002924674, C: 23432.33476910039},
+		{A: 2e-11, B: 4.11559657607, C: 123554.36581794015},
+		{A: 2e-11, B: 0.05687800902, C: 26034.8069892598},
+		{A: 2e-11, B: 4.28354458896, C: 24484.60315228879},
+		{A: 2e-11, B: 3.24222429199, C: 21509.65132471559},
+		{A: 2e-11, B: 2.52315832958, C: 210614.42461581077},
+		{A: 2e-11, B: 4.44247371827, C: 148532.89040742096},
+		{A: 2e-11, B: 0.11737800376, C: 146505.58156934578},
+		{A: 2e-11, B: 2.4137136239, C: 54862.5283776896},
+		{A: 2e-11, B: 4.66022680664, C: 247223.17092456558},
+		{A: 2e-11, B: 3.79260774499, C: 3308.4658953804},
+		{A: 2e-11, B: 5.83454304626, C: 2974.609954611},
+		{A: 2e-11, B: 5.3787850016, C: 77622.29586298359},
+		{A: 2e-11, B: 1.63153524958, C: 99979.7361995226},
+		{A: 2e-11, B: 5.18613735503, C: 50600.9604818662},
+		{A: 2e-11, B: 2.55420547959, C: 76064.62208143558},
+		{A: 2e-11, B: 4.05996888958, C: 24502.76239955349},
+		{A: 2e-11, B: 2.47809526116, C: 179966.86716554637},
+		{A: 2e-11, B: 1.25528265056, C: 50912.6489205702},
+		{A: 2e-11, B: 0.75306774592, C: 235746.72801577637},
+		{A: 2e-11, B: 2.97464643769, C: 31219.5442256318},
+		{A: 1e-11, B: 0.17722772219, C: 158438.6183326624},
+		{A: 2e-11, B: 4.94589808424, C: 168959.46149984296},
+		{A: 2e-11, B: 0.63110575282, C: 415.5524906121},
+		{A: 1e-11, B: 0.77799418557, C: 299398.97720771394},
+		{A: 2e-11, B: 5.97867896248, C: 164721.69418265377},
+		{A: 2e-11, B: 5.62955025847, C: 21819.80896338059},
+		{A: 1e-11, B: 4.93638931294, C: 27993.3679065146},
+		{A: 2e-11, B: 6.22578827437, C: 74935.5737684424},
+		{A: 2e-11, B: 1.03965665113, C: 1802.3719907218},
+		{A: 1e-11, B: 5.61994219678, C: 50690.51557644519},
+		{A: 2e-11, B: 5.02281046549, C: 422.6660376129},
+		{A: 1e-11, B: 3.57619837497, C: 209658.82487420217},
+		{A: 2e-11, B: 0.1124953909, C: 38469.94917006779},
+		{A: 2e-11, B: 0.62470424533, C: 51745.27593900929},
+		{A: 1e-11, B: 0.71806518278, C: 28928.40404660019},
+		{A: 2e-11, B: 3.91669753023, C: 76352.50994150538},
+		{A: 2e-11, B: 3.05277164813, C: 225.8292684102},
+		{A: 1e-11, B: 4.33602746737, C: 4601.950118963},
+	},
+	// R1, 711 terms
+	{
+		{A: 0.00217347739, B: 4.65617158663, C: 26087.9031415742},
+		{A: 0.00044141826, B: 1.42385543975, C: 52175.8062831484},
+		{A: 0.00010094479, B: 4.47466326316, C: 78263.70942472259},
+		{A: 2.432804e-05, B: 1.24226083435, C: 104351.61256629678},
+		{A: 1.624367e-05, B: 0, C: 0},
+		{A: 6.03996e-06, B: 4.29303116561, C: 130439.51570787099},
+		{A: 1.52851e-06, B: 1.0606077981, C: 156527.41884944518},
+		{A: 3.9202e-07, B: 4.11136751416, C: 182615.3219910194},
+		{A: 1.776e-07, B: 4.54424653085, C: 27197.2816936676},
+		{A: 1.7999e-07, B: 4.7119372581, C: 24978.5245894808},
+		{A: 1.0154e-07, B: 0.87893548494, C: 208703.2251325936},
+		{A: 8.086e-08, B: 3.0054085489, C: 25028.521211385},
+		{A: 4.444e-08, B: 2.13639058123, C: 20426.571092422},
+		{A: 4.393e-08, B: 1.48074475165, C: 51066.427731055},
+		{A: 3.51e-08, B: 3.21171223697, C: 1059.3819301892},
+		{A: 3.133e-08, B: 5.23846336855, C: 21535.9496445154},
+		{A: 2.65e-08, B: 3.92968881423, C: 234791.12827416777},
+		{A: 2.498e-08, B: 2.02623950395, C: 24498.8302462904},
+		{A: 2.011e-08, B: 1.23911360588, C: 53285.1848352418},
+		{A: 1.963e-08, B: 4.04524902962, C: 5661.3320491522},
+		{A: 1.546e-08, B: 2.61849590442, C: 26617.5941066688},
+		{A: 1.542e-08, B: 2.35659537465, C: 27043.5028831828},
+		{A: 1.417e-08, B: 1.37876323533, C: 1109.3785520934},
+		{A: 1.295e-08, B: 5.19094850935, C: 46514.4742339962},
+		{A: 1.241e-08, B: 0.21246226135, C: 11322.6640983044},
+		{A: 1.09e-08, B: 6.23733263925, C: 27147.28507176339},
+		{A: 1.271e-08, B: 0.56437938715, C: 25132.3033999656},
+		{A: 1.12e-08, B: 0.86374628388, C: 57837.1383323006},
+		{A: 1.005e-08, B: 3.28272284427, C: 37410.5672398786},
+		{A: 1.177e-08, B: 4.53194022227, C: 77154.33087262919},
+		{A: 8.41e-09, B: 5.96261035419, C: 14765.2390432698},
+		{A: 9.35e-09, B: 2.37277497611, C: 25661.3049506982},
+		{A: 7.42e-09, B: 0.77751594919, C: 32858.61374281979},
+		{A: 7.36e-09, B: 4.0688529657, C: 1589.0728952838},
+		{A: 7.12e-09, B: 2.70884051645, C: 16983.9961474566},
+		{A: 8.63e-09, B: 5.55308682281, C: 25558.2121764796},
+		{A: 7.07e-09, B: 0.92992556568, C: 30639.856638633},
+		{A: 6.96e-09, B: 0.69734796212, C: 260879.03141574195},
+		{A: 6.78e-09, B: 2.0219388593, C: 26068.2333806744},
+		{A: 6.08e-09, B: 0.85708468483, C: 4551.9534970588},
+		{A: 5.46e-09, B: 5.38138962861, C: 26091.7844769322},
+		{A: 6.08e-09, B: 3.65993973133, C: 25448.00585526019},
+		{A: 5.97e-09, B: 4.64867954048, C: 26080.78959457339},
+		{A: 5.72e-09, B: 3.50125649521, C: 10213.285546211},
+		{A: 5.15e-09, B: 4.83662922562, C: 50586.73338786459},
+		{A: 5.32e-09, B: 5.21855381213, C: 22645.32819660879},
+		{A: 5.72e-09, B: 0.62751016416, C: 13521.7514415914},
+		{A: 5.16e-09, B: 3.89421000089, C: 83925.0414738748},
+		{A: 5.07e-09, B: 5.55466020276, C: 12566.1516999828},
+		{A: 6.06e-09, B: 4.50215566301, C: 28306.66024576099},
+		{A: 5.33e-09, B: 0.93587795381, C: 26107.57290247399},
+		{A: 4.35e-09, B: 5.79043985706, C: 43071.8992890308},
+		{A: 4.36e-09, B: 5.86659160961, C: 25035.6347583858},
+		{A: 4.29e-09, B: 0.26260280461, C: 36301.18868778519},
+		{A: 4.72e-09, B: 6.00507778421, C: 77204.32749453338},
+		{A: 4.43e-09, B: 3.86758570847, C: 26084.0218062162},
+		{A: 4.87e-09, B: 4.23950195756, C: 26095.016688575},
+		{A: 4.06e-09, B: 5.60324652352, C: 52705.49724824299},
+		{A: 5.23e-09, B: 0.06439941262, C: 25021.4076643842},
+		{A: 3.79e-09, B: 1.95647626585, C: 72602.37737557039},
+		{A: 3.9e-09, B: 3.81996185881, C: 426.598190876},
+		{A: 4.18e-09, B: 2.7752141866, C: 41962.5207369374},
+		{A: 4.53e-09, B: 0.7280313725, C: 529.6909650946},
+		{A: 3.58e-09, B: 6.00165335616, C: 33326.5787331742},
+		{A: 3.47e-09, B: 1.39524259509, C: 23969.1392811958},
+		{A: 3.4e-09, B: 0.24111380317, C: 26301.2022370122},
+		{A: 3.25e-09, B: 4.78747749132, C: 23869.1460373874},
+		{A: 2.9e-09, B: 3.04044859601, C: 53235.18821333759},
+		{A: 3.26e-09, B: 1.30033833957, C: 103242.2340142034},
+		{A: 3.16e-09, B: 2.22791434675, C: 19317.1925403286},
+		{A: 2.64e-09, B: 3.93160763696, C: 6770.7106012456},
+		{A: 3.32e-09, B: 3.59235758173, C: 51220.20654153979},
+		{A: 2.23e-09, B: 2.76528831516, C: 103292.23063610759},
+		{A: 2.15e-09, B: 3.97996009773, C: 56727.7597802072},
+		{A: 2.35e-09, B: 2.2405050326, C: 25874.6040461362},
+		{A: 2.15e-09, B: 6.16660008496, C: 51646.11531805379},
+		{A: 2.08e-09, B: 2.74551899929, C: 40853.142184844},
+		{A: 2.04e-09, B: 3.44654782597, C: 9103.9069941176},
+		{A: 1.97e-09, B: 0.80026289238, C: 27676.976036858},
+		{A: 2.05e-09, B: 3.20844012088, C: 18849.2275499742},
+		{A: 1.96e-09, B: 1.99924288764, C: 48733.23133818299},
+		{A: 2.18e-09, B: 2.11865566044, C: 73711.75592766379},
+		{A: 1.89e-09, B: 0.65681038131, C: 110012.94461544899},
+		{A: 2.07e-09, B: 5.89082708705, C: 31749.2351907264},
+		{A: 1.84e-09, B: 3.74837387583, C: 286966.9345573162},
+		{A: 1.74e-09, B: 5.81264188702, C: 68050.42387851159},
+		{A: 1.63e-09, B: 4.00380715262, C: 79373.087976816},
+		{A: 1.52e-09, B: 5.07389967152, C: 52156.1365222486},
+		{A: 1.51e-09, B: 2.83369443311, C: 955.5997416086},
+		{A: 1.51e-09, B: 0.4095365021, C: 26514.5013324502},
+		{A: 1.47e-09, B: 2.32191248197, C: 38654.05484155699},
+		{A: 1.54e-09, B: 3.26437373895, C: 38519.945791972},
+		{A: 1.85e-09, B: 0.98966099528, C: 29530.4780865396},
+		{A: 1.5e-09, B: 3.69230644689, C: 65697.55772473979},
+		{A: 1.32e-09, B: 2.71837382155, C: 77734.01845962799},
+		{A: 1.4e-09, B: 2.05736044462, C: 6283.0758499914},
+		{A: 1.22e-09, B: 3.31361750281, C: 62389.09182935939},
+		{A: 1.23e-09, B: 2.07840148125, C: 25668.418497699},
+		{A: 1.18e-09, B: 0.51913709271, C: 51535.90899683439},
+		{A: 1.21e-09, B: 2.15433834534, C: 52179.6876185064},
+		{A: 1.51e-09, B: 2.66028539824, C: 25654.19140369739},
+		{A: 1.13e-09, B: 2.33243126122, C: 78793.40038981718},
+		{A: 1.24e-09, B: 3.13058661995, C: 51109.31080595839},
+		{A: 1.5e-09, B: 2.60376479044, C: 639.897286314},
+		{A: 1.23e-09, B: 2.65137961558, C: 51123.53789995999},
+		{A: 1.06e-09, B: 1.31393069594, C: 47623.8527860896},
+		{A: 1.12e-09, B: 5.00798069297, C: 98690.28051714458},
+		{A: 1.07e-09, B: 5.12738291017, C: 99799.65906923798},
+		{A: 1.23e-09, B: 6.13795219131, C: 1066.49547719},
+		{A: 1.06e-09, B: 1.42997322881, C: 52168.69273614759},
+		{A: 1.04e-09, B: 4.51477063494, C: 25234.70675982219},
+		{A: 1.2e-09, B: 4.0656730086, C: 45892.73043315699},
+		{A: 9.2e-10, B: 4.72811907093, C: 2118.7638603784},
+		{A: 1.12e-09, B: 4.78983902693, C: 53131.406024757},
+		{A: 9.6e-10, B: 5.03438502363, C: 32370.9789915656},
+		{A: 9.1e-10, B: 4.22099920434, C: 50057.04242277},
+		{A: 9.1e-10, B: 4.35481864546, C: 129330.13715577759},
+		{A: 1.06e-09, B: 0.14583281261, C: 12432.0426503978},
+		{A: 8.4e-10, B: 0.32848825294, C: 1052.2683831884},
+		{A: 8.4e-10, B: 5.81442139422, C: 129380.13377768178},
+		{A: 9.3e-10, B: 0.34539006564, C: 77308.10968311399},
+		{A: 7.8e-10, B: 1.17633266287, C: 76674.63652943878},
+		{A: 1.07e-09, B: 1.01316358023, C: 52182.9198301492},
+		{A: 8.2e-10, B: 6.11445014523, C: 79323.09135491178},
+		{A: 8.5e-10, B: 2.50332044483, C: 7238.6755916},
+		{A: 7.2e-10, B: 1.561708325, C: 49957.0491789616},
+		{A: 7.6e-10, B: 1.62577226881, C: 15874.6175953632},
+		{A: 9.1e-10, B: 5.75408440781, C: 24491.71669928959},
+		{A: 9.2e-10, B: 3.97747935513, C: 52195.47604404819},
+		{A: 6.8e-10, B: 0.44378826429, C: 91785.46086631398},
+		{A: 7.8e-10, B: 0.63585741399, C: 52171.9249477904},
+		{A: 6.4e-10, B: 2.57311321703, C: 94138.32702008578},
+		{A: 6.5e-10, B: 2.12942233842, C: 7.1135470008},
+		{A: 6.2e-10, B: 3.70751240431, C: 136100.84775702318},
+		{A: 6.8e-10, B: 1.18946361248, C: 54394.56338733519},
+		{A: 7.9e-10, B: 3.35596277474, C: 52389.1053785864},
+		{A: 6.4e-10, B: 5.09677055426, C: 51962.5071877104},
+		{A: 6e-10, B: 6.25938912295, C: 24505.94379329119},
+		{A: 6.1e-10, B: 0.73867692725, C: 82815.66292178139},
+		{A: 6.3e-10, B: 1.29883580487, C: 2218.7571041868},
+		{A: 7e-10, B: 5.28692696943, C: 45405.0956819028},
+		{A: 5.7e-10, B: 1.92365363632, C: 20760.4270331914},
+		{A: 5.4e-10, B: 0.89448628916, C: 71980.63357473118},
+		{A: 5.1e-10, B: 4.71710385051, C: 105460.99111839019},
+		{A: 5.4e-10, B: 6.22009952451, C: 44937.1306915484},
+		{A: 5.2e-10, B: 3.43365769233, C: 25551.09862947879},
+		{A: 5.2e-10, B: 5.77930503293, C: 66941.04532641819},
+		{A: 4.8e-10, B: 0.51937745535, C: 313054.83769889036},
+		{A: 5.2e-10, B: 5.71476972233, C: 103821.92160120218},
+		{A: 4.7e-10, B: 3.82203240163, C: 53764.8791784322},
+		{A: 5e-10, B: 5.76150579489, C: 25938.3399444396},
+		{A: 4.7e-10, B: 5.52705163618, C: 77837.11123384659},
+		{A: 4.3e-10, B: 5.84994474092, C: 63498.47038145279},
+		{A: 5.2e-10, B: 2.63638299982, C: 18093.37469954999},
+		{A: 4.2e-10, B: 5.39178421925, C: 64741.95798313119},
+		{A: 4.1e-10, B: 1.87113963554, C: 125887.56221081219},
+		{A: 4.5e-10, B: 4.04603530632, C: 55618.3812281138},
+		{A: 3.9e-10, B: 3.50934954924, C: 52602.4044740244},
+		{A: 4.8e-10, B: 0.72400634326, C: 33967.99229491319},
+		{A: 5.1e-10, B: 6.02427997984, C: 13655.8604911764},
+		{A: 3.8e-10, B: 0.09359107033, C: 88476.99497093359},
+		{A: 3.8e-10, B: 1.84297739511, C: 78244.0396638228},
+		{A: 4.1e-10, B: 5.74927951755, C: 26727.8004278882},
+		{A: 4.5e-10, B: 5.73633413733, C: 44181.27784112419},
+		{A: 3.5e-10, B: 0.81793649503, C: 23439.44831610119},
+		{A: 3.8e-10, B: 5.17914668407, C: 23754.70674870219},
+		{A: 3.6e-10, B: 4.81782090538, C: 51116.4243529592},
+		{A: 4.1e-10, B: 2.91943000674, C: 26241.681952059},
+		{A: 3.2e-10, B: 1.91760062435, C: 58458.88213313979},
+		{A: 3.3e-10, B: 1.27507180487, C: 131548.89425996438},
+		{A: 3.2e-10, B: 5.01820692941, C: 46848.3301747656},
+		{A: 3e-10, B: 3.95444605179, C: 26555.8681319286},
+		{A: 3.1e-10, B: 1.17911028239, C: 59414.4818747484},
+		{A: 3e-10, B: 0.55604674983, C: 3442.5749449654},
+		{A: 3.4e-10, B: 3.55745328485, C: 433.7117378768},
+		{A: 3.3e-10, B: 5.36229697065, C: 104881.30353139139},
+		{A: 3e-10, B: 1.24209931344, C: 26202.34243025941},
+		{A: 3.3e-10, B: 3.55673853222, C: 25440.89230825939},
+		{A: 2.9e-10, B: 2.9884790115, C: 27154.3986187642},
+		{A: 3.5e-10, B: 3.23888704617, C: 25455.119402261},
+		{A: 3.3e-10, B: 1.77322555881, C: 124778.18365871879},
+		{A: 2.8e-10, B: 4.35951220773, C: 80482.46652890938},
+		{A: 3e-10, B: 5.14555074991, C: 51756.3216392732},
+		{A: 2.9e-10, B: 0.03524298861, C: 79219.3091663312},
+		{A: 2.7e-10, B: 2.59555966553, C: 155468.036919256},
+		{A: 2.7e-10, B: 1.05480537311, C: 28421.0995344462},
+		{A: 3e-10, B: 5.7208773684, C: 51742.09454527159},
+		{A: 2.9e-10, B: 5.20529460359, C: 78267.59076008058},
+		{A: 2.6e-10, B: 1.11551342052, C: 155418.04029735178},
+		{A: 3e-10, B: 6.10884155937, C: 26011.6370702986},
+		{A: 2.7e-10, B: 3.38666053174, C: 103396.01282468818},
+		{A: 2.5e-10, B: 3.48592449926, C: 117873.36400788819},
+		{A: 2.5e-10, B: 1.69043243445, C: 25973.46385288896},
+		{A: 2.6e-10, B: 6.195779404, C: 77197.21394753258},
+		{A: 2.4e-10, B: 1.47972864008, C: 28206.6670019526},
+		{A: 2.3e-10, B: 3.86767325148, C: 16066.0658614748},
+		{A: 2.7e-10, B: 4.06858086478, C: 78270.82297172339},
+		{A: 2.2e-10, B: 4.03528313026, C: 419.4846438752},
+		{A: 2.3e-10, B: 3.3842610754, C: 58946.51688439399},
+		{A: 2.2e-10, B: 1.33669336918, C: 51322.60990139639},
+		{A: 2.3e-10, B: 2.23954141042, C: 103925.01437542078},
+		{A: 2.4e-10, B: 3.65673385174, C: 26610.48055966799},
+		{A: 2.1e-10, B: 4.49565014938, C: 78256.59587772179},
+		{A: 2.1e-10, B: 3.69266624882, C: 77623.81213840858},
+		{A: 2.8e-10, B: 5.71652736884, C: 77211.44104153418},
+		{A: 2.1e-10, B: 3.97119771059, C: 98068.53671630539},
+		{A: 2.1e-10, B: 5.61140929496, C: 120226.23016165999},
+		{A: 2.5e-10, B: 1.73460075205, C: 853.196381752},
+		{A: 2.4e-10, B: 2.89897553229, C: 105410.99449648599},
+		{A: 2e-10, B: 6.15988447425, C: 213.299095438},
+		{A: 2.1e-10, B: 0.4212676491, C: 149.5631971346},
+		{A: 2e-10, B: 4.41588880947, C: 29416.03879785439},
+		{A: 2.4e-10, B: 2.43220296378, C: 50579.61984086379},
+		{A: 2e-10, B: 0.47739908961, C: 162188.75089859738},
+		{A: 2.1e-10, B: 0.18110422167, C: 35191.8101356918},
+		{A: 1.9e-10, B: 2.3875625116, C: 26037.90651966999},
+		{A: 2e-10, B: 0.61997291259, C: 85034.42002596818},
+		{A: 1.8e-10, B: 4.60331561584, C: 76044.9523205358},
+		{A: 1.8e-10, B: 3.06180224259, C: 50593.84693486539},
+		{A: 2.2e-10, B: 0.45787269756, C: 1596.1864422846},
+		{A: 1.9e-10, B: 1.76795970776, C: 78050.41032928458},
+		{A: 1.9e-10, B: 3.58333941723, C: 27140.17152476259},
+		{A: 2.1e-10, B: 0.15585183693, C: 78477.00852016058},
+		{A: 1.8e-10, B: 1.81330190957, C: 25565.3257234804},
+		{A: 2.1e-10, B: 3.45385724015, C: 102762.53967101299},
+		{A: 2.2e-10, B: 4.62891777236, C: 19406.6782881746},
+		{A: 1.9e-10, B: 5.28998871939, C: 26624.70765366959},
+		{A: 1.6e-10, B: 4.3790892436, C: 25131.61398560359},
+		{A: 1.9e-10, B: 2.69598918032, C: 105307.21230790539},
+		{A: 1.8e-10, B: 4.13274028026, C: 5327.4761083828},
+		{A: 1.8e-10, B: 1.10819559505, C: 76144.94556434419},
+		{A: 1.8e-10, B: 2.04678515653, C: 71492.99882347698},
+		{A: 1.5e-10, B: 0.52406345582, C: 42153.969003049},
+		{A: 1.8e-10, B: 0.71897799959, C: 78283.37918562238},
+		{A: 2e-10, B: 3.8710563228, C: 51749.20809227239},
+		{A: 1.4e-10, B: 0.06732488, C: 25984.8103673556},
+		{A: 1.9e-10, B: 2.51337817026, C: 129909.82474277639},
+		{A: 1.8e-10, B: 3.79705759661, C: 108903.56606335558},
+		{A: 1.4e-10, B: 2.30467577173, C: 18207.81398823521},
+		{A: 1.4e-10, B: 4.91192145857, C: 151975.46535238638},
+		{A: 1.4e-10, B: 4.25365079026, C: 157636.79740153858},
+		{A: 1.4e-10, B: 3.82494343251, C: 7880.08915333899},
+		{A: 1.4e-10, B: 0.47442483304, C: 26137.8997634784},
+		{A: 1.5e-10, B: 1.41820144369, C: 40565.2543247742},
+		{A: 1.6e-10, B: 1.92985195149, C: 49842.60989027639},
+		{A: 1.6e-10, B: 2.97241905198, C: 71025.0338331226},
+		{A: 1.3e-10, B: 0.07086712207, C: 76.2660712756},
+		{A: 1.3e-10, B: 2.19019855275, C: 90829.86112470538},
+		{A: 1.4e-10, B: 1.68395491426, C: 6681.2248533996},
+		{A: 1.2e-10, B: 2.16242752229, C: 29428.515568274},
+		{A: 1.3e-10, B: 0.55373605944, C: 79852.78232000639},
+		{A: 1.2e-10, B: 2.10236188465, C: 24609.0365675098},
+		{A: 1.2e-10, B: 3.4937235482, C: 64607.84893354619},
+		{A: 1.3e-10, B: 3.16312334997, C: 25881.717593137},
+		{A: 1.2e-10, B: 0.32301447482, C: 78690.30761559859},
+		{A: 1.6e-10, B: 3.67591976897, C: 78259.82808936459},
+		{A: 1.2e-10, B: 0.81060544329, C: 81706.28436968799},
+		{A: 1.5e-10, B: 2.57802360273, C: 93028.94846799239},
+		{A: 1.3e-10, B: 3.55704873434, C: 339142.7408404646},
+		{A: 1.2e-10, B: 3.14169564714, C: 114564.89811250778},
+		{A: 1.1e-10, B: 4.96251639039, C: 84546.78527471398},
+		{A: 1.1e-10, B: 2.93160493628, C: 536.8045120954},
+		{A: 1.1e-10, B: 0.04474275203, C: 19804.8272915828},
+		{A: 1.1e-10, B: 2.39391294657, C: 10021.8372800994},
+		{A: 1.1e-10, B: 3.22530161123, C: 90695.75207512038},
+		{A: 1.2e-10, B: 0.71785378975, C: 26294.08869001139},
+		{A: 1.1e-10, B: 3.51130712506, C: 7994.5284420242},
+		{A: 1.1e-10, B: 1.78813511249, C: 69159.80243060499},
+		{A: 1e-10, B: 1.89377082198, C: 24925.4284371664},
+		{A: 1.2e-10, B: 6.06775243662, C: 52329.58509363319},
+		{A: 1.2e-10, B: 0.11656433296, C: 51543.0225438352},
+		{A: 1.2e-10, B: 0.01462307671, C: 1581.959348283},
+		{A: 1e-10, B: 3.60476399042, C: 49527.35145767539},
+		{A: 9e-11, B: 5.35498571113, C: 2648.454825473},
+		{A: 1e-10, B: 2.54352513591, C: 52815.7035694624},
+		{A: 9e-11, B: 5.25498849885, C: 130012.91751699499},
+		{A: 1e-10, B: 5.63770719377, C: 181555.94006083018},
+		{A: 1.1e-10, B: 1.65385601347, C: 26507.38778544939},
+		{A: 1.1e-10, B: 4.93008131424, C: 104331.94280539699},
+		{A: 1e-10, B: 0.35445064092, C: 26521.614879451},
+		{A: 1e-10, B: 2.79303258728, C: 39743.7636327506},
+		{A: 9e-11, B: 0.71144667758, C: 25764.39772491679},
+		{A: 8e-11, B: 4.22951187793, C: 181505.94343892598},
+		{A: 8e-11, B: 0.1751430319, C: 129483.91596626239},
+		{A: 1e-10, B: 1.16786157884, C: 25934.1243310894},
+		{A: 1e-10, B: 2.50335300453, C: 103299.34418310839},
+		{A: 9e-11, B: 3.18692590406, C: 39629.32434406539},
+		{A: 1e-10, B: 3.98242351264, C: 522.5774180938},
+		{A: 1.1e-10, B: 0.23158217376, C: 51639.00177105299},
+		{A: 9e-11, B: 0.30008658313, C: 27999.1026247914},
+		{A: 1.1e-10, B: 4.84801040284, C: 150866.08680029298},
+		{A: 1.1e-10, B: 5.94731028211, C: 38813.3565763492},
+		{A: 8e-11, B: 4.66199260294, C: 26094.53170047421},
+		{A: 8e-11, B: 0.71995165086, C: 52643.7712735028},
+		{A: 8e-11, B: 3.3182527779, C: 24395.7374720718},
+		{A: 8e-11, B: 2.52932369592, C: 26190.99591579279},
+		{A: 8e-11, B: 5.11541081184, C: 25619.9381512198},
+		{A: 8e-11, B: 1.18625312267, C: 25867.49049913539},
+		{A: 8e-11, B: 0.31593558687, C: 51528.79544983359},
+		{A: 1e-10, B: 2.12019897039, C: 130969.20667296558},
+		{A: 8e-11, B: 0.24131547257, C: 143961.2671494624},
+		{A: 7e-11, B: 5.90865595918, C: 23976.2528281966},
+		{A: 8e-11, B: 4.88221803127, C: 22759.76748529401},
+		{A: 7e-11, B: 3.17202081415, C: 632.7837393132},
+		{A: 8e-11, B: 2.40875907837, C: 146314.13330323418},
+		{A: 7e-11, B: 6.03640433343, C: 53242.3017603384},
+		{A: 7e-11, B: 0.86406939215, C: 104358.72611329758},
+		{A: 7e-11, B: 3.45761762182, C: 26237.46633870879},
+		{A: 9e-11, B: 3.3062859753, C: 12725.453434775},
+		{A: 7e-11, B: 0.74174993099, C: 124156.43985787958},
+		{A: 8e-11, B: 5.6260015428, C: 131395.11544947958},
+		{A: 7e-11, B: 2.52679818243, C: 77829.99768684579},
+		{A: 8e-11, B: 1.93255904016, C: 77844.22478084739},
+		{A: 7e-11, B: 3.6943967793, C: 85502.38501632259},
+		{A: 7e-11, B: 5.14299105203, C: 35472.7441496494},
+		{A: 8e-11, B: 1.52116219771, C: 26081.27458267419},
+		{A: 8e-11, B: 3.50246944455, C: 25863.55834587229},
+		{A: 8e-11, B: 5.17598738878, C: 23962.02573419499},
+		{A: 9e-11, B: 2.01720755803, C: 104355.49390165479},
+		{A: 7e-11, B: 0.57777174711, C: 86143.79857806159},
+		{A: 7e-11, B: 2.0353430072, C: 100909.03762133139},
+		{A: 6e-11, B: 3.30402834266, C: 104564.91166173479},
+		{A: 6e-11, B: 5.26635186541, C: 50696.93970908399},
+		{A: 6e-11, B: 3.60993601694, C: 27684.0895838588},
+		{A: 8e-11, B: 5.97157930225, C: 131498.89763806018},
+		{A: 6e-11, B: 4.4320413642, C: 54294.57014352679},
+		{A: 6e-11, B: 4.1618373488, C: 54509.0026760204},
+		{A: 7e-11, B: 4.35729742194, C: 52290.24557183361},
+		{A: 7e-11, B: 4.88364528875, C: 26941.0995233262},
+		{A: 7e-11, B: 3.84735933689, C: 34282.1784747828},
+		{A: 6e-11, B: 0.71889591582, C: 89586.37352302698},
+		{A: 6e-11, B: 4.7741020149, C: 104138.31347085879},
+		{A: 6e-11, B: 5.81016662275, C: 70269.18098269838},
+		{A: 7e-11, B: 3.59164938122, C: 188276.6540401716},
+		{A: 6e-11, B: 1.42397911026, C: 102132.85546210999},
+		{A: 6e-11, B: 5.15043168056, C: 97580.90196505119},
+		{A: 8e-11, B: 2.67404385604, C: 647.0108333148},
+		{A: 5e-11, B: 4.58227258685, C: 25977.69682035479},
+		{A: 7e-11, B: 6.18437842544, C: 128850.44281258718},
+		{A: 5e-11, B: 0.05338946228, C: 13541.42120249119},
+		{A: 6e-11, B: 5.55589010148, C: 155997.72788435058},
+		{A: 5e-11, B: 5.54731935938, C: 96357.08412427259},
+		{A: 6e-11, B: 0.36201698821, C: 26308.315784013},
+		{A: 5e-11, B: 3.05584811656, C: 103285.11708910679},
+		{A: 5e-11, B: 4.80319811501, C: 51013.33157874059},
+		{A: 6e-11, B: 3.04691542636, C: 13362.4497067992},
+		{A: 5e-11, B: 5.59905054438, C: 76667.52298243798},
+		{A: 5e-11, B: 3.15313951574, C: 26164.1692128498},
+		{A: 6e-11, B: 1.15194666645, C: 27311.72098235281},
+		{A: 5e-11, B: 6.16463589751, C: 76681.75007643958},
+		{A: 5e-11, B: 2.77667883666, C: 224.3447957019},
+		{A: 5e-11, B: 4.27775147717, C: 14477.3511832},
+		{A: 5e-11, B: 5.50743820134, C: 52125.80966124419},
+		{A: 6e-11, B: 0.68838699976, C: 26720.68688088739},
+		{A: 5e-11, B: 4.44878671847, C: 25227.59321282139},
+		{A: 5e-11, B: 4.88707969684, C: 52061.36699446317},
+		{A: 5e-11, B: 3.66186576821, C: 25241.820306823},
+		{A: 5e-11, B: 1.76383923429, C: 178063.3684939606},
+		{A: 5e-11, B: 3.09083817171, C: 115674.27666460119},
+		{A: 5e-11, B: 1.73277038612, C: 956.2891559706},
+		{A: 6e-11, B: 0.59128664983, C: 134991.4692049298},
+		{A: 5e-11, B: 1.3943570322, C: 104344.49901929598},
+		{A: 5e-11, B: 5.66016557162, C: 45290.65639321759},
+		{A: 5e-11, B: 2.69362119172, C: 50064.15596977079},
+		{A: 5e-11, B: 4.11526724305, C: 209812.60368468694},
+		{A: 6e-11, B: 3.7263359886, C: 26162.6847401415},
+		{A: 4e-11, B: 1.01205356829, C: 183724.7005431128},
+		{A: 5e-11, B: 2.02484630372, C: 52712.61079524379},
+		{A: 5e-11, B: 4.47460304849, C: 77410.51304297059},
+		{A: 5e-11, B: 0.42971081835, C: 52698.38370124219},
+		{A: 5e-11, B: 1.56442459236, C: 11610.5519583742},
+		{A: 4e-11, B: 0.62582613574, C: 22747.2907148744},
+		{A: 4e-11, B: 1.51592730997, C: 66653.15746634839},
+		{A: 5e-11, B: 6.10277243405, C: 97112.93697469679},
+		{A: 4e-11, B: 1.00562353481, C: 51219.51712717779},
+		{A: 4e-11, B: 0.31906550066, C: 48835.19385644859},
+		{A: 5e-11, B: 4.98644771495, C: 51653.22886505459},
+		{A: 5e-11, B: 4.3442901056, C: 32132.1317229496},
+		{A: 4e-11, B: 2.9212557902, C: 78417.48823520739},
+		{A: 4e-11, B: 5.99601868842, C: 121335.60871375339},
+		{A: 5e-11, B: 3.13172791066, C: 61279.713277266},
+		{A: 5e-11, B: 2.13824746365, C: 39609.6545831656},
+		{A: 5e-11, B: 5.68604378667, C: 119116.85160956658},
+		{A: 4e-11, B: 2.73202591375, C: 1692.1656695024},
+		{A: 4e-11, B: 5.78251523942, C: 26734.913974889},
+		{A: 5e-11, B: 0.77777359038, C: 103711.71527998279},
+		{A: 5e-11, B: 0.91021434517, C: 71582.48457132299},
+		{A: 4e-11, B: 3.03035393128, C: 52072.71350892979},
+		{A: 4e-11, B: 0.45156085724, C: 31415.379249957},
+		{A: 4e-11, B: 3.93028686443, C: 104371.28232719658},
+		{A: 4e-11, B: 4.54919541216, C: 26198.1094627936},
+		{A: 4e-11, B: 2.66986199617, C: 24176.703658357},
+		{A: 4e-11, B: 3.50110688244, C: 74821.13447975718},
+		{A: 4e-11, B: 5.75284691398, C: 25138.7275326044},
+		{A: 4e-11, B: 5.44367911308, C: 72936.23331633979},
+		{A: 4e-11, B: 1.60493978664, C: 176953.98994186718},
+		{A: 4e-11, B: 1.93554854399, C: 50049.92887576919},
+		{A: 3e-11, B: 3.90053130991, C: 107794.1875112622},
+		{A: 3e-11, B: 5.45429683058, C: 44295.7171298094},
+		{A: 4e-11, B: 0.60054840458, C: 104347.73123093879},
+		{A: 4e-11, B: 0.88305624488, C: 60055.89543648739},
+		{A: 4e-11, B: 6.20681068925, C: 140652.80125408198},
+		{A: 3e-11, B: 1.44572212087, C: 130226.21661243298},
+		{A: 4e-11, B: 4.3775024277, C: 32769.1279949738},
+		{A: 4e-11, B: 1.75525459469, C: 110634.68841628819},
+		{A: 3e-11, B: 0.6698019741, C: 26222.0121911592},
+		{A: 4e-11, B: 2.20364417845, C: 182085.63102592478},
+		{A: 3e-11, B: 5.6061526381, C: 129387.24732468258},
+		{A: 3e-11, B: 0.96551693568, C: 207593.8465805002},
+		{A: 4e-11, B: 4.51472339809, C: 52169.17772424839},
+		{A: 4e-11, B: 0.86790293541, C: 24822.3356629478},
+		{A: 3e-11, B: 2.05745330337, C: 156100.82065856917},
+		{A: 4e-11, B: 0.27139822027, C: 214364.55718174577},
+		{A: 3e-11, B: 3.63958954831, C: 102232.84870591838},
+		{A: 3e-11, B: 3.33824382272, C: 170049.1702910366},
+		{A: 3e-11, B: 6.15959485239, C: 12546.481939083},
+		{A: 3e-11, B: 0.54712514364, C: 1911.1994832172},
+		{A: 3e-11, B: 5.53215106225, C: 323.5054166574},
+		{A: 3e-11, B: 5.78740917576, C: 27780.06881107659},
+		{A: 4e-11, B: 3.60062257669, C: 105940.68546158058},
+		{A: 3e-11, B: 5.23668974633, C: 17893.6278083656},
+		{A: 3e-11, B: 3.21536557308, C: 155571.81910783658},
+		{A: 4e-11, B: 3.78439308193, C: 68241.8721446232},
+		{A: 4e-11, B: 0.35026324282, C: 53228.07466633679},
+		{A: 3e-11, B: 1.39462627029, C: 110.2063212194},
+		{A: 3e-11, B: 2.9858544254, C: 102769.65321801379},
+		{A: 3e-11, B: 5.54914760118, C: 52101.02468458109},
+		{A: 4e-11, B: 5.31358771393, C: 116917.76426627958},
+		{A: 4e-11, B: 5.02170245911, C: 130443.39704322898},
+		{A: 3e-11, B: 3.39708771017, C: 104778.21075717278},
+		{A: 3e-11, B: 1.35333080979, C: 52182.4348420484},
+		{A: 3e-11, B: 3.53872943291, C: 28791.5192962498},
+		{A: 3e-11, B: 0.32151031474, C: 75615.25459924959},
+		{A: 3e-11, B: 2.81736551277, C: 78187.44335344699},
+		{A: 3e-11, B: 3.41370464721, C: 77616.69859140778},
+		{A: 3e-11, B: 2.72102083462, C: 157586.80077963436},
+		{A: 4e-11, B: 0.3799045894, C: 365230.6439820388},
+		{A: 3e-11, B: 5.29398694468, C: 183570.921732628},
+		{A: 3e-11, B: 0.53039476474, C: 35077.37084700659},
+		{A: 3e-11, B: 0.96990585284, C: 25780.3455206046},
+		{A: 4e-11, B: 5.03799187214, C: 103932.12792242158},
+		{A: 3e-11, B: 0.57063116288, C: 52250.5878817157},
+		{A: 3e-11, B: 4.90694398172, C: 27044.1922975448},
+		{A: 3e-11, B: 5.64342324025, C: 78903.60671103658},
+		{A: 3e-11, B: 3.90829945174, C: 130446.62925487179},
+		{A: 4e-11, B: 5.39121442451, C: 52022.0274726636},
+		{A: 3e-11, B: 5.38325956945, C: 172402.0364448084},
+		{A: 3e-11, B: 3.62725946038, C: 161079.37234650398},
+		{A: 3e-11, B: 0.02159890719, C: 130652.81480330898},
+		{A: 3e-11, B: 5.26052772975, C: 55516.4187098482},
+		{A: 3e-11, B: 1.45533607551, C: 77101.23472031478},
+		{A: 3e-11, B: 2.45621582046, C: 207643.8432024044},
+		{A: 3e-11, B: 3.04264756378, C: 74923.09699802278},
+		{A: 3e-11, B: 3.27797530359, C: 27669.86248985719},
+		{A: 4e-11, B: 6.20795181771, C: 3340.6124266998},
+		{A: 3e-11, B: 2.86818821459, C: 79330.20490191258},
+		{A: 3e-11, B: 5.42245453158, C: 36109.7404216736},
+		{A: 3e-11, B: 1.11238018002, C: 2125.8774073792},
+		{A: 3e-11, B: 4.70325749301, C: 52595.29092702359},
+		{A: 3e-11, B: 4.14290639481, C: 25446.4895798352},
+		{A: 3e-11, B: 4.11874155864, C: 1478.8665740644},
+		{A: 3e-11, B: 4.41727880469, C: 128220.75860368418},
+		{A: 3e-11, B: 5.8430133045, C: 65831.6667743248},
+		{A: 3e-11, B: 0.63458746349, C: 112231.70171963578},
+		{A: 3e-11, B: 5.2035846966, C: 157057.10981453978},
+		{A: 3e-11, B: 4.22446997246, C: 53399.624123927},
+		{A: 3e-11, B: 6.08145059717, C: 26724.8994135984},
+		{A: 3e-11, B: 3.32246989691, C: 111122.32316754239},
+		{A: 3e-11, B: 1.11208497752, C: 78378.1487134078},
+		{A: 3e-11, B: 1.18205388535, C: 80382.47328510099},
+		{A: 3e-11, B: 3.98183946807, C: 25352.02662804239},
+		{A: 3e-11, B: 5.1753723875, C: 225687.22128005017},
+		{A: 2e-11, B: 3.19970010923, C: 64901.25971792339},
+		{A: 3e-11, B: 5.90368046078, C: 104505.39137678158},
+		{A: 3e-11, B: 2.15712281571, C: 28736.3579670472},
+		{A: 2e-11, B: 0.27988699718, C: 52325.36948028299},
+		{A: 2e-11, B: 1.09392137734, C: 24998.19435038059},
+		{A: 3e-11, B: 1.72654636472, C: 130419.8459469712},
+		{A: 3e-11, B: 6.24477199702, C: 51969.62073471119},
+		{A: 3e-11, B: 3.27154613105, C: 77630.92568540938},
+		{A: 2e-11, B: 1.80684819652, C: 123668.80510662538},
+		{A: 2e-11, B: 3.75304905922, C: 150244.3429994538},
+		{A: 3e-11, B: 2.04107819596, C: 76784.84285065818},
+		{A: 3e-11, B: 4.07589828594, C: 97670.38771289718},
+		{A: 2e-11, B: 3.43439078149, C: 52609.51802102519},
+		{A: 2e-11, B: 0.51530246334, C: 133882.09065283637},
+		{A: 3e-11, B: 4.75031746198, C: 204151.27163553477},
+		{A: 2e-11, B: 5.57618391474, C: 24292.64469785319},
+		{A: 2e-11, B: 2.22207407093, C: 78213.71280281838},
+		{A: 2e-11, B: 6.08590562625, C: 39450.3528483734},
+		{A: 3e-11, B: 4.42541702442, C: 51955.39364070959},
+		{A: 3e-11, B: 5.48323931966, C: 220.4126424388},
+		{A: 3e-11, B: 5.24728665819, C: 26709.6469424134},
+		{A: 3e-11, B: 4.52126286883, C: 20043.6745601988},
+		{A: 2e-11, B: 1.69429787369, C: 53029.0026649004},
+		{A: 3e-11, B: 0.98781948776, C: 80596.9058175946},
+		{A: 2e-11, B: 2.7229153699, C: 23866.04650697719},
+		{A: 2e-11, B: 2.23117481869, C: 26402.0893214438},
+		{A: 3e-11, B: 2.32561622864, C: 145204.75475114078},
+		{A: 3e-11, B: 3.55406019317, C: 52225.8029050526},
+		{A: 3e-11, B: 0.82939608505, C: 87253.17713015496},
+		{A: 3e-11, B: 3.59850686303, C: 52396.2189255872},
+		{A: 2e-11, B: 0.25549281668, C: 22909.7573510066},
+		{A: 2e-11, B: 4.18932113589, C: 81591.84508100279},
+		{A: 2e-11, B: 5.58984236569, C: 104202.04936916218},
+		{A: 2e-11, B: 1.5932542826, C: 206.1855484372},
+		{A: 2e-11, B: 2.33240745085, C: 157483.01859105378},
+		{A: 2e-11, B: 3.78499822852, C: 78731.674415077},
+		{A: 3e-11, B: 1.73139148586, C: 77741.13200662879},
+		{A: 2e-11, B: 1.98761216006, C: 102018.41617342478},
+		{A: 2e-11, B: 3.82447809654, C: 1162.4747044078},
+		{A: 2e-11, B: 3.73613869026, C: 24601.92302050899},
+		{A: 2e-11, B: 4.87002033008, C: 51859.41441349179},
+		{A: 2e-11, B: 0.10623356446, C: 111590.2881578968},
+		{A: 2e-11, B: 4.43911582586, C: 78270.3379836226},
+		{A: 2e-11, B: 2.47821765975, C: 78114.14622758799},
+		{A: 2e-11, B: 5.8334620174, C: 181026.24909573558},
+		{A: 2e-11, B: 2.27341561267, C: 25953.79409198919},
+		{A: 2e-11, B: 2.73852323809, C: 123200.84011627098},
+		{A: 2e-11, B: 2.35063735347, C: 78109.93061423779},
+		{A: 2e-11, B: 0.45168929332, C: 2111.6503133776},
+		{A: 2e-11, B: 4.46926491505, C: 25450.90686955},
+		{A: 2e-11, B: 3.97278244291, C: 52808.59002246159},
+		{A: 2e-11, B: 0.58667782465, C: 128320.75184749259},
+		{A: 2e-11, B: 3.05065649587, C: 102755.42612401219},
+		{A: 2e-11, B: 1.1348605474, C: 52492.19815280499},
+		{A: 2e-11, B: 4.64642529286, C: 60370.08161635699},
+		{A: 2e-11, B: 3.68035435205, C: 52309.9153327334},
+		{A: 2e-11, B: 5.93910800197, C: 78160.61665050399},
+		{A: 2e-11, B: 1.26706232, C: 58857.03113654799},
+		{A: 2e-11, B: 5.09637370849, C: 26823.77965510599},
+		{A: 2e-11, B: 3.88808196008, C: 16703.062133499},
+		{A: 2e-11, B: 0.00715741623, C: 130866.11389874699},
+		{A: 2e-11, B: 3.63338095338, C: 51852.30086649099},
+		{A: 2e-11, B: 5.67927933196, C: 103917.90082841998},
+		{A: 2e-11, B: 1.35964203538, C: 3328.13565628019},
+		{A: 2e-11, B: 6.1353166559, C: 129373.02023068098},
+		{A: 2e-11, B: 3.74140255139, C: 52381.99183158559},
+		{A: 2e-11, B: 0.37783717379, C: 53771.99272543299},
+		{A: 2e-11, B: 0.70779949763, C: 26667.590728573},
+		{A: 2e-11, B: 2.05372686428, C: 70383.6202713836},
+		{A: 2e-11, B: 6.06999395703, C: 23919.1426592916},
+		{A: 2e-11, B: 1.22493486348, C: 103498.41618454478},
+		{A: 2e-11, B: 4.0005881348, C: 129799.61842155698},
+		{A: 2e-11, B: 1.62085169147, C: 78149.27013603736},
+		{A: 2e-11, B: 1.81898501228, C: 25771.5112719176},
+		{A: 2e-11, B: 6.2245179639, C: 52252.07235442399},
+		{A: 2e-11, B: 0.20849484282, C: 25754.0472008048},
+		{A: 2e-11, B: 2.38916713246, C: 51226.63067417859},
+		{A: 2e-11, B: 4.34697979051, C: 26404.2950112308},
+		{A: 2e-11, B: 2.87638496001, C: 167850.0829477496},
+		{A: 2e-11, B: 4.0163819369, C: 51868.2486621788},
+		{A: 2e-11, B: 3.38565068956, C: 77726.90491262719},
+		{A: 2e-11, B: 5.73512271075, C: 189853.99758261937},
+		{A: 2e-11, B: 3.93444553575, C: 50910.238804522},
+		{A: 2e-11, B: 3.62039658007, C: 6885.14988993081},
+		{A: 2e-11, B: 1.7649293935, C: 92741.06060792258},
+		{A: 2e-11, B: 2.5819014903, C: 154938.34595416137},
+		{A: 2e-11, B: 5.37279792857, C: 52278.89905736699},
+		{A: 2e-11, B: 3.37039086197, C: 2221.856634597},
+		{A: 2e-11, B: 6.07737780217, C: 94329.77528619739},
+		{A: 2e-11, B: 5.16106622649, C: 26729.31670331319},
+		{A: 2e-11, B: 1.58212922198, C: 26013.1215430069},
+		{A: 2e-11, B: 5.77714525422, C: 25600.26839032},
+		{A: 2e-11, B: 1.88481234244, C: 27177.6119327678},
+		{A: 2e-11, B: 5.68695965343, C: 50264.6067999312},
+		{A: 2e-11, B: 6.0760153167, C: 141762.17980617538},
+		{A: 2e-11, B: 4.33545610317, C: 8194.2753332086},
+		{A: 2e-11, B: 1.10189365852, C: 1223.81784077861},
+		{A: 2e-11, B: 1.68048369897, C: 27250.37784598199},
+		{A: 2e-11, B: 5.32044002117, C: 151199.94274106238},
+		{A: 2e-11, B: 1.33411828477, C: 78257.08086582259},
+		{A: 2e-11, B: 5.40842531044, C: 95247.70557217918},
+		{A: 2e-11, B: 4.55349965747, C: 37698.4550999484},
+		{A: 2e-11, B: 2.71889214634, C: 173511.41499690176},
+		{A: 2e-11, B: 1.95076646341, C: 143005.6674078538},
+		{A: 2e-11, B: 2.00346690661, C: 26073.67604757259},
+		{A: 2e-11, B: 1.78781584986, C: 48847.6706268682},
+		{A: 2e-11, B: 5.89586766332, C: 26312.2479372761},
+		{A: 2e-11, B: 1.99160321093, C: 26189.8656598398},
+		{A: 2e-11, B: 0.40598906242, C: 25773.71696170459},
+		{A: 2e-11, B: 3.36946627953, C: 26575.53789282839},
+		{A: 2e-11, B: 4.77845331095, C: 136722.59155786238},
+		{A: 2e-11, B: 1.11920130054, C: 51315.49635439559},
+		{A: 2e-11, B: 4.99966961564, C: 78800.51393681798},
+		{A: 2e-11, B: 2.50164995664, C: 19202.75325164339},
+		{A: 2e-11, B: 5.31192385841, C: 23384.2869868986},
+		{A: 2e-11, B: 2.93563622378, C: 137678.191299471},
+		{A: 2e-11, B: 5.00974264854, C: 182188.72380014337},
+		{A: 2e-11, B: 2.8206637022, C: 118828.96374949679},
+		{A: 2e-11, B: 1.23355180586, C: 52286.01260436779},
+		{A: 2e-11, B: 0.27866926, C: 24356.7807886416},
+		{A: 2e-11, B: 2.19163720361, C: 26395.46076254379},
+		{A: 2e-11, B: 2.41579406356, C: 147423.51185532758},
+		{A: 2e-11, B: 1.80661945912, C: 860.3099287528},
+		{A: 1e-11, B: 3.75687703582, C: 34082.4315835984},
+		{A: 1e-11, B: 5.85918351804, C: 163766.0944410452},
+		{A: 1e-11, B: 4.84627694564, C: 27170.98337386779},
+		{A: 2e-11, B: 3.12958128871, C: 195047.36464141717},
+		{A: 2e-11, B: 0.59313712363, C: 74.7815985673},
+		{A: 2e-11, B: 4.60111717878, C: 25004.8229092806},
+		{A: 1e-11, B: 1.14751201091, C: 55503.94193942859},
+		{A: 2e-11, B: 4.55507467591, C: 132658.27281205778},
+		{A: 1e-11, B: 1.61844283111, C: 24079.34560241519},
+		{A: 2e-11, B: 1.99970840056, C: 17098.43543614181},
+		{A: 2e-11, B: 4.95650007002, C: 24448.8336243862},
+		{A: 1e-11, B: 6.20512620421, C: 2333.196392872},
+		{A: 2e-11, B: 1.27464400058, C: 144916.86689107097},
+		{A: 2e-11, B: 6.17677089224, C: 87367.61641884019},
+		{A: 1e-11, B: 1.29983905478, C: 154194.22245657316},
+		{A: 2e-11, B: 4.47232796046, C: 130432.40216087017},
+		{A: 1e-11, B: 4.48574397522, C: 101703.15774082378},
+		{A: 2e-11, B: 2.19277171383, C: 103.0927742186},
+		{A: 1e-11, B: 1.80796273878, C: 25508.2155545754},
+		{A: 1e-11, B: 5.486640137, C: 22625.658435709},
+		{A: 1e-11, B: 0.74294839995, C: 78896.49316403578},
+		{A: 1e-11, B: 3.51165251141, C: 26421.7590823436},
+		{A: 1e-11, B: 5.5566899571, C: 220025.88923089797},
+		{A: 2e-11, B: 4.08809950869, C: 25466.159340735},
+		{A: 2e-11, B: 2.89128653047, C: 166740.70439565618},
+		{A: 1e-11, B: 3.92791860433, C: 189386.03259226496},
+		{A: 1e-11, B: 0.3312846752, C: 76571.54375522019},
+		{A: 2e-11, B: 1.98187462633, C: 81604.32185142238},
+		{A: 1e-11, B: 3.57210475329, C: 78786.28684281638},
+		{A: 2e-11, B: 1.13879447241, C: 61165.27398858079},
+		{A: 1e-11, B: 5.62721081889, C: 122444.98726584678},
+		{A: 1e-11, B: 0.51173156424, C: 113341.08027172917},
+		{A: 1e-11, B: 0.63238768568, C: 130459.18546877075},
+		{A: 1e-11, B: 0.62172861766, C: 138319.60486120995},
+		{A: 2e-11, B: 0.35135879351, C: 132028.58860315479},
+		{A: 1e-11, B: 1.68473271541, C: 28286.9904848612},
+		{A: 2e-11, B: 2.61859360479, C: 61560.64729122359},
+		{A: 1e-11, B: 3.42727473387, C: 130435.63437251298},
+		{A: 2e-11, B: 3.42836265136, C: 65717.22748563958},
+		{A: 1e-11, B: 2.98248327032, C: 846.0828347512},
+		{A: 1e-11, B: 5.35146960556, C: 1265.5674786264},
+		{A: 1e-11, B: 4.8837970192, C: 205260.65018762814},
+		{A: 1e-11, B: 1.38681194099, C: 467.9649903544},
+		{A: 1e-11, B: 5.78967137689, C: 76152.05911134499},
+		{A: 1e-11, B: 1.6462517202, C: 75930.51303185058},
+		{A: 1e-11, B: 0.08732855602, C: 103718.82882698359},
+		{A: 1e-11, B: 0.74831854153, C: 51534.3927214094},
+		{A: 1e-11, B: 1.98744571247, C: 26411.4085582316},
+		{A: 2e-11, B: 0.58716824437, C: 52065.59996192899},
+		{A: 1e-11, B: 5.83388253183, C: 104275.34649502118},
+		{A: 1e-11, B: 2.07307900948, C: 78188.92782615528},
+		{A: 1e-11, B: 4.54379329814, C: 203041.8930834414},
+		{A: 1e-11, B: 3.67961974137, C: 116783.65521669458},
+		{A: 1e-11, B: 2.7641982766, C: 52812.8025551726},
+		{A: 1e-11, B: 5.76246998806, C: 1375.7737998458},
+		{A: 1e-11, B: 3.49542841313, C: 79315.97780791098},
+		{A: 1e-11, B: 3.09408892186, C: 9384.8410080752},
+		{A: 2e-11, B: 2.99230815235, C: 91805.13062721379},
+		{A: 1e-11, B: 2.3181898578, C: 58220.0348645238},
+		{A: 1e-11, B: 2.52061585089, C: 91919.56991589899},
+		{A: 1e-11, B: 0.20823485438, C: 54879.422437824},
+		{A: 1e-11, B: 4.44457464944, C: 6044.2285813754},
+		{A: 1e-11, B: 0.49331056411, C: 104819.57755665119},
+		{A: 1e-11, B: 5.98062907857, C: 106570.36967048359},
+		{A: 1e-11, B: 3.43578051874, C: 51841.950342379},
+		{A: 1e-11, B: 1.50478057782, C: 137210.22630911658},
+		{A: 1e-11, B: 0.85871789181, C: 25985.94062330859},
+		{A: 1e-11, B: 0.00464768227, C: 196137.07343261078},
+		{A: 1e-11, B: 4.31601888279, C: 50483.640613646},
+		{A: 1e-11, B: 3.82192552665, C: 25928.601406782},
+		{A: 1e-11, B: 2.13422641639, C: 62197.64356324779},
+		{A: 1e-11, B: 0.26342054765, C: 1795.258443721},
+		{A: 1e-11, B: 3.33069384141, C: 19.66976089979},
+		{A: 1e-11, B: 5.0467220846, C: 26247.2048763664},
+		{A: 1e-11, B: 3.6909155011, C: 77307.42026875199},
+		{A: 1e-11, B: 0.68629642431, C: 26102.1302355758},
+		{A: 1e-11, B: 5.6196302566, C: 104197.83375581198},
+		{A: 1e-11, B: 0.41026701894, C: 636.9962720242},
+		{A: 1e-11, B: 2.73690252229, C: 170068.84005193636},
+		{A: 1e-11, B: 2.97981003623, C: 78057.52387628538},
+		{A: 1e-11, B: 2.84174115238, C: 168959.46149984296},
+		{A: 1e-11, B: 6.19695864555, C: 241561.83887541335},
+		{A: 1e-11, B: 2.31427972623, C: 179172.74704605396},
+		{A: 1e-11, B: 0.82072277702, C: 51329.7234483972},
+		{A: 1e-11, B: 5.23954201903, C: 99024.13645791399},
+		{A: 1e-11, B: 1.86269361791, C: 45494.58142974879},
+		{A: 1e-11, B: 3.54450921353, C: 78338.49102328988},
+		{A: 1e-11, B: 4.60745432488, C: 156507.7490885454},
+		{A: 1e-11, B: 1.62346464256, C: 128106.31931499895},
+		{A: 1e-11, B: 0.72186155433, C: 27005.83342755599},
+		{A: 1e-11, B: 2.30344666604, C: 50380.54783942739},
+		{A: 1e-11, B: 1.18658609813, C: 8989.46770543239},
+		{A: 1e-11, B: 6.25857682168, C: 50800.03248330259},
+		{A: 1e-11, B: 0.99962008973, C: 163298.1294506908},
+		{A: 1e-11, B: 2.86790873835, C: 38634.3850806572},
+		{A: 1e-11, B: 3.61827980669, C: 106262.81204951399},
+		{A: 1e-11, B: 0.61515728295, C: 78469.89497315978},
+		{A: 1e-11, B: 4.60636743786, C: 126996.94076290558},
+		{A: 1e-11, B: 4.48187053152, C: 231348.55332920235},
+		{A: 1e-11, B: 5.1982979368, C: 142871.55835826878},
+		{A: 1e-11, B: 5.68864830775, C: 3178.1457905676},
+		{A: 1e-11, B: 4.89753750946, C: 53265.515074342},
+		{A: 1e-11, B: 3.68051221186, C: 2703.6161546756},
+		{A: 1e-11, B: 0.0173753133, C: 103814.80805420138},
+		{A: 1e-11, B: 1.79348974875, C: 183145.012956114},
+		{A: 1e-11, B: 6.16564988887, C: 199599.31813847594},
+		{A: 1e-11, B: 0.63301837081, C: 51439.92976961659},
+		{A: 1e-11, B: 2.2942790431, C: 1485.9801210652},
+		{A: 1e-11, B: 1.44184009822, C: 112545.88789950538},
+		{A: 1e-11, B: 0.55411127132, C: 42790.9652750732},
+		{A: 1e-11, B: 5.07507945312, C: 54824.2611086214},
+		{A: 1e-11, B: 5.10858625008, C: 107679.74822257696},
+		{A: 1e-11, B: 5.50068575093, C: 27819.0254945068},
+		{A: 1e-11, B: 5.60579347269, C: 153084.84390447979},
+		{A: 1e-11, B: 4.92352598929, C: 51596.1186961496},
+		{A: 1e-11, B: 6.10538330268, C: 181659.72224941078},
+	},
+	// R2, 326 terms
+	{
+		{A: 3.117867e-05, B: 3.08231840296, C: 26087.9031415742},
+		{A: 1.245396e-05, B: 6.15183317423, C: 52175.8062831484},
+		{A: 4.24822e-06, B: 2.9258335296, C: 78263.70942472259},
+		{A: 1.3613e-06, B: 5.97983925842, C: 104351.61256629678},
+		{A: 4.2175e-07, B: 2.74936980629, C: 130439.51570787099},
+		{A: 2.1759e-07, B: 3.14159265359, C: 0},
+		{A: 1.2793e-07, B: 5.80143162209, C: 156527.41884944518},
+		{A: 3.825e-08, B: 2.56993599584, C: 182615.3219910194},
+		{A: 1.042e-08, B: 3.14648120079, C: 24978.5245894808},
+		{A: 1.131e-08, B: 5.6214219697, C: 208703.2251325936},
+		{A: 4.83e-09, B: 6.1430765452, C: 27197.2816936676},
+		{A: 3.32e-09, B: 2.3899257567, C: 234791.12827416777},
+		{A: 3.2e-09, B: 6.20674766565, C: 51066.427731055},
+		{A: 1.2e-09, B: 0.56745598887, C: 20426.571092422},
+		{A: 1.13e-09, B: 3.28048907943, C: 24498.8302462904},
+		{A: 1.05e-09, B: 4.36663468239, C: 25028.521211385},
+		{A: 9.7e-10, B: 5.44128884026, C: 260879.03141574195},
+		{A: 9.5e-10, B: 1.70276410782, C: 1059.3819301892},
+		{A: 1.03e-09, B: 2.98038190305, C: 77154.33087262919},
+		{A: 7.4e-10, B: 1.286303818, C: 26617.5941066688},
+		{A: 5.7e-10, B: 2.9675336298, C: 1109.3785520934},
+		{A: 6.6e-10, B: 5.68201233964, C: 25132.3033999656},
+		{A: 5.3e-10, B: 3.63699398162, C: 46514.4742339962},
+		{A: 5.9e-10, B: 3.10922979724, C: 27043.5028831828},
+		{A: 5.3e-10, B: 0.66673109494, C: 21535.9496445154},
+		{A: 4.3e-10, B: 4.69270605698, C: 27147.28507176339},
+		{A: 4.2e-10, B: 2.77372510028, C: 1589.0728952838},
+		{A: 3.6e-10, B: 4.39741248058, C: 14765.2390432698},
+		{A: 3.5e-10, B: 1.80468389323, C: 11322.6640983044},
+		{A: 4.3e-10, B: 6.0742625499, C: 28306.66024576099},
+		{A: 3.3e-10, B: 5.34572581657, C: 25448.00585526019},
+		{A: 3.1e-10, B: 5.6648907507, C: 30639.856638633},
+		{A: 3.1e-10, B: 2.36104565226, C: 32858.61374281979},
+		{A: 3.1e-10, B: 4.27403143366, C: 16983.9961474566},
+		{A: 3.3e-10, B: 3.22976052744, C: 23869.1460373874},
+		{A: 3.1e-10, B: 0.5004107612, C: 22645.32819660879},
+		{A: 2.8e-10, B: 2.21390393577, C: 286966.9345573162},
+		{A: 3.3e-10, B: 6.03682885493, C: 103242.2340142034},
+		{A: 2.5e-10, B: 4.23934105457, C: 52705.49724824299},
+		{A: 2.3e-10, B: 2.59711556958, C: 23969.1392811958},
+		{A: 2.7e-10, B: 2.10665521901, C: 25558.2121764796},
+		{A: 2.1e-10, B: 0.4089238358, C: 72602.37737557039},
+		{A: 2.5e-10, B: 5.69513499802, C: 5661.3320491522},
+		{A: 2.2e-10, B: 4.86064608327, C: 26301.2022370122},
+		{A: 2.4e-10, B: 0.66522740624, C: 19317.1925403286},
+		{A: 2e-10, B: 5.61847810688, C: 4551.9534970588},
+		{A: 2.1e-10, B: 2.30083904827, C: 51220.20654153979},
+		{A: 1.8e-10, B: 0.4883903725, C: 26068.2333806744},
+		{A: 1.6e-10, B: 6.07779022342, C: 50586.73338786459},
+		{A: 1.6e-10, B: 1.47218845921, C: 53235.18821333759},
+		{A: 1.7e-10, B: 2.94277588966, C: 53285.1848352418},
+		{A: 1.5e-10, B: 2.39097980793, C: 83925.0414738748},
+		{A: 1.5e-10, B: 3.85502950028, C: 26091.7844769322},
+		{A: 1.4e-10, B: 4.13753968603, C: 12566.1516999828},
+		{A: 1.5e-10, B: 5.51545801304, C: 6770.7106012456},
+		{A: 1.4e-10, B: 5.67708505049, C: 57837.1383323006},
+		{A: 1.4e-10, B: 5.73775080815, C: 27676.976036858},
+		{A: 1.6e-10, B: 5.69298300679, C: 26107.57290247399},
+		{A: 1.4e-10, B: 5.00837046884, C: 37410.5672398786},
+		{A: 1.5e-10, B: 5.72321978212, C: 29530.4780865396},
+		{A: 1.2e-10, B: 1.18827026407, C: 40853.142184844},
+		{A: 1.3e-10, B: 2.34101240702, C: 26084.0218062162},
+		{A: 1.2e-10, B: 2.43920574243, C: 56727.7597802072},
+		{A: 1.2e-10, B: 1.12086684687, C: 43071.8992890308},
+		{A: 1.2e-10, B: 1.86975778356, C: 9103.9069941176},
+		{A: 1.2e-10, B: 4.9023641695, C: 36301.18868778519},
+		{A: 1e-10, B: 2.33297570685, C: 426.598190876},
+		{A: 1.1e-10, B: 2.80390774003, C: 129330.13715577759},
+		{A: 1e-10, B: 5.86382886189, C: 26202.34243025941},
+		{A: 9e-11, B: 0.03545904481, C: 25234.70675982219},
+		{A: 1.1e-10, B: 0.92571990717, C: 639.897286314},
+		{A: 1e-10, B: 3.94827815051, C: 25661.3049506982},
+		{A: 9e-11, B: 0.00145195768, C: 49957.0491789616},
+		{A: 8e-11, B: 5.42702003399, C: 110012.94461544899},
+		{A: 8e-11, B: 3.39306818321, C: 25973.46385288896},
+		{A: 9e-11, B: 4.83499870418, C: 38519.945791972},
+		{A: 8e-11, B: 5.65148718768, C: 53131.406024757},
+		{A: 9e-11, B: 5.73812314204, C: 51116.4243529592},
+		{A: 8e-11, B: 1.98050481337, C: 13521.7514415914},
+		{A: 9e-11, B: 0.95963913886, C: 78793.40038981718},
+		{A: 8e-11, B: 5.24647237181, C: 313054.83769889036},
+		{A: 8e-11, B: 3.52212788936, C: 52156.1365222486},
+		{A: 7e-11, B: 3.57060093012, C: 48733.23133818299},
+		{A: 8e-11, B: 3.47359025801, C: 98690.28051714458},
+		{A: 8e-11, B: 5.32679971128, C: 51646.11531805379},
+		{A: 1e-10, B: 5.07319693095, C: 25874.6040461362},
+		{A: 8e-11, B: 4.34411026261, C: 31749.2351907264},
+		{A: 7e-11, B: 3.44556423722, C: 26080.78959457339},
+		{A: 7e-11, B: 5.28088749622, C: 77308.10968311399},
+		{A: 7e-11, B: 3.46667099767, C: 2118.7638603784},
+		{A: 7e-11, B: 1.96360039314, C: 10213.285546211},
+		{A: 7e-11, B: 1.18631489114, C: 103292.23063610759},
+		{A: 7e-11, B: 4.28693259279, C: 955.5997416086},
+		{A: 7e-11, B: 0.88520158736, C: 38654.05484155699},
+		{A: 9e-11, B: 2.1210015003, C: 18849.2275499742},
+		{A: 8e-11, B: 2.75301666365, C: 26095.016688575},
+		{A: 7e-11, B: 4.98530328971, C: 529.6909650946},
+		{A: 7e-11, B: 0.20928215626, C: 33326.5787331742},
+		{A: 6e-11, B: 4.54286088482, C: 79323.09135491178},
+		{A: 6e-11, B: 0.60060875587, C: 52179.6876185064},
+		{A: 6e-11, B: 1.21940434381, C: 41962.5207369374},
+		{A: 6e-11, B: 1.74585091288, C: 62389.09182935939},
+		{A: 6e-11, B: 2.13481309441, C: 51535.90899683439},
+		{A: 6e-11, B: 4.92478078408, C: 26514.5013324502},
+		{A: 7e-11, B: 4.18249532118, C: 77204.32749453338},
+		{A: 7e-11, B: 1.68449319925, C: 52389.1053785864},
+		{A: 5e-11, B: 4.28220819106, C: 68050.42387851159},
+		{A: 7e-11, B: 1.69076988754, C: 12432.0426503978},
+		{A: 6e-11, B: 3.73803069606, C: 45405.0956819028},
+		{A: 5e-11, B: 2.6132037412, C: 79373.087976816},
+		{A: 5e-11, B: 2.82458540907, C: 54394.56338733519},
+		{A: 4e-11, B: 6.27439443684, C: 52168.69273614759},
+		{A: 5e-11, B: 4.50767453332, C: 13655.8604911764},
+		{A: 6e-11, B: 2.41451327257, C: 52195.47604404819},
+		{A: 5e-11, B: 5.35396003655, C: 52171.9249477904},
+		{A: 5e-11, B: 5.48187638039, C: 82815.66292178139},
+		{A: 4e-11, B: 2.46695073802, C: 55618.3812281138},
+		{A: 4e-11, B: 2.40470389725, C: 53764.8791784322},
+		{A: 4e-11, B: 4.24591997877, C: 66941.04532641819},
+		{A: 4e-11, B: 3.26730161531, C: 7238.6755916},
+		{A: 4e-11, B: 4.24517841791, C: 129380.13377768178},
+		{A: 4e-11, B: 5.46706523938, C: 50057.04242277},
+		{A: 4e-11, B: 2.17208918484, C: 136100.84775702318},
+		{A: 4e-11, B: 5.86573287261, C: 155418.04029735178},
+		{A: 4e-11, B: 4.15256069197, C: 32370.9789915656},
+		{A: 4e-11, B: 2.92358717546, C: 2218.7571041868},
+		{A: 4e-11, B: 2.22141015932, C: 33967.99229491319},
+		{A: 5e-11, B: 5.74788858603, C: 52182.9198301492},
+		{A: 4e-11, B: 0.97295961632, C: 44181.27784112419},
+		{A: 3e-11, B: 5.78273085505, C: 28421.0995344462},
+		{A: 3e-11, B: 3.65975480266, C: 99799.65906923798},
+		{A: 4e-11, B: 3.98623592167, C: 26727.8004278882},
+		{A: 3e-11, B: 2.65056124435, C: 76674.63652943878},
+		{A: 3e-11, B: 4.91152657563, C: 47623.8527860896},
+		{A: 4e-11, B: 1.60135504353, C: 77734.01845962799},
+		{A: 3e-11, B: 0.26868606771, C: 78244.0396638228},
+		{A: 3e-11, B: 4.58298191655, C: 1066.49547719},
+		{A: 3e-11, B: 4.04696774318, C: 18093.37469954999},
+		{A: 3e-11, B: 1.04981780528, C: 94138.32702008578},
+		{A: 3e-11, B: 0.82425633309, C: 73711.75592766379},
+		{A: 3e-11, B: 5.12180734718, C: 1052.2683831884},
+		{A: 3e-11, B: 1.58544193974, C: 51109.31080595839},
+		{A: 3e-11, B: 0.22337858409, C: 124778.18365871879},
+		{A: 3e-11, B: 3.08207297479, C: 76044.9523205358},
+		{A: 3e-11, B: 6.26000429251, C: 853.196381752},
+		{A: 3e-11, B: 3.89747679105, C: 64741.95798313119},
+		{A: 3e-11, B: 1.86869273254, C: 52602.4044740244},
+		{A: 4e-11, B: 0.01480665337, C: 7.1135470008},
+		{A: 3e-11, B: 1.95420622178, C: 103396.01282468818},
+		{A: 3e-11, B: 6.00022344367, C: 29416.03879785439},
+		{A: 3e-11, B: 1.0582738966, C: 51123.53789995999},
+		{A: 3e-11, B: 3.9333618709, C: 104881.30353139139},
+		{A: 2e-11, B: 4.80666683935, C: 88476.99497093359},
+		{A: 2e-11, B: 2.56919394798, C: 51962.5071877104},
+		{A: 2e-11, B: 5.19328838214, C: 91785.46086631398},
+		{A: 3e-11, B: 4.94448744333, C: 44937.1306915484},
+		{A: 2e-11, B: 0.39272598961, C: 23754.70674870219},
+		{A: 3e-11, B: 3.64926786888, C: 78267.59076008058},
+		{A: 2e-11, B: 1.93776267237, C: 117873.36400788819},
+		{A: 2e-11, B: 0.16310879863, C: 28206.6670019526},
+		{A: 2e-11, B: 2.74232958068, C: 52290.24557183361},
+		{A: 2e-11, B: 1.94114905421, C: 7994.5284420242},
+		{A: 2e-11, B: 0.39437121176, C: 125887.56221081219},
+		{A: 2e-11, B: 1.03418813348, C: 155468.036919256},
+		{A: 2e-11, B: 2.06866763231, C: 339142.7408404646},
+		{A: 2e-11, B: 1.29912216629, C: 105410.99449648599},
+		{A: 2e-11, B: 0.5412418909, C: 74821.13447975718},
+		{A: 2e-11, B: 2.12346357366, C: 65697.55772473979},
+		{A: 2e-11, B: 2.54143985834, C: 78270.82297172339},
+		{A: 2e-11, B: 4.87063627681, C: 35191.8101356918},
+		{A: 2e-11, B: 0.51631216786, C: 71492.99882347698},
+		{A: 2e-11, B: 0.7397688755, C: 18207.81398823521},
+		{A: 2e-11, B: 5.50150244414, C: 78283.37918562238},
+		{A: 2e-11, B: 4.78661791721, C: 78477.00852016058},
+		{A: 2e-11, B: 4.40683761496, C: 103821.92160120218},
+		{A: 2e-11, B: 3.07092516027, C: 78256.59587772179},
+		{A: 2e-11, B: 5.01301614244, C: 3442.5749449654},
+		{A: 2e-11, B: 2.58799752737, C: 181505.94343892598},
+		{A: 2e-11, B: 4.95243490606, C: 129483.91596626239},
+		{A: 2e-11, B: 4.12821833516, C: 77211.44104153418},
+		{A: 2e-11, B: 4.05475453577, C: 120226.23016165999},
+		{A: 2e-11, B: 3.34041291655, C: 151975.46535238638},
+		{A: 2e-11, B: 3.27812728356, C: 51322.60990139639},
+		{A: 2e-11, B: 4.06092192484, C: 77837.11123384659},
+		{A: 2e-11, B: 5.22546741404, C: 162188.75089859738},
+		{A: 2e-11, B: 5.57497617017, C: 71980.63357473118},
+		{A: 2e-11, B: 1.86655156569, C: 23439.44831610119},
+		{A: 2e-11, B: 4.79020891265, C: 15874.6175953632},
+		{A: 2e-11, B: 2.43202419279, C: 45892.73043315699},
+		{A: 1e-11, B: 4.91443985635, C: 78690.30761559859},
+		{A: 2e-11, B: 2.55186486587, C: 39609.6545831656},
+		{A: 2e-11, B: 2.04705904795, C: 433.7117378768},
+		{A: 1e-11, B: 6.06582167254, C: 102132.85546210999},
+		{A: 2e-11, B: 0.36512406083, C: 6283.0758499914},
+		{A: 2e-11, B: 5.96418661485, C: 78050.41032928458},
+		{A: 1e-11, B: 0.66730245858, C: 58458.88213313979},
+		{A: 1e-11, B: 2.46652537811, C: 98068.53671630539},
+		{A: 2e-11, B: 3.24884087108, C: 150866.08680029298},
+		{A: 2e-11, B: 0.33626385981, C: 52061.36699446317},
+		{A: 1e-11, B: 1.58214319162, C: 114564.89811250778},
+		{A: 1e-11, B: 1.86505965946, C: 27140.17152476259},
+		{A: 1e-11, B: 4.7095478466, C: 77197.21394753258},
+		{A: 1e-11, B: 3.30080224324, C: 104331.94280539699},
+		{A: 2e-11, B: 2.15014442693, C: 78259.82808936459},
+		{A: 1e-11, B: 4.16909637294, C: 51742.09454527159},
+		{A: 2e-11, B: 0.61993180902, C: 130969.20667296558},
+		{A: 1e-11, B: 5.64166202504, C: 81706.28436968799},
+		{A: 1e-11, B: 1.28449131177, C: 27154.3986187642},
+		{A: 1e-11, B: 0.20716724934, C: 131548.89425996438},
+		{A: 1e-11, B: 5.01586042695, C: 105460.99111839019},
+		{A: 1e-11, B: 2.2014213773, C: 104371.28232719658},
+		{A: 1e-11, B: 0.7154790308, C: 103925.01437542078},
+		{A: 1e-11, B: 1.69298852699, C: 71025.0338331226},
+		{A: 2e-11, B: 1.01857337375, C: 93028.94846799239},
+		{A: 2e-11, B: 0.99564225222, C: 129909.82474277639},
+		{A: 1e-11, B: 3.04953117213, C: 26941.0995233262},
+		{A: 1e-11, B: 1.54774252742, C: 104564.91166173479},
+		{A: 1e-11, B: 1.29736654025, C: 26241.681952059},
+		{A: 2e-11, B: 0.39879309478, C: 104355.49390165479},
+		{A: 1e-11, B: 1.73442408485, C: 64607.84893354619},
+		{A: 1e-11, B: 3.52887677711, C: 97580.90196505119},
+		{A: 1e-11, B: 3.55488474055, C: 84546.78527471398},
+		{A: 1e-11, B: 2.68991734615, C: 59414.4818747484},
+		{A: 1e-11, B: 2.23301107736, C: 107794.1875112622},
+		{A: 1e-11, B: 6.04185199127, C: 104344.49901929598},
+		{A: 1e-11, B: 2.33178230942, C: 108903.56606335558},
+		{A: 1e-11, B: 5.23291332097, C: 134991.4692049298},
+		{A: 1e-11, B: 5.42420842294, C: 79852.78232000639},
+		{A: 1e-11, B: 5.56485897392, C: 104358.72611329758},
+		{A: 1e-11, B: 2.03042058117, C: 76144.94556434419},
+		{A: 1e-11, B: 4.42403586918, C: 25021.4076643842},
+		{A: 1e-11, B: 4.07362401058, C: 181555.94006083018},
+		{A: 1e-11, B: 4.39544806298, C: 131498.89763806018},
+		{A: 1e-11, B: 3.37653548744, C: 22759.76748529401},
+		{A: 1e-11, B: 3.34598726101, C: 24864.08530079559},
+		{A: 1e-11, B: 0.81949463309, C: 52815.7035694624},
+		{A: 1e-11, B: 4.13026377404, C: 2648.454825473},
+		{A: 1e-11, B: 1.4070114617, C: 103285.11708910679},
+		{A: 1e-11, B: 2.72338212355, C: 419.4846438752},
+		{A: 1e-11, B: 2.8623972993, C: 63498.47038145279},
+		{A: 1e-11, B: 4.60118276053, C: 19804.8272915828},
+		{A: 1e-11, B: 3.76488909896, C: 51749.20809227239},
+		{A: 1e-11, B: 5.71167880995, C: 78378.1487134078},
+		{A: 1e-11, B: 3.11068504003, C: 54294.57014352679},
+		{A: 1e-11, B: 5.17191010522, C: 1596.1864422846},
+		{A: 1e-11, B: 0.84666659977, C: 26037.90651966999},
+		{A: 1e-11, B: 1.27214241387, C: 39743.7636327506},
+		{A: 1e-11, B: 3.62572271343, C: 51756.3216392732},
+		{A: 1e-11, B: 0.99401491673, C: 50579.61984086379},
+		{A: 1e-11, B: 2.65940437112, C: 79219.3091663312},
+		{A: 1e-11, B: 2.78122688424, C: 157636.79740153858},
+		{A: 1e-11, B: 5.02865728622, C: 365230.6439820388},
+		{A: 1e-11, B: 4.26629771518, C: 26724.8994135984},
+		{A: 1e-11, B: 0.69459147389, C: 90829.86112470538},
+		{A: 1e-11, B: 3.74834736873, C: 44295.7171298094},
+		{A: 1e-11, B: 2.67181600104, C: 5327.4761083828},
+		{A: 1e-11, B: 5.10769130141, C: 58946.51688439399},
+		{A: 1e-11, B: 0.55503171074, C: 69159.80243060499},
+		{A: 1e-11, B: 4.68391610322, C: 90695.75207512038},
+		{A: 1e-11, B: 5.17372763616, C: 149.5631971346},
+		{A: 1e-11, B: 5.26044215633, C: 25934.1243310894},
+		{A: 1e-11, B: 5.13679156205, C: 104347.73123093879},
+		{A: 1e-11, B: 0.91197645609, C: 103299.34418310839},
+		{A: 1e-11, B: 5.89466975855, C: 27311.72098235281},
+		{A: 1e-11, B: 0.91206378451, C: 77829.99768684579},
+		{A: 1e-11, B: 0.82686052448, C: 146314.13330323418},
+		{A: 1e-11, B: 5.01041490382, C: 25450.90686955},
+		{A: 1e-11, B: 0.74891931364, C: 10021.8372800994},
+		{A: 1e-11, B: 3.75910629606, C: 25619.9381512198},
+		{A: 1e-11, B: 1.57855828108, C: 61279.713277266},
+		{A: 1e-11, B: 5.22196769252, C: 77623.81213840858},
+		{A: 1e-11, B: 5.4428033736, C: 52643.7712735028},
+		{A: 1e-11, B: 3.3876046343, C: 49842.60989027639},
+		{A: 1e-11, B: 4.04176416141, C: 25565.3257234804},
+		{A: 1e-11, B: 1.99169557218, C: 105940.68546158058},
+		{A: 1e-11, B: 2.40126368211, C: 26555.8681319286},
+		{A: 1e-11, B: 3.28153684541, C: 78149.27013603736},
+		{A: 1e-11, B: 1.50606534242, C: 50593.84693486539},
+		{A: 1e-11, B: 4.99390846744, C: 143961.2671494624},
+		{A: 1e-11, B: 4.36279289081, C: 70269.18098269838},
+		{A: 1e-11, B: 0.5633044687, C: 29428.515568274},
+		{A: 1e-11, B: 1.96827343473, C: 188276.6540401716},
+		{A: 1e-11, B: 6.1402409335, C: 3328.13565628019},
+		{A: 1e-11, B: 4.38278455115, C: 52329.58509363319},
+		{A: 1e-11, B: 5.32677211586, C: 26137.8997634784},
+		{A: 1e-11, B: 5.49001686286, C: 7880.08915333899},
+		{A: 1e-11, B: 2.08096369099, C: 26610.48055966799},
+		{A: 1e-11, B: 2.81808749734, C: 54509.0026760204},
+		{A: 1e-11, B: 3.85620334673, C: 26624.70765366959},
+		{A: 1e-11, B: 1.15157832957, C: 536.8045120954},
+		{A: 1e-11, B: 3.62974919098, C: 130012.91751699499},
+		{A: 1e-11, B: 0.3597788856, C: 105307.21230790539},
+		{A: 1e-11, B: 2.75970257539, C: 55503.94193942859},
+		{A: 1e-11, B: 3.95087871071, C: 119116.85160956658},
+		{A: 1e-11, B: 2.02746284465, C: 85034.42002596818},
+		{A: 1e-11, B: 0.01631363612, C: 25668.418497699},
+		{A: 1e-11, B: 4.69497491223, C: 1581.959348283},
+		{A: 1e-11, B: 4.43589881789, C: 53242.3017603384},
+		{A: 1e-11, B: 0.02851648548, C: 213.299095438},
+		{A: 1e-11, B: 2.42155941603, C: 25551.09862947879},
+		{A: 1e-11, B: 4.51335885923, C: 12546.481939083},
+		{A: 1e-11, B: 3.0701219029, C: 104138.31347085879},
+		{A: 1e-11, B: 4.25105785914, C: 65831.6667743248},
+		{A: 1e-11, B: 0.26591217377, C: 89586.37352302698},
+		{A: 1e-11, B: 3.25491459524, C: 46848.3301747656},
+		{A: 1e-11, B: 5.00501348827, C: 51639.00177105299},
+		{A: 0, B: 5.50334834007, C: 124156.43985787958},
+		{A: 0, B: 4.83101429854, C: 76.2660712756},
+		{A: 0, B: 4.34030366776, C: 80482.46652890938},
+		{A: 1e-11, B: 3.55161443787, C: 116917.76426627958},
+		{A: 0, B: 4.96302997387, C: 26521.614879451},
+		{A: 1e-11, B: 0.12176395772, C: 6681.2248533996},
+		{A: 1e-11, B: 0.06932929209, C: 48847.6706268682},
+		{A: 1e-11, B: 0.08695556653, C: 26507.38778544939},
+		{A: 0, B: 1.50601747714, C: 632.7837393132},
+		{A: 0, B: 3.68453733674, C: 25035.6347583858},
+		{A: 0, B: 2.10077795936, C: 86143.79857806159},
+		{A: 0, B: 2.61352703953, C: 53399.624123927},
+		{A: 0, B: 0.84481589887, C: 25881.717593137},
+		{A: 0, B: 3.95854005744, C: 95247.70557217918},
+		{A: 1e-11, B: 4.47600641528, C: 97112.93697469679},
+		{A: 1e-11, B: 0.40812953108, C: 77844.22478084739},
+		{A: 0, B: 4.89912517892, C: 25984.8103673556},
+		{A: 0, B: 5.16843610094, C: 52698.38370124219},
+		{A: 0, B: 3.99449121788, C: 131395.11544947958},
+		{A: 0, B: 5.65446547429, C: 80596.9058175946},
+	},
+	// R3, 119 terms
+	{
+		{A: 3.2676e-07, B: 1.67971635359, C: 26087.9031415742},
+		{A: 2.4166e-07, B: 4.63403168997, C: 52175.8062831484},
+		{A: 1.2133e-07, B: 1.38983781545, C: 78263.70942472259},
+		{A: 5.14e-08, B: 4.4391538693, C: 104351.61256629678},
+		{A: 1.981e-08, B: 1.20733880274, C: 130439.51570787099},
+		{A: 1.46e-08, B: 3.14159265359, C: 0},
+		{A: 7.19e-09, B: 4.25913631362, C: 156527.41884944518},
+		{A: 2.5e-09, B: 1.02794425848, C: 182615.3219910194},
+		{A: 8.4e-10, B: 4.08008618813, C: 208703.2251325936},
+		{A: 2.8e-10, B: 0.85051616237, C: 234791.12827416777},
+		{A: 2.3e-10, B: 1.60029451014, C: 24978.5245894808},
+		{A: 1.1e-10, B: 4.58074493182, C: 27197.2816936676},
+		{A: 1.2e-10, B: 4.6587866039, C: 51066.427731055},
+		{A: 9e-11, B: 3.90622332667, C: 260879.03141574195},
+		{A: 5e-11, B: 1.43247327249, C: 77154.33087262919},
+		{A: 4e-11, B: 1.37106678794, C: 53285.1848352418},
+		{A: 3e-11, B: 0.67217979275, C: 286966.9345573162},
+		{A: 2e-11, B: 5.31135121818, C: 20426.571092422},
+		{A: 2e-11, B: 1.54812921017, C: 1109.3785520934},
+		{A: 2e-11, B: 4.48222146022, C: 103242.2340142034},
+		{A: 2e-11, B: 0.20057520755, C: 1059.3819301892},
+		{A: 2e-11, B: 4.21865197056, C: 25132.3033999656},
+		{A: 1e-11, B: 2.11997334018, C: 46514.4742339962},
+		{A: 1e-11, B: 3.24267833975, C: 27147.28507176339},
+		{A: 1e-11, B: 1.33238537892, C: 1589.0728952838},
+		{A: 1e-11, B: 5.14405498793, C: 72602.37737557039},
+		{A: 1e-11, B: 6.14595652541, C: 26617.5941066688},
+		{A: 1e-11, B: 3.90494988936, C: 4551.9534970588},
+		{A: 1e-11, B: 2.85134614467, C: 14765.2390432698},
+		{A: 1e-11, B: 3.74339959279, C: 313054.83769889036},
+		{A: 1e-11, B: 2.82234410581, C: 52705.49724824299},
+		{A: 1e-11, B: 1.70132625273, C: 23869.1460373874},
+		{A: 1e-11, B: 1.4410244446, C: 27043.5028831828},
+		{A: 1e-11, B: 1.2535842334, C: 129330.13715577759},
+		{A: 1e-11, B: 0.32054002273, C: 9103.9069941176},
+		{A: 1e-11, B: 0.90273607509, C: 51220.20654153979},
+		{A: 1e-11, B: 4.28935605425, C: 27676.976036858},
+		{A: 1e-11, B: 4.41206223998, C: 79373.087976816},
+		{A: 1e-11, B: 3.23518929162, C: 26301.2022370122},
+		{A: 1e-11, B: 2.25667255668, C: 21535.9496445154},
+		{A: 1e-11, B: 4.70414203873, C: 50586.73338786459},
+		{A: 1e-11, B: 1.88608259832, C: 98690.28051714458},
+		{A: 1e-11, B: 5.90420893056, C: 40853.142184844},
+		{A: 1e-11, B: 4.46892853356, C: 53131.406024757},
+		{A: 1e-11, B: 3.83684899837, C: 77308.10968311399},
+		{A: 1e-11, B: 1.31974603498, C: 54394.56338733519},
+		{A: 1e-11, B: 0.74728939443, C: 32858.61374281979},
+		{A: 1e-11, B: 6.27673970277, C: 53235.18821333759},
+		{A: 1e-11, B: 4.1481457861, C: 29530.4780865396},
+		{A: 1e-11, B: 0.87302135775, C: 83925.0414738748},
+		{A: 1e-11, B: 5.20339566427, C: 26068.2333806744},
+		{A: 1e-11, B: 2.88631079597, C: 79323.09135491178},
+		{A: 1e-11, B: 5.39853672849, C: 19317.1925403286},
+		{A: 1e-11, B: 5.73721596586, C: 78793.40038981718},
+		{A: 0, B: 1.85241611022, C: 25973.46385288896},
+		{A: 0, B: 4.31134434847, C: 26202.34243025941},
+		{A: 0, B: 4.7430520578, C: 49957.0491789616},
+		{A: 1e-11, B: 3.87802524387, C: 82815.66292178139},
+		{A: 0, B: 4.28937691652, C: 155418.04029735178},
+		{A: 1e-11, B: 3.35625397389, C: 37410.5672398786},
+		{A: 0, B: 2.00313301846, C: 22645.32819660879},
+		{A: 0, B: 2.49236829957, C: 25028.521211385},
+		{A: 0, B: 0.01947787727, C: 25558.2121764796},
+		{A: 1e-11, B: 4.13825019881, C: 3442.5749449654},
+		{A: 0, B: 2.81832388305, C: 25874.6040461362},
+		{A: 0, B: 2.53416253042, C: 12566.1516999828},
+		{A: 1e-11, B: 4.46169102731, C: 28306.66024576099},
+		{A: 1e-11, B: 2.16986592809, C: 45405.0956819028},
+		{A: 0, B: 2.27621739263, C: 26091.7844769322},
+		{A: 0, B: 1.95034764539, C: 52156.1365222486},
+		{A: 0, B: 4.03054078678, C: 30639.856638633},
+		{A: 0, B: 5.76722418743, C: 43071.8992890308},
+		{A: 0, B: 4.12739704581, C: 26107.57290247399},
+		{A: 0, B: 1.37713138665, C: 31749.2351907264},
+		{A: 1e-11, B: 3.54716896556, C: 51646.11531805379},
+		{A: 0, B: 3.3916159585, C: 36301.18868778519},
+		{A: 0, B: 4.34410863436, C: 57837.1383323006},
+		{A: 0, B: 5.6208296069, C: 639.897286314},
+		{A: 0, B: 3.16268062826, C: 529.6909650946},
+		{A: 0, B: 0.77186843357, C: 26084.0218062162},
+		{A: 0, B: 3.78612072314, C: 110012.94461544899},
+		{A: 0, B: 5.50453358586, C: 51116.4243529592},
+		{A: 0, B: 0.0470913142, C: 52389.1053785864},
+		{A: 0, B: 3.93991894858, C: 6770.7106012456},
+		{A: 0, B: 1.41961180167, C: 76674.63652943878},
+		{A: 0, B: 2.61258742182, C: 66941.04532641819},
+		{A: 0, B: 0.70544164813, C: 426.598190876},
+		{A: 0, B: 0.91883551986, C: 55618.3812281138},
+		{A: 0, B: 5.30612883135, C: 52179.6876185064},
+		{A: 0, B: 2.00604152518, C: 2118.7638603784},
+		{A: 0, B: 1.08640210817, C: 56727.7597802072},
+		{A: 0, B: 3.92743267498, C: 58946.51688439399},
+		{A: 0, B: 5.52202827702, C: 38654.05484155699},
+		{A: 0, B: 1.57363600114, C: 24498.8302462904},
+		{A: 0, B: 3.49522546958, C: 5661.3320491522},
+		{A: 0, B: 0.63375195581, C: 51535.90899683439},
+		{A: 0, B: 1.34305878069, C: 105460.99111839019},
+		{A: 0, B: 0.44820055565, C: 339142.7408404646},
+		{A: 0, B: 1.39951362362, C: 2218.7571041868},
+		{A: 0, B: 0.63311095079, C: 18849.2275499742},
+		{A: 0, B: 0.82120127326, C: 52195.47604404819},
+		{A: 0, B: 2.78211363987, C: 47623.8527860896},
+		{A: 0, B: 0.94752126944, C: 53764.8791784322},
+		{A: 0, B: 3.75988526858, C: 52171.9249477904},
+		{A: 0, B: 0.4435143591, C: 136100.84775702318},
+		{A: 0, B: 1.44824925227, C: 76044.9523205358},
+		{A: 0, B: 4.83985082201, C: 78244.0396638228},
+		{A: 0, B: 0.04522486479, C: 62389.09182935939},
+		{A: 0, B: 6.28144869714, C: 213.299095438},
+		{A: 0, B: 5.17875557687, C: 955.5997416086},
+		{A: 0, B: 4.62674052433, C: 52168.69273614759},
+		{A: 0, B: 2.19907823066, C: 77204.32749453338},
+		{A: 0, B: 3.40216597806, C: 26514.5013324502},
+		{A: 0, B: 2.89630853457, C: 13655.8604911764},
+		{A: 0, B: 3.92639394174, C: 50057.04242277},
+		{A: 0, B: 0.27991454535, C: 11322.6640983044},
+		{A: 0, B: 5.63968341805, C: 103292.23063610759},
+		{A: 0, B: 1.86829495425, C: 48733.23133818299},
+		{A: 0, B: 5.77443179008, C: 105410.99449648599},
+	},
+	// R4, 18 terms
+	{
+		{A: 3.94e-09, B: 0.3673540384, C: 26087.9031415742},
+		{A: 3.87e-09, B: 3.18568771507, C: 52175.8062831484},
+		{A: 2.7e-09, B: 6.16983616444, C: 78263.70942472259},
+		{A: 1.49e-09, B: 2.91591904641, C: 104351.61256629678},
+		{A: 7.1e-10, B: 5.95867889641, C: 130439.51570787099},
+		{A: 3.1e-10, B: 2.72386700044, C: 156527.41884944518},
+		{A: 1.7e-10, B: 0, C: 0},
+		{A: 1.2e-10, B: 5.77775334056, C: 182615.3219910194},
+		{A: 5e-11, B: 2.54506442647, C: 208703.2251325936},
+		{A: 2e-11, B: 5.59978443851, C: 234791.12827416777},
+		{A: 1e-11, B: 2.33785160871, C: 260879.03141574195},
+		{A: 0, B: 5.37943916893, C: 286966.9345573162},
+		{A: 0, B: 3.09643524101, C: 51066.427731055},
+		{A: 0, B: 0.0934285875, C: 24978.5245894808},
+		{A: 0, B: 6.02837115675, C: 53285.1848352418},
+		{A: 0, B: 6.13600004668, C: 77154.33087262919},
+		{A: 0, B: 2.93310416266, C: 27197.2816936676},
+		{A: 0, B: 2.89897922039, C: 103242.2340142034},
+	},
+	// R5, 10 terms
+	{
+		{A: 6e-11, B: 3.98900269603, C: 26087.9031415742},
+		{A: 6e-11, B: 1.55248278782, C: 52175.8062831484},
+		{A: 5e-11, B: 4.65461721116, C: 78263.70942472259},
+		{A: 4e-11, B: 1.40238366492, C: 104351.61256629678},
+		{A: 2e-11, B: 4.44231488663, C: 130439.51570787099},
+		{A: 1e-11, B: 3.14159265359, C: 0},
+		{A: 1e-11, B: 1.21278177664, C: 156527.41884944518},
+		{A: 1e-11, B: 4.2614902634, C: 182615.3219910194},
+		{A: 0, B: 0.99831133595, C: 208703.2251325936},
+		{A: 0, B: 4.0026706421, C: 234791.12827416777},
+	},
+}
+
+// GetMercuryR0 evaluates the t^0 series of R.
+func GetMercuryR0(t float64) float64 {
+	return mercuryR[0].Evaluate(t)
+}
+
+// GetMercuryR1 evaluates the t^1 series of R.
+func GetMercuryR1(t float64) float64 {
+	return mercuryR[1].Evaluate(t)
+}
+
+// GetMercuryR2 evaluates the t^2 series of R.
+func GetMercuryR2(t float64) float64 {
+	return mercuryR[2].Evaluate(t)
+}
+
+// GetMercuryR3 evaluates the t^3 series of R.
+func GetMercuryR3(t float64) float64 {
+	return mercuryR[3].Evaluate(t)
+}
+
+// GetMercuryR4 evaluates the t^4 series of R.
+func GetMercuryR4(t float64) float64 {
+	return mercuryR[4].Evaluate(t)
+}
+
+// GetMercuryR5 evaluates the t^5 series of R.
+func GetMercuryR5(t float64) float64 {
+	return mercuryR[5].Evaluate(t)
+}
@@ -0,0 +1,62 @@
+package vsop87neptuned
+
+import (
+    "calendarutil"
+    "mathutil"
+)
+
+/**
+ * 按儒略日计算海王星的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 海王星的日心黄经，单位是弧度(rad)
+ */
+func GetSunEclipticLongitudeForNeptune(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    L0 := GetNeptuneL0(t)
+    L1 := GetNeptuneL1(t)
+    L2 := GetNeptuneL2(t)
+    L3 := GetNeptuneL3(t)
+    L4 := GetNeptuneL4(t)
+    L5 := GetNeptuneL5(t)
+    L := ((((L5 * t + L4) * t + L3) * t + L2) * t + L1) * t + L0
+    return mathutil.Mod2Pi(L)
+}
+
+/**
+ * 按儒略日计算海王星的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 海王星的日心黄纬，单位是弧度(rad)
+ */
+func GetSunEclipticLatitudeForNeptune(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    B0 := GetNeptuneB0(t)
+    B1 := GetNeptuneB1(t)
+    B2 := GetNeptuneB2(t)
+    B3 := GetNeptuneB3(t)
+    B4 := GetNeptuneB4(t)
+    B5 := GetNeptuneB5(t)
+    B := ((((B5 * t + B4) * t + B3) * t + B2) * t + B1) * t + B0
+    return B
+}
+
+/**
+ * 按照儒略日计算海王星和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 海王星和太阳的距离，单位是天文单位(au)
+ */
+func GetSunRadiusForNeptune(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    R0 := GetNeptuneR0(t)
+    R1 := GetNeptuneR1(t)
+    R2 := GetNeptuneR2(t)
+    R3 := GetNeptuneR3(t)
+    R4 := GetNeptuneR4(t)
+    R := (((R4 * t + R3) * t + R2) * t + R1) * t + R0
+    return R
+}
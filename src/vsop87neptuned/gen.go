@@ -0,0 +1,3 @@
+package vsop87neptuned
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Neptune -package vsop87neptuned
@@ -0,0 +1,349 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// NEPTUNE B - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87neptuned
+
+import "vsop87"
+
+var neptuneB = []vsop87.Series{
+	// B0, 172 terms
+	{
+		{A: 0.03088622933, B: 1.44104372626, C: 38.1330356378},
+		{A: 0.00027780087, B: 5.91271882843, C: 76.2660712756},
+		{A: 0.00027623609, B: 0, C: 0},
+		{A: 0.0001535549, B: 2.52123799481, C: 36.6485629295},
+		{A: 0.00015448133, B: 3.50877080888, C: 39.6175083461},
+		{A: 1.999919e-05, B: 1.50998669505, C: 74.7815985673},
+		{A: 1.96754e-05, B: 4.37778195768, C: 1.4844727083},
+		{A: 1.015137e-05, B: 3.21561035875, C: 35.1640902212},
+		{A: 6.05767e-06, B: 2.80246601405, C: 73.297125859},
+		{A: 5.94878e-06, B: 2.12892708114, C: 41.1019810544},
+		{A: 5.88805e-06, B: 3.18655882497, C: 2.9689454166},
+		{A: 4.0183e-06, B: 4.16883287237, C: 114.3991069134},
+		{A: 2.54333e-06, B: 3.27120499438, C: 453.424893819},
+		{A: 2.61647e-06, B: 3.76722704749, C: 213.299095438},
+		{A: 2.79964e-06, B: 1.68165309699, C: 77.7505439839},
+		{A: 2.0559e-06, B: 4.25652348864, C: 529.6909650946},
+		{A: 1.40455e-06, B: 3.52969556376, C: 137.0330241624},
+		{A: 9.853e-07, B: 4.16774829927, C: 33.6796175129},
+		{A: 5.1257e-07, B: 1.95121181203, C: 4.4534181249},
+		{A: 6.7971e-07, B: 4.66970781659, C: 71.8126531507},
+		{A: 4.1931e-07, B: 5.41783694467, C: 111.4301614968},
+		{A: 4.1822e-07, B: 5.94832001477, C: 112.9146342051},
+		{A: 3.0637e-07, B: 0.93620571932, C: 42.5864537627},
+		{A: 1.1084e-07, B: 5.88898793049, C: 108.4612160802},
+		{A: 9.62e-08, B: 0.03944255108, C: 70.3281804424},
+		{A: 9.664e-08, B: 0.22455797403, C: 79.2350166922},
+		{A: 9.728e-08, B: 5.30069593532, C: 32.1951448046},
+		{A: 7.386e-08, B: 3.00684933642, C: 426.598190876},
+		{A: 7.087e-08, B: 0.12535040656, C: 109.9456887885},
+		{A: 6.021e-08, B: 6.20514068152, C: 115.8835796217},
+		{A: 6.169e-08, B: 3.62098109648, C: 983.1158589136},
+		{A: 4.777e-08, B: 0.75210194972, C: 5.9378908332},
+		{A: 6.391e-08, B: 5.8464610106, C: 148.0787244263},
+		{A: 6.251e-08, B: 2.41678769385, C: 152.5321425512},
+		{A: 4.539e-08, B: 5.581820987, C: 175.1660598002},
+		{A: 5.006e-08, B: 4.60815664851, C: 1059.3819301892},
+		{A: 4.289e-08, B: 4.19647392821, C: 47.6942631934},
+		{A: 5.795e-08, B: 5.07516716087, C: 415.2918581812},
+		{A: 4.749e-08, B: 2.51605725604, C: 37.611770776},
+		{A: 4.119e-08, B: 1.72779509865, C: 28.5718080822},
+		{A: 4.076e-08, B: 6.00252170354, C: 145.1097790097},
+		{A: 4.429e-08, B: 5.65995321659, C: 98.8999885246},
+		{A: 3.95e-08, B: 2.74104636753, C: 350.3321196004},
+		{A: 4.091e-08, B: 1.61787956945, C: 39.0962434843},
+		{A: 4.131e-08, B: 4.40682554313, C: 37.1698277913},
+		{A: 4.71e-08, B: 3.50929350767, C: 38.6543004996},
+		{A: 4.44e-08, B: 4.78977105547, C: 38.084851528},
+		{A: 4.433e-08, B: 1.23386935925, C: 38.1812197476},
+		{A: 3.762e-08, B: 4.83940791709, C: 491.5579294568},
+		{A: 2.606e-08, B: 1.20956732792, C: 451.9404211107},
+		{A: 2.537e-08, B: 2.18628045751, C: 454.9093665273},
+		{A: 2.328e-08, B: 5.19779918719, C: 72.0732855816},
+		{A: 2.502e-08, B: 0.8598790435, C: 106.9767433719},
+		{A: 2.342e-08, B: 0.81387240947, C: 4.192785694},
+		{A: 1.981e-08, B: 0.46617960831, C: 184.7272873558},
+		{A: 1.963e-08, B: 6.01909114576, C: 44.070926471},
+		{A: 2.18e-08, B: 0.70099749844, C: 206.1855484372},
+		{A: 1.811e-08, B: 0.40456996647, C: 40.5807161926},
+		{A: 1.814e-08, B: 3.64699555185, C: 220.4126424388},
+		{A: 1.705e-08, B: 6.13551142362, C: 181.7583419392},
+		{A: 1.855e-08, B: 5.61635630213, C: 35.685355083},
+		{A: 1.595e-08, B: 2.97147156093, C: 37.8724032069},
+		{A: 1.785e-08, B: 2.42154818096, C: 388.4651552382},
+		{A: 1.595e-08, B: 3.05266110075, C: 38.3936680687},
+		{A: 1.437e-08, B: 1.48678704605, C: 135.5485514541},
+		{A: 1.387e-08, B: 2.46149266117, C: 138.5174968707},
+		{A: 1.366e-08, B: 1.52026779665, C: 68.8437077341},
+		{A: 1.575e-08, B: 3.58964541604, C: 38.0211610532},
+		{A: 1.297e-08, B: 5.06156596196, C: 33.9402499438},
+		{A: 1.487e-08, B: 0.20211121607, C: 30.0562807905},
+		{A: 1.504e-08, B: 5.80298577327, C: 46.2097904851},
+		{A: 1.192e-08, B: 0.87275514483, C: 42.3258213318},
+		{A: 1.569e-08, B: 2.43405967107, C: 38.2449102224},
+		{A: 1.207e-08, B: 1.84658687853, C: 251.4321310758},
+		{A: 1.015e-08, B: 0.53439848924, C: 129.9194771616},
+		{A: 9.99e-09, B: 2.47463873948, C: 312.1990839626},
+		{A: 9.9e-09, B: 3.41514319052, C: 144.1465711632},
+		{A: 9.63e-09, B: 4.31733242907, C: 151.0476698429},
+		{A: 1.02e-08, B: 0.98226686775, C: 143.6253063014},
+		{A: 9.41e-09, B: 1.02993053785, C: 221.3758502853},
+		{A: 9.38e-09, B: 2.43648356625, C: 567.8240007324},
+		{A: 1.111e-08, B: 0.65175024456, C: 146.594251718},
+		{A: 7.77e-09, B: 0.00175975222, C: 218.4069048687},
+		{A: 8.95e-09, B: 0.2512386962, C: 30.7106720963},
+		{A: 7.95e-09, B: 5.80519741659, C: 149.5631971346},
+		{A: 7.37e-09, B: 3.40060492866, C: 446.3113468182},
+		{A: 7.19e-09, B: 1.43795191278, C: 8.0767548473},
+		{A: 7.2e-09, B: 0.0065100755, C: 460.5384408198},
+		{A: 7.66e-09, B: 4.03399506246, C: 522.5774180938},
+		{A: 6.66e-09, B: 1.39457824982, C: 84.3428261229},
+		{A: 5.84e-09, B: 1.01405548136, C: 536.8045120954},
+		{A: 5.96e-09, B: 0.62390100715, C: 35.212274331},
+		{A: 5.98e-09, B: 5.39946724188, C: 41.0537969446},
+		{A: 4.75e-09, B: 5.80072248338, C: 7.4223635415},
+		{A: 5.1e-09, B: 1.3447857974, C: 258.0244132148},
+		{A: 4.58e-09, B: 5.25325523118, C: 80.7194894005},
+		{A: 4.21e-09, B: 3.24496387889, C: 416.7763308895},
+		{A: 4.46e-09, B: 1.19167306357, C: 180.2738692309},
+		{A: 4.71e-09, B: 0.92632922375, C: 44.7253177768},
+		{A: 3.87e-09, B: 1.68488418788, C: 183.2428146475},
+		{A: 3.75e-09, B: 0.15223869165, C: 255.0554677982},
+		{A: 3.54e-09, B: 4.21526988674, C: 0.9632078465},
+		{A: 3.79e-09, B: 2.16947487177, C: 105.4922706636},
+		{A: 3.41e-09, B: 4.7919405168, C: 110.2063212194},
+		{A: 4.27e-09, B: 5.15774894584, C: 31.5407534988},
+		{A: 3.02e-09, B: 3.4570630628, C: 100.3844612329},
+		{A: 2.98e-09, B: 2.26790695187, C: 639.897286314},
+		{A: 2.79e-09, B: 0.25689162963, C: 39.5056337615},
+		{A: 3.2e-09, B: 3.58085653166, C: 45.2465826386},
+		{A: 2.69e-09, B: 5.72024180826, C: 36.7604375141},
+		{A: 2.47e-09, B: 0.61040148804, C: 186.2117600641},
+		{A: 2.45e-09, B: 0.64173616273, C: 419.4846438752},
+		{A: 2.35e-09, B: 0.73189197665, C: 10213.285546211},
+		{A: 2.32e-09, B: 0.37399822852, C: 490.0734567485},
+		{A: 2.3e-09, B: 5.76570492457, C: 12.5301729722},
+		{A: 2.4e-09, B: 4.13447692727, C: 0.5212648618},
+		{A: 2.79e-09, B: 1.62614865256, C: 294.6729761443},
+		{A: 2.38e-09, B: 2.1852891655, C: 219.891377577},
+		{A: 2.62e-09, B: 3.08384135298, C: 6.592282139},
+		{A: 2.17e-09, B: 2.93214905312, C: 27.0873353739},
+		{A: 2.17e-09, B: 4.69210602828, C: 406.1031376411},
+		{A: 2.19e-09, B: 1.3521271256, C: 216.9224321604},
+		{A: 2e-09, B: 2.35215465744, C: 605.9570363702},
+		{A: 2.32e-09, B: 3.92583619589, C: 1512.8068240082},
+		{A: 2.23e-09, B: 5.52392277606, C: 187.6962327724},
+		{A: 1.9e-09, B: 0.29169556516, C: 291.7040307277},
+		{A: 2.36e-09, B: 3.12464145036, C: 563.6312150384},
+		{A: 1.93e-09, B: 0.53675942386, C: 60.7669528868},
+		{A: 2.15e-09, B: 3.78391259001, C: 103.0927742186},
+		{A: 1.72e-09, B: 5.63262770743, C: 7.1135470008},
+		{A: 1.64e-09, B: 4.14700645532, C: 77.2292791221},
+		{A: 1.62e-09, B: 0.72021213236, C: 11.0457002639},
+		{A: 1.6e-09, B: 4.23490438166, C: 487.3651437628},
+		{A: 1.91e-09, B: 0.37651439206, C: 31.019488637},
+		{A: 1.57e-09, B: 1.02419759383, C: 6283.0758499914},
+		{A: 1.57e-09, B: 4.42530429545, C: 6206.8097787158},
+		{A: 1.78e-09, B: 6.24797160202, C: 316.3918696566},
+		{A: 1.61e-09, B: 5.65988283675, C: 343.2185725996},
+		{A: 1.53e-09, B: 5.58405022784, C: 252.0865223816},
+		{A: 1.89e-09, B: 4.8079103997, C: 641.1211265914},
+		{A: 1.66e-09, B: 5.50438043692, C: 662.531203563},
+		{A: 1.46e-09, B: 5.08949604858, C: 286.596221297},
+		{A: 1.45e-09, B: 2.13015521881, C: 2042.4977891028},
+		{A: 1.56e-09, B: 2.19452173251, C: 274.0660483248},
+		{A: 1.48e-09, B: 4.85696640135, C: 442.7517005706},
+		{A: 1.87e-09, B: 4.96121139073, C: 1589.0728952838},
+		{A: 1.55e-09, B: 2.28260574227, C: 142.1408335931},
+		{A: 1.34e-09, B: 1.29277093566, C: 456.3938392356},
+		{A: 1.26e-09, B: 5.59769497652, C: 179.3588454942},
+		{A: 1.46e-09, B: 2.53359213478, C: 256.5399405065},
+		{A: 1.4e-09, B: 1.57962199954, C: 75.7448064138},
+		{A: 1.23e-09, B: 0.05442220184, C: 944.9828232758},
+		{A: 1.22e-09, B: 1.90676379802, C: 418.2608035978},
+		{A: 1.54e-09, B: 1.86865302773, C: 331.3215390738},
+		{A: 1.44e-09, B: 5.52229258454, C: 14.0146456805},
+		{A: 1.38e-09, B: 2.80728175526, C: 82.8583534146},
+		{A: 1.07e-09, B: 0.66995358132, C: 190.665178189},
+		{A: 1.14e-09, B: 1.4889498028, C: 253.5709950899},
+		{A: 1.1e-09, B: 5.32587573069, C: 240.125798381},
+		{A: 1.05e-09, B: 0.65548440578, C: 173.6815870919},
+		{A: 1.02e-09, B: 2.58735617801, C: 450.4559484024},
+		{A: 9.8e-10, B: 0.44044795266, C: 328.3525936572},
+		{A: 1.01e-09, B: 4.71267656829, C: 117.36805233},
+		{A: 9.4e-10, B: 0.54938580474, C: 293.188503436},
+		{A: 9.5e-10, B: 2.17636214523, C: 101.8689339412},
+		{A: 9.3e-10, B: 0.63687810471, C: 377.1588225434},
+		{A: 9.1e-10, B: 5.84828809934, C: 10137.0194749354},
+		{A: 8.9e-10, B: 1.02830167997, C: 1021.2488945514},
+		{A: 9.4e-10, B: 1.79320597168, C: 493.0424021651},
+		{A: 8e-10, B: 1.58140274465, C: 69.1525242748},
+		{A: 7.5e-10, B: 0.23453373368, C: 63.7358983034},
+		{A: 7.1e-10, B: 1.5196198969, C: 488.5889840402},
+	},
+	// B1, 82 terms
+	{
+		{A: 0.00227279214, B: 3.8079308987, C: 38.1330356378},
+		{A: 1.80312e-05, B: 1.97576485377, C: 76.2660712756},
+		{A: 1.385733e-05, B: 4.82555548018, C: 36.6485629295},
+		{A: 1.4333e-05, B: 3.14159265359, C: 0},
+		{A: 1.073298e-05, B: 6.08054240712, C: 39.6175083461},
+		{A: 1.47903e-06, B: 3.85766231348, C: 74.7815985673},
+		{A: 1.36448e-06, B: 0.47764957338, C: 1.4844727083},
+		{A: 7.0285e-07, B: 6.18782052139, C: 35.1640902212},
+		{A: 5.1899e-07, B: 5.05221791891, C: 73.297125859},
+		{A: 3.7273e-07, B: 4.89476629246, C: 41.1019810544},
+		{A: 4.2568e-07, B: 0.30721737205, C: 114.3991069134},
+		{A: 3.7104e-07, B: 5.75999349109, C: 2.9689454166},
+		{A: 2.6399e-07, B: 5.21566335936, C: 213.299095438},
+		{A: 1.6949e-07, B: 4.26463671859, C: 77.7505439839},
+		{A: 1.8747e-07, B: 0.90426522185, C: 453.424893819},
+		{A: 1.2951e-07, B: 6.17709713139, C: 529.6909650946},
+		{A: 1.0502e-07, B: 1.20336443465, C: 137.0330241624},
+		{A: 4.416e-08, B: 1.25478204684, C: 111.4301614968},
+		{A: 4.383e-08, B: 6.14147099615, C: 71.8126531507},
+		{A: 3.694e-08, B: 0.94837702528, C: 33.6796175129},
+		{A: 2.957e-08, B: 4.7753287121, C: 4.4534181249},
+		{A: 2.698e-08, B: 1.92435531119, C: 112.9146342051},
+		{A: 1.989e-08, B: 3.96637567224, C: 42.5864537627},
+		{A: 1.15e-08, B: 4.30568700024, C: 37.611770776},
+		{A: 8.71e-09, B: 4.81775882249, C: 152.5321425512},
+		{A: 9.44e-09, B: 2.2177777205, C: 109.9456887885},
+		{A: 9.36e-09, B: 1.1705498394, C: 148.0787244263},
+		{A: 9.25e-09, B: 2.40329074, C: 206.1855484372},
+		{A: 6.9e-09, B: 1.57381082857, C: 38.6543004996},
+		{A: 6.24e-09, B: 2.79466003645, C: 79.2350166922},
+		{A: 7.26e-09, B: 4.13829519132, C: 28.5718080822},
+		{A: 6.4e-09, B: 2.46161252327, C: 115.8835796217},
+		{A: 5.31e-09, B: 2.969915305, C: 98.8999885246},
+		{A: 5.37e-09, B: 1.95986772922, C: 220.4126424388},
+		{A: 5.39e-09, B: 2.06690307827, C: 40.5807161926},
+		{A: 7.16e-09, B: 0.5578184701, C: 350.3321196004},
+		{A: 5.63e-09, B: 1.84072805158, C: 983.1158589136},
+		{A: 5.33e-09, B: 1.3478767794, C: 47.6942631934},
+		{A: 5.66e-09, B: 1.80111775954, C: 175.1660598002},
+		{A: 4.49e-09, B: 1.62191691011, C: 144.1465711632},
+		{A: 3.71e-09, B: 2.74239666472, C: 415.2918581812},
+		{A: 3.81e-09, B: 6.11910193382, C: 426.598190876},
+		{A: 3.66e-09, B: 2.3975258536, C: 129.9194771616},
+		{A: 4.56e-09, B: 3.19611413854, C: 108.4612160802},
+		{A: 3.27e-09, B: 3.62341506247, C: 38.1812197476},
+		{A: 3.28e-09, B: 0.89613145346, C: 38.084851528},
+		{A: 3.41e-09, B: 3.8726546907, C: 35.685355083},
+		{A: 3.31e-09, B: 4.48858774501, C: 460.5384408198},
+		{A: 4.14e-09, B: 1.03543720726, C: 70.3281804424},
+		{A: 3.1e-09, B: 0.51297445145, C: 37.1698277913},
+		{A: 2.87e-09, B: 2.183516518, C: 491.5579294568},
+		{A: 2.74e-09, B: 6.11504724934, C: 522.5774180938},
+		{A: 2.81e-09, B: 3.81657117512, C: 5.9378908332},
+		{A: 2.98e-09, B: 4.00532631258, C: 39.0962434843},
+		{A: 2.65e-09, B: 5.26569823181, C: 446.3113468182},
+		{A: 3.19e-09, B: 1.34097217817, C: 184.7272873558},
+		{A: 2.03e-09, B: 6.02944475303, C: 149.5631971346},
+		{A: 2.05e-09, B: 5.5393573202, C: 536.8045120954},
+		{A: 2.26e-09, B: 6.17710997862, C: 454.9093665273},
+		{A: 1.86e-09, B: 3.24302117645, C: 4.192785694},
+		{A: 1.79e-09, B: 4.91458426239, C: 451.9404211107},
+		{A: 1.98e-09, B: 2.3077585288, C: 146.594251718},
+		{A: 1.66e-09, B: 1.16793600058, C: 72.0732855816},
+		{A: 1.47e-09, B: 2.10574339673, C: 44.070926471},
+		{A: 1.23e-09, B: 1.98250467171, C: 46.2097904851},
+		{A: 1.59e-09, B: 3.46955908364, C: 145.1097790097},
+		{A: 1.16e-09, B: 5.8897111359, C: 38.0211610532},
+		{A: 1.15e-09, B: 4.73412534395, C: 38.2449102224},
+		{A: 1.25e-09, B: 3.42713474801, C: 251.4321310758},
+		{A: 1.28e-09, B: 1.51108932026, C: 221.3758502853},
+		{A: 1.27e-09, B: 0.17176461812, C: 138.5174968707},
+		{A: 1.24e-09, B: 5.85160407534, C: 1059.3819301892},
+		{A: 9.1e-10, B: 2.38273591235, C: 30.0562807905},
+		{A: 1.18e-09, B: 5.27114846878, C: 37.8724032069},
+		{A: 1.17e-09, B: 5.35267669439, C: 38.3936680687},
+		{A: 9.9e-10, B: 5.19920708255, C: 135.5485514541},
+		{A: 1.14e-09, B: 4.37452353441, C: 388.4651552382},
+		{A: 9.3e-10, B: 4.64183693718, C: 106.9767433719},
+		{A: 8.4e-10, B: 1.35269684746, C: 33.9402499438},
+		{A: 1.11e-09, B: 3.5622646377, C: 181.7583419392},
+		{A: 8.2e-10, B: 3.18401661435, C: 42.3258213318},
+		{A: 8.4e-10, B: 5.51669920239, C: 8.0767548473},
+	},
+	// B2, 25 terms
+	{
+		{A: 9.690766e-05, B: 5.57123750291, C: 38.1330356378},
+		{A: 7.8815e-07, B: 3.62705474219, C: 76.2660712756},
+		{A: 7.1523e-07, B: 0.4547668858, C: 36.6485629295},
+		{A: 5.8646e-07, B: 3.14159265359, C: 0},
+		{A: 2.9915e-07, B: 1.60671721861, C: 39.6175083461},
+		{A: 6.472e-08, B: 5.60736756575, C: 74.7815985673},
+		{A: 5.8e-08, B: 2.25341847151, C: 1.4844727083},
+		{A: 4.309e-08, B: 1.68126737666, C: 35.1640902212},
+		{A: 3.502e-08, B: 2.39142672984, C: 114.3991069134},
+		{A: 2.649e-08, B: 0.65061457644, C: 73.297125859},
+		{A: 1.518e-08, B: 0.37600329684, C: 213.299095438},
+		{A: 1.223e-08, B: 1.2311604303, C: 2.9689454166},
+		{A: 7.66e-09, B: 5.45279753249, C: 453.424893819},
+		{A: 7.79e-09, B: 2.07081431472, C: 529.6909650946},
+		{A: 4.96e-09, B: 0.26552533921, C: 41.1019810544},
+		{A: 4.69e-09, B: 5.87866293959, C: 77.7505439839},
+		{A: 4.82e-09, B: 5.63056237954, C: 137.0330241624},
+		{A: 3.45e-09, B: 1.80085651594, C: 71.8126531507},
+		{A: 2.74e-09, B: 2.86650141006, C: 33.6796175129},
+		{A: 1.58e-09, B: 4.63868656467, C: 206.1855484372},
+		{A: 1.66e-09, B: 1.24877330835, C: 220.4126424388},
+		{A: 1.53e-09, B: 2.87376446497, C: 111.4301614968},
+		{A: 1.16e-09, B: 3.63838544843, C: 112.9146342051},
+		{A: 8.5e-10, B: 0.43712705655, C: 4.4534181249},
+		{A: 1.04e-09, B: 6.12597614674, C: 144.1465711632},
+	},
+	// B3, 9 terms
+	{
+		{A: 2.73423e-06, B: 1.01688979072, C: 38.1330356378},
+		{A: 2.274e-08, B: 2.36805657126, C: 36.6485629295},
+		{A: 2.029e-08, B: 5.33364321342, C: 76.2660712756},
+		{A: 2.393e-08, B: 0, C: 0},
+		{A: 5.38e-09, B: 3.21934211365, C: 39.6175083461},
+		{A: 2.42e-09, B: 4.52650721578, C: 114.3991069134},
+		{A: 1.85e-09, B: 1.04913770083, C: 74.7815985673},
+		{A: 1.55e-09, B: 3.62376309338, C: 35.1640902212},
+		{A: 1.57e-09, B: 3.9419536961, C: 1.4844727083},
+	},
+	// B4, 1 terms
+	{
+		{A: 5.728e-08, B: 2.66872693322, C: 38.1330356378},
+	},
+	// B5, 1 terms
+	{
+		{A: 1.13e-09, B: 4.70646877989, C: 38.1330356378},
+	},
+}
+
+// GetNeptuneB0 evaluates the t^0 series of B.
+func GetNeptuneB0(t float64) float64 {
+	return neptuneB[0].Evaluate(t)
+}
+
+// GetNeptuneB1 evaluates the t^1 series of B.
+func GetNeptuneB1(t float64) float64 {
+	return neptuneB[1].Evaluate(t)
+}
+
+// GetNeptuneB2 evaluates the t^2 series of B.
+func GetNeptuneB2(t float64) float64 {
+	return neptuneB[2].Evaluate(t)
+}
+
+// GetNeptuneB3 evaluates the t^3 series of B.
+func GetNeptuneB3(t float64) float64 {
+	return neptuneB[3].Evaluate(t)
+}
+
+// GetNeptuneB4 evaluates the t^4 series of B.
+func GetNeptuneB4(t float64) float64 {
+	return neptuneB[4].Evaluate(t)
+}
+
+// GetNeptuneB5 evaluates the t^5 series of B.
+func GetNeptuneB5(t float64) float64 {
+	return neptuneB[5].Evaluate(t)
+}
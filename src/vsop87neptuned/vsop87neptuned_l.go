@@ -0,0 +1,740 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// NEPTUNE L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87neptuned
+
+import "vsop87"
+
+var neptuneL = []vsop87.Series{
+	// L0, 423 terms
+	{
+		{A: 5.31188633047, B: 0, C: 0},
+		{A: 0.01798475509, B: 2.9010127305, C: 38.1330356378},
+		{A: 0.01019727662, B: 0.4858092366, C: 1.4844727083},
+		{A: 0.00124531845, B: 4.83008090682, C: 36.6485629295},
+		{A: 0.0004206445, B: 5.41054991607, C: 2.9689454166},
+		{A: 0.00037714589, B: 6.09221834946, C: 35.1640902212},
+		{A: 0.00033784734, B: 1.24488865578, C: 76.2660712756},
+		{A: 0.00016482741, B: 7.729261e-05, C: 491.5579294568},
+		{A: 9.198582e-05, B: 4.93747059924, C: 39.6175083461},
+		{A: 8.994249e-05, B: 0.27462142569, C: 175.1660598002},
+		{A: 4.216235e-05, B: 1.98711914364, C: 73.297125859},
+		{A: 3.364818e-05, B: 1.03590121818, C: 33.6796175129},
+		{A: 2.2848e-05, B: 4.20606932559, C: 4.4534181249},
+		{A: 1.433512e-05, B: 2.78340432711, C: 74.7815985673},
+		{A: 9.0024e-06, B: 2.07606702418, C: 109.9456887885},
+		{A: 7.44996e-06, B: 3.19032530145, C: 71.8126531507},
+		{A: 5.06206e-06, B: 5.74785370252, C: 114.3991069134},
+		{A: 3.99552e-06, B: 0.34972342569, C: 1021.2488945514},
+		{A: 3.45195e-06, B: 3.46186210169, C: 41.1019810544},
+		{A: 3.06338e-06, B: 0.49684039897, C: 0.5212648618},
+		{A: 2.87322e-06, B: 4.50523446022, C: 0.0481841098},
+		{A: 3.23004e-06, B: 2.24815188609, C: 32.1951448046},
+		{A: 3.40323e-06, B: 3.30369900416, C: 77.7505439839},
+		{A: 2.66605e-06, B: 4.88932609483, C: 0.9632078465},
+		{A: 2.27079e-06, B: 1.79713054538, C: 453.424893819},
+		{A: 2.44722e-06, B: 1.24693337933, C: 9.5612275556},
+		{A: 2.32887e-06, B: 2.50459795017, C: 137.0330241624},
+		{A: 2.8217e-06, B: 2.24565579693, C: 146.594251718},
+		{A: 2.51941e-06, B: 5.78166597292, C: 388.4651552382},
+		{A: 1.5018e-06, B: 2.99706110414, C: 5.9378908332},
+		{A: 1.70404e-06, B: 3.3239063065, C: 108.4612160802},
+		{A: 1.51401e-06, B: 2.1915309428, C: 33.9402499438},
+		{A: 1.48295e-06, B: 0.85948986145, C: 111.4301614968},
+		{A: 1.18672e-06, B: 3.67706204305, C: 2.4476805548},
+		{A: 1.01821e-06, B: 5.70539236951, C: 0.1118745846},
+		{A: 9.7873e-07, B: 2.80518260528, C: 8.0767548473},
+		{A: 1.03054e-06, B: 4.40441222, C: 70.3281804424},
+		{A: 1.03305e-06, B: 0.04078966679, C: 0.2606324309},
+		{A: 1.093e-06, B: 2.41599378049, C: 183.2428146475},
+		{A: 7.3938e-07, B: 1.32805041516, C: 529.6909650946},
+		{A: 7.7725e-07, B: 4.16446516424, C: 4.192785694},
+		{A: 8.6379e-07, B: 4.22834506045, C: 490.0734567485},
+		{A: 8.1536e-07, B: 5.19908046216, C: 493.0424021651},
+		{A: 7.1503e-07, B: 5.29530386579, C: 350.3321196004},
+		{A: 6.4418e-07, B: 3.5454101605, C: 168.0525127994},
+		{A: 6.257e-07, B: 0.15028731465, C: 182.279606801},
+		{A: 5.8488e-07, B: 3.50106873945, C: 145.1097790097},
+		{A: 4.8276e-07, B: 1.11259925628, C: 112.9146342051},
+		{A: 4.7229e-07, B: 4.57373229818, C: 46.2097904851},
+		{A: 3.9124e-07, B: 1.6656935605, C: 213.299095438},
+		{A: 4.7728e-07, B: 0.12906212461, C: 484.444382456},
+		{A: 4.6858e-07, B: 3.01699530327, C: 498.6714764576},
+		{A: 3.8659e-07, B: 2.38685706479, C: 2.9207613068},
+		{A: 4.7046e-07, B: 4.498446604, C: 173.6815870919},
+		{A: 4.7565e-07, B: 2.58404814824, C: 219.891377577},
+		{A: 4.4714e-07, B: 5.47302733614, C: 176.6505325085},
+		{A: 3.2279e-07, B: 3.4575915122, C: 30.7106720963},
+		{A: 2.8249e-07, B: 4.13282446716, C: 6.592282139},
+		{A: 2.4433e-07, B: 4.55736848232, C: 106.9767433719},
+		{A: 2.4661e-07, B: 3.67822620786, C: 181.7583419392},
+		{A: 2.4505e-07, B: 1.55095867965, C: 7.1135470008},
+		{A: 2.1848e-07, B: 1.04366818343, C: 39.0962434843},
+		{A: 1.6936e-07, B: 6.10896452834, C: 44.7253177768},
+		{A: 2.2169e-07, B: 2.74932970271, C: 256.5399405065},
+		{A: 1.6614e-07, B: 4.98188930613, C: 37.611770776},
+		{A: 1.7728e-07, B: 3.55049134167, C: 1.3725981237},
+		{A: 1.7347e-07, B: 2.1406923488, C: 42.5864537627},
+		{A: 1.4953e-07, B: 3.36405649131, C: 98.8999885246},
+		{A: 1.4566e-07, B: 0.69857991985, C: 1550.939859646},
+		{A: 1.5676e-07, B: 6.22010212025, C: 454.9093665273},
+		{A: 1.3243e-07, B: 5.61712542227, C: 68.8437077341},
+		{A: 1.4837e-07, B: 3.52557245517, C: 25.6028626656},
+		{A: 1.2757e-07, B: 0.04509743861, C: 11.0457002639},
+		{A: 1.1988e-07, B: 4.81687553351, C: 24.1183899573},
+		{A: 1.106e-07, B: 1.78958277553, C: 7.4223635415},
+		{A: 1.2108e-07, B: 1.87022663714, C: 79.2350166922},
+		{A: 1.1698e-07, B: 0.49005698002, C: 1.5963472929},
+		{A: 1.0459e-07, B: 2.38743199893, C: 381.3516082374},
+		{A: 1.1681e-07, B: 3.85151357766, C: 218.4069048687},
+		{A: 8.744e-08, B: 0.1416856861, C: 148.0787244263},
+		{A: 9.196e-08, B: 1.00274090619, C: 72.0732855816},
+		{A: 1.1343e-07, B: 0.81432278263, C: 525.4981794006},
+		{A: 1.0097e-07, B: 5.03383557061, C: 601.7642506762},
+		{A: 8.035e-08, B: 1.7768572301, C: 0.2124483211},
+		{A: 8.382e-08, B: 3.07534786987, C: 1.2720243872},
+		{A: 1.0803e-07, B: 2.92081211459, C: 293.188503436},
+		{A: 7.666e-08, B: 1.52223325105, C: 115.8835796217},
+		{A: 7.531e-08, B: 5.37537256533, C: 5.1078094307},
+		{A: 8.691e-08, B: 4.74352784364, C: 143.6253063014},
+		{A: 1.0183e-07, B: 1.15395455831, C: 6244.9428143536},
+		{A: 8.283e-08, B: 0.35956716764, C: 138.5174968707},
+		{A: 9.544e-08, B: 4.02452832984, C: 152.5321425512},
+		{A: 7.274e-08, B: 4.10937535938, C: 251.4321310758},
+		{A: 7.465e-08, B: 1.72131945843, C: 31.019488637},
+		{A: 6.902e-08, B: 4.62452068308, C: 2.7083129857},
+		{A: 7.094e-08, B: 5.11528393609, C: 312.1990839626},
+		{A: 7.929e-08, B: 2.10765101655, C: 27.0873353739},
+		{A: 6.156e-08, B: 3.50746507109, C: 28.5718080822},
+		{A: 7.134e-08, B: 2.05292376023, C: 278.2588340188},
+		{A: 8.193e-08, B: 2.58588219154, C: 141.2258098564},
+		{A: 5.499e-08, B: 2.09250039025, C: 1.6969210294},
+		{A: 5.279e-08, B: 4.09390686798, C: 983.1158589136},
+		{A: 6.947e-08, B: 3.48041784595, C: 415.2918581812},
+		{A: 5.916e-08, B: 0.68957324226, C: 62.2514255951},
+		{A: 5.925e-08, B: 4.0250459262, C: 255.0554677982},
+		{A: 4.606e-08, B: 1.17779101436, C: 43.2408450685},
+		{A: 5.357e-08, B: 3.63061058987, C: 5.4166259714},
+		{A: 5.918e-08, B: 2.57693824084, C: 10175.1525105732},
+		{A: 5.482e-08, B: 3.0797973728, C: 329.8370663655},
+		{A: 3.956e-08, B: 5.00418696742, C: 184.7272873558},
+		{A: 5.408e-08, B: 3.31313295602, C: 528.2064923863},
+		{A: 4.767e-08, B: 4.91981150665, C: 456.3938392356},
+		{A: 3.77e-08, B: 1.57277409442, C: 32.7164096664},
+		{A: 3.924e-08, B: 4.92763242635, C: 180.2738692309},
+		{A: 3.707e-08, B: 4.82965453201, C: 221.3758502853},
+		{A: 3.802e-08, B: 4.96279204998, C: 594.6507036754},
+		{A: 4.014e-08, B: 1.6390516403, C: 40.5807161926},
+		{A: 3.061e-08, B: 0.39713858313, C: 1.4362885985},
+		{A: 3.261e-08, B: 4.65478978469, C: 29.226199388},
+		{A: 3.474e-08, B: 5.65891305944, C: 395.578702239},
+		{A: 2.918e-08, B: 5.91079083895, C: 1.2238402774},
+		{A: 3.649e-08, B: 3.88114678609, C: 494.5268748734},
+		{A: 3.225e-08, B: 5.57423738665, C: 1014.1353475506},
+		{A: 2.845e-08, B: 0.56009386585, C: 144.1465711632},
+		{A: 2.848e-08, B: 0.55423029727, C: 567.8240007324},
+		{A: 3.44e-08, B: 1.70887250883, C: 12.5301729722},
+		{A: 3.267e-08, B: 5.6328779982, C: 488.5889840402},
+		{A: 3.107e-08, B: 5.79335949207, C: 105.4922706636},
+		{A: 2.712e-08, B: 2.43726364359, C: 60.7669528868},
+		{A: 3.202e-08, B: 2.21483496593, C: 41.0537969446},
+		{A: 3.134e-08, B: 4.69665220513, C: 82.8583534146},
+		{A: 3.59e-08, B: 5.69939670162, C: 1124.34166877},
+		{A: 2.967e-08, B: 0.54448940101, C: 135.5485514541},
+		{A: 3.211e-08, B: 4.19927605853, C: 291.7040307277},
+		{A: 2.899e-08, B: 5.99669788291, C: 22.633917249},
+		{A: 3.143e-08, B: 2.93495725805, C: 31.2319369581},
+		{A: 2.729e-08, B: 4.62707721219, C: 5.6290742925},
+		{A: 2.513e-08, B: 5.60391563025, C: 19.1224551112},
+		{A: 2.69e-08, B: 5.32070128202, C: 2.0057375701},
+		{A: 2.63e-08, B: 6.00855841124, C: 37.1698277913},
+		{A: 2.296e-08, B: 6.06934502789, C: 451.9404211107},
+		{A: 2.858e-08, B: 4.88677262419, C: 258.0244132148},
+		{A: 2.879e-08, B: 5.12239168488, C: 38.6543004996},
+		{A: 2.27e-08, B: 2.08634524182, C: 30.0562807905},
+		{A: 2.301e-08, B: 3.35951602914, C: 1028.3624415522},
+		{A: 3.001e-08, B: 3.59143817947, C: 211.8146227297},
+		{A: 2.237e-08, B: 0.3845555347, C: 3.6233367224},
+		{A: 2.901e-08, B: 3.24755614136, C: 366.485629295},
+		{A: 2.592e-08, B: 1.36262641469, C: 35.4247226521},
+		{A: 2.418e-08, B: 4.93467056526, C: 47.6942631934},
+		{A: 2.089e-08, B: 5.79838063413, C: 4.665866446},
+		{A: 2.586e-08, B: 2.69392971321, C: 38.1812197476},
+		{A: 1.913e-08, B: 5.53560681085, C: 149.5631971346},
+		{A: 1.971e-08, B: 6.00790964671, C: 34.2008823747},
+		{A: 2.586e-08, B: 6.24984047544, C: 38.084851528},
+		{A: 2.098e-08, B: 4.57819744766, C: 1019.7644218431},
+		{A: 1.869e-08, B: 3.85907708723, C: 911.042573332},
+		{A: 2.486e-08, B: 5.21235809332, C: 140.001969579},
+		{A: 1.795e-08, B: 1.68012868451, C: 1059.3819301892},
+		{A: 2.326e-08, B: 2.82664069146, C: 807.9497991134},
+		{A: 1.984e-08, B: 5.54763522932, C: 1022.7333672597},
+		{A: 1.919e-08, B: 5.10717766499, C: 216.9224321604},
+		{A: 2.004e-08, B: 5.47811228948, C: 63.7358983034},
+		{A: 2.021e-08, B: 4.15631916516, C: 178.1350052168},
+		{A: 1.76e-08, B: 6.00927149342, C: 172.1971143836},
+		{A: 2.14e-08, B: 2.65037925793, C: 700.6642392008},
+		{A: 1.988e-08, B: 3.3585027278, C: 186.2117600641},
+		{A: 1.956e-08, B: 5.01527508588, C: 294.6729761443},
+		{A: 1.966e-08, B: 4.07957525462, C: 20.6069278195},
+		{A: 1.637e-08, B: 0.53823942149, C: 67.3592350258},
+		{A: 1.54e-08, B: 2.62327849119, C: 41.7563723602},
+		{A: 1.81e-08, B: 5.81430038477, C: 129.9194771616},
+		{A: 1.776e-08, B: 4.37047808449, C: 328.3525936572},
+		{A: 1.46e-08, B: 2.63664516309, C: 2.857070832},
+		{A: 1.388e-08, B: 2.10598045632, C: 3.9321532631},
+		{A: 1.352e-08, B: 0.55618245459, C: 0.6543913058},
+		{A: 1.668e-08, B: 2.77543377384, C: 16.1535096946},
+		{A: 1.338e-08, B: 0.37643611305, C: 14.0146456805},
+		{A: 1.218e-08, B: 0.7345643475, C: 426.598190876},
+		{A: 1.531e-08, B: 4.54891769768, C: 526.722019678},
+		{A: 1.61e-08, B: 3.40993944436, C: 403.1341922245},
+		{A: 1.361e-08, B: 4.48227243414, C: 17.6379824029},
+		{A: 1.589e-08, B: 5.59323020112, C: 3302.479391062},
+		{A: 1.132e-08, B: 5.6452072536, C: 151.0476698429},
+		{A: 1.357e-08, B: 4.0639903143, C: 26.826702943},
+		{A: 1.494e-08, B: 4.98692049495, C: 666.723989257},
+		{A: 1.077e-08, B: 4.3091147025, C: 0.6331394464},
+		{A: 1.042e-08, B: 6.02756893581, C: 106.0135355254},
+		{A: 1.06e-08, B: 0.74679491358, C: 487.3651437628},
+		{A: 1.31e-08, B: 3.7852638093, C: 386.9806825299},
+		{A: 1.342e-08, B: 4.52685061062, C: 563.6312150384},
+		{A: 9.86e-09, B: 0.00600924269, C: 81.3738807063},
+		{A: 1.232e-08, B: 5.17443930901, C: 331.3215390738},
+		{A: 9.29e-09, B: 4.51267465978, C: 38.3936680687},
+		{A: 9.56e-09, B: 3.5044779102, C: 64.9597385808},
+		{A: 9.29e-09, B: 4.43109514438, C: 37.8724032069},
+		{A: 9.26e-09, B: 6.09803297747, C: 4.1446015842},
+		{A: 9.72e-09, B: 0.59038366513, C: 8.9068362498},
+		{A: 1.246e-08, B: 4.69840351226, C: 389.9496279465},
+		{A: 1.009e-08, B: 5.98451242784, C: 142.1408335931},
+		{A: 1.02e-08, B: 0.832338923, C: 39.3568759152},
+		{A: 1.013e-08, B: 0.37845630298, C: 36.9091953604},
+		{A: 9.4e-09, B: 2.42688145966, C: 343.2185725996},
+		{A: 9.74e-09, B: 5.23958752786, C: 253.5709950899},
+		{A: 9.64e-09, B: 5.09748190218, C: 357.4456666012},
+		{A: 8.35e-09, B: 1.4556862667, C: 35.212274331},
+		{A: 1.077e-08, B: 0.71409061316, C: 44.070926471},
+		{A: 1.083e-08, B: 2.27578897621, C: 6.9010986797},
+		{A: 9.38e-09, B: 5.03471583911, C: 69.3649725959},
+		{A: 1.078e-08, B: 1.20253141912, C: 35.685355083},
+		{A: 1.027e-08, B: 0.18243183397, C: 84.3428261229},
+		{A: 7.64e-09, B: 4.62720907712, C: 0.8300814025},
+		{A: 1.013e-08, B: 0.42234855022, C: 32.4557772355},
+		{A: 9.39e-09, B: 4.50445799766, C: 365.0011565867},
+		{A: 7.56e-09, B: 0.82872484717, C: 17.5261078183},
+		{A: 9.16e-09, B: 3.89409205418, C: 38.2449102224},
+		{A: 7.36e-09, B: 4.78125743795, C: 5.3684418616},
+		{A: 7.62e-09, B: 0.0189733713, C: 189.3931538018},
+		{A: 7.38e-09, B: 2.31770478416, C: 42.3258213318},
+		{A: 8.6e-09, B: 4.82440483506, C: 210.3301500214},
+		{A: 8.88e-09, B: 3.20360339895, C: 348.8476468921},
+		{A: 9.16e-09, B: 5.04967792934, C: 38.0211610532},
+		{A: 6.38e-09, B: 0.63267396269, C: 244.318584075},
+		{A: 6.36e-09, B: 1.02615137352, C: 2080.6308247406},
+		{A: 7.74e-09, B: 5.44432678139, C: 367.9701020033},
+		{A: 6.44e-09, B: 1.94044989547, C: 446.3113468182},
+		{A: 6.31e-09, B: 4.82928491724, C: 460.5384408198},
+		{A: 8.55e-09, B: 3.57592750113, C: 439.782755154},
+		{A: 6.78e-09, B: 4.48687912809, C: 351.8165923087},
+		{A: 7.24e-09, B: 4.8914160928, C: 119.5069163441},
+		{A: 5.94e-09, B: 0.59315717529, C: 491.036664595},
+		{A: 6.55e-09, B: 1.99014093, C: 19.0105805266},
+		{A: 5.8e-09, B: 2.57189536188, C: 492.0791943186},
+		{A: 6.94e-09, B: 0.08328521209, C: 5.6772584023},
+		{A: 7.33e-09, B: 5.81485239057, C: 29.7474642498},
+		{A: 6.66e-09, B: 3.42196897591, C: 179.0982130633},
+		{A: 6.78e-09, B: 0.29428615814, C: 171.2339065371},
+		{A: 6.35e-09, B: 2.13805182663, C: 164.1203595363},
+		{A: 6.23e-09, B: 5.6145494038, C: 285.3723810196},
+		{A: 5.29e-09, B: 1.88063108785, C: 416.7763308895},
+		{A: 5.29e-09, B: 5.1325078803, C: 697.743477894},
+		{A: 5e-09, B: 1.49548514415, C: 704.8570248948},
+		{A: 4.87e-09, B: 4.97772067947, C: 274.0660483248},
+		{A: 6.66e-09, B: 6.26456825266, C: 1474.6737883704},
+		{A: 5.32e-09, B: 0.25784352716, C: 477.3308354552},
+		{A: 5.57e-09, B: 0.71378452161, C: 80.7194894005},
+		{A: 5.56e-09, B: 2.60791360513, C: 418.2608035978},
+		{A: 5.84e-09, B: 4.29064541383, C: 16.6747745564},
+		{A: 5.24e-09, B: 5.4275939228, C: 290.2195580194},
+		{A: 5.24e-09, B: 0.29054995359, C: 247.2393453818},
+		{A: 5.41e-09, B: 4.36400580938, C: 815.0633461142},
+		{A: 5.26e-09, B: 1.66512720297, C: 97.4155158163},
+		{A: 4.97e-09, B: 4.72640318293, C: 401.6497195162},
+		{A: 4.32e-09, B: 2.98481475894, C: 100.3844612329},
+		{A: 3.82e-09, B: 0.28067758468, C: 8.385571388},
+		{A: 4.24e-09, B: 6.16774845481, C: 178.7893965226},
+		{A: 4.84e-09, B: 0.01535318279, C: 738.7972748386},
+		{A: 5.18e-09, B: 4.4891659141, C: 875.830299001},
+		{A: 5.06e-09, B: 5.38611121207, C: 404.6186649328},
+		{A: 3.96e-09, B: 4.62747640832, C: 6.1503391543},
+		{A: 4.66e-09, B: 0.23340415764, C: 120.9913890524},
+		{A: 4.09e-09, B: 3.08849480895, C: 59.2824801785},
+		{A: 4.7e-09, B: 5.01853200224, C: 313.6835566709},
+		{A: 4.42e-09, B: 3.68919475089, C: 457.8783119439},
+		{A: 3.84e-09, B: 3.69499925394, C: 160.9389657986},
+		{A: 3.64e-09, B: 0.76192181046, C: 104.0077979553},
+		{A: 4.16e-09, B: 0.26652109651, C: 103.0927742186},
+		{A: 4.01e-09, B: 4.06530055968, C: 14.6690369863},
+		{A: 4.54e-09, B: 3.72767803715, C: 476.4313180835},
+		{A: 4.34e-09, B: 0.335338022, C: 984.6003316219},
+		{A: 3.4e-09, B: 0.99915726716, C: 31.5407534988},
+		{A: 4.2e-09, B: 3.65147769268, C: 20.4950532349},
+		{A: 3.34e-09, B: 0.35121412008, C: 1227.4344429886},
+		{A: 3.23e-09, B: 5.45836731979, C: 918.1561203328},
+		{A: 4.07e-09, B: 4.19457842203, C: 309.7995875176},
+		{A: 3.81e-09, B: 0.0136485696, C: 495.4900827199},
+		{A: 3.34e-09, B: 4.05924071124, C: 8.3373872782},
+		{A: 3.8e-09, B: 3.17063415023, C: 487.6257761937},
+		{A: 3.09e-09, B: 0.48352303405, C: 118.0224436358},
+		{A: 3.8e-09, B: 2.70238752925, C: 134.1122628556},
+		{A: 3.62e-09, B: 4.8898581061, C: 438.2982824457},
+		{A: 3.27e-09, B: 2.91090790412, C: 505.7850234584},
+		{A: 3.08e-09, B: 0.96082817124, C: 21.1494445407},
+		{A: 2.88e-09, B: 1.48123872077, C: 220.4126424388},
+		{A: 2.93e-09, B: 2.56582281789, C: 662.531203563},
+		{A: 3.31e-09, B: 4.37715965811, C: 180.7951340927},
+		{A: 3.26e-09, B: 2.46104924164, C: 169.5369855077},
+		{A: 2.89e-09, B: 2.63591886391, C: 55.7710180407},
+		{A: 2.88e-09, B: 5.02487283285, C: 1440.7335384266},
+		{A: 3.44e-09, B: 1.4893099727, C: 166.5680400911},
+		{A: 2.66e-09, B: 0.63672427386, C: 79.1868325824},
+		{A: 2.68e-09, B: 5.02354540478, C: 377.4194549743},
+		{A: 3.08e-09, B: 1.50185265748, C: 77.2292791221},
+		{A: 3.24e-09, B: 5.30240189273, C: 457.617679513},
+		{A: 2.65e-09, B: 1.087366328, C: 450.4559484024},
+		{A: 2.64e-09, B: 0.83337660655, C: 488.3765357191},
+		{A: 2.9e-09, B: 1.80003152563, C: 101.8689339412},
+		{A: 2.62e-09, B: 2.3039000336, C: 494.7393231945},
+		{A: 3.25e-09, B: 5.52669889053, C: 441.2672278623},
+		{A: 2.54e-09, B: 0.02963623277, C: 117.36805233},
+		{A: 3e-09, B: 0.1743570554, C: 252.9166037841},
+		{A: 3.15e-09, B: 5.3488501304, C: 183.7640795093},
+		{A: 3.13e-09, B: 5.45945846595, C: 13.4933808187},
+		{A: 3.06e-09, B: 5.23085809622, C: 45.2465826386},
+		{A: 2.37e-09, B: 0.32676889138, C: 208.8456773131},
+		{A: 2.63e-09, B: 2.66670785888, C: 464.7312265138},
+		{A: 2.34e-09, B: 1.82700149824, C: 52175.8062831484},
+		{A: 2.75e-09, B: 5.04385701142, C: 156.1554792736},
+		{A: 2.65e-09, B: 5.64967127743, C: 326.8681209489},
+		{A: 2.47e-09, B: 1.74540930625, C: 65.8747623175},
+		{A: 2.69e-09, B: 6.09827783249, C: 1654.0326338646},
+		{A: 2.29e-09, B: 2.25832077914, C: 190.665178189},
+		{A: 2.94e-09, B: 5.45249564193, C: 206.1855484372},
+		{A: 2.38e-09, B: 1.55647021369, C: 79.889407998},
+		{A: 2.3e-09, B: 6.13158632762, C: 178.3474535379},
+		{A: 2.74e-09, B: 4.10829870815, C: 518.3846323998},
+		{A: 2.25e-09, B: 3.86300359251, C: 171.9846660625},
+		{A: 2.28e-09, B: 2.48511565618, C: 12566.1516999828},
+		{A: 2.72e-09, B: 5.61149862463, C: 148.3393568572},
+		{A: 2.14e-09, B: 1.45987216039, C: 522.5774180938},
+		{A: 2.11e-09, B: 4.04791980901, C: 6205.3253060075},
+		{A: 2.66e-09, B: 0.99036038827, C: 209.106309744},
+		{A: 2.3e-09, B: 0.5404995153, C: 532.6117264014},
+		{A: 2.26e-09, B: 3.8415296162, C: 283.6272758804},
+		{A: 2.43e-09, B: 5.32730346969, C: 485.9288551643},
+		{A: 2.09e-09, B: 4.35051470487, C: 536.8045120954},
+		{A: 2.32e-09, B: 3.01948719112, C: 10.9338256793},
+		{A: 2.64e-09, B: 5.70536379124, C: 490.3340891794},
+		{A: 2.8e-09, B: 3.99993658196, C: 674.8007441043},
+		{A: 2.46e-09, B: 0.37698964335, C: 157.6399519819},
+		{A: 2.19e-09, B: 5.67679857772, C: 52099.5402118728},
+		{A: 2.51e-09, B: 1.52353965506, C: 6.8529145699},
+		{A: 2.03e-09, B: 5.44328656642, C: 145.6310438715},
+		{A: 2.38e-09, B: 0.96169723853, C: 497.1870037493},
+		{A: 2.19e-09, B: 4.52300776062, C: 1615.8995982268},
+		{A: 2.75e-09, B: 2.37619210741, C: 2118.7638603784},
+		{A: 2.58e-09, B: 5.1244814878, C: 608.877797677},
+		{A: 2.6e-09, B: 3.88543008475, C: 513.079881013},
+		{A: 1.91e-09, B: 3.72574595369, C: 65.2203710117},
+		{A: 2.11e-09, B: 0.06484535455, C: 215.4379594521},
+		{A: 2.36e-09, B: 3.95835282821, C: 141.4864422873},
+		{A: 1.89e-09, B: 5.28135043909, C: 377.1588225434},
+		{A: 2.43e-09, B: 4.35559878377, C: 482.9599097477},
+		{A: 2.43e-09, B: 6.06808644973, C: 154.0166152595},
+		{A: 2.49e-09, B: 1.57215637373, C: 14.2270940016},
+		{A: 2.38e-09, B: 1.93340192445, C: 500.1559491659},
+		{A: 2.09e-09, B: 5.02893682321, C: 364.559213602},
+		{A: 2.27e-09, B: 5.7298429854, C: 1543.8263126452},
+		{A: 2.17e-09, B: 2.45036922991, C: 187.1749679106},
+		{A: 1.81e-09, B: 1.65699502247, C: 1627.2059309216},
+		{A: 2.14e-09, B: 1.60213179145, C: 11.3063326948},
+		{A: 2.03e-09, B: 0.74638490279, C: 14.5571624017},
+		{A: 1.92e-09, B: 3.17719161639, C: 343.4792050305},
+		{A: 1.77e-09, B: 1.50027795761, C: 9.449352971},
+		{A: 1.77e-09, B: 0.03038098292, C: 165.6048322446},
+		{A: 1.76e-09, B: 4.64462444674, C: 315.1680293792},
+		{A: 2.08e-09, B: 2.65835778368, C: 496.0113475817},
+		{A: 1.74e-09, B: 2.76155855705, C: 49.1787359017},
+		{A: 1.96e-09, B: 1.95549714182, C: 335.7749571987},
+		{A: 2e-09, B: 4.16839394758, C: 285.1117485887},
+		{A: 1.99e-09, B: 0.06168021293, C: 73.5577582899},
+		{A: 1.88e-09, B: 6.17288913873, C: 535.3200393871},
+		{A: 2.15e-09, B: 1.92414563346, C: 552.6973893591},
+		{A: 1.66e-09, B: 5.4903813969, C: 10135.5350022271},
+		{A: 1.92e-09, B: 0.9697343412, C: 304.2342036999},
+		{A: 2.09e-09, B: 5.34065233845, C: 13.642138665},
+		{A: 2.03e-09, B: 5.11234865419, C: 324.7292569348},
+		{A: 1.77e-09, B: 3.5068084179, C: 207.3612046048},
+		{A: 1.74e-09, B: 1.95010708561, C: 319.3126309634},
+		{A: 1.87e-09, B: 5.57685931698, C: 266.1011680621},
+		{A: 1.81e-09, B: 1.43525075751, C: 279.7433067271},
+		{A: 1.65e-09, B: 4.00537112057, C: 493.5636670269},
+		{A: 1.91e-09, B: 1.68313683465, C: 563.3705826075},
+		{A: 1.73e-09, B: 3.93200456456, C: 238.9019581036},
+		{A: 1.61e-09, B: 5.96143146317, C: 36.1272980677},
+		{A: 1.94e-09, B: 2.3766423145, C: 944.9828232758},
+		{A: 1.65e-09, B: 0.97421918976, C: 556.5176680376},
+		{A: 1.89e-09, B: 1.11279570541, C: 1127.2624300768},
+		{A: 1.72e-09, B: 0.75085513952, C: 267.5856407704},
+		{A: 1.93e-09, B: 2.12636756833, C: 20350.3050211464},
+		{A: 1.81e-09, B: 2.1081456208, C: 113.8778420516},
+		{A: 1.94e-09, B: 1.13504964219, C: 57.255490749},
+		{A: 1.81e-09, B: 6.23699820519, C: 355.9611938929},
+		{A: 1.98e-09, B: 5.68125942959, C: 6280.1069045748},
+		{A: 1.73e-09, B: 5.15083799917, C: 474.9468453752},
+		{A: 1.51e-09, B: 1.66981962338, C: 116.5379709275},
+		{A: 1.5e-09, B: 5.42593657173, C: 526.9826521089},
+		{A: 2.05e-09, B: 4.16096717573, C: 711.4493070338},
+		{A: 1.77e-09, B: 3.49360697678, C: 421.2297490144},
+		{A: 1.68e-09, B: 0.52839230204, C: 487.1045113319},
+		{A: 1.6e-09, B: 4.77712663799, C: 524.0137066923},
+		{A: 1.45e-09, B: 2.81448128781, C: 1512.8068240082},
+		{A: 1.46e-09, B: 4.9957011266, C: 142.6620984549},
+		{A: 1.88e-09, B: 0.8210416155, C: 10210.3166007944},
+		{A: 1.45e-09, B: 4.96888131586, C: 1189.3014073508},
+		{A: 1.81e-09, B: 2.9970479059, C: 75.7448064138},
+		{A: 1.76e-09, B: 0.41626373842, C: 222.8603229936},
+		{A: 1.37e-09, B: 2.96534226337, C: 6206.8097787158},
+		{A: 1.38e-09, B: 1.22260849471, C: 187.6962327724},
+		{A: 1.28e-09, B: 2.53394068407, C: 276.7743613105},
+		{A: 1.3e-09, B: 3.04810765699, C: 310.7146112543},
+		{A: 1.22e-09, B: 3.01323006886, C: 70.8494453042},
+		{A: 1.11e-09, B: 0.77449448649, C: 179.3588454942},
+		{A: 1.41e-09, B: 0.18423889807, C: 131.4039498699},
+		{A: 1.26e-09, B: 5.77648809669, C: 525.2375469697},
+		{A: 1.24e-09, B: 2.93225731024, C: 179.6194779251},
+		{A: 1.11e-09, B: 6.18471578216, C: 981.6313862053},
+		{A: 1.41e-09, B: 2.63342951123, C: 381.6122406683},
+		{A: 1.1e-09, B: 5.25053027081, C: 986.0848043302},
+		{A: 9.6e-10, B: 3.86591534559, C: 240.125798381},
+		{A: 1.2e-09, B: 3.78755085035, C: 1057.8974574809},
+		{A: 9.3e-10, B: 4.54014016637, C: 36.6967470393},
+		{A: 1.09e-09, B: 1.533275859, C: 419.7452763061},
+		{A: 9.4e-10, B: 4.21870300178, C: 1024.217839968},
+		{A: 1.09e-09, B: 2.15905156247, C: 289.5651667136},
+		{A: 1.04e-09, B: 0.20665642552, C: 564.8550553158},
+		{A: 8.1e-10, B: 1.89134135215, C: 36.6003788197},
+		{A: 8e-10, B: 4.38832594589, C: 10137.0194749354},
+		{A: 8e-10, B: 1.73940577376, C: 39.5056337615},
+		{A: 8.4e-10, B: 0.81316746605, C: 170.7126416753},
+		{A: 9e-10, B: 0.60145818457, C: 36.7604375141},
+		{A: 7.4e-10, B: 4.92511651321, C: 1549.4553869377},
+		{A: 7.2e-10, B: 5.06852406179, C: 249.9476583675},
+	},
+	// L1, 183 terms
+	{
+		{A: 38.37687716731, B: 0, C: 0},
+		{A: 0.00016604187, B: 4.86319129565, C: 1.4844727083},
+		{A: 0.00015807148, B: 2.27923488532, C: 38.1330356378},
+		{A: 3.334701e-05, B: 3.6819967602, C: 76.2660712756},
+		{A: 1.30584e-05, B: 3.67320813491, C: 2.9689454166},
+		{A: 6.04832e-06, B: 1.50477747549, C: 35.1640902212},
+		{A: 1.78623e-06, B: 3.45318524147, C: 39.6175083461},
+		{A: 1.06537e-06, B: 2.45126138334, C: 4.4534181249},
+		{A: 1.05747e-06, B: 2.7547932655, C: 33.6796175129},
+		{A: 7.2684e-07, B: 5.48724732699, C: 36.6485629295},
+		{A: 5.7069e-07, B: 5.2164980497, C: 0.5212648618},
+		{A: 5.7355e-07, B: 1.85767603384, C: 114.3991069134},
+		{A: 3.5368e-07, B: 4.51676827545, C: 74.7815985673},
+		{A: 3.2216e-07, B: 5.9041148968, C: 77.7505439839},
+		{A: 2.9871e-07, B: 3.67043294114, C: 388.4651552382},
+		{A: 2.8866e-07, B: 5.16877529164, C: 9.5612275556},
+		{A: 2.8742e-07, B: 5.16732589024, C: 2.4476805548},
+		{A: 2.5507e-07, B: 5.24526281928, C: 168.0525127994},
+		{A: 2.4869e-07, B: 4.7319306781, C: 182.279606801},
+		{A: 2.0205e-07, B: 5.78945415677, C: 1021.2488945514},
+		{A: 1.9022e-07, B: 1.82981144269, C: 484.444382456},
+		{A: 1.8661e-07, B: 1.31606255521, C: 498.6714764576},
+		{A: 1.5063e-07, B: 4.9500389376, C: 137.0330241624},
+		{A: 1.5094e-07, B: 3.9870525494, C: 32.1951448046},
+		{A: 1.072e-07, B: 2.44148149225, C: 4.192785694},
+		{A: 1.1725e-07, B: 4.89255650674, C: 71.8126531507},
+		{A: 9.581e-08, B: 1.23188039594, C: 5.9378908332},
+		{A: 9.606e-08, B: 1.88534821556, C: 41.1019810544},
+		{A: 8.968e-08, B: 0.01758559103, C: 8.0767548473},
+		{A: 9.882e-08, B: 6.08165628679, C: 7.1135470008},
+		{A: 7.632e-08, B: 5.51307048241, C: 73.297125859},
+		{A: 6.992e-08, B: 0.61688864282, C: 2.9207613068},
+		{A: 5.543e-08, B: 2.24141557794, C: 46.2097904851},
+		{A: 4.845e-08, B: 3.7105582375, C: 112.9146342051},
+		{A: 3.7e-08, B: 5.25713252333, C: 111.4301614968},
+		{A: 3.233e-08, B: 6.10303038418, C: 70.3281804424},
+		{A: 2.939e-08, B: 4.86520586648, C: 98.8999885246},
+		{A: 2.403e-08, B: 2.90637675099, C: 601.7642506762},
+		{A: 2.398e-08, B: 1.04343654629, C: 6.592282139},
+		{A: 2.784e-08, B: 4.95821114677, C: 108.4612160802},
+		{A: 2.894e-08, B: 4.20148844767, C: 381.3516082374},
+		{A: 2.111e-08, B: 5.93089610785, C: 25.6028626656},
+		{A: 2.075e-08, B: 5.20632201951, C: 30.7106720963},
+		{A: 2.126e-08, B: 0.54976393136, C: 41.0537969446},
+		{A: 2.235e-08, B: 2.38045158073, C: 453.424893819},
+		{A: 1.859e-08, B: 0.89409373259, C: 24.1183899573},
+		{A: 2.018e-08, B: 3.42245274178, C: 31.019488637},
+		{A: 1.7e-08, B: 3.91715254287, C: 11.0457002639},
+		{A: 1.776e-08, B: 3.86571077241, C: 395.578702239},
+		{A: 1.644e-08, B: 0.15855999051, C: 152.5321425512},
+		{A: 1.646e-08, B: 3.34591387314, C: 44.7253177768},
+		{A: 1.876e-08, B: 2.59784179105, C: 33.9402499438},
+		{A: 1.614e-08, B: 0.42137145545, C: 175.1660598002},
+		{A: 1.468e-08, B: 6.12983933526, C: 1550.939859646},
+		{A: 1.408e-08, B: 6.13722948564, C: 490.0734567485},
+		{A: 1.207e-08, B: 0.59525736062, C: 312.1990839626},
+		{A: 1.336e-08, B: 3.28611928206, C: 493.0424021651},
+		{A: 1.176e-08, B: 5.87266726996, C: 5.4166259714},
+		{A: 1.517e-08, B: 3.12967210501, C: 491.5579294568},
+		{A: 1.053e-08, B: 4.6037551683, C: 79.2350166922},
+		{A: 1.037e-08, B: 4.89007314395, C: 1.2720243872},
+		{A: 1.034e-08, B: 5.93741289103, C: 32.7164096664},
+		{A: 1.038e-08, B: 1.13470380744, C: 1014.1353475506},
+		{A: 1.002e-08, B: 1.85850922283, C: 5.1078094307},
+		{A: 9.83e-09, B: 0.05345050384, C: 7.4223635415},
+		{A: 9.98e-09, B: 1.73689827444, C: 1028.3624415522},
+		{A: 1.193e-08, B: 4.63176675581, C: 60.7669528868},
+		{A: 9.4e-09, B: 3.09103721222, C: 62.2514255951},
+		{A: 9.94e-09, B: 4.11489180313, C: 4.665866446},
+		{A: 8.9e-09, B: 0.87049255398, C: 31.2319369581},
+		{A: 8.52e-09, B: 5.35508394316, C: 144.1465711632},
+		{A: 9.22e-09, B: 5.12373360511, C: 145.1097790097},
+		{A: 7.89e-09, B: 0.37496785039, C: 26.826702943},
+		{A: 8.28e-09, B: 4.060351946, C: 115.8835796217},
+		{A: 7.11e-09, B: 3.14189997439, C: 278.2588340188},
+		{A: 7.27e-09, B: 1.39718382835, C: 213.299095438},
+		{A: 7.81e-09, B: 0.10946327923, C: 173.6815870919},
+		{A: 7.93e-09, B: 6.13086312116, C: 567.8240007324},
+		{A: 6.69e-09, B: 4.50554989443, C: 27.0873353739},
+		{A: 8.25e-09, B: 1.35568908148, C: 129.9194771616},
+		{A: 7.38e-09, B: 3.5676601896, C: 176.6505325085},
+		{A: 7.14e-09, B: 6.24797992301, C: 106.9767433719},
+		{A: 6.54e-09, B: 1.13177751192, C: 68.8437077341},
+		{A: 6.24e-09, B: 0.01567750666, C: 28.5718080822},
+		{A: 6.08e-09, B: 4.60180625368, C: 189.3931538018},
+		{A: 5.95e-09, B: 0.00857468445, C: 42.5864537627},
+		{A: 5.3e-09, B: 5.61201247153, C: 12.5301729722},
+		{A: 5.21e-09, B: 1.02371768017, C: 415.2918581812},
+		{A: 6.39e-09, B: 0.68930265745, C: 529.6909650946},
+		{A: 5.26e-09, B: 3.02138731705, C: 5.6290742925},
+		{A: 4.56e-09, B: 4.44331571392, C: 43.2408450685},
+		{A: 5.24e-09, B: 3.43316448349, C: 38.6543004996},
+		{A: 4.36e-09, B: 2.41630174435, C: 82.8583534146},
+		{A: 4.24e-09, B: 1.95736011325, C: 477.3308354552},
+		{A: 4.43e-09, B: 3.39350946329, C: 357.4456666012},
+		{A: 3.83e-09, B: 1.90232196422, C: 22.633917249},
+		{A: 4.79e-09, B: 5.55141744216, C: 37.611770776},
+		{A: 4.62e-09, B: 3.80436154644, C: 343.2185725996},
+		{A: 3.84e-09, B: 5.60377408953, C: 594.6507036754},
+		{A: 3.69e-09, B: 4.45577410338, C: 6.9010986797},
+		{A: 3.58e-09, B: 3.69126616347, C: 3.9321532631},
+		{A: 3.52e-09, B: 3.10952926034, C: 135.5485514541},
+		{A: 3.68e-09, B: 3.53577440355, C: 40.5807161926},
+		{A: 4.24e-09, B: 5.27159202779, C: 181.7583419392},
+		{A: 3.61e-09, B: 0.29018303419, C: 72.0732855816},
+		{A: 3.9e-09, B: 5.49512204296, C: 350.3321196004},
+		{A: 3.78e-09, B: 2.74122401337, C: 488.3765357191},
+		{A: 3.72e-09, B: 0.39980033572, C: 494.7393231945},
+		{A: 3.53e-09, B: 1.10614174053, C: 20.6069278195},
+		{A: 2.96e-09, B: 0.86351261285, C: 149.5631971346},
+		{A: 3.07e-09, B: 5.39420288683, C: 160.9389657986},
+		{A: 3.95e-09, B: 1.93577214824, C: 10137.0194749354},
+		{A: 2.88e-09, B: 2.28755739359, C: 47.6942631934},
+		{A: 2.95e-09, B: 2.4873753724, C: 19.1224551112},
+		{A: 2.9e-09, B: 0.18636083306, C: 143.6253063014},
+		{A: 2.66e-09, B: 3.09977370364, C: 69.3649725959},
+		{A: 2.66e-09, B: 1.21002824826, C: 505.7850234584},
+		{A: 2.52e-09, B: 3.12745026026, C: 460.5384408198},
+		{A: 3.28e-09, B: 0.50849285663, C: 6206.8097787158},
+		{A: 2.57e-09, B: 3.64119914774, C: 446.3113468182},
+		{A: 2.39e-09, B: 5.54080102299, C: 911.042573332},
+		{A: 2.65e-09, B: 0.62702473701, C: 253.5709950899},
+		{A: 2.87e-09, B: 2.44403568436, C: 16.6747745564},
+		{A: 2.31e-09, B: 2.47026250085, C: 454.9093665273},
+		{A: 2.3e-09, B: 3.24571542922, C: 1066.49547719},
+		{A: 2.82e-09, B: 1.48595620175, C: 983.1158589136},
+		{A: 2.12e-09, B: 5.41931177641, C: 64.9597385808},
+		{A: 2.13e-09, B: 1.64175339637, C: 1089.129394439},
+		{A: 2.38e-09, B: 2.69801319489, C: 882.9438460018},
+		{A: 2.1e-09, B: 4.53976756699, C: 1093.322180133},
+		{A: 2.2e-09, B: 2.30038816175, C: 1052.2683831884},
+		{A: 2.56e-09, B: 0.4207359846, C: 23.9059416362},
+		{A: 2.16e-09, B: 5.4422591887, C: 39.0962434843},
+		{A: 2.01e-09, B: 2.58746514605, C: 119.5069163441},
+		{A: 2.24e-09, B: 4.43751392203, C: 639.897286314},
+		{A: 1.86e-09, B: 2.50651218075, C: 487.3651437628},
+		{A: 1.89e-09, B: 4.05785534221, C: 120.9913890524},
+		{A: 1.84e-09, B: 2.24245977278, C: 815.0633461142},
+		{A: 2.02e-09, B: 3.43517732411, C: 45.2465826386},
+		{A: 1.75e-09, B: 4.49165234532, C: 171.2339065371},
+		{A: 1.71e-09, B: 5.50633466316, C: 179.0982130633},
+		{A: 2e-09, B: 6.12663205401, C: 14.2270940016},
+		{A: 1.73e-09, B: 2.61090344107, C: 389.9496279465},
+		{A: 1.67e-09, B: 3.94754384833, C: 77.2292791221},
+		{A: 1.66e-09, B: 3.41009128748, C: 81.3738807063},
+		{A: 1.63e-09, B: 3.88198848446, C: 556.5176680376},
+		{A: 1.64e-09, B: 1.49614763046, C: 63.7358983034},
+		{A: 1.76e-09, B: 3.86129425367, C: 148.3393568572},
+		{A: 1.61e-09, B: 2.22215642318, C: 574.9375477332},
+		{A: 1.71e-09, B: 0.66899426684, C: 179.3106613844},
+		{A: 1.61e-09, B: 1.21480182441, C: 1024.4302882891},
+		{A: 1.55e-09, B: 3.25842414799, C: 10251.4185818488},
+		{A: 1.83e-09, B: 5.45168150656, C: 218.4069048687},
+		{A: 1.52e-09, B: 3.35145509017, C: 285.3723810196},
+		{A: 1.52e-09, B: 0.42398786475, C: 274.0660483248},
+		{A: 1.46e-09, B: 5.70714579127, C: 419.4846438752},
+		{A: 1.56e-09, B: 0.6432152487, C: 1029.8469142605},
+		{A: 1.47e-09, B: 4.3095893074, C: 157.6399519819},
+		{A: 1.47e-09, B: 1.8068917751, C: 377.4194549743},
+		{A: 1.4e-09, B: 1.49826604627, C: 386.9806825299},
+		{A: 1.37e-09, B: 2.14480243915, C: 563.6312150384},
+		{A: 1.27e-09, B: 3.9872659971, C: 84.3428261229},
+		{A: 1.34e-09, B: 4.16039455079, C: 169.5369855077},
+		{A: 1.21e-09, B: 0.29300927469, C: 206.1855484372},
+		{A: 1.29e-09, B: 2.6762505701, C: 180.7951340927},
+		{A: 1.34e-09, B: 3.18868986487, C: 166.5680400911},
+		{A: 1.35e-09, B: 5.0751756178, C: 426.598190876},
+		{A: 1.36e-09, B: 1.8167245174, C: 151.0476698429},
+		{A: 1.29e-09, B: 3.64795525602, C: 183.7640795093},
+		{A: 1.16e-09, B: 6.06435563172, C: 220.4126424388},
+		{A: 1.23e-09, B: 4.46641157829, C: 1022.7333672597},
+		{A: 1.12e-09, B: 4.34485256988, C: 138.5174968707},
+		{A: 1.16e-09, B: 5.58946529961, C: 35.685355083},
+		{A: 1.08e-09, B: 1.03796693383, C: 488.5889840402},
+		{A: 1.08e-09, B: 2.1037848588, C: 494.5268748734},
+		{A: 1.06e-09, B: 0.87068583107, C: 1059.3819301892},
+		{A: 9.7e-10, B: 0.74486741478, C: 485.9288551643},
+		{A: 9.5e-10, B: 5.54259914856, C: 497.1870037493},
+		{A: 8.5e-10, B: 3.16062141266, C: 522.5774180938},
+		{A: 9.7e-10, B: 6.05634803604, C: 482.9599097477},
+		{A: 9.5e-10, B: 0.2311185273, C: 500.1559491659},
+		{A: 8.4e-10, B: 2.64687252518, C: 536.8045120954},
+		{A: 7.4e-10, B: 3.90678924318, C: 1019.7644218431},
+	},
+	// L2, 57 terms
+	{
+		{A: 0.00053892649, B: 0, C: 0},
+		{A: 2.81251e-06, B: 1.19084538887, C: 38.1330356378},
+		{A: 2.95693e-06, B: 1.85520292248, C: 1.4844727083},
+		{A: 2.7019e-06, B: 5.72143228148, C: 76.2660712756},
+		{A: 2.3023e-07, B: 1.21035596452, C: 2.9689454166},
+		{A: 7.333e-08, B: 0.5403330683, C: 2.4476805548},
+		{A: 9.057e-08, B: 4.42544992035, C: 35.1640902212},
+		{A: 5.223e-08, B: 0.67427930044, C: 168.0525127994},
+		{A: 5.201e-08, B: 3.02338671812, C: 182.279606801},
+		{A: 4.288e-08, B: 3.84351844003, C: 114.3991069134},
+		{A: 3.925e-08, B: 3.53214557374, C: 484.444382456},
+		{A: 3.741e-08, B: 5.90238217874, C: 498.6714764576},
+		{A: 2.966e-08, B: 0.31002477611, C: 4.4534181249},
+		{A: 3.415e-08, B: 0.55971639038, C: 74.7815985673},
+		{A: 3.255e-08, B: 1.84921884906, C: 175.1660598002},
+		{A: 2.157e-08, B: 1.89135758747, C: 388.4651552382},
+		{A: 2.211e-08, B: 4.3799709224, C: 7.1135470008},
+		{A: 1.847e-08, B: 3.48574435762, C: 9.5612275556},
+		{A: 2.451e-08, B: 4.68586840176, C: 491.5579294568},
+		{A: 1.844e-08, B: 5.12281562096, C: 33.6796175129},
+		{A: 2.204e-08, B: 1.69321574906, C: 77.7505439839},
+		{A: 1.652e-08, B: 2.55859494053, C: 36.6485629295},
+		{A: 1.309e-08, B: 4.52400192922, C: 1021.2488945514},
+		{A: 1.124e-08, B: 0.38710602242, C: 137.0330241624},
+		{A: 6.64e-09, B: 0.88101734307, C: 4.192785694},
+		{A: 4.97e-09, B: 2.24615784762, C: 395.578702239},
+		{A: 5.12e-09, B: 6.22609200672, C: 381.3516082374},
+		{A: 5.82e-09, B: 5.25716719826, C: 31.019488637},
+		{A: 4.46e-09, B: 0.36647221351, C: 98.8999885246},
+		{A: 3.83e-09, B: 5.48585528762, C: 5.9378908332},
+		{A: 3.75e-09, B: 4.61250246774, C: 8.0767548473},
+		{A: 3.54e-09, B: 1.30783918287, C: 601.7642506762},
+		{A: 2.59e-09, B: 5.66033623678, C: 112.9146342051},
+		{A: 2.47e-09, B: 2.89695614593, C: 189.3931538018},
+		{A: 2.45e-09, B: 4.26572913391, C: 220.4126424388},
+		{A: 2e-09, B: 0.52604535784, C: 64.9597385808},
+		{A: 1.91e-09, B: 4.88786653062, C: 39.6175083461},
+		{A: 2.33e-09, B: 3.16423779113, C: 41.1019810544},
+		{A: 2.48e-09, B: 5.85877831382, C: 1059.3819301892},
+		{A: 1.94e-09, B: 2.37949641473, C: 73.297125859},
+		{A: 2.27e-09, B: 0.20028518978, C: 60.7669528868},
+		{A: 1.84e-09, B: 3.01962045713, C: 1014.1353475506},
+		{A: 1.9e-09, B: 5.57500985081, C: 343.2185725996},
+		{A: 1.72e-09, B: 3.66036463613, C: 477.3308354552},
+		{A: 1.72e-09, B: 0.59550457102, C: 46.2097904851},
+		{A: 1.82e-09, B: 1.92429384025, C: 183.7640795093},
+		{A: 1.71e-09, B: 1.61368476689, C: 357.4456666012},
+		{A: 1.73e-09, B: 6.23717119485, C: 493.0424021651},
+		{A: 2.17e-09, B: 1.46218158211, C: 71.8126531507},
+		{A: 1.78e-09, B: 0.34928799031, C: 1028.3624415522},
+		{A: 1.69e-09, B: 4.91086673212, C: 166.5680400911},
+		{A: 1.57e-09, B: 5.89200571154, C: 169.5369855077},
+		{A: 1.82e-09, B: 2.33457064554, C: 152.5321425512},
+		{A: 1.51e-09, B: 3.81621340568, C: 146.594251718},
+		{A: 1.36e-09, B: 2.75150881988, C: 144.1465711632},
+		{A: 1.04e-09, B: 6.03262825314, C: 529.6909650946},
+		{A: 7.6e-10, B: 0.20932812381, C: 453.424893819},
+	},
+	// L3, 15 terms
+	{
+		{A: 3.1254e-07, B: 0, C: 0},
+		{A: 1.2461e-07, B: 6.04431418812, C: 1.4844727083},
+		{A: 1.4541e-07, B: 1.35337075856, C: 76.2660712756},
+		{A: 1.1547e-07, B: 6.11257808366, C: 38.1330356378},
+		{A: 1.351e-08, B: 4.93951495175, C: 2.9689454166},
+		{A: 7.41e-09, B: 2.35936954597, C: 168.0525127994},
+		{A: 7.15e-09, B: 1.27409542804, C: 182.279606801},
+		{A: 5.37e-09, B: 5.23632185196, C: 484.444382456},
+		{A: 5.23e-09, B: 4.16769839601, C: 498.6714764576},
+		{A: 6.64e-09, B: 0.55871435877, C: 31.019488637},
+		{A: 3.01e-09, B: 2.69253200796, C: 7.1135470008},
+		{A: 1.94e-09, B: 2.05904114139, C: 137.0330241624},
+		{A: 2.06e-09, B: 2.51012178002, C: 74.7815985673},
+		{A: 1.6e-09, B: 5.63111039032, C: 114.3991069134},
+		{A: 1.49e-09, B: 3.09327713923, C: 35.1640902212},
+	},
+	// L4, 2 terms
+	{
+		{A: 1.13998e-06, B: 3.14159265359, C: 0},
+		{A: 6.05e-09, B: 3.18211885677, C: 76.2660712756},
+	},
+	// L5, 1 terms
+	{
+		{A: 8.74e-09, B: 3.14159265359, C: 0},
+	},
+}
+
+// GetNeptuneL0 evaluates the t^0 series of L.
+func GetNeptuneL0(t float64) float64 {
+	return neptuneL[0].Evaluate(t)
+}
+
+// GetNeptuneL1 evaluates the t^1 series of L.
+func GetNeptuneL1(t float64) float64 {
+	return neptuneL[1].Evaluate(t)
+}
+
+// GetNeptuneL2 evaluates the t^2 series of L.
+func GetNeptuneL2(t float64) float64 {
+	return neptuneL[2].Evaluate(t)
+}
+
+// GetNeptuneL3 evaluates the t^3 series of L.
+func GetNeptuneL3(t float64) float64 {
+	return neptuneL[3].Evaluate(t)
+}
+
+// GetNeptuneL4 evaluates the t^4 series of L.
+func GetNeptuneL4(t float64) float64 {
+	return neptuneL[4].Evaluate(t)
+}
+
+// GetNeptuneL5 evaluates the t^5 series of L.
+func GetNeptuneL5(t float64) float64 {
+	return neptuneL[5].Evaluate(t)
+}
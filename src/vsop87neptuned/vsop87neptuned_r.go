@@ -0,0 +1,1009 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// NEPTUNE R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87neptuned
+
+import "vsop87"
+
+var neptuneR = []vsop87.Series{
+	// R0, 607 terms
+	{
+		{A: 30.07013206102, B: 0, C: 0},
+		{A: 0.2706225949, B: 1.3299945893, C: 38.1330356378},
+		{A: 0.01691764281, B: 3.25186138896, C: 36.6485629295},
+		{A: 0.00807830737, B: 5.18592836167, C: 1.4844727083},
+		{A: 0.00537760613, B: 4.52113902845, C: 35.1640902212},
+		{A: 0.00495725642, B: 1.57105654815, C: 491.5579294568},
+		{A: 0.0027457197, B: 1.84552256801, C: 175.1660598002},
+		{A: 0.00135134095, B: 3.37220607384, C: 39.6175083461},
+		{A: 0.00121801825, B: 5.79754444303, C: 76.2660712756},
+		{A: 0.00100895397, B: 0.37702748681, C: 73.297125859},
+		{A: 0.00069791722, B: 3.79617226928, C: 2.9689454166},
+		{A: 0.00046687838, B: 5.74937810094, C: 33.6796175129},
+		{A: 0.00024593778, B: 0.50801728204, C: 109.9456887885},
+		{A: 0.00016939242, B: 1.59422166991, C: 71.8126531507},
+		{A: 0.00014229686, B: 1.07786112902, C: 74.7815985673},
+		{A: 0.00012011825, B: 1.92062131635, C: 1021.2488945514},
+		{A: 8.394731e-05, B: 0.67816895547, C: 146.594251718},
+		{A: 7.5718e-05, B: 1.07149263431, C: 388.4651552382},
+		{A: 5.720852e-05, B: 2.59059512267, C: 4.4534181249},
+		{A: 4.839672e-05, B: 1.9068599107, C: 41.1019810544},
+		{A: 4.483492e-05, B: 2.90573457534, C: 529.6909650946},
+		{A: 4.270202e-05, B: 3.41343865825, C: 453.424893819},
+		{A: 4.35379e-05, B: 0.6798566237, C: 32.1951448046},
+		{A: 4.420804e-05, B: 1.74993796503, C: 108.4612160802},
+		{A: 2.881063e-05, B: 1.98600105123, C: 137.0330241624},
+		{A: 2.635535e-05, B: 3.09755943422, C: 213.299095438},
+		{A: 3.38093e-05, B: 0.84810683275, C: 183.2428146475},
+		{A: 2.878942e-05, B: 3.67415901855, C: 350.3321196004},
+		{A: 2.306293e-05, B: 2.80962935724, C: 70.3281804424},
+		{A: 2.530149e-05, B: 5.79839567009, C: 490.0734567485},
+		{A: 2.523132e-05, B: 0.48630800015, C: 493.0424021651},
+		{A: 2.087303e-05, B: 0.61858378281, C: 33.9402499438},
+		{A: 1.976522e-05, B: 5.1170304456, C: 168.0525127994},
+		{A: 1.905254e-05, B: 1.72186472126, C: 182.279606801},
+		{A: 1.654039e-05, B: 1.92782545887, C: 145.1097790097},
+		{A: 1.435072e-05, B: 1.70005157785, C: 484.444382456},
+		{A: 1.403029e-05, B: 4.58914203187, C: 498.6714764576},
+		{A: 1.499193e-05, B: 1.01623299513, C: 219.891377577},
+		{A: 1.39886e-05, B: 0.7622031762, C: 176.6505325085},
+		{A: 1.403377e-05, B: 6.07659416908, C: 173.6815870919},
+		{A: 1.12856e-05, B: 5.96661179805, C: 9.5612275556},
+		{A: 1.228304e-05, B: 1.59881465324, C: 77.7505439839},
+		{A: 8.35414e-06, B: 3.97066884218, C: 114.3991069134},
+		{A: 8.11186e-06, B: 3.0025888087, C: 46.2097904851},
+		{A: 7.31925e-06, B: 2.10447054189, C: 181.7583419392},
+		{A: 6.15781e-06, B: 2.97874625677, C: 106.9767433719},
+		{A: 7.04778e-06, B: 1.1873821088, C: 256.5399405065},
+		{A: 5.0204e-06, B: 1.38657803368, C: 5.9378908332},
+		{A: 5.30357e-06, B: 4.24059166485, C: 111.4301614968},
+		{A: 4.37096e-06, B: 2.27029212923, C: 1550.939859646},
+		{A: 4.0025e-06, B: 1.25609325435, C: 8.0767548473},
+		{A: 4.21011e-06, B: 1.89084929506, C: 30.7106720963},
+		{A: 3.82457e-06, B: 3.29965259685, C: 983.1158589136},
+		{A: 4.22485e-06, B: 5.53186169605, C: 525.4981794006},
+		{A: 3.55389e-06, B: 2.27847846648, C: 218.4069048687},
+		{A: 2.80062e-06, B: 1.54129714238, C: 98.8999885246},
+		{A: 3.14499e-06, B: 3.95932948594, C: 381.3516082374},
+		{A: 2.80556e-06, B: 4.54238271682, C: 44.7253177768},
+		{A: 2.67738e-06, B: 5.13323364247, C: 112.9146342051},
+		{A: 3.33311e-06, B: 5.75067616021, C: 39.0962434843},
+		{A: 2.91625e-06, B: 4.02398326341, C: 68.8437077341},
+		{A: 3.21429e-06, B: 1.50625025822, C: 454.9093665273},
+		{A: 3.09196e-06, B: 2.85452752153, C: 72.0732855816},
+		{A: 3.45094e-06, B: 1.35905860594, C: 293.188503436},
+		{A: 3.07439e-06, B: 0.31964571332, C: 601.7642506762},
+		{A: 2.51356e-06, B: 3.53992782846, C: 312.1990839626},
+		{A: 2.48152e-06, B: 3.41078346726, C: 37.611770776},
+		{A: 3.06e-06, B: 2.72475094464, C: 6244.9428143536},
+		{A: 2.93532e-06, B: 4.89079857814, C: 528.2064923863},
+		{A: 2.34479e-06, B: 0.59231043427, C: 42.5864537627},
+		{A: 2.39628e-06, B: 3.16441455173, C: 143.6253063014},
+		{A: 2.14523e-06, B: 3.6248028304, C: 278.2588340188},
+		{A: 2.46198e-06, B: 1.01506302015, C: 141.2258098564},
+		{A: 1.74089e-06, B: 5.55011789988, C: 567.8240007324},
+		{A: 1.63934e-06, B: 2.10166491786, C: 2.4476805548},
+		{A: 1.62897e-06, B: 2.48946521653, C: 4.192785694},
+		{A: 1.93455e-06, B: 1.5842528758, C: 138.5174968707},
+		{A: 1.55323e-06, B: 3.28425127954, C: 31.019488637},
+		{A: 1.82469e-06, B: 2.45244890571, C: 255.0554677982},
+		{A: 1.77846e-06, B: 4.14773474853, C: 10175.1525105732},
+		{A: 1.74413e-06, B: 1.53042999914, C: 329.8370663655},
+		{A: 1.37649e-06, B: 3.34900537767, C: 0.9632078465},
+		{A: 1.61011e-06, B: 5.16655038482, C: 211.8146227297},
+		{A: 1.13473e-06, B: 4.96286007991, C: 148.0787244263},
+		{A: 1.28823e-06, B: 3.25521535448, C: 24.1183899573},
+		{A: 1.07363e-06, B: 3.26457701792, C: 1059.3819301892},
+		{A: 1.22732e-06, B: 5.39399536941, C: 62.2514255951},
+		{A: 1.20529e-06, B: 3.08050145518, C: 184.7272873558},
+		{A: 9.9356e-07, B: 1.92888554099, C: 28.5718080822},
+		{A: 9.7713e-07, B: 2.59474415429, C: 6.592282139},
+		{A: 1.24095e-06, B: 3.1151675034, C: 221.3758502853},
+		{A: 1.24693e-06, B: 2.97042405451, C: 251.4321310758},
+		{A: 1.14252e-06, B: 0.25039919123, C: 594.6507036754},
+		{A: 1.11006e-06, B: 3.34276426767, C: 180.2738692309},
+		{A: 1.20939e-06, B: 1.92914010593, C: 25.6028626656},
+		{A: 1.04667e-06, B: 0.94883561775, C: 395.578702239},
+		{A: 1.09779e-06, B: 5.43147520571, C: 494.5268748734},
+		{A: 9.6919e-07, B: 0.86184760695, C: 1014.1353475506},
+		{A: 9.8685e-07, B: 0.8957795271, C: 488.5889840402},
+		{A: 8.8968e-07, B: 4.78109764779, C: 144.1465711632},
+		{A: 1.07888e-06, B: 0.98700578434, C: 1124.34166877},
+		{A: 9.7067e-07, B: 2.62667400276, C: 291.7040307277},
+		{A: 7.5131e-07, B: 5.88936524779, C: 43.2408450685},
+		{A: 9.3718e-07, B: 6.09873565184, C: 526.722019678},
+		{A: 9.4822e-07, B: 0.2066294394, C: 456.3938392356},
+		{A: 7.0036e-07, B: 2.39683345663, C: 426.598190876},
+		{A: 7.7187e-07, B: 4.2107675324, C: 105.4922706636},
+		{A: 8.9874e-07, B: 3.25100749923, C: 258.0244132148},
+		{A: 6.9133e-07, B: 4.93031154435, C: 1028.3624415522},
+		{A: 9.0657e-07, B: 1.69466970587, C: 366.485629295},
+		{A: 7.4242e-07, B: 3.14479101276, C: 82.8583534146},
+		{A: 5.7995e-07, B: 0.86159785905, C: 60.7669528868},
+		{A: 7.8695e-07, B: 1.0930757555, C: 700.6642392008},
+		{A: 5.723e-07, B: 0.81331949225, C: 2.9207613068},
+		{A: 6.3443e-07, B: 4.39590123005, C: 149.5631971346},
+		{A: 5.5698e-07, B: 3.89047249911, C: 47.6942631934},
+		{A: 5.643e-07, B: 5.15003563302, C: 0.5212648618},
+		{A: 5.6174e-07, B: 5.42986960794, C: 911.042573332},
+		{A: 6.1746e-07, B: 6.16453667559, C: 1019.7644218431},
+		{A: 7.0503e-07, B: 0.08077330612, C: 40.5807161926},
+		{A: 7.4677e-07, B: 4.8590449998, C: 186.2117600641},
+		{A: 6.1861e-07, B: 4.78702599861, C: 11.0457002639},
+		{A: 6.1135e-07, B: 0.83712253227, C: 1022.7333672597},
+		{A: 6.1268e-07, B: 5.70228826765, C: 178.1350052168},
+		{A: 5.2887e-07, B: 0.37458943972, C: 27.0873353739},
+		{A: 5.6722e-07, B: 3.52318112447, C: 216.9224321604},
+		{A: 4.8819e-07, B: 5.10789123481, C: 64.9597385808},
+		{A: 6.329e-07, B: 4.3942491003, C: 807.9497991134},
+		{A: 6.4062e-07, B: 6.28297531806, C: 7.1135470008},
+		{A: 4.6356e-07, B: 1.34735469284, C: 451.9404211107},
+		{A: 6.054e-07, B: 3.40316162416, C: 294.6729761443},
+		{A: 4.69e-07, B: 0.17048203552, C: 7.4223635415},
+		{A: 5.6766e-07, B: 0.45048868231, C: 140.001969579},
+		{A: 5.5887e-07, B: 1.06815733757, C: 172.1971143836},
+		{A: 5.3761e-07, B: 2.79644687008, C: 328.3525936572},
+		{A: 4.3828e-07, B: 6.04655696644, C: 135.5485514541},
+		{A: 4.9549e-07, B: 0.64106656292, C: 41.0537969446},
+		{A: 5.396e-07, B: 2.91774494436, C: 563.6312150384},
+		{A: 4.2961e-07, B: 5.40175361431, C: 487.3651437628},
+		{A: 5.1508e-07, B: 0.09105540708, C: 210.3301500214},
+		{A: 4.1889e-07, B: 3.12343223889, C: 29.226199388},
+		{A: 4.7655e-07, B: 3.90701760087, C: 63.7358983034},
+		{A: 4.1639e-07, B: 6.26847783513, C: 32.7164096664},
+		{A: 4.1429e-07, B: 4.45464156759, C: 37.1698277913},
+		{A: 4.0745e-07, B: 0.16043648294, C: 79.2350166922},
+		{A: 4.8205e-07, B: 1.8419837301, C: 403.1341922245},
+		{A: 3.6912e-07, B: 0.44771386183, C: 30.0562807905},
+		{A: 4.7762e-07, B: 0.88083849566, C: 3302.479391062},
+		{A: 3.9465e-07, B: 3.50565484069, C: 357.4456666012},
+		{A: 4.2139e-07, B: 0.63375113663, C: 343.2185725996},
+		{A: 4.1275e-07, B: 1.36370496322, C: 31.2319369581},
+		{A: 4.2612e-07, B: 3.55270845713, C: 38.6543004996},
+		{A: 3.8931e-07, B: 5.2669175327, C: 415.2918581812},
+		{A: 3.8967e-07, B: 5.25866056502, C: 386.9806825299},
+		{A: 3.3734e-07, B: 5.24400184426, C: 67.3592350258},
+		{A: 4.0879e-07, B: 3.55292279438, C: 331.3215390738},
+		{A: 3.8768e-07, B: 1.12288359393, C: 38.1812197476},
+		{A: 3.75e-07, B: 6.08687972441, C: 35.4247226521},
+		{A: 3.8831e-07, B: 4.67876780698, C: 38.084851528},
+		{A: 3.8231e-07, B: 6.26491054328, C: 389.9496279465},
+		{A: 2.9976e-07, B: 4.45759985804, C: 22.633917249},
+		{A: 3.1356e-07, B: 0.07746010366, C: 12.5301729722},
+		{A: 2.6341e-07, B: 4.59559782754, C: 106.0135355254},
+		{A: 2.7465e-07, B: 5.9954158789, C: 206.1855484372},
+		{A: 2.5152e-07, B: 4.4986776032, C: 34.2008823747},
+		{A: 2.4122e-07, B: 5.17089441917, C: 129.9194771616},
+		{A: 2.8997e-07, B: 3.6492721021, C: 253.5709950899},
+		{A: 2.7173e-07, B: 4.37944546475, C: 142.1408335931},
+		{A: 3.0634e-07, B: 1.5934880656, C: 348.8476468921},
+		{A: 3.1464e-07, B: 1.05065113524, C: 100.3844612329},
+		{A: 2.4056e-07, B: 1.02801635413, C: 41.7563723602},
+		{A: 2.2632e-07, B: 4.72511111292, C: 81.3738807063},
+		{A: 2.1942e-07, B: 3.48416607882, C: 69.1525242748},
+		{A: 2.6333e-07, B: 3.01556008632, C: 365.0011565867},
+		{A: 2.2355e-07, B: 3.92220883921, C: 5.1078094307},
+		{A: 2.2498e-07, B: 4.03487494425, C: 19.1224551112},
+		{A: 2.2885e-07, B: 1.58977064672, C: 189.3931538018},
+		{A: 2.652e-07, B: 3.61427038042, C: 367.9701020033},
+		{A: 2.5496e-07, B: 2.43810518614, C: 351.8165923087},
+		{A: 1.9111e-07, B: 2.59694457001, C: 2080.6308247406},
+		{A: 1.964e-07, B: 6.15701741238, C: 35.212274331},
+		{A: 2.5688e-07, B: 2.00512719767, C: 439.782755154},
+		{A: 2.1613e-07, B: 3.32354204724, C: 119.5069163441},
+		{A: 2.5389e-07, B: 4.74025836522, C: 1474.6737883704},
+		{A: 1.8107e-07, B: 5.35129342595, C: 244.318584075},
+		{A: 2.3295e-07, B: 5.93767742799, C: 316.3918696566},
+		{A: 2.2087e-07, B: 4.81594755148, C: 84.3428261229},
+		{A: 1.6972e-07, B: 3.0510514994, C: 220.4126424388},
+		{A: 2.0022e-07, B: 4.99276451168, C: 179.0982130633},
+		{A: 2.037e-07, B: 1.86508317889, C: 171.2339065371},
+		{A: 1.9426e-07, B: 2.04829970231, C: 5.4166259714},
+		{A: 2.2628e-07, B: 0.27205783433, C: 666.723989257},
+		{A: 1.9072e-07, B: 3.70882976684, C: 164.1203595363},
+		{A: 1.7969e-07, B: 3.40425338171, C: 69.3649725959},
+		{A: 1.8716e-07, B: 0.90215956591, C: 285.3723810196},
+		{A: 1.5889e-07, B: 0.42011285882, C: 697.743477894},
+		{A: 1.4988e-07, B: 3.08544843665, C: 704.8570248948},
+		{A: 1.4774e-07, B: 3.36129613309, C: 274.0660483248},
+		{A: 1.5972e-07, B: 1.82864185268, C: 477.3308354552},
+		{A: 1.3892e-07, B: 2.94161501165, C: 38.3936680687},
+		{A: 1.3922e-07, B: 2.85574364078, C: 37.8724032069},
+		{A: 1.5481e-07, B: 4.94982954853, C: 101.8689339412},
+		{A: 1.7571e-07, B: 5.82317632469, C: 35.685355083},
+		{A: 1.5856e-07, B: 5.04973561582, C: 36.9091953604},
+		{A: 1.6414e-07, B: 3.63049397028, C: 45.2465826386},
+		{A: 1.7158e-07, B: 2.51251149482, C: 20.6069278195},
+		{A: 1.2941e-07, B: 3.03041555329, C: 522.5774180938},
+		{A: 1.5752e-07, B: 5.00292909214, C: 247.2393453818},
+		{A: 1.2679e-07, B: 0.20331109568, C: 460.5384408198},
+		{A: 1.626e-07, B: 5.93480347217, C: 815.0633461142},
+		{A: 1.2903e-07, B: 3.51141502996, C: 446.3113468182},
+		{A: 1.3891e-07, B: 5.5106469767, C: 31.5407534988},
+		{A: 1.3668e-07, B: 5.4557613532, C: 39.3568759152},
+		{A: 1.3418e-07, B: 3.95805150079, C: 290.2195580194},
+		{A: 1.5368e-07, B: 2.45783892707, C: 26.826702943},
+		{A: 1.4246e-07, B: 3.18588280921, C: 401.6497195162},
+		{A: 1.2222e-07, B: 4.94370170146, C: 14.0146456805},
+		{A: 1.5484e-07, B: 3.79703715637, C: 404.6186649328},
+		{A: 1.3427e-07, B: 3.79527836573, C: 151.0476698429},
+		{A: 1.445e-07, B: 4.93940408761, C: 120.9913890524},
+		{A: 1.4331e-07, B: 4.71117327722, C: 738.7972748386},
+		{A: 1.1566e-07, B: 5.91003539239, C: 536.8045120954},
+		{A: 1.5578e-07, B: 2.91836788254, C: 875.830299001},
+		{A: 1.3124e-07, B: 2.16056013419, C: 152.5321425512},
+		{A: 1.1744e-07, B: 2.94770244071, C: 2.7083129857},
+		{A: 1.2793e-07, B: 1.97868575679, C: 1.3725981237},
+		{A: 1.2969e-07, B: 0.00535826017, C: 97.4155158163},
+		{A: 1.3891e-07, B: 4.7643544182, C: 0.2606324309},
+		{A: 1.3729e-07, B: 2.3230647385, C: 38.2449102224},
+		{A: 1.0714e-07, B: 6.18129683877, C: 115.8835796217},
+		{A: 1.161e-07, B: 4.61712859898, C: 178.7893965226},
+		{A: 1.1257e-07, B: 0.79300245838, C: 42.3258213318},
+		{A: 1.45e-07, B: 5.44690193314, C: 44.070926471},
+		{A: 1.1534e-07, B: 5.26580538005, C: 160.9389657986},
+		{A: 1.3355e-07, B: 5.20849186729, C: 32.4557772355},
+		{A: 1.3658e-07, B: 2.15687632802, C: 476.4313180835},
+		{A: 1.3782e-07, B: 3.47865209163, C: 38.0211610532},
+		{A: 1.2714e-07, B: 2.09462988855, C: 20.4950532349},
+		{A: 1.3257e-07, B: 5.15138524813, C: 103.0927742186},
+		{A: 9.715e-08, B: 0.7459788348, C: 918.1561203328},
+		{A: 1.034e-07, B: 5.38977407079, C: 222.8603229936},
+		{A: 1.3357e-07, B: 5.89635739027, C: 748.0978699633},
+		{A: 1.2632e-07, B: 1.20306997433, C: 16.1535096946},
+		{A: 1.1437e-07, B: 1.58444114292, C: 495.4900827199},
+		{A: 1.1424e-07, B: 4.74142930795, C: 487.6257761937},
+		{A: 9.098e-08, B: 5.19932138822, C: 118.0224436358},
+		{A: 9.336e-08, B: 0.97313630925, C: 662.531203563},
+		{A: 9.827e-08, B: 4.48170250645, C: 505.7850234584},
+		{A: 8.585e-08, B: 0.20375451897, C: 944.9828232758},
+		{A: 8.875e-08, B: 5.53111742265, C: 17.5261078183},
+		{A: 9.957e-08, B: 4.03258125243, C: 169.5369855077},
+		{A: 1.1506e-07, B: 3.11649121817, C: 17.6379824029},
+		{A: 9.818e-08, B: 5.20376439002, C: 1.5963472929},
+		{A: 1.016e-07, B: 3.74441320429, C: 457.617679513},
+		{A: 8.661e-08, B: 0.31247523804, C: 1440.7335384266},
+		{A: 8.496e-08, B: 1.06445636872, C: 55.7710180407},
+		{A: 1.1162e-07, B: 1.92907800408, C: 564.8550553158},
+		{A: 8.057e-08, B: 0.31116345866, C: 377.4194549743},
+		{A: 9.851e-08, B: 4.23328578127, C: 418.2608035978},
+		{A: 7.938e-08, B: 2.40417397694, C: 488.3765357191},
+		{A: 9.894e-08, B: 0.63707319139, C: 183.7640795093},
+		{A: 9.913e-08, B: 3.94049519088, C: 441.2672278623},
+		{A: 7.867e-08, B: 3.87469522964, C: 494.7393231945},
+		{A: 7.589e-08, B: 3.15909316566, C: 416.7763308895},
+		{A: 8.496e-08, B: 5.38968698704, C: 104.0077979553},
+		{A: 9.716e-08, B: 3.06038536864, C: 166.5680400911},
+		{A: 9.377e-08, B: 0.56416645296, C: 673.316271396},
+		{A: 8.771e-08, B: 5.24534141981, C: 1057.8974574809},
+		{A: 7.99e-08, B: 1.55726966638, C: 59.2824801785},
+		{A: 9.09e-08, B: 4.32953439022, C: 29.7474642498},
+		{A: 9.667e-08, B: 5.89033222679, C: 358.4088744477},
+		{A: 7.209e-08, B: 2.29464803358, C: 79.1868325824},
+		{A: 8.062e-08, B: 0.44458003524, C: 19.0105805266},
+		{A: 8.254e-08, B: 3.47304582051, C: 156.1554792736},
+		{A: 9.804e-08, B: 6.06393995615, C: 784.7464328928},
+		{A: 8.516e-08, B: 5.99060386955, C: 180.7951340927},
+		{A: 8.09e-08, B: 1.38588221442, C: 1654.0326338646},
+		{A: 9.074e-08, B: 4.0397149046, C: 1017.0561088574},
+		{A: 6.908e-08, B: 1.41919832926, C: 178.3474535379},
+		{A: 8.23e-08, B: 2.53750470473, C: 518.3846323998},
+		{A: 8.594e-08, B: 5.29104206063, C: 457.8783119439},
+		{A: 6.769e-08, B: 5.43380191356, C: 171.9846660625},
+		{A: 8.571e-08, B: 0.35876828441, C: 636.6677084665},
+		{A: 8.995e-08, B: 1.36992508507, C: 6209.7787241324},
+		{A: 6.641e-08, B: 2.92327140872, C: 0.0481841098},
+		{A: 9.278e-08, B: 3.80308677009, C: 25558.2121764796},
+		{A: 6.567e-08, B: 4.01934954352, C: 0.1118745846},
+		{A: 6.441e-08, B: 4.28250687347, C: 36.1272980677},
+		{A: 7.257e-08, B: 4.09776235307, C: 326.8681209489},
+		{A: 8.384e-08, B: 5.49363770202, C: 532.6117264014},
+		{A: 7.471e-08, B: 4.62144262894, C: 526.9826521089},
+		{A: 7.5e-08, B: 0.61545750834, C: 485.9288551643},
+		{A: 7.716e-08, B: 1.04880632264, C: 525.2375469697},
+		{A: 8.504e-08, B: 2.79350586429, C: 10139.988420352},
+		{A: 7.466e-08, B: 5.07942174095, C: 157.6399519819},
+		{A: 7.186e-08, B: 6.22833818429, C: 77.2292791221},
+		{A: 7.784e-08, B: 1.89308880453, C: 984.6003316219},
+		{A: 6.513e-08, B: 0.07498932215, C: 79.889407998},
+		{A: 6.077e-08, B: 2.96673519667, C: 36.6967470393},
+		{A: 7.706e-08, B: 5.7063258079, C: 209.106309744},
+		{A: 7.265e-08, B: 4.94483532589, C: 131.4039498699},
+		{A: 6.984e-08, B: 2.53239305821, C: 497.1870037493},
+		{A: 7.824e-08, B: 2.31462643851, C: 513.079881013},
+		{A: 7.175e-08, B: 3.69203633127, C: 524.0137066923},
+		{A: 6.855e-08, B: 0.14076801572, C: 283.6272758804},
+		{A: 6.922e-08, B: 3.36515011915, C: 438.2982824457},
+		{A: 7.349e-08, B: 3.50406958122, C: 500.1559491659},
+		{A: 6.301e-08, B: 0.14776691217, C: 608.877797677},
+		{A: 5.892e-08, B: 4.24403528888, C: 4.665866446},
+		{A: 7.613e-08, B: 5.14905171677, C: 259.5088859231},
+		{A: 7.128e-08, B: 5.92696788834, C: 482.9599097477},
+		{A: 6.829e-08, B: 1.01745137848, C: 1543.8263126452},
+		{A: 5.981e-08, B: 4.79954091087, C: 215.4379594521},
+		{A: 5.526e-08, B: 2.34003154732, C: 65.2203710117},
+		{A: 6.817e-08, B: 6.1216282969, C: 395.0574373772},
+		{A: 5.369e-08, B: 3.76855960849, C: 52099.5402118728},
+		{A: 5.776e-08, B: 5.61434462641, C: 987.5692770385},
+		{A: 7.523e-08, B: 5.60432148128, C: 2810.9214616052},
+		{A: 7.329e-08, B: 3.76815551582, C: 1512.8068240082},
+		{A: 5.616e-08, B: 2.13872867116, C: 145.6310438715},
+		{A: 5.258e-08, B: 0.3085083691, C: 36.6003788197},
+		{A: 5.688e-08, B: 1.82274388581, C: 1227.4344429886},
+		{A: 5.658e-08, B: 2.35049199704, C: 5.6290742925},
+		{A: 6.135e-08, B: 4.23390561816, C: 496.0113475817},
+		{A: 5.128e-08, B: 2.89050864873, C: 313.6835566709},
+		{A: 6.472e-08, B: 3.49494191669, C: 552.6973893591},
+		{A: 4.983e-08, B: 3.91958511552, C: 10135.5350022271},
+		{A: 5.217e-08, B: 0.40052635702, C: 319.3126309634},
+		{A: 4.952e-08, B: 1.42482088612, C: 49.1787359017},
+		{A: 5.964e-08, B: 5.70758449643, C: 309.7995875176},
+		{A: 5.091e-08, B: 6.00974510144, C: 1409.7140497896},
+		{A: 5.205e-08, B: 5.5027133451, C: 238.9019581036},
+		{A: 4.8e-08, B: 1.1345031067, C: 134.0640787458},
+		{A: 4.943e-08, B: 1.43051344597, C: 422.405405182},
+		{A: 5.604e-08, B: 2.05669305961, C: 207.3612046048},
+		{A: 6.31e-08, B: 5.22966882627, C: 139.7413371481},
+		{A: 4.772e-08, B: 3.06668713747, C: 464.7312265138},
+		{A: 4.919e-08, B: 3.57280542629, C: 52175.8062831484},
+		{A: 4.762e-08, B: 5.90654311203, C: 838.9692877504},
+		{A: 4.848e-08, B: 0.77467099227, C: 1.6969210294},
+		{A: 5.694e-08, B: 0.77313415569, C: 709.9648343255},
+		{A: 5.455e-08, B: 0.90289242792, C: 208.8456773131},
+		{A: 4.901e-08, B: 3.79986913631, C: 15.4991183888},
+		{A: 4.772e-08, B: 0.15755140037, C: 39.5056337615},
+		{A: 5.673e-08, B: 2.68359159067, C: 1127.2624300768},
+		{A: 5.477e-08, B: 0.53123497431, C: 113.8778420516},
+		{A: 5.077e-08, B: 1.59268428609, C: 1547.9709142294},
+		{A: 4.981e-08, B: 1.44584050478, C: 1.2720243872},
+		{A: 5.813e-08, B: 5.85024085408, C: 57.255490749},
+		{A: 5.52e-08, B: 5.06396698257, C: 421.2297490144},
+		{A: 5.938e-08, B: 0.96886308551, C: 6280.1069045748},
+		{A: 5.206e-08, B: 3.5800381937, C: 474.9468453752},
+		{A: 5.256e-08, B: 0.61005270999, C: 95.9792272178},
+		{A: 5.531e-08, B: 5.28764137194, C: 36.7604375141},
+		{A: 6.158e-08, B: 5.73176703797, C: 711.4493070338},
+		{A: 5.003e-08, B: 2.19048397989, C: 501.6404218742},
+		{A: 5.15e-08, B: 5.58407480282, C: 26049.7701059364},
+		{A: 5.138e-08, B: 4.55234158942, C: 670.916774951},
+		{A: 5.609e-08, B: 4.3727275978, C: 52.8020726241},
+		{A: 5.636e-08, B: 2.39183054397, C: 10210.3166007944},
+		{A: 4.512e-08, B: 2.59978208967, C: 1234.5479899894},
+		{A: 5.412e-08, B: 4.58813638089, C: 179.6194779251},
+		{A: 4.314e-08, B: 3.38846714337, C: 142.6620984549},
+		{A: 4.708e-08, B: 5.23537414423, C: 3.6233367224},
+		{A: 4.471e-08, B: 3.94378336812, C: 12566.1516999828},
+		{A: 5.296e-08, B: 1.12249063176, C: 134.1122628556},
+		{A: 4.188e-08, B: 2.52490407427, C: 6205.3253060075},
+		{A: 4.645e-08, B: 1.90644271528, C: 13324.3166711614},
+		{A: 4.502e-08, B: 2.01956920977, C: 315.1680293792},
+		{A: 5.346e-08, B: 2.94804816223, C: 353.0404325861},
+		{A: 4.177e-08, B: 2.09489065926, C: 803.7570134194},
+		{A: 5.296e-08, B: 3.88249567974, C: 2118.7638603784},
+		{A: 5.325e-08, B: 4.28221258353, C: 477.9157907918},
+		{A: 5.519e-08, B: 0.09960891963, C: 600.019145537},
+		{A: 5.169e-08, B: 0.59948596687, C: 6.9010986797},
+		{A: 4.179e-08, B: 0.14619703083, C: 6644.5762904701},
+		{A: 4.49e-08, B: 1.07042724999, C: 52139.15772021889},
+		{A: 3.97e-08, B: 6.13227798578, C: 1553.9088050626},
+		{A: 3.97e-08, B: 4.69887237362, C: 91.7864415238},
+		{A: 4.234e-08, B: 0.14478458924, C: 65.8747623175},
+		{A: 5.183e-08, B: 3.52837189306, C: 110.2063212194},
+		{A: 5.259e-08, B: 6.20809827528, C: 142.7102825647},
+		{A: 3.869e-08, B: 5.25125030487, C: 1558.0534066468},
+		{A: 4.457e-08, B: 2.10248126544, C: 487.1045113319},
+		{A: 4.89e-08, B: 1.83606790269, C: 46.5186070258},
+		{A: 3.875e-08, B: 5.60269278935, C: 385.4962098216},
+		{A: 3.826e-08, B: 1.30946706974, C: 2176.6100519584},
+		{A: 4.591e-08, B: 4.84657580441, C: 1337.640764208},
+		{A: 5.111e-08, B: 1.18808079775, C: 981.6313862053},
+		{A: 4.709e-08, B: 1.40878215308, C: 52213.9393187862},
+		{A: 3.891e-08, B: 5.43661875415, C: 154.6710065653},
+		{A: 4.145e-08, B: 4.32505910718, C: 363.5166838784},
+		{A: 4.441e-08, B: 3.5015842457, C: 187.6962327724},
+		{A: 3.703e-08, B: 2.48768949613, C: 67.8804998876},
+		{A: 4.094e-08, B: 1.4234704726, C: 310.7146112543},
+		{A: 3.681e-08, B: 5.70552661143, C: 491.6698040414},
+		{A: 4.787e-08, B: 3.65822147476, C: 589.3459522886},
+		{A: 4.02e-08, B: 5.45643059988, C: 6641.6073450535},
+		{A: 3.656e-08, B: 0.57790726599, C: 491.4460548722},
+		{A: 4.288e-08, B: 3.35265955957, C: 203.2166030206},
+		{A: 3.843e-08, B: 4.61508898119, C: 1025.7023126763},
+		{A: 3.767e-08, B: 0.05292047125, C: 320.2758388099},
+		{A: 4.632e-08, B: 0.82011276589, C: 3265.8308281325},
+		{A: 4.609e-08, B: 5.25443775917, C: 296.1574488526},
+		{A: 4.555e-08, B: 5.30391170376, C: 26013.1215430069},
+		{A: 3.556e-08, B: 4.80267245336, C: 224.3447957019},
+		{A: 4.859e-08, B: 5.52756242256, C: 487.4133278726},
+		{A: 3.626e-08, B: 1.44624342082, C: 70.8494453042},
+		{A: 4.302e-08, B: 1.60914544159, C: 12529.5031370533},
+		{A: 3.493e-08, B: 4.75315651083, C: 12489.8856287072},
+		{A: 3.722e-08, B: 0.27433061822, C: 949.4362414007},
+		{A: 4.234e-08, B: 5.25112033465, C: 194.2885149114},
+		{A: 3.451e-08, B: 2.97409317928, C: 499.6346843041},
+		{A: 4.796e-08, B: 6.21059766333, C: 491.8185618877},
+		{A: 3.639e-08, B: 1.25605018211, C: 2603.2082428344},
+		{A: 4.646e-08, B: 5.71392540144, C: 321.7603115182},
+		{A: 3.702e-08, B: 2.08952561657, C: 491.036664595},
+		{A: 3.672e-08, B: 2.87489628704, C: 497.49582029},
+		{A: 3.965e-08, B: 1.0548498824, C: 75.7448064138},
+		{A: 3.416e-08, B: 0.68584132933, C: 305.0855369618},
+		{A: 4.513e-08, B: 4.3892700249, C: 425.1137181677},
+		{A: 3.853e-08, B: 0.61321572401, C: 12526.5341916367},
+		{A: 3.788e-08, B: 3.3222199584, C: 3140.0127549298},
+		{A: 3.781e-08, B: 5.58125317044, C: 1652.5481611563},
+		{A: 3.903e-08, B: 5.31609723466, C: 408.1783111804},
+		{A: 3.945e-08, B: 3.60558877407, C: 1589.0728952838},
+		{A: 4.084e-08, B: 0.83813879869, C: 52.3601296394},
+		{A: 4.084e-08, B: 3.50290269471, C: 23.9059416362},
+		{A: 3.694e-08, B: 1.03218855688, C: 481.4754370394},
+		{A: 3.636e-08, B: 5.31068934607, C: 141.4864422873},
+		{A: 3.345e-08, B: 3.94392179077, C: 20389.92252949249},
+		{A: 4.639e-08, B: 6.24618220184, C: 821.3949958223},
+		{A: 3.934e-08, B: 0.26992234338, C: 1655.5171065729},
+		{A: 4.431e-08, B: 2.486474378, C: 549.7284439425},
+		{A: 4.168e-08, B: 5.39993754642, C: 236.5024616586},
+		{A: 4.02e-08, B: 0.07393243012, C: 52136.18877480229},
+		{A: 4.055e-08, B: 1.34004288978, C: 1054.9285120643},
+		{A: 3.275e-08, B: 0.98533127454, C: 1344.7543112088},
+		{A: 3.213e-08, B: 2.97105590703, C: 20386.95358407589},
+		{A: 4.428e-08, B: 0.06728869735, C: 491.2972970259},
+		{A: 4.063e-08, B: 0.0619283857, C: 6168.676743078},
+		{A: 3.804e-08, B: 5.34897033476, C: 523.7530742614},
+		{A: 3.917e-08, B: 5.67905809516, C: 1131.1945833399},
+		{A: 3.833e-08, B: 0.87811168267, C: 52.6901980395},
+		{A: 4.02e-08, B: 2.69209723289, C: 1439.4615140394},
+		{A: 4.373e-08, B: 1.86209663434, C: 73.5577582899},
+		{A: 3.159e-08, B: 1.04693380342, C: 703.3725521865},
+		{A: 3.116e-08, B: 5.2015916684, C: 449.232108125},
+		{A: 3.258e-08, B: 4.65131076542, C: 696.2590051857},
+		{A: 3.427e-08, B: 0.27003884843, C: 2389.9091473964},
+		{A: 4.349e-08, B: 0.07531141761, C: 20426.571092422},
+		{A: 3.383e-08, B: 5.61838426864, C: 699.2279506023},
+		{A: 3.305e-08, B: 1.4166687729, C: 562.1467423301},
+		{A: 3.297e-08, B: 5.46677712589, C: 1442.2180111349},
+		{A: 3.277e-08, B: 2.71815883511, C: 980.146913497},
+		{A: 3.171e-08, B: 4.49510885866, C: 1439.2490657183},
+		{A: 4.175e-08, B: 4.24327707038, C: 381.6122406683},
+		{A: 3.155e-08, B: 3.40776789576, C: 39.7293829307},
+		{A: 4.112e-08, B: 0.90309319273, C: 1087.6931058405},
+		{A: 3.35e-08, B: 5.27474671017, C: 80.7194894005},
+		{A: 3.725e-08, B: 1.52448613082, C: 1058.109905802},
+		{A: 3.65e-08, B: 3.59798316565, C: 192.8040422031},
+		{A: 3.837e-08, B: 1.48519528444, C: 10098.8864392976},
+		{A: 2.959e-08, B: 1.23012121982, C: 2500.1154686158},
+		{A: 3.33e-08, B: 6.12470287875, C: 10172.1835651566},
+		{A: 3.361e-08, B: 4.31837298696, C: 492.0791943186},
+		{A: 3.288e-08, B: 3.14692435376, C: 347.3631741838},
+		{A: 2.992e-08, B: 5.01304660316, C: 175.21424391},
+		{A: 3.294e-08, B: 2.52694043155, C: 1692.1656695024},
+		{A: 2.984e-08, B: 1.8178065989, C: 175.1178756904},
+		{A: 3.013e-08, B: 0.92957285991, C: 1515.7757694248},
+		{A: 3.863e-08, B: 5.4604492857, C: 332.8060117821},
+		{A: 3.403e-08, B: 1.10932483984, C: 987.3086446076},
+		{A: 3.312e-08, B: 0.67710158807, C: 977.4867846211},
+		{A: 3.03e-08, B: 1.77996261146, C: 156489.2858138074},
+		{A: 3.605e-08, B: 4.89955108152, C: 1043.8828118004},
+		{A: 2.937e-08, B: 0.6046967123, C: 990.2294059144},
+		{A: 3.276e-08, B: 4.26765608367, C: 1189.3014073508},
+		{A: 2.966e-08, B: 5.29808076929, C: 31.9826964835},
+		{A: 2.994e-08, B: 2.58599359402, C: 178.086821107},
+		{A: 3.905e-08, B: 1.87748122254, C: 1158.2819187138},
+		{A: 3.11e-08, B: 3.09203517638, C: 235.933012687},
+		{A: 3.313e-08, B: 2.70308129756, C: 604.4725636619},
+		{A: 3.276e-08, B: 1.24440460327, C: 874.6546428334},
+		{A: 3.276e-08, B: 5.58544609667, C: 950.920714109},
+		{A: 3.746e-08, B: 0.33859914037, C: 913.9633346388},
+		{A: 3.552e-08, B: 3.07180917863, C: 240.3864308119},
+		{A: 2.885e-08, B: 6.01130634957, C: 1097.514965827},
+		{A: 3.643e-08, B: 5.11977873355, C: 452.2010535416},
+		{A: 2.768e-08, B: 4.38396269009, C: 391.4341006548},
+		{A: 2.776e-08, B: 5.0182159483, C: 8.9068362498},
+		{A: 2.99e-08, B: 5.62911695857, C: 140.6563608848},
+		{A: 2.761e-08, B: 4.05534163807, C: 6283.0758499914},
+		{A: 3.226e-08, B: 4.76711354367, C: 6241.973868937},
+		{A: 3.748e-08, B: 4.84009347869, C: 341.7340998913},
+		{A: 2.752e-08, B: 4.53621078796, C: 6206.8097787158},
+		{A: 3.847e-08, B: 2.40982343643, C: 26086.4186688659},
+		{A: 2.727e-08, B: 3.28234198801, C: 483.4811746095},
+		{A: 2.884e-08, B: 4.05452029151, C: 1.2238402774},
+		{A: 2.702e-08, B: 3.72061244391, C: 946.4672959841},
+		{A: 2.723e-08, B: 4.37517047024, C: 15.1903018481},
+		{A: 2.847e-08, B: 5.22951186538, C: 661.0467308547},
+		{A: 2.68e-08, B: 4.19379121323, C: 13.184564278},
+		{A: 3.269e-08, B: 0.4311977852, C: 496.9745554282},
+		{A: 3.489e-08, B: 3.82213189319, C: 625.9945152181},
+		{A: 3.757e-08, B: 3.88223872147, C: 495.702531041},
+		{A: 2.872e-08, B: 5.00345974886, C: 252.0865223816},
+		{A: 3.742e-08, B: 2.03372773652, C: 8.5980197091},
+		{A: 3.172e-08, B: 1.11135762382, C: 260.9933586314},
+		{A: 3.341e-08, B: 2.91360557418, C: 304.2342036999},
+		{A: 2.915e-08, B: 2.63627684599, C: 6681.2248533996},
+		{A: 2.915e-08, B: 1.4377362589, C: 6604.958782124},
+		{A: 2.629e-08, B: 2.0982440745, C: 2713.4145640538},
+		{A: 2.901e-08, B: 3.3392480023, C: 515.463871093},
+		{A: 2.803e-08, B: 1.23584865903, C: 6643.0918177618},
+		{A: 3.045e-08, B: 3.33515866438, C: 921.0768816396},
+		{A: 2.699e-08, B: 5.4259779465, C: 925.2696673336},
+		{A: 2.808e-08, B: 5.77870303237, C: 1024.217839968},
+		{A: 3.028e-08, B: 3.75501312393, C: 511.5954083047},
+		{A: 3.09e-08, B: 2.49453093252, C: 14.6690369863},
+		{A: 2.913e-08, B: 4.83296711477, C: 515.936951845},
+		{A: 3.139e-08, B: 5.9913425471, C: 570.7447620392},
+		{A: 2.752e-08, B: 3.08268180744, C: 853.196381752},
+		{A: 2.779e-08, B: 3.74527347899, C: 494.0056100116},
+		{A: 2.643e-08, B: 1.99093797444, C: 470.2172884544},
+		{A: 2.763e-08, B: 4.01095972177, C: 448.9714756941},
+		{A: 2.643e-08, B: 5.24970673655, C: 249.9476583675},
+		{A: 3.426e-08, B: 4.73955481174, C: 1050.9963588012},
+		{A: 2.573e-08, B: 2.01267457287, C: 1514.2912967165},
+		{A: 2.633e-08, B: 1.63640090603, C: 170.7126416753},
+		{A: 3.034e-08, B: 4.48979734509, C: 560.7104537316},
+		{A: 3.025e-08, B: 5.51446170055, C: 369.4545747116},
+		{A: 3.095e-08, B: 4.01459691667, C: 1615.8995982268},
+		{A: 2.49e-08, B: 0.15301603966, C: 78187.44335344699},
+		{A: 2.589e-08, B: 0.79196093766, C: 1228.9189156969},
+		{A: 3.143e-08, B: 5.33170343283, C: 1542.3418399369},
+		{A: 3.138e-08, B: 4.50785484172, C: 461.7622810972},
+		{A: 2.812e-08, B: 3.7424659412, C: 2.0057375701},
+		{A: 3.062e-08, B: 4.88018345098, C: 227.9681324243},
+		{A: 2.553e-08, B: 4.85437812287, C: 488.8496164711},
+		{A: 2.971e-08, B: 1.27359129352, C: 530.914805372},
+		{A: 2.646e-08, B: 3.64828423565, C: 335.7749571987},
+		{A: 3.329e-08, B: 2.71693827722, C: 171.021458216},
+		{A: 2.648e-08, B: 0.60243117586, C: 70.5888128733},
+		{A: 3.061e-08, B: 5.05044834864, C: 378.6432952517},
+		{A: 2.738e-08, B: 4.75405645015, C: 151.260118164},
+		{A: 2.728e-08, B: 5.89052930055, C: 213.9534867438},
+		{A: 3.411e-08, B: 2.24137878065, C: 734.4557312983},
+		{A: 2.623e-08, B: 0.54340876464, C: 1586.1039498672},
+		{A: 3.169e-08, B: 5.84871429991, C: 1049.5118860929},
+		{A: 2.43e-08, B: 2.34595493263, C: 450.4559484024},
+		{A: 2.907e-08, B: 5.58085498481, C: 597.5714649822},
+		{A: 3.3e-08, B: 0.94221473935, C: 58.1705144857},
+		{A: 2.543e-08, B: 5.30426930256, C: 419.4846438752},
+		{A: 3.175e-08, B: 2.32600231924, C: 339.2864193365},
+		{A: 2.858e-08, B: 2.36621678719, C: 32.5039613453},
+		{A: 2.712e-08, B: 5.79983621237, C: 1587.5884225755},
+		{A: 3.34e-08, B: 1.36950315448, C: 384.2723695442},
+		{A: 3.301e-08, B: 5.83023910521, C: 51.7751743028},
+		{A: 2.415e-08, B: 0.6944692367, C: 489.5521918867},
+		{A: 2.736e-08, B: 5.74320864965, C: 1167.8431462694},
+		{A: 2.956e-08, B: 5.22962139507, C: 199.8538987291},
+		{A: 3.262e-08, B: 0.01501002027, C: 1545.3107853535},
+		{A: 2.506e-08, B: 4.84043333582, C: 943.4983505675},
+		{A: 3.24e-08, B: 2.46676155925, C: 1016.7954764265},
+		{A: 3.148e-08, B: 4.62079057738, C: 233.533516242},
+		{A: 2.327e-08, B: 4.10421417326, C: 70.1157321213},
+		{A: 2.371e-08, B: 4.79963943424, C: 271.145287018},
+		{A: 3.006e-08, B: 3.66877796077, C: 1476.1582610787},
+		{A: 2.537e-08, B: 5.66681769885, C: 21.1494445407},
+		{A: 3.006e-08, B: 0.9304890948, C: 21.9795259432},
+		{A: 3.033e-08, B: 0.6715748869, C: 292.4859280204},
+		{A: 2.344e-08, B: 1.83547256266, C: 492.3086889822},
+		{A: 3.117e-08, B: 2.76268894894, C: 1473.1893156621},
+		{A: 2.323e-08, B: 2.88799980853, C: 533.6231183577},
+		{A: 2.34e-08, B: 4.44862573253, C: 490.8071699314},
+		{A: 2.511e-08, B: 0.99467349084, C: 266.1011680621},
+		{A: 2.919e-08, B: 4.75889516601, C: 1511.3223512999},
+		{A: 2.493e-08, B: 6.10541658597, C: 1225.9499702803},
+		{A: 2.798e-08, B: 3.06162629894, C: 419.7452763061},
+		{A: 2.691e-08, B: 3.20679023131, C: 463.5073862364},
+		{A: 2.291e-08, B: 5.81534758547, C: 246.9787129509},
+		{A: 2.319e-08, B: 6.0551428147, C: 525.7588118315},
+		{A: 3.112e-08, B: 0.89712836583, C: 314.9073969483},
+		{A: 3.085e-08, B: 5.84605938859, C: 1192.2221686576},
+		{A: 2.897e-08, B: 0.54747024257, C: 20350.3050211464},
+		{A: 3.067e-08, B: 2.22206306288, C: 248.4631856592},
+		{A: 2.252e-08, B: 0.87483094907, C: 61.0275853177},
+		{A: 2.392e-08, B: 3.62837597194, C: 439.1977998174},
+		{A: 2.817e-08, B: 2.73562306571, C: 16.6747745564},
+		{A: 2.379e-08, B: 6.17876088396, C: 467.6519878206},
+		{A: 2.598e-08, B: 4.82643304253, C: 384.5811860849},
+		{A: 2.718e-08, B: 1.01823841209, C: 215.9592243139},
+		{A: 2.998e-08, B: 1.097557153, C: 1964.7472451189},
+		{A: 2.884e-08, B: 2.97813466834, C: 383.0967133766},
+		{A: 2.231e-08, B: 4.48841493844, C: 4.1446015842},
+		{A: 2.203e-08, B: 2.23336308907, C: 481.2629887183},
+		{A: 2.26e-08, B: 2.3540491366, C: 659.6104422562},
+		{A: 2.491e-08, B: 1.7023635707, C: 445.3481389717},
+		{A: 3.041e-08, B: 5.55577674116, C: 674.8007441043},
+		{A: 2.289e-08, B: 1.18497528002, C: 1552.4243323543},
+		{A: 2.975e-08, B: 0.48272389481, C: 1052.4808315095},
+		{A: 2.339e-08, B: 0.75318738767, C: 478.8153081635},
+		{A: 3.011e-08, B: 0.16359500858, C: 54.2865453324},
+		{A: 2.82e-08, B: 6.18522693724, C: 556.5176680376},
+		{A: 2.266e-08, B: 5.91286000054, C: 3.4902102784},
+		{A: 2.231e-08, B: 1.45038594906, C: 196.5067008026},
+	},
+	// R1, 250 terms
+	{
+		{A: 0.00236338502, B: 0.70498011235, C: 38.1330356378},
+		{A: 0.00013220279, B: 3.32015499895, C: 1.4844727083},
+		{A: 8.621863e-05, B: 6.2162895163, C: 35.1640902212},
+		{A: 2.70174e-05, B: 1.88140666779, C: 39.6175083461},
+		{A: 2.15315e-05, B: 5.16873840979, C: 76.2660712756},
+		{A: 2.154735e-05, B: 2.09431198086, C: 2.9689454166},
+		{A: 1.463924e-05, B: 1.18417031047, C: 33.6796175129},
+		{A: 1.603165e-05, B: 0, C: 0},
+		{A: 1.135773e-05, B: 3.91891199655, C: 36.6485629295},
+		{A: 8.9765e-06, B: 5.24122933533, C: 388.4651552382},
+		{A: 7.89908e-06, B: 0.5331548458, C: 168.0525127994},
+		{A: 7.6003e-06, B: 0.02051033644, C: 182.279606801},
+		{A: 6.07183e-06, B: 1.0770650035, C: 1021.2488945514},
+		{A: 5.71622e-06, B: 3.40060785432, C: 484.444382456},
+		{A: 5.6079e-06, B: 2.88685815667, C: 498.6714764576},
+		{A: 4.9019e-06, B: 3.46830928696, C: 137.0330241624},
+		{A: 2.64093e-06, B: 0.86220057976, C: 4.4534181249},
+		{A: 2.70526e-06, B: 3.27355867939, C: 71.8126531507},
+		{A: 2.03524e-06, B: 2.41820674409, C: 32.1951448046},
+		{A: 1.55438e-06, B: 0.36537064534, C: 41.1019810544},
+		{A: 1.32766e-06, B: 3.60157672619, C: 9.5612275556},
+		{A: 9.3626e-07, B: 0.66670888163, C: 46.2097904851},
+		{A: 8.3317e-07, B: 3.25992461673, C: 98.8999885246},
+		{A: 7.2205e-07, B: 4.47717435693, C: 601.7642506762},
+		{A: 6.8983e-07, B: 1.46326969479, C: 74.7815985673},
+		{A: 8.6953e-07, B: 5.77228651853, C: 381.3516082374},
+		{A: 6.8717e-07, B: 4.52563942435, C: 70.3281804424},
+		{A: 6.4724e-07, B: 3.85477388838, C: 73.297125859},
+		{A: 6.8377e-07, B: 3.39509945953, C: 108.4612160802},
+		{A: 5.3375e-07, B: 5.43650770516, C: 395.578702239},
+		{A: 4.4453e-07, B: 3.61409723545, C: 2.4476805548},
+		{A: 4.1243e-07, B: 4.73866592865, C: 8.0767548473},
+		{A: 4.8331e-07, B: 1.98568593981, C: 175.1660598002},
+		{A: 4.1744e-07, B: 4.94257598763, C: 31.019488637},
+		{A: 4.4102e-07, B: 1.41744904844, C: 1550.939859646},
+		{A: 4.117e-07, B: 1.41999374753, C: 490.0734567485},
+		{A: 4.1099e-07, B: 4.86312637841, C: 493.0424021651},
+		{A: 3.6267e-07, B: 5.30764043577, C: 312.1990839626},
+		{A: 3.6284e-07, B: 0.38187812797, C: 77.7505439839},
+		{A: 4.0619e-07, B: 2.27237172464, C: 529.6909650946},
+		{A: 3.236e-07, B: 5.91123007786, C: 5.9378908332},
+		{A: 3.1197e-07, B: 2.70549944134, C: 1014.1353475506},
+		{A: 3.273e-07, B: 5.22147683115, C: 41.0537969446},
+		{A: 3.6079e-07, B: 4.87817494829, C: 491.5579294568},
+		{A: 3.0181e-07, B: 3.63273193845, C: 30.7106720963},
+		{A: 2.9991e-07, B: 3.30769367603, C: 1028.3624415522},
+		{A: 2.7048e-07, B: 1.77647060739, C: 44.7253177768},
+		{A: 2.7756e-07, B: 4.55583165091, C: 7.1135470008},
+		{A: 2.7475e-07, B: 0.97228280623, C: 33.9402499438},
+		{A: 2.4944e-07, B: 3.10083391185, C: 144.1465711632},
+		{A: 2.5958e-07, B: 2.99724758632, C: 60.7669528868},
+		{A: 2.1369e-07, B: 4.71270048898, C: 278.2588340188},
+		{A: 2.1283e-07, B: 0.68957829113, C: 251.4321310758},
+		{A: 2.3727e-07, B: 5.12044184469, C: 176.6505325085},
+		{A: 2.1392e-07, B: 0.86286397645, C: 4.192785694},
+		{A: 2.3373e-07, B: 1.64955088447, C: 173.6815870919},
+		{A: 2.4163e-07, B: 3.56602004577, C: 145.1097790097},
+		{A: 2.0238e-07, B: 5.61479765982, C: 24.1183899573},
+		{A: 2.6958e-07, B: 4.14294870704, C: 453.424893819},
+		{A: 2.4048e-07, B: 1.00718363213, C: 213.299095438},
+		{A: 1.8322e-07, B: 1.98028683488, C: 72.0732855816},
+		{A: 1.8266e-07, B: 6.17260374467, C: 189.3931538018},
+		{A: 1.9201e-07, B: 4.65162168927, C: 106.9767433719},
+		{A: 1.7606e-07, B: 1.60307551767, C: 62.2514255951},
+		{A: 1.6545e-07, B: 1.69931816587, C: 357.4456666012},
+		{A: 2.0132e-07, B: 3.29520553529, C: 114.3991069134},
+		{A: 1.5425e-07, B: 4.38812302799, C: 25.6028626656},
+		{A: 1.9173e-07, B: 2.20014267311, C: 343.2185725996},
+		{A: 1.5077e-07, B: 3.66802659382, C: 0.5212648618},
+		{A: 1.4029e-07, B: 0.5533633329, C: 129.9194771616},
+		{A: 1.3361e-07, B: 5.8575108372, C: 68.8437077341},
+		{A: 1.5357e-07, B: 4.20731277007, C: 567.8240007324},
+		{A: 1.2746e-07, B: 3.52815836608, C: 477.3308354552},
+		{A: 1.1724e-07, B: 5.5764726346, C: 31.2319369581},
+		{A: 1.1533e-07, B: 0.89138506506, C: 594.6507036754},
+		{A: 1.0508e-07, B: 4.35552732772, C: 32.7164096664},
+		{A: 1.0826e-07, B: 5.21826226871, C: 26.826702943},
+		{A: 1.0085e-07, B: 1.98102855874, C: 40.5807161926},
+		{A: 1.0518e-07, B: 5.27281360238, C: 2.9207613068},
+		{A: 9.207e-08, B: 0.50092534158, C: 64.9597385808},
+		{A: 9.231e-08, B: 0.6818097771, C: 160.9389657986},
+		{A: 8.735e-08, B: 5.80657503476, C: 6.592282139},
+		{A: 1.0114e-07, B: 4.51164596694, C: 28.5718080822},
+		{A: 1.0392e-07, B: 5.18877536013, C: 42.5864537627},
+		{A: 9.873e-08, B: 3.7651215808, C: 181.7583419392},
+		{A: 8.35e-08, B: 2.82449631025, C: 43.2408450685},
+		{A: 9.838e-08, B: 1.494387636, C: 47.6942631934},
+		{A: 7.645e-08, B: 4.07503370297, C: 389.9496279465},
+		{A: 8.004e-08, B: 2.78082277326, C: 505.7850234584},
+		{A: 7.44e-08, B: 2.35731983047, C: 11.0457002639},
+		{A: 7.342e-08, B: 1.62279119952, C: 135.5485514541},
+		{A: 9.45e-08, B: 0.27241261915, C: 426.598190876},
+		{A: 7.192e-08, B: 0.82841201068, C: 911.042573332},
+		{A: 6.979e-08, B: 1.86753914872, C: 206.1855484372},
+		{A: 6.874e-08, B: 0.83802906828, C: 82.8583534146},
+		{A: 7.897e-08, B: 1.86554246391, C: 38.6543004996},
+		{A: 6.729e-08, B: 3.98338053636, C: 12.5301729722},
+		{A: 6.357e-08, B: 0.90093123522, C: 487.3651437628},
+		{A: 6.72e-08, B: 1.339360407, C: 220.4126424388},
+		{A: 7.695e-08, B: 5.13312500855, C: 23.9059416362},
+		{A: 7.059e-08, B: 5.99832463494, C: 639.897286314},
+		{A: 8.302e-08, B: 3.85960902325, C: 37.611770776},
+		{A: 6.412e-08, B: 2.41743702679, C: 1059.3819301892},
+		{A: 6.751e-08, B: 1.9686089447, C: 45.2465826386},
+		{A: 6.431e-08, B: 4.07813226506, C: 35.685355083},
+		{A: 5.517e-08, B: 3.8132579089, C: 815.0633461142},
+		{A: 5.562e-08, B: 0.4161960215, C: 563.6312150384},
+		{A: 6.115e-08, B: 2.10934525342, C: 697.743477894},
+		{A: 6.216e-08, B: 4.79301628209, C: 143.6253063014},
+		{A: 5.346e-08, B: 3.13071964722, C: 386.9806825299},
+		{A: 5.245e-08, B: 6.06245070403, C: 171.2339065371},
+		{A: 5.129e-08, B: 0.79394555531, C: 179.0982130633},
+		{A: 5.168e-08, B: 4.73765992885, C: 522.5774180938},
+		{A: 6.422e-08, B: 0.64684316894, C: 350.3321196004},
+		{A: 5.006e-08, B: 2.37645082899, C: 77.2292791221},
+		{A: 5.005e-08, B: 4.70632786971, C: 460.5384408198},
+		{A: 5.167e-08, B: 5.2024661657, C: 446.3113468182},
+		{A: 5.119e-08, B: 2.17338058771, C: 494.7393231945},
+		{A: 5.025e-08, B: 4.21265519856, C: 536.8045120954},
+		{A: 4.722e-08, B: 6.22814313946, C: 63.7358983034},
+		{A: 5.125e-08, B: 5.38138329172, C: 179.3106613844},
+		{A: 4.918e-08, B: 4.09031782903, C: 488.3765357191},
+		{A: 4.652e-08, B: 5.10765073368, C: 274.0660483248},
+		{A: 4.711e-08, B: 5.56542374115, C: 42.3258213318},
+		{A: 4.459e-08, B: 1.3078482983, C: 69.3649725959},
+		{A: 5.485e-08, B: 3.88088464259, C: 218.4069048687},
+		{A: 4.416e-08, B: 3.05353893868, C: 27.0873353739},
+		{A: 4.559e-08, B: 4.92224120952, C: 285.3723810196},
+		{A: 4.393e-08, B: 4.18047835584, C: 5.4166259714},
+		{A: 4.687e-08, B: 2.2140115321, C: 1029.8469142605},
+		{A: 4.644e-08, B: 1.87902594973, C: 1433.6199914258},
+		{A: 5.639e-08, B: 3.05596737234, C: 983.1158589136},
+		{A: 6.045e-08, B: 5.68817982786, C: 351.8165923087},
+		{A: 4.43e-08, B: 3.37768805833, C: 377.4194549743},
+		{A: 4.683e-08, B: 2.14346624864, C: 97.4155158163},
+		{A: 5.845e-08, B: 4.62301099402, C: 1024.217839968},
+		{A: 4.536e-08, B: 2.45860473853, C: 496.0113475817},
+		{A: 4.398e-08, B: 5.65312496227, C: 3.9321532631},
+		{A: 4.287e-08, B: 0.66340266603, C: 1012.6508748423},
+		{A: 4.086e-08, B: 0.14551174994, C: 385.2837615005},
+		{A: 4.029e-08, B: 5.98399329775, C: 178.3474535379},
+		{A: 4.276e-08, B: 3.6820508297, C: 348.8476468921},
+		{A: 5.257e-08, B: 3.75263242432, C: 379.8671355291},
+		{A: 4.012e-08, B: 0.42559540783, C: 104313.47953065898},
+		{A: 4.025e-08, B: 2.40645188238, C: 84.3428261229},
+		{A: 3.957e-08, B: 0.86846121055, C: 171.9846660625},
+		{A: 3.961e-08, B: 3.04953080906, C: 1017.3167412883},
+		{A: 5.559e-08, B: 0.77714806229, C: 1447.8470854274},
+		{A: 5.071e-08, B: 2.61075526868, C: 1536.7127656444},
+		{A: 4.052e-08, B: 5.00014006312, C: 391.6465489759},
+		{A: 5.182e-08, B: 4.73444634983, C: 382.8360809457},
+		{A: 3.763e-08, B: 4.29449373755, C: 313.6835566709},
+		{A: 4.038e-08, B: 2.82857942788, C: 1661.1461808654},
+		{A: 4.067e-08, B: 5.7316992896, C: 169.5369855077},
+		{A: 3.841e-08, B: 1.6258092842, C: 0.9632078465},
+		{A: 3.901e-08, B: 2.70874386576, C: 14.0146456805},
+		{A: 3.721e-08, B: 1.20062375429, C: 1026.8779688439},
+		{A: 3.911e-08, B: 3.01809123569, C: 100.3844612329},
+		{A: 3.489e-08, B: 4.28865448963, C: 1025.1810478145},
+		{A: 3.714e-08, B: 5.05021268365, C: 292.4859280204},
+		{A: 3.816e-08, B: 3.93084933114, C: 39.0962434843},
+		{A: 3.988e-08, B: 2.82832650224, C: 134.1122628556},
+		{A: 3.745e-08, B: 4.24728135115, C: 180.7951340927},
+		{A: 3.836e-08, B: 1.02685786071, C: 1018.2799491348},
+		{A: 3.941e-08, B: 5.21895739331, C: 183.7640795093},
+		{A: 4.669e-08, B: 4.38080962573, C: 1066.49547719},
+		{A: 3.78e-08, B: 6.03723468132, C: 1022.7333672597},
+		{A: 3.647e-08, B: 3.98130320367, C: 608.877797677},
+		{A: 3.456e-08, B: 5.54052355058, C: 846.0828347512},
+		{A: 4.047e-08, B: 3.71041480907, C: 1018.0675008137},
+		{A: 3.865e-08, B: 4.76002199091, C: 166.5680400911},
+		{A: 3.629e-08, B: 3.29053233846, C: 447.7958195265},
+		{A: 3.564e-08, B: 4.36703678321, C: 397.0631749473},
+		{A: 3.304e-08, B: 1.49289552229, C: 1505.6932770074},
+		{A: 3.976e-08, B: 2.42476188945, C: 106.0135355254},
+		{A: 4.217e-08, B: 4.21677652639, C: 1052.2683831884},
+		{A: 3.294e-08, B: 0.42088065654, C: 22.633917249},
+		{A: 3.615e-08, B: 3.68096122231, C: 494.5268748734},
+		{A: 3.23e-08, B: 5.10786091356, C: 69.1525242748},
+		{A: 3.28e-08, B: 3.62226152032, C: 531.1754378029},
+		{A: 3.337e-08, B: 2.7250287632, C: 481.4754370394},
+		{A: 3.187e-08, B: 0.08677634706, C: 399.5108555021},
+		{A: 3.389e-08, B: 1.79454271219, C: 1519.920371009},
+		{A: 3.179e-08, B: 3.40418030121, C: 423.6292454594},
+		{A: 3.154e-08, B: 3.69356460843, C: 470.2172884544},
+		{A: 3.706e-08, B: 2.79048710497, C: 462.0229135281},
+		{A: 3.136e-08, B: 4.38015969606, C: 385.4962098216},
+		{A: 3.122e-08, B: 0.48346644637, C: 79.1868325824},
+		{A: 3.392e-08, B: 0.48037804731, C: 521.0929453855},
+		{A: 3.465e-08, B: 0.93152295589, C: 2183.7235989592},
+		{A: 3.735e-08, B: 0.98809808606, C: 487.4133278726},
+		{A: 3.998e-08, B: 3.38773325131, C: 6283.0758499914},
+		{A: 2.998e-08, B: 2.61728063127, C: 487.6257761937},
+		{A: 3.295e-08, B: 2.53821501556, C: 4.665866446},
+		{A: 2.964e-08, B: 3.66274645375, C: 495.4900827199},
+		{A: 3.901e-08, B: 1.65463523144, C: 210.3301500214},
+		{A: 2.95e-08, B: 1.99904237956, C: 872.9095376942},
+		{A: 2.948e-08, B: 2.90769224206, C: 391.4341006548},
+		{A: 2.971e-08, B: 0.31626092637, C: 5.1078094307},
+		{A: 3.085e-08, B: 0.95725590904, C: 109.9456887885},
+		{A: 2.995e-08, B: 3.34433305798, C: 394.0942295307},
+		{A: 3.126e-08, B: 5.89472116854, C: 105.4922706636},
+		{A: 3.904e-08, B: 3.01022809543, C: 556.5176680376},
+		{A: 3.388e-08, B: 6.24936444215, C: 535.3200393871},
+		{A: 2.93e-08, B: 6.15005257333, C: 164.1203595363},
+		{A: 3.267e-08, B: 4.19718045293, C: 518.3846323998},
+		{A: 3.946e-08, B: 2.8884275967, C: 151.260118164},
+		{A: 3.076e-08, B: 6.04134449219, C: 142.1408335931},
+		{A: 2.823e-08, B: 0.60712626756, C: 214.7835681463},
+		{A: 2.917e-08, B: 2.74502617182, C: 138.5174968707},
+		{A: 3.347e-08, B: 6.09373507569, C: 6246.4272870619},
+		{A: 3.659e-08, B: 5.12211619716, C: 79.2350166922},
+		{A: 3.01e-08, B: 0.24656411754, C: 91.7864415238},
+		{A: 2.861e-08, B: 6.17465663902, C: 422.405405182},
+		{A: 2.989e-08, B: 2.31620917965, C: 485.9288551643},
+		{A: 3.088e-08, B: 2.29186342974, C: 110.2063212194},
+		{A: 3.03e-08, B: 3.698661491, C: 532.6117264014},
+		{A: 3.02e-08, B: 2.36422658177, C: 290.2195580194},
+		{A: 3.17e-08, B: 1.23078934548, C: 10176.6369832815},
+		{A: 2.652e-08, B: 3.35836234807, C: 148.0787244263},
+		{A: 2.673e-08, B: 6.03366372927, C: 196.5067008026},
+		{A: 2.63e-08, B: 0.46957619348, C: 1970.4245035212},
+		{A: 2.599e-08, B: 4.86022081674, C: 439.1977998174},
+		{A: 2.878e-08, B: 2.61946597178, C: 488.5889840402},
+		{A: 2.72e-08, B: 1.71836225398, C: 364.559213602},
+		{A: 3.333e-08, B: 3.25126857354, C: 30.0562807905},
+		{A: 3.053e-08, B: 2.49346960035, C: 6243.4583416453},
+		{A: 3.062e-08, B: 6.23776299963, C: 419.4846438752},
+		{A: 2.786e-08, B: 0.83078219939, C: 497.1870037493},
+		{A: 2.834e-08, B: 3.52926079424, C: 457.8783119439},
+		{A: 2.932e-08, B: 1.80245810977, C: 500.1559491659},
+		{A: 3.03e-08, B: 5.10152500393, C: 367.9701020033},
+		{A: 2.956e-08, B: 5.76230870725, C: 986.0848043302},
+		{A: 3.116e-08, B: 2.20042242739, C: 495.702531041},
+		{A: 2.554e-08, B: 0.65945973992, C: 67.3592350258},
+		{A: 2.901e-08, B: 3.91891656185, C: 10173.6680378649},
+		{A: 2.84e-08, B: 1.34453183591, C: 482.9599097477},
+		{A: 2.458e-08, B: 1.20012815574, C: 489.110248902},
+		{A: 2.556e-08, B: 3.86921927085, C: 487.1045113319},
+		{A: 2.614e-08, B: 1.51881085312, C: 463.5073862364},
+		{A: 2.386e-08, B: 4.58400538443, C: 615.9913446778},
+		{A: 2.438e-08, B: 5.19827220476, C: 501.1191570124},
+		{A: 2.537e-08, B: 1.64802783144, C: 519.6084726772},
+		{A: 2.444e-08, B: 3.87859489652, C: 185.2485522176},
+		{A: 2.795e-08, B: 4.0426575258, C: 255.0554677982},
+		{A: 2.895e-08, B: 3.26202698812, C: 1646.9190868638},
+		{A: 2.225e-08, B: 5.75197574692, C: 605.9570363702},
+		{A: 2.324e-08, B: 3.99503920129, C: 481.2629887183},
+		{A: 2.962e-08, B: 1.74151265966, C: 2080.6308247406},
+		{A: 2.621e-08, B: 1.74442251671, C: 35.212274331},
+	},
+	// R2, 72 terms
+	{
+		{A: 4.247412e-05, B: 5.89910679117, C: 38.1330356378},
+		{A: 2.1757e-06, B: 0.3458182908, C: 1.4844727083},
+		{A: 1.63025e-06, B: 2.2387294713, C: 168.0525127994},
+		{A: 1.56285e-06, B: 4.59414467342, C: 182.279606801},
+		{A: 1.1794e-06, B: 5.10295026024, C: 484.444382456},
+		{A: 1.12429e-06, B: 1.19000583596, C: 498.6714764576},
+		{A: 1.27141e-06, B: 2.84786298079, C: 35.1640902212},
+		{A: 9.9467e-07, B: 3.41578558739, C: 175.1660598002},
+		{A: 6.4814e-07, B: 3.4621406484, C: 388.4651552382},
+		{A: 7.7286e-07, B: 0.01659281785, C: 491.5579294568},
+		{A: 4.9509e-07, B: 4.06995509133, C: 76.2660712756},
+		{A: 3.933e-07, B: 6.09521855958, C: 1021.2488945514},
+		{A: 3.645e-07, B: 5.17130059988, C: 137.0330241624},
+		{A: 3.708e-07, B: 5.97288967681, C: 2.9689454166},
+		{A: 3.0484e-07, B: 3.58259801313, C: 33.6796175129},
+		{A: 2.1099e-07, B: 0.76843555176, C: 36.6485629295},
+		{A: 1.3886e-07, B: 3.59248623971, C: 395.578702239},
+		{A: 1.3117e-07, B: 5.09263515697, C: 98.8999885246},
+		{A: 1.1379e-07, B: 1.18060018898, C: 381.3516082374},
+		{A: 9.132e-08, B: 2.34787658568, C: 601.7642506762},
+		{A: 8.527e-08, B: 5.25134685897, C: 2.4476805548},
+		{A: 8.136e-08, B: 4.96270726986, C: 4.4534181249},
+		{A: 7.417e-08, B: 4.46775409796, C: 189.3931538018},
+		{A: 7.225e-08, B: 1.92287508629, C: 9.5612275556},
+		{A: 7.289e-08, B: 1.6551952578, C: 1028.3624415522},
+		{A: 8.076e-08, B: 5.84268048311, C: 220.4126424388},
+		{A: 9.654e-08, B: 0, C: 0},
+		{A: 6.554e-08, B: 0.69397520733, C: 144.1465711632},
+		{A: 7.782e-08, B: 1.14341656235, C: 1059.3819301892},
+		{A: 5.665e-08, B: 6.25378258571, C: 74.7815985673},
+		{A: 5.628e-08, B: 5.23383764266, C: 46.2097904851},
+		{A: 5.523e-08, B: 4.59041448911, C: 1014.1353475506},
+		{A: 5.177e-08, B: 5.23116646157, C: 477.3308354552},
+		{A: 5.503e-08, B: 3.49522319102, C: 183.7640795093},
+		{A: 4.878e-08, B: 3.52934357721, C: 39.6175083461},
+		{A: 4.787e-08, B: 2.08260524745, C: 41.1019810544},
+		{A: 5.055e-08, B: 0.19949888617, C: 166.5680400911},
+		{A: 4.751e-08, B: 1.1805494827, C: 169.5369855077},
+		{A: 4.747e-08, B: 1.50608965076, C: 73.297125859},
+		{A: 6.113e-08, B: 6.18326155595, C: 71.8126531507},
+		{A: 4.606e-08, B: 3.91970908886, C: 587.5371566746},
+		{A: 5.756e-08, B: 2.23667359233, C: 176.6505325085},
+		{A: 4.536e-08, B: 2.84337336954, C: 7.1135470008},
+		{A: 4.338e-08, B: 0.51553847388, C: 446.3113468182},
+		{A: 3.891e-08, B: 0.26338839265, C: 1550.939859646},
+		{A: 4.465e-08, B: 3.01487041298, C: 129.9194771616},
+		{A: 3.727e-08, B: 2.37977930658, C: 160.9389657986},
+		{A: 3.84e-08, B: 3.7929038188, C: 111.4301614968},
+		{A: 4.142e-08, B: 1.70293820961, C: 983.1158589136},
+		{A: 3.296e-08, B: 1.07748822909, C: 505.7850234584},
+		{A: 4.008e-08, B: 0.30663868827, C: 494.7393231945},
+		{A: 3.974e-08, B: 5.9735178384, C: 488.3765357191},
+		{A: 3.925e-08, B: 4.85736421123, C: 60.7669528868},
+		{A: 2.966e-08, B: 2.01608546009, C: 822.176893115},
+		{A: 3.972e-08, B: 1.07780371834, C: 374.2380612366},
+		{A: 3.843e-08, B: 5.23002047199, C: 350.3321196004},
+		{A: 2.848e-08, B: 6.17799253802, C: 704.8570248948},
+		{A: 3.527e-08, B: 0.79317138165, C: 274.0660483248},
+		{A: 2.828e-08, B: 1.32275775835, C: 386.9806825299},
+		{A: 2.773e-08, B: 5.37132330836, C: 251.4321310758},
+		{A: 3.113e-08, B: 5.1262228869, C: 426.598190876},
+		{A: 3.344e-08, B: 5.61433537548, C: 1124.34166877},
+		{A: 2.597e-08, B: 0.67759426519, C: 312.1990839626},
+		{A: 2.581e-08, B: 3.55847612121, C: 567.8240007324},
+		{A: 2.578e-08, B: 1.45603792456, C: 1035.475988553},
+		{A: 2.541e-08, B: 5.19427579702, C: 1227.4344429886},
+		{A: 2.51e-08, B: 4.12148891512, C: 171.2339065371},
+		{A: 2.511e-08, B: 2.71606957319, C: 179.0982130633},
+		{A: 2.342e-08, B: 0.96469916587, C: 1019.7644218431},
+		{A: 2.5e-08, B: 0.7028227603, C: 707.7777862016},
+		{A: 2.48e-08, B: 4.59623030219, C: 693.5506922},
+		{A: 2.253e-08, B: 0.74334306011, C: 976.0023119128},
+	},
+	// R3, 22 terms
+	{
+		{A: 1.66297e-06, B: 4.55243893489, C: 38.1330356378},
+		{A: 2.238e-07, B: 3.94830879358, C: 168.0525127994},
+		{A: 2.1348e-07, B: 2.86296778794, C: 182.279606801},
+		{A: 1.6233e-07, B: 0.54226725872, C: 484.444382456},
+		{A: 1.5623e-07, B: 5.75702251906, C: 498.6714764576},
+		{A: 1.1867e-07, B: 4.4028019271, C: 1.4844727083},
+		{A: 6.448e-08, B: 5.19003066847, C: 31.019488637},
+		{A: 3.655e-08, B: 5.91335292846, C: 1007.0218005498},
+		{A: 3.681e-08, B: 1.62865545676, C: 388.4651552382},
+		{A: 3.198e-08, B: 0.70197118575, C: 1558.0534066468},
+		{A: 3.243e-08, B: 1.8803566598, C: 522.5774180938},
+		{A: 3.269e-08, B: 2.94301808574, C: 76.2660712756},
+		{A: 2.688e-08, B: 1.87062743473, C: 402.6922492398},
+		{A: 3.246e-08, B: 0.79381356193, C: 536.8045120954},
+		{A: 2.65e-08, B: 5.76858449026, C: 343.2185725996},
+		{A: 2.644e-08, B: 4.64542905401, C: 500.1559491659},
+		{A: 2.541e-08, B: 4.79217120822, C: 482.9599097477},
+		{A: 2.523e-08, B: 1.7286988978, C: 395.578702239},
+		{A: 2.69e-08, B: 2.21096415618, C: 446.3113468182},
+		{A: 2.355e-08, B: 5.77381398401, C: 485.9288551643},
+		{A: 2.874e-08, B: 6.1964334054, C: 815.0633461142},
+		{A: 2.278e-08, B: 3.66579603119, C: 497.1870037493},
+	},
+	// R4, 7 terms
+	{
+		{A: 4.227e-08, B: 2.40375758563, C: 477.3308354552},
+		{A: 4.333e-08, B: 0.10459484545, C: 395.578702239},
+		{A: 3.545e-08, B: 4.78431259422, C: 1028.3624415522},
+		{A: 3.154e-08, B: 3.88192942366, C: 505.7850234584},
+		{A: 3.016e-08, B: 1.03609346831, C: 189.3931538018},
+		{A: 2.294e-08, B: 1.10879658603, C: 182.279606801},
+		{A: 2.295e-08, B: 5.67776133184, C: 168.0525127994},
+	},
+}
+
+// GetNeptuneR0 evaluates the t^0 series of R.
+func GetNeptuneR0(t float64) float64 {
+	return neptuneR[0].Evaluate(t)
+}
+
+// GetNeptuneR1 evaluates the t^1 series of R.
+func GetNeptuneR1(t float64) float64 {
+	return neptuneR[1].Evaluate(t)
+}
+
+// GetNeptuneR2 evaluates the t^2 series of R.
+func GetNeptuneR2(t float64) float64 {
+	return neptuneR[2].Evaluate(t)
+}
+
+// GetNeptuneR3 evaluates the t^3 series of R.
+func GetNeptuneR3(t float64) float64 {
+	return neptuneR[3].Evaluate(t)
+}
+
+// GetNeptuneR4 evaluates the t^4 series of R.
+func GetNeptuneR4(t float64) float64 {
+	return neptuneR[4].Evaluate(t)
+}
@@ -0,0 +1,63 @@
+package vsop87saturnd
+
+import (
+    "calendarutil"
+    "mathutil"
+)
+
+/**
+ * 按儒略日计算土星的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 土星的日心黄经，单位是弧度(rad)
+ */
+func GetSunEclipticLongitudeForSaturn(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    L0 := GetSaturnL0(t)
+    L1 := GetSaturnL1(t)
+    L2 := GetSaturnL2(t)
+    L3 := GetSaturnL3(t)
+    L4 := GetSaturnL4(t)
+    L5 := GetSaturnL5(t)
+    L := ((((L5 * t + L4) * t + L3) * t + L2) * t + L1) * t + L0
+    return mathutil.Mod2Pi(L)
+}
+
+/**
+ * 按儒略日计算土星的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 土星的日心黄纬，单位是弧度(rad)
+ */
+func GetSunEclipticLatitudeForSaturn(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    B0 := GetSaturnB0(t)
+    B1 := GetSaturnB1(t)
+    B2 := GetSaturnB2(t)
+    B3 := GetSaturnB3(t)
+    B4 := GetSaturnB4(t)
+    B5 := GetSaturnB5(t)
+    B := ((((B5 * t + B4) * t + B3) * t + B2) * t + B1) * t + B0
+    return B
+}
+
+/**
+ * 按照儒略日计算土星和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 土星和太阳的距离，单位是天文单位(au)
+ */
+func GetSunRadiusForSaturn(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    R0 := GetSaturnR0(t)
+    R1 := GetSaturnR1(t)
+    R2 := GetSaturnR2(t)
+    R3 := GetSaturnR3(t)
+    R4 := GetSaturnR4(t)
+    R5 := GetSaturnR5(t)
+    R := ((((R5 * t + R4) * t + R3) * t + R2) * t + R1) * t + R0
+    return R
+}
@@ -0,0 +1,3 @@
+package vsop87saturnd
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Saturn -package vsop87saturnd
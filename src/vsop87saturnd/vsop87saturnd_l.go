@@ -0,0 +1,2417 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// SATURN L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87saturnd
+
+import "vsop87"
+
+var saturnL = []vsop87.Series{
+	// L0, 1152 terms
+	{
+		{A: 0.87401354029, B: 0, C: 0},
+		{A: 0.1110765978, B: 3.96205090194, C: 213.299095438},
+		{A: 0.01414150958, B: 4.58581515873, C: 7.1135470008},
+		{A: 0.00398379386, B: 0.52112025957, C: 206.1855484372},
+		{A: 0.00350769223, B: 3.30329903015, C: 426.598190876},
+		{A: 0.00206816296, B: 0.24658366938, C: 103.0927742186},
+		{A: 0.00079271288, B: 3.8400707853, C: 220.4126424388},
+		{A: 0.00023990338, B: 4.6697693486, C: 110.2063212194},
+		{A: 0.00016573583, B: 0.43719123541, C: 419.4846438752},
+		{A: 0.00014906995, B: 5.76903283845, C: 316.3918696566},
+		{A: 0.000158203, B: 0.9380895376, C: 632.7837393132},
+		{A: 0.00014609562, B: 1.56518573691, C: 3.9321532631},
+		{A: 0.00013160308, B: 4.44891180176, C: 14.2270940016},
+		{A: 0.00015053509, B: 2.71670027883, C: 639.897286314},
+		{A: 0.00013005305, B: 5.98119067061, C: 11.0457002639},
+		{A: 0.00010725066, B: 3.12939596466, C: 202.2533951741},
+		{A: 5.863207e-05, B: 0.23657028777, C: 529.6909650946},
+		{A: 5.227771e-05, B: 4.2078316238, C: 3.1813937377},
+		{A: 6.126308e-05, B: 1.76328499656, C: 277.0349937414},
+		{A: 5.019658e-05, B: 3.17787919533, C: 433.7117378768},
+		{A: 4.592541e-05, B: 0.61976424374, C: 199.0720014364},
+		{A: 4.005862e-05, B: 2.24479893937, C: 63.7358983034},
+		{A: 2.953815e-05, B: 0.98280385206, C: 95.9792272178},
+		{A: 3.873696e-05, B: 3.22282692566, C: 138.5174968707},
+		{A: 2.461172e-05, B: 2.03163631205, C: 735.8765135318},
+		{A: 3.26949e-05, B: 0.77491895787, C: 949.1756089698},
+		{A: 1.758143e-05, B: 3.26580514774, C: 522.5774180938},
+		{A: 1.640183e-05, B: 5.50504966218, C: 846.0828347512},
+		{A: 1.391336e-05, B: 4.02331978116, C: 323.5054166574},
+		{A: 1.580641e-05, B: 4.3726631412, C: 309.2783226558},
+		{A: 1.123515e-05, B: 2.83726793572, C: 415.5524906121},
+		{A: 1.017258e-05, B: 3.71698151814, C: 227.5261894396},
+		{A: 8.48643e-06, B: 3.19149825839, C: 209.3669421749},
+		{A: 1.087237e-05, B: 4.18343232481, C: 2.4476805548},
+		{A: 9.56752e-06, B: 0.50740889886, C: 1265.5674786264},
+		{A: 7.89205e-06, B: 5.00745123149, C: 0.9632078465},
+		{A: 6.86965e-06, B: 1.74714407827, C: 1052.2683831884},
+		{A: 6.5447e-06, B: 1.59889331515, C: 0.0481841098},
+		{A: 7.48811e-06, B: 2.14398149298, C: 853.196381752},
+		{A: 6.3398e-06, B: 2.29889903023, C: 412.3710968744},
+		{A: 7.43584e-06, B: 5.25276954625, C: 224.3447957019},
+		{A: 8.52677e-06, B: 3.42141350697, C: 175.1660598002},
+		{A: 5.79857e-06, B: 3.09259007048, C: 74.7815985673},
+		{A: 6.24904e-06, B: 0.97046831256, C: 210.1177017003},
+		{A: 5.29861e-06, B: 4.44938897119, C: 117.3198682202},
+		{A: 5.42643e-06, B: 1.51824320514, C: 9.5612275556},
+		{A: 4.74279e-06, B: 5.47527185987, C: 742.9900605326},
+		{A: 4.48542e-06, B: 1.28990416161, C: 127.4717966068},
+		{A: 5.46358e-06, B: 2.12678554211, C: 350.3321196004},
+		{A: 4.78054e-06, B: 2.96488054338, C: 137.0330241624},
+		{A: 3.54944e-06, B: 3.0128648303, C: 838.9692877504},
+		{A: 4.51827e-06, B: 1.04436664241, C: 490.3340891794},
+		{A: 3.47413e-06, B: 1.53928227764, C: 340.7708920448},
+		{A: 3.43475e-06, B: 0.24604039134, C: 0.5212648618},
+		{A: 3.09001e-06, B: 3.49486734909, C: 216.4804891757},
+		{A: 3.22185e-06, B: 0.96137456104, C: 203.7378678824},
+		{A: 3.72308e-06, B: 2.27819108625, C: 217.2312487011},
+		{A: 3.21543e-06, B: 2.57182354537, C: 647.0108333148},
+		{A: 3.30196e-06, B: 0.24715617844, C: 1581.959348283},
+		{A: 2.49116e-06, B: 1.47010534421, C: 1368.660252845},
+		{A: 2.86688e-06, B: 2.37043745859, C: 351.8165923087},
+		{A: 2.20225e-06, B: 4.20422424873, C: 200.7689224658},
+		{A: 2.77775e-06, B: 0.40020408926, C: 211.8146227297},
+		{A: 2.045e-06, B: 6.010822066, C: 265.9892934775},
+		{A: 2.07663e-06, B: 0.48349820488, C: 1162.4747044078},
+		{A: 2.08655e-06, B: 1.34516255304, C: 625.6701923124},
+		{A: 1.82454e-06, B: 5.49122292426, C: 2.9207613068},
+		{A: 2.26609e-06, B: 4.91003163138, C: 12.5301729722},
+		{A: 2.07659e-06, B: 1.283022189, C: 39.3568759152},
+		{A: 1.73914e-06, B: 1.86305806814, C: 0.7507595254},
+		{A: 1.8469e-06, B: 3.50344404958, C: 149.5631971346},
+		{A: 1.83511e-06, B: 0.97254952728, C: 4.192785694},
+		{A: 1.46068e-06, B: 6.23102544071, C: 195.1398481733},
+		{A: 1.64541e-06, B: 0.4400551752, C: 5.4166259714},
+		{A: 1.47526e-06, B: 1.53529320509, C: 5.6290742925},
+		{A: 1.39666e-06, B: 4.29450260069, C: 21.3406410024},
+		{A: 1.31283e-06, B: 4.06828961903, C: 10.2949407385},
+		{A: 1.17283e-06, B: 2.67920400584, C: 1155.361157407},
+		{A: 1.49299e-06, B: 5.73594349789, C: 52.6901980395},
+		{A: 1.22373e-06, B: 1.97588777199, C: 4.665866446},
+		{A: 1.13747e-06, B: 5.59427544714, C: 1059.3819301892},
+		{A: 1.02702e-06, B: 1.19748124058, C: 1685.0521225016},
+		{A: 1.18156e-06, B: 5.340729339, C: 554.0699874828},
+		{A: 1.09275e-06, B: 3.43812715686, C: 536.8045120954},
+		{A: 1.10399e-06, B: 0.1660402409, C: 1.4844727083},
+		{A: 1.24969e-06, B: 6.27737805832, C: 1898.3512179396},
+		{A: 8.9949e-07, B: 5.80392934702, C: 114.1384744825},
+		{A: 1.03956e-06, B: 2.19210363069, C: 88.865680217},
+		{A: 1.12437e-06, B: 1.10502663534, C: 191.2076949102},
+		{A: 1.0657e-06, B: 4.01156608514, C: 956.2891559706},
+		{A: 9.143e-07, B: 1.8752157751, C: 38.1330356378},
+		{A: 8.3791e-07, B: 5.48810655641, C: 0.1118745846},
+		{A: 8.3461e-07, B: 2.28972767279, C: 628.8515860501},
+		{A: 9.6987e-07, B: 4.53666595763, C: 302.164775655},
+		{A: 1.00631e-06, B: 4.96513666539, C: 269.9214467406},
+		{A: 7.5491e-07, B: 2.18045274099, C: 728.762966531},
+		{A: 9.633e-07, B: 2.8331918921, C: 275.5505210331},
+		{A: 8.2363e-07, B: 3.05469876064, C: 440.8252848776},
+		{A: 7.3888e-07, B: 5.08914205084, C: 1375.7737998458},
+		{A: 7.1633e-07, B: 5.1094074343, C: 65.2203710117},
+		{A: 7.0409e-07, B: 4.86846451411, C: 0.2124483211},
+		{A: 6.976e-07, B: 3.71029022489, C: 14.977853527},
+		{A: 8.8772e-07, B: 3.86334563977, C: 278.5194664497},
+		{A: 6.809e-07, B: 0.7341546099, C: 1478.8665740644},
+		{A: 6.6501e-07, B: 0.02677580336, C: 70.8494453042},
+		{A: 6.5682e-07, B: 2.02165559602, C: 142.4496501338},
+		{A: 7.5765e-07, B: 1.61410487792, C: 284.1485407422},
+		{A: 6.3153e-07, B: 3.49493353034, C: 479.2883889155},
+		{A: 6.2539e-07, B: 2.58713611532, C: 422.6660376129},
+		{A: 6.9313e-07, B: 3.43979731402, C: 515.463871093},
+		{A: 7.9021e-07, B: 4.45154941586, C: 35.4247226521},
+		{A: 6.3664e-07, B: 3.31749528708, C: 62.2514255951},
+		{A: 5.2939e-07, B: 5.51392725227, C: 0.2606324309},
+		{A: 5.3011e-07, B: 3.18480701697, C: 8.0767548473},
+		{A: 5.4492e-07, B: 2.45674090515, C: 22.0914005278},
+		{A: 5.0514e-07, B: 4.26749346978, C: 99.1606209555},
+		{A: 5.517e-07, B: 0.9679744615, C: 942.062061969},
+		{A: 4.9288e-07, B: 2.38641424063, C: 1471.7530270636},
+		{A: 4.7199e-07, B: 2.02515248245, C: 312.1990839626},
+		{A: 6.108e-07, B: 1.50295092063, C: 210.8514148832},
+		{A: 4.5126e-07, B: 0.93109376473, C: 2001.4439921582},
+		{A: 6.0556e-07, B: 2.68715551585, C: 388.4651552382},
+		{A: 4.3452e-07, B: 2.52602011714, C: 288.0806940053},
+		{A: 4.2544e-07, B: 3.81793980322, C: 330.6189636582},
+		{A: 3.9915e-07, B: 5.713786529, C: 408.4389436113},
+		{A: 5.0145e-07, B: 6.03164759907, C: 2214.7430875962},
+		{A: 4.586e-07, B: 0.54229721801, C: 212.3358875915},
+		{A: 5.4165e-07, B: 0.78154835399, C: 191.9584544356},
+		{A: 4.7016e-07, B: 4.59934671151, C: 437.6438911399},
+		{A: 4.2362e-07, B: 1.90070070955, C: 430.5303441391},
+		{A: 3.9722e-07, B: 1.63259419913, C: 1066.49547719},
+		{A: 3.6345e-07, B: 0.84756992711, C: 213.3472795478},
+		{A: 3.5468e-07, B: 4.18603772925, C: 215.7467759928},
+		{A: 3.6344e-07, B: 3.93295730315, C: 213.2509113282},
+		{A: 3.8005e-07, B: 0.31313803095, C: 423.4167971383},
+		{A: 4.4746e-07, B: 1.12488341174, C: 6.1503391543},
+		{A: 3.7902e-07, B: 1.19795851115, C: 2.7083129857},
+		{A: 4.3402e-07, B: 1.37363944007, C: 563.6312150384},
+		{A: 4.3764e-07, B: 3.93043802956, C: 525.4981794006},
+		{A: 3.4825e-07, B: 1.01566605408, C: 203.0041546995},
+		{A: 3.1755e-07, B: 1.69273634405, C: 0.1600586944},
+		{A: 3.088e-07, B: 6.13525703832, C: 417.0369633204},
+		{A: 3.6388e-07, B: 6.00586032647, C: 18.1592472647},
+		{A: 2.9032e-07, B: 1.19660544505, C: 404.5067903482},
+		{A: 3.2812e-07, B: 0.53649479713, C: 107.0249274817},
+		{A: 3.0433e-07, B: 0.72335287989, C: 222.8603229936},
+		{A: 3.2644e-07, B: 0.81204701486, C: 1795.258443721},
+		{A: 3.7769e-07, B: 3.69666903716, C: 1272.6810256272},
+		{A: 2.7679e-07, B: 1.45663979401, C: 7.1617311106},
+		{A: 2.7187e-07, B: 1.89731951902, C: 1045.1548361876},
+		{A: 3.7699e-07, B: 4.51997049537, C: 24.3790223882},
+		{A: 3.4885e-07, B: 4.46095761791, C: 214.2623032845},
+		{A: 3.265e-07, B: 0.66372395761, C: 692.5874843535},
+		{A: 3.0324e-07, B: 5.30369950147, C: 33.9402499438},
+		{A: 2.748e-07, B: 6.22702216249, C: 1.2720243872},
+		{A: 2.6657e-07, B: 4.56713198392, C: 7.065362891},
+		{A: 3.1745e-07, B: 5.49798599565, C: 56.6223513026},
+		{A: 2.805e-07, B: 5.64447420566, C: 128.9562693151},
+		{A: 2.4277e-07, B: 3.93966553574, C: 414.0680179038},
+		{A: 3.2017e-07, B: 5.22260660455, C: 92.0470739547},
+		{A: 2.6976e-07, B: 0.06705123981, C: 205.2223405907},
+		{A: 2.2974e-07, B: 3.6581775177, C: 207.6700211455},
+		{A: 3.1775e-07, B: 5.59198119173, C: 6069.7767545534},
+		{A: 2.3153e-07, B: 2.10054506119, C: 1788.1448967202},
+		{A: 3.1025e-07, B: 0.37190053329, C: 703.6331846174},
+		{A: 2.9376e-07, B: 0.14742155778, C: 131.4039498699},
+		{A: 2.2562e-07, B: 5.24009182383, C: 212.7778305762},
+		{A: 2.6185e-07, B: 5.41311252822, C: 140.001969579},
+		{A: 2.5673e-07, B: 4.36038885283, C: 32.2433289144},
+		{A: 2.0392e-07, B: 2.8241390926, C: 429.7795846137},
+		{A: 2.0659e-07, B: 0.67091805084, C: 2317.8358618148},
+		{A: 2.4397e-07, B: 3.08740396398, C: 145.6310438715},
+		{A: 2.3735e-07, B: 2.54365387567, C: 76.2660712756},
+		{A: 2.0157e-07, B: 5.06708675157, C: 617.8058857862},
+		{A: 2.3307e-07, B: 3.97357729211, C: 483.2205421786},
+		{A: 2.2878e-07, B: 6.10452832642, C: 177.8743727859},
+		{A: 2.2978e-07, B: 3.20140795404, C: 208.633228992},
+		{A: 2.0638e-07, B: 5.22128727027, C: 6.592282139},
+		{A: 2.1446e-07, B: 0.72034565528, C: 1258.4539316256},
+		{A: 1.8034e-07, B: 6.11382719947, C: 210.3783341312},
+		{A: 2.238e-07, B: 5.92299908546, C: 173.9422195228},
+		{A: 1.9128e-07, B: 5.77772013766, C: 213.8203602998},
+		{A: 2.0871e-07, B: 5.79126331864, C: 2531.1349572528},
+		{A: 1.9327e-07, B: 1.64147367403, C: 565.1156877467},
+		{A: 1.6806e-07, B: 3.27953583323, C: 98.8999885246},
+		{A: 2.0833e-07, B: 2.01655935909, C: 860.3099287528},
+		{A: 1.7939e-07, B: 3.14329498012, C: 831.8557407496},
+		{A: 1.5653e-07, B: 3.10137669623, C: 106.2741679563},
+		{A: 1.8235e-07, B: 5.22595172482, C: 73.297125859},
+		{A: 1.9302e-07, B: 5.9394711405, C: 425.1137181677},
+		{A: 1.4514e-07, B: 2.75049388379, C: 1.2238402774},
+		{A: 1.4562e-07, B: 5.18795088579, C: 305.3461693927},
+		{A: 1.4254e-07, B: 3.88079504939, C: 54.1746707478},
+		{A: 1.4594e-07, B: 3.25016810034, C: 78.7137518304},
+		{A: 1.3637e-07, B: 2.55486219141, C: 405.2575498736},
+		{A: 1.3914e-07, B: 1.72356993808, C: 69.1525242748},
+		{A: 1.3689e-07, B: 2.37430586272, C: 125.9873238985},
+		{A: 1.3496e-07, B: 0.82683590985, C: 99.9113804809},
+		{A: 1.8483e-07, B: 0.73171264866, C: 9999.986450773},
+		{A: 1.3542e-07, B: 3.58584380924, C: 234.6397364404},
+		{A: 1.3741e-07, B: 6.18458356845, C: 245.5424243524},
+		{A: 1.6944e-07, B: 0.72200792996, C: 2111.6503133776},
+		{A: 1.7441e-07, B: 0.23803796878, C: 134.5853436076},
+		{A: 1.4181e-07, B: 4.51963935804, C: 59.8037450403},
+		{A: 1.3598e-07, B: 2.53776983965, C: 1.6969210294},
+		{A: 1.224e-07, B: 2.11973445754, C: 28.3111756513},
+		{A: 1.1988e-07, B: 1.62114832786, C: 1361.5467058442},
+		{A: 1.1974e-07, B: 4.0737873512, C: 280.9671470045},
+		{A: 1.2758e-07, B: 5.31146919749, C: 344.7030453079},
+		{A: 1.6051e-07, B: 3.97093160336, C: 355.7487455718},
+		{A: 1.1427e-07, B: 5.51123470805, C: 192.6921676185},
+		{A: 1.3133e-07, B: 4.69168003518, C: 767.3690829208},
+		{A: 1.4746e-07, B: 3.28998910617, C: 1589.0728952838},
+		{A: 1.1417e-07, B: 1.81615681635, C: 2104.5367663768},
+		{A: 1.1626e-07, B: 2.79410384978, C: 362.8622925726},
+		{A: 1.3234e-07, B: 4.16642914717, C: 225.8292684102},
+		{A: 1.0599e-07, B: 5.50554288376, C: 199.2844497575},
+		{A: 1.0558e-07, B: 3.57501718639, C: 1.4362885985},
+		{A: 1.0485e-07, B: 2.84462532686, C: 85.8272988312},
+		{A: 1.0296e-07, B: 0.22225264071, C: 198.321241911},
+		{A: 1.0552e-07, B: 0.18716643576, C: 217.491881132},
+		{A: 1.1853e-07, B: 0.11584857323, C: 7.6348118626},
+		{A: 1.0248e-07, B: 0.2190415417, C: 144.1465711632},
+		{A: 1.0403e-07, B: 1.68776321208, C: 31.019488637},
+		{A: 1.0313e-07, B: 4.72132701805, C: 216.2198567448},
+		{A: 1.0719e-07, B: 2.60869377832, C: 339.2864193365},
+		{A: 9.636e-08, B: 3.66746262954, C: 212.5483359126},
+		{A: 9.631e-08, B: 3.34275630477, C: 223.5940361765},
+		{A: 9.684e-08, B: 0.41556436593, C: 2634.2277314714},
+		{A: 9.885e-08, B: 4.01798130416, C: 207.1487562837},
+		{A: 1.3212e-07, B: 6.00683506785, C: 214.7835681463},
+		{A: 1.1346e-07, B: 2.61898383052, C: 7.8643065262},
+		{A: 9.158e-08, B: 5.39855118256, C: 342.2553647531},
+		{A: 1.1882e-07, B: 4.00188476744, C: 267.4737661858},
+		{A: 1.2054e-07, B: 3.59904816676, C: 124.433415221},
+		{A: 8.921e-08, B: 4.22716773496, C: 6.3627874754},
+		{A: 1.0142e-07, B: 3.60807025662, C: 14.0146456805},
+		{A: 9.35e-08, B: 0.72255756005, C: 347.8844390456},
+		{A: 1.0529e-07, B: 2.36779614951, C: 831.1049812242},
+		{A: 8.587e-08, B: 4.48439552745, C: 1692.1656695024},
+		{A: 1.0142e-07, B: 3.93620624488, C: 207.8824694666},
+		{A: 9.147e-08, B: 4.28032835242, C: 312.4597163935},
+		{A: 8.088e-08, B: 0.81225752596, C: 264.5048207692},
+		{A: 9.241e-08, B: 4.26402650779, C: 20.6069278195},
+		{A: 9.614e-08, B: 0.64291347187, C: 218.9281697305},
+		{A: 8.537e-08, B: 0.48756672382, C: 1574.8458012822},
+		{A: 7.986e-08, B: 4.71088791079, C: 333.657345044},
+		{A: 8.951e-08, B: 0.90641577433, C: 497.4476361802},
+		{A: 7.959e-08, B: 2.73277594136, C: 4.1446015842},
+		{A: 9.133e-08, B: 5.08250578843, C: 241.6102710893},
+		{A: 9.669e-08, B: 1.60623316904, C: 0.8937718773},
+		{A: 8.883e-08, B: 5.55491009279, C: 2847.5268269094},
+		{A: 8.926e-08, B: 5.80857835271, C: 329.7251917809},
+		{A: 7.226e-08, B: 0.60164771281, C: 206.233732547},
+		{A: 7.655e-08, B: 5.53676341721, C: 116.4260963429},
+		{A: 7.118e-08, B: 0.18747501525, C: 209.106309744},
+		{A: 7.507e-08, B: 5.43555636173, C: 621.7380390493},
+		{A: 8.885e-08, B: 5.36210591059, C: 343.2185725996},
+		{A: 7.056e-08, B: 0.41911130648, C: 756.3233826569},
+		{A: 8.124e-08, B: 4.05571025939, C: 237.6781178262},
+		{A: 8.964e-08, B: 1.6502392713, C: 210.3301500214},
+		{A: 6.961e-08, B: 3.17855200943, C: 543.0242872189},
+		{A: 8.916e-08, B: 0.56503620503, C: 2428.0421830342},
+		{A: 6.926e-08, B: 3.66869171435, C: 247.2393453818},
+		{A: 8.982e-08, B: 4.25046722481, C: 46.470422916},
+		{A: 7.089e-08, B: 5.14399672225, C: 231.4583427027},
+		{A: 7.381e-08, B: 1.25092810119, C: 217.964961884},
+		{A: 7.134e-08, B: 2.83090354854, C: 1148.2476104062},
+		{A: 6.353e-08, B: 0.82582711056, C: 31.492569389},
+		{A: 7.558e-08, B: 5.62617378543, C: 518.6452648307},
+		{A: 6.383e-08, B: 3.54809945181, C: 244.318584075},
+		{A: 6.914e-08, B: 3.70012837706, C: 206.1373643274},
+		{A: 6.286e-08, B: 5.79144749096, C: 179.3588454942},
+		{A: 6.639e-08, B: 4.55197585824, C: 120.358249606},
+		{A: 5.823e-08, B: 1.40737990571, C: 214.0498549634},
+		{A: 5.85e-08, B: 4.86725483749, C: 320.3240229197},
+		{A: 6.213e-08, B: 1.07959478499, C: 251.4321310758},
+		{A: 7.73e-08, B: 3.82244175824, C: 380.12776796},
+		{A: 5.716e-08, B: 1.34909972549, C: 1677.9385755008},
+		{A: 6.469e-08, B: 1.34776801494, C: 188.9200730498},
+		{A: 5.668e-08, B: 2.28643368177, C: 20.4468691251},
+		{A: 6.092e-08, B: 3.62275289839, C: 1169.5882514086},
+		{A: 5.711e-08, B: 0.51687421521, C: 148.0787244263},
+		{A: 5.804e-08, B: 1.54831552984, C: 2420.9286360334},
+		{A: 5.703e-08, B: 5.0599348323, C: 2.9689454166},
+		{A: 5.913e-08, B: 1.66225477547, C: 842.1506814881},
+		{A: 7.449e-08, B: 1.36195943673, C: 166.828672522},
+		{A: 6.482e-08, B: 1.94032041024, C: 357.4456666012},
+		{A: 6.368e-08, B: 2.44556930837, C: 654.1243803156},
+		{A: 6.327e-08, B: 0.40654591365, C: 168.0525127994},
+		{A: 5.573e-08, B: 2.69383455663, C: 750.1036075334},
+		{A: 7.216e-08, B: 2.22547711392, C: 488.8496164711},
+		{A: 6.701e-08, B: 6.03737590382, C: 160.6088973985},
+		{A: 6.938e-08, B: 5.7836203441, C: 700.6642392008},
+		{A: 6.701e-08, B: 3.14738404371, C: 491.8185618877},
+		{A: 5.684e-08, B: 2.59531540359, C: 1.6445314027},
+		{A: 4.9e-08, B: 2.03902856851, C: 0.8031491521},
+		{A: 5.147e-08, B: 4.10182033298, C: 196.6243208816},
+		{A: 4.985e-08, B: 2.96765983996, C: 258.8757464767},
+		{A: 5.911e-08, B: 1.81507526918, C: 252.6559713532},
+		{A: 6.056e-08, B: 3.33431010543, C: 182.279606801},
+		{A: 6.195e-08, B: 5.01900871714, C: 273.1028404783},
+		{A: 6.316e-08, B: 5.49053160191, C: 206.706813299},
+		{A: 5.529e-08, B: 3.31498938717, C: 1905.4647649404},
+		{A: 5.102e-08, B: 3.9817145361, C: 254.9435932136},
+		{A: 4.762e-08, B: 2.24463685255, C: 635.9651330509},
+		{A: 5.213e-08, B: 0.53609344278, C: 135.5485514541},
+		{A: 4.639e-08, B: 0.04466373027, C: 213.1872208534},
+		{A: 5.951e-08, B: 0.5456548749, C: 51.2057253312},
+		{A: 4.535e-08, B: 0.16088614438, C: 2950.619601128},
+		{A: 4.639e-08, B: 4.73769153591, C: 213.4109700226},
+		{A: 4.716e-08, B: 3.13636467789, C: 28.5718080822},
+		{A: 4.748e-08, B: 1.12156952989, C: 6.2197751235},
+		{A: 5.735e-08, B: 0.04425142145, C: 348.8476468921},
+		{A: 4.334e-08, B: 2.68814219154, C: 81.7521332162},
+		{A: 4.538e-08, B: 3.83676888638, C: 487.3651437628},
+		{A: 5.582e-08, B: 3.63486861028, C: 248.7238180901},
+		{A: 4.106e-08, B: 3.39164360376, C: 50.4025761791},
+		{A: 5.657e-08, B: 3.59967787362, C: 282.4516197128},
+		{A: 5.145e-08, B: 1.33329458239, C: 173.6815870919},
+		{A: 3.898e-08, B: 4.11804949361, C: 213.5115437591},
+		{A: 3.898e-08, B: 0.66430577257, C: 213.0866471169},
+		{A: 4.418e-08, B: 0.10784811796, C: 905.8865797915},
+		{A: 4.935e-08, B: 2.19060382431, C: 189.7232222019},
+		{A: 3.799e-08, B: 2.60752583205, C: 546.956440482},
+		{A: 3.96e-08, B: 1.6033988901, C: 218.7157214094},
+		{A: 3.74e-08, B: 3.30724497407, C: 274.0660483248},
+		{A: 3.778e-08, B: 0.26606330942, C: 636.7158925763},
+		{A: 4.657e-08, B: 0.37532078548, C: 2744.4340526908},
+		{A: 3.682e-08, B: 5.11587898667, C: 458.8415197904},
+		{A: 4.23e-08, B: 5.18313062329, C: 27.0873353739},
+		{A: 5.181e-08, B: 3.75590784411, C: 3127.3133312618},
+		{A: 3.904e-08, B: 2.21738744557, C: 358.9301393095},
+		{A: 4.784e-08, B: 4.60666675927, C: 72.0732855816},
+		{A: 3.552e-08, B: 3.23789349146, C: 543.9180590962},
+		{A: 3.502e-08, B: 3.68869576093, C: 41.6444977756},
+		{A: 4.803e-08, B: 4.73553427126, C: 240.3864308119},
+		{A: 3.768e-08, B: 3.86077796242, C: 2008.557539159},
+		{A: 3.68e-08, B: 5.36657425183, C: 10.0343083076},
+		{A: 4.298e-08, B: 3.15595944154, C: 738.7972748386},
+		{A: 3.388e-08, B: 0.73176365772, C: 11.3063326948},
+		{A: 3.507e-08, B: 2.62508475661, C: 13.3333221243},
+		{A: 3.552e-08, B: 0.28967392251, C: 1891.2376709388},
+		{A: 3.604e-08, B: 4.6932409048, C: 295.0512286542},
+		{A: 3.621e-08, B: 6.25264336426, C: 129.9194771616},
+		{A: 3.334e-08, B: 5.04221806054, C: 153.4953503977},
+		{A: 3.837e-08, B: 5.31732096284, C: 3163.918696566},
+		{A: 3.281e-08, B: 5.59031570352, C: 2.0057375701},
+		{A: 4.042e-08, B: 2.3708130809, C: 176.6505325085},
+		{A: 3.5e-08, B: 2.5474426836, C: 1464.6394800628},
+		{A: 4.144e-08, B: 5.46982520458, C: 6.9010986797},
+		{A: 3.691e-08, B: 4.07518441665, C: 969.6224780949},
+		{A: 3.947e-08, B: 4.27108449197, C: 181.806526049},
+		{A: 3.867e-08, B: 5.4864338631, C: 37.8724032069},
+		{A: 3.339e-08, B: 6.05372370584, C: 9.4011688612},
+		{A: 3.484e-08, B: 5.81097824751, C: 13.4933808187},
+		{A: 3.033e-08, B: 2.38897886651, C: 221.3758502853},
+		{A: 2.99e-08, B: 4.13995939326, C: 66.70484372},
+		{A: 3.746e-08, B: 5.29902286106, C: 561.1835344836},
+		{A: 3.233e-08, B: 4.27743802321, C: 593.426863398},
+		{A: 3.17e-08, B: 1.7540047777, C: 235.3904959658},
+		{A: 4.114e-08, B: 2.01006788412, C: 601.7642506762},
+		{A: 2.937e-08, B: 4.76351448561, C: 213.5597278689},
+		{A: 2.932e-08, B: 1.83671373509, C: 501.3797894433},
+		{A: 2.937e-08, B: 0.01884528825, C: 213.0384630071},
+		{A: 3.268e-08, B: 4.44653949711, C: 60.7669528868},
+		{A: 3.608e-08, B: 0.14307251176, C: 552.5855147745},
+		{A: 2.947e-08, B: 0.74753671556, C: 17.5261078183},
+		{A: 3.979e-08, B: 0.76931722276, C: 424.1505103212},
+		{A: 2.803e-08, B: 1.07518176128, C: 1994.3304451574},
+		{A: 2.905e-08, B: 1.27201007426, C: 2737.32050569},
+		{A: 3.61e-08, B: 0.22394084, C: 121.2520214833},
+		{A: 2.846e-08, B: 5.11748545179, C: 205.6642835754},
+		{A: 3.156e-08, B: 2.74955723696, C: 494.2662424425},
+		{A: 3.576e-08, B: 4.49826302447, C: 167.0893049529},
+		{A: 2.746e-08, B: 0.66908290712, C: 7.0016724162},
+		{A: 2.78e-08, B: 2.10066625279, C: 894.8408795276},
+		{A: 2.875e-08, B: 2.39009721774, C: 151.0476698429},
+		{A: 3.02e-08, B: 0.2547582689, C: 40.8413486235},
+		{A: 2.731e-08, B: 3.74814908509, C: 429.0458714308},
+		{A: 2.793e-08, B: 4.1793883723, C: 292.0128472684},
+		{A: 2.706e-08, B: 5.34438894925, C: 327.4375699205},
+		{A: 2.965e-08, B: 0.61653881148, C: 643.8294395771},
+		{A: 2.616e-08, B: 4.8190138756, C: 681.5417840896},
+		{A: 2.548e-08, B: 3.7816258082, C: 1485.9801210652},
+		{A: 3.483e-08, B: 5.76091147029, C: 141.2258098564},
+		{A: 3.257e-08, B: 0.75722680616, C: 555.5544601911},
+		{A: 2.887e-08, B: 6.15899159727, C: 425.6349830295},
+		{A: 2.45e-08, B: 1.29619859767, C: 193.655375465},
+		{A: 3.401e-08, B: 2.48137843009, C: 43.2890291783},
+		{A: 3.208e-08, B: 0.6600284234, C: 776.9303104764},
+		{A: 2.435e-08, B: 4.58097103726, C: 477.8039162072},
+		{A: 2.577e-08, B: 1.41538858001, C: 100.6450936638},
+		{A: 2.6e-08, B: 3.73139519973, C: 17.4084877393},
+		{A: 2.428e-08, B: 1.04400815278, C: 1279.794572628},
+		{A: 2.569e-08, B: 5.36004101928, C: 7.2254215854},
+		{A: 2.844e-08, B: 2.4722876765, C: 280.003939158},
+		{A: 2.847e-08, B: 1.52706408796, C: 17.2654753874},
+		{A: 2.461e-08, B: 2.73899140465, C: 172.2452984934},
+		{A: 3.228e-08, B: 4.10258705369, C: 618.5566453116},
+		{A: 2.288e-08, B: 0.18365494079, C: 426.6463749858},
+		{A: 2.952e-08, B: 3.97748947007, C: 650.9429865779},
+		{A: 2.653e-08, B: 0.14255829255, C: 162.8965192589},
+		{A: 2.291e-08, B: 3.26940117011, C: 426.5500067662},
+		{A: 3.118e-08, B: 2.80941831445, C: 2221.856634597},
+		{A: 2.343e-08, B: 4.24349768377, C: 113.3877149571},
+		{A: 2.78e-08, B: 4.36271946528, C: 130.4407420234},
+		{A: 2.539e-08, B: 5.58396427573, C: 381.3516082374},
+		{A: 2.673e-08, B: 2.74210116623, C: 45.5766510387},
+		{A: 3.017e-08, B: 3.7220807074, C: 228.276948965},
+		{A: 2.781e-08, B: 0.36312756349, C: 8.5980197091},
+		{A: 2.377e-08, B: 4.49193242045, C: 25.1297819136},
+		{A: 2.14e-08, B: 5.43424670725, C: 630.3360587584},
+		{A: 2.456e-08, B: 1.71617205116, C: 313.6835566709},
+		{A: 2.071e-08, B: 2.40453395841, C: 16.4623262353},
+		{A: 2.05e-08, B: 6.19704773331, C: 3267.0114707846},
+		{A: 2.764e-08, B: 0.40107063007, C: 219.4494345923},
+		{A: 2.307e-08, B: 2.61462153778, C: 26.826702943},
+		{A: 2.65e-08, B: 0.05892373791, C: 5856.4776591154},
+		{A: 1.974e-08, B: 2.15890150781, C: 746.9222137957},
+		{A: 1.949e-08, B: 3.13157993205, C: 226.6324175623},
+		{A: 2.063e-08, B: 0.75916097286, C: 472.1748419147},
+		{A: 2.172e-08, B: 1.41622302638, C: 23.5758732361},
+		{A: 2.378e-08, B: 3.45446288811, C: 241.8709035202},
+		{A: 2.314e-08, B: 2.92766120608, C: 170.7608257851},
+		{A: 2.409e-08, B: 1.55291842382, C: 112.6540017742},
+		{A: 2.092e-08, B: 4.33481587531, C: 210.5907824523},
+		{A: 1.883e-08, B: 4.75777119721, C: 115.6229471908},
+		{A: 1.963e-08, B: 5.63940648232, C: 454.9093665273},
+		{A: 1.871e-08, B: 2.14579836453, C: 135.336103133},
+		{A: 2.304e-08, B: 0.11816226543, C: 3060.8259223474},
+		{A: 2.221e-08, B: 4.34506511014, C: 556.5176680376},
+		{A: 1.867e-08, B: 5.70943358261, C: 19.1224551112},
+		{A: 2.269e-08, B: 3.36100653157, C: 696.5196376166},
+		{A: 2.127e-08, B: 0.4475492931, C: 216.0074084237},
+		{A: 1.807e-08, B: 6.1542731617, C: 5.8415226136},
+		{A: 2.213e-08, B: 3.42223891884, C: 533.6231183577},
+		{A: 1.866e-08, B: 3.90535444843, C: 220.364458329},
+		{A: 1.767e-08, B: 0.94232357739, C: 213.4591541324},
+		{A: 1.767e-08, B: 3.84003619647, C: 213.1390367436},
+		{A: 1.91e-08, B: 3.72504487558, C: 104.0559820651},
+		{A: 1.75e-08, B: 0.82378244287, C: 220.4608265486},
+		{A: 1.838e-08, B: 0.06310147657, C: 436.1594184316},
+		{A: 2.146e-08, B: 4.41415180481, C: 184.0941479094},
+		{A: 1.73e-08, B: 2.21039276178, C: 416.3032501375},
+		{A: 1.715e-08, B: 0.26601715797, C: 103.1409583284},
+		{A: 1.71e-08, B: 0.6351540758, C: 181.0557665236},
+		{A: 2.307e-08, B: 3.29544714308, C: 569.0478410098},
+		{A: 1.906e-08, B: 5.30639447218, C: 405.9912630565},
+		{A: 1.863e-08, B: 4.68613642432, C: 286.596221297},
+		{A: 1.873e-08, B: 2.26516020863, C: 1781.0313497194},
+		{A: 2.035e-08, B: 3.85188859267, C: 672.1406152284},
+		{A: 2.236e-08, B: 3.01959133214, C: 105.5404547734},
+		{A: 1.767e-08, B: 1.45800271562, C: 16.6747745564},
+		{A: 1.633e-08, B: 0.16030477876, C: 18.9100067901},
+		{A: 2.116e-08, B: 2.90186702031, C: 486.4019359163},
+		{A: 2.202e-08, B: 3.88125957017, C: 427.5613987225},
+		{A: 1.706e-08, B: 3.35213628354, C: 103.0445901088},
+		{A: 1.604e-08, B: 2.48973273967, C: 55.6591434561},
+		{A: 1.744e-08, B: 1.83791106739, C: 1044.4040766622},
+		{A: 1.569e-08, B: 6.10089581118, C: 106.0135355254},
+		{A: 2.081e-08, B: 6.03810192844, C: 916.9322800554},
+		{A: 1.799e-08, B: 5.01592570405, C: 731.9443602687},
+		{A: 1.737e-08, B: 1.49651330833, C: 25.8634950965},
+		{A: 1.695e-08, B: 3.53314158403, C: 627.3671133418},
+		{A: 1.543e-08, B: 0.81384993001, C: 2310.722314814},
+		{A: 1.896e-08, B: 3.38169845451, C: 2324.9494088156},
+		{A: 1.926e-08, B: 4.66519027283, C: 353.301065017},
+		{A: 1.765e-08, B: 5.14740716994, C: 107.2855599126},
+		{A: 1.556e-08, B: 1.12431826916, C: 230.8252032563},
+		{A: 1.843e-08, B: 0.02435960281, C: 102.1295663721},
+		{A: 1.501e-08, B: 4.18415120927, C: 194.1766403268},
+		{A: 1.528e-08, B: 1.00328674046, C: 3053.7123753466},
+		{A: 1.529e-08, B: 5.58893570479, C: 212.0270710508},
+		{A: 1.684e-08, B: 5.08547245125, C: 3480.3105662226},
+		{A: 1.461e-08, B: 2.31020597821, C: 721.6494195302},
+		{A: 1.48e-08, B: 5.34331643017, C: 418.5214360287},
+		{A: 1.601e-08, B: 5.53623000915, C: 391.1734682239},
+		{A: 1.893e-08, B: 3.62340803433, C: 204.7010757289},
+		{A: 1.529e-08, B: 6.06535432009, C: 77.962992305},
+		{A: 1.529e-08, B: 5.47660937625, C: 214.5711198252},
+		{A: 1.552e-08, B: 2.06693539836, C: 36.6485629295},
+		{A: 1.453e-08, B: 6.04709831442, C: 165.6048322446},
+		{A: 1.393e-08, B: 2.2825336906, C: 403.0223176399},
+		{A: 1.444e-08, B: 2.90650214018, C: 447.9388318784},
+		{A: 1.924e-08, B: 1.37028714759, C: 468.2426886516},
+		{A: 1.426e-08, B: 0.13255011458, C: 2207.6295405954},
+		{A: 1.389e-08, B: 2.21739183113, C: 643.0786800517},
+		{A: 1.365e-08, B: 1.63853880518, C: 629.6023455755},
+		{A: 1.362e-08, B: 3.35131049142, C: 93.531546663},
+		{A: 1.376e-08, B: 5.3698953845, C: 180.1619946463},
+		{A: 1.584e-08, B: 0.85642767335, C: 271.4059194489},
+		{A: 1.405e-08, B: 5.69231057947, C: 25.2727942655},
+		{A: 1.681e-08, B: 5.30308110734, C: 835.0371344873},
+		{A: 1.598e-08, B: 3.04233449432, C: 42.5382696529},
+		{A: 1.759e-08, B: 3.5904306694, C: 508.3503240922},
+		{A: 1.394e-08, B: 4.5507086329, C: 426.0769260142},
+		{A: 1.314e-08, B: 1.81147178081, C: 1382.8873468466},
+		{A: 1.281e-08, B: 4.2650838804, C: 123.5396433437},
+		{A: 1.742e-08, B: 5.71133189432, C: 22.8945496799},
+		{A: 1.483e-08, B: 1.84687831602, C: 289.5651667136},
+		{A: 1.257e-08, B: 3.01131200921, C: 409.9234163196},
+		{A: 1.285e-08, B: 4.41168551011, C: 558.0021407459},
+		{A: 1.355e-08, B: 3.87115897452, C: 1802.3719907218},
+		{A: 1.333e-08, B: 0.08474224795, C: 411.620337349},
+		{A: 1.235e-08, B: 4.08060394635, C: 28.4541880032},
+		{A: 1.373e-08, B: 5.06955106471, C: 427.1194557378},
+		{A: 1.565e-08, B: 2.32953532704, C: 41.0537969446},
+		{A: 1.656e-08, B: 6.06169130804, C: 268.4369740323},
+		{A: 1.212e-08, B: 3.05966957556, C: 420.9691165835},
+		{A: 1.238e-08, B: 5.25936700679, C: 412.5835451955},
+		{A: 1.22e-08, B: 3.92987038126, C: 2.6601288759},
+		{A: 1.552e-08, B: 1.48184004773, C: 9786.687355335},
+		{A: 1.24e-08, B: 1.46716327302, C: 291.262087743},
+		{A: 1.133e-08, B: 5.39046583617, C: 423.6774295692},
+		{A: 1.319e-08, B: 5.79905891015, C: 1108.1399749656},
+		{A: 1.329e-08, B: 0.92291650117, C: 778.4147831847},
+		{A: 1.399e-08, B: 2.55906860098, C: 421.93232443},
+		{A: 1.12e-08, B: 3.86777259232, C: 1033.3583763983},
+		{A: 1.164e-08, B: 4.10048660918, C: 685.4739373527},
+		{A: 1.321e-08, B: 1.45843550806, C: 1073.6090241908},
+		{A: 1.313e-08, B: 0.11761534168, C: 71.8126531507},
+		{A: 1.438e-08, B: 2.57741975416, C: 100.3844612329},
+		{A: 1.19e-08, B: 5.63379509659, C: 5.1078094307},
+		{A: 1.289e-08, B: 5.20604565993, C: 278.2588340188},
+		{A: 1.157e-08, B: 5.00101860101, C: 230.5645708254},
+		{A: 1.233e-08, B: 2.70207317014, C: 282.6640680339},
+		{A: 1.209e-08, B: 4.02230498135, C: 980.6681783588},
+		{A: 1.07e-08, B: 5.17569455055, C: 313.2104759189},
+		{A: 1.292e-08, B: 4.30946655209, C: 219.891377577},
+		{A: 1.399e-08, B: 2.58476795858, C: 2538.2485042536},
+		{A: 1.038e-08, B: 0.1421219968, C: 820.0592809603},
+		{A: 1.245e-08, B: 4.0827889713, C: 525.7588118315},
+		{A: 1.254e-08, B: 2.46275017735, C: 457.617679513},
+		{A: 1.021e-08, B: 1.11239421009, C: 69.3649725959},
+		{A: 1.009e-08, B: 1.01709171385, C: 143.9341228421},
+		{A: 1.075e-08, B: 2.39196853318, C: 48.7580447764},
+		{A: 1.18e-08, B: 6.18938910429, C: 3377.217792004},
+		{A: 9.89e-09, B: 5.94928603657, C: 3583.4033404412},
+		{A: 9.72e-09, B: 4.25434114756, C: 397.3932433474},
+		{A: 9.83e-09, B: 0.04442608551, C: 140.9651774255},
+		{A: 9.72e-09, B: 5.67683107883, C: 422.405405182},
+		{A: 1.298e-08, B: 1.34524469231, C: 875.830299001},
+		{A: 1.19e-08, B: 0.67933974618, C: 699.7010313543},
+		{A: 9.5e-09, B: 2.669643407, C: 92.3077063856},
+		{A: 9.33e-09, B: 0.6300065658, C: 406.954470903},
+		{A: 9.59e-09, B: 1.77556884452, C: 67.6680515665},
+		{A: 1.185e-08, B: 3.70140604185, C: 285.6330134505},
+		{A: 9.56e-09, B: 5.18928530992, C: 319.3126309634},
+		{A: 1.014e-08, B: 1.97449310063, C: 2097.423219376},
+		{A: 1.048e-08, B: 3.69659410655, C: 117.9105690512},
+		{A: 1.153e-08, B: 2.53320305623, C: 104.5772469269},
+		{A: 1.258e-08, B: 2.51536062507, C: 694.0719570618},
+		{A: 9.71e-09, B: 5.19147635849, C: 240.125798381},
+		{A: 9.4e-09, B: 3.94701776697, C: 35.212274331},
+		{A: 1.047e-08, B: 6.1236097946, C: 238.9019581036},
+		{A: 1.185e-08, B: 5.28289734361, C: 638.4128136057},
+		{A: 8.93e-09, B: 0.95364488395, C: 14.8177948326},
+		{A: 1.094e-08, B: 3.9300967924, C: 945.2434557067},
+		{A: 9.49e-09, B: 3.46451925897, C: 443.8636662634},
+		{A: 1.002e-08, B: 3.18639902867, C: 337.732510659},
+		{A: 1.017e-08, B: 2.87111101661, C: 211.6021744086},
+		{A: 8.75e-09, B: 0.58638080067, C: 2.2876218604},
+		{A: 9.25e-09, B: 1.54981519784, C: 19.643719973},
+		{A: 1.152e-08, B: 1.6852860859, C: 691.1030116452},
+		{A: 8.32e-09, B: 2.64637256467, C: 436.8931316145},
+		{A: 8.34e-09, B: 3.8891352157, C: 331.2096644892},
+		{A: 8.25e-09, B: 2.1543787221, C: 739.8086667949},
+		{A: 8.48e-09, B: 3.182632391, C: 196.0336200506},
+		{A: 1.044e-08, B: 3.87842686803, C: 532.6117264014},
+		{A: 8.46e-09, B: 5.38853773752, C: 97.6761482472},
+		{A: 1.021e-08, B: 2.93075488512, C: 184.9879197867},
+		{A: 8.43e-09, B: 6.12012061227, C: 616.3214130779},
+		{A: 8.2e-09, B: 1.01380400969, C: 480.7728616238},
+		{A: 8.42e-09, B: 3.56523575381, C: 421.1815649046},
+		{A: 1.076e-08, B: 3.28234305253, C: 5.6772584023},
+		{A: 8.08e-09, B: 2.78227865672, C: 212.0752551606},
+		{A: 8.12e-09, B: 0.94281737163, C: 108.7218485111},
+		{A: 8.08e-09, B: 2.21202653278, C: 610.6923387854},
+		{A: 8.08e-09, B: 2.00008111713, C: 214.5229357154},
+		{A: 8.75e-09, B: 2.14897461363, C: 114.3991069134},
+		{A: 7.91e-09, B: 2.35474255596, C: 1.3725981237},
+		{A: 9.6e-09, B: 0.25496742364, C: 710.7467316182},
+		{A: 1.001e-08, B: 2.34471240227, C: 16.04163511},
+		{A: 9.94e-09, B: 0.63700664871, C: 84.9335269539},
+		{A: 9.85e-09, B: 2.63664920104, C: 395.578702239},
+		{A: 8.74e-09, B: 2.59112594967, C: 418.0001711669},
+		{A: 7.58e-09, B: 0.58117487362, C: 2627.1141844706},
+		{A: 8.94e-09, B: 1.48976897396, C: 760.25553592},
+		{A: 7.68e-09, B: 5.25095392845, C: 305.0855369618},
+		{A: 1.02e-08, B: 2.73153988233, C: 268.9582388941},
+		{A: 9.03e-09, B: 0.13224671457, C: 238.4288773516},
+		{A: 7.5e-09, B: 0.76128043194, C: 724.8308132679},
+		{A: 9.03e-09, B: 3.3710532337, C: 526.5095713569},
+		{A: 9.3e-09, B: 2.8362259411, C: 2641.3412784722},
+		{A: 8.08e-09, B: 5.23759255053, C: 216.2680408546},
+		{A: 8.64e-09, B: 1.22059443823, C: 570.7447620392},
+		{A: 7.98e-09, B: 3.72388187653, C: 124.5028511902},
+		{A: 7.53e-09, B: 0.72747041757, C: 3370.1042450032},
+		{A: 8.44e-09, B: 2.0325176781, C: 511.5317178299},
+		{A: 8.27e-09, B: 4.49936223096, C: 444.7574381407},
+		{A: 8.48e-09, B: 3.74330244183, C: 2118.7638603784},
+		{A: 7.95e-09, B: 0.27939057139, C: 101.8689339412},
+		{A: 7.54e-09, B: 5.68583497533, C: 662.531203563},
+		{A: 7.5e-09, B: 4.78778128003, C: 102.5715093568},
+		{A: 7.09e-09, B: 1.64518562815, C: 159.1244246902},
+		{A: 7.7e-09, B: 4.0240499195, C: 909.8187330546},
+		{A: 7.65e-09, B: 1.62693133597, C: 465.9550667912},
+		{A: 9.11e-09, B: 5.04635658282, C: 913.9633346388},
+		{A: 8.61e-09, B: 2.78971410809, C: 495.7507151508},
+		{A: 6.88e-09, B: 1.10207467005, C: 1.536862335},
+		{A: 8.03e-09, B: 4.3304391909, C: 453.424893819},
+		{A: 6.73e-09, B: 0.03439333853, C: 2524.021410252},
+		{A: 8.56e-09, B: 3.50639182375, C: 439.1283638482},
+		{A: 6.84e-09, B: 3.93906807606, C: 337.8019466282},
+		{A: 7.16e-09, B: 6.18909854987, C: 310.7146112543},
+		{A: 9.22e-09, B: 1.7063420032, C: 125.1841747464},
+		{A: 8.85e-09, B: 1.69955870744, C: 6283.0758499914},
+		{A: 6.56e-09, B: 1.28102954508, C: 432.0148168474},
+		{A: 8.08e-09, B: 1.64410808383, C: 299.1263942692},
+		{A: 6.56e-09, B: 1.4944916462, C: 849.2642284889},
+		{A: 6.79e-09, B: 4.0296298449, C: 429.5189521828},
+		{A: 8.54e-09, B: 3.04068731741, C: 298.2326223919},
+		{A: 6.76e-09, B: 2.17631477883, C: 576.1613880106},
+		{A: 8.81e-09, B: 5.47733557925, C: 220.9339073006},
+		{A: 7.39e-09, B: 5.85330901725, C: 938.1299087059},
+		{A: 6.37e-09, B: 2.96294462433, C: 425.8474313506},
+		{A: 6.65e-09, B: 1.62998758015, C: 221.1634019642},
+		{A: 6.93e-09, B: 3.538716976, C: 1182.9215735329},
+		{A: 6.59e-09, B: 1.85319023888, C: 72.3339180125},
+		{A: 6.31e-09, B: 2.01234919422, C: 58.1068240109},
+		{A: 8.06e-09, B: 5.21763933753, C: 428.0826635843},
+		{A: 8.04e-09, B: 5.94281804567, C: 26.0235537909},
+		{A: 6.79e-09, B: 2.1178446094, C: 256.4280659219},
+		{A: 6.92e-09, B: 1.88791537515, C: 214.9960164674},
+		{A: 7.31e-09, B: 1.95762888351, C: 19.0105805266},
+		{A: 7.86e-09, B: 0.91252523635, C: 518.3846323998},
+		{A: 6.84e-09, B: 4.89288171806, C: 3796.7024358792},
+		{A: 6.12e-09, B: 2.08511492036, C: 1038.0412891868},
+		{A: 5.98e-09, B: 3.48814927085, C: 219.6618829134},
+		{A: 8.28e-09, B: 0.316894722, C: 25.6028626656},
+		{A: 7.73e-09, B: 4.57524006328, C: 624.919432787},
+		{A: 7.75e-09, B: 6.12920077021, C: 432.2272651685},
+		{A: 8.2e-09, B: 4.1132032613, C: 141.4864422873},
+		{A: 5.88e-09, B: 1.95775535412, C: 211.8628068395},
+		{A: 6.61e-09, B: 5.30100397707, C: 103.6140390804},
+		{A: 5.88e-09, B: 2.82460441973, C: 214.7353840365},
+		{A: 6.51e-09, B: 5.56732715834, C: 393.4610900843},
+		{A: 5.64e-09, B: 4.01666572198, C: 850.0149880143},
+		{A: 6.57e-09, B: 2.58166087726, C: 526.9826521089},
+		{A: 7.22e-09, B: 0.6849421948, C: 953.1077622329},
+		{A: 5.92e-09, B: 2.3719066249, C: 205.4347889118},
+		{A: 7.38e-09, B: 1.07981019512, C: 239.1625905345},
+		{A: 5.9e-09, B: 6.03587790219, C: 188.0263011725},
+		{A: 5.59e-09, B: 5.76010635813, C: 430.79097657},
+		{A: 5.35e-09, B: 5.80499883199, C: 100.1720129118},
+		{A: 6.11e-09, B: 5.954393601, C: 3693.6096616606},
+		{A: 5.91e-09, B: 4.22379888536, C: 505.3119427064},
+		{A: 6.91e-09, B: 2.96568305933, C: 606.7601855223},
+		{A: 6.48e-09, B: 2.33387623043, C: 30.7588562061},
+		{A: 5.44e-09, B: 2.21686115865, C: 92.7978334801},
+		{A: 5.17e-09, B: 2.68282421083, C: 262.0571402144},
+		{A: 5.63e-09, B: 1.69735688719, C: 2413.8150890326},
+		{A: 5.69e-09, B: 2.85075508949, C: 227.3137411185},
+		{A: 5.31e-09, B: 2.17713708433, C: 263.0203480609},
+		{A: 5.04e-09, B: 4.44947885193, C: 343.7398374614},
+		{A: 5.78e-09, B: 3.31462999801, C: 33.7278016227},
+		{A: 6.19e-09, B: 1.83409636127, C: 867.4234757536},
+		{A: 5.46e-09, B: 4.82201187196, C: 1048.3362299253},
+		{A: 5.17e-09, B: 1.42016110098, C: 1246.6574718363},
+		{A: 6.81e-09, B: 1.94124532036, C: 25874.6040461362},
+		{A: 5.51e-09, B: 5.70617358907, C: 1119.1856752295},
+		{A: 5.23e-09, B: 5.78878978812, C: 366.7944458357},
+		{A: 4.86e-09, B: 1.90063955671, C: 1063.3140834523},
+		{A: 5.52e-09, B: 3.64325031166, C: 256.5881246163},
+		{A: 6.12e-09, B: 2.39349965241, C: 2854.6403739102},
+		{A: 4.95e-09, B: 3.4683358124, C: 597.3590166611},
+		{A: 6.22e-09, B: 1.86539391351, C: 524.0137066923},
+		{A: 4.8e-09, B: 5.33557742428, C: 29.2049475286},
+		{A: 4.92e-09, B: 4.64109549618, C: 384.0599212231},
+		{A: 5.2e-09, B: 2.32171681836, C: 2957.7331481288},
+		{A: 5.45e-09, B: 0.5327477871, C: 431.264057322},
+		{A: 4.79e-09, B: 2.1332517724, C: 319.5732633943},
+		{A: 5.26e-09, B: 4.17771910249, C: 136.0698163159},
+		{A: 6.12e-09, B: 4.56148986681, C: 774.4826299216},
+		{A: 6.42e-09, B: 3.25195912708, C: 67.8804998876},
+		{A: 5.27e-09, B: 4.00299045889, C: 2435.155730035},
+		{A: 5.24e-09, B: 4.69817741494, C: 336.8387387817},
+		{A: 4.95e-09, B: 5.95703962647, C: 765.8846102125},
+		{A: 4.63e-09, B: 6.097803226, C: 54.3347294422},
+		{A: 5.38e-09, B: 0.22116216124, C: 450.9772132642},
+		{A: 4.65e-09, B: 1.87487942848, C: 958.576777831},
+		{A: 5e-09, B: 1.54084756342, C: 572.2292347475},
+		{A: 5.28e-09, B: 3.54764543325, C: 233.9060232575},
+		{A: 4.51e-09, B: 5.72664397006, C: 3899.7952100978},
+		{A: 5.14e-09, B: 5.00509609437, C: 273.8536000037},
+		{A: 4.71e-09, B: 2.96871670899, C: 306.830642101},
+		{A: 4.47e-09, B: 1.87279400375, C: 62.038977274},
+		{A: 4.56e-09, B: 5.4552199852, C: 1171.875873269},
+		{A: 4.99e-09, B: 1.9282177853, C: 217.4436970222},
+		{A: 5.48e-09, B: 3.21873307934, C: 824.7421937488},
+		{A: 5.79e-09, B: 2.29424247924, C: 810.6581120991},
+		{A: 5.5e-09, B: 0.6787519659, C: 315.1680293792},
+		{A: 5.56e-09, B: 1.30277646899, C: 133.1008708993},
+		{A: 4.49e-09, B: 6.05954557724, C: 141.6988906084},
+		{A: 4.47e-09, B: 5.83737433218, C: 823.9914342234},
+		{A: 5.17e-09, B: 3.62832879827, C: 934.9485149682},
+		{A: 4.82e-09, B: 1.04855231956, C: 1055.4497769261},
+		{A: 4.82e-09, B: 4.07207792722, C: 195.8906076987},
+		{A: 4.28e-09, B: 0.65142455407, C: 427.3489504014},
+		{A: 5.85e-09, B: 5.48406138684, C: 376.1956146969},
+		{A: 4.69e-09, B: 3.87344294455, C: 320.5846553506},
+		{A: 4.88e-09, B: 2.8352396426, C: 460.5384408198},
+		{A: 4.5e-09, B: 4.30419652064, C: 88.1149206916},
+		{A: 5.37e-09, B: 0.85582040238, C: 214.1928673153},
+		{A: 4.38e-09, B: 1.32216133929, C: 963.4027029714},
+		{A: 5.6e-09, B: 2.50374228728, C: 952.0963702766},
+		{A: 4.42e-09, B: 2.80002649649, C: 209.1544938538},
+		{A: 4.43e-09, B: 4.31062007978, C: 9992.8729037722},
+		{A: 4.69e-09, B: 0.45235276525, C: 464.7312265138},
+		{A: 4.88e-09, B: 0.35817443686, C: 36.9091953604},
+		{A: 4.18e-09, B: 4.81080887468, C: 775.233389447},
+		{A: 4.17e-09, B: 4.93943593579, C: 306.0969289181},
+		{A: 4.83e-09, B: 3.9284792242, C: 39.6175083461},
+		{A: 4.67e-09, B: 1.89153069484, C: 30.0562807905},
+		{A: 4.1e-09, B: 5.52148731635, C: 118.0706277456},
+		{A: 4.06e-09, B: 1.35991757653, C: 945.9942152321},
+		{A: 4.46e-09, B: 4.0665611248, C: 380.3884003909},
+		{A: 4.66e-09, B: 3.65954736664, C: 988.532484885},
+		{A: 4.18e-09, B: 1.40185532806, C: 313.9441891018},
+		{A: 4.81e-09, B: 1.80873987903, C: 43.1289704839},
+		{A: 4.37e-09, B: 0.86746182155, C: 170.9732741062},
+		{A: 4.83e-09, B: 4.49894122772, C: 46.2097904851},
+		{A: 3.98e-09, B: 2.90977731924, C: 131.5469622218},
+		{A: 5.29e-09, B: 3.74604329884, C: 699.1797664925},
+		{A: 3.96e-09, B: 0.34033987778, C: 2943.5060541272},
+		{A: 5.45e-09, B: 2.97400965609, C: 305.6068018236},
+		{A: 4.12e-09, B: 3.81935995126, C: 84.3428261229},
+		{A: 4.25e-09, B: 2.60672101181, C: 121.8427223143},
+		{A: 4.74e-09, B: 2.41769418569, C: 838.218528225},
+		{A: 4.57e-09, B: 1.27246488727, C: 107.7586406646},
+		{A: 5.19e-09, B: 3.12247974037, C: 10213.285546211},
+		{A: 4.95e-09, B: 4.63705386984, C: 301.4140161296},
+		{A: 5.37e-09, B: 3.92653937147, C: 212.4053235607},
+		{A: 3.85e-09, B: 3.3347632505, C: 806.725958836},
+		{A: 4.77e-09, B: 1.66001855277, C: 175.4266922311},
+		{A: 3.78e-09, B: 0.47722247042, C: 200.5564741447},
+		{A: 4.59e-09, B: 5.14821844812, C: 960.2213092337},
+		{A: 4.01e-09, B: 4.36420932544, C: 739.0579072695},
+		{A: 4.67e-09, B: 2.96423984091, C: 170.0100662597},
+		{A: 4.57e-09, B: 4.45829983338, C: 33.1371007917},
+		{A: 4.76e-09, B: 3.63257697985, C: 20.4950532349},
+		{A: 4.62e-09, B: 3.57494442856, C: 71.6002048296},
+		{A: 3.76e-09, B: 2.94770389653, C: 6062.6632075526},
+		{A: 4.73e-09, B: 5.32759318114, C: 373.9079928365},
+		{A: 3.83e-09, B: 1.84111991862, C: 346.3999663373},
+		{A: 3.66e-09, B: 2.05039240297, C: 87.3117715395},
+		{A: 3.83e-09, B: 2.00608370504, C: 3274.1250177854},
+		{A: 3.74e-09, B: 5.65444305031, C: 540.7366653585},
+		{A: 4.1e-09, B: 5.62629715297, C: 58.319272332},
+		{A: 4.41e-09, B: 6.26992749567, C: 378.9039276826},
+		{A: 4.51e-09, B: 3.05920369737, C: 898.7730327907},
+		{A: 3.71e-09, B: 5.65787287655, C: 89.7594520943},
+		{A: 3.67e-09, B: 5.71998148487, C: 96.8729990951},
+		{A: 4.1e-09, B: 1.06290837961, C: 1257.7031721002},
+		{A: 4.18e-09, B: 2.03053179312, C: 146.594251718},
+		{A: 4.92e-09, B: 0.89529424356, C: 423.6292454594},
+		{A: 4.74e-09, B: 2.43080822444, C: 705.1176573257},
+		{A: 4.57e-09, B: 3.42347802916, C: 829.6205085159},
+		{A: 4.19e-09, B: 0.32183412086, C: 90.5626012464},
+		{A: 3.47e-09, B: 3.6011680744, C: 449.232108125},
+		{A: 3.97e-09, B: 2.29159647723, C: 782.3469364478},
+		{A: 4.33e-09, B: 3.40938468811, C: 32.4557772355},
+		{A: 3.43e-09, B: 4.30952656038, C: 401.3253966105},
+		{A: 3.51e-09, B: 0.42160026295, C: 3686.4961146598},
+		{A: 3.6e-09, B: 5.83204569879, C: 491.5579294568},
+		{A: 3.89e-09, B: 2.73429108352, C: 36.1754821775},
+		{A: 4.34e-09, B: 0.33664392528, C: 55.1378785943},
+		{A: 3.46e-09, B: 5.09191837323, C: 392.6579409322},
+		{A: 3.36e-09, B: 2.38696934868, C: 295.1942410061},
+		{A: 3.36e-09, B: 1.56832822829, C: 233.7459645631},
+		{A: 4e-09, B: 3.08774286568, C: 745.9108218394},
+		{A: 4.68e-09, B: 4.21298903633, C: 832.5894539325},
+		{A: 4e-09, B: 1.32415028336, C: 551.1010420662},
+		{A: 3.44e-09, B: 1.52193307438, C: 754.8389099486},
+		{A: 4.33e-09, B: 3.06696455642, C: 885.4397106664},
+		{A: 3.46e-09, B: 4.7697143387, C: 4113.0943055358},
+		{A: 3.29e-09, B: 3.31034285904, C: 952.3570027075},
+		{A: 4.25e-09, B: 2.90590905341, C: 462.0229135281},
+		{A: 3.42e-09, B: 5.87738962422, C: 561.934294009},
+		{A: 4.39e-09, B: 0.20791179302, C: 768.8535556291},
+		{A: 3.3e-09, B: 2.08599193524, C: 614.6244920485},
+		{A: 3.94e-09, B: 3.59805431851, C: 1261.6353253633},
+		{A: 3.69e-09, B: 6.03075127328, C: 199.8057146193},
+		{A: 3.75e-09, B: 2.56075851331, C: 732.6951197941},
+		{A: 4.08e-09, B: 0.69233617038, C: 328.2407190726},
+		{A: 3.31e-09, B: 4.2833330937, C: 541.5398145106},
+		{A: 3.23e-09, B: 0.06694179304, C: 433.7599219866},
+		{A: 4.31e-09, B: 4.5060334092, C: 2914.0142358238},
+		{A: 3.43e-09, B: 2.18898146246, C: 80.1982245387},
+		{A: 4.37e-09, B: 5.34124436008, C: 387.2413149608},
+		{A: 3.71e-09, B: 4.82569229712, C: 103.3534066495},
+		{A: 3.18e-09, B: 3.75720880396, C: 749.2098356561},
+		{A: 3.44e-09, B: 0.70749566894, C: 229.9738699944},
+		{A: 3.12e-09, B: 6.02741985422, C: 361.3778198643},
+		{A: 3.4e-09, B: 0.64358874512, C: 303.8616966844},
+		{A: 3.94e-09, B: 0.49801994278, C: 248.4631856592},
+		{A: 3.09e-09, B: 5.83535487659, C: 236.1936451179},
+		{A: 3.25e-09, B: 2.65292455786, C: 757.2171545342},
+		{A: 3.65e-09, B: 0.56788400592, C: 402.2191684878},
+		{A: 3.42e-09, B: 3.83450185886, C: 519.3960243561},
+		{A: 3.06e-09, B: 0.35126074897, C: 354.9979860464},
+		{A: 3.14e-09, B: 5.42086935152, C: 1151.4290041439},
+		{A: 3.84e-09, B: 0.09367760642, C: 201.5196819912},
+		{A: 3.07e-09, B: 5.62165090342, C: 426.4863162914},
+		{A: 3.01e-09, B: 1.78550205039, C: 1354.4331588434},
+		{A: 3e-09, B: 5.23426324539, C: 190.4045457581},
+		{A: 2.96e-09, B: 3.15801280224, C: 192.8522263129},
+		{A: 3.81e-09, B: 1.98600461808, C: 109.9456887885},
+		{A: 3.7e-09, B: 5.57659190517, C: 562.1467423301},
+		{A: 3.05e-09, B: 2.73187068494, C: 840.6662087798},
+		{A: 3.74e-09, B: 3.73375594662, C: 420.4478517217},
+		{A: 3.07e-09, B: 4.03149340189, C: 426.7100654606},
+		{A: 3.2e-09, B: 1.426651801, C: 2730.2069586892},
+		{A: 3.92e-09, B: 0.143432949, C: 206.3979967583},
+		{A: 2.88e-09, B: 2.44787565138, C: 623.2225117576},
+		{A: 3.44e-09, B: 1.57825843162, C: 6290.1893969922},
+		{A: 3.17e-09, B: 1.84490263693, C: 214.9436268407},
+		{A: 3.46e-09, B: 2.16849893508, C: 3171.0322435668},
+		{A: 2.86e-09, B: 1.01623455966, C: 315.6411101312},
+		{A: 3.61e-09, B: 3.44807605339, C: 259.769518354},
+		{A: 2.8e-09, B: 4.64272946177, C: 254.1404440615},
+		{A: 2.85e-09, B: 2.09430258387, C: 335.1418177523},
+		{A: 2.81e-09, B: 4.72136141979, C: 317.142629182},
+		{A: 2.89e-09, B: 4.70265740009, C: 29.7474642498},
+		{A: 2.9e-09, B: 2.74360609522, C: 551.031606097},
+		{A: 3e-09, B: 5.23308974173, C: 1321.4390704036},
+		{A: 2.83e-09, B: 1.21193797828, C: 1699.2792165032},
+		{A: 2.8e-09, B: 0.45877292284, C: 38.6061163898},
+		{A: 2.73e-09, B: 1.81952809851, C: 1056.2005364515},
+		{A: 3.36e-09, B: 5.63115066542, C: 95.2284676924},
+		{A: 3.09e-09, B: 3.33676739908, C: 1193.9672737968},
+		{A: 2.88e-09, B: 2.57603349418, C: 1166.4068576709},
+		{A: 2.77e-09, B: 3.35359995343, C: 532.8723588323},
+		{A: 2.87e-09, B: 0.37229115993, C: 114.9416236346},
+		{A: 2.74e-09, B: 4.21164027953, C: 90.8232336773},
+		{A: 2.67e-09, B: 5.61367132586, C: 870.4618571394},
+		{A: 3.76e-09, B: 6.14391456675, C: 913.0001267923},
+		{A: 2.96e-09, B: 5.75705165982, C: 4010.0015313172},
+		{A: 3.04e-09, B: 1.97982468634, C: 495.9631634719},
+		{A: 3.08e-09, B: 5.08911712262, C: 481.7360694703},
+		{A: 2.65e-09, B: 0.02893016561, C: 172.4577468145},
+		{A: 2.91e-09, B: 2.10106037916, C: 619.2903584945},
+		{A: 2.73e-09, B: 4.78343050572, C: 771.3012361839},
+		{A: 3.58e-09, B: 0.04093867464, C: 637.4496057592},
+		{A: 2.72e-09, B: 5.86505586319, C: 332.1728723357},
+		{A: 2.66e-09, B: 3.28626000731, C: 560.7104537316},
+		{A: 3.12e-09, B: 3.91213951915, C: 1226.2106027112},
+		{A: 2.58e-09, B: 3.41185135958, C: 426.8106391971},
+		{A: 2.57e-09, B: 1.06772454181, C: 714.6788848813},
+		{A: 2.58e-09, B: 6.24129294573, C: 426.3857425549},
+		{A: 2.56e-09, B: 0.75289096697, C: 103.843533744},
+		{A: 2.54e-09, B: 3.21116652124, C: 102.3420146932},
+		{A: 2.54e-09, B: 6.14632777985, C: 620.253566341},
+		{A: 2.66e-09, B: 2.54280196709, C: 132.8884225782},
+		{A: 3.14e-09, B: 0.31303204249, C: 991.7138786227},
+		{A: 3.17e-09, B: 2.93589163442, C: 357.2332182801},
+		{A: 2.66e-09, B: 6.1228063667, C: 57.5161231799},
+		{A: 2.54e-09, B: 2.99730079627, C: 642.3449668688},
+		{A: 2.67e-09, B: 5.54663413439, C: 628.5909536192},
+		{A: 3.48e-09, B: 1.33319249154, C: 815.0633461142},
+		{A: 2.78e-09, B: 5.5957374192, C: 334.5511169213},
+		{A: 3.03e-09, B: 3.22789148979, C: 409.1897031367},
+		{A: 2.46e-09, B: 3.90430259983, C: 441.576044403},
+		{A: 2.6e-09, B: 3.8635529353, C: 639.3760214522},
+		{A: 2.5e-09, B: 0.07635434166, C: 2840.4132799086},
+		{A: 2.46e-09, B: 5.71009371698, C: 476.3194434989},
+		{A: 3.01e-09, B: 6.15272106984, C: 559.6990617753},
+		{A: 2.68e-09, B: 3.73809606804, C: 658.0565335787},
+		{A: 3.16e-09, B: 4.63035287047, C: 745.277682393},
+		{A: 3.11e-09, B: 3.51827727424, C: 2751.5475996916},
+		{A: 2.39e-09, B: 0.51133317457, C: 1041.2226829245},
+		{A: 2.38e-09, B: 5.4634727942, C: 4216.1870797544},
+		{A: 2.62e-09, B: 3.20254407166, C: 1251.3403846248},
+		{A: 2.38e-09, B: 1.02679111743, C: 1262.3860848887},
+		{A: 2.77e-09, B: 3.32996713394, C: 545.4719677737},
+		{A: 2.71e-09, B: 0.49229846069, C: 419.532827985},
+		{A: 3.03e-09, B: 3.77087511317, C: 285.3723810196},
+		{A: 2.34e-09, B: 3.64328189368, C: 407.4757357648},
+		{A: 2.7e-09, B: 3.93080966194, C: 313.4711083498},
+		{A: 3.02e-09, B: 3.15201610429, C: 915.235359026},
+		{A: 2.64e-09, B: 0.46127639727, C: 720.8986600048},
+		{A: 2.35e-09, B: 1.74238582338, C: 369.0820676961},
+		{A: 2.84e-09, B: 5.20345848078, C: 395.105621487},
+		{A: 2.95e-09, B: 5.03016292492, C: 594.6507036754},
+		{A: 2.9e-09, B: 1.94941528794, C: 907.3710524998},
+		{A: 2.29e-09, B: 0.11049722694, C: 3259.8979237838},
+		{A: 3.04e-09, B: 1.81516929363, C: 49.7212526229},
+		{A: 2.68e-09, B: 5.54944666204, C: 12352.8526045448},
+		{A: 2.48e-09, B: 4.39993603541, C: 385.5443939314},
+		{A: 2.34e-09, B: 1.63365119249, C: 3590.516887442},
+		{A: 3.17e-09, B: 4.74907646809, C: 420.005908737},
+		{A: 2.28e-09, B: 4.89094697746, C: 1181.4371008246},
+		{A: 2.58e-09, B: 2.41510535278, C: 550.1378342197},
+		{A: 2.36e-09, B: 4.10002766188, C: 6467.9257579616},
+		{A: 2.49e-09, B: 1.97767956861, C: 589.4947101349},
+		{A: 2.26e-09, B: 1.60308230318, C: 316.279995072},
+		{A: 2.86e-09, B: 6.10513234736, C: 484.7050148869},
+		{A: 2.99e-09, B: 3.71741328977, C: 1123.1178284926},
+		{A: 2.63e-09, B: 1.85502475341, C: 608.877797677},
+		{A: 2.2e-09, B: 0.94624116595, C: 316.5037442412},
+		{A: 2.92e-09, B: 3.12099513976, C: 47.6942631934},
+		{A: 2.17e-09, B: 4.93010394323, C: 281.1795953256},
+		{A: 2.95e-09, B: 3.18346450625, C: 1050.9963588012},
+		{A: 2.48e-09, B: 5.48523310249, C: 638.9340784675},
+		{A: 2.76e-09, B: 1.09325899753, C: 544.5087599272},
+		{A: 2.79e-09, B: 2.65446123759, C: 134.1122628556},
+		{A: 2.47e-09, B: 4.17284927352, C: 950.1388168163},
+		{A: 2.12e-09, B: 2.45582771491, C: 1164.7623262682},
+		{A: 2.66e-09, B: 2.30028827109, C: 314.9073969483},
+		{A: 2.15e-09, B: 3.55148746518, C: 1097.0942747017},
+		{A: 2.23e-09, B: 0.36862624591, C: 81.8951455681},
+		{A: 2.83e-09, B: 0.35013012349, C: 1269.4996318895},
+		{A: 2.63e-09, B: 0.58255768951, C: 386.9806825299},
+		{A: 2.08e-09, B: 4.61303945066, C: 668.2084619653},
+		{A: 2.22e-09, B: 4.51639912193, C: 304.1223291153},
+		{A: 2.74e-09, B: 0.63572336701, C: 679.2541622292},
+		{A: 2.15e-09, B: 0.74685271552, C: 1008.9793540101},
+		{A: 2.68e-09, B: 3.43326489941, C: 598.8434893694},
+		{A: 2.71e-09, B: 3.98364990268, C: 453.6855262499},
+		{A: 2.15e-09, B: 2.24139383226, C: 661.2379273164},
+		{A: 2.64e-09, B: 2.58516335032, C: 2527.2028039897},
+		{A: 2.05e-09, B: 1.94472028389, C: 650.1922270525},
+		{A: 2.58e-09, B: 1.50724315598, C: 1759.8337210689},
+		{A: 2.72e-09, B: 6.27135287672, C: 990.2294059144},
+		{A: 2.01e-09, B: 1.11891338895, C: 97.4155158163},
+		{A: 2.36e-09, B: 4.13547185065, C: 348.635198571},
+		{A: 2.01e-09, B: 2.67485193508, C: 1546.5346256309},
+		{A: 2.5e-09, B: 5.44391862722, C: 1254.5217783625},
+		{A: 2.03e-09, B: 5.48660442144, C: 557.0389328994},
+		{A: 2.5e-09, B: 0.32142312427, C: 25448.00585526019},
+		{A: 1.98e-09, B: 0.91019549387, C: 1310.3933701397},
+		{A: 2e-09, B: 0.90438804926, C: 47.061123747},
+		{A: 1.94e-09, B: 4.05730813129, C: 426.8588233069},
+		{A: 1.97e-09, B: 0.58292199999, C: 156.6767441354},
+		{A: 1.97e-09, B: 2.5938418852, C: 639.8491022042},
+		{A: 2.01e-09, B: 1.49980256114, C: 827.9235874865},
+		{A: 1.97e-09, B: 5.79126360809, C: 639.9454704238},
+		{A: 2.66e-09, B: 1.45514683392, C: 109.2431133729},
+		{A: 2.39e-09, B: 4.63640382863, C: 868.7167520002},
+		{A: 1.94e-09, B: 5.59582424111, C: 426.3375584451},
+		{A: 2.47e-09, B: 2.91348766248, C: 689.6185389369},
+		{A: 2.05e-09, B: 1.04616771894, C: 448.6895914038},
+		{A: 2.32e-09, B: 1.76503818816, C: 354.2642728635},
+		{A: 2.24e-09, B: 3.45391027762, C: 1190.0351205337},
+		{A: 2.21e-09, B: 6.27951545913, C: 1596.1864422846},
+		{A: 2.37e-09, B: 1.24961141369, C: 882.9438460018},
+		{A: 2.06e-09, B: 5.17010664247, C: 253.4591205053},
+		{A: 2.03e-09, B: 0.25518217825, C: 4002.8879843164},
+		{A: 2.45e-09, B: 3.94368773869, C: 769.8167634756},
+		{A: 2.55e-09, B: 6.1179075155, C: 763.4369296577},
+		{A: 1.94e-09, B: 2.83197251801, C: 263.7016716171},
+		{A: 2.37e-09, B: 2.1400737388, C: 2700.7151403858},
+		{A: 2.03e-09, B: 3.00078001542, C: 1385.174968707},
+		{A: 2.03e-09, B: 3.88000057282, C: 419.4364597654},
+		{A: 1.86e-09, B: 4.79530535895, C: 843.6351541964},
+		{A: 2.03e-09, B: 0.16707173895, C: 535.9107402181},
+		{A: 2.45e-09, B: 0.76762638475, C: 5643.1785636774},
+		{A: 1.83e-09, B: 2.20151176434, C: 35.1640902212},
+		{A: 2.42e-09, B: 3.41613986919, C: 864.2420820159},
+		{A: 2.49e-09, B: 3.47840802421, C: 1045.8885493705},
+		{A: 1.99e-09, B: 4.70077509959, C: 1276.6131788903},
+		{A: 1.89e-09, B: 1.8424761039, C: 434.6749457233},
+		{A: 1.92e-09, B: 3.83148077309, C: 666.723989257},
+		{A: 2.01e-09, B: 1.34290804373, C: 1012.9115072732},
+		{A: 2.1e-09, B: 5.12097279511, C: 3494.5376602242},
+		{A: 1.92e-09, B: 1.36630036429, C: 904.4021070832},
+		{A: 1.95e-09, B: 1.62629576534, C: 364.3467652809},
+		{A: 1.79e-09, B: 1.6650299905, C: 244.791664827},
+		{A: 1.86e-09, B: 4.55663748319, C: 347.4113582936},
+		{A: 2.01e-09, B: 0.5021968074, C: 36.3879304986},
+		{A: 1.79e-09, B: 4.55153097409, C: 97.4636999261},
+		{A: 2e-09, B: 0.68398141887, C: 2015.6710861598},
+		{A: 1.95e-09, B: 2.20599546209, C: 66.1835788582},
+		{A: 1.86e-09, B: 4.48925201018, C: 611.4430983108},
+		{A: 1.86e-09, B: 3.13663606153, C: 433.663553767},
+		{A: 1.77e-09, B: 3.62811326217, C: 326.6868103951},
+		{A: 2.06e-09, B: 0.38552475035, C: 857.1285350151},
+		{A: 2.29e-09, B: 1.22330638216, C: 2906.900688823},
+		{A: 2.13e-09, B: 6.08436923028, C: 271.61836777},
+		{A: 1.74e-09, B: 6.18833529233, C: 3576.2897934404},
+		{A: 2.2e-09, B: 2.89866380776, C: 322.6116447801},
+		{A: 2.02e-09, B: 2.43755817264, C: 812.1425848074},
+		{A: 2.11e-09, B: 4.27999470527, C: 1127.2624300768},
+		{A: 1.88e-09, B: 0.97173474238, C: 1080.7225711916},
+		{A: 1.68e-09, B: 0.65008064023, C: 1493.093668066},
+		{A: 2.08e-09, B: 4.68942071563, C: 5429.8794682394},
+		{A: 1.82e-09, B: 0.76058628096, C: 504.561183181},
+		{A: 1.67e-09, B: 6.22608046965, C: 108.50940019},
+		{A: 1.76e-09, B: 2.08816807487, C: 670.916774951},
+		{A: 1.7e-09, B: 1.55680702386, C: 1670.8250285},
+		{A: 1.87e-09, B: 4.12687876136, C: 9985.7593567714},
+		{A: 1.66e-09, B: 1.5859635437, C: 1379.7059531089},
+		{A: 1.7e-09, B: 1.30946662341, C: 837.6972633632},
+		{A: 1.66e-09, B: 0.02413278965, C: 224.6054281328},
+		{A: 2.04e-09, B: 6.12072939658, C: 9360.089164459},
+		{A: 1.89e-09, B: 0.28191962964, C: 1175.8080265321},
+		{A: 1.84e-09, B: 2.8746109364, C: 398.1440028728},
+		{A: 1.72e-09, B: 5.41410693088, C: 2306.7901615509},
+		{A: 1.74e-09, B: 0.94052578814, C: 632.0329797878},
+		{A: 1.81e-09, B: 2.20017945285, C: 1049.0869894507},
+		{A: 1.76e-09, B: 1.0985763266, C: 531.978586955},
+		{A: 2.01e-09, B: 2.68411933635, C: 795.6802585721},
+		{A: 1.97e-09, B: 1.43444932583, C: 347.3631741838},
+		{A: 2e-09, B: 4.33954193534, C: 1364.7280995819},
+		{A: 1.66e-09, B: 2.8393687284, C: 3553.9115221378},
+		{A: 1.6e-09, B: 1.28778451384, C: 962.5089310941},
+		{A: 2.06e-09, B: 3.02518737424, C: 1141.1340634054},
+		{A: 2e-09, B: 5.54644533685, C: 308.3151148093},
+		{A: 1.58e-09, B: 4.7546243761, C: 1534.7381658416},
+		{A: 1.56e-09, B: 3.42359004405, C: 241.7532834412},
+		{A: 1.82e-09, B: 5.26192506263, C: 968.1380053866},
+		{A: 1.68e-09, B: 0.3705510874, C: 10007.0999977738},
+		{A: 1.74e-09, B: 4.61279044571, C: 223.3334037456},
+		{A: 1.58e-09, B: 4.23116519562, C: 821.703812363},
+		{A: 2.12e-09, B: 5.98406058023, C: 432.7485300303},
+		{A: 1.6e-09, B: 4.08093018212, C: 632.7355552034},
+		{A: 1.79e-09, B: 5.74376301842, C: 924.0458270562},
+		{A: 1.85e-09, B: 3.01715709315, C: 55.8715917772},
+		{A: 1.85e-09, B: 0.75718598244, C: 1286.9081196288},
+		{A: 1.7e-09, B: 3.46903106526, C: 1304.9243545416},
+		{A: 1.67e-09, B: 3.19767851189, C: 635.231419868},
+		{A: 1.53e-09, B: 3.8300620821, C: 318.679491517},
+		{A: 1.56e-09, B: 1.61558494091, C: 110.2545053292},
+		{A: 2.06e-09, B: 1.62702696825, C: 389.9496279465},
+		{A: 1.84e-09, B: 3.31730160238, C: 1578.0271950199},
+		{A: 1.84e-09, B: 6.19913208096, C: 731.6837278378},
+		{A: 1.58e-09, B: 5.58628906712, C: 42.3258213318},
+		{A: 1.59e-09, B: 2.00727485442, C: 702.1487119091},
+		{A: 1.55e-09, B: 0.02889842368, C: 1357.6145525811},
+		{A: 1.51e-09, B: 5.80616069064, C: 680.0573113813},
+		{A: 1.57e-09, B: 5.67235483479, C: 77837.11123384659},
+		{A: 1.56e-09, B: 2.78001360754, C: 1567.7322542814},
+		{A: 2.03e-09, B: 5.91820261629, C: 971.1069508032},
+		{A: 1.56e-09, B: 5.68482948401, C: 649.4585138696},
+		{A: 1.86e-09, B: 5.72093247348, C: 664.2763087022},
+		{A: 1.84e-09, B: 4.05878767739, C: 976.7360250957},
+		{A: 1.59e-09, B: 3.10978439502, C: 230.7075831773},
+		{A: 1.54e-09, B: 4.54479813612, C: 1239.5439248355},
+		{A: 1.77e-09, B: 4.68148789757, C: 2921.1277828246},
+		{A: 1.58e-09, B: 4.30854435851, C: 633.7469471597},
+		{A: 1.95e-09, B: 5.33376523453, C: 1130.2313754934},
+		{A: 1.88e-09, B: 2.04023570497, C: 1127.0499817557},
+		{A: 1.74e-09, B: 2.88817750136, C: 25668.418497699},
+		{A: 1.61e-09, B: 2.9630000889, C: 152.7445908723},
+		{A: 1.7e-09, B: 1.7077956077, C: 493.303034596},
+		{A: 1.56e-09, B: 3.05331862578, C: 913.7508863177},
+		{A: 1.69e-09, B: 3.18325334507, C: 757.8078553652},
+		{A: 1.45e-09, B: 0.8967219869, C: 632.831923423},
+		{A: 1.49e-09, B: 2.69519361988, C: 203.2647871304},
+		{A: 1.67e-09, B: 2.96547549589, C: 1201.831580323},
+		{A: 1.87e-09, B: 3.17592113403, C: 842.9014410135},
+		{A: 1.73e-09, B: 1.9152883635, C: 3487.4241132234},
+		{A: 1.54e-09, B: 0.37169915171, C: 285.1117485887},
+		{A: 1.98e-09, B: 3.14620903981, C: 640.8604941605},
+		{A: 1.43e-09, B: 3.42760427364, C: 520.129737539},
+		{A: 1.57e-09, B: 2.34913118792, C: 5959.570433334},
+		{A: 1.78e-09, B: 5.34566184657, C: 272.5815756165},
+		{A: 1.71e-09, B: 3.34185265551, C: 3067.9394693482},
+		{A: 1.71e-09, B: 4.87848878292, C: 354.5249052944},
+		{A: 1.89e-09, B: 0.07069084691, C: 1585.8915015461},
+		{A: 1.37e-09, B: 1.28925665885, C: 214.1022445901},
+		{A: 1.37e-09, B: 3.494384829, C: 212.4959462859},
+		{A: 1.74e-09, B: 3.07450555579, C: 64.9597385808},
+		{A: 1.81e-09, B: 0.78652249647, C: 657.1627617014},
+		{A: 1.58e-09, B: 2.93809799392, C: 211.6545640353},
+		{A: 1.33e-09, B: 5.70724173596, C: 469.7271613599},
+		{A: 1.4e-09, B: 0.35530248121, C: 219.5188705615},
+		{A: 1.32e-09, B: 1.60614108449, C: 1372.5924061081},
+		{A: 1.64e-09, B: 1.68236348359, C: 707.5653378805},
+		{A: 1.28e-09, B: 1.49742950959, C: 45.2465826386},
+		{A: 1.32e-09, B: 4.98075995751, C: 238.5718897035},
+		{A: 1.4e-09, B: 3.62845435388, C: 423.8898778903},
+		{A: 1.34e-09, B: 1.41837795083, C: 3906.9087570986},
+		{A: 1.27e-09, B: 1.48966646069, C: 856.3777754897},
+		{A: 1.58e-09, B: 2.57990141197, C: 369.9758395734},
+		{A: 1.32e-09, B: 2.39662455993, C: 184.7272873558},
+		{A: 1.4e-09, B: 4.36785639279, C: 207.0793203145},
+		{A: 1.57e-09, B: 4.56655940808, C: 251.1714986449},
+		{A: 1.25e-09, B: 4.60433583965, C: 6076.8903015542},
+		{A: 1.21e-09, B: 1.59513269211, C: 184.8449074348},
+		{A: 1.45e-09, B: 5.85121885906, C: 221.8971151471},
+		{A: 1.37e-09, B: 0.76499603885, C: 476.1069951778},
+		{A: 1.39e-09, B: 6.02467582226, C: 429.3065038617},
+		{A: 1.17e-09, B: 0.23611722307, C: 426.7582495704},
+		{A: 1.17e-09, B: 3.13382984215, C: 426.4381321816},
+		{A: 1.16e-09, B: 4.3480144872, C: 418.9633790134},
+		{A: 1.35e-09, B: 2.69789181467, C: 455.1699989582},
+		{A: 1.34e-09, B: 1.19058772772, C: 502.8642621516},
+		{A: 1.23e-09, B: 3.97560160218, C: 499.895316735},
+		{A: 1.1e-09, B: 2.37032413718, C: 439.9315130003},
+		{A: 1.09e-09, B: 6.20303291896, C: 220.3007678542},
+		{A: 1.09e-09, B: 1.38979633175, C: 325.9530972122},
+		{A: 1.15e-09, B: 0.05845336809, C: 631.8205314667},
+		{A: 1.39e-09, B: 2.21086387259, C: 9573.388259897},
+		{A: 1.42e-09, B: 6.14666228712, C: 3340.6124266998},
+		{A: 1.22e-09, B: 4.77182119272, C: 604.4725636619},
+		{A: 1.19e-09, B: 3.03461367061, C: 528.2064923863},
+		{A: 1.27e-09, B: 2.97263950543, C: 498.9321088885},
+		{A: 1.11e-09, B: 5.01192320232, C: 220.2001941177},
+		{A: 1.26e-09, B: 3.95743516129, C: 566.600160455},
+		{A: 9.8e-10, B: 2.36210526249, C: 634.2682120215},
+		{A: 1.08e-09, B: 2.46872857126, C: 83.3796182764},
+		{A: 1.01e-09, B: 4.88259474274, C: 425.3261664888},
+		{A: 1.27e-09, B: 4.89006771254, C: 162.0933701068},
+		{A: 9.9e-10, B: 0.90073463816, C: 586.3133163972},
+		{A: 9.9e-10, B: 0.16310526294, C: 394.3548619616},
+		{A: 1.15e-09, B: 0.49160291262, C: 517.1607921224},
+		{A: 1.01e-09, B: 3.86043866859, C: 198.1087935899},
+		{A: 1.05e-09, B: 3.48222097813, C: 5863.5912061162},
+		{A: 1.04e-09, B: 4.61148354671, C: 220.5245170234},
+		{A: 1.01e-09, B: 4.77041950285, C: 427.8702152632},
+		{A: 9.8e-10, B: 2.90784721214, C: 199.9657733137},
+		{A: 1.27e-09, B: 0.14136936897, C: 2332.0629558164},
+		{A: 9.1e-10, B: 6.22628300117, C: 211.2933578679},
+		{A: 1e-09, B: 5.14847283436, C: 226.7924762567},
+		{A: 9.1e-10, B: 4.84054379386, C: 215.3048330081},
+		{A: 9.9e-10, B: 4.375834924, C: 640.4185511758},
+		{A: 9.3e-10, B: 5.30395179617, C: 222.7002642992},
+		{A: 8.6e-10, B: 4.57481701854, C: 636.9765250072},
+		{A: 1.11e-09, B: 0.61102669309, C: 1089.129394439},
+		{A: 8.6e-10, B: 4.50969293872, C: 625.8826406335},
+		{A: 8.8e-10, B: 0.36828594935, C: 444.1242986943},
+		{A: 9.3e-10, B: 5.76287499885, C: 203.8979265768},
+		{A: 8.2e-10, B: 2.85558603378, C: 318.8395502114},
+		{A: 8.2e-10, B: 5.19618475111, C: 1467.8208738005},
+		{A: 8.6e-10, B: 0.97633784601, C: 200.0352092829},
+		{A: 8.2e-10, B: 4.78557953017, C: 195.7729876197},
+		{A: 1.06e-09, B: 2.29520624233, C: 799.6124118352},
+		{A: 8.1e-10, B: 3.57727166766, C: 205.9731001161},
+		{A: 7.8e-10, B: 5.5034351258, C: 262.8078997398},
+		{A: 8.7e-10, B: 0.76830756075, C: 201.9927627432},
+		{A: 1.02e-09, B: 2.11516755277, C: 206.9363079626},
+		{A: 8.1e-10, B: 5.29639775054, C: 111.1695290659},
+		{A: 7.5e-10, B: 2.77117107886, C: 255.8373650909},
+		{A: 7.4e-10, B: 5.81587984729, C: 316.4400537664},
+		{A: 7.6e-10, B: 1.78743197928, C: 171.6545976624},
+		{A: 9.4e-10, B: 4.99996904753, C: 378.6432952517},
+		{A: 8.9e-10, B: 5.85818860151, C: 807.9497991134},
+		{A: 7.2e-10, B: 0.99858616883, C: 280.2163874791},
+		{A: 9.9e-10, B: 0.15018241445, C: 186.2117600641},
+		{A: 7.2e-10, B: 5.15715918322, C: 110.1581371096},
+	},
+	// L1, 642 terms
+	{
+		{A: 213.54295595986, B: 0, C: 0},
+		{A: 0.01296855005, B: 1.82820544701, C: 213.299095438},
+		{A: 0.00564347566, B: 2.88500136429, C: 7.1135470008},
+		{A: 0.0009832303, B: 1.08070061328, C: 426.598190876},
+		{A: 0.0010767877, B: 2.27769911872, C: 206.1855484372},
+		{A: 0.00040254586, B: 2.0412825709, C: 220.4126424388},
+		{A: 0.00019941734, B: 1.27954662736, C: 103.0927742186},
+		{A: 0.00010511706, B: 2.748803928, C: 14.2270940016},
+		{A: 6.939233e-05, B: 0.40493079985, C: 639.897286314},
+		{A: 4.803325e-05, B: 2.44194097666, C: 419.4846438752},
+		{A: 4.056325e-05, B: 2.92166618776, C: 110.2063212194},
+		{A: 3.76863e-05, B: 3.6496563146, C: 3.9321532631},
+		{A: 3.384684e-05, B: 2.41694251653, C: 3.1813937377},
+		{A: 3.3022e-05, B: 1.26256486715, C: 433.7117378768},
+		{A: 3.071382e-05, B: 2.3273931775, C: 199.0720014364},
+		{A: 1.953036e-05, B: 3.563946833, C: 11.0457002639},
+		{A: 1.249348e-05, B: 2.62803737519, C: 95.9792272178},
+		{A: 9.21683e-06, B: 1.9608983425, C: 227.5261894396},
+		{A: 7.05587e-06, B: 4.4168924933, C: 529.6909650946},
+		{A: 6.49654e-06, B: 6.17418093659, C: 202.2533951741},
+		{A: 6.27603e-06, B: 6.11088227167, C: 309.2783226558},
+		{A: 4.86843e-06, B: 6.03998200305, C: 853.196381752},
+		{A: 4.68377e-06, B: 4.61707843907, C: 63.7358983034},
+		{A: 4.78501e-06, B: 4.98776987984, C: 522.5774180938},
+		{A: 4.1701e-06, B: 2.11708169277, C: 323.5054166574},
+		{A: 4.0763e-06, B: 1.29949556676, C: 209.3669421749},
+		{A: 3.43826e-06, B: 3.95854178574, C: 412.3710968744},
+		{A: 3.39724e-06, B: 3.63396398752, C: 316.3918696566},
+		{A: 3.35936e-06, B: 3.77173072712, C: 735.8765135318},
+		{A: 3.31933e-06, B: 2.86077699882, C: 210.1177017003},
+		{A: 3.52489e-06, B: 2.31707079463, C: 632.7837393132},
+		{A: 2.89429e-06, B: 2.73263080235, C: 117.3198682202},
+		{A: 2.65801e-06, B: 0.54344631312, C: 647.0108333148},
+		{A: 2.30493e-06, B: 1.64428879621, C: 216.4804891757},
+		{A: 2.80911e-06, B: 5.74398845416, C: 2.4476805548},
+		{A: 1.91667e-06, B: 2.96512946582, C: 224.3447957019},
+		{A: 1.72891e-06, B: 4.07695221044, C: 846.0828347512},
+		{A: 1.67131e-06, B: 2.59745202658, C: 21.3406410024},
+		{A: 1.36328e-06, B: 2.28580246629, C: 10.2949407385},
+		{A: 1.31364e-06, B: 3.44108355646, C: 742.9900605326},
+		{A: 1.27838e-06, B: 4.09533471247, C: 217.2312487011},
+		{A: 1.08862e-06, B: 6.16141072262, C: 415.5524906121},
+		{A: 9.3909e-07, B: 3.48397279899, C: 1052.2683831884},
+		{A: 9.2482e-07, B: 3.94755499926, C: 88.865680217},
+		{A: 9.7584e-07, B: 4.72845436677, C: 838.9692877504},
+		{A: 8.66e-07, B: 1.21951325061, C: 440.8252848776},
+		{A: 8.3463e-07, B: 3.11269504725, C: 625.6701923124},
+		{A: 7.7588e-07, B: 6.24408938835, C: 302.164775655},
+		{A: 6.1557e-07, B: 1.82789612597, C: 195.1398481733},
+		{A: 6.19e-07, B: 4.29344363385, C: 127.4717966068},
+		{A: 6.7106e-07, B: 0.28961738595, C: 4.665866446},
+		{A: 5.6919e-07, B: 5.01889578112, C: 137.0330241624},
+		{A: 5.416e-07, B: 5.12628572382, C: 490.3340891794},
+		{A: 5.4585e-07, B: 0.28356341456, C: 74.7815985673},
+		{A: 5.1425e-07, B: 1.45766406064, C: 536.8045120954},
+		{A: 6.5843e-07, B: 5.64757042732, C: 9.5612275556},
+		{A: 5.778e-07, B: 2.47630552035, C: 191.9584544356},
+		{A: 4.4444e-07, B: 2.70873627665, C: 5.4166259714},
+		{A: 4.6799e-07, B: 1.1772121105, C: 149.5631971346},
+		{A: 4.038e-07, B: 3.88870105683, C: 728.762966531},
+		{A: 3.7768e-07, B: 2.53379013859, C: 12.5301729722},
+		{A: 4.6649e-07, B: 5.14818326902, C: 515.463871093},
+		{A: 4.5891e-07, B: 2.23198878761, C: 956.2891559706},
+		{A: 4.04e-07, B: 0.4128152044, C: 269.9214467406},
+		{A: 3.7191e-07, B: 3.78239026411, C: 2.9207613068},
+		{A: 3.3778e-07, B: 3.21070688046, C: 1368.660252845},
+		{A: 3.7969e-07, B: 0.6466596718, C: 422.6660376129},
+		{A: 3.2857e-07, B: 0.30063884563, C: 351.8165923087},
+		{A: 3.305e-07, B: 5.43038091186, C: 1066.49547719},
+		{A: 3.0276e-07, B: 2.84067004928, C: 203.0041546995},
+		{A: 3.5116e-07, B: 6.08421794089, C: 5.6290742925},
+		{A: 2.9667e-07, B: 3.39052569135, C: 1059.3819301892},
+		{A: 3.3217e-07, B: 4.64063092111, C: 277.0349937414},
+		{A: 3.1876e-07, B: 4.3862292377, C: 1155.361157407},
+		{A: 2.8913e-07, B: 2.02614760507, C: 330.6189636582},
+		{A: 2.8264e-07, B: 2.74178953996, C: 265.9892934775},
+		{A: 3.0089e-07, B: 6.18684614308, C: 284.1485407422},
+		{A: 3.1329e-07, B: 2.43455855525, C: 52.6901980395},
+		{A: 2.6493e-07, B: 4.51214170121, C: 340.7708920448},
+		{A: 2.1983e-07, B: 5.14437352579, C: 4.192785694},
+		{A: 2.223e-07, B: 1.96481952451, C: 203.7378678824},
+		{A: 2.0824e-07, B: 6.16048095923, C: 860.3099287528},
+		{A: 2.169e-07, B: 2.67578768862, C: 942.062061969},
+		{A: 2.2552e-07, B: 5.88579123, C: 210.8514148832},
+		{A: 1.9807e-07, B: 2.31345263487, C: 437.6438911399},
+		{A: 1.9447e-07, B: 4.76573277668, C: 70.8494453042},
+		{A: 1.931e-07, B: 4.10209060369, C: 18.1592472647},
+		{A: 2.2662e-07, B: 4.13732273379, C: 191.2076949102},
+		{A: 1.8209e-07, B: 0.90310796389, C: 429.7795846137},
+		{A: 1.7667e-07, B: 1.84954766042, C: 234.6397364404},
+		{A: 1.7547e-07, B: 2.44735118493, C: 423.4167971383},
+		{A: 1.5428e-07, B: 4.23790088205, C: 1162.4747044078},
+		{A: 1.4608e-07, B: 3.59713247857, C: 1045.1548361876},
+		{A: 1.4111e-07, B: 2.94262468353, C: 1685.0521225016},
+		{A: 1.6328e-07, B: 4.05665272725, C: 949.1756089698},
+		{A: 1.3348e-07, B: 6.2450959224, C: 38.1330356378},
+		{A: 1.5918e-07, B: 1.06434204938, C: 56.6223513026},
+		{A: 1.4059e-07, B: 1.43503954068, C: 408.4389436113},
+		{A: 1.3093e-07, B: 5.75815864257, C: 138.5174968707},
+		{A: 1.5772e-07, B: 5.59350835225, C: 6.1503391543},
+		{A: 1.4962e-07, B: 5.77192239389, C: 22.0914005278},
+		{A: 1.6024e-07, B: 1.93900586533, C: 1272.6810256272},
+		{A: 1.6751e-07, B: 5.96673627422, C: 628.8515860501},
+		{A: 1.2843e-07, B: 4.24658666814, C: 405.2575498736},
+		{A: 1.3628e-07, B: 4.09892958087, C: 1471.7530270636},
+		{A: 1.5067e-07, B: 0.74142807591, C: 200.7689224658},
+		{A: 1.0961e-07, B: 1.55022573283, C: 223.5940361765},
+		{A: 1.1695e-07, B: 1.81237511034, C: 124.433415221},
+		{A: 1.0346e-07, B: 3.46814088412, C: 1375.7737998458},
+		{A: 1.2056e-07, B: 1.85655834555, C: 131.4039498699},
+		{A: 1.0123e-07, B: 2.38221133049, C: 107.0249274817},
+		{A: 9.855e-08, B: 3.95166998848, C: 430.5303441391},
+		{A: 9.803e-08, B: 2.55389483994, C: 99.9113804809},
+		{A: 1.0614e-07, B: 5.36692189034, C: 215.7467759928},
+		{A: 1.208e-07, B: 4.84549317054, C: 831.8557407496},
+		{A: 1.021e-07, B: 6.0769296137, C: 32.2433289144},
+		{A: 9.245e-08, B: 3.6541746727, C: 142.4496501338},
+		{A: 8.984e-08, B: 1.23808405498, C: 106.2741679563},
+		{A: 9.336e-08, B: 5.81062768434, C: 7.1617311106},
+		{A: 9.717e-08, B: 1.38703872827, C: 145.6310438715},
+		{A: 8.394e-08, B: 4.42341211111, C: 703.6331846174},
+		{A: 8.37e-08, B: 5.64015188458, C: 62.2514255951},
+		{A: 8.244e-08, B: 2.42225929772, C: 1258.4539316256},
+		{A: 7.784e-08, B: 0.52562994711, C: 654.1243803156},
+		{A: 7.626e-08, B: 3.75258725596, C: 312.1990839626},
+		{A: 7.222e-08, B: 0.28429555677, C: 0.7507595254},
+		{A: 8.236e-08, B: 6.22250515902, C: 14.977853527},
+		{A: 7.054e-08, B: 0.5317781074, C: 388.4651552382},
+		{A: 6.567e-08, B: 3.48657341701, C: 35.4247226521},
+		{A: 9.011e-08, B: 4.9491962691, C: 208.633228992},
+		{A: 8.98e-08, B: 0.08138173719, C: 288.0806940053},
+		{A: 6.421e-08, B: 3.32905264657, C: 1361.5467058442},
+		{A: 6.489e-08, B: 2.89389587598, C: 114.1384744825},
+		{A: 6.244e-08, B: 0.54973852782, C: 65.2203710117},
+		{A: 6.154e-08, B: 2.67885860584, C: 2001.4439921582},
+		{A: 6.742e-08, B: 0.23586769279, C: 8.0767548473},
+		{A: 7.297e-08, B: 4.85321224483, C: 222.8603229936},
+		{A: 6.302e-08, B: 3.80651124694, C: 1788.1448967202},
+		{A: 5.824e-08, B: 4.39327457448, C: 81.7521332162},
+		{A: 6.102e-08, B: 0.88585782895, C: 92.0470739547},
+		{A: 6.914e-08, B: 2.04631426723, C: 99.1606209555},
+		{A: 5.363e-08, B: 5.47995103139, C: 563.6312150384},
+		{A: 5.172e-08, B: 2.11968421583, C: 214.2623032845},
+		{A: 5.117e-08, B: 5.76987684107, C: 565.1156877467},
+		{A: 6.197e-08, B: 1.625536888, C: 1589.0728952838},
+		{A: 4.97e-08, B: 0.41949366126, C: 76.2660712756},
+		{A: 6.64e-08, B: 5.82582210639, C: 483.2205421786},
+		{A: 5.277e-08, B: 4.57975789757, C: 134.5853436076},
+		{A: 4.974e-08, B: 4.20243895902, C: 404.5067903482},
+		{A: 5.15e-08, B: 4.67582673243, C: 212.3358875915},
+		{A: 4.764e-08, B: 4.59303997414, C: 554.0699874828},
+		{A: 4.573e-08, B: 3.24875415786, C: 231.4583427027},
+		{A: 4.811e-08, B: 0.46206327592, C: 362.8622925726},
+		{A: 5.148e-08, B: 3.33570646174, C: 1.4844727083},
+		{A: 4.654e-08, B: 5.80233066659, C: 217.964961884},
+		{A: 4.509e-08, B: 5.37581684215, C: 497.4476361802},
+		{A: 4.443e-08, B: 0.11349392292, C: 295.0512286542},
+		{A: 4.943e-08, B: 3.78020789259, C: 1265.5674786264},
+		{A: 4.211e-08, B: 4.8830602196, C: 98.8999885246},
+		{A: 4.252e-08, B: 5.00120115113, C: 213.3472795478},
+		{A: 4.774e-08, B: 4.53259894142, C: 1148.2476104062},
+		{A: 3.911e-08, B: 0.58582192963, C: 750.1036075334},
+		{A: 5.069e-08, B: 2.20305668335, C: 207.8824694666},
+		{A: 3.553e-08, B: 0.35374030841, C: 333.657345044},
+		{A: 3.771e-08, B: 0.98542435766, C: 24.3790223882},
+		{A: 3.458e-08, B: 1.84990273999, C: 225.8292684102},
+		{A: 3.401e-08, B: 5.31342401626, C: 347.8844390456},
+		{A: 3.347e-08, B: 0.21414641376, C: 635.9651330509},
+		{A: 3.637e-08, B: 1.61315058382, C: 245.5424243524},
+		{A: 3.416e-08, B: 2.19551489078, C: 1574.8458012822},
+		{A: 3.655e-08, B: 0.8054424569, C: 343.2185725996},
+		{A: 4.26e-08, B: 1.80258750109, C: 213.2509113282},
+		{A: 3.11e-08, B: 3.03815175282, C: 1677.9385755008},
+		{A: 3.052e-08, B: 1.33858964447, C: 543.9180590962},
+		{A: 3.694e-08, B: 0.81606028298, C: 344.7030453079},
+		{A: 3.016e-08, B: 3.36219319026, C: 7.8643065262},
+		{A: 2.937e-08, B: 4.86927342776, C: 144.1465711632},
+		{A: 2.768e-08, B: 2.42707131609, C: 2317.8358618148},
+		{A: 3.059e-08, B: 4.30820099442, C: 6062.6632075526},
+		{A: 3.65e-08, B: 5.12802531219, C: 218.9281697305},
+		{A: 2.963e-08, B: 3.53480751374, C: 2104.5367663768},
+		{A: 3.23e-08, B: 2.88057019783, C: 216.2198567448},
+		{A: 2.984e-08, B: 2.52971310583, C: 1692.1656695024},
+		{A: 2.897e-08, B: 5.7325648224, C: 9992.8729037722},
+		{A: 2.591e-08, B: 3.79880285744, C: 17.2654753874},
+		{A: 3.495e-08, B: 5.29902525443, C: 350.3321196004},
+		{A: 2.859e-08, B: 3.72804950659, C: 6076.8903015542},
+		{A: 2.775e-08, B: 0.23549396237, C: 357.4456666012},
+		{A: 2.976e-08, B: 2.48769315964, C: 46.470422916},
+		{A: 2.487e-08, B: 4.3786807853, C: 217.491881132},
+		{A: 2.711e-08, B: 5.1537684015, C: 10007.0999977738},
+		{A: 3.127e-08, B: 1.92343235583, C: 17.4084877393},
+		{A: 3.181e-08, B: 1.72419900322, C: 1169.5882514086},
+		{A: 2.348e-08, B: 0.77373103004, C: 414.0680179038},
+		{A: 2.606e-08, B: 3.4283691344, C: 31.019488637},
+		{A: 2.556e-08, B: 0.91735028377, C: 479.2883889155},
+		{A: 2.399e-08, B: 4.82440545738, C: 1279.794572628},
+		{A: 2.245e-08, B: 3.76323995584, C: 425.1137181677},
+		{A: 3.02e-08, B: 0.25310250109, C: 120.358249606},
+		{A: 2.503e-08, B: 2.10679832121, C: 168.0525127994},
+		{A: 2.564e-08, B: 1.63158205055, C: 182.279606801},
+		{A: 2.221e-08, B: 3.15472373256, C: 212.7778305762},
+		{A: 2.357e-08, B: 2.33145013714, C: 218.7157214094},
+		{A: 2.51e-08, B: 4.51903989011, C: 198.321241911},
+		{A: 2.715e-08, B: 5.76330259543, C: 618.5566453116},
+		{A: 2.204e-08, B: 3.35952557362, C: 160.6088973985},
+		{A: 2.648e-08, B: 0.71962005233, C: 85.8272988312},
+		{A: 2.029e-08, B: 5.28642331696, C: 125.9873238985},
+		{A: 2.497e-08, B: 1.36671447252, C: 1905.4647649404},
+		{A: 2.017e-08, B: 1.11498225426, C: 447.9388318784},
+		{A: 2.052e-08, B: 1.27587874735, C: 14.0146456805},
+		{A: 2.254e-08, B: 3.2244767419, C: 273.1028404783},
+		{A: 2.014e-08, B: 0.39787014152, C: 358.9301393095},
+		{A: 1.981e-08, B: 2.33696859021, C: 28.4541880032},
+		{A: 2.197e-08, B: 5.93386789705, C: 13.3333221243},
+		{A: 2.237e-08, B: 3.64433751164, C: 213.8203602998},
+		{A: 1.93e-08, B: 1.8567174034, C: 1.2720243872},
+		{A: 2.037e-08, B: 5.05300562628, C: 424.1505103212},
+		{A: 1.994e-08, B: 1.35690802366, C: 20.6069278195},
+		{A: 1.911e-08, B: 3.44106886717, C: 69.1525242748},
+		{A: 1.925e-08, B: 3.75243031545, C: 28.3111756513},
+		{A: 2.297e-08, B: 4.24557050896, C: 1464.6394800628},
+		{A: 2.117e-08, B: 2.25897766314, C: 116.4260963429},
+		{A: 1.847e-08, B: 5.40631472802, C: 31.492569389},
+		{A: 1.841e-08, B: 1.56916484272, C: 650.9429865779},
+		{A: 1.884e-08, B: 6.27233535258, C: 25.1297819136},
+		{A: 1.96e-08, B: 4.8948401484, C: 275.5505210331},
+		{A: 2.016e-08, B: 5.45791785675, C: 842.1506814881},
+		{A: 2.282e-08, B: 4.9627694744, C: 258.8757464767},
+		{A: 1.709e-08, B: 3.99098237135, C: 416.3032501375},
+		{A: 2.176e-08, B: 0.00746756006, C: 0.8937718773},
+		{A: 1.634e-08, B: 5.30978165487, C: 251.4321310758},
+		{A: 1.687e-08, B: 0.41586020065, C: 54.1746707478},
+		{A: 1.91e-08, B: 2.5982575579, C: 329.7251917809},
+		{A: 2.113e-08, B: 2.56582292726, C: 59.8037450403},
+		{A: 1.921e-08, B: 2.42279051938, C: 113.3877149571},
+		{A: 1.658e-08, B: 5.4732365154, C: 1073.6090241908},
+		{A: 1.59e-08, B: 2.7754529735, C: 1994.3304451574},
+		{A: 1.936e-08, B: 3.47558926847, C: 1581.959348283},
+		{A: 1.649e-08, B: 1.82779010589, C: 128.9562693151},
+		{A: 1.598e-08, B: 1.718064653, C: 129.9194771616},
+		{A: 1.967e-08, B: 1.25160413795, C: 621.7380390493},
+		{A: 1.702e-08, B: 1.910761028, C: 278.5194664497},
+		{A: 1.569e-08, B: 0.16491194947, C: 643.0786800517},
+		{A: 1.989e-08, B: 5.28799230992, C: 508.3503240922},
+		{A: 1.52e-08, B: 0.56950979689, C: 320.3240229197},
+		{A: 1.501e-08, B: 1.99815894193, C: 1891.2376709388},
+		{A: 1.532e-08, B: 3.27362317849, C: 2420.9286360334},
+		{A: 1.701e-08, B: 2.72041261115, C: 767.3690829208},
+		{A: 1.561e-08, B: 6.09424459628, C: 280.9671470045},
+		{A: 1.331e-08, B: 4.2094444379, C: 546.956440482},
+		{A: 1.381e-08, B: 2.0676810083, C: 192.6921676185},
+		{A: 1.368e-08, B: 6.28049502257, C: 1795.258443721},
+		{A: 1.519e-08, B: 2.20299556153, C: 2008.557539159},
+		{A: 1.356e-08, B: 4.01521042413, C: 721.6494195302},
+		{A: 1.296e-08, B: 4.84815978742, C: 45.5766510387},
+		{A: 1.267e-08, B: 5.28146654999, C: 173.9422195228},
+		{A: 1.402e-08, B: 6.1295155155, C: 39.3568759152},
+		{A: 1.252e-08, B: 2.19169926554, C: 2634.2277314714},
+		{A: 1.466e-08, B: 4.16354845643, C: 26.826702943},
+		{A: 1.285e-08, B: 3.76170874847, C: 2.2876218604},
+		{A: 1.5e-08, B: 5.41022492529, C: 214.0498549634},
+		{A: 1.396e-08, B: 4.78595583428, C: 219.4494345923},
+		{A: 1.43e-08, B: 0.70934745161, C: 254.9435932136},
+		{A: 1.195e-08, B: 3.71281085322, C: 264.5048207692},
+		{A: 1.181e-08, B: 0.42635230882, C: 41.6444977756},
+		{A: 1.19e-08, B: 2.02079286787, C: 1485.9801210652},
+		{A: 1.16e-08, B: 5.23649231796, C: 181.0557665236},
+		{A: 1.535e-08, B: 3.62746990294, C: 561.1835344836},
+		{A: 1.12e-08, B: 1.0912792213, C: 6.592282139},
+		{A: 1.1e-08, B: 0.27844612141, C: 184.0941479094},
+		{A: 1.227e-08, B: 1.3996968127, C: 209.106309744},
+		{A: 1.353e-08, B: 6.12903657666, C: 207.6700211455},
+		{A: 1.124e-08, B: 6.05105541765, C: 291.262087743},
+		{A: 1.194e-08, B: 4.79565407023, C: 1478.8665740644},
+		{A: 1.082e-08, B: 4.73602931755, C: 78.7137518304},
+		{A: 1.202e-08, B: 3.47301104146, C: 51.2057253312},
+		{A: 1.298e-08, B: 2.34761557822, C: 210.3783341312},
+		{A: 1.166e-08, B: 4.20037524355, C: 417.0369633204},
+		{A: 1.228e-08, B: 3.94985981275, C: 1781.0313497194},
+		{A: 1.401e-08, B: 2.41318931513, C: 636.7158925763},
+		{A: 1.009e-08, B: 6.17414889934, C: 2111.6503133776},
+		{A: 1.084e-08, B: 3.68958647346, C: 274.0660483248},
+		{A: 1.068e-08, B: 0.80258823981, C: 436.8931316145},
+		{A: 1.007e-08, B: 3.4279250886, C: 629.6023455755},
+		{A: 9.98e-09, B: 5.57130056835, C: 205.2223405907},
+		{A: 1.058e-08, B: 1.05742945779, C: 237.6781178262},
+		{A: 1.02e-08, B: 3.336672903, C: 166.828672522},
+		{A: 9.65e-09, B: 6.08359503243, C: 601.7642506762},
+		{A: 1.005e-08, B: 3.56310748091, C: 643.8294395771},
+		{A: 9.87e-09, B: 0.97129012811, C: 305.3461693927},
+		{A: 9.27e-09, B: 3.87717400791, C: 135.336103133},
+		{A: 1.129e-08, B: 5.94840103961, C: 196.6243208816},
+		{A: 1.118e-08, B: 5.25415059584, C: 189.7232222019},
+		{A: 1.2e-08, B: 1.16671933467, C: 2221.856634597},
+		{A: 9.09e-09, B: 2.14001565047, C: 617.8058857862},
+		{A: 8.99e-09, B: 2.31811625712, C: 312.4597163935},
+		{A: 1.081e-08, B: 0.91006048421, C: 313.2104759189},
+		{A: 8.91e-09, B: 3.74923531791, C: 916.9322800554},
+		{A: 8.86e-09, B: 4.76066858907, C: 776.9303104764},
+		{A: 9.12e-09, B: 0.99592540858, C: 491.8185618877},
+		{A: 8.8e-09, B: 3.67349449376, C: 25.2727942655},
+		{A: 1.203e-08, B: 1.3974926741, C: 337.732510659},
+		{A: 8.67e-09, B: 0.11684071625, C: 267.4737661858},
+		{A: 8.79e-09, B: 6.12222682852, C: 867.4234757536},
+		{A: 1.08e-08, B: 0.15038819285, C: 175.1660598002},
+		{A: 9.88e-09, B: 3.12456192471, C: 214.7835681463},
+		{A: 8.89e-09, B: 4.70508769146, C: 148.0787244263},
+		{A: 8.27e-09, B: 6.08977582217, C: 488.8496164711},
+		{A: 8.89e-09, B: 5.05124166027, C: 220.4608265486},
+		{A: 8.28e-09, B: 6.27262544155, C: 1382.8873468466},
+		{A: 1.04e-08, B: 5.76735098196, C: 501.3797894433},
+		{A: 1.103e-08, B: 0.4870647723, C: 692.5874843535},
+		{A: 8.1e-09, B: 2.5036238508, C: 2310.722314814},
+		{A: 8.5e-09, B: 4.55410385197, C: 77.962992305},
+		{A: 1.108e-08, B: 5.31792012163, C: 235.3904959658},
+		{A: 7.9e-09, B: 0.89213206336, C: 342.2553647531},
+		{A: 7.75e-09, B: 2.85873930879, C: 211.8146227297},
+		{A: 8.42e-09, B: 2.99884993009, C: 2737.32050569},
+		{A: 7.84e-09, B: 0.0574845924, C: 543.0242872189},
+		{A: 7.54e-09, B: 5.18317747668, C: 244.318584075},
+		{A: 9.69e-09, B: 1.31760425414, C: 486.4019359163},
+		{A: 9.43e-09, B: 5.48641674428, C: 339.2864193365},
+		{A: 7.59e-09, B: 6.25347177163, C: 151.0476698429},
+		{A: 7.1e-09, B: 2.4161996881, C: 247.2393453818},
+		{A: 7.94e-09, B: 2.59522645936, C: 1.6445314027},
+		{A: 8.57e-09, B: 1.99318788624, C: 248.7238180901},
+		{A: 7.17e-09, B: 4.56798357445, C: 121.2520214833},
+		{A: 6.71e-09, B: 2.50955477476, C: 444.7574381407},
+		{A: 6.83e-09, B: 5.51033310275, C: 487.3651437628},
+		{A: 6.84e-09, B: 0.01892628603, C: 228.276948965},
+		{A: 6.65e-09, B: 1.47172657769, C: 427.5613987225},
+		{A: 7.61e-09, B: 4.61079808671, C: 23.5758732361},
+		{A: 8.07e-09, B: 3.2151371812, C: 1898.3512179396},
+		{A: 6.45e-09, B: 1.92436523628, C: 2950.619601128},
+		{A: 6.24e-09, B: 6.05830190539, C: 241.6102710893},
+		{A: 6.99e-09, B: 4.02804515616, C: 425.6349830295},
+		{A: 6.24e-09, B: 5.85966148394, C: 696.5196376166},
+		{A: 6.2e-09, B: 1.86426453489, C: 2207.6295405954},
+		{A: 6.41e-09, B: 5.69868017561, C: 319.5732633943},
+		{A: 6.46e-09, B: 3.78920578728, C: 1038.0412891868},
+		{A: 6.72e-09, B: 2.54160055954, C: 271.4059194489},
+		{A: 7.68e-09, B: 1.80484245332, C: 2324.9494088156},
+		{A: 7.37e-09, B: 1.50539891226, C: 268.4369740323},
+		{A: 8.36e-09, B: 1.2658381101, C: 212.5483359126},
+		{A: 7.53e-09, B: 5.2753616624, C: 204.7010757289},
+		{A: 6.33e-09, B: 2.19920009577, C: 1802.3719907218},
+		{A: 7.2e-09, B: 2.58587107868, C: 472.1748419147},
+		{A: 6.83e-09, B: 3.8322386642, C: 43.2890291783},
+		{A: 7.4e-09, B: 6.21601938401, C: 556.5176680376},
+		{A: 7.95e-09, B: 1.14460330178, C: 381.3516082374},
+		{A: 6.78e-09, B: 3.65930963429, C: 2097.423219376},
+		{A: 5.68e-09, B: 5.9215866109, C: 2428.0421830342},
+		{A: 5.7e-09, B: 1.18024241664, C: 131.5469622218},
+		{A: 5.66e-09, B: 4.74157739398, C: 380.12776796},
+		{A: 5.86e-09, B: 5.71168743146, C: 570.7447620392},
+		{A: 5.5e-09, B: 4.92413290959, C: 188.9200730498},
+		{A: 7.12e-09, B: 2.69456114358, C: 16.6747745564},
+		{A: 5.45e-09, B: 5.387255296, C: 206.233732547},
+		{A: 5.72e-09, B: 5.79167804981, C: 195.8906076987},
+		{A: 6.02e-09, B: 5.81756794592, C: 963.4027029714},
+		{A: 5.88e-09, B: 4.25026865253, C: 426.6463749858},
+		{A: 5.63e-09, B: 3.28295055824, C: 193.655375465},
+		{A: 5.83e-09, B: 5.44099997963, C: 526.5095713569},
+		{A: 6.79e-09, B: 4.45748326743, C: 105.5404547734},
+		{A: 5.16e-09, B: 5.99843937287, C: 289.5651667136},
+		{A: 5.2e-09, B: 2.19322568805, C: 180.1619946463},
+		{A: 5.43e-09, B: 4.19333695628, C: 213.1872208534},
+		{A: 5.86e-09, B: 3.03470168346, C: 6275.9623029906},
+		{A: 5.72e-09, B: 3.96788877624, C: 140.001969579},
+		{A: 6.11e-09, B: 4.1539223987, C: 436.1594184316},
+		{A: 5.05e-09, B: 2.95739392583, C: 135.5485514541},
+		{A: 5.87e-09, B: 4.55320395537, C: 5863.5912061162},
+		{A: 4.92e-09, B: 2.71595874382, C: 84.9335269539},
+		{A: 5.76e-09, B: 5.98300938454, C: 9793.8009023358},
+		{A: 4.89e-09, B: 5.68450383182, C: 533.6231183577},
+		{A: 5.19e-09, B: 3.09688510923, C: 327.4375699205},
+		{A: 4.86e-09, B: 5.24220804875, C: 5849.3641121146},
+		{A: 4.75e-09, B: 4.51295931678, C: 411.620337349},
+		{A: 5.4e-09, B: 4.44843952768, C: 10206.1719992102},
+		{A: 4.79e-09, B: 0.87707794164, C: 207.1487562837},
+		{A: 4.68e-09, B: 0.46572028197, C: 306.0969289181},
+		{A: 5.86e-09, B: 0.86387928244, C: 2538.2485042536},
+		{A: 4.75e-09, B: 6.19152982788, C: 397.3932433474},
+		{A: 5.41e-09, B: 1.47958133221, C: 42.5382696529},
+		{A: 4.96e-09, B: 6.07879620658, C: 576.1613880106},
+		{A: 4.47e-09, B: 2.59259132013, C: 7.2254215854},
+		{A: 4.45e-09, B: 5.0682730047, C: 778.4147831847},
+		{A: 5.6e-09, B: 0.00461017471, C: 221.3758502853},
+		{A: 4.56e-09, B: 4.60143715337, C: 710.7467316182},
+		{A: 4.49e-09, B: 5.79223649465, C: 685.4739373527},
+		{A: 5.01e-09, B: 1.91370965325, C: 831.1049812242},
+		{A: 5.95e-09, B: 4.90329839607, C: 824.7421937488},
+		{A: 4.47e-09, B: 4.88662794571, C: 429.0458714308},
+		{A: 4.45e-09, B: 1.74764943142, C: 525.7588118315},
+		{A: 4.57e-09, B: 0.8089271253, C: 458.8415197904},
+		{A: 5.43e-09, B: 2.60317945475, C: 213.4109700226},
+		{A: 4.93e-09, B: 0.61947189193, C: 41.0537969446},
+		{A: 4.55e-09, B: 2.69847252264, C: 3053.7123753466},
+		{A: 4.29e-09, B: 3.89071982978, C: 92.7978334801},
+		{A: 4.11e-09, B: 1.34981168865, C: 27.0873353739},
+		{A: 4.48e-09, B: 1.84775051361, C: 980.6681783588},
+		{A: 4.45e-09, B: 4.21745990439, C: 905.8865797915},
+		{A: 4.03e-09, B: 2.33067250642, C: 2627.1141844706},
+		{A: 4.04e-09, B: 5.00179215709, C: 431.264057322},
+		{A: 3.84e-09, B: 1.65634584042, C: 241.7532834412},
+		{A: 4.1e-09, B: 0.76907037678, C: 395.578702239},
+		{A: 4.56e-09, B: 1.98353741244, C: 213.5115437591},
+		{A: 4.59e-09, B: 2.04878772547, C: 285.6330134505},
+		{A: 3.96e-09, B: 5.04141834913, C: 298.2326223919},
+		{A: 3.77e-09, B: 5.68073822097, C: 2744.4340526908},
+		{A: 4.15e-09, B: 4.41600504868, C: 179.3588454942},
+		{A: 3.96e-09, B: 4.2987285195, C: 206.706813299},
+		{A: 3.89e-09, B: 5.69091953122, C: 849.2642284889},
+		{A: 3.69e-09, B: 1.36192003466, C: 835.0371344873},
+		{A: 3.74e-09, B: 0.41402282126, C: 9779.5738083342},
+		{A: 3.79e-09, B: 1.72255764532, C: 184.9879197867},
+		{A: 3.65e-09, B: 5.88205574821, C: 19.643719973},
+		{A: 4.56e-09, B: 4.81297899859, C: 213.0866471169},
+		{A: 3.59e-09, B: 1.06819138836, C: 206.1373643274},
+		{A: 3.67e-09, B: 1.14184327929, C: 569.0478410098},
+		{A: 3.52e-09, B: 3.04388401587, C: 638.4128136057},
+		{A: 4.63e-09, B: 1.55834877017, C: 421.1815649046},
+		{A: 4.59e-09, B: 5.34648461645, C: 699.7010313543},
+		{A: 3.83e-09, B: 4.05921035379, C: 739.8086667949},
+		{A: 3.54e-09, B: 1.09760553168, C: 738.7972748386},
+		{A: 3.82e-09, B: 0.05348541587, C: 252.6559713532},
+		{A: 3.44e-09, B: 1.18536656224, C: 439.1283638482},
+		{A: 3.82e-09, B: 2.10483762147, C: 532.6117264014},
+		{A: 3.61e-09, B: 0.50215018154, C: 50.4025761791},
+		{A: 3.51e-09, B: 3.49546336297, C: 1354.4331588434},
+		{A: 3.95e-09, B: 4.2627887156, C: 432.2272651685},
+		{A: 3.45e-09, B: 2.38455893509, C: 426.0769260142},
+		{A: 3.5e-09, B: 1.51541607946, C: 259.769518354},
+		{A: 4.26e-09, B: 5.29998227949, C: 934.9485149682},
+		{A: 3.39e-09, B: 5.59774645356, C: 519.3960243561},
+		{A: 3.88e-09, B: 3.40083809779, C: 2413.8150890326},
+		{A: 3.24e-09, B: 3.68352014131, C: 72.0732855816},
+		{A: 3.23e-09, B: 1.79597508586, C: 405.9912630565},
+		{A: 3.66e-09, B: 3.56764349139, C: 1119.1856752295},
+		{A: 3.58e-09, B: 4.11241839677, C: 37.8724032069},
+		{A: 4.23e-09, B: 1.45116702108, C: 2641.3412784722},
+		{A: 3.14e-09, B: 0.68465789313, C: 757.2171545342},
+		{A: 3.2e-09, B: 3.12697568936, C: 945.9942152321},
+		{A: 3.38e-09, B: 4.89782013581, C: 898.7730327907},
+		{A: 3.19e-09, B: 5.76881401291, C: 69.3649725959},
+		{A: 3.1e-09, B: 5.35598720822, C: 815.0633461142},
+		{A: 3.69e-09, B: 4.46143610142, C: 421.93232443},
+		{A: 3.11e-09, B: 2.19275640712, C: 5856.4776591154},
+		{A: 3.06e-09, B: 2.99917010799, C: 1130.2313754934},
+		{A: 3.3e-09, B: 0.64102961163, C: 558.0021407459},
+		{A: 3.05e-09, B: 0.40963115602, C: 661.2379273164},
+		{A: 3.2e-09, B: 3.2926731994, C: 760.25553592},
+		{A: 2.98e-09, B: 5.48693246086, C: 702.1487119091},
+		{A: 3.52e-09, B: 2.18179692198, C: 2118.7638603784},
+		{A: 2.99e-09, B: 5.94980651345, C: 572.2292347475},
+		{A: 3.43e-09, B: 2.6290008365, C: 213.5597278689},
+		{A: 2.96e-09, B: 4.12563821701, C: 73.297125859},
+		{A: 3.6e-09, B: 2.94387423457, C: 2214.7430875962},
+		{A: 2.93e-09, B: 5.71837797264, C: 60.7669528868},
+		{A: 3.26e-09, B: 1.93806509331, C: 480.7728616238},
+		{A: 3.35e-09, B: 2.60120542851, C: 518.3846323998},
+		{A: 3.22e-09, B: 2.89685459163, C: 427.1194557378},
+		{A: 3.67e-09, B: 2.2048984833, C: 518.6452648307},
+		{A: 3.61e-09, B: 3.31464351282, C: 630.3360587584},
+		{A: 2.88e-09, B: 0.8776047815, C: 887.7273325268},
+		{A: 2.9e-09, B: 0.24071300709, C: 705.1176573257},
+		{A: 3.32e-09, B: 5.96464701829, C: 100.6450936638},
+		{A: 2.84e-09, B: 1.58760551116, C: 681.5417840896},
+		{A: 2.81e-09, B: 1.68339116394, C: 3267.0114707846},
+		{A: 2.87e-09, B: 3.54730637851, C: 756.3233826569},
+		{A: 3.31e-09, B: 2.74250642576, C: 22.8945496799},
+		{A: 2.81e-09, B: 4.79802388453, C: 409.9234163196},
+		{A: 3.72e-09, B: 1.08754087151, C: 426.5500067662},
+		{A: 3.4e-09, B: 0.59629116557, C: 627.3671133418},
+		{A: 3.25e-09, B: 4.07319450014, C: 511.5317178299},
+		{A: 2.73e-09, B: 0.71334827688, C: 305.0855369618},
+		{A: 2.72e-09, B: 1.76124839309, C: 945.2434557067},
+		{A: 2.95e-09, B: 4.00327005783, C: 432.7485300303},
+		{A: 2.71e-09, B: 5.28903262032, C: 1080.7225711916},
+		{A: 2.76e-09, B: 3.89192411657, C: 610.6923387854},
+		{A: 2.94e-09, B: 2.80121651058, C: 724.8308132679},
+		{A: 3.19e-09, B: 5.24824059915, C: 229.9738699944},
+		{A: 2.64e-09, B: 2.36406383589, C: 731.9443602687},
+		{A: 2.88e-09, B: 4.6781884493, C: 170.7608257851},
+		{A: 3.26e-09, B: 3.81328980623, C: 525.4981794006},
+		{A: 2.83e-09, B: 3.52027709716, C: 319.3126309634},
+		{A: 2.64e-09, B: 0.25871603855, C: 494.2662424425},
+		{A: 2.61e-09, B: 4.08135671345, C: 25.8634950965},
+		{A: 2.96e-09, B: 4.49129913731, C: 693.5506922},
+		{A: 2.92e-09, B: 0.65370180027, C: 25867.49049913539},
+		{A: 2.92e-09, B: 0.12510953311, C: 25881.717593137},
+		{A: 2.54e-09, B: 4.03912322565, C: 990.2294059144},
+		{A: 2.88e-09, B: 3.98604904657, C: 707.7777862016},
+		{A: 2.85e-09, B: 1.92328297431, C: 3134.4268782626},
+		{A: 2.84e-09, B: 2.45411523294, C: 3120.199784261},
+		{A: 2.56e-09, B: 3.6328275778, C: 430.79097657},
+		{A: 2.83e-09, B: 2.51091647682, C: 286.596221297},
+		{A: 3.25e-09, B: 4.33261281211, C: 732.6951197941},
+		{A: 2.64e-09, B: 0.05450228136, C: 650.1922270525},
+		{A: 2.73e-09, B: 4.90735780421, C: 409.1897031367},
+		{A: 3.04e-09, B: 4.61759348542, C: 468.2426886516},
+		{A: 2.85e-09, B: 5.7246790389, C: 33.9402499438},
+		{A: 2.42e-09, B: 5.28336514054, C: 403.0223176399},
+		{A: 2.7e-09, B: 0.51583145648, C: 263.7016716171},
+		{A: 2.63e-09, B: 4.81670787366, C: 1055.4497769261},
+		{A: 2.37e-09, B: 2.92617048443, C: 913.9633346388},
+		{A: 2.46e-09, B: 2.19675150666, C: 2943.5060541272},
+		{A: 2.78e-09, B: 4.58404840578, C: 398.1440028728},
+		{A: 2.34e-09, B: 2.64374114605, C: 739.0579072695},
+		{A: 2.29e-09, B: 3.80445074468, C: 58.1068240109},
+		{A: 3e-09, B: 2.06111081979, C: 429.5189521828},
+		{A: 2.23e-09, B: 3.39888651505, C: 188.0263011725},
+		{A: 3.01e-09, B: 2.96411385108, C: 624.919432787},
+		{A: 2.21e-09, B: 1.79137414078, C: 2524.021410252},
+		{A: 2.2e-09, B: 0.95686592581, C: 1894.4190646765},
+		{A: 2.25e-09, B: 4.30669421945, C: 637.4496057592},
+		{A: 2.14e-09, B: 1.70442143644, C: 658.0565335787},
+		{A: 2.27e-09, B: 3.22613053351, C: 638.9340784675},
+		{A: 2.2e-09, B: 2.66798936385, C: 953.1077622329},
+		{A: 2.53e-09, B: 3.09377787768, C: 29.2049475286},
+		{A: 2.44e-09, B: 3.15828383212, C: 7.0016724162},
+		{A: 2.95e-09, B: 4.95843934543, C: 714.6788848813},
+		{A: 2.09e-09, B: 0.94525938634, C: 864.2420820159},
+		{A: 2.16e-09, B: 0.1222123618, C: 28.5718080822},
+		{A: 2.14e-09, B: 2.80190604605, C: 373.9079928365},
+		{A: 2.12e-09, B: 2.07343849515, C: 1357.6145525811},
+		{A: 2.16e-09, B: 1.25531205533, C: 477.8039162072},
+		{A: 2.06e-09, B: 5.35971491902, C: 3060.8259223474},
+		{A: 2.04e-09, B: 3.0857941046, C: 67.6680515665},
+		{A: 2.1e-09, B: 1.91489853604, C: 938.1299087059},
+		{A: 2.09e-09, B: 1.46554109301, C: 952.3570027075},
+		{A: 2.02e-09, B: 3.57670882297, C: 334.5511169213},
+		{A: 2.28e-09, B: 5.66209641464, C: 1699.2792165032},
+		{A: 1.97e-09, B: 4.61055255182, C: 464.7312265138},
+		{A: 1.93e-09, B: 4.24606721746, C: 141.6988906084},
+		{A: 2.66e-09, B: 0.69665031373, C: 2854.6403739102},
+		{A: 2.27e-09, B: 1.31845358943, C: 230.7075831773},
+		{A: 1.92e-09, B: 5.26739976413, C: 504.561183181},
+		{A: 1.87e-09, B: 0.8553719223, C: 273.8536000037},
+		{A: 1.99e-09, B: 3.91291687807, C: 418.5214360287},
+		{A: 1.92e-09, B: 6.15674105214, C: 611.4430983108},
+		{A: 2.1e-09, B: 1.47873602747, C: 205.4347889118},
+		{A: 1.94e-09, B: 2.37167703302, C: 3370.1042450032},
+		{A: 2.28e-09, B: 2.15266015145, C: 55.1378785943},
+		{A: 2.01e-09, B: 2.71380671608, C: 586.3133163972},
+		{A: 1.94e-09, B: 3.29560033731, C: 1670.8250285},
+		{A: 2.01e-09, B: 4.23447633663, C: 1493.093668066},
+		{A: 1.81e-09, B: 3.61567262848, C: 9786.687355335},
+		{A: 1.81e-09, B: 2.83211558346, C: 1262.3860848887},
+		{A: 2.42e-09, B: 4.69869158516, C: 1141.1340634054},
+		{A: 1.84e-09, B: 4.66807336402, C: 1251.3403846248},
+		{A: 2.21e-09, B: 2.25887876254, C: 355.7487455718},
+		{A: 2e-09, B: 1.17340443616, C: 4952.0635932862},
+		{A: 2.22e-09, B: 2.23360866067, C: 2435.155730035},
+		{A: 1.75e-09, B: 0.04701598422, C: 107.7586406646},
+		{A: 1.71e-09, B: 5.0250074269, C: 93.531546663},
+		{A: 1.84e-09, B: 5.19723697138, C: 835.7878940127},
+		{A: 2.21e-09, B: 4.49141283681, C: 913.0001267923},
+		{A: 1.95e-09, B: 0.92088046109, C: 551.031606097},
+		{A: 1.66e-09, B: 5.01778115937, C: 354.9979860464},
+		{A: 1.65e-09, B: 2.26267552932, C: 406.954470903},
+		{A: 1.89e-09, B: 0.31221126958, C: 420.9691165835},
+		{A: 1.96e-09, B: 2.70333585839, C: 774.4826299216},
+		{A: 1.76e-09, B: 6.12029409039, C: 181.806526049},
+		{A: 1.72e-09, B: 1.94132177757, C: 3259.8979237838},
+		{A: 1.6e-09, B: 0.55319954265, C: 5429.8794682394},
+		{A: 1.61e-09, B: 2.88623631474, C: 184.8449074348},
+		{A: 1.92e-09, B: 0.26639534884, C: 295.1942410061},
+		{A: 1.67e-09, B: 3.71345214172, C: 1056.2005364515},
+		{A: 1.95e-09, B: 4.83926717598, C: 1596.1864422846},
+		{A: 1.56e-09, B: 2.81916058733, C: 428.0826635843},
+		{A: 2.15e-09, B: 1.88276472005, C: 220.364458329},
+		{A: 1.67e-09, B: 2.68872854428, C: 423.6774295692},
+		{A: 1.54e-09, B: 1.66553954375, C: 115.6229471908},
+		{A: 1.75e-09, B: 0.20216461467, C: 384.0599212231},
+		{A: 2.01e-09, B: 4.38095931887, C: 418.0001711669},
+		{A: 1.67e-09, B: 1.86485857353, C: 393.4610900843},
+		{A: 1.55e-09, B: 0.92480392431, C: 282.6640680339},
+		{A: 1.46e-09, B: 1.97663966745, C: 9360.089164459},
+		{A: 1.6e-09, B: 2.62483919699, C: 353.301065017},
+		{A: 1.86e-09, B: 1.37307151419, C: 292.0128472684},
+		{A: 1.98e-09, B: 1.15631374887, C: 2957.7331481288},
+		{A: 1.44e-09, B: 4.82956915076, C: 453.424893819},
+		{A: 1.49e-09, B: 3.60682821788, C: 205.6642835754},
+		{A: 1.47e-09, B: 4.48377791879, C: 81.8951455681},
+		{A: 1.47e-09, B: 5.74795037748, C: 856.3777754897},
+		{A: 1.42e-09, B: 3.53823120158, C: 212.0270710508},
+		{A: 1.4e-09, B: 0.70476909062, C: 640.8604941605},
+		{A: 1.39e-09, B: 1.39047667205, C: 1261.6353253633},
+		{A: 1.53e-09, B: 3.29559426243, C: 391.1734682239},
+		{A: 1.58e-09, B: 1.79872341304, C: 326.6868103951},
+		{A: 1.74e-09, B: 3.98677435872, C: 1049.0869894507},
+		{A: 1.71e-09, B: 4.16825100469, C: 213.0384630071},
+		{A: 1.33e-09, B: 4.74095454922, C: 0.0481841098},
+		{A: 1.55e-09, B: 5.3231361873, C: 2015.6710861598},
+		{A: 1.58e-09, B: 2.67557086253, C: 2531.1349572528},
+		{A: 1.58e-09, B: 4.64622526567, C: 427.3489504014},
+		{A: 1.23e-09, B: 2.20103444636, C: 210.5907824523},
+		{A: 1.6e-09, B: 1.85888551524, C: 201.5196819912},
+		{A: 1.19e-09, B: 3.12572799769, C: 238.5718897035},
+		{A: 1.2e-09, B: 4.62897224203, C: 203.2647871304},
+		{A: 1.29e-09, B: 4.92592016162, C: 1286.9081196288},
+		{A: 1.32e-09, B: 3.44682160054, C: 156.6767441354},
+		{A: 1.43e-09, B: 0.67951827513, C: 425.8474313506},
+		{A: 1.14e-09, B: 5.46519773276, C: 552.5855147745},
+		{A: 1.32e-09, B: 1.76335093671, C: 432.0148168474},
+		{A: 1.13e-09, B: 0.68933513038, C: 450.9772132642},
+		{A: 1.28e-09, B: 2.13986068877, C: 2751.5475996916},
+		{A: 1.23e-09, B: 4.59695145319, C: 216.0074084237},
+		{A: 1.19e-09, B: 1.04688666457, C: 462.0229135281},
+		{A: 1.08e-09, B: 5.36873170289, C: 3377.217792004},
+		{A: 1.42e-09, B: 6.24626256472, C: 299.1263942692},
+		{A: 1.18e-09, B: 0.6344825351, C: 369.9758395734},
+		{A: 1.05e-09, B: 2.31570619675, C: 200.5564741447},
+		{A: 1.24e-09, B: 1.8711081514, C: 850.0149880143},
+		{A: 1.06e-09, B: 0.5562366257, C: 114.3991069134},
+		{A: 1.02e-09, B: 3.95315219638, C: 361.3778198643},
+		{A: 9.5e-10, B: 4.10658529323, C: 10213.285546211},
+		{A: 9.7e-10, B: 1.13534710734, C: 387.2413149608},
+		{A: 9.6e-10, B: 4.46689094543, C: 401.3253966105},
+		{A: 1.19e-09, B: 2.33636675091, C: 318.8395502114},
+		{A: 1.15e-09, B: 3.37508073115, C: 313.9441891018},
+		{A: 1.06e-09, B: 3.7358621165, C: 220.9339073006},
+		{A: 9e-10, B: 0.59788492023, C: 227.3137411185},
+		{A: 1.03e-09, B: 5.09172929383, C: 213.4591541324},
+		{A: 9.7e-10, B: 5.95268532215, C: 1044.4040766622},
+		{A: 1.03e-09, B: 1.70625660572, C: 213.1390367436},
+		{A: 8e-10, B: 0.86872596168, C: 233.9060232575},
+		{A: 8.9e-10, B: 5.3599093223, C: 214.1928673153},
+		{A: 8e-10, B: 2.69565238975, C: 540.7366653585},
+		{A: 9.5e-10, B: 1.19504849611, C: 460.5384408198},
+		{A: 1.05e-09, B: 0.58624363205, C: 481.7360694703},
+		{A: 9.9e-10, B: 2.68841109007, C: 219.891377577},
+		{A: 9.8e-10, B: 1.59923557478, C: 484.7050148869},
+		{A: 8.1e-10, B: 1.12279793521, C: 420.4478517217},
+		{A: 7.5e-10, B: 4.58892231446, C: 394.3548619616},
+		{A: 9.9e-10, B: 4.6889585175, C: 448.6895914038},
+		{A: 7.6e-10, B: 1.66929798365, C: 196.0336200506},
+		{A: 8.7e-10, B: 3.1247719509, C: 857.1285350151},
+		{A: 7.8e-10, B: 5.5981938746, C: 364.3467652809},
+		{A: 7.9e-10, B: 3.53267171729, C: 969.6224780949},
+	},
+	// L2, 321 terms
+	{
+		{A: 0.00116441181, B: 1.17987850633, C: 7.1135470008},
+		{A: 0.00091920844, B: 0.07425261094, C: 213.299095438},
+		{A: 0.00090592251, B: 0, C: 0},
+		{A: 0.00015276909, B: 4.06492007503, C: 206.1855484372},
+		{A: 0.00010631396, B: 0.25778277414, C: 220.4126424388},
+		{A: 0.00010604979, B: 5.40963595885, C: 426.598190876},
+		{A: 4.265368e-05, B: 1.0459555663, C: 14.2270940016},
+		{A: 1.215527e-05, B: 2.91860042123, C: 103.0927742186},
+		{A: 1.164684e-05, B: 4.60942128971, C: 639.897286314},
+		{A: 1.081967e-05, B: 5.6913035167, C: 433.7117378768},
+		{A: 1.020079e-05, B: 0.63369182642, C: 3.1813937377},
+		{A: 1.044754e-05, B: 4.04206453611, C: 199.0720014364},
+		{A: 6.33582e-06, B: 4.38825410036, C: 419.4846438752},
+		{A: 5.49329e-06, B: 5.57303134242, C: 3.9321532631},
+		{A: 4.56914e-06, B: 1.26840971349, C: 110.2063212194},
+		{A: 4.251e-06, B: 0.20935499279, C: 227.5261894396},
+		{A: 2.73739e-06, B: 4.28841011784, C: 95.9792272178},
+		{A: 1.61571e-06, B: 1.3813914942, C: 11.0457002639},
+		{A: 1.29494e-06, B: 1.5658688417, C: 309.2783226558},
+		{A: 1.17008e-06, B: 3.88120915956, C: 853.196381752},
+		{A: 1.05415e-06, B: 4.90003203599, C: 647.0108333148},
+		{A: 1.00967e-06, B: 0.892704931, C: 21.3406410024},
+		{A: 9.5227e-07, B: 5.62561150598, C: 412.3710968744},
+		{A: 8.1948e-07, B: 1.02477558315, C: 117.3198682202},
+		{A: 7.4857e-07, B: 4.76178468163, C: 210.1177017003},
+		{A: 8.2727e-07, B: 6.05030934786, C: 216.4804891757},
+		{A: 9.5659e-07, B: 2.91093561539, C: 316.3918696566},
+		{A: 6.3696e-07, B: 0.35179804917, C: 323.5054166574},
+		{A: 8.486e-07, B: 5.73472777961, C: 209.3669421749},
+		{A: 6.0647e-07, B: 4.8751785019, C: 632.7837393132},
+		{A: 6.6459e-07, B: 0.48297940601, C: 10.2949407385},
+		{A: 6.7184e-07, B: 0.45648612616, C: 522.5774180938},
+		{A: 5.3281e-07, B: 2.74730541387, C: 529.6909650946},
+		{A: 4.5827e-07, B: 5.69296621745, C: 440.8252848776},
+		{A: 4.5293e-07, B: 1.66856699796, C: 202.2533951741},
+		{A: 4.233e-07, B: 5.70768187703, C: 88.865680217},
+		{A: 3.214e-07, B: 0.07050050346, C: 63.7358983034},
+		{A: 3.1573e-07, B: 1.67190022213, C: 302.164775655},
+		{A: 3.115e-07, B: 4.16379537691, C: 191.9584544356},
+		{A: 2.4631e-07, B: 5.6556472857, C: 735.8765135318},
+		{A: 2.6558e-07, B: 0.83256214407, C: 224.3447957019},
+		{A: 2.0108e-07, B: 5.94364609981, C: 217.2312487011},
+		{A: 1.7511e-07, B: 4.90014736798, C: 625.6701923124},
+		{A: 1.713e-07, B: 1.62593421274, C: 742.9900605326},
+		{A: 1.3744e-07, B: 3.764971673, C: 195.1398481733},
+		{A: 1.2236e-07, B: 4.71789723976, C: 203.0041546995},
+		{A: 1.194e-07, B: 0.12620714199, C: 234.6397364404},
+		{A: 1.604e-07, B: 0.57886320845, C: 515.463871093},
+		{A: 1.1154e-07, B: 5.9221684478, C: 536.8045120954},
+		{A: 1.4068e-07, B: 0.206752937, C: 838.9692877504},
+		{A: 1.1013e-07, B: 5.60207982774, C: 728.762966531},
+		{A: 1.1718e-07, B: 3.12098483554, C: 846.0828347512},
+		{A: 9.962e-08, B: 4.15472049127, C: 860.3099287528},
+		{A: 1.0601e-07, B: 3.20327613035, C: 1066.49547719},
+		{A: 1.0072e-07, B: 0.25709351996, C: 330.6189636582},
+		{A: 9.49e-08, B: 0.46379969328, C: 956.2891559706},
+		{A: 1.024e-07, B: 4.9873665607, C: 422.6660376129},
+		{A: 8.287e-08, B: 2.13990364272, C: 269.9214467406},
+		{A: 7.238e-08, B: 5.39724715258, C: 1052.2683831884},
+		{A: 7.73e-08, B: 5.24602742309, C: 429.7795846137},
+		{A: 6.353e-08, B: 4.46211130731, C: 284.1485407422},
+		{A: 5.935e-08, B: 5.40967847103, C: 149.5631971346},
+		{A: 7.55e-08, B: 4.03401153929, C: 9.5612275556},
+		{A: 5.779e-08, B: 4.2938089111, C: 415.5524906121},
+		{A: 6.082e-08, B: 5.93416924841, C: 405.2575498736},
+		{A: 5.711e-08, B: 0.01824076994, C: 124.433415221},
+		{A: 5.676e-08, B: 6.0223568215, C: 223.5940361765},
+		{A: 4.757e-08, B: 4.92804854717, C: 654.1243803156},
+		{A: 4.727e-08, B: 2.27461984667, C: 18.1592472647},
+		{A: 4.509e-08, B: 4.40688707557, C: 942.062061969},
+		{A: 5.621e-08, B: 0.29694719379, C: 127.4717966068},
+		{A: 5.453e-08, B: 5.53868222772, C: 949.1756089698},
+		{A: 4.13e-08, B: 4.68673560379, C: 74.7815985673},
+		{A: 4.098e-08, B: 5.308512622, C: 1045.1548361876},
+		{A: 4.223e-08, B: 2.89014939299, C: 56.6223513026},
+		{A: 4.887e-08, B: 3.20022991216, C: 277.0349937414},
+		{A: 3.905e-08, B: 3.30270187305, C: 490.3340891794},
+		{A: 3.923e-08, B: 6.09732996823, C: 81.7521332162},
+		{A: 3.755e-08, B: 4.93065184796, C: 52.6901980395},
+		{A: 4.602e-08, B: 6.13908576681, C: 1155.361157407},
+		{A: 3.714e-08, B: 0.40648076787, C: 137.0330241624},
+		{A: 3.407e-08, B: 4.28514461015, C: 99.9113804809},
+		{A: 3.579e-08, B: 0.20402442077, C: 1272.6810256272},
+		{A: 3.946e-08, B: 0.36500928968, C: 12.5301729722},
+		{A: 3.246e-08, B: 1.56761884227, C: 1059.3819301892},
+		{A: 4.063e-08, B: 0.29084229143, C: 831.8557407496},
+		{A: 3.688e-08, B: 0.15467406177, C: 437.6438911399},
+		{A: 2.895e-08, B: 3.13473183482, C: 70.8494453042},
+		{A: 2.8e-08, B: 0.32727938074, C: 191.2076949102},
+		{A: 2.672e-08, B: 1.87612402267, C: 295.0512286542},
+		{A: 3.454e-08, B: 4.77197610696, C: 423.4167971383},
+		{A: 2.623e-08, B: 5.15237415384, C: 1368.660252845},
+		{A: 2.457e-08, B: 3.89612890177, C: 210.8514148832},
+		{A: 2.461e-08, B: 1.5852287676, C: 32.2433289144},
+		{A: 2.595e-08, B: 3.59007068361, C: 131.4039498699},
+		{A: 2.289e-08, B: 4.76825865118, C: 351.8165923087},
+		{A: 2.357e-08, B: 5.83099000562, C: 106.2741679563},
+		{A: 2.221e-08, B: 5.98277491515, C: 6062.6632075526},
+		{A: 2.221e-08, B: 2.05930402282, C: 6076.8903015542},
+		{A: 2.183e-08, B: 5.94985336393, C: 145.6310438715},
+		{A: 2.718e-08, B: 3.37801252354, C: 408.4389436113},
+		{A: 2.288e-08, B: 3.1400061932, C: 22.0914005278},
+		{A: 2.09e-08, B: 1.12304173562, C: 9992.8729037722},
+		{A: 2.089e-08, B: 3.48276230686, C: 10007.0999977738},
+		{A: 2.57e-08, B: 5.12167203704, C: 265.9892934775},
+		{A: 1.835e-08, B: 4.15379879659, C: 1258.4539316256},
+		{A: 1.82e-08, B: 5.05340615445, C: 1361.5467058442},
+		{A: 1.76e-08, B: 4.13532689228, C: 107.0249274817},
+		{A: 1.921e-08, B: 4.51790997496, C: 138.5174968707},
+		{A: 1.707e-08, B: 1.3586459328, C: 231.4583427027},
+		{A: 1.956e-08, B: 5.87006093798, C: 1471.7530270636},
+		{A: 2.133e-08, B: 5.2340984872, C: 1265.5674786264},
+		{A: 1.595e-08, B: 5.61962698786, C: 447.9388318784},
+		{A: 1.609e-08, B: 3.74893709671, C: 628.8515860501},
+		{A: 1.49e-08, B: 0.4835240494, C: 340.7708920448},
+		{A: 1.56e-08, B: 5.97095003614, C: 430.5303441391},
+		{A: 1.352e-08, B: 0.71405348653, C: 28.4541880032},
+		{A: 1.355e-08, B: 2.91219493604, C: 215.7467759928},
+		{A: 1.298e-08, B: 5.84254169775, C: 543.9180590962},
+		{A: 1.664e-08, B: 6.23834873469, C: 1148.2476104062},
+		{A: 1.205e-08, B: 2.83373725021, C: 200.7689224658},
+		{A: 1.192e-08, B: 3.52219428945, C: 497.4476361802},
+		{A: 1.122e-08, B: 2.6057103027, C: 1279.794572628},
+		{A: 1.217e-08, B: 6.23528359211, C: 1589.0728952838},
+		{A: 1.42e-08, B: 0.85079202155, C: 6069.7767545534},
+		{A: 1.12e-08, B: 4.95656566453, C: 1685.0521225016},
+		{A: 1.01e-08, B: 3.39689646619, C: 1073.6090241908},
+		{A: 1.352e-08, B: 2.27575429523, C: 9999.986450773},
+		{A: 9.79e-09, B: 1.58571463442, C: 1375.7737998458},
+		{A: 1.159e-08, B: 0.71823181781, C: 508.3503240922},
+		{A: 1.014e-08, B: 2.40759054741, C: 703.6331846174},
+		{A: 9.56e-09, B: 2.66256831556, C: 134.5853436076},
+		{A: 1.11e-08, B: 1.19713920197, C: 618.5566453116},
+		{A: 9.45e-09, B: 4.68155456977, C: 362.8622925726},
+		{A: 9.53e-09, B: 4.20749172571, C: 288.0806940053},
+		{A: 1.033e-08, B: 1.08781255146, C: 184.8449074348},
+		{A: 9.42e-09, B: 2.4346522346, C: 222.8603229936},
+		{A: 9.09e-09, B: 4.5176938536, C: 38.1330356378},
+		{A: 1.002e-08, B: 1.38543153271, C: 483.2205421786},
+		{A: 1.082e-08, B: 4.52832816548, C: 635.9651330509},
+		{A: 1.008e-08, B: 4.91325851448, C: 750.1036075334},
+		{A: 8.62e-09, B: 4.79998518474, C: 1677.9385755008},
+		{A: 8.28e-09, B: 2.21940849017, C: 333.657345044},
+		{A: 7.45e-09, B: 3.97279299984, C: 1574.8458012822},
+		{A: 9.03e-09, B: 5.58963782799, C: 1788.1448967202},
+		{A: 7.35e-09, B: 2.28191723259, C: 1162.4747044078},
+		{A: 7.73e-09, B: 5.82270096882, C: 416.3032501375},
+		{A: 7.34e-09, B: 2.35356586018, C: 120.358249606},
+		{A: 7.45e-09, B: 4.84266000843, C: 76.2660712756},
+		{A: 7.65e-09, B: 2.50840146722, C: 343.2185725996},
+		{A: 9.08e-09, B: 5.01046293458, C: 1581.959348283},
+		{A: 7.07e-09, B: 3.66631544506, C: 347.8844390456},
+		{A: 8.7e-09, B: 0.77106152694, C: 113.3877149571},
+		{A: 6.86e-09, B: 2.88543836068, C: 92.0470739547},
+		{A: 6.73e-09, B: 3.75650667651, C: 203.7378678824},
+		{A: 6.56e-09, B: 3.77718582702, C: 217.964961884},
+		{A: 6.75e-09, B: 5.62875135263, C: 17.2654753874},
+		{A: 6.91e-09, B: 0.21330089609, C: 99.1606209555},
+		{A: 7.86e-09, B: 4.49318079175, C: 643.0786800517},
+		{A: 6.41e-09, B: 0.67588390141, C: 46.470422916},
+		{A: 6.63e-09, B: 5.74837848383, C: 721.6494195302},
+		{A: 8.09e-09, B: 5.94893988352, C: 1464.6394800628},
+		{A: 6.38e-09, B: 4.86195439622, C: 357.4456666012},
+		{A: 7.4e-09, B: 6.00053422445, C: 337.732510659},
+		{A: 5.55e-09, B: 4.95858934298, C: 358.9301393095},
+		{A: 5.81e-09, B: 3.87669679805, C: 565.1156877467},
+		{A: 5.41e-09, B: 1.22296838713, C: 62.2514255951},
+		{A: 6.97e-09, B: 0.00715950269, C: 1169.5882514086},
+		{A: 5.24e-09, B: 1.53830423608, C: 195.8906076987},
+		{A: 5.18e-09, B: 5.41992758537, C: 312.1990839626},
+		{A: 6.26e-09, B: 5.26580317026, C: 436.8931316145},
+		{A: 5.37e-09, B: 6.170316576, C: 182.279606801},
+		{A: 5.74e-09, B: 5.98607898826, C: 1905.4647649404},
+		{A: 5.41e-09, B: 0.30589337713, C: 98.8999885246},
+		{A: 6.03e-09, B: 3.26888470585, C: 208.633228992},
+		{A: 5.04e-09, B: 3.80930996688, C: 168.0525127994},
+		{A: 4.77e-09, B: 3.56642391994, C: 563.6312150384},
+		{A: 5.11e-09, B: 4.70719837179, C: 2001.4439921582},
+		{A: 4.75e-09, B: 1.06025557585, C: 5856.4776591154},
+		{A: 5.4e-09, B: 0.87230551412, C: 1692.1656695024},
+		{A: 4.54e-09, B: 2.48128029368, C: 9786.687355335},
+		{A: 4.56e-09, B: 3.18303484133, C: 218.9281697305},
+		{A: 4.62e-09, B: 0.71358186864, C: 258.8757464767},
+		{A: 4.24e-09, B: 4.89778948357, C: 636.7158925763},
+		{A: 5.37e-09, B: 2.59376221736, C: 313.2104759189},
+		{A: 4.1e-09, B: 4.22147787617, C: 867.4234757536},
+		{A: 4.08e-09, B: 3.06057772788, C: 424.1505103212},
+		{A: 4.07e-09, B: 3.79376013938, C: 24.3790223882},
+		{A: 5.69e-09, B: 3.68547825941, C: 350.3321196004},
+		{A: 4.04e-09, B: 0.91401255827, C: 114.1384744825},
+		{A: 3.95e-09, B: 3.50478374207, C: 129.9194771616},
+		{A: 3.95e-09, B: 2.86309689622, C: 212.3358875915},
+		{A: 3.86e-09, B: 5.00762729432, C: 388.4651552382},
+		{A: 3.93e-09, B: 6.26835522096, C: 241.7532834412},
+		{A: 4.01e-09, B: 4.60258908692, C: 1994.3304451574},
+		{A: 3.85e-09, B: 0.91582119643, C: 160.6088973985},
+		{A: 4.67e-09, B: 0.54876489832, C: 404.5067903482},
+		{A: 3.68e-09, B: 0.35674031808, C: 214.2623032845},
+		{A: 4.71e-09, B: 0.67360047481, C: 207.8824694666},
+		{A: 3.79e-09, B: 0.92901327825, C: 767.3690829208},
+		{A: 4.2e-09, B: 5.69797398044, C: 225.8292684102},
+		{A: 3.56e-09, B: 3.10092792842, C: 842.1506814881},
+		{A: 4.28e-09, B: 5.35375368944, C: 2104.5367663768},
+		{A: 4.22e-09, B: 2.67975581832, C: 77.962992305},
+		{A: 3.7e-09, B: 5.46144813372, C: 1038.0412891868},
+		{A: 3.79e-09, B: 5.56429091578, C: 131.5469622218},
+		{A: 4.41e-09, B: 5.68196668399, C: 1781.0313497194},
+		{A: 3.61e-09, B: 5.20616019966, C: 629.6023455755},
+		{A: 3.41e-09, B: 5.92928351979, C: 26.826702943},
+		{A: 4.19e-09, B: 5.26851686707, C: 85.8272988312},
+		{A: 3.22e-09, B: 0.80223983857, C: 6283.0758499914},
+		{A: 3.23e-09, B: 3.86700993914, C: 576.1613880106},
+		{A: 3.21e-09, B: 2.1718603297, C: 10213.285546211},
+		{A: 3.55e-09, B: 2.80560859177, C: 344.7030453079},
+		{A: 3.11e-09, B: 3.77477255556, C: 1891.2376709388},
+		{A: 3.18e-09, B: 5.22020784209, C: 142.4496501338},
+		{A: 3.15e-09, B: 0.52272202855, C: 5849.3641121146},
+		{A: 4.28e-09, B: 4.63722058283, C: 1898.3512179396},
+		{A: 3.37e-09, B: 0.68198429948, C: 45.5766510387},
+		{A: 3.16e-09, B: 0.54074780109, C: 444.7574381407},
+		{A: 3.1e-09, B: 1.41032075652, C: 273.1028404783},
+		{A: 3.11e-09, B: 3.5374455623, C: 251.4321310758},
+		{A: 2.95e-09, B: 1.93253015677, C: 436.1594184316},
+		{A: 2.96e-09, B: 1.97705648834, C: 9779.5738083342},
+		{A: 3.26e-09, B: 3.67854047003, C: 963.4027029714},
+		{A: 3.89e-09, B: 5.76841276132, C: 39.3568759152},
+		{A: 2.77e-09, B: 5.73995694175, C: 92.7978334801},
+		{A: 3.15e-09, B: 4.96371610197, C: 757.2171545342},
+		{A: 2.95e-09, B: 1.816388339, C: 1493.093668066},
+		{A: 2.87e-09, B: 0.97698377929, C: 685.4739373527},
+		{A: 2.81e-09, B: 2.66463042095, C: 1286.9081196288},
+		{A: 3.3e-09, B: 5.7977692276, C: 650.9429865779},
+		{A: 2.92e-09, B: 3.97858181479, C: 472.1748419147},
+		{A: 2.66e-09, B: 4.1371611132, C: 601.7642506762},
+		{A: 2.62e-09, B: 0.91887592474, C: 245.5424243524},
+		{A: 2.78e-09, B: 3.08964256591, C: 778.4147831847},
+		{A: 2.77e-09, B: 3.0875091688, C: 621.7380390493},
+		{A: 2.55e-09, B: 3.93981592051, C: 181.0557665236},
+		{A: 3.33e-09, B: 2.04835822938, C: 561.1835344836},
+		{A: 2.47e-09, B: 2.92754257675, C: 219.4494345923},
+		{A: 3.06e-09, B: 0.36127922606, C: 824.7421937488},
+		{A: 2.53e-09, B: 1.80130756458, C: 5643.1785636774},
+		{A: 3.37e-09, B: 4.97764462199, C: 175.1660598002},
+		{A: 2.73e-09, B: 0.66599369335, C: 2008.557539159},
+		{A: 2.27e-09, B: 4.87285356383, C: 661.2379273164},
+		{A: 2.49e-09, B: 3.14202895058, C: 144.1465711632},
+		{A: 2.2e-09, B: 3.93526603081, C: 319.5732633943},
+		{A: 2.12e-09, B: 5.85248164087, C: 546.956440482},
+		{A: 2.34e-09, B: 1.65314711167, C: 554.0699874828},
+		{A: 2.04e-09, B: 0.88373842674, C: 31.492569389},
+		{A: 2.05e-09, B: 2.93169866171, C: 1596.1864422846},
+		{A: 2.01e-09, B: 3.36504567824, C: 1080.7225711916},
+		{A: 2.24e-09, B: 4.34612745705, C: 1382.8873468466},
+		{A: 1.92e-09, B: 5.13697232918, C: 329.7251917809},
+		{A: 2.08e-09, B: 3.08549771485, C: 41.6444977756},
+		{A: 2.36e-09, B: 0.0799874286, C: 1141.1340634054},
+		{A: 2.03e-09, B: 4.13011580915, C: 2627.1141844706},
+		{A: 2.03e-09, B: 0.13969067385, C: 1485.9801210652},
+		{A: 2.04e-09, B: 3.38137545713, C: 699.7010313543},
+		{A: 2.12e-09, B: 4.52370676085, C: 2310.722314814},
+		{A: 2.18e-09, B: 5.79277335862, C: 2221.856634597},
+		{A: 2.13e-09, B: 0.50441377637, C: 934.9485149682},
+		{A: 2.1e-09, B: 5.04017633795, C: 2420.9286360334},
+		{A: 2.14e-09, B: 4.64286758581, C: 2317.8358618148},
+		{A: 1.78e-09, B: 0.84588580004, C: 128.3655684841},
+		{A: 1.7e-09, B: 2.75006619605, C: 710.7467316182},
+		{A: 1.71e-09, B: 4.32615182967, C: 291.262087743},
+		{A: 1.72e-09, B: 3.4697130692, C: 501.3797894433},
+		{A: 1.7e-09, B: 1.05408992106, C: 526.5095713569},
+		{A: 1.62e-09, B: 1.1568304295, C: 519.3960243561},
+		{A: 1.8e-09, B: 4.96266204107, C: 1670.8250285},
+		{A: 1.72e-09, B: 1.65385549578, C: 916.9322800554},
+		{A: 1.7e-09, B: 2.30821101766, C: 429.0458714308},
+		{A: 1.7e-09, B: 5.98716489326, C: 643.8294395771},
+		{A: 1.73e-09, B: 5.19933564968, C: 1354.4331588434},
+		{A: 1.95e-09, B: 4.50165508529, C: 2214.7430875962},
+		{A: 1.56e-09, B: 4.16290662749, C: 572.2292347475},
+		{A: 1.53e-09, B: 1.23776248578, C: 2413.8150890326},
+		{A: 1.5e-09, B: 0.63076983213, C: 1478.8665740644},
+		{A: 1.69e-09, B: 4.28090123029, C: 305.3461693927},
+		{A: 1.74e-09, B: 6.23077892653, C: 3384.3313390048},
+		{A: 1.49e-09, B: 3.13274908516, C: 9573.388259897},
+		{A: 1.62e-09, B: 6.25601818345, C: 213.2509113282},
+		{A: 1.49e-09, B: 4.81749019484, C: 945.9942152321},
+		{A: 1.62e-09, B: 0.8861012919, C: 216.2198567448},
+		{A: 1.33e-09, B: 2.31915371262, C: 156.6767441354},
+		{A: 1.65e-09, B: 6.06456216591, C: 732.6951197941},
+		{A: 1.41e-09, B: 6.14293754333, C: 1795.258443721},
+		{A: 1.33e-09, B: 0.06530337135, C: 218.7157214094},
+		{A: 1.62e-09, B: 3.17058130506, C: 213.3472795478},
+		{A: 1.25e-09, B: 2.07143636845, C: 425.1137181677},
+		{A: 1.46e-09, B: 1.88627500632, C: 211.8146227297},
+		{A: 1.13e-09, B: 2.79541965778, C: 235.3904959658},
+		{A: 1.17e-09, B: 0.76464798684, C: 479.2883889155},
+		{A: 1.08e-09, B: 3.95650672786, C: 570.7447620392},
+		{A: 1.06e-09, B: 0.12820734703, C: 188.0263011725},
+		{A: 1.34e-09, B: 3.58244908862, C: 849.2642284889},
+		{A: 1.14e-09, B: 0.25990388555, C: 398.1440028728},
+		{A: 1.12e-09, B: 2.39181495831, C: 217.491881132},
+		{A: 9.1e-10, B: 2.50716605179, C: 121.2520214833},
+		{A: 9.1e-10, B: 1.75367948574, C: 213.8203602998},
+		{A: 8.8e-10, B: 5.26121947108, C: 395.578702239},
+		{A: 9.6e-10, B: 3.98832609364, C: 289.5651667136},
+		{A: 9.1e-10, B: 0.35318362186, C: 312.4597163935},
+		{A: 1.12e-09, B: 1.14387590923, C: 1802.3719907218},
+		{A: 8.2e-10, B: 3.73605217214, C: 207.6700211455},
+		{A: 8.2e-10, B: 6.06283262812, C: 210.3783341312},
+		{A: 8.4e-10, B: 3.34470673492, C: 67.6680515665},
+		{A: 8.6e-10, B: 2.7391730018, C: 5863.5912061162},
+		{A: 8.3e-10, B: 2.81499116485, C: 776.9303104764},
+		{A: 9.1e-10, B: 1.2616009317, C: 212.7778305762},
+		{A: 9e-10, B: 2.08722491981, C: 2111.6503133776},
+		{A: 8e-10, B: 2.13136842916, C: 421.93232443},
+		{A: 8.2e-10, B: 4.16358350281, C: 9793.8009023358},
+		{A: 7.7e-10, B: 2.96973341607, C: 431.264057322},
+		{A: 7.9e-10, B: 3.42790361067, C: 417.0369633204},
+		{A: 7.9e-10, B: 3.18693585419, C: 320.3240229197},
+		{A: 8e-10, B: 0.78975763683, C: 204.7010757289},
+		{A: 7.7e-10, B: 1.89354243952, C: 556.5176680376},
+		{A: 7.3e-10, B: 4.85923277221, C: 2118.7638603784},
+		{A: 7.1e-10, B: 3.64551577433, C: 198.321241911},
+	},
+	// L3, 148 terms
+	{
+		{A: 0.00016038734, B: 5.73945377424, C: 7.1135470008},
+		{A: 4.249793e-05, B: 4.58539675603, C: 213.299095438},
+		{A: 1.906524e-05, B: 4.76082050205, C: 220.4126424388},
+		{A: 1.465687e-05, B: 5.91326678323, C: 206.1855484372},
+		{A: 1.162041e-05, B: 5.61973132428, C: 14.2270940016},
+		{A: 1.066581e-05, B: 3.60816533142, C: 426.598190876},
+		{A: 2.39377e-06, B: 3.86088273439, C: 433.7117378768},
+		{A: 2.36975e-06, B: 5.76826451465, C: 199.0720014364},
+		{A: 1.65641e-06, B: 5.11641150216, C: 3.1813937377},
+		{A: 1.31409e-06, B: 4.74327544615, C: 227.5261894396},
+		{A: 1.51352e-06, B: 2.73594641861, C: 639.897286314},
+		{A: 6.163e-07, B: 4.74287052463, C: 103.0927742186},
+		{A: 6.3365e-07, B: 0.22850089497, C: 419.4846438752},
+		{A: 4.0437e-07, B: 5.47298059144, C: 21.3406410024},
+		{A: 4.0205e-07, B: 5.9642026672, C: 95.9792272178},
+		{A: 3.8746e-07, B: 5.83386199529, C: 110.2063212194},
+		{A: 2.8025e-07, B: 3.01235311514, C: 647.0108333148},
+		{A: 2.5029e-07, B: 0.9880817074, C: 3.9321532631},
+		{A: 1.8101e-07, B: 1.02506397063, C: 412.3710968744},
+		{A: 1.7879e-07, B: 3.31913418974, C: 309.2783226558},
+		{A: 1.6208e-07, B: 3.89825272754, C: 440.8252848776},
+		{A: 1.5763e-07, B: 5.61667809625, C: 117.3198682202},
+		{A: 1.9014e-07, B: 1.91614237463, C: 853.196381752},
+		{A: 1.8262e-07, B: 4.96738415934, C: 10.2949407385},
+		{A: 1.2947e-07, B: 1.18068953942, C: 88.865680217},
+		{A: 1.7919e-07, B: 4.20376505349, C: 216.4804891757},
+		{A: 1.1453e-07, B: 5.57520615096, C: 11.0457002639},
+		{A: 1.0548e-07, B: 5.92906266269, C: 191.9584544356},
+		{A: 1.0389e-07, B: 3.94838736947, C: 209.3669421749},
+		{A: 8.65e-08, B: 3.39335369698, C: 302.164775655},
+		{A: 7.58e-08, B: 4.87736913157, C: 323.5054166574},
+		{A: 6.697e-08, B: 0.38198725552, C: 632.7837393132},
+		{A: 5.864e-08, B: 1.05621157685, C: 210.1177017003},
+		{A: 5.449e-08, B: 4.64268475485, C: 234.6397364404},
+		{A: 6.327e-08, B: 2.25492722762, C: 522.5774180938},
+		{A: 3.602e-08, B: 2.30677010956, C: 515.463871093},
+		{A: 3.229e-08, B: 2.20309400066, C: 860.3099287528},
+		{A: 3.701e-08, B: 3.14159265359, C: 0},
+		{A: 2.583e-08, B: 4.93447677059, C: 224.3447957019},
+		{A: 2.543e-08, B: 0.42393884183, C: 625.6701923124},
+		{A: 2.213e-08, B: 3.19814958289, C: 202.2533951741},
+		{A: 2.421e-08, B: 4.76621391814, C: 330.6189636582},
+		{A: 2.85e-08, B: 0.5860439501, C: 529.6909650946},
+		{A: 1.965e-08, B: 4.39525359412, C: 124.433415221},
+		{A: 2.154e-08, B: 1.35488209144, C: 405.2575498736},
+		{A: 2.296e-08, B: 3.34809165905, C: 429.7795846137},
+		{A: 2.018e-08, B: 3.06693569701, C: 654.1243803156},
+		{A: 1.979e-08, B: 1.02981005658, C: 728.762966531},
+		{A: 1.868e-08, B: 3.09383546177, C: 422.6660376129},
+		{A: 1.846e-08, B: 4.1522598545, C: 536.8045120954},
+		{A: 2.194e-08, B: 1.18918501013, C: 1066.49547719},
+		{A: 2.09e-08, B: 4.15631351317, C: 223.5940361765},
+		{A: 1.481e-08, B: 0.37916705169, C: 316.3918696566},
+		{A: 1.72e-08, B: 5.82865773356, C: 195.1398481733},
+		{A: 1.46e-08, B: 1.57663426355, C: 81.7521332162},
+		{A: 1.623e-08, B: 6.03706764648, C: 742.9900605326},
+		{A: 1.286e-08, B: 1.66154726117, C: 63.7358983034},
+		{A: 1.304e-08, B: 5.02409881054, C: 956.2891559706},
+		{A: 1.446e-08, B: 2.10575519127, C: 838.9692877504},
+		{A: 1.245e-08, B: 3.8810975277, C: 269.9214467406},
+		{A: 1.018e-08, B: 3.72599601656, C: 295.0512286542},
+		{A: 1.323e-08, B: 1.38492882986, C: 735.8765135318},
+		{A: 1.318e-08, B: 2.33460998999, C: 217.2312487011},
+		{A: 9.43e-09, B: 2.75813531246, C: 284.1485407422},
+		{A: 9.06e-09, B: 0.71155526266, C: 846.0828347512},
+		{A: 8.86e-09, B: 3.83754799777, C: 447.9388318784},
+		{A: 9.43e-09, B: 3.31480217015, C: 18.1592472647},
+		{A: 8e-09, B: 4.71386673963, C: 56.6223513026},
+		{A: 9.08e-09, B: 2.02119147951, C: 831.8557407496},
+		{A: 7.87e-09, B: 0.80410269937, C: 1045.1548361876},
+		{A: 7.09e-09, B: 4.27064410504, C: 437.6438911399},
+		{A: 6.51e-09, B: 6.17565900032, C: 942.062061969},
+		{A: 7.85e-09, B: 2.40767785311, C: 203.0041546995},
+		{A: 7.02e-09, B: 1.64585301418, C: 423.4167971383},
+		{A: 5.43e-09, B: 2.86326941725, C: 184.8449074348},
+		{A: 5.32e-09, B: 6.25762144463, C: 1059.3819301892},
+		{A: 5.21e-09, B: 3.43013038466, C: 149.5631971346},
+		{A: 4.84e-09, B: 4.8836606072, C: 1272.6810256272},
+		{A: 4.37e-09, B: 5.40220619672, C: 408.4389436113},
+		{A: 3.88e-09, B: 2.57589594168, C: 508.3503240922},
+		{A: 4.21e-09, B: 4.05836524024, C: 543.9180590962},
+		{A: 3.75e-09, B: 1.22747948298, C: 2324.9494088156},
+		{A: 3.47e-09, B: 0.59237194522, C: 22.0914005278},
+		{A: 4.33e-09, B: 1.69090148012, C: 1155.361157407},
+		{A: 3.89e-09, B: 1.46170367972, C: 1073.6090241908},
+		{A: 3.07e-09, B: 1.82185086955, C: 628.8515860501},
+		{A: 4.09e-09, B: 1.21858750514, C: 1052.2683831884},
+		{A: 3.09e-09, B: 0.33610530663, C: 6076.8903015542},
+		{A: 3.09e-09, B: 1.42279282226, C: 6062.6632075526},
+		{A: 3.4e-09, B: 1.8332577031, C: 1141.1340634054},
+		{A: 3.03e-09, B: 2.4158474733, C: 127.4717966068},
+		{A: 3.05e-09, B: 5.34154702988, C: 131.4039498699},
+		{A: 2.98e-09, B: 2.28594631393, C: 635.9651330509},
+		{A: 3.72e-09, B: 1.0372391139, C: 313.2104759189},
+		{A: 3.38e-09, B: 0.69100012338, C: 1361.5467058442},
+		{A: 3.25e-09, B: 1.78816356937, C: 1148.2476104062},
+		{A: 3.22e-09, B: 1.1862880501, C: 721.6494195302},
+		{A: 2.71e-09, B: 2.4566315646, C: 415.5524906121},
+		{A: 2.51e-09, B: 3.12046701975, C: 1382.8873468466},
+		{A: 2.54e-09, B: 3.00353256829, C: 618.5566453116},
+		{A: 2.95e-09, B: 0.35280179538, C: 2730.2069586892},
+		{A: 2.42e-09, B: 1.52154324392, C: 70.8494453042},
+		{A: 2.96e-09, B: 0.89576757167, C: 2104.5367663768},
+		{A: 2.64e-09, B: 3.00987438634, C: 661.2379273164},
+		{A: 2.67e-09, B: 0.31623829657, C: 1677.9385755008},
+		{A: 2.7e-09, B: 2.56774718753, C: 643.0786800517},
+		{A: 2.61e-09, B: 1.55058302472, C: 1457.525933062},
+		{A: 2.46e-09, B: 2.29214585472, C: 867.4234757536},
+		{A: 2.69e-09, B: 3.18157515051, C: 750.1036075334},
+		{A: 2.72e-09, B: 1.12208982319, C: 1788.1448967202},
+		{A: 2.56e-09, B: 0.37673546414, C: 1279.794572628},
+		{A: 2.06e-09, B: 1.81129778306, C: 497.4476361802},
+		{A: 2.51e-09, B: 0.61933213502, C: 2413.8150890326},
+		{A: 2.37e-09, B: 3.35941544147, C: 436.8931316145},
+		{A: 2.47e-09, B: 0.10102936687, C: 99.9113804809},
+		{A: 2.47e-09, B: 0.93125798111, C: 52.6901980395},
+		{A: 2.21e-09, B: 2.07880035795, C: 824.7421937488},
+		{A: 1.97e-09, B: 6.16682223437, C: 1258.4539316256},
+		{A: 2.29e-09, B: 5.5791753484, C: 2943.5060541272},
+		{A: 2.27e-09, B: 0.43324651601, C: 2737.32050569},
+		{A: 2.03e-09, B: 4.12623986247, C: 337.732510659},
+		{A: 2.14e-09, B: 3.57607524509, C: 934.9485149682},
+		{A: 2.12e-09, B: 1.25688162158, C: 1773.9178027186},
+		{A: 2.15e-09, B: 0.8886764788, C: 1038.0412891868},
+		{A: 2.44e-09, B: 5.5157208457, C: 231.4583427027},
+		{A: 1.81e-09, B: 2.13821830481, C: 416.3032501375},
+		{A: 2.1e-09, B: 4.19139167658, C: 2221.856634597},
+		{A: 1.78e-09, B: 2.91685344537, C: 74.7815985673},
+		{A: 2.01e-09, B: 0.46214583002, C: 2854.6403739102},
+		{A: 2.36e-09, B: 4.64388694899, C: 1905.4647649404},
+		{A: 1.99e-09, B: 1.54991619669, C: 1471.7530270636},
+		{A: 1.99e-09, B: 0.70725247497, C: 2420.9286360334},
+		{A: 1.62e-09, B: 2.5148834502, C: 430.5303441391},
+		{A: 1.6e-09, B: 1.23508694599, C: 1596.1864422846},
+		{A: 1.75e-09, B: 4.14605894816, C: 2090.3096723752},
+		{A: 1.52e-09, B: 0.05796022559, C: 32.2433289144},
+		{A: 1.76e-09, B: 1.29002070623, C: 490.3340891794},
+		{A: 1.54e-09, B: 3.60622857548, C: 650.9429865779},
+		{A: 1.85e-09, B: 4.74969742128, C: 319.5732633943},
+		{A: 1.54e-09, B: 1.54587199996, C: 1464.6394800628},
+		{A: 1.08e-09, B: 4.251257865, C: 145.6310438715},
+		{A: 1.06e-09, B: 1.04047809351, C: 1162.4747044078},
+		{A: 1.14e-09, B: 2.640557371, C: 362.8622925726},
+		{A: 9.3e-10, B: 3.36746275886, C: 483.2205421786},
+		{A: 9.1e-10, B: 2.05796248692, C: 210.8514148832},
+		{A: 9.1e-10, B: 4.53336314765, C: 241.7532834412},
+		{A: 7.2e-10, B: 3.74361312157, C: 1485.9801210652},
+		{A: 7.6e-10, B: 3.33892447677, C: 195.8906076987},
+	},
+	// L4, 68 terms
+	{
+		{A: 1.661894e-05, B: 3.99826248978, C: 7.1135470008},
+		{A: 2.57107e-06, B: 2.98436499013, C: 220.4126424388},
+		{A: 2.36344e-06, B: 3.90241428075, C: 14.2270940016},
+		{A: 1.49418e-06, B: 2.74110824208, C: 213.299095438},
+		{A: 1.09598e-06, B: 1.51515739251, C: 206.1855484372},
+		{A: 1.13953e-06, B: 3.14159265359, C: 0},
+		{A: 6.839e-07, B: 1.72120953337, C: 426.598190876},
+		{A: 3.7699e-07, B: 1.23795458356, C: 199.0720014364},
+		{A: 4.006e-07, B: 2.04644897412, C: 433.7117378768},
+		{A: 3.1219e-07, B: 3.0109418409, C: 227.5261894396},
+		{A: 1.5111e-07, B: 0.82897064529, C: 639.897286314},
+		{A: 9.444e-08, B: 3.71485300868, C: 21.3406410024},
+		{A: 5.69e-08, B: 2.41995290633, C: 419.4846438752},
+		{A: 4.47e-08, B: 1.45120818748, C: 95.9792272178},
+		{A: 5.608e-08, B: 1.1560709574, C: 647.0108333148},
+		{A: 4.463e-08, B: 2.11783225176, C: 440.8252848776},
+		{A: 3.229e-08, B: 4.09278077834, C: 110.2063212194},
+		{A: 2.871e-08, B: 2.77203153866, C: 412.3710968744},
+		{A: 2.796e-08, B: 3.00730249564, C: 88.865680217},
+		{A: 2.638e-08, B: 0.00255721254, C: 853.196381752},
+		{A: 2.574e-08, B: 0.39246854091, C: 103.0927742186},
+		{A: 1.862e-08, B: 5.07955457727, C: 309.2783226558},
+		{A: 2.225e-08, B: 3.77689198137, C: 117.3198682202},
+		{A: 1.769e-08, B: 5.19176876406, C: 302.164775655},
+		{A: 1.921e-08, B: 2.82884328662, C: 234.6397364404},
+		{A: 1.805e-08, B: 2.23816036743, C: 216.4804891757},
+		{A: 1.211e-08, B: 1.54685246534, C: 191.9584544356},
+		{A: 7.65e-09, B: 3.44501766503, C: 323.5054166574},
+		{A: 7.63e-09, B: 4.83197222448, C: 210.1177017003},
+		{A: 6.13e-09, B: 4.19052656353, C: 515.463871093},
+		{A: 6.48e-09, B: 2.28591710303, C: 209.3669421749},
+		{A: 6.16e-09, B: 4.03194472161, C: 522.5774180938},
+		{A: 6.3e-09, B: 2.37952532019, C: 632.7837393132},
+		{A: 6.39e-09, B: 0.29772678242, C: 860.3099287528},
+		{A: 5.59e-09, B: 2.1711006053, C: 124.433415221},
+		{A: 4.42e-09, B: 2.23500083592, C: 447.9388318784},
+		{A: 4.07e-09, B: 5.4451597099, C: 1066.49547719},
+		{A: 4.69e-09, B: 1.26889429317, C: 654.1243803156},
+		{A: 4.88e-09, B: 3.20329778617, C: 405.2575498736},
+		{A: 4.15e-09, B: 3.12435410343, C: 330.6189636582},
+		{A: 4.42e-09, B: 3.38933498625, C: 81.7521332162},
+		{A: 3.32e-09, B: 4.12464206608, C: 838.9692877504},
+		{A: 3.2e-09, B: 3.18332026736, C: 529.6909650946},
+		{A: 3.12e-09, B: 1.40962796637, C: 429.7795846137},
+		{A: 2.91e-09, B: 3.18885372262, C: 1464.6394800628},
+		{A: 3.33e-09, B: 2.94355912397, C: 728.762966531},
+		{A: 2.35e-09, B: 3.67049647573, C: 1148.2476104062},
+		{A: 2.86e-09, B: 2.57895004576, C: 1045.1548361876},
+		{A: 2.23e-09, B: 3.57980034401, C: 1155.361157407},
+		{A: 2.61e-09, B: 2.04564143519, C: 1677.9385755008},
+		{A: 2.18e-09, B: 2.61967125327, C: 536.8045120954},
+		{A: 2.62e-09, B: 2.48322150677, C: 625.6701923124},
+		{A: 1.91e-09, B: 4.39064160974, C: 1574.8458012822},
+		{A: 1.76e-09, B: 1.26161895188, C: 422.6660376129},
+		{A: 1.9e-09, B: 2.326931712, C: 223.5940361765},
+		{A: 1.85e-09, B: 1.08713469614, C: 742.9900605326},
+		{A: 1.68e-09, B: 0.69946458053, C: 824.7421937488},
+		{A: 1.77e-09, B: 5.02663339078, C: 203.0041546995},
+		{A: 2.18e-09, B: 0.40426546037, C: 867.4234757536},
+		{A: 1.78e-09, B: 3.67593243311, C: 831.8557407496},
+		{A: 1.75e-09, B: 5.75326979098, C: 1073.6090241908},
+		{A: 1.56e-09, B: 3.02120117572, C: 1781.0313497194},
+		{A: 1.48e-09, B: 2.28313808274, C: 295.0512286542},
+		{A: 1.5e-09, B: 3.48436135302, C: 956.2891559706},
+		{A: 1.52e-09, B: 1.91404443241, C: 942.062061969},
+		{A: 1.46e-09, B: 6.1651969664, C: 316.3918696566},
+		{A: 9.6e-10, B: 2.93247663741, C: 224.3447957019},
+		{A: 8.8e-10, B: 4.48383632427, C: 423.4167971383},
+	},
+	// L5, 27 terms
+	{
+		{A: 1.23615e-06, B: 2.25923345732, C: 7.1135470008},
+		{A: 3.419e-07, B: 2.16250652689, C: 14.2270940016},
+		{A: 2.7546e-07, B: 1.19868150215, C: 220.4126424388},
+		{A: 5.818e-08, B: 1.21584270184, C: 227.5261894396},
+		{A: 5.318e-08, B: 0.23550400093, C: 433.7117378768},
+		{A: 3.677e-08, B: 6.22669694355, C: 426.598190876},
+		{A: 3.057e-08, B: 2.97372046322, C: 199.0720014364},
+		{A: 2.861e-08, B: 4.28710932685, C: 206.1855484372},
+		{A: 1.617e-08, B: 6.25265362286, C: 213.299095438},
+		{A: 1.279e-08, B: 5.27612561266, C: 639.897286314},
+		{A: 9.32e-09, B: 5.56741549127, C: 647.0108333148},
+		{A: 7.56e-09, B: 6.17716234487, C: 191.9584544356},
+		{A: 7.6e-09, B: 0.69475544472, C: 302.164775655},
+		{A: 1.038e-08, B: 0.23516951637, C: 440.8252848776},
+		{A: 1.007e-08, B: 3.14159265359, C: 0},
+		{A: 5.49e-09, B: 4.87733288264, C: 88.865680217},
+		{A: 5.04e-09, B: 4.77955496203, C: 419.4846438752},
+		{A: 3.46e-09, B: 4.31847547394, C: 853.196381752},
+		{A: 3.92e-09, B: 5.69922389094, C: 654.1243803156},
+		{A: 2.42e-09, B: 2.05052677361, C: 323.5054166574},
+		{A: 2.66e-09, B: 1.11384528244, C: 234.6397364404},
+		{A: 1.99e-09, B: 0.88505901097, C: 309.2783226558},
+		{A: 2.58e-09, B: 5.10074489186, C: 95.9792272178},
+		{A: 1.66e-09, B: 2.40063312194, C: 515.463871093},
+		{A: 1.55e-09, B: 4.70433216164, C: 860.3099287528},
+		{A: 8.9e-10, B: 1.3637107038, C: 412.3710968744},
+		{A: 1.02e-09, B: 0.49450039082, C: 117.3198682202},
+	},
+}
+
+// GetSaturnL0 evaluates the t^0 series of L.
+func GetSaturnL0(t float64) float64 {
+	return saturnL[0].Evaluate(t)
+}
+
+// GetSaturnL1 evaluates the t^1 series of L.
+func GetSaturnL1(t float64) float64 {
+	return saturnL[1].Evaluate(t)
+}
+
+// GetSaturnL2 evaluates the t^2 series of L.
+func GetSaturnL2(t float64) float64 {
+	return saturnL[2].Evaluate(t)
+}
+
+// GetSaturnL3 evaluates the t^3 series of L.
+func GetSaturnL3(t float64) float64 {
+	return saturnL[3].Evaluate(t)
+}
+
+// GetSaturnL4 evaluates the t^4 series of L.
+func GetSaturnL4(t float64) float64 {
+	return saturnL[4].Evaluate(t)
+}
+
+// GetSaturnL5 evaluates the t^5 series of L.
+func GetSaturnL5(t float64) float64 {
+	return saturnL[5].Evaluate(t)
+}
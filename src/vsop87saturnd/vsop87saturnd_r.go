@@ -0,0 +1,2494 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// SATURN R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87saturnd
+
+import "vsop87"
+
+var saturnR = []vsop87.Series{
+	// R0, 1205 terms
+	{
+		{A: 9.55758135801, B: 0, C: 0},
+		{A: 0.52921382465, B: 2.39226219733, C: 213.299095438},
+		{A: 0.01873679934, B: 5.23549605091, C: 206.1855484372},
+		{A: 0.01464663959, B: 1.64763045468, C: 426.598190876},
+		{A: 0.00821891059, B: 5.93520025371, C: 316.3918696566},
+		{A: 0.00547506899, B: 5.01532628454, C: 103.0927742186},
+		{A: 0.00371684449, B: 2.27114833428, C: 220.4126424388},
+		{A: 0.00361778433, B: 3.13904303264, C: 7.1135470008},
+		{A: 0.00140617548, B: 5.70406652991, C: 632.7837393132},
+		{A: 0.00108974737, B: 3.29313595577, C: 110.2063212194},
+		{A: 0.00069007015, B: 5.94099622447, C: 419.4846438752},
+		{A: 0.0006105335, B: 0.94037761156, C: 639.897286314},
+		{A: 0.00048913044, B: 1.55733388472, C: 202.2533951741},
+		{A: 0.00034143794, B: 0.19518550682, C: 277.0349937414},
+		{A: 0.00032401718, B: 5.47084606947, C: 949.1756089698},
+		{A: 0.00020936573, B: 0.46349163993, C: 735.8765135318},
+		{A: 0.00020839118, B: 1.5210259064, C: 433.7117378768},
+		{A: 0.00020746678, B: 5.33255667599, C: 199.0720014364},
+		{A: 0.00015298457, B: 3.05943652881, C: 529.6909650946},
+		{A: 0.00014296479, B: 2.60433537909, C: 323.5054166574},
+		{A: 0.00011993314, B: 5.98051421881, C: 846.0828347512},
+		{A: 0.00011380261, B: 1.73105746566, C: 522.5774180938},
+		{A: 0.00012884128, B: 1.64892310393, C: 138.5174968707},
+		{A: 7.752769e-05, B: 5.85191318903, C: 95.9792272178},
+		{A: 9.796061e-05, B: 5.20475863996, C: 1265.5674786264},
+		{A: 6.465967e-05, B: 0.17733160145, C: 1052.2683831884},
+		{A: 6.770621e-05, B: 3.00433479284, C: 14.2270940016},
+		{A: 5.850443e-05, B: 1.45519636076, C: 415.5524906121},
+		{A: 5.307481e-05, B: 0.5973753405, C: 63.7358983034},
+		{A: 4.695746e-05, B: 2.14919036956, C: 227.5261894396},
+		{A: 4.043988e-05, B: 1.64010323863, C: 209.3669421749},
+		{A: 3.688132e-05, B: 0.7801613317, C: 412.3710968744},
+		{A: 3.376457e-05, B: 3.69528478828, C: 224.3447957019},
+		{A: 2.885348e-05, B: 1.38764077631, C: 838.9692877504},
+		{A: 2.976033e-05, B: 5.68467931117, C: 210.1177017003},
+		{A: 3.419551e-05, B: 4.94549148887, C: 1581.959348283},
+		{A: 3.460943e-05, B: 1.85088802878, C: 175.1660598002},
+		{A: 3.400616e-05, B: 0.55386747515, C: 350.3321196004},
+		{A: 2.50763e-05, B: 3.53851863255, C: 742.9900605326},
+		{A: 2.448325e-05, B: 6.18412386316, C: 1368.660252845},
+		{A: 2.406138e-05, B: 2.96559220267, C: 117.3198682202},
+		{A: 2.881181e-05, B: 0.17960757891, C: 853.196381752},
+		{A: 2.173959e-05, B: 0.01508587396, C: 340.7708920448},
+		{A: 2.024483e-05, B: 5.05411271271, C: 11.0457002639},
+		{A: 1.740254e-05, B: 2.34657043464, C: 309.2783226558},
+		{A: 1.861397e-05, B: 5.93361638244, C: 625.6701923124},
+		{A: 1.888436e-05, B: 0.02968443389, C: 3.9321532631},
+		{A: 1.610859e-05, B: 1.17302463549, C: 74.7815985673},
+		{A: 1.462631e-05, B: 1.92588134017, C: 216.4804891757},
+		{A: 1.474547e-05, B: 5.6767046113, C: 203.7378678824},
+		{A: 1.395109e-05, B: 5.93669404929, C: 127.4717966068},
+		{A: 1.781165e-05, B: 0.76314388077, C: 217.2312487011},
+		{A: 1.817186e-05, B: 5.77713225779, C: 490.3340891794},
+		{A: 1.472392e-05, B: 1.40064915651, C: 137.0330241624},
+		{A: 1.304089e-05, B: 0.77235613966, C: 647.0108333148},
+		{A: 1.149773e-05, B: 5.74021249703, C: 1162.4747044078},
+		{A: 1.126667e-05, B: 4.46707803791, C: 265.9892934775},
+		{A: 1.277489e-05, B: 2.98412586423, C: 1059.3819301892},
+		{A: 1.207053e-05, B: 0.7528593316, C: 351.8165923087},
+		{A: 1.071399e-05, B: 1.13567265104, C: 1155.361157407},
+		{A: 1.020922e-05, B: 5.91233512844, C: 1685.0521225016},
+		{A: 1.315042e-05, B: 5.11202572637, C: 211.8146227297},
+		{A: 1.295553e-05, B: 4.69184139933, C: 1898.3512179396},
+		{A: 1.099037e-05, B: 1.81765118601, C: 149.5631971346},
+		{A: 9.98462e-06, B: 2.63131596867, C: 200.7689224658},
+		{A: 9.85869e-06, B: 2.25992849742, C: 956.2891559706},
+		{A: 9.32434e-06, B: 3.66980793184, C: 554.0699874828},
+		{A: 6.64481e-06, B: 0.60297724821, C: 728.762966531},
+		{A: 6.5985e-06, B: 4.66635439533, C: 195.1398481733},
+		{A: 6.1774e-06, B: 5.62092000007, C: 942.062061969},
+		{A: 6.26382e-06, B: 5.9420823259, C: 1478.8665740644},
+		{A: 4.8223e-06, B: 1.84070179496, C: 479.2883889155},
+		{A: 4.87689e-06, B: 2.79373616806, C: 3.1813937377},
+		{A: 4.70086e-06, B: 0.8384775504, C: 1471.7530270636},
+		{A: 4.51817e-06, B: 5.64468459871, C: 2001.4439921582},
+		{A: 5.53128e-06, B: 3.41088600844, C: 269.9214467406},
+		{A: 5.34397e-06, B: 1.26443331367, C: 275.5505210331},
+		{A: 4.72572e-06, B: 1.8819858466, C: 515.463871093},
+		{A: 4.05434e-06, B: 1.64001413521, C: 536.8045120954},
+		{A: 5.17196e-06, B: 4.44310450526, C: 2214.7430875962},
+		{A: 4.52848e-06, B: 3.00349117198, C: 302.164775655},
+		{A: 4.9434e-06, B: 2.28626675074, C: 278.5194664497},
+		{A: 4.89825e-06, B: 5.80631420383, C: 191.2076949102},
+		{A: 4.27459e-06, B: 0.05741344372, C: 284.1485407422},
+		{A: 3.39763e-06, B: 1.40198657693, C: 440.8252848776},
+		{A: 3.40627e-06, B: 0.89091104306, C: 628.8515860501},
+		{A: 3.85974e-06, B: 1.99700402508, C: 1272.6810256272},
+		{A: 2.88298e-06, B: 1.12160250272, C: 422.6660376129},
+		{A: 2.94444e-06, B: 0.42577061903, C: 312.1990839626},
+		{A: 2.6249e-06, B: 0.31753439818, C: 1045.1548361876},
+		{A: 2.95331e-06, B: 0.67144493789, C: 88.865680217},
+		{A: 3.42968e-06, B: 5.85600322299, C: 1795.258443721},
+		{A: 3.41117e-06, B: 2.3758524725, C: 525.4981794006},
+		{A: 2.34018e-06, B: 4.22756813216, C: 114.1384744825},
+		{A: 2.23729e-06, B: 2.28129446763, C: 330.6189636582},
+		{A: 2.75814e-06, B: 0.47832439352, C: 38.1330356378},
+		{A: 2.24592e-06, B: 0.54754005675, C: 1788.1448967202},
+		{A: 3.033e-06, B: 0.87946670205, C: 6069.7767545534},
+		{A: 2.92103e-06, B: 6.2142061192, C: 210.8514148832},
+		{A: 2.26121e-06, B: 0.37495223398, C: 142.4496501338},
+		{A: 2.77257e-06, B: 5.31917702012, C: 692.5874843535},
+		{A: 2.42911e-06, B: 5.37187983246, C: 1258.4539316256},
+		{A: 2.05571e-06, B: 0.95755250527, C: 288.0806940053},
+		{A: 2.07567e-06, B: 5.38126259725, C: 2317.8358618148},
+		{A: 1.86835e-06, B: 6.03591766061, C: 404.5067903482},
+		{A: 2.18536e-06, B: 5.25607043545, C: 212.3358875915},
+		{A: 2.22155e-06, B: 5.94588016768, C: 39.3568759152},
+		{A: 1.79673e-06, B: 4.41045924362, C: 408.4389436113},
+		{A: 2.4144e-06, B: 1.1252586811, C: 388.4651552382},
+		{A: 1.97093e-06, B: 3.9014194285, C: 52.6901980395},
+		{A: 2.36639e-06, B: 0.90802744873, C: 1375.7737998458},
+		{A: 1.71915e-06, B: 5.56318632797, C: 213.3472795478},
+		{A: 1.69865e-06, B: 2.8566755401, C: 99.1606209555},
+		{A: 2.14398e-06, B: 4.20253525974, C: 2531.1349572528},
+		{A: 1.7201e-06, B: 2.36537801012, C: 213.2509113282},
+		{A: 1.65707e-06, B: 2.63679789706, C: 215.7467759928},
+		{A: 2.30892e-06, B: 5.49463421262, C: 191.9584544356},
+		{A: 1.77585e-06, B: 0.38155817719, C: 430.5303441391},
+		{A: 1.91514e-06, B: 2.95906900704, C: 437.6438911399},
+		{A: 1.6325e-06, B: 3.4583251728, C: 617.8058857862},
+		{A: 1.62305e-06, B: 5.73050678664, C: 203.0041546995},
+		{A: 1.75108e-06, B: 5.71404465044, C: 1066.49547719},
+		{A: 1.83041e-06, B: 5.66851947172, C: 2111.6503133776},
+		{A: 1.50077e-06, B: 4.40663921925, C: 417.0369633204},
+		{A: 1.87935e-06, B: 6.07916265661, C: 563.6312150384},
+		{A: 1.45127e-06, B: 5.08176368814, C: 423.4167971383},
+		{A: 1.37491e-06, B: 5.43912787991, C: 222.8603229936},
+		{A: 1.72824e-06, B: 1.8492099409, C: 1589.0728952838},
+		{A: 1.65478e-06, B: 2.89132196119, C: 214.2623032845},
+		{A: 1.45727e-06, B: 1.56565192483, C: 831.8557407496},
+		{A: 1.76864e-06, B: 2.30323752987, C: 9999.986450773},
+		{A: 1.28877e-06, B: 2.55338644107, C: 414.0680179038},
+		{A: 1.20093e-06, B: 0.04329750542, C: 1361.5467058442},
+		{A: 1.43441e-06, B: 0.9981735772, C: 76.2660712756},
+		{A: 1.08747e-06, B: 2.09282278191, C: 207.6700211455},
+		{A: 1.32106e-06, B: 2.85902597898, C: 312.4597163935},
+		{A: 1.12238e-06, B: 0.26221759151, C: 2104.5367663768},
+		{A: 1.25186e-06, B: 4.78354048063, C: 205.2223405907},
+		{A: 1.04427e-06, B: 3.63671899047, C: 65.2203710117},
+		{A: 1.07447e-06, B: 3.67064138701, C: 212.7778305762},
+		{A: 1.08642e-06, B: 2.85492389024, C: 21.3406410024},
+		{A: 9.7743e-07, B: 5.12231845599, C: 2634.2277314714},
+		{A: 1.09097e-06, B: 1.63231061493, C: 208.633228992},
+		{A: 9.6852e-07, B: 4.19928280035, C: 305.3461693927},
+		{A: 9.6507e-07, B: 2.56002066845, C: 1692.1656695024},
+		{A: 8.5829e-07, B: 4.54545085982, C: 210.3783341312},
+		{A: 9.9249e-07, B: 5.13816222131, C: 1574.8458012822},
+		{A: 1.12532e-06, B: 5.03109281265, C: 703.6331846174},
+		{A: 8.4023e-07, B: 1.18337717265, C: 429.7795846137},
+		{A: 8.9021e-07, B: 5.38791571457, C: 107.0249274817},
+		{A: 1.10191e-06, B: 2.43656081234, C: 355.7487455718},
+		{A: 9.0659e-07, B: 4.20908809746, C: 213.8203602998},
+		{A: 9.5885e-07, B: 5.44594259071, C: 2428.0421830342},
+		{A: 9.4109e-07, B: 2.39786381418, C: 483.2205421786},
+		{A: 8.5609e-07, B: 0.03354346966, C: 860.3099287528},
+		{A: 8.8796e-07, B: 4.0576630675, C: 128.9562693151},
+		{A: 8.1951e-07, B: 1.66499731549, C: 62.2514255951},
+		{A: 9.124e-07, B: 3.96942332591, C: 2847.5268269094},
+		{A: 8.3961e-07, B: 4.60845858022, C: 177.8743727859},
+		{A: 8.8376e-07, B: 3.86800515885, C: 140.001969579},
+		{A: 9.3308e-07, B: 0.73846639887, C: 831.1049812242},
+		{A: 9.1872e-07, B: 2.9497760532, C: 35.4247226521},
+		{A: 8.7077e-07, B: 1.33390590052, C: 1905.4647649404},
+		{A: 9.6584e-07, B: 4.84438390997, C: 131.4039498699},
+		{A: 7.101e-07, B: 0.99334817658, C: 405.2575498736},
+		{A: 9.5266e-07, B: 2.51506908152, C: 2.4476805548},
+		{A: 7.2514e-07, B: 4.63213873657, C: 245.5424243524},
+		{A: 8.258e-07, B: 1.52823217919, C: 145.6310438715},
+		{A: 7.6693e-07, B: 3.15240783008, C: 767.3690829208},
+		{A: 7.0317e-07, B: 4.0425370727, C: 173.9422195228},
+		{A: 8.6015e-07, B: 2.3010372727, C: 85.8272988312},
+		{A: 6.6529e-07, B: 4.75053522835, C: 70.8494453042},
+		{A: 6.5835e-07, B: 2.46869725001, C: 280.9671470045},
+		{A: 6.4824e-07, B: 0.09343869325, C: 9.5612275556},
+		{A: 7.1557e-07, B: 0.01212415296, C: 565.1156877467},
+		{A: 6.6533e-07, B: 1.08034871114, C: 339.2864193365},
+		{A: 6.3488e-07, B: 2.01740971153, C: 234.6397364404},
+		{A: 6.0786e-07, B: 5.12026947473, C: 756.3233826569},
+		{A: 5.8123e-07, B: 6.05732868566, C: 1677.9385755008},
+		{A: 6.4236e-07, B: 1.28586474622, C: 1148.2476104062},
+		{A: 7.3124e-07, B: 4.37810889148, C: 425.1137181677},
+		{A: 5.5012e-07, B: 3.85865703217, C: 342.2553647531},
+		{A: 5.7101e-07, B: 6.26689214029, C: 2420.9286360334},
+		{A: 6.409e-07, B: 4.09854757476, C: 327.4375699205},
+		{A: 5.5306e-07, B: 1.60456896521, C: 543.0242872189},
+		{A: 5.7987e-07, B: 5.4726912434, C: 347.8844390456},
+		{A: 7.3581e-07, B: 3.72292337326, C: 92.0470739547},
+		{A: 7.376e-07, B: 3.57045342615, C: 1.4844727083},
+		{A: 6.494e-07, B: 2.44739629174, C: 267.4737661858},
+		{A: 5.4414e-07, B: 3.71479080197, C: 344.7030453079},
+		{A: 4.9783e-07, B: 3.93453970179, C: 192.6921676185},
+		{A: 4.9537e-07, B: 3.22831070579, C: 333.657345044},
+		{A: 4.7539e-07, B: 3.92925402178, C: 199.2844497575},
+		{A: 4.9368e-07, B: 4.90341763553, C: 217.491881132},
+		{A: 6.2711e-07, B: 4.40120079629, C: 214.7835681463},
+		{A: 4.6359e-07, B: 2.09430260266, C: 212.5483359126},
+		{A: 4.6289e-07, B: 2.6403845348, C: 10.2949407385},
+		{A: 5.4335e-07, B: 1.07179534996, C: 362.8622925726},
+		{A: 5.8742e-07, B: 2.62270940799, C: 225.8292684102},
+		{A: 4.8457e-07, B: 3.15166418511, C: 216.2198567448},
+		{A: 4.6316e-07, B: 4.8622664277, C: 2950.619601128},
+		{A: 4.597e-07, B: 4.97297391881, C: 198.321241911},
+		{A: 4.6678e-07, B: 2.44960215701, C: 207.1487562837},
+		{A: 4.4905e-07, B: 1.77616995803, C: 223.5940361765},
+		{A: 4.4521e-07, B: 5.55987055442, C: 264.5048207692},
+		{A: 5.5914e-07, B: 4.29520232351, C: 329.7251917809},
+		{A: 4.9643e-07, B: 5.20789299388, C: 2744.4340526908},
+		{A: 5.8829e-07, B: 4.23073947869, C: 700.6642392008},
+		{A: 5.2629e-07, B: 3.7923062907, C: 343.2185725996},
+		{A: 4.1532e-07, B: 0.74488808688, C: 125.9873238985},
+		{A: 4.7767e-07, B: 2.39260015876, C: 207.8824694666},
+		{A: 5.6157e-07, B: 2.07214273531, C: 124.433415221},
+		{A: 4.3345e-07, B: 1.83707598036, C: 106.2741679563},
+		{A: 3.9793e-07, B: 4.00870764324, C: 12.5301729722},
+		{A: 5.3882e-07, B: 4.97905460628, C: 134.5853436076},
+		{A: 5.0135e-07, B: 5.75914508514, C: 320.3240229197},
+		{A: 4.496e-07, B: 5.35721924134, C: 218.9281697305},
+		{A: 4.1089e-07, B: 4.92252591399, C: 1891.2376709388},
+		{A: 4.6509e-07, B: 2.06623129884, C: 2008.557539159},
+		{A: 4.2949e-07, B: 0.39856812529, C: 357.4456666012},
+		{A: 3.7992e-07, B: 2.06495914285, C: 247.2393453818},
+		{A: 4.8733e-07, B: 5.32762223699, C: 3127.3133312618},
+		{A: 3.4583e-07, B: 5.62555932761, C: 99.9113804809},
+		{A: 4.1092e-07, B: 2.4726489737, C: 237.6781178262},
+		{A: 4.0763e-07, B: 4.08408559215, C: 621.7380390493},
+		{A: 3.4213e-07, B: 0.73077393007, C: 750.1036075334},
+		{A: 3.3967e-07, B: 5.31264617621, C: 206.233732547},
+		{A: 3.6509e-07, B: 1.6882677575, C: 22.0914005278},
+		{A: 3.9361e-07, B: 3.4573071999, C: 241.6102710893},
+		{A: 3.4796e-07, B: 2.24780137629, C: 487.3651437628},
+		{A: 3.3049e-07, B: 4.86593901955, C: 209.106309744},
+		{A: 3.2584e-07, B: 2.22713131846, C: 319.5732633943},
+		{A: 3.9035e-07, B: 3.73870591196, C: 3163.918696566},
+		{A: 3.2722e-07, B: 1.06640549236, C: 252.6559713532},
+		{A: 3.8671e-07, B: 4.39617126814, C: 18.1592472647},
+		{A: 3.4514e-07, B: 1.8260750069, C: 380.12776796},
+		{A: 4.1539e-07, B: 0.08136234251, C: 210.3301500214},
+		{A: 3.3527e-07, B: 5.80475568528, C: 251.4321310758},
+		{A: 3.1221e-07, B: 1.96489151107, C: 244.318584075},
+		{A: 3.0521e-07, B: 2.26854188579, C: 1169.5882514086},
+		{A: 3.4828e-07, B: 5.96324553131, C: 217.964961884},
+		{A: 3.8481e-07, B: 4.43707551964, C: 160.6088973985},
+		{A: 3.5998e-07, B: 3.83262381556, C: 56.6223513026},
+		{A: 3.1041e-07, B: 4.89914223233, C: 144.1465711632},
+		{A: 3.2342e-07, B: 3.58191018804, C: 231.4583427027},
+		{A: 2.8838e-07, B: 5.80081031514, C: 1994.3304451574},
+		{A: 3.2175e-07, B: 2.13166877923, C: 206.1373643274},
+		{A: 3.2643e-07, B: 1.93131580544, C: 98.8999885246},
+		{A: 3.4917e-07, B: 5.65276617691, C: 497.4476361802},
+		{A: 2.8928e-07, B: 2.2165328892, C: 14.977853527},
+		{A: 3.1569e-07, B: 3.81846560564, C: 73.297125859},
+		{A: 3.2199e-07, B: 0.9981184629, C: 1464.6394800628},
+		{A: 2.9153e-07, B: 5.98414099408, C: 2737.32050569},
+		{A: 3.6706e-07, B: 4.75493516597, C: 348.8476468921},
+		{A: 2.8665e-07, B: 1.68732054583, C: 78.7137518304},
+		{A: 2.7501e-07, B: 6.12086395418, C: 214.0498549634},
+		{A: 2.8795e-07, B: 0.04448605904, C: 5.6290742925},
+		{A: 2.7205e-07, B: 0.24587543816, C: 313.2104759189},
+		{A: 3.2441e-07, B: 3.77921585847, C: 33.9402499438},
+		{A: 2.7088e-07, B: 5.2031009802, C: 148.0787244263},
+		{A: 3.4956e-07, B: 3.43886187587, C: 273.1028404783},
+		{A: 3.3076e-07, B: 2.44662095168, C: 969.6224780949},
+		{A: 2.7745e-07, B: 1.44598606685, C: 258.8757464767},
+		{A: 2.7178e-07, B: 4.2591859622, C: 179.3588454942},
+		{A: 2.7872e-07, B: 0.78772093522, C: 546.956440482},
+		{A: 2.9106e-07, B: 4.83947711462, C: 905.8865797915},
+		{A: 2.7417e-07, B: 2.44930366818, C: 254.9435932136},
+		{A: 3.4296e-07, B: 6.00920969644, C: 166.828672522},
+		{A: 2.8859e-07, B: 6.0291724991, C: 188.9200730498},
+		{A: 2.6001e-07, B: 0.65046992484, C: 654.1243803156},
+		{A: 3.356e-07, B: 1.23732329127, C: 2221.856634597},
+		{A: 2.4356e-07, B: 0.5224875133, C: 894.8408795276},
+		{A: 2.7767e-07, B: 5.17820678484, C: 5.4166259714},
+		{A: 2.5568e-07, B: 3.35897159622, C: 0.9632078465},
+		{A: 2.2879e-07, B: 3.5129348069, C: 458.8415197904},
+		{A: 2.4496e-07, B: 0.00976884124, C: 69.1525242748},
+		{A: 2.8794e-07, B: 0.75545700854, C: 488.8496164711},
+		{A: 3.1228e-07, B: 2.05299907796, C: 282.4516197128},
+		{A: 2.5438e-07, B: 5.2903772925, C: 636.7158925763},
+		{A: 2.5332e-07, B: 4.9700796945, C: 3060.8259223474},
+		{A: 2.3596e-07, B: 2.54766434769, C: 196.6243208816},
+		{A: 2.9602e-07, B: 3.92688207792, C: 206.706813299},
+		{A: 2.8255e-07, B: 2.72125009693, C: 32.2433289144},
+		{A: 2.2115e-07, B: 4.75775237642, C: 213.1872208534},
+		{A: 2.213e-07, B: 3.25436709191, C: 681.5417840896},
+		{A: 2.1675e-07, B: 4.61403328597, C: 3267.0114707846},
+		{A: 2.2115e-07, B: 3.16759500067, C: 213.4109700226},
+		{A: 2.6912e-07, B: 2.86269769133, C: 24.3790223882},
+		{A: 2.0737e-07, B: 1.66895754198, C: 274.0660483248},
+		{A: 2.8309e-07, B: 4.73122154345, C: 552.5855147745},
+		{A: 2.5252e-07, B: 5.11986371899, C: 168.0525127994},
+		{A: 2.6364e-07, B: 1.59272536419, C: 491.8185618877},
+		{A: 2.1995e-07, B: 0.8807900928, C: 635.9651330509},
+		{A: 2.7076e-07, B: 5.53694832022, C: 555.5544601911},
+		{A: 1.9683e-07, B: 2.14388519695, C: 54.1746707478},
+		{A: 2.7266e-07, B: 3.57891326986, C: 561.1835344836},
+		{A: 2.5162e-07, B: 1.78070903718, C: 182.279606801},
+		{A: 2.1386e-07, B: 3.86030772476, C: 116.4260963429},
+		{A: 2.5572e-07, B: 1.62093861709, C: 2324.9494088156},
+		{A: 2.0025e-07, B: 2.90618582553, C: 120.358249606},
+		{A: 1.9882e-07, B: 5.59203696008, C: 4.192785694},
+		{A: 1.9454e-07, B: 0.10623632006, C: 218.7157214094},
+		{A: 2.5617e-07, B: 2.09931460158, C: 248.7238180901},
+		{A: 1.9804e-07, B: 2.52180124343, C: 1485.9801210652},
+		{A: 1.8516e-07, B: 2.54810951896, C: 213.5115437591},
+		{A: 1.9831e-07, B: 0.07955320843, C: 842.1506814881},
+		{A: 1.8516e-07, B: 5.3775511051, C: 213.0866471169},
+		{A: 2.3655e-07, B: 1.59974907716, C: 738.7972748386},
+		{A: 2.0375e-07, B: 2.94653107321, C: 59.8037450403},
+		{A: 2.4247e-07, B: 3.15387696867, C: 240.3864308119},
+		{A: 1.8294e-07, B: 3.18715992969, C: 295.0512286542},
+		{A: 1.7464e-07, B: 2.90471803626, C: 477.8039162072},
+		{A: 2.0698e-07, B: 1.07232100334, C: 494.2662424425},
+		{A: 2.04e-07, B: 1.83665590916, C: 533.6231183577},
+		{A: 2.1285e-07, B: 0.63341794388, C: 189.7232222019},
+		{A: 1.6116e-07, B: 0.60069688498, C: 746.9222137957},
+		{A: 1.6297e-07, B: 3.98317294128, C: 2.9207613068},
+		{A: 1.6922e-07, B: 4.74266972033, C: 2207.6295405954},
+		{A: 2.0479e-07, B: 6.05098286202, C: 173.6815870919},
+		{A: 1.5447e-07, B: 1.49120311247, C: 543.9180590962},
+		{A: 1.9944e-07, B: 4.9408663275, C: 121.2520214833},
+		{A: 1.7127e-07, B: 0.71458025372, C: 1781.0313497194},
+		{A: 1.724e-07, B: 0.67749766724, C: 151.0476698429},
+		{A: 1.5574e-07, B: 5.70296527381, C: 3053.7123753466},
+		{A: 1.5036e-07, B: 5.52770334605, C: 2310.722314814},
+		{A: 1.5928e-07, B: 4.45642717299, C: 643.8294395771},
+		{A: 1.6165e-07, B: 0.63286131026, C: 358.9301393095},
+		{A: 1.4589e-07, B: 5.26158292613, C: 472.1748419147},
+		{A: 1.6545e-07, B: 3.52813228069, C: 3480.3105662226},
+		{A: 1.8912e-07, B: 0.55218675639, C: 4.665866446},
+		{A: 1.7595e-07, B: 2.26495491189, C: 672.1406152284},
+		{A: 1.8104e-07, B: 2.71285673689, C: 181.806526049},
+		{A: 1.5918e-07, B: 5.23446779429, C: 135.5485514541},
+		{A: 1.3931e-07, B: 3.19357128657, C: 213.5597278689},
+		{A: 1.4058e-07, B: 0.82375896652, C: 221.3758502853},
+		{A: 1.3931e-07, B: 4.73208739639, C: 213.0384630071},
+		{A: 1.469e-07, B: 2.65882838685, C: 292.0128472684},
+		{A: 1.4454e-07, B: 0.21819892811, C: 235.3904959658},
+		{A: 1.6168e-07, B: 0.91025406068, C: 280.003939158},
+		{A: 1.3327e-07, B: 3.54947442109, C: 205.6642835754},
+		{A: 1.6104e-07, B: 0.82547975762, C: 176.6505325085},
+		{A: 1.6441e-07, B: 5.39398801335, C: 424.1505103212},
+		{A: 1.2747e-07, B: 0.75780958758, C: 721.6494195302},
+		{A: 1.2754e-07, B: 3.55466871752, C: 153.4953503977},
+		{A: 1.4448e-07, B: 0.12049617049, C: 313.6835566709},
+		{A: 1.6499e-07, B: 3.26383140489, C: 6283.0758499914},
+		{A: 1.6564e-07, B: 1.62649604519, C: 5856.4776591154},
+		{A: 1.495e-07, B: 1.23923264394, C: 2641.3412784722},
+		{A: 1.5724e-07, B: 1.18874754834, C: 486.4019359163},
+		{A: 1.1893e-07, B: 0.91693668558, C: 416.3032501375},
+		{A: 1.1684e-07, B: 1.11385455828, C: 81.7521332162},
+		{A: 1.2985e-07, B: 4.74373293725, C: 3377.217792004},
+		{A: 1.1864e-07, B: 0.64411806416, C: 28.3111756513},
+		{A: 1.3216e-07, B: 4.9590402443, C: 1279.794572628},
+		{A: 1.6121e-07, B: 0.98185208328, C: 2538.2485042536},
+		{A: 1.49e-07, B: 1.76649832526, C: 569.0478410098},
+		{A: 1.1337e-07, B: 4.36555105334, C: 3583.4033404412},
+		{A: 1.1253e-07, B: 5.98638731448, C: 193.655375465},
+		{A: 1.4753e-07, B: 2.92291248767, C: 167.0893049529},
+		{A: 1.3774e-07, B: 2.50808183571, C: 1802.3719907218},
+		{A: 1.1068e-07, B: 0.00471764868, C: 629.6023455755},
+		{A: 1.2781e-07, B: 3.62178749219, C: 67.6680515665},
+		{A: 1.2238e-07, B: 0.27163151602, C: 1044.4040766622},
+		{A: 1.1021e-07, B: 0.15223056578, C: 501.3797894433},
+		{A: 1.4206e-07, B: 2.63254885854, C: 618.5566453116},
+		{A: 1.4365e-07, B: 0.37819794671, C: 601.7642506762},
+		{A: 1.5034e-07, B: 2.67095006272, C: 46.470422916},
+		{A: 1.2248e-07, B: 2.19751851112, C: 650.9429865779},
+		{A: 1.0783e-07, B: 2.86375137884, C: 113.3877149571},
+		{A: 1.1418e-07, B: 1.20874560246, C: 172.2452984934},
+		{A: 1.4613e-07, B: 6.05645353059, C: 468.2426886516},
+		{A: 1.058e-07, B: 2.05903854864, C: 429.0458714308},
+		{A: 1.3721e-07, B: 2.20936291526, C: 228.276948965},
+		{A: 1.218e-07, B: 1.82585577726, C: 241.8709035202},
+		{A: 1.0787e-07, B: 5.06924118186, C: 162.8965192589},
+		{A: 1.2056e-07, B: 3.20018724042, C: 72.0732855816},
+		{A: 1.2233e-07, B: 4.5074193097, C: 425.6349830295},
+		{A: 1.2101e-07, B: 4.14977794161, C: 1108.1399749656},
+		{A: 9.843e-08, B: 1.49451039604, C: 226.6324175623},
+		{A: 1.0287e-07, B: 2.10680007784, C: 1033.3583763983},
+		{A: 9.975e-08, B: 2.81640446254, C: 518.6452648307},
+		{A: 9.597e-08, B: 4.80028087522, C: 426.6463749858},
+		{A: 1.0746e-07, B: 4.66838299108, C: 129.9194771616},
+		{A: 1.2961e-07, B: 5.11568581806, C: 219.4494345923},
+		{A: 1.2302e-07, B: 5.335685477, C: 776.9303104764},
+		{A: 9.484e-08, B: 4.85702954575, C: 820.0592809603},
+		{A: 1.1441e-07, B: 3.85769732764, C: 405.9912630565},
+		{A: 9.625e-08, B: 1.60280478656, C: 426.5500067662},
+		{A: 9.164e-08, B: 0.7020456798, C: 403.0223176399},
+		{A: 1.0112e-07, B: 2.7648687563, C: 210.5907824523},
+		{A: 1.0816e-07, B: 1.36864298163, C: 170.7608257851},
+		{A: 1.0187e-07, B: 2.36063948382, C: 685.4739373527},
+		{A: 1.2397e-07, B: 6.06349943525, C: 875.830299001},
+		{A: 1.2146e-07, B: 2.04060386262, C: 508.3503240922},
+		{A: 9.574e-08, B: 3.19555214859, C: 286.596221297},
+		{A: 1.0193e-07, B: 4.01123146905, C: 381.3516082374},
+		{A: 8.9e-08, B: 3.6326023588, C: 319.3126309634},
+		{A: 1.0052e-07, B: 5.1610725104, C: 216.0074084237},
+		{A: 8.528e-08, B: 3.88076551354, C: 630.3360587584},
+		{A: 8.875e-08, B: 5.46623776078, C: 3370.1042450032},
+		{A: 8.401e-08, B: 5.65557131026, C: 213.4591541324},
+		{A: 1.0033e-07, B: 5.97497644283, C: 6.1503391543},
+		{A: 8.401e-08, B: 2.27009862215, C: 213.1390367436},
+		{A: 1.1661e-07, B: 0.95163302252, C: 694.0719570618},
+		{A: 8.696e-08, B: 2.33868966556, C: 220.364458329},
+		{A: 8.141e-08, B: 5.5405974715, C: 220.4608265486},
+		{A: 9.615e-08, B: 2.75755414306, C: 556.5176680376},
+		{A: 9.12e-08, B: 0.44322374149, C: 2097.423219376},
+		{A: 8.109e-08, B: 5.53989498262, C: 181.0557665236},
+		{A: 1.0763e-07, B: 0.05616402982, C: 691.1030116452},
+		{A: 9.579e-08, B: 2.84979792871, C: 184.0941479094},
+		{A: 9.958e-08, B: 2.38581008546, C: 945.2434557067},
+		{A: 8.526e-08, B: 0.17821781104, C: 289.5651667136},
+		{A: 7.7e-08, B: 0.0048137541, C: 7.1617311106},
+		{A: 8.613e-08, B: 0.82900327241, C: 2957.7331481288},
+		{A: 9.517e-08, B: 2.27516458273, C: 8.0767548473},
+		{A: 9.049e-08, B: 3.3733502579, C: 731.9443602687},
+		{A: 7.888e-08, B: 5.78452089815, C: 230.8252032563},
+		{A: 7.684e-08, B: 3.10462250617, C: 7.065362891},
+		{A: 7.391e-08, B: 5.29648701813, C: 2627.1141844706},
+		{A: 9.875e-08, B: 4.69411059509, C: 10213.285546211},
+		{A: 7.328e-08, B: 0.09051133382, C: 100.6450936638},
+		{A: 7.999e-08, B: 1.60928374337, C: 696.5196376166},
+		{A: 7.6e-08, B: 4.90078510977, C: 51.2057253312},
+		{A: 9.928e-08, B: 5.25713005643, C: 699.7010313543},
+		{A: 7.333e-08, B: 5.61982406824, C: 31.492569389},
+		{A: 7.376e-08, B: 4.52737009022, C: 616.3214130779},
+		{A: 9.422e-08, B: 2.44475274779, C: 2118.7638603784},
+		{A: 7.3e-08, B: 4.0188547501, C: 212.0270710508},
+		{A: 7.502e-08, B: 4.71301501745, C: 436.1594184316},
+		{A: 9.071e-08, B: 2.75662160229, C: 130.4407420234},
+		{A: 8.913e-08, B: 2.19608557019, C: 427.5613987225},
+		{A: 8.801e-08, B: 4.26655882704, C: 141.2258098564},
+		{A: 6.853e-08, B: 5.69082635009, C: 480.7728616238},
+		{A: 7.765e-08, B: 3.27218537808, C: 3796.7024358792},
+		{A: 9.159e-08, B: 3.0471367165, C: 9786.687355335},
+		{A: 9.034e-08, B: 2.04165937353, C: 204.7010757289},
+		{A: 6.902e-08, B: 4.61962635489, C: 2524.021410252},
+		{A: 6.728e-08, B: 0.58794595002, C: 739.8086667949},
+		{A: 6.741e-08, B: 0.52362906624, C: 135.336103133},
+		{A: 8.201e-08, B: 5.03994203224, C: 411.620337349},
+		{A: 7.24e-08, B: 3.90653111215, C: 214.5711198252},
+		{A: 6.887e-08, B: 4.11954799957, C: 662.531203563},
+		{A: 6.566e-08, B: 2.67659365854, C: 194.1766403268},
+		{A: 6.539e-08, B: 6.25585361704, C: 31.019488637},
+		{A: 7.484e-08, B: 5.56871021201, C: 271.4059194489},
+		{A: 8.078e-08, B: 3.0995581756, C: 353.301065017},
+		{A: 7.072e-08, B: 1.10066698352, C: 282.6640680339},
+		{A: 6.089e-08, B: 0.79684364835, C: 593.426863398},
+		{A: 6.14e-08, B: 3.79672343724, C: 180.1619946463},
+		{A: 6.703e-08, B: 3.8285424862, C: 412.5835451955},
+		{A: 6.024e-08, B: 5.46288776207, C: 724.8308132679},
+		{A: 8.102e-08, B: 4.51051495778, C: 268.4369740323},
+		{A: 6.033e-08, B: 1.24326252021, C: 447.9388318784},
+		{A: 7.425e-08, B: 2.29394888999, C: 532.6117264014},
+		{A: 6.007e-08, B: 2.87215425398, C: 426.0769260142},
+		{A: 7.568e-08, B: 0.79147591036, C: 2854.6403739102},
+		{A: 5.816e-08, B: 1.70824982811, C: 50.4025761791},
+		{A: 7.534e-08, B: 5.3859829268, C: 953.1077622329},
+		{A: 5.863e-08, B: 2.04201456623, C: 454.9093665273},
+		{A: 7.291e-08, B: 0.88044346877, C: 457.617679513},
+		{A: 6.235e-08, B: 4.51960341418, C: 3693.6096616606},
+		{A: 6.359e-08, B: 6.27053660532, C: 313.9441891018},
+		{A: 5.722e-08, B: 0.47241118592, C: 610.6923387854},
+		{A: 5.704e-08, B: 0.45599464136, C: 643.0786800517},
+		{A: 6.176e-08, B: 3.98739420856, C: 835.0371344873},
+		{A: 5.73e-08, B: 0.5081424249, C: 1038.0412891868},
+		{A: 6.812e-08, B: 4.2046338569, C: 938.1299087059},
+		{A: 5.62e-08, B: 4.08049141112, C: 3899.7952100978},
+		{A: 6.77e-08, B: 4.22172125738, C: 916.9322800554},
+		{A: 6.069e-08, B: 3.46762401122, C: 278.2588340188},
+		{A: 5.875e-08, B: 5.51773010551, C: 1073.6090241908},
+		{A: 5.558e-08, B: 3.29478679376, C: 20.6069278195},
+		{A: 6.274e-08, B: 4.88767368263, C: 0.5212648618},
+		{A: 5.794e-08, B: 4.09991767938, C: 391.1734682239},
+		{A: 5.442e-08, B: 2.79802608247, C: 397.3932433474},
+		{A: 5.754e-08, B: 4.42718264879, C: 165.6048322446},
+		{A: 5.879e-08, B: 6.17871525366, C: 291.262087743},
+		{A: 6.716e-08, B: 2.1866384773, C: 627.3671133418},
+		{A: 5.761e-08, B: 0.62536160332, C: 114.3991069134},
+		{A: 5.359e-08, B: 2.29390692216, C: 331.2096644892},
+		{A: 6.21e-08, B: 4.92273259045, C: 450.9772132642},
+		{A: 6.686e-08, B: 2.13438181268, C: 285.6330134505},
+		{A: 5.173e-08, B: 4.10128119721, C: 6.592282139},
+		{A: 5.707e-08, B: 3.48716972669, C: 230.5645708254},
+		{A: 6.363e-08, B: 5.64626069194, C: 518.3846323998},
+		{A: 5.241e-08, B: 3.78081098206, C: 418.5214360287},
+		{A: 5.191e-08, B: 4.39595146262, C: 84.3428261229},
+		{A: 6.71e-08, B: 2.96748528229, C: 624.919432787},
+		{A: 4.931e-08, B: 2.71959451867, C: 558.0021407459},
+		{A: 5.225e-08, B: 4.65463431385, C: 310.7146112543},
+		{A: 4.857e-08, B: 2.61373582429, C: 66.70484372},
+		{A: 4.847e-08, B: 3.76991373317, C: 423.6774295692},
+		{A: 5.284e-08, B: 1.96024672163, C: 1182.9215735329},
+		{A: 5.933e-08, B: 2.74003948393, C: 219.891377577},
+		{A: 6.373e-08, B: 1.4129634646, C: 606.7601855223},
+		{A: 4.728e-08, B: 0.23421038001, C: 1063.3140834523},
+		{A: 6.408e-08, B: 1.1668741968, C: 268.9582388941},
+		{A: 4.782e-08, B: 1.56813683227, C: 420.9691165835},
+		{A: 5.399e-08, B: 4.57611449409, C: 238.9019581036},
+		{A: 5.161e-08, B: 0.14436456585, C: 2413.8150890326},
+		{A: 4.858e-08, B: 5.21378840436, C: 3686.4961146598},
+		{A: 5.086e-08, B: 1.73392381835, C: 337.732510659},
+		{A: 4.65e-08, B: 3.7102912129, C: 305.0855369618},
+		{A: 4.896e-08, B: 3.6778653184, C: 240.125798381},
+		{A: 5.949e-08, B: 0.29956165181, C: 524.0137066923},
+		{A: 4.968e-08, B: 2.53258931342, C: 980.6681783588},
+		{A: 4.944e-08, B: 2.16189522746, C: 104.0559820651},
+		{A: 5.366e-08, B: 3.54867806985, C: 107.2855599126},
+		{A: 4.917e-08, B: 0.48641512683, C: 3274.1250177854},
+		{A: 5.617e-08, B: 6.27593478237, C: 112.6540017742},
+		{A: 4.524e-08, B: 5.09539085552, C: 103.1409583284},
+		{A: 5.643e-08, B: 1.5272433648, C: 105.5404547734},
+		{A: 4.504e-08, B: 1.68251875362, C: 196.0336200506},
+		{A: 4.897e-08, B: 4.90011892854, C: 102.1295663721},
+		{A: 4.525e-08, B: 1.88735156553, C: 103.0445901088},
+		{A: 4.327e-08, B: 1.4540722938, C: 409.9234163196},
+		{A: 5.095e-08, B: 3.40640608336, C: 427.1194557378},
+		{A: 5.782e-08, B: 3.55197606731, C: 25874.6040461362},
+		{A: 4.192e-08, B: 0.16603430914, C: 958.576777831},
+		{A: 4.976e-08, B: 0.50639895683, C: 511.5317178299},
+		{A: 4.167e-08, B: 5.9472576207, C: 316.4400537664},
+		{A: 4.353e-08, B: 3.78587101731, C: 1171.875873269},
+		{A: 5.387e-08, B: 2.03693287651, C: 2435.155730035},
+		{A: 4.067e-08, B: 4.6659260313, C: 106.0135355254},
+		{A: 4.817e-08, B: 3.53529781673, C: 960.2213092337},
+		{A: 4.048e-08, B: 3.20024146722, C: 775.233389447},
+		{A: 4.016e-08, B: 6.00569143107, C: 945.9942152321},
+		{A: 3.989e-08, B: 3.15130319196, C: 115.6229471908},
+		{A: 4.559e-08, B: 5.59555355771, C: 778.4147831847},
+		{A: 4.153e-08, B: 2.75042736587, C: 316.3436855468},
+		{A: 3.983e-08, B: 2.00842137744, C: 597.3590166611},
+		{A: 4.212e-08, B: 4.16852690218, C: 823.9914342234},
+		{A: 5.193e-08, B: 0.71717111984, C: 810.6581120991},
+		{A: 3.927e-08, B: 5.04361736754, C: 2943.5060541272},
+		{A: 4.225e-08, B: 0.02571003853, C: 0.7507595254},
+		{A: 4.926e-08, B: 1.12994881124, C: 526.9826521089},
+		{A: 4.17e-08, B: 3.94116290117, C: 422.405405182},
+		{A: 4.432e-08, B: 3.995990468, C: 393.4610900843},
+		{A: 3.84e-08, B: 1.21234108241, C: 212.0752551606},
+		{A: 3.866e-08, B: 4.20930793423, C: 97.6761482472},
+		{A: 4.44e-08, B: 1.35536679738, C: 211.6021744086},
+		{A: 3.84e-08, B: 0.43014354282, C: 214.5229357154},
+		{A: 4.724e-08, B: 3.62039208608, C: 638.4128136057},
+		{A: 4.87e-08, B: 5.7587459962, C: 1246.6574718363},
+		{A: 4.449e-08, B: 1.43384065964, C: 184.9879197867},
+		{A: 3.931e-08, B: 2.36660272585, C: 909.8187330546},
+		{A: 3.787e-08, B: 5.98932416906, C: 325.9530972122},
+		{A: 3.665e-08, B: 0.72917314141, C: 20.4468691251},
+		{A: 4.243e-08, B: 2.29103096797, C: 453.424893819},
+		{A: 3.73e-08, B: 6.24831601183, C: 159.1244246902},
+		{A: 3.9e-08, B: 1.31013240315, C: 850.0149880143},
+		{A: 5.134e-08, B: 1.97348901289, C: 526.5095713569},
+		{A: 3.621e-08, B: 2.77435773661, C: 123.5396433437},
+		{A: 3.607e-08, B: 5.3205884271, C: 406.954470903},
+		{A: 3.802e-08, B: 1.94444523548, C: 421.1815649046},
+		{A: 3.906e-08, B: 3.02475451573, C: 317.3550775031},
+		{A: 4.473e-08, B: 0.80804073855, C: 838.218528225},
+		{A: 4.081e-08, B: 2.0873246818, C: 988.532484885},
+		{A: 3.843e-08, B: 4.99347148246, C: 7.6348118626},
+		{A: 4.467e-08, B: 6.09037793116, C: 760.25553592},
+		{A: 3.514e-08, B: 3.97285766412, C: 426.4863162914},
+		{A: 3.504e-08, B: 0.85064201666, C: 299.1263942692},
+		{A: 4.397e-08, B: 1.68577228317, C: 824.7421937488},
+		{A: 3.581e-08, B: 2.35235960566, C: 337.8019466282},
+		{A: 4.606e-08, B: 3.48411642192, C: 913.9633346388},
+		{A: 3.79e-08, B: 3.64538213705, C: 216.2680408546},
+		{A: 3.496e-08, B: 0.95035381131, C: 436.8931316145},
+		{A: 4.422e-08, B: 0.82822191292, C: 43.2890291783},
+		{A: 3.688e-08, B: 2.837854438, C: 739.0579072695},
+		{A: 4.439e-08, B: 1.19409419107, C: 421.93232443},
+		{A: 3.572e-08, B: 2.77298538478, C: 444.7574381407},
+		{A: 4.42e-08, B: 5.44308967028, C: 963.4027029714},
+		{A: 4.443e-08, B: 3.73070830296, C: 37.8724032069},
+		{A: 4.322e-08, B: 4.75680702521, C: 40.8413486235},
+		{A: 3.724e-08, B: 0.59005210557, C: 256.4280659219},
+		{A: 4.471e-08, B: 2.22367643527, C: 318.8395502114},
+		{A: 4.184e-08, B: 1.5271919664, C: 298.2326223919},
+		{A: 3.534e-08, B: 5.0193759957, C: 386.9806825299},
+		{A: 3.4e-08, B: 3.22663067085, C: 4113.0943055358},
+		{A: 4.556e-08, B: 1.35715974815, C: 495.7507151508},
+		{A: 4.453e-08, B: 1.80417064247, C: 829.6205085159},
+		{A: 3.617e-08, B: 1.51036385224, C: 41.6444977756},
+		{A: 3.789e-08, B: 4.80357656146, C: 238.4288773516},
+		{A: 3.514e-08, B: 2.38272766645, C: 426.7100654606},
+		{A: 3.208e-08, B: 1.74465274123, C: 952.3570027075},
+		{A: 4.398e-08, B: 2.65839000906, C: 832.5894539325},
+		{A: 4.092e-08, B: 3.07954777295, C: 60.7669528868},
+		{A: 3.813e-08, B: 5.63047104819, C: 315.4286618101},
+		{A: 3.219e-08, B: 6.22278803635, C: 754.8389099486},
+		{A: 3.102e-08, B: 2.69222024257, C: 343.7398374614},
+		{A: 4.045e-08, B: 4.024637721, C: 376.1956146969},
+		{A: 3.576e-08, B: 0.3853278728, C: 214.9960164674},
+		{A: 3.291e-08, B: 5.49542015261, C: 143.9341228421},
+		{A: 3.981e-08, B: 5.75449411958, C: 239.1625905345},
+		{A: 3.249e-08, B: 0.58789568678, C: 619.2903584945},
+		{A: 3.114e-08, B: 0.02831060137, C: 221.1634019642},
+		{A: 3.555e-08, B: 3.12207684735, C: 1048.3362299253},
+		{A: 3.01e-08, B: 1.91180343491, C: 93.531546663},
+		{A: 3.384e-08, B: 1.81702854004, C: 443.8636662634},
+		{A: 3.222e-08, B: 2.37342117781, C: 429.5189521828},
+		{A: 3.431e-08, B: 5.92099840679, C: 570.7447620392},
+		{A: 3.271e-08, B: 2.04947945059, C: 806.725958836},
+		{A: 3.207e-08, B: 5.44018976766, C: 402.2191684878},
+		{A: 3.091e-08, B: 0.10717557454, C: 3590.516887442},
+		{A: 2.951e-08, B: 1.76365810296, C: 426.8106391971},
+		{A: 2.958e-08, B: 0.23653889192, C: 1354.4331588434},
+		{A: 2.948e-08, B: 4.59289832104, C: 426.3857425549},
+		{A: 3.506e-08, B: 5.43222584214, C: 84.9335269539},
+		{A: 2.894e-08, B: 5.69678330542, C: 1262.3860848887},
+		{A: 3.682e-08, B: 1.07122313007, C: 395.578702239},
+		{A: 2.983e-08, B: 5.25093816048, C: 313.4711083498},
+		{A: 3.117e-08, B: 4.18767239237, C: 366.7944458357},
+		{A: 2.873e-08, B: 4.45472312727, C: 361.3778198643},
+		{A: 3.521e-08, B: 2.05528981993, C: 1261.6353253633},
+		{A: 3.496e-08, B: 1.87950759078, C: 439.1283638482},
+		{A: 3.012e-08, B: 0.64439385874, C: 263.0203480609},
+		{A: 2.849e-08, B: 1.12491777974, C: 262.0571402144},
+		{A: 2.91e-08, B: 2.76192171681, C: 541.5398145106},
+		{A: 3.322e-08, B: 6.08893948791, C: 108.7218485111},
+		{A: 3.181e-08, B: 1.01419299056, C: 418.0001711669},
+		{A: 2.793e-08, B: 0.38781777981, C: 211.8628068395},
+		{A: 3.091e-08, B: 2.18216748751, C: 306.830642101},
+		{A: 3.748e-08, B: 3.89145855821, C: 220.9339073006},
+		{A: 2.982e-08, B: 1.99831689446, C: 117.9105690512},
+		{A: 2.793e-08, B: 1.25466684542, C: 214.7353840365},
+		{A: 3.512e-08, B: 1.50965040301, C: 885.4397106664},
+		{A: 2.716e-08, B: 0.80710391613, C: 757.2171545342},
+		{A: 3.137e-08, B: 2.09889265033, C: 2751.5475996916},
+		{A: 2.738e-08, B: 4.89270330923, C: 464.7312265138},
+		{A: 2.875e-08, B: 4.28436709414, C: 4010.0015313172},
+		{A: 3.313e-08, B: 3.01452486457, C: 336.8387387817},
+		{A: 2.746e-08, B: 2.69963506928, C: 380.3884003909},
+		{A: 3.132e-08, B: 2.19562786872, C: 2.9689454166},
+		{A: 3.233e-08, B: 0.60809684558, C: 3171.0322435668},
+		{A: 3.034e-08, B: 0.93246285284, C: 205.4347889118},
+		{A: 3.006e-08, B: 5.91067479448, C: 2.7083129857},
+		{A: 3.643e-08, B: 5.58302397259, C: 423.6292454594},
+		{A: 2.625e-08, B: 1.07042050691, C: 23.5758732361},
+		{A: 3.495e-08, B: 0.1988756203, C: 576.1613880106},
+		{A: 2.59e-08, B: 0.2125277375, C: 110.2545053292},
+		{A: 2.704e-08, B: 6.12908233599, C: 572.2292347475},
+		{A: 2.568e-08, B: 0.17571588314, C: 1056.2005364515},
+		{A: 2.583e-08, B: 2.96927378731, C: 384.0599212231},
+		{A: 2.555e-08, B: 3.96441052072, C: 430.79097657},
+		{A: 2.786e-08, B: 2.54945911818, C: 195.8906076987},
+		{A: 2.869e-08, B: 4.82964665921, C: 710.7467316182},
+		{A: 2.534e-08, B: 5.31005598763, C: 427.3489504014},
+		{A: 2.618e-08, B: 1.22081401503, C: 36.6485629295},
+		{A: 3.464e-08, B: 2.328113282, C: 285.3723810196},
+		{A: 3.374e-08, B: 3.34109586766, C: 162.0933701068},
+		{A: 2.694e-08, B: 4.64149271687, C: 140.9651774255},
+		{A: 2.603e-08, B: 0.99527295832, C: 92.3077063856},
+		{A: 3.14e-08, B: 5.4079027758, C: 328.2407190726},
+		{A: 2.603e-08, B: 4.3153279088, C: 561.934294009},
+		{A: 2.987e-08, B: 0.82758128867, C: 45.5766510387},
+		{A: 2.959e-08, B: 3.35623851523, C: 273.8536000037},
+		{A: 2.561e-08, B: 0.54160683162, C: 107.7586406646},
+		{A: 2.9e-08, B: 1.20691455948, C: 462.0229135281},
+		{A: 2.648e-08, B: 1.90547819027, C: 88.1149206916},
+		{A: 2.461e-08, B: 4.68211868869, C: 2840.4132799086},
+		{A: 2.534e-08, B: 5.00311256556, C: 431.264057322},
+		{A: 2.521e-08, B: 3.3216047231, C: 136.0698163159},
+		{A: 2.945e-08, B: 1.06452531856, C: 732.6951197941},
+		{A: 2.654e-08, B: 1.36744710395, C: 460.5384408198},
+		{A: 3.297e-08, B: 1.33975572602, C: 305.6068018236},
+		{A: 2.634e-08, B: 2.299955338, C: 519.3960243561},
+		{A: 2.805e-08, B: 5.62255444533, C: 1699.2792165032},
+		{A: 2.439e-08, B: 5.14733660159, C: 303.8616966844},
+		{A: 2.434e-08, B: 3.71460437051, C: 4216.1870797544},
+		{A: 2.416e-08, B: 3.76296045457, C: 77.7505439839},
+		{A: 2.803e-08, B: 2.55280894914, C: 505.3119427064},
+		{A: 2.592e-08, B: 3.32836551071, C: 110.1581371096},
+		{A: 3.074e-08, B: 1.71462387764, C: 256.5881246163},
+		{A: 3.295e-08, B: 0.81766682522, C: 705.1176573257},
+		{A: 3.183e-08, B: 6.15742006608, C: 109.2431133729},
+		{A: 2.908e-08, B: 5.38534195293, C: 315.1680293792},
+		{A: 2.326e-08, B: 1.42604031905, C: 131.5469622218},
+		{A: 2.427e-08, B: 2.0462785074, C: 124.5028511902},
+		{A: 2.632e-08, B: 1.41253794767, C: 211.6545640353},
+		{A: 2.297e-08, B: 1.38016674676, C: 425.8474313506},
+		{A: 2.318e-08, B: 6.27716072818, C: 317.142629182},
+		{A: 2.31e-08, B: 4.86442292404, C: 3259.8979237838},
+		{A: 2.873e-08, B: 1.10206537875, C: 7.8643065262},
+		{A: 2.616e-08, B: 0.11849676899, C: 133.1008708993},
+		{A: 3.213e-08, B: 4.4932046169, C: 432.2272651685},
+		{A: 2.276e-08, B: 6.04688191978, C: 214.1022445901},
+		{A: 2.276e-08, B: 1.96882478275, C: 212.4959462859},
+		{A: 2.917e-08, B: 0.96774661857, C: 100.3844612329},
+		{A: 2.89e-08, B: 5.72610904534, C: 322.0209439491},
+		{A: 2.829e-08, B: 2.66887892162, C: 141.4864422873},
+		{A: 2.695e-08, B: 1.4748857107, C: 42.5382696529},
+		{A: 2.697e-08, B: 5.34002228297, C: 432.0148168474},
+		{A: 2.229e-08, B: 4.40717937246, C: 540.7366653585},
+		{A: 2.214e-08, B: 2.43714413196, C: 426.8588233069},
+		{A: 2.512e-08, B: 4.68291916658, C: 1596.1864422846},
+		{A: 2.202e-08, B: 5.91122030007, C: 867.4234757536},
+		{A: 2.856e-08, B: 0.94736445171, C: 41.0537969446},
+		{A: 2.396e-08, B: 0.10516628717, C: 206.9363079626},
+		{A: 2.345e-08, B: 1.1668526778, C: 640.8604941605},
+		{A: 2.263e-08, B: 4.62327588198, C: 188.0263011725},
+		{A: 2.214e-08, B: 3.97566024178, C: 426.3375584451},
+		{A: 2.274e-08, B: 4.9405583072, C: 4002.8879843164},
+		{A: 2.541e-08, B: 0.837056152, C: 12352.8526045448},
+		{A: 2.145e-08, B: 3.40120044084, C: 111.1695290659},
+		{A: 2.895e-08, B: 6.07389082608, C: 2914.0142358238},
+		{A: 2.52e-08, B: 1.05396310009, C: 184.7272873558},
+		{A: 2.448e-08, B: 3.49820841117, C: 481.7360694703},
+		{A: 2.343e-08, B: 2.86472924644, C: 207.0793203145},
+		{A: 2.964e-08, B: 5.96264929181, C: 465.9550667912},
+		{A: 2.122e-08, B: 4.04560058177, C: 118.0706277456},
+		{A: 2.452e-08, B: 4.35251210402, C: 1382.8873468466},
+		{A: 2.131e-08, B: 0.61211416273, C: 335.1418177523},
+		{A: 2.116e-08, B: 4.76181734842, C: 765.8846102125},
+		{A: 2.245e-08, B: 5.67078632283, C: 6467.9257579616},
+		{A: 2.425e-08, B: 0.84789834075, C: 550.1378342197},
+		{A: 2.227e-08, B: 1.15684015463, C: 227.3137411185},
+		{A: 2.314e-08, B: 6.13104971819, C: 2730.2069586892},
+		{A: 2.344e-08, B: 0.35844885568, C: 217.4436970222},
+		{A: 2.529e-08, B: 3.07975959821, C: 774.4826299216},
+		{A: 2.523e-08, B: 1.75026771081, C: 1578.0271950199},
+		{A: 2.111e-08, B: 4.24637589094, C: 96.8729990951},
+		{A: 2.826e-08, B: 1.01974994073, C: 87.3117715395},
+		{A: 2.906e-08, B: 3.75374302356, C: 428.0826635843},
+		{A: 2.113e-08, B: 2.19787343926, C: 449.232108125},
+		{A: 2.142e-08, B: 1.19671359858, C: 209.1544938538},
+		{A: 2.882e-08, B: 2.59371585952, C: 39.6175083461},
+		{A: 2.078e-08, B: 2.87503604503, C: 14.0146456805},
+		{A: 2.09e-08, B: 1.99032225653, C: 441.576044403},
+		{A: 2.519e-08, B: 2.99001165551, C: 745.277682393},
+		{A: 2.035e-08, B: 5.37147785849, C: 1041.2226829245},
+		{A: 2.054e-08, B: 1.11817372961, C: 842.9014410135},
+		{A: 2.023e-08, B: 2.94559148702, C: 668.2084619653},
+		{A: 2.529e-08, B: 4.34280159004, C: 221.8971151471},
+		{A: 2.554e-08, B: 5.56906955622, C: 214.1928673153},
+		{A: 2.712e-08, B: 1.60469055827, C: 1050.9963588012},
+		{A: 2.35e-08, B: 3.36541706919, C: 220.2001941177},
+		{A: 2.015e-08, B: 2.60446576036, C: 315.6411101312},
+		{A: 2.158e-08, B: 1.64945261993, C: 219.6618829134},
+		{A: 2.12e-08, B: 2.93968038721, C: 304.1223291153},
+		{A: 2.357e-08, B: 1.94433441808, C: 233.9060232575},
+		{A: 2.579e-08, B: 4.54124062411, C: 484.7050148869},
+		{A: 2.046e-08, B: 5.45531068264, C: 200.5564741447},
+		{A: 2.04e-08, B: 2.04492641594, C: 1097.0942747017},
+		{A: 2.675e-08, B: 1.20234167733, C: 28.5718080822},
+		{A: 2.528e-08, B: 4.69268465973, C: 637.4496057592},
+		{A: 2.314e-08, B: 2.81193072994, C: 25.1297819136},
+		{A: 2.689e-08, B: 5.03868493349, C: 1269.4996318895},
+		{A: 2.115e-08, B: 3.10772296248, C: 1276.6131788903},
+		{A: 2.712e-08, B: 1.49710379127, C: 3340.6124266998},
+		{A: 2.138e-08, B: 4.52114042624, C: 378.9039276826},
+		{A: 2.708e-08, B: 0.01014338204, C: 389.9496279465},
+		{A: 2.56e-08, B: 5.75783882561, C: 544.5087599272},
+		{A: 2.028e-08, B: 0.24331359951, C: 146.594251718},
+		{A: 2.096e-08, B: 1.44475430956, C: 864.2420820159},
+		{A: 1.897e-08, B: 4.63194412388, C: 220.3007678542},
+		{A: 1.901e-08, B: 1.78319572727, C: 192.8522263129},
+		{A: 2.011e-08, B: 4.11578325523, C: 315.8706047948},
+		{A: 2.014e-08, B: 2.26726355818, C: 198.1087935899},
+		{A: 1.905e-08, B: 2.15527255015, C: 326.6868103951},
+		{A: 1.949e-08, B: 3.95440811214, C: 103.6140390804},
+		{A: 2.098e-08, B: 5.24613314798, C: 175.4266922311},
+		{A: 1.884e-08, B: 5.66018186202, C: 1310.3933701397},
+		{A: 1.911e-08, B: 2.606654466, C: 301.4140161296},
+		{A: 2.16e-08, B: 3.42888079793, C: 420.005908737},
+		{A: 2.325e-08, B: 5.896321781, C: 815.0633461142},
+		{A: 1.914e-08, B: 0.22451332248, C: 171.6545976624},
+		{A: 1.854e-08, B: 0.04278915026, C: 233.7459645631},
+		{A: 1.973e-08, B: 2.6864025948, C: 769.8167634756},
+		{A: 1.941e-08, B: 0.61369890353, C: 3487.4241132234},
+		{A: 1.836e-08, B: 3.41496980986, C: 195.7729876197},
+		{A: 2.554e-08, B: 2.35660179716, C: 212.4053235607},
+		{A: 1.822e-08, B: 4.05510232882, C: 639.9454704238},
+		{A: 1.883e-08, B: 6.27079329518, C: 16.6747745564},
+		{A: 1.865e-08, B: 0.17460226411, C: 244.791664827},
+		{A: 2.097e-08, B: 4.58369520569, C: 316.9131345184},
+		{A: 1.879e-08, B: 2.76480572708, C: 28.4541880032},
+		{A: 2.111e-08, B: 2.92457831824, C: 328.9220426288},
+		{A: 2.077e-08, B: 0.3594303358, C: 589.4947101349},
+		{A: 1.825e-08, B: 4.04945265223, C: 190.4045457581},
+		{A: 1.895e-08, B: 3.89414880651, C: 334.5511169213},
+		{A: 2.425e-08, B: 3.76754213762, C: 25558.2121764796},
+		{A: 2.218e-08, B: 1.85341154236, C: 635.231419868},
+		{A: 1.782e-08, B: 0.86927461254, C: 92.7978334801},
+		{A: 2.432e-08, B: 3.78026263567, C: 1254.5217783625},
+		{A: 2.106e-08, B: 0.17285594964, C: 354.2642728635},
+		{A: 1.791e-08, B: 6.23892012939, C: 1670.8250285},
+		{A: 1.996e-08, B: 1.40940081042, C: 230.7075831773},
+		{A: 1.753e-08, B: 1.86660297451, C: 241.7532834412},
+		{A: 2.093e-08, B: 2.3936677788, C: 187.4356003415},
+		{A: 2.007e-08, B: 3.54083120293, C: 226.7924762567},
+		{A: 1.949e-08, B: 1.36533052698, C: 1385.174968707},
+		{A: 1.737e-08, B: 2.67583588366, C: 6.3627874754},
+		{A: 1.868e-08, B: 4.24454204649, C: 1119.1856752295},
+		{A: 1.896e-08, B: 3.81474515719, C: 310.9752436852},
+		{A: 1.86e-08, B: 3.67887919389, C: 1321.4390704036},
+		{A: 2.305e-08, B: 3.53252557028, C: 1570.9136480191},
+		{A: 2.008e-08, B: 3.88504783546, C: 638.9340784675},
+		{A: 1.928e-08, B: 2.64393870433, C: 525.7588118315},
+		{A: 1.807e-08, B: 0.76057354967, C: 66.1835788582},
+		{A: 1.824e-08, B: 0.85822155861, C: 639.8491022042},
+		{A: 2.221e-08, B: 4.8221041383, C: 1585.8915015461},
+		{A: 2.227e-08, B: 4.58488941022, C: 271.61836777},
+		{A: 1.897e-08, B: 0.6433475825, C: 55.6591434561},
+		{A: 1.792e-08, B: 0.00514800434, C: 827.9235874865},
+		{A: 1.831e-08, B: 3.69768852728, C: 172.4577468145},
+		{A: 1.79e-08, B: 4.8006297772, C: 3576.2897934404},
+		{A: 1.698e-08, B: 0.72475212282, C: 295.1942410061},
+		{A: 1.744e-08, B: 3.4525618329, C: 238.5718897035},
+		{A: 2.334e-08, B: 1.51845210531, C: 170.0100662597},
+		{A: 1.669e-08, B: 4.44620549295, C: 4319.279853973},
+		{A: 1.939e-08, B: 3.43927826945, C: 102.5715093568},
+		{A: 1.733e-08, B: 5.96815907422, C: 837.6972633632},
+		{A: 1.686e-08, B: 2.12870436615, C: 491.5579294568},
+		{A: 1.651e-08, B: 3.20586624475, C: 281.1795953256},
+		{A: 1.884e-08, B: 4.2444781245, C: 13.4933808187},
+		{A: 1.88e-08, B: 0.33845094634, C: 214.9436268407},
+		{A: 1.817e-08, B: 3.07678560214, C: 220.5245170234},
+		{A: 1.872e-08, B: 3.79328892492, C: 392.6579409322},
+		{A: 2.195e-08, B: 1.93786776664, C: 259.769518354},
+		{A: 1.881e-08, B: 4.49314034712, C: 199.8057146193},
+		{A: 1.662e-08, B: 0.29659841675, C: 314.9073969483},
+		{A: 1.626e-08, B: 2.29697402942, C: 3067.9394693482},
+		{A: 1.927e-08, B: 1.00706624515, C: 26.826702943},
+		{A: 1.621e-08, B: 0.01495920679, C: 1379.7059531089},
+		{A: 1.655e-08, B: 4.15494230496, C: 4326.3934009738},
+		{A: 1.719e-08, B: 5.9744386099, C: 152.5321425512},
+		{A: 1.653e-08, B: 5.65633302949, C: 448.6895914038},
+		{A: 1.79e-08, B: 3.73709604091, C: 10.0343083076},
+		{A: 1.6e-08, B: 2.28430251221, C: 749.2098356561},
+		{A: 1.77e-08, B: 0.03256515992, C: 364.3467652809},
+		{A: 1.939e-08, B: 5.93191442558, C: 249.9476583675},
+		{A: 1.988e-08, B: 4.78810872536, C: 101.8689339412},
+		{A: 1.667e-08, B: 5.52149899431, C: 229.9738699944},
+		{A: 2.215e-08, B: 3.55121116323, C: 594.6507036754},
+		{A: 1.95e-08, B: 0.80878923373, C: 1049.0869894507},
+		{A: 1.773e-08, B: 2.55608046714, C: 9985.7593567714},
+		{A: 2.088e-08, B: 2.33165208016, C: 420.4478517217},
+		{A: 2.107e-08, B: 2.43624356568, C: 453.6855262499},
+		{A: 1.907e-08, B: 4.72569972805, C: 857.1285350151},
+		{A: 1.609e-08, B: 4.96540433043, C: 285.1117485887},
+		{A: 2.152e-08, B: 4.87024306306, C: 186.2117600641},
+		{A: 1.685e-08, B: 5.68609178354, C: 200.0352092829},
+		{A: 1.752e-08, B: 5.21532265401, C: 25448.00585526019},
+		{A: 1.87e-08, B: 6.14683645342, C: 347.3631741838},
+		{A: 1.731e-08, B: 1.95944272122, C: 934.9485149682},
+		{A: 1.68e-08, B: 5.562466977, C: 170.9732741062},
+		{A: 1.652e-08, B: 1.2452135105, C: 398.1440028728},
+		{A: 1.548e-08, B: 0.38524522125, C: 17.2654753874},
+		{A: 1.577e-08, B: 0.23430114545, C: 434.6749457233},
+		{A: 1.652e-08, B: 2.84480428863, C: 385.5443939314},
+		{A: 1.77e-08, B: 1.1505799628, C: 199.9657733137},
+		{A: 1.528e-08, B: 4.02240583348, C: 236.1936451179},
+		{A: 2.118e-08, B: 5.47803429266, C: 369.0820676961},
+		{A: 1.543e-08, B: 2.54353574089, C: 632.7355552034},
+		{A: 1.504e-08, B: 5.94300855424, C: 280.2163874791},
+		{A: 1.495e-08, B: 2.19380669867, C: 407.4757357648},
+		{A: 1.894e-08, B: 1.88797605501, C: 598.8434893694},
+		{A: 1.515e-08, B: 4.70072295492, C: 211.2933578679},
+		{A: 1.767e-08, B: 3.11910667879, C: 2921.1277828246},
+		{A: 1.515e-08, B: 3.31498374761, C: 215.3048330081},
+		{A: 1.728e-08, B: 5.28095966912, C: 219.5188705615},
+		{A: 1.906e-08, B: 5.24236020775, C: 248.4631856592},
+		{A: 1.607e-08, B: 0.80041605735, C: 642.3449668688},
+		{A: 1.64e-08, B: 2.93387205029, C: 1364.7280995819},
+		{A: 1.585e-08, B: 0.77219822539, C: 661.2379273164},
+		{A: 1.458e-08, B: 5.67666822477, C: 632.831923423},
+		{A: 1.866e-08, B: 4.40562835971, C: 971.1069508032},
+		{A: 1.838e-08, B: 0.4849219076, C: 1127.0499817557},
+		{A: 1.903e-08, B: 5.18692835916, C: 2015.6710861598},
+		{A: 1.59e-08, B: 2.8004320807, C: 633.7469471597},
+		{A: 1.489e-08, B: 4.10155671855, C: 77837.11123384659},
+		{A: 1.573e-08, B: 4.23741356107, C: 203.8979265768},
+		{A: 1.69e-08, B: 0.65475720351, C: 2700.7151403858},
+		{A: 1.872e-08, B: 3.54376036064, C: 354.5249052944},
+		{A: 1.419e-08, B: 4.53129346734, C: 224.6054281328},
+		{A: 1.575e-08, B: 3.52476647615, C: 373.9079928365},
+		{A: 1.817e-08, B: 3.9520388555, C: 6076.8903015542},
+		{A: 1.446e-08, B: 5.41423319377, C: 317.8763423649},
+		{A: 1.552e-08, B: 1.89030720529, C: 1304.9243545416},
+		{A: 1.394e-08, B: 1.86243646383, C: 913.7508863177},
+		{A: 1.94e-08, B: 4.36562864826, C: 432.7485300303},
+		{A: 1.655e-08, B: 1.31748248488, C: 25668.418497699},
+		{A: 1.438e-08, B: 5.12958189872, C: 71.8126531507},
+		{A: 1.929e-08, B: 4.90721606846, C: 206.3979967583},
+		{A: 1.533e-08, B: 2.10965059985, C: 378.6432952517},
+		{A: 1.421e-08, B: 6.22897936063, C: 904.4021070832},
+		{A: 1.45e-08, B: 1.98065714692, C: 205.9731001161},
+		{A: 1.584e-08, B: 5.94110940455, C: 1226.2106027112},
+		{A: 1.51e-08, B: 3.77771207288, C: 222.7002642992},
+		{A: 1.67e-08, B: 2.51954641624, C: 976.7360250957},
+		{A: 1.823e-08, B: 1.60093991502, C: 1141.1340634054},
+		{A: 1.564e-08, B: 3.94804398924, C: 9566.2747128962},
+		{A: 1.406e-08, B: 3.65940442223, C: 316.5037442412},
+		{A: 1.653e-08, B: 3.62394476466, C: 968.1380053866},
+		{A: 1.495e-08, B: 0.7783227917, C: 5959.570433334},
+		{A: 1.408e-08, B: 5.2497092498, C: 316.279995072},
+		{A: 1.724e-08, B: 0.25483952164, C: 125.1841747464},
+		{A: 1.426e-08, B: 2.26871672572, C: 17.4084877393},
+		{A: 1.881e-08, B: 4.12588105003, C: 562.1467423301},
+		{A: 1.37e-08, B: 4.7447486688, C: 1357.6145525811},
+		{A: 1.485e-08, B: 2.18712736768, C: 9889.7801295536},
+		{A: 1.369e-08, B: 1.31846306523, C: 1160.8301730051},
+		{A: 1.394e-08, B: 5.6692451786, C: 1736.9915610157},
+		{A: 1.375e-08, B: 0.16983286236, C: 346.3999663373},
+		{A: 1.525e-08, B: 2.40244831911, C: 419.4364597654},
+		{A: 1.359e-08, B: 2.68668516027, C: 310.7627953641},
+		{A: 1.396e-08, B: 3.6705539794, C: 253.4591205053},
+		{A: 1.454e-08, B: 5.4935626244, C: 504.561183181},
+		{A: 1.813e-08, B: 0.2188206686, C: 263.7016716171},
+		{A: 1.33e-08, B: 3.01736059716, C: 254.1404440615},
+		{A: 1.498e-08, B: 0.17578085903, C: 155.7829722581},
+		{A: 1.509e-08, B: 5.13129901759, C: 768.8535556291},
+		{A: 1.55e-08, B: 1.44225397189, C: 1894.4190646765},
+		{A: 1.447e-08, B: 1.44933809994, C: 893.3564068193},
+		{A: 1.306e-08, B: 5.76425101758, C: 714.6788848813},
+		{A: 1.66e-08, B: 1.34160526151, C: 322.6116447801},
+		{A: 1.347e-08, B: 3.00388920953, C: 843.6351541964},
+		{A: 1.432e-08, B: 3.43786149731, C: 251.1714986449},
+		{A: 1.51e-08, B: 4.39762427873, C: 25.2727942655},
+		{A: 1.587e-08, B: 5.17106904014, C: 141.6988906084},
+		{A: 1.477e-08, B: 5.4751837761, C: 226.0417167313},
+		{A: 1.356e-08, B: 4.24406292182, C: 332.1728723357},
+		{A: 1.644e-08, B: 1.74367211793, C: 67.8804998876},
+		{A: 1.407e-08, B: 5.79229630947, C: 188.1693135244},
+		{A: 1.575e-08, B: 0.09808372057, C: 702.1487119091},
+		{A: 1.765e-08, B: 4.93410889383, C: 201.5196819912},
+		{A: 1.318e-08, B: 4.91605557404, C: 17.5261078183},
+		{A: 1.701e-08, B: 4.47360878108, C: 384.2723695442},
+		{A: 1.304e-08, B: 6.04155032791, C: 25.8634950965},
+		{A: 1.269e-08, B: 4.91035989349, C: 354.9979860464},
+		{A: 1.62e-08, B: 5.54960841244, C: 260.360219185},
+		{A: 1.263e-08, B: 1.16521999431, C: 255.8373650909},
+		{A: 1.744e-08, B: 3.70453251764, C: 147.1155165798},
+		{A: 1.579e-08, B: 4.31561365365, C: 2228.9701815978},
+		{A: 1.557e-08, B: 0.57740217353, C: 3178.1457905676},
+		{A: 1.302e-08, B: 0.32055726013, C: 119.767548775},
+		{A: 1.405e-08, B: 3.2040748604, C: 395.105621487},
+		{A: 1.234e-08, B: 2.34766954239, C: 318.679491517},
+		{A: 1.519e-08, B: 4.74629629688, C: 100.1720129118},
+		{A: 1.573e-08, B: 4.00132484524, C: 1264.2954542392},
+		{A: 1.222e-08, B: 0.10709243166, C: 1372.5924061081},
+		{A: 1.205e-08, B: 0.24105241435, C: 466.7582159433},
+		{A: 1.188e-08, B: 1.27112537278, C: 1184.4060462412},
+		{A: 1.28e-08, B: 4.85454052139, C: 535.9107402181},
+		{A: 1.482e-08, B: 4.4716669291, C: 763.4369296577},
+		{A: 1.233e-08, B: 1.64803509193, C: 433.663553767},
+		{A: 1.476e-08, B: 3.7663439911, C: 272.5815756165},
+		{A: 1.199e-08, B: 1.78020373551, C: 102.3420146932},
+		{A: 1.186e-08, B: 4.72162748523, C: 795.6802585721},
+		{A: 1.291e-08, B: 4.42697938285, C: 10220.3990932118},
+		{A: 1.576e-08, B: 2.40263038916, C: 348.635198571},
+		{A: 1.497e-08, B: 3.44614317326, C: 3024.2205570432},
+		{A: 1.175e-08, B: 4.85145058205, C: 433.7599219866},
+		{A: 1.262e-08, B: 5.79416346069, C: 531.978586955},
+		{A: 1.486e-08, B: 4.39599352105, C: 1055.4497769261},
+		{A: 1.351e-08, B: 0.46461977407, C: 707.5653378805},
+		{A: 1.23e-08, B: 5.18147817992, C: 752.3912293938},
+		{A: 1.175e-08, B: 4.35535063059, C: 3892.681663097},
+		{A: 1.581e-08, B: 5.49361132323, C: 419.532827985},
+		{A: 1.247e-08, B: 4.22328749428, C: 113.1270825262},
+		{A: 1.145e-08, B: 2.26067253357, C: 199.1201855462},
+		{A: 1.385e-08, B: 0.89711064123, C: 6073.7089078165},
+		{A: 1.228e-08, B: 4.55057016747, C: 680.0573113813},
+		{A: 1.47e-08, B: 1.58708185256, C: 409.1897031367},
+		{A: 1.366e-08, B: 3.99684537321, C: 6065.8446012903},
+		{A: 1.131e-08, B: 1.56474593118, C: 196.8367692027},
+		{A: 1.163e-08, B: 5.75528918663, C: 2303.6087678132},
+		{A: 1.142e-08, B: 4.35845106342, C: 1834.6153196362},
+		{A: 1.244e-08, B: 1.93187654929, C: 623.2225117576},
+		{A: 1.136e-08, B: 3.13253323524, C: 611.4430983108},
+		{A: 1.425e-08, B: 2.65840274172, C: 1253.7710188371},
+		{A: 1.114e-08, B: 3.43048279234, C: 771.3012361839},
+		{A: 1.314e-08, B: 0.15326588489, C: 493.303034596},
+		{A: 1.109e-08, B: 2.9580840286, C: 1091.6252591036},
+		{A: 1.177e-08, B: 3.88159541809, C: 128.3655684841},
+		{A: 1.15e-08, B: 4.6914056969, C: 1.2720243872},
+		{A: 1.527e-08, B: 1.09983755253, C: 683.0262567979},
+		{A: 1.304e-08, B: 5.24544813643, C: 5650.2921106782},
+		{A: 1.347e-08, B: 4.11616699496, C: 97.4636999261},
+		{A: 1.085e-08, B: 0.70231952018, C: 1166.4068576709},
+		{A: 1.224e-08, B: 4.2299482253, C: 827.1728279611},
+		{A: 1.142e-08, B: 5.36157631813, C: 199.0238173266},
+		{A: 1.373e-08, B: 0.89371361814, C: 799.6124118352},
+		{A: 1.085e-08, B: 1.15969472512, C: 398.2870152247},
+		{A: 1.098e-08, B: 3.75659421786, C: 318.3976072267},
+		{A: 1.136e-08, B: 1.35127769399, C: 205.9249160063},
+		{A: 1.108e-08, B: 6.03201954623, C: 206.4461808681},
+		{A: 1.146e-08, B: 0.18236094571, C: 6386.16862421},
+		{A: 1.216e-08, B: 0.49809632153, C: 1178.9894202698},
+		{A: 1.295e-08, B: 2.32056477953, C: 10003.9186040361},
+		{A: 1.082e-08, B: 1.966110692, C: 3700.7232086614},
+		{A: 1.094e-08, B: 5.12244388591, C: 314.3861320865},
+		{A: 1.464e-08, B: 4.54056066665, C: 1248.1419445446},
+		{A: 1.277e-08, B: 5.42029902662, C: 9996.0542975099},
+		{A: 1.248e-08, B: 0.21625135029, C: 101.6083015103},
+		{A: 1.055e-08, B: 5.5372537326, C: 1578.7779545453},
+		{A: 1.265e-08, B: 5.85587479852, C: 82.8583534146},
+		{A: 1.058e-08, B: 0.73824266822, C: 670.916774951},
+		{A: 1.127e-08, B: 3.6345849801, C: 582.3811631341},
+		{A: 1.188e-08, B: 2.10062104535, C: 423.8898778903},
+		{A: 1.217e-08, B: 2.49656109071, C: 311.7260032106},
+		{A: 1.08e-08, B: 3.96349373526, C: 118.8737768977},
+		{A: 1.175e-08, B: 0.91096377814, C: 740.0692992258},
+		{A: 1.087e-08, B: 5.46774426742, C: 494.4786907636},
+		{A: 1.08e-08, B: 3.49168860514, C: 847.0460425977},
+		{A: 1.095e-08, B: 0.97418295319, C: 1159.2933106701},
+		{A: 1.078e-08, B: 1.75579678521, C: 1457.525933062},
+		{A: 1.087e-08, B: 4.81206824168, C: 109.6850563576},
+		{A: 1.293e-08, B: 0.02397468965, C: 2723.0934116884},
+		{A: 1.19e-08, B: 4.49552956868, C: 429.3065038617},
+		{A: 1.127e-08, B: 0.84707518843, C: 48.7580447764},
+		{A: 1.047e-08, B: 4.58416926615, C: 89.7594520943},
+		{A: 1.251e-08, B: 1.16783030789, C: 455.1699989582},
+		{A: 1.122e-08, B: 5.7216130664, C: 78263.70942472259},
+		{A: 1.027e-08, B: 0.16330222064, C: 229.7614216733},
+		{A: 1.069e-08, B: 2.37188773221, C: 848.530515306},
+		{A: 1.252e-08, B: 5.28238896229, C: 6080.8224548173},
+		{A: 1.181e-08, B: 5.22299379363, C: 1459.9565672743},
+		{A: 1.382e-08, B: 0.51603096285, C: 774.0095491696},
+		{A: 1.064e-08, B: 5.95222326171, C: 1144.3154571431},
+		{A: 1.083e-08, B: 5.04862249728, C: 629.8629780064},
+		{A: 1.061e-08, B: 3.38604454777, C: 27.0873353739},
+		{A: 1.415e-08, B: 4.85796248007, C: 2332.0629558164},
+		{A: 1.082e-08, B: 4.07686503205, C: 1245.172999128},
+		{A: 1.112e-08, B: 6.07617329506, C: 870.4618571394},
+		{A: 1.072e-08, B: 0.73647405514, C: 1482.7987273275},
+		{A: 1.322e-08, B: 2.81015928946, C: 223.3334037456},
+		{A: 1.398e-08, B: 1.55232715558, C: 25771.5112719176},
+		{A: 1.065e-08, B: 3.98884050015, C: 683.1863154923},
+		{A: 1.008e-08, B: 5.19594380826, C: 316.1312372257},
+		{A: 1.197e-08, B: 0.79148395839, C: 9580.5018068978},
+		{A: 1.402e-08, B: 4.82957073563, C: 883.7951792637},
+		{A: 1.064e-08, B: 4.65334775068, C: 201.9927627432},
+		{A: 1.108e-08, B: 5.88857586823, C: 657.1627617014},
+		{A: 9.96e-09, B: 4.99081076034, C: 426.7582495704},
+		{A: 9.96e-09, B: 1.60533807224, C: 426.4381321816},
+		{A: 1.028e-08, B: 5.9212831945, C: 108.50940019},
+		{A: 1.004e-08, B: 3.5425959786, C: 754.0357607965},
+		{A: 1.214e-08, B: 0.81213286478, C: 1773.9178027186},
+		{A: 1.318e-08, B: 0.6026917613, C: 1123.1178284926},
+		{A: 1.327e-08, B: 4.758850089, C: 321.808495628},
+		{A: 1.014e-08, B: 5.79119811472, C: 6460.8122109608},
+		{A: 1.178e-08, B: 0.47169015111, C: 495.9631634719},
+		{A: 9.99e-09, B: 5.95030119388, C: 3906.9087570986},
+		{A: 1.033e-08, B: 3.75433174131, C: 414.8187774292},
+		{A: 1.002e-08, B: 1.39171012432, C: 1251.3403846248},
+		{A: 9.9e-09, B: 1.32621236288, C: 1268.7488723641},
+		{A: 1.275e-08, B: 1.95417923977, C: 757.8078553652},
+		{A: 1.174e-08, B: 6.04352585298, C: 225.0785088848},
+		{A: 1.174e-08, B: 5.43253033568, C: 849.2642284889},
+		{A: 1.004e-08, B: 5.33434806968, C: 46.2097904851},
+		{A: 1.108e-08, B: 1.28177889943, C: 294.3004691288},
+		{A: 9.76e-09, B: 1.83523959034, C: 5.8897067234},
+		{A: 9.71e-09, B: 3.38563950019, C: 306.0969289181},
+		{A: 1.05e-08, B: 3.88449467091, C: 632.2624744514},
+		{A: 1.05e-08, B: 3.26096036982, C: 159.7151255212},
+		{A: 1.041e-08, B: 2.36429894351, C: 821.5437536686},
+		{A: 1.218e-08, B: 4.61739999906, C: 990.2294059144},
+		{A: 1.342e-08, B: 1.55614528399, C: 498.9321088885},
+		{A: 9.67e-09, B: 3.84645372731, C: 604.4725636619},
+		{A: 1.171e-08, B: 0.42265751679, C: 10011.0321510369},
+		{A: 9.65e-09, B: 0.05396772193, C: 962.5089310941},
+		{A: 1.096e-08, B: 3.04685199735, C: 608.404716925},
+		{A: 1.166e-08, B: 6.14999706886, C: 737.3128021303},
+		{A: 9.86e-09, B: 3.71830385737, C: 1235.6117715724},
+		{A: 9.53e-09, B: 0.79704964354, C: 16.4623262353},
+		{A: 9.76e-09, B: 1.05304205075, C: 8.3373872782},
+		{A: 1.142e-08, B: 1.06057209808, C: 369.9758395734},
+		{A: 1.06e-08, B: 4.36236322604, C: 633.305004175},
+		{A: 1.138e-08, B: 4.31859245106, C: 98.4269077726},
+		{A: 1.006e-08, B: 5.89037944896, C: 10007.0999977738},
+		{A: 9.67e-09, B: 1.56852913547, C: 157.6399519819},
+		{A: 1.082e-08, B: 0.99548769517, C: 4.1446015842},
+		{A: 1.009e-08, B: 6.15037679495, C: 401.3253966105},
+		{A: 9.81e-09, B: 2.37620383333, C: 35.212274331},
+		{A: 1.011e-08, B: 5.42685471402, C: 110.7275860812},
+		{A: 9.89e-09, B: 4.11575312649, C: 413.8555695827},
+		{A: 1.024e-08, B: 1.90798238649, C: 1175.8080265321},
+		{A: 1.079e-08, B: 4.95981991427, C: 631.8205314667},
+		{A: 9.82e-09, B: 3.02842195594, C: 347.4113582936},
+		{A: 9.79e-09, B: 3.74615289445, C: 700.4517908797},
+		{A: 9.28e-09, B: 5.22236951137, C: 1173.5204046717},
+		{A: 9.12e-09, B: 4.14451390992, C: 469.7271613599},
+		{A: 9.38e-09, B: 1.18273838991, C: 254.3528923826},
+		{A: 9.77e-09, B: 1.26684849112, C: 104.5772469269},
+		{A: 1.134e-08, B: 5.87478488618, C: 6058.7310542895},
+		{A: 1.092e-08, B: 4.51789158271, C: 532.1386456494},
+		{A: 1.132e-08, B: 4.5542002715, C: 1912.5783119412},
+		{A: 9.15e-09, B: 4.87266214195, C: 18.9100067901},
+		{A: 9.15e-09, B: 5.96624579967, C: 1987.2168981566},
+		{A: 1.039e-08, B: 2.40020830681, C: 6475.0393049624},
+		{A: 9.65e-09, B: 4.98927479154, C: 394.3548619616},
+		{A: 9.64e-09, B: 3.609037159, C: 3281.2385647862},
+		{A: 8.97e-09, B: 2.81660605059, C: 316.2318109622},
+		{A: 1.008e-08, B: 5.78024010734, C: 502.8642621516},
+		{A: 1.005e-08, B: 6.12431717236, C: 6275.9623029906},
+		{A: 8.95e-09, B: 6.09245508513, C: 316.551928351},
+		{A: 1.224e-08, B: 1.73535287415, C: 5120.6011455836},
+		{A: 1.138e-08, B: 2.92901543353, C: 1037.2905296614},
+		{A: 9.24e-09, B: 5.70601816488, C: 614.8369403696},
+		{A: 8.93e-09, B: 5.25155704274, C: 475.3562356524},
+		{A: 1.042e-08, B: 1.10027795842, C: 1518.2234499796},
+		{A: 8.9e-09, B: 4.03192782386, C: 1314.3255234028},
+		{A: 9.26e-09, B: 3.35110915055, C: 635.70450062},
+		{A: 1.13e-08, B: 5.49282680494, C: 92.940845832},
+		{A: 8.9e-09, B: 2.12933822393, C: 3384.3313390048},
+		{A: 9.67e-09, B: 5.86215202069, C: 13.3333221243},
+		{A: 1.004e-08, B: 1.73116475997, C: 10316.3783204296},
+		{A: 1.226e-08, B: 3.52834223937, C: 80.4106728598},
+		{A: 1.029e-08, B: 4.90620832171, C: 19.1224551112},
+		{A: 9.96e-09, B: 0.76740358631, C: 733.428832977},
+		{A: 9.1e-09, B: 4.08904906301, C: 3333.498879699},
+		{A: 1.235e-08, B: 1.23871819142, C: 357.2332182801},
+		{A: 1.218e-08, B: 0.18349810348, C: 1090.4014188262},
+		{A: 8.64e-09, B: 4.71917415767, C: 620.253566341},
+		{A: 9.35e-09, B: 1.45887009044, C: 1042.9196039539},
+		{A: 8.66e-09, B: 4.04792682992, C: 522.529233984},
+		{A: 1.158e-08, B: 5.4332220911, C: 1089.129394439},
+		{A: 8.6e-09, B: 0.49220052417, C: 64.9597385808},
+		{A: 1.19e-08, B: 5.5896536965, C: 2810.9214616052},
+		{A: 9.57e-09, B: 3.1291404701, C: 628.5909536192},
+		{A: 8.61e-09, B: 5.69790389801, C: 103.843533744},
+		{A: 1.037e-08, B: 5.91424823262, C: 11.3063326948},
+		{A: 9.18e-09, B: 0.21424702155, C: 373.0142209592},
+		{A: 8.36e-09, B: 3.02501867546, C: 387.2413149608},
+		{A: 1.158e-08, B: 3.33343863758, C: 6290.1893969922},
+		{A: 8.56e-09, B: 0.81593288669, C: 907.3710524998},
+		{A: 1.036e-08, B: 3.11936047271, C: 5429.8794682394},
+		{A: 8.53e-09, B: 6.23618175592, C: 938.8806682313},
+		{A: 9.82e-09, B: 3.39082880963, C: 521.6142102473},
+		{A: 8.51e-09, B: 5.14029961564, C: 802.7938055729},
+		{A: 8.28e-09, B: 0.28399876908, C: 338.4832701844},
+		{A: 8.68e-09, B: 1.89151676387, C: 627.1546650207},
+		{A: 8.78e-09, B: 2.67671626912, C: 688.6553310904},
+		{A: 9.21e-09, B: 0.18441593712, C: 3803.81598288},
+		{A: 8.21e-09, B: 0.74694467095, C: 1152.1797636693},
+		{A: 8.41e-09, B: 2.42616504698, C: 625.8826406335},
+		{A: 8.62e-09, B: 3.35273419872, C: 425.3261664888},
+		{A: 8.87e-09, B: 3.46938383985, C: 1748.788020805},
+		{A: 8.15e-09, B: 5.95428642326, C: 321.0577361026},
+		{A: 9.58e-09, B: 1.35525670354, C: 1201.831580323},
+		{A: 9.05e-09, B: 5.43093361027, C: 236.8749686741},
+		{A: 8.62e-09, B: 3.24167644516, C: 427.8702152632},
+		{A: 7.93e-09, B: 5.01131700831, C: 109.9938728983},
+		{A: 7.91e-09, B: 2.21809404489, C: 110.4187695405},
+		{A: 8.42e-09, B: 5.04957483651, C: 444.1242986943},
+		{A: 8.87e-09, B: 4.23752031714, C: 3553.9115221378},
+		{A: 8.51e-09, B: 4.64229745145, C: 4105.980758535},
+		{A: 1.067e-08, B: 4.69271921916, C: 559.6990617753},
+		{A: 9.66e-09, B: 4.55013458162, C: 9360.089164459},
+		{A: 9.77e-09, B: 1.50925667031, C: 186.472392495},
+		{A: 1.092e-08, B: 0.5815374794, C: 203.2647871304},
+		{A: 9.97e-09, B: 0.24589891452, C: 439.9315130003},
+		{A: 7.86e-09, B: 3.84829878956, C: 194.3890886479},
+		{A: 7.74e-09, B: 3.7605063931, C: 219.1406180516},
+		{A: 8.45e-09, B: 4.21602090805, C: 2648.454825473},
+		{A: 9.62e-09, B: 0.30590569897, C: 229.340730548},
+		{A: 7.63e-09, B: 0.05577842075, C: 846.131018861},
+		{A: 8.39e-09, B: 5.68124142701, C: 2620.0006374698},
+		{A: 8.18e-09, B: 2.5260962654, C: 26301.2022370122},
+		{A: 9.29e-09, B: 0.71906225883, C: 740.2817475469},
+		{A: 8.95e-09, B: 5.83218231202, C: 4539.6924964118},
+		{A: 9.39e-09, B: 3.68460642343, C: 817.7716590999},
+		{A: 7.55e-09, B: 4.90024080821, C: 532.8723588323},
+		{A: 7.84e-09, B: 1.14096100609, C: 551.031606097},
+		{A: 8.99e-09, B: 1.85252071775, C: 835.7878940127},
+		{A: 7.53e-09, B: 0.04254534997, C: 1534.7381658416},
+		{A: 1.033e-08, B: 1.0013799327, C: 134.1122628556},
+		{A: 8.51e-09, B: 6.1227286454, C: 1475.6851803267},
+		{A: 7.97e-09, B: 5.14322789256, C: 473.659314623},
+		{A: 7.29e-09, B: 4.94931618796, C: 476.1069951778},
+		{A: 8.52e-09, B: 3.11058720799, C: 232.4215505492},
+		{A: 7.83e-09, B: 4.50805467439, C: 1151.4290041439},
+		{A: 7.51e-09, B: 0.92289775523, C: 1884.124123938},
+		{A: 8.32e-09, B: 4.19686348297, C: 29.2049475286},
+		{A: 7.2e-09, B: 0.4096104103, C: 522.6256022036},
+		{A: 7.22e-09, B: 3.96121088528, C: 1474.9344208013},
+		{A: 7.88e-09, B: 0.99170388242, C: 121.8427223143},
+		{A: 7.22e-09, B: 4.16734185316, C: 232.2091022281},
+		{A: 9.7e-09, B: 2.32204039048, C: 566.600160455},
+		{A: 8.14e-09, B: 5.19337022083, C: 948.2124011233},
+		{A: 7.24e-09, B: 2.30837674225, C: 949.12742486},
+		{A: 7.7e-09, B: 5.89605163084, C: 156.6767441354},
+		{A: 7.05e-09, B: 5.40102118863, C: 1193.9672737968},
+		{A: 8.09e-09, B: 3.56474059969, C: 845.3320752258},
+		{A: 7.55e-09, B: 3.94580797273, C: 451.7279727896},
+		{A: 7.31e-09, B: 6.11847213487, C: 1239.5439248355},
+		{A: 7.47e-09, B: 1.40599730465, C: 782.3469364478},
+		{A: 8.61e-09, B: 1.84312374221, C: 984.6003316219},
+		{A: 6.95e-09, B: 4.78088165969, C: 10419.4710946482},
+		{A: 8.04e-09, B: 1.079984374, C: 89.0086925689},
+		{A: 8.28e-09, B: 5.86080569334, C: 845.1196269047},
+		{A: 6.92e-09, B: 3.38736418117, C: 6489.2613984286},
+		{A: 6.94e-09, B: 3.07863807714, C: 316.6043179777},
+		{A: 6.9e-09, B: 5.8668131138, C: 316.1794213355},
+		{A: 7.14e-09, B: 5.38707933404, C: 567.8240007324},
+		{A: 7.67e-09, B: 0.1208184965, C: 485.8806710545},
+		{A: 8.2e-09, B: 1.87877245664, C: 499.895316735},
+		{A: 7.05e-09, B: 0.57839934869, C: 1053.7528558967},
+		{A: 6.84e-09, B: 4.8844227063, C: 2545.3620512544},
+		{A: 6.89e-09, B: 6.14296395253, C: 622.4887985747},
+		{A: 8.23e-09, B: 3.50224755884, C: 877.5754041402},
+		{A: 8.27e-09, B: 2.59300433753, C: 232.942815411},
+		{A: 7.35e-09, B: 3.05650026582, C: 66.9172920411},
+	},
+	// R1, 639 terms
+	{
+		{A: 0.06182981282, B: 0.25843515034, C: 213.299095438},
+		{A: 0.00506577574, B: 0.71114650941, C: 206.1855484372},
+		{A: 0.00341394136, B: 5.7963577396, C: 426.598190876},
+		{A: 0.00188491375, B: 0.47215719444, C: 220.4126424388},
+		{A: 0.0018626154, B: 3.14159265359, C: 0},
+		{A: 0.00143891176, B: 1.40744864239, C: 7.1135470008},
+		{A: 0.00049621111, B: 6.0174446958, C: 103.0927742186},
+		{A: 0.00020928189, B: 5.0924565447, C: 639.897286314},
+		{A: 0.00019952612, B: 1.17560125007, C: 419.4846438752},
+		{A: 0.00018839639, B: 1.60819563173, C: 110.2063212194},
+		{A: 0.00012892827, B: 5.94330258435, C: 433.7117378768},
+		{A: 0.00013876565, B: 0.75886204364, C: 199.0720014364},
+		{A: 5.396699e-05, B: 1.28852405908, C: 14.2270940016},
+		{A: 4.869308e-05, B: 0.86793894213, C: 323.5054166574},
+		{A: 4.247455e-05, B: 0.39299384543, C: 227.5261894396},
+		{A: 3.252084e-05, B: 1.25853470491, C: 95.9792272178},
+		{A: 2.856006e-05, B: 2.16731405366, C: 735.8765135318},
+		{A: 2.909411e-05, B: 4.60679154788, C: 202.2533951741},
+		{A: 3.081408e-05, B: 3.43662557418, C: 522.5774180938},
+		{A: 1.987689e-05, B: 2.45054204795, C: 412.3710968744},
+		{A: 1.941309e-05, B: 6.02393385142, C: 209.3669421749},
+		{A: 1.581446e-05, B: 1.29191789712, C: 210.1177017003},
+		{A: 1.339511e-05, B: 4.30801821806, C: 853.196381752},
+		{A: 1.31559e-05, B: 1.25296446023, C: 117.3198682202},
+		{A: 1.203085e-05, B: 1.86654673794, C: 316.3918696566},
+		{A: 1.091088e-05, B: 0.07527246854, C: 216.4804891757},
+		{A: 9.54403e-06, B: 5.15173410519, C: 647.0108333148},
+		{A: 9.66012e-06, B: 0.47991379141, C: 632.7837393132},
+		{A: 8.81827e-06, B: 1.88471724478, C: 1052.2683831884},
+		{A: 8.74215e-06, B: 1.40224683864, C: 224.3447957019},
+		{A: 8.97512e-06, B: 0.98343776092, C: 529.6909650946},
+		{A: 7.84866e-06, B: 3.06377517461, C: 838.9692877504},
+		{A: 7.39892e-06, B: 1.38225356694, C: 625.6701923124},
+		{A: 6.12961e-06, B: 3.03307306767, C: 63.7358983034},
+		{A: 6.5821e-06, B: 4.1436293098, C: 309.2783226558},
+		{A: 6.496e-06, B: 1.7248948616, C: 742.9900605326},
+		{A: 5.99236e-06, B: 2.54924174765, C: 217.2312487011},
+		{A: 5.02886e-06, B: 2.12958819475, C: 3.9321532631},
+		{A: 4.13017e-06, B: 4.59334402271, C: 415.5524906121},
+		{A: 3.56117e-06, B: 2.30312127651, C: 728.762966531},
+		{A: 3.44777e-06, B: 5.88787577835, C: 440.8252848776},
+		{A: 3.95004e-06, B: 0.53349091102, C: 956.2891559706},
+		{A: 3.35526e-06, B: 1.61614647174, C: 1368.660252845},
+		{A: 3.62772e-06, B: 4.70691652867, C: 302.164775655},
+		{A: 3.21611e-06, B: 0.97931764923, C: 3.1813937377},
+		{A: 2.77783e-06, B: 0.26007031431, C: 195.1398481733},
+		{A: 2.91173e-06, B: 2.83129427918, C: 1155.361157407},
+		{A: 2.64971e-06, B: 2.42670902733, C: 88.865680217},
+		{A: 2.64864e-06, B: 5.82860588985, C: 149.5631971346},
+		{A: 3.16777e-06, B: 3.58395655749, C: 515.463871093},
+		{A: 2.94324e-06, B: 2.81632778983, C: 11.0457002639},
+		{A: 2.44864e-06, B: 1.04493438899, C: 942.062061969},
+		{A: 2.15368e-06, B: 3.56535574833, C: 490.3340891794},
+		{A: 2.64047e-06, B: 1.28547685567, C: 1059.3819301892},
+		{A: 2.46245e-06, B: 0.90730313861, C: 191.9584544356},
+		{A: 2.22077e-06, B: 5.1319321205, C: 269.9214467406},
+		{A: 1.94973e-06, B: 4.56665009915, C: 846.0828347512},
+		{A: 1.82802e-06, B: 2.67913220473, C: 127.4717966068},
+		{A: 1.81645e-06, B: 4.93431600689, C: 74.7815985673},
+		{A: 1.74651e-06, B: 3.44560172182, C: 137.0330241624},
+		{A: 1.65515e-06, B: 5.99775895715, C: 536.8045120954},
+		{A: 1.54809e-06, B: 1.19720845085, C: 265.9892934775},
+		{A: 1.69743e-06, B: 4.63464467495, C: 284.1485407422},
+		{A: 1.51526e-06, B: 0.52928231044, C: 330.6189636582},
+		{A: 1.52461e-06, B: 5.43886711695, C: 422.6660376129},
+		{A: 1.57687e-06, B: 2.99559914619, C: 340.7708920448},
+		{A: 1.4063e-06, B: 2.02069760726, C: 1045.1548361876},
+		{A: 1.39834e-06, B: 1.3528295939, C: 1685.0521225016},
+		{A: 1.40977e-06, B: 1.27099900689, C: 203.0041546995},
+		{A: 1.36013e-06, B: 5.01678984678, C: 351.8165923087},
+		{A: 1.53391e-06, B: 0.26968607873, C: 1272.6810256272},
+		{A: 1.29476e-06, B: 1.14344730612, C: 21.3406410024},
+		{A: 1.27831e-06, B: 2.53876158952, C: 1471.7530270636},
+		{A: 1.26538e-06, B: 3.00310970076, C: 277.0349937414},
+		{A: 1.00277e-06, B: 3.61360169153, C: 1066.49547719},
+		{A: 1.03169e-06, B: 0.38175114761, C: 203.7378678824},
+		{A: 1.07527e-06, B: 4.31870663477, C: 210.8514148832},
+		{A: 9.5934e-07, B: 0.79463744168, C: 1258.4539316256},
+		{A: 8.2663e-07, B: 0.28181414606, C: 234.6397364404},
+		{A: 9.7986e-07, B: 2.56085956186, C: 191.2076949102},
+		{A: 9.7389e-07, B: 3.26245865063, C: 831.8557407496},
+		{A: 7.2227e-07, B: 4.3798463038, C: 860.3099287528},
+		{A: 7.0639e-07, B: 0.7319151392, C: 437.6438911399},
+		{A: 7.0447e-07, B: 0.87698401733, C: 423.4167971383},
+		{A: 7.2057e-07, B: 5.58013290518, C: 429.7795846137},
+		{A: 7.3332e-07, B: 0.62505906432, C: 1375.7737998458},
+		{A: 6.6433e-07, B: 2.68414462465, C: 405.2575498736},
+		{A: 6.3812e-07, B: 1.7505149818, C: 1361.5467058442},
+		{A: 6.1601e-07, B: 1.09332288242, C: 2001.4439921582},
+		{A: 6.7006e-07, B: 0.06872766216, C: 408.4389436113},
+		{A: 6.8945e-07, B: 2.47127505057, C: 949.1756089698},
+		{A: 6.0456e-07, B: 2.25094790113, C: 1788.1448967202},
+		{A: 6.7074e-07, B: 5.45365870159, C: 200.7689224658},
+		{A: 6.5579e-07, B: 0.05539079332, C: 1589.0728952838},
+		{A: 4.932e-07, B: 4.17243429807, C: 138.5174968707},
+		{A: 5.0648e-07, B: 6.26867505289, C: 223.5940361765},
+		{A: 5.5166e-07, B: 4.59491533823, C: 628.8515860501},
+		{A: 4.7916e-07, B: 0.83929741626, C: 10.2949407385},
+		{A: 4.6691e-07, B: 2.17322569098, C: 312.1990839626},
+		{A: 5.4179e-07, B: 0.28360076018, C: 124.433415221},
+		{A: 4.9511e-07, B: 3.79960349195, C: 215.7467759928},
+		{A: 4.0136e-07, B: 5.18161452756, C: 1478.8665740644},
+		{A: 3.9302e-07, B: 0.56257369109, C: 1574.8458012822},
+		{A: 3.4962e-07, B: 4.68487505703, C: 38.1330356378},
+		{A: 4.277e-07, B: 2.98582069454, C: 1148.2476104062},
+		{A: 3.6521e-07, B: 0.63453270366, C: 52.6901980395},
+		{A: 3.9752e-07, B: 0.28412706854, C: 131.4039498699},
+		{A: 3.1777e-07, B: 5.19036499973, C: 76.2660712756},
+		{A: 3.3041e-07, B: 1.9796484643, C: 142.4496501338},
+		{A: 4.2053e-07, B: 4.830179518, C: 288.0806940053},
+		{A: 3.0757e-07, B: 1.47903923433, C: 1677.9385755008},
+		{A: 4.2829e-07, B: 3.38225543528, C: 208.633228992},
+		{A: 2.9245e-07, B: 5.09869866956, C: 654.1243803156},
+		{A: 2.9165e-07, B: 4.95664881649, C: 1795.258443721},
+		{A: 2.9136e-07, B: 2.74747553685, C: 404.5067903482},
+		{A: 3.2689e-07, B: 6.12099521344, C: 145.6310438715},
+		{A: 2.8008e-07, B: 0.83185907283, C: 2317.8358618148},
+		{A: 2.7725e-07, B: 2.24364073545, C: 430.5303441391},
+		{A: 2.9939e-07, B: 1.96415498448, C: 2104.5367663768},
+		{A: 3.2982e-07, B: 3.28236160491, C: 222.8603229936},
+		{A: 3.1772e-07, B: 6.02453027348, C: 1905.4647649404},
+		{A: 2.6959e-07, B: 5.24308283338, C: 388.4651552382},
+		{A: 2.6514e-07, B: 0.99638302878, C: 107.0249274817},
+		{A: 2.5421e-07, B: 2.87336642463, C: 703.6331846174},
+		{A: 2.4908e-07, B: 1.07713811775, C: 99.9113804809},
+		{A: 2.4955e-07, B: 6.23974037842, C: 106.2741679563},
+		{A: 2.4894e-07, B: 0.81040976807, C: 312.4597163935},
+		{A: 2.434e-07, B: 0.54867402916, C: 214.2623032845},
+		{A: 2.8441e-07, B: 0.82630052794, C: 1692.1656695024},
+		{A: 2.3219e-07, B: 5.07995629354, C: 479.2883889155},
+		{A: 2.4362e-07, B: 3.10643455533, C: 212.3358875915},
+		{A: 2.1951e-07, B: 6.06688237952, C: 85.8272988312},
+		{A: 2.2046e-07, B: 3.89863665506, C: 563.6312150384},
+		{A: 2.2596e-07, B: 4.86725457223, C: 295.0512286542},
+		{A: 2.1256e-07, B: 5.10797617452, C: 333.657345044},
+		{A: 2.5985e-07, B: 2.20813879137, C: 1265.5674786264},
+		{A: 2.0904e-07, B: 3.28855303434, C: 70.8494453042},
+		{A: 2.1505e-07, B: 3.79541155976, C: 347.8844390456},
+		{A: 2.2067e-07, B: 4.22716352578, C: 217.964961884},
+		{A: 2.0629e-07, B: 1.68732248608, C: 231.4583427027},
+		{A: 2.1429e-07, B: 3.08914428467, C: 554.0699874828},
+		{A: 2.131e-07, B: 0.38868340861, C: 319.5732633943},
+		{A: 2.0521e-07, B: 2.45651851283, C: 18.1592472647},
+		{A: 2.6055e-07, B: 4.27554951169, C: 483.2205421786},
+		{A: 2.0703e-07, B: 5.1205793632, C: 362.8622925726},
+		{A: 2.2047e-07, B: 5.51249354809, C: 343.2185725996},
+		{A: 1.9443e-07, B: 2.02441679295, C: 313.2104759189},
+		{A: 2.0163e-07, B: 5.0848137311, C: 750.1036075334},
+		{A: 2.0125e-07, B: 3.42997916125, C: 213.3472795478},
+		{A: 2.4196e-07, B: 0.64787472796, C: 207.8824694666},
+		{A: 2.1977e-07, B: 0.72894956852, C: 99.1606209555},
+		{A: 2.112e-07, B: 2.69286728009, C: 1464.6394800628},
+		{A: 1.7192e-07, B: 4.71525117969, C: 2111.6503133776},
+		{A: 1.854e-07, B: 0.04817255506, C: 245.5424243524},
+		{A: 1.7521e-07, B: 3.83662880684, C: 497.4476361802},
+		{A: 1.6107e-07, B: 4.22374822303, C: 565.1156877467},
+		{A: 2.1607e-07, B: 4.16647257628, C: 2.4476805548},
+		{A: 1.5979e-07, B: 0.27376396113, C: 225.8292684102},
+		{A: 1.6831e-07, B: 1.41134653939, C: 114.1384744825},
+		{A: 1.5626e-07, B: 2.82768623405, C: 81.7521332162},
+		{A: 1.5499e-07, B: 1.20606390539, C: 1994.3304451574},
+		{A: 1.5168e-07, B: 3.84591816174, C: 1162.4747044078},
+		{A: 1.6436e-07, B: 3.04752365976, C: 134.5853436076},
+		{A: 1.587e-07, B: 0.33026420429, C: 1891.2376709388},
+		{A: 2.037e-07, B: 0.23170286692, C: 213.2509113282},
+		{A: 1.6291e-07, B: 1.70643197929, C: 2420.9286360334},
+		{A: 1.628e-07, B: 4.9415942732, C: 357.4456666012},
+		{A: 1.8076e-07, B: 5.69515344123, C: 56.6223513026},
+		{A: 1.3724e-07, B: 0.5724019003, C: 2634.2277314714},
+		{A: 1.7355e-07, B: 3.55311137444, C: 218.9281697305},
+		{A: 1.374e-07, B: 5.70545527289, C: 92.0470739547},
+		{A: 1.5328e-07, B: 1.3133869285, C: 216.2198567448},
+		{A: 1.2538e-07, B: 5.19222019427, C: 635.9651330509},
+		{A: 1.2815e-07, B: 1.6015113087, C: 320.3240229197},
+		{A: 1.3043e-07, B: 0.45068441373, C: 1169.5882514086},
+		{A: 1.1984e-07, B: 5.9491612357, C: 543.9180590962},
+		{A: 1.1753e-07, B: 2.80279347133, C: 217.491881132},
+		{A: 1.4746e-07, B: 5.56520105813, C: 344.7030453079},
+		{A: 1.2762e-07, B: 1.63557330778, C: 273.1028404783},
+		{A: 1.1855e-07, B: 2.46234840263, C: 721.6494195302},
+		{A: 1.3309e-07, B: 5.75641013916, C: 2221.856634597},
+		{A: 1.4471e-07, B: 0.45316163629, C: 2008.557539159},
+		{A: 1.184e-07, B: 1.7572077238, C: 160.6088973985},
+		{A: 1.2374e-07, B: 1.01456317602, C: 329.7251917809},
+		{A: 1.0747e-07, B: 1.58065203003, C: 212.7778305762},
+		{A: 1.2758e-07, B: 1.9195237324, C: 1581.959348283},
+		{A: 1.1944e-07, B: 4.44720922423, C: 32.2433289144},
+		{A: 1.1865e-07, B: 5.10696147162, C: 4.665866446},
+		{A: 1.1861e-07, B: 4.30847607078, C: 618.5566453116},
+		{A: 1.0036e-07, B: 0.48709852137, C: 305.3461693927},
+		{A: 1.2777e-07, B: 3.74412991331, C: 508.3503240922},
+		{A: 1.0677e-07, B: 0.76645916273, C: 218.7157214094},
+		{A: 1.1351e-07, B: 3.00009819697, C: 198.321241911},
+		{A: 1.0249e-07, B: 2.40923650192, C: 546.956440482},
+		{A: 9.984e-08, B: 2.63882014753, C: 416.3032501375},
+		{A: 9.345e-08, B: 5.4591731786, C: 414.0680179038},
+		{A: 9.317e-08, B: 4.46380159546, C: 2428.0421830342},
+		{A: 9.928e-08, B: 4.04821559448, C: 62.2514255951},
+		{A: 1.2767e-07, B: 3.43273835457, C: 258.8757464767},
+		{A: 9.733e-08, B: 1.6106632468, C: 327.4375699205},
+		{A: 1.1163e-07, B: 2.40665325234, C: 1781.0313497194},
+		{A: 1.0608e-07, B: 2.0748002083, C: 213.8203602998},
+		{A: 9.125e-08, B: 2.92369523159, C: 1279.794572628},
+		{A: 9.525e-08, B: 1.10338403136, C: 113.3877149571},
+		{A: 9.805e-08, B: 3.28427768485, C: 275.5505210331},
+		{A: 1.1263e-07, B: 1.89402915826, C: 561.1835344836},
+		{A: 8.572e-08, B: 2.17858055966, C: 425.1137181677},
+		{A: 8.577e-08, B: 1.95484887975, C: 35.4247226521},
+		{A: 1.0157e-07, B: 0.09037368733, C: 182.279606801},
+		{A: 1.1807e-07, B: 3.71278037583, C: 350.3321196004},
+		{A: 8.595e-08, B: 1.83382454431, C: 629.6023455755},
+		{A: 8.396e-08, B: 3.76782674303, C: 251.4321310758},
+		{A: 8.46e-08, B: 0.35676476459, C: 617.8058857862},
+		{A: 8.25e-08, B: 5.31140994372, C: 65.2203710117},
+		{A: 8.342e-08, B: 1.3830766388, C: 1.4844727083},
+		{A: 7.987e-08, B: 5.1362289817, C: 22.0914005278},
+		{A: 8.377e-08, B: 0.91817077859, C: 1485.9801210652},
+		{A: 7.98e-08, B: 0.94199750915, C: 2310.722314814},
+		{A: 8.898e-08, B: 0.54037636841, C: 168.0525127994},
+		{A: 8.233e-08, B: 3.45785310349, C: 424.1505103212},
+		{A: 8.034e-08, B: 3.38451795597, C: 144.1465711632},
+		{A: 7.871e-08, B: 5.14041888473, C: 358.9301393095},
+		{A: 8.868e-08, B: 6.13541788772, C: 621.7380390493},
+		{A: 7.523e-08, B: 5.75475671698, C: 447.9388318784},
+		{A: 7.515e-08, B: 2.18967849979, C: 264.5048207692},
+		{A: 8.083e-08, B: 1.42661116937, C: 2737.32050569},
+		{A: 8.199e-08, B: 0.96419579079, C: 767.3690829208},
+		{A: 8.232e-08, B: 0.35471613534, C: 278.5194664497},
+		{A: 8.226e-08, B: 5.44467204721, C: 254.9435932136},
+		{A: 6.779e-08, B: 1.19567671732, C: 5.4166259714},
+		{A: 8.928e-08, B: 4.88240256153, C: 120.358249606},
+		{A: 7.845e-08, B: 4.56376829397, C: 280.9671470045},
+		{A: 6.566e-08, B: 3.50152072308, C: 9.5612275556},
+		{A: 6.398e-08, B: 0.33471834269, C: 2950.619601128},
+		{A: 6.881e-08, B: 3.39438820076, C: 98.8999885246},
+		{A: 7.418e-08, B: 4.52451404934, C: 5.6290742925},
+		{A: 8.021e-08, B: 0.94470052446, C: 636.7158925763},
+		{A: 6.134e-08, B: 0.18013315689, C: 2207.6295405954},
+		{A: 7.153e-08, B: 3.85218295688, C: 214.0498549634},
+		{A: 6.046e-08, B: 4.66733263196, C: 543.0242872189},
+		{A: 6.365e-08, B: 2.12000811873, C: 274.0660483248},
+		{A: 6.481e-08, B: 5.31032923608, C: 6076.8903015542},
+		{A: 5.935e-08, B: 6.16808119163, C: 650.9429865779},
+		{A: 5.752e-08, B: 3.55773840903, C: 1073.6090241908},
+		{A: 6.438e-08, B: 0.44934410249, C: 10007.0999977738},
+		{A: 6.283e-08, B: 3.20942251433, C: 219.4494345923},
+		{A: 5.542e-08, B: 3.61193204407, C: 125.9873238985},
+		{A: 5.522e-08, B: 3.84217355164, C: 181.0557665236},
+		{A: 5.777e-08, B: 3.00590926498, C: 121.2520214833},
+		{A: 6.67e-08, B: 1.65236689367, C: 1898.3512179396},
+		{A: 7.591e-08, B: 0.10483002359, C: 2324.9494088156},
+		{A: 5.881e-08, B: 1.04006410206, C: 9992.8729037722},
+		{A: 5.609e-08, B: 4.83142709229, C: 643.0786800517},
+		{A: 5.569e-08, B: 2.23863483508, C: 1038.0412891868},
+		{A: 5.755e-08, B: 5.91598458372, C: 6062.6632075526},
+		{A: 5.845e-08, B: 6.10234689502, C: 209.106309744},
+		{A: 5.577e-08, B: 0.81426649853, C: 472.1748419147},
+		{A: 5.247e-08, B: 0.56496127013, C: 192.6921676185},
+		{A: 5.493e-08, B: 5.81071309534, C: 237.6781178262},
+		{A: 5.148e-08, B: 4.85160826999, C: 267.4737661858},
+		{A: 6.122e-08, B: 2.11480301005, C: 2097.423219376},
+		{A: 6.188e-08, B: 4.59441762166, C: 207.6700211455},
+		{A: 6.303e-08, B: 0.75806431119, C: 210.3783341312},
+		{A: 5.102e-08, B: 4.01017179605, C: 205.2223405907},
+		{A: 6.583e-08, B: 1.79054357427, C: 12.5301729722},
+		{A: 4.902e-08, B: 0.8509952186, C: 247.2393453818},
+		{A: 4.918e-08, B: 4.03512681632, C: 487.3651437628},
+		{A: 5.818e-08, B: 5.48495503489, C: 2538.2485042536},
+		{A: 4.855e-08, B: 4.18197778083, C: 2744.4340526908},
+		{A: 4.885e-08, B: 0.25103933716, C: 129.9194771616},
+		{A: 5.748e-08, B: 0.55968589618, C: 116.4260963429},
+		{A: 4.901e-08, B: 4.48628916012, C: 291.262087743},
+		{A: 4.72e-08, B: 5.57686152365, C: 342.2553647531},
+		{A: 5.962e-08, B: 5.12885837444, C: 692.5874843535},
+		{A: 5.629e-08, B: 4.39847572369, C: 196.6243208816},
+		{A: 5.596e-08, B: 0.94874135403, C: 1802.3719907218},
+		{A: 6.197e-08, B: 3.80364010966, C: 339.2864193365},
+		{A: 4.668e-08, B: 3.16816375033, C: 148.0787244263},
+		{A: 4.891e-08, B: 2.67234862638, C: 417.0369633204},
+		{A: 4.959e-08, B: 1.63453587065, C: 166.828672522},
+		{A: 4.408e-08, B: 4.95179678525, C: 184.0941479094},
+		{A: 4.449e-08, B: 5.69134789394, C: 252.6559713532},
+		{A: 4.943e-08, B: 0.85358212806, C: 46.470422916},
+		{A: 5.153e-08, B: 3.82176885491, C: 842.1506814881},
+		{A: 5.93e-08, B: 5.95484153666, C: 486.4019359163},
+		{A: 4.206e-08, B: 2.97664198894, C: 380.12776796},
+		{A: 4.467e-08, B: 0.249149784, C: 128.9562693151},
+		{A: 5.419e-08, B: 6.19106890918, C: 337.732510659},
+		{A: 4.499e-08, B: 4.71434958315, C: 151.0476698429},
+		{A: 4.233e-08, B: 4.18702525973, C: 685.4739373527},
+		{A: 4.695e-08, B: 1.54881559549, C: 214.7835681463},
+		{A: 4.084e-08, B: 4.871732264, C: 14.977853527},
+		{A: 4.321e-08, B: 5.4261516886, C: 436.8931316145},
+		{A: 5.145e-08, B: 0.49931857511, C: 248.7238180901},
+		{A: 3.897e-08, B: 0.74661138504, C: 2627.1141844706},
+		{A: 3.995e-08, B: 3.07750371135, C: 710.7467316182},
+		{A: 4.089e-08, B: 5.81996977038, C: 491.8185618877},
+		{A: 4.532e-08, B: 3.67494714028, C: 189.7232222019},
+		{A: 3.69e-08, B: 1.26565281569, C: 211.8146227297},
+		{A: 4.036e-08, B: 1.15473702593, C: 3053.7123753466},
+		{A: 3.672e-08, B: 4.52661018437, C: 488.8496164711},
+		{A: 3.662e-08, B: 2.87243745783, C: 411.620337349},
+		{A: 3.653e-08, B: 3.06205147988, C: 409.9234163196},
+		{A: 3.908e-08, B: 3.45947158106, C: 220.4608265486},
+		{A: 4.989e-08, B: 3.36376245705, C: 824.7421937488},
+		{A: 3.677e-08, B: 3.55713278092, C: 244.318584075},
+		{A: 3.58e-08, B: 1.57825591891, C: 643.8294395771},
+		{A: 3.546e-08, B: 2.1984624503, C: 135.336103133},
+		{A: 3.56e-08, B: 4.51362022045, C: 601.7642506762},
+		{A: 3.843e-08, B: 0.98567531677, C: 271.4059194489},
+		{A: 3.559e-08, B: 1.11005765159, C: 6283.0758499914},
+		{A: 4.266e-08, B: 6.19696005871, C: 268.4369740323},
+		{A: 3.442e-08, B: 4.27628882392, C: 867.4234757536},
+		{A: 4.844e-08, B: 3.73706907228, C: 235.3904959658},
+		{A: 3.659e-08, B: 2.21859531609, C: 2.9207613068},
+		{A: 3.958e-08, B: 5.17084570945, C: 114.3991069134},
+		{A: 3.609e-08, B: 5.54387488088, C: 458.8415197904},
+		{A: 4.47e-08, B: 3.742569309, C: 699.7010313543},
+		{A: 3.293e-08, B: 4.48068043469, C: 289.5651667136},
+		{A: 3.24e-08, B: 5.94728881707, C: 131.5469622218},
+		{A: 3.477e-08, B: 3.54553285172, C: 963.4027029714},
+		{A: 3.838e-08, B: 4.77967877681, C: 175.1660598002},
+		{A: 3.223e-08, B: 1.95410765469, C: 212.0270710508},
+		{A: 4.053e-08, B: 4.19011281964, C: 501.3797894433},
+		{A: 3.1e-08, B: 2.11956558345, C: 916.9322800554},
+		{A: 3.183e-08, B: 1.93201605379, C: 1354.4331588434},
+		{A: 3.301e-08, B: 1.80825506815, C: 756.3233826569},
+		{A: 4.187e-08, B: 5.96622666047, C: 212.5483359126},
+		{A: 3.716e-08, B: 3.70660462807, C: 204.7010757289},
+		{A: 3e-08, B: 6.15443664698, C: 3267.0114707846},
+		{A: 2.993e-08, B: 4.20888489881, C: 533.6231183577},
+		{A: 4.125e-08, B: 6.09715151219, C: 2641.3412784722},
+		{A: 3.145e-08, B: 2.55483540896, C: 905.8865797915},
+		{A: 2.982e-08, B: 1.52760656472, C: 945.9942152321},
+		{A: 3.015e-08, B: 1.76012152992, C: 28.3111756513},
+		{A: 3.453e-08, B: 1.42473508236, C: 2214.7430875962},
+		{A: 2.926e-08, B: 5.50138147476, C: 24.3790223882},
+		{A: 2.978e-08, B: 4.2744005991, C: 195.8906076987},
+		{A: 3.526e-08, B: 3.63935401565, C: 229.9738699944},
+		{A: 2.86e-08, B: 4.52551886503, C: 241.6102710893},
+		{A: 3.059e-08, B: 5.68165832697, C: 282.6640680339},
+		{A: 3.415e-08, B: 5.26311934884, C: 67.6680515665},
+		{A: 2.819e-08, B: 5.42053027567, C: 305.0855369618},
+		{A: 3.503e-08, B: 1.31670335802, C: 69.1525242748},
+		{A: 2.746e-08, B: 0.82597971627, C: 444.7574381407},
+		{A: 2.796e-08, B: 0.0702104716, C: 681.5417840896},
+		{A: 3.366e-08, B: 4.03843228994, C: 6.1503391543},
+		{A: 3.242e-08, B: 2.63461047831, C: 739.8086667949},
+		{A: 2.718e-08, B: 3.40899287465, C: 188.9200730498},
+		{A: 2.741e-08, B: 3.22092213412, C: 776.9303104764},
+		{A: 2.793e-08, B: 3.39766347322, C: 431.264057322},
+		{A: 2.966e-08, B: 3.9142937295, C: 526.5095713569},
+		{A: 2.693e-08, B: 3.38996413068, C: 778.4147831847},
+		{A: 2.68e-08, B: 3.82192393959, C: 3060.8259223474},
+		{A: 2.954e-08, B: 2.69669880207, C: 426.6463749858},
+		{A: 2.681e-08, B: 1.04615621583, C: 28.4541880032},
+		{A: 3.182e-08, B: 2.72333374876, C: 432.2272651685},
+		{A: 2.633e-08, B: 2.55029306465, C: 10213.285546211},
+		{A: 2.923e-08, B: 0.85695094024, C: 2435.155730035},
+		{A: 2.596e-08, B: 5.42890752137, C: 207.1487562837},
+		{A: 3.225e-08, B: 0.9653861573, C: 2118.7638603784},
+		{A: 2.774e-08, B: 0.3326084427, C: 326.6868103951},
+		{A: 2.55e-08, B: 5.88893697427, C: 439.1283638482},
+		{A: 2.716e-08, B: 3.15505406487, C: 170.7608257851},
+		{A: 2.942e-08, B: 4.88555233562, C: 397.3932433474},
+		{A: 3.121e-08, B: 1.87815629157, C: 2413.8150890326},
+		{A: 3.263e-08, B: 2.59868619716, C: 213.0384630071},
+		{A: 2.518e-08, B: 0.15471130491, C: 945.2434557067},
+		{A: 3.169e-08, B: 5.70993651497, C: 381.3516082374},
+		{A: 2.515e-08, B: 0.06248441393, C: 427.5613987225},
+		{A: 3.279e-08, B: 4.95751323467, C: 313.9441891018},
+		{A: 2.595e-08, B: 5.13169797457, C: 299.1263942692},
+		{A: 2.572e-08, B: 3.42558391509, C: 4.192785694},
+		{A: 2.58e-08, B: 2.03280916494, C: 319.3126309634},
+		{A: 3.294e-08, B: 6.24566168486, C: 421.1815649046},
+		{A: 2.58e-08, B: 2.62721090534, C: 213.1872208534},
+		{A: 2.879e-08, B: 0.45679876898, C: 285.6330134505},
+		{A: 2.406e-08, B: 4.57473098758, C: 228.276948965},
+		{A: 2.518e-08, B: 2.5550008583, C: 140.001969579},
+		{A: 2.422e-08, B: 2.36310658303, C: 84.3428261229},
+		{A: 2.374e-08, B: 2.25544718932, C: 17.2654753874},
+		{A: 2.627e-08, B: 1.26370339212, C: 724.8308132679},
+		{A: 2.346e-08, B: 3.77641630157, C: 206.233732547},
+		{A: 2.463e-08, B: 5.4209427824, C: 395.578702239},
+		{A: 2.352e-08, B: 0.63041319237, C: 210.5907824523},
+		{A: 3.166e-08, B: 0.26273580642, C: 201.5196819912},
+		{A: 2.405e-08, B: 0.78919759458, C: 426.0769260142},
+		{A: 2.39e-08, B: 5.89523812458, C: 738.7972748386},
+		{A: 2.515e-08, B: 0.70044371265, C: 2943.5060541272},
+		{A: 2.332e-08, B: 4.06963624306, C: 519.3960243561},
+		{A: 3.132e-08, B: 2.7933163219, C: 732.6951197941},
+		{A: 2.658e-08, B: 3.34020209714, C: 1141.1340634054},
+		{A: 2.258e-08, B: 0.1240330973, C: 2524.021410252},
+		{A: 2.697e-08, B: 2.58404587754, C: 425.6349830295},
+		{A: 2.416e-08, B: 3.85003724506, C: 696.5196376166},
+		{A: 2.597e-08, B: 2.54164936697, C: 436.1594184316},
+		{A: 2.192e-08, B: 3.07202313269, C: 203.2647871304},
+		{A: 2.424e-08, B: 2.60715310452, C: 511.5317178299},
+		{A: 2.126e-08, B: 0.14811901148, C: 405.9912630565},
+		{A: 2.306e-08, B: 1.25068142377, C: 427.1194557378},
+		{A: 2.121e-08, B: 0.43505808954, C: 184.9879197867},
+		{A: 2.755e-08, B: 3.02380019321, C: 468.2426886516},
+		{A: 2.333e-08, B: 3.02634928771, C: 216.0074084237},
+		{A: 2.182e-08, B: 4.27912012069, C: 7.1617311106},
+		{A: 2.101e-08, B: 4.31781498012, C: 572.2292347475},
+		{A: 2.362e-08, B: 4.8291434111, C: 556.5176680376},
+		{A: 2.218e-08, B: 0.82936075453, C: 3370.1042450032},
+		{A: 2.103e-08, B: 5.25950154713, C: 661.2379273164},
+		{A: 2.58e-08, B: 1.0370534038, C: 213.4109700226},
+		{A: 2.366e-08, B: 6.14368355608, C: 205.4347889118},
+		{A: 2.042e-08, B: 0.21462094901, C: 3259.8979237838},
+		{A: 2.547e-08, B: 4.69969204009, C: 221.3758502853},
+		{A: 1.987e-08, B: 3.22670561632, C: 1382.8873468466},
+		{A: 2.213e-08, B: 0.89932827487, C: 286.596221297},
+		{A: 2.191e-08, B: 0.08759174058, C: 259.769518354},
+		{A: 1.968e-08, B: 0.57824086026, C: 180.1619946463},
+		{A: 2.037e-08, B: 2.35713099759, C: 610.6923387854},
+		{A: 1.959e-08, B: 2.18553775379, C: 72.0732855816},
+		{A: 2.061e-08, B: 1.68041202479, C: 1670.8250285},
+		{A: 1.94e-08, B: 0.62951066481, C: 406.954470903},
+		{A: 2.043e-08, B: 4.39130144045, C: 576.1613880106},
+		{A: 1.936e-08, B: 1.0528693453, C: 1262.3860848887},
+		{A: 1.975e-08, B: 0.3194583516, C: 938.1299087059},
+		{A: 2.015e-08, B: 1.66410213484, C: 193.655375465},
+		{A: 1.971e-08, B: 0.72639439054, C: 200.5564741447},
+		{A: 1.952e-08, B: 6.25320630177, C: 241.7532834412},
+		{A: 1.976e-08, B: 1.31263772699, C: 135.5485514541},
+		{A: 2.448e-08, B: 0.52850194172, C: 429.5189521828},
+		{A: 1.977e-08, B: 3.13944703383, C: 421.93232443},
+		{A: 1.853e-08, B: 0.17184530353, C: 196.0336200506},
+		{A: 2.552e-08, B: 5.39764879348, C: 2854.6403739102},
+		{A: 1.83e-08, B: 1.47821899466, C: 638.4128136057},
+		{A: 2.245e-08, B: 6.0042716427, C: 230.7075831773},
+		{A: 1.822e-08, B: 6.08626100417, C: 1261.6353253633},
+		{A: 2.168e-08, B: 0.41741136149, C: 213.5115437591},
+		{A: 1.869e-08, B: 3.67791368036, C: 403.0223176399},
+		{A: 1.866e-08, B: 1.59662677545, C: 391.1734682239},
+		{A: 2.034e-08, B: 1.21814866092, C: 3046.5988283458},
+		{A: 1.929e-08, B: 4.93193335031, C: 420.9691165835},
+		{A: 1.746e-08, B: 5.09757251683, C: 107.7586406646},
+		{A: 2.168e-08, B: 3.24685294764, C: 213.0866471169},
+		{A: 2.178e-08, B: 5.09777299346, C: 558.0021407459},
+		{A: 1.992e-08, B: 2.29524873043, C: 1773.9178027186},
+		{A: 1.761e-08, B: 2.8865562467, C: 141.6988906084},
+		{A: 1.769e-08, B: 5.47051542758, C: 206.1373643274},
+		{A: 1.734e-08, B: 2.11941015901, C: 430.79097657},
+		{A: 2.377e-08, B: 1.0763352157, C: 59.8037450403},
+		{A: 1.797e-08, B: 2.90984583978, C: 92.7978334801},
+		{A: 1.725e-08, B: 5.22827286197, C: 757.2171545342},
+		{A: 2.305e-08, B: 5.88235807192, C: 426.5500067662},
+		{A: 1.751e-08, B: 5.2899080347, C: 87.3117715395},
+		{A: 2.202e-08, B: 1.28096946505, C: 624.919432787},
+		{A: 2.043e-08, B: 0.46193065602, C: 831.1049812242},
+		{A: 1.931e-08, B: 1.26974971942, C: 219.891377577},
+		{A: 1.953e-08, B: 2.96900002385, C: 398.1440028728},
+		{A: 1.676e-08, B: 4.81683149512, C: 181.806526049},
+		{A: 1.902e-08, B: 2.74426125465, C: 4952.0635932862},
+		{A: 2.133e-08, B: 5.37177705284, C: 627.3671133418},
+		{A: 1.962e-08, B: 3.52111949662, C: 213.4591541324},
+		{A: 1.709e-08, B: 6.14073761844, C: 952.3570027075},
+		{A: 1.784e-08, B: 1.05243716682, C: 353.301065017},
+		{A: 1.7e-08, B: 1.1741886417, C: 739.0579072695},
+		{A: 1.609e-08, B: 1.35009554392, C: 84.9335269539},
+		{A: 2.038e-08, B: 2.47570829812, C: 26.826702943},
+		{A: 1.87e-08, B: 5.61729116529, C: 2957.7331481288},
+		{A: 1.962e-08, B: 0.13564680851, C: 213.1390367436},
+		{A: 2.041e-08, B: 3.31354526279, C: 1596.1864422846},
+		{A: 1.612e-08, B: 6.19495100885, C: 432.0148168474},
+		{A: 1.742e-08, B: 2.87947098602, C: 179.3588454942},
+		{A: 1.964e-08, B: 2.84253666387, C: 429.0458714308},
+		{A: 1.805e-08, B: 0.60932632638, C: 532.6117264014},
+		{A: 1.647e-08, B: 0.82347900016, C: 214.5711198252},
+		{A: 1.893e-08, B: 4.33962647901, C: 173.9422195228},
+		{A: 1.689e-08, B: 1.13037158144, C: 586.3133163972},
+		{A: 1.523e-08, B: 2.71561930244, C: 73.297125859},
+		{A: 1.524e-08, B: 5.26558677448, C: 5429.8794682394},
+		{A: 1.582e-08, B: 2.79533721474, C: 842.9014410135},
+		{A: 1.608e-08, B: 2.33230359324, C: 418.5214360287},
+		{A: 1.579e-08, B: 1.15182102801, C: 731.9443602687},
+		{A: 1.689e-08, B: 1.91915438546, C: 630.3360587584},
+		{A: 1.99e-08, B: 5.23790221176, C: 550.1378342197},
+		{A: 1.772e-08, B: 2.95372411478, C: 172.2452984934},
+		{A: 1.596e-08, B: 0.99004701777, C: 953.1077622329},
+		{A: 1.784e-08, B: 3.9139103236, C: 159.1244246902},
+		{A: 1.592e-08, B: 2.99690086808, C: 45.5766510387},
+		{A: 1.968e-08, B: 0.23073879009, C: 220.364458329},
+		{A: 1.549e-08, B: 5.88699595922, C: 60.5545045657},
+		{A: 1.459e-08, B: 5.51999778036, C: 273.8536000037},
+		{A: 1.909e-08, B: 2.78415262815, C: 418.0001711669},
+		{A: 1.445e-08, B: 3.25530914937, C: 453.424893819},
+		{A: 1.454e-08, B: 0.16250693313, C: 115.6229471908},
+		{A: 1.566e-08, B: 2.24077018103, C: 1056.2005364515},
+		{A: 1.412e-08, B: 3.45442909885, C: 354.9979860464},
+		{A: 1.564e-08, B: 3.38591337689, C: 409.1897031367},
+		{A: 1.631e-08, B: 1.06286709889, C: 213.5597278689},
+		{A: 1.415e-08, B: 1.3209187759, C: 373.9079928365},
+		{A: 1.389e-08, B: 0.40584159469, C: 9360.089164459},
+		{A: 1.663e-08, B: 2.33357114562, C: 188.0263011725},
+		{A: 1.426e-08, B: 5.44677783737, C: 864.2420820159},
+		{A: 1.716e-08, B: 3.96056093028, C: 1699.2792165032},
+		{A: 1.682e-08, B: 0.3974767032, C: 17.4084877393},
+		{A: 1.368e-08, B: 5.83289186692, C: 569.0478410098},
+		{A: 1.416e-08, B: 3.65464640816, C: 6.8529145699},
+		{A: 1.469e-08, B: 3.4906919383, C: 934.9485149682},
+		{A: 1.33e-08, B: 4.41794310534, C: 3914.0223040994},
+		{A: 1.309e-08, B: 1.29979865382, C: 428.0826635843},
+		{A: 1.3e-08, B: 1.57748627871, C: 238.5718897035},
+		{A: 1.389e-08, B: 1.31202796503, C: 6275.9623029906},
+		{A: 1.384e-08, B: 0.67585082323, C: 2751.5475996916},
+		{A: 1.471e-08, B: 1.21149871903, C: 2531.1349572528},
+		{A: 1.334e-08, B: 4.11154515525, C: 206.9363079626},
+		{A: 1.601e-08, B: 0.93356520728, C: 355.7487455718},
+		{A: 1.259e-08, B: 2.56678207309, C: 850.0149880143},
+		{A: 1.277e-08, B: 0.41764451386, C: 100.6450936638},
+		{A: 1.436e-08, B: 4.06045514506, C: 177.8743727859},
+		{A: 1.308e-08, B: 1.01324076289, C: 423.6774295692},
+		{A: 1.541e-08, B: 6.03020449918, C: 292.0128472684},
+		{A: 1.307e-08, B: 5.83815678434, C: 5863.5912061162},
+		{A: 1.613e-08, B: 2.45074803642, C: 1049.0869894507},
+		{A: 1.249e-08, B: 3.01518429832, C: 464.7312265138},
+		{A: 1.25e-08, B: 6.23516728885, C: 823.9914342234},
+		{A: 1.275e-08, B: 2.68217384213, C: 637.4496057592},
+		{A: 1.249e-08, B: 2.97028182853, C: 51749.20809227239},
+		{A: 1.24e-08, B: 2.66940683813, C: 2700.7151403858},
+		{A: 1.456e-08, B: 1.85558224828, C: 96.8729990951},
+		{A: 1.491e-08, B: 4.98649587341, C: 295.1942410061},
+		{A: 1.23e-08, B: 4.27283851216, C: 12139.5535091068},
+		{A: 1.292e-08, B: 2.73196017809, C: 10206.1719992102},
+		{A: 1.247e-08, B: 3.77399749791, C: 504.561183181},
+		{A: 1.408e-08, B: 1.02955773079, C: 518.3846323998},
+		{A: 1.223e-08, B: 1.1220209384, C: 221.1634019642},
+		{A: 1.42e-08, B: 4.39795293289, C: 606.7601855223},
+		{A: 1.19e-08, B: 1.57292553631, C: 820.0592809603},
+		{A: 1.247e-08, B: 0.99102599652, C: 9793.8009023358},
+		{A: 1.234e-08, B: 1.10826361423, C: 2303.6087678132},
+		{A: 1.186e-08, B: 4.55984967028, C: 9808.5381846614},
+		{A: 1.346e-08, B: 4.94456950019, C: 384.0599212231},
+		{A: 1.514e-08, B: 3.6039229173, C: 2015.6710861598},
+		{A: 1.432e-08, B: 2.28704432909, C: 525.4981794006},
+		{A: 1.129e-08, B: 0.8710034062, C: 162.0933701068},
+		{A: 1.196e-08, B: 5.1348521485, C: 227.3137411185},
+		{A: 1.339e-08, B: 2.48923887712, C: 206.706813299},
+		{A: 1.421e-08, B: 1.65379789078, C: 857.1285350151},
+		{A: 1.162e-08, B: 1.92099315083, C: 220.9339073006},
+		{A: 1.277e-08, B: 4.85435999187, C: 54.1746707478},
+		{A: 1.153e-08, B: 5.33028034679, C: 233.9060232575},
+		{A: 1.214e-08, B: 4.11324721963, C: 3377.217792004},
+		{A: 1.109e-08, B: 5.68915582674, C: 162.8965192589},
+		{A: 1.068e-08, B: 4.85383480876, C: 611.4430983108},
+		{A: 1.119e-08, B: 1.40805686363, C: 1987.2168981566},
+		{A: 1.085e-08, B: 0.6420814819, C: 731.6837278378},
+		{A: 1.435e-08, B: 3.20880139888, C: 835.7878940127},
+		{A: 1.184e-08, B: 2.99776919968, C: 199.2844497575},
+		{A: 1.281e-08, B: 3.1224533951, C: 427.3489504014},
+		{A: 1.058e-08, B: 5.17851282929, C: 306.0969289181},
+		{A: 1.152e-08, B: 4.39244449554, C: 199.9657733137},
+		{A: 1.036e-08, B: 3.68027119804, C: 597.3590166611},
+		{A: 1.055e-08, B: 3.25561743426, C: 394.3548619616},
+		{A: 1.127e-08, B: 4.3325537196, C: 552.5855147745},
+		{A: 1.213e-08, B: 6.2144761211, C: 42.5382696529},
+		{A: 1.117e-08, B: 3.74367882111, C: 214.1928673153},
+		{A: 1.023e-08, B: 3.84199833949, C: 894.8408795276},
+		{A: 1.042e-08, B: 5.3012007859, C: 450.9772132642},
+		{A: 1.29e-08, B: 3.96221234564, C: 318.8395502114},
+		{A: 1.073e-08, B: 4.10012122884, C: 188.1693135244},
+		{A: 1.204e-08, B: 0.3770236575, C: 393.4610900843},
+		{A: 1.214e-08, B: 2.01826978554, C: 401.3253966105},
+		{A: 1.018e-08, B: 0.02946649279, C: 2840.4132799086},
+		{A: 1.237e-08, B: 5.41088851225, C: 425.8474313506},
+		{A: 1.187e-08, B: 5.16511890602, C: 838.218528225},
+		{A: 1.276e-08, B: 2.93572146232, C: 1457.525933062},
+		{A: 9.94e-09, B: 3.40079885702, C: 211.6021744086},
+		{A: 1.042e-08, B: 2.42209320898, C: 361.3778198643},
+		{A: 1.093e-08, B: 3.66289018246, C: 226.6324175623},
+		{A: 9.78e-09, B: 3.76334208607, C: 5856.4776591154},
+		{A: 1.263e-08, B: 2.09195268609, C: 78.7137518304},
+		{A: 1.009e-08, B: 5.85963705048, C: 1268.7488723641},
+		{A: 1.148e-08, B: 4.39543895068, C: 570.7447620392},
+		{A: 1.051e-08, B: 3.27272240682, C: 153.4953503977},
+		{A: 9.75e-09, B: 3.42924642244, C: 105.5404547734},
+		{A: 9.97e-09, B: 4.30943991893, C: 212.4053235607},
+		{A: 9.54e-09, B: 3.88548755058, C: 171.6545976624},
+		{A: 9.6e-09, B: 1.9018000528, C: 1159.2933106701},
+		{A: 9.53e-09, B: 3.40787141587, C: 244.791664827},
+		{A: 9.69e-09, B: 1.93369993197, C: 525.7588118315},
+		{A: 9.18e-09, B: 1.73738789723, C: 223.3334037456},
+		{A: 1.164e-08, B: 5.05392864346, C: 263.7016716171},
+		{A: 9.51e-09, B: 4.23581224839, C: 92.940845832},
+		{A: 1.16e-08, B: 5.80630916592, C: 460.5384408198},
+		{A: 1.186e-08, B: 4.46262000755, C: 465.9550667912},
+		{A: 9.31e-09, B: 2.09868057209, C: 205.6642835754},
+		{A: 9.42e-09, B: 3.86810837922, C: 238.4288773516},
+		{A: 1.02e-08, B: 5.53181822898, C: 0.0481841098},
+		{A: 9.95e-09, B: 2.0345788549, C: 6290.1893969922},
+		{A: 8.88e-09, B: 2.6095759299, C: 1912.5783119412},
+		{A: 8.73e-09, B: 5.7843339302, C: 480.7728616238},
+		{A: 8.54e-09, B: 1.63255087291, C: 328.2407190726},
+		{A: 1.123e-08, B: 4.07401922216, C: 3693.6096616606},
+		{A: 9.34e-09, B: 3.52355235083, C: 10220.3990932118},
+		{A: 8.33e-09, B: 3.0330222784, C: 532.8723588323},
+		{A: 1.007e-08, B: 2.73615455688, C: 4841.8572720668},
+		{A: 8.7e-09, B: 1.06968760644, C: 51.2057253312},
+		{A: 8.91e-09, B: 1.36817544763, C: 700.4517908797},
+		{A: 8.33e-09, B: 5.39754715806, C: 159.7151255212},
+		{A: 8.54e-09, B: 1.91765015557, C: 622.4887985747},
+		{A: 9.76e-09, B: 3.09106001923, C: 2332.0629558164},
+		{A: 8.19e-09, B: 5.55683690482, C: 462.0229135281},
+		{A: 8e-09, B: 1.4704267746, C: 969.6224780949},
+		{A: 9.33e-09, B: 1.40166917666, C: 287.9376816534},
+		{A: 7.84e-09, B: 1.6923516277, C: 477.8039162072},
+		{A: 7.82e-09, B: 3.98153416585, C: 702.1487119091},
+		{A: 8.1e-09, B: 5.87689161549, C: 561.934294009},
+		{A: 8.58e-09, B: 4.02964773169, C: 41.6444977756},
+		{A: 8.19e-09, B: 0.98885784755, C: 960.2213092337},
+		{A: 8.82e-09, B: 1.49559638306, C: 760.25553592},
+		{A: 7.67e-09, B: 2.46787654531, C: 402.2191684878},
+		{A: 8.51e-09, B: 3.64678001195, C: 348.635198571},
+		{A: 8.52e-09, B: 1.03470840672, C: 2620.0006374698},
+		{A: 7.7e-09, B: 4.67090683753, C: 16.6747745564},
+		{A: 8.49e-09, B: 5.27994730935, C: 74.6385862154},
+		{A: 8.54e-09, B: 2.56811257488, C: 432.7485300303},
+		{A: 7.67e-09, B: 1.11806243753, C: 2847.5268269094},
+		{A: 7.29e-09, B: 0.4417199071, C: 898.7730327907},
+		{A: 7.76e-09, B: 5.54603607568, C: 3171.0322435668},
+		{A: 7.21e-09, B: 6.05392551158, C: 91.7864415238},
+		{A: 7.39e-09, B: 3.57172839746, C: 775.233389447},
+		{A: 7.3e-09, B: 4.98865345688, C: 705.1176573257},
+		{A: 7.05e-09, B: 0.4494244575, C: 219.6618829134},
+		{A: 7.08e-09, B: 0.69014726046, C: 1048.3362299253},
+		{A: 7.06e-09, B: 2.22974712805, C: 29.2049475286},
+		{A: 7.11e-09, B: 0.00981284716, C: 2115.5824666407},
+		{A: 7.22e-09, B: 4.14075205197, C: 225.0785088848},
+		{A: 7.35e-09, B: 1.54083195463, C: 201.9927627432},
+		{A: 7.46e-09, B: 4.08997409526, C: 849.2642284889},
+		{A: 7.29e-09, B: 2.81779134868, C: 419.532827985},
+		{A: 8.37e-09, B: 1.00844734156, C: 4127.3213995374},
+		{A: 9.39e-09, B: 0.46037763932, C: 5488.8681053816},
+		{A: 9.39e-09, B: 1.85473712038, C: 5062.2699145056},
+		{A: 7.21e-09, B: 1.62872794201, C: 2200.5159935946},
+	},
+	// R2, 342 terms
+	{
+		{A: 0.00436902464, B: 4.78671673044, C: 213.299095438},
+		{A: 0.0007192276, B: 2.50069994874, C: 206.1855484372},
+		{A: 0.00049766792, B: 4.9716815087, C: 220.4126424388},
+		{A: 0.00043220894, B: 3.86940443794, C: 426.598190876},
+		{A: 0.00029645554, B: 5.96310264282, C: 7.1135470008},
+		{A: 4.14165e-05, B: 4.10670940823, C: 433.7117378768},
+		{A: 4.720909e-05, B: 2.47527992423, C: 199.0720014364},
+		{A: 3.78937e-05, B: 3.09771025067, C: 639.897286314},
+		{A: 2.96399e-05, B: 1.37206248846, C: 103.0927742186},
+		{A: 2.556363e-05, B: 2.85065721526, C: 419.4846438752},
+		{A: 2.208457e-05, B: 6.27588858707, C: 110.2063212194},
+		{A: 2.187621e-05, B: 5.85545832218, C: 14.2270940016},
+		{A: 1.956896e-05, B: 4.92448618045, C: 227.5261894396},
+		{A: 2.326801e-05, B: 0, C: 0},
+		{A: 9.2384e-06, B: 5.46392422737, C: 323.5054166574},
+		{A: 7.05936e-06, B: 2.97081280098, C: 95.9792272178},
+		{A: 5.46115e-06, B: 4.12854181522, C: 412.3710968744},
+		{A: 3.73838e-06, B: 5.83435991809, C: 117.3198682202},
+		{A: 3.60882e-06, B: 3.27703082368, C: 647.0108333148},
+		{A: 3.5635e-06, B: 3.19152043942, C: 210.1177017003},
+		{A: 3.90627e-06, B: 4.48106176893, C: 216.4804891757},
+		{A: 4.31485e-06, B: 5.17825414612, C: 522.5774180938},
+		{A: 3.25598e-06, B: 2.26867601656, C: 853.196381752},
+		{A: 4.05018e-06, B: 4.17294157872, C: 209.3669421749},
+		{A: 2.04494e-06, B: 0.0877484859, C: 202.2533951741},
+		{A: 2.06854e-06, B: 4.02188336738, C: 735.8765135318},
+		{A: 1.78474e-06, B: 4.09716541453, C: 440.8252848776},
+		{A: 1.80143e-06, B: 3.59704903955, C: 632.7837393132},
+		{A: 1.53656e-06, B: 3.13470530382, C: 625.6701923124},
+		{A: 1.47779e-06, B: 0.13614300541, C: 302.164775655},
+		{A: 1.23189e-06, B: 4.18895309647, C: 88.865680217},
+		{A: 1.33076e-06, B: 2.5935046942, C: 191.9584544356},
+		{A: 1.00367e-06, B: 5.46056190585, C: 3.1813937377},
+		{A: 1.31975e-06, B: 5.93293968941, C: 309.2783226558},
+		{A: 9.7235e-07, B: 4.01832604356, C: 728.762966531},
+		{A: 1.10709e-06, B: 4.77853798276, C: 838.9692877504},
+		{A: 1.19053e-06, B: 5.55385105975, C: 224.3447957019},
+		{A: 9.3852e-07, B: 4.38395529912, C: 217.2312487011},
+		{A: 1.08701e-06, B: 5.29310899841, C: 515.463871093},
+		{A: 7.8609e-07, B: 5.72525447528, C: 21.3406410024},
+		{A: 8.1468e-07, B: 5.10897365253, C: 956.2891559706},
+		{A: 9.6412e-07, B: 6.25859229567, C: 742.9900605326},
+		{A: 6.9228e-07, B: 4.04901237761, C: 3.9321532631},
+		{A: 6.5168e-07, B: 3.77713343518, C: 1052.2683831884},
+		{A: 6.4088e-07, B: 5.81235002453, C: 529.6909650946},
+		{A: 6.2541e-07, B: 2.18445116349, C: 195.1398481733},
+		{A: 5.6987e-07, B: 3.14666549033, C: 203.0041546995},
+		{A: 5.5979e-07, B: 4.8410842286, C: 234.6397364404},
+		{A: 5.294e-07, B: 5.07780548444, C: 330.6189636582},
+		{A: 5.0635e-07, B: 2.77318570728, C: 942.062061969},
+		{A: 4.1649e-07, B: 4.79014211005, C: 63.7358983034},
+		{A: 4.4858e-07, B: 0.56460613593, C: 269.9214467406},
+		{A: 4.1357e-07, B: 3.73496404402, C: 316.3918696566},
+		{A: 5.2847e-07, B: 3.92623831484, C: 949.1756089698},
+		{A: 3.8398e-07, B: 3.73966157784, C: 1045.1548361876},
+		{A: 3.7583e-07, B: 4.18924633757, C: 536.8045120954},
+		{A: 3.5285e-07, B: 2.90795856092, C: 284.1485407422},
+		{A: 3.3576e-07, B: 3.80465978802, C: 149.5631971346},
+		{A: 4.1073e-07, B: 4.57870454147, C: 1155.361157407},
+		{A: 3.0412e-07, B: 2.48140171991, C: 860.3099287528},
+		{A: 3.1373e-07, B: 4.84075951849, C: 1272.6810256272},
+		{A: 3.0218e-07, B: 4.3518629447, C: 405.2575498736},
+		{A: 3.943e-07, B: 3.50858482049, C: 422.6660376129},
+		{A: 2.9658e-07, B: 1.58886982096, C: 1066.49547719},
+		{A: 3.5202e-07, B: 5.94478241578, C: 1059.3819301892},
+		{A: 2.5829e-07, B: 3.54946335477, C: 1368.660252845},
+		{A: 2.6283e-07, B: 4.81567477177, C: 124.433415221},
+		{A: 2.9963e-07, B: 3.66312205813, C: 429.7795846137},
+		{A: 3.3011e-07, B: 4.96879544579, C: 831.8557407496},
+		{A: 2.4305e-07, B: 5.31133255082, C: 10.2949407385},
+		{A: 2.6332e-07, B: 4.4525327339, C: 223.5940361765},
+		{A: 2.2108e-07, B: 2.76092021113, C: 415.5524906121},
+		{A: 2.7187e-07, B: 1.66347897738, C: 277.0349937414},
+		{A: 2.1639e-07, B: 1.03836302307, C: 11.0457002639},
+		{A: 1.9713e-07, B: 2.52194629263, C: 1258.4539316256},
+		{A: 1.7062e-07, B: 3.27669927228, C: 654.1243803156},
+		{A: 1.7261e-07, B: 3.49414816663, C: 1361.5467058442},
+		{A: 1.6097e-07, B: 1.73396878598, C: 490.3340891794},
+		{A: 2.1099e-07, B: 3.62102032955, C: 1265.5674786264},
+		{A: 1.7692e-07, B: 4.31141612385, C: 1471.7530270636},
+		{A: 1.3458e-07, B: 0.32327889681, C: 295.0512286542},
+		{A: 1.2586e-07, B: 3.13794576887, C: 74.7815985673},
+		{A: 1.2023e-07, B: 2.32917797741, C: 210.8514148832},
+		{A: 1.512e-07, B: 3.59558424278, C: 265.9892934775},
+		{A: 1.2959e-07, B: 4.62359706368, C: 1589.0728952838},
+		{A: 1.5424e-07, B: 5.01335704925, C: 127.4717966068},
+		{A: 1.1193e-07, B: 4.54981248285, C: 81.7521332162},
+		{A: 1.3449e-07, B: 4.88710089777, C: 437.6438911399},
+		{A: 1.0673e-07, B: 5.05234757424, C: 191.2076949102},
+		{A: 1.3963e-07, B: 3.04990968366, C: 423.4167971383},
+		{A: 1.0614e-07, B: 5.02845923229, C: 137.0330241624},
+		{A: 1.4382e-07, B: 4.68720080027, C: 1148.2476104062},
+		{A: 1.347e-07, B: 1.90280407135, C: 408.4389436113},
+		{A: 1.0077e-07, B: 5.20426583827, C: 340.7708920448},
+		{A: 1.0323e-07, B: 3.34460279759, C: 1685.0521225016},
+		{A: 9.563e-08, B: 3.17317920222, C: 351.8165923087},
+		{A: 1.1295e-07, B: 5.47808960704, C: 1375.7737998458},
+		{A: 8.617e-08, B: 2.81294528041, C: 99.9113804809},
+		{A: 8.46e-08, B: 3.22691940753, C: 1677.9385755008},
+		{A: 7.914e-08, B: 2.35624291874, C: 1574.8458012822},
+		{A: 7.587e-08, B: 6.08171425316, C: 231.4583427027},
+		{A: 9.175e-08, B: 3.40072244924, C: 1581.959348283},
+		{A: 7.337e-08, B: 2.00393601815, C: 131.4039498699},
+		{A: 8.24e-08, B: 4.04095881407, C: 1788.1448967202},
+		{A: 7.579e-08, B: 3.68311134272, C: 846.0828347512},
+		{A: 6.691e-08, B: 4.37253800717, C: 145.6310438715},
+		{A: 7.539e-08, B: 3.29482043104, C: 750.1036075334},
+		{A: 6.367e-08, B: 4.00239137708, C: 447.9388318784},
+		{A: 6.249e-08, B: 4.5560367194, C: 106.2741679563},
+		{A: 6.489e-08, B: 1.33782087599, C: 215.7467759928},
+		{A: 6.501e-08, B: 3.78204726337, C: 313.2104759189},
+		{A: 5.978e-08, B: 0.55276980086, C: 18.1592472647},
+		{A: 6.171e-08, B: 2.84712795642, C: 138.5174968707},
+		{A: 6.837e-08, B: 4.83481646949, C: 319.5732633943},
+		{A: 6.678e-08, B: 5.43046031699, C: 508.3503240922},
+		{A: 7.175e-08, B: 4.37855723752, C: 1464.6394800628},
+		{A: 5.753e-08, B: 4.14268749228, C: 543.9180590962},
+		{A: 5.727e-08, B: 4.35383078313, C: 1905.4647649404},
+		{A: 5.101e-08, B: 2.63866058897, C: 288.0806940053},
+		{A: 5.311e-08, B: 3.6252084951, C: 6076.8903015542},
+		{A: 5.498e-08, B: 4.19972735173, C: 721.6494195302},
+		{A: 5.089e-08, B: 5.04845206653, C: 10007.0999977738},
+		{A: 5.505e-08, B: 1.13479635941, C: 56.6223513026},
+		{A: 4.82e-08, B: 3.30043078578, C: 76.2660712756},
+		{A: 4.915e-08, B: 6.17790518458, C: 483.2205421786},
+		{A: 5.048e-08, B: 2.44627820757, C: 628.8515860501},
+		{A: 4.534e-08, B: 1.19648682598, C: 200.7689224658},
+		{A: 4.817e-08, B: 3.11549733365, C: 2001.4439921582},
+		{A: 4.712e-08, B: 1.26507812515, C: 6062.6632075526},
+		{A: 4.811e-08, B: 5.78388270496, C: 184.8449074348},
+		{A: 4.775e-08, B: 0.76197795755, C: 333.657345044},
+		{A: 4.514e-08, B: 0.95293919611, C: 343.2185725996},
+		{A: 4.525e-08, B: 2.68827745072, C: 9992.8729037722},
+		{A: 4.378e-08, B: 0.80241129896, C: 222.8603229936},
+		{A: 4.873e-08, B: 5.92092913946, C: 618.5566453116},
+		{A: 4.142e-08, B: 1.91878383159, C: 497.4476361802},
+		{A: 5.112e-08, B: 4.50449287745, C: 416.3032501375},
+		{A: 4.125e-08, B: 1.98204847532, C: 347.8844390456},
+		{A: 4.045e-08, B: 2.87666810085, C: 38.1330356378},
+		{A: 4.133e-08, B: 2.90478811425, C: 107.0249274817},
+		{A: 4.035e-08, B: 2.92972681787, C: 1994.3304451574},
+		{A: 4.916e-08, B: 3.12316267561, C: 1898.3512179396},
+		{A: 3.657e-08, B: 3.24680246734, C: 362.8622925726},
+		{A: 3.753e-08, B: 0.87719890943, C: 703.6331846174},
+		{A: 3.576e-08, B: 3.48080143501, C: 388.4651552382},
+		{A: 3.555e-08, B: 4.08436297683, C: 430.5303441391},
+		{A: 3.598e-08, B: 0.05255328597, C: 32.2433289144},
+		{A: 3.561e-08, B: 5.46414552453, C: 6283.0758499914},
+		{A: 3.48e-08, B: 1.81622589595, C: 70.8494453042},
+		{A: 3.827e-08, B: 3.1204122849, C: 635.9651330509},
+		{A: 3.399e-08, B: 0.54882815021, C: 10213.285546211},
+		{A: 3.399e-08, B: 3.5183335608, C: 629.6023455755},
+		{A: 3.364e-08, B: 3.27821747958, C: 357.4456666012},
+		{A: 3.26e-08, B: 1.97623748027, C: 203.7378678824},
+		{A: 3.118e-08, B: 2.18465627368, C: 1891.2376709388},
+		{A: 3.163e-08, B: 1.26040995242, C: 134.5853436076},
+		{A: 4.004e-08, B: 5.45434102599, C: 1692.1656695024},
+		{A: 3.18e-08, B: 2.46319174788, C: 867.4234757536},
+		{A: 3.389e-08, B: 4.20503159673, C: 337.732510659},
+		{A: 3.026e-08, B: 2.19331614526, C: 217.964961884},
+		{A: 3.573e-08, B: 5.5509724081, C: 113.3877149571},
+		{A: 3.682e-08, B: 3.78966280284, C: 2104.5367663768},
+		{A: 3.125e-08, B: 4.09203641264, C: 1478.8665740644},
+		{A: 2.881e-08, B: 3.9081065024, C: 312.1990839626},
+		{A: 3.199e-08, B: 3.92123638342, C: 1038.0412891868},
+		{A: 4.014e-08, B: 5.17826893553, C: 404.5067903482},
+		{A: 3.907e-08, B: 4.1176719178, C: 1781.0313497194},
+		{A: 3.144e-08, B: 1.61185684069, C: 1073.6090241908},
+		{A: 3.072e-08, B: 5.00675625396, C: 312.4597163935},
+		{A: 3.034e-08, B: 5.46288652854, C: 258.8757464767},
+		{A: 2.884e-08, B: 2.38477237305, C: 181.0557665236},
+		{A: 2.986e-08, B: 0.88783591586, C: 1279.794572628},
+		{A: 2.683e-08, B: 0.00956197492, C: 195.8906076987},
+		{A: 3.081e-08, B: 5.6003473733, C: 216.2198567448},
+		{A: 2.626e-08, B: 6.12701960244, C: 273.1028404783},
+		{A: 2.665e-08, B: 2.31576422128, C: 565.1156877467},
+		{A: 3.245e-08, B: 3.87540558646, C: 85.8272988312},
+		{A: 2.74e-08, B: 5.73784096806, C: 160.6088973985},
+		{A: 2.876e-08, B: 4.74720607366, C: 213.2509113282},
+		{A: 2.523e-08, B: 5.30458920892, C: 444.7574381407},
+		{A: 2.752e-08, B: 5.0898453993, C: 1169.5882514086},
+		{A: 2.889e-08, B: 1.66674437398, C: 213.3472795478},
+		{A: 2.923e-08, B: 4.21481009033, C: 650.9429865779},
+		{A: 3.036e-08, B: 2.5542667535, C: 6069.7767545534},
+		{A: 3.116e-08, B: 2.67220972004, C: 52.6901980395},
+		{A: 2.371e-08, B: 0.89591351822, C: 121.2520214833},
+		{A: 2.993e-08, B: 3.96957827454, C: 9999.986450773},
+		{A: 3.088e-08, B: 0.40656113014, C: 561.1835344836},
+		{A: 2.385e-08, B: 4.74063881551, C: 218.7157214094},
+		{A: 2.632e-08, B: 1.12706218927, C: 344.7030453079},
+		{A: 2.316e-08, B: 4.08445262041, C: 131.5469622218},
+		{A: 2.214e-08, B: 3.37726228553, C: 22.0914005278},
+		{A: 2.129e-08, B: 3.32497715011, C: 358.9301393095},
+		{A: 2.679e-08, B: 1.6897140187, C: 208.633228992},
+		{A: 2.607e-08, B: 5.10250482155, C: 824.7421937488},
+		{A: 2.25e-08, B: 2.60474848767, C: 305.3461693927},
+		{A: 2.087e-08, B: 3.37293958793, C: 320.3240229197},
+		{A: 2.693e-08, B: 3.6215945647, C: 436.8931316145},
+		{A: 2.492e-08, B: 2.96129217279, C: 2214.7430875962},
+		{A: 2.704e-08, B: 2.88483697319, C: 643.0786800517},
+		{A: 2.124e-08, B: 1.61210282593, C: 218.9281697305},
+		{A: 2.037e-08, B: 4.63481160778, C: 188.0263011725},
+		{A: 2.394e-08, B: 3.46386258552, C: 6275.9623029906},
+		{A: 1.973e-08, B: 2.28886138203, C: 2627.1141844706},
+		{A: 1.937e-08, B: 5.67082364247, C: 28.4541880032},
+		{A: 1.92e-08, B: 4.25647211328, C: 546.956440482},
+		{A: 2.498e-08, B: 3.57572154405, C: 2420.9286360334},
+		{A: 1.898e-08, B: 1.30987536388, C: 212.3358875915},
+		{A: 1.852e-08, B: 1.58508015515, C: 424.1505103212},
+		{A: 1.85e-08, B: 3.57830449726, C: 329.7251917809},
+		{A: 2.128e-08, B: 3.95329215734, C: 1795.258443721},
+		{A: 2.236e-08, B: 4.22073549375, C: 2221.856634597},
+		{A: 1.933e-08, B: 1.68771499202, C: 350.3321196004},
+		{A: 1.799e-08, B: 2.06541260431, C: 144.1465711632},
+		{A: 1.904e-08, B: 4.60953896857, C: 182.279606801},
+		{A: 2.236e-08, B: 5.17945392885, C: 99.1606209555},
+		{A: 1.755e-08, B: 2.73425330428, C: 291.262087743},
+		{A: 2.231e-08, B: 5.42548168745, C: 207.8824694666},
+		{A: 1.848e-08, B: 2.24194286719, C: 168.0525127994},
+		{A: 1.726e-08, B: 1.31878655393, C: 219.4494345923},
+		{A: 1.709e-08, B: 5.55913931846, C: 92.7978334801},
+		{A: 1.693e-08, B: 1.95360003617, C: 129.9194771616},
+		{A: 2.064e-08, B: 4.84900344498, C: 1141.1340634054},
+		{A: 1.758e-08, B: 5.05088656436, C: 214.2623032845},
+		{A: 1.781e-08, B: 2.8588015334, C: 636.7158925763},
+		{A: 1.9e-08, B: 2.90295578617, C: 2310.722314814},
+		{A: 1.759e-08, B: 5.34657858395, C: 45.5766510387},
+		{A: 1.654e-08, B: 6.14450664508, C: 554.0699874828},
+		{A: 1.578e-08, B: 4.50941374663, C: 210.3783341312},
+		{A: 1.681e-08, B: 3.55136706992, C: 1354.4331588434},
+		{A: 1.862e-08, B: 3.01276783582, C: 2317.8358618148},
+		{A: 1.589e-08, B: 1.1577344835, C: 235.3904959658},
+		{A: 1.551e-08, B: 2.15558953807, C: 207.6700211455},
+		{A: 1.874e-08, B: 4.12861627986, C: 225.8292684102},
+		{A: 1.621e-08, B: 3.29992957653, C: 1670.8250285},
+		{A: 1.911e-08, B: 0.1772431914, C: 12.5301729722},
+		{A: 1.477e-08, B: 5.9027026057, C: 1.4844727083},
+		{A: 1.618e-08, B: 5.72513459206, C: 1485.9801210652},
+		{A: 1.446e-08, B: 1.7810458992, C: 1382.8873468466},
+		{A: 1.683e-08, B: 3.43534671475, C: 2428.0421830342},
+		{A: 1.542e-08, B: 5.51223038941, C: 204.7010757289},
+		{A: 1.42e-08, B: 2.07339356364, C: 198.321241911},
+		{A: 1.444e-08, B: 5.56032454849, C: 128.3655684841},
+		{A: 1.476e-08, B: 6.12782257368, C: 212.7778305762},
+		{A: 1.474e-08, B: 0.33626790634, C: 213.8203602998},
+		{A: 1.428e-08, B: 3.25039966249, C: 945.9942152321},
+		{A: 1.41e-08, B: 0.68747644676, C: 429.0458714308},
+		{A: 1.752e-08, B: 2.70090942746, C: 12.7426212933},
+		{A: 1.681e-08, B: 4.97526853273, C: 2008.557539159},
+		{A: 1.408e-08, B: 0.80461100746, C: 1585.1407420207},
+		{A: 1.485e-08, B: 0.49674043855, C: 120.358249606},
+		{A: 1.49e-08, B: 2.68459799437, C: 207.1487562837},
+		{A: 1.411e-08, B: 4.36399216092, C: 5863.5912061162},
+		{A: 1.315e-08, B: 4.73430848989, C: 241.7532834412},
+		{A: 1.516e-08, B: 4.99488503706, C: 1162.4747044078},
+		{A: 1.31e-08, B: 1.98714265058, C: 563.6312150384},
+		{A: 1.286e-08, B: 2.12891372062, C: 251.4321310758},
+		{A: 1.271e-08, B: 5.70165238307, C: 2.9207613068},
+		{A: 1.312e-08, B: 1.68811514551, C: 2207.6295405954},
+		{A: 1.259e-08, B: 0.35924965717, C: 334.5511169213},
+		{A: 1.252e-08, B: 2.14513440216, C: 1055.4497769261},
+		{A: 1.401e-08, B: 6.13250261735, C: 1802.3719907218},
+		{A: 1.343e-08, B: 5.79995727295, C: 9793.8009023358},
+		{A: 1.228e-08, B: 3.29059284057, C: 661.2379273164},
+		{A: 1.202e-08, B: 2.88792018909, C: 2413.8150890326},
+		{A: 1.286e-08, B: 5.72360160371, C: 298.2326223919},
+		{A: 1.357e-08, B: 0.93175963411, C: 217.491881132},
+		{A: 1.356e-08, B: 2.28121627817, C: 601.7642506762},
+		{A: 1.19e-08, B: 1.94993809928, C: 501.3797894433},
+		{A: 1.304e-08, B: 0.3733792328, C: 3473.1970192218},
+		{A: 1.35e-08, B: 2.8723562232, C: 142.4496501338},
+		{A: 1.349e-08, B: 3.21102203937, C: 175.1660598002},
+		{A: 1.312e-08, B: 3.70149813509, C: 2111.6503133776},
+		{A: 1.129e-08, B: 1.08860603834, C: 842.1506814881},
+		{A: 1.237e-08, B: 0.08698781252, C: 526.5095713569},
+		{A: 1.217e-08, B: 3.8983534984, C: 209.106309744},
+		{A: 1.467e-08, B: 1.16228775027, C: 621.7380390493},
+		{A: 1.044e-08, B: 0.30512759901, C: 436.1594184316},
+		{A: 1.14e-08, B: 5.33720637097, C: 114.1384744825},
+		{A: 1.295e-08, B: 4.70261675421, C: 9786.687355335},
+		{A: 1.037e-08, B: 4.07846687083, C: 156.6767441354},
+		{A: 1.391e-08, B: 4.73554028436, C: 398.1440028728},
+		{A: 1.167e-08, B: 5.68899703631, C: 479.2883889155},
+		{A: 1.035e-08, B: 5.34279429465, C: 327.4375699205},
+		{A: 9.97e-09, B: 1.19323192891, C: 710.7467316182},
+		{A: 1.193e-08, B: 5.17722376816, C: 98.8999885246},
+		{A: 1.165e-08, B: 4.58588490135, C: 732.6951197941},
+		{A: 1.161e-08, B: 4.90854984994, C: 10206.1719992102},
+		{A: 1.144e-08, B: 0.5039478414, C: 3906.9087570986},
+		{A: 1.182e-08, B: 3.69482624364, C: 2854.6403739102},
+		{A: 9.7e-09, B: 2.89031410383, C: 1987.2168981566},
+		{A: 1.039e-08, B: 0.48694895443, C: 525.4981794006},
+		{A: 1.079e-08, B: 3.61750956217, C: 2097.423219376},
+		{A: 1.148e-08, B: 3.31015591733, C: 5856.4776591154},
+		{A: 1.241e-08, B: 4.31971543677, C: 230.7075831773},
+		{A: 9.1e-09, B: 4.59825926062, C: 380.12776796},
+		{A: 9.07e-09, B: 1.34912454077, C: 685.4739373527},
+		{A: 1.166e-08, B: 1.61085609717, C: 5849.3641121146},
+		{A: 8.82e-09, B: 6.12045540405, C: 519.3960243561},
+		{A: 9.63e-09, B: 4.96065454054, C: 699.7010313543},
+		{A: 1.062e-08, B: 5.13323858077, C: 2751.5475996916},
+		{A: 8.65e-09, B: 6.12821112133, C: 245.5424243524},
+		{A: 1.1e-08, B: 2.18435744407, C: 1699.2792165032},
+		{A: 8.22e-09, B: 5.55083534581, C: 739.0579072695},
+		{A: 9.26e-09, B: 2.01158276144, C: 417.0369633204},
+		{A: 8.13e-09, B: 5.18401872205, C: 214.7835681463},
+		{A: 1.033e-08, B: 5.48677848094, C: 3995.7744373156},
+		{A: 8.72e-09, B: 3.02363724703, C: 306.0969289181},
+		{A: 7.96e-09, B: 0.4434366454, C: 486.4019359163},
+		{A: 8.78e-09, B: 1.82164034386, C: 135.336103133},
+		{A: 7.91e-09, B: 2.14989417962, C: 2620.0006374698},
+		{A: 8.81e-09, B: 2.39697554334, C: 289.5651667136},
+		{A: 7.82e-09, B: 4.50471317138, C: 980.6681783588},
+		{A: 7.83e-09, B: 1.14229319753, C: 540.7366653585},
+		{A: 8.31e-09, B: 0.69937251013, C: 421.93232443},
+		{A: 7.7e-09, B: 2.40292326155, C: 576.1613880106},
+		{A: 9.5e-09, B: 5.97621460162, C: 196.6243208816},
+		{A: 8.14e-09, B: 4.19303098086, C: 831.1049812242},
+		{A: 9.69e-09, B: 4.78071024754, C: 326.6868103951},
+		{A: 7.6e-09, B: 0.4486053353, C: 425.6349830295},
+		{A: 9.07e-09, B: 0.94781730418, C: 525.7588118315},
+		{A: 7.88e-09, B: 0.14287187051, C: 916.9322800554},
+		{A: 8.01e-09, B: 1.863831191, C: 3039.485281345},
+		{A: 8.01e-09, B: 0.46947170994, C: 3466.083472221},
+		{A: 7.47e-09, B: 6.05374861925, C: 211.8146227297},
+		{A: 9.68e-09, B: 3.02618272726, C: 2634.2277314714},
+		{A: 7.39e-09, B: 2.27110740297, C: 2303.6087678132},
+		{A: 7.5e-09, B: 5.48554383902, C: 173.9422195228},
+		{A: 1.024e-08, B: 1.9156492556, C: 229.9738699944},
+		{A: 8.16e-09, B: 4.98990432666, C: 4209.0735327536},
+		{A: 7.28e-09, B: 1.30997967935, C: 511.5317178299},
+		{A: 7.16e-09, B: 3.74192651696, C: 3053.7123753466},
+		{A: 7.27e-09, B: 0.39191881243, C: 1493.093668066},
+		{A: 7.17e-09, B: 2.68899513085, C: 228.276948965},
+		{A: 7.39e-09, B: 2.12749199443, C: 1176.7017984094},
+		{A: 8.05e-09, B: 0.0718719391, C: 556.5176680376},
+		{A: 8.35e-09, B: 3.482878557, C: 84.9335269539},
+		{A: 7.9e-09, B: 0.48073040004, C: 4017.115078318},
+		{A: 7.25e-09, B: 1.96643065215, C: 220.4608265486},
+		{A: 6.83e-09, B: 2.68825142163, C: 151.0476698429},
+		{A: 7.39e-09, B: 3.33688408107, C: 953.1077622329},
+		{A: 7.45e-09, B: 6.22304530635, C: 1269.4996318895},
+	},
+	// R3, 157 terms
+	{
+		{A: 0.00020315005, B: 3.02186626038, C: 213.299095438},
+		{A: 8.923581e-05, B: 3.19144205755, C: 220.4126424388},
+		{A: 6.908677e-05, B: 4.35174889353, C: 206.1855484372},
+		{A: 4.087129e-05, B: 4.22406927376, C: 7.1135470008},
+		{A: 3.879041e-05, B: 2.01056445995, C: 426.598190876},
+		{A: 1.070788e-05, B: 4.20360341236, C: 199.0720014364},
+		{A: 9.07332e-06, B: 2.28344368029, C: 433.7117378768},
+		{A: 6.06121e-06, B: 3.17458570534, C: 227.5261894396},
+		{A: 5.96639e-06, B: 4.13455753351, C: 14.2270940016},
+		{A: 4.83181e-06, B: 1.17345973258, C: 639.897286314},
+		{A: 3.93174e-06, B: 0, C: 0},
+		{A: 2.29472e-06, B: 4.69838526383, C: 419.4846438752},
+		{A: 1.8825e-06, B: 4.59003889007, C: 110.2063212194},
+		{A: 1.49508e-06, B: 3.201994444, C: 103.0927742186},
+		{A: 1.21442e-06, B: 3.76831374104, C: 323.5054166574},
+		{A: 1.01215e-06, B: 5.81884137755, C: 412.3710968744},
+		{A: 1.02146e-06, B: 4.70974422803, C: 95.9792272178},
+		{A: 9.3078e-07, B: 1.43531270909, C: 647.0108333148},
+		{A: 7.2601e-07, B: 4.15395598507, C: 117.3198682202},
+		{A: 8.4347e-07, B: 2.63462379693, C: 216.4804891757},
+		{A: 6.2198e-07, B: 2.31239345505, C: 440.8252848776},
+		{A: 4.5145e-07, B: 4.37317047297, C: 191.9584544356},
+		{A: 4.9536e-07, B: 2.38854232908, C: 209.3669421749},
+		{A: 5.4829e-07, B: 0.30526468471, C: 853.196381752},
+		{A: 4.0498e-07, B: 1.83836569765, C: 302.164775655},
+		{A: 3.8089e-07, B: 5.94455115525, C: 88.865680217},
+		{A: 3.2243e-07, B: 4.01146349387, C: 21.3406410024},
+		{A: 4.0671e-07, B: 0.6884518321, C: 522.5774180938},
+		{A: 2.8209e-07, B: 5.77193013961, C: 210.1177017003},
+		{A: 2.4976e-07, B: 3.06249709014, C: 234.6397364404},
+		{A: 2.0824e-07, B: 4.92570695678, C: 625.6701923124},
+		{A: 2.507e-07, B: 0.73137425284, C: 515.463871093},
+		{A: 1.7485e-07, B: 5.73135068691, C: 728.762966531},
+		{A: 1.8009e-07, B: 1.45593152612, C: 309.2783226558},
+		{A: 1.6927e-07, B: 3.52771580455, C: 3.1813937377},
+		{A: 1.3437e-07, B: 3.36479898106, C: 330.6189636582},
+		{A: 1.109e-07, B: 3.37212682914, C: 224.3447957019},
+		{A: 1.1082e-07, B: 3.41719974793, C: 956.2891559706},
+		{A: 9.978e-08, B: 1.58791582772, C: 202.2533951741},
+		{A: 1.1551e-07, B: 5.99093726182, C: 735.8765135318},
+		{A: 1.05e-07, B: 6.06911092266, C: 405.2575498736},
+		{A: 9.144e-08, B: 2.93557421591, C: 124.433415221},
+		{A: 8.737e-08, B: 4.65432480769, C: 632.7837393132},
+		{A: 1.0023e-07, B: 0.58247011625, C: 860.3099287528},
+		{A: 7.482e-08, B: 4.50669216436, C: 942.062061969},
+		{A: 1.0091e-07, B: 0.28268774007, C: 838.9692877504},
+		{A: 9.243e-08, B: 2.57034547708, C: 223.5940361765},
+		{A: 8.652e-08, B: 1.75808100881, C: 429.7795846137},
+		{A: 7.564e-08, B: 1.45635107202, C: 654.1243803156},
+		{A: 7.058e-08, B: 5.47394786065, C: 1045.1548361876},
+		{A: 6.97e-08, B: 1.51811695028, C: 422.6660376129},
+		{A: 8.067e-08, B: 4.48457709292, C: 742.9900605326},
+		{A: 6.817e-08, B: 4.83084424818, C: 316.3918696566},
+		{A: 7.693e-08, B: 0.43769724671, C: 831.8557407496},
+		{A: 7.934e-08, B: 4.20112367712, C: 195.1398481733},
+		{A: 6.119e-08, B: 2.33960392135, C: 269.9214467406},
+		{A: 5.589e-08, B: 1.14518720694, C: 284.1485407422},
+		{A: 5.564e-08, B: 4.18123189068, C: 529.6909650946},
+		{A: 5.034e-08, B: 2.12020038657, C: 295.0512286542},
+		{A: 6.556e-08, B: 3.42459866876, C: 10.2949407385},
+		{A: 5.544e-08, B: 2.46823271699, C: 536.8045120954},
+		{A: 6.189e-08, B: 6.0143382752, C: 1066.49547719},
+		{A: 5.649e-08, B: 0.82784598388, C: 217.2312487011},
+		{A: 4.264e-08, B: 3.23245736673, C: 1272.6810256272},
+		{A: 4.45e-08, B: 0.9247780859, C: 203.0041546995},
+		{A: 3.268e-08, B: 4.32777516976, C: 1258.4539316256},
+		{A: 3.655e-08, B: 0.05832123987, C: 81.7521332162},
+		{A: 3.951e-08, B: 0.11124996745, C: 1155.361157407},
+		{A: 3.773e-08, B: 6.01157059552, C: 1052.2683831884},
+		{A: 2.915e-08, B: 5.64342950039, C: 3.9321532631},
+		{A: 3.019e-08, B: 2.19411778004, C: 447.9388318784},
+		{A: 2.977e-08, B: 1.8938734255, C: 149.5631971346},
+		{A: 3.146e-08, B: 0.19215180096, C: 1148.2476104062},
+		{A: 2.763e-08, B: 0.92363342001, C: 508.3503240922},
+		{A: 2.79e-08, B: 4.97199778427, C: 1677.9385755008},
+		{A: 2.608e-08, B: 2.99591016813, C: 1589.0728952838},
+		{A: 2.881e-08, B: 5.40535671721, C: 1361.5467058442},
+		{A: 2.337e-08, B: 1.30362271569, C: 184.8449074348},
+		{A: 2.536e-08, B: 3.71412120849, C: 408.4389436113},
+		{A: 2.45e-08, B: 3.22118361135, C: 319.5732633943},
+		{A: 2.585e-08, B: 2.31346415454, C: 543.9180590962},
+		{A: 2.324e-08, B: 5.87500715503, C: 721.6494195302},
+		{A: 1.99e-08, B: 0.51565577383, C: 416.3032501375},
+		{A: 2.49e-08, B: 4.24017800021, C: 1059.3819301892},
+		{A: 1.935e-08, B: 2.41463084855, C: 337.732510659},
+		{A: 1.886e-08, B: 0.53809070779, C: 635.9651330509},
+		{A: 1.893e-08, B: 5.62352727352, C: 11.0457002639},
+		{A: 2.389e-08, B: 5.73399981234, C: 313.2104759189},
+		{A: 1.9e-08, B: 2.41000566465, C: 131.5469622218},
+		{A: 1.743e-08, B: 4.57646237847, C: 1994.3304451574},
+		{A: 1.913e-08, B: 5.17436386408, C: 2854.6403739102},
+		{A: 1.946e-08, B: 6.23355845623, C: 1471.7530270636},
+		{A: 1.963e-08, B: 6.17814558628, C: 1464.6394800628},
+		{A: 1.838e-08, B: 5.59464577559, C: 1038.0412891868},
+		{A: 1.541e-08, B: 0.60765337379, C: 210.8514148832},
+		{A: 1.617e-08, B: 1.75479346067, C: 195.8906076987},
+		{A: 1.577e-08, B: 0.55789908488, C: 2324.9494088156},
+		{A: 1.492e-08, B: 0.26624235633, C: 497.4476361802},
+		{A: 1.659e-08, B: 2.57526072926, C: 2090.3096723752},
+		{A: 1.809e-08, B: 1.82317819973, C: 436.8931316145},
+		{A: 1.566e-08, B: 6.15328100324, C: 490.3340891794},
+		{A: 1.771e-08, B: 6.11741716855, C: 1073.6090241908},
+		{A: 1.456e-08, B: 0.85374460914, C: 415.5524906121},
+		{A: 1.645e-08, B: 2.95335775161, C: 437.6438911399},
+		{A: 1.391e-08, B: 4.1202502856, C: 1574.8458012822},
+		{A: 1.585e-08, B: 5.96841377266, C: 1781.0313497194},
+		{A: 1.507e-08, B: 3.84895122542, C: 1251.3403846248},
+		{A: 1.442e-08, B: 5.32547705924, C: 2538.2485042536},
+		{A: 1.805e-08, B: 1.50973093681, C: 750.1036075334},
+		{A: 1.462e-08, B: 3.28599831588, C: 1884.124123938},
+		{A: 1.482e-08, B: 0.99340744053, C: 643.0786800517},
+		{A: 1.312e-08, B: 3.79347668996, C: 1567.7322542814},
+		{A: 1.665e-08, B: 0.02551523913, C: 423.4167971383},
+		{A: 1.469e-08, B: 5.35285153471, C: 1354.4331588434},
+		{A: 1.352e-08, B: 0.69945139243, C: 867.4234757536},
+		{A: 1.124e-08, B: 1.79624810407, C: 618.5566453116},
+		{A: 1.126e-08, B: 4.70052329245, C: 113.3877149571},
+		{A: 1.122e-08, B: 3.9553722427, C: 1891.2376709388},
+		{A: 1.458e-08, B: 1.50198846753, C: 430.5303441391},
+		{A: 1.145e-08, B: 5.13093399117, C: 25.2727942655},
+		{A: 1.178e-08, B: 2.97062300389, C: 241.7532834412},
+		{A: 1.274e-08, B: 2.29089799814, C: 2420.9286360334},
+		{A: 1.071e-08, B: 0.04888943982, C: 63.7358983034},
+		{A: 1.377e-08, B: 5.58271514873, C: 1382.8873468466},
+		{A: 1.145e-08, B: 3.10797488346, C: 2200.5159935946},
+		{A: 1.076e-08, B: 0.79465514815, C: 127.4717966068},
+		{A: 1.046e-08, B: 5.85060227045, C: 215.7467759928},
+		{A: 1.082e-08, B: 3.7258944551, C: 131.4039498699},
+		{A: 1.218e-08, B: 0.47504349592, C: 824.7421937488},
+		{A: 1.116e-08, B: 3.78039049056, C: 1375.7737998458},
+		{A: 9.69e-09, B: 5.90752273481, C: 265.9892934775},
+		{A: 1.23e-08, B: 1.41325962069, C: 2634.2277314714},
+		{A: 1.07e-08, B: 4.80334493874, C: 1987.2168981566},
+		{A: 9.46e-09, B: 6.25968535931, C: 2015.6710861598},
+		{A: 1.03e-08, B: 1.08973644893, C: 362.8622925726},
+		{A: 1.072e-08, B: 5.41838042079, C: 1279.794572628},
+		{A: 8.8e-09, B: 1.92224908504, C: 483.2205421786},
+		{A: 8.78e-09, B: 2.96591300878, C: 934.9485149682},
+		{A: 8.79e-09, B: 2.65659265685, C: 145.6310438715},
+		{A: 8.72e-09, B: 6.26261969664, C: 2.4476805548},
+		{A: 1.082e-08, B: 4.48298283322, C: 2214.7430875962},
+		{A: 9.59e-09, B: 0.74479087918, C: 16.6747745564},
+		{A: 1.035e-08, B: 4.05664979327, C: 231.4583427027},
+		{A: 8.51e-09, B: 0.09360495322, C: 628.8515860501},
+		{A: 8.88e-09, B: 5.98816755324, C: 2524.021410252},
+		{A: 8.66e-09, B: 3.1625926563, C: 2207.6295405954},
+		{A: 8.43e-09, B: 1.23731248821, C: 74.7815985673},
+		{A: 8.09e-09, B: 2.89742868175, C: 2008.557539159},
+		{A: 7.79e-09, B: 2.28434811609, C: 1478.8665740644},
+		{A: 9.9e-09, B: 5.32604038017, C: 2428.0421830342},
+		{A: 7.95e-09, B: 2.3817813581, C: 2228.9701815978},
+		{A: 7.65e-09, B: 4.7003367494, C: 1670.8250285},
+		{A: 1.024e-08, B: 4.23352869513, C: 1802.3719907218},
+		{A: 8.31e-09, B: 5.87457134912, C: 1368.660252845},
+		{A: 7.17e-09, B: 5.92144324994, C: 1685.0521225016},
+		{A: 7.72e-09, B: 1.15596098579, C: 3053.7123753466},
+		{A: 6.91e-09, B: 3.13193109668, C: 56.6223513026},
+	},
+	// R4, 64 terms
+	{
+		{A: 1.20205e-05, B: 1.41499446465, C: 220.4126424388},
+		{A: 7.07796e-06, B: 1.16153570102, C: 213.299095438},
+		{A: 5.16121e-06, B: 6.2397356833, C: 206.1855484372},
+		{A: 4.26664e-06, B: 2.46924890293, C: 7.1135470008},
+		{A: 2.67736e-06, B: 0.18659206741, C: 426.598190876},
+		{A: 1.70171e-06, B: 5.95926972384, C: 199.0720014364},
+		{A: 1.45113e-06, B: 1.44211060143, C: 227.5261894396},
+		{A: 1.50339e-06, B: 0.4797016714, C: 433.7117378768},
+		{A: 1.21033e-06, B: 2.40527320817, C: 14.2270940016},
+		{A: 4.7332e-07, B: 5.56857488676, C: 639.897286314},
+		{A: 1.5745e-07, B: 2.90112466278, C: 110.2063212194},
+		{A: 1.6668e-07, B: 0.52920774279, C: 440.8252848776},
+		{A: 1.8954e-07, B: 5.85626429118, C: 647.0108333148},
+		{A: 1.4074e-07, B: 1.30343550656, C: 412.3710968744},
+		{A: 1.2708e-07, B: 2.09349305926, C: 323.5054166574},
+		{A: 1.4724e-07, B: 0.29905316786, C: 419.4846438752},
+		{A: 1.1133e-07, B: 2.4630482599, C: 117.3198682202},
+		{A: 1.132e-07, B: 0.21785507019, C: 95.9792272178},
+		{A: 9.233e-08, B: 2.28127318068, C: 21.3406410024},
+		{A: 9.246e-08, B: 1.5649631283, C: 88.865680217},
+		{A: 8.97e-08, B: 0.68301278041, C: 216.4804891757},
+		{A: 7.674e-08, B: 3.59367715368, C: 302.164775655},
+		{A: 7.823e-08, B: 4.48688804175, C: 853.196381752},
+		{A: 8.36e-08, B: 1.27239488455, C: 234.6397364404},
+		{A: 9.552e-08, B: 3.14159265359, C: 0},
+		{A: 4.834e-08, B: 2.58836294602, C: 515.463871093},
+		{A: 6.059e-08, B: 5.1677444874, C: 103.0927742186},
+		{A: 4.41e-08, B: 0.02211643085, C: 191.9584544356},
+		{A: 4.364e-08, B: 1.59622746023, C: 330.6189636582},
+		{A: 3.676e-08, B: 3.29899839673, C: 210.1177017003},
+		{A: 4.364e-08, B: 5.97349927933, C: 654.1243803156},
+		{A: 4.447e-08, B: 4.97415112184, C: 860.3099287528},
+		{A: 3.22e-08, B: 2.72684237392, C: 522.5774180938},
+		{A: 4.005e-08, B: 1.59858435636, C: 405.2575498736},
+		{A: 3.099e-08, B: 0.75235436533, C: 209.3669421749},
+		{A: 2.464e-08, B: 1.19167306488, C: 124.433415221},
+		{A: 3.088e-08, B: 1.32258934286, C: 728.762966531},
+		{A: 2.22e-08, B: 3.28087994088, C: 203.0041546995},
+		{A: 2.127e-08, B: 6.14648095022, C: 429.7795846137},
+		{A: 2.11e-08, B: 0.75462855247, C: 295.0512286542},
+		{A: 2.02e-08, B: 3.89394929749, C: 1066.49547719},
+		{A: 2.248e-08, B: 0.49319150178, C: 447.9388318784},
+		{A: 2.18e-08, B: 0.72761059998, C: 625.6701923124},
+		{A: 1.809e-08, B: 0.09057839517, C: 942.062061969},
+		{A: 1.672e-08, B: 1.39635398184, C: 224.3447957019},
+		{A: 1.641e-08, B: 3.0246830755, C: 184.8449074348},
+		{A: 1.772e-08, B: 0.81879250825, C: 223.5940361765},
+		{A: 1.902e-08, B: 2.00472814984, C: 831.8557407496},
+		{A: 1.6e-08, B: 5.41185167676, C: 824.7421937488},
+		{A: 1.505e-08, B: 5.95520747253, C: 422.6660376129},
+		{A: 1.133e-08, B: 1.11512973946, C: 838.9692877504},
+		{A: 1.19e-08, B: 1.89600567803, C: 956.2891559706},
+		{A: 1.487e-08, B: 2.11906469507, C: 529.6909650946},
+		{A: 1.409e-08, B: 0.72254420236, C: 536.8045120954},
+		{A: 1.125e-08, B: 0.89062692183, C: 721.6494195302},
+		{A: 1.301e-08, B: 1.64867038984, C: 17.4084877393},
+		{A: 1.164e-08, B: 5.9695798184, C: 195.1398481733},
+		{A: 9.5e-09, B: 5.3608071329, C: 316.3918696566},
+		{A: 9.85e-09, B: 3.05768671768, C: 1574.8458012822},
+		{A: 1.05e-08, B: 1.59202481523, C: 735.8765135318},
+		{A: 8.17e-09, B: 4.92838813598, C: 56.6223513026},
+		{A: 7.8e-09, B: 2.72125404102, C: 508.3503240922},
+		{A: 9.69e-09, B: 1.00708261792, C: 1045.1548361876},
+		{A: 7.16e-09, B: 1.11042181341, C: 1169.5882514086},
+	},
+	// R5, 28 terms
+	{
+		{A: 1.28612e-06, B: 5.91282565136, C: 220.4126424388},
+		{A: 3.2273e-07, B: 0.69256228602, C: 7.1135470008},
+		{A: 2.6698e-07, B: 5.91428528629, C: 227.5261894396},
+		{A: 1.9923e-07, B: 0.67370653385, C: 14.2270940016},
+		{A: 2.0223e-07, B: 4.95136801768, C: 433.7117378768},
+		{A: 1.3537e-07, B: 1.45669521408, C: 199.0720014364},
+		{A: 1.4097e-07, B: 2.67074280191, C: 206.1855484372},
+		{A: 1.3364e-07, B: 4.5882699637, C: 426.598190876},
+		{A: 7.257e-08, B: 4.62966127155, C: 213.299095438},
+		{A: 4.876e-08, B: 3.61448275002, C: 639.897286314},
+		{A: 3.136e-08, B: 4.65661021909, C: 191.9584544356},
+		{A: 2.917e-08, B: 0.48665273315, C: 323.5054166574},
+		{A: 3.759e-08, B: 4.89624165044, C: 440.8252848776},
+		{A: 3.303e-08, B: 4.07190859545, C: 647.0108333148},
+		{A: 2.883e-08, B: 3.18003019204, C: 419.4846438752},
+		{A: 2.338e-08, B: 3.69553554327, C: 88.865680217},
+		{A: 1.95e-08, B: 5.3272924778, C: 302.164775655},
+		{A: 2.052e-08, B: 3.31663577368, C: 95.9792272178},
+		{A: 1.591e-08, B: 2.67009215574, C: 853.196381752},
+		{A: 2.028e-08, B: 0.56025552769, C: 117.3198682202},
+		{A: 1.56e-08, B: 0.85608042681, C: 515.463871093},
+		{A: 1.678e-08, B: 0, C: 0},
+		{A: 1.102e-08, B: 5.98011943842, C: 3.1813937377},
+		{A: 1.285e-08, B: 5.82563377753, C: 234.6397364404},
+		{A: 8.96e-09, B: 5.22791858719, C: 216.4804891757},
+		{A: 1.141e-08, B: 0.15741228205, C: 412.3710968744},
+		{A: 7.98e-09, B: 0.37452846153, C: 28.4541880032},
+		{A: 8.37e-09, B: 5.04769794123, C: 124.433415221},
+	},
+}
+
+// GetSaturnR0 evaluates the t^0 series of R.
+func GetSaturnR0(t float64) float64 {
+	return saturnR[0].Evaluate(t)
+}
+
+// GetSaturnR1 evaluates the t^1 series of R.
+func GetSaturnR1(t float64) float64 {
+	return saturnR[1].Evaluate(t)
+}
+
+// GetSaturnR2 evaluates the t^2 series of R.
+func GetSaturnR2(t float64) float64 {
+	return saturnR[2].Evaluate(t)
+}
+
+// GetSaturnR3 evaluates the t^3 series of R.
+func GetSaturnR3(t float64) float64 {
+	return saturnR[3].Evaluate(t)
+}
+
+// GetSaturnR4 evaluates the t^4 series of R.
+func GetSaturnR4(t float64) float64 {
+	return saturnR[4].Evaluate(t)
+}
+
+// GetSaturnR5 evaluates the t^5 series of R.
+func GetSaturnR5(t float64) float64 {
+	return saturnR[5].Evaluate(t)
+}
@@ -0,0 +1,61 @@
+package vsop87uranusd
+
+import (
+    "calendarutil"
+    "mathutil"
+)
+
+/**
+ * 按儒略日计算天王星的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 天王星的日心黄经，单位是弧度(rad)
+ */
+func GetSunEclipticLongitudeForUranus(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    L0 := GetUranusL0(t)
+    L1 := GetUranusL1(t)
+    L2 := GetUranusL2(t)
+    L3 := GetUranusL3(t)
+    L4 := GetUranusL4(t)
+    L5 := GetUranusL5(t)
+    L := ((((L5 * t + L4) * t + L3) * t + L2) * t + L1) * t + L0
+    return mathutil.Mod2Pi(L)
+}
+
+/**
+ * 按儒略日计算天王星的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 天王星的日心黄纬，单位是弧度(rad)
+ */
+func GetSunEclipticLatitudeForUranus(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    B0 := GetUranusB0(t)
+    B1 := GetUranusB1(t)
+    B2 := GetUranusB2(t)
+    B3 := GetUranusB3(t)
+    B4 := GetUranusB4(t)
+    B := (((B4 * t + B3) * t + B2) * t + B1) * t + B0
+    return B
+}
+
+/**
+ * 按照儒略日计算天王星和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 天王星和太阳的距离，单位是天文单位(au)
+ */
+func GetSunRadiusForUranus(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    R0 := GetUranusR0(t)
+    R1 := GetUranusR1(t)
+    R2 := GetUranusR2(t)
+    R3 := GetUranusR3(t)
+    R4 := GetUranusR4(t)
+    R := (((R4 * t + R3) * t + R2) * t + R1) * t + R0
+    return R
+}
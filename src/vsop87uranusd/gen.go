@@ -0,0 +1,3 @@
+package vsop87uranusd
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Uranus -package vsop87uranusd
@@ -0,0 +1,1637 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// URANUS L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87uranusd
+
+import "vsop87"
+
+var uranusL = []vsop87.Series{
+	// L0, 947 terms
+	{
+		{A: 5.48129294299, B: 0, C: 0},
+		{A: 0.09260408252, B: 0.8910642153, C: 74.7815985673},
+		{A: 0.01504247826, B: 3.62719262195, C: 1.4844727083},
+		{A: 0.00365981718, B: 1.89962189068, C: 73.297125859},
+		{A: 0.00272328132, B: 3.35823710524, C: 149.5631971346},
+		{A: 0.00070328499, B: 5.39254431993, C: 63.7358983034},
+		{A: 0.00068892609, B: 6.09292489045, C: 76.2660712756},
+		{A: 0.00061998592, B: 2.26952040469, C: 2.9689454166},
+		{A: 0.00061950714, B: 2.85098907565, C: 11.0457002639},
+		{A: 0.00026468869, B: 3.14152087888, C: 71.8126531507},
+		{A: 0.00025710505, B: 6.11379842935, C: 454.9093665273},
+		{A: 0.00021078897, B: 4.36059465144, C: 148.0787244263},
+		{A: 0.00017818665, B: 1.74436982544, C: 36.6485629295},
+		{A: 0.00014613471, B: 4.73732047977, C: 3.9321532631},
+		{A: 0.00011162535, B: 5.82681993692, C: 224.3447957019},
+		{A: 0.00010997934, B: 0.48865493179, C: 138.5174968707},
+		{A: 9.527487e-05, B: 2.95516893093, C: 35.1640902212},
+		{A: 7.545543e-05, B: 5.23626440666, C: 109.9456887885},
+		{A: 4.22017e-05, B: 3.23328535514, C: 70.8494453042},
+		{A: 4.05185e-05, B: 2.27754158724, C: 151.0476698429},
+		{A: 3.354607e-05, B: 1.06549008887, C: 4.4534181249},
+		{A: 2.926671e-05, B: 4.62903695486, C: 9.5612275556},
+		{A: 3.490352e-05, B: 5.48305567292, C: 146.594251718},
+		{A: 3.144093e-05, B: 4.75199307603, C: 77.7505439839},
+		{A: 2.92241e-05, B: 5.3523674338, C: 85.8272988312},
+		{A: 2.27279e-05, B: 4.36600802756, C: 70.3281804424},
+		{A: 2.051209e-05, B: 1.51773563459, C: 0.1118745846},
+		{A: 2.148599e-05, B: 0.60745800902, C: 38.1330356378},
+		{A: 1.991726e-05, B: 4.92437290826, C: 277.0349937414},
+		{A: 1.376208e-05, B: 2.04281409054, C: 65.2203710117},
+		{A: 1.66691e-05, B: 3.62744580852, C: 380.12776796},
+		{A: 1.284183e-05, B: 3.11346336879, C: 202.2533951741},
+		{A: 1.150416e-05, B: 0.93344454002, C: 3.1813937377},
+		{A: 1.533223e-05, B: 2.58593414266, C: 52.6901980395},
+		{A: 1.281641e-05, B: 0.54269869505, C: 222.8603229936},
+		{A: 1.3721e-05, B: 4.19641615561, C: 111.4301614968},
+		{A: 1.220998e-05, B: 0.19901396193, C: 108.4612160802},
+		{A: 9.46195e-06, B: 1.19249463066, C: 127.4717966068},
+		{A: 1.150993e-05, B: 4.17898207045, C: 33.6796175129},
+		{A: 1.244342e-05, B: 0.91612680579, C: 2.4476805548},
+		{A: 1.072008e-05, B: 0.23564502877, C: 62.2514255951},
+		{A: 1.090461e-05, B: 1.77501638912, C: 12.5301729722},
+		{A: 7.07875e-06, B: 5.18285226584, C: 213.299095438},
+		{A: 6.53401e-06, B: 0.96586909116, C: 78.7137518304},
+		{A: 6.27562e-06, B: 0.18210181975, C: 984.6003316219},
+		{A: 5.24495e-06, B: 2.01276706996, C: 299.1263942692},
+		{A: 5.5937e-06, B: 3.35776737704, C: 0.5212648618},
+		{A: 6.06827e-06, B: 5.43209728952, C: 529.6909650946},
+		{A: 4.04891e-06, B: 5.98689011389, C: 8.0767548473},
+		{A: 4.67211e-06, B: 0.41484068933, C: 145.1097790097},
+		{A: 4.71288e-06, B: 1.40664336447, C: 184.7272873558},
+		{A: 4.83219e-06, B: 2.10553990154, C: 0.9632078465},
+		{A: 3.95614e-06, B: 5.87039580949, C: 351.8165923087},
+		{A: 4.33532e-06, B: 5.52142978255, C: 183.2428146475},
+		{A: 3.09885e-06, B: 5.83301304674, C: 145.6310438715},
+		{A: 3.78609e-06, B: 2.34975805006, C: 56.6223513026},
+		{A: 3.98996e-06, B: 0.33810765436, C: 415.5524906121},
+		{A: 3.00379e-06, B: 5.64353974146, C: 22.0914005278},
+		{A: 2.49229e-06, B: 4.74617120584, C: 225.8292684102},
+		{A: 2.39334e-06, B: 2.35045874708, C: 137.0330241624},
+		{A: 2.94172e-06, B: 5.83916826225, C: 39.6175083461},
+		{A: 2.1648e-06, B: 4.77847481363, C: 340.7708920448},
+		{A: 2.51792e-06, B: 1.63696775578, C: 221.3758502853},
+		{A: 2.19621e-06, B: 1.92212987979, C: 67.6680515665},
+		{A: 2.01963e-06, B: 1.29693040865, C: 0.0481841098},
+		{A: 2.24097e-06, B: 0.51574863468, C: 84.3428261229},
+		{A: 2.16549e-06, B: 6.14211862702, C: 5.9378908332},
+		{A: 2.22588e-06, B: 2.84309380331, C: 0.2606324309},
+		{A: 2.07828e-06, B: 5.5802057004, C: 68.8437077341},
+		{A: 1.87474e-06, B: 1.31924326253, C: 0.1600586944},
+		{A: 1.58028e-06, B: 0.73811997211, C: 54.1746707478},
+		{A: 1.99146e-06, B: 0.9563415501, C: 152.5321425512},
+		{A: 1.68648e-06, B: 5.87874000882, C: 18.1592472647},
+		{A: 1.703e-06, B: 3.67717520688, C: 5.4166259714},
+		{A: 1.93652e-06, B: 1.88800122606, C: 456.3938392356},
+		{A: 1.92998e-06, B: 0.91616058506, C: 453.424893819},
+		{A: 1.81934e-06, B: 3.53624029238, C: 79.2350166922},
+		{A: 1.73145e-06, B: 1.53860728054, C: 160.6088973985},
+		{A: 1.64588e-06, B: 1.42379714838, C: 106.9767433719},
+		{A: 1.71968e-06, B: 5.67952685533, C: 219.891377577},
+		{A: 1.62792e-06, B: 3.05029377666, C: 112.9146342051},
+		{A: 1.46653e-06, B: 1.26300172265, C: 59.8037450403},
+		{A: 1.39453e-06, B: 5.385977234, C: 32.1951448046},
+		{A: 1.38585e-06, B: 4.25994786673, C: 909.8187330546},
+		{A: 1.43058e-06, B: 1.29995487555, C: 35.4247226521},
+		{A: 1.2384e-06, B: 1.37359990336, C: 7.1135470008},
+		{A: 1.04414e-06, B: 5.02820888813, C: 0.7507595254},
+		{A: 1.03277e-06, B: 0.68095301267, C: 14.977853527},
+		{A: 9.4741e-07, B: 0.90674090409, C: 74.6697239827},
+		{A: 8.2978e-07, B: 2.92828718445, C: 265.9892934775},
+		{A: 1.10163e-06, B: 2.02685778976, C: 554.0699874828},
+		{A: 9.4226e-07, B: 3.9426632826, C: 74.8934731519},
+		{A: 7.9858e-07, B: 1.0144682918, C: 6.592282139},
+		{A: 1.09376e-06, B: 5.70581833286, C: 77.962992305},
+		{A: 8.5876e-07, B: 1.70649435603, C: 82.8583534146},
+		{A: 1.03562e-06, B: 1.45770270246, C: 24.3790223882},
+		{A: 7.4667e-07, B: 4.63177552576, C: 69.3649725959},
+		{A: 7.9919e-07, B: 3.00974084247, C: 297.6419215609},
+		{A: 8.4502e-07, B: 0.36887189574, C: 186.2117600641},
+		{A: 8.881e-07, B: 0.52481330563, C: 181.7583419392},
+		{A: 7.0303e-07, B: 1.18986880009, C: 66.70484372},
+		{A: 6.9965e-07, B: 0.87476081875, C: 305.3461693927},
+		{A: 6.9927e-07, B: 3.76102749315, C: 131.4039498699},
+		{A: 8.4604e-07, B: 5.88725183325, C: 256.5399405065},
+		{A: 7.4341e-07, B: 6.24271323846, C: 447.7958195265},
+		{A: 6.231e-07, B: 0.16901376623, C: 479.2883889155},
+		{A: 7.2726e-07, B: 2.84892775693, C: 462.0229135281},
+		{A: 6.906e-07, B: 4.43934854374, C: 39.3568759152},
+		{A: 7.6568e-07, B: 4.5872111034, C: 6.2197751235},
+		{A: 7.3387e-07, B: 4.27603448634, C: 87.3117715395},
+		{A: 5.5307e-07, B: 1.49636544147, C: 71.6002048296},
+		{A: 5.7291e-07, B: 1.63015165542, C: 143.6253063014},
+		{A: 6.1661e-07, B: 3.18604743524, C: 77.2292791221},
+		{A: 5.7634e-07, B: 3.67180685401, C: 51.2057253312},
+		{A: 5.0289e-07, B: 1.12279384633, C: 20.6069278195},
+		{A: 5.3744e-07, B: 5.51890986247, C: 128.9562693151},
+		{A: 5.7894e-07, B: 2.66877593418, C: 381.6122406683},
+		{A: 5.8112e-07, B: 1.58629352171, C: 60.7669528868},
+		{A: 4.5382e-07, B: 0.48053933052, C: 14.0146456805},
+		{A: 3.7581e-07, B: 6.06822931932, C: 211.8146227297},
+		{A: 3.864e-07, B: 3.43597050177, C: 153.4953503977},
+		{A: 4.6087e-07, B: 4.36201639577, C: 75.7448064138},
+		{A: 4.0088e-07, B: 4.57333927519, C: 46.2097904851},
+		{A: 3.4229e-07, B: 2.93967782207, C: 140.001969579},
+		{A: 3.8669e-07, B: 5.58941074168, C: 99.1606209555},
+		{A: 3.4827e-07, B: 1.02792863024, C: 203.7378678824},
+		{A: 4.0024e-07, B: 0.69889667397, C: 218.4069048687},
+		{A: 3.2538e-07, B: 4.21625657443, C: 200.7689224658},
+		{A: 3.1865e-07, B: 5.50961503408, C: 72.3339180125},
+		{A: 4.1695e-07, B: 3.82438031124, C: 81.0013736908},
+		{A: 3.4795e-07, B: 0.39363490236, C: 1.3725981237},
+		{A: 3.9775e-07, B: 6.05600836903, C: 293.188503436},
+		{A: 2.7577e-07, B: 2.18261286374, C: 125.9873238985},
+		{A: 3.6279e-07, B: 1.66586085405, C: 258.0244132148},
+		{A: 3.5442e-07, B: 1.96652806541, C: 835.0371344873},
+		{A: 3.5361e-07, B: 3.7225869003, C: 692.5874843535},
+		{A: 2.7323e-07, B: 2.10164372072, C: 209.3669421749},
+		{A: 2.653e-07, B: 4.48265986115, C: 373.9079928365},
+		{A: 3.4472e-07, B: 1.07907945481, C: 191.2076949102},
+		{A: 2.9915e-07, B: 3.87358632506, C: 259.5088859231},
+		{A: 2.6233e-07, B: 3.63172504384, C: 490.3340891794},
+		{A: 2.5848e-07, B: 0.54461409359, C: 41.6444977756},
+		{A: 2.6989e-07, B: 6.27711247734, C: 28.5718080822},
+		{A: 2.6391e-07, B: 5.81110061049, C: 75.3028634291},
+		{A: 3.4227e-07, B: 6.05617272657, C: 275.5505210331},
+		{A: 2.4279e-07, B: 3.18776564878, C: 81.3738807063},
+		{A: 2.9937e-07, B: 1.88789751816, C: 269.9214467406},
+		{A: 2.6235e-07, B: 6.20105251336, C: 134.5853436076},
+		{A: 2.2754e-07, B: 0.92919725789, C: 288.0806940053},
+		{A: 2.518e-07, B: 5.42547381962, C: 116.4260963429},
+		{A: 2.2715e-07, B: 0.53098783687, C: 1514.2912967165},
+		{A: 2.6485e-07, B: 4.77176167929, C: 284.1485407422},
+		{A: 2.7008e-07, B: 4.75281624832, C: 41.1019810544},
+		{A: 2.1972e-07, B: 4.58613057386, C: 404.5067903482},
+		{A: 2.2012e-07, B: 1.84389287183, C: 617.8058857862},
+		{A: 2.4694e-07, B: 4.7087519549, C: 378.6432952517},
+		{A: 2.8949e-07, B: 0.17127584792, C: 528.2064923863},
+		{A: 2.0492e-07, B: 0.10285646641, C: 195.1398481733},
+		{A: 2.0696e-07, B: 5.62143477633, C: 55.6591434561},
+		{A: 2.5843e-07, B: 0.74627159338, C: 278.5194664497},
+		{A: 2.299e-07, B: 3.58378694661, C: 1.5963472929},
+		{A: 2.1843e-07, B: 0.05733533568, C: 173.9422195228},
+		{A: 1.905e-07, B: 2.30351091243, C: 5.1078094307},
+		{A: 2.0675e-07, B: 2.64113858585, C: 105.4922706636},
+		{A: 2.1856e-07, B: 5.87352402691, C: 45.5766510387},
+		{A: 2.112e-07, B: 1.98081790016, C: 114.3991069134},
+		{A: 1.9279e-07, B: 2.84304025179, C: 159.1244246902},
+		{A: 1.9061e-07, B: 0.50598371738, C: 67.3592350258},
+		{A: 2.0434e-07, B: 3.77601951414, C: 135.5485514541},
+		{A: 1.7326e-07, B: 4.47793157645, C: 120.358249606},
+		{A: 2.0547e-07, B: 0.88695598555, C: 255.0554677982},
+		{A: 1.932e-07, B: 1.48569290504, C: 0.8937718773},
+		{A: 2.1331e-07, B: 2.7447002306, C: 28.3111756513},
+		{A: 1.7582e-07, B: 4.091396367, C: 296.1574488526},
+		{A: 1.5918e-07, B: 3.94525074972, C: 17.5261078183},
+		{A: 1.5562e-07, B: 0.92748407689, C: 300.6108669775},
+		{A: 1.6439e-07, B: 0.30868798605, C: 30.7106720963},
+		{A: 1.5237e-07, B: 4.93048601827, C: 7.4223635415},
+		{A: 1.9284e-07, B: 6.21950083268, C: 329.8370663655},
+		{A: 1.386e-07, B: 0.56255266406, C: 144.1465711632},
+		{A: 1.6206e-07, B: 2.30292598693, C: 344.7030453079},
+		{A: 1.6041e-07, B: 0.19723295436, C: 103.0927742186},
+		{A: 1.4414e-07, B: 2.57606243208, C: 230.5645708254},
+		{A: 1.6789e-07, B: 4.93540052916, C: 565.1156877467},
+		{A: 1.7052e-07, B: 1.81844925116, C: 294.6729761443},
+		{A: 1.6766e-07, B: 0.2754218633, C: 73.8183907208},
+		{A: 1.5428e-07, B: 1.91577056305, C: 96.8729990951},
+		{A: 1.5718e-07, B: 3.87095025861, C: 98.8999885246},
+		{A: 1.1923e-07, B: 6.17545505441, C: 44.7253177768},
+		{A: 1.2407e-07, B: 6.22419970167, C: 80.1982245387},
+		{A: 1.304e-07, B: 1.99652993223, C: 27.0873353739},
+		{A: 1.3229e-07, B: 3.43782440072, C: 227.3137411185},
+		{A: 1.1669e-07, B: 4.31526860843, C: 426.598190876},
+		{A: 1.4378e-07, B: 5.78353646474, C: 1059.3819301892},
+		{A: 1.5879e-07, B: 0.98454960055, C: 6208.2942514241},
+		{A: 1.1158e-07, B: 1.7441743069, C: 220.4126424388},
+		{A: 1.1989e-07, B: 5.8438865795, C: 13.3333221243},
+		{A: 1.1386e-07, B: 2.55925734515, C: 19.1224551112},
+		{A: 1.3281e-07, B: 5.39472153462, C: 391.1734682239},
+		{A: 1.2295e-07, B: 4.57340278496, C: 23.5758732361},
+		{A: 1.2827e-07, B: 1.7741026907, C: 180.2738692309},
+		{A: 1.1651e-07, B: 4.29138607818, C: 142.4496501338},
+		{A: 1.2248e-07, B: 2.44241346243, C: 100.3844612329},
+		{A: 1.2421e-07, B: 2.32591770919, C: 80.7194894005},
+		{A: 9.774e-08, B: 0.39898140151, C: 7.8643065262},
+		{A: 1.3172e-07, B: 2.74099358938, C: 177.8743727859},
+		{A: 1.2262e-07, B: 5.42795591646, C: 831.1049812242},
+		{A: 1.0272e-07, B: 5.90194483926, C: 74.5209661364},
+		{A: 9.317e-08, B: 3.75869700774, C: 74.8297826771},
+		{A: 1.0701e-07, B: 4.00709797731, C: 235.3904959658},
+		{A: 9.243e-08, B: 5.38492199672, C: 20.4468691251},
+		{A: 9.461e-08, B: 2.60126707172, C: 92.3077063856},
+		{A: 1.2066e-07, B: 5.5216310022, C: 74.2603337055},
+		{A: 1.0836e-07, B: 1.88393779293, C: 241.6102710893},
+		{A: 9.317e-08, B: 1.1648365831, C: 74.7334144575},
+		{A: 1.0718e-07, B: 5.50310449842, C: 187.6962327724},
+		{A: 1.2057e-07, B: 6.0212005039, C: 154.0166152595},
+		{A: 9.124e-08, B: 1.15458738606, C: 0.6331394464},
+		{A: 1.1526e-07, B: 6.26425302826, C: 155.7829722581},
+		{A: 1.22e-07, B: 5.79400179483, C: 1364.7280995819},
+		{A: 1.0979e-07, B: 5.76614513865, C: 628.8515860501},
+		{A: 8.532e-08, B: 5.1801645615, C: 1.6445314027},
+		{A: 8.66e-08, B: 3.78133822411, C: 74.9416572617},
+		{A: 1.1227e-07, B: 1.30788626675, C: 604.4725636619},
+		{A: 1.0531e-07, B: 1.05867421534, C: 291.7040307277},
+		{A: 8.446e-08, B: 3.52020067595, C: 756.3233826569},
+		{A: 1.0291e-07, B: 5.30493908317, C: 75.0422309982},
+		{A: 8.015e-08, B: 6.24347048958, C: 543.0242872189},
+		{A: 7.796e-08, B: 5.23497582886, C: 58.1068240109},
+		{A: 9.31e-08, B: 1.65210713729, C: 24.1183899573},
+		{A: 8.642e-08, B: 1.14285691458, C: 74.6215398729},
+		{A: 7.797e-08, B: 4.00208030502, C: 31.492569389},
+		{A: 8.915e-08, B: 3.63129389881, C: 408.4389436113},
+		{A: 7.191e-08, B: 4.24536221306, C: 110.2063212194},
+		{A: 9.764e-08, B: 0.10205649809, C: 366.485629295},
+		{A: 8.71e-08, B: 2.2591075948, C: 451.9404211107},
+		{A: 9.43e-08, B: 2.00492467431, C: 331.3215390738},
+		{A: 9.008e-08, B: 1.63146330622, C: 443.8636662634},
+		{A: 7.247e-08, B: 4.35313018726, C: 88.1149206916},
+		{A: 7.659e-08, B: 0.61918159043, C: 50.4025761791},
+		{A: 6.836e-08, B: 5.12190844483, C: 0.8031491521},
+		{A: 9.367e-08, B: 1.42664537007, C: 414.0680179038},
+		{A: 8.136e-08, B: 0.45279998999, C: 25.6028626656},
+		{A: 9.199e-08, B: 2.41000352664, C: 10138.5039476437},
+		{A: 6.896e-08, B: 5.85017813531, C: 339.2864193365},
+		{A: 6.487e-08, B: 6.03397141885, C: 1.2238402774},
+		{A: 7.186e-08, B: 4.00480285222, C: 157.6399519819},
+		{A: 8.13e-08, B: 0.21907525983, C: 422.6660376129},
+		{A: 8.022e-08, B: 2.09953974305, C: 92.940845832},
+		{A: 6.371e-08, B: 4.47820781123, C: 79.889407998},
+		{A: 7.957e-08, B: 5.86499639179, C: 760.25553592},
+		{A: 8.383e-08, B: 2.33782809093, C: 417.0369633204},
+		{A: 7.931e-08, B: 3.41952210669, C: 7.7042478318},
+		{A: 6.613e-08, B: 1.39197711439, C: 16.6747745564},
+		{A: 7.142e-08, B: 5.57864813931, C: 4.7353024152},
+		{A: 7.441e-08, B: 0.48121969777, C: 68.1893164283},
+		{A: 7.187e-08, B: 0.50441238918, C: 457.8783119439},
+		{A: 6.613e-08, B: 2.84628770892, C: 142.1408335931},
+		{A: 6.399e-08, B: 3.88681409308, C: 74.0308390419},
+		{A: 6.14e-08, B: 1.6577690922, C: 350.3321196004},
+		{A: 7.053e-08, B: 0.13890020306, C: 306.830642101},
+		{A: 6.01e-08, B: 5.4643400464, C: 48.7580447764},
+		{A: 6.81e-08, B: 6.15448079403, C: 67.8804998876},
+		{A: 5.982e-08, B: 2.36098472874, C: 2.0057375701},
+		{A: 5.709e-08, B: 1.49928444044, C: 206.1855484372},
+		{A: 6.843e-08, B: 1.08172913275, C: 465.9550667912},
+		{A: 6.408e-08, B: 5.07331258075, C: 4.665866446},
+		{A: 7.805e-08, B: 3.98866710061, C: 3.6233367224},
+		{A: 5.527e-08, B: 5.57881556653, C: 2.9207613068},
+		{A: 5.521e-08, B: 3.3822598704, C: 149.45132255},
+		{A: 6.107e-08, B: 1.95504762856, C: 216.9224321604},
+		{A: 5.692e-08, B: 2.83076925167, C: 260.9933586314},
+		{A: 6.586e-08, B: 2.71085048651, C: 329.7251917809},
+		{A: 5.826e-08, B: 3.98800970226, C: 347.8844390456},
+		{A: 5.745e-08, B: 0.49160564101, C: 0.3725070155},
+		{A: 5.473e-08, B: 5.69158856279, C: 1.6969210294},
+		{A: 4.982e-08, B: 2.40870746521, C: 342.2553647531},
+		{A: 6.04e-08, B: 4.78944090986, C: 558.0021407459},
+		{A: 5.317e-08, B: 2.78403764459, C: 13.4933808187},
+		{A: 5.09e-08, B: 5.47747622578, C: 372.4235201282},
+		{A: 4.894e-08, B: 1.77082918618, C: 333.657345044},
+		{A: 5.389e-08, B: 2.94076732149, C: 9.4011688612},
+		{A: 4.757e-08, B: 5.37129102802, C: 61.2882177486},
+		{A: 5e-08, B: 3.43988321744, C: 518.6452648307},
+		{A: 5.664e-08, B: 3.30309284254, C: 0.6543913058},
+		{A: 5.53e-08, B: 0.45092393824, C: 162.0933701068},
+		{A: 5.746e-08, B: 3.45964923866, C: 55.1378785943},
+		{A: 5.666e-08, B: 1.23578675332, C: 328.3525936572},
+		{A: 5.071e-08, B: 5.42033080481, C: 977.4867846211},
+		{A: 5.719e-08, B: 0.66718965817, C: 92.0470739547},
+		{A: 4.994e-08, B: 1.29267872727, C: 983.1158589136},
+		{A: 5.57e-08, B: 2.36255927193, C: 6.9010986797},
+		{A: 5.189e-08, B: 2.40682220291, C: 58.319272332},
+		{A: 5.502e-08, B: 0.13301359232, C: 149.6750717192},
+		{A: 4.706e-08, B: 1.85473330365, C: 119.5069163441},
+		{A: 4.305e-08, B: 4.18171934306, C: 90.8232336773},
+		{A: 5.953e-08, B: 1.73036741041, C: 152.7445908723},
+		{A: 5.607e-08, B: 5.53187692339, C: 1087.6931058405},
+		{A: 5.591e-08, B: 5.75072223569, C: 358.9301393095},
+		{A: 4.441e-08, B: 0.97726075887, C: 4.192785694},
+		{A: 4.608e-08, B: 3.31800103668, C: 89.7594520943},
+		{A: 4.677e-08, B: 5.01422713233, C: 43.1289704839},
+		{A: 4.034e-08, B: 1.08242564328, C: 75.5323580927},
+		{A: 5.626e-08, B: 1.10270225604, C: 66.9172920411},
+		{A: 4.058e-08, B: 1.9401213605, C: 17.2654753874},
+		{A: 4.77e-08, B: 2.24207019076, C: 986.0848043302},
+		{A: 5.207e-08, B: 1.3660042891, C: 767.3690829208},
+		{A: 4.94e-08, B: 0.13733547633, C: 0.8513332619},
+		{A: 4.339e-08, B: 5.33814728291, C: 152.0108776894},
+		{A: 3.917e-08, B: 3.85320550575, C: 2.2876218604},
+		{A: 3.903e-08, B: 5.87573410158, C: 16.4623262353},
+		{A: 4.655e-08, B: 0.93665017107, C: 267.4737661858},
+		{A: 4.638e-08, B: 5.42566923517, C: 16.04163511},
+		{A: 5.177e-08, B: 3.40845690805, C: 1289.9465010146},
+		{A: 3.825e-08, B: 0.5988810573, C: 210.3301500214},
+		{A: 5.048e-08, B: 2.16732539242, C: 367.9701020033},
+		{A: 3.819e-08, B: 1.70901925915, C: 5.6290742925},
+		{A: 5.004e-08, B: 0.26759264038, C: 403.1341922245},
+		{A: 4.202e-08, B: 5.12029089394, C: 19.0105805266},
+		{A: 4.472e-08, B: 2.88978371811, C: 59.2824801785},
+		{A: 4.7e-08, B: 4.17709035394, C: 130.4407420234},
+		{A: 4.487e-08, B: 0.85521839581, C: 969.6224780949},
+		{A: 3.802e-08, B: 4.59721371468, C: 25.8634950965},
+		{A: 4.024e-08, B: 4.98868930941, C: 30.0562807905},
+		{A: 4.722e-08, B: 6.16359211847, C: 173.6815870919},
+		{A: 4.732e-08, B: 3.76697693308, C: 373.0142209592},
+		{A: 3.542e-08, B: 0.76768843819, C: 114.1384744825},
+		{A: 3.37e-08, B: 2.00021522907, C: 286.596221297},
+		{A: 3.626e-08, B: 3.20240733896, C: 991.7138786227},
+		{A: 4.028e-08, B: 0.46802022168, C: 387.2413149608},
+		{A: 3.442e-08, B: 5.2095973335, C: 894.8408795276},
+		{A: 3.273e-08, B: 5.46374958434, C: 192.6921676185},
+		{A: 3.291e-08, B: 3.97847646998, C: 264.5048207692},
+		{A: 3.291e-08, B: 1.62538722379, C: 681.5417840896},
+		{A: 3.198e-08, B: 3.965808048, C: 146.3818033969},
+		{A: 4.016e-08, B: 1.4065746484, C: 383.0967133766},
+		{A: 3.844e-08, B: 3.60271287642, C: 0.590700831},
+		{A: 3.123e-08, B: 4.50215520755, C: 1439.5096981492},
+		{A: 3.434e-08, B: 5.18704419009, C: 97.4155158163},
+		{A: 3.123e-08, B: 4.14198630214, C: 76.4785195967},
+		{A: 3.259e-08, B: 1.65410614252, C: 214.7835681463},
+		{A: 3.557e-08, B: 4.15769848885, C: 68.5618234438},
+		{A: 2.934e-08, B: 2.95575531139, C: 120.9913890524},
+		{A: 2.914e-08, B: 3.69930995976, C: 874.3940104025},
+		{A: 3.301e-08, B: 2.14570582133, C: 253.5709950899},
+		{A: 3.362e-08, B: 4.82277888708, C: 19.643719973},
+		{A: 3.218e-08, B: 2.56428709831, C: 60.5545045657},
+		{A: 3.059e-08, B: 3.57539890234, C: 117.9105690512},
+		{A: 3.073e-08, B: 3.54739757836, C: 95.3885263868},
+		{A: 2.789e-08, B: 0.65190913388, C: 42.5382696529},
+		{A: 3.235e-08, B: 5.31608088666, C: 546.956440482},
+		{A: 3.657e-08, B: 5.89905956226, C: 16.1535096946},
+		{A: 3.549e-08, B: 2.76314903735, C: 82.4858463991},
+		{A: 3.627e-08, B: 4.68663059919, C: 593.426863398},
+		{A: 3.306e-08, B: 1.57486085317, C: 312.4597163935},
+		{A: 3.602e-08, B: 2.51921910142, C: 22.8945496799},
+		{A: 3.431e-08, B: 4.95532928836, C: 49.7212526229},
+		{A: 2.675e-08, B: 2.41314606353, C: 29.2049475286},
+		{A: 3.101e-08, B: 1.42849885249, C: 17.6379824029},
+		{A: 3.399e-08, B: 3.02815712113, C: 88.7962442478},
+		{A: 3.379e-08, B: 2.65894323745, C: 771.3012361839},
+		{A: 2.547e-08, B: 6.10642153361, C: 455.8725743738},
+		{A: 2.967e-08, B: 0.31461418738, C: 150.5264049811},
+		{A: 2.681e-08, B: 1.16839594153, C: 477.8039162072},
+		{A: 3.343e-08, B: 3.09880618811, C: 552.5855147745},
+		{A: 2.678e-08, B: 1.42841144096, C: 1.1119656928},
+		{A: 2.54e-08, B: 1.94053883528, C: 6.4804075544},
+		{A: 2.491e-08, B: 2.97226347939, C: 453.9461586808},
+		{A: 2.744e-08, B: 1.93313970916, C: 73.1852512744},
+		{A: 2.935e-08, B: 4.5939410628, C: 167.0893049529},
+		{A: 3.007e-08, B: 1.38745560615, C: 365.0011565867},
+		{A: 3.053e-08, B: 1.91792962252, C: 561.1835344836},
+		{A: 2.496e-08, B: 5.45540866674, C: 66.1835788582},
+		{A: 2.622e-08, B: 1.46324659292, C: 33.1371007917},
+		{A: 3.203e-08, B: 4.01683757076, C: 555.5544601911},
+		{A: 2.317e-08, B: 1.13727677715, C: 43.2408450685},
+		{A: 2.341e-08, B: 5.87635018071, C: 228.276948965},
+		{A: 2.841e-08, B: 3.60234459541, C: 42.5864537627},
+		{A: 2.858e-08, B: 1.53714262537, C: 353.301065017},
+		{A: 2.484e-08, B: 3.85791009894, C: 104.0077979553},
+		{A: 2.903e-08, B: 5.21967656512, C: 73.4090004436},
+		{A: 2.76e-08, B: 1.21343315367, C: 32.2433289144},
+		{A: 2.269e-08, B: 3.31411391807, C: 4.1446015842},
+		{A: 2.241e-08, B: 2.65636547591, C: 70.1157321213},
+		{A: 2.246e-08, B: 4.08175081363, C: 123.5396433437},
+		{A: 2.583e-08, B: 2.38305971478, C: 100.6450936638},
+		{A: 2.761e-08, B: 5.71758409791, C: 43.2890291783},
+		{A: 2.827e-08, B: 6.16734582851, C: 101.8689339412},
+		{A: 2.838e-08, B: 0.5488849549, C: 20.4950532349},
+		{A: 2.145e-08, B: 4.04195315408, C: 47.061123747},
+		{A: 2.81e-08, B: 1.90169260186, C: 273.1028404783},
+		{A: 2.922e-08, B: 2.79808700183, C: 418.2608035978},
+		{A: 2.07e-08, B: 5.5040271829, C: 47.6942631934},
+		{A: 2.071e-08, B: 2.0197357306, C: 316.3918696566},
+		{A: 2.52e-08, B: 3.12740527423, C: 905.8865797915},
+		{A: 2.17e-08, B: 4.4619656005, C: 2.7083129857},
+		{A: 2.399e-08, B: 3.78849518316, C: 75.5847477194},
+		{A: 2.746e-08, B: 4.82558024832, C: 6.8529145699},
+		{A: 2.717e-08, B: 2.33108458294, C: 404.6186649328},
+		{A: 2.416e-08, B: 4.11932546205, C: 332.8060117821},
+		{A: 1.974e-08, B: 5.79881978458, C: 11.1575748485},
+		{A: 1.967e-08, B: 5.43918682709, C: 199.2844497575},
+		{A: 2.282e-08, B: 2.79530897096, C: 22.633917249},
+		{A: 1.91e-08, B: 0.27727117649, C: 69.1525242748},
+		{A: 2.471e-08, B: 3.51033894778, C: 8.5980197091},
+		{A: 1.904e-08, B: 3.45282024423, C: 472.1748419147},
+		{A: 2.606e-08, B: 0.43601023323, C: 439.782755154},
+		{A: 2.663e-08, B: 5.43112910549, C: 3265.8308281325},
+		{A: 2.058e-08, B: 1.69362390174, C: 65.8747623175},
+		{A: 2.258e-08, B: 5.32927779367, C: 908.3342603463},
+		{A: 1.873e-08, B: 5.5539980591, C: 175.1660598002},
+		{A: 2.222e-08, B: 0.96973865202, C: 39.0962434843},
+		{A: 2.121e-08, B: 2.00302088316, C: 106.0135355254},
+		{A: 2.01e-08, B: 1.49945418027, C: 29.226199388},
+		{A: 2.222e-08, B: 4.36573603431, C: 468.2426886516},
+		{A: 2.055e-08, B: 0.05798973044, C: 205.2223405907},
+		{A: 2.086e-08, B: 0.44287700052, C: 10.2949407385},
+		{A: 1.77e-08, B: 4.3204880583, C: 0.457574387},
+		{A: 2.112e-08, B: 5.78682409103, C: 486.4019359163},
+		{A: 1.909e-08, B: 0.82888506421, C: 254.9435932136},
+		{A: 1.973e-08, B: 6.05826379648, C: 78.4049352897},
+		{A: 1.908e-08, B: 5.55892482384, C: 15.4991183888},
+		{A: 1.888e-08, B: 6.20874408008, C: 198.321241911},
+		{A: 2.092e-08, B: 2.55561831566, C: 49.5088043018},
+		{A: 1.775e-08, B: 6.17741514589, C: 258.8757464767},
+		{A: 2.146e-08, B: 1.4050811881, C: 526.722019678},
+		{A: 1.8e-08, B: 0.04238718337, C: 334.2904844904},
+		{A: 1.738e-08, B: 1.99152421966, C: 77.0692204277},
+		{A: 1.641e-08, B: 3.36410541913, C: 118.0224436358},
+		{A: 2.084e-08, B: 5.21275540105, C: 134.0640787458},
+		{A: 1.861e-08, B: 2.97480744198, C: 178.7893965226},
+		{A: 1.75e-08, B: 2.01731567093, C: 142.6620984549},
+		{A: 1.6e-08, B: 1.60963172329, C: 40.1600250673},
+		{A: 1.668e-08, B: 1.53361997245, C: 0.8300814025},
+		{A: 1.769e-08, B: 4.72689497119, C: 32.7164096664},
+		{A: 1.782e-08, B: 2.60469159465, C: 166.828672522},
+		{A: 1.765e-08, B: 5.57583636983, C: 522.5774180938},
+		{A: 1.877e-08, B: 1.09044005603, C: 274.0660483248},
+		{A: 1.544e-08, B: 1.9179841914, C: 303.8616966844},
+		{A: 1.532e-08, B: 3.44973397383, C: 124.5028511902},
+		{A: 1.658e-08, B: 5.23946791059, C: 233.9060232575},
+		{A: 1.743e-08, B: 2.32369273283, C: 290.2195580194},
+		{A: 1.528e-08, B: 0.62020771152, C: 1033.3583763983},
+		{A: 1.522e-08, B: 6.11272567668, C: 165.6048322446},
+		{A: 1.496e-08, B: 2.02190170195, C: 150.0844619964},
+		{A: 1.49e-08, B: 3.30997217921, C: 820.0592809603},
+		{A: 1.398e-08, B: 3.41567259878, C: 4.9959348461},
+		{A: 1.886e-08, B: 6.25585539882, C: 162.8965192589},
+		{A: 1.388e-08, B: 0.62745508416, C: 448.6895914038},
+		{A: 1.918e-08, B: 0.91483173263, C: 1819.6374661092},
+		{A: 1.682e-08, B: 2.11545135265, C: 189.7232222019},
+		{A: 1.711e-08, B: 2.55731536599, C: 1108.1399749656},
+		{A: 1.598e-08, B: 0.89607036108, C: 115.8835796217},
+		{A: 1.477e-08, B: 0.22838214106, C: 370.9390474199},
+		{A: 1.727e-08, B: 1.54005322759, C: 401.6497195162},
+		{A: 1.432e-08, B: 3.73381952953, C: 8.9068362498},
+		{A: 1.338e-08, B: 0.19338311739, C: 81.8951455681},
+		{A: 1.618e-08, B: 6.02595306259, C: 31.2319369581},
+		{A: 1.452e-08, B: 0.11827434627, C: 72.7758609972},
+		{A: 1.626e-08, B: 4.26332651029, C: 369.4545747116},
+		{A: 1.284e-08, B: 3.18389639039, C: 362.8622925726},
+		{A: 1.755e-08, B: 5.57436830525, C: 344.9636777388},
+		{A: 1.451e-08, B: 4.4261538175, C: 189.1807054807},
+		{A: 1.294e-08, B: 2.77775613125, C: 63.6240237188},
+		{A: 1.712e-08, B: 2.16785817753, C: 536.8045120954},
+		{A: 1.266e-08, B: 2.78408709208, C: 55.7710180407},
+		{A: 1.7e-08, B: 2.49908604932, C: 441.2672278623},
+		{A: 1.645e-08, B: 2.41257585783, C: 10.0824924174},
+		{A: 1.666e-08, B: 5.00664377609, C: 79.5169009825},
+		{A: 1.674e-08, B: 0.00394784675, C: 491.5579294568},
+		{A: 1.334e-08, B: 5.62972572008, C: 129.9194771616},
+		{A: 1.328e-08, B: 0.2560613584, C: 114.9416236346},
+		{A: 1.176e-08, B: 1.63208422172, C: 84.1827674285},
+		{A: 1.177e-08, B: 4.10449248614, C: 103.3534066495},
+		{A: 1.384e-08, B: 5.7300452987, C: 89.338760969},
+		{A: 1.287e-08, B: 0.99537181009, C: 14.6690369863},
+		{A: 1.171e-08, B: 5.98078310685, C: 57.1436161644},
+		{A: 1.126e-08, B: 3.39586759408, C: 375.3924655448},
+		{A: 1.406e-08, B: 5.18477940039, C: 113.8778420516},
+		{A: 1.397e-08, B: 1.49751443233, C: 14.2270940016},
+		{A: 1.206e-08, B: 3.60301196272, C: 480.7728616238},
+		{A: 1.113e-08, B: 4.80418427391, C: 9.449352971},
+		{A: 1.434e-08, B: 1.57158177893, C: 419.7452763061},
+		{A: 1.473e-08, B: 1.03881736383, C: 1215.1649024473},
+		{A: 1.311e-08, B: 2.99704179684, C: 458.8415197904},
+		{A: 1.453e-08, B: 6.10676427884, C: 64.6991061499},
+		{A: 1.07e-08, B: 3.16542344402, C: 54.3347294422},
+		{A: 1.389e-08, B: 2.78512263875, C: 26.0235537909},
+		{A: 1.203e-08, B: 0.20627563214, C: 0.5694489716},
+		{A: 1.343e-08, B: 5.58004577468, C: 95.2284676924},
+		{A: 1.062e-08, B: 2.40616687148, C: 154.979823106},
+		{A: 1.399e-08, B: 1.66776602336, C: 240.3864308119},
+		{A: 1.036e-08, B: 5.53891715915, C: 403.0223176399},
+		{A: 1.269e-08, B: 2.3768452729, C: 37.8724032069},
+		{A: 1.197e-08, B: 4.87553746725, C: 1044.4040766622},
+		{A: 1.009e-08, B: 2.7461946296, C: 80.4106728598},
+		{A: 1.33e-08, B: 0.99603813295, C: 483.2205421786},
+		{A: 1.348e-08, B: 0.58829539202, C: 476.4313180835},
+		{A: 9.89e-09, B: 3.31666847329, C: 18.9100067901},
+		{A: 1.054e-08, B: 2.85972567059, C: 616.3214130779},
+		{A: 1.276e-08, B: 4.72938141859, C: 691.1030116452},
+		{A: 1.219e-08, B: 3.6290968922, C: 106.2741679563},
+		{A: 1.269e-08, B: 1.53301050628, C: 280.9671470045},
+		{A: 9.68e-09, B: 2.73688433893, C: 218.9281697305},
+		{A: 1.33e-08, B: 5.69234088687, C: 694.0719570618},
+		{A: 1.121e-08, B: 2.8054243979, C: 148.5999892881},
+		{A: 9.8e-09, B: 6.04026702553, C: 5.4690155981},
+		{A: 1.235e-08, B: 0.61136787453, C: 237.6781178262},
+		{A: 1.161e-08, B: 5.3220902482, C: 369.0820676961},
+		{A: 9.44e-09, B: 1.14261861393, C: 384.0599212231},
+		{A: 1.017e-08, B: 2.49896409345, C: 147.1155165798},
+		{A: 1.082e-08, B: 2.46236323548, C: 326.8681209489},
+		{A: 1.037e-08, B: 5.92126063748, C: 4.8259251404},
+		{A: 1.232e-08, B: 5.83725190224, C: 63.847772888},
+		{A: 9.14e-09, B: 0.36627060914, C: 10.9338256793},
+		{A: 1.165e-08, B: 5.32140830393, C: 308.3151148093},
+		{A: 9.52e-09, B: 4.78982033367, C: 93.9040536785},
+		{A: 9.93e-09, B: 3.39918521663, C: 10.785067833},
+		{A: 1.206e-08, B: 3.6453050053, C: 699.7010313543},
+		{A: 8.93e-09, B: 2.97140509591, C: 248.4631856592},
+		{A: 8.83e-09, B: 2.85605198817, C: 15.1903018481},
+		{A: 8.75e-09, B: 3.4392570928, C: 3.0808200012},
+		{A: 1.103e-08, B: 4.80576195766, C: 6133.5126528568},
+		{A: 1.178e-08, B: 6.01576659565, C: 377.1588225434},
+		{A: 9.74e-09, B: 2.51206761828, C: 121.8427223143},
+		{A: 8.65e-09, B: 5.68958102479, C: 141.6988906084},
+		{A: 9.74e-09, B: 3.15729174941, C: 215.4379594521},
+		{A: 8.47e-09, B: 0.84854843713, C: 2043.9822618111},
+		{A: 9.61e-09, B: 0.22181374419, C: 0.9150237367},
+		{A: 1.167e-08, B: 2.37544946421, C: 33.9402499438},
+		{A: 1.013e-08, B: 3.43778868786, C: 36.9091953604},
+		{A: 8.38e-09, B: 1.63355479706, C: 2.3358059702},
+		{A: 1.113e-08, B: 2.5098969497, C: 405.9912630565},
+		{A: 9.87e-09, B: 1.14030030863, C: 82.2039621088},
+		{A: 1.06e-08, B: 1.70915765427, C: 438.2982824457},
+		{A: 8.29e-09, B: 2.97491446672, C: 62.7726904569},
+		{A: 9.91e-09, B: 4.44869793177, C: 406.1031376411},
+		{A: 9.52e-09, B: 6.13897716036, C: 184.9879197867},
+		{A: 1.033e-08, B: 4.19932839584, C: 141.4864422873},
+		{A: 1.029e-08, B: 5.13205530996, C: 157.2674449664},
+		{A: 8.05e-09, B: 3.11000318272, C: 93.7921790939},
+		{A: 8.65e-09, B: 4.44578048207, C: 295.1942410061},
+		{A: 9.21e-09, B: 4.88190545687, C: 12.0089081104},
+		{A: 9.49e-09, B: 5.99910796869, C: 606.7601855223},
+		{A: 9.91e-09, B: 1.68012021428, C: 40.5807161926},
+		{A: 8.54e-09, B: 0.37823682862, C: 217.2312487011},
+		{A: 8.83e-09, B: 2.3622438514, C: 3.7720945687},
+		{A: 1.016e-08, B: 3.90745585959, C: 194.2885149114},
+		{A: 8.07e-09, B: 5.93051451738, C: 302.0953396858},
+		{A: 8.79e-09, B: 0.52695940866, C: 1057.8974574809},
+		{A: 1.009e-08, B: 1.19621149495, C: 490.0734567485},
+		{A: 8.01e-09, B: 4.96724351781, C: 661.0949149645},
+		{A: 8.43e-09, B: 0.97496000705, C: 73.88782669},
+		{A: 1.028e-08, B: 2.65189503651, C: 477.9157907918},
+		{A: 8.46e-09, B: 3.3634373396, C: 40.8413486235},
+		{A: 7.72e-09, B: 4.93551925711, C: 425.1137181677},
+		{A: 7.81e-09, B: 0.59382881638, C: 97.6761482472},
+		{A: 7.7e-09, B: 4.25621058806, C: 488.8496164711},
+		{A: 9.35e-09, B: 1.24971148781, C: 624.919432787},
+		{A: 9.84e-09, B: 4.44298060183, C: 171.6545976624},
+		{A: 8.06e-09, B: 0.09410536829, C: 440.6822725257},
+		{A: 7.69e-09, B: 4.09296452529, C: 140.6563608848},
+		{A: 8.02e-09, B: 0.78515729603, C: 11.848849416},
+		{A: 1.007e-08, B: 4.06909438635, C: 76.154196691},
+		{A: 9.35e-09, B: 0.03808890956, C: 156.1554792736},
+		{A: 7.12e-09, B: 5.35549696452, C: 610.6923387854},
+		{A: 9.11e-09, B: 4.70177653335, C: 81.682697247},
+		{A: 9.26e-09, B: 3.09765550633, C: 833.552661779},
+		{A: 8.12e-09, B: 3.54377377085, C: 149.4031384402},
+		{A: 8.01e-09, B: 5.48034970408, C: 21.9795259432},
+		{A: 9e-09, B: 4.2173934702, C: 778.4147831847},
+		{A: 6.89e-09, B: 2.56862945159, C: 109.3125493421},
+		{A: 6.85e-09, B: 3.1996582898, C: 31.6526280834},
+		{A: 9.52e-09, B: 4.8257997882, C: 1744.8558675419},
+		{A: 7.24e-09, B: 2.16878848875, C: 1171.875873269},
+		{A: 8.97e-09, B: 3.94746491183, C: 75.6753704446},
+		{A: 7.39e-09, B: 5.45802693622, C: 252.6559713532},
+		{A: 8.21e-09, B: 4.26046087515, C: 1246.6574718363},
+		{A: 6.63e-09, B: 5.83767831921, C: 86.6304479833},
+		{A: 6.64e-09, B: 1.72432848951, C: 216.4804891757},
+		{A: 7.21e-09, B: 3.98089320988, C: 902.7051860538},
+		{A: 6.63e-09, B: 4.98388191647, C: 958.576777831},
+		{A: 7.5e-09, B: 2.66119349235, C: 363.5166838784},
+		{A: 8.28e-09, B: 3.62849315181, C: 14.8177948326},
+		{A: 6.63e-09, B: 3.23521229496, C: 207.8824694666},
+		{A: 6.81e-09, B: 3.29667467046, C: 25.0603459444},
+		{A: 8.87e-09, B: 4.81199147907, C: 155.5010879678},
+		{A: 6.46e-09, B: 2.65129054432, C: 685.4739373527},
+		{A: 8.08e-09, B: 5.68764955594, C: 280.003939158},
+		{A: 6.19e-09, B: 1.32296886424, C: 193.655375465},
+		{A: 6.21e-09, B: 2.57837200005, C: 703.6331846174},
+		{A: 8.54e-09, B: 3.63173686962, C: 411.620337349},
+		{A: 7.28e-09, B: 1.05845783695, C: 916.9322800554},
+		{A: 7.33e-09, B: 0.75817076935, C: 44.0921783304},
+		{A: 6.29e-09, B: 1.52747752455, C: 397.3932433474},
+		{A: 5.99e-09, B: 5.30671888409, C: 180.1619946463},
+		{A: 6.15e-09, B: 5.68908944293, C: 25.2727942655},
+		{A: 7.09e-09, B: 0.64922689354, C: 14.5571624017},
+		{A: 7.29e-09, B: 4.79389069212, C: 479.4002635001},
+		{A: 6.67e-09, B: 1.98320895029, C: 37.611770776},
+		{A: 5.86e-09, B: 1.51157853976, C: 668.2084619653},
+		{A: 6.39e-09, B: 1.69085181319, C: 262.4778313397},
+		{A: 6.16e-09, B: 4.62035066985, C: 12.2695405413},
+		{A: 6.87e-09, B: 2.24368916079, C: 228.7982138268},
+		{A: 5.99e-09, B: 2.08317681783, C: 149.3025647037},
+		{A: 5.97e-09, B: 3.14660293947, C: 137.5542890242},
+		{A: 5.81e-09, B: 2.69049614736, C: 823.9914342234},
+		{A: 7.09e-09, B: 4.38514100216, C: 184.0941479094},
+		{A: 6.71e-09, B: 3.46925958949, C: 105.380396079},
+		{A: 6.19e-09, B: 2.91325544152, C: 236.8749686741},
+		{A: 5.58e-09, B: 2.9617719488, C: 34.2008823747},
+		{A: 6.48e-09, B: 5.56457931302, C: 140.9651774255},
+		{A: 5.81e-09, B: 0.55427680962, C: 331.2096644892},
+		{A: 5.85e-09, B: 0.15548306049, C: 232.4215505492},
+		{A: 5.48e-09, B: 3.57525860446, C: 497.4476361802},
+		{A: 5.74e-09, B: 5.60908001848, C: 118.8737768977},
+		{A: 7.02e-09, B: 1.74156189506, C: 149.0419322728},
+		{A: 5.43e-09, B: 0.49890445043, C: 133.1008708993},
+		{A: 7.16e-09, B: 3.04149734724, C: 131.9252147317},
+		{A: 5.44e-09, B: 6.22369103738, C: 149.6113812444},
+		{A: 5.39e-09, B: 0.74276113752, C: 911.3032057629},
+		{A: 6.14e-09, B: 5.83710659138, C: 181.0557665236},
+		{A: 6.01e-09, B: 0.30768922616, C: 407.5876103494},
+		{A: 6.35e-09, B: 4.03476459045, C: 136.0698163159},
+		{A: 5.26e-09, B: 5.42874995984, C: 450.9772132642},
+		{A: 5.47e-09, B: 3.07676037032, C: 204.7010757289},
+		{A: 6.22e-09, B: 3.08666523105, C: 268.4369740323},
+		{A: 5.37e-09, B: 4.25467814241, C: 217.4436970222},
+		{A: 5.66e-09, B: 4.84686444604, C: 842.1506814881},
+		{A: 6.36e-09, B: 2.57425168783, C: 621.7380390493},
+		{A: 6.23e-09, B: 5.84341335807, C: 52.8020726241},
+		{A: 5.44e-09, B: 3.629830065, C: 149.5150130248},
+		{A: 5.78e-09, B: 0.34796271917, C: 139.4807047172},
+		{A: 5.37e-09, B: 5.99181083831, C: 246.9787129509},
+		{A: 6.74e-09, B: 2.57972741298, C: 602.9880909536},
+		{A: 5.39e-09, B: 6.1966296161, C: 696.5196376166},
+		{A: 5.16e-09, B: 2.19916575703, C: 458.090760265},
+		{A: 6.32e-09, B: 5.2665855364, C: 67.0773507355},
+		{A: 5.81e-09, B: 0.05320320337, C: 95.931043108},
+		{A: 5.04e-09, B: 6.24600928623, C: 149.723255829},
+		{A: 6.38e-09, B: 6.23121553223, C: 10063.7223490764},
+		{A: 5.28e-09, B: 0.20662780149, C: 310.1720945331},
+		{A: 5.37e-09, B: 2.96207822442, C: 73.1370671646},
+		{A: 5.08e-09, B: 5.29969144068, C: 335.7749571987},
+		{A: 4.87e-09, B: 2.83772541949, C: 143.9341228421},
+		{A: 5.37e-09, B: 3.36808372143, C: 252.0865223816},
+		{A: 6.32e-09, B: 5.88494938125, C: 920.8644333185},
+		{A: 5.23e-09, B: 6.13183488285, C: 1589.0728952838},
+		{A: 5.79e-09, B: 0.04597861846, C: 563.6312150384},
+		{A: 6.13e-09, B: 0.34938781762, C: 343.4792050305},
+		{A: 4.95e-09, B: 2.8921249981, C: 61.448276443},
+		{A: 6.23e-09, B: 0.71740350315, C: 513.079881013},
+		{A: 4.98e-09, B: 2.53375871592, C: 41.7563723602},
+		{A: 5.64e-09, B: 2.01612524784, C: 449.2802922348},
+		{A: 4.8e-09, B: 0.10535023009, C: 69.6737891366},
+		{A: 4.94e-09, B: 3.25187012728, C: 428.0826635843},
+		{A: 5.36e-09, B: 5.81149025999, C: 282.6640680339},
+		{A: 4.68e-09, B: 0.89483830828, C: 541.5398145106},
+		{A: 5.33e-09, B: 2.44239677121, C: 393.4610900843},
+		{A: 5.89e-09, B: 6.24067076234, C: 29.7956483596},
+		{A: 4.65e-09, B: 0.2500674371, C: 57.255490749},
+		{A: 6.22e-09, B: 3.89339038121, C: 416.7763308895},
+		{A: 5.19e-09, B: 2.72375973888, C: 469.1364605289},
+		{A: 4.98e-09, B: 1.76422801185, C: 380.3884003909},
+		{A: 5.96e-09, B: 0.83642843095, C: 98.3574718034},
+		{A: 4.59e-09, B: 0.39052216206, C: 197.7999770492},
+		{A: 5.74e-09, B: 2.86366933069, C: 170.7608257851},
+		{A: 5.18e-09, B: 3.38058345605, C: 535.9107402181},
+		{A: 5.64e-09, B: 1.20395155832, C: 832.5894539325},
+		{A: 5.56e-09, B: 4.39974034374, C: 196.6243208816},
+		{A: 5.37e-09, B: 3.9363706494, C: 460.5384408198},
+		{A: 4.82e-09, B: 2.4156214883, C: 827.9235874865},
+		{A: 5.78e-09, B: 2.39644032176, C: 1670.0742689746},
+		{A: 4.75e-09, B: 4.19223519775, C: 271.4059194489},
+		{A: 4.52e-09, B: 3.9914625148, C: 135.336103133},
+		{A: 5.14e-09, B: 6.11377193423, C: 1894.4190646765},
+		{A: 5.31e-09, B: 3.45607724228, C: 450.4559484024},
+		{A: 4.92e-09, B: 5.87591888758, C: 170.1701249541},
+		{A: 5.88e-09, B: 2.66953705406, C: 310.9752436852},
+		{A: 5.64e-09, B: 1.0449111737, C: 446.3113468182},
+		{A: 4.4e-09, B: 5.85084571537, C: 224.2329211173},
+		{A: 4.41e-09, B: 2.19799439287, C: 119.3950417595},
+		{A: 5.49e-09, B: 6.05651523611, C: 76.3779458602},
+		{A: 5.73e-09, B: 2.2989852675, C: 122.4758617607},
+		{A: 4.73e-09, B: 4.61187869812, C: 291.262087743},
+		{A: 5.53e-09, B: 4.90464199013, C: 463.5073862364},
+		{A: 5.99e-09, B: 1.48666209087, C: 149.8238295655},
+		{A: 4.99e-09, B: 3.72896978991, C: 853.196381752},
+		{A: 4.4e-09, B: 5.05024690419, C: 79.4474650133},
+		{A: 5.58e-09, B: 0.42332722744, C: 283.6272758804},
+		{A: 4.58e-09, B: 4.49655973916, C: 754.8389099486},
+		{A: 4.49e-09, B: 1.32861330901, C: 308.6876218248},
+		{A: 5.65e-09, B: 0.45628024105, C: 241.8709035202},
+		{A: 5.1e-09, B: 3.70202346104, C: 452.4616859725},
+		{A: 4.04e-09, B: 0.1233582124, C: 1097.0942747017},
+		{A: 4.09e-09, B: 4.02092464698, C: 735.8765135318},
+		{A: 4.64e-09, B: 3.82915608692, C: 1094.5460204104},
+		{A: 4.39e-09, B: 2.72266354653, C: 376.1956146969},
+		{A: 4.78e-09, B: 0.23380952322, C: 1182.9215735329},
+		{A: 4.12e-09, B: 1.21971515436, C: 419.4846438752},
+		{A: 5.46e-09, B: 0.22296640745, C: 829.6205085159},
+		{A: 5.1e-09, B: 2.69499052512, C: 412.5835451955},
+		{A: 4.09e-09, B: 2.51935747849, C: 409.0720830577},
+		{A: 4.51e-09, B: 0.56137272347, C: 758.7710632117},
+		{A: 3.99e-09, B: 0.8603731533, C: 337.8019466282},
+		{A: 3.93e-09, B: 0.402024632, C: 107.4980082337},
+		{A: 4.94e-09, B: 5.02745190154, C: 619.2903584945},
+		{A: 4.04e-09, B: 0.08539758465, C: 18.9623964168},
+		{A: 5.04e-09, B: 4.18251931021, C: 449.4927405559},
+		{A: 4.06e-09, B: 3.80082989682, C: 34.5309507748},
+		{A: 5.45e-09, B: 2.80919248176, C: 514.5643537213},
+		{A: 3.9e-09, B: 1.65941826256, C: 447.2051186955},
+		{A: 4.48e-09, B: 2.81540452771, C: 400.1652468079},
+		{A: 4.05e-09, B: 4.86073222353, C: 1404.0849754971},
+		{A: 4.76e-09, B: 1.61050626902, C: 54.2865453324},
+		{A: 4.06e-09, B: 1.29798079034, C: 226.7924762567},
+		{A: 5.26e-09, B: 5.35780726572, C: 838.218528225},
+		{A: 4.03e-09, B: 2.75405589772, C: 285.1117485887},
+		{A: 3.81e-09, B: 4.97702366598, C: 309.2783226558},
+		{A: 4.48e-09, B: 1.37926537411, C: 745.277682393},
+		{A: 4.19e-09, B: 0.90546724862, C: 451.7279727896},
+		{A: 4.5e-09, B: 1.92391706975, C: 474.9468453752},
+		{A: 4.74e-09, B: 5.54351717465, C: 494.2662424425},
+		{A: 4.6e-09, B: 5.09575399931, C: 289.5651667136},
+		{A: 4.55e-09, B: 3.1475508733, C: 168.5737776612},
+		{A: 3.72e-09, B: 4.87645271422, C: 116.5379709275},
+		{A: 4.79e-09, B: 1.08512503555, C: 154.2984995498},
+		{A: 3.74e-09, B: 0.16389070181, C: 1190.7858800591},
+		{A: 4.22e-09, B: 3.51871257208, C: 706.8145783551},
+		{A: 3.54e-09, B: 4.06405413226, C: 124.2904028691},
+		{A: 4.94e-09, B: 4.57924296149, C: 167.7224443993},
+		{A: 3.57e-09, B: 5.78050145791, C: 1265.5674786264},
+		{A: 4.02e-09, B: 2.6765270326, C: 464.4705940829},
+		{A: 3.7e-09, B: 2.21677703856, C: 232.0490435337},
+		{A: 4.62e-09, B: 4.1042430527, C: 27.7204748203},
+		{A: 3.64e-09, B: 3.0751873248, C: 442.639825986},
+		{A: 4.03e-09, B: 1.69214233165, C: 90.2807169561},
+		{A: 3.48e-09, B: 1.16647947937, C: 357.4456666012},
+		{A: 4.43e-09, B: 1.32306861852, C: 298.2326223919},
+		{A: 3.86e-09, B: 4.34980428548, C: 227.5261894396},
+		{A: 3.37e-09, B: 5.92030047826, C: 445.3481389717},
+		{A: 3.56e-09, B: 2.3882420066, C: 511.5954083047},
+		{A: 3.57e-09, B: 3.19265737844, C: 21.1976286505},
+		{A: 4.06e-09, B: 3.72223708907, C: 3116.2676309979},
+		{A: 3.41e-09, B: 3.05116722794, C: 15.7810026791},
+		{A: 3.79e-09, B: 4.72472516443, C: 30.5987975117},
+		{A: 4e-09, B: 0.71868453904, C: 836.5216071956},
+		{A: 3.7e-09, B: 0.17259001853, C: 6531.661656265},
+		{A: 4.56e-09, B: 0.83408547295, C: 674.8007441043},
+		{A: 3.76e-09, B: 5.91068811321, C: 1617.3840709351},
+		{A: 3.92e-09, B: 1.90856045571, C: 25863.55834587229},
+		{A: 3.33e-09, B: 4.77074940789, C: 76.7873361374},
+		{A: 3.32e-09, B: 0.8569940272, C: 749.2098356561},
+		{A: 3.21e-09, B: 3.88221470645, C: 38.6061163898},
+		{A: 3.21e-09, B: 2.59404134515, C: 1300.9922012785},
+		{A: 4.38e-09, B: 2.60178805278, C: 224.4566702865},
+		{A: 3.71e-09, B: 3.73501205989, C: 328.2407190726},
+		{A: 3.57e-09, B: 0.03656571669, C: 148.8124376092},
+		{A: 3.89e-09, B: 3.06990362181, C: 1012.9115072732},
+		{A: 3.92e-09, B: 3.14428599675, C: 125.1841747464},
+		{A: 3.26e-09, B: 2.23565995627, C: 89.5993933999},
+		{A: 3.14e-09, B: 4.56810921721, C: 1681.1199692385},
+		{A: 3.33e-09, B: 4.37613329736, C: 147.9668498417},
+		{A: 3.26e-09, B: 4.15448016748, C: 21.1494445407},
+		{A: 3.71e-09, B: 4.09066371754, C: 239.1625905345},
+		{A: 3.72e-09, B: 1.12230345314, C: 321.7603115182},
+		{A: 3.05e-09, B: 6.12924444546, C: 19.9737883731},
+		{A: 4.07e-09, B: 3.65906570714, C: 679.2541622292},
+		{A: 4.06e-09, B: 3.58469900333, C: 26013.1215430069},
+		{A: 3.1e-09, B: 3.92339533494, C: 229.0800981171},
+		{A: 3.21e-09, B: 1.35118535306, C: 172.4577468145},
+		{A: 3.32e-09, B: 3.54513021513, C: 288.7350853111},
+		{A: 3.12e-09, B: 2.87878773413, C: 806.725958836},
+		{A: 3.72e-09, B: 1.99953045718, C: 192.8040422031},
+		{A: 3.02e-09, B: 0.01867543539, C: 501.3797894433},
+		{A: 2.99e-09, B: 3.9646896095, C: 20277.0078952874},
+		{A: 3.08e-09, B: 2.66235795286, C: 248.7238180901},
+		{A: 2.98e-09, B: 3.52867456736, C: 21.4582610814},
+		{A: 3.7e-09, B: 3.51061046963, C: 91.4563731237},
+		{A: 2.97e-09, B: 0.79872983355, C: 742.9900605326},
+		{A: 4.14e-09, B: 4.81163687199, C: 589.4947101349},
+		{A: 3.02e-09, B: 5.2607933805, C: 27.5604161259},
+		{A: 3.14e-09, B: 3.14643487607, C: 361.3778198643},
+		{A: 3.56e-09, B: 4.63707521448, C: 442.7517005706},
+		{A: 3.57e-09, B: 3.44793069844, C: 44.6134431922},
+		{A: 3.7e-09, B: 4.25032151516, C: 304.2342036999},
+		{A: 3.66e-09, B: 5.43115395433, C: 625.9945152181},
+		{A: 3.65e-09, B: 0.3045449841, C: 6283.0758499914},
+		{A: 3.22e-09, B: 2.32892248876, C: 229.4526051326},
+		{A: 3.69e-09, B: 3.28573365074, C: 104.5290628171},
+		{A: 3.27e-09, B: 2.98588869318, C: 348.8476468921},
+		{A: 3.15e-09, B: 3.66842071994, C: 230.9370778409},
+		{A: 3.56e-09, B: 0.90433599977, C: 29.7474642498},
+		{A: 3.84e-09, B: 0.91820739126, C: 549.7284439425},
+		{A: 3.17e-09, B: 3.86462587284, C: 639.897286314},
+		{A: 3.33e-09, B: 4.95319798125, C: 881.5075574033},
+		{A: 2.85e-09, B: 4.09883967296, C: 904.1896587621},
+		{A: 3.38e-09, B: 5.65177488491, C: 195.7729876197},
+		{A: 3.65e-09, B: 0.57418860616, C: 285.6330134505},
+		{A: 3.63e-09, B: 0.98999016221, C: 839.7030009333},
+		{A: 3.3e-09, B: 2.26308969695, C: 49.1787359017},
+		{A: 3.35e-09, B: 2.25619157817, C: 272.5815756165},
+		{A: 3.76e-09, B: 5.87496858487, C: 268.9582388941},
+		{A: 3.62e-09, B: 4.94491380965, C: 572.2292347475},
+		{A: 3.2e-09, B: 5.58342880588, C: 459.3627846522},
+		{A: 2.99e-09, B: 2.86286938521, C: 883.7951792637},
+		{A: 2.86e-09, B: 2.49409963193, C: 156.043604689},
+		{A: 2.72e-09, B: 3.62976505444, C: 754.0357607965},
+		{A: 3.49e-09, B: 2.34615857088, C: 6069.7767545534},
+		{A: 2.79e-09, B: 4.04872155075, C: 180.7951340927},
+		{A: 3.28e-09, B: 1.21350330743, C: 148.1905990109},
+		{A: 3.15e-09, B: 0.74270298817, C: 320.2758388099},
+		{A: 3.24e-09, B: 5.54296698387, C: 1507.1777497157},
+		{A: 2.66e-09, B: 4.36134021576, C: 1310.3933701397},
+		{A: 2.62e-09, B: 5.84934968714, C: 450.1740641121},
+		{A: 2.8e-09, B: 5.05848320657, C: 102.523325247},
+		{A: 3.47e-09, B: 4.7006863962, C: 282.1428031721},
+		{A: 3.42e-09, B: 5.47365149093, C: 163.5778428151},
+		{A: 3.64e-09, B: 3.29301824378, C: 378.9039276826},
+		{A: 3.3e-09, B: 4.63426494882, C: 341.9947323222},
+		{A: 2.59e-09, B: 2.43682741156, C: 170.0100662597},
+		{A: 3.36e-09, B: 3.79029047358, C: 9999.986450773},
+		{A: 2.84e-09, B: 2.52583672467, C: 266.1011680621},
+		{A: 2.81e-09, B: 3.93593342516, C: 194.1766403268},
+		{A: 2.97e-09, B: 0.18595848541, C: 491.8185618877},
+		{A: 2.9e-09, B: 4.49575150721, C: 151.850818995},
+		{A: 2.84e-09, B: 1.59495665161, C: 336.8387387817},
+		{A: 2.55e-09, B: 5.85817353877, C: 229.340730548},
+		{A: 2.6e-09, B: 5.92834225312, C: 455.0694252217},
+		{A: 2.74e-09, B: 0.53977064975, C: 380.2396425446},
+		{A: 2.59e-09, B: 3.15728797958, C: 454.7493078329},
+		{A: 3.03e-09, B: 0.17851964142, C: 384.5811860849},
+		{A: 2.85e-09, B: 1.29732672572, C: 25.1297819136},
+		{A: 2.73e-09, B: 4.18776699292, C: 177.3049238143},
+		{A: 3.26e-09, B: 1.68159391466, C: 161.7208630913},
+		{A: 3.21e-09, B: 2.36931686576, C: 2274.5468326365},
+		{A: 2.41e-09, B: 3.57660492473, C: 150.31395666},
+		{A: 2.73e-09, B: 3.787800994, C: 380.0158933754},
+		{A: 2.65e-09, B: 3.02540120552, C: 454.7974919427},
+		{A: 2.65e-09, B: 6.06087280189, C: 455.0212411119},
+		{A: 2.79e-09, B: 3.82392760479, C: 31.5407534988},
+		{A: 2.73e-09, B: 2.64820862667, C: 838.0060799039},
+		{A: 2.38e-09, B: 5.17487170793, C: 263.0203480609},
+		{A: 2.99e-09, B: 3.94906046599, C: 531.978586955},
+		{A: 3.17e-09, B: 2.28289083599, C: 44.070926471},
+		{A: 2.5e-09, B: 1.35950829789, C: 304.1223291153},
+		{A: 3.12e-09, B: 2.73327875294, C: 442.3791935551},
+		{A: 3.14e-09, B: 3.86400459047, C: 734.4557312983},
+		{A: 2.51e-09, B: 0.15148137746, C: 221.1634019642},
+		{A: 2.72e-09, B: 5.71864670101, C: 164.5410506616},
+		{A: 3.05e-09, B: 4.96642198943, C: 1140.38330388},
+		{A: 2.82e-09, B: 5.46073788901, C: 550.1378342197},
+		{A: 2.73e-09, B: 5.68721459468, C: 92.4195809702},
+		{A: 2.56e-09, B: 0.8878797087, C: 418.5214360287},
+		{A: 2.29e-09, B: 5.02021405557, C: 144.8973306886},
+		{A: 2.31e-09, B: 5.7023675287, C: 132.8884225782},
+		{A: 2.18e-09, B: 2.0562373618, C: 303.0585475323},
+		{A: 3e-09, B: 1.76109447754, C: 371.5297482509},
+		{A: 2.16e-09, B: 2.97122807313, C: 176.6505325085},
+		{A: 2.24e-09, B: 3.12798198868, C: 188.9200730498},
+		{A: 2.12e-09, B: 1.30757526083, C: 74.1484591209},
+		{A: 2.38e-09, B: 4.65119406609, C: 385.7568422525},
+		{A: 2.16e-09, B: 0.0708691012, C: 893.3564068193},
+		{A: 2.62e-09, B: 5.78959872639, C: 635.9651330509},
+		{A: 2.5e-09, B: 4.47327859711, C: 551.1010420662},
+		{A: 2.22e-09, B: 1.64692618955, C: 76.42612997},
+		{A: 2.54e-09, B: 2.61838408005, C: 525.2375469697},
+		{A: 2.84e-09, B: 4.13290731223, C: 544.5087599272},
+		{A: 2.62e-09, B: 2.82476092056, C: 971.1069508032},
+		{A: 2.66e-09, B: 4.1246725861, C: 375.6743498351},
+		{A: 2.12e-09, B: 3.61675003296, C: 75.4147380137},
+		{A: 2.53e-09, B: 3.14224867483, C: 270.1820791715},
+		{A: 2.5e-09, B: 4.32883971376, C: 346.4481504471},
+		{A: 2.73e-09, B: 1.95676918609, C: 402.2191684878},
+		{A: 2.59e-09, B: 1.96371242284, C: 968.1380053866},
+		{A: 2.38e-09, B: 1.29663338057, C: 421.1815649046},
+		{A: 2.43e-09, B: 5.94961177434, C: 117.36805233},
+		{A: 2.41e-09, B: 4.70849619029, C: 406.954470903},
+		{A: 2.16e-09, B: 3.31232425021, C: 190.665178189},
+		{A: 2.01e-09, B: 1.23733749784, C: 799.6124118352},
+		{A: 2.23e-09, B: 0.98087204684, C: 627.3671133418},
+		{A: 2.26e-09, B: 1.66139333004, C: 1366.2125722902},
+		{A: 2.05e-09, B: 0.3383968395, C: 143.3434220111},
+		{A: 2.4e-09, B: 0.71559872262, C: 525.7588118315},
+		{A: 2.23e-09, B: 2.57722930616, C: 981.6313862053},
+		{A: 1.93e-09, B: 4.48974066435, C: 172.1971143836},
+		{A: 2.21e-09, B: 2.88670838151, C: 238.9019581036},
+		{A: 1.77e-09, B: 3.87122035013, C: 389.6889955156},
+		{A: 1.75e-09, B: 5.85737374379, C: 170.7126416753},
+		{A: 2.33e-09, B: 0.63169996424, C: 980.6681783588},
+		{A: 2.25e-09, B: 4.81504648561, C: 88.274979386},
+		{A: 2.1e-09, B: 2.49819501106, C: 128.4350044533},
+		{A: 1.72e-09, B: 0.91255921858, C: 210.8514148832},
+		{A: 1.76e-09, B: 3.85133296117, C: 605.9570363702},
+		{A: 2.11e-09, B: 1.72999855867, C: 10213.285546211},
+		{A: 1.7e-09, B: 1.614053401, C: 1512.8068240082},
+		{A: 2.16e-09, B: 1.54874566441, C: 1060.8664028975},
+		{A: 1.94e-09, B: 6.07381783144, C: 520.129737539},
+		{A: 1.7e-09, B: 2.58526747515, C: 1515.7757694248},
+		{A: 1.72e-09, B: 1.45073604378, C: 995.6460318858},
+		{A: 1.6e-09, B: 4.20015913513, C: 433.7117378768},
+		{A: 2.19e-09, B: 1.63985385568, C: 630.3360587584},
+		{A: 2.19e-09, B: 1.96273394194, C: 313.6835566709},
+		{A: 1.59e-09, B: 3.62343846627, C: 73.9784494152},
+		{A: 2.06e-09, B: 0.83764718449, C: 104.8378793578},
+		{A: 2.03e-09, B: 0.68701289007, C: 1363.2436268736},
+		{A: 1.58e-09, B: 0.81666479221, C: 987.5692770385},
+		{A: 1.72e-09, B: 2.97938676702, C: 327.4375699205},
+		{A: 1.47e-09, B: 2.15517092627, C: 73.2489417492},
+		{A: 1.56e-09, B: 1.296434692, C: 216.2680408546},
+		{A: 1.53e-09, B: 3.77492020946, C: 768.8535556291},
+		{A: 1.47e-09, B: 4.74903152642, C: 73.3453099688},
+		{A: 1.98e-09, B: 2.80749830888, C: 225.3080035484},
+		{A: 1.79e-09, B: 0.35410767087, C: 421.2297490144},
+		{A: 2.04e-09, B: 3.47423038287, C: 564.8550553158},
+		{A: 1.72e-09, B: 2.8842064282, C: 233.533516242},
+		{A: 1.95e-09, B: 3.09114364733, C: 294.3004691288},
+		{A: 1.81e-09, B: 4.75684139861, C: 71.1582618449},
+		{A: 1.6e-09, B: 3.16134209902, C: 70.0462961521},
+		{A: 1.55e-09, B: 2.07144269719, C: 91.2439248026},
+		{A: 1.54e-09, B: 3.37462128317, C: 138.4056222861},
+		{A: 1.92e-09, B: 6.19629054455, C: 312.1990839626},
+		{A: 1.62e-09, B: 0.60896273267, C: 73.0364934281},
+		{A: 1.72e-09, B: 2.01712700688, C: 973.554631358},
+		{A: 1.45e-09, B: 1.72582731815, C: 302.3772239761},
+		{A: 1.7e-09, B: 2.97437423989, C: 3191.0492295652},
+		{A: 1.53e-09, B: 0.11158566352, C: 138.6293714553},
+		{A: 1.64e-09, B: 5.37129563471, C: 457.3570470821},
+		{A: 1.32e-09, B: 3.15466226029, C: 523.4711899711},
+		{A: 1.37e-09, B: 2.88313323946, C: 765.8846102125},
+		{A: 1.28e-09, B: 1.75773421717, C: 77.7023598741},
+		{A: 1.73e-09, B: 5.03552846066, C: 415.2918581812},
+		{A: 1.37e-09, B: 4.77137510538, C: 73.4571845534},
+		{A: 1.63e-09, B: 0.99316178485, C: 94.4253185403},
+		{A: 1.38e-09, B: 4.68330115148, C: 517.1607921224},
+		{A: 1.33e-09, B: 2.95376828791, C: 75.1541055828},
+		{A: 1.34e-09, B: 1.35779558361, C: 249.9476583675},
+		{A: 1.68e-09, B: 4.7316454297, C: 108.7218485111},
+		{A: 1.19e-09, B: 1.48229220689, C: 237.4174853953},
+		{A: 1.34e-09, B: 1.5227717792, C: 154.6710065653},
+		{A: 1.17e-09, B: 5.4864227361, C: 437.6438911399},
+		{A: 1.31e-09, B: 5.76525548944, C: 75.4359898731},
+		{A: 1.27e-09, B: 2.3830922723, C: 208.8456773131},
+		{A: 1.15e-09, B: 4.38951350436, C: 343.2185725996},
+		{A: 1.14e-09, B: 4.48142283161, C: 224.8660605637},
+		{A: 1.17e-09, B: 5.37802827323, C: 293.7097682978},
+		{A: 1.36e-09, B: 2.80772094137, C: 374.4986936675},
+	},
+	// L1, 426 terms
+	{
+		{A: 75.02543121646, B: 0, C: 0},
+		{A: 0.00154458244, B: 5.24201658072, C: 74.7815985673},
+		{A: 0.00024456413, B: 1.71255705309, C: 1.4844727083},
+		{A: 9.257828e-05, B: 0.42844639064, C: 11.0457002639},
+		{A: 8.265977e-05, B: 1.5022003511, C: 63.7358983034},
+		{A: 7.841715e-05, B: 1.31983607251, C: 149.5631971346},
+		{A: 3.899105e-05, B: 0.46483574024, C: 3.9321532631},
+		{A: 2.283777e-05, B: 4.17367533997, C: 76.2660712756},
+		{A: 1.9266e-05, B: 0.53013080152, C: 2.9689454166},
+		{A: 1.232727e-05, B: 1.58634458237, C: 70.8494453042},
+		{A: 7.91206e-06, B: 5.43641224143, C: 3.1813937377},
+		{A: 7.66954e-06, B: 1.99555409575, C: 73.297125859},
+		{A: 4.81671e-06, B: 2.98401996914, C: 85.8272988312},
+		{A: 4.49798e-06, B: 4.13826237508, C: 138.5174968707},
+		{A: 4.456e-06, B: 3.72300400331, C: 224.3447957019},
+		{A: 4.26554e-06, B: 4.73126059388, C: 71.8126531507},
+		{A: 3.47735e-06, B: 2.45372261286, C: 9.5612275556},
+		{A: 3.53752e-06, B: 2.58324496886, C: 148.0787244263},
+		{A: 3.17084e-06, B: 5.57855232072, C: 52.6901980395},
+		{A: 1.7992e-06, B: 5.68367730922, C: 12.5301729722},
+		{A: 1.71084e-06, B: 3.00060075287, C: 78.7137518304},
+		{A: 2.05585e-06, B: 2.36263144251, C: 2.4476805548},
+		{A: 1.58029e-06, B: 2.90931969498, C: 0.9632078465},
+		{A: 1.89068e-06, B: 4.20242881378, C: 56.6223513026},
+		{A: 1.5467e-06, B: 5.59083925605, C: 4.4534181249},
+		{A: 1.83762e-06, B: 0.28371004654, C: 151.0476698429},
+		{A: 1.43464e-06, B: 2.59049246726, C: 62.2514255951},
+		{A: 1.51984e-06, B: 2.9421732689, C: 77.7505439839},
+		{A: 1.53515e-06, B: 4.65186885939, C: 35.1640902212},
+		{A: 1.21452e-06, B: 4.1483920492, C: 127.4717966068},
+		{A: 1.15546e-06, B: 3.73224603791, C: 65.2203710117},
+		{A: 1.02022e-06, B: 4.18754517993, C: 145.6310438715},
+		{A: 1.01718e-06, B: 6.03385875009, C: 0.1118745846},
+		{A: 8.8202e-07, B: 3.99035787994, C: 18.1592472647},
+		{A: 8.7549e-07, B: 6.15520787584, C: 202.2533951741},
+		{A: 8.053e-07, B: 2.64124743934, C: 22.0914005278},
+		{A: 7.2047e-07, B: 6.04545933578, C: 70.3281804424},
+		{A: 6.857e-07, B: 4.05071895264, C: 77.962992305},
+		{A: 5.9173e-07, B: 3.70413919082, C: 67.6680515665},
+		{A: 4.7267e-07, B: 3.54312460519, C: 351.8165923087},
+		{A: 4.2534e-07, B: 5.72357370899, C: 5.4166259714},
+		{A: 4.4339e-07, B: 5.90865821911, C: 7.1135470008},
+		{A: 3.5605e-07, B: 3.29197259183, C: 8.0767548473},
+		{A: 3.5524e-07, B: 3.32784616138, C: 71.6002048296},
+		{A: 3.6116e-07, B: 5.89964278801, C: 33.6796175129},
+		{A: 3.0608e-07, B: 5.46414592601, C: 160.6088973985},
+		{A: 3.1454e-07, B: 5.62015632303, C: 984.6003316219},
+		{A: 3.8544e-07, B: 4.91519003848, C: 222.8603229936},
+		{A: 3.4996e-07, B: 5.08034112149, C: 38.1330356378},
+		{A: 3.0811e-07, B: 5.49591403863, C: 59.8037450403},
+		{A: 2.8947e-07, B: 4.51867390414, C: 84.3428261229},
+		{A: 2.6627e-07, B: 5.54127301037, C: 131.4039498699},
+		{A: 2.9866e-07, B: 1.65980844667, C: 447.7958195265},
+		{A: 2.9206e-07, B: 1.14722640419, C: 462.0229135281},
+		{A: 2.5753e-07, B: 4.99362028417, C: 137.0330241624},
+		{A: 2.5373e-07, B: 5.73584678604, C: 380.12776796},
+		{A: 2.1672e-07, B: 2.80556379586, C: 69.3649725959},
+		{A: 2.6605e-07, B: 6.14640604128, C: 299.1263942692},
+		{A: 2.2995e-07, B: 2.24925345862, C: 111.4301614968},
+		{A: 1.9246e-07, B: 3.55645739672, C: 54.1746707478},
+		{A: 2.178e-07, B: 0.93285892393, C: 213.299095438},
+		{A: 1.9338e-07, B: 1.86249384092, C: 108.4612160802},
+		{A: 1.6153e-07, B: 3.10208165842, C: 14.977853527},
+		{A: 1.3126e-07, B: 1.95385539499, C: 87.3117715395},
+		{A: 1.3907e-07, B: 1.541490458, C: 340.7708920448},
+		{A: 1.3549e-07, B: 4.3845512672, C: 5.9378908332},
+		{A: 1.3102e-07, B: 5.88301410143, C: 6.2197751235},
+		{A: 1.181e-07, B: 0.32615567587, C: 35.4247226521},
+		{A: 1.098e-07, B: 1.69230280951, C: 45.5766510387},
+		{A: 1.2351e-07, B: 0.32823896833, C: 51.2057253312},
+		{A: 1.0906e-07, B: 5.9706844479, C: 265.9892934775},
+		{A: 1.1446e-07, B: 3.37831545858, C: 72.3339180125},
+		{A: 1.2013e-07, B: 3.60395709253, C: 269.9214467406},
+		{A: 1.1662e-07, B: 1.74504271366, C: 79.2350166922},
+		{A: 1.3777e-07, B: 2.69028726334, C: 225.8292684102},
+		{A: 1.2006e-07, B: 5.34430562395, C: 152.5321425512},
+		{A: 9.866e-08, B: 5.50316093605, C: 153.4953503977},
+		{A: 1.0436e-07, B: 4.16875643286, C: 24.3790223882},
+		{A: 1.0632e-07, B: 3.06875158069, C: 284.1485407422},
+		{A: 9.613e-08, B: 0.49590148788, C: 209.3669421749},
+		{A: 9.283e-08, B: 3.54479191952, C: 41.6444977756},
+		{A: 9.536e-08, B: 5.60054956443, C: 82.8583534146},
+		{A: 9.74e-08, B: 1.01087744586, C: 68.8437077341},
+		{A: 9.187e-08, B: 4.49472579228, C: 20.6069278195},
+		{A: 1.0159e-07, B: 3.51765739489, C: 529.6909650946},
+		{A: 8.612e-08, B: 3.88869873588, C: 60.7669528868},
+		{A: 1.003e-07, B: 4.6479020458, C: 77.2292791221},
+		{A: 8.689e-08, B: 1.96813580258, C: 195.1398481733},
+		{A: 8.37e-08, B: 4.40914764204, C: 134.5853436076},
+		{A: 9.273e-08, B: 3.932912279, C: 39.6175083461},
+		{A: 7.784e-08, B: 5.35626068469, C: 75.7448064138},
+		{A: 7.724e-08, B: 5.77176047568, C: 73.8183907208},
+		{A: 7.683e-08, B: 4.44252070929, C: 14.0146456805},
+		{A: 8.355e-08, B: 2.4442591043, C: 146.594251718},
+		{A: 7.954e-08, B: 5.73093878181, C: 184.7272873558},
+		{A: 7.465e-08, B: 2.18972405572, C: 145.1097790097},
+		{A: 6.43e-08, B: 0.84582374839, C: 32.1951448046},
+		{A: 6.257e-08, B: 2.17085130003, C: 74.8934731519},
+		{A: 7.911e-08, B: 0.17275924476, C: 120.358249606},
+		{A: 7.036e-08, B: 4.12047266896, C: 191.2076949102},
+		{A: 6.86e-08, B: 2.13462553365, C: 116.4260963429},
+		{A: 5.191e-08, B: 3.11155355454, C: 106.9767433719},
+		{A: 4.798e-08, B: 2.25093144226, C: 46.2097904851},
+		{A: 4.566e-08, B: 3.45427648666, C: 0.7507595254},
+		{A: 4.401e-08, B: 3.94058045671, C: 6.592282139},
+		{A: 4.214e-08, B: 5.17805765625, C: 144.1465711632},
+		{A: 4.409e-08, B: 0.24427052932, C: 92.940845832},
+		{A: 4.866e-08, B: 1.15344187054, C: 112.9146342051},
+		{A: 4.744e-08, B: 5.18229292013, C: 81.0013736908},
+		{A: 4.332e-08, B: 2.52429167546, C: 99.1606209555},
+		{A: 3.876e-08, B: 2.78430217652, C: 565.1156877467},
+		{A: 3.801e-08, B: 0.75133837939, C: 58.1068240109},
+		{A: 4.146e-08, B: 5.84943984597, C: 221.3758502853},
+		{A: 3.885e-08, B: 4.95626104286, C: 125.9873238985},
+		{A: 3.815e-08, B: 3.2300440193, C: 479.2883889155},
+		{A: 3.679e-08, B: 5.28098232097, C: 66.9172920411},
+		{A: 3.479e-08, B: 2.95514470947, C: 74.6697239827},
+		{A: 3.514e-08, B: 4.90090391308, C: 28.3111756513},
+		{A: 4.515e-08, B: 4.15474629145, C: 344.7030453079},
+		{A: 4.036e-08, B: 2.28903172191, C: 109.9456887885},
+		{A: 4.266e-08, B: 2.68534591099, C: 7.8643065262},
+		{A: 3.428e-08, B: 0.02846652682, C: 140.001969579},
+		{A: 3.644e-08, B: 5.3200209381, C: 408.4389436113},
+		{A: 3.252e-08, B: 1.44975192429, C: 128.9562693151},
+		{A: 4.143e-08, B: 1.89070487241, C: 277.0349937414},
+		{A: 3.177e-08, B: 0.04197149544, C: 220.4126424388},
+		{A: 3.901e-08, B: 6.25926496244, C: 0.8937718773},
+		{A: 3.787e-08, B: 0.02516903921, C: 152.7445908723},
+		{A: 3.2e-08, B: 0.52009458683, C: 2.2876218604},
+		{A: 2.995e-08, B: 1.94615440691, C: 80.1982245387},
+		{A: 4.029e-08, B: 5.24603808726, C: 96.8729990951},
+		{A: 3.302e-08, B: 4.8103355106, C: 422.6660376129},
+		{A: 3.189e-08, B: 6.261566034, C: 456.3938392356},
+		{A: 2.804e-08, B: 1.35626949052, C: 404.5067903482},
+		{A: 2.97e-08, B: 0.54327361056, C: 159.1244246902},
+		{A: 3.465e-08, B: 5.88337990735, C: 16.6747745564},
+		{A: 3.518e-08, B: 4.9964940413, C: 36.6485629295},
+		{A: 3.081e-08, B: 2.82772472086, C: 453.424893819},
+		{A: 3.32e-08, B: 1.56223495893, C: 23.5758732361},
+		{A: 2.573e-08, B: 6.19617997586, C: 135.5485514541},
+		{A: 2.547e-08, B: 5.19937103778, C: 173.9422195228},
+		{A: 2.534e-08, B: 1.85452635674, C: 490.3340891794},
+		{A: 3.106e-08, B: 6.07067928601, C: 142.4496501338},
+		{A: 3.302e-08, B: 1.02846689671, C: 297.6419215609},
+		{A: 2.429e-08, B: 1.33640100979, C: 211.8146227297},
+		{A: 2.792e-08, B: 3.89897022917, C: 358.9301393095},
+		{A: 2.947e-08, B: 5.31528985588, C: 55.1378785943},
+		{A: 2.449e-08, B: 3.44007536754, C: 206.1855484372},
+		{A: 2.407e-08, B: 4.38551271701, C: 60.5545045657},
+		{A: 2.425e-08, B: 2.22643225523, C: 66.70484372},
+		{A: 2.295e-08, B: 2.31690029267, C: 31.492569389},
+		{A: 2.225e-08, B: 0.41365126245, C: 81.3738807063},
+		{A: 2.196e-08, B: 0.76281798713, C: 17.5261078183},
+		{A: 2.301e-08, B: 3.60815987923, C: 288.0806940053},
+		{A: 2.557e-08, B: 0.73679737974, C: 200.7689224658},
+		{A: 2.158e-08, B: 2.61924330277, C: 13.3333221243},
+		{A: 2.048e-08, B: 6.27204714771, C: 98.8999885246},
+		{A: 2.054e-08, B: 3.61072687338, C: 333.657345044},
+		{A: 2.19e-08, B: 2.496967297, C: 76.4785195967},
+		{A: 2.092e-08, B: 1.66496421654, C: 235.3904959658},
+		{A: 2.206e-08, B: 2.35938756479, C: 347.8844390456},
+		{A: 2.469e-08, B: 4.70656858928, C: 186.2117600641},
+		{A: 2.226e-08, B: 5.9732773815, C: 1514.2912967165},
+		{A: 1.851e-08, B: 2.19455296942, C: 203.7378678824},
+		{A: 1.865e-08, B: 4.9820720428, C: 5.1078094307},
+		{A: 2.171e-08, B: 5.49034081907, C: 373.0142209592},
+		{A: 1.999e-08, B: 5.80509154216, C: 146.3818033969},
+		{A: 1.903e-08, B: 4.32950489567, C: 49.5088043018},
+		{A: 1.732e-08, B: 3.94794012202, C: 24.1183899573},
+		{A: 1.747e-08, B: 2.46883637489, C: 55.6591434561},
+		{A: 1.833e-08, B: 3.3511004846, C: 143.6253063014},
+		{A: 1.686e-08, B: 1.28621563322, C: 103.0927742186},
+		{A: 1.72e-08, B: 2.35857527806, C: 1.6445314027},
+		{A: 1.641e-08, B: 2.99507314472, C: 391.1734682239},
+		{A: 1.61e-08, B: 0.97420709262, C: 977.4867846211},
+		{A: 1.696e-08, B: 4.98332661473, C: 387.2413149608},
+		{A: 1.527e-08, B: 3.15107379811, C: 7.4223635415},
+		{A: 1.57e-08, B: 1.61119571428, C: 991.7138786227},
+		{A: 1.497e-08, B: 2.89637638984, C: 19.643719973},
+		{A: 1.507e-08, B: 3.32822127349, C: 909.8187330546},
+		{A: 1.375e-08, B: 5.75263837916, C: 19.1224551112},
+		{A: 1.407e-08, B: 2.20244941425, C: 67.3592350258},
+		{A: 1.364e-08, B: 4.40006421418, C: 27.0873353739},
+		{A: 1.357e-08, B: 4.33780029649, C: 70.1157321213},
+		{A: 1.311e-08, B: 4.62202930578, C: 81.8951455681},
+		{A: 1.307e-08, B: 2.79964247834, C: 25.6028626656},
+		{A: 1.312e-08, B: 3.7362325266, C: 628.8515860501},
+		{A: 1.286e-08, B: 3.96557527092, C: 61.2882177486},
+		{A: 1.723e-08, B: 4.56068809303, C: 305.3461693927},
+		{A: 1.313e-08, B: 4.90611014973, C: 617.8058857862},
+		{A: 1.508e-08, B: 6.25017976193, C: 604.4725636619},
+		{A: 1.235e-08, B: 5.93779486368, C: 415.5524906121},
+		{A: 1.278e-08, B: 3.21119872139, C: 92.0470739547},
+		{A: 1.357e-08, B: 0.72647086107, C: 546.956440482},
+		{A: 1.552e-08, B: 5.05296247763, C: 10.2949407385},
+		{A: 1.23e-08, B: 1.52077038294, C: 157.6399519819},
+		{A: 1.21e-08, B: 2.63049415027, C: 426.598190876},
+		{A: 1.206e-08, B: 4.83219370572, C: 100.3844612329},
+		{A: 1.234e-08, B: 4.46203104116, C: 162.0933701068},
+		{A: 1.174e-08, B: 5.3235619109, C: 17.2654753874},
+		{A: 1.431e-08, B: 6.18138614295, C: 14.2270940016},
+		{A: 1.244e-08, B: 0.16929250603, C: 29.2049475286},
+		{A: 1.18e-08, B: 4.09719023908, C: 443.8636662634},
+		{A: 1.18e-08, B: 3.31438239649, C: 44.7253177768},
+		{A: 1.259e-08, B: 1.88793196065, C: 0.6543913058},
+		{A: 1.263e-08, B: 3.49967730885, C: 230.5645708254},
+		{A: 1.168e-08, B: 2.04071854201, C: 30.7106720963},
+		{A: 1.523e-08, B: 2.28101186489, C: 373.9079928365},
+		{A: 1.429e-08, B: 2.05075136274, C: 181.7583419392},
+		{A: 1.065e-08, B: 2.95960854361, C: 241.6102710893},
+		{A: 1.253e-08, B: 0.23639539817, C: 561.1835344836},
+		{A: 1.255e-08, B: 1.2581992576, C: 155.7829722581},
+		{A: 1.044e-08, B: 2.89293032709, C: 543.0242872189},
+		{A: 1.062e-08, B: 3.26314901318, C: 28.5718080822},
+		{A: 1.124e-08, B: 1.06535506684, C: 88.1149206916},
+		{A: 1.186e-08, B: 5.73445278027, C: 329.7251917809},
+		{A: 1.19e-08, B: 2.82438170535, C: 41.1019810544},
+		{A: 1.067e-08, B: 0.2710180619, C: 58.319272332},
+		{A: 1.017e-08, B: 4.30527610005, C: 67.8804998876},
+		{A: 9.59e-09, B: 5.20504598622, C: 42.5382696529},
+		{A: 9.84e-09, B: 4.90934403664, C: 465.9550667912},
+		{A: 9.44e-09, B: 0.66925769374, C: 88.7962442478},
+		{A: 1.017e-08, B: 4.37095088461, C: 13.4933808187},
+		{A: 1.222e-08, B: 5.13450955699, C: 300.6108669775},
+		{A: 9.89e-09, B: 0.539379093, C: 80.7194894005},
+		{A: 8.9e-09, B: 3.09802121989, C: 110.2063212194},
+		{A: 1.095e-08, B: 1.7063757674, C: 43.1289704839},
+		{A: 9.92e-09, B: 4.17968869928, C: 154.0166152595},
+		{A: 9.5e-09, B: 0.09841899432, C: 273.1028404783},
+		{A: 8.7e-09, B: 4.77500238443, C: 33.1371007917},
+		{A: 8.67e-09, B: 4.22078052532, C: 20.4468691251},
+		{A: 8.3e-09, B: 5.23785245773, C: 472.1748419147},
+		{A: 8.84e-09, B: 4.34377463442, C: 105.4922706636},
+		{A: 8.12e-09, B: 3.53258780148, C: 39.3568759152},
+		{A: 1.055e-08, B: 1.52219418153, C: 227.3137411185},
+		{A: 9.63e-09, B: 1.87806076896, C: 259.5088859231},
+		{A: 8.59e-09, B: 0.57844232244, C: 152.0108776894},
+		{A: 8.61e-09, B: 4.69213709412, C: 1059.3819301892},
+		{A: 1.084e-08, B: 2.79612346618, C: 48.7580447764},
+		{A: 9.94e-09, B: 2.87052008214, C: 454.9093665273},
+		{A: 8.31e-09, B: 1.62068330602, C: 554.0699874828},
+		{A: 8.91e-09, B: 2.8502603686, C: 32.2433289144},
+		{A: 8.76e-09, B: 0.83921717739, C: 4.7353024152},
+		{A: 7.07e-09, B: 6.16918394997, C: 3.6233367224},
+		{A: 7.87e-09, B: 1.95585343912, C: 16.4623262353},
+		{A: 7.02e-09, B: 5.4955704624, C: 558.0021407459},
+		{A: 8.17e-09, B: 0.38724470336, C: 378.6432952517},
+		{A: 8.04e-09, B: 2.25693582099, C: 16.04163511},
+		{A: 8.66e-09, B: 1.80814575866, C: 258.8757464767},
+		{A: 6.51e-09, B: 3.72120167607, C: 286.596221297},
+		{A: 6.72e-09, B: 1.00052727778, C: 522.5774180938},
+		{A: 6.31e-09, B: 4.14839739363, C: 141.6988906084},
+		{A: 7.48e-09, B: 4.19441869839, C: 486.4019359163},
+		{A: 6.68e-09, B: 0.77754011576, C: 120.9913890524},
+		{A: 6.19e-09, B: 4.31040053492, C: 455.8725743738},
+		{A: 6.19e-09, B: 4.77556598202, C: 453.9461586808},
+		{A: 6.47e-09, B: 5.74952736928, C: 119.5069163441},
+		{A: 6.09e-09, B: 0.24149609998, C: 117.9105690512},
+		{A: 6.3e-09, B: 1.79018649942, C: 440.6822725257},
+		{A: 6.01e-09, B: 1.41196883461, C: 218.9281697305},
+		{A: 7.19e-09, B: 4.27398947015, C: 50.4025761791},
+		{A: 5.94e-09, B: 3.92150462249, C: 25.2727942655},
+		{A: 7.1e-09, B: 0.45768559438, C: 536.8045120954},
+		{A: 7.06e-09, B: 6.15599144951, C: 258.0244132148},
+		{A: 6.17e-09, B: 2.80636989892, C: 68.5618234438},
+		{A: 5.87e-09, B: 5.47247350993, C: 767.3690829208},
+		{A: 6.9e-09, B: 3.48978614301, C: 835.0371344873},
+		{A: 5.37e-09, B: 4.06668446648, C: 450.9772132642},
+		{A: 5.11e-09, B: 0.60155300709, C: 264.5048207692},
+		{A: 6.94e-09, B: 1.18127476921, C: 129.9194771616},
+		{A: 5.84e-09, B: 1.94104733057, C: 106.2741679563},
+		{A: 5.22e-09, B: 5.9518061751, C: 518.6452648307},
+		{A: 5.07e-09, B: 4.39658523394, C: 121.8427223143},
+		{A: 6.27e-09, B: 2.24582628581, C: 218.4069048687},
+		{A: 4.85e-09, B: 0.02058107411, C: 106.0135355254},
+		{A: 5.92e-09, B: 2.06072766194, C: 296.1574488526},
+		{A: 5.87e-09, B: 0.1855747086, C: 458.090760265},
+		{A: 4.83e-09, B: 1.50333774574, C: 150.5264049811},
+		{A: 4.74e-09, B: 4.99848521665, C: 458.8415197904},
+		{A: 5.66e-09, B: 1.9443518903, C: 699.7010313543},
+		{A: 4.72e-09, B: 1.865197202, C: 180.1619946463},
+		{A: 4.72e-09, B: 0.07145793467, C: 216.4804891757},
+		{A: 5.71e-09, B: 6.01195273302, C: 47.061123747},
+		{A: 4.6e-09, B: 3.76890954025, C: 342.2553647531},
+		{A: 4.89e-09, B: 2.96084966272, C: 385.7568422525},
+		{A: 4.58e-09, B: 1.99730631732, C: 275.5505210331},
+		{A: 4.6e-09, B: 5.75982407113, C: 89.7594520943},
+		{A: 5.49e-09, B: 1.43219978325, C: 171.6545976624},
+		{A: 5.44e-09, B: 0.04821904056, C: 114.3991069134},
+		{A: 4.5e-09, B: 1.94933296558, C: 148.5999892881},
+		{A: 4.44e-09, B: 2.94093732205, C: 692.5874843535},
+		{A: 4.42e-09, B: 2.15938034999, C: 173.6815870919},
+		{A: 5.43e-09, B: 2.61197342701, C: 451.7279727896},
+		{A: 4.65e-09, B: 0.31777753866, C: 756.3233826569},
+		{A: 4.41e-09, B: 2.82271922049, C: 32.7164096664},
+		{A: 5.38e-09, B: 2.39420182072, C: 339.2864193365},
+		{A: 5.69e-09, B: 0.84686482736, C: 260.9933586314},
+		{A: 5.72e-09, B: 5.40379754526, C: 278.5194664497},
+		{A: 4.22e-09, B: 4.61520857062, C: 40.1600250673},
+		{A: 4.51e-09, B: 4.5091120102, C: 142.1408335931},
+		{A: 5.01e-09, B: 0.18290112601, C: 331.3215390738},
+		{A: 4.68e-09, B: 0.97688759019, C: 760.25553592},
+		{A: 4.43e-09, B: 4.58896013561, C: 149.6750717192},
+		{A: 4.28e-09, B: 1.02564850231, C: 469.1364605289},
+		{A: 5e-09, B: 4.34235307579, C: 166.828672522},
+		{A: 4.12e-09, B: 5.69502940499, C: 92.3077063856},
+		{A: 4.04e-09, B: 5.18855270166, C: 22.633917249},
+		{A: 3.96e-09, B: 3.98515136901, C: 31.2319369581},
+		{A: 4.21e-09, B: 5.47567810199, C: 104.0077979553},
+		{A: 4.25e-09, B: 3.50702044406, C: 180.2738692309},
+		{A: 4.15e-09, B: 1.5229107152, C: 497.4476361802},
+		{A: 4.3e-09, B: 2.39159932023, C: 39.0962434843},
+		{A: 4.01e-09, B: 0.55271143649, C: 95.3885263868},
+		{A: 3.84e-09, B: 2.48712922138, C: 210.3301500214},
+		{A: 4.22e-09, B: 1.02056886848, C: 468.2426886516},
+		{A: 4.65e-09, B: 5.72323435231, C: 183.2428146475},
+		{A: 3.83e-09, B: 2.63486938783, C: 685.4739373527},
+		{A: 3.67e-09, B: 5.39331524988, C: 874.3940104025},
+		{A: 4.61e-09, B: 3.5796125479, C: 187.6962327724},
+		{A: 4.09e-09, B: 4.21780704807, C: 181.0557665236},
+		{A: 4.4e-09, B: 0.36380766054, C: 367.9701020033},
+		{A: 3.92e-09, B: 5.44355925956, C: 26.0235537909},
+		{A: 4.31e-09, B: 3.83885208954, C: 254.9435932136},
+		{A: 3.66e-09, B: 2.92275490656, C: 291.262087743},
+		{A: 4.16e-09, B: 2.54190330826, C: 255.0554677982},
+		{A: 3.48e-09, B: 0.35176743482, C: 46.470422916},
+		{A: 4.13e-09, B: 2.41518097006, C: 483.2205421786},
+		{A: 3.86e-09, B: 4.76483292968, C: 268.4369740323},
+		{A: 3.44e-09, B: 0.20350283971, C: 184.0941479094},
+		{A: 3.5e-09, B: 1.24205287122, C: 97.4155158163},
+		{A: 3.61e-09, B: 5.683933914, C: 353.301065017},
+		{A: 3.59e-09, B: 2.62171903648, C: 162.8965192589},
+		{A: 3.81e-09, B: 3.38777292581, C: 114.9416236346},
+		{A: 3.52e-09, B: 2.76374792259, C: 295.1942410061},
+		{A: 3.4e-09, B: 1.3466636056, C: 34.2008823747},
+		{A: 4.33e-09, B: 1.90504858871, C: 123.5396433437},
+		{A: 3.89e-09, B: 2.41268196916, C: 381.6122406683},
+		{A: 3.83e-09, B: 3.20416581825, C: 79.4474650133},
+		{A: 3.69e-09, B: 2.1518588972, C: 555.5544601911},
+		{A: 3.7e-09, B: 2.19402183275, C: 562.6680071919},
+		{A: 3.27e-09, B: 3.40081544565, C: 309.2783226558},
+		{A: 3.78e-09, B: 5.75737470182, C: 916.9322800554},
+		{A: 3.18e-09, B: 4.53066393124, C: 350.3321196004},
+		{A: 3.76e-09, B: 1.74845257914, C: 545.4719677737},
+		{A: 3.46e-09, B: 4.15815107375, C: 282.6640680339},
+		{A: 3.19e-09, B: 4.38123849114, C: 154.979823106},
+		{A: 3.2e-09, B: 0.81846631878, C: 610.6923387854},
+		{A: 3.27e-09, B: 5.07873875355, C: 189.7232222019},
+		{A: 3.06e-09, B: 1.71903179875, C: 394.3548619616},
+		{A: 3.29e-09, B: 1.82999432252, C: 706.8145783551},
+		{A: 3.35e-09, B: 4.78622577105, C: 109.3125493421},
+		{A: 3.1e-09, B: 5.08120849869, C: 376.1956146969},
+		{A: 3.27e-09, B: 1.87637598331, C: 207.8824694666},
+		{A: 3.23e-09, B: 1.888454518, C: 192.6921676185},
+		{A: 2.84e-09, B: 2.88222063053, C: 384.0599212231},
+		{A: 2.83e-09, B: 4.63187254084, C: 332.1728723357},
+		{A: 2.94e-09, B: 2.84554743359, C: 267.4737661858},
+		{A: 2.85e-09, B: 0.97965330777, C: 113.8778420516},
+		{A: 3.19e-09, B: 5.09582764612, C: 285.6330134505},
+		{A: 2.8e-09, B: 0.67871105907, C: 312.4597163935},
+		{A: 3e-09, B: 5.93285242876, C: 124.2904028691},
+		{A: 3.2e-09, B: 4.86151247369, C: 448.6895914038},
+		{A: 3.1e-09, B: 3.75000484412, C: 253.5709950899},
+		{A: 3.11e-09, B: 5.5968659072, C: 271.4059194489},
+		{A: 3.16e-09, B: 1.89686876211, C: 228.276948965},
+		{A: 2.69e-09, B: 0.14585942744, C: 142.6620984549},
+		{A: 2.7e-09, B: 2.12904548682, C: 778.4147831847},
+		{A: 2.67e-09, B: 0.96560769114, C: 90.8232336773},
+		{A: 3.08e-09, B: 1.38454900684, C: 375.3924655448},
+		{A: 2.98e-09, B: 3.99595366039, C: 451.9404211107},
+		{A: 2.78e-09, B: 3.38339026214, C: 346.3999663373},
+		{A: 2.87e-09, B: 1.01918432834, C: 905.8865797915},
+		{A: 2.63e-09, B: 0.16921968622, C: 124.5028511902},
+		{A: 2.83e-09, B: 5.95865378023, C: 362.8622925726},
+		{A: 2.66e-09, B: 3.07331582044, C: 193.655375465},
+		{A: 2.64e-09, B: 5.47114459575, C: 133.1008708993},
+		{A: 2.88e-09, B: 2.78232740152, C: 1812.5239191084},
+		{A: 3.61e-09, B: 4.30140629884, C: 198.321241911},
+		{A: 2.57e-09, B: 1.60206491208, C: 369.0820676961},
+		{A: 2.74e-09, B: 2.88347680082, C: 233.9060232575},
+		{A: 2.67e-09, B: 4.90554019072, C: 681.5417840896},
+		{A: 3.05e-09, B: 1.55983861329, C: 49.7212526229},
+		{A: 2.53e-09, B: 0.50457429429, C: 316.3918696566},
+		{A: 2.58e-09, B: 5.81453094409, C: 630.3360587584},
+		{A: 2.8e-09, B: 1.15452517706, C: 986.0848043302},
+		{A: 2.65e-09, B: 4.93584097286, C: 831.1049812242},
+		{A: 2.46e-09, B: 1.2518623362, C: 134.0640787458},
+		{A: 2.98e-09, B: 5.75927878031, C: 902.7051860538},
+		{A: 2.4e-09, B: 2.84888261768, C: 44.0921783304},
+		{A: 2.61e-09, B: 2.20643594285, C: 73.0846775379},
+		{A: 3.21e-09, B: 3.4686482782, C: 372.4235201282},
+		{A: 2.34e-09, B: 6.06783988023, C: 147.1155165798},
+		{A: 2.62e-09, B: 2.69623862046, C: 167.7224443993},
+		{A: 2.67e-09, B: 4.05985113852, C: 75.3028634291},
+		{A: 2.4e-09, B: 0.48471871511, C: 172.1971143836},
+		{A: 2.61e-09, B: 4.64354183979, C: 535.3200393871},
+		{A: 2.44e-09, B: 5.85987959874, C: 507.5995645668},
+		{A: 2.37e-09, B: 4.79666486485, C: 377.1588225434},
+		{A: 2.24e-09, B: 1.94589447357, C: 593.426863398},
+		{A: 2.26e-09, B: 3.71637531808, C: 449.2802922348},
+		{A: 2.33e-09, B: 5.98739382153, C: 219.891377577},
+		{A: 2.4e-09, B: 2.71609791875, C: 227.5261894396},
+		{A: 2.21e-09, B: 2.23218400256, C: 460.5384408198},
+		{A: 2.26e-09, B: 2.74516124394, C: 446.3113468182},
+		{A: 2.21e-09, B: 3.2033980767, C: 463.5073862364},
+		{A: 2.03e-09, B: 5.04975483055, C: 457.8783119439},
+		{A: 1.91e-09, B: 4.24841510229, C: 4.665866446},
+		{A: 1.69e-09, B: 0.59358171769, C: 983.1158589136},
+		{A: 1.79e-09, B: 4.12060524413, C: 310.1720945331},
+		{A: 1.87e-09, B: 6.22165475247, C: 294.6729761443},
+		{A: 1.62e-09, B: 1.30776665222, C: 248.7238180901},
+		{A: 2.19e-09, B: 4.17413407057, C: 303.8616966844},
+		{A: 1.93e-09, B: 1.64715944768, C: 91.4563731237},
+		{A: 1.7e-09, B: 2.18067759964, C: 66.1835788582},
+		{A: 1.56e-09, B: 4.92094728667, C: 68.1893164283},
+		{A: 1.45e-09, B: 5.51404722738, C: 280.9671470045},
+		{A: 1.44e-09, B: 5.81835834612, C: 75.5323580927},
+		{A: 1.53e-09, B: 0.48549989656, C: 144.8973306886},
+		{A: 1.68e-09, B: 5.81402201452, C: 149.45132255},
+		{A: 1.5e-09, B: 4.66632209585, C: 306.830642101},
+		{A: 1.31e-09, B: 1.01359934164, C: 175.1660598002},
+		{A: 1.74e-09, B: 3.03279013213, C: 298.2326223919},
+		{A: 1.63e-09, B: 1.97665571311, C: 221.1634019642},
+		{A: 1.44e-09, B: 2.5905808501, C: 217.2312487011},
+		{A: 1.67e-09, B: 2.7460416758, C: 69.1525242748},
+		{A: 1.29e-09, B: 2.87574897902, C: 156.1554792736},
+	},
+	// L2, 151 terms
+	{
+		{A: 0.00053033277, B: 0, C: 0},
+		{A: 2.357636e-05, B: 2.26014661705, C: 74.7815985673},
+		{A: 7.69129e-06, B: 4.52561041823, C: 11.0457002639},
+		{A: 5.51533e-06, B: 3.25814281023, C: 63.7358983034},
+		{A: 5.41532e-06, B: 2.27573907424, C: 3.9321532631},
+		{A: 5.29473e-06, B: 4.92348433826, C: 1.4844727083},
+		{A: 2.57521e-06, B: 3.69059216858, C: 3.1813937377},
+		{A: 2.38835e-06, B: 5.85806638405, C: 149.5631971346},
+		{A: 1.81904e-06, B: 6.21763603405, C: 70.8494453042},
+		{A: 4.9401e-07, B: 6.03101301723, C: 56.6223513026},
+		{A: 5.3504e-07, B: 1.44225240953, C: 76.2660712756},
+		{A: 3.8222e-07, B: 1.78467827781, C: 52.6901980395},
+		{A: 4.4753e-07, B: 3.90904910523, C: 2.4476805548},
+		{A: 4.453e-07, B: 0.81152639478, C: 85.8272988312},
+		{A: 3.7403e-07, B: 4.46228598032, C: 2.9689454166},
+		{A: 3.3029e-07, B: 0.86388149962, C: 9.5612275556},
+		{A: 2.4292e-07, B: 2.10702559049, C: 18.1592472647},
+		{A: 2.9423e-07, B: 5.09818697708, C: 73.297125859},
+		{A: 2.2135e-07, B: 4.81730808582, C: 78.7137518304},
+		{A: 2.2491e-07, B: 5.99320728691, C: 138.5174968707},
+		{A: 1.7226e-07, B: 2.53537183199, C: 145.6310438715},
+		{A: 2.1392e-07, B: 2.39880709309, C: 77.962992305},
+		{A: 2.0578e-07, B: 2.16918786539, C: 224.3447957019},
+		{A: 1.6777e-07, B: 3.46631344086, C: 12.5301729722},
+		{A: 1.2012e-07, B: 0.01941361902, C: 22.0914005278},
+		{A: 1.0466e-07, B: 4.45556032593, C: 62.2514255951},
+		{A: 1.101e-07, B: 0.0849627437, C: 127.4717966068},
+		{A: 8.668e-08, B: 4.25550086984, C: 7.1135470008},
+		{A: 1.0476e-07, B: 5.16453084068, C: 71.6002048296},
+		{A: 7.16e-08, B: 1.24903906391, C: 5.4166259714},
+		{A: 8.387e-08, B: 5.50115930045, C: 67.6680515665},
+		{A: 6.087e-08, B: 5.44611674384, C: 65.2203710117},
+		{A: 6.013e-08, B: 4.51836836347, C: 151.0476698429},
+		{A: 5.718e-08, B: 1.8293391534, C: 202.2533951741},
+		{A: 6.109e-08, B: 3.36320161279, C: 447.7958195265},
+		{A: 6.003e-08, B: 5.72500086735, C: 462.0229135281},
+		{A: 5.111e-08, B: 3.52374555791, C: 59.8037450403},
+		{A: 5.155e-08, B: 1.05810305746, C: 131.4039498699},
+		{A: 5.969e-08, B: 5.61147374852, C: 148.0787244263},
+		{A: 5.065e-08, B: 3.36477113418, C: 4.4534181249},
+		{A: 4.845e-08, B: 1.20298837109, C: 71.8126531507},
+		{A: 3.979e-08, B: 0.67629577193, C: 77.7505439839},
+		{A: 3.673e-08, B: 1.76315074166, C: 351.8165923087},
+		{A: 3.149e-08, B: 3.83590892865, C: 45.5766510387},
+		{A: 3.036e-08, B: 3.32062892682, C: 160.6088973985},
+		{A: 3.033e-08, B: 6.14532331482, C: 77.2292791221},
+		{A: 3.596e-08, B: 4.57256025582, C: 454.9093665273},
+		{A: 2.664e-08, B: 5.36121614612, C: 269.9214467406},
+		{A: 2.498e-08, B: 1.04819496324, C: 69.3649725959},
+		{A: 2.307e-08, B: 2.69282373897, C: 84.3428261229},
+		{A: 2.249e-08, B: 5.07693376112, C: 14.977853527},
+		{A: 2.228e-08, B: 1.38937510191, C: 284.1485407422},
+		{A: 2.064e-08, B: 4.34647674542, C: 984.6003316219},
+		{A: 2.105e-08, B: 2.32047802326, C: 120.358249606},
+		{A: 1.864e-08, B: 5.70354779393, C: 54.1746707478},
+		{A: 2.005e-08, B: 3.87177765185, C: 195.1398481733},
+		{A: 1.622e-08, B: 5.07964536529, C: 209.3669421749},
+		{A: 1.597e-08, B: 0.48807990368, C: 137.0330241624},
+		{A: 1.583e-08, B: 2.90536212187, C: 51.2057253312},
+		{A: 1.725e-08, B: 6.25703202673, C: 41.6444977756},
+		{A: 2.073e-08, B: 1.24032244487, C: 35.1640902212},
+		{A: 1.543e-08, B: 2.15414338268, C: 70.3281804424},
+		{A: 1.671e-08, B: 6.28283232471, C: 277.0349937414},
+		{A: 1.494e-08, B: 6.04572758571, C: 87.3117715395},
+		{A: 1.418e-08, B: 1.15843502159, C: 213.299095438},
+		{A: 1.239e-08, B: 4.63223076077, C: 92.940845832},
+		{A: 1.238e-08, B: 2.65969680342, C: 134.5853436076},
+		{A: 1.273e-08, B: 5.87964059822, C: 60.5545045657},
+		{A: 1.16e-08, B: 1.03320781667, C: 153.4953503977},
+		{A: 1.43e-08, B: 4.68022239016, C: 299.1263942692},
+		{A: 1.117e-08, B: 2.62506108047, C: 72.3339180125},
+		{A: 1.142e-08, B: 4.64615099782, C: 152.7445908723},
+		{A: 9.74e-09, B: 2.85233132493, C: 222.8603229936},
+		{A: 1.046e-08, B: 4.81299135934, C: 116.4260963429},
+		{A: 8.72e-09, B: 3.49659835508, C: 340.7708920448},
+		{A: 9.52e-09, B: 2.1083748084, C: 20.6069278195},
+		{A: 9.64e-09, B: 2.46471453524, C: 380.12776796},
+		{A: 8.43e-09, B: 6.12869288891, C: 49.5088043018},
+		{A: 8.21e-09, B: 0.27134156683, C: 191.2076949102},
+		{A: 8.13e-09, B: 4.08930465981, C: 14.2270940016},
+		{A: 7.96e-09, B: 6.170668463, C: 344.7030453079},
+		{A: 9.24e-09, B: 2.11096444289, C: 14.0146456805},
+		{A: 7.91e-09, B: 2.38927423348, C: 58.1068240109},
+		{A: 7.81e-09, B: 0.7422311595, C: 408.4389436113},
+		{A: 7.59e-09, B: 3.77564054479, C: 80.1982245387},
+		{A: 8.84e-09, B: 1.99930014838, C: 265.9892934775},
+		{A: 7.22e-09, B: 3.10001033669, C: 422.6660376129},
+		{A: 7.5e-09, B: 2.33167721991, C: 358.9301393095},
+		{A: 6.87e-09, B: 2.0286634204, C: 33.6796175129},
+		{A: 6.03e-09, B: 1.10391172652, C: 55.1378785943},
+		{A: 6.55e-09, B: 3.85415269764, C: 16.6747745564},
+		{A: 6.06e-09, B: 0.15052747979, C: 28.3111756513},
+		{A: 6.39e-09, B: 5.16714934188, C: 23.5758732361},
+		{A: 6.58e-09, B: 0.75636229109, C: 76.4785195967},
+		{A: 5.9e-09, B: 1.73778850095, C: 8.0767548473},
+		{A: 5.65e-09, B: 4.92645232089, C: 35.4247226521},
+		{A: 6.56e-09, B: 2.34273264083, C: 38.1330356378},
+		{A: 5.42e-09, B: 5.97968975563, C: 146.594251718},
+		{A: 5.18e-09, B: 3.19086220901, C: 152.5321425512},
+		{A: 5.36e-09, B: 4.52808465499, C: 220.4126424388},
+		{A: 4.89e-09, B: 4.80633294199, C: 159.1244246902},
+		{A: 4.91e-09, B: 0.85765309118, C: 565.1156877467},
+		{A: 4.83e-09, B: 3.52583593251, C: 144.1465711632},
+		{A: 5.21e-09, B: 5.21561656321, C: 206.1855484372},
+		{A: 4.77e-09, B: 4.25420753202, C: 365.9006739584},
+		{A: 4.66e-09, B: 5.13219663072, C: 297.6419215609},
+		{A: 5.57e-09, B: 0.98387565952, C: 225.8292684102},
+		{A: 5.31e-09, B: 4.2253465745, C: 29.2049475286},
+		{A: 5e-09, B: 3.49663062387, C: 128.9562693151},
+		{A: 4.45e-09, B: 2.60797570173, C: 96.8729990951},
+		{A: 4.66e-09, B: 6.05585106742, C: 70.1157321213},
+		{A: 4.25e-09, B: 1.04692398351, C: 19.643719973},
+		{A: 4.91e-09, B: 2.2612339868, C: 152.0108776894},
+		{A: 4.55e-09, B: 5.45520675, C: 333.657345044},
+		{A: 4.58e-09, B: 0.91654899383, C: 373.0142209592},
+		{A: 5.2e-09, B: 5.72828536642, C: 111.4301614968},
+		{A: 4.32e-09, B: 1.04604024916, C: 125.9873238985},
+		{A: 3.87e-09, B: 2.82547341355, C: 200.7689224658},
+		{A: 3.83e-09, B: 1.91679738697, C: 5.6290742925},
+		{A: 5.04e-09, B: 1.95816731769, C: 415.5524906121},
+		{A: 3.7e-09, B: 3.21958844151, C: 387.2413149608},
+		{A: 3.79e-09, B: 2.75940848661, C: 81.8951455681},
+		{A: 3.45e-09, B: 2.98021540638, C: 429.7795846137},
+		{A: 3.68e-09, B: 6.20331898497, C: 456.3938392356},
+		{A: 3.35e-09, B: 5.29062985955, C: 13.3333221243},
+		{A: 3.2e-09, B: 0.74685222907, C: 347.8844390456},
+		{A: 3.07e-09, B: 1.65925943351, C: 99.1606209555},
+		{A: 2.84e-09, B: 2.0943747648, C: 129.9194771616},
+		{A: 2.75e-09, B: 0.62680026669, C: 31.492569389},
+		{A: 3.39e-09, B: 1.65968150805, C: 142.4496501338},
+		{A: 2.7e-09, B: 2.7937834555, C: 977.4867846211},
+		{A: 2.84e-09, B: 2.42261530322, C: 546.956440482},
+		{A: 2.71e-09, B: 0.59225635449, C: 1894.4190646765},
+		{A: 2.63e-09, B: 3.49309481771, C: 440.6822725257},
+		{A: 2.95e-09, B: 0.43376026627, C: 373.9079928365},
+		{A: 2.71e-09, B: 4.82853730065, C: 561.1835344836},
+		{A: 2.48e-09, B: 5.72379940676, C: 79.2350166922},
+		{A: 2.52e-09, B: 5.76728095309, C: 235.3904959658},
+		{A: 2.61e-09, B: 0.09830739366, C: 991.7138786227},
+		{A: 2.38e-09, B: 1.14634663242, C: 288.0806940053},
+		{A: 2.2e-09, B: 5.12386263728, C: 479.2883889155},
+		{A: 2.63e-09, B: 1.52366362053, C: 146.3818033969},
+		{A: 2.3e-09, B: 0.35983101967, C: 109.9456887885},
+		{A: 2.14e-09, B: 2.9438876558, C: 184.7272873558},
+		{A: 1.98e-09, B: 5.72107161521, C: 453.424893819},
+		{A: 1.85e-09, B: 4.7160017678, C: 108.4612160802},
+		{A: 1.39e-09, B: 2.99832472431, C: 211.8146227297},
+		{A: 1.67e-09, B: 1.1964352228, C: 39.6175083461},
+		{A: 1.8e-09, B: 0.80198096578, C: 183.2428146475},
+		{A: 1.31e-09, B: 2.73236351123, C: 522.5774180938},
+		{A: 1.42e-09, B: 5.03489222377, C: 536.8045120954},
+	},
+	// L3, 46 terms
+	{
+		{A: 1.20936e-06, B: 0.02418789918, C: 74.7815985673},
+		{A: 6.8064e-07, B: 4.12084267733, C: 3.9321532631},
+		{A: 5.2828e-07, B: 2.3896406126, C: 11.0457002639},
+		{A: 4.3754e-07, B: 2.95965039734, C: 1.4844727083},
+		{A: 4.53e-07, B: 2.0442379841, C: 3.1813937377},
+		{A: 4.5806e-07, B: 0, C: 0},
+		{A: 2.4969e-07, B: 4.88741307918, C: 63.7358983034},
+		{A: 2.1061e-07, B: 4.54511486862, C: 70.8494453042},
+		{A: 1.9897e-07, B: 2.31320314136, C: 149.5631971346},
+		{A: 8.901e-08, B: 1.57548871761, C: 56.6223513026},
+		{A: 4.271e-08, B: 0.22777319552, C: 18.1592472647},
+		{A: 3.613e-08, B: 5.39244611308, C: 76.2660712756},
+		{A: 3.488e-08, B: 4.97622811775, C: 85.8272988312},
+		{A: 3.479e-08, B: 4.12969359977, C: 52.6901980395},
+		{A: 3.572e-08, B: 0.95052448578, C: 77.962992305},
+		{A: 2.328e-08, B: 0.85770961794, C: 145.6310438715},
+		{A: 2.696e-08, B: 0.37287796344, C: 78.7137518304},
+		{A: 1.946e-08, B: 2.67997393431, C: 7.1135470008},
+		{A: 2.156e-08, B: 5.65647821519, C: 9.5612275556},
+		{A: 1.363e-08, B: 4.86983744746, C: 224.3447957019},
+		{A: 1.333e-08, B: 1.25032115614, C: 12.5301729722},
+		{A: 1.613e-08, B: 0.48764377311, C: 71.6002048296},
+		{A: 1.475e-08, B: 5.19957293069, C: 73.297125859},
+		{A: 1.225e-08, B: 3.93406822032, C: 22.0914005278},
+		{A: 9.11e-09, B: 2.18921999026, C: 127.4717966068},
+		{A: 8.11e-09, B: 3.98323855938, C: 462.0229135281},
+		{A: 8.08e-09, B: 5.06374463008, C: 447.7958195265},
+		{A: 7.18e-09, B: 0.34600103024, C: 5.6290742925},
+		{A: 7.22e-09, B: 1.05856935832, C: 138.5174968707},
+		{A: 6.87e-09, B: 2.93752748595, C: 131.4039498699},
+		{A: 4.63e-09, B: 1.58927254512, C: 151.0476698429},
+		{A: 4.14e-09, B: 4.3290428762, C: 120.358249606},
+		{A: 3.72e-09, B: 0.73596518002, C: 269.9214467406},
+		{A: 3.45e-09, B: 3.05968942771, C: 561.1835344836},
+		{A: 3.38e-09, B: 5.94221536204, C: 284.1485407422},
+		{A: 3.43e-09, B: 4.01891371998, C: 546.956440482},
+		{A: 3.82e-09, B: 5.93515231196, C: 45.5766510387},
+		{A: 2.76e-09, B: 3.44212110991, C: 202.2533951741},
+		{A: 3e-09, B: 1.13119175675, C: 160.6088973985},
+		{A: 2.68e-09, B: 3.24615387968, C: 536.8045120954},
+		{A: 3.58e-09, B: 1.10916640253, C: 333.657345044},
+		{A: 2.36e-09, B: 4.65292396535, C: 387.2413149608},
+		{A: 2.04e-09, B: 5.81663798296, C: 373.0142209592},
+		{A: 1.45e-09, B: 2.75632381347, C: 92.940845832},
+		{A: 1.49e-09, B: 0.13764106563, C: 71.8126531507},
+		{A: 1.56e-09, B: 2.90936922804, C: 153.4953503977},
+	},
+	// L4, 7 terms
+	{
+		{A: 1.13855e-06, B: 3.14159265359, C: 0},
+		{A: 5.599e-08, B: 4.57882424417, C: 74.7815985673},
+		{A: 3.203e-08, B: 0.34623003207, C: 11.0457002639},
+		{A: 1.217e-08, B: 3.42199121826, C: 56.6223513026},
+		{A: 6.34e-09, B: 4.65759668097, C: 18.1592472647},
+		{A: 1.71e-09, B: 3.80393539303, C: 149.5631971346},
+		{A: 1.33e-09, B: 4.35519131657, C: 63.7358983034},
+	},
+	// L5, 1 terms
+	{
+		{A: 8.73e-09, B: 3.14159265359, C: 0},
+	},
+}
+
+// GetUranusL0 evaluates the t^0 series of L.
+func GetUranusL0(t float64) float64 {
+	return uranusL[0].Evaluate(t)
+}
+
+// GetUranusL1 evaluates the t^1 series of L.
+func GetUranusL1(t float64) float64 {
+	return uranusL[1].Evaluate(t)
+}
+
+// GetUranusL2 evaluates the t^2 series of L.
+func GetUranusL2(t float64) float64 {
+	return uranusL[2].Evaluate(t)
+}
+
+// GetUranusL3 evaluates the t^3 series of L.
+func GetUranusL3(t float64) float64 {
+	return uranusL[3].Evaluate(t)
+}
+
+// GetUranusL4 evaluates the t^4 series of L.
+func GetUranusL4(t float64) float64 {
+	return uranusL[4].Evaluate(t)
+}
+
+// GetUranusL5 evaluates the t^5 series of L.
+func GetUranusL5(t float64) float64 {
+	return uranusL[5].Evaluate(t)
+}
@@ -0,0 +1,1947 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// URANUS R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87uranusd
+
+import "vsop87"
+
+var uranusR = []vsop87.Series{
+	// R0, 1124 terms
+	{
+		{A: 19.21264847881, B: 0, C: 0},
+		{A: 0.88784984055, B: 5.60377526994, C: 74.7815985673},
+		{A: 0.03440835545, B: 0.32836098991, C: 73.297125859},
+		{A: 0.02055653495, B: 1.78295170028, C: 149.5631971346},
+		{A: 0.00649321851, B: 4.52247298119, C: 76.2660712756},
+		{A: 0.00602248144, B: 3.86003820462, C: 63.7358983034},
+		{A: 0.00496404171, B: 1.40139934716, C: 454.9093665273},
+		{A: 0.00338525522, B: 1.58002682946, C: 138.5174968707},
+		{A: 0.00243508222, B: 1.57086595074, C: 71.8126531507},
+		{A: 0.00190521915, B: 1.99809364502, C: 1.4844727083},
+		{A: 0.00161858251, B: 2.79137863469, C: 148.0787244263},
+		{A: 0.00143705902, B: 1.38368574483, C: 11.0457002639},
+		{A: 0.00093192359, B: 0.17437193645, C: 36.6485629295},
+		{A: 0.00071424265, B: 4.24509327405, C: 224.3447957019},
+		{A: 0.00089805842, B: 3.66105366329, C: 109.9456887885},
+		{A: 0.00039009624, B: 1.66971128869, C: 70.8494453042},
+		{A: 0.00046677322, B: 1.39976563936, C: 35.1640902212},
+		{A: 0.00039025681, B: 3.36234710692, C: 277.0349937414},
+		{A: 0.0003675516, B: 3.88648934736, C: 146.594251718},
+		{A: 0.00030348875, B: 0.70100446346, C: 151.0476698429},
+		{A: 0.00029156264, B: 3.18056174556, C: 77.7505439839},
+		{A: 0.00020471584, B: 1.555889615, C: 202.2533951741},
+		{A: 0.0002562036, B: 5.25656292802, C: 380.12776796},
+		{A: 0.00025785805, B: 3.78537741503, C: 85.8272988312},
+		{A: 0.00022637152, B: 0.72519137745, C: 529.6909650946},
+		{A: 0.00020473163, B: 2.79639811626, C: 70.3281804424},
+		{A: 0.00017900561, B: 0.55455488605, C: 2.9689454166},
+		{A: 0.00012328151, B: 5.96039150918, C: 127.4717966068},
+		{A: 0.00014701566, B: 4.90434406648, C: 108.4612160802},
+		{A: 0.00011494701, B: 0.43774027872, C: 65.2203710117},
+		{A: 0.00015502809, B: 5.35405037603, C: 38.1330356378},
+		{A: 0.00010792699, B: 1.42104858472, C: 213.299095438},
+		{A: 0.00011696085, B: 3.29825599114, C: 3.9321532631},
+		{A: 0.00011959355, B: 1.75044072173, C: 984.6003316219},
+		{A: 0.00012896507, B: 2.62154018241, C: 111.4301614968},
+		{A: 0.00011852996, B: 0.99342814582, C: 52.6901980395},
+		{A: 9.111446e-05, B: 4.99638600045, C: 62.2514255951},
+		{A: 8.42055e-05, B: 5.25350716616, C: 222.8603229936},
+		{A: 7.449125e-05, B: 0.79491905956, C: 351.8165923087},
+		{A: 8.402147e-05, B: 5.03877516489, C: 415.5524906121},
+		{A: 6.04637e-05, B: 5.67960948357, C: 78.7137518304},
+		{A: 5.524133e-05, B: 3.11499484161, C: 9.5612275556},
+		{A: 7.329454e-05, B: 3.9727752784, C: 183.2428146475},
+		{A: 5.444878e-05, B: 5.10575635361, C: 145.1097790097},
+		{A: 5.238103e-05, B: 2.62960141797, C: 33.6796175129},
+		{A: 4.079167e-05, B: 3.22064788674, C: 340.7708920448},
+		{A: 3.801606e-05, B: 6.10985558505, C: 184.7272873558},
+		{A: 3.919476e-05, B: 4.25015288873, C: 39.6175083461},
+		{A: 2.940492e-05, B: 2.14637460319, C: 137.0330241624},
+		{A: 3.781219e-05, B: 3.45840272873, C: 456.3938392356},
+		{A: 2.942239e-05, B: 0.42393808854, C: 299.1263942692},
+		{A: 3.686787e-05, B: 2.48718116535, C: 453.424893819},
+		{A: 3.101743e-05, B: 4.14031063896, C: 219.891377577},
+		{A: 2.962641e-05, B: 0.82977991995, C: 56.6223513026},
+		{A: 2.937799e-05, B: 3.6765745093, C: 140.001969579},
+		{A: 2.865128e-05, B: 0.30996903761, C: 12.5301729722},
+		{A: 2.538032e-05, B: 4.85457831993, C: 131.4039498699},
+		{A: 1.96251e-05, B: 5.24342224065, C: 84.3428261229},
+		{A: 2.36355e-05, B: 0.44253328372, C: 554.0699874828},
+		{A: 1.979394e-05, B: 6.12836181686, C: 106.9767433719},
+		{A: 2.182572e-05, B: 2.94040431638, C: 305.3461693927},
+		{A: 1.962974e-05, B: 0.0411473912, C: 221.3758502853},
+		{A: 1.82956e-05, B: 4.01105771632, C: 68.8437077341},
+		{A: 1.64292e-05, B: 0.35564102554, C: 67.6680515665},
+		{A: 1.58485e-05, B: 3.16267171762, C: 225.8292684102},
+		{A: 1.848655e-05, B: 2.91111759376, C: 909.8187330546},
+		{A: 1.63243e-05, B: 4.23061792837, C: 22.0914005278},
+		{A: 1.40139e-05, B: 1.39084023521, C: 265.9892934775},
+		{A: 1.403717e-05, B: 5.63563637532, C: 4.4534181249},
+		{A: 1.655866e-05, B: 1.96431297431, C: 79.2350166922},
+		{A: 1.248978e-05, B: 5.44027380866, C: 54.1746707478},
+		{A: 1.563447e-05, B: 1.47917835549, C: 112.9146342051},
+		{A: 1.248054e-05, B: 4.88984353601, C: 479.2883889155},
+		{A: 1.197439e-05, B: 2.52185744943, C: 145.6310438715},
+		{A: 1.506952e-05, B: 5.24186185583, C: 181.7583419392},
+		{A: 1.481746e-05, B: 5.66203046912, C: 152.5321425512},
+		{A: 1.438838e-05, B: 1.53046287618, C: 447.7958195265},
+		{A: 1.408514e-05, B: 4.41921749601, C: 462.0229135281},
+		{A: 1.477112e-05, B: 4.32214690647, C: 256.5399405065},
+		{A: 1.228314e-05, B: 5.9770333104, C: 59.8037450403},
+		{A: 1.249958e-05, B: 6.24484546141, C: 160.6088973985},
+		{A: 9.06468e-06, B: 5.62025869483, C: 74.6697239827},
+		{A: 1.090681e-05, B: 4.15393813845, C: 77.962992305},
+		{A: 8.44931e-06, B: 0.12943398585, C: 82.8583534146},
+		{A: 9.00363e-06, B: 2.37315925843, C: 74.8934731519},
+		{A: 1.071957e-05, B: 1.74286714339, C: 528.2064923863},
+		{A: 6.89708e-06, B: 3.08097059985, C: 69.3649725959},
+		{A: 5.93798e-06, B: 4.50074517056, C: 8.0767548473},
+		{A: 7.18559e-06, B: 4.00047509264, C: 128.9562693151},
+		{A: 6.99574e-06, B: 0.03987168068, C: 143.6253063014},
+		{A: 5.75656e-06, B: 5.89552672641, C: 66.70484372},
+		{A: 7.59004e-06, B: 2.13700057433, C: 692.5874843535},
+		{A: 7.10449e-06, B: 5.41605755095, C: 218.4069048687},
+		{A: 5.48672e-06, B: 5.6281149697, C: 3.1813937377},
+		{A: 6.51632e-06, B: 4.42340061551, C: 18.1592472647},
+		{A: 5.39825e-06, B: 6.20788667166, C: 71.6002048296},
+		{A: 5.44539e-06, B: 5.69375108253, C: 203.7378678824},
+		{A: 7.10276e-06, B: 4.21967260022, C: 381.6122406683},
+		{A: 5.93819e-06, B: 3.83805798523, C: 32.1951448046},
+		{A: 7.10134e-06, B: 4.48972171999, C: 293.188503436},
+		{A: 7.05482e-06, B: 0.45521177725, C: 835.0371344873},
+		{A: 5.88e-06, B: 5.08252923316, C: 186.2117600641},
+		{A: 5.98231e-06, B: 0.35815291076, C: 269.9214467406},
+		{A: 6.41914e-06, B: 2.71127457036, C: 87.3117715395},
+		{A: 4.95621e-06, B: 2.65094755989, C: 200.7689224658},
+		{A: 6.30252e-06, B: 4.46146214548, C: 275.5505210331},
+		{A: 5.75195e-06, B: 5.57862480486, C: 2.4476805548},
+		{A: 5.6987e-06, B: 1.6393093274, C: 77.2292791221},
+		{A: 5.56672e-06, B: 1.07231961344, C: 1059.3819301892},
+		{A: 4.49439e-06, B: 0.27981733949, C: 617.8058857862},
+		{A: 4.63608e-06, B: 1.43448297993, C: 297.6419215609},
+		{A: 4.36547e-06, B: 0.52802035072, C: 209.3669421749},
+		{A: 4.63938e-06, B: 2.35443114417, C: 211.8146227297},
+		{A: 4.35943e-06, B: 2.10077211065, C: 1514.2912967165},
+		{A: 5.15534e-06, B: 3.23274579379, C: 284.1485407422},
+		{A: 4.54879e-06, B: 4.08364210459, C: 99.1606209555},
+		{A: 4.7743e-06, B: 2.89397217998, C: 39.3568759152},
+		{A: 5.42331e-06, B: 5.39481705077, C: 278.5194664497},
+		{A: 4.10087e-06, B: 3.04968860441, C: 404.5067903482},
+		{A: 3.67848e-06, B: 0.71159607058, C: 125.9873238985},
+		{A: 5.03096e-06, B: 5.83931251717, C: 191.2076949102},
+		{A: 4.87532e-06, B: 0.06402454583, C: 60.7669528868},
+		{A: 4.55043e-06, B: 2.59321186669, C: 490.3340891794},
+		{A: 4.36291e-06, B: 2.08183813746, C: 51.2057253312},
+		{A: 4.35803e-06, B: 2.79445203085, C: 75.7448064138},
+		{A: 3.23546e-06, B: 4.82899980859, C: 195.1398481733},
+		{A: 3.59363e-06, B: 0.00868012078, C: 35.4247226521},
+		{A: 4.29314e-06, B: 3.08031550488, C: 41.1019810544},
+		{A: 3.20021e-06, B: 5.48625497747, C: 14.977853527},
+		{A: 4.14331e-06, B: 0.09012800478, C: 258.0244132148},
+		{A: 3.79715e-06, B: 0.05832815311, C: 378.6432952517},
+		{A: 4.20062e-06, B: 2.25393983318, C: 81.0013736908},
+		{A: 3.57721e-06, B: 4.71414305625, C: 173.9422195228},
+		{A: 3.58922e-06, B: 0.35213227553, C: 426.598190876},
+		{A: 4.0541e-06, B: 6.12263257999, C: 24.3790223882},
+		{A: 3.65158e-06, B: 5.59483211224, C: 255.0554677982},
+		{A: 3.08102e-06, B: 3.92355394354, C: 116.4260963429},
+		{A: 3.2566e-06, B: 4.71996698332, C: 134.5853436076},
+		{A: 2.92781e-06, B: 3.9952119483, C: 72.3339180125},
+		{A: 3.86543e-06, B: 0.68619006966, C: 230.5645708254},
+		{A: 3.05686e-06, B: 3.76108783519, C: 344.7030453079},
+		{A: 2.86972e-06, B: 1.8499033531, C: 153.4953503977},
+		{A: 3.5364e-06, B: 4.65717995107, C: 329.8370663655},
+		{A: 3.02051e-06, B: 0.13190003806, C: 565.1156877467},
+		{A: 2.41128e-06, B: 1.60454142389, C: 81.3738807063},
+		{A: 2.49829e-06, B: 4.24205256241, C: 75.3028634291},
+		{A: 2.45063e-06, B: 5.94905404273, C: 20.6069278195},
+		{A: 2.48277e-06, B: 1.06282887181, C: 105.4922706636},
+		{A: 3.05353e-06, B: 2.55534744586, C: 6208.2942514241},
+		{A: 2.96328e-06, B: 4.21100245276, C: 1364.7280995819},
+		{A: 2.19938e-06, B: 2.96119055727, C: 120.358249606},
+		{A: 2.33564e-06, B: 2.97074409938, C: 46.2097904851},
+		{A: 2.62422e-06, B: 3.83652250971, C: 831.1049812242},
+		{A: 2.33546e-06, B: 4.4811700614, C: 628.8515860501},
+		{A: 1.87432e-06, B: 3.03529190348, C: 135.5485514541},
+		{A: 2.16776e-06, B: 3.42907414802, C: 241.6102710893},
+		{A: 2.5576e-06, B: 1.1670789346, C: 177.8743727859},
+		{A: 2.20458e-06, B: 0.1963349229, C: 180.2738692309},
+		{A: 2.24519e-06, B: 0.40677777819, C: 114.3991069134},
+		{A: 2.05398e-06, B: 2.30380942634, C: 259.5088859231},
+		{A: 2.11106e-06, B: 4.93079982424, C: 103.0927742186},
+		{A: 1.75758e-06, B: 5.50822822216, C: 7.1135470008},
+		{A: 1.88512e-06, B: 2.23588941288, C: 5.4166259714},
+		{A: 1.71718e-06, B: 5.21730232334, C: 41.6444977756},
+		{A: 1.76136e-06, B: 1.95958319897, C: 756.3233826569},
+		{A: 1.70447e-06, B: 4.94978757413, C: 206.1855484372},
+		{A: 1.69454e-06, B: 4.04319823722, C: 55.6591434561},
+		{A: 2.19015e-06, B: 0.24790282027, C: 294.6729761443},
+		{A: 1.87768e-06, B: 2.04538775456, C: 408.4389436113},
+		{A: 1.82258e-06, B: 0.70728384467, C: 391.1734682239},
+		{A: 1.92095e-06, B: 5.76718231319, C: 291.7040307277},
+		{A: 1.53684e-06, B: 4.70659406659, C: 543.0242872189},
+		{A: 1.70043e-06, B: 4.50995820508, C: 288.0806940053},
+		{A: 1.64097e-06, B: 5.22527540372, C: 67.3592350258},
+		{A: 1.94341e-06, B: 6.1169036471, C: 414.0680179038},
+		{A: 1.68027e-06, B: 5.25810639105, C: 518.6452648307},
+		{A: 1.56641e-06, B: 0.66304836778, C: 220.4126424388},
+		{A: 1.8233e-06, B: 0.78383856974, C: 417.0369633204},
+		{A: 1.67462e-06, B: 4.92241597775, C: 422.6660376129},
+		{A: 1.7077e-06, B: 2.30927162659, C: 98.8999885246},
+		{A: 1.61678e-06, B: 3.27259601116, C: 443.8636662634},
+		{A: 1.32763e-06, B: 2.88875442023, C: 373.9079928365},
+		{A: 1.6114e-06, B: 3.82341391177, C: 451.9404211107},
+		{A: 1.79292e-06, B: 4.82405681293, C: 366.485629295},
+		{A: 1.78153e-06, B: 3.98026039043, C: 10138.5039476437},
+		{A: 1.41929e-06, B: 1.26972581554, C: 159.1244246902},
+		{A: 1.5375e-06, B: 4.27847681414, C: 45.5766510387},
+		{A: 1.61513e-06, B: 4.99545008738, C: 73.8183907208},
+		{A: 1.46315e-06, B: 2.65664902119, C: 465.9550667912},
+		{A: 1.24875e-06, B: 4.30470898895, C: 339.2864193365},
+		{A: 1.5462e-06, B: 4.3204622812, C: 760.25553592},
+		{A: 1.42894e-06, B: 2.07773752143, C: 457.8783119439},
+		{A: 1.52408e-06, B: 4.64742446768, C: 155.7829722581},
+		{A: 1.16389e-06, B: 4.43513730944, C: 5.9378908332},
+		{A: 1.13444e-06, B: 4.65351596266, C: 80.1982245387},
+		{A: 1.07611e-06, B: 3.77290419929, C: 142.4496501338},
+		{A: 1.3374e-06, B: 5.30894739047, C: 14.0146456805},
+		{A: 1.16104e-06, B: 2.5118272567, C: 296.1574488526},
+		{A: 1.29106e-06, B: 0.36277717661, C: 96.8729990951},
+		{A: 1.22766e-06, B: 2.38341351026, C: 141.4864422873},
+		{A: 1.01368e-06, B: 1.05739625315, C: 92.3077063856},
+		{A: 1.14669e-06, B: 6.24863527978, C: 767.3690829208},
+		{A: 1.13283e-06, B: 0.83051319425, C: 100.3844612329},
+		{A: 1.07199e-06, B: 2.39365512354, C: 347.8844390456},
+		{A: 9.5443e-07, B: 0.80094579583, C: 342.2553647531},
+		{A: 1.10789e-06, B: 0.38651051525, C: 216.9224321604},
+		{A: 1.26978e-06, B: 0.4235935825, C: 331.3215390738},
+		{A: 1.12635e-06, B: 0.08107814739, C: 558.0021407459},
+		{A: 1.03166e-06, B: 0.69792283389, C: 358.9301393095},
+		{A: 1.11474e-06, B: 0.75023459027, C: 80.7194894005},
+		{A: 9.0902e-07, B: 5.16530481614, C: 144.1465711632},
+		{A: 9.0677e-07, B: 0.22036476597, C: 333.657345044},
+		{A: 9.8568e-07, B: 4.33164222339, C: 74.5209661364},
+		{A: 8.9306e-07, B: 2.18851161761, C: 74.8297826771},
+		{A: 1.17216e-06, B: 3.94965784596, C: 74.2603337055},
+		{A: 8.9088e-07, B: 5.87783179087, C: 74.7334144575},
+		{A: 9.7316e-07, B: 0.6942969502, C: 977.4867846211},
+		{A: 1.16587e-06, B: 1.83677031994, C: 1289.9465010146},
+		{A: 8.5449e-07, B: 5.80255966149, C: 6.592282139},
+		{A: 8.6823e-07, B: 5.61973473261, C: 300.6108669775},
+		{A: 1.05226e-06, B: 5.94513614941, C: 328.3525936572},
+		{A: 1.12117e-06, B: 1.21168089807, C: 329.7251917809},
+		{A: 8.2982e-07, B: 2.20797412496, C: 74.9416572617},
+		{A: 9.4345e-07, B: 4.53937998713, C: 28.5718080822},
+		{A: 1.06847e-06, B: 1.82071328579, C: 306.830642101},
+		{A: 1.03572e-06, B: 2.99368274596, C: 6.2197751235},
+		{A: 1.06357e-06, B: 0.8158387475, C: 1087.6931058405},
+		{A: 7.7728e-07, B: 2.73390123734, C: 110.2063212194},
+		{A: 9.8405e-07, B: 3.73478182667, C: 75.0422309982},
+		{A: 8.6231e-07, B: 2.83316881064, C: 983.1158589136},
+		{A: 8.9023e-07, B: 4.7375445896, C: 604.4725636619},
+		{A: 8.3013e-07, B: 1.88273535999, C: 387.2413149608},
+		{A: 9.0227e-07, B: 3.80367274711, C: 986.0848043302},
+		{A: 8.4598e-07, B: 1.25774132938, C: 142.1408335931},
+		{A: 7.469e-07, B: 1.35097482767, C: 350.3321196004},
+		{A: 9.577e-07, B: 5.54845504768, C: 969.6224780949},
+		{A: 9.0277e-07, B: 0.36773710508, C: 0.9632078465},
+		{A: 8.2748e-07, B: 5.85590525764, C: 74.6215398729},
+		{A: 7.5828e-07, B: 2.78019216029, C: 88.1149206916},
+		{A: 8.385e-07, B: 1.84386358668, C: 227.3137411185},
+		{A: 7.0705e-07, B: 4.65567024014, C: 44.7253177768},
+		{A: 7.1322e-07, B: 3.64963906751, C: 894.8408795276},
+		{A: 9.4141e-07, B: 4.98819201726, C: 403.1341922245},
+		{A: 8.8966e-07, B: 4.43895583278, C: 154.0166152595},
+		{A: 7.9436e-07, B: 5.66662613679, C: 267.4737661858},
+		{A: 7.5615e-07, B: 5.40971072536, C: 50.4025761791},
+		{A: 6.8583e-07, B: 4.76679841388, C: 991.7138786227},
+		{A: 6.5256e-07, B: 0.69286370395, C: 152.7445908723},
+		{A: 6.3031e-07, B: 2.89946567712, C: 79.889407998},
+		{A: 6.3878e-07, B: 0.09820555288, C: 681.5417840896},
+		{A: 8.0101e-07, B: 2.97520561915, C: 526.722019678},
+		{A: 6.9693e-07, B: 3.95281159807, C: 187.6962327724},
+		{A: 5.9492e-07, B: 3.59642351692, C: 58.1068240109},
+		{A: 5.9273e-07, B: 0.50930692071, C: 28.3111756513},
+		{A: 6.859e-07, B: 2.4188031153, C: 235.3904959658},
+		{A: 6.6007e-07, B: 5.04558399435, C: 30.7106720963},
+		{A: 7.0223e-07, B: 3.73647415486, C: 546.956440482},
+		{A: 6.6836e-07, B: 0.85506033017, C: 522.5774180938},
+		{A: 6.3027e-07, B: 0.29269109052, C: 119.5069163441},
+		{A: 6.2023e-07, B: 2.31557510311, C: 74.0308390419},
+		{A: 7.1379e-07, B: 3.16967571102, C: 23.5758732361},
+		{A: 7.4827e-07, B: 5.36812537961, C: 373.0142209592},
+		{A: 6.4204e-07, B: 2.3681714946, C: 157.6399519819},
+		{A: 7.0712e-07, B: 0.55830476304, C: 92.940845832},
+		{A: 5.5762e-07, B: 5.27011035858, C: 874.3940104025},
+		{A: 7.5638e-07, B: 4.66344127677, C: 101.8689339412},
+		{A: 7.3727e-07, B: 6.20581665991, C: 312.4597163935},
+		{A: 7.294e-07, B: 0.58406607757, C: 367.9701020033},
+		{A: 5.323e-07, B: 2.24728742995, C: 17.5261078183},
+		{A: 6.3139e-07, B: 4.59563922296, C: 67.8804998876},
+		{A: 6.055e-07, B: 0.57591315857, C: 253.5709950899},
+		{A: 5.2946e-07, B: 2.45947017614, C: 264.5048207692},
+		{A: 7.0236e-07, B: 1.51860943454, C: 552.5855147745},
+		{A: 6.8624e-07, B: 2.44507780453, C: 555.5544601911},
+		{A: 6.2796e-07, B: 0.33786296181, C: 561.1835344836},
+		{A: 4.9009e-07, B: 1.09233728279, C: 19.1224551112},
+		{A: 6.4636e-07, B: 5.274699709, C: 68.1893164283},
+		{A: 6.2957e-07, B: 5.35891188483, C: 92.0470739547},
+		{A: 4.7664e-07, B: 3.90924952181, C: 192.6921676185},
+		{A: 6.5279e-07, B: 4.23629510074, C: 771.3012361839},
+		{A: 6.519e-07, B: 3.73942854797, C: 536.8045120954},
+		{A: 5.9452e-07, B: 6.10554259948, C: 365.0011565867},
+		{A: 5.2153e-07, B: 1.71734604937, C: 905.8865797915},
+		{A: 4.6035e-07, B: 3.87093684776, C: 210.3301500214},
+		{A: 4.6429e-07, B: 5.97423131576, C: 477.8039162072},
+		{A: 6.2115e-07, B: 2.67544358037, C: 130.4407420234},
+		{A: 4.6038e-07, B: 3.89378239085, C: 48.7580447764},
+		{A: 4.2663e-07, B: 3.81519760715, C: 61.2882177486},
+		{A: 5.3909e-07, B: 2.86457147106, C: 353.301065017},
+		{A: 4.6936e-07, B: 1.00011046774, C: 166.828672522},
+		{A: 4.2217e-07, B: 2.61748790314, C: 90.8232336773},
+		{A: 4.3324e-07, B: 4.15777895713, C: 173.6815870919},
+		{A: 4.1296e-07, B: 1.79930408254, C: 149.45132255},
+		{A: 4.496e-07, B: 1.76623306927, C: 0.5212648618},
+		{A: 5.1904e-07, B: 2.97773319756, C: 383.0967133766},
+		{A: 4.2931e-07, B: 1.57416456203, C: 120.9913890524},
+		{A: 4.9611e-07, B: 4.0342792047, C: 303.8616966844},
+		{A: 4.5263e-07, B: 3.58382163089, C: 97.4155158163},
+		{A: 3.8695e-07, B: 2.39404211169, C: 31.492569389},
+		{A: 3.8072e-07, B: 5.7947367035, C: 75.5323580927},
+		{A: 5.0126e-07, B: 4.76412907201, C: 911.3032057629},
+		{A: 5.0884e-07, B: 5.15513957132, C: 439.782755154},
+		{A: 4.3148e-07, B: 0.84999004804, C: 58.319272332},
+		{A: 4.2732e-07, B: 5.17318058934, C: 162.0933701068},
+		{A: 5.0298e-07, B: 5.81603435915, C: 66.9172920411},
+		{A: 3.5639e-07, B: 1.87447823723, C: 472.1748419147},
+		{A: 4.9963e-07, B: 1.8894349079, C: 42.5864537627},
+		{A: 3.9974e-07, B: 1.74262050679, C: 89.7594520943},
+		{A: 4.5252e-07, B: 1.92511912328, C: 55.1378785943},
+		{A: 4.4896e-07, B: 1.4835590189, C: 450.9772132642},
+		{A: 3.4297e-07, B: 5.20257496546, C: 316.3918696566},
+		{A: 4.6355e-07, B: 0.33942039181, C: 273.1028404783},
+		{A: 3.7152e-07, B: 2.03757941865, C: 117.9105690512},
+		{A: 4.6106e-07, B: 5.62315633955, C: 1819.6374661092},
+		{A: 3.9368e-07, B: 4.19402806344, C: 486.4019359163},
+		{A: 4.1039e-07, B: 4.82994471947, C: 149.6750717192},
+		{A: 4.4959e-07, B: 0.72694662195, C: 3265.8308281325},
+		{A: 4.3617e-07, B: 0.75332422672, C: 404.6186649328},
+		{A: 3.1823e-07, B: 3.84768075667, C: 20.4468691251},
+		{A: 4.4196e-07, B: 4.36769721266, C: 418.2608035978},
+		{A: 3.79e-07, B: 3.02928044053, C: 167.0893049529},
+		{A: 4.3684e-07, B: 1.57328182739, C: 491.5579294568},
+		{A: 3.4004e-07, B: 1.26257052908, C: 260.9933586314},
+		{A: 3.1276e-07, B: 4.16123711648, C: 13.3333221243},
+		{A: 3.9984e-07, B: 2.8662612562, C: 468.2426886516},
+		{A: 3.649e-07, B: 2.58804294589, C: 68.5618234438},
+		{A: 3.2364e-07, B: 3.11577354875, C: 103.3534066495},
+		{A: 3.3857e-07, B: 0.15592410716, C: 24.1183899573},
+		{A: 3.5933e-07, B: 1.36784550071, C: 59.2824801785},
+		{A: 3.3633e-07, B: 0.755011774, C: 290.2195580194},
+		{A: 2.9751e-07, B: 5.33178627038, C: 1033.3583763983},
+		{A: 3.2036e-07, B: 4.67549858, C: 205.2223405907},
+		{A: 3.0991e-07, B: 4.62823866461, C: 258.8757464767},
+		{A: 3.5268e-07, B: 1.00718464327, C: 1108.1399749656},
+		{A: 3.3366e-07, B: 3.40738625377, C: 43.1289704839},
+		{A: 3.2638e-07, B: 5.25485850258, C: 114.1384744825},
+		{A: 2.9825e-07, B: 5.64157476876, C: 254.9435932136},
+		{A: 3.1613e-07, B: 3.7823139311, C: 152.0108776894},
+		{A: 3.098e-07, B: 2.26660677937, C: 104.0077979553},
+		{A: 3.4591e-07, B: 5.17326577255, C: 25.6028626656},
+		{A: 2.8398e-07, B: 1.76872790446, C: 820.0592809603},
+		{A: 2.7991e-07, B: 3.92486885309, C: 199.2844497575},
+		{A: 2.8986e-07, B: 2.58171811759, C: 76.4785195967},
+		{A: 3.3772e-07, B: 5.79359878723, C: 274.0660483248},
+		{A: 2.9401e-07, B: 5.93638676504, C: 280.9671470045},
+		{A: 3.1094e-07, B: 1.39352495971, C: 178.7893965226},
+		{A: 3.0118e-07, B: 0.44367887423, C: 27.0873353739},
+		{A: 3.382e-07, B: 6.26168443513, C: 401.6497195162},
+		{A: 2.7513e-07, B: 2.15194454461, C: 480.7728616238},
+		{A: 2.688e-07, B: 2.5130027278, C: 123.5396433437},
+		{A: 2.6139e-07, B: 0.21985367371, C: 286.596221297},
+		{A: 2.6455e-07, B: 3.88229792258, C: 372.4235201282},
+		{A: 3.3974e-07, B: 1.44637843871, C: 88.7962442478},
+		{A: 3.0107e-07, B: 0.82723915882, C: 100.6450936638},
+		{A: 2.7715e-07, B: 4.64827434185, C: 198.321241911},
+		{A: 3.3687e-07, B: 1.14348201049, C: 82.4858463991},
+		{A: 2.6493e-07, B: 1.97889544238, C: 95.3885263868},
+		{A: 2.4355e-07, B: 2.3783917615, C: 146.3818033969},
+		{A: 2.659e-07, B: 0.39881920389, C: 106.0135355254},
+		{A: 2.7006e-07, B: 2.10206230691, C: 1057.8974574809},
+		{A: 2.3976e-07, B: 6.21233637686, C: 16.6747745564},
+		{A: 3.097e-07, B: 5.34005431547, C: 476.4313180835},
+		{A: 2.4073e-07, B: 3.42953641968, C: 1044.4040766622},
+		{A: 2.7023e-07, B: 0.71284764471, C: 248.7238180901},
+		{A: 2.9098e-07, B: 3.99184722502, C: 908.3342603463},
+		{A: 2.2862e-07, B: 2.26978781393, C: 175.1660598002},
+		{A: 2.4026e-07, B: 0.36584131268, C: 73.1852512744},
+		{A: 2.8024e-07, B: 3.46485782266, C: 1439.5096981492},
+		{A: 2.2034e-07, B: 0.051638073, C: 33.1371007917},
+		{A: 2.2185e-07, B: 5.32252126255, C: 483.2205421786},
+		{A: 2.1027e-07, B: 0.37224660652, C: 214.7835681463},
+		{A: 2.0548e-07, B: 1.80004483299, C: 118.0224436358},
+		{A: 2.7835e-07, B: 4.1241255353, C: 694.0719570618},
+		{A: 2.55e-07, B: 5.49632191634, C: 115.8835796217},
+		{A: 2.1377e-07, B: 3.89179204956, C: 66.1835788582},
+		{A: 2.7201e-07, B: 5.761487979, C: 1215.1649024473},
+		{A: 2.4984e-07, B: 0.65339418015, C: 132.8884225782},
+		{A: 2.3976e-07, B: 4.56161326826, C: 458.8415197904},
+		{A: 2.1116e-07, B: 1.1361070625, C: 60.5545045657},
+		{A: 2.6263e-07, B: 2.77532723118, C: 490.0734567485},
+		{A: 2.6369e-07, B: 3.371200393, C: 49.7212526229},
+		{A: 2.287e-07, B: 4.5313563762, C: 78.4049352897},
+		{A: 2.6872e-07, B: 3.26037129303, C: 691.1030116452},
+		{A: 2.5004e-07, B: 3.65018677651, C: 73.4090004436},
+		{A: 2.0874e-07, B: 3.92589972978, C: 134.0640787458},
+		{A: 2.0915e-07, B: 5.53955400138, C: 129.9194771616},
+		{A: 2.3067e-07, B: 2.56806856688, C: 332.8060117821},
+		{A: 2.263e-07, B: 5.02721554401, C: 150.5264049811},
+		{A: 1.9123e-07, B: 1.92386327535, C: 124.5028511902},
+		{A: 2.0678e-07, B: 0.98302410602, C: 29.2049475286},
+		{A: 1.8755e-07, B: 1.07911898422, C: 70.1157321213},
+		{A: 1.9458e-07, B: 1.33847349577, C: 616.3214130779},
+		{A: 2.3071e-07, B: 3.93152899657, C: 43.2890291783},
+		{A: 2.3313e-07, B: 0.61185525008, C: 189.7232222019},
+		{A: 1.966e-07, B: 1.40884902649, C: 1589.0728952838},
+		{A: 2.499e-07, B: 0.91842956919, C: 441.2672278623},
+		{A: 2.3555e-07, B: 0.02127675886, C: 593.426863398},
+		{A: 1.8288e-07, B: 4.55111843462, C: 165.6048322446},
+		{A: 2.098e-07, B: 0.88504201898, C: 326.8681209489},
+		{A: 2.494e-07, B: 4.63470443286, C: 162.8965192589},
+		{A: 1.8941e-07, B: 5.10763304564, C: 81.8951455681},
+		{A: 1.8911e-07, B: 1.23351635328, C: 13.4933808187},
+		{A: 1.7358e-07, B: 4.05768226252, C: 403.0223176399},
+		{A: 1.7362e-07, B: 5.2860722764, C: 7.8643065262},
+		{A: 2.2513e-07, B: 3.15059891398, C: 419.7452763061},
+		{A: 2.1237e-07, B: 2.14856256664, C: 75.5847477194},
+		{A: 1.7845e-07, B: 2.54349200329, C: 47.061123747},
+		{A: 1.6995e-07, B: 2.48647736969, C: 2043.9822618111},
+		{A: 2.3676e-07, B: 5.80355919955, C: 232.0490435337},
+		{A: 2.2639e-07, B: 2.07623129509, C: 699.7010313543},
+		{A: 1.9261e-07, B: 1.56494156016, C: 425.1137181677},
+		{A: 2.1067e-07, B: 5.30844438236, C: 237.6781178262},
+		{A: 2.2733e-07, B: 0.2830312644, C: 0.1118745846},
+		{A: 1.6372e-07, B: 3.45984005656, C: 0.7507595254},
+		{A: 2.1213e-07, B: 0.95828006612, C: 405.9912630565},
+		{A: 1.8033e-07, B: 1.60723214246, C: 215.4379594521},
+		{A: 1.6267e-07, B: 4.8900201636, C: 69.1525242748},
+		{A: 2.1738e-07, B: 3.24738839789, C: 1744.8558675419},
+		{A: 1.6149e-07, B: 0.35803995032, C: 77.0692204277},
+		{A: 2.171e-07, B: 0.88800040769, C: 344.9636777388},
+		{A: 1.7204e-07, B: 6.04366142241, C: 32.2433289144},
+		{A: 1.7883e-07, B: 4.01076173641, C: 280.003939158},
+		{A: 1.5918e-07, B: 2.96623390816, C: 25.8634950965},
+		{A: 1.4769e-07, B: 3.73887340623, C: 610.6923387854},
+		{A: 1.5033e-07, B: 4.24825484707, C: 228.276948965},
+		{A: 1.5586e-07, B: 5.0798708274, C: 114.9416236346},
+		{A: 1.5392e-07, B: 0.22971106129, C: 17.2654753874},
+		{A: 1.5354e-07, B: 0.25482391126, C: 661.0949149645},
+		{A: 1.4617e-07, B: 1.13349626273, C: 823.9914342234},
+		{A: 1.6232e-07, B: 3.4349974381, C: 147.1155165798},
+		{A: 1.4654e-07, B: 1.68288566884, C: 207.8824694666},
+		{A: 1.7682e-07, B: 5.94376629143, C: 624.919432787},
+		{A: 1.8837e-07, B: 1.3833540807, C: 377.1588225434},
+		{A: 1.5425e-07, B: 1.66489033237, C: 440.6822725257},
+		{A: 1.4764e-07, B: 4.41710614445, C: 16.4623262353},
+		{A: 1.4402e-07, B: 0.41359448817, C: 142.6620984549},
+		{A: 1.6992e-07, B: 0.16042368544, C: 438.2982824457},
+		{A: 1.3268e-07, B: 3.04634728126, C: 668.2084619653},
+		{A: 1.646e-07, B: 0.92068542861, C: 369.0820676961},
+		{A: 1.7239e-07, B: 4.51659246818, C: 606.7601855223},
+		{A: 1.3238e-07, B: 0.13650358961, C: 216.4804891757},
+		{A: 1.5832e-07, B: 4.94315562971, C: 124.2904028691},
+		{A: 1.4374e-07, B: 2.93700606008, C: 419.4846438752},
+		{A: 1.2927e-07, B: 1.65950183061, C: 54.3347294422},
+		{A: 1.4224e-07, B: 4.42286781619, C: 47.6942631934},
+		{A: 1.2753e-07, B: 0.03020931725, C: 217.2312487011},
+		{A: 1.4792e-07, B: 1.08447500622, C: 49.5088043018},
+		{A: 1.4031e-07, B: 3.68785757687, C: 16.04163511},
+		{A: 1.3709e-07, B: 4.78890618802, C: 72.7758609972},
+		{A: 1.3073e-07, B: 1.54064778942, C: 218.9281697305},
+		{A: 1.7474e-07, B: 5.05621281434, C: 564.8550553158},
+		{A: 1.2686e-07, B: 3.4464088888, C: 958.576777831},
+		{A: 1.3035e-07, B: 0.56445754615, C: 1171.875873269},
+		{A: 1.2458e-07, B: 3.29187197133, C: 902.7051860538},
+		{A: 1.1893e-07, B: 1.41294011193, C: 55.7710180407},
+		{A: 1.5018e-07, B: 3.43209569509, C: 19.0105805266},
+		{A: 1.647e-07, B: 2.04067754807, C: 411.620337349},
+		{A: 1.5619e-07, B: 1.53464600544, C: 833.552661779},
+		{A: 1.5678e-07, B: 5.92839374034, C: 778.4147831847},
+		{A: 1.2039e-07, B: 5.17748353434, C: 135.336103133},
+		{A: 1.5523e-07, B: 3.54656631824, C: 113.8778420516},
+		{A: 1.4364e-07, B: 4.19825110964, C: 89.338760969},
+		{A: 1.5424e-07, B: 2.12697366269, C: 106.2741679563},
+		{A: 1.1957e-07, B: 1.43314130608, C: 455.8725743738},
+		{A: 1.5938e-07, B: 5.49575810978, C: 513.079881013},
+		{A: 1.3532e-07, B: 4.11463529983, C: 95.2284676924},
+		{A: 1.5105e-07, B: 1.86350524526, C: 7.7042478318},
+		{A: 1.5832e-07, B: 3.42498484109, C: 79.5169009825},
+		{A: 1.1492e-07, B: 4.6518745562, C: 149.6113812444},
+		{A: 1.1406e-07, B: 1.31085455047, C: 63.6240237188},
+		{A: 1.4469e-07, B: 3.35284802718, C: 19.643719973},
+		{A: 1.1953e-07, B: 0.20979051344, C: 65.8747623175},
+		{A: 1.2039e-07, B: 0.0142323841, C: 397.3932433474},
+		{A: 1.4157e-07, B: 1.87440535404, C: 6283.0758499914},
+		{A: 1.1357e-07, B: 0.19079103112, C: 5.6290742925},
+		{A: 1.4109e-07, B: 0.09348109701, C: 6133.5126528568},
+		{A: 1.5322e-07, B: 3.54468546172, C: 252.6559713532},
+		{A: 1.1681e-07, B: 0.85100356112, C: 5.1078094307},
+		{A: 1.4134e-07, B: 5.66340426198, C: 639.897286314},
+		{A: 1.1052e-07, B: 0.47607339302, C: 150.0844619964},
+		{A: 1.1507e-07, B: 5.19480309409, C: 1182.9215735329},
+		{A: 1.1492e-07, B: 2.05801478181, C: 149.5150130248},
+		{A: 1.1571e-07, B: 4.7821072497, C: 334.2904844904},
+		{A: 1.0671e-07, B: 4.67373109923, C: 149.723255829},
+		{A: 1.1651e-07, B: 3.13272450186, C: 93.9040536785},
+		{A: 1.4316e-07, B: 0.08421279341, C: 240.3864308119},
+		{A: 1.0855e-07, B: 4.52379396618, C: 453.9461586808},
+		{A: 1.19e-07, B: 1.41784572428, C: 26.0235537909},
+		{A: 1.0851e-07, B: 4.40625021974, C: 57.1436161644},
+		{A: 1.3385e-07, B: 0.76174742916, C: 37.8724032069},
+		{A: 1.0664e-07, B: 5.81644528276, C: 193.655375465},
+		{A: 1.07e-07, B: 5.3459550607, C: 331.2096644892},
+		{A: 1.0465e-07, B: 3.82648204886, C: 180.1619946463},
+		{A: 1.335e-07, B: 0.86920479636, C: 22.8945496799},
+		{A: 1.0324e-07, B: 2.99969783109, C: 525.7588118315},
+		{A: 1.4293e-07, B: 1.06904465002, C: 477.9157907918},
+		{A: 1.2341e-07, B: 4.62813430535, C: 1894.4190646765},
+		{A: 1.2539e-07, B: 3.70404881494, C: 67.0773507355},
+		{A: 1.1771e-07, B: 1.07971321862, C: 363.5166838784},
+		{A: 1.1466e-07, B: 0.9352838604, C: 121.8427223143},
+		{A: 1.2839e-07, B: 0.31988787839, C: 474.9468453752},
+		{A: 1.0194e-07, B: 6.23976471898, C: 84.1827674285},
+		{A: 1.23e-07, B: 2.85238700423, C: 184.0941479094},
+		{A: 1.3861e-07, B: 3.4836768877, C: 157.2674449664},
+		{A: 1.1395e-07, B: 4.2553344068, C: 181.0557665236},
+		{A: 1.0146e-07, B: 6.01371693363, C: 43.2408450685},
+		{A: 9.889e-08, B: 0.01753733887, C: 40.1600250673},
+		{A: 1.0798e-07, B: 2.4636424394, C: 140.6563608848},
+		{A: 9.893e-08, B: 2.72219544398, C: 384.0599212231},
+		{A: 1.1012e-07, B: 3.77284307154, C: 494.2662424425},
+		{A: 1.0226e-07, B: 1.49169427598, C: 80.4106728598},
+		{A: 1.1981e-07, B: 2.69741212203, C: 369.4545747116},
+		{A: 1.0658e-07, B: 1.7870288097, C: 252.0865223816},
+		{A: 1.2506e-07, B: 4.66852994807, C: 64.6991061499},
+		{A: 1.164e-07, B: 5.70405852396, C: 39.0962434843},
+		{A: 1.2305e-07, B: 1.73322306013, C: 229.0800981171},
+		{A: 9.954e-08, B: 3.72074935511, C: 233.9060232575},
+		{A: 1.1004e-07, B: 3.58723041577, C: 449.2802922348},
+		{A: 1.0581e-07, B: 2.79550711884, C: 1246.6574718363},
+		{A: 1.0411e-07, B: 2.8672714553, C: 189.1807054807},
+		{A: 9.259e-08, B: 5.1983482312, C: 749.2098356561},
+		{A: 1.2468e-07, B: 0.76477162698, C: 122.4758617607},
+		{A: 1.0099e-07, B: 6.06894682979, C: 156.1554792736},
+		{A: 1.2671e-07, B: 6.19797171716, C: 149.8238295655},
+		{A: 9.053e-08, B: 0.99447742265, C: 109.3125493421},
+		{A: 9.595e-08, B: 1.00827882958, C: 393.4610900843},
+		{A: 1.0455e-07, B: 1.23531019351, C: 148.5999892881},
+		{A: 1.0645e-07, B: 5.50399216121, C: 460.5384408198},
+		{A: 1.2433e-07, B: 5.298436616, C: 20.4950532349},
+		{A: 9.002e-08, B: 5.5121689284, C: 133.1008708993},
+		{A: 9.882e-08, B: 5.68987366321, C: 42.5382696529},
+		{A: 1.211e-07, B: 3.14577799081, C: 30.0562807905},
+		{A: 1.038e-07, B: 3.54528360301, C: 619.2903584945},
+		{A: 1.0139e-07, B: 1.90528799801, C: 25.0603459444},
+		{A: 1.1206e-07, B: 5.8782323899, C: 832.5894539325},
+		{A: 9.283e-08, B: 3.00514787072, C: 754.8389099486},
+		{A: 1.0994e-07, B: 0.05721003392, C: 54.2865453324},
+		{A: 8.981e-08, B: 5.82462023723, C: 248.4631856592},
+		{A: 8.634e-08, B: 5.49123270314, C: 448.6895914038},
+		{A: 9.39e-08, B: 1.32674472283, C: 9.4011688612},
+		{A: 9.621e-08, B: 5.6909029939, C: 73.88782669},
+		{A: 1.0843e-07, B: 1.4281264822, C: 268.4369740323},
+		{A: 1.0874e-07, B: 2.6136174199, C: 446.3113468182},
+		{A: 9.681e-08, B: 4.27051176079, C: 282.6640680339},
+		{A: 1.077e-07, B: 0.19304986906, C: 463.5073862364},
+		{A: 8.223e-08, B: 3.30114329151, C: 172.1971143836},
+		{A: 1.0216e-07, B: 5.04428111805, C: 241.8709035202},
+		{A: 8.89e-08, B: 2.53320903906, C: 271.4059194489},
+		{A: 1.0621e-07, B: 4.39013117792, C: 63.847772888},
+		{A: 9.085e-08, B: 0.99085954236, C: 6.9010986797},
+		{A: 1.0414e-07, B: 4.96367476854, C: 97.6761482472},
+		{A: 8.382e-08, B: 5.03764591595, C: 370.9390474199},
+		{A: 8.791e-08, B: 3.05426995163, C: 291.262087743},
+		{A: 8.629e-08, B: 0.1467493805, C: 262.4778313397},
+		{A: 9.673e-08, B: 4.2559733057, C: 602.9880909536},
+		{A: 7.939e-08, B: 5.71230451368, C: 541.5398145106},
+		{A: 7.981e-08, B: 2.35900017752, C: 196.6243208816},
+		{A: 7.941e-08, B: 0.88239951788, C: 154.979823106},
+		{A: 8.212e-08, B: 0.00845991197, C: 76.42612997},
+		{A: 1.0135e-07, B: 1.90258069764, C: 91.4563731237},
+		{A: 8.948e-08, B: 4.31891786278, C: 469.1364605289},
+		{A: 9.906e-08, B: 0.61122653279, C: 308.3151148093},
+		{A: 8.257e-08, B: 4.61012292958, C: 69.6737891366},
+		{A: 1.0291e-07, B: 3.58217488981, C: 842.1506814881},
+		{A: 1.0672e-07, B: 2.28920805112, C: 194.2885149114},
+		{A: 9.024e-08, B: 0.70282370018, C: 685.4739373527},
+		{A: 7.552e-08, B: 4.86800510978, C: 1097.0942747017},
+		{A: 9.496e-08, B: 1.0666235072, C: 93.7921790939},
+		{A: 8.413e-08, B: 3.15290837718, C: 32.7164096664},
+		{A: 8.914e-08, B: 5.03579282562, C: 450.4559484024},
+		{A: 8.008e-08, B: 4.33420849497, C: 302.0953396858},
+		{A: 1.0397e-07, B: 4.90564475822, C: 829.6205085159},
+		{A: 7.401e-08, B: 5.67595616187, C: 337.8019466282},
+		{A: 7.419e-08, B: 3.0467243912, C: 7.4223635415},
+		{A: 7.936e-08, B: 4.37116642726, C: 464.4705940829},
+		{A: 9.731e-08, B: 5.703933034, C: 98.3574718034},
+		{A: 9.287e-08, B: 4.16913084905, C: 15.4991183888},
+		{A: 1.0072e-07, B: 1.18963356664, C: 621.7380390493},
+		{A: 9.867e-08, B: 1.34469368253, C: 142.9709149956},
+		{A: 8.913e-08, B: 1.33679256423, C: 0.2606324309},
+		{A: 8.734e-08, B: 2.03651443558, C: 149.4031384402},
+		{A: 7.745e-08, B: 0.14834225031, C: 1404.0849754971},
+		{A: 9.037e-08, B: 3.53203542312, C: 636.6677084665},
+		{A: 7.707e-08, B: 1.87083579542, C: 31.6526280834},
+		{A: 8.566e-08, B: 2.4828035791, C: 497.4476361802},
+		{A: 1.0074e-07, B: 2.59066128203, C: 711.4493070338},
+		{A: 8.726e-08, B: 5.88905195509, C: 82.2039621088},
+		{A: 9.218e-08, B: 1.10861658464, C: 412.5835451955},
+		{A: 9.483e-08, B: 6.02304095716, C: 916.9322800554},
+		{A: 7.012e-08, B: 6.27635752748, C: 376.1956146969},
+		{A: 9.867e-08, B: 1.9314020472, C: 62.7726904569},
+		{A: 7.121e-08, B: 1.54192963162, C: 679.2541622292},
+		{A: 8.44e-08, B: 5.06400284478, C: 1.3725981237},
+		{A: 8.915e-08, B: 2.39052372377, C: 76.154196691},
+		{A: 9.637e-08, B: 3.78128664809, C: 838.218528225},
+		{A: 7.059e-08, B: 5.91499106809, C: 74.1484591209},
+		{A: 9.57e-08, B: 1.97721363991, C: 703.6331846174},
+		{A: 7.516e-08, B: 4.87738017916, C: 310.1720945331},
+		{A: 7.059e-08, B: 1.94098053303, C: 75.4147380137},
+		{A: 8.552e-08, B: 6.14581140636, C: 17.6379824029},
+		{A: 6.859e-08, B: 5.11679089849, C: 107.4980082337},
+		{A: 7.148e-08, B: 1.73466140387, C: 1190.7858800591},
+		{A: 9.112e-08, B: 0.8836866329, C: 362.8622925726},
+		{A: 9.3e-08, B: 1.44257902224, C: 763.4369296577},
+		{A: 9.073e-08, B: 4.31998777457, C: 16.1535096946},
+		{A: 9.126e-08, B: 3.74417347717, C: 4.665866446},
+		{A: 7.869e-08, B: 4.65596954763, C: 232.4215505492},
+		{A: 9.168e-08, B: 3.25096522859, C: 155.5010879678},
+		{A: 7.624e-08, B: 0.88232424215, C: 459.3627846522},
+		{A: 8.2e-08, B: 1.51866334747, C: 10063.7223490764},
+		{A: 8.579e-08, B: 2.377262345, C: 75.6753704446},
+		{A: 7.595e-08, B: 2.63499505823, C: 657.1627617014},
+		{A: 8.131e-08, B: 6.15861249482, C: 745.277682393},
+		{A: 6.398e-08, B: 0.61376490225, C: 73.2489417492},
+		{A: 7.71e-08, B: 4.02552779925, C: 4.7353024152},
+		{A: 6.38e-08, B: 3.20688120531, C: 73.3453099688},
+		{A: 7.505e-08, B: 0.67397826037, C: 228.7982138268},
+		{A: 7.129e-08, B: 1.33525552417, C: 236.8749686741},
+		{A: 7.444e-08, B: 3.05581518163, C: 171.6545976624},
+		{A: 6.361e-08, B: 6.05108999867, C: 95.9792272178},
+		{A: 7.086e-08, B: 4.88497877319, C: 6531.661656265},
+		{A: 6.527e-08, B: 4.0138014903, C: 118.8737768977},
+		{A: 7.984e-08, B: 1.70695215254, C: 104.5290628171},
+		{A: 6.239e-08, B: 1.08160874504, C: 143.9341228421},
+		{A: 7.2e-08, B: 1.19830150903, C: 1617.3840709351},
+		{A: 6.39e-08, B: 4.83649966441, C: 1072.7152523135},
+		{A: 7.857e-08, B: 3.06062400692, C: 341.9947323222},
+		{A: 6.158e-08, B: 0.04340975323, C: 627.3671133418},
+		{A: 8.664e-08, B: 5.60425824325, C: 2810.9214616052},
+		{A: 6.147e-08, B: 4.16482048084, C: 1300.9922012785},
+		{A: 8.148e-08, B: 3.1170064191, C: 10213.285546211},
+		{A: 8.603e-08, B: 3.80404544682, C: 25558.2121764796},
+		{A: 7.586e-08, B: 2.86885781812, C: 406.1031376411},
+		{A: 7.925e-08, B: 3.11650504, C: 81.682697247},
+		{A: 7.063e-08, B: 5.35078594952, C: 73.0364934281},
+		{A: 6.265e-08, B: 3.27996815317, C: 116.5379709275},
+		{A: 7.345e-08, B: 0.45699353973, C: 192.8040422031},
+		{A: 6.878e-08, B: 1.29690429239, C: 22.633917249},
+		{A: 6.102e-08, B: 2.06174377751, C: 73.9784494152},
+		{A: 7.867e-08, B: 1.45455437627, C: 131.9252147317},
+		{A: 6.837e-08, B: 0.07237438837, C: 90.2807169561},
+		{A: 7.74e-08, B: 2.87078307084, C: 79.4474650133},
+		{A: 6.744e-08, B: 0.01897075429, C: 572.2292347475},
+		{A: 6.034e-08, B: 0.96635268225, C: 476.3194434989},
+		{A: 8.276e-08, B: 2.40596529645, C: 674.8007441043},
+		{A: 6.002e-08, B: 3.24986200464, C: 76.7873361374},
+		{A: 6.863e-08, B: 1.24850658686, C: 400.1652468079},
+		{A: 6.375e-08, B: 1.37050567525, C: 75.1541055828},
+		{A: 7.445e-08, B: 5.47946546419, C: 50.66320861},
+		{A: 5.889e-08, B: 5.83364715391, C: 164.1203595363},
+		{A: 7.19e-08, B: 3.21396813566, C: 71.1582618449},
+		{A: 6.305e-08, B: 1.65443603478, C: 70.0462961521},
+		{A: 6.628e-08, B: 1.990437442, C: 1.5963472929},
+		{A: 6.081e-08, B: 1.2659989053, C: 61.448276443},
+		{A: 7.795e-08, B: 0.70956881527, C: 44.070926471},
+		{A: 7.784e-08, B: 5.47416712189, C: 416.7763308895},
+		{A: 5.727e-08, B: 2.39389303582, C: 20277.0078952874},
+		{A: 6.363e-08, B: 1.96472326808, C: 288.7350853111},
+		{A: 5.724e-08, B: 4.3053278397, C: 86.6304479833},
+		{A: 7.348e-08, B: 5.28008775997, C: 285.6330134505},
+		{A: 5.693e-08, B: 1.13590287643, C: 445.3481389717},
+		{A: 5.799e-08, B: 2.43420435064, C: 180.7951340927},
+		{A: 6.927e-08, B: 4.19297070447, C: 525.2375469697},
+		{A: 6.143e-08, B: 2.23902465258, C: 1310.3933701397},
+		{A: 6.68e-08, B: 5.36285833774, C: 452.4616859725},
+		{A: 5.709e-08, B: 3.65303501856, C: 442.3791935551},
+		{A: 6.238e-08, B: 4.60538870228, C: 137.5542890242},
+		{A: 5.932e-08, B: 3.22993254909, C: 73.4571845534},
+		{A: 7.734e-08, B: 5.72805659987, C: 154.2984995498},
+		{A: 5.782e-08, B: 2.17577477697, C: 2.2876218604},
+		{A: 6.016e-08, B: 3.4658554659, C: 346.3999663373},
+		{A: 7.517e-08, B: 5.63641104589, C: 549.7284439425},
+		{A: 6.685e-08, B: 4.84971092735, C: 148.8124376092},
+		{A: 5.797e-08, B: 0.51568102762, C: 149.3025647037},
+		{A: 7.739e-08, B: 3.23104533276, C: 589.4947101349},
+		{A: 7.59e-08, B: 2.49504939023, C: 321.7603115182},
+		{A: 6.706e-08, B: 5.23367324742, C: 769.8167634756},
+		{A: 7.21e-08, B: 2.56243122515, C: 375.6743498351},
+		{A: 5.491e-08, B: 1.84139760824, C: 375.3924655448},
+		{A: 6.592e-08, B: 3.39703193659, C: 389.6889955156},
+		{A: 6.414e-08, B: 3.56513278405, C: 488.8496164711},
+		{A: 6.376e-08, B: 0.24081237769, C: 881.5075574033},
+		{A: 5.785e-08, B: 3.49508162978, C: 102.523325247},
+		{A: 6.079e-08, B: 0.84517404881, C: 89.5993933999},
+		{A: 5.799e-08, B: 2.19388408812, C: 8.9068362498},
+		{A: 5.305e-08, B: 1.977856112, C: 150.31395666},
+		{A: 5.422e-08, B: 1.21990761182, C: 332.1728723357},
+		{A: 7.235e-08, B: 2.78896876234, C: 748.0978699633},
+		{A: 6.36e-08, B: 0.36304095923, C: 74.4090915518},
+		{A: 5.285e-08, B: 4.41108441808, C: 12.0089081104},
+		{A: 6.602e-08, B: 3.01699401726, C: 442.7517005706},
+		{A: 6.509e-08, B: 0.49819168917, C: 1147.4968508808},
+		{A: 5.585e-08, B: 6.04761249158, C: 172.4577468145},
+		{A: 6.673e-08, B: 0.77536194908, C: 6069.7767545534},
+		{A: 7.125e-08, B: 0.34356180793, C: 511.5954083047},
+		{A: 5.288e-08, B: 4.27462942653, C: 11.1575748485},
+		{A: 6.767e-08, B: 2.00969662613, C: 105.380396079},
+		{A: 5.611e-08, B: 2.40776057824, C: 1286.0143477515},
+		{A: 6.456e-08, B: 4.48699081452, C: 31.2319369581},
+		{A: 5.898e-08, B: 5.26174074234, C: 757.8078553652},
+		{A: 5.153e-08, B: 2.41386832919, C: 742.9900605326},
+		{A: 5.087e-08, B: 6.03592089039, C: 980.6681783588},
+		{A: 6.198e-08, B: 0.83056505252, C: 1507.1777497157},
+		{A: 5.425e-08, B: 1.93107713343, C: 40.8413486235},
+		{A: 6.091e-08, B: 5.18564204379, C: 487.1045113319},
+		{A: 5.715e-08, B: 1.96802719384, C: 394.3548619616},
+		{A: 5.509e-08, B: 1.3127509208, C: 883.7951792637},
+		{A: 5.32e-08, B: 4.22718652038, C: 65.3804297061},
+		{A: 5.357e-08, B: 1.80483136985, C: 139.4807047172},
+		{A: 5.204e-08, B: 3.39869589191, C: 1400.152822234},
+		{A: 6.537e-08, B: 2.31923989568, C: 328.2407190726},
+		{A: 5.041e-08, B: 2.9467334644, C: 361.3778198643},
+		{A: 4.969e-08, B: 6.27367198215, C: 0.1600586944},
+		{A: 5.334e-08, B: 2.78985718428, C: 217.4436970222},
+		{A: 5.654e-08, B: 0.51056760715, C: 285.1117485887},
+		{A: 6.432e-08, B: 2.21948959433, C: 9999.986450773},
+		{A: 5.768e-08, B: 5.10735836078, C: 216.2680408546},
+		{A: 4.977e-08, B: 2.62435916254, C: 194.1766403268},
+		{A: 6.932e-08, B: 1.71722863424, C: 378.9039276826},
+		{A: 5.187e-08, B: 3.04429850681, C: 1083.7609525774},
+		{A: 5.791e-08, B: 3.9406147625, C: 550.1378342197},
+		{A: 5.816e-08, B: 2.24843661305, C: 230.9370778409},
+		{A: 5.319e-08, B: 0.0699882535, C: 336.8387387817},
+		{A: 5.427e-08, B: 0.30577275388, C: 40.5807161926},
+		{A: 6.469e-08, B: 3.02579309025, C: 298.2326223919},
+		{A: 4.974e-08, B: 1.21594265105, C: 455.0694252217},
+		{A: 4.96e-08, B: 4.7280621023, C: 454.7493078329},
+		{A: 5.619e-08, B: 2.27500303004, C: 227.5261894396},
+		{A: 6.328e-08, B: 0.97544932086, C: 249.9476583675},
+		{A: 5.319e-08, B: 4.59867974067, C: 454.7974919427},
+		{A: 4.791e-08, B: 4.40360629153, C: 853.196381752},
+		{A: 6.519e-08, B: 3.03043401282, C: 167.7224443993},
+		{A: 5.45e-08, B: 6.00971547441, C: 25.1297819136},
+		{A: 5.094e-08, B: 3.96693309189, C: 1066.49547719},
+		{A: 5.779e-08, B: 0.65954416303, C: 272.5815756165},
+		{A: 6.491e-08, B: 4.6852965154, C: 312.1990839626},
+		{A: 5.234e-08, B: 4.34712255335, C: 233.533516242},
+		{A: 5.396e-08, B: 5.62885554221, C: 418.5214360287},
+		{A: 5.048e-08, B: 2.46802064424, C: 987.5692770385},
+		{A: 6.152e-08, B: 0.79853332272, C: 2274.5468326365},
+		{A: 6.506e-08, B: 1.7291557512, C: 125.1841747464},
+		{A: 4.993e-08, B: 3.75975860404, C: 57.255490749},
+		{A: 6.295e-08, B: 0.84778953014, C: 10.0824924174},
+		{A: 6.251e-08, B: 4.78782138567, C: 270.1820791715},
+		{A: 5.785e-08, B: 4.31237764709, C: 374.4986936675},
+		{A: 5.406e-08, B: 5.49902863401, C: 632.7837393132},
+		{A: 6.224e-08, B: 0.12733845417, C: 149.0419322728},
+		{A: 4.921e-08, B: 1.43037646364, C: 73.1370671646},
+		{A: 5.076e-08, B: 1.34845106372, C: 455.0212411119},
+		{A: 5.73e-08, B: 3.30386575867, C: 88.274979386},
+		{A: 4.618e-08, B: 0.64720625124, C: 119.3950417595},
+		{A: 6.213e-08, B: 2.58827934841, C: 544.5087599272},
+		{A: 4.825e-08, B: 6.08615765986, C: 304.1223291153},
+		{A: 4.825e-08, B: 6.00483903794, C: 226.7924762567},
+		{A: 5.528e-08, B: 5.69752791882, C: 548.4409131903},
+		{A: 5.108e-08, B: 2.74489127167, C: 423.6292454594},
+		{A: 5.426e-08, B: 2.44835106987, C: 531.978586955},
+		{A: 4.573e-08, B: 1.61098293427, C: 357.4456666012},
+		{A: 4.487e-08, B: 6.09067660554, C: 204.7010757289},
+		{A: 5.866e-08, B: 6.20513223441, C: 772.7857088922},
+		{A: 5.334e-08, B: 2.49860553733, C: 1131.1945833399},
+		{A: 5.656e-08, B: 4.75744184558, C: 491.8185618877},
+		{A: 4.441e-08, B: 0.23590452375, C: 35.685355083},
+		{A: 4.37e-08, B: 3.8113649083, C: 1329.3033769298},
+		{A: 4.406e-08, B: 3.42865095493, C: 144.8973306886},
+		{A: 5.251e-08, B: 4.72114047741, C: 535.3200393871},
+		{A: 5.174e-08, B: 1.37807596858, C: 520.129737539},
+		{A: 4.331e-08, B: 2.64717426456, C: 1517.2602421331},
+		{A: 4.802e-08, B: 2.6056946352, C: 177.3049238143},
+		{A: 4.368e-08, B: 3.36272561974, C: 1503.2455964526},
+		{A: 4.335e-08, B: 2.73379207096, C: 289.5651667136},
+		{A: 5.198e-08, B: 0.97116582962, C: 128.4350044533},
+		{A: 4.437e-08, B: 0.56678131875, C: 253.4591205053},
+		{A: 5.386e-08, B: 5.84886051674, C: 268.6976064632},
+		{A: 5.376e-08, B: 1.30096148962, C: 436.8138097374},
+		{A: 5.797e-08, B: 4.33049740199, C: 208.8456773131},
+		{A: 4.353e-08, B: 1.66111524192, C: 1261.6353253633},
+		{A: 4.812e-08, B: 4.95769337401, C: 545.4719677737},
+		{A: 5.897e-08, B: 2.0420120518, C: 8.5980197091},
+		{A: 5.032e-08, B: 2.8055075977, C: 360.4146120178},
+		{A: 4.921e-08, B: 2.55658380096, C: 260.360219185},
+		{A: 4.413e-08, B: 3.23825819993, C: 973.554631358},
+		{A: 4.506e-08, B: 0.17509624151, C: 380.3884003909},
+		{A: 4.947e-08, B: 5.50324549675, C: 365.9006739584},
+		{A: 4.174e-08, B: 2.99974290843, C: 136.0698163159},
+		{A: 4.553e-08, B: 2.77416673233, C: 147.9668498417},
+		{A: 5.344e-08, B: 1.81213470593, C: 521.0929453855},
+		{A: 5.155e-08, B: 0.78324341489, C: 1670.0742689746},
+		{A: 5.133e-08, B: 1.37435234967, C: 271.61836777},
+		{A: 5.708e-08, B: 2.52872222038, C: 501.3797894433},
+		{A: 4.933e-08, B: 1.36454104948, C: 238.9019581036},
+		{A: 4.973e-08, B: 1.92960964594, C: 535.9107402181},
+		{A: 4.935e-08, B: 5.04375067678, C: 697.8071683688},
+		{A: 4.129e-08, B: 1.50064332826, C: 71.8608372605},
+		{A: 5.207e-08, B: 4.0187736734, C: 92.4195809702},
+		{A: 4.587e-08, B: 4.78553156868, C: 95.931043108},
+		{A: 5.05e-08, B: 5.41251268131, C: 758.7710632117},
+		{A: 5.012e-08, B: 4.50266403888, C: 635.9651330509},
+		{A: 4.248e-08, B: 0.65406962267, C: 920.8644333185},
+		{A: 5.15e-08, B: 1.13490701556, C: 310.9752436852},
+		{A: 4.146e-08, B: 5.54040372231, C: 1048.3362299253},
+		{A: 4.256e-08, B: 4.20942901957, C: 25.2727942655},
+		{A: 4.569e-08, B: 5.19758291396, C: 10.2949407385},
+		{A: 4.259e-08, B: 5.53202386861, C: 184.9879197867},
+		{A: 4.315e-08, B: 2.80569687202, C: 213.9534867438},
+		{A: 4.604e-08, B: 2.51643176466, C: 962.5089310941},
+		{A: 5.105e-08, B: 1.26007002216, C: 971.1069508032},
+		{A: 4.5e-08, B: 6.15796742231, C: 1052.2683831884},
+		{A: 4.095e-08, B: 0.63467124507, C: 1321.4390704036},
+		{A: 3.968e-08, B: 0.07377679014, C: 77.7023598741},
+		{A: 4.617e-08, B: 2.77367751889, C: 406.954470903},
+		{A: 4.899e-08, B: 4.65767840428, C: 305.6068018236},
+		{A: 3.9e-08, B: 1.66467970991, C: 945.2434557067},
+		{A: 4.133e-08, B: 3.76396043787, C: 263.0203480609},
+		{A: 3.899e-08, B: 4.28677450975, C: 224.2329211173},
+		{A: 5.03e-08, B: 6.24112139981, C: 1162.4747044078},
+		{A: 5.024e-08, B: 0.39738855487, C: 968.1380053866},
+		{A: 4.894e-08, B: 2.50422546622, C: 355.7487455718},
+		{A: 4.283e-08, B: 3.15267059582, C: 846.0828347512},
+		{A: 3.941e-08, B: 0.04342429962, C: 1235.6117715724},
+		{A: 4.077e-08, B: 5.6885446997, C: 695.5564297701},
+		{A: 3.842e-08, B: 0.37429373422, C: 774.4826299216},
+		{A: 4.674e-08, B: 0.08112657673, C: 1366.2125722902},
+		{A: 4.671e-08, B: 4.38923533828, C: 117.36805233},
+		{A: 5.313e-08, B: 4.38472090135, C: 689.6185389369},
+		{A: 3.787e-08, B: 1.41443617212, C: 48.9181034708},
+		{A: 4.236e-08, B: 1.60316940746, C: 367.5975949878},
+		{A: 4.569e-08, B: 2.88138923862, C: 551.1010420662},
+		{A: 4.636e-08, B: 5.93442268083, C: 148.1905990109},
+		{A: 5.128e-08, B: 0.17600225009, C: 433.7117378768},
+		{A: 4.264e-08, B: 2.08657038625, C: 325.3836482406},
+		{A: 3.885e-08, B: 5.85359840623, C: 450.7165808333},
+		{A: 4.753e-08, B: 2.58442943928, C: 358.4088744477},
+		{A: 4.226e-08, B: 6.24596640453, C: 448.9714756941},
+		{A: 3.776e-08, B: 1.78756451192, C: 71.7007785661},
+		{A: 4.912e-08, B: 4.45665056284, C: 51.8870488874},
+		{A: 3.854e-08, B: 2.72138633161, C: 151.850818995},
+		{A: 4.561e-08, B: 0.07201979569, C: 2349.3284312038},
+		{A: 4.291e-08, B: 5.39929339966, C: 523.7530742614},
+		{A: 4.143e-08, B: 0.1715886627, C: 735.8765135318},
+		{A: 3.806e-08, B: 1.44358694049, C: 138.6293714553},
+		{A: 3.654e-08, B: 2.41520715554, C: 348.8476468921},
+		{A: 3.728e-08, B: 1.69745141654, C: 984.7122062065},
+		{A: 4.176e-08, B: 4.01139155515, C: 195.7729876197},
+		{A: 4.986e-08, B: 1.0356290592, C: 224.4566702865},
+		{A: 4.031e-08, B: 0.92145122185, C: 76.0054388447},
+		{A: 4.098e-08, B: 3.51214223942, C: 72.4939767069},
+		{A: 3.812e-08, B: 4.41246815759, C: 1511.3223512999},
+		{A: 4.098e-08, B: 2.39702785276, C: 239.1625905345},
+		{A: 4.894e-08, B: 5.26621064696, C: 601.5036182453},
+		{A: 4.459e-08, B: 5.76440378473, C: 836.5216071956},
+		{A: 4.373e-08, B: 4.08948598951, C: 75.4359898731},
+		{A: 4.363e-08, B: 6.01127167247, C: 421.1815649046},
+		{A: 4.414e-08, B: 0.358306616, C: 168.3131452303},
+		{A: 3.7e-08, B: 2.04103813925, C: 63.2146334416},
+		{A: 4.648e-08, B: 2.07482117651, C: 1106.6555022573},
+		{A: 4.304e-08, B: 3.03122452327, C: 1109.6244476739},
+		{A: 3.618e-08, B: 4.84400847177, C: 893.3564068193},
+		{A: 4.023e-08, B: 0.59685650686, C: 91.2439248026},
+		{A: 4.937e-08, B: 5.49417275871, C: 976.7360250957},
+		{A: 4.373e-08, B: 3.76648561161, C: 74.1272072615},
+		{A: 4.31e-08, B: 3.72983787822, C: 673.316271396},
+		{A: 4.68e-08, B: 3.8963125415, C: 163.5778428151},
+		{A: 4.172e-08, B: 0.75349427039, C: 1500.0642027149},
+		{A: 4.492e-08, B: 1.87283145714, C: 141.1776257466},
+		{A: 4.174e-08, B: 5.82910805335, C: 346.4481504471},
+		{A: 3.775e-08, B: 0.83052387256, C: 827.9235874865},
+		{A: 3.481e-08, B: 2.70828672792, C: 818.574808252},
+		{A: 4.853e-08, B: 0.95917381603, C: 58.1705144857},
+		{A: 4.021e-08, B: 3.11274455034, C: 377.4194549743},
+		{A: 4.409e-08, B: 0.16607520728, C: 630.3360587584},
+		{A: 3.597e-08, B: 1.02560564654, C: 515.463871093},
+		{A: 3.444e-08, B: 1.38488805947, C: 117.3198682202},
+		{A: 4.021e-08, B: 5.68447974866, C: 3.4902102784},
+		{A: 4.244e-08, B: 3.75845344717, C: 733.428832977},
+		{A: 3.933e-08, B: 4.55157642432, C: 240.125798381},
+		{A: 4.421e-08, B: 1.51263319894, C: 1610.2705239343},
+		{A: 3.39e-08, B: 4.00215380112, C: 74.9940468884},
+		{A: 4.556e-08, B: 3.41531360529, C: 1140.38330388},
+		{A: 4.171e-08, B: 0.76417016678, C: 623.4349600787},
+		{A: 3.441e-08, B: 2.56450835637, C: 14.8177948326},
+		{A: 3.587e-08, B: 4.10186965494, C: 343.2185725996},
+		{A: 3.997e-08, B: 5.74857613262, C: 6212.2264046872},
+		{A: 4.215e-08, B: 0.84469743228, C: 176.6505325085},
+		{A: 4.098e-08, B: 1.41920746453, C: 559.6990617753},
+		{A: 4.553e-08, B: 1.09016692751, C: 561.8861098992},
+		{A: 3.493e-08, B: 0.11837510368, C: 1031.87390369},
+		{A: 3.596e-08, B: 5.33968666729, C: 394.9455627926},
+		{A: 3.501e-08, B: 5.53309359866, C: 594.9113361063},
+		{A: 3.564e-08, B: 1.57868308864, C: 354.9979860464},
+		{A: 4.279e-08, B: 2.35436288262, C: 562.6680071919},
+		{A: 3.398e-08, B: 1.6208634854, C: 941.3113024436},
+		{A: 3.803e-08, B: 1.78948693511, C: 251.1714986449},
+		{A: 3.299e-08, B: 5.13631478768, C: 477.0007670551},
+		{A: 3.422e-08, B: 3.55674695079, C: 256.4280659219},
+		{A: 3.802e-08, B: 4.09287840097, C: 268.9582388941},
+		{A: 3.818e-08, B: 4.64162443046, C: 71.9245277353},
+		{A: 4.623e-08, B: 2.72836206211, C: 6244.9428143536},
+		{A: 3.466e-08, B: 1.91387688001, C: 58.7399634573},
+		{A: 3.435e-08, B: 2.96178782926, C: 995.6460318858},
+		{A: 3.626e-08, B: 5.35614681493, C: 57.5161231799},
+		{A: 4.512e-08, B: 1.18350543284, C: 170.7608257851},
+		{A: 4.378e-08, B: 0.273460377, C: 469.7271613599},
+		{A: 3.323e-08, B: 4.51516827363, C: 454.8611824175},
+		{A: 3.32e-08, B: 1.42938752044, C: 454.9575506371},
+		{A: 4.578e-08, B: 4.7498051473, C: 731.9443602687},
+		{A: 3.249e-08, B: 0.67719975914, C: 74.0478853844},
+		{A: 4.145e-08, B: 5.58064267022, C: 57.7980074702},
+		{A: 3.512e-08, B: 6.09122971288, C: 70.5888128733},
+		{A: 3.814e-08, B: 2.49565462974, C: 6204.362098161},
+		{A: 3.828e-08, B: 4.39751907192, C: 586.3133163972},
+		{A: 3.639e-08, B: 4.85097208169, C: 138.4056222861},
+		{A: 3.518e-08, B: 0.52105043625, C: 262.8078997398},
+		{A: 3.671e-08, B: 1.99667387765, C: 511.5317178299},
+		{A: 3.215e-08, B: 0.64628330219, C: 887.7273325268},
+		{A: 3.718e-08, B: 3.27473813045, C: 454.6487340964},
+		{A: 3.638e-08, B: 2.63250736806, C: 455.1699989582},
+		{A: 3.772e-08, B: 0.88810300052, C: 10142.4361009068},
+		{A: 3.19e-08, B: 4.87960158471, C: 455.6601260527},
+		{A: 3.669e-08, B: 4.11456655271, C: 409.9234163196},
+		{A: 3.166e-08, B: 1.24948126394, C: 82.6459050935},
+		{A: 3.53e-08, B: 4.02075420346, C: 388.2045228073},
+		{A: 3.163e-08, B: 1.65294183878, C: 765.8846102125},
+		{A: 3.568e-08, B: 5.95965909592, C: 460.8472573605},
+		{A: 3.45e-08, B: 0.08821515281, C: 49.1787359017},
+		{A: 3.27e-08, B: 1.81146731641, C: 34.2008823747},
+		{A: 3.188e-08, B: 1.74587038709, C: 18.9100067901},
+		{A: 3.305e-08, B: 5.77382040863, C: 10.5244354021},
+		{A: 3.345e-08, B: 4.15802505352, C: 1515.7757694248},
+		{A: 3.16e-08, B: 1.06549289762, C: 454.1586070019},
+		{A: 3.525e-08, B: 2.56091667232, C: 78114.14622758799},
+		{A: 4.124e-08, B: 1.06751791085, C: 388.4651552382},
+		{A: 4.016e-08, B: 2.48751669586, C: 531.1754378029},
+		{A: 3.147e-08, B: 5.05814757549, C: 1521.4048437173},
+		{A: 4.18e-08, B: 1.16833674781, C: 514.5643537213},
+		{A: 3.212e-08, B: 3.18682610058, C: 1512.8068240082},
+		{A: 3.486e-08, B: 0.22630227172, C: 36.5366883449},
+		{A: 4.211e-08, B: 3.21876950029, C: 761.7400086283},
+		{A: 3.485e-08, B: 3.26177495276, C: 36.7604375141},
+		{A: 3.506e-08, B: 6.26633354904, C: 545.2750258176},
+		{A: 3.733e-08, B: 2.55776517455, C: 279.4826742962},
+		{A: 4.227e-08, B: 1.41381723926, C: 41.7563723602},
+		{A: 3.187e-08, B: 1.62296832026, C: 138.4693127609},
+		{A: 3.934e-08, B: 2.86731965547, C: 832.0681890707},
+		{A: 3.684e-08, B: 3.941740603, C: 179.3106613844},
+		{A: 3.115e-08, B: 5.67364420834, C: 73.5577582899},
+		{A: 3.663e-08, B: 1.80556740809, C: 31.5407534988},
+		{A: 3.171e-08, B: 4.85529878165, C: 138.5656809805},
+		{A: 3.777e-08, B: 5.94890597104, C: 873.1701701251},
+		{A: 4.357e-08, B: 4.15105623366, C: 10175.1525105732},
+		{A: 3.951e-08, B: 1.60185888278, C: 576.1613880106},
+		{A: 3.525e-08, B: 4.8031643597, C: 429.7795846137},
+		{A: 3.33e-08, B: 5.62171319933, C: 1116.0042814918},
+		{A: 3.943e-08, B: 4.6264154302, C: 898.7730327907},
+		{A: 3.382e-08, B: 1.45717307307, C: 5983.9494557222},
+		{A: 3.215e-08, B: 3.73878297941, C: 335.7749571987},
+		{A: 3.423e-08, B: 5.07987216951, C: 143.3434220111},
+		{A: 4.161e-08, B: 5.39091883238, C: 1363.2436268736},
+		{A: 3.457e-08, B: 3.94796907904, C: 444.8268741099},
+		{A: 3.593e-08, B: 3.91831549069, C: 10134.5717943806},
+		{A: 3.666e-08, B: 4.2262033899, C: 36.1754821775},
+		{A: 3.58e-08, B: 3.43130119859, C: 912.7876784712},
+		{A: 3.7e-08, B: 0.67445695843, C: 73.9302653054},
+		{A: 3.679e-08, B: 1.10949079061, C: 686.958410061},
+		{A: 3.547e-08, B: 5.63096398237, C: 440.8947208468},
+		{A: 3.622e-08, B: 1.10742531477, C: 2250.1678102483},
+		{A: 3.562e-08, B: 3.80604468765, C: 1525.3369969804},
+		{A: 3.33e-08, B: 1.76480149289, C: 78.9743842613},
+		{A: 3.738e-08, B: 1.7675491018, C: 384.5811860849},
+		{A: 3.986e-08, B: 1.0684287447, C: 743.7932096847},
+		{A: 3.032e-08, B: 5.77852412826, C: 612.1768114937},
+		{A: 3.008e-08, B: 0.64086342534, C: 210.8514148832},
+		{A: 3.334e-08, B: 4.81681647959, C: 597.3590166611},
+		{A: 3.141e-08, B: 3.11768616608, C: 6607.9277275406},
+		{A: 3.022e-08, B: 2.08709314702, C: 34.5309507748},
+		{A: 3.226e-08, B: 3.19780030434, C: 377.6800874052},
+		{A: 4.065e-08, B: 3.53637930424, C: 402.2191684878},
+		{A: 4.138e-08, B: 0.28927701421, C: 517.1607921224},
+		{A: 3.697e-08, B: 0.89932694516, C: 75.6329318292},
+		{A: 3.918e-08, B: 5.73859894835, C: 94.4253185403},
+		{A: 3.374e-08, B: 0.4097440558, C: 677.7696895209},
+		{A: 3.194e-08, B: 4.56998602897, C: 1385.174968707},
+		{A: 3.18e-08, B: 1.03370427552, C: 885.4397106664},
+		{A: 3.235e-08, B: 5.09681747179, C: 464.9918589447},
+		{A: 3.798e-08, B: 5.76464888795, C: 586.377006872},
+		{A: 3.173e-08, B: 5.68964342749, C: 4.192785694},
+		{A: 3.181e-08, B: 2.87968862974, C: 9914.1591519418},
+		{A: 3.355e-08, B: 5.45857968674, C: 73.0846775379},
+		{A: 2.899e-08, B: 5.12928266291, C: 448.3170843883},
+		{A: 3.706e-08, B: 2.47342147635, C: 64.2571631652},
+		{A: 3.796e-08, B: 4.29502458131, C: 164.5410506616},
+		{A: 3.534e-08, B: 4.42464754991, C: 46.470422916},
+		{A: 3.488e-08, B: 5.62713714766, C: 3189.5647568569},
+		{A: 2.94e-08, B: 4.33606107945, C: 78263.70942472259},
+		{A: 3.309e-08, B: 3.10770680369, C: 519.6084726772},
+		{A: 4.03e-08, B: 4.41794838679, C: 772.5887669361},
+		{A: 2.867e-08, B: 5.17129632099, C: 346.1875180162},
+		{A: 3.842e-08, B: 1.87994191354, C: 299.7170951002},
+		{A: 3.846e-08, B: 5.38315286213, C: 980.146913497},
+		{A: 3.724e-08, B: 4.94511644698, C: 984.4884570373},
+		{A: 2.814e-08, B: 2.17260061398, C: 191.3195694948},
+		{A: 3.392e-08, B: 3.0855211609, C: 245.4942402426},
+		{A: 2.923e-08, B: 0.37358823115, C: 6.4804075544},
+		{A: 3.025e-08, B: 1.19297242418, C: 104.8378793578},
+		{A: 3.47e-08, B: 1.89084704021, C: 44.6134431922},
+		{A: 2.931e-08, B: 0.78809830626, C: 540.0553418023},
+		{A: 3.707e-08, B: 4.14868763219, C: 6136.4815982734},
+		{A: 3.931e-08, B: 5.52289695589, C: 6171.6456884946},
+		{A: 3.056e-08, B: 2.97000936733, C: 250.6020496733},
+		{A: 3.117e-08, B: 0.76332399369, C: 229.4526051326},
+		{A: 3.091e-08, B: 4.9194127978, C: 221.1634019642},
+		{A: 3.378e-08, B: 3.82658652472, C: 25936.85547173129},
+		{A: 3.041e-08, B: 2.14503983522, C: 6604.958782124},
+		{A: 2.865e-08, B: 0.53734608663, C: 273.8536000037},
+		{A: 2.845e-08, B: 0.34922064899, C: 85.9391734158},
+		{A: 3.657e-08, B: 6.02755271763, C: 340.8827666294},
+		{A: 2.818e-08, B: 4.44508352472, C: 369.342700127},
+		{A: 2.861e-08, B: 2.19284349075, C: 295.1942410061},
+		{A: 2.865e-08, B: 3.21935127992, C: 3.8202786785},
+		{A: 2.797e-08, B: 5.97725967979, C: 2014.0265547571},
+		{A: 3.28e-08, B: 0.74832416123, C: 422.7142217227},
+		{A: 3.509e-08, B: 1.92501559437, C: 343.4792050305},
+		{A: 2.962e-08, B: 2.29867992492, C: 661.1586054393},
+		{A: 3.485e-08, B: 4.5320530238, C: 676.2852168126},
+		{A: 2.836e-08, B: 1.2077968366, C: 1119.1856752295},
+		{A: 3.603e-08, B: 2.72183511139, C: 508.6264628881},
+		{A: 3.62e-08, B: 5.57691156197, C: 10066.691294493},
+		{A: 2.731e-08, B: 0.9666341177, C: 582.3811631341},
+		{A: 3.306e-08, B: 6.20620840278, C: 11.5669651257},
+		{A: 3.279e-08, B: 6.13563821647, C: 276.0717858949},
+		{A: 2.747e-08, B: 4.5747626302, C: 226.6324175623},
+		{A: 2.825e-08, B: 1.24120423378, C: 989.0537497468},
+		{A: 2.707e-08, B: 0.46257342768, C: 1458.472094566},
+		{A: 2.755e-08, B: 5.43548338507, C: 246.9787129509},
+		{A: 3.338e-08, B: 3.98641322371, C: 488.5889840402},
+		{A: 2.96e-08, B: 5.31788128818, C: 1467.0701142751},
+		{A: 3.062e-08, B: 1.93542241882, C: 987.7817253596},
+		{A: 3.504e-08, B: 4.10521239427, C: 6280.1069045748},
+		{A: 3.584e-08, B: 3.12196206517, C: 108.7218485111},
+		{A: 2.975e-08, B: 0.13746189123, C: 9987.4562778008},
+		{A: 2.634e-08, B: 5.61201014857, C: 412.3710968744},
+		{A: 3.702e-08, B: 0.66231252049, C: 10101.8553847142},
+		{A: 3.261e-08, B: 4.39228048501, C: 75.3722993983},
+		{A: 2.939e-08, B: 3.2631997985, C: 130.552616608},
+		{A: 2.742e-08, B: 6.24317126103, C: 447.2051186955},
+		{A: 3.008e-08, B: 4.24451493185, C: 170.1701249541},
+		{A: 3.001e-08, B: 2.45489658954, C: 230.8252032563},
+		{A: 2.722e-08, B: 4.98348297926, C: 754.0357607965},
+		{A: 2.928e-08, B: 5.73784691627, C: 14.6690369863},
+		{A: 2.699e-08, B: 2.98043546816, C: 27.7204748203},
+		{A: 3.678e-08, B: 3.46436124301, C: 26468.0309095342},
+		{A: 3.5e-08, B: 4.41810854452, C: 322.6116447801},
+		{A: 2.943e-08, B: 3.58544468129, C: 12489.8856287072},
+		{A: 2.894e-08, B: 2.2699912084, C: 1615.8995982268},
+		{A: 2.679e-08, B: 1.32002304425, C: 236.1936451179},
+		{A: 2.711e-08, B: 1.25250599577, C: 52250.5878817157},
+		{A: 2.573e-08, B: 4.41371056719, C: 262.0571402144},
+		{A: 3.483e-08, B: 1.97113718781, C: 655.938921424},
+		{A: 2.6e-08, B: 3.8010722697, C: 70.6369969831},
+		{A: 3.28e-08, B: 1.75059058234, C: 683.9894646444},
+		{A: 3.26e-08, B: 3.46047977615, C: 74.1908977363},
+		{A: 3.097e-08, B: 5.47452034532, C: 302.3772239761},
+		{A: 2.565e-08, B: 3.47443238116, C: 2042.4977891028},
+		{A: 3.158e-08, B: 4.58160924364, C: 12492.8545741238},
+		{A: 3.373e-08, B: 5.52806001629, C: 10210.3166007944},
+		{A: 2.619e-08, B: 4.43681016753, C: 949.1756089698},
+		{A: 3.29e-08, B: 1.35055242247, C: 515.6763194141},
+		{A: 3.18e-08, B: 4.49938964982, C: 694.8382229522},
+		{A: 2.558e-08, B: 5.07921716363, C: 197.7999770492},
+		{A: 2.902e-08, B: 2.61388996483, C: 115.3623147599},
+		{A: 2.586e-08, B: 4.09771865336, C: 1448.9108670104},
+		{A: 3.16e-08, B: 4.82168102018, C: 714.6788848813},
+		{A: 3.016e-08, B: 3.16691101108, C: 385.7568422525},
+		{A: 3.001e-08, B: 3.24181300229, C: 1618.8685436434},
+		{A: 3.319e-08, B: 5.98200177347, C: 533.8355666788},
+		{A: 3.307e-08, B: 3.31452419197, C: 732.97125859},
+		{A: 2.53e-08, B: 0.13809025963, C: 591.9423906897},
+		{A: 2.975e-08, B: 5.72770980032, C: 1011.4270345649},
+		{A: 2.857e-08, B: 4.11031053901, C: 2267.4332856357},
+		{A: 3.091e-08, B: 4.87979664891, C: 582.641795565},
+		{A: 3.422e-08, B: 6.18592254593, C: 281.4884118663},
+		{A: 2.501e-08, B: 0.50645055144, C: 29.226199388},
+		{A: 3.502e-08, B: 0.17203520151, C: 371.5297482509},
+		{A: 2.607e-08, B: 3.51828908958, C: 112.3933693433},
+		{A: 2.547e-08, B: 4.45612695304, C: 901.2207133455},
+		{A: 2.778e-08, B: 4.97805873371, C: 132.5796060375},
+		{A: 2.476e-08, B: 1.55163657371, C: 1234.1272988641},
+		{A: 2.929e-08, B: 2.34725672182, C: 273.1510245881},
+		{A: 2.724e-08, B: 5.73177362443, C: 688.6553310904},
+		{A: 2.667e-08, B: 6.13733078138, C: 161.4120465506},
+		{A: 2.877e-08, B: 5.47506403197, C: 1436.5407527326},
+		{A: 2.779e-08, B: 1.54196175338, C: 680.0573113813},
+		{A: 2.427e-08, B: 4.6491343131, C: 392.6579409322},
+		{A: 3.223e-08, B: 2.57976952494, C: 267.5856407704},
+		{A: 2.75e-08, B: 1.29662662582, C: 108.982480942},
+		{A: 3.22e-08, B: 1.0629018794, C: 388.7257876691},
+		{A: 3.236e-08, B: 3.47802643973, C: 283.6272758804},
+		{A: 3.027e-08, B: 5.38233284458, C: 44.0921783304},
+		{A: 2.83e-08, B: 5.70274947128, C: 327.4375699205},
+		{A: 2.768e-08, B: 5.4225616879, C: 482.2573343321},
+		{A: 3.23e-08, B: 5.65661970187, C: 134.3728952865},
+		{A: 2.685e-08, B: 5.03687735302, C: 763.2244813366},
+		{A: 2.441e-08, B: 5.19830386978, C: 380.2396425446},
+		{A: 2.608e-08, B: 3.11386505876, C: 578.449009871},
+		{A: 2.465e-08, B: 1.4924267283, C: 141.6988906084},
+		{A: 2.332e-08, B: 3.19371804203, C: 683.0262567979},
+		{A: 2.307e-08, B: 4.07685834192, C: 78.9262001515},
+		{A: 2.28e-08, B: 2.52476606956, C: 156.6767441354},
+		{A: 2.26e-08, B: 6.23958645351, C: 400.5746370851},
+		{A: 2.301e-08, B: 2.47762267951, C: 107.918699359},
+		{A: 3.089e-08, B: 5.69639540598, C: 537.3952129264},
+		{A: 3.074e-08, B: 1.16740737548, C: 58.6280888727},
+		{A: 2.846e-08, B: 0.02430566936, C: 563.3705826075},
+		{A: 2.423e-08, B: 4.24559706691, C: 27.7417266797},
+		{A: 2.67e-08, B: 3.48404619442, C: 123.0183784819},
+		{A: 2.228e-08, B: 3.33159258912, C: 1257.7031721002},
+		{A: 2.445e-08, B: 2.29979337782, C: 280.2163874791},
+		{A: 2.26e-08, B: 3.85026258012, C: 753.1419889192},
+		{A: 2.195e-08, B: 4.94038065567, C: 1222.2784494481},
+		{A: 2.579e-08, B: 1.76841912074, C: 710.7467316182},
+		{A: 2.402e-08, B: 4.47942458428, C: 569.0478410098},
+		{A: 2.181e-08, B: 5.39048760967, C: 318.679491517},
+		{A: 2.317e-08, B: 6.27946729049, C: 493.0424021651},
+		{A: 2.42e-08, B: 3.66436222896, C: 3.6233367224},
+	},
+	// R1, 514 terms
+	{
+		{A: 0.0147989637, B: 3.67205705317, C: 74.7815985673},
+		{A: 0.00071212085, B: 6.22601006675, C: 63.7358983034},
+		{A: 0.00068626972, B: 6.13411265052, C: 149.5631971346},
+		{A: 0.00020857262, B: 5.24625494219, C: 11.0457002639},
+		{A: 0.00021468152, B: 2.6017670427, C: 76.2660712756},
+		{A: 0.00024059649, B: 3.14159265359, C: 0},
+		{A: 0.00011405346, B: 0.01848461561, C: 70.8494453042},
+		{A: 7.496775e-05, B: 0.42360033283, C: 73.297125859},
+		{A: 4.2438e-05, B: 1.41692350371, C: 85.8272988312},
+		{A: 3.505936e-05, B: 2.58354048851, C: 138.5174968707},
+		{A: 3.228835e-05, B: 5.25499602896, C: 3.9321532631},
+		{A: 3.926694e-05, B: 3.15513991323, C: 71.8126531507},
+		{A: 3.06001e-05, B: 0.15321893225, C: 1.4844727083},
+		{A: 3.578446e-05, B: 2.31160668309, C: 224.3447957019},
+		{A: 2.564251e-05, B: 0.98076846352, C: 148.0787244263},
+		{A: 2.429445e-05, B: 3.99440122468, C: 52.6901980395},
+		{A: 1.644719e-05, B: 2.65349313124, C: 127.4717966068},
+		{A: 1.583766e-05, B: 1.43045619196, C: 78.7137518304},
+		{A: 1.413112e-05, B: 4.57461892062, C: 202.2533951741},
+		{A: 1.489525e-05, B: 2.67559167316, C: 56.6223513026},
+		{A: 1.403237e-05, B: 1.36985349744, C: 77.7505439839},
+		{A: 1.22822e-05, B: 1.04703640149, C: 62.2514255951},
+		{A: 1.508028e-05, B: 5.05996325425, C: 151.0476698429},
+		{A: 9.92085e-06, B: 2.17168865909, C: 65.2203710117},
+		{A: 1.032731e-05, B: 0.26459059027, C: 131.4039498699},
+		{A: 8.61867e-06, B: 5.05530802218, C: 351.8165923087},
+		{A: 7.44445e-06, B: 3.07640148939, C: 35.1640902212},
+		{A: 6.04362e-06, B: 0.90717667985, C: 984.6003316219},
+		{A: 6.46851e-06, B: 4.4729042291, C: 70.3281804424},
+		{A: 5.7471e-06, B: 3.23070708457, C: 447.7958195265},
+		{A: 6.8747e-06, B: 2.49912565674, C: 77.962992305},
+		{A: 6.23602e-06, B: 0.8625307382, C: 9.5612275556},
+		{A: 5.27794e-06, B: 5.15136007084, C: 2.9689454166},
+		{A: 5.61839e-06, B: 2.7177815898, C: 462.0229135281},
+		{A: 5.30364e-06, B: 5.91655309045, C: 213.299095438},
+		{A: 4.6008e-06, B: 4.22302465979, C: 12.5301729722},
+		{A: 4.9428e-06, B: 0.46291078127, C: 145.6310438715},
+		{A: 4.87336e-06, B: 0.70614146398, C: 380.12776796},
+		{A: 3.80908e-06, B: 3.85089591694, C: 3.1813937377},
+		{A: 4.44352e-06, B: 2.15558291251, C: 67.6680515665},
+		{A: 3.388e-06, B: 2.53820897704, C: 18.1592472647},
+		{A: 3.72947e-06, B: 5.05141251694, C: 529.6909650946},
+		{A: 3.48345e-06, B: 1.74874852104, C: 71.6002048296},
+		{A: 4.05881e-06, B: 1.229617276, C: 22.0914005278},
+		{A: 2.68913e-06, B: 6.24069521597, C: 340.7708920448},
+		{A: 2.55585e-06, B: 2.95695013627, C: 84.3428261229},
+		{A: 2.59465e-06, B: 3.92053708924, C: 59.8037450403},
+		{A: 2.24731e-06, B: 3.90961468562, C: 160.6088973985},
+		{A: 2.2171e-06, B: 3.64727173951, C: 137.0330241624},
+		{A: 2.54591e-06, B: 3.50411592815, C: 38.1330356378},
+		{A: 2.3829e-06, B: 2.04879982674, C: 269.9214467406},
+		{A: 2.72355e-06, B: 3.38363105223, C: 222.8603229936},
+		{A: 2.00648e-06, B: 1.24861003313, C: 69.3649725959},
+		{A: 2.34153e-06, B: 0.27825220612, C: 108.4612160802},
+		{A: 1.88515e-06, B: 4.41307507326, C: 265.9892934775},
+		{A: 2.11691e-06, B: 0.68027381802, C: 111.4301614968},
+		{A: 2.05946e-06, B: 1.53379817229, C: 284.1485407422},
+		{A: 1.96179e-06, B: 4.77152996605, C: 299.1263942692},
+		{A: 1.53102e-06, B: 5.21761881347, C: 209.3669421749},
+		{A: 1.62563e-06, B: 4.3405435361, C: 33.6796175129},
+		{A: 1.50563e-06, B: 1.98966326297, C: 54.1746707478},
+		{A: 1.37012e-06, B: 0.40323866041, C: 195.1398481733},
+		{A: 1.17171e-06, B: 0.39649791652, C: 87.3117715395},
+		{A: 1.27913e-06, B: 2.40333045173, C: 39.6175083461},
+		{A: 1.04218e-06, B: 2.92152185788, C: 134.5853436076},
+		{A: 1.03862e-06, B: 1.81622936156, C: 72.3339180125},
+		{A: 1.05741e-06, B: 0.17067407327, C: 79.2350166922},
+		{A: 1.06419e-06, B: 0.69799543514, C: 2.4476805548},
+		{A: 9.5326e-07, B: 4.02880266738, C: 82.8583534146},
+		{A: 1.04772e-06, B: 4.43616414428, C: 305.3461693927},
+		{A: 9.3825e-07, B: 5.01823592717, C: 51.2057253312},
+		{A: 1.03739e-06, B: 2.57553519741, C: 191.2076949102},
+		{A: 1.06679e-06, B: 1.22996874093, C: 225.8292684102},
+		{A: 9.3452e-07, B: 3.09274255916, C: 77.2292791221},
+		{A: 9.7398e-07, B: 3.81380841075, C: 152.5321425512},
+		{A: 8.4583e-07, B: 5.72473747348, C: 68.8437077341},
+		{A: 7.7395e-07, B: 0.08281157747, C: 45.5766510387},
+		{A: 7.6207e-07, B: 4.20384370842, C: 73.8183907208},
+		{A: 8.6249e-07, B: 0.53131085736, C: 145.1097790097},
+		{A: 7.5795e-07, B: 3.78559826812, C: 75.7448064138},
+		{A: 7.7592e-07, B: 1.63628139623, C: 479.2883889155},
+		{A: 8.4612e-07, B: 0.6166245601, C: 116.4260963429},
+		{A: 1.00209e-06, B: 4.94084867643, C: 120.358249606},
+		{A: 7.2142e-07, B: 4.30505812564, C: 565.1156877467},
+		{A: 7.0733e-07, B: 2.38450718488, C: 60.7669528868},
+		{A: 7.1585e-07, B: 3.93906647867, C: 153.4953503977},
+		{A: 8.4566e-07, B: 5.56037336584, C: 344.7030453079},
+		{A: 6.3556e-07, B: 1.93742986679, C: 41.6444977756},
+		{A: 7.1619e-07, B: 3.71213491656, C: 408.4389436113},
+		{A: 6.1594e-07, B: 3.90006698249, C: 4.4534181249},
+		{A: 6.4973e-07, B: 1.55845503407, C: 106.9767433719},
+		{A: 5.9913e-07, B: 0.60110866128, C: 74.8934731519},
+		{A: 6.2e-07, B: 4.39369268007, C: 453.424893819},
+		{A: 6.3361e-07, B: 4.19159979468, C: 184.7272873558},
+		{A: 6.2301e-07, B: 3.23773103318, C: 422.6660376129},
+		{A: 5.4427e-07, B: 3.72545550857, C: 7.1135470008},
+		{A: 5.2474e-07, B: 6.08562717749, C: 404.5067903482},
+		{A: 5.9073e-07, B: 1.55568469603, C: 456.3938392356},
+		{A: 5.2597e-07, B: 3.5049223397, C: 125.9873238985},
+		{A: 5.2835e-07, B: 5.20100035142, C: 358.9301393095},
+		{A: 5.8123e-07, B: 5.33480562448, C: 220.4126424388},
+		{A: 5.2909e-07, B: 4.44819701196, C: 426.598190876},
+		{A: 5.0934e-07, B: 0.526385342, C: 490.3340891794},
+		{A: 5.4968e-07, B: 1.60146090981, C: 14.977853527},
+		{A: 4.9491e-07, B: 4.25534603275, C: 5.4166259714},
+		{A: 5.1303e-07, B: 0.36772379136, C: 206.1855484372},
+		{A: 5.1821e-07, B: 1.75832999538, C: 8.0767548473},
+		{A: 5.6964e-07, B: 0.84114552694, C: 146.594251718},
+		{A: 4.9109e-07, B: 0.94061875871, C: 99.1606209555},
+		{A: 4.6361e-07, B: 5.35115472594, C: 152.7445908723},
+		{A: 4.8023e-07, B: 1.97249712347, C: 288.0806940053},
+		{A: 4.3772e-07, B: 3.03713403879, C: 20.6069278195},
+		{A: 4.9493e-07, B: 5.84619560979, C: 112.9146342051},
+		{A: 4.1987e-07, B: 0.04620500196, C: 128.9562693151},
+		{A: 4.8628e-07, B: 3.62817742782, C: 81.0013736908},
+		{A: 4.1472e-07, B: 2.33730376429, C: 277.0349937414},
+		{A: 3.9983e-07, B: 5.09525356576, C: 35.4247226521},
+		{A: 4.1948e-07, B: 2.51050760642, C: 24.3790223882},
+		{A: 3.8325e-07, B: 3.61946898382, C: 173.9422195228},
+		{A: 3.8385e-07, B: 2.0600322013, C: 333.657345044},
+		{A: 4.2597e-07, B: 1.260887373, C: 1514.2912967165},
+		{A: 3.8855e-07, B: 0.74239364306, C: 347.8844390456},
+		{A: 3.8535e-07, B: 4.95064283065, C: 92.940845832},
+		{A: 3.3234e-07, B: 1.38358507432, C: 74.6697239827},
+		{A: 3.3788e-07, B: 3.68407945156, C: 66.9172920411},
+		{A: 3.8953e-07, B: 5.49236040328, C: 200.7689224658},
+		{A: 3.185e-07, B: 0.53990592534, C: 203.7378678824},
+		{A: 3.332e-07, B: 6.26012644668, C: 1059.3819301892},
+		{A: 3.0806e-07, B: 2.53797566903, C: 977.4867846211},
+		{A: 2.9198e-07, B: 5.43116906, C: 58.1068240109},
+		{A: 3.0059e-07, B: 0.19481555617, C: 387.2413149608},
+		{A: 2.8997e-07, B: 3.10546504714, C: 991.7138786227},
+		{A: 3.564e-07, B: 3.72863820177, C: 96.8729990951},
+		{A: 2.7607e-07, B: 0.37142052647, C: 80.1982245387},
+		{A: 3.2492e-07, B: 4.38403518987, C: 221.3758502853},
+		{A: 2.7029e-07, B: 1.35552416596, C: 0.9632078465},
+		{A: 3.1276e-07, B: 0.79566430555, C: 373.0142209592},
+		{A: 3.1122e-07, B: 2.05381353845, C: 230.5645708254},
+		{A: 2.5883e-07, B: 3.46808071409, C: 144.1465711632},
+		{A: 3.0201e-07, B: 0.71392007232, C: 109.9456887885},
+		{A: 2.4688e-07, B: 3.04162764358, C: 14.0146456805},
+		{A: 2.7882e-07, B: 4.76559523368, C: 415.5524906121},
+		{A: 2.511e-07, B: 5.12405829717, C: 81.3738807063},
+		{A: 2.5582e-07, B: 2.56904073164, C: 522.5774180938},
+		{A: 2.4351e-07, B: 2.2028905975, C: 628.8515860501},
+		{A: 2.5479e-07, B: 1.795218773, C: 143.6253063014},
+		{A: 2.4182e-07, B: 5.67160913092, C: 443.8636662634},
+		{A: 2.5679e-07, B: 5.43185950751, C: 546.956440482},
+		{A: 2.4177e-07, B: 5.59982039849, C: 32.1951448046},
+		{A: 2.4428e-07, B: 3.30271734903, C: 617.8058857862},
+		{A: 2.3535e-07, B: 0.65842590604, C: 46.2097904851},
+		{A: 2.2371e-07, B: 4.82094751058, C: 135.5485514541},
+		{A: 2.7179e-07, B: 2.02720001624, C: 536.8045120954},
+		{A: 2.2213e-07, B: 4.6166462422, C: 391.1734682239},
+		{A: 2.1973e-07, B: 4.59216260632, C: 241.6102710893},
+		{A: 2.0813e-07, B: 0.24392941148, C: 465.9550667912},
+		{A: 2.7264e-07, B: 2.15210992383, C: 140.001969579},
+		{A: 2.1356e-07, B: 5.27168432406, C: 159.1244246902},
+		{A: 2.3632e-07, B: 4.94972840898, C: 561.1835344836},
+		{A: 2.4921e-07, B: 0.54550733267, C: 181.7583419392},
+		{A: 2.3027e-07, B: 3.80632203913, C: 55.1378785943},
+		{A: 1.9799e-07, B: 1.30259938601, C: 518.6452648307},
+		{A: 1.9252e-07, B: 1.31448491434, C: 543.0242872189},
+		{A: 1.9704e-07, B: 4.90869636976, C: 909.8187330546},
+		{A: 2.0801e-07, B: 0.91178207093, C: 76.4785195967},
+		{A: 1.9876e-07, B: 0.66494008343, C: 66.70484372},
+		{A: 1.8957e-07, B: 4.67998817036, C: 98.8999885246},
+		{A: 2.5913e-07, B: 4.52903186569, C: 454.9093665273},
+		{A: 2.1888e-07, B: 1.2337293174, C: 41.1019810544},
+		{A: 1.8703e-07, B: 6.09640927844, C: 103.0927742186},
+		{A: 1.8207e-07, B: 0.97283864525, C: 55.6591434561},
+		{A: 2.1247e-07, B: 4.19373732137, C: 329.7251917809},
+		{A: 1.9408e-07, B: 4.314682308, C: 6.2197751235},
+		{A: 1.8497e-07, B: 5.78624335074, C: 142.4496501338},
+		{A: 2.2588e-07, B: 5.84591645052, C: 297.6419215609},
+		{A: 1.677e-07, B: 6.09084656811, C: 211.8146227297},
+		{A: 1.6432e-07, B: 2.5000846902, C: 61.2882177486},
+		{A: 2.0361e-07, B: 3.16137245375, C: 186.2117600641},
+		{A: 1.5955e-07, B: 2.98317221345, C: 81.8951455681},
+		{A: 1.8953e-07, B: 6.01226591746, C: 155.7829722581},
+		{A: 1.7686e-07, B: 4.82613965176, C: 273.1028404783},
+		{A: 1.5141e-07, B: 3.65588411561, C: 472.1748419147},
+		{A: 1.844e-07, B: 3.47582817224, C: 36.6485629295},
+		{A: 1.6303e-07, B: 0.13086415177, C: 554.0699874828},
+		{A: 1.8633e-07, B: 0.23932740251, C: 23.5758732361},
+		{A: 1.4352e-07, B: 2.69389896537, C: 70.1157321213},
+		{A: 1.519e-07, B: 2.43789398875, C: 486.4019359163},
+		{A: 1.4002e-07, B: 5.12389205028, C: 29.2049475286},
+		{A: 1.5758e-07, B: 4.24947053051, C: 146.3818033969},
+		{A: 1.4125e-07, B: 1.55719788547, C: 110.2063212194},
+		{A: 1.7477e-07, B: 1.94549668506, C: 835.0371344873},
+		{A: 1.3691e-07, B: 1.63831110442, C: 92.0470739547},
+		{A: 1.3801e-07, B: 0.13721153975, C: 235.3904959658},
+		{A: 1.3573e-07, B: 2.85427895075, C: 49.5088043018},
+		{A: 1.2563e-07, B: 3.20921738646, C: 100.3844612329},
+		{A: 1.239e-07, B: 2.88595800082, C: 60.5545045657},
+		{A: 1.4986e-07, B: 0.32593957273, C: 259.5088859231},
+		{A: 1.2922e-07, B: 2.77565630582, C: 105.4922706636},
+		{A: 1.2323e-07, B: 3.36427641421, C: 440.6822725257},
+		{A: 1.5233e-07, B: 0.2558984518, C: 258.8757464767},
+		{A: 1.2106e-07, B: 0.10857558014, C: 157.6399519819},
+		{A: 1.2883e-07, B: 0.30655541587, C: 124.2904028691},
+		{A: 1.09e-07, B: 3.42905554547, C: 33.1371007917},
+		{A: 1.1206e-07, B: 4.98840478043, C: 604.4725636619},
+		{A: 1.0812e-07, B: 3.86253020441, C: 767.3690829208},
+		{A: 1.1561e-07, B: 2.60450144944, C: 166.828672522},
+		{A: 1.02e-07, B: 5.27810824796, C: 264.5048207692},
+		{A: 1.0926e-07, B: 0.64149188846, C: 558.0021407459},
+		{A: 1.2315e-07, B: 4.33998516461, C: 16.6747745564},
+		{A: 9.946e-08, B: 0.67298666287, C: 31.492569389},
+		{A: 1.2641e-07, B: 4.83194943583, C: 114.3991069134},
+		{A: 1.0479e-07, B: 0.20404797652, C: 275.5505210331},
+		{A: 1.1291e-07, B: 0.96120625051, C: 373.9079928365},
+		{A: 1.2144e-07, B: 1.91712815063, C: 378.6432952517},
+		{A: 1.2229e-07, B: 0.7046545467, C: 218.4069048687},
+		{A: 1.0753e-07, B: 5.74480767273, C: 88.1149206916},
+		{A: 9.481e-08, B: 0.65566927406, C: 353.301065017},
+		{A: 1.1006e-07, B: 2.62953946665, C: 154.0166152595},
+		{A: 9.113e-08, B: 2.99457723478, C: 681.5417840896},
+		{A: 1.0429e-07, B: 2.33056994007, C: 132.8884225782},
+		{A: 9.169e-08, B: 4.79284571455, C: 216.4804891757},
+		{A: 9.341e-08, B: 0.75923548315, C: 129.9194771616},
+		{A: 8.917e-08, B: 0.78008399009, C: 67.3592350258},
+		{A: 8.757e-08, B: 6.12717748848, C: 150.5264049811},
+		{A: 9.637e-08, B: 2.88664912193, C: 67.8804998876},
+		{A: 1.0465e-07, B: 0.36943456465, C: 699.7010313543},
+		{A: 9.301e-08, B: 1.49620591593, C: 19.643719973},
+		{A: 9.367e-08, B: 5.26481516822, C: 80.7194894005},
+		{A: 1.0076e-07, B: 3.56540311122, C: 278.5194664497},
+		{A: 9.455e-08, B: 3.06088968751, C: 149.6750717192},
+		{A: 9.168e-08, B: 3.02528121597, C: 162.0933701068},
+		{A: 8.395e-08, B: 2.1845500165, C: 342.2553647531},
+		{A: 9.233e-08, B: 5.32613442062, C: 152.0108776894},
+		{A: 9.786e-08, B: 2.43713607191, C: 75.3028634291},
+		{A: 1.0029e-07, B: 0.81917102953, C: 339.2864193365},
+		{A: 9.429e-08, B: 1.93671715384, C: 147.1155165798},
+		{A: 7.861e-08, B: 4.71717822837, C: 106.0135355254},
+		{A: 8.813e-08, B: 0.01616162513, C: 42.5864537627},
+		{A: 7.808e-08, B: 0.61104170424, C: 135.336103133},
+		{A: 8.193e-08, B: 2.59644466423, C: 469.1364605289},
+		{A: 1.0084e-07, B: 2.58619215129, C: 50.4025761791},
+		{A: 8.574e-08, B: 5.69115937472, C: 760.25553592},
+		{A: 7.525e-08, B: 2.64764195045, C: 5.9378908332},
+		{A: 8.699e-08, B: 0.54050826161, C: 66.1835788582},
+		{A: 8.027e-08, B: 1.94079002321, C: 180.2738692309},
+		{A: 7.547e-08, B: 5.94593031762, C: 97.4155158163},
+		{A: 7.597e-08, B: 5.80197738402, C: 450.9772132642},
+		{A: 8.666e-08, B: 3.69933873164, C: 300.6108669775},
+		{A: 7.685e-08, B: 1.47377256329, C: 32.2433289144},
+		{A: 8.195e-08, B: 2.30769657654, C: 254.9435932136},
+		{A: 8.473e-08, B: 1.27680705911, C: 39.3568759152},
+		{A: 7.026e-08, B: 0.68091865104, C: 874.3940104025},
+		{A: 8.898e-08, B: 0.16273040357, C: 43.1289704839},
+		{A: 7.205e-08, B: 4.9817753104, C: 117.9105690512},
+		{A: 7.389e-08, B: 4.09295183164, C: 92.3077063856},
+		{A: 7.314e-08, B: 5.04313738379, C: 756.3233826569},
+		{A: 8.454e-08, B: 1.22026161161, C: 79.4474650133},
+		{A: 6.925e-08, B: 6.04100189247, C: 350.3321196004},
+		{A: 8.793e-08, B: 1.33398658801, C: 48.7580447764},
+		{A: 7.27e-08, B: 3.32609286227, C: 68.1893164283},
+		{A: 6.825e-08, B: 4.77832275072, C: 142.6620984549},
+		{A: 6.816e-08, B: 3.90452052962, C: 480.7728616238},
+		{A: 7.062e-08, B: 1.27536949417, C: 68.5618234438},
+		{A: 7.947e-08, B: 4.29940380231, C: 624.919432787},
+		{A: 6.741e-08, B: 5.43264472273, C: 610.6923387854},
+		{A: 6.529e-08, B: 5.43599941795, C: 88.7962442478},
+		{A: 7.635e-08, B: 4.81180007736, C: 312.4597163935},
+		{A: 7.235e-08, B: 3.18370421558, C: 268.4369740323},
+		{A: 8.133e-08, B: 1.98936178361, C: 692.5874843535},
+		{A: 6.477e-08, B: 1.05238958778, C: 685.4739373527},
+		{A: 6.63e-08, B: 1.37656948077, C: 291.262087743},
+		{A: 6.878e-08, B: 2.59188446778, C: 282.6640680339},
+		{A: 7.123e-08, B: 5.79744758808, C: 468.2426886516},
+		{A: 6.32e-08, B: 2.58497126634, C: 458.090760265},
+		{A: 6.222e-08, B: 5.68982546821, C: 113.8778420516},
+		{A: 7.635e-08, B: 0.49482302003, C: 296.1574488526},
+		{A: 8.521e-08, B: 0.00576688485, C: 227.3137411185},
+		{A: 6.52e-08, B: 3.99093726386, C: 42.5382696529},
+		{A: 6.435e-08, B: 1.03721543102, C: 365.9006739584},
+		{A: 6.107e-08, B: 0.35071886662, C: 148.5999892881},
+		{A: 8.199e-08, B: 1.13448902886, C: 69.1525242748},
+		{A: 6.102e-08, B: 0.94101111641, C: 13.3333221243},
+		{A: 5.989e-08, B: 4.98445156102, C: 184.0941479094},
+		{A: 6.355e-08, B: 0.16346166674, C: 228.276948965},
+		{A: 7.955e-08, B: 4.03567630186, C: 183.2428146475},
+		{A: 5.884e-08, B: 4.40842406038, C: 19.1224551112},
+		{A: 5.938e-08, B: 5.40863870407, C: 17.5261078183},
+		{A: 5.869e-08, B: 5.39494525133, C: 95.3885263868},
+		{A: 5.775e-08, B: 2.81250784939, C: 121.8427223143},
+		{A: 6.07e-08, B: 4.23605170027, C: 119.5069163441},
+		{A: 6.349e-08, B: 3.52304701692, C: 285.6330134505},
+		{A: 5.78e-08, B: 0.17831551537, C: 458.8415197904},
+		{A: 5.674e-08, B: 4.16711163603, C: 89.7594520943},
+		{A: 5.534e-08, B: 4.24741728108, C: 75.5323580927},
+		{A: 5.648e-08, B: 2.81224199321, C: 154.979823106},
+		{A: 6.939e-08, B: 3.31979953743, C: 306.830642101},
+		{A: 5.682e-08, B: 4.79764449768, C: 248.7238180901},
+		{A: 6.087e-08, B: 4.04640130992, C: 271.4059194489},
+		{A: 6.869e-08, B: 1.34392408836, C: 7.8643065262},
+		{A: 5.611e-08, B: 5.32955957046, C: 920.8644333185},
+		{A: 6.495e-08, B: 0.45735814276, C: 106.2741679563},
+		{A: 5.353e-08, B: 2.49825965802, C: 24.1183899573},
+		{A: 6.612e-08, B: 5.24626646696, C: 58.319272332},
+		{A: 5.552e-08, B: 0.24515487696, C: 173.6815870919},
+		{A: 5.209e-08, B: 6.07866998675, C: 134.0640787458},
+		{A: 5.176e-08, B: 3.69984512887, C: 778.4147831847},
+		{A: 5.949e-08, B: 3.63204266272, C: 189.7232222019},
+		{A: 6.36e-08, B: 0.35370738262, C: 411.620337349},
+		{A: 5.147e-08, B: 1.55440402971, C: 193.655375465},
+		{A: 6.436e-08, B: 5.18759014405, C: 120.9913890524},
+		{A: 6.994e-08, B: 4.85978914075, C: 419.4846438752},
+		{A: 5.323e-08, B: 0.50787742639, C: 16.4623262353},
+		{A: 5.085e-08, B: 1.28917723765, C: 267.4737661858},
+		{A: 5.993e-08, B: 4.70505267412, C: 298.2326223919},
+		{A: 5.507e-08, B: 2.72405080404, C: 986.0848043302},
+		{A: 6.163e-08, B: 1.87793216012, C: 397.3932433474},
+		{A: 4.846e-08, B: 5.6671411571, C: 90.8232336773},
+		{A: 4.875e-08, B: 1.24385851949, C: 25.6028626656},
+		{A: 5.374e-08, B: 0.31175745933, C: 192.6921676185},
+		{A: 5.262e-08, B: 1.85699096844, C: 114.9416236346},
+		{A: 5.373e-08, B: 6.22242588334, C: 91.4563731237},
+		{A: 5.05e-08, B: 3.39322756907, C: 831.1049812242},
+		{A: 4.637e-08, B: 0.84958882655, C: 403.0223176399},
+		{A: 6.382e-08, B: 2.77560901069, C: 198.321241911},
+		{A: 4.685e-08, B: 4.94029403928, C: 902.7051860538},
+		{A: 5.005e-08, B: 1.40309022449, C: 6.1503391543},
+		{A: 5.014e-08, B: 5.57665259095, C: 451.9404211107},
+		{A: 4.58e-08, B: 2.47734499363, C: 31.2319369581},
+		{A: 5.129e-08, B: 3.2352870415, C: 109.3125493421},
+		{A: 4.459e-08, B: 6.22635092697, C: 207.8824694666},
+		{A: 5.734e-08, B: 0.96616252776, C: 483.2205421786},
+		{A: 4.425e-08, B: 2.74721673213, C: 823.9914342234},
+		{A: 4.575e-08, B: 1.87994871749, C: 44.7253177768},
+		{A: 4.748e-08, B: 0.34902594832, C: 457.8783119439},
+		{A: 4.268e-08, B: 4.89983575247, C: 124.5028511902},
+		{A: 4.709e-08, B: 5.28612293112, C: 449.2802922348},
+		{A: 5.761e-08, B: 2.09247769051, C: 187.6962327724},
+		{A: 4.284e-08, B: 0.66132439268, C: 210.3301500214},
+		{A: 4.318e-08, B: 1.68857333749, C: 309.2783226558},
+		{A: 4.332e-08, B: 1.41872733238, C: 25.1297819136},
+		{A: 4.305e-08, B: 1.05990546337, C: 606.7601855223},
+		{A: 4.519e-08, B: 5.84384426255, C: 905.8865797915},
+		{A: 3.934e-08, B: 0.417688973, C: 180.1619946463},
+		{A: 3.973e-08, B: 3.22666150606, C: 639.897286314},
+		{A: 4.871e-08, B: 4.61331971606, C: 258.0244132148},
+		{A: 4.604e-08, B: 4.77631056831, C: 463.5073862364},
+		{A: 3.943e-08, B: 3.31312639875, C: 107.4980082337},
+		{A: 4.217e-08, B: 0.73383451512, C: 497.4476361802},
+		{A: 4.057e-08, B: 1.67333716577, C: 7.4223635415},
+		{A: 3.854e-08, B: 6.13547145503, C: 34.2008823747},
+		{A: 4.617e-08, B: 5.89829880253, C: 303.8616966844},
+		{A: 5.086e-08, B: 2.8523551874, C: 28.3111756513},
+		{A: 5.337e-08, B: 2.36556705745, C: 477.8039162072},
+		{A: 4.456e-08, B: 1.74674336635, C: 95.9792272178},
+		{A: 4.138e-08, B: 3.80344455465, C: 460.5384408198},
+		{A: 3.812e-08, B: 2.48508006441, C: 25.2727942655},
+		{A: 4.732e-08, B: 0.87519409311, C: 255.0554677982},
+		{A: 3.843e-08, B: 4.02615028031, C: 104.0077979553},
+		{A: 3.776e-08, B: 2.89171052095, C: 27.0873353739},
+		{A: 4.932e-08, B: 0.36238909407, C: 123.5396433437},
+		{A: 4.371e-08, B: 3.74322467592, C: 376.1956146969},
+		{A: 3.747e-08, B: 3.04126115463, C: 142.1408335931},
+		{A: 4.232e-08, B: 4.31629167726, C: 446.3113468182},
+		{A: 3.685e-08, B: 3.26448469664, C: 170.7608257851},
+		{A: 3.575e-08, B: 4.31199276037, C: 572.2292347475},
+		{A: 3.567e-08, B: 4.08542270507, C: 433.7117378768},
+		{A: 4.496e-08, B: 2.10358455875, C: 838.218528225},
+		{A: 3.505e-08, B: 3.5390238439, C: 520.129737539},
+		{A: 3.524e-08, B: 3.75716903766, C: 473.068613792},
+		{A: 3.962e-08, B: 5.33706246667, C: 43.2890291783},
+		{A: 3.597e-08, B: 3.65066955203, C: 976.0023119128},
+		{A: 3.487e-08, B: 2.12239114397, C: 316.3918696566},
+		{A: 3.475e-08, B: 4.44351326599, C: 384.0599212231},
+		{A: 3.628e-08, B: 2.11511417759, C: 73.1852512744},
+		{A: 3.702e-08, B: 3.86923731076, C: 981.6313862053},
+		{A: 3.687e-08, B: 5.18698183343, C: 993.198351331},
+		{A: 3.599e-08, B: 2.07986409347, C: 47.6942631934},
+		{A: 3.807e-08, B: 4.21821126511, C: 196.6243208816},
+		{A: 4.707e-08, B: 4.56309173897, C: 47.061123747},
+		{A: 4.312e-08, B: 0.38740046308, C: 988.532484885},
+		{A: 3.867e-08, B: 2.08559458308, C: 457.3570470821},
+		{A: 4.723e-08, B: 4.16947683948, C: 219.891377577},
+		{A: 3.527e-08, B: 0.2037157647, C: 394.3548619616},
+		{A: 3.644e-08, B: 5.82023483708, C: 586.3133163972},
+		{A: 3.328e-08, B: 2.93840719007, C: 535.9107402181},
+		{A: 3.321e-08, B: 4.19289134366, C: 114.1384744825},
+		{A: 4.128e-08, B: 3.06165703109, C: 377.1588225434},
+		{A: 3.545e-08, B: 4.41886084391, C: 1293.8786542777},
+		{A: 3.295e-08, B: 2.97049569593, C: 15.1903018481},
+		{A: 3.337e-08, B: 6.23473900765, C: 9947.0556815321},
+		{A: 3.253e-08, B: 5.22412177835, C: 425.1137181677},
+		{A: 3.677e-08, B: 5.31389484415, C: 141.6988906084},
+		{A: 3.242e-08, B: 4.68868636498, C: 978.9712573294},
+		{A: 3.266e-08, B: 3.57072306171, C: 17.2654753874},
+		{A: 3.435e-08, B: 0.52794358986, C: 141.4864422873},
+		{A: 3.242e-08, B: 2.62760698007, C: 6.592282139},
+		{A: 3.613e-08, B: 1.94737668557, C: 661.0949149645},
+		{A: 3.182e-08, B: 0.3660331511, C: 449.4927405559},
+		{A: 3.311e-08, B: 1.25616383318, C: 233.9060232575},
+		{A: 3.403e-08, B: 6.0379258317, C: 199.2844497575},
+		{A: 4.196e-08, B: 4.26442082589, C: 381.6122406683},
+		{A: 3.961e-08, B: 4.53281422377, C: 916.9322800554},
+		{A: 3.846e-08, B: 3.76849990033, C: 8.5980197091},
+		{A: 3.35e-08, B: 5.63661413371, C: 444.8268741099},
+		{A: 3.78e-08, B: 5.35722293289, C: 328.2407190726},
+		{A: 3.166e-08, B: 2.16351748263, C: 983.1158589136},
+		{A: 3.538e-08, B: 1.89746744103, C: 280.9671470045},
+		{A: 3.93e-08, B: 2.09444900058, C: 653.9813679637},
+		{A: 3.282e-08, B: 1.91872815218, C: 2349.3284312038},
+		{A: 3.269e-08, B: 0.52855777633, C: 450.4559484024},
+		{A: 3.582e-08, B: 1.60170266832, C: 1587.5884225755},
+		{A: 3.522e-08, B: 2.5178203618, C: 237.6781178262},
+		{A: 3.024e-08, B: 3.54567524563, C: 94.4253185403},
+		{A: 3.528e-08, B: 4.79818282081, C: 406.954470903},
+		{A: 2.996e-08, B: 2.5915529362, C: 6133.5126528568},
+		{A: 3.146e-08, B: 2.18094827839, C: 216.9224321604},
+		{A: 3.61e-08, B: 6.15486273902, C: 171.6545976624},
+		{A: 2.977e-08, B: 0.6947862817, C: 294.3004691288},
+		{A: 3.377e-08, B: 1.21382647091, C: 162.8965192589},
+		{A: 3.347e-08, B: 4.14981703949, C: 214.7835681463},
+		{A: 2.953e-08, B: 2.18721777019, C: 597.3590166611},
+		{A: 4.049e-08, B: 3.15153850922, C: 833.552661779},
+		{A: 3.725e-08, B: 5.84743216544, C: 6058.7310542895},
+		{A: 3.39e-08, B: 1.18412112871, C: 167.7224443993},
+		{A: 3.142e-08, B: 2.26934209337, C: 517.1607921224},
+		{A: 4.077e-08, B: 0.07273073033, C: 1190.0351205337},
+		{A: 3.02e-08, B: 2.64998251178, C: 20.4468691251},
+		{A: 3.926e-08, B: 1.41612569694, C: 346.1875180162},
+		{A: 3.11e-08, B: 1.11431255827, C: 1044.4040766622},
+		{A: 2.836e-08, B: 0.62522723719, C: 749.2098356561},
+		{A: 2.831e-08, B: 4.78996738581, C: 820.0592809603},
+		{A: 2.824e-08, B: 0.87232289414, C: 30.7106720963},
+		{A: 3.114e-08, B: 1.79734939525, C: 414.0680179038},
+		{A: 2.801e-08, B: 3.99301180541, C: 10063.7223490764},
+		{A: 3.489e-08, B: 1.86982946081, C: 371.5297482509},
+		{A: 3.725e-08, B: 1.68366366742, C: 683.9894646444},
+		{A: 3.763e-08, B: 3.28247771799, C: 432.8179659995},
+		{A: 3.493e-08, B: 0.98765698465, C: 9988.9407505091},
+		{A: 3.523e-08, B: 5.12512607932, C: 105.380396079},
+		{A: 2.839e-08, B: 4.22662576295, C: 990.2294059144},
+		{A: 3.432e-08, B: 2.8048316223, C: 764.1876891831},
+		{A: 2.733e-08, B: 0.42373696972, C: 354.9979860464},
+		{A: 3.146e-08, B: 5.19208910201, C: 417.0369633204},
+		{A: 3.041e-08, B: 5.75641149588, C: 409.9234163196},
+		{A: 3.379e-08, B: 5.47448876584, C: 1396.2206689709},
+		{A: 3.102e-08, B: 0.4168444478, C: 521.0929453855},
+		{A: 2.863e-08, B: 0.41519700992, C: 894.8408795276},
+		{A: 2.707e-08, B: 3.60084311477, C: 621.7380390493},
+		{A: 3.128e-08, B: 5.23384180625, C: 424.1505103212},
+		{A: 3.107e-08, B: 2.44919355737, C: 4.665866446},
+		{A: 2.683e-08, B: 3.88682711832, C: 133.1008708993},
+		{A: 2.66e-08, B: 4.78670985324, C: 362.8622925726},
+		{A: 3.2e-08, B: 1.88004939357, C: 331.2096644892},
+		{A: 2.73e-08, B: 4.12217979791, C: 600.5404103988},
+		{A: 3.414e-08, B: 4.93712749827, C: 1140.38330388},
+		{A: 2.653e-08, B: 5.10283251074, C: 118.0224436358},
+		{A: 3.222e-08, B: 4.76521772319, C: 294.6729761443},
+		{A: 3.289e-08, B: 4.26401031509, C: 544.5087599272},
+		{A: 3.1e-08, B: 5.4792852793, C: 701.1855040626},
+		{A: 2.785e-08, B: 5.19343849039, C: 144.8973306886},
+		{A: 2.607e-08, B: 4.72531286187, C: 122.4758617607},
+		{A: 2.581e-08, B: 6.27329466695, C: 908.3342603463},
+		{A: 3.285e-08, B: 1.9597262267, C: 372.4235201282},
+		{A: 2.897e-08, B: 0.3737809018, C: 582.3811631341},
+		{A: 2.615e-08, B: 2.25516923974, C: 74.9940468884},
+		{A: 3.582e-08, B: 1.27992264402, C: 987.5692770385},
+		{A: 3.115e-08, B: 5.10929689813, C: 459.0539681115},
+		{A: 2.857e-08, B: 5.90256930211, C: 525.2375469697},
+		{A: 2.589e-08, B: 1.83177157032, C: 657.1627617014},
+		{A: 2.539e-08, B: 4.14968938109, C: 74.7334144575},
+		{A: 2.797e-08, B: 2.82242772664, C: 2036.8687148103},
+		{A: 2.688e-08, B: 2.16500211397, C: 262.8078997398},
+		{A: 2.744e-08, B: 1.54445470732, C: 28.5718080822},
+		{A: 2.539e-08, B: 0.46036497385, C: 74.8297826771},
+		{A: 3.322e-08, B: 3.50108539407, C: 82.6459050935},
+		{A: 2.81e-08, B: 6.06709915335, C: 374.4986936675},
+		{A: 2.504e-08, B: 3.523948017, C: 1183.6723330583},
+		{A: 2.565e-08, B: 1.64023845161, C: 73.4090004436},
+		{A: 2.531e-08, B: 3.50486296784, C: 293.188503436},
+		{A: 2.663e-08, B: 4.23321349902, C: 421.1815649046},
+		{A: 2.793e-08, B: 2.00644423849, C: 75.0422309982},
+		{A: 2.43e-08, B: 1.56119387576, C: 136.0698163159},
+		{A: 2.553e-08, B: 1.25909246207, C: 670.4960838257},
+		{A: 2.604e-08, B: 3.87350462519, C: 74.0308390419},
+		{A: 2.51e-08, B: 3.35948960782, C: 464.9918589447},
+		{A: 3.005e-08, B: 0.81031349171, C: 73.88782669},
+		{A: 3.11e-08, B: 6.14956891318, C: 118.8737768977},
+		{A: 3.234e-08, B: 2.45751141361, C: 98.3574718034},
+		{A: 2.774e-08, B: 6.26134027482, C: 1022.3126761344},
+		{A: 2.402e-08, B: 4.38353347008, C: 1303.2798231389},
+		{A: 3.296e-08, B: 3.84350963765, C: 511.5317178299},
+		{A: 2.8e-08, B: 2.60339313269, C: 74.5209661364},
+		{A: 3.005e-08, B: 0.76247280223, C: 75.6753704446},
+		{A: 2.434e-08, B: 4.9478467943, C: 969.6224780949},
+		{A: 2.632e-08, B: 0.635571102, C: 227.5261894396},
+		{A: 2.669e-08, B: 0.7334022821, C: 73.0846775379},
+		{A: 2.465e-08, B: 1.3064877338, C: 77.0692204277},
+		{A: 3.237e-08, B: 3.19110274211, C: 1887.3055176757},
+		{A: 2.395e-08, B: 2.76580569447, C: 768.8535556291},
+		{A: 3.23e-08, B: 0.01981320255, C: 881.5075574033},
+		{A: 2.747e-08, B: 5.59085990261, C: 388.7257876691},
+		{A: 3.008e-08, B: 5.6595546366, C: 1969.2006632438},
+		{A: 3.008e-08, B: 0.91409756228, C: 2118.7638603784},
+		{A: 2.465e-08, B: 0.26629856014, C: 72.4939767069},
+		{A: 2.629e-08, B: 4.00618677646, C: 26.0235537909},
+		{A: 2.272e-08, B: 2.77069357315, C: 515.463871093},
+		{A: 2.36e-08, B: 4.12736987374, C: 74.6215398729},
+		{A: 2.255e-08, B: 3.3657444395, C: 286.596221297},
+		{A: 2.211e-08, B: 5.18239546182, C: 59.2824801785},
+		{A: 2.994e-08, B: 2.83179016989, C: 184.9879197867},
+		{A: 2.492e-08, B: 1.19872353228, C: 383.0967133766},
+		{A: 2.355e-08, B: 0.48259604722, C: 74.9416572617},
+		{A: 2.185e-08, B: 6.0799711998, C: 63.6240237188},
+		{A: 2.228e-08, B: 1.4245214891, C: 6219.339951688},
+	},
+	// R2, 192 terms
+	{
+		{A: 0.00022439904, B: 0.6995311876, C: 74.7815985673},
+		{A: 4.727037e-05, B: 1.69901641488, C: 63.7358983034},
+		{A: 1.681903e-05, B: 4.64833551727, C: 70.8494453042},
+		{A: 1.433755e-05, B: 3.52119917947, C: 149.5631971346},
+		{A: 1.649559e-05, B: 3.0966007898, C: 11.0457002639},
+		{A: 7.70188e-06, B: 0, C: 0},
+		{A: 4.61009e-06, B: 0.76676632849, C: 3.9321532631},
+		{A: 5.00429e-06, B: 6.17229032223, C: 76.2660712756},
+		{A: 3.90371e-06, B: 4.49605283502, C: 56.6223513026},
+		{A: 3.89945e-06, B: 5.52673426377, C: 85.8272988312},
+		{A: 2.92097e-06, B: 0.20389012095, C: 52.6901980395},
+		{A: 2.72898e-06, B: 3.84707823651, C: 138.5174968707},
+		{A: 2.86579e-06, B: 3.5335768327, C: 73.297125859},
+		{A: 2.05449e-06, B: 3.24758017121, C: 78.7137518304},
+		{A: 2.19674e-06, B: 1.96418942891, C: 131.4039498699},
+		{A: 2.15788e-06, B: 0.84812474187, C: 77.962992305},
+		{A: 1.28834e-06, B: 2.08146849515, C: 3.1813937377},
+		{A: 1.48554e-06, B: 4.89840863841, C: 127.4717966068},
+		{A: 1.17452e-06, B: 4.93414907433, C: 447.7958195265},
+		{A: 1.1269e-06, B: 1.01361852218, C: 462.0229135281},
+		{A: 9.8875e-07, B: 6.15817742611, C: 224.3447957019},
+		{A: 9.1379e-07, B: 0.67973399531, C: 18.1592472647},
+		{A: 8.9217e-07, B: 0.23425778826, C: 202.2533951741},
+		{A: 8.8206e-07, B: 2.93094837724, C: 62.2514255951},
+		{A: 1.14066e-06, B: 4.7874187396, C: 145.6310438715},
+		{A: 1.03858e-06, B: 3.58561789629, C: 71.6002048296},
+		{A: 6.1819e-07, B: 3.29964272893, C: 351.8165923087},
+		{A: 5.7782e-07, B: 4.90737420887, C: 22.0914005278},
+		{A: 6.4369e-07, B: 3.39006689398, C: 1.4844727083},
+		{A: 7.111e-07, B: 6.10490061068, C: 454.9093665273},
+		{A: 5.099e-07, B: 3.86691997779, C: 65.2203710117},
+		{A: 6.3537e-07, B: 3.96202309168, C: 67.6680515665},
+		{A: 5.8957e-07, B: 5.55530463687, C: 9.5612275556},
+		{A: 4.87e-07, B: 3.74709235789, C: 269.9214467406},
+		{A: 4.3584e-07, B: 1.92568752002, C: 59.8037450403},
+		{A: 4.217e-07, B: 2.61650997054, C: 151.0476698429},
+		{A: 4.242e-07, B: 6.13634453301, C: 284.1485407422},
+		{A: 4.434e-07, B: 5.89997845114, C: 71.8126531507},
+		{A: 3.7328e-07, B: 5.91300114911, C: 984.6003316219},
+		{A: 3.6201e-07, B: 5.40315761474, C: 77.7505439839},
+		{A: 4.1989e-07, B: 2.09071623849, C: 12.5301729722},
+		{A: 3.1411e-07, B: 4.59200004835, C: 148.0787244263},
+		{A: 3.1289e-07, B: 2.26696307388, C: 195.1398481733},
+		{A: 2.715e-07, B: 3.53242984046, C: 209.3669421749},
+		{A: 2.8152e-07, B: 4.57845964163, C: 77.2292791221},
+		{A: 2.6097e-07, B: 0.65978256272, C: 120.358249606},
+		{A: 2.4372e-07, B: 5.86680440531, C: 69.3649725959},
+		{A: 2.3037e-07, B: 1.03776963677, C: 84.3428261229},
+		{A: 2.2679e-07, B: 1.7143424397, C: 160.6088973985},
+		{A: 2.765e-07, B: 4.91488946525, C: 277.0349937414},
+		{A: 2.0816e-07, B: 2.19643268155, C: 45.5766510387},
+		{A: 1.9961e-07, B: 2.3207735618, C: 2.4476805548},
+		{A: 1.6584e-07, B: 4.77529536873, C: 213.299095438},
+		{A: 1.6578e-07, B: 1.85615182154, C: 340.7708920448},
+		{A: 1.7196e-07, B: 4.36852462522, C: 54.1746707478},
+		{A: 1.6053e-07, B: 3.64619586667, C: 152.7445908723},
+		{A: 1.4806e-07, B: 5.43824503068, C: 408.4389436113},
+		{A: 1.3872e-07, B: 3.38531100784, C: 358.9301393095},
+		{A: 1.3328e-07, B: 5.25179190495, C: 137.0330241624},
+		{A: 1.3286e-07, B: 1.26285812368, C: 134.5853436076},
+		{A: 1.289e-07, B: 3.03270380745, C: 92.940845832},
+		{A: 1.2467e-07, B: 1.33213558369, C: 51.2057253312},
+		{A: 1.345e-07, B: 1.53176996919, C: 422.6660376129},
+		{A: 1.6442e-07, B: 0.40190549188, C: 265.9892934775},
+		{A: 1.1996e-07, B: 5.10426418352, C: 191.2076949102},
+		{A: 1.2898e-07, B: 4.43242192513, C: 87.3117715395},
+		{A: 1.1449e-07, B: 2.02645622099, C: 7.1135470008},
+		{A: 1.1826e-07, B: 4.65645290272, C: 41.6444977756},
+		{A: 1.2045e-07, B: 3.23910807852, C: 116.4260963429},
+		{A: 1.168e-07, B: 3.73278249629, C: 220.4126424388},
+		{A: 1.1573e-07, B: 4.16500659139, C: 60.5545045657},
+		{A: 1.0175e-07, B: 0.32936886913, C: 70.3281804424},
+		{A: 1.1332e-07, B: 1.07613885149, C: 72.3339180125},
+		{A: 9.655e-08, B: 3.05950236129, C: 2.9689454166},
+		{A: 9.279e-08, B: 2.43997351068, C: 565.1156877467},
+		{A: 8.986e-08, B: 5.18839740735, C: 225.8292684102},
+		{A: 1.0284e-07, B: 1.1860258206, C: 344.7030453079},
+		{A: 8.844e-08, B: 6.00894470528, C: 5.4166259714},
+		{A: 8.508e-08, B: 5.24741470216, C: 347.8844390456},
+		{A: 8.319e-08, B: 3.71723808749, C: 14.977853527},
+		{A: 8.276e-08, B: 2.27408171672, C: 299.1263942692},
+		{A: 8.064e-08, B: 5.71681525179, C: 55.1378785943},
+		{A: 7.83e-08, B: 0.90313686798, C: 222.8603229936},
+		{A: 8.335e-08, B: 4.48600419464, C: 70.1157321213},
+		{A: 8.763e-08, B: 5.8151944012, C: 153.4953503977},
+		{A: 8.472e-08, B: 3.91387041805, C: 333.657345044},
+		{A: 9.874e-08, B: 5.9652614366, C: 35.1640902212},
+		{A: 9.647e-08, B: 0.38872626737, C: 415.5524906121},
+		{A: 7.106e-08, B: 1.5059848847, C: 991.7138786227},
+		{A: 6.596e-08, B: 1.18068235818, C: 96.8729990951},
+		{A: 8.065e-08, B: 2.25930653257, C: 206.1855484372},
+		{A: 6.479e-08, B: 2.99461362786, C: 380.12776796},
+		{A: 9.012e-08, B: 6.0534362253, C: 146.3818033969},
+		{A: 6.131e-08, B: 0.05596259493, C: 99.1606209555},
+		{A: 5.799e-08, B: 0.82465326137, C: 142.4496501338},
+		{A: 5.816e-08, B: 4.63029217647, C: 49.5088043018},
+		{A: 5.608e-08, B: 0.66268449799, C: 58.1068240109},
+		{A: 5.966e-08, B: 2.48916255408, C: 373.0142209592},
+		{A: 5.71e-08, B: 2.23566160404, C: 80.1982245387},
+		{A: 5.272e-08, B: 5.06746739956, C: 440.6822725257},
+		{A: 5.162e-08, B: 4.36457872885, C: 977.4867846211},
+		{A: 5.428e-08, B: 0.85181859845, C: 546.956440482},
+		{A: 5.766e-08, B: 0.34229025692, C: 536.8045120954},
+		{A: 5.924e-08, B: 5.48443563529, C: 76.4785195967},
+		{A: 5.34e-08, B: 3.730731164, C: 23.5758732361},
+		{A: 5.174e-08, B: 4.13873402677, C: 132.8884225782},
+		{A: 5.31e-08, B: 6.14059082194, C: 39.6175083461},
+		{A: 5.79e-08, B: 3.39593613152, C: 458.090760265},
+		{A: 5.007e-08, B: 4.25821412289, C: 522.5774180938},
+		{A: 4.967e-08, B: 4.79184817938, C: 387.2413149608},
+		{A: 5.183e-08, B: 3.25775152471, C: 561.1835344836},
+		{A: 4.602e-08, B: 1.69262282455, C: 152.5321425512},
+		{A: 5.302e-08, B: 1.83522660093, C: 124.2904028691},
+		{A: 5.005e-08, B: 0.3663056595, C: 60.7669528868},
+		{A: 4.454e-08, B: 2.30288945184, C: 312.4597163935},
+		{A: 4.457e-08, B: 0.45775730382, C: 33.1371007917},
+		{A: 5.722e-08, B: 0.89523844278, C: 81.8951455681},
+		{A: 5.842e-08, B: 0.92039543147, C: 20.6069278195},
+		{A: 5.743e-08, B: 0.66226484448, C: 38.1330356378},
+		{A: 4.255e-08, B: 3.55373860346, C: 479.2883889155},
+		{A: 4.19e-08, B: 4.37674804409, C: 79.2350166922},
+		{A: 4.194e-08, B: 1.6498626717, C: 128.9562693151},
+		{A: 5.125e-08, B: 1.40553011416, C: 144.1465711632},
+		{A: 4.045e-08, B: 6.07362424798, C: 19.643719973},
+		{A: 3.984e-08, B: 5.7717840641, C: 288.0806940053},
+		{A: 5.017e-08, B: 2.99521887648, C: 29.2049475286},
+		{A: 3.842e-08, B: 2.60024827897, C: 426.598190876},
+		{A: 3.861e-08, B: 3.19886211335, C: 159.1244246902},
+		{A: 3.87e-08, B: 4.43713601497, C: 141.6988906084},
+		{A: 5.316e-08, B: 4.07970979457, C: 111.4301614968},
+		{A: 4.553e-08, B: 0.01384318412, C: 298.2326223919},
+		{A: 3.737e-08, B: 5.28319518103, C: 353.301065017},
+		{A: 3.939e-08, B: 5.27301148162, C: 521.0929453855},
+		{A: 3.71e-08, B: 5.15385470848, C: 490.3340891794},
+		{A: 4.039e-08, B: 0.60924359087, C: 152.0108776894},
+		{A: 3.861e-08, B: 1.343943837, C: 535.3200393871},
+		{A: 4.385e-08, B: 0.620576801, C: 827.1728279611},
+		{A: 3.567e-08, B: 4.71986443303, C: 6.9010986797},
+		{A: 3.576e-08, B: 3.24526237368, C: 230.5645708254},
+		{A: 3.469e-08, B: 0.79054323335, C: 983.1158589136},
+		{A: 4.524e-08, B: 2.86819565712, C: 129.9194771616},
+		{A: 3.648e-08, B: 5.59395544992, C: 774.4826299216},
+		{A: 3.513e-08, B: 4.49630054276, C: 376.1956146969},
+		{A: 3.432e-08, B: 2.55614913808, C: 258.8757464767},
+		{A: 4.352e-08, B: 2.09804374929, C: 404.5067903482},
+		{A: 3.336e-08, B: 0.89628904042, C: 469.1364605289},
+		{A: 3.274e-08, B: 3.86236880159, C: 42.5382696529},
+		{A: 3.201e-08, B: 2.76459652868, C: 248.7238180901},
+		{A: 3.184e-08, B: 0.07709843451, C: 1514.2912967165},
+		{A: 3.783e-08, B: 5.29835962126, C: 369.0820676961},
+		{A: 3.266e-08, B: 2.24754480216, C: 73.8183907208},
+		{A: 3.055e-08, B: 2.60120354415, C: 433.7117378768},
+		{A: 3.051e-08, B: 4.54953369151, C: 980.6681783588},
+		{A: 3.062e-08, B: 1.27089879603, C: 200.7689224658},
+		{A: 3.055e-08, B: 1.70878161343, C: 639.897286314},
+		{A: 3.11e-08, B: 3.63187411723, C: 16.6747745564},
+		{A: 3.472e-08, B: 4.93521260607, C: 411.620337349},
+		{A: 3.531e-08, B: 4.49372794858, C: 881.5075574033},
+		{A: 3.284e-08, B: 5.59170577331, C: 472.1748419147},
+		{A: 3.015e-08, B: 6.02967446446, C: 291.262087743},
+		{A: 3.467e-08, B: 2.17484439267, C: 554.0699874828},
+		{A: 3.138e-08, B: 0.52367930477, C: 1094.8066528413},
+		{A: 3.257e-08, B: 2.49339546514, C: 451.7279727896},
+		{A: 2.881e-08, B: 0.50481204892, C: 305.3461693927},
+		{A: 3.082e-08, B: 4.20145474081, C: 146.594251718},
+		{A: 2.883e-08, B: 2.44983947531, C: 135.336103133},
+		{A: 2.965e-08, B: 0.3929499553, C: 25.2727942655},
+		{A: 2.831e-08, B: 2.52728803131, C: 867.2804634017},
+		{A: 2.728e-08, B: 5.29491477549, C: 125.9873238985},
+		{A: 2.857e-08, B: 4.71106805785, C: 218.9281697305},
+		{A: 2.763e-08, B: 4.27510031656, C: 350.3321196004},
+		{A: 2.73e-08, B: 1.98552777251, C: 82.8583534146},
+		{A: 2.857e-08, B: 3.08706426922, C: 216.4804891757},
+		{A: 3.365e-08, B: 3.67691210011, C: 661.0949149645},
+		{A: 2.925e-08, B: 1.43646759644, C: 381.6122406683},
+		{A: 2.753e-08, B: 0.39468041761, C: 33.6796175129},
+		{A: 2.756e-08, B: 4.6267249884, C: 1357.6145525811},
+		{A: 3.45e-08, B: 2.12911756067, C: 685.4739373527},
+		{A: 2.571e-08, B: 5.92862393284, C: 89.7594520943},
+		{A: 2.677e-08, B: 0.76342313946, C: 486.4019359163},
+		{A: 2.689e-08, B: 4.16436463826, C: 235.3904959658},
+		{A: 2.646e-08, B: 3.81808560938, C: 550.8885937451},
+		{A: 3.369e-08, B: 3.17071565345, C: 108.4612160802},
+		{A: 2.613e-08, B: 5.68333838067, C: 24.3790223882},
+		{A: 2.736e-08, B: 1.87107584495, C: 529.6909650946},
+		{A: 2.606e-08, B: 4.36605237304, C: 1080.5795588397},
+		{A: 2.407e-08, B: 3.07343136742, C: 391.1734682239},
+		{A: 2.446e-08, B: 5.7384638154, C: 535.9107402181},
+		{A: 2.334e-08, B: 5.18878243102, C: 1059.3819301892},
+		{A: 2.568e-08, B: 1.09886876369, C: 913.0001267923},
+		{A: 2.236e-08, B: 6.10115874045, C: 140.001969579},
+		{A: 3.053e-08, B: 5.35047433775, C: 681.5417840896},
+	},
+	// R3, 55 terms
+	{
+		{A: 1.164382e-05, B: 4.73453291602, C: 74.7815985673},
+		{A: 2.12367e-06, B: 3.34255734999, C: 63.7358983034},
+		{A: 1.96408e-06, B: 2.98004616318, C: 70.8494453042},
+		{A: 1.04527e-06, B: 0.95807937648, C: 11.0457002639},
+		{A: 7.1681e-07, B: 0.02528455665, C: 56.6223513026},
+		{A: 7.254e-07, B: 0.99701907912, C: 149.5631971346},
+		{A: 5.4875e-07, B: 2.59436811267, C: 3.9321532631},
+		{A: 3.4029e-07, B: 3.81553325635, C: 76.2660712756},
+		{A: 3.2081e-07, B: 3.5982517784, C: 131.4039498699},
+		{A: 2.9641e-07, B: 3.44111535957, C: 85.8272988312},
+		{A: 3.6377e-07, B: 5.65035573017, C: 77.962992305},
+		{A: 2.7663e-07, B: 0.4283600147, C: 3.1813937377},
+		{A: 2.7464e-07, B: 2.55126467481, C: 52.6901980395},
+		{A: 2.4569e-07, B: 5.14034173566, C: 78.7137518304},
+		{A: 1.939e-07, B: 5.13477648625, C: 18.1592472647},
+		{A: 1.5767e-07, B: 0.37116951743, C: 447.7958195265},
+		{A: 1.5441e-07, B: 5.57271837433, C: 462.0229135281},
+		{A: 1.5232e-07, B: 3.85998573509, C: 73.297125859},
+		{A: 1.5475e-07, B: 2.97496547327, C: 145.6310438715},
+		{A: 1.7951e-07, B: 0, C: 0},
+		{A: 1.5958e-07, B: 5.19915553904, C: 71.6002048296},
+		{A: 1.1056e-07, B: 6.03152659562, C: 138.5174968707},
+		{A: 1.0529e-07, B: 3.58261852497, C: 224.3447957019},
+		{A: 7.606e-08, B: 1.44542030704, C: 1.4844727083},
+		{A: 8.121e-08, B: 2.61579604319, C: 22.0914005278},
+		{A: 7.107e-08, B: 5.43946774526, C: 269.9214467406},
+		{A: 6.459e-08, B: 4.37142319461, C: 284.1485407422},
+		{A: 6.818e-08, B: 0.01396812984, C: 151.0476698429},
+		{A: 8.101e-08, B: 0.29563819537, C: 127.4717966068},
+		{A: 5.768e-08, B: 4.22672716593, C: 373.0142209592},
+		{A: 5.022e-08, B: 1.84154937974, C: 202.2533951741},
+		{A: 4.692e-08, B: 2.7840457544, C: 120.358249606},
+		{A: 5.087e-08, B: 0.77745294804, C: 62.2514255951},
+		{A: 4.16e-08, B: 1.83820502779, C: 72.3339180125},
+		{A: 3.922e-08, B: 1.88900691473, C: 209.3669421749},
+		{A: 5.201e-08, B: 4.15791319343, C: 195.1398481733},
+		{A: 3.636e-08, B: 1.99709010456, C: 65.2203710117},
+		{A: 3.582e-08, B: 3.92592140377, C: 124.2904028691},
+		{A: 3.808e-08, B: 1.04818660873, C: 92.940845832},
+		{A: 4.241e-08, B: 3.95755998904, C: 9.5612275556},
+		{A: 3.497e-08, B: 1.54139696251, C: 148.0787244263},
+		{A: 3.195e-08, B: 2.98608971329, C: 387.2413149608},
+		{A: 3.95e-08, B: 1.85721204932, C: 152.7445908723},
+		{A: 3.277e-08, B: 1.40881404192, C: 351.8165923087},
+		{A: 3.605e-08, B: 1.17366167402, C: 153.4953503977},
+		{A: 2.94e-08, B: 6.03594958459, C: 12.5301729722},
+		{A: 2.744e-08, B: 5.64674283515, C: 134.5853436076},
+		{A: 2.8e-08, B: 0.79480255927, C: 572.2292347475},
+		{A: 3.054e-08, B: 5.84310939105, C: 160.6088973985},
+		{A: 2.662e-08, B: 1.98593312104, C: 450.9772132642},
+		{A: 2.7e-08, B: 2.77036653988, C: 213.299095438},
+		{A: 2.323e-08, B: 1.67918985468, C: 358.9301393095},
+		{A: 2.254e-08, B: 5.77129530133, C: 84.3428261229},
+		{A: 2.291e-08, B: 4.814246016, C: 536.8045120954},
+		{A: 2.213e-08, B: 2.20360299816, C: 465.9550667912},
+	},
+	// R4, 11 terms
+	{
+		{A: 5.2996e-07, B: 3.00838033088, C: 74.7815985673},
+		{A: 9.887e-08, B: 1.91399083603, C: 56.6223513026},
+		{A: 7.008e-08, B: 5.08677527404, C: 11.0457002639},
+		{A: 6.728e-08, B: 5.42924958121, C: 149.5631971346},
+		{A: 3.703e-08, B: 5.22728581851, C: 131.4039498699},
+		{A: 3.361e-08, B: 1.29695290266, C: 85.8272988312},
+		{A: 2.664e-08, B: 0.44064577837, C: 63.7358983034},
+		{A: 2.309e-08, B: 0.92380720934, C: 145.6310438715},
+		{A: 2.383e-08, B: 6.21390585593, C: 358.9301393095},
+		{A: 2.288e-08, B: 2.23425399117, C: 440.6822725257},
+		{A: 3.093e-08, B: 3.14159265359, C: 0},
+	},
+}
+
+// GetUranusR0 evaluates the t^0 series of R.
+func GetUranusR0(t float64) float64 {
+	return uranusR[0].Evaluate(t)
+}
+
+// GetUranusR1 evaluates the t^1 series of R.
+func GetUranusR1(t float64) float64 {
+	return uranusR[1].Evaluate(t)
+}
+
+// GetUranusR2 evaluates the t^2 series of R.
+func GetUranusR2(t float64) float64 {
+	return uranusR[2].Evaluate(t)
+}
+
+// GetUranusR3 evaluates the t^3 series of R.
+func GetUranusR3(t float64) float64 {
+	return uranusR[3].Evaluate(t)
+}
+
+// GetUranusR4 evaluates the t^4 series of R.
+func GetUranusR4(t float64) float64 {
+	return uranusR[4].Evaluate(t)
+}
@@ -0,0 +1,63 @@
+package vsop87venusd
+
+import (
+    "calendarutil"
+    "mathutil"
+)
+
+/**
+ * 按儒略日计算金星的日心黄经
+ *
+ * @param jd
+ *            儒略日
+ * @return 金星的日心黄经，单位是弧度(rad)
+ */
+func GetSunEclipticLongitudeForVenus(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    L0 := GetVenusL0(t)
+    L1 := GetVenusL1(t)
+    L2 := GetVenusL2(t)
+    L3 := GetVenusL3(t)
+    L4 := GetVenusL4(t)
+    L5 := GetVenusL5(t)
+    L := ((((L5 * t + L4) * t + L3) * t + L2) * t + L1) * t + L0
+    return mathutil.Mod2Pi(L)
+}
+
+/**
+ * 按儒略日计算金星的日心黄纬
+ *
+ * @param jd
+ *            儒略日
+ * @return 金星的日心黄纬，单位是弧度(rad)
+ */
+func GetSunEclipticLatitudeForVenus(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    B0 := GetVenusB0(t)
+    B1 := GetVenusB1(t)
+    B2 := GetVenusB2(t)
+    B3 := GetVenusB3(t)
+    B4 := GetVenusB4(t)
+    B5 := GetVenusB5(t)
+    B := ((((B5 * t + B4) * t + B3) * t + B2) * t + B1) * t + B0
+    return B
+}
+
+/**
+ * 按照儒略日计算金星和太阳的距离
+ *
+ * @param jd
+ *            儒略日
+ * @return 金星和太阳的距离，单位是天文单位(au)
+ */
+func GetSunRadiusForVenus(jd float64) float64 {
+    t := calendarutil.GetJulianThousandYears(jd)
+    R0 := GetVenusR0(t)
+    R1 := GetVenusR1(t)
+    R2 := GetVenusR2(t)
+    R3 := GetVenusR3(t)
+    R4 := GetVenusR4(t)
+    R5 := GetVenusR5(t)
+    R := ((((R5 * t + R4) * t + R3) * t + R2) * t + R1) * t + R0
+    return R
+}
@@ -0,0 +1,3 @@
+package vsop87venusd
+
+//go:generate go run ../vsop87gen -input ../../vsop87d.txt -planet Venus -package vsop87venusd
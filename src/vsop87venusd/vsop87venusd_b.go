@@ -0,0 +1,485 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// VENUS B - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87venusd
+
+import "vsop87"
+
+var venusB = []vsop87.Series{
+	// B0, 210 terms
+	{
+		{A: 0.05923638472, B: 0.26702775813, C: 10213.285546211},
+		{A: 0.00040107978, B: 1.14737178106, C: 20426.571092422},
+		{A: 0.00032814918, B: 3.14159265359, C: 0},
+		{A: 1.011392e-05, B: 1.08946123021, C: 30639.856638633},
+		{A: 1.49458e-06, B: 6.25390296069, C: 18073.7049386502},
+		{A: 1.37788e-06, B: 0.86020146523, C: 1577.3435424478},
+		{A: 1.29973e-06, B: 3.67152483651, C: 9437.762934887},
+		{A: 1.19507e-06, B: 3.70468812804, C: 2352.8661537718},
+		{A: 1.07971e-06, B: 4.53903677647, C: 22003.9146348698},
+		{A: 9.2029e-07, B: 1.53954562706, C: 9153.9036160218},
+		{A: 5.2982e-07, B: 2.28138172277, C: 5507.5532386674},
+		{A: 4.5617e-07, B: 0.72319641722, C: 10239.5838660108},
+		{A: 3.8855e-07, B: 2.93437865147, C: 10186.9872264112},
+		{A: 4.3491e-07, B: 6.14015776699, C: 11790.6290886588},
+		{A: 4.17e-07, B: 5.99126845246, C: 19896.8801273274},
+		{A: 3.9644e-07, B: 3.86842095901, C: 8635.9420037632},
+		{A: 3.9175e-07, B: 3.94960351174, C: 529.6909650946},
+		{A: 3.332e-07, B: 4.83194909595, C: 14143.4952424306},
+		{A: 2.3711e-07, B: 2.90646621218, C: 10988.808157535},
+		{A: 2.35e-07, B: 2.00770618322, C: 13367.9726311066},
+		{A: 2.1809e-07, B: 2.69701424951, C: 19651.048481098},
+		{A: 2.0653e-07, B: 0.98666685459, C: 775.522611324},
+		{A: 1.6976e-07, B: 4.13711782135, C: 10021.8372800994},
+		{A: 1.7835e-07, B: 5.96268643102, C: 25934.1243310894},
+		{A: 1.4949e-07, B: 5.61075168206, C: 10404.7338123226},
+		{A: 1.8579e-07, B: 1.80529277514, C: 40853.142184844},
+		{A: 1.5407e-07, B: 3.29563855296, C: 11015.1064773348},
+		{A: 1.2936e-07, B: 5.42651448496, C: 29580.4747084438},
+		{A: 1.1962e-07, B: 3.57604253827, C: 10742.9765113056},
+		{A: 1.1827e-07, B: 1.190709196, C: 8624.2126509272},
+		{A: 1.1466e-07, B: 5.12780364967, C: 6283.0758499914},
+		{A: 9.484e-08, B: 2.75167834335, C: 191.4482661116},
+		{A: 1.3129e-07, B: 5.70735942511, C: 9683.5945811164},
+		{A: 8.583e-08, B: 0.43182249199, C: 9786.687355335},
+		{A: 9.763e-08, B: 0.14614896296, C: 20618.0193585336},
+		{A: 8.148e-08, B: 1.30548515603, C: 15720.8387848784},
+		{A: 6.05e-08, B: 6.26541665966, C: 11322.6640983044},
+		{A: 5.955e-08, B: 4.92235372433, C: 1059.3819301892},
+		{A: 6.983e-08, B: 3.44920932146, C: 17298.1823273262},
+		{A: 6.228e-08, B: 1.13312070908, C: 29864.334027309},
+		{A: 6.186e-08, B: 4.92498052443, C: 19367.1891622328},
+		{A: 6.155e-08, B: 2.424139469, C: 4705.7323075436},
+		{A: 5.204e-08, B: 3.42528906628, C: 9103.9069941176},
+		{A: 6e-08, B: 3.57639095526, C: 3154.6870848956},
+		{A: 4.796e-08, B: 3.86676184909, C: 7860.4193924392},
+		{A: 5.289e-08, B: 4.99182712443, C: 7084.8967811152},
+		{A: 4.07e-08, B: 5.5879814474, C: 12566.1516999828},
+		{A: 3.942e-08, B: 5.68758787835, C: 10206.1719992102},
+		{A: 3.797e-08, B: 3.89520601076, C: 10192.5101507186},
+		{A: 3.798e-08, B: 6.06410995916, C: 10234.0609417034},
+		{A: 3.579e-08, B: 0.73789669235, C: 4551.9534970588},
+		{A: 3.641e-08, B: 2.61501257205, C: 15874.6175953632},
+		{A: 3.266e-08, B: 0.97517223854, C: 23581.2581773176},
+		{A: 2.813e-08, B: 0.29951755546, C: 9411.4646150872},
+		{A: 3.048e-08, B: 2.5108514699, C: 33794.5437235286},
+		{A: 2.559e-08, B: 4.58043833032, C: 801.8209311238},
+		{A: 2.462e-08, B: 5.05790874754, C: 29050.7837433492},
+		{A: 2.593e-08, B: 5.73113176751, C: 20213.271996984},
+		{A: 2.625e-08, B: 4.24272906574, C: 213.299095438},
+		{A: 2.246e-08, B: 0.82112963936, C: 28286.9904848612},
+		{A: 2.229e-08, B: 2.22457598233, C: 10426.584641649},
+		{A: 1.742e-08, B: 1.48394229233, C: 7058.5984613154},
+		{A: 1.66e-08, B: 5.42775825275, C: 32217.2001810808},
+		{A: 1.491e-08, B: 4.64883377941, C: 1109.3785520934},
+		{A: 2.01e-08, B: 0.75702888128, C: 9999.986450773},
+		{A: 1.562e-08, B: 3.93962080463, C: 37724.7534197482},
+		{A: 1.538e-08, B: 2.1730957708, C: 21535.9496445154},
+		{A: 1.546e-08, B: 4.70759186462, C: 14945.3161735544},
+		{A: 1.2e-08, B: 1.48282382657, C: 9830.3890139878},
+		{A: 1.224e-08, B: 5.55090394449, C: 5661.3320491522},
+		{A: 1.111e-08, B: 1.20276209213, C: 9573.388259897},
+		{A: 1.064e-08, B: 1.98891375536, C: 26.2983197998},
+		{A: 1.041e-08, B: 5.38535116069, C: 7.1135470008},
+		{A: 1.036e-08, B: 1.16719443387, C: 8662.240323563},
+		{A: 1.143e-08, B: 3.20596958337, C: 3532.0606928114},
+		{A: 1.201e-08, B: 0.81913312536, C: 8094.5216858326},
+		{A: 1.005e-08, B: 2.38429892132, C: 27511.4678735372},
+		{A: 1.047e-08, B: 4.56525030769, C: 20419.45754542119},
+		{A: 9.68e-09, B: 6.18496721871, C: 25158.6017197654},
+		{A: 1.044e-08, B: 1.98055689074, C: 10596.1820784342},
+		{A: 9.62e-09, B: 0.48573513747, C: 23958.6317852334},
+		{A: 8.46e-09, B: 0.01566400887, C: 3128.3887650958},
+		{A: 7.92e-09, B: 5.39686899735, C: 24356.7807886416},
+		{A: 8.58e-09, B: 5.34692750735, C: 41654.9631159678},
+		{A: 7.57e-09, B: 6.25904553773, C: 20452.8694122218},
+		{A: 8.01e-09, B: 4.62406152514, C: 9929.4262273458},
+		{A: 8.02e-09, B: 5.3723489252, C: 10497.1448650762},
+		{A: 7.5e-09, B: 3.85219782842, C: 21228.3920235458},
+		{A: 7e-09, B: 1.98097957188, C: 3930.2096962196},
+		{A: 7.19e-09, B: 6.11596800207, C: 10218.8084705184},
+		{A: 6.72e-09, B: 6.23429601219, C: 14765.2390432698},
+		{A: 6.39e-09, B: 5.37566437358, C: 1589.0728952838},
+		{A: 6.05e-09, B: 2.4233039112, C: 10251.3132188468},
+		{A: 7.26e-09, B: 6.16683781802, C: 18875.525869774},
+		{A: 6.13e-09, B: 5.9973118069, C: 4732.0306273434},
+		{A: 7.2e-09, B: 3.84286345199, C: 10207.7626219036},
+		{A: 6.37e-09, B: 6.17053891156, C: 10220.3990932118},
+		{A: 5.15e-09, B: 1.03001478293, C: 22779.4372461938},
+		{A: 5.74e-09, B: 0.43813688572, C: 17085.9586657222},
+		{A: 5.1e-09, B: 1.41065159851, C: 9161.0171630226},
+		{A: 5.69e-09, B: 3.34601425125, C: 3340.6124266998},
+		{A: 6.08e-09, B: 1.25236241968, C: 10175.2578735752},
+		{A: 5.24e-09, B: 2.3979424867, C: 26087.9031415742},
+		{A: 5.42e-09, B: 1.34665646732, C: 29088.811415985},
+		{A: 5.27e-09, B: 4.01994270827, C: 18849.2275499742},
+		{A: 5.69e-09, B: 1.65498800378, C: 39264.0692895602},
+		{A: 5.18e-09, B: 4.96996115446, C: 30213.258447757},
+		{A: 5.14e-09, B: 5.78413007838, C: 12592.4500197826},
+		{A: 5.38e-09, B: 4.56198493922, C: 10063.7223490764},
+		{A: 4.84e-09, B: 4.18538027381, C: 14919.0178537546},
+		{A: 4.93e-09, B: 4.79939382739, C: 9146.790069021},
+		{A: 4.27e-09, B: 3.76876868949, C: 11272.6674764002},
+		{A: 4.95e-09, B: 0.49175293655, C: 45585.1728121874},
+		{A: 4.94e-09, B: 3.74345863918, C: 31441.6775697568},
+		{A: 5.24e-09, B: 0.97991794166, C: 30110.1656735384},
+		{A: 4.83e-09, B: 1.87898057316, C: 51066.427731055},
+		{A: 5.05e-09, B: 3.70047474212, C: 20400.2727726222},
+		{A: 3.51e-09, B: 4.3402657449, C: 10137.0194749354},
+		{A: 3.55e-09, B: 5.56672554631, C: 18837.49819713819},
+		{A: 3.28e-09, B: 3.7842737891, C: 6681.2248533996},
+		{A: 3.49e-09, B: 4.20550749672, C: 20956.2620575166},
+		{A: 3.33e-09, B: 4.44969281739, C: 28521.0927782546},
+		{A: 2.96e-09, B: 2.83205515646, C: 17277.4069318338},
+		{A: 3.11e-09, B: 2.57334132897, C: 20809.4676246452},
+		{A: 2.94e-09, B: 0.75089224483, C: 3149.1641605882},
+		{A: 3.77e-09, B: 3.98143308775, C: 21202.093703746},
+		{A: 2.72e-09, B: 5.56183082489, C: 16496.3613962024},
+		{A: 3.14e-09, B: 0.02584607093, C: 13745.3462390224},
+		{A: 2.63e-09, B: 0.55328410985, C: 36147.4098773004},
+		{A: 2.86e-09, B: 5.16408902215, C: 426.598190876},
+		{A: 2.79e-09, B: 4.29871615943, C: 19999.97290154599},
+		{A: 2.8e-09, B: 1.92925047377, C: 49515.382508407},
+		{A: 2.65e-09, B: 4.81168402147, C: 20235.1228263104},
+		{A: 2.73e-09, B: 5.12740051559, C: 35371.8872659764},
+		{A: 3.06e-09, B: 5.28903794869, C: 382.8965322232},
+		{A: 2.23e-09, B: 2.50591724074, C: 26709.6469424134},
+		{A: 2.35e-09, B: 5.96522395118, C: 10198.033075026},
+		{A: 2.34e-09, B: 3.52866583267, C: 10228.538017396},
+		{A: 2.24e-09, B: 6.24561979789, C: 7064.1213856228},
+		{A: 2.51e-09, B: 2.84739274245, C: 33019.0211122046},
+		{A: 1.96e-09, B: 1.5061039379, C: 31749.2351907264},
+		{A: 1.92e-09, B: 1.69321442572, C: 13341.6743113068},
+		{A: 1.8e-09, B: 6.19353087076, C: 39793.7602546548},
+		{A: 1.99e-09, B: 1.1643332188, C: 22805.7355659936},
+		{A: 1.8e-09, B: 3.72646417141, C: 1551.045222648},
+		{A: 1.73e-09, B: 3.35235705827, C: 53445.5922046266},
+		{A: 1.95e-09, B: 1.51901264131, C: 43232.3066584156},
+		{A: 1.74e-09, B: 2.84049662693, C: 9967.4538999816},
+		{A: 1.63e-09, B: 4.29160537719, C: 36949.2308084242},
+		{A: 1.69e-09, B: 0.37000676558, C: 10459.1171924404},
+		{A: 1.37e-09, B: 5.61149803116, C: 10529.6774158676},
+		{A: 1.39e-09, B: 0.87847805052, C: 16522.6597160022},
+		{A: 1.39e-09, B: 4.12576475427, C: 36301.18868778519},
+		{A: 1.27e-09, B: 5.14447758616, C: 5481.2549188676},
+		{A: 1.31e-09, B: 3.11317801589, C: 9896.8936765544},
+		{A: 1.31e-09, B: 0.89697384735, C: 3442.5749449654},
+		{A: 1.21e-09, B: 1.32802112907, C: 38734.3783244656},
+		{A: 1.22e-09, B: 1.59017183044, C: 10110.1927719924},
+		{A: 1.23e-09, B: 2.33714216061, C: 10316.3783204296},
+		{A: 1.33e-09, B: 2.90682399304, C: 9793.8009023358},
+		{A: 1.11e-09, B: 2.5207763476, C: 13936.794505134},
+		{A: 1.2e-09, B: 0.36076947165, C: 536.8045120954},
+		{A: 1.15e-09, B: 2.53355582059, C: 26735.9452622132},
+		{A: 1.08e-09, B: 2.65839634325, C: 10232.95530711079},
+		{A: 1.08e-09, B: 0.55230439694, C: 10193.61578531121},
+		{A: 1.38e-09, B: 1.0691923924, C: 65236.2212932854},
+		{A: 1.01e-09, B: 3.17012502017, C: 19317.1925403286},
+		{A: 1.27e-09, B: 5.63110477712, C: 10288.0671447783},
+		{A: 1.27e-09, B: 3.86278127025, C: 10138.5039476437},
+		{A: 1.37e-09, B: 2.9335065946, C: 47162.5163546352},
+		{A: 9.5e-10, B: 5.03917884334, C: 52175.8062831484},
+		{A: 9.4e-10, B: 0.71308489207, C: 38500.2760310722},
+		{A: 9.2e-10, B: 5.46204624886, C: 11764.330768859},
+		{A: 9.6e-10, B: 1.52914774412, C: 9690.7081281172},
+		{A: 1.01e-09, B: 0.83318284426, C: 6489.776587288},
+		{A: 1.15e-09, B: 3.76443612245, C: 522.5774180938},
+		{A: 8.9e-10, B: 2.53312656681, C: 10735.8629643048},
+		{A: 8.2e-10, B: 0.85628515615, C: 2379.1644735716},
+		{A: 1.03e-09, B: 5.2268323762, C: 103.0927742186},
+		{A: 9e-10, B: 2.12423586627, C: 28313.288804661},
+		{A: 9e-10, B: 0.39668501735, C: 9580.5018068978},
+		{A: 7.4e-10, B: 6.0268009555, C: 3723.508958923},
+		{A: 8.1e-10, B: 5.25045057985, C: 10419.4710946482},
+		{A: 8e-10, B: 4.23724598221, C: 10007.0999977738},
+		{A: 9.1e-10, B: 2.48874147947, C: 10846.0692855242},
+		{A: 8.5e-10, B: 3.82784790321, C: 51868.2486621788},
+		{A: 8.1e-10, B: 2.26235214191, C: 3903.9113764198},
+		{A: 9.7e-10, B: 0.772950916, C: 18307.8072320436},
+		{A: 9.4e-10, B: 0.17063414792, C: 6872.6731195112},
+		{A: 8e-10, B: 5.62254102739, C: 29999.959352319},
+		{A: 6.8e-10, B: 2.7176293667, C: 16983.9961474566},
+		{A: 6.6e-10, B: 0.76731351736, C: 20.7753954924},
+		{A: 7.5e-10, B: 0.36155638007, C: 39302.096962196},
+		{A: 7.5e-10, B: 2.27327165974, C: 8521.1198767086},
+		{A: 5.8e-10, B: 2.14482855875, C: 8631.326197928},
+		{A: 6.4e-10, B: 5.83569051301, C: 2118.7638603784},
+		{A: 5.8e-10, B: 2.98524209824, C: 19889.76658032659},
+		{A: 5.4e-10, B: 1.78260668333, C: 40077.61957352},
+		{A: 5.5e-10, B: 4.70485939861, C: 639.897286314},
+		{A: 6e-10, B: 5.8966189292, C: 41962.5207369374},
+		{A: 6.6e-10, B: 2.24746237999, C: 74.7815985673},
+		{A: 6.1e-10, B: 3.40726181591, C: 27490.6924780448},
+		{A: 5.1e-10, B: 3.07811180039, C: 24150.080051345},
+		{A: 5.7e-10, B: 2.30081371235, C: 20529.66386664059},
+		{A: 5.2e-10, B: 2.37192464233, C: 29573.361161443},
+		{A: 5.2e-10, B: 4.76610409132, C: 57375.8019008462},
+		{A: 4.7e-10, B: 1.61630288856, C: 30831.3049047446},
+		{A: 5.4e-10, B: 5.89684197257, C: 19903.99367432819},
+		{A: 4e-10, B: 5.32101847424, C: 42430.4857272918},
+		{A: 5.1e-10, B: 5.29186795569, C: 29587.5882554446},
+	},
+	// B1, 133 terms
+	{
+		{A: 0.00513347602, B: 1.80364310797, C: 10213.285546211},
+		{A: 4.3801e-05, B: 3.38615711591, C: 20426.571092422},
+		{A: 1.96586e-06, B: 2.53001197486, C: 30639.856638633},
+		{A: 1.99162e-06, B: 0, C: 0},
+		{A: 1.4031e-07, B: 2.27087044687, C: 9437.762934887},
+		{A: 1.2958e-07, B: 1.50735622957, C: 18073.7049386502},
+		{A: 1.1941e-07, B: 5.60462450426, C: 1577.3435424478},
+		{A: 1.0324e-07, B: 5.24224313355, C: 2352.8661537718},
+		{A: 9.294e-08, B: 6.07545631303, C: 22003.9146348698},
+		{A: 7.441e-08, B: 1.50257909439, C: 11790.6290886588},
+		{A: 8.031e-08, B: 0.29371105198, C: 9153.9036160218},
+		{A: 7.514e-08, B: 5.0808188599, C: 10186.9872264112},
+		{A: 4.669e-08, B: 3.87801635015, C: 10239.5838660108},
+		{A: 4.399e-08, B: 3.58872736593, C: 40853.142184844},
+		{A: 3.975e-08, B: 1.28397121206, C: 10404.7338123226},
+		{A: 4.657e-08, B: 0.75073886819, C: 5507.5532386674},
+		{A: 3.783e-08, B: 4.33004753984, C: 19651.048481098},
+		{A: 3.39e-08, B: 4.88976070903, C: 10988.808157535},
+		{A: 3.555e-08, B: 1.25927550356, C: 19896.8801273274},
+		{A: 3.479e-08, B: 5.5079700216, C: 529.6909650946},
+		{A: 2.884e-08, B: 0.08549582037, C: 14143.4952424306},
+		{A: 1.786e-08, B: 0.37134513186, C: 13367.9726311066},
+		{A: 1.6e-08, B: 1.68378002982, C: 20618.0193585336},
+		{A: 1.539e-08, B: 1.21683853657, C: 25934.1243310894},
+		{A: 1.341e-08, B: 2.90077139758, C: 15720.8387848784},
+		{A: 9.93e-09, B: 1.74681248965, C: 11322.6640983044},
+		{A: 1.165e-08, B: 6.13437155401, C: 7860.4193924392},
+		{A: 1.115e-08, B: 0.6674369038, C: 29580.4747084438},
+		{A: 9.23e-09, B: 2.25384969096, C: 10021.8372800994},
+		{A: 9.65e-09, B: 1.36425494833, C: 9683.5945811164},
+		{A: 9.73e-09, B: 0.39071758442, C: 6283.0758499914},
+		{A: 8.05e-09, B: 0.53331923557, C: 8624.2126509272},
+		{A: 9.13e-09, B: 0.76046003719, C: 8635.9420037632},
+		{A: 9.91e-09, B: 0.5531987933, C: 19367.1891622328},
+		{A: 6.09e-09, B: 2.62364470139, C: 23581.2581773176},
+		{A: 5.32e-09, B: 5.10925676528, C: 9786.687355335},
+		{A: 4.76e-09, B: 6.17672999981, C: 11015.1064773348},
+		{A: 4.72e-09, B: 1.696726292, C: 17298.1823273262},
+		{A: 5.03e-09, B: 2.65840772485, C: 29864.334027309},
+		{A: 4.56e-09, B: 5.01205315518, C: 10742.9765113056},
+		{A: 4.78e-09, B: 3.94100005156, C: 775.522611324},
+		{A: 4.77e-09, B: 3.71554345922, C: 10596.1820784342},
+		{A: 3.47e-09, B: 2.3455106268, C: 9411.4646150872},
+		{A: 4.58e-09, B: 2.31894399069, C: 9999.986450773},
+		{A: 3.74e-09, B: 3.76878356974, C: 21228.3920235458},
+		{A: 4.4e-09, B: 4.33400244581, C: 15874.6175953632},
+		{A: 3.49e-09, B: 1.31468836511, C: 10234.0609417034},
+		{A: 3.1e-09, B: 5.45422332781, C: 10192.5101507186},
+		{A: 3.46e-09, B: 0.94242286364, C: 1059.3819301892},
+		{A: 3.08e-09, B: 4.90145899142, C: 3930.2096962196},
+		{A: 3.31e-09, B: 4.89498986674, C: 10206.1719992102},
+		{A: 2.69e-09, B: 2.39650266204, C: 801.8209311238},
+		{A: 2.69e-09, B: 0.00589873499, C: 9830.3890139878},
+		{A: 2.61e-09, B: 3.48196147279, C: 7058.5984613154},
+		{A: 2.9e-09, B: 0.10953964861, C: 29050.7837433492},
+		{A: 2.83e-09, B: 6.12133736787, C: 20419.45754542119},
+		{A: 2.32e-09, B: 3.0784585003, C: 12566.1516999828},
+		{A: 2.65e-09, B: 4.02431894973, C: 33794.5437235286},
+		{A: 2.2e-09, B: 2.37315851889, C: 4551.9534970588},
+		{A: 2.47e-09, B: 3.07626728158, C: 28286.9904848612},
+		{A: 2.02e-09, B: 3.56872121409, C: 21535.9496445154},
+		{A: 2.25e-09, B: 5.7688889632, C: 213.299095438},
+		{A: 2.17e-09, B: 0.88382111135, C: 20213.271996984},
+		{A: 1.72e-09, B: 6.12653050186, C: 9161.0171630226},
+		{A: 1.95e-09, B: 5.472408554, C: 37724.7534197482},
+		{A: 1.53e-09, B: 4.07656151671, C: 27511.4678735372},
+		{A: 1.74e-09, B: 1.33676849359, C: 32217.2001810808},
+		{A: 1.57e-09, B: 5.98474214437, C: 26.2983197998},
+		{A: 1.63e-09, B: 5.4551913476, C: 10426.584641649},
+		{A: 1.29e-09, B: 2.08748660996, C: 3128.3887650958},
+		{A: 1.31e-09, B: 1.51959002513, C: 10218.8084705184},
+		{A: 1.39e-09, B: 4.42330401713, C: 10220.3990932118},
+		{A: 1.26e-09, B: 2.62296638037, C: 22779.4372461938},
+		{A: 1.46e-09, B: 4.69869606856, C: 25158.6017197654},
+		{A: 1.72e-09, B: 6.13435208788, C: 18837.49819713819},
+		{A: 1.57e-09, B: 5.44507403858, C: 4705.7323075436},
+		{A: 1.17e-09, B: 6.18296175153, C: 20400.2727726222},
+		{A: 1.64e-09, B: 3.30849473132, C: 51066.427731055},
+		{A: 1.13e-09, B: 3.64412860654, C: 7.1135470008},
+		{A: 1.09e-09, B: 5.21220660788, C: 8662.240323563},
+		{A: 1.33e-09, B: 1.78047296245, C: 191.4482661116},
+		{A: 1.17e-09, B: 0.14681677884, C: 9146.790069021},
+		{A: 1.16e-09, B: 0.61940521198, C: 41654.9631159678},
+		{A: 9.6e-10, B: 1.49631428731, C: 7084.8967811152},
+		{A: 9.6e-10, B: 1.21744230443, C: 10198.033075026},
+		{A: 8.2e-10, B: 1.45863866349, C: 10207.7626219036},
+		{A: 8.5e-10, B: 6.04057728058, C: 21202.093703746},
+		{A: 8.3e-10, B: 0.19985600927, C: 14919.0178537546},
+		{A: 7.7e-10, B: 5.5013231061, C: 5661.3320491522},
+		{A: 7.7e-10, B: 2.00173927326, C: 10228.538017396},
+		{A: 9.3e-10, B: 1.85466268819, C: 45585.1728121874},
+		{A: 6.6e-10, B: 3.25826124156, C: 1109.3785520934},
+		{A: 8.9e-10, B: 0.64100435648, C: 3154.6870848956},
+		{A: 6.1e-10, B: 3.80043027736, C: 11272.6674764002},
+		{A: 7.7e-10, B: 1.8551635895, C: 3532.0606928114},
+		{A: 6.2e-10, B: 0.81341290651, C: 382.8965322232},
+		{A: 7.2e-10, B: 2.35312965005, C: 9103.9069941176},
+		{A: 5.3e-10, B: 3.21969389511, C: 20452.8694122218},
+		{A: 6.7e-10, B: 1.42090542131, C: 24356.7807886416},
+		{A: 5.6e-10, B: 2.97733070198, C: 30110.1656735384},
+		{A: 5.1e-10, B: 4.22406663447, C: 20809.4676246452},
+		{A: 5.8e-10, B: 6.20761936031, C: 29088.811415985},
+		{A: 6.1e-10, B: 3.27309494322, C: 49515.382508407},
+		{A: 4.6e-10, B: 5.49443476235, C: 31441.6775697568},
+		{A: 5e-10, B: 4.16651052942, C: 13341.6743113068},
+		{A: 4.7e-10, B: 1.25473247769, C: 33019.0211122046},
+		{A: 4.7e-10, B: 2.03402044389, C: 23958.6317852334},
+		{A: 3.6e-10, B: 5.24409311105, C: 3149.1641605882},
+		{A: 3.8e-10, B: 4.15337829669, C: 18849.2275499742},
+		{A: 4.2e-10, B: 0.43005959574, C: 1589.0728952838},
+		{A: 4.1e-10, B: 1.21289342964, C: 12592.4500197826},
+		{A: 3.8e-10, B: 5.91928287144, C: 28521.0927782546},
+		{A: 3.3e-10, B: 3.98241699279, C: 4732.0306273434},
+		{A: 3.5e-10, B: 2.24417218267, C: 16496.3613962024},
+		{A: 4e-10, B: 6.13293942728, C: 26087.9031415742},
+		{A: 4.4e-10, B: 1.7812329486, C: 426.598190876},
+		{A: 4.1e-10, B: 3.16744909855, C: 39264.0692895602},
+		{A: 3.3e-10, B: 4.96183427323, C: 536.8045120954},
+		{A: 3.4e-10, B: 0.12963030501, C: 30213.258447757},
+		{A: 3.6e-10, B: 5.41167321573, C: 522.5774180938},
+		{A: 2.7e-10, B: 4.44250239485, C: 17277.4069318338},
+		{A: 3.4e-10, B: 5.94541303751, C: 9929.4262273458},
+		{A: 3.3e-10, B: 0.40689057274, C: 10497.1448650762},
+		{A: 2.3e-10, B: 2.59067946967, C: 10175.2578735752},
+		{A: 2.2e-10, B: 0.69625017371, C: 19999.97290154599},
+		{A: 2.3e-10, B: 3.76162101633, C: 10251.3132188468},
+		{A: 2.3e-10, B: 0.62711494266, C: 35371.8872659764},
+		{A: 2.2e-10, B: 4.64142978776, C: 19889.76658032659},
+		{A: 2e-10, B: 4.01315480107, C: 26709.6469424134},
+		{A: 2e-10, B: 4.0334440068, C: 29573.361161443},
+		{A: 2.3e-10, B: 0.90416640595, C: 8094.5216858326},
+		{A: 2.2e-10, B: 1.92092469688, C: 17085.9586657222},
+		{A: 1.9e-10, B: 5.04938942644, C: 6681.2248533996},
+	},
+	// B2, 59 terms
+	{
+		{A: 0.00022377665, B: 3.38509143877, C: 10213.285546211},
+		{A: 2.81739e-06, B: 0, C: 0},
+		{A: 1.73164e-06, B: 5.25563766915, C: 20426.571092422},
+		{A: 2.6945e-07, B: 3.87040891568, C: 30639.856638633},
+		{A: 1.174e-08, B: 0.09768632072, C: 10186.9872264112},
+		{A: 6.85e-09, B: 3.19139067811, C: 11790.6290886588},
+		{A: 7.88e-09, B: 4.36515965295, C: 10239.5838660108},
+		{A: 5.92e-09, B: 5.22270440328, C: 40853.142184844},
+		{A: 5.15e-09, B: 6.12821215207, C: 10988.808157535},
+		{A: 5.38e-09, B: 0.57550272342, C: 2352.8661537718},
+		{A: 5.4e-09, B: 3.11657836329, C: 18073.7049386502},
+		{A: 4.54e-09, B: 2.79306867629, C: 10404.7338123226},
+		{A: 3.74e-09, B: 6.10468482446, C: 9437.762934887},
+		{A: 4.31e-09, B: 4.00778431184, C: 1577.3435424478},
+		{A: 3.6e-09, B: 6.0174784232, C: 19651.048481098},
+		{A: 3.75e-09, B: 1.31319959789, C: 22003.9146348698},
+		{A: 3.54e-09, B: 5.12509281266, C: 9153.9036160218},
+		{A: 1.5e-09, B: 4.58623687118, C: 15720.8387848784},
+		{A: 1.64e-09, B: 5.41790158607, C: 5507.5532386674},
+		{A: 1.59e-09, B: 2.78191550878, C: 19896.8801273274},
+		{A: 1.57e-09, B: 0.65774905071, C: 529.6909650946},
+		{A: 1.55e-09, B: 2.54824315372, C: 9683.5945811164},
+		{A: 1.09e-09, B: 2.01866665583, C: 14143.4952424306},
+		{A: 1.06e-09, B: 2.28289033017, C: 6283.0758499914},
+		{A: 1.15e-09, B: 3.23636374193, C: 20618.0193585336},
+		{A: 1.28e-09, B: 5.32400510939, C: 13367.9726311066},
+		{A: 8.7e-10, B: 3.28265082435, C: 11322.6640983044},
+		{A: 9e-10, B: 5.23585072275, C: 10596.1820784342},
+		{A: 5.5e-10, B: 4.82369879741, C: 7058.5984613154},
+		{A: 4.4e-10, B: 0.58444963462, C: 10206.1719992102},
+		{A: 4.4e-10, B: 2.34401612969, C: 19367.1891622328},
+		{A: 3.8e-10, B: 4.55053233088, C: 9999.986450773},
+		{A: 3.9e-10, B: 5.84340580032, C: 10220.3990932118},
+		{A: 3.6e-10, B: 4.41006216127, C: 51066.427731055},
+		{A: 3.9e-10, B: 3.14348236386, C: 9411.4646150872},
+		{A: 3.3e-10, B: 4.5574866034, C: 10742.9765113056},
+		{A: 3.7e-10, B: 2.79630938717, C: 25934.1243310894},
+		{A: 3.4e-10, B: 0.55287110072, C: 11015.1064773348},
+		{A: 3.4e-10, B: 2.25809144959, C: 29580.4747084438},
+		{A: 3.8e-10, B: 1.88638747393, C: 801.8209311238},
+		{A: 3.4e-10, B: 1.22706917271, C: 10021.8372800994},
+		{A: 2.7e-10, B: 4.83867137637, C: 9830.3890139878},
+		{A: 2.7e-10, B: 4.3114017935, C: 23581.2581773176},
+		{A: 2.7e-10, B: 2.17187621336, C: 8635.9420037632},
+		{A: 2e-10, B: 5.66581696952, C: 21228.3920235458},
+		{A: 2.4e-10, B: 2.1720810785, C: 18849.2275499742},
+		{A: 2e-10, B: 5.29318634138, C: 775.522611324},
+		{A: 1.9e-10, B: 2.73486845601, C: 3128.3887650958},
+		{A: 1.3e-10, B: 3.40362915274, C: 1059.3819301892},
+		{A: 1.4e-10, B: 0.05074160195, C: 7860.4193924392},
+		{A: 1.4e-10, B: 5.43035907265, C: 26.2983197998},
+		{A: 1.2e-10, B: 3.24834347355, C: 9103.9069941176},
+		{A: 1.3e-10, B: 5.04826725887, C: 7.1135470008},
+		{A: 1.5e-10, B: 1.42027402522, C: 29050.7837433492},
+		{A: 1e-10, B: 4.9813806749, C: 10426.584641649},
+		{A: 1.1e-10, B: 0.85773045784, C: 17298.1823273262},
+		{A: 1.1e-10, B: 4.23048200054, C: 29864.334027309},
+		{A: 1e-10, B: 0.26447399758, C: 3930.2096962196},
+		{A: 1.1e-10, B: 1.46728576671, C: 20419.45754542119},
+	},
+	// B3, 15 terms
+	{
+		{A: 6.46671e-06, B: 4.99166565277, C: 10213.285546211},
+		{A: 1.9952e-07, B: 3.14159265359, C: 0},
+		{A: 5.54e-08, B: 0.77376923951, C: 20426.571092422},
+		{A: 2.526e-08, B: 5.4449376302, C: 30639.856638633},
+		{A: 7.9e-10, B: 1.51447613604, C: 10186.9872264112},
+		{A: 5.6e-10, B: 0.63647808442, C: 40853.142184844},
+		{A: 5.8e-10, B: 5.7073117655, C: 10239.5838660108},
+		{A: 3.1e-10, B: 4.72523061067, C: 11790.6290886588},
+		{A: 2.6e-10, B: 1.02068113372, C: 10988.808157535},
+		{A: 2.5e-10, B: 5.60599130442, C: 9437.762934887},
+		{A: 1.7e-10, B: 2.05293621864, C: 2352.8661537718},
+		{A: 1.1e-10, B: 4.33056892256, C: 10404.7338123226},
+		{A: 9e-11, B: 1.36283915068, C: 19651.048481098},
+		{A: 7e-11, B: 4.69592781899, C: 18073.7049386502},
+		{A: 6e-11, B: 2.97926526705, C: 22003.9146348698},
+	},
+	// B4, 5 terms
+	{
+		{A: 1.4102e-07, B: 0.31537190181, C: 10213.285546211},
+		{A: 1.9e-09, B: 2.35466404492, C: 20426.571092422},
+		{A: 1.64e-09, B: 0.74476215141, C: 30639.856638633},
+		{A: 2.14e-09, B: 3.14159265359, C: 0},
+		{A: 4e-11, B: 2.34190883009, C: 40853.142184844},
+	},
+	// B5, 4 terms
+	{
+		{A: 2.39e-09, B: 2.05201727566, C: 10213.285546211},
+		{A: 3.9e-10, B: 0, C: 0},
+		{A: 1.1e-10, B: 3.82500275251, C: 20426.571092422},
+		{A: 9e-11, B: 2.32953116868, C: 30639.856638633},
+	},
+}
+
+// GetVenusB0 evaluates the t^0 series of B.
+func GetVenusB0(t float64) float64 {
+	return venusB[0].Evaluate(t)
+}
+
+// GetVenusB1 evaluates the t^1 series of B.
+func GetVenusB1(t float64) float64 {
+	return venusB[1].Evaluate(t)
+}
+
+// GetVenusB2 evaluates the t^2 series of B.
+func GetVenusB2(t float64) float64 {
+	return venusB[2].Evaluate(t)
+}
+
+// GetVenusB3 evaluates the t^3 series of B.
+func GetVenusB3(t float64) float64 {
+	return venusB[3].Evaluate(t)
+}
+
+// GetVenusB4 evaluates the t^4 series of B.
+func GetVenusB4(t float64) float64 {
+	return venusB[4].Evaluate(t)
+}
+
+// GetVenusB5 evaluates the t^5 series of B.
+func GetVenusB5(t float64) float64 {
+	return venusB[5].Evaluate(t)
+}
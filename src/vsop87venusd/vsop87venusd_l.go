@@ -0,0 +1,730 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// VENUS L - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87venusd
+
+import "vsop87"
+
+var venusL = []vsop87.Series{
+	// L0, 367 terms
+	{
+		{A: 3.17614666774, B: 0, C: 0},
+		{A: 0.01353968419, B: 5.59313319619, C: 10213.285546211},
+		{A: 0.00089891645, B: 5.30650048468, C: 20426.571092422},
+		{A: 5.477201e-05, B: 4.41630652531, C: 7860.4193924392},
+		{A: 3.455732e-05, B: 2.69964470778, C: 11790.6290886588},
+		{A: 2.372061e-05, B: 2.99377539568, C: 3930.2096962196},
+		{A: 1.317108e-05, B: 5.18668219093, C: 26.2983197998},
+		{A: 1.664069e-05, B: 4.2501893503, C: 1577.3435424478},
+		{A: 1.438322e-05, B: 4.15745043958, C: 9683.5945811164},
+		{A: 1.200521e-05, B: 6.15357115319, C: 30639.856638633},
+		{A: 7.6138e-06, B: 1.9501470212, C: 529.6909650946},
+		{A: 7.07676e-06, B: 1.06466707214, C: 775.522611324},
+		{A: 5.84836e-06, B: 3.99839884762, C: 191.4482661116},
+		{A: 7.69314e-06, B: 0.81629615911, C: 9437.762934887},
+		{A: 4.99915e-06, B: 4.12340210074, C: 15720.8387848784},
+		{A: 3.26221e-06, B: 4.59056473097, C: 10404.7338123226},
+		{A: 4.29498e-06, B: 3.58642859752, C: 19367.1891622328},
+		{A: 3.26967e-06, B: 5.67736583705, C: 5507.5532386674},
+		{A: 2.31937e-06, B: 3.16251057072, C: 9153.9036160218},
+		{A: 1.79695e-06, B: 4.65337915578, C: 1109.3785520934},
+		{A: 1.28263e-06, B: 4.22604493736, C: 20.7753954924},
+		{A: 1.55464e-06, B: 5.57043888948, C: 19651.048481098},
+		{A: 1.27907e-06, B: 0.96209822685, C: 5661.3320491522},
+		{A: 1.05547e-06, B: 1.53721191253, C: 801.8209311238},
+		{A: 8.5722e-07, B: 0.35589249966, C: 3154.6870848956},
+		{A: 9.9121e-07, B: 0.83288185132, C: 213.299095438},
+		{A: 9.8804e-07, B: 5.39389655503, C: 13367.9726311066},
+		{A: 8.2094e-07, B: 3.21596990826, C: 18837.49819713819},
+		{A: 8.8031e-07, B: 3.88868860307, C: 9999.986450773},
+		{A: 7.1577e-07, B: 0.11145739345, C: 11015.1064773348},
+		{A: 5.6122e-07, B: 4.24039855475, C: 7.1135470008},
+		{A: 7.0239e-07, B: 0.67458813282, C: 23581.2581773176},
+		{A: 5.0796e-07, B: 0.24531603049, C: 11322.6640983044},
+		{A: 4.6111e-07, B: 5.31576465717, C: 18073.7049386502},
+		{A: 4.4574e-07, B: 6.06282201966, C: 40853.142184844},
+		{A: 4.2594e-07, B: 5.3287333721, C: 2352.8661537718},
+		{A: 4.2635e-07, B: 1.7995542168, C: 7084.8967811152},
+		{A: 4.1177e-07, B: 0.36240972161, C: 382.8965322232},
+		{A: 3.5749e-07, B: 2.70448479296, C: 10206.1719992102},
+		{A: 3.3893e-07, B: 2.02347322198, C: 6283.0758499914},
+		{A: 2.9138e-07, B: 3.59230925768, C: 22003.9146348698},
+		{A: 2.8479e-07, B: 2.22375414002, C: 1059.3819301892},
+		{A: 2.985e-07, B: 4.02176977477, C: 10239.5838660108},
+		{A: 3.3252e-07, B: 2.10025596509, C: 27511.4678735372},
+		{A: 3.0172e-07, B: 4.9419191989, C: 13745.3462390224},
+		{A: 2.9252e-07, B: 3.51392387787, C: 283.8593188652},
+		{A: 2.4424e-07, B: 2.70177493852, C: 8624.2126509272},
+		{A: 2.0274e-07, B: 3.79493637509, C: 14143.4952424306},
+		{A: 2.4322e-07, B: 4.27814493315, C: 5.5229243074},
+		{A: 2.626e-07, B: 0.54067587552, C: 17298.1823273262},
+		{A: 2.0492e-07, B: 0.58547075036, C: 38.0276726358},
+		{A: 1.8988e-07, B: 4.13811500642, C: 4551.9534970588},
+		{A: 2.3739e-07, B: 4.82870797552, C: 6872.6731195112},
+		{A: 1.5885e-07, B: 1.50067222283, C: 8635.9420037632},
+		{A: 1.9069e-07, B: 6.12025580313, C: 29050.7837433492},
+		{A: 1.8269e-07, B: 3.04740408477, C: 19999.97290154599},
+		{A: 1.3656e-07, B: 4.41336292334, C: 3532.0606928114},
+		{A: 1.7094e-07, B: 3.5216152643, C: 31441.6775697568},
+		{A: 1.0955e-07, B: 2.84562790076, C: 18307.8072320436},
+		{A: 1.1048e-07, B: 2.58361219075, C: 9786.687355335},
+		{A: 9.904e-08, B: 1.08737710389, C: 7064.1213856228},
+		{A: 1.0576e-07, B: 0.85419784436, C: 10596.1820784342},
+		{A: 9.231e-08, B: 5.52471655579, C: 12566.1516999828},
+		{A: 1.1599e-07, B: 5.81007422699, C: 19896.8801273274},
+		{A: 1.1807e-07, B: 1.91250672543, C: 21228.3920235458},
+		{A: 1.0105e-07, B: 2.34270786693, C: 10742.9765113056},
+		{A: 8.154e-08, B: 1.92331359797, C: 15.252471185},
+		{A: 8.893e-08, B: 1.97291388515, C: 10186.9872264112},
+		{A: 9.352e-08, B: 4.94508904764, C: 35371.8872659764},
+		{A: 6.821e-08, B: 4.39733188968, C: 8662.240323563},
+		{A: 6.688e-08, B: 1.55310437864, C: 14945.3161735544},
+		{A: 6.413e-08, B: 2.17677652923, C: 10988.808157535},
+		{A: 5.802e-08, B: 1.93462125906, C: 3340.6124266998},
+		{A: 5.95e-08, B: 2.96578175391, C: 4732.0306273434},
+		{A: 5.275e-08, B: 5.01877102496, C: 28286.9904848612},
+		{A: 7.047e-08, B: 1.00111452053, C: 632.7837393132},
+		{A: 5.048e-08, B: 4.27886209626, C: 29580.4747084438},
+		{A: 6.305e-08, B: 0.3550633118, C: 103.0927742186},
+		{A: 5.959e-08, B: 5.04792949464, C: 245.8316462294},
+		{A: 4.651e-08, B: 0.85218058876, C: 6770.7106012456},
+		{A: 5.58e-08, B: 0.48723384809, C: 522.5774180938},
+		{A: 5.327e-08, B: 3.03115417024, C: 10021.8372800994},
+		{A: 5.01e-08, B: 5.773751665, C: 28521.0927782546},
+		{A: 4.608e-08, B: 1.93302108394, C: 4705.7323075436},
+		{A: 5.526e-08, B: 3.36797048901, C: 25158.6017197654},
+		{A: 3.863e-08, B: 4.89351531412, C: 25934.1243310894},
+		{A: 5.303e-08, B: 0.08161426841, C: 39302.096962196},
+		{A: 4.254e-08, B: 5.36046113295, C: 21535.9496445154},
+		{A: 3.763e-08, B: 1.05304597315, C: 19.66976089979},
+		{A: 4.407e-08, B: 4.02575374517, C: 74.7815985673},
+		{A: 4.145e-08, B: 1.14356412295, C: 9676.4810341156},
+		{A: 4.318e-08, B: 4.38289970585, C: 316.3918696566},
+		{A: 3.642e-08, B: 6.11733529325, C: 3128.3887650958},
+		{A: 3.238e-08, B: 5.39551036769, C: 419.4846438752},
+		{A: 3.909e-08, B: 4.0526363533, C: 9690.7081281172},
+		{A: 3.152e-08, B: 0.72553551731, C: 16496.3613962024},
+		{A: 3.496e-08, B: 0.72414615705, C: 3723.508958923},
+		{A: 3.755e-08, B: 3.80208713127, C: 19786.67380610799},
+		{A: 2.891e-08, B: 3.3378273777, C: 32217.2001810808},
+		{A: 3.016e-08, B: 1.57249112496, C: 17277.4069318338},
+		{A: 3.825e-08, B: 0.19612312903, C: 426.598190876},
+		{A: 3.798e-08, B: 0.45524571743, C: 10316.3783204296},
+		{A: 2.57e-08, B: 1.20813474107, C: 13936.794505134},
+		{A: 2.796e-08, B: 3.65128969074, C: 206.1855484372},
+		{A: 2.466e-08, B: 3.61988676373, C: 1551.045222648},
+		{A: 3.108e-08, B: 1.50325806664, C: 43232.3066584156},
+		{A: 2.976e-08, B: 4.79415001304, C: 29088.811415985},
+		{A: 2.217e-08, B: 3.59623681714, C: 24356.7807886416},
+		{A: 2.227e-08, B: 4.9605922194, C: 536.8045120954},
+		{A: 2.397e-08, B: 3.45249688427, C: 19374.3027092336},
+		{A: 2.462e-08, B: 0.53295178258, C: 19360.07561523199},
+		{A: 2.205e-08, B: 2.70399309963, C: 12592.4500197826},
+		{A: 2.23e-08, B: 3.01413465913, C: 18875.525869774},
+		{A: 1.858e-08, B: 4.06129152783, C: 2379.1644735716},
+		{A: 1.807e-08, B: 3.15086214479, C: 9573.388259897},
+		{A: 2.238e-08, B: 5.52216925076, C: 10138.5039476437},
+		{A: 2.195e-08, B: 2.32046770554, C: 8094.5216858326},
+		{A: 2.101e-08, B: 2.90421302975, C: 9967.4538999816},
+		{A: 1.916e-08, B: 4.56513949099, C: 2218.7571041868},
+		{A: 1.467e-08, B: 2.42640162465, C: 10234.0609417034},
+		{A: 1.726e-08, B: 5.59790693845, C: 20452.8694122218},
+		{A: 1.455e-08, B: 2.44757248737, C: 1589.0728952838},
+		{A: 1.991e-08, B: 4.04623390359, C: 31749.2351907264},
+		{A: 1.406e-08, B: 2.71736996917, C: 16983.9961474566},
+		{A: 1.658e-08, B: 0.11252373292, C: 153.7788104848},
+		{A: 1.851e-08, B: 2.92898027939, C: 47162.5163546352},
+		{A: 1.492e-08, B: 1.07513892753, C: 9103.9069941176},
+		{A: 1.247e-08, B: 2.48433565896, C: 17778.11626694899},
+		{A: 1.549e-08, B: 4.205536543, C: 3442.5749449654},
+		{A: 1.243e-08, B: 3.95452438599, C: 170.6728706192},
+		{A: 1.694e-08, B: 6.20694480406, C: 33019.0211122046},
+		{A: 1.221e-08, B: 4.77931820602, C: 30110.1656735384},
+		{A: 1.206e-08, B: 0.30531303095, C: 29864.334027309},
+		{A: 1.238e-08, B: 5.05581820608, C: 20213.271996984},
+		{A: 1.152e-08, B: 3.26229919481, C: 11.729352836},
+		{A: 1.179e-08, B: 1.69491074791, C: 20400.2727726222},
+		{A: 1.165e-08, B: 2.88995128147, C: 574.3447983348},
+		{A: 1.306e-08, B: 0.14519588607, C: 9146.790069021},
+		{A: 1.113e-08, B: 1.52598846804, C: 10426.584641649},
+		{A: 1.104e-08, B: 2.58791423813, C: 18849.2275499742},
+		{A: 1.045e-08, B: 0.5753921642, C: 15874.6175953632},
+		{A: 1.36e-08, B: 2.41976595457, C: 38734.3783244656},
+		{A: 9.81e-09, B: 4.37930727798, C: 110.2063212194},
+		{A: 1.095e-08, B: 0.49492867814, C: 51066.427731055},
+		{A: 1.146e-08, B: 4.54241454215, C: 10220.3990932118},
+		{A: 9.81e-09, B: 1.65915064733, C: 10103.0792249916},
+		{A: 1.27e-08, B: 4.69374306132, C: 9050.8108418032},
+		{A: 1.065e-08, B: 4.41645258887, C: 22805.7355659936},
+		{A: 8.54e-09, B: 2.34437926957, C: 6681.2248533996},
+		{A: 1.104e-08, B: 0.49781459714, C: 1.4844727083},
+		{A: 1.075e-08, B: 1.09857593161, C: 377.3736079158},
+		{A: 1.114e-08, B: 4.35024775806, C: 51092.7260508548},
+		{A: 8.29e-09, B: 5.41196274578, C: 27991.40181316},
+		{A: 9e-09, B: 2.74195379617, C: 41962.5207369374},
+		{A: 1.01e-08, B: 2.96092073452, C: 135.62532501},
+		{A: 7.68e-09, B: 3.98260860494, C: 18844.61174413899},
+		{A: 1.018e-08, B: 1.36891050752, C: 36949.2308084242},
+		{A: 7.26e-09, B: 1.67728773965, C: 21202.093703746},
+		{A: 7.27e-09, B: 0.89048212541, C: 467.9649903544},
+		{A: 8.69e-09, B: 2.93767679827, C: 10192.5101507186},
+		{A: 6.96e-09, B: 5.35698039414, C: 10063.7223490764},
+		{A: 9.2e-09, B: 4.17128923588, C: 18734.4054229196},
+		{A: 6.91e-09, B: 1.50594097883, C: 27197.2816936676},
+		{A: 8.35e-09, B: 0.48050621092, C: 20618.0193585336},
+		{A: 7.11e-09, B: 0.19750098222, C: 18830.38465013739},
+		{A: 8.11e-09, B: 0.16685071959, C: 12432.0426503978},
+		{A: 7.56e-09, B: 3.79022623226, C: 9161.0171630226},
+		{A: 6.22e-09, B: 5.33659507738, C: 9411.4646150872},
+		{A: 8.62e-09, B: 5.72705356405, C: 10175.1525105732},
+		{A: 8.53e-09, B: 0.10404188453, C: 2107.0345075424},
+		{A: 7.42e-09, B: 3.96365892051, C: 813.5502839598},
+		{A: 7.05e-09, B: 0.71229660616, C: 220.4126424388},
+		{A: 5.84e-09, B: 1.719006927, C: 36.0278666774},
+		{A: 6.12e-09, B: 0.36418385449, C: 949.1756089698},
+		{A: 5.87e-09, B: 1.5864894929, C: 6.62855890001},
+		{A: 5.81e-09, B: 5.49288908804, C: 6309.3741697912},
+		{A: 5.81e-09, B: 4.80353237853, C: 24150.080051345},
+		{A: 5.16e-09, B: 6.07328802561, C: 38.1330356378},
+		{A: 6.27e-09, B: 5.47281424954, C: 9580.5018068978},
+		{A: 6.01e-09, B: 1.40500080774, C: 1162.4747044078},
+		{A: 6.2e-09, B: 4.00681042667, C: 9992.8729037722},
+		{A: 6.11e-09, B: 3.62010998629, C: 7255.5696517344},
+		{A: 6.97e-09, B: 2.22359630727, C: 348.924420448},
+		{A: 6.93e-09, B: 5.77432072851, C: 55022.9357470744},
+		{A: 4.94e-09, B: 0.29761886866, C: 7058.5984613154},
+		{A: 5.63e-09, B: 0.24172140474, C: 37410.5672398786},
+		{A: 4.87e-09, B: 5.86917216517, C: 10137.0194749354},
+		{A: 4.93e-09, B: 2.04534833854, C: 735.8765135318},
+		{A: 6.36e-09, B: 2.79707392326, C: 40879.4405046438},
+		{A: 5.19e-09, B: 4.1394565763, C: 16522.6597160022},
+		{A: 5.35e-09, B: 4.6056959782, C: 19573.37471066999},
+		{A: 5.55e-09, B: 5.88120421263, C: 26735.9452622132},
+		{A: 4.4e-09, B: 5.61490649795, C: 23958.6317852334},
+		{A: 5.41e-09, B: 0.62494922735, C: 10007.0999977738},
+		{A: 4.27e-09, B: 4.02335620501, C: 14.2270940016},
+		{A: 4.34e-09, B: 0.29028429049, C: 9264.1099372412},
+		{A: 4.51e-09, B: 1.66320363626, C: 26087.9031415742},
+		{A: 4.22e-09, B: 3.38413582674, C: 10787.6303445458},
+		{A: 5.69e-09, B: 5.14001758731, C: 27490.6924780448},
+		{A: 4.21e-09, B: 4.23407313457, C: 39793.7602546548},
+		{A: 4.58e-09, B: 5.2878636882, C: 49.7570254718},
+		{A: 4.18e-09, B: 5.6909779079, C: 14765.2390432698},
+		{A: 4.75e-09, B: 0.97544690438, C: 1052.2683831884},
+		{A: 3.87e-09, B: 4.41665162999, C: 21.8508293264},
+		{A: 5.23e-09, B: 2.9051242687, C: 20235.1228263104},
+		{A: 5.06e-09, B: 5.26999314618, C: 29999.959352319},
+		{A: 4.38e-09, B: 2.49457071132, C: 20956.2620575166},
+		{A: 5.3e-09, B: 0.71368442157, C: 33794.5437235286},
+		{A: 3.82e-09, B: 1.9211936548, C: 3.9321532631},
+		{A: 3.65e-09, B: 3.81715328784, C: 20419.45754542119},
+		{A: 4.26e-09, B: 2.06384083608, C: 38204.687359371},
+		{A: 4.96e-09, B: 0.44077356179, C: 9835.9119382952},
+		{A: 4.1e-09, B: 4.93346326003, C: 19264.0963880142},
+		{A: 3.8e-09, B: 3.79573358631, C: 8521.1198767086},
+		{A: 3.34e-09, B: 5.51158557799, C: 10251.3132188468},
+		{A: 4.12e-09, B: 2.56129670728, C: 77.673770428},
+		{A: 4.18e-09, B: 2.37865963521, C: 32.5325507914},
+		{A: 3.25e-09, B: 6.03020523465, C: 18947.7045183576},
+		{A: 4e-09, B: 0.91999360201, C: 227.476132789},
+		{A: 4.37e-09, B: 0.91420135162, C: 58953.145443294},
+		{A: 3.6e-09, B: 0.82477639126, C: 22.7752014508},
+		{A: 4.13e-09, B: 4.22381905655, C: 44809.6502008634},
+		{A: 3.75e-09, B: 3.15657291896, C: 19992.85935454519},
+		{A: 3.71e-09, B: 6.05370874275, C: 20007.0864485468},
+		{A: 3.61e-09, B: 5.44371227904, C: 19470.28193645139},
+		{A: 3.86e-09, B: 5.28120540405, C: 47623.8527860896},
+		{A: 3.89e-09, B: 0.7321667224, C: 19050.7972925762},
+		{A: 3.2e-09, B: 2.84811591194, C: 10199.0584522094},
+		{A: 3.86e-09, B: 3.88754165531, C: 1975.492545856},
+		{A: 2.76e-09, B: 4.33979180814, C: 20809.4676246452},
+		{A: 2.76e-09, B: 0.50647429773, C: 9830.3890139878},
+		{A: 3.09e-09, B: 3.79299100668, C: 18204.71445782499},
+		{A: 3.77e-09, B: 0.73768791281, C: 11506.7697697936},
+		{A: 3.22e-09, B: 0.961384421, C: 30666.1549584328},
+		{A: 3.63e-09, B: 1.3047240669, C: 9367.2027114598},
+		{A: 3.66e-09, B: 2.79972786028, C: 11272.6674764002},
+		{A: 2.71e-09, B: 4.66141338193, C: 846.0828347512},
+		{A: 2.59e-09, B: 0.4203117575, C: 39264.0692895602},
+		{A: 2.85e-09, B: 0.40546033634, C: 30.914125635},
+		{A: 2.47e-09, B: 4.80676426942, C: 36147.4098773004},
+		{A: 2.64e-09, B: 2.71608177583, C: 11.0457002639},
+		{A: 2.33e-09, B: 2.76423842887, C: 187.9251477626},
+		{A: 2.48e-09, B: 1.60765612338, C: 10497.1448650762},
+		{A: 2.71e-09, B: 0.8234891963, C: 19793.7873531088},
+		{A: 2.25e-09, B: 3.80080957016, C: 8631.326197928},
+		{A: 2.63e-09, B: 1.92311689852, C: 37724.7534197482},
+		{A: 2.14e-09, B: 5.01663795092, C: 639.897286314},
+		{A: 2.89e-09, B: 0.12342601246, C: 20277.0078952874},
+		{A: 2.1e-09, B: 0.12771800254, C: 29.8214381488},
+		{A: 2.27e-09, B: 4.18036609801, C: 17468.8551979454},
+		{A: 2.74e-09, B: 2.34929343, C: 62883.3551395136},
+		{A: 2.6e-09, B: 5.65254501655, C: 48739.859897083},
+		{A: 2.71e-09, B: 4.95325404028, C: 4214.0690150848},
+		{A: 2.19e-09, B: 2.08775228014, C: 194.9713844606},
+		{A: 1.91e-09, B: 2.49267248333, C: 568.8218740274},
+		{A: 2.5e-09, B: 1.52909737354, C: 6037.244203762},
+		{A: 2.31e-09, B: 5.23674429498, C: 491.6632924588},
+		{A: 1.82e-09, B: 4.98046042571, C: 18418.01355326299},
+		{A: 1.88e-09, B: 2.82273639603, C: 1385.8952763362},
+		{A: 2.04e-09, B: 4.09939796199, C: 14919.0178537546},
+		{A: 1.76e-09, B: 3.8240098246, C: 9360.089164459},
+		{A: 1.98e-09, B: 2.76491873243, C: 10217.2176994741},
+		{A: 1.68e-09, B: 5.19268384202, C: 1066.49547719},
+		{A: 1.99e-09, B: 1.95301487982, C: 7564.830720738},
+		{A: 1.71e-09, B: 2.59623459612, C: 20405.7956969296},
+		{A: 1.72e-09, B: 5.29332132623, C: 11764.330768859},
+		{A: 1.65e-09, B: 2.88557908025, C: 10207.7626219036},
+		{A: 1.64e-09, B: 3.25435371801, C: 3914.9572250346},
+		{A: 2e-09, B: 3.8244321809, C: 18314.9207790444},
+		{A: 1.69e-09, B: 1.78341902878, C: 31022.7531708562},
+		{A: 1.79e-09, B: 0.90840065587, C: 7880.08915333899},
+		{A: 1.63e-09, B: 2.79665037814, C: 41.5507909848},
+		{A: 1.54e-09, B: 3.90796293476, C: 30213.258447757},
+		{A: 1.53e-09, B: 0.07463240782, C: 28528.2063252554},
+		{A: 1.94e-09, B: 5.95838706838, C: 8617.0991039264},
+		{A: 1.71e-09, B: 4.58206324409, C: 20447.3464879144},
+		{A: 1.5e-09, B: 2.11647586229, C: 17248.4253018544},
+		{A: 1.49e-09, B: 2.1725998632, C: 9929.4262273458},
+		{A: 1.91e-09, B: 0.82310353823, C: 52670.0695933026},
+		{A: 1.48e-09, B: 2.94315921485, C: 41654.9631159678},
+		{A: 1.49e-09, B: 4.49798039726, C: 30831.3049047446},
+		{A: 1.84e-09, B: 2.46923348701, C: 34596.3646546524},
+		{A: 1.46e-09, B: 2.694529303, C: 43071.8992890308},
+		{A: 1.59e-09, B: 2.1113771357, C: 19317.1925403286},
+		{A: 1.54e-09, B: 2.76536164654, C: 28513.97923125379},
+		{A: 1.4e-09, B: 4.94595038686, C: 9256.9963902404},
+		{A: 1.41e-09, B: 2.57248458154, C: 13553.8979729108},
+		{A: 1.37e-09, B: 1.66482327575, C: 2636.725472637},
+		{A: 1.4e-09, B: 5.2303960599, C: 22645.32819660879},
+		{A: 1.32e-09, B: 5.35690599728, C: 19624.7501612982},
+		{A: 1.4e-09, B: 2.90637712614, C: 48947.6638706766},
+		{A: 1.29e-09, B: 3.95303623681, C: 32858.61374281979},
+		{A: 1.56e-09, B: 6.01143316387, C: 29057.89729034999},
+		{A: 1.34e-09, B: 5.75241675118, C: 68050.42387851159},
+		{A: 1.54e-09, B: 3.66827363753, C: 276.7457718644},
+		{A: 1.76e-09, B: 3.77298381177, C: 66813.5648357332},
+		{A: 1.26e-09, B: 5.00217740223, C: 27461.7108480654},
+		{A: 1.35e-09, B: 1.3480701392, C: 53285.1848352418},
+		{A: 1.5e-09, B: 0.25029475344, C: 290.972865866},
+		{A: 1.52e-09, B: 3.13035670092, C: 29043.67019634839},
+		{A: 1.69e-09, B: 5.0434810943, C: 73.297125859},
+		{A: 1.66e-09, B: 5.39219948035, C: 41236.0387170672},
+		{A: 1.63e-09, B: 5.59796070987, C: 7576.560073574},
+		{A: 1.26e-09, B: 0.77391784606, C: 49.9966219042},
+		{A: 1.63e-09, B: 0.44241846674, C: 20350.3050211464},
+		{A: 1.36e-09, B: 3.09066368912, C: 418.9243989006},
+		{A: 1.54e-09, B: 0.4708619096, C: 28418.000004036},
+		{A: 1.2e-09, B: 0.88536981986, C: 29573.361161443},
+		{A: 1.32e-09, B: 1.4800976904, C: 17085.9586657222},
+		{A: 1.26e-09, B: 1.39497760964, C: 966.9708774356},
+		{A: 1.43e-09, B: 3.84026797958, C: 14128.2427712456},
+		{A: 1.47e-09, B: 2.11627427804, C: 34363.365597556},
+		{A: 1.06e-09, B: 2.04696932293, C: 37674.9963942764},
+		{A: 1.06e-09, B: 1.43873202489, C: 27682.1407441564},
+		{A: 1.49e-09, B: 0.09286508794, C: 8144.2787113044},
+		{A: 1.03e-09, B: 0.0199204147, C: 18300.69368504279},
+		{A: 1.21e-09, B: 3.57602835443, C: 45.1412196366},
+		{A: 1.25e-09, B: 0.11630302078, C: 149.5631971346},
+		{A: 1.02e-09, B: 4.1794709773, C: 2333.196392872},
+		{A: 9.9e-10, B: 1.51324741657, C: 10419.4710946482},
+		{A: 1.33e-09, B: 3.02183293676, C: 76251.32777062019},
+		{A: 1.36e-09, B: 4.17517197268, C: 3646.3503773544},
+		{A: 1.23e-09, B: 0.44045588682, C: 515.463871093},
+		{A: 1.13e-09, B: 5.69261397718, C: 10110.1927719924},
+		{A: 9.8e-10, B: 6.23797900467, C: 202.2533951741},
+		{A: 9.9e-10, B: 3.75627530197, C: 59728.668054618},
+		{A: 1.01e-09, B: 4.62832557536, C: 65236.2212932854},
+		{A: 1.11e-09, B: 1.25947267588, C: 10846.0692855242},
+		{A: 1.1e-09, B: 5.87455577536, C: 38500.2760310722},
+		{A: 1.28e-09, B: 6.0102456216, C: 90394.82301305079},
+		{A: 9.1e-10, B: 1.77665981007, C: 1539.315869812},
+		{A: 9.2e-10, B: 0.99804571578, C: 95.9792272178},
+		{A: 1.2e-09, B: 3.93060866244, C: 38526.574350872},
+		{A: 1.17e-09, B: 2.24143299549, C: 56600.2792895222},
+		{A: 1.18e-09, B: 6.0912132594, C: 29786.660256881},
+		{A: 9.8e-10, B: 4.60938156207, C: 11787.1059703098},
+		{A: 9.7e-10, B: 3.92727733144, C: 11794.1522070078},
+		{A: 9.3e-10, B: 5.23395435043, C: 14169.7935622304},
+		{A: 9.6e-10, B: 5.27525709038, C: 8734.4189721466},
+		{A: 9.4e-10, B: 0.18166654805, C: 67589.08744705719},
+		{A: 1.1e-09, B: 4.96279287076, C: 48417.97290558199},
+		{A: 8.6e-10, B: 0.39533409505, C: 3956.5080160194},
+		{A: 8.5e-10, B: 5.69642646462, C: 37703.9780242558},
+		{A: 1.14e-09, B: 5.19676285428, C: 70743.77453195279},
+		{A: 8.1e-10, B: 5.51324815184, C: 412.3710968744},
+		{A: 8.9e-10, B: 2.13409771828, C: 44768.0994098786},
+		{A: 8.4e-10, B: 6.02475904578, C: 10632.7701900862},
+		{A: 8.5e-10, B: 4.60912614442, C: 45585.1728121874},
+		{A: 7.8e-10, B: 4.47358603432, C: 114.43928868521},
+		{A: 9.7e-10, B: 4.02223363535, C: 10218.8084705184},
+		{A: 8.1e-10, B: 1.03870237004, C: 9793.8009023358},
+		{A: 9.2e-10, B: 0.80301220092, C: 24383.0791084414},
+		{A: 8.7e-10, B: 2.15124790938, C: 28313.288804661},
+		{A: 7.5e-10, B: 5.17868679355, C: 63658.8777508376},
+		{A: 7.8e-10, B: 5.81927313665, C: 567.7186377304},
+		{A: 7.5e-10, B: 1.72618192481, C: 19580.4882576708},
+		{A: 7.1e-10, B: 0.10259261764, C: 90695.75207512038},
+		{A: 7.7e-10, B: 6.16012067704, C: 1573.8204240988},
+		{A: 7.6e-10, B: 5.12884307551, C: 49515.382508407},
+		{A: 6.9e-10, B: 0.29569499484, C: 10175.2578735752},
+		{A: 6.1e-10, B: 4.80385549281, C: 19889.76658032659},
+		{A: 6e-10, B: 4.56685040226, C: 30426.557543195},
+		{A: 6.2e-10, B: 4.16222812699, C: 42430.4857272918},
+		{A: 5e-10, B: 6.17899839001, C: 22779.4372461938},
+		{A: 4.8e-10, B: 1.52546758016, C: 20639.87018786},
+		{A: 4.6e-10, B: 4.41738494249, C: 34570.0663348526},
+		{A: 3.7e-10, B: 4.69675087759, C: 44007.8292697396},
+	},
+	// L1, 215 terms
+	{
+		{A: 10213.52943052898, B: 0, C: 0},
+		{A: 0.00095707712, B: 2.46424448979, C: 10213.285546211},
+		{A: 0.00014444977, B: 0.51624564679, C: 20426.571092422},
+		{A: 2.13374e-06, B: 1.79547929368, C: 30639.856638633},
+		{A: 1.51669e-06, B: 6.10635282369, C: 1577.3435424478},
+		{A: 1.73904e-06, B: 2.65535879443, C: 26.2983197998},
+		{A: 8.2233e-07, B: 5.7023413373, C: 191.4482661116},
+		{A: 6.9734e-07, B: 2.68136034979, C: 9437.762934887},
+		{A: 5.2408e-07, B: 3.60013087656, C: 775.522611324},
+		{A: 3.8318e-07, B: 1.03379038025, C: 529.6909650946},
+		{A: 2.9633e-07, B: 1.25056322354, C: 5507.5532386674},
+		{A: 2.5056e-07, B: 6.10664792855, C: 10404.7338123226},
+		{A: 1.7772e-07, B: 6.19369798901, C: 1109.3785520934},
+		{A: 1.651e-07, B: 2.6433045264, C: 7.1135470008},
+		{A: 1.423e-07, B: 5.45138233941, C: 9153.9036160218},
+		{A: 1.2607e-07, B: 1.24464400689, C: 40853.142184844},
+		{A: 1.1627e-07, B: 4.97604495371, C: 213.299095438},
+		{A: 1.2563e-07, B: 1.88122199199, C: 382.8965322232},
+		{A: 8.869e-08, B: 0.95282732248, C: 13367.9726311066},
+		{A: 7.374e-08, B: 4.3947676058, C: 10206.1719992102},
+		{A: 6.552e-08, B: 2.28168808058, C: 2352.8661537718},
+		{A: 6.255e-08, B: 4.08056644034, C: 3154.6870848956},
+		{A: 6.697e-08, B: 5.05673427795, C: 801.8209311238},
+		{A: 4.084e-08, B: 4.1210382603, C: 18837.49819713819},
+		{A: 4.882e-08, B: 3.44515199115, C: 11015.1064773348},
+		{A: 3.549e-08, B: 6.19934345402, C: 5.5229243074},
+		{A: 3.448e-08, B: 1.77405651704, C: 11322.6640983044},
+		{A: 4.29e-08, B: 0.0815480921, C: 6283.0758499914},
+		{A: 3.694e-08, B: 2.48453945256, C: 5661.3320491522},
+		{A: 3.555e-08, B: 1.4803694942, C: 1059.3819301892},
+		{A: 3.023e-08, B: 2.24092938317, C: 18073.7049386502},
+		{A: 3e-08, B: 0.39169917698, C: 15.252471185},
+		{A: 2.563e-08, B: 0.35147506973, C: 22003.9146348698},
+		{A: 2.774e-08, B: 1.45683830639, C: 10239.5838660108},
+		{A: 2.951e-08, B: 5.34618097429, C: 7084.8967811152},
+		{A: 2.344e-08, B: 2.36652432105, C: 17298.1823273262},
+		{A: 2.405e-08, B: 2.36085282088, C: 10596.1820784342},
+		{A: 1.72e-08, B: 4.72129626061, C: 10186.9872264112},
+		{A: 2.209e-08, B: 2.07730338665, C: 8635.9420037632},
+		{A: 2.122e-08, B: 4.47091605309, C: 8624.2126509272},
+		{A: 1.527e-08, B: 0.67146857292, C: 14143.4952424306},
+		{A: 1.473e-08, B: 2.59350470099, C: 7064.1213856228},
+		{A: 1.311e-08, B: 0.90408820221, C: 12566.1516999828},
+		{A: 1.474e-08, B: 5.92236241437, C: 9786.687355335},
+		{A: 1.237e-08, B: 2.59740787132, C: 4551.9534970588},
+		{A: 1.219e-08, B: 2.83617320088, C: 9676.4810341156},
+		{A: 1.116e-08, B: 3.83715584719, C: 21228.3920235458},
+		{A: 1.006e-08, B: 4.26200749078, C: 426.598190876},
+		{A: 1.15e-08, B: 2.35531987378, C: 9690.7081281172},
+		{A: 1.219e-08, B: 2.27324315182, C: 522.5774180938},
+		{A: 1.15e-08, B: 0.81088598778, C: 10742.9765113056},
+		{A: 1.101e-08, B: 3.74248783564, C: 18307.8072320436},
+		{A: 1.031e-08, B: 2.03889374176, C: 38.0276726358},
+		{A: 9.71e-09, B: 6.10590045414, C: 3532.0606928114},
+		{A: 8.44e-09, B: 4.75124127613, C: 10988.808157535},
+		{A: 9.08e-09, B: 1.06613723738, C: 10021.8372800994},
+		{A: 8.24e-09, B: 0.23090829723, C: 28286.9904848612},
+		{A: 8.21e-09, B: 2.60456032773, C: 19.66976089979},
+		{A: 7.28e-09, B: 0.10716917942, C: 4705.7323075436},
+		{A: 7.44e-09, B: 3.33129778857, C: 536.8045120954},
+		{A: 8.16e-09, B: 1.27303930175, C: 19896.8801273274},
+		{A: 9.29e-09, B: 1.08024621325, C: 11790.6290886588},
+		{A: 7.97e-09, B: 2.23891816523, C: 3723.508958923},
+		{A: 7.04e-09, B: 5.95307260017, C: 20.7753954924},
+		{A: 6.65e-09, B: 0.21346689192, C: 7860.4193924392},
+		{A: 7.33e-09, B: 2.22147883292, C: 19360.07561523199},
+		{A: 7.02e-09, B: 1.76206343944, C: 19374.3027092336},
+		{A: 5.75e-09, B: 2.38792087791, C: 6770.7106012456},
+		{A: 5.38e-09, B: 1.52023264138, C: 25934.1243310894},
+		{A: 6.9e-09, B: 4.01873754171, C: 19651.048481098},
+		{A: 5.32e-09, B: 4.4157613089, C: 574.3447983348},
+		{A: 5.4e-09, B: 2.15936134728, C: 16496.3613962024},
+		{A: 5.76e-09, B: 5.41170044566, C: 206.1855484372},
+		{A: 4.82e-09, B: 0.40815793538, C: 3340.6124266998},
+		{A: 5.01e-09, B: 3.08578363577, C: 245.8316462294},
+		{A: 4.88e-09, B: 5.22311611589, C: 25158.6017197654},
+		{A: 4.5e-09, B: 0.212798446, C: 11.729352836},
+		{A: 4.32e-09, B: 1.32004964493, C: 103.0927742186},
+		{A: 4.34e-09, B: 5.91094755233, C: 19786.67380610799},
+		{A: 5.64e-09, B: 0.38776462529, C: 19367.1891622328},
+		{A: 4.21e-09, B: 2.71057839701, C: 13936.794505134},
+		{A: 5.49e-09, B: 6.08792865644, C: 3930.2096962196},
+		{A: 4.78e-09, B: 4.70234715828, C: 14945.3161735544},
+		{A: 4.08e-09, B: 4.80890663927, C: 32217.2001810808},
+		{A: 4.04e-09, B: 2.85003595942, C: 29864.334027309},
+		{A: 4.07e-09, B: 2.94002049006, C: 10220.3990932118},
+		{A: 3.59e-09, B: 0.72354778897, C: 419.4846438752},
+		{A: 4.49e-09, B: 1.44520508753, C: 8662.240323563},
+		{A: 3.53e-09, B: 2.22195492336, C: 51066.427731055},
+		{A: 3.24e-09, B: 1.40308439067, C: 29580.4747084438},
+		{A: 4.43e-09, B: 1.93864353398, C: 9146.790069021},
+		{A: 3.14e-09, B: 0.96837035284, C: 20618.0193585336},
+		{A: 3.24e-09, B: 5.10759068171, C: 24356.7807886416},
+		{A: 3.24e-09, B: 1.80146948625, C: 18830.38465013739},
+		{A: 3.7e-09, B: 6.16895004656, C: 2218.7571041868},
+		{A: 2.78e-09, B: 2.20429108375, C: 18844.61174413899},
+		{A: 2.86e-09, B: 3.08459438435, C: 17277.4069318338},
+		{A: 3.83e-09, B: 0.13890934755, C: 4732.0306273434},
+		{A: 2.92e-09, B: 0.43528982259, C: 29088.811415985},
+		{A: 2.73e-09, B: 5.84415407168, C: 9573.388259897},
+		{A: 3.24e-09, B: 2.14144542781, C: 9999.986450773},
+		{A: 2.64e-09, B: 5.20407029554, C: 220.4126424388},
+		{A: 2.54e-09, B: 0.34411959301, C: 28521.0927782546},
+		{A: 3e-09, B: 3.76014360906, C: 8094.5216858326},
+		{A: 3.01e-09, B: 3.64457981649, C: 20400.2727726222},
+		{A: 2.87e-09, B: 1.84003536598, C: 1589.0728952838},
+		{A: 2.06e-09, B: 0.97167234723, C: 10234.0609417034},
+		{A: 2.12e-09, B: 0.241736776, C: 36.0278666774},
+		{A: 2.16e-09, B: 5.8861892303, C: 18875.525869774},
+		{A: 1.98e-09, B: 1.89506914939, C: 20452.8694122218},
+		{A: 2.58e-09, B: 6.27611355094, C: 1551.045222648},
+		{A: 1.97e-09, B: 2.09222675324, C: 9683.5945811164},
+		{A: 2.17e-09, B: 5.79472589364, C: 9103.9069941176},
+		{A: 1.88e-09, B: 0.39123199129, C: 19573.37471066999},
+		{A: 1.95e-09, B: 6.23142464829, C: 30110.1656735384},
+		{A: 1.87e-09, B: 5.49670351645, C: 170.6728706192},
+		{A: 1.78e-09, B: 4.90042854659, C: 10787.6303445458},
+		{A: 1.88e-09, B: 1.62614804098, C: 9161.0171630226},
+		{A: 2.11e-09, B: 2.71884568392, C: 15720.8387848784},
+		{A: 1.77e-09, B: 1.88170417337, C: 33019.0211122046},
+		{A: 2.09e-09, B: 2.66033422116, C: 3442.5749449654},
+		{A: 1.64e-09, B: 4.92240093026, C: 10426.584641649},
+		{A: 1.86e-09, B: 5.13678812068, C: 7255.5696517344},
+		{A: 1.77e-09, B: 5.70206821967, C: 9992.8729037722},
+		{A: 2.14e-09, B: 2.70027196648, C: 3128.3887650958},
+		{A: 2.08e-09, B: 3.38876526854, C: 17778.11626694899},
+		{A: 1.47e-09, B: 4.25008782855, C: 16983.9961474566},
+		{A: 1.48e-09, B: 3.4640441813, C: 21202.093703746},
+		{A: 1.89e-09, B: 1.43553862242, C: 2379.1644735716},
+		{A: 1.39e-09, B: 2.99154379541, C: 110.2063212194},
+		{A: 1.59e-09, B: 5.23851679605, C: 10007.0999977738},
+		{A: 1.36e-09, B: 0.88942869764, C: 22805.7355659936},
+		{A: 1.55e-09, B: 5.90500835975, C: 12592.4500197826},
+		{A: 1.51e-09, B: 0.03422618975, C: 27991.40181316},
+		{A: 1.53e-09, B: 4.01743770323, C: 33794.5437235286},
+		{A: 1.21e-09, B: 0.51392111799, C: 21535.9496445154},
+		{A: 1.09e-09, B: 2.25388616761, C: 26735.9452622132},
+		{A: 1.09e-09, B: 0.78612823474, C: 6681.2248533996},
+		{A: 1.22e-09, B: 4.84805105466, C: 19992.85935454519},
+		{A: 1.12e-09, B: 3.31796669604, C: 36949.2308084242},
+		{A: 1.06e-09, B: 3.34507236765, C: 10103.0792249916},
+		{A: 1.14e-09, B: 4.36384000196, C: 20007.0864485468},
+		{A: 9.8e-10, B: 5.07711736751, C: 135.62532501},
+		{A: 1.2e-09, B: 5.41870615047, C: 37724.7534197482},
+		{A: 1.03e-09, B: 2.62610244425, C: 20213.271996984},
+		{A: 8.5e-10, B: 5.04808202087, C: 9830.3890139878},
+		{A: 1.03e-09, B: 2.01549383816, C: 45585.1728121874},
+		{A: 8.8e-10, B: 2.62613816931, C: 21.8508293264},
+		{A: 8.4e-10, B: 3.50355880173, C: 639.897286314},
+		{A: 9.9e-10, B: 0.61079620895, C: 41654.9631159678},
+		{A: 8.8e-10, B: 3.63836700262, C: 49515.382508407},
+		{A: 9.8e-10, B: 2.42401801881, C: 23581.2581773176},
+		{A: 8.1e-10, B: 0.46468679835, C: 77.673770428},
+		{A: 9.2e-10, B: 4.82530051729, C: 29043.67019634839},
+		{A: 1.02e-09, B: 4.27051236894, C: 15874.6175953632},
+		{A: 9e-10, B: 4.34075776744, C: 29057.89729034999},
+		{A: 8.1e-10, B: 0.01896422336, C: 24150.080051345},
+		{A: 9.3e-10, B: 1.79250830018, C: 12432.0426503978},
+		{A: 8.7e-10, B: 5.25157021446, C: 14128.2427712456},
+		{A: 8.9e-10, B: 5.65756996753, C: 377.3736079158},
+		{A: 9.7e-10, B: 5.67942873241, C: 227.476132789},
+		{A: 7.6e-10, B: 2.93363913259, C: 38204.687359371},
+		{A: 9.1e-10, B: 2.60544242067, C: 1052.2683831884},
+		{A: 8.7e-10, B: 3.82284200928, C: 27511.4678735372},
+		{A: 7.3e-10, B: 4.75280755154, C: 40879.4405046438},
+		{A: 6.7e-10, B: 3.54815262526, C: 30666.1549584328},
+		{A: 6.7e-10, B: 5.81350818057, C: 20809.4676246452},
+		{A: 6.4e-10, B: 4.24772678145, C: 153.7788104848},
+		{A: 6.4e-10, B: 2.99454749109, C: 27197.2816936676},
+		{A: 7e-10, B: 4.03868009742, C: 56600.2792895222},
+		{A: 7.1e-10, B: 4.3362880685, C: 39264.0692895602},
+		{A: 6.9e-10, B: 1.73648747605, C: 37410.5672398786},
+		{A: 6.5e-10, B: 1.08206062736, C: 68050.42387851159},
+		{A: 6.2e-10, B: 4.7769845465, C: 3914.9572250346},
+		{A: 6.1e-10, B: 4.96121014691, C: 34596.3646546524},
+		{A: 6.3e-10, B: 5.04865067599, C: 53445.5922046266},
+		{A: 5.8e-10, B: 3.74010494151, C: 1066.49547719},
+		{A: 5.7e-10, B: 5.39355890141, C: 20419.45754542119},
+		{A: 5.7e-10, B: 3.59399518494, C: 735.8765135318},
+		{A: 6.5e-10, B: 2.10322000074, C: 74.7815985673},
+		{A: 7.3e-10, B: 1.31083648835, C: 11272.6674764002},
+		{A: 5.5e-10, B: 1.33161298098, C: 18300.69368504279},
+		{A: 6.5e-10, B: 4.21150522641, C: 49.7570254718},
+		{A: 6.1e-10, B: 5.66161679402, C: 17468.8551979454},
+		{A: 5.3e-10, B: 4.30231233835, C: 18849.2275499742},
+		{A: 5.5e-10, B: 2.63906959481, C: 52670.0695933026},
+		{A: 5e-10, B: 5.69803054279, C: 39793.7602546548},
+		{A: 4.9e-10, B: 0.77345264124, C: 35371.8872659764},
+		{A: 4.8e-10, B: 6.00565977593, C: 283.8593188652},
+		{A: 4.7e-10, B: 2.63299859494, C: 51868.2486621788},
+		{A: 4.6e-10, B: 0.05105081843, C: 38526.574350872},
+		{A: 5e-10, B: 4.37549274002, C: 28513.97923125379},
+		{A: 4.6e-10, B: 2.93422086586, C: 27682.1407441564},
+		{A: 5.1e-10, B: 5.45979584751, C: 60530.4889857418},
+		{A: 4.5e-10, B: 5.59492908223, C: 467.9649903544},
+		{A: 4.5e-10, B: 2.34680401001, C: 9411.4646150872},
+		{A: 4.5e-10, B: 0.02999265111, C: 44809.6502008634},
+		{A: 4.3e-10, B: 5.62725673544, C: 14.2270940016},
+		{A: 4.7e-10, B: 3.73567275749, C: 64460.6986819614},
+		{A: 4.6e-10, B: 0.12586526756, C: 57375.8019008462},
+		{A: 4.4e-10, B: 2.03114426076, C: 18314.9207790444},
+		{A: 3.9e-10, B: 0.99375127466, C: 94138.32702008578},
+		{A: 5.3e-10, B: 0.41974404621, C: 30831.3049047446},
+		{A: 5.5e-10, B: 1.38351566741, C: 38500.2760310722},
+		{A: 4.1e-10, B: 4.47012768909, C: 40077.61957352},
+		{A: 4.1e-10, B: 0.36665992484, C: 19999.97290154599},
+		{A: 4e-10, B: 3.06358586355, C: 813.5502839598},
+		{A: 4e-10, B: 2.16802870803, C: 59728.668054618},
+		{A: 3.7e-10, B: 1.08739100421, C: 17085.9586657222},
+		{A: 3.9e-10, B: 1.31040309875, C: 48739.859897083},
+		{A: 3.6e-10, B: 1.43280677914, C: 42456.7840470916},
+		{A: 3.7e-10, B: 0.14190533464, C: 29050.7837433492},
+		{A: 3.7e-10, B: 3.66792179278, C: 20956.2620575166},
+		{A: 2.5e-10, B: 3.38876180652, C: 7058.5984613154},
+		{A: 3.1e-10, B: 6.16829805337, C: 10192.5101507186},
+	},
+	// L2, 70 terms
+	{
+		{A: 0.00054127076, B: 0, C: 0},
+		{A: 3.89146e-05, B: 0.34514360047, C: 10213.285546211},
+		{A: 1.33788e-05, B: 2.02011286082, C: 20426.571092422},
+		{A: 2.3836e-07, B: 2.04592119012, C: 26.2983197998},
+		{A: 1.9331e-07, B: 3.53527371458, C: 30639.856638633},
+		{A: 9.984e-08, B: 3.97130221102, C: 775.522611324},
+		{A: 7.046e-08, B: 1.51962593409, C: 1577.3435424478},
+		{A: 6.014e-08, B: 0.99926757893, C: 191.4482661116},
+		{A: 3.163e-08, B: 4.36095475762, C: 9437.762934887},
+		{A: 2.125e-08, B: 2.65810625752, C: 40853.142184844},
+		{A: 1.934e-08, B: 3.39287946981, C: 382.8965322232},
+		{A: 1.46e-08, B: 6.04899046273, C: 529.6909650946},
+		{A: 1.346e-08, B: 2.94633106219, C: 5507.5532386674},
+		{A: 1.025e-08, B: 1.40598904981, C: 10404.7338123226},
+		{A: 1.221e-08, B: 3.73339139385, C: 3154.6870848956},
+		{A: 1.033e-08, B: 3.52858472904, C: 11015.1064773348},
+		{A: 9.55e-09, B: 5.11133878923, C: 801.8209311238},
+		{A: 7.42e-09, B: 1.49198584483, C: 1109.3785520934},
+		{A: 5.25e-09, B: 3.32087042103, C: 213.299095438},
+		{A: 5.78e-09, B: 0.92614279843, C: 10239.5838660108},
+		{A: 6.02e-09, B: 5.19220099775, C: 7084.8967811152},
+		{A: 4.31e-09, B: 2.67159914364, C: 13367.9726311066},
+		{A: 3.89e-09, B: 4.14116341739, C: 8635.9420037632},
+		{A: 3.55e-09, B: 1.12061570874, C: 9153.9036160218},
+		{A: 3.01e-09, B: 3.90047984197, C: 10596.1820784342},
+		{A: 2.12e-09, B: 5.32697688872, C: 18837.49819713819},
+		{A: 2.6e-09, B: 0.22761369281, C: 2352.8661537718},
+		{A: 2.43e-09, B: 4.70747902991, C: 6283.0758499914},
+		{A: 1.96e-09, B: 4.10467294392, C: 11790.6290886588},
+		{A: 1.94e-09, B: 6.0119775947, C: 7860.4193924392},
+		{A: 1.4e-09, B: 4.97015671653, C: 14143.4952424306},
+		{A: 1.34e-09, B: 4.10529011674, C: 17298.1823273262},
+		{A: 1.19e-09, B: 3.39375528828, C: 11322.6640983044},
+		{A: 1.26e-09, B: 0.0985451614, C: 18073.7049386502},
+		{A: 1.22e-09, B: 5.92478855457, C: 574.3447983348},
+		{A: 1.07e-09, B: 0.35660030184, C: 1059.3819301892},
+		{A: 1.08e-09, B: 2.25352052666, C: 12566.1516999828},
+		{A: 9.3e-10, B: 5.48716819776, C: 10021.8372800994},
+		{A: 8.4e-10, B: 4.89744332968, C: 18307.8072320436},
+		{A: 7.4e-10, B: 2.35354025573, C: 426.598190876},
+		{A: 9.3e-10, B: 4.99316908815, C: 14945.3161735544},
+		{A: 6.9e-10, B: 3.8640906586, C: 51066.427731055},
+		{A: 8.2e-10, B: 5.36280178643, C: 10186.9872264112},
+		{A: 7.7e-10, B: 3.75728548372, C: 3723.508958923},
+		{A: 6.3e-10, B: 5.39882267787, C: 21228.3920235458},
+		{A: 5.6e-10, B: 4.11564786973, C: 7064.1213856228},
+		{A: 5.6e-10, B: 6.26920407723, C: 32217.2001810808},
+		{A: 6e-10, B: 5.02186497542, C: 19367.1891622328},
+		{A: 5.8e-10, B: 5.1326370967, C: 20400.2727726222},
+		{A: 5.1e-10, B: 4.52870390511, C: 22003.9146348698},
+		{A: 4.1e-10, B: 3.83822107919, C: 16496.3613962024},
+		{A: 4.1e-10, B: 3.36020411807, C: 4705.7323075436},
+		{A: 4.3e-10, B: 5.98371820588, C: 15720.8387848784},
+		{A: 4.7e-10, B: 0.18498155367, C: 18875.525869774},
+		{A: 3.8e-10, B: 0.52232581277, C: 1551.045222648},
+		{A: 3.9e-10, B: 5.05391675878, C: 10742.9765113056},
+		{A: 3.6e-10, B: 3.16242472203, C: 20452.8694122218},
+		{A: 3.5e-10, B: 5.17462577483, C: 29088.811415985},
+		{A: 3.5e-10, B: 3.47325394141, C: 24356.7807886416},
+		{A: 3.1e-10, B: 4.74511706231, C: 28521.0927782546},
+		{A: 2.9e-10, B: 0.19383091192, C: 19896.8801273274},
+		{A: 3.3e-10, B: 1.80059867635, C: 20618.0193585336},
+		{A: 2.4e-10, B: 0.14022912457, C: 21202.093703746},
+		{A: 2.2e-10, B: 4.73565067573, C: 10988.808157535},
+		{A: 1.8e-10, B: 0.74039763161, C: 25158.6017197654},
+		{A: 1.9e-10, B: 1.53770232218, C: 28286.9904848612},
+		{A: 1.4e-10, B: 1.49084059765, C: 30110.1656735384},
+		{A: 1.3e-10, B: 4.72171283479, C: 29864.334027309},
+		{A: 1.3e-10, B: 5.79700427846, C: 29580.4747084438},
+		{A: 1.4e-10, B: 3.6920522501, C: 27511.4678735372},
+	},
+	// L3, 9 terms
+	{
+		{A: 1.35742e-06, B: 4.80389020993, C: 10213.285546211},
+		{A: 7.7846e-07, B: 3.66876371591, C: 20426.571092422},
+		{A: 2.6023e-07, B: 0, C: 0},
+		{A: 1.214e-08, B: 5.31970006917, C: 30639.856638633},
+		{A: 2.54e-09, B: 4.15021671822, C: 40853.142184844},
+		{A: 8e-11, B: 5.55523563261, C: 51066.427731055},
+		{A: 8e-11, B: 1.40501229148, C: 1577.3435424478},
+		{A: 6e-11, B: 1.27791479726, C: 10404.7338123226},
+		{A: 6e-11, B: 5.76447068962, C: 10239.5838660108},
+	},
+	// L4, 5 terms
+	{
+		{A: 1.14016e-06, B: 3.14159265359, C: 0},
+		{A: 3.209e-08, B: 5.20514170164, C: 20426.571092422},
+		{A: 1.714e-08, B: 2.51099591706, C: 10213.285546211},
+		{A: 5e-10, B: 0.71356059861, C: 30639.856638633},
+		{A: 2.3e-10, B: 5.68127607034, C: 40853.142184844},
+	},
+	// L5, 5 terms
+	{
+		{A: 8.74e-09, B: 3.14159265359, C: 0},
+		{A: 1.17e-09, B: 0.54643013, C: 20426.571092422},
+		{A: 1.18e-09, B: 1.90548541922, C: 10213.285546211},
+		{A: 2e-11, B: 1.07734277826, C: 40853.142184844},
+		{A: 2e-11, B: 1.89460223529, C: 30639.856638633},
+	},
+}
+
+// GetVenusL0 evaluates the t^0 series of L.
+func GetVenusL0(t float64) float64 {
+	return venusL[0].Evaluate(t)
+}
+
+// GetVenusL1 evaluates the t^1 series of L.
+func GetVenusL1(t float64) float64 {
+	return venusL[1].Evaluate(t)
+}
+
+// GetVenusL2 evaluates the t^2 series of L.
+func GetVenusL2(t float64) float64 {
+	return venusL[2].Evaluate(t)
+}
+
+// GetVenusL3 evaluates the t^3 series of L.
+func GetVenusL3(t float64) float64 {
+	return venusL[3].Evaluate(t)
+}
+
+// GetVenusL4 evaluates the t^4 series of L.
+func GetVenusL4(t float64) float64 {
+	return venusL[4].Evaluate(t)
+}
+
+// GetVenusL5 evaluates the t^5 series of L.
+func GetVenusL5(t float64) float64 {
+	return venusL[5].Evaluate(t)
+}
@@ -0,0 +1,644 @@
+// Code generated by vsop87gen from vsop87d.txt; DO NOT EDIT.
+
+// VENUS R - VSOP87 (Bretagnon & Francou, A&A 202, 309, 1988).
+// t = (JD - 2451545) / 365250
+
+package vsop87venusd
+
+import "vsop87"
+
+var venusR = []vsop87.Series{
+	// R0, 330 terms
+	{
+		{A: 0.72334820905, B: 0, C: 0},
+		{A: 0.00489824185, B: 4.02151832268, C: 10213.285546211},
+		{A: 1.658058e-05, B: 4.90206728012, C: 20426.571092422},
+		{A: 1.632093e-05, B: 2.84548851892, C: 7860.4193924392},
+		{A: 1.378048e-05, B: 1.128465906, C: 11790.6290886588},
+		{A: 4.98399e-06, B: 2.58682187717, C: 9683.5945811164},
+		{A: 3.73958e-06, B: 1.42314837063, C: 3930.2096962196},
+		{A: 2.63616e-06, B: 5.5293818592, C: 9437.762934887},
+		{A: 2.37455e-06, B: 2.55135903978, C: 15720.8387848784},
+		{A: 2.21983e-06, B: 2.01346776772, C: 19367.1891622328},
+		{A: 1.19467e-06, B: 3.01975365264, C: 10404.7338123226},
+		{A: 1.25896e-06, B: 2.72769833559, C: 1577.3435424478},
+		{A: 7.6178e-07, B: 1.59577224486, C: 9153.9036160218},
+		{A: 8.5336e-07, B: 3.98607953754, C: 19651.048481098},
+		{A: 7.4347e-07, B: 4.11957854039, C: 5507.5532386674},
+		{A: 4.1904e-07, B: 1.64273363458, C: 18837.49819713819},
+		{A: 4.2493e-07, B: 3.81864530735, C: 13367.9726311066},
+		{A: 3.943e-07, B: 5.39019422358, C: 23581.2581773176},
+		{A: 2.9042e-07, B: 5.67739528728, C: 5661.3320491522},
+		{A: 2.7555e-07, B: 5.72392407794, C: 775.522611324},
+		{A: 2.7283e-07, B: 4.82151812709, C: 11015.1064773348},
+		{A: 3.1274e-07, B: 2.31806719544, C: 9999.986450773},
+		{A: 1.97e-07, B: 4.96157560245, C: 11322.6640983044},
+		{A: 1.9809e-07, B: 0.53189326492, C: 27511.4678735372},
+		{A: 1.3567e-07, B: 3.75530870628, C: 18073.7049386502},
+		{A: 1.2921e-07, B: 1.13381083556, C: 10206.1719992102},
+		{A: 1.6215e-07, B: 0.5645383429, C: 529.6909650946},
+		{A: 1.1821e-07, B: 5.09025877427, C: 3154.6870848956},
+		{A: 1.1728e-07, B: 0.23432298744, C: 7084.8967811152},
+		{A: 1.3079e-07, B: 5.24353197586, C: 17298.1823273262},
+		{A: 1.318e-07, B: 3.37207825651, C: 13745.3462390224},
+		{A: 9.097e-08, B: 3.07004895769, C: 1109.3785520934},
+		{A: 1.0818e-07, B: 2.45024712908, C: 10239.5838660108},
+		{A: 1.1438e-07, B: 4.56838894696, C: 29050.7837433492},
+		{A: 8.377e-08, B: 5.78327612352, C: 30639.856638633},
+		{A: 8.193e-08, B: 1.9502311186, C: 22003.9146348698},
+		{A: 9.308e-08, B: 1.61615909286, C: 2352.8661537718},
+		{A: 1.0652e-07, B: 1.9552839614, C: 31441.6775697568},
+		{A: 1.0357e-07, B: 1.20234990061, C: 15874.6175953632},
+		{A: 9.585e-08, B: 1.46639856228, C: 19999.97290154599},
+		{A: 6.506e-08, B: 2.17390732263, C: 14143.4952424306},
+		{A: 7.562e-08, B: 1.13789564977, C: 8624.2126509272},
+		{A: 6.434e-08, B: 0.84419623033, C: 6283.0758499914},
+		{A: 5.898e-08, B: 0.0109373111, C: 8635.9420037632},
+		{A: 5.632e-08, B: 3.94956548631, C: 12566.1516999828},
+		{A: 5.523e-08, B: 1.27394296557, C: 18307.8072320436},
+		{A: 4.488e-08, B: 2.47835729057, C: 191.4482661116},
+		{A: 4.529e-08, B: 4.730277704, C: 19896.8801273274},
+		{A: 6.193e-08, B: 3.25881250939, C: 6872.6731195112},
+		{A: 6.07e-08, B: 0.35337419942, C: 21228.3920235458},
+		{A: 4.315e-08, B: 2.59737099519, C: 4551.9534970588},
+		{A: 6.005e-08, B: 3.37874723475, C: 35371.8872659764},
+		{A: 3.852e-08, B: 1.01162850357, C: 9786.687355335},
+		{A: 4.033e-08, B: 0.0005085558, C: 801.8209311238},
+		{A: 3.92e-08, B: 5.56542869407, C: 10596.1820784342},
+		{A: 2.709e-08, B: 5.80195530112, C: 7064.1213856228},
+		{A: 3.216e-08, B: 0.39767254848, C: 10186.9872264112},
+		{A: 3.089e-08, B: 6.26174762876, C: 14945.3161735544},
+		{A: 2.982e-08, B: 4.21196716354, C: 28521.0927782546},
+		{A: 3.284e-08, B: 0.70709821006, C: 10742.9765113056},
+		{A: 3.484e-08, B: 4.79878191875, C: 39302.096962196},
+		{A: 3.172e-08, B: 1.80518954174, C: 25158.6017197654},
+		{A: 2.463e-08, B: 0.68708153678, C: 10988.808157535},
+		{A: 2.374e-08, B: 3.77948685343, C: 21535.9496445154},
+		{A: 2.198e-08, B: 2.82996372521, C: 8662.240323563},
+		{A: 1.958e-08, B: 5.41763804167, C: 16496.3613962024},
+		{A: 1.876e-08, B: 2.63426768393, C: 29580.4747084438},
+		{A: 1.902e-08, B: 2.85782199133, C: 3532.0606928114},
+		{A: 1.706e-08, B: 3.67573010379, C: 26.2983197998},
+		{A: 1.817e-08, B: 0.41611036449, C: 4705.7323075436},
+		{A: 1.858e-08, B: 1.50368318296, C: 10021.8372800994},
+		{A: 2.087e-08, B: 6.22112874639, C: 43232.3066584156},
+		{A: 1.95e-08, B: 2.21447019683, C: 19786.67380610799},
+		{A: 1.497e-08, B: 0.00134773824, C: 17277.4069318338},
+		{A: 1.819e-08, B: 3.23144993268, C: 29088.811415985},
+		{A: 1.423e-08, B: 5.85979618707, C: 9676.4810341156},
+		{A: 1.223e-08, B: 5.55818994329, C: 6770.7106012456},
+		{A: 1.14e-08, B: 5.92088900094, C: 13936.794505134},
+		{A: 1.484e-08, B: 2.47665429253, C: 31749.2351907264},
+		{A: 1.185e-08, B: 1.42087628351, C: 4732.0306273434},
+		{A: 1.323e-08, B: 2.48821075422, C: 9690.7081281172},
+		{A: 1.249e-08, B: 1.88323673734, C: 19374.3027092336},
+		{A: 1.27e-08, B: 5.24647873116, C: 19360.07561523199},
+		{A: 1.402e-08, B: 5.17536780118, C: 10316.3783204296},
+		{A: 1.042e-08, B: 3.05454698508, C: 25934.1243310894},
+		{A: 1.174e-08, B: 1.42913732999, C: 18875.525869774},
+		{A: 1.278e-08, B: 1.35747287297, C: 47162.5163546352},
+		{A: 9.17e-09, B: 6.26337648765, C: 20618.0193585336},
+		{A: 9.05e-09, B: 1.12740203561, C: 12592.4500197826},
+		{A: 1.093e-08, B: 4.64451720605, C: 33019.0211122046},
+		{A: 1.014e-08, B: 1.09259406433, C: 1059.3819301892},
+		{A: 7.83e-09, B: 2.02118183873, C: 24356.7807886416},
+		{A: 7.79e-09, B: 0.4158527401, C: 3340.6124266998},
+		{A: 7e-09, B: 1.14936815714, C: 16983.9961474566},
+		{A: 8.78e-09, B: 0.87852464964, C: 38734.3783244656},
+		{A: 6.23e-09, B: 0.89976912165, C: 17778.11626694899},
+		{A: 6.08e-09, B: 1.58476225197, C: 9573.388259897},
+		{A: 8e-09, B: 3.94213003073, C: 10138.5039476437},
+		{A: 7.6e-09, B: 1.31851313748, C: 9967.4538999816},
+		{A: 8.02e-09, B: 2.78173370208, C: 51092.7260508548},
+		{A: 6.64e-09, B: 4.458646824, C: 3128.3887650958},
+		{A: 6.74e-09, B: 5.11214939998, C: 382.8965322232},
+		{A: 5.3e-09, B: 0.85392938403, C: 10234.0609417034},
+		{A: 5.09e-09, B: 3.56809374595, C: 28286.9904848612},
+		{A: 6e-09, B: 4.25927726907, C: 41962.5207369374},
+		{A: 6.01e-09, B: 5.78144137895, C: 213.299095438},
+		{A: 5.95e-09, B: 2.83045104588, C: 22805.7355659936},
+		{A: 6.73e-09, B: 6.06079908421, C: 36949.2308084242},
+		{A: 5.35e-09, B: 5.85422519711, C: 9103.9069941176},
+		{A: 5.44e-09, B: 5.448060748, C: 3723.508958923},
+		{A: 4.92e-09, B: 3.83802404893, C: 27991.40181316},
+		{A: 6.35e-09, B: 0.76494024849, C: 8094.5216858326},
+		{A: 4.34e-09, B: 6.22214487735, C: 27197.2816936676},
+		{A: 4.59e-09, B: 3.55062885479, C: 20213.271996984},
+		{A: 3.98e-09, B: 6.16269975784, C: 10426.584641649},
+		{A: 3.78e-09, B: 2.41665947591, C: 18844.61174413899},
+		{A: 4.21e-09, B: 4.86552697954, C: 9146.790069021},
+		{A: 5e-09, B: 4.20351458644, C: 55022.9357470744},
+		{A: 4.04e-09, B: 4.95834410782, C: 37410.5672398786},
+		{A: 4.02e-09, B: 2.97963246945, C: 10220.3990932118},
+		{A: 4.64e-09, B: 2.59869499733, C: 18734.4054229196},
+		{A: 3.52e-09, B: 0.08963076359, C: 10103.0792249916},
+		{A: 3.48e-09, B: 4.90260339364, C: 18830.38465013739},
+		{A: 3.38e-09, B: 3.22520096478, C: 24150.080051345},
+		{A: 3.75e-09, B: 6.17532088136, C: 26087.9031415742},
+		{A: 4.25e-09, B: 1.2005257828, C: 40879.4405046438},
+		{A: 4.08e-09, B: 3.12833060705, C: 9050.8108418032},
+		{A: 3.85e-09, B: 1.94284690176, C: 283.8593188652},
+		{A: 3.37e-09, B: 4.87838699272, C: 12432.0426503978},
+		{A: 3.26e-09, B: 4.27369741426, C: 26735.9452622132},
+		{A: 3.09e-09, B: 0.50597475053, C: 38204.687359371},
+		{A: 3.29e-09, B: 3.88430599153, C: 29864.334027309},
+		{A: 3.13e-09, B: 1.36138752543, C: 10192.5101507186},
+		{A: 3.47e-09, B: 3.58439807209, C: 27490.6924780448},
+		{A: 2.51e-09, B: 3.78618457047, C: 10063.7223490764},
+		{A: 2.44e-09, B: 3.83523342668, C: 9411.4646150872},
+		{A: 2.81e-09, B: 4.50895206233, C: 32217.2001810808},
+		{A: 2.37e-09, B: 0.87748812245, C: 6681.2248533996},
+		{A: 3.15e-09, B: 5.62657778233, C: 58953.145443294},
+		{A: 3.11e-09, B: 4.15626121491, C: 10175.1525105732},
+		{A: 2.47e-09, B: 2.53637594113, C: 16522.6597160022},
+		{A: 2.19e-09, B: 5.08729383251, C: 7058.5984613154},
+		{A: 2.91e-09, B: 3.72567217056, C: 29999.959352319},
+		{A: 2.67e-09, B: 2.97685503991, C: 19573.37471066999},
+		{A: 2.8e-09, B: 3.70200084294, C: 47623.8527860896},
+		{A: 2.39e-09, B: 3.94545782067, C: 9580.5018068978},
+		{A: 2.46e-09, B: 2.1824488393, C: 9161.0171630226},
+		{A: 2.53e-09, B: 2.69506547016, C: 3442.5749449654},
+		{A: 2.65e-09, B: 2.62811801237, C: 44809.6502008634},
+		{A: 1.94e-09, B: 4.78926136175, C: 33794.5437235286},
+		{A: 1.87e-09, B: 3.65620881095, C: 20452.8694122218},
+		{A: 2.24e-09, B: 2.43601863127, C: 9992.8729037722},
+		{A: 1.93e-09, B: 2.55112161845, C: 2379.1644735716},
+		{A: 2.01e-09, B: 1.90356905733, C: 1551.045222648},
+		{A: 1.76e-09, B: 4.29837616553, C: 10137.0194749354},
+		{A: 1.84e-09, B: 6.16061560223, C: 36147.4098773004},
+		{A: 1.75e-09, B: 2.7198479704, C: 20809.4676246452},
+		{A: 1.86e-09, B: 2.55098927966, C: 14919.0178537546},
+		{A: 1.61e-09, B: 4.13272567123, C: 23958.6317852334},
+		{A: 2.21e-09, B: 4.83552377614, C: 20277.0078952874},
+		{A: 1.6e-09, B: 1.81472642729, C: 10787.6303445458},
+		{A: 1.99e-09, B: 5.7425979833, C: 30666.1549584328},
+		{A: 1.6e-09, B: 4.46270605493, C: 18947.7045183576},
+		{A: 1.87e-09, B: 2.98688597588, C: 2218.7571041868},
+		{A: 1.89e-09, B: 5.34607810282, C: 10007.0999977738},
+		{A: 1.98e-09, B: 0.77846666692, C: 62883.3551395136},
+		{A: 1.44e-09, B: 5.00261963924, C: 9264.1099372412},
+		{A: 1.71e-09, B: 2.05212624568, C: 7255.5696517344},
+		{A: 1.88e-09, B: 4.08173534559, C: 48739.859897083},
+		{A: 1.46e-09, B: 3.94191715702, C: 6309.3741697912},
+		{A: 1.46e-09, B: 5.06313558118, C: 39264.0692895602},
+		{A: 1.35e-09, B: 5.93689169614, C: 37724.7534197482},
+		{A: 1.39e-09, B: 2.81266025896, C: 20.7753954924},
+		{A: 1.77e-09, B: 5.16224804657, C: 9835.9119382952},
+		{A: 1.19e-09, B: 1.37254262864, C: 40077.61957352},
+		{A: 1.2e-09, B: 0.21443767468, C: 31022.7531708562},
+		{A: 1.28e-09, B: 2.92458887798, C: 7.1135470008},
+		{A: 1.5e-09, B: 5.73646272556, C: 632.7837393132},
+		{A: 1.06e-09, B: 0.62224833817, C: 11272.6674764002},
+		{A: 1.14e-09, B: 2.6330132652, C: 17468.8551979454},
+		{A: 1.23e-09, B: 6.22518843711, C: 53285.1848352418},
+		{A: 1.07e-09, B: 1.172589789, C: 43071.8992890308},
+		{A: 1.03e-09, B: 1.09613781581, C: 41654.9631159678},
+		{A: 1.09e-09, B: 2.01412667085, C: 20419.45754542119},
+		{A: 1.02e-09, B: 4.23406964348, C: 10251.3132188468},
+		{A: 1.16e-09, B: 1.27731728606, C: 10199.0584522094},
+		{A: 1.03e-09, B: 5.25887538465, C: 9830.3890139878},
+		{A: 1.12e-09, B: 2.24436894064, C: 18204.71445782499},
+		{A: 1.11e-09, B: 2.23547857955, C: 8521.1198767086},
+		{A: 1.18e-09, B: 0.237542072, C: 10497.1448650762},
+		{A: 1.23e-09, B: 0.88054816668, C: 34596.3646546524},
+		{A: 1.02e-09, B: 4.3943864662, C: 18300.69368504279},
+		{A: 1.31e-09, B: 6.01711652115, C: 9367.2027114598},
+		{A: 1e-09, B: 5.00532389609, C: 10175.2578735752},
+		{A: 1.07e-09, B: 0.41270197502, C: 40853.142184844},
+		{A: 1.32e-09, B: 5.45008342761, C: 11506.7697697936},
+		{A: 9.8e-10, B: 1.07722950958, C: 13553.8979729108},
+		{A: 9.4e-10, B: 2.9172009759, C: 44007.8292697396},
+		{A: 9.7e-10, B: 1.04004223634, C: 68050.42387851159},
+		{A: 1.27e-09, B: 2.20215372683, C: 66813.5648357332},
+		{A: 1.11e-09, B: 1.57823839032, C: 29043.67019634839},
+		{A: 1.18e-09, B: 2.3326817689, C: 18314.9207790444},
+		{A: 9e-10, B: 2.42353056125, C: 32858.61374281979},
+		{A: 1.09e-09, B: 3.82796787296, C: 19470.28193645139},
+		{A: 1.11e-09, B: 4.47666957576, C: 29057.89729034999},
+		{A: 1.01e-09, B: 3.4152849366, C: 19264.0963880142},
+		{A: 9.2e-10, B: 3.66289799512, C: 22645.32819660879},
+		{A: 9.4e-10, B: 6.07530805791, C: 10846.0692855242},
+		{A: 1.14e-09, B: 4.02718653431, C: 7576.560073574},
+		{A: 8.7e-10, B: 6.01842459303, C: 17085.9586657222},
+		{A: 1.09e-09, B: 5.46886607309, C: 52670.0695933026},
+		{A: 1.07e-09, B: 0.54805946713, C: 34363.365597556},
+		{A: 1.08e-09, B: 5.44460610707, C: 19050.7972925762},
+		{A: 7.6e-10, B: 6.15177368654, C: 27682.1407441564},
+		{A: 1.07e-09, B: 4.80525404063, C: 8144.2787113044},
+		{A: 7.3e-10, B: 1.60549217847, C: 20956.2620575166},
+		{A: 9.7e-10, B: 5.1354205113, C: 22779.4372461938},
+		{A: 6.8e-10, B: 2.31300447144, C: 8631.326197928},
+		{A: 9.1e-10, B: 4.28652743953, C: 10110.1927719924},
+		{A: 9.3e-10, B: 5.27290609264, C: 522.5774180938},
+		{A: 7.1e-10, B: 3.6556596169, C: 11764.330768859},
+		{A: 8.9e-10, B: 1.79712963206, C: 45585.1728121874},
+		{A: 6.7e-10, B: 2.25900071584, C: 9360.089164459},
+		{A: 8.5e-10, B: 0.67062144972, C: 56600.2792895222},
+		{A: 8e-10, B: 1.58278081077, C: 19992.85935454519},
+		{A: 6.5e-10, B: 6.23472325597, C: 10419.4710946482},
+		{A: 6.4e-10, B: 0.53356325917, C: 17248.4253018544},
+		{A: 8.5e-10, B: 4.52011215904, C: 29786.660256881},
+		{A: 6.8e-10, B: 4.48235266554, C: 10632.7701900862},
+		{A: 6.4e-10, B: 4.33495700921, C: 47938.0389659592},
+		{A: 7.1e-10, B: 3.03858484137, C: 11787.1059703098},
+		{A: 8.7e-10, B: 4.81823063172, C: 2107.0345075424},
+		{A: 7e-10, B: 2.35648061034, C: 11794.1522070078},
+		{A: 8e-10, B: 2.33248094128, C: 38526.574350872},
+		{A: 7e-10, B: 3.704540611, C: 8734.4189721466},
+		{A: 7.7e-10, B: 4.49569185467, C: 20007.0864485468},
+		{A: 7.2e-10, B: 1.19410424468, C: 10217.2176994741},
+		{A: 6.8e-10, B: 2.01841060183, C: 14128.2427712456},
+		{A: 6.4e-10, B: 5.39293951654, C: 7880.08915333899},
+		{A: 6.6e-10, B: 3.20467071127, C: 14765.2390432698},
+		{A: 8e-10, B: 3.4162045777, C: 48417.97290558199},
+		{A: 8e-10, B: 3.39651161571, C: 245.8316462294},
+		{A: 6.6e-10, B: 5.85414440204, C: 9793.8009023358},
+		{A: 8.2e-10, B: 3.62592908644, C: 70743.77453195279},
+		{A: 5.8e-10, B: 4.95174942212, C: 30110.1656735384},
+		{A: 7.9e-10, B: 6.24161471033, C: 6037.244203762},
+		{A: 6.9e-10, B: 5.50183658445, C: 19793.7873531088},
+		{A: 5.6e-10, B: 1.24148350566, C: 10207.7626219036},
+		{A: 7e-10, B: 2.45123308846, C: 10218.8084705184},
+		{A: 6.4e-10, B: 5.53983104501, C: 10735.8629643048},
+		{A: 5.4e-10, B: 3.6225971324, C: 27461.7108480654},
+		{A: 7.3e-10, B: 1.75882480924, C: 1589.0728952838},
+		{A: 7.5e-10, B: 3.38244819846, C: 4214.0690150848},
+		{A: 5.4e-10, B: 0.64971567468, C: 9929.4262273458},
+		{A: 5.4e-10, B: 3.4095963723, C: 18418.01355326299},
+		{A: 5.6e-10, B: 3.65815006538, C: 14169.7935622304},
+		{A: 5.6e-10, B: 0.71243223808, C: 9896.8936765544},
+		{A: 5.2e-10, B: 1.3334813194, C: 20400.2727726222},
+		{A: 6.7e-10, B: 3.128065954, C: 5481.2549188676},
+		{A: 5.8e-10, B: 0.54482893546, C: 28313.288804661},
+		{A: 5.4e-10, B: 0.15603935681, C: 19580.4882576708},
+		{A: 5.1e-10, B: 3.3751547351, C: 9256.9963902404},
+		{A: 6.3e-10, B: 3.3884897095, C: 49515.382508407},
+		{A: 6.9e-10, B: 4.90917651401, C: 63498.47038145279},
+		{A: 5.7e-10, B: 5.0743774203, C: 18521.1063274816},
+		{A: 5e-10, B: 1.59156823654, C: 18631.31264870099},
+		{A: 5.4e-10, B: 6.25816208666, C: 37674.9963942764},
+		{A: 5.7e-10, B: 5.48065460919, C: 24383.0791084414},
+		{A: 4.5e-10, B: 1.1046649066, C: 10408.2569306716},
+		{A: 5.1e-10, B: 3.61196470313, C: 426.598190876},
+		{A: 5.7e-10, B: 2.09567711267, C: 60530.4889857418},
+		{A: 6e-10, B: 5.94659889997, C: 13897.6635962012},
+		{A: 5.1e-10, B: 5.4723851772, C: 57837.1383323006},
+		{A: 5.1e-10, B: 2.32438478428, C: 19779.56025910719},
+		{A: 5.2e-10, B: 3.23766328818, C: 18940.59097135679},
+		{A: 4.3e-10, B: 5.74921510909, C: 51868.2486621788},
+		{A: 4.8e-10, B: 1.12206254877, C: 9779.5738083342},
+		{A: 5.8e-10, B: 3.08646083897, C: 12074.488407524},
+		{A: 4.6e-10, B: 4.07536026888, C: 7863.9425107882},
+		{A: 4.5e-10, B: 4.75746520642, C: 7856.89627409019},
+		{A: 5.4e-10, B: 4.43528236634, C: 8617.0991039264},
+		{A: 5e-10, B: 3.70569982975, C: 42456.7840470916},
+		{A: 4.4e-10, B: 1.29248911155, C: 69166.430989505},
+		{A: 4.6e-10, B: 0.41229872114, C: 7564.830720738},
+		{A: 4.4e-10, B: 6.17937388307, C: 13341.6743113068},
+		{A: 5.3e-10, B: 4.71388531889, C: 53445.5922046266},
+		{A: 4.1e-10, B: 3.48003037828, C: 37895.4262903674},
+		{A: 4e-10, B: 1.2330554626, C: 10228.538017396},
+		{A: 5.3e-10, B: 5.04979874661, C: 74673.9842281724},
+		{A: 3.9e-10, B: 1.36646013032, C: 21202.093703746},
+		{A: 3.9e-10, B: 2.15376025201, C: 8947.7180675846},
+		{A: 4.1e-10, B: 6.1753298446, C: 65236.2212932854},
+		{A: 5.2e-10, B: 1.29052331493, C: 90394.82301305079},
+		{A: 3.9e-10, B: 0.70253732683, C: 18093.37469954999},
+		{A: 5.2e-10, B: 1.18164377451, C: 10211.8010735027},
+		{A: 4.7e-10, B: 1.78672260794, C: 10401.2106939736},
+		{A: 4e-10, B: 3.66961416802, C: 10198.033075026},
+		{A: 5.1e-10, B: 2.71698589018, C: 94325.0327092704},
+		{A: 3.6e-10, B: 1.2509171162, C: 10323.4918674304},
+		{A: 4.9e-10, B: 1.2133595942, C: 9721.6222537522},
+		{A: 4.2e-10, B: 6.05968230173, C: 105460.99111839019},
+		{A: 4.6e-10, B: 5.06978748275, C: 20350.3050211464},
+		{A: 4e-10, B: 1.97645050921, C: 32243.4985008806},
+		{A: 3.6e-10, B: 4.96702216961, C: 36301.18868778519},
+		{A: 3.7e-10, B: 5.29642935562, C: 38.0276726358},
+		{A: 3.9e-10, B: 0.52064327313, C: 26709.6469424134},
+		{A: 3.5e-10, B: 2.34112124655, C: 58946.51688439399},
+		{A: 3.4e-10, B: 1.82989750626, C: 17675.0234927304},
+		{A: 3.4e-10, B: 0.7649366411, C: 55798.4583583984},
+		{A: 3.5e-10, B: 1.09353675147, C: 69159.80243060499},
+		{A: 3.1e-10, B: 5.59148330297, C: 10639.883737087},
+		{A: 3.2e-10, B: 3.3296078187, C: 71519.2971432768},
+		{A: 3.1e-10, B: 5.98191446392, C: 24341.5283174566},
+		{A: 3.1e-10, B: 0.68615213145, C: 10202.2398459471},
+		{A: 3e-10, B: 4.42039942947, C: 10459.1171924404},
+		{A: 2.9e-10, B: 1.30367701539, C: 20103.06567576459},
+		{A: 3.1e-10, B: 4.51793347997, C: 2957.7158944766},
+		{A: 3.5e-10, B: 4.0563432129, C: 19903.99367432819},
+		{A: 3e-10, B: 1.32113757427, C: 574.3447983348},
+		{A: 2.9e-10, B: 3.36506645849, C: 10288.0671447783},
+		{A: 2.9e-10, B: 1.40019042576, C: 9988.9407505091},
+		{A: 3.2e-10, B: 0.21932095318, C: 24978.5245894808},
+		{A: 3.4e-10, B: 5.22945947227, C: 8673.969676399},
+		{A: 3.9e-10, B: 4.50883171158, C: 16004.6981037436},
+		{A: 2.8e-10, B: 2.32945945641, C: 11392.4800852506},
+		{A: 3.4e-10, B: 3.92498967835, C: 536.8045120954},
+		{A: 3.2e-10, B: 5.46972716255, C: 64607.84893354619},
+		{A: 2.8e-10, B: 2.38858990128, C: 20235.1228263104},
+		{A: 3e-10, B: 3.34585843979, C: 39793.7602546548},
+		{A: 2.6e-10, B: 5.36096904409, C: 1478.8665740644},
+	},
+	// R1, 180 terms
+	{
+		{A: 0.00034551039, B: 0.89198710598, C: 10213.285546211},
+		{A: 2.34203e-06, B: 1.77224942714, C: 20426.571092422},
+		{A: 2.33998e-06, B: 3.14159265359, C: 0},
+		{A: 2.3864e-07, B: 1.11274502648, C: 9437.762934887},
+		{A: 1.0568e-07, B: 4.59168210921, C: 1577.3435424478},
+		{A: 9.124e-08, B: 4.53540907003, C: 10404.7338123226},
+		{A: 6.599e-08, B: 5.97703999838, C: 5507.5532386674},
+		{A: 4.667e-08, B: 3.87683960551, C: 9153.9036160218},
+		{A: 3.84e-08, B: 5.66196924375, C: 13367.9726311066},
+		{A: 2.666e-08, B: 2.82413291285, C: 10206.1719992102},
+		{A: 2.194e-08, B: 2.05314419626, C: 775.522611324},
+		{A: 2.094e-08, B: 2.55137285015, C: 18837.49819713819},
+		{A: 1.782e-08, B: 2.64808558644, C: 30639.856638633},
+		{A: 1.845e-08, B: 1.87612936641, C: 11015.1064773348},
+		{A: 1.303e-08, B: 0.20613045603, C: 11322.6640983044},
+		{A: 1.169e-08, B: 0.79431893441, C: 17298.1823273262},
+		{A: 1.001e-08, B: 6.16555101536, C: 10239.5838660108},
+		{A: 9.15e-09, B: 4.59854496966, C: 1109.3785520934},
+		{A: 8.84e-09, B: 0.66706834422, C: 18073.7049386502},
+		{A: 8.49e-09, B: 5.5864157194, C: 12566.1516999828},
+		{A: 1.071e-08, B: 4.94792017474, C: 6283.0758499914},
+		{A: 8.87e-09, B: 2.47785193216, C: 3154.6870848956},
+		{A: 9.04e-09, B: 0.81413053841, C: 10596.1820784342},
+		{A: 8.18e-09, B: 0.90016838097, C: 5661.3320491522},
+		{A: 8.45e-09, B: 5.48504338112, C: 529.6909650946},
+		{A: 8.24e-09, B: 3.74837629121, C: 7084.8967811152},
+		{A: 6.52e-09, B: 5.07444932607, C: 22003.9146348698},
+		{A: 8.47e-09, B: 0.44119876869, C: 8635.9420037632},
+		{A: 6.38e-09, B: 4.10125791268, C: 191.4482661116},
+		{A: 6.15e-09, B: 3.14417599741, C: 10186.9872264112},
+		{A: 5.27e-09, B: 5.86792949279, C: 2352.8661537718},
+		{A: 5.2e-09, B: 5.33201358267, C: 14143.4952424306},
+		{A: 5.76e-09, B: 2.25212731258, C: 21228.3920235458},
+		{A: 6.62e-09, B: 2.86880467345, C: 8624.2126509272},
+		{A: 5.54e-09, B: 2.17186191243, C: 18307.8072320436},
+		{A: 5.15e-09, B: 4.34331395104, C: 9786.687355335},
+		{A: 5.01e-09, B: 5.56479589366, C: 10742.9765113056},
+		{A: 4.26e-09, B: 1.0216144312, C: 7064.1213856228},
+		{A: 4.18e-09, B: 1.26803034691, C: 9676.4810341156},
+		{A: 3.91e-09, B: 0.78974645621, C: 9690.7081281172},
+		{A: 3.34e-09, B: 3.18175822557, C: 10988.808157535},
+		{A: 3.75e-09, B: 0.66142254036, C: 19360.07561523199},
+		{A: 3.64e-09, B: 0.19369831864, C: 19374.3027092336},
+		{A: 3.13e-09, B: 1.09734397626, C: 4551.9534970588},
+		{A: 3.3e-09, B: 0.58817502306, C: 16496.3613962024},
+		{A: 3.39e-09, B: 5.76768761396, C: 10021.8372800994},
+		{A: 2.91e-09, B: 3.65846764668, C: 25158.6017197654},
+		{A: 2.23e-09, B: 4.33581625553, C: 19786.67380610799},
+		{A: 2.66e-09, B: 3.57408827667, C: 801.8209311238},
+		{A: 2.74e-09, B: 5.73346687248, C: 11790.6290886588},
+		{A: 2.75e-09, B: 5.65814317085, C: 19896.8801273274},
+		{A: 2.12e-09, B: 4.27038489878, C: 4705.7323075436},
+		{A: 2.3e-09, B: 6.1340634559, C: 1059.3819301892},
+		{A: 2.04e-09, B: 4.87348390351, C: 7860.4193924392},
+		{A: 2.41e-09, B: 1.13551531894, C: 26.2983197998},
+		{A: 2.06e-09, B: 0.31907973682, C: 382.8965322232},
+		{A: 2.16e-09, B: 2.54741101724, C: 19651.048481098},
+		{A: 2.12e-09, B: 3.15264941106, C: 14945.3161735544},
+		{A: 1.63e-09, B: 1.13604744392, C: 13936.794505134},
+		{A: 1.51e-09, B: 5.11341268743, C: 28521.0927782546},
+		{A: 1.51e-09, B: 0.81278755582, C: 6770.7106012456},
+		{A: 1.5e-09, B: 5.02227334847, C: 29088.811415985},
+		{A: 1.46e-09, B: 1.37568138685, C: 10220.3990932118},
+		{A: 1.27e-09, B: 4.49298610074, C: 3532.0606928114},
+		{A: 1.21e-09, B: 6.26589208179, C: 29580.4747084438},
+		{A: 1.47e-09, B: 6.16092774714, C: 8662.240323563},
+		{A: 1.14e-09, B: 0.00114012635, C: 25934.1243310894},
+		{A: 1.15e-09, B: 3.56897715344, C: 24356.7807886416},
+		{A: 1.24e-09, B: 0.67547060274, C: 3723.508958923},
+		{A: 1.45e-09, B: 0.36415036222, C: 9146.790069021},
+		{A: 1.04e-09, B: 4.27865011376, C: 9573.388259897},
+		{A: 1.36e-09, B: 5.09581116181, C: 19367.1891622328},
+		{A: 1.02e-09, B: 1.53637788668, C: 17277.4069318338},
+		{A: 1.17e-09, B: 0.57543238496, C: 9999.986450773},
+		{A: 9.2e-10, B: 0.22936081655, C: 18830.38465013739},
+		{A: 1.12e-09, B: 4.04771058036, C: 9103.9069941176},
+		{A: 9.8e-10, B: 3.78447692407, C: 213.299095438},
+		{A: 8.5e-10, B: 5.84471458481, C: 10234.0609417034},
+		{A: 7.9e-10, B: 0.64440357793, C: 18844.61174413899},
+		{A: 8.4e-10, B: 0.56950139213, C: 9683.5945811164},
+		{A: 1.07e-09, B: 1.77067111589, C: 17778.11626694899},
+		{A: 8.1e-10, B: 6.19048382717, C: 20618.0193585336},
+		{A: 8.7e-10, B: 0.15771136594, C: 33019.0211122046},
+		{A: 8.2e-10, B: 4.80683817059, C: 3930.2096962196},
+		{A: 8.6e-10, B: 2.21505615071, C: 8094.5216858326},
+		{A: 6.4e-10, B: 2.69215119482, C: 16983.9961474566},
+		{A: 6.9e-10, B: 0.83385751986, C: 3128.3887650958},
+		{A: 8.1e-10, B: 4.88025042367, C: 4732.0306273434},
+		{A: 5.9e-10, B: 3.34348033725, C: 10787.6303445458},
+		{A: 6.1e-10, B: 0.04044699966, C: 9161.0171630226},
+		{A: 6.4e-10, B: 4.13127333938, C: 9992.8729037722},
+		{A: 6e-10, B: 6.24603986632, C: 32217.2001810808},
+		{A: 5.4e-10, B: 3.38449893196, C: 10426.584641649},
+		{A: 5.4e-10, B: 5.15939119644, C: 28286.9904848612},
+		{A: 6.3e-10, B: 4.32339245083, C: 12592.4500197826},
+		{A: 6e-10, B: 4.4875384617, C: 18875.525869774},
+		{A: 5.7e-10, B: 3.64912085313, C: 10007.0999977738},
+		{A: 4.9e-10, B: 5.10267262491, C: 19573.37471066999},
+		{A: 4.7e-10, B: 5.79444960738, C: 68050.42387851159},
+		{A: 5.2e-10, B: 3.56658420552, C: 7255.5696517344},
+		{A: 5e-10, B: 1.61783309819, C: 36949.2308084242},
+		{A: 5.3e-10, B: 2.64370544855, C: 15874.6175953632},
+		{A: 4e-10, B: 3.93466530964, C: 20419.45754542119},
+		{A: 5.1e-10, B: 0.79154899901, C: 23581.2581773176},
+		{A: 3.8e-10, B: 1.77428239418, C: 10103.0792249916},
+		{A: 4.9e-10, B: 1.12423644455, C: 3442.5749449654},
+		{A: 4e-10, B: 5.22874487975, C: 21535.9496445154},
+		{A: 3.8e-10, B: 1.12473430132, C: 7.1135470008},
+		{A: 3.8e-10, B: 0.11510547453, C: 11272.6674764002},
+		{A: 3.6e-10, B: 2.02476324983, C: 7058.5984613154},
+		{A: 4.7e-10, B: 0.0558943239, C: 12432.0426503978},
+		{A: 3.4e-10, B: 3.45481114998, C: 9830.3890139878},
+		{A: 4.5e-10, B: 4.59817214088, C: 10192.5101507186},
+		{A: 3.7e-10, B: 4.93959675364, C: 3340.6124266998},
+		{A: 4.4e-10, B: 0.70533027806, C: 20213.271996984},
+		{A: 3.4e-10, B: 2.16487642765, C: 64460.6986819614},
+		{A: 3.1e-10, B: 1.57612397319, C: 36147.4098773004},
+		{A: 2.8e-10, B: 2.56454760402, C: 94138.32702008578},
+		{A: 3.3e-10, B: 1.08907268562, C: 29864.334027309},
+		{A: 2.9e-10, B: 0.59718407064, C: 59728.668054618},
+		{A: 3.1e-10, B: 3.04423979263, C: 40879.4405046438},
+		{A: 3.5e-10, B: 0.32247158762, C: 1589.0728952838},
+		{A: 3.1e-10, B: 3.27727318906, C: 19992.85935454519},
+		{A: 2.7e-10, B: 5.83705748551, C: 17085.9586657222},
+		{A: 3.2e-10, B: 2.6426078826, C: 41962.5207369374},
+		{A: 2.8e-10, B: 4.90613317287, C: 29050.7837433492},
+		{A: 2.5e-10, B: 4.55050389739, C: 14919.0178537546},
+		{A: 2.8e-10, B: 3.58851614957, C: 40853.142184844},
+		{A: 2.9e-10, B: 2.79705093386, C: 20007.0864485468},
+		{A: 3.3e-10, B: 0.93862065616, C: 15720.8387848784},
+		{A: 2.4e-10, B: 2.74970637101, C: 18947.7045183576},
+		{A: 2.4e-10, B: 4.38966861409, C: 46386.9937433112},
+		{A: 2.4e-10, B: 0.73361964525, C: 9411.4646150872},
+		{A: 2.8e-10, B: 4.19559784013, C: 37674.9963942764},
+		{A: 2.3e-10, B: 1.00023735538, C: 22779.4372461938},
+		{A: 2.6e-10, B: 0.46990555736, C: 13745.3462390224},
+		{A: 2.8e-10, B: 4.65181292126, C: 1551.045222648},
+		{A: 2.5e-10, B: 4.18690270765, C: 44007.8292697396},
+		{A: 2.2e-10, B: 0.98102807789, C: 426.598190876},
+		{A: 3e-10, B: 1.24986033487, C: 27461.7108480654},
+		{A: 2.7e-10, B: 3.94986823486, C: 17468.8551979454},
+		{A: 2.1e-10, B: 6.09897508157, C: 18300.69368504279},
+		{A: 2.5e-10, B: 4.75875623888, C: 27991.40181316},
+		{A: 2.2e-10, B: 2.95281481673, C: 40077.61957352},
+		{A: 2.8e-10, B: 6.12038264955, C: 38500.2760310722},
+		{A: 2.2e-10, B: 4.11184201321, C: 19779.56025910719},
+		{A: 2.7e-10, B: 3.7244644608, C: 19793.7873531088},
+		{A: 2e-10, B: 4.27086627368, C: 31441.6775697568},
+		{A: 2.2e-10, B: 4.99040169444, C: 31022.7531708562},
+		{A: 2.3e-10, B: 1.33505132122, C: 65236.2212932854},
+		{A: 2.1e-10, B: 4.46897353468, C: 53285.1848352418},
+		{A: 2e-10, B: 4.15140915983, C: 2218.7571041868},
+		{A: 2.5e-10, B: 2.18447182965, C: 27511.4678735372},
+		{A: 1.9e-10, B: 1.43653410349, C: 27197.2816936676},
+		{A: 2.7e-10, B: 1.22555218015, C: 42430.4857272918},
+		{A: 1.9e-10, B: 3.65054338893, C: 49515.382508407},
+		{A: 2.2e-10, B: 5.88380811711, C: 10218.8084705184},
+		{A: 1.8e-10, B: 2.29853355765, C: 19264.0963880142},
+		{A: 1.7e-10, B: 5.44429906531, C: 6681.2248533996},
+		{A: 2e-10, B: 3.68116637773, C: 14128.2427712456},
+		{A: 2.1e-10, B: 4.30316190532, C: 44809.6502008634},
+		{A: 2e-10, B: 2.48583613985, C: 33794.5437235286},
+		{A: 1.7e-10, B: 3.02735393984, C: 28528.2063252554},
+		{A: 1.9e-10, B: 5.92656850674, C: 22805.7355659936},
+		{A: 2.2e-10, B: 5.30827572791, C: 10207.7626219036},
+		{A: 2e-10, B: 0.75829381378, C: 18314.9207790444},
+		{A: 1.7e-10, B: 5.63315744126, C: 16522.6597160022},
+		{A: 1.6e-10, B: 1.71021408448, C: 536.8045120954},
+		{A: 1.5e-10, B: 5.27016880041, C: 53445.5922046266},
+		{A: 1.7e-10, B: 5.61443395877, C: 47938.0389659592},
+		{A: 1.5e-10, B: 5.81110284451, C: 43071.8992890308},
+		{A: 1.5e-10, B: 4.96237667003, C: 19999.97290154599},
+		{A: 1.8e-10, B: 0.55618686515, C: 14765.2390432698},
+		{A: 1.4e-10, B: 3.48144272414, C: 29786.660256881},
+		{A: 1.5e-10, B: 5.84132627836, C: 10228.538017396},
+		{A: 1.6e-10, B: 1.05720065324, C: 26735.9452622132},
+		{A: 1.4e-10, B: 6.08462030302, C: 35371.8872659764},
+		{A: 1.4e-10, B: 2.8453287189, C: 574.3447983348},
+		{A: 1.5e-10, B: 5.3451771514, C: 10198.033075026},
+		{A: 1.3e-10, B: 0.45004137509, C: 20452.8694122218},
+	},
+	// R2, 63 terms
+	{
+		{A: 1.406587e-05, B: 5.0636639519, C: 10213.285546211},
+		{A: 1.5529e-07, B: 5.47321687981, C: 20426.571092422},
+		{A: 1.3059e-07, B: 0, C: 0},
+		{A: 1.099e-08, B: 2.78883988292, C: 9437.762934887},
+		{A: 4.88e-09, B: 6.27806914496, C: 1577.3435424478},
+		{A: 3.61e-09, B: 6.11914188253, C: 10404.7338123226},
+		{A: 3.1e-09, B: 1.38984998403, C: 5507.5532386674},
+		{A: 3.89e-09, B: 1.95017779915, C: 11015.1064773348},
+		{A: 3.72e-09, B: 2.33222828423, C: 775.522611324},
+		{A: 2.07e-09, B: 5.63406721595, C: 10239.5838660108},
+		{A: 1.68e-09, B: 1.10765197296, C: 13367.9726311066},
+		{A: 1.75e-09, B: 6.1667465295, C: 30639.856638633},
+		{A: 1.68e-09, B: 3.64495311632, C: 7084.8967811152},
+		{A: 1.2e-09, B: 5.85815843789, C: 9153.9036160218},
+		{A: 1.6e-09, B: 2.21564938463, C: 3154.6870848956},
+		{A: 1.18e-09, B: 2.62358866565, C: 8635.9420037632},
+		{A: 1.12e-09, B: 2.36235956804, C: 10596.1820784342},
+		{A: 9.2e-10, B: 0.72664449269, C: 12566.1516999828},
+		{A: 6.7e-10, B: 3.76089669118, C: 18837.49819713819},
+		{A: 6.5e-10, B: 2.4798370999, C: 11790.6290886588},
+		{A: 4.8e-10, B: 4.26620187144, C: 2352.8661537718},
+		{A: 4.8e-10, B: 5.5089818955, C: 191.4482661116},
+		{A: 4.8e-10, B: 2.54730918293, C: 17298.1823273262},
+		{A: 4.6e-10, B: 3.40293459332, C: 14143.4952424306},
+		{A: 4.1e-10, B: 1.83997113019, C: 11322.6640983044},
+		{A: 3.7e-10, B: 6.17871126027, C: 1109.3785520934},
+		{A: 3.9e-10, B: 4.77190210316, C: 18073.7049386502},
+		{A: 3.5e-10, B: 3.10133256432, C: 4705.7323075436},
+		{A: 4.6e-10, B: 3.30090415967, C: 6283.0758499914},
+		{A: 3.4e-10, B: 3.91721765773, C: 10021.8372800994},
+		{A: 3.4e-10, B: 3.24663787383, C: 22003.9146348698},
+		{A: 4.2e-10, B: 3.39360926939, C: 14945.3161735544},
+		{A: 4.4e-10, B: 4.42979374073, C: 7860.4193924392},
+		{A: 3.4e-10, B: 2.16381407025, C: 16496.3613962024},
+		{A: 3.1e-10, B: 0.45714618479, C: 26.2983197998},
+		{A: 3.5e-10, B: 3.62868651241, C: 801.8209311238},
+		{A: 3.2e-10, B: 1.84138997078, C: 382.8965322232},
+		{A: 2.5e-10, B: 3.32908650295, C: 18307.8072320436},
+		{A: 2.6e-10, B: 3.64313769818, C: 29088.811415985},
+		{A: 2.9e-10, B: 3.8296717881, C: 10186.9872264112},
+		{A: 2.2e-10, B: 3.17741520378, C: 28521.0927782546},
+		{A: 2.1e-10, B: 2.52643834111, C: 529.6909650946},
+		{A: 2.5e-10, B: 5.71401244457, C: 21202.093703746},
+		{A: 2.1e-10, B: 3.77813434325, C: 21228.3920235458},
+		{A: 1.9e-10, B: 5.24505118517, C: 19896.8801273274},
+		{A: 1.8e-10, B: 4.62463651925, C: 19651.048481098},
+		{A: 1.6e-10, B: 3.35893297896, C: 28286.9904848612},
+		{A: 1.5e-10, B: 5.05571633205, C: 33019.0211122046},
+		{A: 1.4e-10, B: 2.83786355803, C: 19786.67380610799},
+		{A: 1.4e-10, B: 1.79922718553, C: 9830.3890139878},
+		{A: 1.4e-10, B: 3.14801263138, C: 19367.1891622328},
+		{A: 1.4e-10, B: 3.57896195191, C: 10988.808157535},
+		{A: 1.3e-10, B: 3.06303088617, C: 10742.9765113056},
+		{A: 1.3e-10, B: 5.43981998532, C: 25158.6017197654},
+		{A: 1.5e-10, B: 4.83166312889, C: 18875.525869774},
+		{A: 1.2e-10, B: 2.54141086214, C: 7064.1213856228},
+		{A: 1.2e-10, B: 4.45255110769, C: 15720.8387848784},
+		{A: 1e-10, B: 1.87933121728, C: 24356.7807886416},
+		{A: 1.1e-10, B: 2.58708635685, C: 9103.9069941176},
+		{A: 1e-10, B: 2.179013099, C: 3723.508958923},
+		{A: 8e-11, B: 3.63520673832, C: 1059.3819301892},
+		{A: 8e-11, B: 4.67523115598, C: 25934.1243310894},
+		{A: 9e-11, B: 5.97856553283, C: 9683.5945811164},
+	},
+	// R3, 7 terms
+	{
+		{A: 4.9582e-07, B: 3.2226355452, C: 10213.285546211},
+		{A: 8.31e-09, B: 3.21219077104, C: 20426.571092422},
+		{A: 1.12e-09, B: 3.14159265359, C: 0},
+		{A: 1.3e-10, B: 3.77448689585, C: 30639.856638633},
+		{A: 9e-11, B: 4.19802043629, C: 10239.5838660108},
+		{A: 6e-11, B: 0.20714935358, C: 10186.9872264112},
+		{A: 5e-11, B: 0.68781956122, C: 8635.9420037632},
+	},
+	// R4, 3 terms
+	{
+		{A: 5.73e-09, B: 0.9222969782, C: 10213.285546211},
+		{A: 4e-10, B: 0.95468912157, C: 20426.571092422},
+		{A: 6e-11, B: 3.14159265359, C: 0},
+	},
+	// R5, 2 terms
+	{
+		{A: 4.5e-10, B: 0.30032866722, C: 10213.285546211},
+		{A: 2e-11, B: 5.29627718483, C: 20426.571092422},
+	},
+}
+
+// GetVenusR0 evaluates the t^0 series of R.
+func GetVenusR0(t float64) float64 {
+	return venusR[0].Evaluate(t)
+}
+
+// GetVenusR1 evaluates the t^1 series of R.
+func GetVenusR1(t float64) float64 {
+	return venusR[1].Evaluate(t)
+}
+
+// GetVenusR2 evaluates the t^2 series of R.
+func GetVenusR2(t float64) float64 {
+	return venusR[2].Evaluate(t)
+}
+
+// GetVenusR3 evaluates the t^3 series of R.
+func GetVenusR3(t float64) float64 {
+	return venusR[3].Evaluate(t)
+}
+
+// GetVenusR4 evaluates the t^4 series of R.
+func GetVenusR4(t float64) float64 {
+	return venusR[4].Evaluate(t)
+}
+
+// GetVenusR5 evaluates the t^5 series of R.
+func GetVenusR5(t float64) float64 {
+	return venusR[5].Evaluate(t)
+}